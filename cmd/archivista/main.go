@@ -21,6 +21,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"net/http"
 	"os"
@@ -31,7 +32,10 @@ import (
 
 	nested "github.com/antonfisher/nested-logrus-formatter"
 	"github.com/gorilla/handlers"
+	"github.com/in-toto/archivista/pkg/auth"
+	"github.com/in-toto/archivista/pkg/config"
 	"github.com/in-toto/archivista/pkg/server"
+	"github.com/in-toto/archivista/pkg/spiffe"
 	"github.com/sirupsen/logrus"
 )
 
@@ -49,6 +53,11 @@ func main() {
 	)
 	defer cancel()
 
+	if len(os.Args) > 1 {
+		runCommand(ctx, os.Args[1])
+		return
+	}
+
 	startTime := time.Now()
 
 	archivistaService := &server.ArchivistaService{Ctx: ctx, Cfg: nil}
@@ -87,11 +96,54 @@ func main() {
 		WriteTimeout: time.Duration(archivistaService.Cfg.WriteTimeout) * time.Second,
 	}
 
+	if archivistaService.Cfg.EnableAuth && len(archivistaService.Cfg.AuthClientCAFiles) > 0 {
+		clientCAs, err := auth.LoadCertPool(archivistaService.Cfg.AuthClientCAFiles...)
+		if err != nil {
+			logrus.Fatalf("unable to load client certificate authorities: %+v", err)
+		}
+
+		// clients without a certificate may still authenticate with a bearer token
+		srv.TLSConfig = &tls.Config{
+			ClientCAs:  clientCAs,
+			ClientAuth: tls.VerifyClientCertIfGiven,
+		}
+	}
+
+	if archivistaService.Cfg.EnableSPIFFE {
+		if archivistaService.Cfg.EnableTLS {
+			logrus.Fatal("TLS and SPIFFE can not both be enabled")
+		}
+
+		source, err := spiffe.NewX509Source(ctx, archivistaService.Cfg.SPIFFEAddress)
+		if err != nil {
+			logrus.Fatalf("unable to initialize spiffe: %+v", err)
+		}
+		defer source.Close()
+
+		svid, err := source.GetX509SVID()
+		if err != nil {
+			logrus.Fatalf("unable to get x509 svid: %+v", err)
+		}
+
+		authorizer, err := spiffe.Authorizer(archivistaService.Cfg.SPIFFEAuthorizedIds, svid.ID.TrustDomain())
+		if err != nil {
+			logrus.Fatalf("unable to configure spiffe authorization: %+v", err)
+		}
+
+		srv.TLSConfig = spiffe.ServerTLSConfig(source, authorizer)
+		logrus.Infof("serving mTLS as %s", svid.ID)
+	}
+
 	go func() {
 		if archivistaService.Cfg.EnableTLS {
 			if err := srv.ServeTLS(listener, archivistaService.Cfg.TLSCert, archivistaService.Cfg.TLSKey); err != nil {
 				logrus.Fatalf("unable to start http server: %+v", err)
 			}
+		} else if archivistaService.Cfg.EnableSPIFFE {
+			// the certificate comes from the svid source in the tls config
+			if err := srv.ServeTLS(listener, "", ""); err != nil {
+				logrus.Fatalf("unable to start http server: %+v", err)
+			}
 		} else {
 			if err := srv.Serve(listener); err != nil {
 				logrus.Fatalf("unable to start http server: %+v", err)
@@ -103,8 +155,42 @@ func main() {
 	logrus.Infof("startup complete (time since start: %s)", time.Since(startTime))
 
 	<-ctx.Done()
-	<-archivistaService.GetFileStoreCh()
 	<-archivistaService.GetSQLStoreCh()
+	<-archivistaService.GetPublisherCh()
+	<-archivistaService.GetRetentionCh()
+	if err := archivistaService.Close(); err != nil {
+		logrus.Errorf("unable to close object store: %+v", err)
+	}
 
 	logrus.Infof("exiting, uptime: %v", time.Since(startTime))
 }
+
+// runCommand runs one of the maintenance commands of the archivista binary against the
+// storage configured in the environment, instead of serving
+func runCommand(ctx context.Context, command string) {
+	cfg := new(config.Config)
+	if err := cfg.Process(); err != nil {
+		logrus.Fatal(err)
+	}
+
+	switch command {
+	case "recompress":
+		if err := recompress(ctx, cfg); err != nil {
+			logrus.Fatalf("recompress failed: %+v", err)
+		}
+	case "rewrap":
+		if err := rewrap(ctx, cfg); err != nil {
+			logrus.Fatalf("rewrap failed: %+v", err)
+		}
+	case "migrate-layout":
+		if err := migrateLayout(ctx, cfg); err != nil {
+			logrus.Fatalf("migrate-layout failed: %+v", err)
+		}
+	case "migrate-schema":
+		if err := migrateSchema(ctx, cfg); err != nil {
+			logrus.Fatalf("migrate-schema failed: %+v", err)
+		}
+	default:
+		logrus.Fatalf("unknown command %q. Available commands: recompress, rewrap, migrate-layout, migrate-schema", command)
+	}
+}
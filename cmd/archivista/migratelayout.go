@@ -0,0 +1,56 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage/filestore"
+	"github.com/sirupsen/logrus"
+)
+
+// migrateLayout moves the envelopes of the FILE backend that are still in the flat layout from
+// before sharding into their shards. It can run while Archivista is serving, since envelopes are
+// read from either layout.
+func migrateLayout(ctx context.Context, cfg *config.Config) error {
+	if !strings.EqualFold(cfg.StorageBackend, "FILE") {
+		return errors.New("only the FILE storage backend has a layout to migrate")
+	}
+
+	store := filestore.New(cfg.FileDir, filestore.WithFsync(cfg.FileFsync))
+	if err := store.Start(ctx); err != nil {
+		return fmt.Errorf("could not start object store: %w", err)
+	}
+	defer store.Close()
+
+	startTime := time.Now()
+	logrus.Infof("migrating envelopes in %s to the sharded layout", cfg.FileDir)
+	result, err := store.MigrateLayout(ctx, func(key string, err error) {
+		logrus.Errorf("failed to migrate %s: %v", key, err)
+	})
+	logrus.Infof("checked %d envelopes and migrated %d in %s", result.Checked, result.Migrated, time.Since(startTime))
+	if err != nil {
+		return err
+	} else if result.Failed > 0 {
+		return fmt.Errorf("%d envelopes could not be migrated", result.Failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/metadatastorage/sqlstore"
+	"github.com/sirupsen/logrus"
+)
+
+// migrateSchema updates a database that is managed by ent's auto-migration rather than the atlas
+// migrations, dropping the indexes the schema no longer declares. Archivista never drops indexes
+// on its own, since operators may have added some by hand.
+func migrateSchema(ctx context.Context, cfg *config.Config) error {
+	if !cfg.EnableSQLStore {
+		return errors.New("the SQL store is disabled, so there is no schema to migrate")
+	}
+
+	client, err := sqlstore.NewEntClient(cfg.SQLStoreBackend, cfg.SQLStoreConnectionString)
+	if err != nil {
+		return fmt.Errorf("could not create ent client: %w", err)
+	}
+	defer client.Close()
+
+	logrus.Infof("migrating %s schema", cfg.SQLStoreBackend)
+	return sqlstore.MigrateSchema(ctx, client)
+}
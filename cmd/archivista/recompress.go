@@ -0,0 +1,69 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/sirupsen/logrus"
+)
+
+// recompress rewrites every envelope in the configured object store that isn't compressed
+// with ARCHIVISTA_STORAGE_COMPRESSION. It can run while Archivista is serving, since envelopes
+// stay readable while they are rewritten.
+func recompress(ctx context.Context, cfg *config.Config) error {
+	if cfg.StorageBackend == "" {
+		return errors.New("no storage backend is configured")
+	}
+
+	// encrypted envelopes are compressed before they are encrypted, which rewrapping takes care of
+	if cfg.StorageEncryptionKeyProvider != "" {
+		return errors.New("envelopes are encrypted, so they can't be recompressed")
+	}
+
+	store, err := objectstorage.New(ctx, cfg.StorageBackend, cfg)
+	if err != nil {
+		return fmt.Errorf("could not create object store: %w", err)
+	}
+
+	if err := store.Start(ctx); err != nil {
+		return fmt.Errorf("could not start object store: %w", err)
+	}
+	defer store.Close()
+
+	recompressor, ok := store.(objectstorage.Recompressor)
+	if !ok {
+		return fmt.Errorf("storage backend %s does not support recompression", cfg.StorageBackend)
+	}
+
+	startTime := time.Now()
+	logrus.Infof("recompressing envelopes with compression %q", cfg.StorageCompression)
+	result, err := objectstorage.RecompressAll(ctx, recompressor, func(key string, err error) {
+		logrus.Errorf("failed to recompress %s: %v", key, err)
+	})
+	logrus.Infof("checked %d envelopes and recompressed %d in %s", result.Checked, result.Recompressed, time.Since(startTime))
+	if err != nil {
+		return err
+	} else if result.Failed > 0 {
+		return fmt.Errorf("%d envelopes could not be recompressed", result.Failed)
+	}
+
+	return nil
+}
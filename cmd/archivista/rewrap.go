@@ -0,0 +1,66 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage/encryption"
+	"github.com/sirupsen/logrus"
+)
+
+// rewrap wraps the data key of every envelope in the configured object store with the current
+// key of the key provider, and encrypts envelopes stored before encryption was enabled. It is
+// run after rotating keys, so older keys can be retired.
+func rewrap(ctx context.Context, cfg *config.Config) error {
+	if cfg.StorageBackend == "" {
+		return errors.New("no storage backend is configured")
+	} else if cfg.StorageEncryptionKeyProvider == "" {
+		return errors.New("no key provider is configured")
+	}
+
+	store, err := encryption.NewFromConfig(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("could not create object store: %w", err)
+	}
+
+	if err := store.Start(ctx); err != nil {
+		return fmt.Errorf("could not start object store: %w", err)
+	}
+	defer store.Close()
+
+	encrypted, ok := store.(*encryption.Store)
+	if !ok {
+		return errors.New("the object store does not encrypt envelopes")
+	}
+
+	startTime := time.Now()
+	logrus.Infof("rewrapping envelopes with key provider %s", cfg.StorageEncryptionKeyProvider)
+	result, err := encrypted.RewrapAll(ctx, func(key string, err error) {
+		logrus.Errorf("failed to rewrap %s: %v", key, err)
+	})
+	logrus.Infof("checked %d envelopes and rewrapped %d in %s", result.Checked, result.Rewrapped, time.Since(startTime))
+	if err != nil {
+		return err
+	} else if result.Failed > 0 {
+		return fmt.Errorf("%d envelopes could not be rewrapped", result.Failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:          "delete",
+	Short:        "deletes envelopes and the metadata stored from them by their gitoids",
+	SilenceUsage: true,
+	Args:         cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, gitoid := range args {
+			if _, err := api.Delete(cmd.Context(), archivistaUrl, gitoid, requestOptions()...); err != nil {
+				return fmt.Errorf("failed to delete %s: %w", gitoid, err)
+			}
+
+			rootCmd.Printf("deleted %s\n", gitoid)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(deleteCmd)
+}
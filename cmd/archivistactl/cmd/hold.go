@@ -0,0 +1,91 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+var (
+	holdReason        string
+	holdSubjectDigest string
+
+	holdCmd = &cobra.Command{
+		Use:          "hold",
+		Short:        "Places and releases legal holds that prevent envelopes from being deleted",
+		SilenceUsage: true,
+	}
+
+	placeHoldCmd = &cobra.Command{
+		Use:          "place [gitoids...]",
+		Short:        "Places envelopes under a legal hold, by gitoid or by the digest of a subject",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hold := api.HoldRequest{Reason: holdReason, Gitoids: args}
+			if holdSubjectDigest != "" {
+				algorithm, digest, ok := strings.Cut(holdSubjectDigest, ":")
+				if !ok {
+					return errors.New("subject digest must be formatted as algorithm:digest")
+				}
+
+				hold.SubjectDigestAlgorithm = algorithm
+				hold.SubjectDigest = digest
+			} else if len(args) == 0 {
+				return errors.New("gitoids or a subject digest are required")
+			}
+
+			resp, err := api.Hold(cmd.Context(), archivistaUrl, hold, requestOptions()...)
+			if err != nil {
+				return err
+			}
+
+			rootCmd.Printf("placed legal hold %s on:\n", resp.ID)
+			for _, gitoid := range resp.Gitoids {
+				rootCmd.Printf("  %s\n", gitoid)
+			}
+
+			return nil
+		},
+	}
+
+	releaseHoldCmd = &cobra.Command{
+		Use:          "release <id>",
+		Short:        "Releases a legal hold",
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := api.ReleaseHold(cmd.Context(), archivistaUrl, args[0], requestOptions()...)
+			if err != nil {
+				return err
+			}
+
+			rootCmd.Printf("released legal hold %s\n", resp.ID)
+			return nil
+		},
+	}
+)
+
+func init() {
+	rootCmd.AddCommand(holdCmd)
+	holdCmd.AddCommand(placeHoldCmd)
+	holdCmd.AddCommand(releaseHoldCmd)
+	placeHoldCmd.Flags().StringVarP(&holdReason, "reason", "r", "", "Why the envelopes are held")
+	placeHoldCmd.Flags().StringVar(&holdSubjectDigest, "subject-digest", "", "Hold every envelope with a subject of this digest, formatted as algorithm:digest")
+	_ = placeHoldCmd.MarkFlagRequired("reason")
+}
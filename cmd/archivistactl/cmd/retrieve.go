@@ -15,9 +15,12 @@
 package cmd
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/in-toto/archivista/pkg/api"
@@ -25,7 +28,9 @@ import (
 )
 
 var (
-	outFile string
+	outFile  string
+	fromFile string
+	outDir   string
 
 	retrieveCmd = &cobra.Command{
 		Use:          "retrieve",
@@ -54,6 +59,54 @@ var (
 		},
 	}
 
+	envelopesCmd = &cobra.Command{
+		Use:          "envelopes [gitoid...]",
+		Short:        "Retrieves many dsse envelopes by their gitoids from archivista in one request",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gitoids := args
+			if len(fromFile) > 0 {
+				fileGitoids, err := readGitoids(fromFile)
+				if err != nil {
+					return err
+				}
+
+				gitoids = append(gitoids, fileGitoids...)
+			}
+
+			if len(gitoids) == 0 {
+				return errors.New("gitoids must be passed as arguments or with --from-file")
+			}
+
+			if err := os.MkdirAll(outDir, 0o755); err != nil {
+				return err
+			}
+
+			results, err := api.DownloadMany(cmd.Context(), archivistaUrl, gitoids, requestOptions()...)
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, result := range results {
+				if result.Err == nil {
+					result.Err = os.WriteFile(filepath.Join(outDir, result.Gitoid+".json"), result.Content, 0o644)
+				}
+
+				if result.Err != nil {
+					failed++
+					rootCmd.PrintErrf("%s: %v\n", result.Gitoid, result.Err)
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d envelopes could not be retrieved", failed, len(results))
+			}
+
+			return nil
+		},
+	}
+
 	subjectCmd = &cobra.Command{
 		Use:          "subjects",
 		Short:        "Retrieves all subjects on an in-toto statement by the envelope gitoid",
@@ -74,8 +127,38 @@ var (
 func init() {
 	rootCmd.AddCommand(retrieveCmd)
 	retrieveCmd.AddCommand(envelopeCmd)
+	retrieveCmd.AddCommand(envelopesCmd)
 	retrieveCmd.AddCommand(subjectCmd)
 	envelopeCmd.Flags().StringVarP(&outFile, "out", "o", "", "File to write the envelope out to. Defaults to stdout")
+	envelopesCmd.Flags().StringVar(&fromFile, "from-file", "", "File listing gitoids to retrieve, one per line. Use - to read from stdin")
+	envelopesCmd.Flags().StringVarP(&outDir, "out-dir", "o", ".", "Directory to write the envelopes out to, each as <gitoid>.json")
+}
+
+// readGitoids reads one gitoid per line, skipping blank lines and lines starting with #
+func readGitoids(path string) ([]string, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		defer file.Close()
+		r = file
+	}
+
+	gitoids := make([]string, 0)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		gitoids = append(gitoids, line)
+	}
+
+	return gitoids, scanner.Err()
 }
 
 func printSubjects(results retrieveSubjectResults) {
@@ -16,8 +16,15 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/edwarnicke/gitoid"
+	"github.com/in-toto/archivista/pkg/api"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -93,3 +100,50 @@ func (ut *UTRetrieveSuite) Test_RetrieveSubjectsNoDB() {
 		ut.FailNow("Expected: error")
 	}
 }
+
+func (ut *UTRetrieveSuite) Test_RetrieveEnvelopesMissingArg() {
+	output := bytes.NewBufferString("")
+	rootCmd.SetOut(output)
+	rootCmd.SetErr(output)
+	rootCmd.SetArgs([]string{"retrieve", "envelopes"})
+	err := rootCmd.Execute()
+	ut.ErrorContains(err, "gitoids must be passed as arguments or with --from-file")
+}
+
+func (ut *UTRetrieveSuite) Test_RetrieveEnvelopes_FromFile() {
+	content := []byte(`{"payload":"stored"}`)
+	gid, err := gitoid.New(bytes.NewReader(content), gitoid.WithContentLength(int64(len(content))), gitoid.WithSha256())
+	ut.Require().NoError(err)
+	stored := gid.String()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ut.Equal("/v1/download", r.URL.Path)
+		req := api.BatchDownloadRequest{}
+		ut.Require().NoError(json.NewDecoder(r.Body).Decode(&req))
+		ut.Equal([]string{stored, "missing"}, req.Gitoids)
+
+		enc := json.NewEncoder(w)
+		_ = enc.Encode(api.BatchDownloadItem{Gitoid: stored, Envelope: content})
+		_ = enc.Encode(api.BatchDownloadItem{Gitoid: "missing", Error: &api.ErrorResponse{Error: "not_found", Message: "object not found: missing"}})
+	}))
+	defer testServer.Close()
+	defer func() { archivistaUrl, fromFile = "http://localhost:8082", "" }()
+
+	dir := ut.T().TempDir()
+	listFile := filepath.Join(dir, "gitoids.txt")
+	ut.Require().NoError(os.WriteFile(listFile, []byte("# release 1.0\n"+stored+"\n\nmissing\n"), 0o600))
+	outDir := filepath.Join(dir, "envelopes")
+
+	output := bytes.NewBufferString("")
+	rootCmd.SetOut(output)
+	rootCmd.SetErr(output)
+	rootCmd.SetArgs([]string{"retrieve", "envelopes", "-u", testServer.URL, "--from-file", listFile, "--out-dir", outDir})
+	err = rootCmd.Execute()
+	ut.ErrorContains(err, "1 of 2 envelopes could not be retrieved")
+	ut.Contains(output.String(), "missing: object not found: missing")
+
+	written, err := os.ReadFile(filepath.Join(outDir, stored+".json"))
+	ut.NoError(err)
+	ut.Equal(content, written)
+	ut.NoFileExists(filepath.Join(outDir, "missing.json"))
+}
@@ -26,6 +26,7 @@ import (
 var (
 	archivistaUrl  string
 	requestHeaders []string
+	tenant         string
 
 	rootCmd = &cobra.Command{
 		Use:   "archivistactl",
@@ -36,6 +37,7 @@ var (
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&archivistaUrl, "archivistaurl", "u", "http://localhost:8082", "url of the archivista instance")
 	rootCmd.PersistentFlags().StringArrayVarP(&requestHeaders, "headers", "H", []string{}, "headers to use when making requests to archivista")
+	rootCmd.PersistentFlags().StringVar(&tenant, "tenant", "", "tenant to scope requests to on archivista instances with multi-tenancy enabled")
 }
 
 func Execute() error {
@@ -58,5 +60,9 @@ func requestOptions() []api.RequestOption {
 		opts = append(opts, api.WithHeaders(headers))
 	}
 
+	if tenant != "" {
+		opts = append(opts, api.WithTenant(tenant))
+	}
+
 	return opts
 }
@@ -16,46 +16,251 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/in-toto/archivista/pkg/api"
 	"github.com/spf13/cobra"
 )
 
-var storeCmd = &cobra.Command{
-	Use:          "store",
-	Short:        "stores an attestation on the archivista server",
-	SilenceUsage: true,
-	Args:         cobra.MinimumNArgs(1),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		for _, filePath := range args {
-			if gitoid, err := storeAttestationByPath(cmd.Context(), archivistaUrl, filePath); err != nil {
-				return fmt.Errorf("failed to store %s: %w", filePath, err)
-			} else {
-				rootCmd.Printf("%s stored with gitoid %s\n", filePath, gitoid)
+var (
+	storeParallel  int
+	storeBatchSize int
+
+	storeCmd = &cobra.Command{
+		Use:          "store <file|directory|glob>...",
+		Short:        "stores an attestation on the archivista server",
+		Long:         "stores attestations on the archivista server. Directories are searched for .json files, globs are expanded, and many attestations are uploaded in batches.",
+		SilenceUsage: true,
+		Args:         cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			paths, err := expandStorePaths(args)
+			if err != nil {
+				return err
 			}
-		}
 
-		return nil
-	},
-}
+			if len(paths) == 1 {
+				resp, err := storeAttestationByPath(cmd.Context(), archivistaUrl, paths[0])
+				if err != nil {
+					return fmt.Errorf("failed to store %s: %w", paths[0], err)
+				}
+
+				if failed := printUploaded(paths[0], resp.Gitoid, resp.Status, resp.Envelopes); failed > 0 {
+					return fmt.Errorf("%d of %d envelopes of %s could not be stored", failed, len(resp.Envelopes), paths[0])
+				}
+
+				return nil
+			}
+
+			if failed := storeAttestationsByPath(cmd.Context(), archivistaUrl, paths); failed > 0 {
+				return fmt.Errorf("%d of %d attestations could not be stored", failed, len(paths))
+			}
+
+			return nil
+		},
+	}
+)
 
 func init() {
 	rootCmd.AddCommand(storeCmd)
+	storeCmd.Flags().IntVar(&storeParallel, "parallel", 1, "Number of batches of attestations to upload at once")
+	storeCmd.Flags().IntVar(&storeBatchSize, "batch-size", 100, "Number of attestations to upload in each request")
 }
 
-func storeAttestationByPath(ctx context.Context, baseUrl, path string) (string, error) {
+// printUploaded prints what was stored from the file at path and returns how many of its envelopes
+// could not be stored. A bundle of several envelopes is stored envelope by envelope, so each is
+// printed on its own.
+func printUploaded(path, gitoid string, status api.UploadStatus, envelopes []api.UploadResponse) int {
+	if len(envelopes) <= 1 {
+		printStored(path, gitoid, status)
+		return 0
+	}
+
+	failed := 0
+	for i, envelope := range envelopes {
+		name := fmt.Sprintf("%s envelope %d", path, i+1)
+		if envelope.Error != nil {
+			rootCmd.PrintErrf("failed to store %s: %s\n", name, envelope.Error.Message)
+			failed++
+			continue
+		}
+
+		printStored(name, envelope.Gitoid, envelope.Status)
+	}
+
+	return failed
+}
+
+func printStored(path, gitoid string, status api.UploadStatus) {
+	if status == api.UploadStatusAlreadyExists {
+		rootCmd.Printf("%s already stored with gitoid %s\n", path, gitoid)
+	} else {
+		rootCmd.Printf("%s stored with gitoid %s\n", path, gitoid)
+	}
+}
+
+// isAttestationFile reports whether a file found in a directory should be stored: envelopes and
+// Sigstore bundles are .json files, and in-toto bundles are .jsonl files
+func isAttestationFile(path string) bool {
+	ext := filepath.Ext(path)
+	return strings.EqualFold(ext, ".json") || strings.EqualFold(ext, ".jsonl")
+}
+
+// expandStorePaths expands globs and searches directories for .json and .jsonl files. Paths that
+// are neither are stored as they are.
+func expandStorePaths(args []string) ([]string, error) {
+	paths := make([]string, 0, len(args))
+	for _, arg := range args {
+		matches := []string{arg}
+		if strings.ContainsAny(arg, "*?[") {
+			var err error
+			if matches, err = filepath.Glob(arg); err != nil {
+				return nil, fmt.Errorf("invalid glob %s: %w", arg, err)
+			} else if len(matches) == 0 {
+				return nil, fmt.Errorf("no files match %s", arg)
+			}
+		}
+
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil || !info.IsDir() {
+				// missing files are reported when they are stored
+				paths = append(paths, match)
+				continue
+			}
+
+			if err := filepath.WalkDir(match, func(path string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+
+				if !d.IsDir() && isAttestationFile(path) {
+					paths = append(paths, path)
+				}
+
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.New("no attestations found to store")
+	}
+
+	return paths, nil
+}
+
+func storeAttestationByPath(ctx context.Context, baseUrl, path string) (api.UploadResponse, error) {
 	file, err := os.Open(path)
 	if err != nil {
-		return "", err
+		return api.UploadResponse{}, err
 	}
 
 	defer file.Close()
-	resp, err := api.StoreWithReader(ctx, baseUrl, file, requestOptions()...)
+	return api.StoreWithReader(ctx, baseUrl, file, requestOptions()...)
+}
+
+// storeAttestationsByPath uploads the attestations at paths in batches, storeParallel batches
+// at a time, and returns how many could not be stored
+func storeAttestationsByPath(ctx context.Context, baseUrl string, paths []string) int {
+	batchSize := min(max(storeBatchSize, 1), api.MaxBatchUpload)
+	batches := make(chan []string)
+	go func() {
+		defer close(batches)
+		for start := 0; start < len(paths); start += batchSize {
+			batches <- paths[start:min(start+batchSize, len(paths))]
+		}
+	}()
+
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	failed := 0
+	for range max(storeParallel, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				results := storeBatch(ctx, baseUrl, batch)
+				mu.Lock()
+				for i, result := range results {
+					if result.err != nil {
+						failed++
+						rootCmd.PrintErrf("failed to store %s: %v\n", batch[i], result.err)
+					} else if printUploaded(batch[i], result.gitoid, result.status, result.envelopes) > 0 {
+						failed++
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	return failed
+}
+
+type storeResult struct {
+	gitoid    string
+	status    api.UploadStatus
+	envelopes []api.UploadResponse
+	err       error
+}
+
+// storeBatch uploads the attestations at paths in one request and returns the result of each
+func storeBatch(ctx context.Context, baseUrl string, paths []string) []storeResult {
+	results := make([]storeResult, len(paths))
+	envelopes := make([]io.Reader, 0, len(paths))
+	// sent maps the index of each uploaded envelope back to its path
+	sent := make([]int, 0, len(paths))
+	for i, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			results[i].err = err
+			continue
+		}
+
+		defer file.Close()
+		envelopes = append(envelopes, file)
+		sent = append(sent, i)
+	}
+
+	if len(envelopes) == 0 {
+		return results
+	}
+
+	for _, i := range sent {
+		results[i].err = errors.New("missing from the server's response")
+	}
+
+	uploaded, err := api.StoreMany(ctx, baseUrl, envelopes, requestOptions()...)
 	if err != nil {
-		return "", err
+		for _, i := range sent {
+			results[i].err = err
+		}
+
+		return results
+	}
+
+	for _, result := range uploaded {
+		if result.Index < 0 || result.Index >= len(sent) {
+			continue
+		}
+
+		i := sent[result.Index]
+		if result.Error != nil {
+			results[i] = storeResult{err: errors.New(result.Error.Message)}
+		} else {
+			results[i] = storeResult{gitoid: result.Gitoid, status: result.Status, envelopes: result.Envelopes}
+		}
 	}
 
-	return resp.Gitoid, nil
+	return results
 }
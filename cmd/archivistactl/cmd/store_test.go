@@ -0,0 +1,142 @@
+// Copyright 2023 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT Store
+type UTStoreSuite struct {
+	suite.Suite
+	dir string
+}
+
+func TestUTStoreSuite(t *testing.T) {
+	suite.Run(t, new(UTStoreSuite))
+}
+
+func (ut *UTStoreSuite) SetupTest() {
+	ut.dir = ut.T().TempDir()
+	for _, name := range []string{"a.json", "b.json", "nested/c.json", "notes.txt"} {
+		path := filepath.Join(ut.dir, name)
+		ut.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+		ut.Require().NoError(os.WriteFile(path, []byte(name), 0o600))
+	}
+}
+
+func (ut *UTStoreSuite) Test_ExpandStorePaths() {
+	// bundles are stored along with envelopes
+	ut.Require().NoError(os.WriteFile(filepath.Join(ut.dir, "nested", "d.jsonl"), nil, 0o600))
+	ut.Require().NoError(os.WriteFile(filepath.Join(ut.dir, "app.sigstore.json"), nil, 0o600))
+
+	paths, err := expandStorePaths([]string{ut.dir})
+	ut.NoError(err)
+	ut.ElementsMatch([]string{
+		filepath.Join(ut.dir, "a.json"),
+		filepath.Join(ut.dir, "b.json"),
+		filepath.Join(ut.dir, "nested", "c.json"),
+		filepath.Join(ut.dir, "nested", "d.jsonl"),
+		filepath.Join(ut.dir, "app.sigstore.json"),
+	}, paths)
+
+	paths, err = expandStorePaths([]string{filepath.Join(ut.dir, "*.txt"), "missing.json"})
+	ut.NoError(err)
+	ut.Equal([]string{filepath.Join(ut.dir, "notes.txt"), "missing.json"}, paths)
+
+	_, err = expandStorePaths([]string{filepath.Join(ut.dir, "*.yaml")})
+	ut.ErrorContains(err, "no files match")
+}
+
+func (ut *UTStoreSuite) Test_StoreDirectory() {
+	requests := atomic.Int32{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		ut.Equal("/v1/upload/batch", r.URL.Path)
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		ut.Require().NoError(err)
+
+		resp := api.BatchUploadResponse{}
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		for index := 0; ; index++ {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+
+			ut.Require().NoError(err)
+			envelope, _ := io.ReadAll(part)
+			if string(envelope) == "b.json" {
+				resp.Results = append(resp.Results, api.BatchUploadResult{Index: index, Error: &api.ErrorResponse{Error: "invalid_envelope", Message: "invalid dsse envelope"}})
+			} else {
+				resp.Results = append(resp.Results, api.BatchUploadResult{Index: index, Gitoid: "gitoid-" + string(envelope), Status: api.UploadStatusCreated})
+			}
+		}
+
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer testServer.Close()
+	defer func() { archivistaUrl, storeParallel, storeBatchSize = "http://localhost:8082", 1, 100 }()
+
+	output := bytes.NewBufferString("")
+	rootCmd.SetOut(output)
+	rootCmd.SetErr(output)
+	rootCmd.SetArgs([]string{"store", "-u", testServer.URL, "--parallel", "2", "--batch-size", "2", ut.dir})
+	err := rootCmd.Execute()
+	ut.ErrorContains(err, "1 of 3 attestations could not be stored")
+	ut.Equal(int32(2), requests.Load())
+	ut.Contains(output.String(), filepath.Join(ut.dir, "a.json")+" stored with gitoid gitoid-a.json")
+	ut.Contains(output.String(), "failed to store "+filepath.Join(ut.dir, "b.json")+": invalid dsse envelope")
+	ut.Contains(output.String(), filepath.Join(ut.dir, "nested", "c.json")+" stored with gitoid gitoid-nested/c.json")
+}
+
+func (ut *UTStoreSuite) Test_StoreBundles() {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ut.Equal("/v1/upload/batch", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(api.BatchUploadResponse{Results: []api.BatchUploadResult{
+			{Index: 0, Gitoid: "gitoid-a.json", Status: api.UploadStatusCreated},
+			{Index: 1, Envelopes: []api.UploadResponse{
+				{Gitoid: "gitoid-first", Status: api.UploadStatusCreated},
+				{Error: &api.ErrorResponse{Error: "internal", Message: "database is down"}},
+			}},
+		}})
+	}))
+	defer testServer.Close()
+	defer func() { archivistaUrl = "http://localhost:8082" }()
+
+	output := bytes.NewBufferString("")
+	rootCmd.SetOut(output)
+	rootCmd.SetErr(output)
+	a, b := filepath.Join(ut.dir, "a.json"), filepath.Join(ut.dir, "b.json")
+	rootCmd.SetArgs([]string{"store", "-u", testServer.URL, a, b})
+	err := rootCmd.Execute()
+	ut.ErrorContains(err, "1 of 2 attestations could not be stored")
+	ut.Contains(output.String(), a+" stored with gitoid gitoid-a.json")
+	ut.Contains(output.String(), b+" envelope 1 stored with gitoid gitoid-first")
+	ut.Contains(output.String(), "failed to store "+b+" envelope 2: database is down")
+}
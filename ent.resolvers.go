@@ -34,6 +34,26 @@ func (r *queryResolver) Dsses(ctx context.Context, after *entgql.Cursor[uuid.UUI
 	return r.client.Dsse.Query().Paginate(ctx, after, first, before, last, ent.WithDsseFilter(where.Filter))
 }
 
+// LegalHolds is the resolver for the legalHolds field.
+func (r *queryResolver) LegalHolds(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.LegalHoldOrder, where *ent.LegalHoldWhereInput) (*ent.LegalHoldConnection, error) {
+	return r.client.LegalHold.Query().Paginate(ctx, after, first, before, last, ent.WithLegalHoldOrder(orderBy), ent.WithLegalHoldFilter(where.Filter))
+}
+
+// Publications is the resolver for the publications field.
+func (r *queryResolver) Publications(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.PublicationOrder, where *ent.PublicationWhereInput) (*ent.PublicationConnection, error) {
+	return r.client.Publication.Query().Paginate(ctx, after, first, before, last, ent.WithPublicationOrder(orderBy), ent.WithPublicationFilter(where.Filter))
+}
+
+// PublishDeliveries is the resolver for the publishDeliveries field.
+func (r *queryResolver) PublishDeliveries(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.PublishDeliveryOrder, where *ent.PublishDeliveryWhereInput) (*ent.PublishDeliveryConnection, error) {
+	return r.client.PublishDelivery.Query().Paginate(ctx, after, first, before, last, ent.WithPublishDeliveryOrder(orderBy), ent.WithPublishDeliveryFilter(where.Filter))
+}
+
+// SbomPackages is the resolver for the sbomPackages field.
+func (r *queryResolver) SbomPackages(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, where *ent.SbomPackageWhereInput) (*ent.SbomPackageConnection, error) {
+	return r.client.SbomPackage.Query().Paginate(ctx, after, first, before, last, ent.WithSbomPackageFilter(where.Filter))
+}
+
 // Subjects is the resolver for the subjects field.
 func (r *queryResolver) Subjects(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.SubjectOrder, where *ent.SubjectWhereInput) (*ent.SubjectConnection, error) {
 	return r.client.Subject.Query().Paginate(ctx, after, first, before, last, ent.WithSubjectFilter(where.Filter))
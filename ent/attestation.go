@@ -11,6 +11,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/ociattestation"
 )
 
 // Attestation is the model entity for the Attestation schema.
@@ -18,6 +24,8 @@ type Attestation struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// Type holds the value of the "type" field.
 	Type string `json:"type,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -31,11 +39,30 @@ type Attestation struct {
 type AttestationEdges struct {
 	// AttestationCollection holds the value of the attestation_collection edge.
 	AttestationCollection *AttestationCollection `json:"attestation_collection,omitempty"`
+	// GitAttestation holds the value of the git_attestation edge.
+	GitAttestation *GitAttestation `json:"git_attestation,omitempty"`
+	// GithubAttestation holds the value of the github_attestation edge.
+	GithubAttestation *GithubAttestation `json:"github_attestation,omitempty"`
+	// GitlabAttestation holds the value of the gitlab_attestation edge.
+	GitlabAttestation *GitlabAttestation `json:"gitlab_attestation,omitempty"`
+	// EnvironmentAttestation holds the value of the environment_attestation edge.
+	EnvironmentAttestation *EnvironmentAttestation `json:"environment_attestation,omitempty"`
+	// CommandRunAttestation holds the value of the command_run_attestation edge.
+	CommandRunAttestation *CommandRunAttestation `json:"command_run_attestation,omitempty"`
+	// OciAttestation holds the value of the oci_attestation edge.
+	OciAttestation *OciAttestation `json:"oci_attestation,omitempty"`
+	// Materials holds the value of the materials edge.
+	Materials []*Material `json:"materials,omitempty"`
+	// Products holds the value of the products edge.
+	Products []*Product `json:"products,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [9]bool
 	// totalCount holds the count of the edges above.
-	totalCount [1]map[string]int
+	totalCount [9]map[string]int
+
+	namedMaterials map[string][]*Material
+	namedProducts  map[string][]*Product
 }
 
 // AttestationCollectionOrErr returns the AttestationCollection value or an error if the edge
@@ -49,12 +76,96 @@ func (e AttestationEdges) AttestationCollectionOrErr() (*AttestationCollection,
 	return nil, &NotLoadedError{edge: "attestation_collection"}
 }
 
+// GitAttestationOrErr returns the GitAttestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttestationEdges) GitAttestationOrErr() (*GitAttestation, error) {
+	if e.GitAttestation != nil {
+		return e.GitAttestation, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: gitattestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "git_attestation"}
+}
+
+// GithubAttestationOrErr returns the GithubAttestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttestationEdges) GithubAttestationOrErr() (*GithubAttestation, error) {
+	if e.GithubAttestation != nil {
+		return e.GithubAttestation, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: githubattestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "github_attestation"}
+}
+
+// GitlabAttestationOrErr returns the GitlabAttestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttestationEdges) GitlabAttestationOrErr() (*GitlabAttestation, error) {
+	if e.GitlabAttestation != nil {
+		return e.GitlabAttestation, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: gitlabattestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "gitlab_attestation"}
+}
+
+// EnvironmentAttestationOrErr returns the EnvironmentAttestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttestationEdges) EnvironmentAttestationOrErr() (*EnvironmentAttestation, error) {
+	if e.EnvironmentAttestation != nil {
+		return e.EnvironmentAttestation, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: environmentattestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "environment_attestation"}
+}
+
+// CommandRunAttestationOrErr returns the CommandRunAttestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttestationEdges) CommandRunAttestationOrErr() (*CommandRunAttestation, error) {
+	if e.CommandRunAttestation != nil {
+		return e.CommandRunAttestation, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: commandrunattestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "command_run_attestation"}
+}
+
+// OciAttestationOrErr returns the OciAttestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttestationEdges) OciAttestationOrErr() (*OciAttestation, error) {
+	if e.OciAttestation != nil {
+		return e.OciAttestation, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: ociattestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "oci_attestation"}
+}
+
+// MaterialsOrErr returns the Materials value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttestationEdges) MaterialsOrErr() ([]*Material, error) {
+	if e.loadedTypes[7] {
+		return e.Materials, nil
+	}
+	return nil, &NotLoadedError{edge: "materials"}
+}
+
+// ProductsOrErr returns the Products value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttestationEdges) ProductsOrErr() ([]*Product, error) {
+	if e.loadedTypes[8] {
+		return e.Products, nil
+	}
+	return nil, &NotLoadedError{edge: "products"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Attestation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case attestation.FieldType:
+		case attestation.FieldTenant, attestation.FieldType:
 			values[i] = new(sql.NullString)
 		case attestation.FieldID:
 			values[i] = new(uuid.UUID)
@@ -81,6 +192,12 @@ func (_m *Attestation) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case attestation.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
 		case attestation.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -112,6 +229,46 @@ func (_m *Attestation) QueryAttestationCollection() *AttestationCollectionQuery
 	return NewAttestationClient(_m.config).QueryAttestationCollection(_m)
 }
 
+// QueryGitAttestation queries the "git_attestation" edge of the Attestation entity.
+func (_m *Attestation) QueryGitAttestation() *GitAttestationQuery {
+	return NewAttestationClient(_m.config).QueryGitAttestation(_m)
+}
+
+// QueryGithubAttestation queries the "github_attestation" edge of the Attestation entity.
+func (_m *Attestation) QueryGithubAttestation() *GithubAttestationQuery {
+	return NewAttestationClient(_m.config).QueryGithubAttestation(_m)
+}
+
+// QueryGitlabAttestation queries the "gitlab_attestation" edge of the Attestation entity.
+func (_m *Attestation) QueryGitlabAttestation() *GitlabAttestationQuery {
+	return NewAttestationClient(_m.config).QueryGitlabAttestation(_m)
+}
+
+// QueryEnvironmentAttestation queries the "environment_attestation" edge of the Attestation entity.
+func (_m *Attestation) QueryEnvironmentAttestation() *EnvironmentAttestationQuery {
+	return NewAttestationClient(_m.config).QueryEnvironmentAttestation(_m)
+}
+
+// QueryCommandRunAttestation queries the "command_run_attestation" edge of the Attestation entity.
+func (_m *Attestation) QueryCommandRunAttestation() *CommandRunAttestationQuery {
+	return NewAttestationClient(_m.config).QueryCommandRunAttestation(_m)
+}
+
+// QueryOciAttestation queries the "oci_attestation" edge of the Attestation entity.
+func (_m *Attestation) QueryOciAttestation() *OciAttestationQuery {
+	return NewAttestationClient(_m.config).QueryOciAttestation(_m)
+}
+
+// QueryMaterials queries the "materials" edge of the Attestation entity.
+func (_m *Attestation) QueryMaterials() *MaterialQuery {
+	return NewAttestationClient(_m.config).QueryMaterials(_m)
+}
+
+// QueryProducts queries the "products" edge of the Attestation entity.
+func (_m *Attestation) QueryProducts() *ProductQuery {
+	return NewAttestationClient(_m.config).QueryProducts(_m)
+}
+
 // Update returns a builder for updating this Attestation.
 // Note that you need to call Attestation.Unwrap() before calling this method if this Attestation
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -135,11 +292,62 @@ func (_m *Attestation) String() string {
 	var builder strings.Builder
 	builder.WriteString("Attestation(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(_m.Type)
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedMaterials returns the Materials named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Attestation) NamedMaterials(name string) ([]*Material, error) {
+	if _m.Edges.namedMaterials == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedMaterials[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Attestation) appendNamedMaterials(name string, edges ...*Material) {
+	if _m.Edges.namedMaterials == nil {
+		_m.Edges.namedMaterials = make(map[string][]*Material)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedMaterials[name] = []*Material{}
+	} else {
+		_m.Edges.namedMaterials[name] = append(_m.Edges.namedMaterials[name], edges...)
+	}
+}
+
+// NamedProducts returns the Products named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Attestation) NamedProducts(name string) ([]*Product, error) {
+	if _m.Edges.namedProducts == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedProducts[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Attestation) appendNamedProducts(name string, edges ...*Product) {
+	if _m.Edges.namedProducts == nil {
+		_m.Edges.namedProducts = make(map[string][]*Product)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedProducts[name] = []*Product{}
+	} else {
+		_m.Edges.namedProducts[name] = append(_m.Edges.namedProducts[name], edges...)
+	}
+}
+
 // Attestations is a parsable slice of Attestation.
 type Attestations []*Attestation
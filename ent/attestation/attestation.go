@@ -3,6 +3,7 @@
 package attestation
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -13,10 +14,28 @@ const (
 	Label = "attestation"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// EdgeAttestationCollection holds the string denoting the attestation_collection edge name in mutations.
 	EdgeAttestationCollection = "attestation_collection"
+	// EdgeGitAttestation holds the string denoting the git_attestation edge name in mutations.
+	EdgeGitAttestation = "git_attestation"
+	// EdgeGithubAttestation holds the string denoting the github_attestation edge name in mutations.
+	EdgeGithubAttestation = "github_attestation"
+	// EdgeGitlabAttestation holds the string denoting the gitlab_attestation edge name in mutations.
+	EdgeGitlabAttestation = "gitlab_attestation"
+	// EdgeEnvironmentAttestation holds the string denoting the environment_attestation edge name in mutations.
+	EdgeEnvironmentAttestation = "environment_attestation"
+	// EdgeCommandRunAttestation holds the string denoting the command_run_attestation edge name in mutations.
+	EdgeCommandRunAttestation = "command_run_attestation"
+	// EdgeOciAttestation holds the string denoting the oci_attestation edge name in mutations.
+	EdgeOciAttestation = "oci_attestation"
+	// EdgeMaterials holds the string denoting the materials edge name in mutations.
+	EdgeMaterials = "materials"
+	// EdgeProducts holds the string denoting the products edge name in mutations.
+	EdgeProducts = "products"
 	// Table holds the table name of the attestation in the database.
 	Table = "attestations"
 	// AttestationCollectionTable is the table that holds the attestation_collection relation/edge.
@@ -26,11 +45,68 @@ const (
 	AttestationCollectionInverseTable = "attestation_collections"
 	// AttestationCollectionColumn is the table column denoting the attestation_collection relation/edge.
 	AttestationCollectionColumn = "attestation_collection_attestations"
+	// GitAttestationTable is the table that holds the git_attestation relation/edge.
+	GitAttestationTable = "git_attestations"
+	// GitAttestationInverseTable is the table name for the GitAttestation entity.
+	// It exists in this package in order to avoid circular dependency with the "gitattestation" package.
+	GitAttestationInverseTable = "git_attestations"
+	// GitAttestationColumn is the table column denoting the git_attestation relation/edge.
+	GitAttestationColumn = "attestation_git_attestation"
+	// GithubAttestationTable is the table that holds the github_attestation relation/edge.
+	GithubAttestationTable = "github_attestations"
+	// GithubAttestationInverseTable is the table name for the GithubAttestation entity.
+	// It exists in this package in order to avoid circular dependency with the "githubattestation" package.
+	GithubAttestationInverseTable = "github_attestations"
+	// GithubAttestationColumn is the table column denoting the github_attestation relation/edge.
+	GithubAttestationColumn = "attestation_github_attestation"
+	// GitlabAttestationTable is the table that holds the gitlab_attestation relation/edge.
+	GitlabAttestationTable = "gitlab_attestations"
+	// GitlabAttestationInverseTable is the table name for the GitlabAttestation entity.
+	// It exists in this package in order to avoid circular dependency with the "gitlabattestation" package.
+	GitlabAttestationInverseTable = "gitlab_attestations"
+	// GitlabAttestationColumn is the table column denoting the gitlab_attestation relation/edge.
+	GitlabAttestationColumn = "attestation_gitlab_attestation"
+	// EnvironmentAttestationTable is the table that holds the environment_attestation relation/edge.
+	EnvironmentAttestationTable = "environment_attestations"
+	// EnvironmentAttestationInverseTable is the table name for the EnvironmentAttestation entity.
+	// It exists in this package in order to avoid circular dependency with the "environmentattestation" package.
+	EnvironmentAttestationInverseTable = "environment_attestations"
+	// EnvironmentAttestationColumn is the table column denoting the environment_attestation relation/edge.
+	EnvironmentAttestationColumn = "attestation_environment_attestation"
+	// CommandRunAttestationTable is the table that holds the command_run_attestation relation/edge.
+	CommandRunAttestationTable = "command_run_attestations"
+	// CommandRunAttestationInverseTable is the table name for the CommandRunAttestation entity.
+	// It exists in this package in order to avoid circular dependency with the "commandrunattestation" package.
+	CommandRunAttestationInverseTable = "command_run_attestations"
+	// CommandRunAttestationColumn is the table column denoting the command_run_attestation relation/edge.
+	CommandRunAttestationColumn = "attestation_command_run_attestation"
+	// OciAttestationTable is the table that holds the oci_attestation relation/edge.
+	OciAttestationTable = "oci_attestations"
+	// OciAttestationInverseTable is the table name for the OciAttestation entity.
+	// It exists in this package in order to avoid circular dependency with the "ociattestation" package.
+	OciAttestationInverseTable = "oci_attestations"
+	// OciAttestationColumn is the table column denoting the oci_attestation relation/edge.
+	OciAttestationColumn = "attestation_oci_attestation"
+	// MaterialsTable is the table that holds the materials relation/edge.
+	MaterialsTable = "materials"
+	// MaterialsInverseTable is the table name for the Material entity.
+	// It exists in this package in order to avoid circular dependency with the "material" package.
+	MaterialsInverseTable = "materials"
+	// MaterialsColumn is the table column denoting the materials relation/edge.
+	MaterialsColumn = "attestation_materials"
+	// ProductsTable is the table that holds the products relation/edge.
+	ProductsTable = "products"
+	// ProductsInverseTable is the table name for the Product entity.
+	// It exists in this package in order to avoid circular dependency with the "product" package.
+	ProductsInverseTable = "products"
+	// ProductsColumn is the table column denoting the products relation/edge.
+	ProductsColumn = "attestation_products"
 )
 
 // Columns holds all SQL columns for attestation fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldType,
 }
 
@@ -55,7 +131,18 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
 	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	TypeValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
@@ -70,6 +157,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -81,6 +173,76 @@ func ByAttestationCollectionField(field string, opts ...sql.OrderTermOption) Ord
 		sqlgraph.OrderByNeighborTerms(s, newAttestationCollectionStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByGitAttestationField orders the results by git_attestation field.
+func ByGitAttestationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGitAttestationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGithubAttestationField orders the results by github_attestation field.
+func ByGithubAttestationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGithubAttestationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGitlabAttestationField orders the results by gitlab_attestation field.
+func ByGitlabAttestationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGitlabAttestationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEnvironmentAttestationField orders the results by environment_attestation field.
+func ByEnvironmentAttestationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnvironmentAttestationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCommandRunAttestationField orders the results by command_run_attestation field.
+func ByCommandRunAttestationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommandRunAttestationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOciAttestationField orders the results by oci_attestation field.
+func ByOciAttestationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOciAttestationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMaterialsCount orders the results by materials count.
+func ByMaterialsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMaterialsStep(), opts...)
+	}
+}
+
+// ByMaterials orders the results by materials terms.
+func ByMaterials(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMaterialsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProductsCount orders the results by products count.
+func ByProductsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProductsStep(), opts...)
+	}
+}
+
+// ByProducts orders the results by products terms.
+func ByProducts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProductsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAttestationCollectionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -88,3 +250,59 @@ func newAttestationCollectionStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, AttestationCollectionTable, AttestationCollectionColumn),
 	)
 }
+func newGitAttestationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GitAttestationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, GitAttestationTable, GitAttestationColumn),
+	)
+}
+func newGithubAttestationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GithubAttestationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, GithubAttestationTable, GithubAttestationColumn),
+	)
+}
+func newGitlabAttestationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GitlabAttestationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, GitlabAttestationTable, GitlabAttestationColumn),
+	)
+}
+func newEnvironmentAttestationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnvironmentAttestationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, EnvironmentAttestationTable, EnvironmentAttestationColumn),
+	)
+}
+func newCommandRunAttestationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommandRunAttestationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, CommandRunAttestationTable, CommandRunAttestationColumn),
+	)
+}
+func newOciAttestationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OciAttestationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, OciAttestationTable, OciAttestationColumn),
+	)
+}
+func newMaterialsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MaterialsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MaterialsTable, MaterialsColumn),
+	)
+}
+func newProductsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProductsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProductsTable, ProductsColumn),
+	)
+}
@@ -54,11 +54,81 @@ func IDLTE(id uuid.UUID) predicate.Attestation {
 	return predicate.Attestation(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldEQ(FieldTenant, v))
+}
+
 // Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
 func Type(v string) predicate.Attestation {
 	return predicate.Attestation(sql.FieldEQ(FieldType, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Attestation {
+	return predicate.Attestation(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v string) predicate.Attestation {
 	return predicate.Attestation(sql.FieldEQ(FieldType, v))
@@ -147,6 +217,190 @@ func HasAttestationCollectionWith(preds ...predicate.AttestationCollection) pred
 	})
 }
 
+// HasGitAttestation applies the HasEdge predicate on the "git_attestation" edge.
+func HasGitAttestation() predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, GitAttestationTable, GitAttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGitAttestationWith applies the HasEdge predicate on the "git_attestation" edge with a given conditions (other predicates).
+func HasGitAttestationWith(preds ...predicate.GitAttestation) predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := newGitAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGithubAttestation applies the HasEdge predicate on the "github_attestation" edge.
+func HasGithubAttestation() predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, GithubAttestationTable, GithubAttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGithubAttestationWith applies the HasEdge predicate on the "github_attestation" edge with a given conditions (other predicates).
+func HasGithubAttestationWith(preds ...predicate.GithubAttestation) predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := newGithubAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGitlabAttestation applies the HasEdge predicate on the "gitlab_attestation" edge.
+func HasGitlabAttestation() predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, GitlabAttestationTable, GitlabAttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGitlabAttestationWith applies the HasEdge predicate on the "gitlab_attestation" edge with a given conditions (other predicates).
+func HasGitlabAttestationWith(preds ...predicate.GitlabAttestation) predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := newGitlabAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnvironmentAttestation applies the HasEdge predicate on the "environment_attestation" edge.
+func HasEnvironmentAttestation() predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, EnvironmentAttestationTable, EnvironmentAttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvironmentAttestationWith applies the HasEdge predicate on the "environment_attestation" edge with a given conditions (other predicates).
+func HasEnvironmentAttestationWith(preds ...predicate.EnvironmentAttestation) predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := newEnvironmentAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCommandRunAttestation applies the HasEdge predicate on the "command_run_attestation" edge.
+func HasCommandRunAttestation() predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, CommandRunAttestationTable, CommandRunAttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommandRunAttestationWith applies the HasEdge predicate on the "command_run_attestation" edge with a given conditions (other predicates).
+func HasCommandRunAttestationWith(preds ...predicate.CommandRunAttestation) predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := newCommandRunAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOciAttestation applies the HasEdge predicate on the "oci_attestation" edge.
+func HasOciAttestation() predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, OciAttestationTable, OciAttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOciAttestationWith applies the HasEdge predicate on the "oci_attestation" edge with a given conditions (other predicates).
+func HasOciAttestationWith(preds ...predicate.OciAttestation) predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := newOciAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMaterials applies the HasEdge predicate on the "materials" edge.
+func HasMaterials() predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MaterialsTable, MaterialsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMaterialsWith applies the HasEdge predicate on the "materials" edge with a given conditions (other predicates).
+func HasMaterialsWith(preds ...predicate.Material) predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := newMaterialsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProducts applies the HasEdge predicate on the "products" edge.
+func HasProducts() predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProductsTable, ProductsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductsWith applies the HasEdge predicate on the "products" edge with a given conditions (other predicates).
+func HasProductsWith(preds ...predicate.Product) predicate.Attestation {
+	return predicate.Attestation(func(s *sql.Selector) {
+		step := newProductsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Attestation) predicate.Attestation {
 	return predicate.Attestation(sql.AndPredicates(predicates...))
@@ -12,6 +12,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
+	"github.com/in-toto/archivista/ent/product"
 )
 
 // AttestationCreate is the builder for creating a Attestation entity.
@@ -21,6 +29,20 @@ type AttestationCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *AttestationCreate) SetTenant(v string) *AttestationCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *AttestationCreate) SetNillableTenant(v *string) *AttestationCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetType sets the "type" field.
 func (_c *AttestationCreate) SetType(v string) *AttestationCreate {
 	_c.mutation.SetType(v)
@@ -52,6 +74,150 @@ func (_c *AttestationCreate) SetAttestationCollection(v *AttestationCollection)
 	return _c.SetAttestationCollectionID(v.ID)
 }
 
+// SetGitAttestationID sets the "git_attestation" edge to the GitAttestation entity by ID.
+func (_c *AttestationCreate) SetGitAttestationID(id uuid.UUID) *AttestationCreate {
+	_c.mutation.SetGitAttestationID(id)
+	return _c
+}
+
+// SetNillableGitAttestationID sets the "git_attestation" edge to the GitAttestation entity by ID if the given value is not nil.
+func (_c *AttestationCreate) SetNillableGitAttestationID(id *uuid.UUID) *AttestationCreate {
+	if id != nil {
+		_c = _c.SetGitAttestationID(*id)
+	}
+	return _c
+}
+
+// SetGitAttestation sets the "git_attestation" edge to the GitAttestation entity.
+func (_c *AttestationCreate) SetGitAttestation(v *GitAttestation) *AttestationCreate {
+	return _c.SetGitAttestationID(v.ID)
+}
+
+// SetGithubAttestationID sets the "github_attestation" edge to the GithubAttestation entity by ID.
+func (_c *AttestationCreate) SetGithubAttestationID(id uuid.UUID) *AttestationCreate {
+	_c.mutation.SetGithubAttestationID(id)
+	return _c
+}
+
+// SetNillableGithubAttestationID sets the "github_attestation" edge to the GithubAttestation entity by ID if the given value is not nil.
+func (_c *AttestationCreate) SetNillableGithubAttestationID(id *uuid.UUID) *AttestationCreate {
+	if id != nil {
+		_c = _c.SetGithubAttestationID(*id)
+	}
+	return _c
+}
+
+// SetGithubAttestation sets the "github_attestation" edge to the GithubAttestation entity.
+func (_c *AttestationCreate) SetGithubAttestation(v *GithubAttestation) *AttestationCreate {
+	return _c.SetGithubAttestationID(v.ID)
+}
+
+// SetGitlabAttestationID sets the "gitlab_attestation" edge to the GitlabAttestation entity by ID.
+func (_c *AttestationCreate) SetGitlabAttestationID(id uuid.UUID) *AttestationCreate {
+	_c.mutation.SetGitlabAttestationID(id)
+	return _c
+}
+
+// SetNillableGitlabAttestationID sets the "gitlab_attestation" edge to the GitlabAttestation entity by ID if the given value is not nil.
+func (_c *AttestationCreate) SetNillableGitlabAttestationID(id *uuid.UUID) *AttestationCreate {
+	if id != nil {
+		_c = _c.SetGitlabAttestationID(*id)
+	}
+	return _c
+}
+
+// SetGitlabAttestation sets the "gitlab_attestation" edge to the GitlabAttestation entity.
+func (_c *AttestationCreate) SetGitlabAttestation(v *GitlabAttestation) *AttestationCreate {
+	return _c.SetGitlabAttestationID(v.ID)
+}
+
+// SetEnvironmentAttestationID sets the "environment_attestation" edge to the EnvironmentAttestation entity by ID.
+func (_c *AttestationCreate) SetEnvironmentAttestationID(id uuid.UUID) *AttestationCreate {
+	_c.mutation.SetEnvironmentAttestationID(id)
+	return _c
+}
+
+// SetNillableEnvironmentAttestationID sets the "environment_attestation" edge to the EnvironmentAttestation entity by ID if the given value is not nil.
+func (_c *AttestationCreate) SetNillableEnvironmentAttestationID(id *uuid.UUID) *AttestationCreate {
+	if id != nil {
+		_c = _c.SetEnvironmentAttestationID(*id)
+	}
+	return _c
+}
+
+// SetEnvironmentAttestation sets the "environment_attestation" edge to the EnvironmentAttestation entity.
+func (_c *AttestationCreate) SetEnvironmentAttestation(v *EnvironmentAttestation) *AttestationCreate {
+	return _c.SetEnvironmentAttestationID(v.ID)
+}
+
+// SetCommandRunAttestationID sets the "command_run_attestation" edge to the CommandRunAttestation entity by ID.
+func (_c *AttestationCreate) SetCommandRunAttestationID(id uuid.UUID) *AttestationCreate {
+	_c.mutation.SetCommandRunAttestationID(id)
+	return _c
+}
+
+// SetNillableCommandRunAttestationID sets the "command_run_attestation" edge to the CommandRunAttestation entity by ID if the given value is not nil.
+func (_c *AttestationCreate) SetNillableCommandRunAttestationID(id *uuid.UUID) *AttestationCreate {
+	if id != nil {
+		_c = _c.SetCommandRunAttestationID(*id)
+	}
+	return _c
+}
+
+// SetCommandRunAttestation sets the "command_run_attestation" edge to the CommandRunAttestation entity.
+func (_c *AttestationCreate) SetCommandRunAttestation(v *CommandRunAttestation) *AttestationCreate {
+	return _c.SetCommandRunAttestationID(v.ID)
+}
+
+// SetOciAttestationID sets the "oci_attestation" edge to the OciAttestation entity by ID.
+func (_c *AttestationCreate) SetOciAttestationID(id uuid.UUID) *AttestationCreate {
+	_c.mutation.SetOciAttestationID(id)
+	return _c
+}
+
+// SetNillableOciAttestationID sets the "oci_attestation" edge to the OciAttestation entity by ID if the given value is not nil.
+func (_c *AttestationCreate) SetNillableOciAttestationID(id *uuid.UUID) *AttestationCreate {
+	if id != nil {
+		_c = _c.SetOciAttestationID(*id)
+	}
+	return _c
+}
+
+// SetOciAttestation sets the "oci_attestation" edge to the OciAttestation entity.
+func (_c *AttestationCreate) SetOciAttestation(v *OciAttestation) *AttestationCreate {
+	return _c.SetOciAttestationID(v.ID)
+}
+
+// AddMaterialIDs adds the "materials" edge to the Material entity by IDs.
+func (_c *AttestationCreate) AddMaterialIDs(ids ...uuid.UUID) *AttestationCreate {
+	_c.mutation.AddMaterialIDs(ids...)
+	return _c
+}
+
+// AddMaterials adds the "materials" edges to the Material entity.
+func (_c *AttestationCreate) AddMaterials(v ...*Material) *AttestationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMaterialIDs(ids...)
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (_c *AttestationCreate) AddProductIDs(ids ...uuid.UUID) *AttestationCreate {
+	_c.mutation.AddProductIDs(ids...)
+	return _c
+}
+
+// AddProducts adds the "products" edges to the Product entity.
+func (_c *AttestationCreate) AddProducts(v ...*Product) *AttestationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddProductIDs(ids...)
+}
+
 // Mutation returns the AttestationMutation object of the builder.
 func (_c *AttestationCreate) Mutation() *AttestationMutation {
 	return _c.mutation
@@ -59,7 +225,9 @@ func (_c *AttestationCreate) Mutation() *AttestationMutation {
 
 // Save creates the Attestation in the database.
 func (_c *AttestationCreate) Save(ctx context.Context) (*Attestation, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -86,15 +254,31 @@ func (_c *AttestationCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *AttestationCreate) defaults() {
+func (_c *AttestationCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := attestation.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if attestation.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized attestation.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := attestation.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *AttestationCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Attestation.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := attestation.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "Attestation.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Attestation.type"`)}
 	}
@@ -141,6 +325,10 @@ func (_c *AttestationCreate) createSpec() (*Attestation, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(attestation.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.GetType(); ok {
 		_spec.SetField(attestation.FieldType, field.TypeString, value)
 		_node.Type = value
@@ -162,6 +350,134 @@ func (_c *AttestationCreate) createSpec() (*Attestation, *sqlgraph.CreateSpec) {
 		_node.attestation_collection_attestations = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.GitAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitAttestationTable,
+			Columns: []string{attestation.GitAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GithubAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GithubAttestationTable,
+			Columns: []string{attestation.GithubAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(githubattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GitlabAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitlabAttestationTable,
+			Columns: []string{attestation.GitlabAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EnvironmentAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.EnvironmentAttestationTable,
+			Columns: []string{attestation.EnvironmentAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CommandRunAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.CommandRunAttestationTable,
+			Columns: []string{attestation.CommandRunAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commandrunattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OciAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.OciAttestationTable,
+			Columns: []string{attestation.OciAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ociattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MaterialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.MaterialsTable,
+			Columns: []string{attestation.MaterialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(material.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProductsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.ProductsTable,
+			Columns: []string{attestation.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
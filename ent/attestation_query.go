@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -14,20 +15,38 @@ import (
 	"github.com/google/uuid"
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
 	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/product"
 )
 
 // AttestationQuery is the builder for querying Attestation entities.
 type AttestationQuery struct {
 	config
-	ctx                       *QueryContext
-	order                     []attestation.OrderOption
-	inters                    []Interceptor
-	predicates                []predicate.Attestation
-	withAttestationCollection *AttestationCollectionQuery
-	withFKs                   bool
-	modifiers                 []func(*sql.Selector)
-	loadTotal                 []func(context.Context, []*Attestation) error
+	ctx                        *QueryContext
+	order                      []attestation.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.Attestation
+	withAttestationCollection  *AttestationCollectionQuery
+	withGitAttestation         *GitAttestationQuery
+	withGithubAttestation      *GithubAttestationQuery
+	withGitlabAttestation      *GitlabAttestationQuery
+	withEnvironmentAttestation *EnvironmentAttestationQuery
+	withCommandRunAttestation  *CommandRunAttestationQuery
+	withOciAttestation         *OciAttestationQuery
+	withMaterials              *MaterialQuery
+	withProducts               *ProductQuery
+	withFKs                    bool
+	modifiers                  []func(*sql.Selector)
+	loadTotal                  []func(context.Context, []*Attestation) error
+	withNamedMaterials         map[string]*MaterialQuery
+	withNamedProducts          map[string]*ProductQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +105,182 @@ func (_q *AttestationQuery) QueryAttestationCollection() *AttestationCollectionQ
 	return query
 }
 
+// QueryGitAttestation chains the current query on the "git_attestation" edge.
+func (_q *AttestationQuery) QueryGitAttestation() *GitAttestationQuery {
+	query := (&GitAttestationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, selector),
+			sqlgraph.To(gitattestation.Table, gitattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.GitAttestationTable, attestation.GitAttestationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGithubAttestation chains the current query on the "github_attestation" edge.
+func (_q *AttestationQuery) QueryGithubAttestation() *GithubAttestationQuery {
+	query := (&GithubAttestationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, selector),
+			sqlgraph.To(githubattestation.Table, githubattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.GithubAttestationTable, attestation.GithubAttestationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGitlabAttestation chains the current query on the "gitlab_attestation" edge.
+func (_q *AttestationQuery) QueryGitlabAttestation() *GitlabAttestationQuery {
+	query := (&GitlabAttestationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, selector),
+			sqlgraph.To(gitlabattestation.Table, gitlabattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.GitlabAttestationTable, attestation.GitlabAttestationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnvironmentAttestation chains the current query on the "environment_attestation" edge.
+func (_q *AttestationQuery) QueryEnvironmentAttestation() *EnvironmentAttestationQuery {
+	query := (&EnvironmentAttestationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, selector),
+			sqlgraph.To(environmentattestation.Table, environmentattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.EnvironmentAttestationTable, attestation.EnvironmentAttestationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCommandRunAttestation chains the current query on the "command_run_attestation" edge.
+func (_q *AttestationQuery) QueryCommandRunAttestation() *CommandRunAttestationQuery {
+	query := (&CommandRunAttestationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, selector),
+			sqlgraph.To(commandrunattestation.Table, commandrunattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.CommandRunAttestationTable, attestation.CommandRunAttestationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOciAttestation chains the current query on the "oci_attestation" edge.
+func (_q *AttestationQuery) QueryOciAttestation() *OciAttestationQuery {
+	query := (&OciAttestationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, selector),
+			sqlgraph.To(ociattestation.Table, ociattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.OciAttestationTable, attestation.OciAttestationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMaterials chains the current query on the "materials" edge.
+func (_q *AttestationQuery) QueryMaterials() *MaterialQuery {
+	query := (&MaterialClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, selector),
+			sqlgraph.To(material.Table, material.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attestation.MaterialsTable, attestation.MaterialsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProducts chains the current query on the "products" edge.
+func (_q *AttestationQuery) QueryProducts() *ProductQuery {
+	query := (&ProductClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attestation.ProductsTable, attestation.ProductsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Attestation entity from the query.
 // Returns a *NotFoundError when no Attestation was found.
 func (_q *AttestationQuery) First(ctx context.Context) (*Attestation, error) {
@@ -273,12 +468,20 @@ func (_q *AttestationQuery) Clone() *AttestationQuery {
 		return nil
 	}
 	return &AttestationQuery{
-		config:                    _q.config,
-		ctx:                       _q.ctx.Clone(),
-		order:                     append([]attestation.OrderOption{}, _q.order...),
-		inters:                    append([]Interceptor{}, _q.inters...),
-		predicates:                append([]predicate.Attestation{}, _q.predicates...),
-		withAttestationCollection: _q.withAttestationCollection.Clone(),
+		config:                     _q.config,
+		ctx:                        _q.ctx.Clone(),
+		order:                      append([]attestation.OrderOption{}, _q.order...),
+		inters:                     append([]Interceptor{}, _q.inters...),
+		predicates:                 append([]predicate.Attestation{}, _q.predicates...),
+		withAttestationCollection:  _q.withAttestationCollection.Clone(),
+		withGitAttestation:         _q.withGitAttestation.Clone(),
+		withGithubAttestation:      _q.withGithubAttestation.Clone(),
+		withGitlabAttestation:      _q.withGitlabAttestation.Clone(),
+		withEnvironmentAttestation: _q.withEnvironmentAttestation.Clone(),
+		withCommandRunAttestation:  _q.withCommandRunAttestation.Clone(),
+		withOciAttestation:         _q.withOciAttestation.Clone(),
+		withMaterials:              _q.withMaterials.Clone(),
+		withProducts:               _q.withProducts.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -296,18 +499,106 @@ func (_q *AttestationQuery) WithAttestationCollection(opts ...func(*AttestationC
 	return _q
 }
 
+// WithGitAttestation tells the query-builder to eager-load the nodes that are connected to
+// the "git_attestation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithGitAttestation(opts ...func(*GitAttestationQuery)) *AttestationQuery {
+	query := (&GitAttestationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGitAttestation = query
+	return _q
+}
+
+// WithGithubAttestation tells the query-builder to eager-load the nodes that are connected to
+// the "github_attestation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithGithubAttestation(opts ...func(*GithubAttestationQuery)) *AttestationQuery {
+	query := (&GithubAttestationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGithubAttestation = query
+	return _q
+}
+
+// WithGitlabAttestation tells the query-builder to eager-load the nodes that are connected to
+// the "gitlab_attestation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithGitlabAttestation(opts ...func(*GitlabAttestationQuery)) *AttestationQuery {
+	query := (&GitlabAttestationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGitlabAttestation = query
+	return _q
+}
+
+// WithEnvironmentAttestation tells the query-builder to eager-load the nodes that are connected to
+// the "environment_attestation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithEnvironmentAttestation(opts ...func(*EnvironmentAttestationQuery)) *AttestationQuery {
+	query := (&EnvironmentAttestationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEnvironmentAttestation = query
+	return _q
+}
+
+// WithCommandRunAttestation tells the query-builder to eager-load the nodes that are connected to
+// the "command_run_attestation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithCommandRunAttestation(opts ...func(*CommandRunAttestationQuery)) *AttestationQuery {
+	query := (&CommandRunAttestationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCommandRunAttestation = query
+	return _q
+}
+
+// WithOciAttestation tells the query-builder to eager-load the nodes that are connected to
+// the "oci_attestation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithOciAttestation(opts ...func(*OciAttestationQuery)) *AttestationQuery {
+	query := (&OciAttestationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOciAttestation = query
+	return _q
+}
+
+// WithMaterials tells the query-builder to eager-load the nodes that are connected to
+// the "materials" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithMaterials(opts ...func(*MaterialQuery)) *AttestationQuery {
+	query := (&MaterialClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMaterials = query
+	return _q
+}
+
+// WithProducts tells the query-builder to eager-load the nodes that are connected to
+// the "products" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithProducts(opts ...func(*ProductQuery)) *AttestationQuery {
+	query := (&ProductClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withProducts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Type string `json:"type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Attestation.Query().
-//		GroupBy(attestation.FieldType).
+//		GroupBy(attestation.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *AttestationQuery) GroupBy(field string, fields ...string) *AttestationGroupBy {
@@ -325,11 +616,11 @@ func (_q *AttestationQuery) GroupBy(field string, fields ...string) *Attestation
 // Example:
 //
 //	var v []struct {
-//		Type string `json:"type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Attestation.Query().
-//		Select(attestation.FieldType).
+//		Select(attestation.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *AttestationQuery) Select(fields ...string) *AttestationSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -375,8 +666,16 @@ func (_q *AttestationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		nodes       = []*Attestation{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [9]bool{
 			_q.withAttestationCollection != nil,
+			_q.withGitAttestation != nil,
+			_q.withGithubAttestation != nil,
+			_q.withGitlabAttestation != nil,
+			_q.withEnvironmentAttestation != nil,
+			_q.withCommandRunAttestation != nil,
+			_q.withOciAttestation != nil,
+			_q.withMaterials != nil,
+			_q.withProducts != nil,
 		}
 	)
 	if _q.withAttestationCollection != nil {
@@ -412,6 +711,70 @@ func (_q *AttestationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withGitAttestation; query != nil {
+		if err := _q.loadGitAttestation(ctx, query, nodes, nil,
+			func(n *Attestation, e *GitAttestation) { n.Edges.GitAttestation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGithubAttestation; query != nil {
+		if err := _q.loadGithubAttestation(ctx, query, nodes, nil,
+			func(n *Attestation, e *GithubAttestation) { n.Edges.GithubAttestation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGitlabAttestation; query != nil {
+		if err := _q.loadGitlabAttestation(ctx, query, nodes, nil,
+			func(n *Attestation, e *GitlabAttestation) { n.Edges.GitlabAttestation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEnvironmentAttestation; query != nil {
+		if err := _q.loadEnvironmentAttestation(ctx, query, nodes, nil,
+			func(n *Attestation, e *EnvironmentAttestation) { n.Edges.EnvironmentAttestation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCommandRunAttestation; query != nil {
+		if err := _q.loadCommandRunAttestation(ctx, query, nodes, nil,
+			func(n *Attestation, e *CommandRunAttestation) { n.Edges.CommandRunAttestation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOciAttestation; query != nil {
+		if err := _q.loadOciAttestation(ctx, query, nodes, nil,
+			func(n *Attestation, e *OciAttestation) { n.Edges.OciAttestation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMaterials; query != nil {
+		if err := _q.loadMaterials(ctx, query, nodes,
+			func(n *Attestation) { n.Edges.Materials = []*Material{} },
+			func(n *Attestation, e *Material) { n.Edges.Materials = append(n.Edges.Materials, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withProducts; query != nil {
+		if err := _q.loadProducts(ctx, query, nodes,
+			func(n *Attestation) { n.Edges.Products = []*Product{} },
+			func(n *Attestation, e *Product) { n.Edges.Products = append(n.Edges.Products, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedMaterials {
+		if err := _q.loadMaterials(ctx, query, nodes,
+			func(n *Attestation) { n.appendNamedMaterials(name) },
+			func(n *Attestation, e *Material) { n.appendNamedMaterials(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedProducts {
+		if err := _q.loadProducts(ctx, query, nodes,
+			func(n *Attestation) { n.appendNamedProducts(name) },
+			func(n *Attestation, e *Product) { n.appendNamedProducts(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range _q.loadTotal {
 		if err := _q.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -452,6 +815,236 @@ func (_q *AttestationQuery) loadAttestationCollection(ctx context.Context, query
 	}
 	return nil
 }
+func (_q *AttestationQuery) loadGitAttestation(ctx context.Context, query *GitAttestationQuery, nodes []*Attestation, init func(*Attestation), assign func(*Attestation, *GitAttestation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Attestation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.GitAttestation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attestation.GitAttestationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.attestation_git_attestation
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attestation_git_attestation" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attestation_git_attestation" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AttestationQuery) loadGithubAttestation(ctx context.Context, query *GithubAttestationQuery, nodes []*Attestation, init func(*Attestation), assign func(*Attestation, *GithubAttestation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Attestation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.GithubAttestation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attestation.GithubAttestationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.attestation_github_attestation
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attestation_github_attestation" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attestation_github_attestation" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AttestationQuery) loadGitlabAttestation(ctx context.Context, query *GitlabAttestationQuery, nodes []*Attestation, init func(*Attestation), assign func(*Attestation, *GitlabAttestation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Attestation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.GitlabAttestation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attestation.GitlabAttestationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.attestation_gitlab_attestation
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attestation_gitlab_attestation" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attestation_gitlab_attestation" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AttestationQuery) loadEnvironmentAttestation(ctx context.Context, query *EnvironmentAttestationQuery, nodes []*Attestation, init func(*Attestation), assign func(*Attestation, *EnvironmentAttestation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Attestation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.EnvironmentAttestation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attestation.EnvironmentAttestationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.attestation_environment_attestation
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attestation_environment_attestation" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attestation_environment_attestation" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AttestationQuery) loadCommandRunAttestation(ctx context.Context, query *CommandRunAttestationQuery, nodes []*Attestation, init func(*Attestation), assign func(*Attestation, *CommandRunAttestation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Attestation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.CommandRunAttestation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attestation.CommandRunAttestationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.attestation_command_run_attestation
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attestation_command_run_attestation" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attestation_command_run_attestation" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AttestationQuery) loadOciAttestation(ctx context.Context, query *OciAttestationQuery, nodes []*Attestation, init func(*Attestation), assign func(*Attestation, *OciAttestation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Attestation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.OciAttestation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attestation.OciAttestationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.attestation_oci_attestation
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attestation_oci_attestation" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attestation_oci_attestation" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AttestationQuery) loadMaterials(ctx context.Context, query *MaterialQuery, nodes []*Attestation, init func(*Attestation), assign func(*Attestation, *Material)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Attestation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Material(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attestation.MaterialsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.attestation_materials
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attestation_materials" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attestation_materials" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AttestationQuery) loadProducts(ctx context.Context, query *ProductQuery, nodes []*Attestation, init func(*Attestation), assign func(*Attestation, *Product)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Attestation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attestation.ProductsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.attestation_products
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attestation_products" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attestation_products" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AttestationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -537,6 +1130,34 @@ func (_q *AttestationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedMaterials tells the query-builder to eager-load the nodes that are connected to the "materials"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithNamedMaterials(name string, opts ...func(*MaterialQuery)) *AttestationQuery {
+	query := (&MaterialClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedMaterials == nil {
+		_q.withNamedMaterials = make(map[string]*MaterialQuery)
+	}
+	_q.withNamedMaterials[name] = query
+	return _q
+}
+
+// WithNamedProducts tells the query-builder to eager-load the nodes that are connected to the "products"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttestationQuery) WithNamedProducts(name string, opts ...func(*ProductQuery)) *AttestationQuery {
+	query := (&ProductClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedProducts == nil {
+		_q.withNamedProducts = make(map[string]*ProductQuery)
+	}
+	_q.withNamedProducts[name] = query
+	return _q
+}
+
 // AttestationGroupBy is the group-by builder for Attestation entities.
 type AttestationGroupBy struct {
 	selector
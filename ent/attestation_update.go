@@ -13,7 +13,15 @@ import (
 	"github.com/google/uuid"
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
 	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/product"
 )
 
 // AttestationUpdate is the builder for updating Attestation entities.
@@ -54,6 +62,150 @@ func (_u *AttestationUpdate) SetAttestationCollection(v *AttestationCollection)
 	return _u.SetAttestationCollectionID(v.ID)
 }
 
+// SetGitAttestationID sets the "git_attestation" edge to the GitAttestation entity by ID.
+func (_u *AttestationUpdate) SetGitAttestationID(id uuid.UUID) *AttestationUpdate {
+	_u.mutation.SetGitAttestationID(id)
+	return _u
+}
+
+// SetNillableGitAttestationID sets the "git_attestation" edge to the GitAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdate) SetNillableGitAttestationID(id *uuid.UUID) *AttestationUpdate {
+	if id != nil {
+		_u = _u.SetGitAttestationID(*id)
+	}
+	return _u
+}
+
+// SetGitAttestation sets the "git_attestation" edge to the GitAttestation entity.
+func (_u *AttestationUpdate) SetGitAttestation(v *GitAttestation) *AttestationUpdate {
+	return _u.SetGitAttestationID(v.ID)
+}
+
+// SetGithubAttestationID sets the "github_attestation" edge to the GithubAttestation entity by ID.
+func (_u *AttestationUpdate) SetGithubAttestationID(id uuid.UUID) *AttestationUpdate {
+	_u.mutation.SetGithubAttestationID(id)
+	return _u
+}
+
+// SetNillableGithubAttestationID sets the "github_attestation" edge to the GithubAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdate) SetNillableGithubAttestationID(id *uuid.UUID) *AttestationUpdate {
+	if id != nil {
+		_u = _u.SetGithubAttestationID(*id)
+	}
+	return _u
+}
+
+// SetGithubAttestation sets the "github_attestation" edge to the GithubAttestation entity.
+func (_u *AttestationUpdate) SetGithubAttestation(v *GithubAttestation) *AttestationUpdate {
+	return _u.SetGithubAttestationID(v.ID)
+}
+
+// SetGitlabAttestationID sets the "gitlab_attestation" edge to the GitlabAttestation entity by ID.
+func (_u *AttestationUpdate) SetGitlabAttestationID(id uuid.UUID) *AttestationUpdate {
+	_u.mutation.SetGitlabAttestationID(id)
+	return _u
+}
+
+// SetNillableGitlabAttestationID sets the "gitlab_attestation" edge to the GitlabAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdate) SetNillableGitlabAttestationID(id *uuid.UUID) *AttestationUpdate {
+	if id != nil {
+		_u = _u.SetGitlabAttestationID(*id)
+	}
+	return _u
+}
+
+// SetGitlabAttestation sets the "gitlab_attestation" edge to the GitlabAttestation entity.
+func (_u *AttestationUpdate) SetGitlabAttestation(v *GitlabAttestation) *AttestationUpdate {
+	return _u.SetGitlabAttestationID(v.ID)
+}
+
+// SetEnvironmentAttestationID sets the "environment_attestation" edge to the EnvironmentAttestation entity by ID.
+func (_u *AttestationUpdate) SetEnvironmentAttestationID(id uuid.UUID) *AttestationUpdate {
+	_u.mutation.SetEnvironmentAttestationID(id)
+	return _u
+}
+
+// SetNillableEnvironmentAttestationID sets the "environment_attestation" edge to the EnvironmentAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdate) SetNillableEnvironmentAttestationID(id *uuid.UUID) *AttestationUpdate {
+	if id != nil {
+		_u = _u.SetEnvironmentAttestationID(*id)
+	}
+	return _u
+}
+
+// SetEnvironmentAttestation sets the "environment_attestation" edge to the EnvironmentAttestation entity.
+func (_u *AttestationUpdate) SetEnvironmentAttestation(v *EnvironmentAttestation) *AttestationUpdate {
+	return _u.SetEnvironmentAttestationID(v.ID)
+}
+
+// SetCommandRunAttestationID sets the "command_run_attestation" edge to the CommandRunAttestation entity by ID.
+func (_u *AttestationUpdate) SetCommandRunAttestationID(id uuid.UUID) *AttestationUpdate {
+	_u.mutation.SetCommandRunAttestationID(id)
+	return _u
+}
+
+// SetNillableCommandRunAttestationID sets the "command_run_attestation" edge to the CommandRunAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdate) SetNillableCommandRunAttestationID(id *uuid.UUID) *AttestationUpdate {
+	if id != nil {
+		_u = _u.SetCommandRunAttestationID(*id)
+	}
+	return _u
+}
+
+// SetCommandRunAttestation sets the "command_run_attestation" edge to the CommandRunAttestation entity.
+func (_u *AttestationUpdate) SetCommandRunAttestation(v *CommandRunAttestation) *AttestationUpdate {
+	return _u.SetCommandRunAttestationID(v.ID)
+}
+
+// SetOciAttestationID sets the "oci_attestation" edge to the OciAttestation entity by ID.
+func (_u *AttestationUpdate) SetOciAttestationID(id uuid.UUID) *AttestationUpdate {
+	_u.mutation.SetOciAttestationID(id)
+	return _u
+}
+
+// SetNillableOciAttestationID sets the "oci_attestation" edge to the OciAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdate) SetNillableOciAttestationID(id *uuid.UUID) *AttestationUpdate {
+	if id != nil {
+		_u = _u.SetOciAttestationID(*id)
+	}
+	return _u
+}
+
+// SetOciAttestation sets the "oci_attestation" edge to the OciAttestation entity.
+func (_u *AttestationUpdate) SetOciAttestation(v *OciAttestation) *AttestationUpdate {
+	return _u.SetOciAttestationID(v.ID)
+}
+
+// AddMaterialIDs adds the "materials" edge to the Material entity by IDs.
+func (_u *AttestationUpdate) AddMaterialIDs(ids ...uuid.UUID) *AttestationUpdate {
+	_u.mutation.AddMaterialIDs(ids...)
+	return _u
+}
+
+// AddMaterials adds the "materials" edges to the Material entity.
+func (_u *AttestationUpdate) AddMaterials(v ...*Material) *AttestationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMaterialIDs(ids...)
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (_u *AttestationUpdate) AddProductIDs(ids ...uuid.UUID) *AttestationUpdate {
+	_u.mutation.AddProductIDs(ids...)
+	return _u
+}
+
+// AddProducts adds the "products" edges to the Product entity.
+func (_u *AttestationUpdate) AddProducts(v ...*Product) *AttestationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProductIDs(ids...)
+}
+
 // Mutation returns the AttestationMutation object of the builder.
 func (_u *AttestationUpdate) Mutation() *AttestationMutation {
 	return _u.mutation
@@ -65,6 +217,84 @@ func (_u *AttestationUpdate) ClearAttestationCollection() *AttestationUpdate {
 	return _u
 }
 
+// ClearGitAttestation clears the "git_attestation" edge to the GitAttestation entity.
+func (_u *AttestationUpdate) ClearGitAttestation() *AttestationUpdate {
+	_u.mutation.ClearGitAttestation()
+	return _u
+}
+
+// ClearGithubAttestation clears the "github_attestation" edge to the GithubAttestation entity.
+func (_u *AttestationUpdate) ClearGithubAttestation() *AttestationUpdate {
+	_u.mutation.ClearGithubAttestation()
+	return _u
+}
+
+// ClearGitlabAttestation clears the "gitlab_attestation" edge to the GitlabAttestation entity.
+func (_u *AttestationUpdate) ClearGitlabAttestation() *AttestationUpdate {
+	_u.mutation.ClearGitlabAttestation()
+	return _u
+}
+
+// ClearEnvironmentAttestation clears the "environment_attestation" edge to the EnvironmentAttestation entity.
+func (_u *AttestationUpdate) ClearEnvironmentAttestation() *AttestationUpdate {
+	_u.mutation.ClearEnvironmentAttestation()
+	return _u
+}
+
+// ClearCommandRunAttestation clears the "command_run_attestation" edge to the CommandRunAttestation entity.
+func (_u *AttestationUpdate) ClearCommandRunAttestation() *AttestationUpdate {
+	_u.mutation.ClearCommandRunAttestation()
+	return _u
+}
+
+// ClearOciAttestation clears the "oci_attestation" edge to the OciAttestation entity.
+func (_u *AttestationUpdate) ClearOciAttestation() *AttestationUpdate {
+	_u.mutation.ClearOciAttestation()
+	return _u
+}
+
+// ClearMaterials clears all "materials" edges to the Material entity.
+func (_u *AttestationUpdate) ClearMaterials() *AttestationUpdate {
+	_u.mutation.ClearMaterials()
+	return _u
+}
+
+// RemoveMaterialIDs removes the "materials" edge to Material entities by IDs.
+func (_u *AttestationUpdate) RemoveMaterialIDs(ids ...uuid.UUID) *AttestationUpdate {
+	_u.mutation.RemoveMaterialIDs(ids...)
+	return _u
+}
+
+// RemoveMaterials removes "materials" edges to Material entities.
+func (_u *AttestationUpdate) RemoveMaterials(v ...*Material) *AttestationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMaterialIDs(ids...)
+}
+
+// ClearProducts clears all "products" edges to the Product entity.
+func (_u *AttestationUpdate) ClearProducts() *AttestationUpdate {
+	_u.mutation.ClearProducts()
+	return _u
+}
+
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (_u *AttestationUpdate) RemoveProductIDs(ids ...uuid.UUID) *AttestationUpdate {
+	_u.mutation.RemoveProductIDs(ids...)
+	return _u
+}
+
+// RemoveProducts removes "products" edges to Product entities.
+func (_u *AttestationUpdate) RemoveProducts(v ...*Product) *AttestationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProductIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AttestationUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -149,49 +379,457 @@ func (_u *AttestationUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{attestation.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if _u.mutation.GitAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitAttestationTable,
+			Columns: []string{attestation.GitAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GitAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitAttestationTable,
+			Columns: []string{attestation.GitAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GithubAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GithubAttestationTable,
+			Columns: []string{attestation.GithubAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(githubattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GithubAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GithubAttestationTable,
+			Columns: []string{attestation.GithubAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(githubattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GitlabAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitlabAttestationTable,
+			Columns: []string{attestation.GitlabAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GitlabAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitlabAttestationTable,
+			Columns: []string{attestation.GitlabAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EnvironmentAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.EnvironmentAttestationTable,
+			Columns: []string{attestation.EnvironmentAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnvironmentAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.EnvironmentAttestationTable,
+			Columns: []string{attestation.EnvironmentAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommandRunAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.CommandRunAttestationTable,
+			Columns: []string{attestation.CommandRunAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commandrunattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommandRunAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.CommandRunAttestationTable,
+			Columns: []string{attestation.CommandRunAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commandrunattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OciAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.OciAttestationTable,
+			Columns: []string{attestation.OciAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ociattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OciAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.OciAttestationTable,
+			Columns: []string{attestation.OciAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ociattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MaterialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.MaterialsTable,
+			Columns: []string{attestation.MaterialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(material.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMaterialsIDs(); len(nodes) > 0 && !_u.mutation.MaterialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.MaterialsTable,
+			Columns: []string{attestation.MaterialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(material.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MaterialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.MaterialsTable,
+			Columns: []string{attestation.MaterialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(material.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.ProductsTable,
+			Columns: []string{attestation.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedProductsIDs(); len(nodes) > 0 && !_u.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.ProductsTable,
+			Columns: []string{attestation.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.ProductsTable,
+			Columns: []string{attestation.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AttestationUpdateOne is the builder for updating a single Attestation entity.
+type AttestationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttestationMutation
+}
+
+// SetType sets the "type" field.
+func (_u *AttestationUpdateOne) SetType(v string) *AttestationUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *AttestationUpdateOne) SetNillableType(v *string) *AttestationUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetAttestationCollectionID sets the "attestation_collection" edge to the AttestationCollection entity by ID.
+func (_u *AttestationUpdateOne) SetAttestationCollectionID(id uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.SetAttestationCollectionID(id)
+	return _u
+}
+
+// SetAttestationCollection sets the "attestation_collection" edge to the AttestationCollection entity.
+func (_u *AttestationUpdateOne) SetAttestationCollection(v *AttestationCollection) *AttestationUpdateOne {
+	return _u.SetAttestationCollectionID(v.ID)
+}
+
+// SetGitAttestationID sets the "git_attestation" edge to the GitAttestation entity by ID.
+func (_u *AttestationUpdateOne) SetGitAttestationID(id uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.SetGitAttestationID(id)
+	return _u
+}
+
+// SetNillableGitAttestationID sets the "git_attestation" edge to the GitAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdateOne) SetNillableGitAttestationID(id *uuid.UUID) *AttestationUpdateOne {
+	if id != nil {
+		_u = _u.SetGitAttestationID(*id)
+	}
+	return _u
+}
+
+// SetGitAttestation sets the "git_attestation" edge to the GitAttestation entity.
+func (_u *AttestationUpdateOne) SetGitAttestation(v *GitAttestation) *AttestationUpdateOne {
+	return _u.SetGitAttestationID(v.ID)
+}
+
+// SetGithubAttestationID sets the "github_attestation" edge to the GithubAttestation entity by ID.
+func (_u *AttestationUpdateOne) SetGithubAttestationID(id uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.SetGithubAttestationID(id)
+	return _u
+}
+
+// SetNillableGithubAttestationID sets the "github_attestation" edge to the GithubAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdateOne) SetNillableGithubAttestationID(id *uuid.UUID) *AttestationUpdateOne {
+	if id != nil {
+		_u = _u.SetGithubAttestationID(*id)
+	}
+	return _u
+}
+
+// SetGithubAttestation sets the "github_attestation" edge to the GithubAttestation entity.
+func (_u *AttestationUpdateOne) SetGithubAttestation(v *GithubAttestation) *AttestationUpdateOne {
+	return _u.SetGithubAttestationID(v.ID)
+}
+
+// SetGitlabAttestationID sets the "gitlab_attestation" edge to the GitlabAttestation entity by ID.
+func (_u *AttestationUpdateOne) SetGitlabAttestationID(id uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.SetGitlabAttestationID(id)
+	return _u
+}
+
+// SetNillableGitlabAttestationID sets the "gitlab_attestation" edge to the GitlabAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdateOne) SetNillableGitlabAttestationID(id *uuid.UUID) *AttestationUpdateOne {
+	if id != nil {
+		_u = _u.SetGitlabAttestationID(*id)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// AttestationUpdateOne is the builder for updating a single Attestation entity.
-type AttestationUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *AttestationMutation
+// SetGitlabAttestation sets the "gitlab_attestation" edge to the GitlabAttestation entity.
+func (_u *AttestationUpdateOne) SetGitlabAttestation(v *GitlabAttestation) *AttestationUpdateOne {
+	return _u.SetGitlabAttestationID(v.ID)
 }
 
-// SetType sets the "type" field.
-func (_u *AttestationUpdateOne) SetType(v string) *AttestationUpdateOne {
-	_u.mutation.SetType(v)
+// SetEnvironmentAttestationID sets the "environment_attestation" edge to the EnvironmentAttestation entity by ID.
+func (_u *AttestationUpdateOne) SetEnvironmentAttestationID(id uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.SetEnvironmentAttestationID(id)
 	return _u
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (_u *AttestationUpdateOne) SetNillableType(v *string) *AttestationUpdateOne {
-	if v != nil {
-		_u.SetType(*v)
+// SetNillableEnvironmentAttestationID sets the "environment_attestation" edge to the EnvironmentAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdateOne) SetNillableEnvironmentAttestationID(id *uuid.UUID) *AttestationUpdateOne {
+	if id != nil {
+		_u = _u.SetEnvironmentAttestationID(*id)
 	}
 	return _u
 }
 
-// SetAttestationCollectionID sets the "attestation_collection" edge to the AttestationCollection entity by ID.
-func (_u *AttestationUpdateOne) SetAttestationCollectionID(id uuid.UUID) *AttestationUpdateOne {
-	_u.mutation.SetAttestationCollectionID(id)
+// SetEnvironmentAttestation sets the "environment_attestation" edge to the EnvironmentAttestation entity.
+func (_u *AttestationUpdateOne) SetEnvironmentAttestation(v *EnvironmentAttestation) *AttestationUpdateOne {
+	return _u.SetEnvironmentAttestationID(v.ID)
+}
+
+// SetCommandRunAttestationID sets the "command_run_attestation" edge to the CommandRunAttestation entity by ID.
+func (_u *AttestationUpdateOne) SetCommandRunAttestationID(id uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.SetCommandRunAttestationID(id)
 	return _u
 }
 
-// SetAttestationCollection sets the "attestation_collection" edge to the AttestationCollection entity.
-func (_u *AttestationUpdateOne) SetAttestationCollection(v *AttestationCollection) *AttestationUpdateOne {
-	return _u.SetAttestationCollectionID(v.ID)
+// SetNillableCommandRunAttestationID sets the "command_run_attestation" edge to the CommandRunAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdateOne) SetNillableCommandRunAttestationID(id *uuid.UUID) *AttestationUpdateOne {
+	if id != nil {
+		_u = _u.SetCommandRunAttestationID(*id)
+	}
+	return _u
+}
+
+// SetCommandRunAttestation sets the "command_run_attestation" edge to the CommandRunAttestation entity.
+func (_u *AttestationUpdateOne) SetCommandRunAttestation(v *CommandRunAttestation) *AttestationUpdateOne {
+	return _u.SetCommandRunAttestationID(v.ID)
+}
+
+// SetOciAttestationID sets the "oci_attestation" edge to the OciAttestation entity by ID.
+func (_u *AttestationUpdateOne) SetOciAttestationID(id uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.SetOciAttestationID(id)
+	return _u
+}
+
+// SetNillableOciAttestationID sets the "oci_attestation" edge to the OciAttestation entity by ID if the given value is not nil.
+func (_u *AttestationUpdateOne) SetNillableOciAttestationID(id *uuid.UUID) *AttestationUpdateOne {
+	if id != nil {
+		_u = _u.SetOciAttestationID(*id)
+	}
+	return _u
+}
+
+// SetOciAttestation sets the "oci_attestation" edge to the OciAttestation entity.
+func (_u *AttestationUpdateOne) SetOciAttestation(v *OciAttestation) *AttestationUpdateOne {
+	return _u.SetOciAttestationID(v.ID)
+}
+
+// AddMaterialIDs adds the "materials" edge to the Material entity by IDs.
+func (_u *AttestationUpdateOne) AddMaterialIDs(ids ...uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.AddMaterialIDs(ids...)
+	return _u
+}
+
+// AddMaterials adds the "materials" edges to the Material entity.
+func (_u *AttestationUpdateOne) AddMaterials(v ...*Material) *AttestationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMaterialIDs(ids...)
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (_u *AttestationUpdateOne) AddProductIDs(ids ...uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.AddProductIDs(ids...)
+	return _u
+}
+
+// AddProducts adds the "products" edges to the Product entity.
+func (_u *AttestationUpdateOne) AddProducts(v ...*Product) *AttestationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProductIDs(ids...)
 }
 
 // Mutation returns the AttestationMutation object of the builder.
@@ -205,6 +843,84 @@ func (_u *AttestationUpdateOne) ClearAttestationCollection() *AttestationUpdateO
 	return _u
 }
 
+// ClearGitAttestation clears the "git_attestation" edge to the GitAttestation entity.
+func (_u *AttestationUpdateOne) ClearGitAttestation() *AttestationUpdateOne {
+	_u.mutation.ClearGitAttestation()
+	return _u
+}
+
+// ClearGithubAttestation clears the "github_attestation" edge to the GithubAttestation entity.
+func (_u *AttestationUpdateOne) ClearGithubAttestation() *AttestationUpdateOne {
+	_u.mutation.ClearGithubAttestation()
+	return _u
+}
+
+// ClearGitlabAttestation clears the "gitlab_attestation" edge to the GitlabAttestation entity.
+func (_u *AttestationUpdateOne) ClearGitlabAttestation() *AttestationUpdateOne {
+	_u.mutation.ClearGitlabAttestation()
+	return _u
+}
+
+// ClearEnvironmentAttestation clears the "environment_attestation" edge to the EnvironmentAttestation entity.
+func (_u *AttestationUpdateOne) ClearEnvironmentAttestation() *AttestationUpdateOne {
+	_u.mutation.ClearEnvironmentAttestation()
+	return _u
+}
+
+// ClearCommandRunAttestation clears the "command_run_attestation" edge to the CommandRunAttestation entity.
+func (_u *AttestationUpdateOne) ClearCommandRunAttestation() *AttestationUpdateOne {
+	_u.mutation.ClearCommandRunAttestation()
+	return _u
+}
+
+// ClearOciAttestation clears the "oci_attestation" edge to the OciAttestation entity.
+func (_u *AttestationUpdateOne) ClearOciAttestation() *AttestationUpdateOne {
+	_u.mutation.ClearOciAttestation()
+	return _u
+}
+
+// ClearMaterials clears all "materials" edges to the Material entity.
+func (_u *AttestationUpdateOne) ClearMaterials() *AttestationUpdateOne {
+	_u.mutation.ClearMaterials()
+	return _u
+}
+
+// RemoveMaterialIDs removes the "materials" edge to Material entities by IDs.
+func (_u *AttestationUpdateOne) RemoveMaterialIDs(ids ...uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.RemoveMaterialIDs(ids...)
+	return _u
+}
+
+// RemoveMaterials removes "materials" edges to Material entities.
+func (_u *AttestationUpdateOne) RemoveMaterials(v ...*Material) *AttestationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMaterialIDs(ids...)
+}
+
+// ClearProducts clears all "products" edges to the Product entity.
+func (_u *AttestationUpdateOne) ClearProducts() *AttestationUpdateOne {
+	_u.mutation.ClearProducts()
+	return _u
+}
+
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (_u *AttestationUpdateOne) RemoveProductIDs(ids ...uuid.UUID) *AttestationUpdateOne {
+	_u.mutation.RemoveProductIDs(ids...)
+	return _u
+}
+
+// RemoveProducts removes "products" edges to Product entities.
+func (_u *AttestationUpdateOne) RemoveProducts(v ...*Product) *AttestationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProductIDs(ids...)
+}
+
 // Where appends a list predicates to the AttestationUpdate builder.
 func (_u *AttestationUpdateOne) Where(ps ...predicate.Attestation) *AttestationUpdateOne {
 	_u.mutation.Where(ps...)
@@ -319,6 +1035,270 @@ func (_u *AttestationUpdateOne) sqlSave(ctx context.Context) (_node *Attestation
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.GitAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitAttestationTable,
+			Columns: []string{attestation.GitAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GitAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitAttestationTable,
+			Columns: []string{attestation.GitAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GithubAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GithubAttestationTable,
+			Columns: []string{attestation.GithubAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(githubattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GithubAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GithubAttestationTable,
+			Columns: []string{attestation.GithubAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(githubattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GitlabAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitlabAttestationTable,
+			Columns: []string{attestation.GitlabAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GitlabAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.GitlabAttestationTable,
+			Columns: []string{attestation.GitlabAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EnvironmentAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.EnvironmentAttestationTable,
+			Columns: []string{attestation.EnvironmentAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnvironmentAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.EnvironmentAttestationTable,
+			Columns: []string{attestation.EnvironmentAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommandRunAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.CommandRunAttestationTable,
+			Columns: []string{attestation.CommandRunAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commandrunattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommandRunAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.CommandRunAttestationTable,
+			Columns: []string{attestation.CommandRunAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commandrunattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OciAttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.OciAttestationTable,
+			Columns: []string{attestation.OciAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ociattestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OciAttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   attestation.OciAttestationTable,
+			Columns: []string{attestation.OciAttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ociattestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MaterialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.MaterialsTable,
+			Columns: []string{attestation.MaterialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(material.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMaterialsIDs(); len(nodes) > 0 && !_u.mutation.MaterialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.MaterialsTable,
+			Columns: []string{attestation.MaterialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(material.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MaterialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.MaterialsTable,
+			Columns: []string{attestation.MaterialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(material.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.ProductsTable,
+			Columns: []string{attestation.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedProductsIDs(); len(nodes) > 0 && !_u.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.ProductsTable,
+			Columns: []string{attestation.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attestation.ProductsTable,
+			Columns: []string{attestation.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Attestation{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
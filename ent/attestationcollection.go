@@ -18,6 +18,8 @@ type AttestationCollection struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -67,7 +69,7 @@ func (*AttestationCollection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case attestationcollection.FieldName:
+		case attestationcollection.FieldTenant, attestationcollection.FieldName:
 			values[i] = new(sql.NullString)
 		case attestationcollection.FieldID:
 			values[i] = new(uuid.UUID)
@@ -94,6 +96,12 @@ func (_m *AttestationCollection) assignValues(columns []string, values []any) er
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case attestationcollection.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
 		case attestationcollection.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -153,6 +161,9 @@ func (_m *AttestationCollection) String() string {
 	var builder strings.Builder
 	builder.WriteString("AttestationCollection(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteByte(')')
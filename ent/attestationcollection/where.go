@@ -54,11 +54,81 @@ func IDLTE(id uuid.UUID) predicate.AttestationCollection {
 	return predicate.AttestationCollection(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldEQ(FieldTenant, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.AttestationCollection {
 	return predicate.AttestationCollection(sql.FieldEQ(FieldName, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.AttestationCollection {
+	return predicate.AttestationCollection(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.AttestationCollection {
 	return predicate.AttestationCollection(sql.FieldEQ(FieldName, v))
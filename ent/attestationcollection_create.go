@@ -22,6 +22,20 @@ type AttestationCollectionCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *AttestationCollectionCreate) SetTenant(v string) *AttestationCollectionCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *AttestationCollectionCreate) SetNillableTenant(v *string) *AttestationCollectionCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetName sets the "name" field.
 func (_c *AttestationCollectionCreate) SetName(v string) *AttestationCollectionCreate {
 	_c.mutation.SetName(v)
@@ -75,7 +89,9 @@ func (_c *AttestationCollectionCreate) Mutation() *AttestationCollectionMutation
 
 // Save creates the AttestationCollection in the database.
 func (_c *AttestationCollectionCreate) Save(ctx context.Context) (*AttestationCollection, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -102,15 +118,31 @@ func (_c *AttestationCollectionCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *AttestationCollectionCreate) defaults() {
+func (_c *AttestationCollectionCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := attestationcollection.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if attestationcollection.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized attestationcollection.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := attestationcollection.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *AttestationCollectionCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "AttestationCollection.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := attestationcollection.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "AttestationCollection.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AttestationCollection.name"`)}
 	}
@@ -157,6 +189,10 @@ func (_c *AttestationCollectionCreate) createSpec() (*AttestationCollection, *sq
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(attestationcollection.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(attestationcollection.FieldName, field.TypeString, value)
 		_node.Name = value
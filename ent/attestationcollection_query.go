@@ -340,12 +340,12 @@ func (_q *AttestationCollectionQuery) WithStatement(opts ...func(*StatementQuery
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.AttestationCollection.Query().
-//		GroupBy(attestationcollection.FieldName).
+//		GroupBy(attestationcollection.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *AttestationCollectionQuery) GroupBy(field string, fields ...string) *AttestationCollectionGroupBy {
@@ -363,11 +363,11 @@ func (_q *AttestationCollectionQuery) GroupBy(field string, fields ...string) *A
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.AttestationCollection.Query().
-//		Select(attestationcollection.FieldName).
+//		Select(attestationcollection.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *AttestationCollectionQuery) Select(fields ...string) *AttestationCollectionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
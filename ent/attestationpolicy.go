@@ -18,6 +18,8 @@ type AttestationPolicy struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -54,7 +56,7 @@ func (*AttestationPolicy) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case attestationpolicy.FieldName:
+		case attestationpolicy.FieldTenant, attestationpolicy.FieldName:
 			values[i] = new(sql.NullString)
 		case attestationpolicy.FieldID:
 			values[i] = new(uuid.UUID)
@@ -81,6 +83,12 @@ func (_m *AttestationPolicy) assignValues(columns []string, values []any) error
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case attestationpolicy.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
 		case attestationpolicy.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -135,6 +143,9 @@ func (_m *AttestationPolicy) String() string {
 	var builder strings.Builder
 	builder.WriteString("AttestationPolicy(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteByte(')')
@@ -21,6 +21,20 @@ type AttestationPolicyCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *AttestationPolicyCreate) SetTenant(v string) *AttestationPolicyCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *AttestationPolicyCreate) SetNillableTenant(v *string) *AttestationPolicyCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetName sets the "name" field.
 func (_c *AttestationPolicyCreate) SetName(v string) *AttestationPolicyCreate {
 	_c.mutation.SetName(v)
@@ -67,7 +81,9 @@ func (_c *AttestationPolicyCreate) Mutation() *AttestationPolicyMutation {
 
 // Save creates the AttestationPolicy in the database.
 func (_c *AttestationPolicyCreate) Save(ctx context.Context) (*AttestationPolicy, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -94,15 +110,31 @@ func (_c *AttestationPolicyCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *AttestationPolicyCreate) defaults() {
+func (_c *AttestationPolicyCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := attestationpolicy.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if attestationpolicy.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized attestationpolicy.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := attestationpolicy.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *AttestationPolicyCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "AttestationPolicy.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := attestationpolicy.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "AttestationPolicy.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AttestationPolicy.name"`)}
 	}
@@ -146,6 +178,10 @@ func (_c *AttestationPolicyCreate) createSpec() (*AttestationPolicy, *sqlgraph.C
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(attestationpolicy.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(attestationpolicy.FieldName, field.TypeString, value)
 		_node.Name = value
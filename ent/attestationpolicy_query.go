@@ -302,12 +302,12 @@ func (_q *AttestationPolicyQuery) WithStatement(opts ...func(*StatementQuery)) *
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.AttestationPolicy.Query().
-//		GroupBy(attestationpolicy.FieldName).
+//		GroupBy(attestationpolicy.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *AttestationPolicyQuery) GroupBy(field string, fields ...string) *AttestationPolicyGroupBy {
@@ -325,11 +325,11 @@ func (_q *AttestationPolicyQuery) GroupBy(field string, fields ...string) *Attes
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.AttestationPolicy.Query().
-//		Select(attestationpolicy.FieldName).
+//		Select(attestationpolicy.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *AttestationPolicyQuery) Select(fields ...string) *AttestationPolicySelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
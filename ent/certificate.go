@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/signature"
+)
+
+// Certificate is the model entity for the Certificate schema.
+type Certificate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// ChainIndex holds the value of the "chain_index" field.
+	ChainIndex int `json:"chain_index,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// Issuer holds the value of the "issuer" field.
+	Issuer string `json:"issuer,omitempty"`
+	// SerialNumber holds the value of the "serial_number" field.
+	SerialNumber string `json:"serial_number,omitempty"`
+	// NotBefore holds the value of the "not_before" field.
+	NotBefore time.Time `json:"not_before,omitempty"`
+	// NotAfter holds the value of the "not_after" field.
+	NotAfter time.Time `json:"not_after,omitempty"`
+	// SpiffeID holds the value of the "spiffe_id" field.
+	SpiffeID string `json:"spiffe_id,omitempty"`
+	// OidcIssuer holds the value of the "oidc_issuer" field.
+	OidcIssuer string `json:"oidc_issuer,omitempty"`
+	// BuildSignerURI holds the value of the "build_signer_uri" field.
+	BuildSignerURI string `json:"build_signer_uri,omitempty"`
+	// BuildSignerDigest holds the value of the "build_signer_digest" field.
+	BuildSignerDigest string `json:"build_signer_digest,omitempty"`
+	// RunnerEnvironment holds the value of the "runner_environment" field.
+	RunnerEnvironment string `json:"runner_environment,omitempty"`
+	// SourceRepositoryURI holds the value of the "source_repository_uri" field.
+	SourceRepositoryURI string `json:"source_repository_uri,omitempty"`
+	// SourceRepositoryDigest holds the value of the "source_repository_digest" field.
+	SourceRepositoryDigest string `json:"source_repository_digest,omitempty"`
+	// SourceRepositoryRef holds the value of the "source_repository_ref" field.
+	SourceRepositoryRef string `json:"source_repository_ref,omitempty"`
+	// BuildConfigURI holds the value of the "build_config_uri" field.
+	BuildConfigURI string `json:"build_config_uri,omitempty"`
+	// BuildTrigger holds the value of the "build_trigger" field.
+	BuildTrigger string `json:"build_trigger,omitempty"`
+	// RunInvocationURI holds the value of the "run_invocation_uri" field.
+	RunInvocationURI string `json:"run_invocation_uri,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CertificateQuery when eager-loading is set.
+	Edges                  CertificateEdges `json:"edges"`
+	signature_certificates *uuid.UUID
+	selectValues           sql.SelectValues
+}
+
+// CertificateEdges holds the relations/edges for other nodes in the graph.
+type CertificateEdges struct {
+	// SubjectAltNames holds the value of the subject_alt_names edge.
+	SubjectAltNames []*SubjectAltName `json:"subject_alt_names,omitempty"`
+	// Signature holds the value of the signature edge.
+	Signature *Signature `json:"signature,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedSubjectAltNames map[string][]*SubjectAltName
+}
+
+// SubjectAltNamesOrErr returns the SubjectAltNames value or an error if the edge
+// was not loaded in eager-loading.
+func (e CertificateEdges) SubjectAltNamesOrErr() ([]*SubjectAltName, error) {
+	if e.loadedTypes[0] {
+		return e.SubjectAltNames, nil
+	}
+	return nil, &NotLoadedError{edge: "subject_alt_names"}
+}
+
+// SignatureOrErr returns the Signature value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertificateEdges) SignatureOrErr() (*Signature, error) {
+	if e.Signature != nil {
+		return e.Signature, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: signature.Label}
+	}
+	return nil, &NotLoadedError{edge: "signature"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Certificate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case certificate.FieldChainIndex:
+			values[i] = new(sql.NullInt64)
+		case certificate.FieldTenant, certificate.FieldSubject, certificate.FieldIssuer, certificate.FieldSerialNumber, certificate.FieldSpiffeID, certificate.FieldOidcIssuer, certificate.FieldBuildSignerURI, certificate.FieldBuildSignerDigest, certificate.FieldRunnerEnvironment, certificate.FieldSourceRepositoryURI, certificate.FieldSourceRepositoryDigest, certificate.FieldSourceRepositoryRef, certificate.FieldBuildConfigURI, certificate.FieldBuildTrigger, certificate.FieldRunInvocationURI:
+			values[i] = new(sql.NullString)
+		case certificate.FieldNotBefore, certificate.FieldNotAfter:
+			values[i] = new(sql.NullTime)
+		case certificate.FieldID:
+			values[i] = new(uuid.UUID)
+		case certificate.ForeignKeys[0]: // signature_certificates
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Certificate fields.
+func (_m *Certificate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case certificate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case certificate.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case certificate.FieldChainIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chain_index", values[i])
+			} else if value.Valid {
+				_m.ChainIndex = int(value.Int64)
+			}
+		case certificate.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case certificate.FieldIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer", values[i])
+			} else if value.Valid {
+				_m.Issuer = value.String
+			}
+		case certificate.FieldSerialNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field serial_number", values[i])
+			} else if value.Valid {
+				_m.SerialNumber = value.String
+			}
+		case certificate.FieldNotBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field not_before", values[i])
+			} else if value.Valid {
+				_m.NotBefore = value.Time
+			}
+		case certificate.FieldNotAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field not_after", values[i])
+			} else if value.Valid {
+				_m.NotAfter = value.Time
+			}
+		case certificate.FieldSpiffeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spiffe_id", values[i])
+			} else if value.Valid {
+				_m.SpiffeID = value.String
+			}
+		case certificate.FieldOidcIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field oidc_issuer", values[i])
+			} else if value.Valid {
+				_m.OidcIssuer = value.String
+			}
+		case certificate.FieldBuildSignerURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_signer_uri", values[i])
+			} else if value.Valid {
+				_m.BuildSignerURI = value.String
+			}
+		case certificate.FieldBuildSignerDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_signer_digest", values[i])
+			} else if value.Valid {
+				_m.BuildSignerDigest = value.String
+			}
+		case certificate.FieldRunnerEnvironment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field runner_environment", values[i])
+			} else if value.Valid {
+				_m.RunnerEnvironment = value.String
+			}
+		case certificate.FieldSourceRepositoryURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_repository_uri", values[i])
+			} else if value.Valid {
+				_m.SourceRepositoryURI = value.String
+			}
+		case certificate.FieldSourceRepositoryDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_repository_digest", values[i])
+			} else if value.Valid {
+				_m.SourceRepositoryDigest = value.String
+			}
+		case certificate.FieldSourceRepositoryRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_repository_ref", values[i])
+			} else if value.Valid {
+				_m.SourceRepositoryRef = value.String
+			}
+		case certificate.FieldBuildConfigURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_config_uri", values[i])
+			} else if value.Valid {
+				_m.BuildConfigURI = value.String
+			}
+		case certificate.FieldBuildTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_trigger", values[i])
+			} else if value.Valid {
+				_m.BuildTrigger = value.String
+			}
+		case certificate.FieldRunInvocationURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_invocation_uri", values[i])
+			} else if value.Valid {
+				_m.RunInvocationURI = value.String
+			}
+		case certificate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field signature_certificates", values[i])
+			} else if value.Valid {
+				_m.signature_certificates = new(uuid.UUID)
+				*_m.signature_certificates = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Certificate.
+// This includes values selected through modifiers, order, etc.
+func (_m *Certificate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySubjectAltNames queries the "subject_alt_names" edge of the Certificate entity.
+func (_m *Certificate) QuerySubjectAltNames() *SubjectAltNameQuery {
+	return NewCertificateClient(_m.config).QuerySubjectAltNames(_m)
+}
+
+// QuerySignature queries the "signature" edge of the Certificate entity.
+func (_m *Certificate) QuerySignature() *SignatureQuery {
+	return NewCertificateClient(_m.config).QuerySignature(_m)
+}
+
+// Update returns a builder for updating this Certificate.
+// Note that you need to call Certificate.Unwrap() before calling this method if this Certificate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Certificate) Update() *CertificateUpdateOne {
+	return NewCertificateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Certificate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Certificate) Unwrap() *Certificate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Certificate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Certificate) String() string {
+	var builder strings.Builder
+	builder.WriteString("Certificate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("chain_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChainIndex))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("issuer=")
+	builder.WriteString(_m.Issuer)
+	builder.WriteString(", ")
+	builder.WriteString("serial_number=")
+	builder.WriteString(_m.SerialNumber)
+	builder.WriteString(", ")
+	builder.WriteString("not_before=")
+	builder.WriteString(_m.NotBefore.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("not_after=")
+	builder.WriteString(_m.NotAfter.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("spiffe_id=")
+	builder.WriteString(_m.SpiffeID)
+	builder.WriteString(", ")
+	builder.WriteString("oidc_issuer=")
+	builder.WriteString(_m.OidcIssuer)
+	builder.WriteString(", ")
+	builder.WriteString("build_signer_uri=")
+	builder.WriteString(_m.BuildSignerURI)
+	builder.WriteString(", ")
+	builder.WriteString("build_signer_digest=")
+	builder.WriteString(_m.BuildSignerDigest)
+	builder.WriteString(", ")
+	builder.WriteString("runner_environment=")
+	builder.WriteString(_m.RunnerEnvironment)
+	builder.WriteString(", ")
+	builder.WriteString("source_repository_uri=")
+	builder.WriteString(_m.SourceRepositoryURI)
+	builder.WriteString(", ")
+	builder.WriteString("source_repository_digest=")
+	builder.WriteString(_m.SourceRepositoryDigest)
+	builder.WriteString(", ")
+	builder.WriteString("source_repository_ref=")
+	builder.WriteString(_m.SourceRepositoryRef)
+	builder.WriteString(", ")
+	builder.WriteString("build_config_uri=")
+	builder.WriteString(_m.BuildConfigURI)
+	builder.WriteString(", ")
+	builder.WriteString("build_trigger=")
+	builder.WriteString(_m.BuildTrigger)
+	builder.WriteString(", ")
+	builder.WriteString("run_invocation_uri=")
+	builder.WriteString(_m.RunInvocationURI)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedSubjectAltNames returns the SubjectAltNames named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Certificate) NamedSubjectAltNames(name string) ([]*SubjectAltName, error) {
+	if _m.Edges.namedSubjectAltNames == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedSubjectAltNames[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Certificate) appendNamedSubjectAltNames(name string, edges ...*SubjectAltName) {
+	if _m.Edges.namedSubjectAltNames == nil {
+		_m.Edges.namedSubjectAltNames = make(map[string][]*SubjectAltName)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedSubjectAltNames[name] = []*SubjectAltName{}
+	} else {
+		_m.Edges.namedSubjectAltNames[name] = append(_m.Edges.namedSubjectAltNames[name], edges...)
+	}
+}
+
+// Certificates is a parsable slice of Certificate.
+type Certificates []*Certificate
@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certificate
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the certificate type in the database.
+	Label = "certificate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldChainIndex holds the string denoting the chain_index field in the database.
+	FieldChainIndex = "chain_index"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldIssuer holds the string denoting the issuer field in the database.
+	FieldIssuer = "issuer"
+	// FieldSerialNumber holds the string denoting the serial_number field in the database.
+	FieldSerialNumber = "serial_number"
+	// FieldNotBefore holds the string denoting the not_before field in the database.
+	FieldNotBefore = "not_before"
+	// FieldNotAfter holds the string denoting the not_after field in the database.
+	FieldNotAfter = "not_after"
+	// FieldSpiffeID holds the string denoting the spiffe_id field in the database.
+	FieldSpiffeID = "spiffe_id"
+	// FieldOidcIssuer holds the string denoting the oidc_issuer field in the database.
+	FieldOidcIssuer = "oidc_issuer"
+	// FieldBuildSignerURI holds the string denoting the build_signer_uri field in the database.
+	FieldBuildSignerURI = "build_signer_uri"
+	// FieldBuildSignerDigest holds the string denoting the build_signer_digest field in the database.
+	FieldBuildSignerDigest = "build_signer_digest"
+	// FieldRunnerEnvironment holds the string denoting the runner_environment field in the database.
+	FieldRunnerEnvironment = "runner_environment"
+	// FieldSourceRepositoryURI holds the string denoting the source_repository_uri field in the database.
+	FieldSourceRepositoryURI = "source_repository_uri"
+	// FieldSourceRepositoryDigest holds the string denoting the source_repository_digest field in the database.
+	FieldSourceRepositoryDigest = "source_repository_digest"
+	// FieldSourceRepositoryRef holds the string denoting the source_repository_ref field in the database.
+	FieldSourceRepositoryRef = "source_repository_ref"
+	// FieldBuildConfigURI holds the string denoting the build_config_uri field in the database.
+	FieldBuildConfigURI = "build_config_uri"
+	// FieldBuildTrigger holds the string denoting the build_trigger field in the database.
+	FieldBuildTrigger = "build_trigger"
+	// FieldRunInvocationURI holds the string denoting the run_invocation_uri field in the database.
+	FieldRunInvocationURI = "run_invocation_uri"
+	// EdgeSubjectAltNames holds the string denoting the subject_alt_names edge name in mutations.
+	EdgeSubjectAltNames = "subject_alt_names"
+	// EdgeSignature holds the string denoting the signature edge name in mutations.
+	EdgeSignature = "signature"
+	// Table holds the table name of the certificate in the database.
+	Table = "certificates"
+	// SubjectAltNamesTable is the table that holds the subject_alt_names relation/edge.
+	SubjectAltNamesTable = "subject_alt_names"
+	// SubjectAltNamesInverseTable is the table name for the SubjectAltName entity.
+	// It exists in this package in order to avoid circular dependency with the "subjectaltname" package.
+	SubjectAltNamesInverseTable = "subject_alt_names"
+	// SubjectAltNamesColumn is the table column denoting the subject_alt_names relation/edge.
+	SubjectAltNamesColumn = "certificate_subject_alt_names"
+	// SignatureTable is the table that holds the signature relation/edge.
+	SignatureTable = "certificates"
+	// SignatureInverseTable is the table name for the Signature entity.
+	// It exists in this package in order to avoid circular dependency with the "signature" package.
+	SignatureInverseTable = "signatures"
+	// SignatureColumn is the table column denoting the signature relation/edge.
+	SignatureColumn = "signature_certificates"
+)
+
+// Columns holds all SQL columns for certificate fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldChainIndex,
+	FieldSubject,
+	FieldIssuer,
+	FieldSerialNumber,
+	FieldNotBefore,
+	FieldNotAfter,
+	FieldSpiffeID,
+	FieldOidcIssuer,
+	FieldBuildSignerURI,
+	FieldBuildSignerDigest,
+	FieldRunnerEnvironment,
+	FieldSourceRepositoryURI,
+	FieldSourceRepositoryDigest,
+	FieldSourceRepositoryRef,
+	FieldBuildConfigURI,
+	FieldBuildTrigger,
+	FieldRunInvocationURI,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "certificates"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"signature_certificates",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// ChainIndexValidator is a validator for the "chain_index" field. It is called by the builders before save.
+	ChainIndexValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Certificate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByChainIndex orders the results by the chain_index field.
+func ByChainIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChainIndex, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByIssuer orders the results by the issuer field.
+func ByIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuer, opts...).ToFunc()
+}
+
+// BySerialNumber orders the results by the serial_number field.
+func BySerialNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSerialNumber, opts...).ToFunc()
+}
+
+// ByNotBefore orders the results by the not_before field.
+func ByNotBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotBefore, opts...).ToFunc()
+}
+
+// ByNotAfter orders the results by the not_after field.
+func ByNotAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotAfter, opts...).ToFunc()
+}
+
+// BySpiffeID orders the results by the spiffe_id field.
+func BySpiffeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpiffeID, opts...).ToFunc()
+}
+
+// ByOidcIssuer orders the results by the oidc_issuer field.
+func ByOidcIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOidcIssuer, opts...).ToFunc()
+}
+
+// ByBuildSignerURI orders the results by the build_signer_uri field.
+func ByBuildSignerURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildSignerURI, opts...).ToFunc()
+}
+
+// ByBuildSignerDigest orders the results by the build_signer_digest field.
+func ByBuildSignerDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildSignerDigest, opts...).ToFunc()
+}
+
+// ByRunnerEnvironment orders the results by the runner_environment field.
+func ByRunnerEnvironment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunnerEnvironment, opts...).ToFunc()
+}
+
+// BySourceRepositoryURI orders the results by the source_repository_uri field.
+func BySourceRepositoryURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceRepositoryURI, opts...).ToFunc()
+}
+
+// BySourceRepositoryDigest orders the results by the source_repository_digest field.
+func BySourceRepositoryDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceRepositoryDigest, opts...).ToFunc()
+}
+
+// BySourceRepositoryRef orders the results by the source_repository_ref field.
+func BySourceRepositoryRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceRepositoryRef, opts...).ToFunc()
+}
+
+// ByBuildConfigURI orders the results by the build_config_uri field.
+func ByBuildConfigURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildConfigURI, opts...).ToFunc()
+}
+
+// ByBuildTrigger orders the results by the build_trigger field.
+func ByBuildTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildTrigger, opts...).ToFunc()
+}
+
+// ByRunInvocationURI orders the results by the run_invocation_uri field.
+func ByRunInvocationURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunInvocationURI, opts...).ToFunc()
+}
+
+// BySubjectAltNamesCount orders the results by subject_alt_names count.
+func BySubjectAltNamesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSubjectAltNamesStep(), opts...)
+	}
+}
+
+// BySubjectAltNames orders the results by subject_alt_names terms.
+func BySubjectAltNames(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubjectAltNamesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySignatureField orders the results by signature field.
+func BySignatureField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSignatureStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSubjectAltNamesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubjectAltNamesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SubjectAltNamesTable, SubjectAltNamesColumn),
+	)
+}
+func newSignatureStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SignatureInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SignatureTable, SignatureColumn),
+	)
+}
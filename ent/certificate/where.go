@@ -0,0 +1,1413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certificate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldTenant, v))
+}
+
+// ChainIndex applies equality check predicate on the "chain_index" field. It's identical to ChainIndexEQ.
+func ChainIndex(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldChainIndex, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSubject, v))
+}
+
+// Issuer applies equality check predicate on the "issuer" field. It's identical to IssuerEQ.
+func Issuer(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldIssuer, v))
+}
+
+// SerialNumber applies equality check predicate on the "serial_number" field. It's identical to SerialNumberEQ.
+func SerialNumber(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// NotBefore applies equality check predicate on the "not_before" field. It's identical to NotBeforeEQ.
+func NotBefore(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldNotBefore, v))
+}
+
+// NotAfter applies equality check predicate on the "not_after" field. It's identical to NotAfterEQ.
+func NotAfter(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldNotAfter, v))
+}
+
+// SpiffeID applies equality check predicate on the "spiffe_id" field. It's identical to SpiffeIDEQ.
+func SpiffeID(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSpiffeID, v))
+}
+
+// OidcIssuer applies equality check predicate on the "oidc_issuer" field. It's identical to OidcIssuerEQ.
+func OidcIssuer(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldOidcIssuer, v))
+}
+
+// BuildSignerURI applies equality check predicate on the "build_signer_uri" field. It's identical to BuildSignerURIEQ.
+func BuildSignerURI(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldBuildSignerURI, v))
+}
+
+// BuildSignerDigest applies equality check predicate on the "build_signer_digest" field. It's identical to BuildSignerDigestEQ.
+func BuildSignerDigest(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldBuildSignerDigest, v))
+}
+
+// RunnerEnvironment applies equality check predicate on the "runner_environment" field. It's identical to RunnerEnvironmentEQ.
+func RunnerEnvironment(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRunnerEnvironment, v))
+}
+
+// SourceRepositoryURI applies equality check predicate on the "source_repository_uri" field. It's identical to SourceRepositoryURIEQ.
+func SourceRepositoryURI(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryDigest applies equality check predicate on the "source_repository_digest" field. It's identical to SourceRepositoryDigestEQ.
+func SourceRepositoryDigest(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryRef applies equality check predicate on the "source_repository_ref" field. It's identical to SourceRepositoryRefEQ.
+func SourceRepositoryRef(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSourceRepositoryRef, v))
+}
+
+// BuildConfigURI applies equality check predicate on the "build_config_uri" field. It's identical to BuildConfigURIEQ.
+func BuildConfigURI(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldBuildConfigURI, v))
+}
+
+// BuildTrigger applies equality check predicate on the "build_trigger" field. It's identical to BuildTriggerEQ.
+func BuildTrigger(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldBuildTrigger, v))
+}
+
+// RunInvocationURI applies equality check predicate on the "run_invocation_uri" field. It's identical to RunInvocationURIEQ.
+func RunInvocationURI(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRunInvocationURI, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// ChainIndexEQ applies the EQ predicate on the "chain_index" field.
+func ChainIndexEQ(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldChainIndex, v))
+}
+
+// ChainIndexNEQ applies the NEQ predicate on the "chain_index" field.
+func ChainIndexNEQ(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldChainIndex, v))
+}
+
+// ChainIndexIn applies the In predicate on the "chain_index" field.
+func ChainIndexIn(vs ...int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldChainIndex, vs...))
+}
+
+// ChainIndexNotIn applies the NotIn predicate on the "chain_index" field.
+func ChainIndexNotIn(vs ...int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldChainIndex, vs...))
+}
+
+// ChainIndexGT applies the GT predicate on the "chain_index" field.
+func ChainIndexGT(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldChainIndex, v))
+}
+
+// ChainIndexGTE applies the GTE predicate on the "chain_index" field.
+func ChainIndexGTE(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldChainIndex, v))
+}
+
+// ChainIndexLT applies the LT predicate on the "chain_index" field.
+func ChainIndexLT(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldChainIndex, v))
+}
+
+// ChainIndexLTE applies the LTE predicate on the "chain_index" field.
+func ChainIndexLTE(v int) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldChainIndex, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// IssuerEQ applies the EQ predicate on the "issuer" field.
+func IssuerEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldIssuer, v))
+}
+
+// IssuerNEQ applies the NEQ predicate on the "issuer" field.
+func IssuerNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldIssuer, v))
+}
+
+// IssuerIn applies the In predicate on the "issuer" field.
+func IssuerIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldIssuer, vs...))
+}
+
+// IssuerNotIn applies the NotIn predicate on the "issuer" field.
+func IssuerNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldIssuer, vs...))
+}
+
+// IssuerGT applies the GT predicate on the "issuer" field.
+func IssuerGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldIssuer, v))
+}
+
+// IssuerGTE applies the GTE predicate on the "issuer" field.
+func IssuerGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldIssuer, v))
+}
+
+// IssuerLT applies the LT predicate on the "issuer" field.
+func IssuerLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldIssuer, v))
+}
+
+// IssuerLTE applies the LTE predicate on the "issuer" field.
+func IssuerLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldIssuer, v))
+}
+
+// IssuerContains applies the Contains predicate on the "issuer" field.
+func IssuerContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldIssuer, v))
+}
+
+// IssuerHasPrefix applies the HasPrefix predicate on the "issuer" field.
+func IssuerHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldIssuer, v))
+}
+
+// IssuerHasSuffix applies the HasSuffix predicate on the "issuer" field.
+func IssuerHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldIssuer, v))
+}
+
+// IssuerEqualFold applies the EqualFold predicate on the "issuer" field.
+func IssuerEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldIssuer, v))
+}
+
+// IssuerContainsFold applies the ContainsFold predicate on the "issuer" field.
+func IssuerContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldIssuer, v))
+}
+
+// SerialNumberEQ applies the EQ predicate on the "serial_number" field.
+func SerialNumberEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberNEQ applies the NEQ predicate on the "serial_number" field.
+func SerialNumberNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberIn applies the In predicate on the "serial_number" field.
+func SerialNumberIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberNotIn applies the NotIn predicate on the "serial_number" field.
+func SerialNumberNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberGT applies the GT predicate on the "serial_number" field.
+func SerialNumberGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSerialNumber, v))
+}
+
+// SerialNumberGTE applies the GTE predicate on the "serial_number" field.
+func SerialNumberGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSerialNumber, v))
+}
+
+// SerialNumberLT applies the LT predicate on the "serial_number" field.
+func SerialNumberLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSerialNumber, v))
+}
+
+// SerialNumberLTE applies the LTE predicate on the "serial_number" field.
+func SerialNumberLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSerialNumber, v))
+}
+
+// SerialNumberContains applies the Contains predicate on the "serial_number" field.
+func SerialNumberContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSerialNumber, v))
+}
+
+// SerialNumberHasPrefix applies the HasPrefix predicate on the "serial_number" field.
+func SerialNumberHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSerialNumber, v))
+}
+
+// SerialNumberHasSuffix applies the HasSuffix predicate on the "serial_number" field.
+func SerialNumberHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSerialNumber, v))
+}
+
+// SerialNumberEqualFold applies the EqualFold predicate on the "serial_number" field.
+func SerialNumberEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSerialNumber, v))
+}
+
+// SerialNumberContainsFold applies the ContainsFold predicate on the "serial_number" field.
+func SerialNumberContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSerialNumber, v))
+}
+
+// NotBeforeEQ applies the EQ predicate on the "not_before" field.
+func NotBeforeEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldNotBefore, v))
+}
+
+// NotBeforeNEQ applies the NEQ predicate on the "not_before" field.
+func NotBeforeNEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldNotBefore, v))
+}
+
+// NotBeforeIn applies the In predicate on the "not_before" field.
+func NotBeforeIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldNotBefore, vs...))
+}
+
+// NotBeforeNotIn applies the NotIn predicate on the "not_before" field.
+func NotBeforeNotIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldNotBefore, vs...))
+}
+
+// NotBeforeGT applies the GT predicate on the "not_before" field.
+func NotBeforeGT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldNotBefore, v))
+}
+
+// NotBeforeGTE applies the GTE predicate on the "not_before" field.
+func NotBeforeGTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldNotBefore, v))
+}
+
+// NotBeforeLT applies the LT predicate on the "not_before" field.
+func NotBeforeLT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldNotBefore, v))
+}
+
+// NotBeforeLTE applies the LTE predicate on the "not_before" field.
+func NotBeforeLTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldNotBefore, v))
+}
+
+// NotAfterEQ applies the EQ predicate on the "not_after" field.
+func NotAfterEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldNotAfter, v))
+}
+
+// NotAfterNEQ applies the NEQ predicate on the "not_after" field.
+func NotAfterNEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldNotAfter, v))
+}
+
+// NotAfterIn applies the In predicate on the "not_after" field.
+func NotAfterIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldNotAfter, vs...))
+}
+
+// NotAfterNotIn applies the NotIn predicate on the "not_after" field.
+func NotAfterNotIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldNotAfter, vs...))
+}
+
+// NotAfterGT applies the GT predicate on the "not_after" field.
+func NotAfterGT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldNotAfter, v))
+}
+
+// NotAfterGTE applies the GTE predicate on the "not_after" field.
+func NotAfterGTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldNotAfter, v))
+}
+
+// NotAfterLT applies the LT predicate on the "not_after" field.
+func NotAfterLT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldNotAfter, v))
+}
+
+// NotAfterLTE applies the LTE predicate on the "not_after" field.
+func NotAfterLTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldNotAfter, v))
+}
+
+// SpiffeIDEQ applies the EQ predicate on the "spiffe_id" field.
+func SpiffeIDEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSpiffeID, v))
+}
+
+// SpiffeIDNEQ applies the NEQ predicate on the "spiffe_id" field.
+func SpiffeIDNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSpiffeID, v))
+}
+
+// SpiffeIDIn applies the In predicate on the "spiffe_id" field.
+func SpiffeIDIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSpiffeID, vs...))
+}
+
+// SpiffeIDNotIn applies the NotIn predicate on the "spiffe_id" field.
+func SpiffeIDNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSpiffeID, vs...))
+}
+
+// SpiffeIDGT applies the GT predicate on the "spiffe_id" field.
+func SpiffeIDGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSpiffeID, v))
+}
+
+// SpiffeIDGTE applies the GTE predicate on the "spiffe_id" field.
+func SpiffeIDGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSpiffeID, v))
+}
+
+// SpiffeIDLT applies the LT predicate on the "spiffe_id" field.
+func SpiffeIDLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSpiffeID, v))
+}
+
+// SpiffeIDLTE applies the LTE predicate on the "spiffe_id" field.
+func SpiffeIDLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSpiffeID, v))
+}
+
+// SpiffeIDContains applies the Contains predicate on the "spiffe_id" field.
+func SpiffeIDContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSpiffeID, v))
+}
+
+// SpiffeIDHasPrefix applies the HasPrefix predicate on the "spiffe_id" field.
+func SpiffeIDHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSpiffeID, v))
+}
+
+// SpiffeIDHasSuffix applies the HasSuffix predicate on the "spiffe_id" field.
+func SpiffeIDHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSpiffeID, v))
+}
+
+// SpiffeIDIsNil applies the IsNil predicate on the "spiffe_id" field.
+func SpiffeIDIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldSpiffeID))
+}
+
+// SpiffeIDNotNil applies the NotNil predicate on the "spiffe_id" field.
+func SpiffeIDNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldSpiffeID))
+}
+
+// SpiffeIDEqualFold applies the EqualFold predicate on the "spiffe_id" field.
+func SpiffeIDEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSpiffeID, v))
+}
+
+// SpiffeIDContainsFold applies the ContainsFold predicate on the "spiffe_id" field.
+func SpiffeIDContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSpiffeID, v))
+}
+
+// OidcIssuerEQ applies the EQ predicate on the "oidc_issuer" field.
+func OidcIssuerEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldOidcIssuer, v))
+}
+
+// OidcIssuerNEQ applies the NEQ predicate on the "oidc_issuer" field.
+func OidcIssuerNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldOidcIssuer, v))
+}
+
+// OidcIssuerIn applies the In predicate on the "oidc_issuer" field.
+func OidcIssuerIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldOidcIssuer, vs...))
+}
+
+// OidcIssuerNotIn applies the NotIn predicate on the "oidc_issuer" field.
+func OidcIssuerNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldOidcIssuer, vs...))
+}
+
+// OidcIssuerGT applies the GT predicate on the "oidc_issuer" field.
+func OidcIssuerGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldOidcIssuer, v))
+}
+
+// OidcIssuerGTE applies the GTE predicate on the "oidc_issuer" field.
+func OidcIssuerGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldOidcIssuer, v))
+}
+
+// OidcIssuerLT applies the LT predicate on the "oidc_issuer" field.
+func OidcIssuerLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldOidcIssuer, v))
+}
+
+// OidcIssuerLTE applies the LTE predicate on the "oidc_issuer" field.
+func OidcIssuerLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldOidcIssuer, v))
+}
+
+// OidcIssuerContains applies the Contains predicate on the "oidc_issuer" field.
+func OidcIssuerContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldOidcIssuer, v))
+}
+
+// OidcIssuerHasPrefix applies the HasPrefix predicate on the "oidc_issuer" field.
+func OidcIssuerHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldOidcIssuer, v))
+}
+
+// OidcIssuerHasSuffix applies the HasSuffix predicate on the "oidc_issuer" field.
+func OidcIssuerHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldOidcIssuer, v))
+}
+
+// OidcIssuerIsNil applies the IsNil predicate on the "oidc_issuer" field.
+func OidcIssuerIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldOidcIssuer))
+}
+
+// OidcIssuerNotNil applies the NotNil predicate on the "oidc_issuer" field.
+func OidcIssuerNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldOidcIssuer))
+}
+
+// OidcIssuerEqualFold applies the EqualFold predicate on the "oidc_issuer" field.
+func OidcIssuerEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldOidcIssuer, v))
+}
+
+// OidcIssuerContainsFold applies the ContainsFold predicate on the "oidc_issuer" field.
+func OidcIssuerContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldOidcIssuer, v))
+}
+
+// BuildSignerURIEQ applies the EQ predicate on the "build_signer_uri" field.
+func BuildSignerURIEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURINEQ applies the NEQ predicate on the "build_signer_uri" field.
+func BuildSignerURINEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURIIn applies the In predicate on the "build_signer_uri" field.
+func BuildSignerURIIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldBuildSignerURI, vs...))
+}
+
+// BuildSignerURINotIn applies the NotIn predicate on the "build_signer_uri" field.
+func BuildSignerURINotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldBuildSignerURI, vs...))
+}
+
+// BuildSignerURIGT applies the GT predicate on the "build_signer_uri" field.
+func BuildSignerURIGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURIGTE applies the GTE predicate on the "build_signer_uri" field.
+func BuildSignerURIGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURILT applies the LT predicate on the "build_signer_uri" field.
+func BuildSignerURILT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURILTE applies the LTE predicate on the "build_signer_uri" field.
+func BuildSignerURILTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURIContains applies the Contains predicate on the "build_signer_uri" field.
+func BuildSignerURIContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURIHasPrefix applies the HasPrefix predicate on the "build_signer_uri" field.
+func BuildSignerURIHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURIHasSuffix applies the HasSuffix predicate on the "build_signer_uri" field.
+func BuildSignerURIHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURIIsNil applies the IsNil predicate on the "build_signer_uri" field.
+func BuildSignerURIIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldBuildSignerURI))
+}
+
+// BuildSignerURINotNil applies the NotNil predicate on the "build_signer_uri" field.
+func BuildSignerURINotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldBuildSignerURI))
+}
+
+// BuildSignerURIEqualFold applies the EqualFold predicate on the "build_signer_uri" field.
+func BuildSignerURIEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldBuildSignerURI, v))
+}
+
+// BuildSignerURIContainsFold applies the ContainsFold predicate on the "build_signer_uri" field.
+func BuildSignerURIContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldBuildSignerURI, v))
+}
+
+// BuildSignerDigestEQ applies the EQ predicate on the "build_signer_digest" field.
+func BuildSignerDigestEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestNEQ applies the NEQ predicate on the "build_signer_digest" field.
+func BuildSignerDigestNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestIn applies the In predicate on the "build_signer_digest" field.
+func BuildSignerDigestIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldBuildSignerDigest, vs...))
+}
+
+// BuildSignerDigestNotIn applies the NotIn predicate on the "build_signer_digest" field.
+func BuildSignerDigestNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldBuildSignerDigest, vs...))
+}
+
+// BuildSignerDigestGT applies the GT predicate on the "build_signer_digest" field.
+func BuildSignerDigestGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestGTE applies the GTE predicate on the "build_signer_digest" field.
+func BuildSignerDigestGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestLT applies the LT predicate on the "build_signer_digest" field.
+func BuildSignerDigestLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestLTE applies the LTE predicate on the "build_signer_digest" field.
+func BuildSignerDigestLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestContains applies the Contains predicate on the "build_signer_digest" field.
+func BuildSignerDigestContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestHasPrefix applies the HasPrefix predicate on the "build_signer_digest" field.
+func BuildSignerDigestHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestHasSuffix applies the HasSuffix predicate on the "build_signer_digest" field.
+func BuildSignerDigestHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestIsNil applies the IsNil predicate on the "build_signer_digest" field.
+func BuildSignerDigestIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldBuildSignerDigest))
+}
+
+// BuildSignerDigestNotNil applies the NotNil predicate on the "build_signer_digest" field.
+func BuildSignerDigestNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldBuildSignerDigest))
+}
+
+// BuildSignerDigestEqualFold applies the EqualFold predicate on the "build_signer_digest" field.
+func BuildSignerDigestEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldBuildSignerDigest, v))
+}
+
+// BuildSignerDigestContainsFold applies the ContainsFold predicate on the "build_signer_digest" field.
+func BuildSignerDigestContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldBuildSignerDigest, v))
+}
+
+// RunnerEnvironmentEQ applies the EQ predicate on the "runner_environment" field.
+func RunnerEnvironmentEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentNEQ applies the NEQ predicate on the "runner_environment" field.
+func RunnerEnvironmentNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentIn applies the In predicate on the "runner_environment" field.
+func RunnerEnvironmentIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldRunnerEnvironment, vs...))
+}
+
+// RunnerEnvironmentNotIn applies the NotIn predicate on the "runner_environment" field.
+func RunnerEnvironmentNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldRunnerEnvironment, vs...))
+}
+
+// RunnerEnvironmentGT applies the GT predicate on the "runner_environment" field.
+func RunnerEnvironmentGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentGTE applies the GTE predicate on the "runner_environment" field.
+func RunnerEnvironmentGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentLT applies the LT predicate on the "runner_environment" field.
+func RunnerEnvironmentLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentLTE applies the LTE predicate on the "runner_environment" field.
+func RunnerEnvironmentLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentContains applies the Contains predicate on the "runner_environment" field.
+func RunnerEnvironmentContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentHasPrefix applies the HasPrefix predicate on the "runner_environment" field.
+func RunnerEnvironmentHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentHasSuffix applies the HasSuffix predicate on the "runner_environment" field.
+func RunnerEnvironmentHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentIsNil applies the IsNil predicate on the "runner_environment" field.
+func RunnerEnvironmentIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldRunnerEnvironment))
+}
+
+// RunnerEnvironmentNotNil applies the NotNil predicate on the "runner_environment" field.
+func RunnerEnvironmentNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldRunnerEnvironment))
+}
+
+// RunnerEnvironmentEqualFold applies the EqualFold predicate on the "runner_environment" field.
+func RunnerEnvironmentEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldRunnerEnvironment, v))
+}
+
+// RunnerEnvironmentContainsFold applies the ContainsFold predicate on the "runner_environment" field.
+func RunnerEnvironmentContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldRunnerEnvironment, v))
+}
+
+// SourceRepositoryURIEQ applies the EQ predicate on the "source_repository_uri" field.
+func SourceRepositoryURIEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURINEQ applies the NEQ predicate on the "source_repository_uri" field.
+func SourceRepositoryURINEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURIIn applies the In predicate on the "source_repository_uri" field.
+func SourceRepositoryURIIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSourceRepositoryURI, vs...))
+}
+
+// SourceRepositoryURINotIn applies the NotIn predicate on the "source_repository_uri" field.
+func SourceRepositoryURINotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSourceRepositoryURI, vs...))
+}
+
+// SourceRepositoryURIGT applies the GT predicate on the "source_repository_uri" field.
+func SourceRepositoryURIGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURIGTE applies the GTE predicate on the "source_repository_uri" field.
+func SourceRepositoryURIGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURILT applies the LT predicate on the "source_repository_uri" field.
+func SourceRepositoryURILT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURILTE applies the LTE predicate on the "source_repository_uri" field.
+func SourceRepositoryURILTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURIContains applies the Contains predicate on the "source_repository_uri" field.
+func SourceRepositoryURIContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURIHasPrefix applies the HasPrefix predicate on the "source_repository_uri" field.
+func SourceRepositoryURIHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURIHasSuffix applies the HasSuffix predicate on the "source_repository_uri" field.
+func SourceRepositoryURIHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURIIsNil applies the IsNil predicate on the "source_repository_uri" field.
+func SourceRepositoryURIIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldSourceRepositoryURI))
+}
+
+// SourceRepositoryURINotNil applies the NotNil predicate on the "source_repository_uri" field.
+func SourceRepositoryURINotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldSourceRepositoryURI))
+}
+
+// SourceRepositoryURIEqualFold applies the EqualFold predicate on the "source_repository_uri" field.
+func SourceRepositoryURIEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryURIContainsFold applies the ContainsFold predicate on the "source_repository_uri" field.
+func SourceRepositoryURIContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSourceRepositoryURI, v))
+}
+
+// SourceRepositoryDigestEQ applies the EQ predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestNEQ applies the NEQ predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestIn applies the In predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSourceRepositoryDigest, vs...))
+}
+
+// SourceRepositoryDigestNotIn applies the NotIn predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSourceRepositoryDigest, vs...))
+}
+
+// SourceRepositoryDigestGT applies the GT predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestGTE applies the GTE predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestLT applies the LT predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestLTE applies the LTE predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestContains applies the Contains predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestHasPrefix applies the HasPrefix predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestHasSuffix applies the HasSuffix predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestIsNil applies the IsNil predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldSourceRepositoryDigest))
+}
+
+// SourceRepositoryDigestNotNil applies the NotNil predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldSourceRepositoryDigest))
+}
+
+// SourceRepositoryDigestEqualFold applies the EqualFold predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryDigestContainsFold applies the ContainsFold predicate on the "source_repository_digest" field.
+func SourceRepositoryDigestContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSourceRepositoryDigest, v))
+}
+
+// SourceRepositoryRefEQ applies the EQ predicate on the "source_repository_ref" field.
+func SourceRepositoryRefEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefNEQ applies the NEQ predicate on the "source_repository_ref" field.
+func SourceRepositoryRefNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefIn applies the In predicate on the "source_repository_ref" field.
+func SourceRepositoryRefIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSourceRepositoryRef, vs...))
+}
+
+// SourceRepositoryRefNotIn applies the NotIn predicate on the "source_repository_ref" field.
+func SourceRepositoryRefNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSourceRepositoryRef, vs...))
+}
+
+// SourceRepositoryRefGT applies the GT predicate on the "source_repository_ref" field.
+func SourceRepositoryRefGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefGTE applies the GTE predicate on the "source_repository_ref" field.
+func SourceRepositoryRefGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefLT applies the LT predicate on the "source_repository_ref" field.
+func SourceRepositoryRefLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefLTE applies the LTE predicate on the "source_repository_ref" field.
+func SourceRepositoryRefLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefContains applies the Contains predicate on the "source_repository_ref" field.
+func SourceRepositoryRefContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefHasPrefix applies the HasPrefix predicate on the "source_repository_ref" field.
+func SourceRepositoryRefHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefHasSuffix applies the HasSuffix predicate on the "source_repository_ref" field.
+func SourceRepositoryRefHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefIsNil applies the IsNil predicate on the "source_repository_ref" field.
+func SourceRepositoryRefIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldSourceRepositoryRef))
+}
+
+// SourceRepositoryRefNotNil applies the NotNil predicate on the "source_repository_ref" field.
+func SourceRepositoryRefNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldSourceRepositoryRef))
+}
+
+// SourceRepositoryRefEqualFold applies the EqualFold predicate on the "source_repository_ref" field.
+func SourceRepositoryRefEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSourceRepositoryRef, v))
+}
+
+// SourceRepositoryRefContainsFold applies the ContainsFold predicate on the "source_repository_ref" field.
+func SourceRepositoryRefContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSourceRepositoryRef, v))
+}
+
+// BuildConfigURIEQ applies the EQ predicate on the "build_config_uri" field.
+func BuildConfigURIEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURINEQ applies the NEQ predicate on the "build_config_uri" field.
+func BuildConfigURINEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURIIn applies the In predicate on the "build_config_uri" field.
+func BuildConfigURIIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldBuildConfigURI, vs...))
+}
+
+// BuildConfigURINotIn applies the NotIn predicate on the "build_config_uri" field.
+func BuildConfigURINotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldBuildConfigURI, vs...))
+}
+
+// BuildConfigURIGT applies the GT predicate on the "build_config_uri" field.
+func BuildConfigURIGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURIGTE applies the GTE predicate on the "build_config_uri" field.
+func BuildConfigURIGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURILT applies the LT predicate on the "build_config_uri" field.
+func BuildConfigURILT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURILTE applies the LTE predicate on the "build_config_uri" field.
+func BuildConfigURILTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURIContains applies the Contains predicate on the "build_config_uri" field.
+func BuildConfigURIContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURIHasPrefix applies the HasPrefix predicate on the "build_config_uri" field.
+func BuildConfigURIHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURIHasSuffix applies the HasSuffix predicate on the "build_config_uri" field.
+func BuildConfigURIHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURIIsNil applies the IsNil predicate on the "build_config_uri" field.
+func BuildConfigURIIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldBuildConfigURI))
+}
+
+// BuildConfigURINotNil applies the NotNil predicate on the "build_config_uri" field.
+func BuildConfigURINotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldBuildConfigURI))
+}
+
+// BuildConfigURIEqualFold applies the EqualFold predicate on the "build_config_uri" field.
+func BuildConfigURIEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldBuildConfigURI, v))
+}
+
+// BuildConfigURIContainsFold applies the ContainsFold predicate on the "build_config_uri" field.
+func BuildConfigURIContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldBuildConfigURI, v))
+}
+
+// BuildTriggerEQ applies the EQ predicate on the "build_trigger" field.
+func BuildTriggerEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldBuildTrigger, v))
+}
+
+// BuildTriggerNEQ applies the NEQ predicate on the "build_trigger" field.
+func BuildTriggerNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldBuildTrigger, v))
+}
+
+// BuildTriggerIn applies the In predicate on the "build_trigger" field.
+func BuildTriggerIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldBuildTrigger, vs...))
+}
+
+// BuildTriggerNotIn applies the NotIn predicate on the "build_trigger" field.
+func BuildTriggerNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldBuildTrigger, vs...))
+}
+
+// BuildTriggerGT applies the GT predicate on the "build_trigger" field.
+func BuildTriggerGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldBuildTrigger, v))
+}
+
+// BuildTriggerGTE applies the GTE predicate on the "build_trigger" field.
+func BuildTriggerGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldBuildTrigger, v))
+}
+
+// BuildTriggerLT applies the LT predicate on the "build_trigger" field.
+func BuildTriggerLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldBuildTrigger, v))
+}
+
+// BuildTriggerLTE applies the LTE predicate on the "build_trigger" field.
+func BuildTriggerLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldBuildTrigger, v))
+}
+
+// BuildTriggerContains applies the Contains predicate on the "build_trigger" field.
+func BuildTriggerContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldBuildTrigger, v))
+}
+
+// BuildTriggerHasPrefix applies the HasPrefix predicate on the "build_trigger" field.
+func BuildTriggerHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldBuildTrigger, v))
+}
+
+// BuildTriggerHasSuffix applies the HasSuffix predicate on the "build_trigger" field.
+func BuildTriggerHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldBuildTrigger, v))
+}
+
+// BuildTriggerIsNil applies the IsNil predicate on the "build_trigger" field.
+func BuildTriggerIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldBuildTrigger))
+}
+
+// BuildTriggerNotNil applies the NotNil predicate on the "build_trigger" field.
+func BuildTriggerNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldBuildTrigger))
+}
+
+// BuildTriggerEqualFold applies the EqualFold predicate on the "build_trigger" field.
+func BuildTriggerEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldBuildTrigger, v))
+}
+
+// BuildTriggerContainsFold applies the ContainsFold predicate on the "build_trigger" field.
+func BuildTriggerContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldBuildTrigger, v))
+}
+
+// RunInvocationURIEQ applies the EQ predicate on the "run_invocation_uri" field.
+func RunInvocationURIEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURINEQ applies the NEQ predicate on the "run_invocation_uri" field.
+func RunInvocationURINEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURIIn applies the In predicate on the "run_invocation_uri" field.
+func RunInvocationURIIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldRunInvocationURI, vs...))
+}
+
+// RunInvocationURINotIn applies the NotIn predicate on the "run_invocation_uri" field.
+func RunInvocationURINotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldRunInvocationURI, vs...))
+}
+
+// RunInvocationURIGT applies the GT predicate on the "run_invocation_uri" field.
+func RunInvocationURIGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURIGTE applies the GTE predicate on the "run_invocation_uri" field.
+func RunInvocationURIGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURILT applies the LT predicate on the "run_invocation_uri" field.
+func RunInvocationURILT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURILTE applies the LTE predicate on the "run_invocation_uri" field.
+func RunInvocationURILTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURIContains applies the Contains predicate on the "run_invocation_uri" field.
+func RunInvocationURIContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURIHasPrefix applies the HasPrefix predicate on the "run_invocation_uri" field.
+func RunInvocationURIHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURIHasSuffix applies the HasSuffix predicate on the "run_invocation_uri" field.
+func RunInvocationURIHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURIIsNil applies the IsNil predicate on the "run_invocation_uri" field.
+func RunInvocationURIIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldRunInvocationURI))
+}
+
+// RunInvocationURINotNil applies the NotNil predicate on the "run_invocation_uri" field.
+func RunInvocationURINotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldRunInvocationURI))
+}
+
+// RunInvocationURIEqualFold applies the EqualFold predicate on the "run_invocation_uri" field.
+func RunInvocationURIEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldRunInvocationURI, v))
+}
+
+// RunInvocationURIContainsFold applies the ContainsFold predicate on the "run_invocation_uri" field.
+func RunInvocationURIContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldRunInvocationURI, v))
+}
+
+// HasSubjectAltNames applies the HasEdge predicate on the "subject_alt_names" edge.
+func HasSubjectAltNames() predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SubjectAltNamesTable, SubjectAltNamesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubjectAltNamesWith applies the HasEdge predicate on the "subject_alt_names" edge with a given conditions (other predicates).
+func HasSubjectAltNamesWith(preds ...predicate.SubjectAltName) predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := newSubjectAltNamesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSignature applies the HasEdge predicate on the "signature" edge.
+func HasSignature() predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SignatureTable, SignatureColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSignatureWith applies the HasEdge predicate on the "signature" edge with a given conditions (other predicates).
+func HasSignatureWith(preds ...predicate.Signature) predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := newSignatureStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Certificate) predicate.Certificate {
+	return predicate.Certificate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Certificate) predicate.Certificate {
+	return predicate.Certificate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Certificate) predicate.Certificate {
+	return predicate.Certificate(sql.NotPredicates(p))
+}
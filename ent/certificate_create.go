@@ -0,0 +1,582 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+)
+
+// CertificateCreate is the builder for creating a Certificate entity.
+type CertificateCreate struct {
+	config
+	mutation *CertificateMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *CertificateCreate) SetTenant(v string) *CertificateCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableTenant(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetChainIndex sets the "chain_index" field.
+func (_c *CertificateCreate) SetChainIndex(v int) *CertificateCreate {
+	_c.mutation.SetChainIndex(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *CertificateCreate) SetSubject(v string) *CertificateCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetIssuer sets the "issuer" field.
+func (_c *CertificateCreate) SetIssuer(v string) *CertificateCreate {
+	_c.mutation.SetIssuer(v)
+	return _c
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (_c *CertificateCreate) SetSerialNumber(v string) *CertificateCreate {
+	_c.mutation.SetSerialNumber(v)
+	return _c
+}
+
+// SetNotBefore sets the "not_before" field.
+func (_c *CertificateCreate) SetNotBefore(v time.Time) *CertificateCreate {
+	_c.mutation.SetNotBefore(v)
+	return _c
+}
+
+// SetNotAfter sets the "not_after" field.
+func (_c *CertificateCreate) SetNotAfter(v time.Time) *CertificateCreate {
+	_c.mutation.SetNotAfter(v)
+	return _c
+}
+
+// SetSpiffeID sets the "spiffe_id" field.
+func (_c *CertificateCreate) SetSpiffeID(v string) *CertificateCreate {
+	_c.mutation.SetSpiffeID(v)
+	return _c
+}
+
+// SetNillableSpiffeID sets the "spiffe_id" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableSpiffeID(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetSpiffeID(*v)
+	}
+	return _c
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (_c *CertificateCreate) SetOidcIssuer(v string) *CertificateCreate {
+	_c.mutation.SetOidcIssuer(v)
+	return _c
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableOidcIssuer(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetOidcIssuer(*v)
+	}
+	return _c
+}
+
+// SetBuildSignerURI sets the "build_signer_uri" field.
+func (_c *CertificateCreate) SetBuildSignerURI(v string) *CertificateCreate {
+	_c.mutation.SetBuildSignerURI(v)
+	return _c
+}
+
+// SetNillableBuildSignerURI sets the "build_signer_uri" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableBuildSignerURI(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetBuildSignerURI(*v)
+	}
+	return _c
+}
+
+// SetBuildSignerDigest sets the "build_signer_digest" field.
+func (_c *CertificateCreate) SetBuildSignerDigest(v string) *CertificateCreate {
+	_c.mutation.SetBuildSignerDigest(v)
+	return _c
+}
+
+// SetNillableBuildSignerDigest sets the "build_signer_digest" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableBuildSignerDigest(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetBuildSignerDigest(*v)
+	}
+	return _c
+}
+
+// SetRunnerEnvironment sets the "runner_environment" field.
+func (_c *CertificateCreate) SetRunnerEnvironment(v string) *CertificateCreate {
+	_c.mutation.SetRunnerEnvironment(v)
+	return _c
+}
+
+// SetNillableRunnerEnvironment sets the "runner_environment" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableRunnerEnvironment(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetRunnerEnvironment(*v)
+	}
+	return _c
+}
+
+// SetSourceRepositoryURI sets the "source_repository_uri" field.
+func (_c *CertificateCreate) SetSourceRepositoryURI(v string) *CertificateCreate {
+	_c.mutation.SetSourceRepositoryURI(v)
+	return _c
+}
+
+// SetNillableSourceRepositoryURI sets the "source_repository_uri" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableSourceRepositoryURI(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetSourceRepositoryURI(*v)
+	}
+	return _c
+}
+
+// SetSourceRepositoryDigest sets the "source_repository_digest" field.
+func (_c *CertificateCreate) SetSourceRepositoryDigest(v string) *CertificateCreate {
+	_c.mutation.SetSourceRepositoryDigest(v)
+	return _c
+}
+
+// SetNillableSourceRepositoryDigest sets the "source_repository_digest" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableSourceRepositoryDigest(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetSourceRepositoryDigest(*v)
+	}
+	return _c
+}
+
+// SetSourceRepositoryRef sets the "source_repository_ref" field.
+func (_c *CertificateCreate) SetSourceRepositoryRef(v string) *CertificateCreate {
+	_c.mutation.SetSourceRepositoryRef(v)
+	return _c
+}
+
+// SetNillableSourceRepositoryRef sets the "source_repository_ref" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableSourceRepositoryRef(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetSourceRepositoryRef(*v)
+	}
+	return _c
+}
+
+// SetBuildConfigURI sets the "build_config_uri" field.
+func (_c *CertificateCreate) SetBuildConfigURI(v string) *CertificateCreate {
+	_c.mutation.SetBuildConfigURI(v)
+	return _c
+}
+
+// SetNillableBuildConfigURI sets the "build_config_uri" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableBuildConfigURI(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetBuildConfigURI(*v)
+	}
+	return _c
+}
+
+// SetBuildTrigger sets the "build_trigger" field.
+func (_c *CertificateCreate) SetBuildTrigger(v string) *CertificateCreate {
+	_c.mutation.SetBuildTrigger(v)
+	return _c
+}
+
+// SetNillableBuildTrigger sets the "build_trigger" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableBuildTrigger(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetBuildTrigger(*v)
+	}
+	return _c
+}
+
+// SetRunInvocationURI sets the "run_invocation_uri" field.
+func (_c *CertificateCreate) SetRunInvocationURI(v string) *CertificateCreate {
+	_c.mutation.SetRunInvocationURI(v)
+	return _c
+}
+
+// SetNillableRunInvocationURI sets the "run_invocation_uri" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableRunInvocationURI(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetRunInvocationURI(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CertificateCreate) SetID(v uuid.UUID) *CertificateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableID(v *uuid.UUID) *CertificateCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddSubjectAltNameIDs adds the "subject_alt_names" edge to the SubjectAltName entity by IDs.
+func (_c *CertificateCreate) AddSubjectAltNameIDs(ids ...uuid.UUID) *CertificateCreate {
+	_c.mutation.AddSubjectAltNameIDs(ids...)
+	return _c
+}
+
+// AddSubjectAltNames adds the "subject_alt_names" edges to the SubjectAltName entity.
+func (_c *CertificateCreate) AddSubjectAltNames(v ...*SubjectAltName) *CertificateCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSubjectAltNameIDs(ids...)
+}
+
+// SetSignatureID sets the "signature" edge to the Signature entity by ID.
+func (_c *CertificateCreate) SetSignatureID(id uuid.UUID) *CertificateCreate {
+	_c.mutation.SetSignatureID(id)
+	return _c
+}
+
+// SetSignature sets the "signature" edge to the Signature entity.
+func (_c *CertificateCreate) SetSignature(v *Signature) *CertificateCreate {
+	return _c.SetSignatureID(v.ID)
+}
+
+// Mutation returns the CertificateMutation object of the builder.
+func (_c *CertificateCreate) Mutation() *CertificateMutation {
+	return _c.mutation
+}
+
+// Save creates the Certificate in the database.
+func (_c *CertificateCreate) Save(ctx context.Context) (*Certificate, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CertificateCreate) SaveX(ctx context.Context) *Certificate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CertificateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CertificateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CertificateCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := certificate.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if certificate.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized certificate.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := certificate.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CertificateCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Certificate.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := certificate.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "Certificate.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChainIndex(); !ok {
+		return &ValidationError{Name: "chain_index", err: errors.New(`ent: missing required field "Certificate.chain_index"`)}
+	}
+	if v, ok := _c.mutation.ChainIndex(); ok {
+		if err := certificate.ChainIndexValidator(v); err != nil {
+			return &ValidationError{Name: "chain_index", err: fmt.Errorf(`ent: validator failed for field "Certificate.chain_index": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "Certificate.subject"`)}
+	}
+	if _, ok := _c.mutation.Issuer(); !ok {
+		return &ValidationError{Name: "issuer", err: errors.New(`ent: missing required field "Certificate.issuer"`)}
+	}
+	if _, ok := _c.mutation.SerialNumber(); !ok {
+		return &ValidationError{Name: "serial_number", err: errors.New(`ent: missing required field "Certificate.serial_number"`)}
+	}
+	if _, ok := _c.mutation.NotBefore(); !ok {
+		return &ValidationError{Name: "not_before", err: errors.New(`ent: missing required field "Certificate.not_before"`)}
+	}
+	if _, ok := _c.mutation.NotAfter(); !ok {
+		return &ValidationError{Name: "not_after", err: errors.New(`ent: missing required field "Certificate.not_after"`)}
+	}
+	if len(_c.mutation.SignatureIDs()) == 0 {
+		return &ValidationError{Name: "signature", err: errors.New(`ent: missing required edge "Certificate.signature"`)}
+	}
+	return nil
+}
+
+func (_c *CertificateCreate) sqlSave(ctx context.Context) (*Certificate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CertificateCreate) createSpec() (*Certificate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Certificate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(certificate.Table, sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(certificate.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.ChainIndex(); ok {
+		_spec.SetField(certificate.FieldChainIndex, field.TypeInt, value)
+		_node.ChainIndex = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(certificate.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Issuer(); ok {
+		_spec.SetField(certificate.FieldIssuer, field.TypeString, value)
+		_node.Issuer = value
+	}
+	if value, ok := _c.mutation.SerialNumber(); ok {
+		_spec.SetField(certificate.FieldSerialNumber, field.TypeString, value)
+		_node.SerialNumber = value
+	}
+	if value, ok := _c.mutation.NotBefore(); ok {
+		_spec.SetField(certificate.FieldNotBefore, field.TypeTime, value)
+		_node.NotBefore = value
+	}
+	if value, ok := _c.mutation.NotAfter(); ok {
+		_spec.SetField(certificate.FieldNotAfter, field.TypeTime, value)
+		_node.NotAfter = value
+	}
+	if value, ok := _c.mutation.SpiffeID(); ok {
+		_spec.SetField(certificate.FieldSpiffeID, field.TypeString, value)
+		_node.SpiffeID = value
+	}
+	if value, ok := _c.mutation.OidcIssuer(); ok {
+		_spec.SetField(certificate.FieldOidcIssuer, field.TypeString, value)
+		_node.OidcIssuer = value
+	}
+	if value, ok := _c.mutation.BuildSignerURI(); ok {
+		_spec.SetField(certificate.FieldBuildSignerURI, field.TypeString, value)
+		_node.BuildSignerURI = value
+	}
+	if value, ok := _c.mutation.BuildSignerDigest(); ok {
+		_spec.SetField(certificate.FieldBuildSignerDigest, field.TypeString, value)
+		_node.BuildSignerDigest = value
+	}
+	if value, ok := _c.mutation.RunnerEnvironment(); ok {
+		_spec.SetField(certificate.FieldRunnerEnvironment, field.TypeString, value)
+		_node.RunnerEnvironment = value
+	}
+	if value, ok := _c.mutation.SourceRepositoryURI(); ok {
+		_spec.SetField(certificate.FieldSourceRepositoryURI, field.TypeString, value)
+		_node.SourceRepositoryURI = value
+	}
+	if value, ok := _c.mutation.SourceRepositoryDigest(); ok {
+		_spec.SetField(certificate.FieldSourceRepositoryDigest, field.TypeString, value)
+		_node.SourceRepositoryDigest = value
+	}
+	if value, ok := _c.mutation.SourceRepositoryRef(); ok {
+		_spec.SetField(certificate.FieldSourceRepositoryRef, field.TypeString, value)
+		_node.SourceRepositoryRef = value
+	}
+	if value, ok := _c.mutation.BuildConfigURI(); ok {
+		_spec.SetField(certificate.FieldBuildConfigURI, field.TypeString, value)
+		_node.BuildConfigURI = value
+	}
+	if value, ok := _c.mutation.BuildTrigger(); ok {
+		_spec.SetField(certificate.FieldBuildTrigger, field.TypeString, value)
+		_node.BuildTrigger = value
+	}
+	if value, ok := _c.mutation.RunInvocationURI(); ok {
+		_spec.SetField(certificate.FieldRunInvocationURI, field.TypeString, value)
+		_node.RunInvocationURI = value
+	}
+	if nodes := _c.mutation.SubjectAltNamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.SubjectAltNamesTable,
+			Columns: []string{certificate.SubjectAltNamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SignatureIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   certificate.SignatureTable,
+			Columns: []string{certificate.SignatureColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signature.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.signature_certificates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CertificateCreateBulk is the builder for creating many Certificate entities in bulk.
+type CertificateCreateBulk struct {
+	config
+	err      error
+	builders []*CertificateCreate
+}
+
+// Save creates the Certificate entities in the database.
+func (_c *CertificateCreateBulk) Save(ctx context.Context) ([]*Certificate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Certificate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CertificateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CertificateCreateBulk) SaveX(ctx context.Context) []*Certificate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CertificateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CertificateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
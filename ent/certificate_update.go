@@ -0,0 +1,1261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+)
+
+// CertificateUpdate is the builder for updating Certificate entities.
+type CertificateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CertificateMutation
+}
+
+// Where appends a list predicates to the CertificateUpdate builder.
+func (_u *CertificateUpdate) Where(ps ...predicate.Certificate) *CertificateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChainIndex sets the "chain_index" field.
+func (_u *CertificateUpdate) SetChainIndex(v int) *CertificateUpdate {
+	_u.mutation.ResetChainIndex()
+	_u.mutation.SetChainIndex(v)
+	return _u
+}
+
+// SetNillableChainIndex sets the "chain_index" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableChainIndex(v *int) *CertificateUpdate {
+	if v != nil {
+		_u.SetChainIndex(*v)
+	}
+	return _u
+}
+
+// AddChainIndex adds value to the "chain_index" field.
+func (_u *CertificateUpdate) AddChainIndex(v int) *CertificateUpdate {
+	_u.mutation.AddChainIndex(v)
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *CertificateUpdate) SetSubject(v string) *CertificateUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableSubject(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetIssuer sets the "issuer" field.
+func (_u *CertificateUpdate) SetIssuer(v string) *CertificateUpdate {
+	_u.mutation.SetIssuer(v)
+	return _u
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableIssuer(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetIssuer(*v)
+	}
+	return _u
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (_u *CertificateUpdate) SetSerialNumber(v string) *CertificateUpdate {
+	_u.mutation.SetSerialNumber(v)
+	return _u
+}
+
+// SetNillableSerialNumber sets the "serial_number" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableSerialNumber(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetSerialNumber(*v)
+	}
+	return _u
+}
+
+// SetNotBefore sets the "not_before" field.
+func (_u *CertificateUpdate) SetNotBefore(v time.Time) *CertificateUpdate {
+	_u.mutation.SetNotBefore(v)
+	return _u
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableNotBefore(v *time.Time) *CertificateUpdate {
+	if v != nil {
+		_u.SetNotBefore(*v)
+	}
+	return _u
+}
+
+// SetNotAfter sets the "not_after" field.
+func (_u *CertificateUpdate) SetNotAfter(v time.Time) *CertificateUpdate {
+	_u.mutation.SetNotAfter(v)
+	return _u
+}
+
+// SetNillableNotAfter sets the "not_after" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableNotAfter(v *time.Time) *CertificateUpdate {
+	if v != nil {
+		_u.SetNotAfter(*v)
+	}
+	return _u
+}
+
+// SetSpiffeID sets the "spiffe_id" field.
+func (_u *CertificateUpdate) SetSpiffeID(v string) *CertificateUpdate {
+	_u.mutation.SetSpiffeID(v)
+	return _u
+}
+
+// SetNillableSpiffeID sets the "spiffe_id" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableSpiffeID(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetSpiffeID(*v)
+	}
+	return _u
+}
+
+// ClearSpiffeID clears the value of the "spiffe_id" field.
+func (_u *CertificateUpdate) ClearSpiffeID() *CertificateUpdate {
+	_u.mutation.ClearSpiffeID()
+	return _u
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (_u *CertificateUpdate) SetOidcIssuer(v string) *CertificateUpdate {
+	_u.mutation.SetOidcIssuer(v)
+	return _u
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableOidcIssuer(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetOidcIssuer(*v)
+	}
+	return _u
+}
+
+// ClearOidcIssuer clears the value of the "oidc_issuer" field.
+func (_u *CertificateUpdate) ClearOidcIssuer() *CertificateUpdate {
+	_u.mutation.ClearOidcIssuer()
+	return _u
+}
+
+// SetBuildSignerURI sets the "build_signer_uri" field.
+func (_u *CertificateUpdate) SetBuildSignerURI(v string) *CertificateUpdate {
+	_u.mutation.SetBuildSignerURI(v)
+	return _u
+}
+
+// SetNillableBuildSignerURI sets the "build_signer_uri" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableBuildSignerURI(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetBuildSignerURI(*v)
+	}
+	return _u
+}
+
+// ClearBuildSignerURI clears the value of the "build_signer_uri" field.
+func (_u *CertificateUpdate) ClearBuildSignerURI() *CertificateUpdate {
+	_u.mutation.ClearBuildSignerURI()
+	return _u
+}
+
+// SetBuildSignerDigest sets the "build_signer_digest" field.
+func (_u *CertificateUpdate) SetBuildSignerDigest(v string) *CertificateUpdate {
+	_u.mutation.SetBuildSignerDigest(v)
+	return _u
+}
+
+// SetNillableBuildSignerDigest sets the "build_signer_digest" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableBuildSignerDigest(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetBuildSignerDigest(*v)
+	}
+	return _u
+}
+
+// ClearBuildSignerDigest clears the value of the "build_signer_digest" field.
+func (_u *CertificateUpdate) ClearBuildSignerDigest() *CertificateUpdate {
+	_u.mutation.ClearBuildSignerDigest()
+	return _u
+}
+
+// SetRunnerEnvironment sets the "runner_environment" field.
+func (_u *CertificateUpdate) SetRunnerEnvironment(v string) *CertificateUpdate {
+	_u.mutation.SetRunnerEnvironment(v)
+	return _u
+}
+
+// SetNillableRunnerEnvironment sets the "runner_environment" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableRunnerEnvironment(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetRunnerEnvironment(*v)
+	}
+	return _u
+}
+
+// ClearRunnerEnvironment clears the value of the "runner_environment" field.
+func (_u *CertificateUpdate) ClearRunnerEnvironment() *CertificateUpdate {
+	_u.mutation.ClearRunnerEnvironment()
+	return _u
+}
+
+// SetSourceRepositoryURI sets the "source_repository_uri" field.
+func (_u *CertificateUpdate) SetSourceRepositoryURI(v string) *CertificateUpdate {
+	_u.mutation.SetSourceRepositoryURI(v)
+	return _u
+}
+
+// SetNillableSourceRepositoryURI sets the "source_repository_uri" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableSourceRepositoryURI(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetSourceRepositoryURI(*v)
+	}
+	return _u
+}
+
+// ClearSourceRepositoryURI clears the value of the "source_repository_uri" field.
+func (_u *CertificateUpdate) ClearSourceRepositoryURI() *CertificateUpdate {
+	_u.mutation.ClearSourceRepositoryURI()
+	return _u
+}
+
+// SetSourceRepositoryDigest sets the "source_repository_digest" field.
+func (_u *CertificateUpdate) SetSourceRepositoryDigest(v string) *CertificateUpdate {
+	_u.mutation.SetSourceRepositoryDigest(v)
+	return _u
+}
+
+// SetNillableSourceRepositoryDigest sets the "source_repository_digest" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableSourceRepositoryDigest(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetSourceRepositoryDigest(*v)
+	}
+	return _u
+}
+
+// ClearSourceRepositoryDigest clears the value of the "source_repository_digest" field.
+func (_u *CertificateUpdate) ClearSourceRepositoryDigest() *CertificateUpdate {
+	_u.mutation.ClearSourceRepositoryDigest()
+	return _u
+}
+
+// SetSourceRepositoryRef sets the "source_repository_ref" field.
+func (_u *CertificateUpdate) SetSourceRepositoryRef(v string) *CertificateUpdate {
+	_u.mutation.SetSourceRepositoryRef(v)
+	return _u
+}
+
+// SetNillableSourceRepositoryRef sets the "source_repository_ref" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableSourceRepositoryRef(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetSourceRepositoryRef(*v)
+	}
+	return _u
+}
+
+// ClearSourceRepositoryRef clears the value of the "source_repository_ref" field.
+func (_u *CertificateUpdate) ClearSourceRepositoryRef() *CertificateUpdate {
+	_u.mutation.ClearSourceRepositoryRef()
+	return _u
+}
+
+// SetBuildConfigURI sets the "build_config_uri" field.
+func (_u *CertificateUpdate) SetBuildConfigURI(v string) *CertificateUpdate {
+	_u.mutation.SetBuildConfigURI(v)
+	return _u
+}
+
+// SetNillableBuildConfigURI sets the "build_config_uri" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableBuildConfigURI(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetBuildConfigURI(*v)
+	}
+	return _u
+}
+
+// ClearBuildConfigURI clears the value of the "build_config_uri" field.
+func (_u *CertificateUpdate) ClearBuildConfigURI() *CertificateUpdate {
+	_u.mutation.ClearBuildConfigURI()
+	return _u
+}
+
+// SetBuildTrigger sets the "build_trigger" field.
+func (_u *CertificateUpdate) SetBuildTrigger(v string) *CertificateUpdate {
+	_u.mutation.SetBuildTrigger(v)
+	return _u
+}
+
+// SetNillableBuildTrigger sets the "build_trigger" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableBuildTrigger(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetBuildTrigger(*v)
+	}
+	return _u
+}
+
+// ClearBuildTrigger clears the value of the "build_trigger" field.
+func (_u *CertificateUpdate) ClearBuildTrigger() *CertificateUpdate {
+	_u.mutation.ClearBuildTrigger()
+	return _u
+}
+
+// SetRunInvocationURI sets the "run_invocation_uri" field.
+func (_u *CertificateUpdate) SetRunInvocationURI(v string) *CertificateUpdate {
+	_u.mutation.SetRunInvocationURI(v)
+	return _u
+}
+
+// SetNillableRunInvocationURI sets the "run_invocation_uri" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableRunInvocationURI(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetRunInvocationURI(*v)
+	}
+	return _u
+}
+
+// ClearRunInvocationURI clears the value of the "run_invocation_uri" field.
+func (_u *CertificateUpdate) ClearRunInvocationURI() *CertificateUpdate {
+	_u.mutation.ClearRunInvocationURI()
+	return _u
+}
+
+// AddSubjectAltNameIDs adds the "subject_alt_names" edge to the SubjectAltName entity by IDs.
+func (_u *CertificateUpdate) AddSubjectAltNameIDs(ids ...uuid.UUID) *CertificateUpdate {
+	_u.mutation.AddSubjectAltNameIDs(ids...)
+	return _u
+}
+
+// AddSubjectAltNames adds the "subject_alt_names" edges to the SubjectAltName entity.
+func (_u *CertificateUpdate) AddSubjectAltNames(v ...*SubjectAltName) *CertificateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubjectAltNameIDs(ids...)
+}
+
+// SetSignatureID sets the "signature" edge to the Signature entity by ID.
+func (_u *CertificateUpdate) SetSignatureID(id uuid.UUID) *CertificateUpdate {
+	_u.mutation.SetSignatureID(id)
+	return _u
+}
+
+// SetSignature sets the "signature" edge to the Signature entity.
+func (_u *CertificateUpdate) SetSignature(v *Signature) *CertificateUpdate {
+	return _u.SetSignatureID(v.ID)
+}
+
+// Mutation returns the CertificateMutation object of the builder.
+func (_u *CertificateUpdate) Mutation() *CertificateMutation {
+	return _u.mutation
+}
+
+// ClearSubjectAltNames clears all "subject_alt_names" edges to the SubjectAltName entity.
+func (_u *CertificateUpdate) ClearSubjectAltNames() *CertificateUpdate {
+	_u.mutation.ClearSubjectAltNames()
+	return _u
+}
+
+// RemoveSubjectAltNameIDs removes the "subject_alt_names" edge to SubjectAltName entities by IDs.
+func (_u *CertificateUpdate) RemoveSubjectAltNameIDs(ids ...uuid.UUID) *CertificateUpdate {
+	_u.mutation.RemoveSubjectAltNameIDs(ids...)
+	return _u
+}
+
+// RemoveSubjectAltNames removes "subject_alt_names" edges to SubjectAltName entities.
+func (_u *CertificateUpdate) RemoveSubjectAltNames(v ...*SubjectAltName) *CertificateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSubjectAltNameIDs(ids...)
+}
+
+// ClearSignature clears the "signature" edge to the Signature entity.
+func (_u *CertificateUpdate) ClearSignature() *CertificateUpdate {
+	_u.mutation.ClearSignature()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CertificateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CertificateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CertificateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CertificateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CertificateUpdate) check() error {
+	if v, ok := _u.mutation.ChainIndex(); ok {
+		if err := certificate.ChainIndexValidator(v); err != nil {
+			return &ValidationError{Name: "chain_index", err: fmt.Errorf(`ent: validator failed for field "Certificate.chain_index": %w`, err)}
+		}
+	}
+	if _u.mutation.SignatureCleared() && len(_u.mutation.SignatureIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Certificate.signature"`)
+	}
+	return nil
+}
+
+func (_u *CertificateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(certificate.Table, certificate.Columns, sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChainIndex(); ok {
+		_spec.SetField(certificate.FieldChainIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChainIndex(); ok {
+		_spec.AddField(certificate.FieldChainIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(certificate.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Issuer(); ok {
+		_spec.SetField(certificate.FieldIssuer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SerialNumber(); ok {
+		_spec.SetField(certificate.FieldSerialNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NotBefore(); ok {
+		_spec.SetField(certificate.FieldNotBefore, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.NotAfter(); ok {
+		_spec.SetField(certificate.FieldNotAfter, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SpiffeID(); ok {
+		_spec.SetField(certificate.FieldSpiffeID, field.TypeString, value)
+	}
+	if _u.mutation.SpiffeIDCleared() {
+		_spec.ClearField(certificate.FieldSpiffeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OidcIssuer(); ok {
+		_spec.SetField(certificate.FieldOidcIssuer, field.TypeString, value)
+	}
+	if _u.mutation.OidcIssuerCleared() {
+		_spec.ClearField(certificate.FieldOidcIssuer, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildSignerURI(); ok {
+		_spec.SetField(certificate.FieldBuildSignerURI, field.TypeString, value)
+	}
+	if _u.mutation.BuildSignerURICleared() {
+		_spec.ClearField(certificate.FieldBuildSignerURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildSignerDigest(); ok {
+		_spec.SetField(certificate.FieldBuildSignerDigest, field.TypeString, value)
+	}
+	if _u.mutation.BuildSignerDigestCleared() {
+		_spec.ClearField(certificate.FieldBuildSignerDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerEnvironment(); ok {
+		_spec.SetField(certificate.FieldRunnerEnvironment, field.TypeString, value)
+	}
+	if _u.mutation.RunnerEnvironmentCleared() {
+		_spec.ClearField(certificate.FieldRunnerEnvironment, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceRepositoryURI(); ok {
+		_spec.SetField(certificate.FieldSourceRepositoryURI, field.TypeString, value)
+	}
+	if _u.mutation.SourceRepositoryURICleared() {
+		_spec.ClearField(certificate.FieldSourceRepositoryURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceRepositoryDigest(); ok {
+		_spec.SetField(certificate.FieldSourceRepositoryDigest, field.TypeString, value)
+	}
+	if _u.mutation.SourceRepositoryDigestCleared() {
+		_spec.ClearField(certificate.FieldSourceRepositoryDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceRepositoryRef(); ok {
+		_spec.SetField(certificate.FieldSourceRepositoryRef, field.TypeString, value)
+	}
+	if _u.mutation.SourceRepositoryRefCleared() {
+		_spec.ClearField(certificate.FieldSourceRepositoryRef, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildConfigURI(); ok {
+		_spec.SetField(certificate.FieldBuildConfigURI, field.TypeString, value)
+	}
+	if _u.mutation.BuildConfigURICleared() {
+		_spec.ClearField(certificate.FieldBuildConfigURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildTrigger(); ok {
+		_spec.SetField(certificate.FieldBuildTrigger, field.TypeString, value)
+	}
+	if _u.mutation.BuildTriggerCleared() {
+		_spec.ClearField(certificate.FieldBuildTrigger, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunInvocationURI(); ok {
+		_spec.SetField(certificate.FieldRunInvocationURI, field.TypeString, value)
+	}
+	if _u.mutation.RunInvocationURICleared() {
+		_spec.ClearField(certificate.FieldRunInvocationURI, field.TypeString)
+	}
+	if _u.mutation.SubjectAltNamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.SubjectAltNamesTable,
+			Columns: []string{certificate.SubjectAltNamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSubjectAltNamesIDs(); len(nodes) > 0 && !_u.mutation.SubjectAltNamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.SubjectAltNamesTable,
+			Columns: []string{certificate.SubjectAltNamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubjectAltNamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.SubjectAltNamesTable,
+			Columns: []string{certificate.SubjectAltNamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SignatureCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   certificate.SignatureTable,
+			Columns: []string{certificate.SignatureColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signature.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SignatureIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   certificate.SignatureTable,
+			Columns: []string{certificate.SignatureColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signature.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certificate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CertificateUpdateOne is the builder for updating a single Certificate entity.
+type CertificateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CertificateMutation
+}
+
+// SetChainIndex sets the "chain_index" field.
+func (_u *CertificateUpdateOne) SetChainIndex(v int) *CertificateUpdateOne {
+	_u.mutation.ResetChainIndex()
+	_u.mutation.SetChainIndex(v)
+	return _u
+}
+
+// SetNillableChainIndex sets the "chain_index" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableChainIndex(v *int) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetChainIndex(*v)
+	}
+	return _u
+}
+
+// AddChainIndex adds value to the "chain_index" field.
+func (_u *CertificateUpdateOne) AddChainIndex(v int) *CertificateUpdateOne {
+	_u.mutation.AddChainIndex(v)
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *CertificateUpdateOne) SetSubject(v string) *CertificateUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableSubject(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetIssuer sets the "issuer" field.
+func (_u *CertificateUpdateOne) SetIssuer(v string) *CertificateUpdateOne {
+	_u.mutation.SetIssuer(v)
+	return _u
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableIssuer(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetIssuer(*v)
+	}
+	return _u
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (_u *CertificateUpdateOne) SetSerialNumber(v string) *CertificateUpdateOne {
+	_u.mutation.SetSerialNumber(v)
+	return _u
+}
+
+// SetNillableSerialNumber sets the "serial_number" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableSerialNumber(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetSerialNumber(*v)
+	}
+	return _u
+}
+
+// SetNotBefore sets the "not_before" field.
+func (_u *CertificateUpdateOne) SetNotBefore(v time.Time) *CertificateUpdateOne {
+	_u.mutation.SetNotBefore(v)
+	return _u
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableNotBefore(v *time.Time) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetNotBefore(*v)
+	}
+	return _u
+}
+
+// SetNotAfter sets the "not_after" field.
+func (_u *CertificateUpdateOne) SetNotAfter(v time.Time) *CertificateUpdateOne {
+	_u.mutation.SetNotAfter(v)
+	return _u
+}
+
+// SetNillableNotAfter sets the "not_after" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableNotAfter(v *time.Time) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetNotAfter(*v)
+	}
+	return _u
+}
+
+// SetSpiffeID sets the "spiffe_id" field.
+func (_u *CertificateUpdateOne) SetSpiffeID(v string) *CertificateUpdateOne {
+	_u.mutation.SetSpiffeID(v)
+	return _u
+}
+
+// SetNillableSpiffeID sets the "spiffe_id" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableSpiffeID(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetSpiffeID(*v)
+	}
+	return _u
+}
+
+// ClearSpiffeID clears the value of the "spiffe_id" field.
+func (_u *CertificateUpdateOne) ClearSpiffeID() *CertificateUpdateOne {
+	_u.mutation.ClearSpiffeID()
+	return _u
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (_u *CertificateUpdateOne) SetOidcIssuer(v string) *CertificateUpdateOne {
+	_u.mutation.SetOidcIssuer(v)
+	return _u
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableOidcIssuer(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetOidcIssuer(*v)
+	}
+	return _u
+}
+
+// ClearOidcIssuer clears the value of the "oidc_issuer" field.
+func (_u *CertificateUpdateOne) ClearOidcIssuer() *CertificateUpdateOne {
+	_u.mutation.ClearOidcIssuer()
+	return _u
+}
+
+// SetBuildSignerURI sets the "build_signer_uri" field.
+func (_u *CertificateUpdateOne) SetBuildSignerURI(v string) *CertificateUpdateOne {
+	_u.mutation.SetBuildSignerURI(v)
+	return _u
+}
+
+// SetNillableBuildSignerURI sets the "build_signer_uri" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableBuildSignerURI(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetBuildSignerURI(*v)
+	}
+	return _u
+}
+
+// ClearBuildSignerURI clears the value of the "build_signer_uri" field.
+func (_u *CertificateUpdateOne) ClearBuildSignerURI() *CertificateUpdateOne {
+	_u.mutation.ClearBuildSignerURI()
+	return _u
+}
+
+// SetBuildSignerDigest sets the "build_signer_digest" field.
+func (_u *CertificateUpdateOne) SetBuildSignerDigest(v string) *CertificateUpdateOne {
+	_u.mutation.SetBuildSignerDigest(v)
+	return _u
+}
+
+// SetNillableBuildSignerDigest sets the "build_signer_digest" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableBuildSignerDigest(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetBuildSignerDigest(*v)
+	}
+	return _u
+}
+
+// ClearBuildSignerDigest clears the value of the "build_signer_digest" field.
+func (_u *CertificateUpdateOne) ClearBuildSignerDigest() *CertificateUpdateOne {
+	_u.mutation.ClearBuildSignerDigest()
+	return _u
+}
+
+// SetRunnerEnvironment sets the "runner_environment" field.
+func (_u *CertificateUpdateOne) SetRunnerEnvironment(v string) *CertificateUpdateOne {
+	_u.mutation.SetRunnerEnvironment(v)
+	return _u
+}
+
+// SetNillableRunnerEnvironment sets the "runner_environment" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableRunnerEnvironment(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetRunnerEnvironment(*v)
+	}
+	return _u
+}
+
+// ClearRunnerEnvironment clears the value of the "runner_environment" field.
+func (_u *CertificateUpdateOne) ClearRunnerEnvironment() *CertificateUpdateOne {
+	_u.mutation.ClearRunnerEnvironment()
+	return _u
+}
+
+// SetSourceRepositoryURI sets the "source_repository_uri" field.
+func (_u *CertificateUpdateOne) SetSourceRepositoryURI(v string) *CertificateUpdateOne {
+	_u.mutation.SetSourceRepositoryURI(v)
+	return _u
+}
+
+// SetNillableSourceRepositoryURI sets the "source_repository_uri" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableSourceRepositoryURI(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetSourceRepositoryURI(*v)
+	}
+	return _u
+}
+
+// ClearSourceRepositoryURI clears the value of the "source_repository_uri" field.
+func (_u *CertificateUpdateOne) ClearSourceRepositoryURI() *CertificateUpdateOne {
+	_u.mutation.ClearSourceRepositoryURI()
+	return _u
+}
+
+// SetSourceRepositoryDigest sets the "source_repository_digest" field.
+func (_u *CertificateUpdateOne) SetSourceRepositoryDigest(v string) *CertificateUpdateOne {
+	_u.mutation.SetSourceRepositoryDigest(v)
+	return _u
+}
+
+// SetNillableSourceRepositoryDigest sets the "source_repository_digest" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableSourceRepositoryDigest(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetSourceRepositoryDigest(*v)
+	}
+	return _u
+}
+
+// ClearSourceRepositoryDigest clears the value of the "source_repository_digest" field.
+func (_u *CertificateUpdateOne) ClearSourceRepositoryDigest() *CertificateUpdateOne {
+	_u.mutation.ClearSourceRepositoryDigest()
+	return _u
+}
+
+// SetSourceRepositoryRef sets the "source_repository_ref" field.
+func (_u *CertificateUpdateOne) SetSourceRepositoryRef(v string) *CertificateUpdateOne {
+	_u.mutation.SetSourceRepositoryRef(v)
+	return _u
+}
+
+// SetNillableSourceRepositoryRef sets the "source_repository_ref" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableSourceRepositoryRef(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetSourceRepositoryRef(*v)
+	}
+	return _u
+}
+
+// ClearSourceRepositoryRef clears the value of the "source_repository_ref" field.
+func (_u *CertificateUpdateOne) ClearSourceRepositoryRef() *CertificateUpdateOne {
+	_u.mutation.ClearSourceRepositoryRef()
+	return _u
+}
+
+// SetBuildConfigURI sets the "build_config_uri" field.
+func (_u *CertificateUpdateOne) SetBuildConfigURI(v string) *CertificateUpdateOne {
+	_u.mutation.SetBuildConfigURI(v)
+	return _u
+}
+
+// SetNillableBuildConfigURI sets the "build_config_uri" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableBuildConfigURI(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetBuildConfigURI(*v)
+	}
+	return _u
+}
+
+// ClearBuildConfigURI clears the value of the "build_config_uri" field.
+func (_u *CertificateUpdateOne) ClearBuildConfigURI() *CertificateUpdateOne {
+	_u.mutation.ClearBuildConfigURI()
+	return _u
+}
+
+// SetBuildTrigger sets the "build_trigger" field.
+func (_u *CertificateUpdateOne) SetBuildTrigger(v string) *CertificateUpdateOne {
+	_u.mutation.SetBuildTrigger(v)
+	return _u
+}
+
+// SetNillableBuildTrigger sets the "build_trigger" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableBuildTrigger(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetBuildTrigger(*v)
+	}
+	return _u
+}
+
+// ClearBuildTrigger clears the value of the "build_trigger" field.
+func (_u *CertificateUpdateOne) ClearBuildTrigger() *CertificateUpdateOne {
+	_u.mutation.ClearBuildTrigger()
+	return _u
+}
+
+// SetRunInvocationURI sets the "run_invocation_uri" field.
+func (_u *CertificateUpdateOne) SetRunInvocationURI(v string) *CertificateUpdateOne {
+	_u.mutation.SetRunInvocationURI(v)
+	return _u
+}
+
+// SetNillableRunInvocationURI sets the "run_invocation_uri" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableRunInvocationURI(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetRunInvocationURI(*v)
+	}
+	return _u
+}
+
+// ClearRunInvocationURI clears the value of the "run_invocation_uri" field.
+func (_u *CertificateUpdateOne) ClearRunInvocationURI() *CertificateUpdateOne {
+	_u.mutation.ClearRunInvocationURI()
+	return _u
+}
+
+// AddSubjectAltNameIDs adds the "subject_alt_names" edge to the SubjectAltName entity by IDs.
+func (_u *CertificateUpdateOne) AddSubjectAltNameIDs(ids ...uuid.UUID) *CertificateUpdateOne {
+	_u.mutation.AddSubjectAltNameIDs(ids...)
+	return _u
+}
+
+// AddSubjectAltNames adds the "subject_alt_names" edges to the SubjectAltName entity.
+func (_u *CertificateUpdateOne) AddSubjectAltNames(v ...*SubjectAltName) *CertificateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSubjectAltNameIDs(ids...)
+}
+
+// SetSignatureID sets the "signature" edge to the Signature entity by ID.
+func (_u *CertificateUpdateOne) SetSignatureID(id uuid.UUID) *CertificateUpdateOne {
+	_u.mutation.SetSignatureID(id)
+	return _u
+}
+
+// SetSignature sets the "signature" edge to the Signature entity.
+func (_u *CertificateUpdateOne) SetSignature(v *Signature) *CertificateUpdateOne {
+	return _u.SetSignatureID(v.ID)
+}
+
+// Mutation returns the CertificateMutation object of the builder.
+func (_u *CertificateUpdateOne) Mutation() *CertificateMutation {
+	return _u.mutation
+}
+
+// ClearSubjectAltNames clears all "subject_alt_names" edges to the SubjectAltName entity.
+func (_u *CertificateUpdateOne) ClearSubjectAltNames() *CertificateUpdateOne {
+	_u.mutation.ClearSubjectAltNames()
+	return _u
+}
+
+// RemoveSubjectAltNameIDs removes the "subject_alt_names" edge to SubjectAltName entities by IDs.
+func (_u *CertificateUpdateOne) RemoveSubjectAltNameIDs(ids ...uuid.UUID) *CertificateUpdateOne {
+	_u.mutation.RemoveSubjectAltNameIDs(ids...)
+	return _u
+}
+
+// RemoveSubjectAltNames removes "subject_alt_names" edges to SubjectAltName entities.
+func (_u *CertificateUpdateOne) RemoveSubjectAltNames(v ...*SubjectAltName) *CertificateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSubjectAltNameIDs(ids...)
+}
+
+// ClearSignature clears the "signature" edge to the Signature entity.
+func (_u *CertificateUpdateOne) ClearSignature() *CertificateUpdateOne {
+	_u.mutation.ClearSignature()
+	return _u
+}
+
+// Where appends a list predicates to the CertificateUpdate builder.
+func (_u *CertificateUpdateOne) Where(ps ...predicate.Certificate) *CertificateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CertificateUpdateOne) Select(field string, fields ...string) *CertificateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Certificate entity.
+func (_u *CertificateUpdateOne) Save(ctx context.Context) (*Certificate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CertificateUpdateOne) SaveX(ctx context.Context) *Certificate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CertificateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CertificateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CertificateUpdateOne) check() error {
+	if v, ok := _u.mutation.ChainIndex(); ok {
+		if err := certificate.ChainIndexValidator(v); err != nil {
+			return &ValidationError{Name: "chain_index", err: fmt.Errorf(`ent: validator failed for field "Certificate.chain_index": %w`, err)}
+		}
+	}
+	if _u.mutation.SignatureCleared() && len(_u.mutation.SignatureIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Certificate.signature"`)
+	}
+	return nil
+}
+
+func (_u *CertificateUpdateOne) sqlSave(ctx context.Context) (_node *Certificate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(certificate.Table, certificate.Columns, sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Certificate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certificate.FieldID)
+		for _, f := range fields {
+			if !certificate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != certificate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChainIndex(); ok {
+		_spec.SetField(certificate.FieldChainIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChainIndex(); ok {
+		_spec.AddField(certificate.FieldChainIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(certificate.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Issuer(); ok {
+		_spec.SetField(certificate.FieldIssuer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SerialNumber(); ok {
+		_spec.SetField(certificate.FieldSerialNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NotBefore(); ok {
+		_spec.SetField(certificate.FieldNotBefore, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.NotAfter(); ok {
+		_spec.SetField(certificate.FieldNotAfter, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SpiffeID(); ok {
+		_spec.SetField(certificate.FieldSpiffeID, field.TypeString, value)
+	}
+	if _u.mutation.SpiffeIDCleared() {
+		_spec.ClearField(certificate.FieldSpiffeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OidcIssuer(); ok {
+		_spec.SetField(certificate.FieldOidcIssuer, field.TypeString, value)
+	}
+	if _u.mutation.OidcIssuerCleared() {
+		_spec.ClearField(certificate.FieldOidcIssuer, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildSignerURI(); ok {
+		_spec.SetField(certificate.FieldBuildSignerURI, field.TypeString, value)
+	}
+	if _u.mutation.BuildSignerURICleared() {
+		_spec.ClearField(certificate.FieldBuildSignerURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildSignerDigest(); ok {
+		_spec.SetField(certificate.FieldBuildSignerDigest, field.TypeString, value)
+	}
+	if _u.mutation.BuildSignerDigestCleared() {
+		_spec.ClearField(certificate.FieldBuildSignerDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerEnvironment(); ok {
+		_spec.SetField(certificate.FieldRunnerEnvironment, field.TypeString, value)
+	}
+	if _u.mutation.RunnerEnvironmentCleared() {
+		_spec.ClearField(certificate.FieldRunnerEnvironment, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceRepositoryURI(); ok {
+		_spec.SetField(certificate.FieldSourceRepositoryURI, field.TypeString, value)
+	}
+	if _u.mutation.SourceRepositoryURICleared() {
+		_spec.ClearField(certificate.FieldSourceRepositoryURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceRepositoryDigest(); ok {
+		_spec.SetField(certificate.FieldSourceRepositoryDigest, field.TypeString, value)
+	}
+	if _u.mutation.SourceRepositoryDigestCleared() {
+		_spec.ClearField(certificate.FieldSourceRepositoryDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceRepositoryRef(); ok {
+		_spec.SetField(certificate.FieldSourceRepositoryRef, field.TypeString, value)
+	}
+	if _u.mutation.SourceRepositoryRefCleared() {
+		_spec.ClearField(certificate.FieldSourceRepositoryRef, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildConfigURI(); ok {
+		_spec.SetField(certificate.FieldBuildConfigURI, field.TypeString, value)
+	}
+	if _u.mutation.BuildConfigURICleared() {
+		_spec.ClearField(certificate.FieldBuildConfigURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuildTrigger(); ok {
+		_spec.SetField(certificate.FieldBuildTrigger, field.TypeString, value)
+	}
+	if _u.mutation.BuildTriggerCleared() {
+		_spec.ClearField(certificate.FieldBuildTrigger, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunInvocationURI(); ok {
+		_spec.SetField(certificate.FieldRunInvocationURI, field.TypeString, value)
+	}
+	if _u.mutation.RunInvocationURICleared() {
+		_spec.ClearField(certificate.FieldRunInvocationURI, field.TypeString)
+	}
+	if _u.mutation.SubjectAltNamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.SubjectAltNamesTable,
+			Columns: []string{certificate.SubjectAltNamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSubjectAltNamesIDs(); len(nodes) > 0 && !_u.mutation.SubjectAltNamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.SubjectAltNamesTable,
+			Columns: []string{certificate.SubjectAltNamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubjectAltNamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.SubjectAltNamesTable,
+			Columns: []string{certificate.SubjectAltNamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SignatureCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   certificate.SignatureTable,
+			Columns: []string{certificate.SignatureColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signature.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SignatureIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   certificate.SignatureTable,
+			Columns: []string{certificate.SignatureColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signature.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Certificate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certificate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -19,13 +19,32 @@ import (
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
 	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
 	"github.com/in-toto/archivista/ent/payloaddigest"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
 	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
 	"github.com/in-toto/archivista/ent/subjectdigest"
 	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 // Client is the client that holds all ent builders.
@@ -39,20 +58,58 @@ type Client struct {
 	AttestationCollection *AttestationCollectionClient
 	// AttestationPolicy is the client for interacting with the AttestationPolicy builders.
 	AttestationPolicy *AttestationPolicyClient
+	// Certificate is the client for interacting with the Certificate builders.
+	Certificate *CertificateClient
+	// CommandRunAttestation is the client for interacting with the CommandRunAttestation builders.
+	CommandRunAttestation *CommandRunAttestationClient
 	// Dsse is the client for interacting with the Dsse builders.
 	Dsse *DsseClient
+	// EnvironmentAttestation is the client for interacting with the EnvironmentAttestation builders.
+	EnvironmentAttestation *EnvironmentAttestationClient
+	// GitAttestation is the client for interacting with the GitAttestation builders.
+	GitAttestation *GitAttestationClient
+	// GithubAttestation is the client for interacting with the GithubAttestation builders.
+	GithubAttestation *GithubAttestationClient
+	// GitlabAttestation is the client for interacting with the GitlabAttestation builders.
+	GitlabAttestation *GitlabAttestationClient
+	// LegalHold is the client for interacting with the LegalHold builders.
+	LegalHold *LegalHoldClient
+	// Material is the client for interacting with the Material builders.
+	Material *MaterialClient
+	// OciAttestation is the client for interacting with the OciAttestation builders.
+	OciAttestation *OciAttestationClient
 	// PayloadDigest is the client for interacting with the PayloadDigest builders.
 	PayloadDigest *PayloadDigestClient
+	// Product is the client for interacting with the Product builders.
+	Product *ProductClient
+	// Publication is the client for interacting with the Publication builders.
+	Publication *PublicationClient
+	// PublishDelivery is the client for interacting with the PublishDelivery builders.
+	PublishDelivery *PublishDeliveryClient
+	// SbomPackage is the client for interacting with the SbomPackage builders.
+	SbomPackage *SbomPackageClient
+	// SbomPackageDigest is the client for interacting with the SbomPackageDigest builders.
+	SbomPackageDigest *SbomPackageDigestClient
 	// Signature is the client for interacting with the Signature builders.
 	Signature *SignatureClient
+	// SlsaDependency is the client for interacting with the SlsaDependency builders.
+	SlsaDependency *SlsaDependencyClient
+	// SlsaProvenance is the client for interacting with the SlsaProvenance builders.
+	SlsaProvenance *SlsaProvenanceClient
 	// Statement is the client for interacting with the Statement builders.
 	Statement *StatementClient
 	// Subject is the client for interacting with the Subject builders.
 	Subject *SubjectClient
+	// SubjectAltName is the client for interacting with the SubjectAltName builders.
+	SubjectAltName *SubjectAltNameClient
 	// SubjectDigest is the client for interacting with the SubjectDigest builders.
 	SubjectDigest *SubjectDigestClient
 	// Timestamp is the client for interacting with the Timestamp builders.
 	Timestamp *TimestampClient
+	// TransparencyLogEntry is the client for interacting with the TransparencyLogEntry builders.
+	TransparencyLogEntry *TransparencyLogEntryClient
+	// VerificationSummary is the client for interacting with the VerificationSummary builders.
+	VerificationSummary *VerificationSummaryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -67,13 +124,32 @@ func (c *Client) init() {
 	c.Attestation = NewAttestationClient(c.config)
 	c.AttestationCollection = NewAttestationCollectionClient(c.config)
 	c.AttestationPolicy = NewAttestationPolicyClient(c.config)
+	c.Certificate = NewCertificateClient(c.config)
+	c.CommandRunAttestation = NewCommandRunAttestationClient(c.config)
 	c.Dsse = NewDsseClient(c.config)
+	c.EnvironmentAttestation = NewEnvironmentAttestationClient(c.config)
+	c.GitAttestation = NewGitAttestationClient(c.config)
+	c.GithubAttestation = NewGithubAttestationClient(c.config)
+	c.GitlabAttestation = NewGitlabAttestationClient(c.config)
+	c.LegalHold = NewLegalHoldClient(c.config)
+	c.Material = NewMaterialClient(c.config)
+	c.OciAttestation = NewOciAttestationClient(c.config)
 	c.PayloadDigest = NewPayloadDigestClient(c.config)
+	c.Product = NewProductClient(c.config)
+	c.Publication = NewPublicationClient(c.config)
+	c.PublishDelivery = NewPublishDeliveryClient(c.config)
+	c.SbomPackage = NewSbomPackageClient(c.config)
+	c.SbomPackageDigest = NewSbomPackageDigestClient(c.config)
 	c.Signature = NewSignatureClient(c.config)
+	c.SlsaDependency = NewSlsaDependencyClient(c.config)
+	c.SlsaProvenance = NewSlsaProvenanceClient(c.config)
 	c.Statement = NewStatementClient(c.config)
 	c.Subject = NewSubjectClient(c.config)
+	c.SubjectAltName = NewSubjectAltNameClient(c.config)
 	c.SubjectDigest = NewSubjectDigestClient(c.config)
 	c.Timestamp = NewTimestampClient(c.config)
+	c.TransparencyLogEntry = NewTransparencyLogEntryClient(c.config)
+	c.VerificationSummary = NewVerificationSummaryClient(c.config)
 }
 
 type (
@@ -164,18 +240,37 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                   ctx,
-		config:                cfg,
-		Attestation:           NewAttestationClient(cfg),
-		AttestationCollection: NewAttestationCollectionClient(cfg),
-		AttestationPolicy:     NewAttestationPolicyClient(cfg),
-		Dsse:                  NewDsseClient(cfg),
-		PayloadDigest:         NewPayloadDigestClient(cfg),
-		Signature:             NewSignatureClient(cfg),
-		Statement:             NewStatementClient(cfg),
-		Subject:               NewSubjectClient(cfg),
-		SubjectDigest:         NewSubjectDigestClient(cfg),
-		Timestamp:             NewTimestampClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Attestation:            NewAttestationClient(cfg),
+		AttestationCollection:  NewAttestationCollectionClient(cfg),
+		AttestationPolicy:      NewAttestationPolicyClient(cfg),
+		Certificate:            NewCertificateClient(cfg),
+		CommandRunAttestation:  NewCommandRunAttestationClient(cfg),
+		Dsse:                   NewDsseClient(cfg),
+		EnvironmentAttestation: NewEnvironmentAttestationClient(cfg),
+		GitAttestation:         NewGitAttestationClient(cfg),
+		GithubAttestation:      NewGithubAttestationClient(cfg),
+		GitlabAttestation:      NewGitlabAttestationClient(cfg),
+		LegalHold:              NewLegalHoldClient(cfg),
+		Material:               NewMaterialClient(cfg),
+		OciAttestation:         NewOciAttestationClient(cfg),
+		PayloadDigest:          NewPayloadDigestClient(cfg),
+		Product:                NewProductClient(cfg),
+		Publication:            NewPublicationClient(cfg),
+		PublishDelivery:        NewPublishDeliveryClient(cfg),
+		SbomPackage:            NewSbomPackageClient(cfg),
+		SbomPackageDigest:      NewSbomPackageDigestClient(cfg),
+		Signature:              NewSignatureClient(cfg),
+		SlsaDependency:         NewSlsaDependencyClient(cfg),
+		SlsaProvenance:         NewSlsaProvenanceClient(cfg),
+		Statement:              NewStatementClient(cfg),
+		Subject:                NewSubjectClient(cfg),
+		SubjectAltName:         NewSubjectAltNameClient(cfg),
+		SubjectDigest:          NewSubjectDigestClient(cfg),
+		Timestamp:              NewTimestampClient(cfg),
+		TransparencyLogEntry:   NewTransparencyLogEntryClient(cfg),
+		VerificationSummary:    NewVerificationSummaryClient(cfg),
 	}, nil
 }
 
@@ -193,18 +288,37 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                   ctx,
-		config:                cfg,
-		Attestation:           NewAttestationClient(cfg),
-		AttestationCollection: NewAttestationCollectionClient(cfg),
-		AttestationPolicy:     NewAttestationPolicyClient(cfg),
-		Dsse:                  NewDsseClient(cfg),
-		PayloadDigest:         NewPayloadDigestClient(cfg),
-		Signature:             NewSignatureClient(cfg),
-		Statement:             NewStatementClient(cfg),
-		Subject:               NewSubjectClient(cfg),
-		SubjectDigest:         NewSubjectDigestClient(cfg),
-		Timestamp:             NewTimestampClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Attestation:            NewAttestationClient(cfg),
+		AttestationCollection:  NewAttestationCollectionClient(cfg),
+		AttestationPolicy:      NewAttestationPolicyClient(cfg),
+		Certificate:            NewCertificateClient(cfg),
+		CommandRunAttestation:  NewCommandRunAttestationClient(cfg),
+		Dsse:                   NewDsseClient(cfg),
+		EnvironmentAttestation: NewEnvironmentAttestationClient(cfg),
+		GitAttestation:         NewGitAttestationClient(cfg),
+		GithubAttestation:      NewGithubAttestationClient(cfg),
+		GitlabAttestation:      NewGitlabAttestationClient(cfg),
+		LegalHold:              NewLegalHoldClient(cfg),
+		Material:               NewMaterialClient(cfg),
+		OciAttestation:         NewOciAttestationClient(cfg),
+		PayloadDigest:          NewPayloadDigestClient(cfg),
+		Product:                NewProductClient(cfg),
+		Publication:            NewPublicationClient(cfg),
+		PublishDelivery:        NewPublishDeliveryClient(cfg),
+		SbomPackage:            NewSbomPackageClient(cfg),
+		SbomPackageDigest:      NewSbomPackageDigestClient(cfg),
+		Signature:              NewSignatureClient(cfg),
+		SlsaDependency:         NewSlsaDependencyClient(cfg),
+		SlsaProvenance:         NewSlsaProvenanceClient(cfg),
+		Statement:              NewStatementClient(cfg),
+		Subject:                NewSubjectClient(cfg),
+		SubjectAltName:         NewSubjectAltNameClient(cfg),
+		SubjectDigest:          NewSubjectDigestClient(cfg),
+		Timestamp:              NewTimestampClient(cfg),
+		TransparencyLogEntry:   NewTransparencyLogEntryClient(cfg),
+		VerificationSummary:    NewVerificationSummaryClient(cfg),
 	}, nil
 }
 
@@ -234,9 +348,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Attestation, c.AttestationCollection, c.AttestationPolicy, c.Dsse,
-		c.PayloadDigest, c.Signature, c.Statement, c.Subject, c.SubjectDigest,
-		c.Timestamp,
+		c.Attestation, c.AttestationCollection, c.AttestationPolicy, c.Certificate,
+		c.CommandRunAttestation, c.Dsse, c.EnvironmentAttestation, c.GitAttestation,
+		c.GithubAttestation, c.GitlabAttestation, c.LegalHold, c.Material,
+		c.OciAttestation, c.PayloadDigest, c.Product, c.Publication, c.PublishDelivery,
+		c.SbomPackage, c.SbomPackageDigest, c.Signature, c.SlsaDependency,
+		c.SlsaProvenance, c.Statement, c.Subject, c.SubjectAltName, c.SubjectDigest,
+		c.Timestamp, c.TransparencyLogEntry, c.VerificationSummary,
 	} {
 		n.Use(hooks...)
 	}
@@ -246,9 +364,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Attestation, c.AttestationCollection, c.AttestationPolicy, c.Dsse,
-		c.PayloadDigest, c.Signature, c.Statement, c.Subject, c.SubjectDigest,
-		c.Timestamp,
+		c.Attestation, c.AttestationCollection, c.AttestationPolicy, c.Certificate,
+		c.CommandRunAttestation, c.Dsse, c.EnvironmentAttestation, c.GitAttestation,
+		c.GithubAttestation, c.GitlabAttestation, c.LegalHold, c.Material,
+		c.OciAttestation, c.PayloadDigest, c.Product, c.Publication, c.PublishDelivery,
+		c.SbomPackage, c.SbomPackageDigest, c.Signature, c.SlsaDependency,
+		c.SlsaProvenance, c.Statement, c.Subject, c.SubjectAltName, c.SubjectDigest,
+		c.Timestamp, c.TransparencyLogEntry, c.VerificationSummary,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -263,20 +385,58 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AttestationCollection.mutate(ctx, m)
 	case *AttestationPolicyMutation:
 		return c.AttestationPolicy.mutate(ctx, m)
+	case *CertificateMutation:
+		return c.Certificate.mutate(ctx, m)
+	case *CommandRunAttestationMutation:
+		return c.CommandRunAttestation.mutate(ctx, m)
 	case *DsseMutation:
 		return c.Dsse.mutate(ctx, m)
+	case *EnvironmentAttestationMutation:
+		return c.EnvironmentAttestation.mutate(ctx, m)
+	case *GitAttestationMutation:
+		return c.GitAttestation.mutate(ctx, m)
+	case *GithubAttestationMutation:
+		return c.GithubAttestation.mutate(ctx, m)
+	case *GitlabAttestationMutation:
+		return c.GitlabAttestation.mutate(ctx, m)
+	case *LegalHoldMutation:
+		return c.LegalHold.mutate(ctx, m)
+	case *MaterialMutation:
+		return c.Material.mutate(ctx, m)
+	case *OciAttestationMutation:
+		return c.OciAttestation.mutate(ctx, m)
 	case *PayloadDigestMutation:
 		return c.PayloadDigest.mutate(ctx, m)
+	case *ProductMutation:
+		return c.Product.mutate(ctx, m)
+	case *PublicationMutation:
+		return c.Publication.mutate(ctx, m)
+	case *PublishDeliveryMutation:
+		return c.PublishDelivery.mutate(ctx, m)
+	case *SbomPackageMutation:
+		return c.SbomPackage.mutate(ctx, m)
+	case *SbomPackageDigestMutation:
+		return c.SbomPackageDigest.mutate(ctx, m)
 	case *SignatureMutation:
 		return c.Signature.mutate(ctx, m)
+	case *SlsaDependencyMutation:
+		return c.SlsaDependency.mutate(ctx, m)
+	case *SlsaProvenanceMutation:
+		return c.SlsaProvenance.mutate(ctx, m)
 	case *StatementMutation:
 		return c.Statement.mutate(ctx, m)
 	case *SubjectMutation:
 		return c.Subject.mutate(ctx, m)
+	case *SubjectAltNameMutation:
+		return c.SubjectAltName.mutate(ctx, m)
 	case *SubjectDigestMutation:
 		return c.SubjectDigest.mutate(ctx, m)
 	case *TimestampMutation:
 		return c.Timestamp.mutate(ctx, m)
+	case *TransparencyLogEntryMutation:
+		return c.TransparencyLogEntry.mutate(ctx, m)
+	case *VerificationSummaryMutation:
+		return c.VerificationSummary.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -406,14 +566,144 @@ func (c *AttestationClient) QueryAttestationCollection(_m *Attestation) *Attesta
 	return query
 }
 
+// QueryGitAttestation queries the git_attestation edge of a Attestation.
+func (c *AttestationClient) QueryGitAttestation(_m *Attestation) *GitAttestationQuery {
+	query := (&GitAttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, id),
+			sqlgraph.To(gitattestation.Table, gitattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.GitAttestationTable, attestation.GitAttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGithubAttestation queries the github_attestation edge of a Attestation.
+func (c *AttestationClient) QueryGithubAttestation(_m *Attestation) *GithubAttestationQuery {
+	query := (&GithubAttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, id),
+			sqlgraph.To(githubattestation.Table, githubattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.GithubAttestationTable, attestation.GithubAttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGitlabAttestation queries the gitlab_attestation edge of a Attestation.
+func (c *AttestationClient) QueryGitlabAttestation(_m *Attestation) *GitlabAttestationQuery {
+	query := (&GitlabAttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, id),
+			sqlgraph.To(gitlabattestation.Table, gitlabattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.GitlabAttestationTable, attestation.GitlabAttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvironmentAttestation queries the environment_attestation edge of a Attestation.
+func (c *AttestationClient) QueryEnvironmentAttestation(_m *Attestation) *EnvironmentAttestationQuery {
+	query := (&EnvironmentAttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, id),
+			sqlgraph.To(environmentattestation.Table, environmentattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.EnvironmentAttestationTable, attestation.EnvironmentAttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCommandRunAttestation queries the command_run_attestation edge of a Attestation.
+func (c *AttestationClient) QueryCommandRunAttestation(_m *Attestation) *CommandRunAttestationQuery {
+	query := (&CommandRunAttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, id),
+			sqlgraph.To(commandrunattestation.Table, commandrunattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.CommandRunAttestationTable, attestation.CommandRunAttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOciAttestation queries the oci_attestation edge of a Attestation.
+func (c *AttestationClient) QueryOciAttestation(_m *Attestation) *OciAttestationQuery {
+	query := (&OciAttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, id),
+			sqlgraph.To(ociattestation.Table, ociattestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, attestation.OciAttestationTable, attestation.OciAttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMaterials queries the materials edge of a Attestation.
+func (c *AttestationClient) QueryMaterials(_m *Attestation) *MaterialQuery {
+	query := (&MaterialClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, id),
+			sqlgraph.To(material.Table, material.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attestation.MaterialsTable, attestation.MaterialsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProducts queries the products edge of a Attestation.
+func (c *AttestationClient) QueryProducts(_m *Attestation) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attestation.Table, attestation.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attestation.ProductsTable, attestation.ProductsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AttestationClient) Hooks() []Hook {
-	return c.hooks.Attestation
+	hooks := c.hooks.Attestation
+	return append(hooks[:len(hooks):len(hooks)], attestation.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *AttestationClient) Interceptors() []Interceptor {
-	return c.inters.Attestation
+	inters := c.inters.Attestation
+	return append(inters[:len(inters):len(inters)], attestation.Interceptors[:]...)
 }
 
 func (c *AttestationClient) mutate(ctx context.Context, m *AttestationMutation) (Value, error) {
@@ -573,12 +863,14 @@ func (c *AttestationCollectionClient) QueryStatement(_m *AttestationCollection)
 
 // Hooks returns the client hooks.
 func (c *AttestationCollectionClient) Hooks() []Hook {
-	return c.hooks.AttestationCollection
+	hooks := c.hooks.AttestationCollection
+	return append(hooks[:len(hooks):len(hooks)], attestationcollection.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *AttestationCollectionClient) Interceptors() []Interceptor {
-	return c.inters.AttestationCollection
+	inters := c.inters.AttestationCollection
+	return append(inters[:len(inters):len(inters)], attestationcollection.Interceptors[:]...)
 }
 
 func (c *AttestationCollectionClient) mutate(ctx context.Context, m *AttestationCollectionMutation) (Value, error) {
@@ -722,12 +1014,14 @@ func (c *AttestationPolicyClient) QueryStatement(_m *AttestationPolicy) *Stateme
 
 // Hooks returns the client hooks.
 func (c *AttestationPolicyClient) Hooks() []Hook {
-	return c.hooks.AttestationPolicy
+	hooks := c.hooks.AttestationPolicy
+	return append(hooks[:len(hooks):len(hooks)], attestationpolicy.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *AttestationPolicyClient) Interceptors() []Interceptor {
-	return c.inters.AttestationPolicy
+	inters := c.inters.AttestationPolicy
+	return append(inters[:len(inters):len(inters)], attestationpolicy.Interceptors[:]...)
 }
 
 func (c *AttestationPolicyClient) mutate(ctx context.Context, m *AttestationPolicyMutation) (Value, error) {
@@ -745,107 +1039,107 @@ func (c *AttestationPolicyClient) mutate(ctx context.Context, m *AttestationPoli
 	}
 }
 
-// DsseClient is a client for the Dsse schema.
-type DsseClient struct {
+// CertificateClient is a client for the Certificate schema.
+type CertificateClient struct {
 	config
 }
 
-// NewDsseClient returns a client for the Dsse from the given config.
-func NewDsseClient(c config) *DsseClient {
-	return &DsseClient{config: c}
+// NewCertificateClient returns a client for the Certificate from the given config.
+func NewCertificateClient(c config) *CertificateClient {
+	return &CertificateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `dsse.Hooks(f(g(h())))`.
-func (c *DsseClient) Use(hooks ...Hook) {
-	c.hooks.Dsse = append(c.hooks.Dsse, hooks...)
+// A call to `Use(f, g, h)` equals to `certificate.Hooks(f(g(h())))`.
+func (c *CertificateClient) Use(hooks ...Hook) {
+	c.hooks.Certificate = append(c.hooks.Certificate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `dsse.Intercept(f(g(h())))`.
-func (c *DsseClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Dsse = append(c.inters.Dsse, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `certificate.Intercept(f(g(h())))`.
+func (c *CertificateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Certificate = append(c.inters.Certificate, interceptors...)
 }
 
-// Create returns a builder for creating a Dsse entity.
-func (c *DsseClient) Create() *DsseCreate {
-	mutation := newDsseMutation(c.config, OpCreate)
-	return &DsseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Certificate entity.
+func (c *CertificateClient) Create() *CertificateCreate {
+	mutation := newCertificateMutation(c.config, OpCreate)
+	return &CertificateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Dsse entities.
-func (c *DsseClient) CreateBulk(builders ...*DsseCreate) *DsseCreateBulk {
-	return &DsseCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Certificate entities.
+func (c *CertificateClient) CreateBulk(builders ...*CertificateCreate) *CertificateCreateBulk {
+	return &CertificateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DsseClient) MapCreateBulk(slice any, setFunc func(*DsseCreate, int)) *DsseCreateBulk {
+func (c *CertificateClient) MapCreateBulk(slice any, setFunc func(*CertificateCreate, int)) *CertificateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DsseCreateBulk{err: fmt.Errorf("calling to DsseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CertificateCreateBulk{err: fmt.Errorf("calling to CertificateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DsseCreate, rv.Len())
+	builders := make([]*CertificateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DsseCreateBulk{config: c.config, builders: builders}
+	return &CertificateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Dsse.
-func (c *DsseClient) Update() *DsseUpdate {
-	mutation := newDsseMutation(c.config, OpUpdate)
-	return &DsseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Certificate.
+func (c *CertificateClient) Update() *CertificateUpdate {
+	mutation := newCertificateMutation(c.config, OpUpdate)
+	return &CertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DsseClient) UpdateOne(_m *Dsse) *DsseUpdateOne {
-	mutation := newDsseMutation(c.config, OpUpdateOne, withDsse(_m))
-	return &DsseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CertificateClient) UpdateOne(_m *Certificate) *CertificateUpdateOne {
+	mutation := newCertificateMutation(c.config, OpUpdateOne, withCertificate(_m))
+	return &CertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DsseClient) UpdateOneID(id uuid.UUID) *DsseUpdateOne {
-	mutation := newDsseMutation(c.config, OpUpdateOne, withDsseID(id))
-	return &DsseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CertificateClient) UpdateOneID(id uuid.UUID) *CertificateUpdateOne {
+	mutation := newCertificateMutation(c.config, OpUpdateOne, withCertificateID(id))
+	return &CertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Dsse.
-func (c *DsseClient) Delete() *DsseDelete {
-	mutation := newDsseMutation(c.config, OpDelete)
-	return &DsseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Certificate.
+func (c *CertificateClient) Delete() *CertificateDelete {
+	mutation := newCertificateMutation(c.config, OpDelete)
+	return &CertificateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DsseClient) DeleteOne(_m *Dsse) *DsseDeleteOne {
+func (c *CertificateClient) DeleteOne(_m *Certificate) *CertificateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DsseClient) DeleteOneID(id uuid.UUID) *DsseDeleteOne {
-	builder := c.Delete().Where(dsse.ID(id))
+func (c *CertificateClient) DeleteOneID(id uuid.UUID) *CertificateDeleteOne {
+	builder := c.Delete().Where(certificate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DsseDeleteOne{builder}
+	return &CertificateDeleteOne{builder}
 }
 
-// Query returns a query builder for Dsse.
-func (c *DsseClient) Query() *DsseQuery {
-	return &DsseQuery{
+// Query returns a query builder for Certificate.
+func (c *CertificateClient) Query() *CertificateQuery {
+	return &CertificateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDsse},
+		ctx:    &QueryContext{Type: TypeCertificate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Dsse entity by its id.
-func (c *DsseClient) Get(ctx context.Context, id uuid.UUID) (*Dsse, error) {
-	return c.Query().Where(dsse.ID(id)).Only(ctx)
+// Get returns a Certificate entity by its id.
+func (c *CertificateClient) Get(ctx context.Context, id uuid.UUID) (*Certificate, error) {
+	return c.Query().Where(certificate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DsseClient) GetX(ctx context.Context, id uuid.UUID) *Dsse {
+func (c *CertificateClient) GetX(ctx context.Context, id uuid.UUID) *Certificate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -853,15 +1147,15 @@ func (c *DsseClient) GetX(ctx context.Context, id uuid.UUID) *Dsse {
 	return obj
 }
 
-// QueryStatement queries the statement edge of a Dsse.
-func (c *DsseClient) QueryStatement(_m *Dsse) *StatementQuery {
-	query := (&StatementClient{config: c.config}).Query()
+// QuerySubjectAltNames queries the subject_alt_names edge of a Certificate.
+func (c *CertificateClient) QuerySubjectAltNames(_m *Certificate) *SubjectAltNameQuery {
+	query := (&SubjectAltNameClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(dsse.Table, dsse.FieldID, id),
-			sqlgraph.To(statement.Table, statement.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, dsse.StatementTable, dsse.StatementColumn),
+			sqlgraph.From(certificate.Table, certificate.FieldID, id),
+			sqlgraph.To(subjectaltname.Table, subjectaltname.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, certificate.SubjectAltNamesTable, certificate.SubjectAltNamesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -869,31 +1163,15 @@ func (c *DsseClient) QueryStatement(_m *Dsse) *StatementQuery {
 	return query
 }
 
-// QuerySignatures queries the signatures edge of a Dsse.
-func (c *DsseClient) QuerySignatures(_m *Dsse) *SignatureQuery {
+// QuerySignature queries the signature edge of a Certificate.
+func (c *CertificateClient) QuerySignature(_m *Certificate) *SignatureQuery {
 	query := (&SignatureClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(dsse.Table, dsse.FieldID, id),
+			sqlgraph.From(certificate.Table, certificate.FieldID, id),
 			sqlgraph.To(signature.Table, signature.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, dsse.SignaturesTable, dsse.SignaturesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPayloadDigests queries the payload_digests edge of a Dsse.
-func (c *DsseClient) QueryPayloadDigests(_m *Dsse) *PayloadDigestQuery {
-	query := (&PayloadDigestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(dsse.Table, dsse.FieldID, id),
-			sqlgraph.To(payloaddigest.Table, payloaddigest.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, dsse.PayloadDigestsTable, dsse.PayloadDigestsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, certificate.SignatureTable, certificate.SignatureColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -902,131 +1180,133 @@ func (c *DsseClient) QueryPayloadDigests(_m *Dsse) *PayloadDigestQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *DsseClient) Hooks() []Hook {
-	return c.hooks.Dsse
+func (c *CertificateClient) Hooks() []Hook {
+	hooks := c.hooks.Certificate
+	return append(hooks[:len(hooks):len(hooks)], certificate.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DsseClient) Interceptors() []Interceptor {
-	return c.inters.Dsse
+func (c *CertificateClient) Interceptors() []Interceptor {
+	inters := c.inters.Certificate
+	return append(inters[:len(inters):len(inters)], certificate.Interceptors[:]...)
 }
 
-func (c *DsseClient) mutate(ctx context.Context, m *DsseMutation) (Value, error) {
+func (c *CertificateClient) mutate(ctx context.Context, m *CertificateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DsseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CertificateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DsseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DsseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DsseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CertificateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Dsse mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Certificate mutation op: %q", m.Op())
 	}
 }
 
-// PayloadDigestClient is a client for the PayloadDigest schema.
-type PayloadDigestClient struct {
+// CommandRunAttestationClient is a client for the CommandRunAttestation schema.
+type CommandRunAttestationClient struct {
 	config
 }
 
-// NewPayloadDigestClient returns a client for the PayloadDigest from the given config.
-func NewPayloadDigestClient(c config) *PayloadDigestClient {
-	return &PayloadDigestClient{config: c}
+// NewCommandRunAttestationClient returns a client for the CommandRunAttestation from the given config.
+func NewCommandRunAttestationClient(c config) *CommandRunAttestationClient {
+	return &CommandRunAttestationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `payloaddigest.Hooks(f(g(h())))`.
-func (c *PayloadDigestClient) Use(hooks ...Hook) {
-	c.hooks.PayloadDigest = append(c.hooks.PayloadDigest, hooks...)
+// A call to `Use(f, g, h)` equals to `commandrunattestation.Hooks(f(g(h())))`.
+func (c *CommandRunAttestationClient) Use(hooks ...Hook) {
+	c.hooks.CommandRunAttestation = append(c.hooks.CommandRunAttestation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `payloaddigest.Intercept(f(g(h())))`.
-func (c *PayloadDigestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PayloadDigest = append(c.inters.PayloadDigest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `commandrunattestation.Intercept(f(g(h())))`.
+func (c *CommandRunAttestationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommandRunAttestation = append(c.inters.CommandRunAttestation, interceptors...)
 }
 
-// Create returns a builder for creating a PayloadDigest entity.
-func (c *PayloadDigestClient) Create() *PayloadDigestCreate {
-	mutation := newPayloadDigestMutation(c.config, OpCreate)
-	return &PayloadDigestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CommandRunAttestation entity.
+func (c *CommandRunAttestationClient) Create() *CommandRunAttestationCreate {
+	mutation := newCommandRunAttestationMutation(c.config, OpCreate)
+	return &CommandRunAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PayloadDigest entities.
-func (c *PayloadDigestClient) CreateBulk(builders ...*PayloadDigestCreate) *PayloadDigestCreateBulk {
-	return &PayloadDigestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CommandRunAttestation entities.
+func (c *CommandRunAttestationClient) CreateBulk(builders ...*CommandRunAttestationCreate) *CommandRunAttestationCreateBulk {
+	return &CommandRunAttestationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PayloadDigestClient) MapCreateBulk(slice any, setFunc func(*PayloadDigestCreate, int)) *PayloadDigestCreateBulk {
+func (c *CommandRunAttestationClient) MapCreateBulk(slice any, setFunc func(*CommandRunAttestationCreate, int)) *CommandRunAttestationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PayloadDigestCreateBulk{err: fmt.Errorf("calling to PayloadDigestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CommandRunAttestationCreateBulk{err: fmt.Errorf("calling to CommandRunAttestationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PayloadDigestCreate, rv.Len())
+	builders := make([]*CommandRunAttestationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PayloadDigestCreateBulk{config: c.config, builders: builders}
+	return &CommandRunAttestationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PayloadDigest.
-func (c *PayloadDigestClient) Update() *PayloadDigestUpdate {
-	mutation := newPayloadDigestMutation(c.config, OpUpdate)
-	return &PayloadDigestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CommandRunAttestation.
+func (c *CommandRunAttestationClient) Update() *CommandRunAttestationUpdate {
+	mutation := newCommandRunAttestationMutation(c.config, OpUpdate)
+	return &CommandRunAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PayloadDigestClient) UpdateOne(_m *PayloadDigest) *PayloadDigestUpdateOne {
-	mutation := newPayloadDigestMutation(c.config, OpUpdateOne, withPayloadDigest(_m))
-	return &PayloadDigestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommandRunAttestationClient) UpdateOne(_m *CommandRunAttestation) *CommandRunAttestationUpdateOne {
+	mutation := newCommandRunAttestationMutation(c.config, OpUpdateOne, withCommandRunAttestation(_m))
+	return &CommandRunAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PayloadDigestClient) UpdateOneID(id uuid.UUID) *PayloadDigestUpdateOne {
-	mutation := newPayloadDigestMutation(c.config, OpUpdateOne, withPayloadDigestID(id))
-	return &PayloadDigestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommandRunAttestationClient) UpdateOneID(id uuid.UUID) *CommandRunAttestationUpdateOne {
+	mutation := newCommandRunAttestationMutation(c.config, OpUpdateOne, withCommandRunAttestationID(id))
+	return &CommandRunAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PayloadDigest.
-func (c *PayloadDigestClient) Delete() *PayloadDigestDelete {
-	mutation := newPayloadDigestMutation(c.config, OpDelete)
-	return &PayloadDigestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CommandRunAttestation.
+func (c *CommandRunAttestationClient) Delete() *CommandRunAttestationDelete {
+	mutation := newCommandRunAttestationMutation(c.config, OpDelete)
+	return &CommandRunAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PayloadDigestClient) DeleteOne(_m *PayloadDigest) *PayloadDigestDeleteOne {
+func (c *CommandRunAttestationClient) DeleteOne(_m *CommandRunAttestation) *CommandRunAttestationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PayloadDigestClient) DeleteOneID(id uuid.UUID) *PayloadDigestDeleteOne {
-	builder := c.Delete().Where(payloaddigest.ID(id))
+func (c *CommandRunAttestationClient) DeleteOneID(id uuid.UUID) *CommandRunAttestationDeleteOne {
+	builder := c.Delete().Where(commandrunattestation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PayloadDigestDeleteOne{builder}
+	return &CommandRunAttestationDeleteOne{builder}
 }
 
-// Query returns a query builder for PayloadDigest.
-func (c *PayloadDigestClient) Query() *PayloadDigestQuery {
-	return &PayloadDigestQuery{
+// Query returns a query builder for CommandRunAttestation.
+func (c *CommandRunAttestationClient) Query() *CommandRunAttestationQuery {
+	return &CommandRunAttestationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePayloadDigest},
+		ctx:    &QueryContext{Type: TypeCommandRunAttestation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PayloadDigest entity by its id.
-func (c *PayloadDigestClient) Get(ctx context.Context, id uuid.UUID) (*PayloadDigest, error) {
-	return c.Query().Where(payloaddigest.ID(id)).Only(ctx)
+// Get returns a CommandRunAttestation entity by its id.
+func (c *CommandRunAttestationClient) Get(ctx context.Context, id uuid.UUID) (*CommandRunAttestation, error) {
+	return c.Query().Where(commandrunattestation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PayloadDigestClient) GetX(ctx context.Context, id uuid.UUID) *PayloadDigest {
+func (c *CommandRunAttestationClient) GetX(ctx context.Context, id uuid.UUID) *CommandRunAttestation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1034,15 +1314,15 @@ func (c *PayloadDigestClient) GetX(ctx context.Context, id uuid.UUID) *PayloadDi
 	return obj
 }
 
-// QueryDsse queries the dsse edge of a PayloadDigest.
-func (c *PayloadDigestClient) QueryDsse(_m *PayloadDigest) *DsseQuery {
-	query := (&DsseClient{config: c.config}).Query()
+// QueryAttestation queries the attestation edge of a CommandRunAttestation.
+func (c *CommandRunAttestationClient) QueryAttestation(_m *CommandRunAttestation) *AttestationQuery {
+	query := (&AttestationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(payloaddigest.Table, payloaddigest.FieldID, id),
-			sqlgraph.To(dsse.Table, dsse.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, payloaddigest.DsseTable, payloaddigest.DsseColumn),
+			sqlgraph.From(commandrunattestation.Table, commandrunattestation.FieldID, id),
+			sqlgraph.To(attestation.Table, attestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, commandrunattestation.AttestationTable, commandrunattestation.AttestationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1051,50 +1331,2278 @@ func (c *PayloadDigestClient) QueryDsse(_m *PayloadDigest) *DsseQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PayloadDigestClient) Hooks() []Hook {
-	return c.hooks.PayloadDigest
+func (c *CommandRunAttestationClient) Hooks() []Hook {
+	hooks := c.hooks.CommandRunAttestation
+	return append(hooks[:len(hooks):len(hooks)], commandrunattestation.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PayloadDigestClient) Interceptors() []Interceptor {
-	return c.inters.PayloadDigest
+func (c *CommandRunAttestationClient) Interceptors() []Interceptor {
+	inters := c.inters.CommandRunAttestation
+	return append(inters[:len(inters):len(inters)], commandrunattestation.Interceptors[:]...)
 }
 
-func (c *PayloadDigestClient) mutate(ctx context.Context, m *PayloadDigestMutation) (Value, error) {
+func (c *CommandRunAttestationClient) mutate(ctx context.Context, m *CommandRunAttestationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PayloadDigestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommandRunAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PayloadDigestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommandRunAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PayloadDigestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommandRunAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PayloadDigestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CommandRunAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PayloadDigest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CommandRunAttestation mutation op: %q", m.Op())
 	}
 }
 
-// SignatureClient is a client for the Signature schema.
-type SignatureClient struct {
+// DsseClient is a client for the Dsse schema.
+type DsseClient struct {
 	config
 }
 
-// NewSignatureClient returns a client for the Signature from the given config.
-func NewSignatureClient(c config) *SignatureClient {
-	return &SignatureClient{config: c}
+// NewDsseClient returns a client for the Dsse from the given config.
+func NewDsseClient(c config) *DsseClient {
+	return &DsseClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `signature.Hooks(f(g(h())))`.
-func (c *SignatureClient) Use(hooks ...Hook) {
-	c.hooks.Signature = append(c.hooks.Signature, hooks...)
+// A call to `Use(f, g, h)` equals to `dsse.Hooks(f(g(h())))`.
+func (c *DsseClient) Use(hooks ...Hook) {
+	c.hooks.Dsse = append(c.hooks.Dsse, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `signature.Intercept(f(g(h())))`.
-func (c *SignatureClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Signature = append(c.inters.Signature, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `dsse.Intercept(f(g(h())))`.
+func (c *DsseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Dsse = append(c.inters.Dsse, interceptors...)
+}
+
+// Create returns a builder for creating a Dsse entity.
+func (c *DsseClient) Create() *DsseCreate {
+	mutation := newDsseMutation(c.config, OpCreate)
+	return &DsseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Dsse entities.
+func (c *DsseClient) CreateBulk(builders ...*DsseCreate) *DsseCreateBulk {
+	return &DsseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DsseClient) MapCreateBulk(slice any, setFunc func(*DsseCreate, int)) *DsseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DsseCreateBulk{err: fmt.Errorf("calling to DsseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DsseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DsseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Dsse.
+func (c *DsseClient) Update() *DsseUpdate {
+	mutation := newDsseMutation(c.config, OpUpdate)
+	return &DsseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DsseClient) UpdateOne(_m *Dsse) *DsseUpdateOne {
+	mutation := newDsseMutation(c.config, OpUpdateOne, withDsse(_m))
+	return &DsseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DsseClient) UpdateOneID(id uuid.UUID) *DsseUpdateOne {
+	mutation := newDsseMutation(c.config, OpUpdateOne, withDsseID(id))
+	return &DsseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Dsse.
+func (c *DsseClient) Delete() *DsseDelete {
+	mutation := newDsseMutation(c.config, OpDelete)
+	return &DsseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DsseClient) DeleteOne(_m *Dsse) *DsseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DsseClient) DeleteOneID(id uuid.UUID) *DsseDeleteOne {
+	builder := c.Delete().Where(dsse.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DsseDeleteOne{builder}
+}
+
+// Query returns a query builder for Dsse.
+func (c *DsseClient) Query() *DsseQuery {
+	return &DsseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDsse},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Dsse entity by its id.
+func (c *DsseClient) Get(ctx context.Context, id uuid.UUID) (*Dsse, error) {
+	return c.Query().Where(dsse.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DsseClient) GetX(ctx context.Context, id uuid.UUID) *Dsse {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStatement queries the statement edge of a Dsse.
+func (c *DsseClient) QueryStatement(_m *Dsse) *StatementQuery {
+	query := (&StatementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, id),
+			sqlgraph.To(statement.Table, statement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, dsse.StatementTable, dsse.StatementColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySignatures queries the signatures edge of a Dsse.
+func (c *DsseClient) QuerySignatures(_m *Dsse) *SignatureQuery {
+	query := (&SignatureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, id),
+			sqlgraph.To(signature.Table, signature.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dsse.SignaturesTable, dsse.SignaturesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayloadDigests queries the payload_digests edge of a Dsse.
+func (c *DsseClient) QueryPayloadDigests(_m *Dsse) *PayloadDigestQuery {
+	query := (&PayloadDigestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, id),
+			sqlgraph.To(payloaddigest.Table, payloaddigest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dsse.PayloadDigestsTable, dsse.PayloadDigestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPublishDeliveries queries the publish_deliveries edge of a Dsse.
+func (c *DsseClient) QueryPublishDeliveries(_m *Dsse) *PublishDeliveryQuery {
+	query := (&PublishDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, id),
+			sqlgraph.To(publishdelivery.Table, publishdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dsse.PublishDeliveriesTable, dsse.PublishDeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPublications queries the publications edge of a Dsse.
+func (c *DsseClient) QueryPublications(_m *Dsse) *PublicationQuery {
+	query := (&PublicationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, id),
+			sqlgraph.To(publication.Table, publication.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dsse.PublicationsTable, dsse.PublicationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransparencyLogEntries queries the transparency_log_entries edge of a Dsse.
+func (c *DsseClient) QueryTransparencyLogEntries(_m *Dsse) *TransparencyLogEntryQuery {
+	query := (&TransparencyLogEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, id),
+			sqlgraph.To(transparencylogentry.Table, transparencylogentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dsse.TransparencyLogEntriesTable, dsse.TransparencyLogEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLegalHolds queries the legal_holds edge of a Dsse.
+func (c *DsseClient) QueryLegalHolds(_m *Dsse) *LegalHoldQuery {
+	query := (&LegalHoldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, id),
+			sqlgraph.To(legalhold.Table, legalhold.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, dsse.LegalHoldsTable, dsse.LegalHoldsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DsseClient) Hooks() []Hook {
+	hooks := c.hooks.Dsse
+	return append(hooks[:len(hooks):len(hooks)], dsse.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DsseClient) Interceptors() []Interceptor {
+	inters := c.inters.Dsse
+	return append(inters[:len(inters):len(inters)], dsse.Interceptors[:]...)
+}
+
+func (c *DsseClient) mutate(ctx context.Context, m *DsseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DsseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DsseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DsseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DsseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Dsse mutation op: %q", m.Op())
+	}
+}
+
+// EnvironmentAttestationClient is a client for the EnvironmentAttestation schema.
+type EnvironmentAttestationClient struct {
+	config
+}
+
+// NewEnvironmentAttestationClient returns a client for the EnvironmentAttestation from the given config.
+func NewEnvironmentAttestationClient(c config) *EnvironmentAttestationClient {
+	return &EnvironmentAttestationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `environmentattestation.Hooks(f(g(h())))`.
+func (c *EnvironmentAttestationClient) Use(hooks ...Hook) {
+	c.hooks.EnvironmentAttestation = append(c.hooks.EnvironmentAttestation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `environmentattestation.Intercept(f(g(h())))`.
+func (c *EnvironmentAttestationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnvironmentAttestation = append(c.inters.EnvironmentAttestation, interceptors...)
+}
+
+// Create returns a builder for creating a EnvironmentAttestation entity.
+func (c *EnvironmentAttestationClient) Create() *EnvironmentAttestationCreate {
+	mutation := newEnvironmentAttestationMutation(c.config, OpCreate)
+	return &EnvironmentAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnvironmentAttestation entities.
+func (c *EnvironmentAttestationClient) CreateBulk(builders ...*EnvironmentAttestationCreate) *EnvironmentAttestationCreateBulk {
+	return &EnvironmentAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnvironmentAttestationClient) MapCreateBulk(slice any, setFunc func(*EnvironmentAttestationCreate, int)) *EnvironmentAttestationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnvironmentAttestationCreateBulk{err: fmt.Errorf("calling to EnvironmentAttestationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnvironmentAttestationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnvironmentAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnvironmentAttestation.
+func (c *EnvironmentAttestationClient) Update() *EnvironmentAttestationUpdate {
+	mutation := newEnvironmentAttestationMutation(c.config, OpUpdate)
+	return &EnvironmentAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnvironmentAttestationClient) UpdateOne(_m *EnvironmentAttestation) *EnvironmentAttestationUpdateOne {
+	mutation := newEnvironmentAttestationMutation(c.config, OpUpdateOne, withEnvironmentAttestation(_m))
+	return &EnvironmentAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnvironmentAttestationClient) UpdateOneID(id uuid.UUID) *EnvironmentAttestationUpdateOne {
+	mutation := newEnvironmentAttestationMutation(c.config, OpUpdateOne, withEnvironmentAttestationID(id))
+	return &EnvironmentAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnvironmentAttestation.
+func (c *EnvironmentAttestationClient) Delete() *EnvironmentAttestationDelete {
+	mutation := newEnvironmentAttestationMutation(c.config, OpDelete)
+	return &EnvironmentAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnvironmentAttestationClient) DeleteOne(_m *EnvironmentAttestation) *EnvironmentAttestationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnvironmentAttestationClient) DeleteOneID(id uuid.UUID) *EnvironmentAttestationDeleteOne {
+	builder := c.Delete().Where(environmentattestation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnvironmentAttestationDeleteOne{builder}
+}
+
+// Query returns a query builder for EnvironmentAttestation.
+func (c *EnvironmentAttestationClient) Query() *EnvironmentAttestationQuery {
+	return &EnvironmentAttestationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnvironmentAttestation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EnvironmentAttestation entity by its id.
+func (c *EnvironmentAttestationClient) Get(ctx context.Context, id uuid.UUID) (*EnvironmentAttestation, error) {
+	return c.Query().Where(environmentattestation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnvironmentAttestationClient) GetX(ctx context.Context, id uuid.UUID) *EnvironmentAttestation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttestation queries the attestation edge of a EnvironmentAttestation.
+func (c *EnvironmentAttestationClient) QueryAttestation(_m *EnvironmentAttestation) *AttestationQuery {
+	query := (&AttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environmentattestation.Table, environmentattestation.FieldID, id),
+			sqlgraph.To(attestation.Table, attestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, environmentattestation.AttestationTable, environmentattestation.AttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EnvironmentAttestationClient) Hooks() []Hook {
+	hooks := c.hooks.EnvironmentAttestation
+	return append(hooks[:len(hooks):len(hooks)], environmentattestation.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnvironmentAttestationClient) Interceptors() []Interceptor {
+	inters := c.inters.EnvironmentAttestation
+	return append(inters[:len(inters):len(inters)], environmentattestation.Interceptors[:]...)
+}
+
+func (c *EnvironmentAttestationClient) mutate(ctx context.Context, m *EnvironmentAttestationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnvironmentAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnvironmentAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnvironmentAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnvironmentAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EnvironmentAttestation mutation op: %q", m.Op())
+	}
+}
+
+// GitAttestationClient is a client for the GitAttestation schema.
+type GitAttestationClient struct {
+	config
+}
+
+// NewGitAttestationClient returns a client for the GitAttestation from the given config.
+func NewGitAttestationClient(c config) *GitAttestationClient {
+	return &GitAttestationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gitattestation.Hooks(f(g(h())))`.
+func (c *GitAttestationClient) Use(hooks ...Hook) {
+	c.hooks.GitAttestation = append(c.hooks.GitAttestation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gitattestation.Intercept(f(g(h())))`.
+func (c *GitAttestationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GitAttestation = append(c.inters.GitAttestation, interceptors...)
+}
+
+// Create returns a builder for creating a GitAttestation entity.
+func (c *GitAttestationClient) Create() *GitAttestationCreate {
+	mutation := newGitAttestationMutation(c.config, OpCreate)
+	return &GitAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GitAttestation entities.
+func (c *GitAttestationClient) CreateBulk(builders ...*GitAttestationCreate) *GitAttestationCreateBulk {
+	return &GitAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GitAttestationClient) MapCreateBulk(slice any, setFunc func(*GitAttestationCreate, int)) *GitAttestationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GitAttestationCreateBulk{err: fmt.Errorf("calling to GitAttestationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GitAttestationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GitAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GitAttestation.
+func (c *GitAttestationClient) Update() *GitAttestationUpdate {
+	mutation := newGitAttestationMutation(c.config, OpUpdate)
+	return &GitAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GitAttestationClient) UpdateOne(_m *GitAttestation) *GitAttestationUpdateOne {
+	mutation := newGitAttestationMutation(c.config, OpUpdateOne, withGitAttestation(_m))
+	return &GitAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GitAttestationClient) UpdateOneID(id uuid.UUID) *GitAttestationUpdateOne {
+	mutation := newGitAttestationMutation(c.config, OpUpdateOne, withGitAttestationID(id))
+	return &GitAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GitAttestation.
+func (c *GitAttestationClient) Delete() *GitAttestationDelete {
+	mutation := newGitAttestationMutation(c.config, OpDelete)
+	return &GitAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GitAttestationClient) DeleteOne(_m *GitAttestation) *GitAttestationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GitAttestationClient) DeleteOneID(id uuid.UUID) *GitAttestationDeleteOne {
+	builder := c.Delete().Where(gitattestation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GitAttestationDeleteOne{builder}
+}
+
+// Query returns a query builder for GitAttestation.
+func (c *GitAttestationClient) Query() *GitAttestationQuery {
+	return &GitAttestationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGitAttestation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GitAttestation entity by its id.
+func (c *GitAttestationClient) Get(ctx context.Context, id uuid.UUID) (*GitAttestation, error) {
+	return c.Query().Where(gitattestation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GitAttestationClient) GetX(ctx context.Context, id uuid.UUID) *GitAttestation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttestation queries the attestation edge of a GitAttestation.
+func (c *GitAttestationClient) QueryAttestation(_m *GitAttestation) *AttestationQuery {
+	query := (&AttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gitattestation.Table, gitattestation.FieldID, id),
+			sqlgraph.To(attestation.Table, attestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, gitattestation.AttestationTable, gitattestation.AttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GitAttestationClient) Hooks() []Hook {
+	hooks := c.hooks.GitAttestation
+	return append(hooks[:len(hooks):len(hooks)], gitattestation.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *GitAttestationClient) Interceptors() []Interceptor {
+	inters := c.inters.GitAttestation
+	return append(inters[:len(inters):len(inters)], gitattestation.Interceptors[:]...)
+}
+
+func (c *GitAttestationClient) mutate(ctx context.Context, m *GitAttestationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GitAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GitAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GitAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GitAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GitAttestation mutation op: %q", m.Op())
+	}
+}
+
+// GithubAttestationClient is a client for the GithubAttestation schema.
+type GithubAttestationClient struct {
+	config
+}
+
+// NewGithubAttestationClient returns a client for the GithubAttestation from the given config.
+func NewGithubAttestationClient(c config) *GithubAttestationClient {
+	return &GithubAttestationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `githubattestation.Hooks(f(g(h())))`.
+func (c *GithubAttestationClient) Use(hooks ...Hook) {
+	c.hooks.GithubAttestation = append(c.hooks.GithubAttestation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `githubattestation.Intercept(f(g(h())))`.
+func (c *GithubAttestationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GithubAttestation = append(c.inters.GithubAttestation, interceptors...)
+}
+
+// Create returns a builder for creating a GithubAttestation entity.
+func (c *GithubAttestationClient) Create() *GithubAttestationCreate {
+	mutation := newGithubAttestationMutation(c.config, OpCreate)
+	return &GithubAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GithubAttestation entities.
+func (c *GithubAttestationClient) CreateBulk(builders ...*GithubAttestationCreate) *GithubAttestationCreateBulk {
+	return &GithubAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GithubAttestationClient) MapCreateBulk(slice any, setFunc func(*GithubAttestationCreate, int)) *GithubAttestationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GithubAttestationCreateBulk{err: fmt.Errorf("calling to GithubAttestationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GithubAttestationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GithubAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GithubAttestation.
+func (c *GithubAttestationClient) Update() *GithubAttestationUpdate {
+	mutation := newGithubAttestationMutation(c.config, OpUpdate)
+	return &GithubAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GithubAttestationClient) UpdateOne(_m *GithubAttestation) *GithubAttestationUpdateOne {
+	mutation := newGithubAttestationMutation(c.config, OpUpdateOne, withGithubAttestation(_m))
+	return &GithubAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GithubAttestationClient) UpdateOneID(id uuid.UUID) *GithubAttestationUpdateOne {
+	mutation := newGithubAttestationMutation(c.config, OpUpdateOne, withGithubAttestationID(id))
+	return &GithubAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GithubAttestation.
+func (c *GithubAttestationClient) Delete() *GithubAttestationDelete {
+	mutation := newGithubAttestationMutation(c.config, OpDelete)
+	return &GithubAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GithubAttestationClient) DeleteOne(_m *GithubAttestation) *GithubAttestationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GithubAttestationClient) DeleteOneID(id uuid.UUID) *GithubAttestationDeleteOne {
+	builder := c.Delete().Where(githubattestation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GithubAttestationDeleteOne{builder}
+}
+
+// Query returns a query builder for GithubAttestation.
+func (c *GithubAttestationClient) Query() *GithubAttestationQuery {
+	return &GithubAttestationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGithubAttestation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GithubAttestation entity by its id.
+func (c *GithubAttestationClient) Get(ctx context.Context, id uuid.UUID) (*GithubAttestation, error) {
+	return c.Query().Where(githubattestation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GithubAttestationClient) GetX(ctx context.Context, id uuid.UUID) *GithubAttestation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttestation queries the attestation edge of a GithubAttestation.
+func (c *GithubAttestationClient) QueryAttestation(_m *GithubAttestation) *AttestationQuery {
+	query := (&AttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(githubattestation.Table, githubattestation.FieldID, id),
+			sqlgraph.To(attestation.Table, attestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, githubattestation.AttestationTable, githubattestation.AttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GithubAttestationClient) Hooks() []Hook {
+	hooks := c.hooks.GithubAttestation
+	return append(hooks[:len(hooks):len(hooks)], githubattestation.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *GithubAttestationClient) Interceptors() []Interceptor {
+	inters := c.inters.GithubAttestation
+	return append(inters[:len(inters):len(inters)], githubattestation.Interceptors[:]...)
+}
+
+func (c *GithubAttestationClient) mutate(ctx context.Context, m *GithubAttestationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GithubAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GithubAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GithubAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GithubAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GithubAttestation mutation op: %q", m.Op())
+	}
+}
+
+// GitlabAttestationClient is a client for the GitlabAttestation schema.
+type GitlabAttestationClient struct {
+	config
+}
+
+// NewGitlabAttestationClient returns a client for the GitlabAttestation from the given config.
+func NewGitlabAttestationClient(c config) *GitlabAttestationClient {
+	return &GitlabAttestationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gitlabattestation.Hooks(f(g(h())))`.
+func (c *GitlabAttestationClient) Use(hooks ...Hook) {
+	c.hooks.GitlabAttestation = append(c.hooks.GitlabAttestation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gitlabattestation.Intercept(f(g(h())))`.
+func (c *GitlabAttestationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GitlabAttestation = append(c.inters.GitlabAttestation, interceptors...)
+}
+
+// Create returns a builder for creating a GitlabAttestation entity.
+func (c *GitlabAttestationClient) Create() *GitlabAttestationCreate {
+	mutation := newGitlabAttestationMutation(c.config, OpCreate)
+	return &GitlabAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GitlabAttestation entities.
+func (c *GitlabAttestationClient) CreateBulk(builders ...*GitlabAttestationCreate) *GitlabAttestationCreateBulk {
+	return &GitlabAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GitlabAttestationClient) MapCreateBulk(slice any, setFunc func(*GitlabAttestationCreate, int)) *GitlabAttestationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GitlabAttestationCreateBulk{err: fmt.Errorf("calling to GitlabAttestationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GitlabAttestationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GitlabAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GitlabAttestation.
+func (c *GitlabAttestationClient) Update() *GitlabAttestationUpdate {
+	mutation := newGitlabAttestationMutation(c.config, OpUpdate)
+	return &GitlabAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GitlabAttestationClient) UpdateOne(_m *GitlabAttestation) *GitlabAttestationUpdateOne {
+	mutation := newGitlabAttestationMutation(c.config, OpUpdateOne, withGitlabAttestation(_m))
+	return &GitlabAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GitlabAttestationClient) UpdateOneID(id uuid.UUID) *GitlabAttestationUpdateOne {
+	mutation := newGitlabAttestationMutation(c.config, OpUpdateOne, withGitlabAttestationID(id))
+	return &GitlabAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GitlabAttestation.
+func (c *GitlabAttestationClient) Delete() *GitlabAttestationDelete {
+	mutation := newGitlabAttestationMutation(c.config, OpDelete)
+	return &GitlabAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GitlabAttestationClient) DeleteOne(_m *GitlabAttestation) *GitlabAttestationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GitlabAttestationClient) DeleteOneID(id uuid.UUID) *GitlabAttestationDeleteOne {
+	builder := c.Delete().Where(gitlabattestation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GitlabAttestationDeleteOne{builder}
+}
+
+// Query returns a query builder for GitlabAttestation.
+func (c *GitlabAttestationClient) Query() *GitlabAttestationQuery {
+	return &GitlabAttestationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGitlabAttestation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GitlabAttestation entity by its id.
+func (c *GitlabAttestationClient) Get(ctx context.Context, id uuid.UUID) (*GitlabAttestation, error) {
+	return c.Query().Where(gitlabattestation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GitlabAttestationClient) GetX(ctx context.Context, id uuid.UUID) *GitlabAttestation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttestation queries the attestation edge of a GitlabAttestation.
+func (c *GitlabAttestationClient) QueryAttestation(_m *GitlabAttestation) *AttestationQuery {
+	query := (&AttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gitlabattestation.Table, gitlabattestation.FieldID, id),
+			sqlgraph.To(attestation.Table, attestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, gitlabattestation.AttestationTable, gitlabattestation.AttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GitlabAttestationClient) Hooks() []Hook {
+	hooks := c.hooks.GitlabAttestation
+	return append(hooks[:len(hooks):len(hooks)], gitlabattestation.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *GitlabAttestationClient) Interceptors() []Interceptor {
+	inters := c.inters.GitlabAttestation
+	return append(inters[:len(inters):len(inters)], gitlabattestation.Interceptors[:]...)
+}
+
+func (c *GitlabAttestationClient) mutate(ctx context.Context, m *GitlabAttestationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GitlabAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GitlabAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GitlabAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GitlabAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GitlabAttestation mutation op: %q", m.Op())
+	}
+}
+
+// LegalHoldClient is a client for the LegalHold schema.
+type LegalHoldClient struct {
+	config
+}
+
+// NewLegalHoldClient returns a client for the LegalHold from the given config.
+func NewLegalHoldClient(c config) *LegalHoldClient {
+	return &LegalHoldClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `legalhold.Hooks(f(g(h())))`.
+func (c *LegalHoldClient) Use(hooks ...Hook) {
+	c.hooks.LegalHold = append(c.hooks.LegalHold, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `legalhold.Intercept(f(g(h())))`.
+func (c *LegalHoldClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LegalHold = append(c.inters.LegalHold, interceptors...)
+}
+
+// Create returns a builder for creating a LegalHold entity.
+func (c *LegalHoldClient) Create() *LegalHoldCreate {
+	mutation := newLegalHoldMutation(c.config, OpCreate)
+	return &LegalHoldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LegalHold entities.
+func (c *LegalHoldClient) CreateBulk(builders ...*LegalHoldCreate) *LegalHoldCreateBulk {
+	return &LegalHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LegalHoldClient) MapCreateBulk(slice any, setFunc func(*LegalHoldCreate, int)) *LegalHoldCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LegalHoldCreateBulk{err: fmt.Errorf("calling to LegalHoldClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LegalHoldCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LegalHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LegalHold.
+func (c *LegalHoldClient) Update() *LegalHoldUpdate {
+	mutation := newLegalHoldMutation(c.config, OpUpdate)
+	return &LegalHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LegalHoldClient) UpdateOne(_m *LegalHold) *LegalHoldUpdateOne {
+	mutation := newLegalHoldMutation(c.config, OpUpdateOne, withLegalHold(_m))
+	return &LegalHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LegalHoldClient) UpdateOneID(id uuid.UUID) *LegalHoldUpdateOne {
+	mutation := newLegalHoldMutation(c.config, OpUpdateOne, withLegalHoldID(id))
+	return &LegalHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LegalHold.
+func (c *LegalHoldClient) Delete() *LegalHoldDelete {
+	mutation := newLegalHoldMutation(c.config, OpDelete)
+	return &LegalHoldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LegalHoldClient) DeleteOne(_m *LegalHold) *LegalHoldDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LegalHoldClient) DeleteOneID(id uuid.UUID) *LegalHoldDeleteOne {
+	builder := c.Delete().Where(legalhold.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LegalHoldDeleteOne{builder}
+}
+
+// Query returns a query builder for LegalHold.
+func (c *LegalHoldClient) Query() *LegalHoldQuery {
+	return &LegalHoldQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLegalHold},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LegalHold entity by its id.
+func (c *LegalHoldClient) Get(ctx context.Context, id uuid.UUID) (*LegalHold, error) {
+	return c.Query().Where(legalhold.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LegalHoldClient) GetX(ctx context.Context, id uuid.UUID) *LegalHold {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDsses queries the dsses edge of a LegalHold.
+func (c *LegalHoldClient) QueryDsses(_m *LegalHold) *DsseQuery {
+	query := (&DsseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(legalhold.Table, legalhold.FieldID, id),
+			sqlgraph.To(dsse.Table, dsse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, legalhold.DssesTable, legalhold.DssesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LegalHoldClient) Hooks() []Hook {
+	hooks := c.hooks.LegalHold
+	return append(hooks[:len(hooks):len(hooks)], legalhold.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LegalHoldClient) Interceptors() []Interceptor {
+	inters := c.inters.LegalHold
+	return append(inters[:len(inters):len(inters)], legalhold.Interceptors[:]...)
+}
+
+func (c *LegalHoldClient) mutate(ctx context.Context, m *LegalHoldMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LegalHoldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LegalHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LegalHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LegalHoldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LegalHold mutation op: %q", m.Op())
+	}
+}
+
+// MaterialClient is a client for the Material schema.
+type MaterialClient struct {
+	config
+}
+
+// NewMaterialClient returns a client for the Material from the given config.
+func NewMaterialClient(c config) *MaterialClient {
+	return &MaterialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `material.Hooks(f(g(h())))`.
+func (c *MaterialClient) Use(hooks ...Hook) {
+	c.hooks.Material = append(c.hooks.Material, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `material.Intercept(f(g(h())))`.
+func (c *MaterialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Material = append(c.inters.Material, interceptors...)
+}
+
+// Create returns a builder for creating a Material entity.
+func (c *MaterialClient) Create() *MaterialCreate {
+	mutation := newMaterialMutation(c.config, OpCreate)
+	return &MaterialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Material entities.
+func (c *MaterialClient) CreateBulk(builders ...*MaterialCreate) *MaterialCreateBulk {
+	return &MaterialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MaterialClient) MapCreateBulk(slice any, setFunc func(*MaterialCreate, int)) *MaterialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MaterialCreateBulk{err: fmt.Errorf("calling to MaterialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MaterialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MaterialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Material.
+func (c *MaterialClient) Update() *MaterialUpdate {
+	mutation := newMaterialMutation(c.config, OpUpdate)
+	return &MaterialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MaterialClient) UpdateOne(_m *Material) *MaterialUpdateOne {
+	mutation := newMaterialMutation(c.config, OpUpdateOne, withMaterial(_m))
+	return &MaterialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MaterialClient) UpdateOneID(id uuid.UUID) *MaterialUpdateOne {
+	mutation := newMaterialMutation(c.config, OpUpdateOne, withMaterialID(id))
+	return &MaterialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Material.
+func (c *MaterialClient) Delete() *MaterialDelete {
+	mutation := newMaterialMutation(c.config, OpDelete)
+	return &MaterialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MaterialClient) DeleteOne(_m *Material) *MaterialDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MaterialClient) DeleteOneID(id uuid.UUID) *MaterialDeleteOne {
+	builder := c.Delete().Where(material.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MaterialDeleteOne{builder}
+}
+
+// Query returns a query builder for Material.
+func (c *MaterialClient) Query() *MaterialQuery {
+	return &MaterialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMaterial},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Material entity by its id.
+func (c *MaterialClient) Get(ctx context.Context, id uuid.UUID) (*Material, error) {
+	return c.Query().Where(material.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MaterialClient) GetX(ctx context.Context, id uuid.UUID) *Material {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttestation queries the attestation edge of a Material.
+func (c *MaterialClient) QueryAttestation(_m *Material) *AttestationQuery {
+	query := (&AttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(material.Table, material.FieldID, id),
+			sqlgraph.To(attestation.Table, attestation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, material.AttestationTable, material.AttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MaterialClient) Hooks() []Hook {
+	hooks := c.hooks.Material
+	return append(hooks[:len(hooks):len(hooks)], material.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MaterialClient) Interceptors() []Interceptor {
+	inters := c.inters.Material
+	return append(inters[:len(inters):len(inters)], material.Interceptors[:]...)
+}
+
+func (c *MaterialClient) mutate(ctx context.Context, m *MaterialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MaterialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MaterialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MaterialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MaterialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Material mutation op: %q", m.Op())
+	}
+}
+
+// OciAttestationClient is a client for the OciAttestation schema.
+type OciAttestationClient struct {
+	config
+}
+
+// NewOciAttestationClient returns a client for the OciAttestation from the given config.
+func NewOciAttestationClient(c config) *OciAttestationClient {
+	return &OciAttestationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ociattestation.Hooks(f(g(h())))`.
+func (c *OciAttestationClient) Use(hooks ...Hook) {
+	c.hooks.OciAttestation = append(c.hooks.OciAttestation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ociattestation.Intercept(f(g(h())))`.
+func (c *OciAttestationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OciAttestation = append(c.inters.OciAttestation, interceptors...)
+}
+
+// Create returns a builder for creating a OciAttestation entity.
+func (c *OciAttestationClient) Create() *OciAttestationCreate {
+	mutation := newOciAttestationMutation(c.config, OpCreate)
+	return &OciAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OciAttestation entities.
+func (c *OciAttestationClient) CreateBulk(builders ...*OciAttestationCreate) *OciAttestationCreateBulk {
+	return &OciAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OciAttestationClient) MapCreateBulk(slice any, setFunc func(*OciAttestationCreate, int)) *OciAttestationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OciAttestationCreateBulk{err: fmt.Errorf("calling to OciAttestationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OciAttestationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OciAttestationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OciAttestation.
+func (c *OciAttestationClient) Update() *OciAttestationUpdate {
+	mutation := newOciAttestationMutation(c.config, OpUpdate)
+	return &OciAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OciAttestationClient) UpdateOne(_m *OciAttestation) *OciAttestationUpdateOne {
+	mutation := newOciAttestationMutation(c.config, OpUpdateOne, withOciAttestation(_m))
+	return &OciAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OciAttestationClient) UpdateOneID(id uuid.UUID) *OciAttestationUpdateOne {
+	mutation := newOciAttestationMutation(c.config, OpUpdateOne, withOciAttestationID(id))
+	return &OciAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OciAttestation.
+func (c *OciAttestationClient) Delete() *OciAttestationDelete {
+	mutation := newOciAttestationMutation(c.config, OpDelete)
+	return &OciAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OciAttestationClient) DeleteOne(_m *OciAttestation) *OciAttestationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OciAttestationClient) DeleteOneID(id uuid.UUID) *OciAttestationDeleteOne {
+	builder := c.Delete().Where(ociattestation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OciAttestationDeleteOne{builder}
+}
+
+// Query returns a query builder for OciAttestation.
+func (c *OciAttestationClient) Query() *OciAttestationQuery {
+	return &OciAttestationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOciAttestation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OciAttestation entity by its id.
+func (c *OciAttestationClient) Get(ctx context.Context, id uuid.UUID) (*OciAttestation, error) {
+	return c.Query().Where(ociattestation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OciAttestationClient) GetX(ctx context.Context, id uuid.UUID) *OciAttestation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttestation queries the attestation edge of a OciAttestation.
+func (c *OciAttestationClient) QueryAttestation(_m *OciAttestation) *AttestationQuery {
+	query := (&AttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ociattestation.Table, ociattestation.FieldID, id),
+			sqlgraph.To(attestation.Table, attestation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ociattestation.AttestationTable, ociattestation.AttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OciAttestationClient) Hooks() []Hook {
+	hooks := c.hooks.OciAttestation
+	return append(hooks[:len(hooks):len(hooks)], ociattestation.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OciAttestationClient) Interceptors() []Interceptor {
+	inters := c.inters.OciAttestation
+	return append(inters[:len(inters):len(inters)], ociattestation.Interceptors[:]...)
+}
+
+func (c *OciAttestationClient) mutate(ctx context.Context, m *OciAttestationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OciAttestationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OciAttestationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OciAttestationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OciAttestationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OciAttestation mutation op: %q", m.Op())
+	}
+}
+
+// PayloadDigestClient is a client for the PayloadDigest schema.
+type PayloadDigestClient struct {
+	config
+}
+
+// NewPayloadDigestClient returns a client for the PayloadDigest from the given config.
+func NewPayloadDigestClient(c config) *PayloadDigestClient {
+	return &PayloadDigestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payloaddigest.Hooks(f(g(h())))`.
+func (c *PayloadDigestClient) Use(hooks ...Hook) {
+	c.hooks.PayloadDigest = append(c.hooks.PayloadDigest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payloaddigest.Intercept(f(g(h())))`.
+func (c *PayloadDigestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayloadDigest = append(c.inters.PayloadDigest, interceptors...)
+}
+
+// Create returns a builder for creating a PayloadDigest entity.
+func (c *PayloadDigestClient) Create() *PayloadDigestCreate {
+	mutation := newPayloadDigestMutation(c.config, OpCreate)
+	return &PayloadDigestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayloadDigest entities.
+func (c *PayloadDigestClient) CreateBulk(builders ...*PayloadDigestCreate) *PayloadDigestCreateBulk {
+	return &PayloadDigestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayloadDigestClient) MapCreateBulk(slice any, setFunc func(*PayloadDigestCreate, int)) *PayloadDigestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayloadDigestCreateBulk{err: fmt.Errorf("calling to PayloadDigestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayloadDigestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayloadDigestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayloadDigest.
+func (c *PayloadDigestClient) Update() *PayloadDigestUpdate {
+	mutation := newPayloadDigestMutation(c.config, OpUpdate)
+	return &PayloadDigestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayloadDigestClient) UpdateOne(_m *PayloadDigest) *PayloadDigestUpdateOne {
+	mutation := newPayloadDigestMutation(c.config, OpUpdateOne, withPayloadDigest(_m))
+	return &PayloadDigestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayloadDigestClient) UpdateOneID(id uuid.UUID) *PayloadDigestUpdateOne {
+	mutation := newPayloadDigestMutation(c.config, OpUpdateOne, withPayloadDigestID(id))
+	return &PayloadDigestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayloadDigest.
+func (c *PayloadDigestClient) Delete() *PayloadDigestDelete {
+	mutation := newPayloadDigestMutation(c.config, OpDelete)
+	return &PayloadDigestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayloadDigestClient) DeleteOne(_m *PayloadDigest) *PayloadDigestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayloadDigestClient) DeleteOneID(id uuid.UUID) *PayloadDigestDeleteOne {
+	builder := c.Delete().Where(payloaddigest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayloadDigestDeleteOne{builder}
+}
+
+// Query returns a query builder for PayloadDigest.
+func (c *PayloadDigestClient) Query() *PayloadDigestQuery {
+	return &PayloadDigestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayloadDigest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayloadDigest entity by its id.
+func (c *PayloadDigestClient) Get(ctx context.Context, id uuid.UUID) (*PayloadDigest, error) {
+	return c.Query().Where(payloaddigest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayloadDigestClient) GetX(ctx context.Context, id uuid.UUID) *PayloadDigest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDsse queries the dsse edge of a PayloadDigest.
+func (c *PayloadDigestClient) QueryDsse(_m *PayloadDigest) *DsseQuery {
+	query := (&DsseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payloaddigest.Table, payloaddigest.FieldID, id),
+			sqlgraph.To(dsse.Table, dsse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payloaddigest.DsseTable, payloaddigest.DsseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PayloadDigestClient) Hooks() []Hook {
+	hooks := c.hooks.PayloadDigest
+	return append(hooks[:len(hooks):len(hooks)], payloaddigest.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayloadDigestClient) Interceptors() []Interceptor {
+	inters := c.inters.PayloadDigest
+	return append(inters[:len(inters):len(inters)], payloaddigest.Interceptors[:]...)
+}
+
+func (c *PayloadDigestClient) mutate(ctx context.Context, m *PayloadDigestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayloadDigestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayloadDigestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayloadDigestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayloadDigestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayloadDigest mutation op: %q", m.Op())
+	}
+}
+
+// ProductClient is a client for the Product schema.
+type ProductClient struct {
+	config
+}
+
+// NewProductClient returns a client for the Product from the given config.
+func NewProductClient(c config) *ProductClient {
+	return &ProductClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `product.Hooks(f(g(h())))`.
+func (c *ProductClient) Use(hooks ...Hook) {
+	c.hooks.Product = append(c.hooks.Product, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `product.Intercept(f(g(h())))`.
+func (c *ProductClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Product = append(c.inters.Product, interceptors...)
+}
+
+// Create returns a builder for creating a Product entity.
+func (c *ProductClient) Create() *ProductCreate {
+	mutation := newProductMutation(c.config, OpCreate)
+	return &ProductCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Product entities.
+func (c *ProductClient) CreateBulk(builders ...*ProductCreate) *ProductCreateBulk {
+	return &ProductCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProductClient) MapCreateBulk(slice any, setFunc func(*ProductCreate, int)) *ProductCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProductCreateBulk{err: fmt.Errorf("calling to ProductClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProductCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProductCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Product.
+func (c *ProductClient) Update() *ProductUpdate {
+	mutation := newProductMutation(c.config, OpUpdate)
+	return &ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductClient) UpdateOne(_m *Product) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProduct(_m))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductClient) UpdateOneID(id uuid.UUID) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProductID(id))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Product.
+func (c *ProductClient) Delete() *ProductDelete {
+	mutation := newProductMutation(c.config, OpDelete)
+	return &ProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductClient) DeleteOne(_m *Product) *ProductDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProductClient) DeleteOneID(id uuid.UUID) *ProductDeleteOne {
+	builder := c.Delete().Where(product.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductDeleteOne{builder}
+}
+
+// Query returns a query builder for Product.
+func (c *ProductClient) Query() *ProductQuery {
+	return &ProductQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProduct},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Product entity by its id.
+func (c *ProductClient) Get(ctx context.Context, id uuid.UUID) (*Product, error) {
+	return c.Query().Where(product.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductClient) GetX(ctx context.Context, id uuid.UUID) *Product {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttestation queries the attestation edge of a Product.
+func (c *ProductClient) QueryAttestation(_m *Product) *AttestationQuery {
+	query := (&AttestationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(attestation.Table, attestation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, product.AttestationTable, product.AttestationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProductClient) Hooks() []Hook {
+	hooks := c.hooks.Product
+	return append(hooks[:len(hooks):len(hooks)], product.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProductClient) Interceptors() []Interceptor {
+	inters := c.inters.Product
+	return append(inters[:len(inters):len(inters)], product.Interceptors[:]...)
+}
+
+func (c *ProductClient) mutate(ctx context.Context, m *ProductMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Product mutation op: %q", m.Op())
+	}
+}
+
+// PublicationClient is a client for the Publication schema.
+type PublicationClient struct {
+	config
+}
+
+// NewPublicationClient returns a client for the Publication from the given config.
+func NewPublicationClient(c config) *PublicationClient {
+	return &PublicationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `publication.Hooks(f(g(h())))`.
+func (c *PublicationClient) Use(hooks ...Hook) {
+	c.hooks.Publication = append(c.hooks.Publication, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `publication.Intercept(f(g(h())))`.
+func (c *PublicationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Publication = append(c.inters.Publication, interceptors...)
+}
+
+// Create returns a builder for creating a Publication entity.
+func (c *PublicationClient) Create() *PublicationCreate {
+	mutation := newPublicationMutation(c.config, OpCreate)
+	return &PublicationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Publication entities.
+func (c *PublicationClient) CreateBulk(builders ...*PublicationCreate) *PublicationCreateBulk {
+	return &PublicationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PublicationClient) MapCreateBulk(slice any, setFunc func(*PublicationCreate, int)) *PublicationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PublicationCreateBulk{err: fmt.Errorf("calling to PublicationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PublicationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PublicationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Publication.
+func (c *PublicationClient) Update() *PublicationUpdate {
+	mutation := newPublicationMutation(c.config, OpUpdate)
+	return &PublicationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PublicationClient) UpdateOne(_m *Publication) *PublicationUpdateOne {
+	mutation := newPublicationMutation(c.config, OpUpdateOne, withPublication(_m))
+	return &PublicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PublicationClient) UpdateOneID(id uuid.UUID) *PublicationUpdateOne {
+	mutation := newPublicationMutation(c.config, OpUpdateOne, withPublicationID(id))
+	return &PublicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Publication.
+func (c *PublicationClient) Delete() *PublicationDelete {
+	mutation := newPublicationMutation(c.config, OpDelete)
+	return &PublicationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PublicationClient) DeleteOne(_m *Publication) *PublicationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PublicationClient) DeleteOneID(id uuid.UUID) *PublicationDeleteOne {
+	builder := c.Delete().Where(publication.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PublicationDeleteOne{builder}
+}
+
+// Query returns a query builder for Publication.
+func (c *PublicationClient) Query() *PublicationQuery {
+	return &PublicationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePublication},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Publication entity by its id.
+func (c *PublicationClient) Get(ctx context.Context, id uuid.UUID) (*Publication, error) {
+	return c.Query().Where(publication.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PublicationClient) GetX(ctx context.Context, id uuid.UUID) *Publication {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDsse queries the dsse edge of a Publication.
+func (c *PublicationClient) QueryDsse(_m *Publication) *DsseQuery {
+	query := (&DsseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(publication.Table, publication.FieldID, id),
+			sqlgraph.To(dsse.Table, dsse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, publication.DsseTable, publication.DsseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PublicationClient) Hooks() []Hook {
+	hooks := c.hooks.Publication
+	return append(hooks[:len(hooks):len(hooks)], publication.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PublicationClient) Interceptors() []Interceptor {
+	inters := c.inters.Publication
+	return append(inters[:len(inters):len(inters)], publication.Interceptors[:]...)
+}
+
+func (c *PublicationClient) mutate(ctx context.Context, m *PublicationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PublicationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PublicationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PublicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PublicationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Publication mutation op: %q", m.Op())
+	}
+}
+
+// PublishDeliveryClient is a client for the PublishDelivery schema.
+type PublishDeliveryClient struct {
+	config
+}
+
+// NewPublishDeliveryClient returns a client for the PublishDelivery from the given config.
+func NewPublishDeliveryClient(c config) *PublishDeliveryClient {
+	return &PublishDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `publishdelivery.Hooks(f(g(h())))`.
+func (c *PublishDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.PublishDelivery = append(c.hooks.PublishDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `publishdelivery.Intercept(f(g(h())))`.
+func (c *PublishDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PublishDelivery = append(c.inters.PublishDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a PublishDelivery entity.
+func (c *PublishDeliveryClient) Create() *PublishDeliveryCreate {
+	mutation := newPublishDeliveryMutation(c.config, OpCreate)
+	return &PublishDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PublishDelivery entities.
+func (c *PublishDeliveryClient) CreateBulk(builders ...*PublishDeliveryCreate) *PublishDeliveryCreateBulk {
+	return &PublishDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PublishDeliveryClient) MapCreateBulk(slice any, setFunc func(*PublishDeliveryCreate, int)) *PublishDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PublishDeliveryCreateBulk{err: fmt.Errorf("calling to PublishDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PublishDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PublishDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PublishDelivery.
+func (c *PublishDeliveryClient) Update() *PublishDeliveryUpdate {
+	mutation := newPublishDeliveryMutation(c.config, OpUpdate)
+	return &PublishDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PublishDeliveryClient) UpdateOne(_m *PublishDelivery) *PublishDeliveryUpdateOne {
+	mutation := newPublishDeliveryMutation(c.config, OpUpdateOne, withPublishDelivery(_m))
+	return &PublishDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PublishDeliveryClient) UpdateOneID(id uuid.UUID) *PublishDeliveryUpdateOne {
+	mutation := newPublishDeliveryMutation(c.config, OpUpdateOne, withPublishDeliveryID(id))
+	return &PublishDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PublishDelivery.
+func (c *PublishDeliveryClient) Delete() *PublishDeliveryDelete {
+	mutation := newPublishDeliveryMutation(c.config, OpDelete)
+	return &PublishDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PublishDeliveryClient) DeleteOne(_m *PublishDelivery) *PublishDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PublishDeliveryClient) DeleteOneID(id uuid.UUID) *PublishDeliveryDeleteOne {
+	builder := c.Delete().Where(publishdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PublishDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for PublishDelivery.
+func (c *PublishDeliveryClient) Query() *PublishDeliveryQuery {
+	return &PublishDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePublishDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PublishDelivery entity by its id.
+func (c *PublishDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*PublishDelivery, error) {
+	return c.Query().Where(publishdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PublishDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *PublishDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDsse queries the dsse edge of a PublishDelivery.
+func (c *PublishDeliveryClient) QueryDsse(_m *PublishDelivery) *DsseQuery {
+	query := (&DsseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(publishdelivery.Table, publishdelivery.FieldID, id),
+			sqlgraph.To(dsse.Table, dsse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, publishdelivery.DsseTable, publishdelivery.DsseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PublishDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.PublishDelivery
+	return append(hooks[:len(hooks):len(hooks)], publishdelivery.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PublishDeliveryClient) Interceptors() []Interceptor {
+	inters := c.inters.PublishDelivery
+	return append(inters[:len(inters):len(inters)], publishdelivery.Interceptors[:]...)
+}
+
+func (c *PublishDeliveryClient) mutate(ctx context.Context, m *PublishDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PublishDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PublishDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PublishDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PublishDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PublishDelivery mutation op: %q", m.Op())
+	}
+}
+
+// SbomPackageClient is a client for the SbomPackage schema.
+type SbomPackageClient struct {
+	config
+}
+
+// NewSbomPackageClient returns a client for the SbomPackage from the given config.
+func NewSbomPackageClient(c config) *SbomPackageClient {
+	return &SbomPackageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sbompackage.Hooks(f(g(h())))`.
+func (c *SbomPackageClient) Use(hooks ...Hook) {
+	c.hooks.SbomPackage = append(c.hooks.SbomPackage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sbompackage.Intercept(f(g(h())))`.
+func (c *SbomPackageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SbomPackage = append(c.inters.SbomPackage, interceptors...)
+}
+
+// Create returns a builder for creating a SbomPackage entity.
+func (c *SbomPackageClient) Create() *SbomPackageCreate {
+	mutation := newSbomPackageMutation(c.config, OpCreate)
+	return &SbomPackageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SbomPackage entities.
+func (c *SbomPackageClient) CreateBulk(builders ...*SbomPackageCreate) *SbomPackageCreateBulk {
+	return &SbomPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SbomPackageClient) MapCreateBulk(slice any, setFunc func(*SbomPackageCreate, int)) *SbomPackageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SbomPackageCreateBulk{err: fmt.Errorf("calling to SbomPackageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SbomPackageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SbomPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SbomPackage.
+func (c *SbomPackageClient) Update() *SbomPackageUpdate {
+	mutation := newSbomPackageMutation(c.config, OpUpdate)
+	return &SbomPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SbomPackageClient) UpdateOne(_m *SbomPackage) *SbomPackageUpdateOne {
+	mutation := newSbomPackageMutation(c.config, OpUpdateOne, withSbomPackage(_m))
+	return &SbomPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SbomPackageClient) UpdateOneID(id uuid.UUID) *SbomPackageUpdateOne {
+	mutation := newSbomPackageMutation(c.config, OpUpdateOne, withSbomPackageID(id))
+	return &SbomPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SbomPackage.
+func (c *SbomPackageClient) Delete() *SbomPackageDelete {
+	mutation := newSbomPackageMutation(c.config, OpDelete)
+	return &SbomPackageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SbomPackageClient) DeleteOne(_m *SbomPackage) *SbomPackageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SbomPackageClient) DeleteOneID(id uuid.UUID) *SbomPackageDeleteOne {
+	builder := c.Delete().Where(sbompackage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SbomPackageDeleteOne{builder}
+}
+
+// Query returns a query builder for SbomPackage.
+func (c *SbomPackageClient) Query() *SbomPackageQuery {
+	return &SbomPackageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSbomPackage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SbomPackage entity by its id.
+func (c *SbomPackageClient) Get(ctx context.Context, id uuid.UUID) (*SbomPackage, error) {
+	return c.Query().Where(sbompackage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SbomPackageClient) GetX(ctx context.Context, id uuid.UUID) *SbomPackage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDigests queries the digests edge of a SbomPackage.
+func (c *SbomPackageClient) QueryDigests(_m *SbomPackage) *SbomPackageDigestQuery {
+	query := (&SbomPackageDigestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sbompackage.Table, sbompackage.FieldID, id),
+			sqlgraph.To(sbompackagedigest.Table, sbompackagedigest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sbompackage.DigestsTable, sbompackage.DigestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStatement queries the statement edge of a SbomPackage.
+func (c *SbomPackageClient) QueryStatement(_m *SbomPackage) *StatementQuery {
+	query := (&StatementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sbompackage.Table, sbompackage.FieldID, id),
+			sqlgraph.To(statement.Table, statement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sbompackage.StatementTable, sbompackage.StatementColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SbomPackageClient) Hooks() []Hook {
+	hooks := c.hooks.SbomPackage
+	return append(hooks[:len(hooks):len(hooks)], sbompackage.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SbomPackageClient) Interceptors() []Interceptor {
+	inters := c.inters.SbomPackage
+	return append(inters[:len(inters):len(inters)], sbompackage.Interceptors[:]...)
+}
+
+func (c *SbomPackageClient) mutate(ctx context.Context, m *SbomPackageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SbomPackageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SbomPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SbomPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SbomPackageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SbomPackage mutation op: %q", m.Op())
+	}
+}
+
+// SbomPackageDigestClient is a client for the SbomPackageDigest schema.
+type SbomPackageDigestClient struct {
+	config
+}
+
+// NewSbomPackageDigestClient returns a client for the SbomPackageDigest from the given config.
+func NewSbomPackageDigestClient(c config) *SbomPackageDigestClient {
+	return &SbomPackageDigestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sbompackagedigest.Hooks(f(g(h())))`.
+func (c *SbomPackageDigestClient) Use(hooks ...Hook) {
+	c.hooks.SbomPackageDigest = append(c.hooks.SbomPackageDigest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sbompackagedigest.Intercept(f(g(h())))`.
+func (c *SbomPackageDigestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SbomPackageDigest = append(c.inters.SbomPackageDigest, interceptors...)
+}
+
+// Create returns a builder for creating a SbomPackageDigest entity.
+func (c *SbomPackageDigestClient) Create() *SbomPackageDigestCreate {
+	mutation := newSbomPackageDigestMutation(c.config, OpCreate)
+	return &SbomPackageDigestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SbomPackageDigest entities.
+func (c *SbomPackageDigestClient) CreateBulk(builders ...*SbomPackageDigestCreate) *SbomPackageDigestCreateBulk {
+	return &SbomPackageDigestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SbomPackageDigestClient) MapCreateBulk(slice any, setFunc func(*SbomPackageDigestCreate, int)) *SbomPackageDigestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SbomPackageDigestCreateBulk{err: fmt.Errorf("calling to SbomPackageDigestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SbomPackageDigestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SbomPackageDigestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SbomPackageDigest.
+func (c *SbomPackageDigestClient) Update() *SbomPackageDigestUpdate {
+	mutation := newSbomPackageDigestMutation(c.config, OpUpdate)
+	return &SbomPackageDigestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SbomPackageDigestClient) UpdateOne(_m *SbomPackageDigest) *SbomPackageDigestUpdateOne {
+	mutation := newSbomPackageDigestMutation(c.config, OpUpdateOne, withSbomPackageDigest(_m))
+	return &SbomPackageDigestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SbomPackageDigestClient) UpdateOneID(id uuid.UUID) *SbomPackageDigestUpdateOne {
+	mutation := newSbomPackageDigestMutation(c.config, OpUpdateOne, withSbomPackageDigestID(id))
+	return &SbomPackageDigestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SbomPackageDigest.
+func (c *SbomPackageDigestClient) Delete() *SbomPackageDigestDelete {
+	mutation := newSbomPackageDigestMutation(c.config, OpDelete)
+	return &SbomPackageDigestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SbomPackageDigestClient) DeleteOne(_m *SbomPackageDigest) *SbomPackageDigestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SbomPackageDigestClient) DeleteOneID(id uuid.UUID) *SbomPackageDigestDeleteOne {
+	builder := c.Delete().Where(sbompackagedigest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SbomPackageDigestDeleteOne{builder}
+}
+
+// Query returns a query builder for SbomPackageDigest.
+func (c *SbomPackageDigestClient) Query() *SbomPackageDigestQuery {
+	return &SbomPackageDigestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSbomPackageDigest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SbomPackageDigest entity by its id.
+func (c *SbomPackageDigestClient) Get(ctx context.Context, id uuid.UUID) (*SbomPackageDigest, error) {
+	return c.Query().Where(sbompackagedigest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SbomPackageDigestClient) GetX(ctx context.Context, id uuid.UUID) *SbomPackageDigest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySbomPackage queries the sbom_package edge of a SbomPackageDigest.
+func (c *SbomPackageDigestClient) QuerySbomPackage(_m *SbomPackageDigest) *SbomPackageQuery {
+	query := (&SbomPackageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sbompackagedigest.Table, sbompackagedigest.FieldID, id),
+			sqlgraph.To(sbompackage.Table, sbompackage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sbompackagedigest.SbomPackageTable, sbompackagedigest.SbomPackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SbomPackageDigestClient) Hooks() []Hook {
+	hooks := c.hooks.SbomPackageDigest
+	return append(hooks[:len(hooks):len(hooks)], sbompackagedigest.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SbomPackageDigestClient) Interceptors() []Interceptor {
+	inters := c.inters.SbomPackageDigest
+	return append(inters[:len(inters):len(inters)], sbompackagedigest.Interceptors[:]...)
+}
+
+func (c *SbomPackageDigestClient) mutate(ctx context.Context, m *SbomPackageDigestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SbomPackageDigestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SbomPackageDigestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SbomPackageDigestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SbomPackageDigestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SbomPackageDigest mutation op: %q", m.Op())
+	}
+}
+
+// SignatureClient is a client for the Signature schema.
+type SignatureClient struct {
+	config
+}
+
+// NewSignatureClient returns a client for the Signature from the given config.
+func NewSignatureClient(c config) *SignatureClient {
+	return &SignatureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signature.Hooks(f(g(h())))`.
+func (c *SignatureClient) Use(hooks ...Hook) {
+	c.hooks.Signature = append(c.hooks.Signature, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `signature.Intercept(f(g(h())))`.
+func (c *SignatureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Signature = append(c.inters.Signature, interceptors...)
 }
 
 // Create returns a builder for creating a Signature entity.
@@ -1103,79 +3611,413 @@ func (c *SignatureClient) Create() *SignatureCreate {
 	return &SignatureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Signature entities.
-func (c *SignatureClient) CreateBulk(builders ...*SignatureCreate) *SignatureCreateBulk {
-	return &SignatureCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Signature entities.
+func (c *SignatureClient) CreateBulk(builders ...*SignatureCreate) *SignatureCreateBulk {
+	return &SignatureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SignatureClient) MapCreateBulk(slice any, setFunc func(*SignatureCreate, int)) *SignatureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SignatureCreateBulk{err: fmt.Errorf("calling to SignatureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SignatureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SignatureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Signature.
+func (c *SignatureClient) Update() *SignatureUpdate {
+	mutation := newSignatureMutation(c.config, OpUpdate)
+	return &SignatureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SignatureClient) UpdateOne(_m *Signature) *SignatureUpdateOne {
+	mutation := newSignatureMutation(c.config, OpUpdateOne, withSignature(_m))
+	return &SignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SignatureClient) UpdateOneID(id uuid.UUID) *SignatureUpdateOne {
+	mutation := newSignatureMutation(c.config, OpUpdateOne, withSignatureID(id))
+	return &SignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Signature.
+func (c *SignatureClient) Delete() *SignatureDelete {
+	mutation := newSignatureMutation(c.config, OpDelete)
+	return &SignatureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SignatureClient) DeleteOne(_m *Signature) *SignatureDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SignatureClient) DeleteOneID(id uuid.UUID) *SignatureDeleteOne {
+	builder := c.Delete().Where(signature.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SignatureDeleteOne{builder}
+}
+
+// Query returns a query builder for Signature.
+func (c *SignatureClient) Query() *SignatureQuery {
+	return &SignatureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSignature},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Signature entity by its id.
+func (c *SignatureClient) Get(ctx context.Context, id uuid.UUID) (*Signature, error) {
+	return c.Query().Where(signature.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SignatureClient) GetX(ctx context.Context, id uuid.UUID) *Signature {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDsse queries the dsse edge of a Signature.
+func (c *SignatureClient) QueryDsse(_m *Signature) *DsseQuery {
+	query := (&DsseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(signature.Table, signature.FieldID, id),
+			sqlgraph.To(dsse.Table, dsse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, signature.DsseTable, signature.DsseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTimestamps queries the timestamps edge of a Signature.
+func (c *SignatureClient) QueryTimestamps(_m *Signature) *TimestampQuery {
+	query := (&TimestampClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(signature.Table, signature.FieldID, id),
+			sqlgraph.To(timestamp.Table, timestamp.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, signature.TimestampsTable, signature.TimestampsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCertificates queries the certificates edge of a Signature.
+func (c *SignatureClient) QueryCertificates(_m *Signature) *CertificateQuery {
+	query := (&CertificateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(signature.Table, signature.FieldID, id),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, signature.CertificatesTable, signature.CertificatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SignatureClient) Hooks() []Hook {
+	hooks := c.hooks.Signature
+	return append(hooks[:len(hooks):len(hooks)], signature.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SignatureClient) Interceptors() []Interceptor {
+	inters := c.inters.Signature
+	return append(inters[:len(inters):len(inters)], signature.Interceptors[:]...)
+}
+
+func (c *SignatureClient) mutate(ctx context.Context, m *SignatureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SignatureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SignatureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SignatureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Signature mutation op: %q", m.Op())
+	}
+}
+
+// SlsaDependencyClient is a client for the SlsaDependency schema.
+type SlsaDependencyClient struct {
+	config
+}
+
+// NewSlsaDependencyClient returns a client for the SlsaDependency from the given config.
+func NewSlsaDependencyClient(c config) *SlsaDependencyClient {
+	return &SlsaDependencyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `slsadependency.Hooks(f(g(h())))`.
+func (c *SlsaDependencyClient) Use(hooks ...Hook) {
+	c.hooks.SlsaDependency = append(c.hooks.SlsaDependency, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `slsadependency.Intercept(f(g(h())))`.
+func (c *SlsaDependencyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SlsaDependency = append(c.inters.SlsaDependency, interceptors...)
+}
+
+// Create returns a builder for creating a SlsaDependency entity.
+func (c *SlsaDependencyClient) Create() *SlsaDependencyCreate {
+	mutation := newSlsaDependencyMutation(c.config, OpCreate)
+	return &SlsaDependencyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SlsaDependency entities.
+func (c *SlsaDependencyClient) CreateBulk(builders ...*SlsaDependencyCreate) *SlsaDependencyCreateBulk {
+	return &SlsaDependencyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SlsaDependencyClient) MapCreateBulk(slice any, setFunc func(*SlsaDependencyCreate, int)) *SlsaDependencyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SlsaDependencyCreateBulk{err: fmt.Errorf("calling to SlsaDependencyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SlsaDependencyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SlsaDependencyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SlsaDependency.
+func (c *SlsaDependencyClient) Update() *SlsaDependencyUpdate {
+	mutation := newSlsaDependencyMutation(c.config, OpUpdate)
+	return &SlsaDependencyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SlsaDependencyClient) UpdateOne(_m *SlsaDependency) *SlsaDependencyUpdateOne {
+	mutation := newSlsaDependencyMutation(c.config, OpUpdateOne, withSlsaDependency(_m))
+	return &SlsaDependencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SlsaDependencyClient) UpdateOneID(id uuid.UUID) *SlsaDependencyUpdateOne {
+	mutation := newSlsaDependencyMutation(c.config, OpUpdateOne, withSlsaDependencyID(id))
+	return &SlsaDependencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SlsaDependency.
+func (c *SlsaDependencyClient) Delete() *SlsaDependencyDelete {
+	mutation := newSlsaDependencyMutation(c.config, OpDelete)
+	return &SlsaDependencyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SlsaDependencyClient) DeleteOne(_m *SlsaDependency) *SlsaDependencyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SlsaDependencyClient) DeleteOneID(id uuid.UUID) *SlsaDependencyDeleteOne {
+	builder := c.Delete().Where(slsadependency.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SlsaDependencyDeleteOne{builder}
+}
+
+// Query returns a query builder for SlsaDependency.
+func (c *SlsaDependencyClient) Query() *SlsaDependencyQuery {
+	return &SlsaDependencyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSlsaDependency},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SlsaDependency entity by its id.
+func (c *SlsaDependencyClient) Get(ctx context.Context, id uuid.UUID) (*SlsaDependency, error) {
+	return c.Query().Where(slsadependency.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SlsaDependencyClient) GetX(ctx context.Context, id uuid.UUID) *SlsaDependency {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySlsaProvenance queries the slsa_provenance edge of a SlsaDependency.
+func (c *SlsaDependencyClient) QuerySlsaProvenance(_m *SlsaDependency) *SlsaProvenanceQuery {
+	query := (&SlsaProvenanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slsadependency.Table, slsadependency.FieldID, id),
+			sqlgraph.To(slsaprovenance.Table, slsaprovenance.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, slsadependency.SlsaProvenanceTable, slsadependency.SlsaProvenanceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SlsaDependencyClient) Hooks() []Hook {
+	hooks := c.hooks.SlsaDependency
+	return append(hooks[:len(hooks):len(hooks)], slsadependency.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SlsaDependencyClient) Interceptors() []Interceptor {
+	inters := c.inters.SlsaDependency
+	return append(inters[:len(inters):len(inters)], slsadependency.Interceptors[:]...)
+}
+
+func (c *SlsaDependencyClient) mutate(ctx context.Context, m *SlsaDependencyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SlsaDependencyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SlsaDependencyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SlsaDependencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SlsaDependencyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SlsaDependency mutation op: %q", m.Op())
+	}
+}
+
+// SlsaProvenanceClient is a client for the SlsaProvenance schema.
+type SlsaProvenanceClient struct {
+	config
+}
+
+// NewSlsaProvenanceClient returns a client for the SlsaProvenance from the given config.
+func NewSlsaProvenanceClient(c config) *SlsaProvenanceClient {
+	return &SlsaProvenanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `slsaprovenance.Hooks(f(g(h())))`.
+func (c *SlsaProvenanceClient) Use(hooks ...Hook) {
+	c.hooks.SlsaProvenance = append(c.hooks.SlsaProvenance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `slsaprovenance.Intercept(f(g(h())))`.
+func (c *SlsaProvenanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SlsaProvenance = append(c.inters.SlsaProvenance, interceptors...)
+}
+
+// Create returns a builder for creating a SlsaProvenance entity.
+func (c *SlsaProvenanceClient) Create() *SlsaProvenanceCreate {
+	mutation := newSlsaProvenanceMutation(c.config, OpCreate)
+	return &SlsaProvenanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SlsaProvenance entities.
+func (c *SlsaProvenanceClient) CreateBulk(builders ...*SlsaProvenanceCreate) *SlsaProvenanceCreateBulk {
+	return &SlsaProvenanceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SignatureClient) MapCreateBulk(slice any, setFunc func(*SignatureCreate, int)) *SignatureCreateBulk {
+func (c *SlsaProvenanceClient) MapCreateBulk(slice any, setFunc func(*SlsaProvenanceCreate, int)) *SlsaProvenanceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SignatureCreateBulk{err: fmt.Errorf("calling to SignatureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SlsaProvenanceCreateBulk{err: fmt.Errorf("calling to SlsaProvenanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SignatureCreate, rv.Len())
+	builders := make([]*SlsaProvenanceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SignatureCreateBulk{config: c.config, builders: builders}
+	return &SlsaProvenanceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Signature.
-func (c *SignatureClient) Update() *SignatureUpdate {
-	mutation := newSignatureMutation(c.config, OpUpdate)
-	return &SignatureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SlsaProvenance.
+func (c *SlsaProvenanceClient) Update() *SlsaProvenanceUpdate {
+	mutation := newSlsaProvenanceMutation(c.config, OpUpdate)
+	return &SlsaProvenanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SignatureClient) UpdateOne(_m *Signature) *SignatureUpdateOne {
-	mutation := newSignatureMutation(c.config, OpUpdateOne, withSignature(_m))
-	return &SignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SlsaProvenanceClient) UpdateOne(_m *SlsaProvenance) *SlsaProvenanceUpdateOne {
+	mutation := newSlsaProvenanceMutation(c.config, OpUpdateOne, withSlsaProvenance(_m))
+	return &SlsaProvenanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SignatureClient) UpdateOneID(id uuid.UUID) *SignatureUpdateOne {
-	mutation := newSignatureMutation(c.config, OpUpdateOne, withSignatureID(id))
-	return &SignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SlsaProvenanceClient) UpdateOneID(id uuid.UUID) *SlsaProvenanceUpdateOne {
+	mutation := newSlsaProvenanceMutation(c.config, OpUpdateOne, withSlsaProvenanceID(id))
+	return &SlsaProvenanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Signature.
-func (c *SignatureClient) Delete() *SignatureDelete {
-	mutation := newSignatureMutation(c.config, OpDelete)
-	return &SignatureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SlsaProvenance.
+func (c *SlsaProvenanceClient) Delete() *SlsaProvenanceDelete {
+	mutation := newSlsaProvenanceMutation(c.config, OpDelete)
+	return &SlsaProvenanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SignatureClient) DeleteOne(_m *Signature) *SignatureDeleteOne {
+func (c *SlsaProvenanceClient) DeleteOne(_m *SlsaProvenance) *SlsaProvenanceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SignatureClient) DeleteOneID(id uuid.UUID) *SignatureDeleteOne {
-	builder := c.Delete().Where(signature.ID(id))
+func (c *SlsaProvenanceClient) DeleteOneID(id uuid.UUID) *SlsaProvenanceDeleteOne {
+	builder := c.Delete().Where(slsaprovenance.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SignatureDeleteOne{builder}
+	return &SlsaProvenanceDeleteOne{builder}
 }
 
-// Query returns a query builder for Signature.
-func (c *SignatureClient) Query() *SignatureQuery {
-	return &SignatureQuery{
+// Query returns a query builder for SlsaProvenance.
+func (c *SlsaProvenanceClient) Query() *SlsaProvenanceQuery {
+	return &SlsaProvenanceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSignature},
+		ctx:    &QueryContext{Type: TypeSlsaProvenance},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Signature entity by its id.
-func (c *SignatureClient) Get(ctx context.Context, id uuid.UUID) (*Signature, error) {
-	return c.Query().Where(signature.ID(id)).Only(ctx)
+// Get returns a SlsaProvenance entity by its id.
+func (c *SlsaProvenanceClient) Get(ctx context.Context, id uuid.UUID) (*SlsaProvenance, error) {
+	return c.Query().Where(slsaprovenance.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SignatureClient) GetX(ctx context.Context, id uuid.UUID) *Signature {
+func (c *SlsaProvenanceClient) GetX(ctx context.Context, id uuid.UUID) *SlsaProvenance {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1183,15 +4025,15 @@ func (c *SignatureClient) GetX(ctx context.Context, id uuid.UUID) *Signature {
 	return obj
 }
 
-// QueryDsse queries the dsse edge of a Signature.
-func (c *SignatureClient) QueryDsse(_m *Signature) *DsseQuery {
-	query := (&DsseClient{config: c.config}).Query()
+// QueryResolvedDependencies queries the resolved_dependencies edge of a SlsaProvenance.
+func (c *SlsaProvenanceClient) QueryResolvedDependencies(_m *SlsaProvenance) *SlsaDependencyQuery {
+	query := (&SlsaDependencyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(signature.Table, signature.FieldID, id),
-			sqlgraph.To(dsse.Table, dsse.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, signature.DsseTable, signature.DsseColumn),
+			sqlgraph.From(slsaprovenance.Table, slsaprovenance.FieldID, id),
+			sqlgraph.To(slsadependency.Table, slsadependency.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, slsaprovenance.ResolvedDependenciesTable, slsaprovenance.ResolvedDependenciesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1199,15 +4041,15 @@ func (c *SignatureClient) QueryDsse(_m *Signature) *DsseQuery {
 	return query
 }
 
-// QueryTimestamps queries the timestamps edge of a Signature.
-func (c *SignatureClient) QueryTimestamps(_m *Signature) *TimestampQuery {
-	query := (&TimestampClient{config: c.config}).Query()
+// QueryStatement queries the statement edge of a SlsaProvenance.
+func (c *SlsaProvenanceClient) QueryStatement(_m *SlsaProvenance) *StatementQuery {
+	query := (&StatementClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(signature.Table, signature.FieldID, id),
-			sqlgraph.To(timestamp.Table, timestamp.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, signature.TimestampsTable, signature.TimestampsColumn),
+			sqlgraph.From(slsaprovenance.Table, slsaprovenance.FieldID, id),
+			sqlgraph.To(statement.Table, statement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, slsaprovenance.StatementTable, slsaprovenance.StatementColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1216,27 +4058,29 @@ func (c *SignatureClient) QueryTimestamps(_m *Signature) *TimestampQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *SignatureClient) Hooks() []Hook {
-	return c.hooks.Signature
+func (c *SlsaProvenanceClient) Hooks() []Hook {
+	hooks := c.hooks.SlsaProvenance
+	return append(hooks[:len(hooks):len(hooks)], slsaprovenance.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *SignatureClient) Interceptors() []Interceptor {
-	return c.inters.Signature
+func (c *SlsaProvenanceClient) Interceptors() []Interceptor {
+	inters := c.inters.SlsaProvenance
+	return append(inters[:len(inters):len(inters)], slsaprovenance.Interceptors[:]...)
 }
 
-func (c *SignatureClient) mutate(ctx context.Context, m *SignatureMutation) (Value, error) {
+func (c *SlsaProvenanceClient) mutate(ctx context.Context, m *SlsaProvenanceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SignatureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SlsaProvenanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SignatureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SlsaProvenanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SlsaProvenanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SignatureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SlsaProvenanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Signature mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SlsaProvenance mutation op: %q", m.Op())
 	}
 }
 
@@ -1396,6 +4240,54 @@ func (c *StatementClient) QueryAttestationCollections(_m *Statement) *Attestatio
 	return query
 }
 
+// QuerySlsaProvenance queries the slsa_provenance edge of a Statement.
+func (c *StatementClient) QuerySlsaProvenance(_m *Statement) *SlsaProvenanceQuery {
+	query := (&SlsaProvenanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statement.Table, statement.FieldID, id),
+			sqlgraph.To(slsaprovenance.Table, slsaprovenance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, statement.SlsaProvenanceTable, statement.SlsaProvenanceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVerificationSummary queries the verification_summary edge of a Statement.
+func (c *StatementClient) QueryVerificationSummary(_m *Statement) *VerificationSummaryQuery {
+	query := (&VerificationSummaryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statement.Table, statement.FieldID, id),
+			sqlgraph.To(verificationsummary.Table, verificationsummary.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, statement.VerificationSummaryTable, statement.VerificationSummaryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySbomPackages queries the sbom_packages edge of a Statement.
+func (c *StatementClient) QuerySbomPackages(_m *Statement) *SbomPackageQuery {
+	query := (&SbomPackageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statement.Table, statement.FieldID, id),
+			sqlgraph.To(sbompackage.Table, sbompackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, statement.SbomPackagesTable, statement.SbomPackagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryDsse queries the dsse edge of a Statement.
 func (c *StatementClient) QueryDsse(_m *Statement) *DsseQuery {
 	query := (&DsseClient{config: c.config}).Query()
@@ -1414,12 +4306,14 @@ func (c *StatementClient) QueryDsse(_m *Statement) *DsseQuery {
 
 // Hooks returns the client hooks.
 func (c *StatementClient) Hooks() []Hook {
-	return c.hooks.Statement
+	hooks := c.hooks.Statement
+	return append(hooks[:len(hooks):len(hooks)], statement.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *StatementClient) Interceptors() []Interceptor {
-	return c.inters.Statement
+	inters := c.inters.Statement
+	return append(inters[:len(inters):len(inters)], statement.Interceptors[:]...)
 }
 
 func (c *StatementClient) mutate(ctx context.Context, m *StatementMutation) (Value, error) {
@@ -1431,113 +4325,280 @@ func (c *StatementClient) mutate(ctx context.Context, m *StatementMutation) (Val
 	case OpUpdateOne:
 		return (&StatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&StatementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StatementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Statement mutation op: %q", m.Op())
+	}
+}
+
+// SubjectClient is a client for the Subject schema.
+type SubjectClient struct {
+	config
+}
+
+// NewSubjectClient returns a client for the Subject from the given config.
+func NewSubjectClient(c config) *SubjectClient {
+	return &SubjectClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subject.Hooks(f(g(h())))`.
+func (c *SubjectClient) Use(hooks ...Hook) {
+	c.hooks.Subject = append(c.hooks.Subject, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subject.Intercept(f(g(h())))`.
+func (c *SubjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Subject = append(c.inters.Subject, interceptors...)
+}
+
+// Create returns a builder for creating a Subject entity.
+func (c *SubjectClient) Create() *SubjectCreate {
+	mutation := newSubjectMutation(c.config, OpCreate)
+	return &SubjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Subject entities.
+func (c *SubjectClient) CreateBulk(builders ...*SubjectCreate) *SubjectCreateBulk {
+	return &SubjectCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubjectClient) MapCreateBulk(slice any, setFunc func(*SubjectCreate, int)) *SubjectCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubjectCreateBulk{err: fmt.Errorf("calling to SubjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubjectCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubjectCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Subject.
+func (c *SubjectClient) Update() *SubjectUpdate {
+	mutation := newSubjectMutation(c.config, OpUpdate)
+	return &SubjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubjectClient) UpdateOne(_m *Subject) *SubjectUpdateOne {
+	mutation := newSubjectMutation(c.config, OpUpdateOne, withSubject(_m))
+	return &SubjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubjectClient) UpdateOneID(id uuid.UUID) *SubjectUpdateOne {
+	mutation := newSubjectMutation(c.config, OpUpdateOne, withSubjectID(id))
+	return &SubjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Subject.
+func (c *SubjectClient) Delete() *SubjectDelete {
+	mutation := newSubjectMutation(c.config, OpDelete)
+	return &SubjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubjectClient) DeleteOne(_m *Subject) *SubjectDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubjectClient) DeleteOneID(id uuid.UUID) *SubjectDeleteOne {
+	builder := c.Delete().Where(subject.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubjectDeleteOne{builder}
+}
+
+// Query returns a query builder for Subject.
+func (c *SubjectClient) Query() *SubjectQuery {
+	return &SubjectQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubject},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Subject entity by its id.
+func (c *SubjectClient) Get(ctx context.Context, id uuid.UUID) (*Subject, error) {
+	return c.Query().Where(subject.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubjectClient) GetX(ctx context.Context, id uuid.UUID) *Subject {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubjectDigests queries the subject_digests edge of a Subject.
+func (c *SubjectClient) QuerySubjectDigests(_m *Subject) *SubjectDigestQuery {
+	query := (&SubjectDigestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subject.Table, subject.FieldID, id),
+			sqlgraph.To(subjectdigest.Table, subjectdigest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, subject.SubjectDigestsTable, subject.SubjectDigestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStatement queries the statement edge of a Subject.
+func (c *SubjectClient) QueryStatement(_m *Subject) *StatementQuery {
+	query := (&StatementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subject.Table, subject.FieldID, id),
+			sqlgraph.To(statement.Table, statement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subject.StatementTable, subject.StatementColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SubjectClient) Hooks() []Hook {
+	hooks := c.hooks.Subject
+	return append(hooks[:len(hooks):len(hooks)], subject.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubjectClient) Interceptors() []Interceptor {
+	inters := c.inters.Subject
+	return append(inters[:len(inters):len(inters)], subject.Interceptors[:]...)
+}
+
+func (c *SubjectClient) mutate(ctx context.Context, m *SubjectMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Statement mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Subject mutation op: %q", m.Op())
 	}
 }
 
-// SubjectClient is a client for the Subject schema.
-type SubjectClient struct {
+// SubjectAltNameClient is a client for the SubjectAltName schema.
+type SubjectAltNameClient struct {
 	config
 }
 
-// NewSubjectClient returns a client for the Subject from the given config.
-func NewSubjectClient(c config) *SubjectClient {
-	return &SubjectClient{config: c}
+// NewSubjectAltNameClient returns a client for the SubjectAltName from the given config.
+func NewSubjectAltNameClient(c config) *SubjectAltNameClient {
+	return &SubjectAltNameClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `subject.Hooks(f(g(h())))`.
-func (c *SubjectClient) Use(hooks ...Hook) {
-	c.hooks.Subject = append(c.hooks.Subject, hooks...)
+// A call to `Use(f, g, h)` equals to `subjectaltname.Hooks(f(g(h())))`.
+func (c *SubjectAltNameClient) Use(hooks ...Hook) {
+	c.hooks.SubjectAltName = append(c.hooks.SubjectAltName, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `subject.Intercept(f(g(h())))`.
-func (c *SubjectClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Subject = append(c.inters.Subject, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `subjectaltname.Intercept(f(g(h())))`.
+func (c *SubjectAltNameClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubjectAltName = append(c.inters.SubjectAltName, interceptors...)
 }
 
-// Create returns a builder for creating a Subject entity.
-func (c *SubjectClient) Create() *SubjectCreate {
-	mutation := newSubjectMutation(c.config, OpCreate)
-	return &SubjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SubjectAltName entity.
+func (c *SubjectAltNameClient) Create() *SubjectAltNameCreate {
+	mutation := newSubjectAltNameMutation(c.config, OpCreate)
+	return &SubjectAltNameCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Subject entities.
-func (c *SubjectClient) CreateBulk(builders ...*SubjectCreate) *SubjectCreateBulk {
-	return &SubjectCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SubjectAltName entities.
+func (c *SubjectAltNameClient) CreateBulk(builders ...*SubjectAltNameCreate) *SubjectAltNameCreateBulk {
+	return &SubjectAltNameCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SubjectClient) MapCreateBulk(slice any, setFunc func(*SubjectCreate, int)) *SubjectCreateBulk {
+func (c *SubjectAltNameClient) MapCreateBulk(slice any, setFunc func(*SubjectAltNameCreate, int)) *SubjectAltNameCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SubjectCreateBulk{err: fmt.Errorf("calling to SubjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SubjectAltNameCreateBulk{err: fmt.Errorf("calling to SubjectAltNameClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SubjectCreate, rv.Len())
+	builders := make([]*SubjectAltNameCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SubjectCreateBulk{config: c.config, builders: builders}
+	return &SubjectAltNameCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Subject.
-func (c *SubjectClient) Update() *SubjectUpdate {
-	mutation := newSubjectMutation(c.config, OpUpdate)
-	return &SubjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SubjectAltName.
+func (c *SubjectAltNameClient) Update() *SubjectAltNameUpdate {
+	mutation := newSubjectAltNameMutation(c.config, OpUpdate)
+	return &SubjectAltNameUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SubjectClient) UpdateOne(_m *Subject) *SubjectUpdateOne {
-	mutation := newSubjectMutation(c.config, OpUpdateOne, withSubject(_m))
-	return &SubjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SubjectAltNameClient) UpdateOne(_m *SubjectAltName) *SubjectAltNameUpdateOne {
+	mutation := newSubjectAltNameMutation(c.config, OpUpdateOne, withSubjectAltName(_m))
+	return &SubjectAltNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SubjectClient) UpdateOneID(id uuid.UUID) *SubjectUpdateOne {
-	mutation := newSubjectMutation(c.config, OpUpdateOne, withSubjectID(id))
-	return &SubjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SubjectAltNameClient) UpdateOneID(id uuid.UUID) *SubjectAltNameUpdateOne {
+	mutation := newSubjectAltNameMutation(c.config, OpUpdateOne, withSubjectAltNameID(id))
+	return &SubjectAltNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Subject.
-func (c *SubjectClient) Delete() *SubjectDelete {
-	mutation := newSubjectMutation(c.config, OpDelete)
-	return &SubjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SubjectAltName.
+func (c *SubjectAltNameClient) Delete() *SubjectAltNameDelete {
+	mutation := newSubjectAltNameMutation(c.config, OpDelete)
+	return &SubjectAltNameDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SubjectClient) DeleteOne(_m *Subject) *SubjectDeleteOne {
+func (c *SubjectAltNameClient) DeleteOne(_m *SubjectAltName) *SubjectAltNameDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SubjectClient) DeleteOneID(id uuid.UUID) *SubjectDeleteOne {
-	builder := c.Delete().Where(subject.ID(id))
+func (c *SubjectAltNameClient) DeleteOneID(id uuid.UUID) *SubjectAltNameDeleteOne {
+	builder := c.Delete().Where(subjectaltname.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SubjectDeleteOne{builder}
+	return &SubjectAltNameDeleteOne{builder}
 }
 
-// Query returns a query builder for Subject.
-func (c *SubjectClient) Query() *SubjectQuery {
-	return &SubjectQuery{
+// Query returns a query builder for SubjectAltName.
+func (c *SubjectAltNameClient) Query() *SubjectAltNameQuery {
+	return &SubjectAltNameQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSubject},
+		ctx:    &QueryContext{Type: TypeSubjectAltName},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Subject entity by its id.
-func (c *SubjectClient) Get(ctx context.Context, id uuid.UUID) (*Subject, error) {
-	return c.Query().Where(subject.ID(id)).Only(ctx)
+// Get returns a SubjectAltName entity by its id.
+func (c *SubjectAltNameClient) Get(ctx context.Context, id uuid.UUID) (*SubjectAltName, error) {
+	return c.Query().Where(subjectaltname.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SubjectClient) GetX(ctx context.Context, id uuid.UUID) *Subject {
+func (c *SubjectAltNameClient) GetX(ctx context.Context, id uuid.UUID) *SubjectAltName {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1545,31 +4606,15 @@ func (c *SubjectClient) GetX(ctx context.Context, id uuid.UUID) *Subject {
 	return obj
 }
 
-// QuerySubjectDigests queries the subject_digests edge of a Subject.
-func (c *SubjectClient) QuerySubjectDigests(_m *Subject) *SubjectDigestQuery {
-	query := (&SubjectDigestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(subject.Table, subject.FieldID, id),
-			sqlgraph.To(subjectdigest.Table, subjectdigest.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, subject.SubjectDigestsTable, subject.SubjectDigestsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStatement queries the statement edge of a Subject.
-func (c *SubjectClient) QueryStatement(_m *Subject) *StatementQuery {
-	query := (&StatementClient{config: c.config}).Query()
+// QueryCertificate queries the certificate edge of a SubjectAltName.
+func (c *SubjectAltNameClient) QueryCertificate(_m *SubjectAltName) *CertificateQuery {
+	query := (&CertificateClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(subject.Table, subject.FieldID, id),
-			sqlgraph.To(statement.Table, statement.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, subject.StatementTable, subject.StatementColumn),
+			sqlgraph.From(subjectaltname.Table, subjectaltname.FieldID, id),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subjectaltname.CertificateTable, subjectaltname.CertificateColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1578,27 +4623,29 @@ func (c *SubjectClient) QueryStatement(_m *Subject) *StatementQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *SubjectClient) Hooks() []Hook {
-	return c.hooks.Subject
+func (c *SubjectAltNameClient) Hooks() []Hook {
+	hooks := c.hooks.SubjectAltName
+	return append(hooks[:len(hooks):len(hooks)], subjectaltname.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *SubjectClient) Interceptors() []Interceptor {
-	return c.inters.Subject
+func (c *SubjectAltNameClient) Interceptors() []Interceptor {
+	inters := c.inters.SubjectAltName
+	return append(inters[:len(inters):len(inters)], subjectaltname.Interceptors[:]...)
 }
 
-func (c *SubjectClient) mutate(ctx context.Context, m *SubjectMutation) (Value, error) {
+func (c *SubjectAltNameClient) mutate(ctx context.Context, m *SubjectAltNameMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SubjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SubjectAltNameCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SubjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SubjectAltNameUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SubjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SubjectAltNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SubjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SubjectAltNameDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Subject mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SubjectAltName mutation op: %q", m.Op())
 	}
 }
 
@@ -1728,12 +4775,14 @@ func (c *SubjectDigestClient) QuerySubject(_m *SubjectDigest) *SubjectQuery {
 
 // Hooks returns the client hooks.
 func (c *SubjectDigestClient) Hooks() []Hook {
-	return c.hooks.SubjectDigest
+	hooks := c.hooks.SubjectDigest
+	return append(hooks[:len(hooks):len(hooks)], subjectdigest.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *SubjectDigestClient) Interceptors() []Interceptor {
-	return c.inters.SubjectDigest
+	inters := c.inters.SubjectDigest
+	return append(inters[:len(inters):len(inters)], subjectdigest.Interceptors[:]...)
 }
 
 func (c *SubjectDigestClient) mutate(ctx context.Context, m *SubjectDigestMutation) (Value, error) {
@@ -1877,12 +4926,14 @@ func (c *TimestampClient) QuerySignature(_m *Timestamp) *SignatureQuery {
 
 // Hooks returns the client hooks.
 func (c *TimestampClient) Hooks() []Hook {
-	return c.hooks.Timestamp
+	hooks := c.hooks.Timestamp
+	return append(hooks[:len(hooks):len(hooks)], timestamp.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *TimestampClient) Interceptors() []Interceptor {
-	return c.inters.Timestamp
+	inters := c.inters.Timestamp
+	return append(inters[:len(inters):len(inters)], timestamp.Interceptors[:]...)
 }
 
 func (c *TimestampClient) mutate(ctx context.Context, m *TimestampMutation) (Value, error) {
@@ -1900,14 +4951,326 @@ func (c *TimestampClient) mutate(ctx context.Context, m *TimestampMutation) (Val
 	}
 }
 
+// TransparencyLogEntryClient is a client for the TransparencyLogEntry schema.
+type TransparencyLogEntryClient struct {
+	config
+}
+
+// NewTransparencyLogEntryClient returns a client for the TransparencyLogEntry from the given config.
+func NewTransparencyLogEntryClient(c config) *TransparencyLogEntryClient {
+	return &TransparencyLogEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transparencylogentry.Hooks(f(g(h())))`.
+func (c *TransparencyLogEntryClient) Use(hooks ...Hook) {
+	c.hooks.TransparencyLogEntry = append(c.hooks.TransparencyLogEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transparencylogentry.Intercept(f(g(h())))`.
+func (c *TransparencyLogEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TransparencyLogEntry = append(c.inters.TransparencyLogEntry, interceptors...)
+}
+
+// Create returns a builder for creating a TransparencyLogEntry entity.
+func (c *TransparencyLogEntryClient) Create() *TransparencyLogEntryCreate {
+	mutation := newTransparencyLogEntryMutation(c.config, OpCreate)
+	return &TransparencyLogEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TransparencyLogEntry entities.
+func (c *TransparencyLogEntryClient) CreateBulk(builders ...*TransparencyLogEntryCreate) *TransparencyLogEntryCreateBulk {
+	return &TransparencyLogEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TransparencyLogEntryClient) MapCreateBulk(slice any, setFunc func(*TransparencyLogEntryCreate, int)) *TransparencyLogEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TransparencyLogEntryCreateBulk{err: fmt.Errorf("calling to TransparencyLogEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TransparencyLogEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TransparencyLogEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TransparencyLogEntry.
+func (c *TransparencyLogEntryClient) Update() *TransparencyLogEntryUpdate {
+	mutation := newTransparencyLogEntryMutation(c.config, OpUpdate)
+	return &TransparencyLogEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TransparencyLogEntryClient) UpdateOne(_m *TransparencyLogEntry) *TransparencyLogEntryUpdateOne {
+	mutation := newTransparencyLogEntryMutation(c.config, OpUpdateOne, withTransparencyLogEntry(_m))
+	return &TransparencyLogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TransparencyLogEntryClient) UpdateOneID(id uuid.UUID) *TransparencyLogEntryUpdateOne {
+	mutation := newTransparencyLogEntryMutation(c.config, OpUpdateOne, withTransparencyLogEntryID(id))
+	return &TransparencyLogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TransparencyLogEntry.
+func (c *TransparencyLogEntryClient) Delete() *TransparencyLogEntryDelete {
+	mutation := newTransparencyLogEntryMutation(c.config, OpDelete)
+	return &TransparencyLogEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TransparencyLogEntryClient) DeleteOne(_m *TransparencyLogEntry) *TransparencyLogEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TransparencyLogEntryClient) DeleteOneID(id uuid.UUID) *TransparencyLogEntryDeleteOne {
+	builder := c.Delete().Where(transparencylogentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TransparencyLogEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for TransparencyLogEntry.
+func (c *TransparencyLogEntryClient) Query() *TransparencyLogEntryQuery {
+	return &TransparencyLogEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTransparencyLogEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TransparencyLogEntry entity by its id.
+func (c *TransparencyLogEntryClient) Get(ctx context.Context, id uuid.UUID) (*TransparencyLogEntry, error) {
+	return c.Query().Where(transparencylogentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TransparencyLogEntryClient) GetX(ctx context.Context, id uuid.UUID) *TransparencyLogEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDsse queries the dsse edge of a TransparencyLogEntry.
+func (c *TransparencyLogEntryClient) QueryDsse(_m *TransparencyLogEntry) *DsseQuery {
+	query := (&DsseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transparencylogentry.Table, transparencylogentry.FieldID, id),
+			sqlgraph.To(dsse.Table, dsse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transparencylogentry.DsseTable, transparencylogentry.DsseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TransparencyLogEntryClient) Hooks() []Hook {
+	hooks := c.hooks.TransparencyLogEntry
+	return append(hooks[:len(hooks):len(hooks)], transparencylogentry.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TransparencyLogEntryClient) Interceptors() []Interceptor {
+	inters := c.inters.TransparencyLogEntry
+	return append(inters[:len(inters):len(inters)], transparencylogentry.Interceptors[:]...)
+}
+
+func (c *TransparencyLogEntryClient) mutate(ctx context.Context, m *TransparencyLogEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TransparencyLogEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TransparencyLogEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TransparencyLogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TransparencyLogEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TransparencyLogEntry mutation op: %q", m.Op())
+	}
+}
+
+// VerificationSummaryClient is a client for the VerificationSummary schema.
+type VerificationSummaryClient struct {
+	config
+}
+
+// NewVerificationSummaryClient returns a client for the VerificationSummary from the given config.
+func NewVerificationSummaryClient(c config) *VerificationSummaryClient {
+	return &VerificationSummaryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `verificationsummary.Hooks(f(g(h())))`.
+func (c *VerificationSummaryClient) Use(hooks ...Hook) {
+	c.hooks.VerificationSummary = append(c.hooks.VerificationSummary, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `verificationsummary.Intercept(f(g(h())))`.
+func (c *VerificationSummaryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VerificationSummary = append(c.inters.VerificationSummary, interceptors...)
+}
+
+// Create returns a builder for creating a VerificationSummary entity.
+func (c *VerificationSummaryClient) Create() *VerificationSummaryCreate {
+	mutation := newVerificationSummaryMutation(c.config, OpCreate)
+	return &VerificationSummaryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VerificationSummary entities.
+func (c *VerificationSummaryClient) CreateBulk(builders ...*VerificationSummaryCreate) *VerificationSummaryCreateBulk {
+	return &VerificationSummaryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VerificationSummaryClient) MapCreateBulk(slice any, setFunc func(*VerificationSummaryCreate, int)) *VerificationSummaryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VerificationSummaryCreateBulk{err: fmt.Errorf("calling to VerificationSummaryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VerificationSummaryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VerificationSummaryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VerificationSummary.
+func (c *VerificationSummaryClient) Update() *VerificationSummaryUpdate {
+	mutation := newVerificationSummaryMutation(c.config, OpUpdate)
+	return &VerificationSummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VerificationSummaryClient) UpdateOne(_m *VerificationSummary) *VerificationSummaryUpdateOne {
+	mutation := newVerificationSummaryMutation(c.config, OpUpdateOne, withVerificationSummary(_m))
+	return &VerificationSummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VerificationSummaryClient) UpdateOneID(id uuid.UUID) *VerificationSummaryUpdateOne {
+	mutation := newVerificationSummaryMutation(c.config, OpUpdateOne, withVerificationSummaryID(id))
+	return &VerificationSummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VerificationSummary.
+func (c *VerificationSummaryClient) Delete() *VerificationSummaryDelete {
+	mutation := newVerificationSummaryMutation(c.config, OpDelete)
+	return &VerificationSummaryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VerificationSummaryClient) DeleteOne(_m *VerificationSummary) *VerificationSummaryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VerificationSummaryClient) DeleteOneID(id uuid.UUID) *VerificationSummaryDeleteOne {
+	builder := c.Delete().Where(verificationsummary.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VerificationSummaryDeleteOne{builder}
+}
+
+// Query returns a query builder for VerificationSummary.
+func (c *VerificationSummaryClient) Query() *VerificationSummaryQuery {
+	return &VerificationSummaryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVerificationSummary},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VerificationSummary entity by its id.
+func (c *VerificationSummaryClient) Get(ctx context.Context, id uuid.UUID) (*VerificationSummary, error) {
+	return c.Query().Where(verificationsummary.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VerificationSummaryClient) GetX(ctx context.Context, id uuid.UUID) *VerificationSummary {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStatement queries the statement edge of a VerificationSummary.
+func (c *VerificationSummaryClient) QueryStatement(_m *VerificationSummary) *StatementQuery {
+	query := (&StatementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(verificationsummary.Table, verificationsummary.FieldID, id),
+			sqlgraph.To(statement.Table, statement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, verificationsummary.StatementTable, verificationsummary.StatementColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VerificationSummaryClient) Hooks() []Hook {
+	hooks := c.hooks.VerificationSummary
+	return append(hooks[:len(hooks):len(hooks)], verificationsummary.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *VerificationSummaryClient) Interceptors() []Interceptor {
+	inters := c.inters.VerificationSummary
+	return append(inters[:len(inters):len(inters)], verificationsummary.Interceptors[:]...)
+}
+
+func (c *VerificationSummaryClient) mutate(ctx context.Context, m *VerificationSummaryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VerificationSummaryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VerificationSummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VerificationSummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VerificationSummaryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VerificationSummary mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Attestation, AttestationCollection, AttestationPolicy, Dsse, PayloadDigest,
-		Signature, Statement, Subject, SubjectDigest, Timestamp []ent.Hook
+		Attestation, AttestationCollection, AttestationPolicy, Certificate,
+		CommandRunAttestation, Dsse, EnvironmentAttestation, GitAttestation,
+		GithubAttestation, GitlabAttestation, LegalHold, Material, OciAttestation,
+		PayloadDigest, Product, Publication, PublishDelivery, SbomPackage,
+		SbomPackageDigest, Signature, SlsaDependency, SlsaProvenance, Statement,
+		Subject, SubjectAltName, SubjectDigest, Timestamp, TransparencyLogEntry,
+		VerificationSummary []ent.Hook
 	}
 	inters struct {
-		Attestation, AttestationCollection, AttestationPolicy, Dsse, PayloadDigest,
-		Signature, Statement, Subject, SubjectDigest, Timestamp []ent.Interceptor
+		Attestation, AttestationCollection, AttestationPolicy, Certificate,
+		CommandRunAttestation, Dsse, EnvironmentAttestation, GitAttestation,
+		GithubAttestation, GitlabAttestation, LegalHold, Material, OciAttestation,
+		PayloadDigest, Product, Publication, PublishDelivery, SbomPackage,
+		SbomPackageDigest, Signature, SlsaDependency, SlsaProvenance, Statement,
+		Subject, SubjectAltName, SubjectDigest, Timestamp, TransparencyLogEntry,
+		VerificationSummary []ent.Interceptor
 	}
 )
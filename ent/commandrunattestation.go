@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+)
+
+// CommandRunAttestation is the model entity for the CommandRunAttestation schema.
+type CommandRunAttestation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// Cmd holds the value of the "cmd" field.
+	Cmd string `json:"cmd,omitempty"`
+	// ExitCode holds the value of the "exit_code" field.
+	ExitCode int `json:"exit_code,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommandRunAttestationQuery when eager-loading is set.
+	Edges                               CommandRunAttestationEdges `json:"edges"`
+	attestation_command_run_attestation *uuid.UUID
+	selectValues                        sql.SelectValues
+}
+
+// CommandRunAttestationEdges holds the relations/edges for other nodes in the graph.
+type CommandRunAttestationEdges struct {
+	// Attestation holds the value of the attestation edge.
+	Attestation *Attestation `json:"attestation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// AttestationOrErr returns the Attestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommandRunAttestationEdges) AttestationOrErr() (*Attestation, error) {
+	if e.Attestation != nil {
+		return e.Attestation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "attestation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommandRunAttestation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commandrunattestation.FieldExitCode:
+			values[i] = new(sql.NullInt64)
+		case commandrunattestation.FieldTenant, commandrunattestation.FieldCmd:
+			values[i] = new(sql.NullString)
+		case commandrunattestation.FieldID:
+			values[i] = new(uuid.UUID)
+		case commandrunattestation.ForeignKeys[0]: // attestation_command_run_attestation
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommandRunAttestation fields.
+func (_m *CommandRunAttestation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commandrunattestation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case commandrunattestation.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case commandrunattestation.FieldCmd:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cmd", values[i])
+			} else if value.Valid {
+				_m.Cmd = value.String
+			}
+		case commandrunattestation.FieldExitCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exit_code", values[i])
+			} else if value.Valid {
+				_m.ExitCode = int(value.Int64)
+			}
+		case commandrunattestation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_command_run_attestation", values[i])
+			} else if value.Valid {
+				_m.attestation_command_run_attestation = new(uuid.UUID)
+				*_m.attestation_command_run_attestation = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommandRunAttestation.
+// This includes values selected through modifiers, order, etc.
+func (_m *CommandRunAttestation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttestation queries the "attestation" edge of the CommandRunAttestation entity.
+func (_m *CommandRunAttestation) QueryAttestation() *AttestationQuery {
+	return NewCommandRunAttestationClient(_m.config).QueryAttestation(_m)
+}
+
+// Update returns a builder for updating this CommandRunAttestation.
+// Note that you need to call CommandRunAttestation.Unwrap() before calling this method if this CommandRunAttestation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CommandRunAttestation) Update() *CommandRunAttestationUpdateOne {
+	return NewCommandRunAttestationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CommandRunAttestation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CommandRunAttestation) Unwrap() *CommandRunAttestation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommandRunAttestation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CommandRunAttestation) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommandRunAttestation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("cmd=")
+	builder.WriteString(_m.Cmd)
+	builder.WriteString(", ")
+	builder.WriteString("exit_code=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExitCode))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommandRunAttestations is a parsable slice of CommandRunAttestation.
+type CommandRunAttestations []*CommandRunAttestation
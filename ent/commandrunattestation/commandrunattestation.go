@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commandrunattestation
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the commandrunattestation type in the database.
+	Label = "command_run_attestation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldCmd holds the string denoting the cmd field in the database.
+	FieldCmd = "cmd"
+	// FieldExitCode holds the string denoting the exit_code field in the database.
+	FieldExitCode = "exit_code"
+	// EdgeAttestation holds the string denoting the attestation edge name in mutations.
+	EdgeAttestation = "attestation"
+	// Table holds the table name of the commandrunattestation in the database.
+	Table = "command_run_attestations"
+	// AttestationTable is the table that holds the attestation relation/edge.
+	AttestationTable = "command_run_attestations"
+	// AttestationInverseTable is the table name for the Attestation entity.
+	// It exists in this package in order to avoid circular dependency with the "attestation" package.
+	AttestationInverseTable = "attestations"
+	// AttestationColumn is the table column denoting the attestation relation/edge.
+	AttestationColumn = "attestation_command_run_attestation"
+)
+
+// Columns holds all SQL columns for commandrunattestation fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldCmd,
+	FieldExitCode,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "command_run_attestations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"attestation_command_run_attestation",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CommandRunAttestation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByCmd orders the results by the cmd field.
+func ByCmd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCmd, opts...).ToFunc()
+}
+
+// ByExitCode orders the results by the exit_code field.
+func ByExitCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExitCode, opts...).ToFunc()
+}
+
+// ByAttestationField orders the results by attestation field.
+func ByAttestationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttestationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttestationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttestationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, AttestationTable, AttestationColumn),
+	)
+}
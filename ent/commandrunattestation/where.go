@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commandrunattestation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// Cmd applies equality check predicate on the "cmd" field. It's identical to CmdEQ.
+func Cmd(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEQ(FieldCmd, v))
+}
+
+// ExitCode applies equality check predicate on the "exit_code" field. It's identical to ExitCodeEQ.
+func ExitCode(v int) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEQ(FieldExitCode, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// CmdEQ applies the EQ predicate on the "cmd" field.
+func CmdEQ(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEQ(FieldCmd, v))
+}
+
+// CmdNEQ applies the NEQ predicate on the "cmd" field.
+func CmdNEQ(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldNEQ(FieldCmd, v))
+}
+
+// CmdIn applies the In predicate on the "cmd" field.
+func CmdIn(vs ...string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldIn(FieldCmd, vs...))
+}
+
+// CmdNotIn applies the NotIn predicate on the "cmd" field.
+func CmdNotIn(vs ...string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldNotIn(FieldCmd, vs...))
+}
+
+// CmdGT applies the GT predicate on the "cmd" field.
+func CmdGT(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldGT(FieldCmd, v))
+}
+
+// CmdGTE applies the GTE predicate on the "cmd" field.
+func CmdGTE(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldGTE(FieldCmd, v))
+}
+
+// CmdLT applies the LT predicate on the "cmd" field.
+func CmdLT(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldLT(FieldCmd, v))
+}
+
+// CmdLTE applies the LTE predicate on the "cmd" field.
+func CmdLTE(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldLTE(FieldCmd, v))
+}
+
+// CmdContains applies the Contains predicate on the "cmd" field.
+func CmdContains(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldContains(FieldCmd, v))
+}
+
+// CmdHasPrefix applies the HasPrefix predicate on the "cmd" field.
+func CmdHasPrefix(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldHasPrefix(FieldCmd, v))
+}
+
+// CmdHasSuffix applies the HasSuffix predicate on the "cmd" field.
+func CmdHasSuffix(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldHasSuffix(FieldCmd, v))
+}
+
+// CmdEqualFold applies the EqualFold predicate on the "cmd" field.
+func CmdEqualFold(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEqualFold(FieldCmd, v))
+}
+
+// CmdContainsFold applies the ContainsFold predicate on the "cmd" field.
+func CmdContainsFold(v string) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldContainsFold(FieldCmd, v))
+}
+
+// ExitCodeEQ applies the EQ predicate on the "exit_code" field.
+func ExitCodeEQ(v int) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldEQ(FieldExitCode, v))
+}
+
+// ExitCodeNEQ applies the NEQ predicate on the "exit_code" field.
+func ExitCodeNEQ(v int) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldNEQ(FieldExitCode, v))
+}
+
+// ExitCodeIn applies the In predicate on the "exit_code" field.
+func ExitCodeIn(vs ...int) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldIn(FieldExitCode, vs...))
+}
+
+// ExitCodeNotIn applies the NotIn predicate on the "exit_code" field.
+func ExitCodeNotIn(vs ...int) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldNotIn(FieldExitCode, vs...))
+}
+
+// ExitCodeGT applies the GT predicate on the "exit_code" field.
+func ExitCodeGT(v int) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldGT(FieldExitCode, v))
+}
+
+// ExitCodeGTE applies the GTE predicate on the "exit_code" field.
+func ExitCodeGTE(v int) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldGTE(FieldExitCode, v))
+}
+
+// ExitCodeLT applies the LT predicate on the "exit_code" field.
+func ExitCodeLT(v int) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldLT(FieldExitCode, v))
+}
+
+// ExitCodeLTE applies the LTE predicate on the "exit_code" field.
+func ExitCodeLTE(v int) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.FieldLTE(FieldExitCode, v))
+}
+
+// HasAttestation applies the HasEdge predicate on the "attestation" edge.
+func HasAttestation() predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AttestationTable, AttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttestationWith applies the HasEdge predicate on the "attestation" edge with a given conditions (other predicates).
+func HasAttestationWith(preds ...predicate.Attestation) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(func(s *sql.Selector) {
+		step := newAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CommandRunAttestation) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CommandRunAttestation) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CommandRunAttestation) predicate.CommandRunAttestation {
+	return predicate.CommandRunAttestation(sql.NotPredicates(p))
+}
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+)
+
+// CommandRunAttestationCreate is the builder for creating a CommandRunAttestation entity.
+type CommandRunAttestationCreate struct {
+	config
+	mutation *CommandRunAttestationMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *CommandRunAttestationCreate) SetTenant(v string) *CommandRunAttestationCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *CommandRunAttestationCreate) SetNillableTenant(v *string) *CommandRunAttestationCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetCmd sets the "cmd" field.
+func (_c *CommandRunAttestationCreate) SetCmd(v string) *CommandRunAttestationCreate {
+	_c.mutation.SetCmd(v)
+	return _c
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_c *CommandRunAttestationCreate) SetExitCode(v int) *CommandRunAttestationCreate {
+	_c.mutation.SetExitCode(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CommandRunAttestationCreate) SetID(v uuid.UUID) *CommandRunAttestationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CommandRunAttestationCreate) SetNillableID(v *uuid.UUID) *CommandRunAttestationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_c *CommandRunAttestationCreate) SetAttestationID(id uuid.UUID) *CommandRunAttestationCreate {
+	_c.mutation.SetAttestationID(id)
+	return _c
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_c *CommandRunAttestationCreate) SetAttestation(v *Attestation) *CommandRunAttestationCreate {
+	return _c.SetAttestationID(v.ID)
+}
+
+// Mutation returns the CommandRunAttestationMutation object of the builder.
+func (_c *CommandRunAttestationCreate) Mutation() *CommandRunAttestationMutation {
+	return _c.mutation
+}
+
+// Save creates the CommandRunAttestation in the database.
+func (_c *CommandRunAttestationCreate) Save(ctx context.Context) (*CommandRunAttestation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CommandRunAttestationCreate) SaveX(ctx context.Context) *CommandRunAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommandRunAttestationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommandRunAttestationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CommandRunAttestationCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := commandrunattestation.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if commandrunattestation.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized commandrunattestation.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := commandrunattestation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CommandRunAttestationCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "CommandRunAttestation.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := commandrunattestation.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "CommandRunAttestation.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Cmd(); !ok {
+		return &ValidationError{Name: "cmd", err: errors.New(`ent: missing required field "CommandRunAttestation.cmd"`)}
+	}
+	if _, ok := _c.mutation.ExitCode(); !ok {
+		return &ValidationError{Name: "exit_code", err: errors.New(`ent: missing required field "CommandRunAttestation.exit_code"`)}
+	}
+	if len(_c.mutation.AttestationIDs()) == 0 {
+		return &ValidationError{Name: "attestation", err: errors.New(`ent: missing required edge "CommandRunAttestation.attestation"`)}
+	}
+	return nil
+}
+
+func (_c *CommandRunAttestationCreate) sqlSave(ctx context.Context) (*CommandRunAttestation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CommandRunAttestationCreate) createSpec() (*CommandRunAttestation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommandRunAttestation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(commandrunattestation.Table, sqlgraph.NewFieldSpec(commandrunattestation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(commandrunattestation.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.Cmd(); ok {
+		_spec.SetField(commandrunattestation.FieldCmd, field.TypeString, value)
+		_node.Cmd = value
+	}
+	if value, ok := _c.mutation.ExitCode(); ok {
+		_spec.SetField(commandrunattestation.FieldExitCode, field.TypeInt, value)
+		_node.ExitCode = value
+	}
+	if nodes := _c.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   commandrunattestation.AttestationTable,
+			Columns: []string{commandrunattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.attestation_command_run_attestation = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CommandRunAttestationCreateBulk is the builder for creating many CommandRunAttestation entities in bulk.
+type CommandRunAttestationCreateBulk struct {
+	config
+	err      error
+	builders []*CommandRunAttestationCreate
+}
+
+// Save creates the CommandRunAttestation entities in the database.
+func (_c *CommandRunAttestationCreateBulk) Save(ctx context.Context) ([]*CommandRunAttestation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CommandRunAttestation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommandRunAttestationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CommandRunAttestationCreateBulk) SaveX(ctx context.Context) []*CommandRunAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommandRunAttestationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommandRunAttestationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
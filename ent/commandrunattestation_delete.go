@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// CommandRunAttestationDelete is the builder for deleting a CommandRunAttestation entity.
+type CommandRunAttestationDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommandRunAttestationMutation
+}
+
+// Where appends a list predicates to the CommandRunAttestationDelete builder.
+func (_d *CommandRunAttestationDelete) Where(ps ...predicate.CommandRunAttestation) *CommandRunAttestationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CommandRunAttestationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CommandRunAttestationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CommandRunAttestationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commandrunattestation.Table, sqlgraph.NewFieldSpec(commandrunattestation.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CommandRunAttestationDeleteOne is the builder for deleting a single CommandRunAttestation entity.
+type CommandRunAttestationDeleteOne struct {
+	_d *CommandRunAttestationDelete
+}
+
+// Where appends a list predicates to the CommandRunAttestationDelete builder.
+func (_d *CommandRunAttestationDeleteOne) Where(ps ...predicate.CommandRunAttestation) *CommandRunAttestationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CommandRunAttestationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commandrunattestation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CommandRunAttestationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// CommandRunAttestationUpdate is the builder for updating CommandRunAttestation entities.
+type CommandRunAttestationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommandRunAttestationMutation
+}
+
+// Where appends a list predicates to the CommandRunAttestationUpdate builder.
+func (_u *CommandRunAttestationUpdate) Where(ps ...predicate.CommandRunAttestation) *CommandRunAttestationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCmd sets the "cmd" field.
+func (_u *CommandRunAttestationUpdate) SetCmd(v string) *CommandRunAttestationUpdate {
+	_u.mutation.SetCmd(v)
+	return _u
+}
+
+// SetNillableCmd sets the "cmd" field if the given value is not nil.
+func (_u *CommandRunAttestationUpdate) SetNillableCmd(v *string) *CommandRunAttestationUpdate {
+	if v != nil {
+		_u.SetCmd(*v)
+	}
+	return _u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_u *CommandRunAttestationUpdate) SetExitCode(v int) *CommandRunAttestationUpdate {
+	_u.mutation.ResetExitCode()
+	_u.mutation.SetExitCode(v)
+	return _u
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_u *CommandRunAttestationUpdate) SetNillableExitCode(v *int) *CommandRunAttestationUpdate {
+	if v != nil {
+		_u.SetExitCode(*v)
+	}
+	return _u
+}
+
+// AddExitCode adds value to the "exit_code" field.
+func (_u *CommandRunAttestationUpdate) AddExitCode(v int) *CommandRunAttestationUpdate {
+	_u.mutation.AddExitCode(v)
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *CommandRunAttestationUpdate) SetAttestationID(id uuid.UUID) *CommandRunAttestationUpdate {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *CommandRunAttestationUpdate) SetAttestation(v *Attestation) *CommandRunAttestationUpdate {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the CommandRunAttestationMutation object of the builder.
+func (_u *CommandRunAttestationUpdate) Mutation() *CommandRunAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *CommandRunAttestationUpdate) ClearAttestation() *CommandRunAttestationUpdate {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CommandRunAttestationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommandRunAttestationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CommandRunAttestationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommandRunAttestationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommandRunAttestationUpdate) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CommandRunAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *CommandRunAttestationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commandrunattestation.Table, commandrunattestation.Columns, sqlgraph.NewFieldSpec(commandrunattestation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Cmd(); ok {
+		_spec.SetField(commandrunattestation.FieldCmd, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExitCode(); ok {
+		_spec.SetField(commandrunattestation.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitCode(); ok {
+		_spec.AddField(commandrunattestation.FieldExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   commandrunattestation.AttestationTable,
+			Columns: []string{commandrunattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   commandrunattestation.AttestationTable,
+			Columns: []string{commandrunattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commandrunattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CommandRunAttestationUpdateOne is the builder for updating a single CommandRunAttestation entity.
+type CommandRunAttestationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommandRunAttestationMutation
+}
+
+// SetCmd sets the "cmd" field.
+func (_u *CommandRunAttestationUpdateOne) SetCmd(v string) *CommandRunAttestationUpdateOne {
+	_u.mutation.SetCmd(v)
+	return _u
+}
+
+// SetNillableCmd sets the "cmd" field if the given value is not nil.
+func (_u *CommandRunAttestationUpdateOne) SetNillableCmd(v *string) *CommandRunAttestationUpdateOne {
+	if v != nil {
+		_u.SetCmd(*v)
+	}
+	return _u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_u *CommandRunAttestationUpdateOne) SetExitCode(v int) *CommandRunAttestationUpdateOne {
+	_u.mutation.ResetExitCode()
+	_u.mutation.SetExitCode(v)
+	return _u
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_u *CommandRunAttestationUpdateOne) SetNillableExitCode(v *int) *CommandRunAttestationUpdateOne {
+	if v != nil {
+		_u.SetExitCode(*v)
+	}
+	return _u
+}
+
+// AddExitCode adds value to the "exit_code" field.
+func (_u *CommandRunAttestationUpdateOne) AddExitCode(v int) *CommandRunAttestationUpdateOne {
+	_u.mutation.AddExitCode(v)
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *CommandRunAttestationUpdateOne) SetAttestationID(id uuid.UUID) *CommandRunAttestationUpdateOne {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *CommandRunAttestationUpdateOne) SetAttestation(v *Attestation) *CommandRunAttestationUpdateOne {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the CommandRunAttestationMutation object of the builder.
+func (_u *CommandRunAttestationUpdateOne) Mutation() *CommandRunAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *CommandRunAttestationUpdateOne) ClearAttestation() *CommandRunAttestationUpdateOne {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Where appends a list predicates to the CommandRunAttestationUpdate builder.
+func (_u *CommandRunAttestationUpdateOne) Where(ps ...predicate.CommandRunAttestation) *CommandRunAttestationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CommandRunAttestationUpdateOne) Select(field string, fields ...string) *CommandRunAttestationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CommandRunAttestation entity.
+func (_u *CommandRunAttestationUpdateOne) Save(ctx context.Context) (*CommandRunAttestation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommandRunAttestationUpdateOne) SaveX(ctx context.Context) *CommandRunAttestation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CommandRunAttestationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommandRunAttestationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommandRunAttestationUpdateOne) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CommandRunAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *CommandRunAttestationUpdateOne) sqlSave(ctx context.Context) (_node *CommandRunAttestation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commandrunattestation.Table, commandrunattestation.Columns, sqlgraph.NewFieldSpec(commandrunattestation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CommandRunAttestation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commandrunattestation.FieldID)
+		for _, f := range fields {
+			if !commandrunattestation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != commandrunattestation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Cmd(); ok {
+		_spec.SetField(commandrunattestation.FieldCmd, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExitCode(); ok {
+		_spec.SetField(commandrunattestation.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitCode(); ok {
+		_spec.AddField(commandrunattestation.FieldExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   commandrunattestation.AttestationTable,
+			Columns: []string{commandrunattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   commandrunattestation.AttestationTable,
+			Columns: []string{commandrunattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CommandRunAttestation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commandrunattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
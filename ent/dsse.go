@@ -19,6 +19,8 @@ type Dsse struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// GitoidSha256 holds the value of the "gitoid_sha256" field.
@@ -40,14 +42,26 @@ type DsseEdges struct {
 	Signatures []*Signature `json:"signatures,omitempty"`
 	// PayloadDigests holds the value of the payload_digests edge.
 	PayloadDigests []*PayloadDigest `json:"payload_digests,omitempty"`
+	// PublishDeliveries holds the value of the publish_deliveries edge.
+	PublishDeliveries []*PublishDelivery `json:"publish_deliveries,omitempty"`
+	// Publications holds the value of the publications edge.
+	Publications []*Publication `json:"publications,omitempty"`
+	// TransparencyLogEntries holds the value of the transparency_log_entries edge.
+	TransparencyLogEntries []*TransparencyLogEntry `json:"transparency_log_entries,omitempty"`
+	// LegalHolds holds the value of the legal_holds edge.
+	LegalHolds []*LegalHold `json:"legal_holds,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [7]bool
 	// totalCount holds the count of the edges above.
-	totalCount [3]map[string]int
+	totalCount [7]map[string]int
 
-	namedSignatures     map[string][]*Signature
-	namedPayloadDigests map[string][]*PayloadDigest
+	namedSignatures             map[string][]*Signature
+	namedPayloadDigests         map[string][]*PayloadDigest
+	namedPublishDeliveries      map[string][]*PublishDelivery
+	namedPublications           map[string][]*Publication
+	namedTransparencyLogEntries map[string][]*TransparencyLogEntry
+	namedLegalHolds             map[string][]*LegalHold
 }
 
 // StatementOrErr returns the Statement value or an error if the edge
@@ -79,12 +93,48 @@ func (e DsseEdges) PayloadDigestsOrErr() ([]*PayloadDigest, error) {
 	return nil, &NotLoadedError{edge: "payload_digests"}
 }
 
+// PublishDeliveriesOrErr returns the PublishDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e DsseEdges) PublishDeliveriesOrErr() ([]*PublishDelivery, error) {
+	if e.loadedTypes[3] {
+		return e.PublishDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "publish_deliveries"}
+}
+
+// PublicationsOrErr returns the Publications value or an error if the edge
+// was not loaded in eager-loading.
+func (e DsseEdges) PublicationsOrErr() ([]*Publication, error) {
+	if e.loadedTypes[4] {
+		return e.Publications, nil
+	}
+	return nil, &NotLoadedError{edge: "publications"}
+}
+
+// TransparencyLogEntriesOrErr returns the TransparencyLogEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e DsseEdges) TransparencyLogEntriesOrErr() ([]*TransparencyLogEntry, error) {
+	if e.loadedTypes[5] {
+		return e.TransparencyLogEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "transparency_log_entries"}
+}
+
+// LegalHoldsOrErr returns the LegalHolds value or an error if the edge
+// was not loaded in eager-loading.
+func (e DsseEdges) LegalHoldsOrErr() ([]*LegalHold, error) {
+	if e.loadedTypes[6] {
+		return e.LegalHolds, nil
+	}
+	return nil, &NotLoadedError{edge: "legal_holds"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Dsse) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case dsse.FieldGitoidSha256, dsse.FieldPayloadType:
+		case dsse.FieldTenant, dsse.FieldGitoidSha256, dsse.FieldPayloadType:
 			values[i] = new(sql.NullString)
 		case dsse.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -113,6 +163,12 @@ func (_m *Dsse) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case dsse.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
 		case dsse.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -167,6 +223,26 @@ func (_m *Dsse) QueryPayloadDigests() *PayloadDigestQuery {
 	return NewDsseClient(_m.config).QueryPayloadDigests(_m)
 }
 
+// QueryPublishDeliveries queries the "publish_deliveries" edge of the Dsse entity.
+func (_m *Dsse) QueryPublishDeliveries() *PublishDeliveryQuery {
+	return NewDsseClient(_m.config).QueryPublishDeliveries(_m)
+}
+
+// QueryPublications queries the "publications" edge of the Dsse entity.
+func (_m *Dsse) QueryPublications() *PublicationQuery {
+	return NewDsseClient(_m.config).QueryPublications(_m)
+}
+
+// QueryTransparencyLogEntries queries the "transparency_log_entries" edge of the Dsse entity.
+func (_m *Dsse) QueryTransparencyLogEntries() *TransparencyLogEntryQuery {
+	return NewDsseClient(_m.config).QueryTransparencyLogEntries(_m)
+}
+
+// QueryLegalHolds queries the "legal_holds" edge of the Dsse entity.
+func (_m *Dsse) QueryLegalHolds() *LegalHoldQuery {
+	return NewDsseClient(_m.config).QueryLegalHolds(_m)
+}
+
 // Update returns a builder for updating this Dsse.
 // Note that you need to call Dsse.Unwrap() before calling this method if this Dsse
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -190,6 +266,9 @@ func (_m *Dsse) String() string {
 	var builder strings.Builder
 	builder.WriteString("Dsse(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
 	if v := _m.CreatedAt; v != nil {
 		builder.WriteString("created_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -252,5 +331,101 @@ func (_m *Dsse) appendNamedPayloadDigests(name string, edges ...*PayloadDigest)
 	}
 }
 
+// NamedPublishDeliveries returns the PublishDeliveries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Dsse) NamedPublishDeliveries(name string) ([]*PublishDelivery, error) {
+	if _m.Edges.namedPublishDeliveries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedPublishDeliveries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Dsse) appendNamedPublishDeliveries(name string, edges ...*PublishDelivery) {
+	if _m.Edges.namedPublishDeliveries == nil {
+		_m.Edges.namedPublishDeliveries = make(map[string][]*PublishDelivery)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedPublishDeliveries[name] = []*PublishDelivery{}
+	} else {
+		_m.Edges.namedPublishDeliveries[name] = append(_m.Edges.namedPublishDeliveries[name], edges...)
+	}
+}
+
+// NamedPublications returns the Publications named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Dsse) NamedPublications(name string) ([]*Publication, error) {
+	if _m.Edges.namedPublications == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedPublications[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Dsse) appendNamedPublications(name string, edges ...*Publication) {
+	if _m.Edges.namedPublications == nil {
+		_m.Edges.namedPublications = make(map[string][]*Publication)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedPublications[name] = []*Publication{}
+	} else {
+		_m.Edges.namedPublications[name] = append(_m.Edges.namedPublications[name], edges...)
+	}
+}
+
+// NamedTransparencyLogEntries returns the TransparencyLogEntries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Dsse) NamedTransparencyLogEntries(name string) ([]*TransparencyLogEntry, error) {
+	if _m.Edges.namedTransparencyLogEntries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedTransparencyLogEntries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Dsse) appendNamedTransparencyLogEntries(name string, edges ...*TransparencyLogEntry) {
+	if _m.Edges.namedTransparencyLogEntries == nil {
+		_m.Edges.namedTransparencyLogEntries = make(map[string][]*TransparencyLogEntry)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedTransparencyLogEntries[name] = []*TransparencyLogEntry{}
+	} else {
+		_m.Edges.namedTransparencyLogEntries[name] = append(_m.Edges.namedTransparencyLogEntries[name], edges...)
+	}
+}
+
+// NamedLegalHolds returns the LegalHolds named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Dsse) NamedLegalHolds(name string) ([]*LegalHold, error) {
+	if _m.Edges.namedLegalHolds == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedLegalHolds[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Dsse) appendNamedLegalHolds(name string, edges ...*LegalHold) {
+	if _m.Edges.namedLegalHolds == nil {
+		_m.Edges.namedLegalHolds = make(map[string][]*LegalHold)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedLegalHolds[name] = []*LegalHold{}
+	} else {
+		_m.Edges.namedLegalHolds[name] = append(_m.Edges.namedLegalHolds[name], edges...)
+	}
+}
+
 // Dsses is a parsable slice of Dsse.
 type Dsses []*Dsse
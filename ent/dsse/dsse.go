@@ -5,6 +5,7 @@ package dsse
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -15,6 +16,8 @@ const (
 	Label = "dsse"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldGitoidSha256 holds the string denoting the gitoid_sha256 field in the database.
@@ -27,6 +30,14 @@ const (
 	EdgeSignatures = "signatures"
 	// EdgePayloadDigests holds the string denoting the payload_digests edge name in mutations.
 	EdgePayloadDigests = "payload_digests"
+	// EdgePublishDeliveries holds the string denoting the publish_deliveries edge name in mutations.
+	EdgePublishDeliveries = "publish_deliveries"
+	// EdgePublications holds the string denoting the publications edge name in mutations.
+	EdgePublications = "publications"
+	// EdgeTransparencyLogEntries holds the string denoting the transparency_log_entries edge name in mutations.
+	EdgeTransparencyLogEntries = "transparency_log_entries"
+	// EdgeLegalHolds holds the string denoting the legal_holds edge name in mutations.
+	EdgeLegalHolds = "legal_holds"
 	// Table holds the table name of the dsse in the database.
 	Table = "dsses"
 	// StatementTable is the table that holds the statement relation/edge.
@@ -50,11 +61,38 @@ const (
 	PayloadDigestsInverseTable = "payload_digests"
 	// PayloadDigestsColumn is the table column denoting the payload_digests relation/edge.
 	PayloadDigestsColumn = "dsse_payload_digests"
+	// PublishDeliveriesTable is the table that holds the publish_deliveries relation/edge.
+	PublishDeliveriesTable = "publish_deliveries"
+	// PublishDeliveriesInverseTable is the table name for the PublishDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "publishdelivery" package.
+	PublishDeliveriesInverseTable = "publish_deliveries"
+	// PublishDeliveriesColumn is the table column denoting the publish_deliveries relation/edge.
+	PublishDeliveriesColumn = "dsse_publish_deliveries"
+	// PublicationsTable is the table that holds the publications relation/edge.
+	PublicationsTable = "publications"
+	// PublicationsInverseTable is the table name for the Publication entity.
+	// It exists in this package in order to avoid circular dependency with the "publication" package.
+	PublicationsInverseTable = "publications"
+	// PublicationsColumn is the table column denoting the publications relation/edge.
+	PublicationsColumn = "dsse_publications"
+	// TransparencyLogEntriesTable is the table that holds the transparency_log_entries relation/edge.
+	TransparencyLogEntriesTable = "transparency_log_entries"
+	// TransparencyLogEntriesInverseTable is the table name for the TransparencyLogEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "transparencylogentry" package.
+	TransparencyLogEntriesInverseTable = "transparency_log_entries"
+	// TransparencyLogEntriesColumn is the table column denoting the transparency_log_entries relation/edge.
+	TransparencyLogEntriesColumn = "dsse_transparency_log_entries"
+	// LegalHoldsTable is the table that holds the legal_holds relation/edge. The primary key declared below.
+	LegalHoldsTable = "legal_hold_dsses"
+	// LegalHoldsInverseTable is the table name for the LegalHold entity.
+	// It exists in this package in order to avoid circular dependency with the "legalhold" package.
+	LegalHoldsInverseTable = "legal_holds"
 )
 
 // Columns holds all SQL columns for dsse fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldCreatedAt,
 	FieldGitoidSha256,
 	FieldPayloadType,
@@ -66,6 +104,12 @@ var ForeignKeys = []string{
 	"dsse_statement",
 }
 
+var (
+	// LegalHoldsPrimaryKey and LegalHoldsColumn2 are the table columns denoting the
+	// primary key for the legal_holds relation (M2M).
+	LegalHoldsPrimaryKey = []string{"legal_hold_id", "dsse_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -81,7 +125,18 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// GitoidSha256Validator is a validator for the "gitoid_sha256" field. It is called by the builders before save.
@@ -100,6 +155,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -149,6 +209,62 @@ func ByPayloadDigests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPayloadDigestsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPublishDeliveriesCount orders the results by publish_deliveries count.
+func ByPublishDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPublishDeliveriesStep(), opts...)
+	}
+}
+
+// ByPublishDeliveries orders the results by publish_deliveries terms.
+func ByPublishDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPublishDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPublicationsCount orders the results by publications count.
+func ByPublicationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPublicationsStep(), opts...)
+	}
+}
+
+// ByPublications orders the results by publications terms.
+func ByPublications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPublicationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransparencyLogEntriesCount orders the results by transparency_log_entries count.
+func ByTransparencyLogEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransparencyLogEntriesStep(), opts...)
+	}
+}
+
+// ByTransparencyLogEntries orders the results by transparency_log_entries terms.
+func ByTransparencyLogEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransparencyLogEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLegalHoldsCount orders the results by legal_holds count.
+func ByLegalHoldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLegalHoldsStep(), opts...)
+	}
+}
+
+// ByLegalHolds orders the results by legal_holds terms.
+func ByLegalHolds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLegalHoldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newStatementStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -170,3 +286,31 @@ func newPayloadDigestsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PayloadDigestsTable, PayloadDigestsColumn),
 	)
 }
+func newPublishDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PublishDeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PublishDeliveriesTable, PublishDeliveriesColumn),
+	)
+}
+func newPublicationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PublicationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PublicationsTable, PublicationsColumn),
+	)
+}
+func newTransparencyLogEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransparencyLogEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransparencyLogEntriesTable, TransparencyLogEntriesColumn),
+	)
+}
+func newLegalHoldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LegalHoldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, LegalHoldsTable, LegalHoldsPrimaryKey...),
+	)
+}
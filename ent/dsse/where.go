@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.Dsse {
 	return predicate.Dsse(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldEQ(FieldTenant, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Dsse {
 	return predicate.Dsse(sql.FieldEQ(FieldCreatedAt, v))
@@ -71,6 +76,71 @@ func PayloadType(v string) predicate.Dsse {
 	return predicate.Dsse(sql.FieldEQ(FieldPayloadType, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Dsse {
+	return predicate.Dsse(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Dsse {
 	return predicate.Dsse(sql.FieldEQ(FieldCreatedAt, v))
@@ -320,6 +390,98 @@ func HasPayloadDigestsWith(preds ...predicate.PayloadDigest) predicate.Dsse {
 	})
 }
 
+// HasPublishDeliveries applies the HasEdge predicate on the "publish_deliveries" edge.
+func HasPublishDeliveries() predicate.Dsse {
+	return predicate.Dsse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PublishDeliveriesTable, PublishDeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPublishDeliveriesWith applies the HasEdge predicate on the "publish_deliveries" edge with a given conditions (other predicates).
+func HasPublishDeliveriesWith(preds ...predicate.PublishDelivery) predicate.Dsse {
+	return predicate.Dsse(func(s *sql.Selector) {
+		step := newPublishDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPublications applies the HasEdge predicate on the "publications" edge.
+func HasPublications() predicate.Dsse {
+	return predicate.Dsse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PublicationsTable, PublicationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPublicationsWith applies the HasEdge predicate on the "publications" edge with a given conditions (other predicates).
+func HasPublicationsWith(preds ...predicate.Publication) predicate.Dsse {
+	return predicate.Dsse(func(s *sql.Selector) {
+		step := newPublicationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransparencyLogEntries applies the HasEdge predicate on the "transparency_log_entries" edge.
+func HasTransparencyLogEntries() predicate.Dsse {
+	return predicate.Dsse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransparencyLogEntriesTable, TransparencyLogEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransparencyLogEntriesWith applies the HasEdge predicate on the "transparency_log_entries" edge with a given conditions (other predicates).
+func HasTransparencyLogEntriesWith(preds ...predicate.TransparencyLogEntry) predicate.Dsse {
+	return predicate.Dsse(func(s *sql.Selector) {
+		step := newTransparencyLogEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLegalHolds applies the HasEdge predicate on the "legal_holds" edge.
+func HasLegalHolds() predicate.Dsse {
+	return predicate.Dsse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, LegalHoldsTable, LegalHoldsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLegalHoldsWith applies the HasEdge predicate on the "legal_holds" edge with a given conditions (other predicates).
+func HasLegalHoldsWith(preds ...predicate.LegalHold) predicate.Dsse {
+	return predicate.Dsse(func(s *sql.Selector) {
+		step := newLegalHoldsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Dsse) predicate.Dsse {
 	return predicate.Dsse(sql.AndPredicates(predicates...))
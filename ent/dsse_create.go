@@ -12,9 +12,13 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/legalhold"
 	"github.com/in-toto/archivista/ent/payloaddigest"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
 	"github.com/in-toto/archivista/ent/signature"
 	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
 )
 
 // DsseCreate is the builder for creating a Dsse entity.
@@ -24,6 +28,20 @@ type DsseCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *DsseCreate) SetTenant(v string) *DsseCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *DsseCreate) SetNillableTenant(v *string) *DsseCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *DsseCreate) SetCreatedAt(v time.Time) *DsseCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -113,6 +131,66 @@ func (_c *DsseCreate) AddPayloadDigests(v ...*PayloadDigest) *DsseCreate {
 	return _c.AddPayloadDigestIDs(ids...)
 }
 
+// AddPublishDeliveryIDs adds the "publish_deliveries" edge to the PublishDelivery entity by IDs.
+func (_c *DsseCreate) AddPublishDeliveryIDs(ids ...uuid.UUID) *DsseCreate {
+	_c.mutation.AddPublishDeliveryIDs(ids...)
+	return _c
+}
+
+// AddPublishDeliveries adds the "publish_deliveries" edges to the PublishDelivery entity.
+func (_c *DsseCreate) AddPublishDeliveries(v ...*PublishDelivery) *DsseCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPublishDeliveryIDs(ids...)
+}
+
+// AddPublicationIDs adds the "publications" edge to the Publication entity by IDs.
+func (_c *DsseCreate) AddPublicationIDs(ids ...uuid.UUID) *DsseCreate {
+	_c.mutation.AddPublicationIDs(ids...)
+	return _c
+}
+
+// AddPublications adds the "publications" edges to the Publication entity.
+func (_c *DsseCreate) AddPublications(v ...*Publication) *DsseCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPublicationIDs(ids...)
+}
+
+// AddTransparencyLogEntryIDs adds the "transparency_log_entries" edge to the TransparencyLogEntry entity by IDs.
+func (_c *DsseCreate) AddTransparencyLogEntryIDs(ids ...uuid.UUID) *DsseCreate {
+	_c.mutation.AddTransparencyLogEntryIDs(ids...)
+	return _c
+}
+
+// AddTransparencyLogEntries adds the "transparency_log_entries" edges to the TransparencyLogEntry entity.
+func (_c *DsseCreate) AddTransparencyLogEntries(v ...*TransparencyLogEntry) *DsseCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransparencyLogEntryIDs(ids...)
+}
+
+// AddLegalHoldIDs adds the "legal_holds" edge to the LegalHold entity by IDs.
+func (_c *DsseCreate) AddLegalHoldIDs(ids ...uuid.UUID) *DsseCreate {
+	_c.mutation.AddLegalHoldIDs(ids...)
+	return _c
+}
+
+// AddLegalHolds adds the "legal_holds" edges to the LegalHold entity.
+func (_c *DsseCreate) AddLegalHolds(v ...*LegalHold) *DsseCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLegalHoldIDs(ids...)
+}
+
 // Mutation returns the DsseMutation object of the builder.
 func (_c *DsseCreate) Mutation() *DsseMutation {
 	return _c.mutation
@@ -120,7 +198,9 @@ func (_c *DsseCreate) Mutation() *DsseMutation {
 
 // Save creates the Dsse in the database.
 func (_c *DsseCreate) Save(ctx context.Context) (*Dsse, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -147,19 +227,38 @@ func (_c *DsseCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *DsseCreate) defaults() {
+func (_c *DsseCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := dsse.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if dsse.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dsse.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := dsse.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if dsse.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized dsse.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := dsse.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *DsseCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Dsse.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := dsse.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "Dsse.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.GitoidSha256(); !ok {
 		return &ValidationError{Name: "gitoid_sha256", err: errors.New(`ent: missing required field "Dsse.gitoid_sha256"`)}
 	}
@@ -211,6 +310,10 @@ func (_c *DsseCreate) createSpec() (*Dsse, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(dsse.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(dsse.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = &value
@@ -272,6 +375,70 @@ func (_c *DsseCreate) createSpec() (*Dsse, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PublishDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublishDeliveriesTable,
+			Columns: []string{dsse.PublishDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PublicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublicationsTable,
+			Columns: []string{dsse.PublicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransparencyLogEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.TransparencyLogEntriesTable,
+			Columns: []string{dsse.TransparencyLogEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LegalHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   dsse.LegalHoldsTable,
+			Columns: dsse.LegalHoldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,27 +14,39 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/legalhold"
 	"github.com/in-toto/archivista/ent/payloaddigest"
 	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
 	"github.com/in-toto/archivista/ent/signature"
 	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
 )
 
 // DsseQuery is the builder for querying Dsse entities.
 type DsseQuery struct {
 	config
-	ctx                     *QueryContext
-	order                   []dsse.OrderOption
-	inters                  []Interceptor
-	predicates              []predicate.Dsse
-	withStatement           *StatementQuery
-	withSignatures          *SignatureQuery
-	withPayloadDigests      *PayloadDigestQuery
-	withFKs                 bool
-	modifiers               []func(*sql.Selector)
-	loadTotal               []func(context.Context, []*Dsse) error
-	withNamedSignatures     map[string]*SignatureQuery
-	withNamedPayloadDigests map[string]*PayloadDigestQuery
+	ctx                             *QueryContext
+	order                           []dsse.OrderOption
+	inters                          []Interceptor
+	predicates                      []predicate.Dsse
+	withStatement                   *StatementQuery
+	withSignatures                  *SignatureQuery
+	withPayloadDigests              *PayloadDigestQuery
+	withPublishDeliveries           *PublishDeliveryQuery
+	withPublications                *PublicationQuery
+	withTransparencyLogEntries      *TransparencyLogEntryQuery
+	withLegalHolds                  *LegalHoldQuery
+	withFKs                         bool
+	modifiers                       []func(*sql.Selector)
+	loadTotal                       []func(context.Context, []*Dsse) error
+	withNamedSignatures             map[string]*SignatureQuery
+	withNamedPayloadDigests         map[string]*PayloadDigestQuery
+	withNamedPublishDeliveries      map[string]*PublishDeliveryQuery
+	withNamedPublications           map[string]*PublicationQuery
+	withNamedTransparencyLogEntries map[string]*TransparencyLogEntryQuery
+	withNamedLegalHolds             map[string]*LegalHoldQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -137,6 +149,94 @@ func (_q *DsseQuery) QueryPayloadDigests() *PayloadDigestQuery {
 	return query
 }
 
+// QueryPublishDeliveries chains the current query on the "publish_deliveries" edge.
+func (_q *DsseQuery) QueryPublishDeliveries() *PublishDeliveryQuery {
+	query := (&PublishDeliveryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, selector),
+			sqlgraph.To(publishdelivery.Table, publishdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dsse.PublishDeliveriesTable, dsse.PublishDeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPublications chains the current query on the "publications" edge.
+func (_q *DsseQuery) QueryPublications() *PublicationQuery {
+	query := (&PublicationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, selector),
+			sqlgraph.To(publication.Table, publication.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dsse.PublicationsTable, dsse.PublicationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransparencyLogEntries chains the current query on the "transparency_log_entries" edge.
+func (_q *DsseQuery) QueryTransparencyLogEntries() *TransparencyLogEntryQuery {
+	query := (&TransparencyLogEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, selector),
+			sqlgraph.To(transparencylogentry.Table, transparencylogentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dsse.TransparencyLogEntriesTable, dsse.TransparencyLogEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLegalHolds chains the current query on the "legal_holds" edge.
+func (_q *DsseQuery) QueryLegalHolds() *LegalHoldQuery {
+	query := (&LegalHoldClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dsse.Table, dsse.FieldID, selector),
+			sqlgraph.To(legalhold.Table, legalhold.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, dsse.LegalHoldsTable, dsse.LegalHoldsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Dsse entity from the query.
 // Returns a *NotFoundError when no Dsse was found.
 func (_q *DsseQuery) First(ctx context.Context) (*Dsse, error) {
@@ -324,14 +424,18 @@ func (_q *DsseQuery) Clone() *DsseQuery {
 		return nil
 	}
 	return &DsseQuery{
-		config:             _q.config,
-		ctx:                _q.ctx.Clone(),
-		order:              append([]dsse.OrderOption{}, _q.order...),
-		inters:             append([]Interceptor{}, _q.inters...),
-		predicates:         append([]predicate.Dsse{}, _q.predicates...),
-		withStatement:      _q.withStatement.Clone(),
-		withSignatures:     _q.withSignatures.Clone(),
-		withPayloadDigests: _q.withPayloadDigests.Clone(),
+		config:                     _q.config,
+		ctx:                        _q.ctx.Clone(),
+		order:                      append([]dsse.OrderOption{}, _q.order...),
+		inters:                     append([]Interceptor{}, _q.inters...),
+		predicates:                 append([]predicate.Dsse{}, _q.predicates...),
+		withStatement:              _q.withStatement.Clone(),
+		withSignatures:             _q.withSignatures.Clone(),
+		withPayloadDigests:         _q.withPayloadDigests.Clone(),
+		withPublishDeliveries:      _q.withPublishDeliveries.Clone(),
+		withPublications:           _q.withPublications.Clone(),
+		withTransparencyLogEntries: _q.withTransparencyLogEntries.Clone(),
+		withLegalHolds:             _q.withLegalHolds.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -371,18 +475,62 @@ func (_q *DsseQuery) WithPayloadDigests(opts ...func(*PayloadDigestQuery)) *Dsse
 	return _q
 }
 
+// WithPublishDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "publish_deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DsseQuery) WithPublishDeliveries(opts ...func(*PublishDeliveryQuery)) *DsseQuery {
+	query := (&PublishDeliveryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPublishDeliveries = query
+	return _q
+}
+
+// WithPublications tells the query-builder to eager-load the nodes that are connected to
+// the "publications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DsseQuery) WithPublications(opts ...func(*PublicationQuery)) *DsseQuery {
+	query := (&PublicationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPublications = query
+	return _q
+}
+
+// WithTransparencyLogEntries tells the query-builder to eager-load the nodes that are connected to
+// the "transparency_log_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DsseQuery) WithTransparencyLogEntries(opts ...func(*TransparencyLogEntryQuery)) *DsseQuery {
+	query := (&TransparencyLogEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransparencyLogEntries = query
+	return _q
+}
+
+// WithLegalHolds tells the query-builder to eager-load the nodes that are connected to
+// the "legal_holds" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DsseQuery) WithLegalHolds(opts ...func(*LegalHoldQuery)) *DsseQuery {
+	query := (&LegalHoldClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLegalHolds = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Dsse.Query().
-//		GroupBy(dsse.FieldCreatedAt).
+//		GroupBy(dsse.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *DsseQuery) GroupBy(field string, fields ...string) *DsseGroupBy {
@@ -400,11 +548,11 @@ func (_q *DsseQuery) GroupBy(field string, fields ...string) *DsseGroupBy {
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Dsse.Query().
-//		Select(dsse.FieldCreatedAt).
+//		Select(dsse.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *DsseQuery) Select(fields ...string) *DsseSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -450,10 +598,14 @@ func (_q *DsseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Dsse, e
 		nodes       = []*Dsse{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [7]bool{
 			_q.withStatement != nil,
 			_q.withSignatures != nil,
 			_q.withPayloadDigests != nil,
+			_q.withPublishDeliveries != nil,
+			_q.withPublications != nil,
+			_q.withTransparencyLogEntries != nil,
+			_q.withLegalHolds != nil,
 		}
 	)
 	if _q.withStatement != nil {
@@ -503,6 +655,36 @@ func (_q *DsseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Dsse, e
 			return nil, err
 		}
 	}
+	if query := _q.withPublishDeliveries; query != nil {
+		if err := _q.loadPublishDeliveries(ctx, query, nodes,
+			func(n *Dsse) { n.Edges.PublishDeliveries = []*PublishDelivery{} },
+			func(n *Dsse, e *PublishDelivery) { n.Edges.PublishDeliveries = append(n.Edges.PublishDeliveries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPublications; query != nil {
+		if err := _q.loadPublications(ctx, query, nodes,
+			func(n *Dsse) { n.Edges.Publications = []*Publication{} },
+			func(n *Dsse, e *Publication) { n.Edges.Publications = append(n.Edges.Publications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransparencyLogEntries; query != nil {
+		if err := _q.loadTransparencyLogEntries(ctx, query, nodes,
+			func(n *Dsse) { n.Edges.TransparencyLogEntries = []*TransparencyLogEntry{} },
+			func(n *Dsse, e *TransparencyLogEntry) {
+				n.Edges.TransparencyLogEntries = append(n.Edges.TransparencyLogEntries, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLegalHolds; query != nil {
+		if err := _q.loadLegalHolds(ctx, query, nodes,
+			func(n *Dsse) { n.Edges.LegalHolds = []*LegalHold{} },
+			func(n *Dsse, e *LegalHold) { n.Edges.LegalHolds = append(n.Edges.LegalHolds, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedSignatures {
 		if err := _q.loadSignatures(ctx, query, nodes,
 			func(n *Dsse) { n.appendNamedSignatures(name) },
@@ -517,6 +699,34 @@ func (_q *DsseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Dsse, e
 			return nil, err
 		}
 	}
+	for name, query := range _q.withNamedPublishDeliveries {
+		if err := _q.loadPublishDeliveries(ctx, query, nodes,
+			func(n *Dsse) { n.appendNamedPublishDeliveries(name) },
+			func(n *Dsse, e *PublishDelivery) { n.appendNamedPublishDeliveries(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedPublications {
+		if err := _q.loadPublications(ctx, query, nodes,
+			func(n *Dsse) { n.appendNamedPublications(name) },
+			func(n *Dsse, e *Publication) { n.appendNamedPublications(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedTransparencyLogEntries {
+		if err := _q.loadTransparencyLogEntries(ctx, query, nodes,
+			func(n *Dsse) { n.appendNamedTransparencyLogEntries(name) },
+			func(n *Dsse, e *TransparencyLogEntry) { n.appendNamedTransparencyLogEntries(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedLegalHolds {
+		if err := _q.loadLegalHolds(ctx, query, nodes,
+			func(n *Dsse) { n.appendNamedLegalHolds(name) },
+			func(n *Dsse, e *LegalHold) { n.appendNamedLegalHolds(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range _q.loadTotal {
 		if err := _q.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -619,6 +829,160 @@ func (_q *DsseQuery) loadPayloadDigests(ctx context.Context, query *PayloadDiges
 	}
 	return nil
 }
+func (_q *DsseQuery) loadPublishDeliveries(ctx context.Context, query *PublishDeliveryQuery, nodes []*Dsse, init func(*Dsse), assign func(*Dsse, *PublishDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Dsse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PublishDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(dsse.PublishDeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.dsse_publish_deliveries
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "dsse_publish_deliveries" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "dsse_publish_deliveries" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DsseQuery) loadPublications(ctx context.Context, query *PublicationQuery, nodes []*Dsse, init func(*Dsse), assign func(*Dsse, *Publication)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Dsse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Publication(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(dsse.PublicationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.dsse_publications
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "dsse_publications" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "dsse_publications" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DsseQuery) loadTransparencyLogEntries(ctx context.Context, query *TransparencyLogEntryQuery, nodes []*Dsse, init func(*Dsse), assign func(*Dsse, *TransparencyLogEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Dsse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TransparencyLogEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(dsse.TransparencyLogEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.dsse_transparency_log_entries
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "dsse_transparency_log_entries" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "dsse_transparency_log_entries" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DsseQuery) loadLegalHolds(ctx context.Context, query *LegalHoldQuery, nodes []*Dsse, init func(*Dsse), assign func(*Dsse, *LegalHold)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Dsse)
+	nids := make(map[uuid.UUID]map[*Dsse]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(dsse.LegalHoldsTable)
+		s.Join(joinT).On(s.C(legalhold.FieldID), joinT.C(dsse.LegalHoldsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(dsse.LegalHoldsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(dsse.LegalHoldsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Dsse]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*LegalHold](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "legal_holds" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *DsseQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -732,6 +1096,62 @@ func (_q *DsseQuery) WithNamedPayloadDigests(name string, opts ...func(*PayloadD
 	return _q
 }
 
+// WithNamedPublishDeliveries tells the query-builder to eager-load the nodes that are connected to the "publish_deliveries"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *DsseQuery) WithNamedPublishDeliveries(name string, opts ...func(*PublishDeliveryQuery)) *DsseQuery {
+	query := (&PublishDeliveryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedPublishDeliveries == nil {
+		_q.withNamedPublishDeliveries = make(map[string]*PublishDeliveryQuery)
+	}
+	_q.withNamedPublishDeliveries[name] = query
+	return _q
+}
+
+// WithNamedPublications tells the query-builder to eager-load the nodes that are connected to the "publications"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *DsseQuery) WithNamedPublications(name string, opts ...func(*PublicationQuery)) *DsseQuery {
+	query := (&PublicationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedPublications == nil {
+		_q.withNamedPublications = make(map[string]*PublicationQuery)
+	}
+	_q.withNamedPublications[name] = query
+	return _q
+}
+
+// WithNamedTransparencyLogEntries tells the query-builder to eager-load the nodes that are connected to the "transparency_log_entries"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *DsseQuery) WithNamedTransparencyLogEntries(name string, opts ...func(*TransparencyLogEntryQuery)) *DsseQuery {
+	query := (&TransparencyLogEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedTransparencyLogEntries == nil {
+		_q.withNamedTransparencyLogEntries = make(map[string]*TransparencyLogEntryQuery)
+	}
+	_q.withNamedTransparencyLogEntries[name] = query
+	return _q
+}
+
+// WithNamedLegalHolds tells the query-builder to eager-load the nodes that are connected to the "legal_holds"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *DsseQuery) WithNamedLegalHolds(name string, opts ...func(*LegalHoldQuery)) *DsseQuery {
+	query := (&LegalHoldClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedLegalHolds == nil {
+		_q.withNamedLegalHolds = make(map[string]*LegalHoldQuery)
+	}
+	_q.withNamedLegalHolds[name] = query
+	return _q
+}
+
 // DsseGroupBy is the group-by builder for Dsse entities.
 type DsseGroupBy struct {
 	selector
@@ -12,10 +12,14 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/legalhold"
 	"github.com/in-toto/archivista/ent/payloaddigest"
 	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
 	"github.com/in-toto/archivista/ent/signature"
 	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
 )
 
 // DsseUpdate is the builder for updating Dsse entities.
@@ -108,6 +112,66 @@ func (_u *DsseUpdate) AddPayloadDigests(v ...*PayloadDigest) *DsseUpdate {
 	return _u.AddPayloadDigestIDs(ids...)
 }
 
+// AddPublishDeliveryIDs adds the "publish_deliveries" edge to the PublishDelivery entity by IDs.
+func (_u *DsseUpdate) AddPublishDeliveryIDs(ids ...uuid.UUID) *DsseUpdate {
+	_u.mutation.AddPublishDeliveryIDs(ids...)
+	return _u
+}
+
+// AddPublishDeliveries adds the "publish_deliveries" edges to the PublishDelivery entity.
+func (_u *DsseUpdate) AddPublishDeliveries(v ...*PublishDelivery) *DsseUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPublishDeliveryIDs(ids...)
+}
+
+// AddPublicationIDs adds the "publications" edge to the Publication entity by IDs.
+func (_u *DsseUpdate) AddPublicationIDs(ids ...uuid.UUID) *DsseUpdate {
+	_u.mutation.AddPublicationIDs(ids...)
+	return _u
+}
+
+// AddPublications adds the "publications" edges to the Publication entity.
+func (_u *DsseUpdate) AddPublications(v ...*Publication) *DsseUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPublicationIDs(ids...)
+}
+
+// AddTransparencyLogEntryIDs adds the "transparency_log_entries" edge to the TransparencyLogEntry entity by IDs.
+func (_u *DsseUpdate) AddTransparencyLogEntryIDs(ids ...uuid.UUID) *DsseUpdate {
+	_u.mutation.AddTransparencyLogEntryIDs(ids...)
+	return _u
+}
+
+// AddTransparencyLogEntries adds the "transparency_log_entries" edges to the TransparencyLogEntry entity.
+func (_u *DsseUpdate) AddTransparencyLogEntries(v ...*TransparencyLogEntry) *DsseUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransparencyLogEntryIDs(ids...)
+}
+
+// AddLegalHoldIDs adds the "legal_holds" edge to the LegalHold entity by IDs.
+func (_u *DsseUpdate) AddLegalHoldIDs(ids ...uuid.UUID) *DsseUpdate {
+	_u.mutation.AddLegalHoldIDs(ids...)
+	return _u
+}
+
+// AddLegalHolds adds the "legal_holds" edges to the LegalHold entity.
+func (_u *DsseUpdate) AddLegalHolds(v ...*LegalHold) *DsseUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLegalHoldIDs(ids...)
+}
+
 // Mutation returns the DsseMutation object of the builder.
 func (_u *DsseUpdate) Mutation() *DsseMutation {
 	return _u.mutation
@@ -161,6 +225,90 @@ func (_u *DsseUpdate) RemovePayloadDigests(v ...*PayloadDigest) *DsseUpdate {
 	return _u.RemovePayloadDigestIDs(ids...)
 }
 
+// ClearPublishDeliveries clears all "publish_deliveries" edges to the PublishDelivery entity.
+func (_u *DsseUpdate) ClearPublishDeliveries() *DsseUpdate {
+	_u.mutation.ClearPublishDeliveries()
+	return _u
+}
+
+// RemovePublishDeliveryIDs removes the "publish_deliveries" edge to PublishDelivery entities by IDs.
+func (_u *DsseUpdate) RemovePublishDeliveryIDs(ids ...uuid.UUID) *DsseUpdate {
+	_u.mutation.RemovePublishDeliveryIDs(ids...)
+	return _u
+}
+
+// RemovePublishDeliveries removes "publish_deliveries" edges to PublishDelivery entities.
+func (_u *DsseUpdate) RemovePublishDeliveries(v ...*PublishDelivery) *DsseUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePublishDeliveryIDs(ids...)
+}
+
+// ClearPublications clears all "publications" edges to the Publication entity.
+func (_u *DsseUpdate) ClearPublications() *DsseUpdate {
+	_u.mutation.ClearPublications()
+	return _u
+}
+
+// RemovePublicationIDs removes the "publications" edge to Publication entities by IDs.
+func (_u *DsseUpdate) RemovePublicationIDs(ids ...uuid.UUID) *DsseUpdate {
+	_u.mutation.RemovePublicationIDs(ids...)
+	return _u
+}
+
+// RemovePublications removes "publications" edges to Publication entities.
+func (_u *DsseUpdate) RemovePublications(v ...*Publication) *DsseUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePublicationIDs(ids...)
+}
+
+// ClearTransparencyLogEntries clears all "transparency_log_entries" edges to the TransparencyLogEntry entity.
+func (_u *DsseUpdate) ClearTransparencyLogEntries() *DsseUpdate {
+	_u.mutation.ClearTransparencyLogEntries()
+	return _u
+}
+
+// RemoveTransparencyLogEntryIDs removes the "transparency_log_entries" edge to TransparencyLogEntry entities by IDs.
+func (_u *DsseUpdate) RemoveTransparencyLogEntryIDs(ids ...uuid.UUID) *DsseUpdate {
+	_u.mutation.RemoveTransparencyLogEntryIDs(ids...)
+	return _u
+}
+
+// RemoveTransparencyLogEntries removes "transparency_log_entries" edges to TransparencyLogEntry entities.
+func (_u *DsseUpdate) RemoveTransparencyLogEntries(v ...*TransparencyLogEntry) *DsseUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransparencyLogEntryIDs(ids...)
+}
+
+// ClearLegalHolds clears all "legal_holds" edges to the LegalHold entity.
+func (_u *DsseUpdate) ClearLegalHolds() *DsseUpdate {
+	_u.mutation.ClearLegalHolds()
+	return _u
+}
+
+// RemoveLegalHoldIDs removes the "legal_holds" edge to LegalHold entities by IDs.
+func (_u *DsseUpdate) RemoveLegalHoldIDs(ids ...uuid.UUID) *DsseUpdate {
+	_u.mutation.RemoveLegalHoldIDs(ids...)
+	return _u
+}
+
+// RemoveLegalHolds removes "legal_holds" edges to LegalHold entities.
+func (_u *DsseUpdate) RemoveLegalHolds(v ...*LegalHold) *DsseUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLegalHoldIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DsseUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -343,6 +491,186 @@ func (_u *DsseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PublishDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublishDeliveriesTable,
+			Columns: []string{dsse.PublishDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPublishDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.PublishDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublishDeliveriesTable,
+			Columns: []string{dsse.PublishDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PublishDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublishDeliveriesTable,
+			Columns: []string{dsse.PublishDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PublicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublicationsTable,
+			Columns: []string{dsse.PublicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPublicationsIDs(); len(nodes) > 0 && !_u.mutation.PublicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublicationsTable,
+			Columns: []string{dsse.PublicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PublicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublicationsTable,
+			Columns: []string{dsse.PublicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransparencyLogEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.TransparencyLogEntriesTable,
+			Columns: []string{dsse.TransparencyLogEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransparencyLogEntriesIDs(); len(nodes) > 0 && !_u.mutation.TransparencyLogEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.TransparencyLogEntriesTable,
+			Columns: []string{dsse.TransparencyLogEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransparencyLogEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.TransparencyLogEntriesTable,
+			Columns: []string{dsse.TransparencyLogEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   dsse.LegalHoldsTable,
+			Columns: dsse.LegalHoldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLegalHoldsIDs(); len(nodes) > 0 && !_u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   dsse.LegalHoldsTable,
+			Columns: dsse.LegalHoldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LegalHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   dsse.LegalHoldsTable,
+			Columns: dsse.LegalHoldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{dsse.Label}
@@ -440,6 +768,66 @@ func (_u *DsseUpdateOne) AddPayloadDigests(v ...*PayloadDigest) *DsseUpdateOne {
 	return _u.AddPayloadDigestIDs(ids...)
 }
 
+// AddPublishDeliveryIDs adds the "publish_deliveries" edge to the PublishDelivery entity by IDs.
+func (_u *DsseUpdateOne) AddPublishDeliveryIDs(ids ...uuid.UUID) *DsseUpdateOne {
+	_u.mutation.AddPublishDeliveryIDs(ids...)
+	return _u
+}
+
+// AddPublishDeliveries adds the "publish_deliveries" edges to the PublishDelivery entity.
+func (_u *DsseUpdateOne) AddPublishDeliveries(v ...*PublishDelivery) *DsseUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPublishDeliveryIDs(ids...)
+}
+
+// AddPublicationIDs adds the "publications" edge to the Publication entity by IDs.
+func (_u *DsseUpdateOne) AddPublicationIDs(ids ...uuid.UUID) *DsseUpdateOne {
+	_u.mutation.AddPublicationIDs(ids...)
+	return _u
+}
+
+// AddPublications adds the "publications" edges to the Publication entity.
+func (_u *DsseUpdateOne) AddPublications(v ...*Publication) *DsseUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPublicationIDs(ids...)
+}
+
+// AddTransparencyLogEntryIDs adds the "transparency_log_entries" edge to the TransparencyLogEntry entity by IDs.
+func (_u *DsseUpdateOne) AddTransparencyLogEntryIDs(ids ...uuid.UUID) *DsseUpdateOne {
+	_u.mutation.AddTransparencyLogEntryIDs(ids...)
+	return _u
+}
+
+// AddTransparencyLogEntries adds the "transparency_log_entries" edges to the TransparencyLogEntry entity.
+func (_u *DsseUpdateOne) AddTransparencyLogEntries(v ...*TransparencyLogEntry) *DsseUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransparencyLogEntryIDs(ids...)
+}
+
+// AddLegalHoldIDs adds the "legal_holds" edge to the LegalHold entity by IDs.
+func (_u *DsseUpdateOne) AddLegalHoldIDs(ids ...uuid.UUID) *DsseUpdateOne {
+	_u.mutation.AddLegalHoldIDs(ids...)
+	return _u
+}
+
+// AddLegalHolds adds the "legal_holds" edges to the LegalHold entity.
+func (_u *DsseUpdateOne) AddLegalHolds(v ...*LegalHold) *DsseUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLegalHoldIDs(ids...)
+}
+
 // Mutation returns the DsseMutation object of the builder.
 func (_u *DsseUpdateOne) Mutation() *DsseMutation {
 	return _u.mutation
@@ -493,6 +881,90 @@ func (_u *DsseUpdateOne) RemovePayloadDigests(v ...*PayloadDigest) *DsseUpdateOn
 	return _u.RemovePayloadDigestIDs(ids...)
 }
 
+// ClearPublishDeliveries clears all "publish_deliveries" edges to the PublishDelivery entity.
+func (_u *DsseUpdateOne) ClearPublishDeliveries() *DsseUpdateOne {
+	_u.mutation.ClearPublishDeliveries()
+	return _u
+}
+
+// RemovePublishDeliveryIDs removes the "publish_deliveries" edge to PublishDelivery entities by IDs.
+func (_u *DsseUpdateOne) RemovePublishDeliveryIDs(ids ...uuid.UUID) *DsseUpdateOne {
+	_u.mutation.RemovePublishDeliveryIDs(ids...)
+	return _u
+}
+
+// RemovePublishDeliveries removes "publish_deliveries" edges to PublishDelivery entities.
+func (_u *DsseUpdateOne) RemovePublishDeliveries(v ...*PublishDelivery) *DsseUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePublishDeliveryIDs(ids...)
+}
+
+// ClearPublications clears all "publications" edges to the Publication entity.
+func (_u *DsseUpdateOne) ClearPublications() *DsseUpdateOne {
+	_u.mutation.ClearPublications()
+	return _u
+}
+
+// RemovePublicationIDs removes the "publications" edge to Publication entities by IDs.
+func (_u *DsseUpdateOne) RemovePublicationIDs(ids ...uuid.UUID) *DsseUpdateOne {
+	_u.mutation.RemovePublicationIDs(ids...)
+	return _u
+}
+
+// RemovePublications removes "publications" edges to Publication entities.
+func (_u *DsseUpdateOne) RemovePublications(v ...*Publication) *DsseUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePublicationIDs(ids...)
+}
+
+// ClearTransparencyLogEntries clears all "transparency_log_entries" edges to the TransparencyLogEntry entity.
+func (_u *DsseUpdateOne) ClearTransparencyLogEntries() *DsseUpdateOne {
+	_u.mutation.ClearTransparencyLogEntries()
+	return _u
+}
+
+// RemoveTransparencyLogEntryIDs removes the "transparency_log_entries" edge to TransparencyLogEntry entities by IDs.
+func (_u *DsseUpdateOne) RemoveTransparencyLogEntryIDs(ids ...uuid.UUID) *DsseUpdateOne {
+	_u.mutation.RemoveTransparencyLogEntryIDs(ids...)
+	return _u
+}
+
+// RemoveTransparencyLogEntries removes "transparency_log_entries" edges to TransparencyLogEntry entities.
+func (_u *DsseUpdateOne) RemoveTransparencyLogEntries(v ...*TransparencyLogEntry) *DsseUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransparencyLogEntryIDs(ids...)
+}
+
+// ClearLegalHolds clears all "legal_holds" edges to the LegalHold entity.
+func (_u *DsseUpdateOne) ClearLegalHolds() *DsseUpdateOne {
+	_u.mutation.ClearLegalHolds()
+	return _u
+}
+
+// RemoveLegalHoldIDs removes the "legal_holds" edge to LegalHold entities by IDs.
+func (_u *DsseUpdateOne) RemoveLegalHoldIDs(ids ...uuid.UUID) *DsseUpdateOne {
+	_u.mutation.RemoveLegalHoldIDs(ids...)
+	return _u
+}
+
+// RemoveLegalHolds removes "legal_holds" edges to LegalHold entities.
+func (_u *DsseUpdateOne) RemoveLegalHolds(v ...*LegalHold) *DsseUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLegalHoldIDs(ids...)
+}
+
 // Where appends a list predicates to the DsseUpdate builder.
 func (_u *DsseUpdateOne) Where(ps ...predicate.Dsse) *DsseUpdateOne {
 	_u.mutation.Where(ps...)
@@ -705,6 +1177,186 @@ func (_u *DsseUpdateOne) sqlSave(ctx context.Context) (_node *Dsse, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PublishDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublishDeliveriesTable,
+			Columns: []string{dsse.PublishDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPublishDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.PublishDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublishDeliveriesTable,
+			Columns: []string{dsse.PublishDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PublishDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublishDeliveriesTable,
+			Columns: []string{dsse.PublishDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PublicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublicationsTable,
+			Columns: []string{dsse.PublicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPublicationsIDs(); len(nodes) > 0 && !_u.mutation.PublicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublicationsTable,
+			Columns: []string{dsse.PublicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PublicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.PublicationsTable,
+			Columns: []string{dsse.PublicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransparencyLogEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.TransparencyLogEntriesTable,
+			Columns: []string{dsse.TransparencyLogEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransparencyLogEntriesIDs(); len(nodes) > 0 && !_u.mutation.TransparencyLogEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.TransparencyLogEntriesTable,
+			Columns: []string{dsse.TransparencyLogEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransparencyLogEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dsse.TransparencyLogEntriesTable,
+			Columns: []string{dsse.TransparencyLogEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   dsse.LegalHoldsTable,
+			Columns: dsse.LegalHoldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLegalHoldsIDs(); len(nodes) > 0 && !_u.mutation.LegalHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   dsse.LegalHoldsTable,
+			Columns: dsse.LegalHoldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LegalHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   dsse.LegalHoldsTable,
+			Columns: dsse.LegalHoldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Dsse{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -15,13 +15,32 @@ import (
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
 	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
 	"github.com/in-toto/archivista/ent/payloaddigest"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
 	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
 	"github.com/in-toto/archivista/ent/subjectdigest"
 	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -82,16 +101,35 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			attestation.Table:           attestation.ValidColumn,
-			attestationcollection.Table: attestationcollection.ValidColumn,
-			attestationpolicy.Table:     attestationpolicy.ValidColumn,
-			dsse.Table:                  dsse.ValidColumn,
-			payloaddigest.Table:         payloaddigest.ValidColumn,
-			signature.Table:             signature.ValidColumn,
-			statement.Table:             statement.ValidColumn,
-			subject.Table:               subject.ValidColumn,
-			subjectdigest.Table:         subjectdigest.ValidColumn,
-			timestamp.Table:             timestamp.ValidColumn,
+			attestation.Table:            attestation.ValidColumn,
+			attestationcollection.Table:  attestationcollection.ValidColumn,
+			attestationpolicy.Table:      attestationpolicy.ValidColumn,
+			certificate.Table:            certificate.ValidColumn,
+			commandrunattestation.Table:  commandrunattestation.ValidColumn,
+			dsse.Table:                   dsse.ValidColumn,
+			environmentattestation.Table: environmentattestation.ValidColumn,
+			gitattestation.Table:         gitattestation.ValidColumn,
+			githubattestation.Table:      githubattestation.ValidColumn,
+			gitlabattestation.Table:      gitlabattestation.ValidColumn,
+			legalhold.Table:              legalhold.ValidColumn,
+			material.Table:               material.ValidColumn,
+			ociattestation.Table:         ociattestation.ValidColumn,
+			payloaddigest.Table:          payloaddigest.ValidColumn,
+			product.Table:                product.ValidColumn,
+			publication.Table:            publication.ValidColumn,
+			publishdelivery.Table:        publishdelivery.ValidColumn,
+			sbompackage.Table:            sbompackage.ValidColumn,
+			sbompackagedigest.Table:      sbompackagedigest.ValidColumn,
+			signature.Table:              signature.ValidColumn,
+			slsadependency.Table:         slsadependency.ValidColumn,
+			slsaprovenance.Table:         slsaprovenance.ValidColumn,
+			statement.Table:              statement.ValidColumn,
+			subject.Table:                subject.ValidColumn,
+			subjectaltname.Table:         subjectaltname.ValidColumn,
+			subjectdigest.Table:          subjectdigest.ValidColumn,
+			timestamp.Table:              timestamp.ValidColumn,
+			transparencylogentry.Table:   transparencylogentry.ValidColumn,
+			verificationsummary.Table:    verificationsummary.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
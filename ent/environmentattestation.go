@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+)
+
+// EnvironmentAttestation is the model entity for the EnvironmentAttestation schema.
+type EnvironmentAttestation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// Os holds the value of the "os" field.
+	Os string `json:"os,omitempty"`
+	// Hostname holds the value of the "hostname" field.
+	Hostname string `json:"hostname,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnvironmentAttestationQuery when eager-loading is set.
+	Edges                               EnvironmentAttestationEdges `json:"edges"`
+	attestation_environment_attestation *uuid.UUID
+	selectValues                        sql.SelectValues
+}
+
+// EnvironmentAttestationEdges holds the relations/edges for other nodes in the graph.
+type EnvironmentAttestationEdges struct {
+	// Attestation holds the value of the attestation edge.
+	Attestation *Attestation `json:"attestation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// AttestationOrErr returns the Attestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnvironmentAttestationEdges) AttestationOrErr() (*Attestation, error) {
+	if e.Attestation != nil {
+		return e.Attestation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "attestation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnvironmentAttestation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case environmentattestation.FieldTenant, environmentattestation.FieldOs, environmentattestation.FieldHostname, environmentattestation.FieldUsername:
+			values[i] = new(sql.NullString)
+		case environmentattestation.FieldID:
+			values[i] = new(uuid.UUID)
+		case environmentattestation.ForeignKeys[0]: // attestation_environment_attestation
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnvironmentAttestation fields.
+func (_m *EnvironmentAttestation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case environmentattestation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case environmentattestation.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case environmentattestation.FieldOs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os", values[i])
+			} else if value.Valid {
+				_m.Os = value.String
+			}
+		case environmentattestation.FieldHostname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hostname", values[i])
+			} else if value.Valid {
+				_m.Hostname = value.String
+			}
+		case environmentattestation.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				_m.Username = value.String
+			}
+		case environmentattestation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_environment_attestation", values[i])
+			} else if value.Valid {
+				_m.attestation_environment_attestation = new(uuid.UUID)
+				*_m.attestation_environment_attestation = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnvironmentAttestation.
+// This includes values selected through modifiers, order, etc.
+func (_m *EnvironmentAttestation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttestation queries the "attestation" edge of the EnvironmentAttestation entity.
+func (_m *EnvironmentAttestation) QueryAttestation() *AttestationQuery {
+	return NewEnvironmentAttestationClient(_m.config).QueryAttestation(_m)
+}
+
+// Update returns a builder for updating this EnvironmentAttestation.
+// Note that you need to call EnvironmentAttestation.Unwrap() before calling this method if this EnvironmentAttestation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EnvironmentAttestation) Update() *EnvironmentAttestationUpdateOne {
+	return NewEnvironmentAttestationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EnvironmentAttestation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EnvironmentAttestation) Unwrap() *EnvironmentAttestation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnvironmentAttestation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EnvironmentAttestation) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnvironmentAttestation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("os=")
+	builder.WriteString(_m.Os)
+	builder.WriteString(", ")
+	builder.WriteString("hostname=")
+	builder.WriteString(_m.Hostname)
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(_m.Username)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnvironmentAttestations is a parsable slice of EnvironmentAttestation.
+type EnvironmentAttestations []*EnvironmentAttestation
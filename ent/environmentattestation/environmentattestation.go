@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package environmentattestation
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the environmentattestation type in the database.
+	Label = "environment_attestation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldOs holds the string denoting the os field in the database.
+	FieldOs = "os"
+	// FieldHostname holds the string denoting the hostname field in the database.
+	FieldHostname = "hostname"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// EdgeAttestation holds the string denoting the attestation edge name in mutations.
+	EdgeAttestation = "attestation"
+	// Table holds the table name of the environmentattestation in the database.
+	Table = "environment_attestations"
+	// AttestationTable is the table that holds the attestation relation/edge.
+	AttestationTable = "environment_attestations"
+	// AttestationInverseTable is the table name for the Attestation entity.
+	// It exists in this package in order to avoid circular dependency with the "attestation" package.
+	AttestationInverseTable = "attestations"
+	// AttestationColumn is the table column denoting the attestation relation/edge.
+	AttestationColumn = "attestation_environment_attestation"
+)
+
+// Columns holds all SQL columns for environmentattestation fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldOs,
+	FieldHostname,
+	FieldUsername,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "environment_attestations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"attestation_environment_attestation",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the EnvironmentAttestation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByOs orders the results by the os field.
+func ByOs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOs, opts...).ToFunc()
+}
+
+// ByHostname orders the results by the hostname field.
+func ByHostname(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostname, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByAttestationField orders the results by attestation field.
+func ByAttestationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttestationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttestationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttestationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, AttestationTable, AttestationColumn),
+	)
+}
@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package environmentattestation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// Os applies equality check predicate on the "os" field. It's identical to OsEQ.
+func Os(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldOs, v))
+}
+
+// Hostname applies equality check predicate on the "hostname" field. It's identical to HostnameEQ.
+func Hostname(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldHostname, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldUsername, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// OsEQ applies the EQ predicate on the "os" field.
+func OsEQ(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldOs, v))
+}
+
+// OsNEQ applies the NEQ predicate on the "os" field.
+func OsNEQ(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNEQ(FieldOs, v))
+}
+
+// OsIn applies the In predicate on the "os" field.
+func OsIn(vs ...string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldIn(FieldOs, vs...))
+}
+
+// OsNotIn applies the NotIn predicate on the "os" field.
+func OsNotIn(vs ...string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNotIn(FieldOs, vs...))
+}
+
+// OsGT applies the GT predicate on the "os" field.
+func OsGT(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGT(FieldOs, v))
+}
+
+// OsGTE applies the GTE predicate on the "os" field.
+func OsGTE(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGTE(FieldOs, v))
+}
+
+// OsLT applies the LT predicate on the "os" field.
+func OsLT(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLT(FieldOs, v))
+}
+
+// OsLTE applies the LTE predicate on the "os" field.
+func OsLTE(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLTE(FieldOs, v))
+}
+
+// OsContains applies the Contains predicate on the "os" field.
+func OsContains(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldContains(FieldOs, v))
+}
+
+// OsHasPrefix applies the HasPrefix predicate on the "os" field.
+func OsHasPrefix(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldHasPrefix(FieldOs, v))
+}
+
+// OsHasSuffix applies the HasSuffix predicate on the "os" field.
+func OsHasSuffix(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldHasSuffix(FieldOs, v))
+}
+
+// OsIsNil applies the IsNil predicate on the "os" field.
+func OsIsNil() predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldIsNull(FieldOs))
+}
+
+// OsNotNil applies the NotNil predicate on the "os" field.
+func OsNotNil() predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNotNull(FieldOs))
+}
+
+// OsEqualFold applies the EqualFold predicate on the "os" field.
+func OsEqualFold(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEqualFold(FieldOs, v))
+}
+
+// OsContainsFold applies the ContainsFold predicate on the "os" field.
+func OsContainsFold(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldContainsFold(FieldOs, v))
+}
+
+// HostnameEQ applies the EQ predicate on the "hostname" field.
+func HostnameEQ(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldHostname, v))
+}
+
+// HostnameNEQ applies the NEQ predicate on the "hostname" field.
+func HostnameNEQ(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNEQ(FieldHostname, v))
+}
+
+// HostnameIn applies the In predicate on the "hostname" field.
+func HostnameIn(vs ...string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldIn(FieldHostname, vs...))
+}
+
+// HostnameNotIn applies the NotIn predicate on the "hostname" field.
+func HostnameNotIn(vs ...string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNotIn(FieldHostname, vs...))
+}
+
+// HostnameGT applies the GT predicate on the "hostname" field.
+func HostnameGT(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGT(FieldHostname, v))
+}
+
+// HostnameGTE applies the GTE predicate on the "hostname" field.
+func HostnameGTE(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGTE(FieldHostname, v))
+}
+
+// HostnameLT applies the LT predicate on the "hostname" field.
+func HostnameLT(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLT(FieldHostname, v))
+}
+
+// HostnameLTE applies the LTE predicate on the "hostname" field.
+func HostnameLTE(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLTE(FieldHostname, v))
+}
+
+// HostnameContains applies the Contains predicate on the "hostname" field.
+func HostnameContains(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldContains(FieldHostname, v))
+}
+
+// HostnameHasPrefix applies the HasPrefix predicate on the "hostname" field.
+func HostnameHasPrefix(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldHasPrefix(FieldHostname, v))
+}
+
+// HostnameHasSuffix applies the HasSuffix predicate on the "hostname" field.
+func HostnameHasSuffix(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldHasSuffix(FieldHostname, v))
+}
+
+// HostnameIsNil applies the IsNil predicate on the "hostname" field.
+func HostnameIsNil() predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldIsNull(FieldHostname))
+}
+
+// HostnameNotNil applies the NotNil predicate on the "hostname" field.
+func HostnameNotNil() predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNotNull(FieldHostname))
+}
+
+// HostnameEqualFold applies the EqualFold predicate on the "hostname" field.
+func HostnameEqualFold(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEqualFold(FieldHostname, v))
+}
+
+// HostnameContainsFold applies the ContainsFold predicate on the "hostname" field.
+func HostnameContainsFold(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldContainsFold(FieldHostname, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameIsNil applies the IsNil predicate on the "username" field.
+func UsernameIsNil() predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldIsNull(FieldUsername))
+}
+
+// UsernameNotNil applies the NotNil predicate on the "username" field.
+func UsernameNotNil() predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldNotNull(FieldUsername))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// HasAttestation applies the HasEdge predicate on the "attestation" edge.
+func HasAttestation() predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AttestationTable, AttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttestationWith applies the HasEdge predicate on the "attestation" edge with a given conditions (other predicates).
+func HasAttestationWith(preds ...predicate.Attestation) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(func(s *sql.Selector) {
+		step := newAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnvironmentAttestation) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnvironmentAttestation) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnvironmentAttestation) predicate.EnvironmentAttestation {
+	return predicate.EnvironmentAttestation(sql.NotPredicates(p))
+}
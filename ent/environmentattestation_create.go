@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+)
+
+// EnvironmentAttestationCreate is the builder for creating a EnvironmentAttestation entity.
+type EnvironmentAttestationCreate struct {
+	config
+	mutation *EnvironmentAttestationMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *EnvironmentAttestationCreate) SetTenant(v string) *EnvironmentAttestationCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *EnvironmentAttestationCreate) SetNillableTenant(v *string) *EnvironmentAttestationCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetOs sets the "os" field.
+func (_c *EnvironmentAttestationCreate) SetOs(v string) *EnvironmentAttestationCreate {
+	_c.mutation.SetOs(v)
+	return _c
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_c *EnvironmentAttestationCreate) SetNillableOs(v *string) *EnvironmentAttestationCreate {
+	if v != nil {
+		_c.SetOs(*v)
+	}
+	return _c
+}
+
+// SetHostname sets the "hostname" field.
+func (_c *EnvironmentAttestationCreate) SetHostname(v string) *EnvironmentAttestationCreate {
+	_c.mutation.SetHostname(v)
+	return _c
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_c *EnvironmentAttestationCreate) SetNillableHostname(v *string) *EnvironmentAttestationCreate {
+	if v != nil {
+		_c.SetHostname(*v)
+	}
+	return _c
+}
+
+// SetUsername sets the "username" field.
+func (_c *EnvironmentAttestationCreate) SetUsername(v string) *EnvironmentAttestationCreate {
+	_c.mutation.SetUsername(v)
+	return _c
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_c *EnvironmentAttestationCreate) SetNillableUsername(v *string) *EnvironmentAttestationCreate {
+	if v != nil {
+		_c.SetUsername(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EnvironmentAttestationCreate) SetID(v uuid.UUID) *EnvironmentAttestationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EnvironmentAttestationCreate) SetNillableID(v *uuid.UUID) *EnvironmentAttestationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_c *EnvironmentAttestationCreate) SetAttestationID(id uuid.UUID) *EnvironmentAttestationCreate {
+	_c.mutation.SetAttestationID(id)
+	return _c
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_c *EnvironmentAttestationCreate) SetAttestation(v *Attestation) *EnvironmentAttestationCreate {
+	return _c.SetAttestationID(v.ID)
+}
+
+// Mutation returns the EnvironmentAttestationMutation object of the builder.
+func (_c *EnvironmentAttestationCreate) Mutation() *EnvironmentAttestationMutation {
+	return _c.mutation
+}
+
+// Save creates the EnvironmentAttestation in the database.
+func (_c *EnvironmentAttestationCreate) Save(ctx context.Context) (*EnvironmentAttestation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnvironmentAttestationCreate) SaveX(ctx context.Context) *EnvironmentAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvironmentAttestationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvironmentAttestationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnvironmentAttestationCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := environmentattestation.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if environmentattestation.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized environmentattestation.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := environmentattestation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnvironmentAttestationCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "EnvironmentAttestation.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := environmentattestation.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "EnvironmentAttestation.tenant": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AttestationIDs()) == 0 {
+		return &ValidationError{Name: "attestation", err: errors.New(`ent: missing required edge "EnvironmentAttestation.attestation"`)}
+	}
+	return nil
+}
+
+func (_c *EnvironmentAttestationCreate) sqlSave(ctx context.Context) (*EnvironmentAttestation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnvironmentAttestationCreate) createSpec() (*EnvironmentAttestation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvironmentAttestation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(environmentattestation.Table, sqlgraph.NewFieldSpec(environmentattestation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(environmentattestation.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.Os(); ok {
+		_spec.SetField(environmentattestation.FieldOs, field.TypeString, value)
+		_node.Os = value
+	}
+	if value, ok := _c.mutation.Hostname(); ok {
+		_spec.SetField(environmentattestation.FieldHostname, field.TypeString, value)
+		_node.Hostname = value
+	}
+	if value, ok := _c.mutation.Username(); ok {
+		_spec.SetField(environmentattestation.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if nodes := _c.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   environmentattestation.AttestationTable,
+			Columns: []string{environmentattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.attestation_environment_attestation = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EnvironmentAttestationCreateBulk is the builder for creating many EnvironmentAttestation entities in bulk.
+type EnvironmentAttestationCreateBulk struct {
+	config
+	err      error
+	builders []*EnvironmentAttestationCreate
+}
+
+// Save creates the EnvironmentAttestation entities in the database.
+func (_c *EnvironmentAttestationCreateBulk) Save(ctx context.Context) ([]*EnvironmentAttestation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnvironmentAttestation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvironmentAttestationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnvironmentAttestationCreateBulk) SaveX(ctx context.Context) []*EnvironmentAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvironmentAttestationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvironmentAttestationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
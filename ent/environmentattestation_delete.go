@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// EnvironmentAttestationDelete is the builder for deleting a EnvironmentAttestation entity.
+type EnvironmentAttestationDelete struct {
+	config
+	hooks    []Hook
+	mutation *EnvironmentAttestationMutation
+}
+
+// Where appends a list predicates to the EnvironmentAttestationDelete builder.
+func (_d *EnvironmentAttestationDelete) Where(ps ...predicate.EnvironmentAttestation) *EnvironmentAttestationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EnvironmentAttestationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EnvironmentAttestationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EnvironmentAttestationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(environmentattestation.Table, sqlgraph.NewFieldSpec(environmentattestation.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EnvironmentAttestationDeleteOne is the builder for deleting a single EnvironmentAttestation entity.
+type EnvironmentAttestationDeleteOne struct {
+	_d *EnvironmentAttestationDelete
+}
+
+// Where appends a list predicates to the EnvironmentAttestationDelete builder.
+func (_d *EnvironmentAttestationDeleteOne) Where(ps ...predicate.EnvironmentAttestation) *EnvironmentAttestationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EnvironmentAttestationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{environmentattestation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EnvironmentAttestationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// EnvironmentAttestationUpdate is the builder for updating EnvironmentAttestation entities.
+type EnvironmentAttestationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnvironmentAttestationMutation
+}
+
+// Where appends a list predicates to the EnvironmentAttestationUpdate builder.
+func (_u *EnvironmentAttestationUpdate) Where(ps ...predicate.EnvironmentAttestation) *EnvironmentAttestationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOs sets the "os" field.
+func (_u *EnvironmentAttestationUpdate) SetOs(v string) *EnvironmentAttestationUpdate {
+	_u.mutation.SetOs(v)
+	return _u
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_u *EnvironmentAttestationUpdate) SetNillableOs(v *string) *EnvironmentAttestationUpdate {
+	if v != nil {
+		_u.SetOs(*v)
+	}
+	return _u
+}
+
+// ClearOs clears the value of the "os" field.
+func (_u *EnvironmentAttestationUpdate) ClearOs() *EnvironmentAttestationUpdate {
+	_u.mutation.ClearOs()
+	return _u
+}
+
+// SetHostname sets the "hostname" field.
+func (_u *EnvironmentAttestationUpdate) SetHostname(v string) *EnvironmentAttestationUpdate {
+	_u.mutation.SetHostname(v)
+	return _u
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_u *EnvironmentAttestationUpdate) SetNillableHostname(v *string) *EnvironmentAttestationUpdate {
+	if v != nil {
+		_u.SetHostname(*v)
+	}
+	return _u
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (_u *EnvironmentAttestationUpdate) ClearHostname() *EnvironmentAttestationUpdate {
+	_u.mutation.ClearHostname()
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *EnvironmentAttestationUpdate) SetUsername(v string) *EnvironmentAttestationUpdate {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *EnvironmentAttestationUpdate) SetNillableUsername(v *string) *EnvironmentAttestationUpdate {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// ClearUsername clears the value of the "username" field.
+func (_u *EnvironmentAttestationUpdate) ClearUsername() *EnvironmentAttestationUpdate {
+	_u.mutation.ClearUsername()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *EnvironmentAttestationUpdate) SetAttestationID(id uuid.UUID) *EnvironmentAttestationUpdate {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *EnvironmentAttestationUpdate) SetAttestation(v *Attestation) *EnvironmentAttestationUpdate {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the EnvironmentAttestationMutation object of the builder.
+func (_u *EnvironmentAttestationUpdate) Mutation() *EnvironmentAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *EnvironmentAttestationUpdate) ClearAttestation() *EnvironmentAttestationUpdate {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnvironmentAttestationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvironmentAttestationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnvironmentAttestationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvironmentAttestationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnvironmentAttestationUpdate) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvironmentAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *EnvironmentAttestationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(environmentattestation.Table, environmentattestation.Columns, sqlgraph.NewFieldSpec(environmentattestation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Os(); ok {
+		_spec.SetField(environmentattestation.FieldOs, field.TypeString, value)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(environmentattestation.FieldOs, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hostname(); ok {
+		_spec.SetField(environmentattestation.FieldHostname, field.TypeString, value)
+	}
+	if _u.mutation.HostnameCleared() {
+		_spec.ClearField(environmentattestation.FieldHostname, field.TypeString)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(environmentattestation.FieldUsername, field.TypeString, value)
+	}
+	if _u.mutation.UsernameCleared() {
+		_spec.ClearField(environmentattestation.FieldUsername, field.TypeString)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   environmentattestation.AttestationTable,
+			Columns: []string{environmentattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   environmentattestation.AttestationTable,
+			Columns: []string{environmentattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{environmentattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnvironmentAttestationUpdateOne is the builder for updating a single EnvironmentAttestation entity.
+type EnvironmentAttestationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnvironmentAttestationMutation
+}
+
+// SetOs sets the "os" field.
+func (_u *EnvironmentAttestationUpdateOne) SetOs(v string) *EnvironmentAttestationUpdateOne {
+	_u.mutation.SetOs(v)
+	return _u
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_u *EnvironmentAttestationUpdateOne) SetNillableOs(v *string) *EnvironmentAttestationUpdateOne {
+	if v != nil {
+		_u.SetOs(*v)
+	}
+	return _u
+}
+
+// ClearOs clears the value of the "os" field.
+func (_u *EnvironmentAttestationUpdateOne) ClearOs() *EnvironmentAttestationUpdateOne {
+	_u.mutation.ClearOs()
+	return _u
+}
+
+// SetHostname sets the "hostname" field.
+func (_u *EnvironmentAttestationUpdateOne) SetHostname(v string) *EnvironmentAttestationUpdateOne {
+	_u.mutation.SetHostname(v)
+	return _u
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_u *EnvironmentAttestationUpdateOne) SetNillableHostname(v *string) *EnvironmentAttestationUpdateOne {
+	if v != nil {
+		_u.SetHostname(*v)
+	}
+	return _u
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (_u *EnvironmentAttestationUpdateOne) ClearHostname() *EnvironmentAttestationUpdateOne {
+	_u.mutation.ClearHostname()
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *EnvironmentAttestationUpdateOne) SetUsername(v string) *EnvironmentAttestationUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *EnvironmentAttestationUpdateOne) SetNillableUsername(v *string) *EnvironmentAttestationUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// ClearUsername clears the value of the "username" field.
+func (_u *EnvironmentAttestationUpdateOne) ClearUsername() *EnvironmentAttestationUpdateOne {
+	_u.mutation.ClearUsername()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *EnvironmentAttestationUpdateOne) SetAttestationID(id uuid.UUID) *EnvironmentAttestationUpdateOne {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *EnvironmentAttestationUpdateOne) SetAttestation(v *Attestation) *EnvironmentAttestationUpdateOne {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the EnvironmentAttestationMutation object of the builder.
+func (_u *EnvironmentAttestationUpdateOne) Mutation() *EnvironmentAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *EnvironmentAttestationUpdateOne) ClearAttestation() *EnvironmentAttestationUpdateOne {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Where appends a list predicates to the EnvironmentAttestationUpdate builder.
+func (_u *EnvironmentAttestationUpdateOne) Where(ps ...predicate.EnvironmentAttestation) *EnvironmentAttestationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnvironmentAttestationUpdateOne) Select(field string, fields ...string) *EnvironmentAttestationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnvironmentAttestation entity.
+func (_u *EnvironmentAttestationUpdateOne) Save(ctx context.Context) (*EnvironmentAttestation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvironmentAttestationUpdateOne) SaveX(ctx context.Context) *EnvironmentAttestation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnvironmentAttestationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvironmentAttestationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnvironmentAttestationUpdateOne) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvironmentAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *EnvironmentAttestationUpdateOne) sqlSave(ctx context.Context) (_node *EnvironmentAttestation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(environmentattestation.Table, environmentattestation.Columns, sqlgraph.NewFieldSpec(environmentattestation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnvironmentAttestation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, environmentattestation.FieldID)
+		for _, f := range fields {
+			if !environmentattestation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != environmentattestation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Os(); ok {
+		_spec.SetField(environmentattestation.FieldOs, field.TypeString, value)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(environmentattestation.FieldOs, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hostname(); ok {
+		_spec.SetField(environmentattestation.FieldHostname, field.TypeString, value)
+	}
+	if _u.mutation.HostnameCleared() {
+		_spec.ClearField(environmentattestation.FieldHostname, field.TypeString)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(environmentattestation.FieldUsername, field.TypeString, value)
+	}
+	if _u.mutation.UsernameCleared() {
+		_spec.ClearField(environmentattestation.FieldUsername, field.TypeString)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   environmentattestation.AttestationTable,
+			Columns: []string{environmentattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   environmentattestation.AttestationTable,
+			Columns: []string{environmentattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EnvironmentAttestation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{environmentattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
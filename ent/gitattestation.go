@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+)
+
+// GitAttestation is the model entity for the GitAttestation schema.
+type GitAttestation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// CommitHash holds the value of the "commit_hash" field.
+	CommitHash string `json:"commit_hash,omitempty"`
+	// Branch holds the value of the "branch" field.
+	Branch string `json:"branch,omitempty"`
+	// TreeHash holds the value of the "tree_hash" field.
+	TreeHash string `json:"tree_hash,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// AuthorEmail holds the value of the "author_email" field.
+	AuthorEmail string `json:"author_email,omitempty"`
+	// CommitterName holds the value of the "committer_name" field.
+	CommitterName string `json:"committer_name,omitempty"`
+	// CommitterEmail holds the value of the "committer_email" field.
+	CommitterEmail string `json:"committer_email,omitempty"`
+	// CommitDate holds the value of the "commit_date" field.
+	CommitDate string `json:"commit_date,omitempty"`
+	// CommitMessage holds the value of the "commit_message" field.
+	CommitMessage string `json:"commit_message,omitempty"`
+	// ParentHashes holds the value of the "parent_hashes" field.
+	ParentHashes []string `json:"parent_hashes,omitempty"`
+	// Refs holds the value of the "refs" field.
+	Refs []string `json:"refs,omitempty"`
+	// Remotes holds the value of the "remotes" field.
+	Remotes []string `json:"remotes,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GitAttestationQuery when eager-loading is set.
+	Edges                       GitAttestationEdges `json:"edges"`
+	attestation_git_attestation *uuid.UUID
+	selectValues                sql.SelectValues
+}
+
+// GitAttestationEdges holds the relations/edges for other nodes in the graph.
+type GitAttestationEdges struct {
+	// Attestation holds the value of the attestation edge.
+	Attestation *Attestation `json:"attestation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// AttestationOrErr returns the Attestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GitAttestationEdges) AttestationOrErr() (*Attestation, error) {
+	if e.Attestation != nil {
+		return e.Attestation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "attestation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GitAttestation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gitattestation.FieldParentHashes, gitattestation.FieldRefs, gitattestation.FieldRemotes, gitattestation.FieldTags:
+			values[i] = new([]byte)
+		case gitattestation.FieldTenant, gitattestation.FieldCommitHash, gitattestation.FieldBranch, gitattestation.FieldTreeHash, gitattestation.FieldAuthor, gitattestation.FieldAuthorEmail, gitattestation.FieldCommitterName, gitattestation.FieldCommitterEmail, gitattestation.FieldCommitDate, gitattestation.FieldCommitMessage:
+			values[i] = new(sql.NullString)
+		case gitattestation.FieldID:
+			values[i] = new(uuid.UUID)
+		case gitattestation.ForeignKeys[0]: // attestation_git_attestation
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GitAttestation fields.
+func (_m *GitAttestation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gitattestation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case gitattestation.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case gitattestation.FieldCommitHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commit_hash", values[i])
+			} else if value.Valid {
+				_m.CommitHash = value.String
+			}
+		case gitattestation.FieldBranch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field branch", values[i])
+			} else if value.Valid {
+				_m.Branch = value.String
+			}
+		case gitattestation.FieldTreeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tree_hash", values[i])
+			} else if value.Valid {
+				_m.TreeHash = value.String
+			}
+		case gitattestation.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				_m.Author = value.String
+			}
+		case gitattestation.FieldAuthorEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_email", values[i])
+			} else if value.Valid {
+				_m.AuthorEmail = value.String
+			}
+		case gitattestation.FieldCommitterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field committer_name", values[i])
+			} else if value.Valid {
+				_m.CommitterName = value.String
+			}
+		case gitattestation.FieldCommitterEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field committer_email", values[i])
+			} else if value.Valid {
+				_m.CommitterEmail = value.String
+			}
+		case gitattestation.FieldCommitDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commit_date", values[i])
+			} else if value.Valid {
+				_m.CommitDate = value.String
+			}
+		case gitattestation.FieldCommitMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commit_message", values[i])
+			} else if value.Valid {
+				_m.CommitMessage = value.String
+			}
+		case gitattestation.FieldParentHashes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_hashes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ParentHashes); err != nil {
+					return fmt.Errorf("unmarshal field parent_hashes: %w", err)
+				}
+			}
+		case gitattestation.FieldRefs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field refs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Refs); err != nil {
+					return fmt.Errorf("unmarshal field refs: %w", err)
+				}
+			}
+		case gitattestation.FieldRemotes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field remotes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Remotes); err != nil {
+					return fmt.Errorf("unmarshal field remotes: %w", err)
+				}
+			}
+		case gitattestation.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case gitattestation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_git_attestation", values[i])
+			} else if value.Valid {
+				_m.attestation_git_attestation = new(uuid.UUID)
+				*_m.attestation_git_attestation = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GitAttestation.
+// This includes values selected through modifiers, order, etc.
+func (_m *GitAttestation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttestation queries the "attestation" edge of the GitAttestation entity.
+func (_m *GitAttestation) QueryAttestation() *AttestationQuery {
+	return NewGitAttestationClient(_m.config).QueryAttestation(_m)
+}
+
+// Update returns a builder for updating this GitAttestation.
+// Note that you need to call GitAttestation.Unwrap() before calling this method if this GitAttestation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GitAttestation) Update() *GitAttestationUpdateOne {
+	return NewGitAttestationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GitAttestation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GitAttestation) Unwrap() *GitAttestation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GitAttestation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GitAttestation) String() string {
+	var builder strings.Builder
+	builder.WriteString("GitAttestation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("commit_hash=")
+	builder.WriteString(_m.CommitHash)
+	builder.WriteString(", ")
+	builder.WriteString("branch=")
+	builder.WriteString(_m.Branch)
+	builder.WriteString(", ")
+	builder.WriteString("tree_hash=")
+	builder.WriteString(_m.TreeHash)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(_m.Author)
+	builder.WriteString(", ")
+	builder.WriteString("author_email=")
+	builder.WriteString(_m.AuthorEmail)
+	builder.WriteString(", ")
+	builder.WriteString("committer_name=")
+	builder.WriteString(_m.CommitterName)
+	builder.WriteString(", ")
+	builder.WriteString("committer_email=")
+	builder.WriteString(_m.CommitterEmail)
+	builder.WriteString(", ")
+	builder.WriteString("commit_date=")
+	builder.WriteString(_m.CommitDate)
+	builder.WriteString(", ")
+	builder.WriteString("commit_message=")
+	builder.WriteString(_m.CommitMessage)
+	builder.WriteString(", ")
+	builder.WriteString("parent_hashes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParentHashes))
+	builder.WriteString(", ")
+	builder.WriteString("refs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Refs))
+	builder.WriteString(", ")
+	builder.WriteString("remotes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Remotes))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GitAttestations is a parsable slice of GitAttestation.
+type GitAttestations []*GitAttestation
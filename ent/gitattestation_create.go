@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+)
+
+// GitAttestationCreate is the builder for creating a GitAttestation entity.
+type GitAttestationCreate struct {
+	config
+	mutation *GitAttestationMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *GitAttestationCreate) SetTenant(v string) *GitAttestationCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableTenant(v *string) *GitAttestationCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetCommitHash sets the "commit_hash" field.
+func (_c *GitAttestationCreate) SetCommitHash(v string) *GitAttestationCreate {
+	_c.mutation.SetCommitHash(v)
+	return _c
+}
+
+// SetBranch sets the "branch" field.
+func (_c *GitAttestationCreate) SetBranch(v string) *GitAttestationCreate {
+	_c.mutation.SetBranch(v)
+	return _c
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableBranch(v *string) *GitAttestationCreate {
+	if v != nil {
+		_c.SetBranch(*v)
+	}
+	return _c
+}
+
+// SetTreeHash sets the "tree_hash" field.
+func (_c *GitAttestationCreate) SetTreeHash(v string) *GitAttestationCreate {
+	_c.mutation.SetTreeHash(v)
+	return _c
+}
+
+// SetNillableTreeHash sets the "tree_hash" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableTreeHash(v *string) *GitAttestationCreate {
+	if v != nil {
+		_c.SetTreeHash(*v)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *GitAttestationCreate) SetAuthor(v string) *GitAttestationCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableAuthor(v *string) *GitAttestationCreate {
+	if v != nil {
+		_c.SetAuthor(*v)
+	}
+	return _c
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (_c *GitAttestationCreate) SetAuthorEmail(v string) *GitAttestationCreate {
+	_c.mutation.SetAuthorEmail(v)
+	return _c
+}
+
+// SetNillableAuthorEmail sets the "author_email" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableAuthorEmail(v *string) *GitAttestationCreate {
+	if v != nil {
+		_c.SetAuthorEmail(*v)
+	}
+	return _c
+}
+
+// SetCommitterName sets the "committer_name" field.
+func (_c *GitAttestationCreate) SetCommitterName(v string) *GitAttestationCreate {
+	_c.mutation.SetCommitterName(v)
+	return _c
+}
+
+// SetNillableCommitterName sets the "committer_name" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableCommitterName(v *string) *GitAttestationCreate {
+	if v != nil {
+		_c.SetCommitterName(*v)
+	}
+	return _c
+}
+
+// SetCommitterEmail sets the "committer_email" field.
+func (_c *GitAttestationCreate) SetCommitterEmail(v string) *GitAttestationCreate {
+	_c.mutation.SetCommitterEmail(v)
+	return _c
+}
+
+// SetNillableCommitterEmail sets the "committer_email" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableCommitterEmail(v *string) *GitAttestationCreate {
+	if v != nil {
+		_c.SetCommitterEmail(*v)
+	}
+	return _c
+}
+
+// SetCommitDate sets the "commit_date" field.
+func (_c *GitAttestationCreate) SetCommitDate(v string) *GitAttestationCreate {
+	_c.mutation.SetCommitDate(v)
+	return _c
+}
+
+// SetNillableCommitDate sets the "commit_date" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableCommitDate(v *string) *GitAttestationCreate {
+	if v != nil {
+		_c.SetCommitDate(*v)
+	}
+	return _c
+}
+
+// SetCommitMessage sets the "commit_message" field.
+func (_c *GitAttestationCreate) SetCommitMessage(v string) *GitAttestationCreate {
+	_c.mutation.SetCommitMessage(v)
+	return _c
+}
+
+// SetNillableCommitMessage sets the "commit_message" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableCommitMessage(v *string) *GitAttestationCreate {
+	if v != nil {
+		_c.SetCommitMessage(*v)
+	}
+	return _c
+}
+
+// SetParentHashes sets the "parent_hashes" field.
+func (_c *GitAttestationCreate) SetParentHashes(v []string) *GitAttestationCreate {
+	_c.mutation.SetParentHashes(v)
+	return _c
+}
+
+// SetRefs sets the "refs" field.
+func (_c *GitAttestationCreate) SetRefs(v []string) *GitAttestationCreate {
+	_c.mutation.SetRefs(v)
+	return _c
+}
+
+// SetRemotes sets the "remotes" field.
+func (_c *GitAttestationCreate) SetRemotes(v []string) *GitAttestationCreate {
+	_c.mutation.SetRemotes(v)
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *GitAttestationCreate) SetTags(v []string) *GitAttestationCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GitAttestationCreate) SetID(v uuid.UUID) *GitAttestationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GitAttestationCreate) SetNillableID(v *uuid.UUID) *GitAttestationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_c *GitAttestationCreate) SetAttestationID(id uuid.UUID) *GitAttestationCreate {
+	_c.mutation.SetAttestationID(id)
+	return _c
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_c *GitAttestationCreate) SetAttestation(v *Attestation) *GitAttestationCreate {
+	return _c.SetAttestationID(v.ID)
+}
+
+// Mutation returns the GitAttestationMutation object of the builder.
+func (_c *GitAttestationCreate) Mutation() *GitAttestationMutation {
+	return _c.mutation
+}
+
+// Save creates the GitAttestation in the database.
+func (_c *GitAttestationCreate) Save(ctx context.Context) (*GitAttestation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GitAttestationCreate) SaveX(ctx context.Context) *GitAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GitAttestationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GitAttestationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GitAttestationCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := gitattestation.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if gitattestation.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized gitattestation.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := gitattestation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GitAttestationCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "GitAttestation.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := gitattestation.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "GitAttestation.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CommitHash(); !ok {
+		return &ValidationError{Name: "commit_hash", err: errors.New(`ent: missing required field "GitAttestation.commit_hash"`)}
+	}
+	if len(_c.mutation.AttestationIDs()) == 0 {
+		return &ValidationError{Name: "attestation", err: errors.New(`ent: missing required edge "GitAttestation.attestation"`)}
+	}
+	return nil
+}
+
+func (_c *GitAttestationCreate) sqlSave(ctx context.Context) (*GitAttestation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GitAttestationCreate) createSpec() (*GitAttestation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GitAttestation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gitattestation.Table, sqlgraph.NewFieldSpec(gitattestation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(gitattestation.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.CommitHash(); ok {
+		_spec.SetField(gitattestation.FieldCommitHash, field.TypeString, value)
+		_node.CommitHash = value
+	}
+	if value, ok := _c.mutation.Branch(); ok {
+		_spec.SetField(gitattestation.FieldBranch, field.TypeString, value)
+		_node.Branch = value
+	}
+	if value, ok := _c.mutation.TreeHash(); ok {
+		_spec.SetField(gitattestation.FieldTreeHash, field.TypeString, value)
+		_node.TreeHash = value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(gitattestation.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := _c.mutation.AuthorEmail(); ok {
+		_spec.SetField(gitattestation.FieldAuthorEmail, field.TypeString, value)
+		_node.AuthorEmail = value
+	}
+	if value, ok := _c.mutation.CommitterName(); ok {
+		_spec.SetField(gitattestation.FieldCommitterName, field.TypeString, value)
+		_node.CommitterName = value
+	}
+	if value, ok := _c.mutation.CommitterEmail(); ok {
+		_spec.SetField(gitattestation.FieldCommitterEmail, field.TypeString, value)
+		_node.CommitterEmail = value
+	}
+	if value, ok := _c.mutation.CommitDate(); ok {
+		_spec.SetField(gitattestation.FieldCommitDate, field.TypeString, value)
+		_node.CommitDate = value
+	}
+	if value, ok := _c.mutation.CommitMessage(); ok {
+		_spec.SetField(gitattestation.FieldCommitMessage, field.TypeString, value)
+		_node.CommitMessage = value
+	}
+	if value, ok := _c.mutation.ParentHashes(); ok {
+		_spec.SetField(gitattestation.FieldParentHashes, field.TypeJSON, value)
+		_node.ParentHashes = value
+	}
+	if value, ok := _c.mutation.Refs(); ok {
+		_spec.SetField(gitattestation.FieldRefs, field.TypeJSON, value)
+		_node.Refs = value
+	}
+	if value, ok := _c.mutation.Remotes(); ok {
+		_spec.SetField(gitattestation.FieldRemotes, field.TypeJSON, value)
+		_node.Remotes = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(gitattestation.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if nodes := _c.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitattestation.AttestationTable,
+			Columns: []string{gitattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.attestation_git_attestation = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GitAttestationCreateBulk is the builder for creating many GitAttestation entities in bulk.
+type GitAttestationCreateBulk struct {
+	config
+	err      error
+	builders []*GitAttestationCreate
+}
+
+// Save creates the GitAttestation entities in the database.
+func (_c *GitAttestationCreateBulk) Save(ctx context.Context) ([]*GitAttestation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GitAttestation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GitAttestationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GitAttestationCreateBulk) SaveX(ctx context.Context) []*GitAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GitAttestationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GitAttestationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,974 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// GitAttestationUpdate is the builder for updating GitAttestation entities.
+type GitAttestationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GitAttestationMutation
+}
+
+// Where appends a list predicates to the GitAttestationUpdate builder.
+func (_u *GitAttestationUpdate) Where(ps ...predicate.GitAttestation) *GitAttestationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCommitHash sets the "commit_hash" field.
+func (_u *GitAttestationUpdate) SetCommitHash(v string) *GitAttestationUpdate {
+	_u.mutation.SetCommitHash(v)
+	return _u
+}
+
+// SetNillableCommitHash sets the "commit_hash" field if the given value is not nil.
+func (_u *GitAttestationUpdate) SetNillableCommitHash(v *string) *GitAttestationUpdate {
+	if v != nil {
+		_u.SetCommitHash(*v)
+	}
+	return _u
+}
+
+// SetBranch sets the "branch" field.
+func (_u *GitAttestationUpdate) SetBranch(v string) *GitAttestationUpdate {
+	_u.mutation.SetBranch(v)
+	return _u
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (_u *GitAttestationUpdate) SetNillableBranch(v *string) *GitAttestationUpdate {
+	if v != nil {
+		_u.SetBranch(*v)
+	}
+	return _u
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (_u *GitAttestationUpdate) ClearBranch() *GitAttestationUpdate {
+	_u.mutation.ClearBranch()
+	return _u
+}
+
+// SetTreeHash sets the "tree_hash" field.
+func (_u *GitAttestationUpdate) SetTreeHash(v string) *GitAttestationUpdate {
+	_u.mutation.SetTreeHash(v)
+	return _u
+}
+
+// SetNillableTreeHash sets the "tree_hash" field if the given value is not nil.
+func (_u *GitAttestationUpdate) SetNillableTreeHash(v *string) *GitAttestationUpdate {
+	if v != nil {
+		_u.SetTreeHash(*v)
+	}
+	return _u
+}
+
+// ClearTreeHash clears the value of the "tree_hash" field.
+func (_u *GitAttestationUpdate) ClearTreeHash() *GitAttestationUpdate {
+	_u.mutation.ClearTreeHash()
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *GitAttestationUpdate) SetAuthor(v string) *GitAttestationUpdate {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *GitAttestationUpdate) SetNillableAuthor(v *string) *GitAttestationUpdate {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (_u *GitAttestationUpdate) ClearAuthor() *GitAttestationUpdate {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (_u *GitAttestationUpdate) SetAuthorEmail(v string) *GitAttestationUpdate {
+	_u.mutation.SetAuthorEmail(v)
+	return _u
+}
+
+// SetNillableAuthorEmail sets the "author_email" field if the given value is not nil.
+func (_u *GitAttestationUpdate) SetNillableAuthorEmail(v *string) *GitAttestationUpdate {
+	if v != nil {
+		_u.SetAuthorEmail(*v)
+	}
+	return _u
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (_u *GitAttestationUpdate) ClearAuthorEmail() *GitAttestationUpdate {
+	_u.mutation.ClearAuthorEmail()
+	return _u
+}
+
+// SetCommitterName sets the "committer_name" field.
+func (_u *GitAttestationUpdate) SetCommitterName(v string) *GitAttestationUpdate {
+	_u.mutation.SetCommitterName(v)
+	return _u
+}
+
+// SetNillableCommitterName sets the "committer_name" field if the given value is not nil.
+func (_u *GitAttestationUpdate) SetNillableCommitterName(v *string) *GitAttestationUpdate {
+	if v != nil {
+		_u.SetCommitterName(*v)
+	}
+	return _u
+}
+
+// ClearCommitterName clears the value of the "committer_name" field.
+func (_u *GitAttestationUpdate) ClearCommitterName() *GitAttestationUpdate {
+	_u.mutation.ClearCommitterName()
+	return _u
+}
+
+// SetCommitterEmail sets the "committer_email" field.
+func (_u *GitAttestationUpdate) SetCommitterEmail(v string) *GitAttestationUpdate {
+	_u.mutation.SetCommitterEmail(v)
+	return _u
+}
+
+// SetNillableCommitterEmail sets the "committer_email" field if the given value is not nil.
+func (_u *GitAttestationUpdate) SetNillableCommitterEmail(v *string) *GitAttestationUpdate {
+	if v != nil {
+		_u.SetCommitterEmail(*v)
+	}
+	return _u
+}
+
+// ClearCommitterEmail clears the value of the "committer_email" field.
+func (_u *GitAttestationUpdate) ClearCommitterEmail() *GitAttestationUpdate {
+	_u.mutation.ClearCommitterEmail()
+	return _u
+}
+
+// SetCommitDate sets the "commit_date" field.
+func (_u *GitAttestationUpdate) SetCommitDate(v string) *GitAttestationUpdate {
+	_u.mutation.SetCommitDate(v)
+	return _u
+}
+
+// SetNillableCommitDate sets the "commit_date" field if the given value is not nil.
+func (_u *GitAttestationUpdate) SetNillableCommitDate(v *string) *GitAttestationUpdate {
+	if v != nil {
+		_u.SetCommitDate(*v)
+	}
+	return _u
+}
+
+// ClearCommitDate clears the value of the "commit_date" field.
+func (_u *GitAttestationUpdate) ClearCommitDate() *GitAttestationUpdate {
+	_u.mutation.ClearCommitDate()
+	return _u
+}
+
+// SetCommitMessage sets the "commit_message" field.
+func (_u *GitAttestationUpdate) SetCommitMessage(v string) *GitAttestationUpdate {
+	_u.mutation.SetCommitMessage(v)
+	return _u
+}
+
+// SetNillableCommitMessage sets the "commit_message" field if the given value is not nil.
+func (_u *GitAttestationUpdate) SetNillableCommitMessage(v *string) *GitAttestationUpdate {
+	if v != nil {
+		_u.SetCommitMessage(*v)
+	}
+	return _u
+}
+
+// ClearCommitMessage clears the value of the "commit_message" field.
+func (_u *GitAttestationUpdate) ClearCommitMessage() *GitAttestationUpdate {
+	_u.mutation.ClearCommitMessage()
+	return _u
+}
+
+// SetParentHashes sets the "parent_hashes" field.
+func (_u *GitAttestationUpdate) SetParentHashes(v []string) *GitAttestationUpdate {
+	_u.mutation.SetParentHashes(v)
+	return _u
+}
+
+// AppendParentHashes appends value to the "parent_hashes" field.
+func (_u *GitAttestationUpdate) AppendParentHashes(v []string) *GitAttestationUpdate {
+	_u.mutation.AppendParentHashes(v)
+	return _u
+}
+
+// ClearParentHashes clears the value of the "parent_hashes" field.
+func (_u *GitAttestationUpdate) ClearParentHashes() *GitAttestationUpdate {
+	_u.mutation.ClearParentHashes()
+	return _u
+}
+
+// SetRefs sets the "refs" field.
+func (_u *GitAttestationUpdate) SetRefs(v []string) *GitAttestationUpdate {
+	_u.mutation.SetRefs(v)
+	return _u
+}
+
+// AppendRefs appends value to the "refs" field.
+func (_u *GitAttestationUpdate) AppendRefs(v []string) *GitAttestationUpdate {
+	_u.mutation.AppendRefs(v)
+	return _u
+}
+
+// ClearRefs clears the value of the "refs" field.
+func (_u *GitAttestationUpdate) ClearRefs() *GitAttestationUpdate {
+	_u.mutation.ClearRefs()
+	return _u
+}
+
+// SetRemotes sets the "remotes" field.
+func (_u *GitAttestationUpdate) SetRemotes(v []string) *GitAttestationUpdate {
+	_u.mutation.SetRemotes(v)
+	return _u
+}
+
+// AppendRemotes appends value to the "remotes" field.
+func (_u *GitAttestationUpdate) AppendRemotes(v []string) *GitAttestationUpdate {
+	_u.mutation.AppendRemotes(v)
+	return _u
+}
+
+// ClearRemotes clears the value of the "remotes" field.
+func (_u *GitAttestationUpdate) ClearRemotes() *GitAttestationUpdate {
+	_u.mutation.ClearRemotes()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *GitAttestationUpdate) SetTags(v []string) *GitAttestationUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *GitAttestationUpdate) AppendTags(v []string) *GitAttestationUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *GitAttestationUpdate) ClearTags() *GitAttestationUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *GitAttestationUpdate) SetAttestationID(id uuid.UUID) *GitAttestationUpdate {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *GitAttestationUpdate) SetAttestation(v *Attestation) *GitAttestationUpdate {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the GitAttestationMutation object of the builder.
+func (_u *GitAttestationUpdate) Mutation() *GitAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *GitAttestationUpdate) ClearAttestation() *GitAttestationUpdate {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GitAttestationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GitAttestationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GitAttestationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GitAttestationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GitAttestationUpdate) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GitAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *GitAttestationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gitattestation.Table, gitattestation.Columns, sqlgraph.NewFieldSpec(gitattestation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CommitHash(); ok {
+		_spec.SetField(gitattestation.FieldCommitHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Branch(); ok {
+		_spec.SetField(gitattestation.FieldBranch, field.TypeString, value)
+	}
+	if _u.mutation.BranchCleared() {
+		_spec.ClearField(gitattestation.FieldBranch, field.TypeString)
+	}
+	if value, ok := _u.mutation.TreeHash(); ok {
+		_spec.SetField(gitattestation.FieldTreeHash, field.TypeString, value)
+	}
+	if _u.mutation.TreeHashCleared() {
+		_spec.ClearField(gitattestation.FieldTreeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(gitattestation.FieldAuthor, field.TypeString, value)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(gitattestation.FieldAuthor, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorEmail(); ok {
+		_spec.SetField(gitattestation.FieldAuthorEmail, field.TypeString, value)
+	}
+	if _u.mutation.AuthorEmailCleared() {
+		_spec.ClearField(gitattestation.FieldAuthorEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitterName(); ok {
+		_spec.SetField(gitattestation.FieldCommitterName, field.TypeString, value)
+	}
+	if _u.mutation.CommitterNameCleared() {
+		_spec.ClearField(gitattestation.FieldCommitterName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitterEmail(); ok {
+		_spec.SetField(gitattestation.FieldCommitterEmail, field.TypeString, value)
+	}
+	if _u.mutation.CommitterEmailCleared() {
+		_spec.ClearField(gitattestation.FieldCommitterEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitDate(); ok {
+		_spec.SetField(gitattestation.FieldCommitDate, field.TypeString, value)
+	}
+	if _u.mutation.CommitDateCleared() {
+		_spec.ClearField(gitattestation.FieldCommitDate, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitMessage(); ok {
+		_spec.SetField(gitattestation.FieldCommitMessage, field.TypeString, value)
+	}
+	if _u.mutation.CommitMessageCleared() {
+		_spec.ClearField(gitattestation.FieldCommitMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ParentHashes(); ok {
+		_spec.SetField(gitattestation.FieldParentHashes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParentHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gitattestation.FieldParentHashes, value)
+		})
+	}
+	if _u.mutation.ParentHashesCleared() {
+		_spec.ClearField(gitattestation.FieldParentHashes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Refs(); ok {
+		_spec.SetField(gitattestation.FieldRefs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRefs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gitattestation.FieldRefs, value)
+		})
+	}
+	if _u.mutation.RefsCleared() {
+		_spec.ClearField(gitattestation.FieldRefs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Remotes(); ok {
+		_spec.SetField(gitattestation.FieldRemotes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRemotes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gitattestation.FieldRemotes, value)
+		})
+	}
+	if _u.mutation.RemotesCleared() {
+		_spec.ClearField(gitattestation.FieldRemotes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(gitattestation.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gitattestation.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(gitattestation.FieldTags, field.TypeJSON)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitattestation.AttestationTable,
+			Columns: []string{gitattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitattestation.AttestationTable,
+			Columns: []string{gitattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gitattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GitAttestationUpdateOne is the builder for updating a single GitAttestation entity.
+type GitAttestationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GitAttestationMutation
+}
+
+// SetCommitHash sets the "commit_hash" field.
+func (_u *GitAttestationUpdateOne) SetCommitHash(v string) *GitAttestationUpdateOne {
+	_u.mutation.SetCommitHash(v)
+	return _u
+}
+
+// SetNillableCommitHash sets the "commit_hash" field if the given value is not nil.
+func (_u *GitAttestationUpdateOne) SetNillableCommitHash(v *string) *GitAttestationUpdateOne {
+	if v != nil {
+		_u.SetCommitHash(*v)
+	}
+	return _u
+}
+
+// SetBranch sets the "branch" field.
+func (_u *GitAttestationUpdateOne) SetBranch(v string) *GitAttestationUpdateOne {
+	_u.mutation.SetBranch(v)
+	return _u
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (_u *GitAttestationUpdateOne) SetNillableBranch(v *string) *GitAttestationUpdateOne {
+	if v != nil {
+		_u.SetBranch(*v)
+	}
+	return _u
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (_u *GitAttestationUpdateOne) ClearBranch() *GitAttestationUpdateOne {
+	_u.mutation.ClearBranch()
+	return _u
+}
+
+// SetTreeHash sets the "tree_hash" field.
+func (_u *GitAttestationUpdateOne) SetTreeHash(v string) *GitAttestationUpdateOne {
+	_u.mutation.SetTreeHash(v)
+	return _u
+}
+
+// SetNillableTreeHash sets the "tree_hash" field if the given value is not nil.
+func (_u *GitAttestationUpdateOne) SetNillableTreeHash(v *string) *GitAttestationUpdateOne {
+	if v != nil {
+		_u.SetTreeHash(*v)
+	}
+	return _u
+}
+
+// ClearTreeHash clears the value of the "tree_hash" field.
+func (_u *GitAttestationUpdateOne) ClearTreeHash() *GitAttestationUpdateOne {
+	_u.mutation.ClearTreeHash()
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *GitAttestationUpdateOne) SetAuthor(v string) *GitAttestationUpdateOne {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *GitAttestationUpdateOne) SetNillableAuthor(v *string) *GitAttestationUpdateOne {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (_u *GitAttestationUpdateOne) ClearAuthor() *GitAttestationUpdateOne {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (_u *GitAttestationUpdateOne) SetAuthorEmail(v string) *GitAttestationUpdateOne {
+	_u.mutation.SetAuthorEmail(v)
+	return _u
+}
+
+// SetNillableAuthorEmail sets the "author_email" field if the given value is not nil.
+func (_u *GitAttestationUpdateOne) SetNillableAuthorEmail(v *string) *GitAttestationUpdateOne {
+	if v != nil {
+		_u.SetAuthorEmail(*v)
+	}
+	return _u
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (_u *GitAttestationUpdateOne) ClearAuthorEmail() *GitAttestationUpdateOne {
+	_u.mutation.ClearAuthorEmail()
+	return _u
+}
+
+// SetCommitterName sets the "committer_name" field.
+func (_u *GitAttestationUpdateOne) SetCommitterName(v string) *GitAttestationUpdateOne {
+	_u.mutation.SetCommitterName(v)
+	return _u
+}
+
+// SetNillableCommitterName sets the "committer_name" field if the given value is not nil.
+func (_u *GitAttestationUpdateOne) SetNillableCommitterName(v *string) *GitAttestationUpdateOne {
+	if v != nil {
+		_u.SetCommitterName(*v)
+	}
+	return _u
+}
+
+// ClearCommitterName clears the value of the "committer_name" field.
+func (_u *GitAttestationUpdateOne) ClearCommitterName() *GitAttestationUpdateOne {
+	_u.mutation.ClearCommitterName()
+	return _u
+}
+
+// SetCommitterEmail sets the "committer_email" field.
+func (_u *GitAttestationUpdateOne) SetCommitterEmail(v string) *GitAttestationUpdateOne {
+	_u.mutation.SetCommitterEmail(v)
+	return _u
+}
+
+// SetNillableCommitterEmail sets the "committer_email" field if the given value is not nil.
+func (_u *GitAttestationUpdateOne) SetNillableCommitterEmail(v *string) *GitAttestationUpdateOne {
+	if v != nil {
+		_u.SetCommitterEmail(*v)
+	}
+	return _u
+}
+
+// ClearCommitterEmail clears the value of the "committer_email" field.
+func (_u *GitAttestationUpdateOne) ClearCommitterEmail() *GitAttestationUpdateOne {
+	_u.mutation.ClearCommitterEmail()
+	return _u
+}
+
+// SetCommitDate sets the "commit_date" field.
+func (_u *GitAttestationUpdateOne) SetCommitDate(v string) *GitAttestationUpdateOne {
+	_u.mutation.SetCommitDate(v)
+	return _u
+}
+
+// SetNillableCommitDate sets the "commit_date" field if the given value is not nil.
+func (_u *GitAttestationUpdateOne) SetNillableCommitDate(v *string) *GitAttestationUpdateOne {
+	if v != nil {
+		_u.SetCommitDate(*v)
+	}
+	return _u
+}
+
+// ClearCommitDate clears the value of the "commit_date" field.
+func (_u *GitAttestationUpdateOne) ClearCommitDate() *GitAttestationUpdateOne {
+	_u.mutation.ClearCommitDate()
+	return _u
+}
+
+// SetCommitMessage sets the "commit_message" field.
+func (_u *GitAttestationUpdateOne) SetCommitMessage(v string) *GitAttestationUpdateOne {
+	_u.mutation.SetCommitMessage(v)
+	return _u
+}
+
+// SetNillableCommitMessage sets the "commit_message" field if the given value is not nil.
+func (_u *GitAttestationUpdateOne) SetNillableCommitMessage(v *string) *GitAttestationUpdateOne {
+	if v != nil {
+		_u.SetCommitMessage(*v)
+	}
+	return _u
+}
+
+// ClearCommitMessage clears the value of the "commit_message" field.
+func (_u *GitAttestationUpdateOne) ClearCommitMessage() *GitAttestationUpdateOne {
+	_u.mutation.ClearCommitMessage()
+	return _u
+}
+
+// SetParentHashes sets the "parent_hashes" field.
+func (_u *GitAttestationUpdateOne) SetParentHashes(v []string) *GitAttestationUpdateOne {
+	_u.mutation.SetParentHashes(v)
+	return _u
+}
+
+// AppendParentHashes appends value to the "parent_hashes" field.
+func (_u *GitAttestationUpdateOne) AppendParentHashes(v []string) *GitAttestationUpdateOne {
+	_u.mutation.AppendParentHashes(v)
+	return _u
+}
+
+// ClearParentHashes clears the value of the "parent_hashes" field.
+func (_u *GitAttestationUpdateOne) ClearParentHashes() *GitAttestationUpdateOne {
+	_u.mutation.ClearParentHashes()
+	return _u
+}
+
+// SetRefs sets the "refs" field.
+func (_u *GitAttestationUpdateOne) SetRefs(v []string) *GitAttestationUpdateOne {
+	_u.mutation.SetRefs(v)
+	return _u
+}
+
+// AppendRefs appends value to the "refs" field.
+func (_u *GitAttestationUpdateOne) AppendRefs(v []string) *GitAttestationUpdateOne {
+	_u.mutation.AppendRefs(v)
+	return _u
+}
+
+// ClearRefs clears the value of the "refs" field.
+func (_u *GitAttestationUpdateOne) ClearRefs() *GitAttestationUpdateOne {
+	_u.mutation.ClearRefs()
+	return _u
+}
+
+// SetRemotes sets the "remotes" field.
+func (_u *GitAttestationUpdateOne) SetRemotes(v []string) *GitAttestationUpdateOne {
+	_u.mutation.SetRemotes(v)
+	return _u
+}
+
+// AppendRemotes appends value to the "remotes" field.
+func (_u *GitAttestationUpdateOne) AppendRemotes(v []string) *GitAttestationUpdateOne {
+	_u.mutation.AppendRemotes(v)
+	return _u
+}
+
+// ClearRemotes clears the value of the "remotes" field.
+func (_u *GitAttestationUpdateOne) ClearRemotes() *GitAttestationUpdateOne {
+	_u.mutation.ClearRemotes()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *GitAttestationUpdateOne) SetTags(v []string) *GitAttestationUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *GitAttestationUpdateOne) AppendTags(v []string) *GitAttestationUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *GitAttestationUpdateOne) ClearTags() *GitAttestationUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *GitAttestationUpdateOne) SetAttestationID(id uuid.UUID) *GitAttestationUpdateOne {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *GitAttestationUpdateOne) SetAttestation(v *Attestation) *GitAttestationUpdateOne {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the GitAttestationMutation object of the builder.
+func (_u *GitAttestationUpdateOne) Mutation() *GitAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *GitAttestationUpdateOne) ClearAttestation() *GitAttestationUpdateOne {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Where appends a list predicates to the GitAttestationUpdate builder.
+func (_u *GitAttestationUpdateOne) Where(ps ...predicate.GitAttestation) *GitAttestationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GitAttestationUpdateOne) Select(field string, fields ...string) *GitAttestationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GitAttestation entity.
+func (_u *GitAttestationUpdateOne) Save(ctx context.Context) (*GitAttestation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GitAttestationUpdateOne) SaveX(ctx context.Context) *GitAttestation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GitAttestationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GitAttestationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GitAttestationUpdateOne) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GitAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *GitAttestationUpdateOne) sqlSave(ctx context.Context) (_node *GitAttestation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gitattestation.Table, gitattestation.Columns, sqlgraph.NewFieldSpec(gitattestation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GitAttestation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gitattestation.FieldID)
+		for _, f := range fields {
+			if !gitattestation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gitattestation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CommitHash(); ok {
+		_spec.SetField(gitattestation.FieldCommitHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Branch(); ok {
+		_spec.SetField(gitattestation.FieldBranch, field.TypeString, value)
+	}
+	if _u.mutation.BranchCleared() {
+		_spec.ClearField(gitattestation.FieldBranch, field.TypeString)
+	}
+	if value, ok := _u.mutation.TreeHash(); ok {
+		_spec.SetField(gitattestation.FieldTreeHash, field.TypeString, value)
+	}
+	if _u.mutation.TreeHashCleared() {
+		_spec.ClearField(gitattestation.FieldTreeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(gitattestation.FieldAuthor, field.TypeString, value)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(gitattestation.FieldAuthor, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorEmail(); ok {
+		_spec.SetField(gitattestation.FieldAuthorEmail, field.TypeString, value)
+	}
+	if _u.mutation.AuthorEmailCleared() {
+		_spec.ClearField(gitattestation.FieldAuthorEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitterName(); ok {
+		_spec.SetField(gitattestation.FieldCommitterName, field.TypeString, value)
+	}
+	if _u.mutation.CommitterNameCleared() {
+		_spec.ClearField(gitattestation.FieldCommitterName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitterEmail(); ok {
+		_spec.SetField(gitattestation.FieldCommitterEmail, field.TypeString, value)
+	}
+	if _u.mutation.CommitterEmailCleared() {
+		_spec.ClearField(gitattestation.FieldCommitterEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitDate(); ok {
+		_spec.SetField(gitattestation.FieldCommitDate, field.TypeString, value)
+	}
+	if _u.mutation.CommitDateCleared() {
+		_spec.ClearField(gitattestation.FieldCommitDate, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitMessage(); ok {
+		_spec.SetField(gitattestation.FieldCommitMessage, field.TypeString, value)
+	}
+	if _u.mutation.CommitMessageCleared() {
+		_spec.ClearField(gitattestation.FieldCommitMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ParentHashes(); ok {
+		_spec.SetField(gitattestation.FieldParentHashes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParentHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gitattestation.FieldParentHashes, value)
+		})
+	}
+	if _u.mutation.ParentHashesCleared() {
+		_spec.ClearField(gitattestation.FieldParentHashes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Refs(); ok {
+		_spec.SetField(gitattestation.FieldRefs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRefs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gitattestation.FieldRefs, value)
+		})
+	}
+	if _u.mutation.RefsCleared() {
+		_spec.ClearField(gitattestation.FieldRefs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Remotes(); ok {
+		_spec.SetField(gitattestation.FieldRemotes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRemotes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gitattestation.FieldRemotes, value)
+		})
+	}
+	if _u.mutation.RemotesCleared() {
+		_spec.ClearField(gitattestation.FieldRemotes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(gitattestation.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gitattestation.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(gitattestation.FieldTags, field.TypeJSON)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitattestation.AttestationTable,
+			Columns: []string{gitattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitattestation.AttestationTable,
+			Columns: []string{gitattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GitAttestation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gitattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
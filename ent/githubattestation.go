@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+)
+
+// GithubAttestation is the model entity for the GithubAttestation schema.
+type GithubAttestation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// CiConfigPath holds the value of the "ci_config_path" field.
+	CiConfigPath string `json:"ci_config_path,omitempty"`
+	// PipelineID holds the value of the "pipeline_id" field.
+	PipelineID string `json:"pipeline_id,omitempty"`
+	// PipelineName holds the value of the "pipeline_name" field.
+	PipelineName string `json:"pipeline_name,omitempty"`
+	// PipelineURL holds the value of the "pipeline_url" field.
+	PipelineURL string `json:"pipeline_url,omitempty"`
+	// ProjectURL holds the value of the "project_url" field.
+	ProjectURL string `json:"project_url,omitempty"`
+	// RunnerID holds the value of the "runner_id" field.
+	RunnerID string `json:"runner_id,omitempty"`
+	// CiHost holds the value of the "ci_host" field.
+	CiHost string `json:"ci_host,omitempty"`
+	// CiServerURL holds the value of the "ci_server_url" field.
+	CiServerURL string `json:"ci_server_url,omitempty"`
+	// RunnerArch holds the value of the "runner_arch" field.
+	RunnerArch string `json:"runner_arch,omitempty"`
+	// RunnerOs holds the value of the "runner_os" field.
+	RunnerOs string `json:"runner_os,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GithubAttestationQuery when eager-loading is set.
+	Edges                          GithubAttestationEdges `json:"edges"`
+	attestation_github_attestation *uuid.UUID
+	selectValues                   sql.SelectValues
+}
+
+// GithubAttestationEdges holds the relations/edges for other nodes in the graph.
+type GithubAttestationEdges struct {
+	// Attestation holds the value of the attestation edge.
+	Attestation *Attestation `json:"attestation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// AttestationOrErr returns the Attestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GithubAttestationEdges) AttestationOrErr() (*Attestation, error) {
+	if e.Attestation != nil {
+		return e.Attestation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "attestation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GithubAttestation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case githubattestation.FieldTenant, githubattestation.FieldCiConfigPath, githubattestation.FieldPipelineID, githubattestation.FieldPipelineName, githubattestation.FieldPipelineURL, githubattestation.FieldProjectURL, githubattestation.FieldRunnerID, githubattestation.FieldCiHost, githubattestation.FieldCiServerURL, githubattestation.FieldRunnerArch, githubattestation.FieldRunnerOs:
+			values[i] = new(sql.NullString)
+		case githubattestation.FieldID:
+			values[i] = new(uuid.UUID)
+		case githubattestation.ForeignKeys[0]: // attestation_github_attestation
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GithubAttestation fields.
+func (_m *GithubAttestation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case githubattestation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case githubattestation.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case githubattestation.FieldCiConfigPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ci_config_path", values[i])
+			} else if value.Valid {
+				_m.CiConfigPath = value.String
+			}
+		case githubattestation.FieldPipelineID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline_id", values[i])
+			} else if value.Valid {
+				_m.PipelineID = value.String
+			}
+		case githubattestation.FieldPipelineName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline_name", values[i])
+			} else if value.Valid {
+				_m.PipelineName = value.String
+			}
+		case githubattestation.FieldPipelineURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline_url", values[i])
+			} else if value.Valid {
+				_m.PipelineURL = value.String
+			}
+		case githubattestation.FieldProjectURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_url", values[i])
+			} else if value.Valid {
+				_m.ProjectURL = value.String
+			}
+		case githubattestation.FieldRunnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field runner_id", values[i])
+			} else if value.Valid {
+				_m.RunnerID = value.String
+			}
+		case githubattestation.FieldCiHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ci_host", values[i])
+			} else if value.Valid {
+				_m.CiHost = value.String
+			}
+		case githubattestation.FieldCiServerURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ci_server_url", values[i])
+			} else if value.Valid {
+				_m.CiServerURL = value.String
+			}
+		case githubattestation.FieldRunnerArch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field runner_arch", values[i])
+			} else if value.Valid {
+				_m.RunnerArch = value.String
+			}
+		case githubattestation.FieldRunnerOs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field runner_os", values[i])
+			} else if value.Valid {
+				_m.RunnerOs = value.String
+			}
+		case githubattestation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_github_attestation", values[i])
+			} else if value.Valid {
+				_m.attestation_github_attestation = new(uuid.UUID)
+				*_m.attestation_github_attestation = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GithubAttestation.
+// This includes values selected through modifiers, order, etc.
+func (_m *GithubAttestation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttestation queries the "attestation" edge of the GithubAttestation entity.
+func (_m *GithubAttestation) QueryAttestation() *AttestationQuery {
+	return NewGithubAttestationClient(_m.config).QueryAttestation(_m)
+}
+
+// Update returns a builder for updating this GithubAttestation.
+// Note that you need to call GithubAttestation.Unwrap() before calling this method if this GithubAttestation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GithubAttestation) Update() *GithubAttestationUpdateOne {
+	return NewGithubAttestationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GithubAttestation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GithubAttestation) Unwrap() *GithubAttestation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GithubAttestation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GithubAttestation) String() string {
+	var builder strings.Builder
+	builder.WriteString("GithubAttestation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("ci_config_path=")
+	builder.WriteString(_m.CiConfigPath)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline_id=")
+	builder.WriteString(_m.PipelineID)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline_name=")
+	builder.WriteString(_m.PipelineName)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline_url=")
+	builder.WriteString(_m.PipelineURL)
+	builder.WriteString(", ")
+	builder.WriteString("project_url=")
+	builder.WriteString(_m.ProjectURL)
+	builder.WriteString(", ")
+	builder.WriteString("runner_id=")
+	builder.WriteString(_m.RunnerID)
+	builder.WriteString(", ")
+	builder.WriteString("ci_host=")
+	builder.WriteString(_m.CiHost)
+	builder.WriteString(", ")
+	builder.WriteString("ci_server_url=")
+	builder.WriteString(_m.CiServerURL)
+	builder.WriteString(", ")
+	builder.WriteString("runner_arch=")
+	builder.WriteString(_m.RunnerArch)
+	builder.WriteString(", ")
+	builder.WriteString("runner_os=")
+	builder.WriteString(_m.RunnerOs)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GithubAttestations is a parsable slice of GithubAttestation.
+type GithubAttestations []*GithubAttestation
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package githubattestation
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the githubattestation type in the database.
+	Label = "github_attestation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldCiConfigPath holds the string denoting the ci_config_path field in the database.
+	FieldCiConfigPath = "ci_config_path"
+	// FieldPipelineID holds the string denoting the pipeline_id field in the database.
+	FieldPipelineID = "pipeline_id"
+	// FieldPipelineName holds the string denoting the pipeline_name field in the database.
+	FieldPipelineName = "pipeline_name"
+	// FieldPipelineURL holds the string denoting the pipeline_url field in the database.
+	FieldPipelineURL = "pipeline_url"
+	// FieldProjectURL holds the string denoting the project_url field in the database.
+	FieldProjectURL = "project_url"
+	// FieldRunnerID holds the string denoting the runner_id field in the database.
+	FieldRunnerID = "runner_id"
+	// FieldCiHost holds the string denoting the ci_host field in the database.
+	FieldCiHost = "ci_host"
+	// FieldCiServerURL holds the string denoting the ci_server_url field in the database.
+	FieldCiServerURL = "ci_server_url"
+	// FieldRunnerArch holds the string denoting the runner_arch field in the database.
+	FieldRunnerArch = "runner_arch"
+	// FieldRunnerOs holds the string denoting the runner_os field in the database.
+	FieldRunnerOs = "runner_os"
+	// EdgeAttestation holds the string denoting the attestation edge name in mutations.
+	EdgeAttestation = "attestation"
+	// Table holds the table name of the githubattestation in the database.
+	Table = "github_attestations"
+	// AttestationTable is the table that holds the attestation relation/edge.
+	AttestationTable = "github_attestations"
+	// AttestationInverseTable is the table name for the Attestation entity.
+	// It exists in this package in order to avoid circular dependency with the "attestation" package.
+	AttestationInverseTable = "attestations"
+	// AttestationColumn is the table column denoting the attestation relation/edge.
+	AttestationColumn = "attestation_github_attestation"
+)
+
+// Columns holds all SQL columns for githubattestation fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldCiConfigPath,
+	FieldPipelineID,
+	FieldPipelineName,
+	FieldPipelineURL,
+	FieldProjectURL,
+	FieldRunnerID,
+	FieldCiHost,
+	FieldCiServerURL,
+	FieldRunnerArch,
+	FieldRunnerOs,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "github_attestations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"attestation_github_attestation",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the GithubAttestation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByCiConfigPath orders the results by the ci_config_path field.
+func ByCiConfigPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCiConfigPath, opts...).ToFunc()
+}
+
+// ByPipelineID orders the results by the pipeline_id field.
+func ByPipelineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipelineID, opts...).ToFunc()
+}
+
+// ByPipelineName orders the results by the pipeline_name field.
+func ByPipelineName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipelineName, opts...).ToFunc()
+}
+
+// ByPipelineURL orders the results by the pipeline_url field.
+func ByPipelineURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipelineURL, opts...).ToFunc()
+}
+
+// ByProjectURL orders the results by the project_url field.
+func ByProjectURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectURL, opts...).ToFunc()
+}
+
+// ByRunnerID orders the results by the runner_id field.
+func ByRunnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunnerID, opts...).ToFunc()
+}
+
+// ByCiHost orders the results by the ci_host field.
+func ByCiHost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCiHost, opts...).ToFunc()
+}
+
+// ByCiServerURL orders the results by the ci_server_url field.
+func ByCiServerURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCiServerURL, opts...).ToFunc()
+}
+
+// ByRunnerArch orders the results by the runner_arch field.
+func ByRunnerArch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunnerArch, opts...).ToFunc()
+}
+
+// ByRunnerOs orders the results by the runner_os field.
+func ByRunnerOs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunnerOs, opts...).ToFunc()
+}
+
+// ByAttestationField orders the results by attestation field.
+func ByAttestationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttestationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttestationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttestationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, AttestationTable, AttestationColumn),
+	)
+}
@@ -0,0 +1,963 @@
+// Code generated by ent, DO NOT EDIT.
+
+package githubattestation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// CiConfigPath applies equality check predicate on the "ci_config_path" field. It's identical to CiConfigPathEQ.
+func CiConfigPath(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldCiConfigPath, v))
+}
+
+// PipelineID applies equality check predicate on the "pipeline_id" field. It's identical to PipelineIDEQ.
+func PipelineID(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldPipelineID, v))
+}
+
+// PipelineName applies equality check predicate on the "pipeline_name" field. It's identical to PipelineNameEQ.
+func PipelineName(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldPipelineName, v))
+}
+
+// PipelineURL applies equality check predicate on the "pipeline_url" field. It's identical to PipelineURLEQ.
+func PipelineURL(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldPipelineURL, v))
+}
+
+// ProjectURL applies equality check predicate on the "project_url" field. It's identical to ProjectURLEQ.
+func ProjectURL(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldProjectURL, v))
+}
+
+// RunnerID applies equality check predicate on the "runner_id" field. It's identical to RunnerIDEQ.
+func RunnerID(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldRunnerID, v))
+}
+
+// CiHost applies equality check predicate on the "ci_host" field. It's identical to CiHostEQ.
+func CiHost(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldCiHost, v))
+}
+
+// CiServerURL applies equality check predicate on the "ci_server_url" field. It's identical to CiServerURLEQ.
+func CiServerURL(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldCiServerURL, v))
+}
+
+// RunnerArch applies equality check predicate on the "runner_arch" field. It's identical to RunnerArchEQ.
+func RunnerArch(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldRunnerArch, v))
+}
+
+// RunnerOs applies equality check predicate on the "runner_os" field. It's identical to RunnerOsEQ.
+func RunnerOs(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldRunnerOs, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// CiConfigPathEQ applies the EQ predicate on the "ci_config_path" field.
+func CiConfigPathEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldCiConfigPath, v))
+}
+
+// CiConfigPathNEQ applies the NEQ predicate on the "ci_config_path" field.
+func CiConfigPathNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldCiConfigPath, v))
+}
+
+// CiConfigPathIn applies the In predicate on the "ci_config_path" field.
+func CiConfigPathIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldCiConfigPath, vs...))
+}
+
+// CiConfigPathNotIn applies the NotIn predicate on the "ci_config_path" field.
+func CiConfigPathNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldCiConfigPath, vs...))
+}
+
+// CiConfigPathGT applies the GT predicate on the "ci_config_path" field.
+func CiConfigPathGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldCiConfigPath, v))
+}
+
+// CiConfigPathGTE applies the GTE predicate on the "ci_config_path" field.
+func CiConfigPathGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldCiConfigPath, v))
+}
+
+// CiConfigPathLT applies the LT predicate on the "ci_config_path" field.
+func CiConfigPathLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldCiConfigPath, v))
+}
+
+// CiConfigPathLTE applies the LTE predicate on the "ci_config_path" field.
+func CiConfigPathLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldCiConfigPath, v))
+}
+
+// CiConfigPathContains applies the Contains predicate on the "ci_config_path" field.
+func CiConfigPathContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldCiConfigPath, v))
+}
+
+// CiConfigPathHasPrefix applies the HasPrefix predicate on the "ci_config_path" field.
+func CiConfigPathHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldCiConfigPath, v))
+}
+
+// CiConfigPathHasSuffix applies the HasSuffix predicate on the "ci_config_path" field.
+func CiConfigPathHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldCiConfigPath, v))
+}
+
+// CiConfigPathIsNil applies the IsNil predicate on the "ci_config_path" field.
+func CiConfigPathIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldCiConfigPath))
+}
+
+// CiConfigPathNotNil applies the NotNil predicate on the "ci_config_path" field.
+func CiConfigPathNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldCiConfigPath))
+}
+
+// CiConfigPathEqualFold applies the EqualFold predicate on the "ci_config_path" field.
+func CiConfigPathEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldCiConfigPath, v))
+}
+
+// CiConfigPathContainsFold applies the ContainsFold predicate on the "ci_config_path" field.
+func CiConfigPathContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldCiConfigPath, v))
+}
+
+// PipelineIDEQ applies the EQ predicate on the "pipeline_id" field.
+func PipelineIDEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldPipelineID, v))
+}
+
+// PipelineIDNEQ applies the NEQ predicate on the "pipeline_id" field.
+func PipelineIDNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldPipelineID, v))
+}
+
+// PipelineIDIn applies the In predicate on the "pipeline_id" field.
+func PipelineIDIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldPipelineID, vs...))
+}
+
+// PipelineIDNotIn applies the NotIn predicate on the "pipeline_id" field.
+func PipelineIDNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldPipelineID, vs...))
+}
+
+// PipelineIDGT applies the GT predicate on the "pipeline_id" field.
+func PipelineIDGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldPipelineID, v))
+}
+
+// PipelineIDGTE applies the GTE predicate on the "pipeline_id" field.
+func PipelineIDGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldPipelineID, v))
+}
+
+// PipelineIDLT applies the LT predicate on the "pipeline_id" field.
+func PipelineIDLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldPipelineID, v))
+}
+
+// PipelineIDLTE applies the LTE predicate on the "pipeline_id" field.
+func PipelineIDLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldPipelineID, v))
+}
+
+// PipelineIDContains applies the Contains predicate on the "pipeline_id" field.
+func PipelineIDContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldPipelineID, v))
+}
+
+// PipelineIDHasPrefix applies the HasPrefix predicate on the "pipeline_id" field.
+func PipelineIDHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldPipelineID, v))
+}
+
+// PipelineIDHasSuffix applies the HasSuffix predicate on the "pipeline_id" field.
+func PipelineIDHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldPipelineID, v))
+}
+
+// PipelineIDIsNil applies the IsNil predicate on the "pipeline_id" field.
+func PipelineIDIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldPipelineID))
+}
+
+// PipelineIDNotNil applies the NotNil predicate on the "pipeline_id" field.
+func PipelineIDNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldPipelineID))
+}
+
+// PipelineIDEqualFold applies the EqualFold predicate on the "pipeline_id" field.
+func PipelineIDEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldPipelineID, v))
+}
+
+// PipelineIDContainsFold applies the ContainsFold predicate on the "pipeline_id" field.
+func PipelineIDContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldPipelineID, v))
+}
+
+// PipelineNameEQ applies the EQ predicate on the "pipeline_name" field.
+func PipelineNameEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldPipelineName, v))
+}
+
+// PipelineNameNEQ applies the NEQ predicate on the "pipeline_name" field.
+func PipelineNameNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldPipelineName, v))
+}
+
+// PipelineNameIn applies the In predicate on the "pipeline_name" field.
+func PipelineNameIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldPipelineName, vs...))
+}
+
+// PipelineNameNotIn applies the NotIn predicate on the "pipeline_name" field.
+func PipelineNameNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldPipelineName, vs...))
+}
+
+// PipelineNameGT applies the GT predicate on the "pipeline_name" field.
+func PipelineNameGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldPipelineName, v))
+}
+
+// PipelineNameGTE applies the GTE predicate on the "pipeline_name" field.
+func PipelineNameGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldPipelineName, v))
+}
+
+// PipelineNameLT applies the LT predicate on the "pipeline_name" field.
+func PipelineNameLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldPipelineName, v))
+}
+
+// PipelineNameLTE applies the LTE predicate on the "pipeline_name" field.
+func PipelineNameLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldPipelineName, v))
+}
+
+// PipelineNameContains applies the Contains predicate on the "pipeline_name" field.
+func PipelineNameContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldPipelineName, v))
+}
+
+// PipelineNameHasPrefix applies the HasPrefix predicate on the "pipeline_name" field.
+func PipelineNameHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldPipelineName, v))
+}
+
+// PipelineNameHasSuffix applies the HasSuffix predicate on the "pipeline_name" field.
+func PipelineNameHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldPipelineName, v))
+}
+
+// PipelineNameIsNil applies the IsNil predicate on the "pipeline_name" field.
+func PipelineNameIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldPipelineName))
+}
+
+// PipelineNameNotNil applies the NotNil predicate on the "pipeline_name" field.
+func PipelineNameNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldPipelineName))
+}
+
+// PipelineNameEqualFold applies the EqualFold predicate on the "pipeline_name" field.
+func PipelineNameEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldPipelineName, v))
+}
+
+// PipelineNameContainsFold applies the ContainsFold predicate on the "pipeline_name" field.
+func PipelineNameContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldPipelineName, v))
+}
+
+// PipelineURLEQ applies the EQ predicate on the "pipeline_url" field.
+func PipelineURLEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldPipelineURL, v))
+}
+
+// PipelineURLNEQ applies the NEQ predicate on the "pipeline_url" field.
+func PipelineURLNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldPipelineURL, v))
+}
+
+// PipelineURLIn applies the In predicate on the "pipeline_url" field.
+func PipelineURLIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldPipelineURL, vs...))
+}
+
+// PipelineURLNotIn applies the NotIn predicate on the "pipeline_url" field.
+func PipelineURLNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldPipelineURL, vs...))
+}
+
+// PipelineURLGT applies the GT predicate on the "pipeline_url" field.
+func PipelineURLGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldPipelineURL, v))
+}
+
+// PipelineURLGTE applies the GTE predicate on the "pipeline_url" field.
+func PipelineURLGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldPipelineURL, v))
+}
+
+// PipelineURLLT applies the LT predicate on the "pipeline_url" field.
+func PipelineURLLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldPipelineURL, v))
+}
+
+// PipelineURLLTE applies the LTE predicate on the "pipeline_url" field.
+func PipelineURLLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldPipelineURL, v))
+}
+
+// PipelineURLContains applies the Contains predicate on the "pipeline_url" field.
+func PipelineURLContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldPipelineURL, v))
+}
+
+// PipelineURLHasPrefix applies the HasPrefix predicate on the "pipeline_url" field.
+func PipelineURLHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldPipelineURL, v))
+}
+
+// PipelineURLHasSuffix applies the HasSuffix predicate on the "pipeline_url" field.
+func PipelineURLHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldPipelineURL, v))
+}
+
+// PipelineURLIsNil applies the IsNil predicate on the "pipeline_url" field.
+func PipelineURLIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldPipelineURL))
+}
+
+// PipelineURLNotNil applies the NotNil predicate on the "pipeline_url" field.
+func PipelineURLNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldPipelineURL))
+}
+
+// PipelineURLEqualFold applies the EqualFold predicate on the "pipeline_url" field.
+func PipelineURLEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldPipelineURL, v))
+}
+
+// PipelineURLContainsFold applies the ContainsFold predicate on the "pipeline_url" field.
+func PipelineURLContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldPipelineURL, v))
+}
+
+// ProjectURLEQ applies the EQ predicate on the "project_url" field.
+func ProjectURLEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldProjectURL, v))
+}
+
+// ProjectURLNEQ applies the NEQ predicate on the "project_url" field.
+func ProjectURLNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldProjectURL, v))
+}
+
+// ProjectURLIn applies the In predicate on the "project_url" field.
+func ProjectURLIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldProjectURL, vs...))
+}
+
+// ProjectURLNotIn applies the NotIn predicate on the "project_url" field.
+func ProjectURLNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldProjectURL, vs...))
+}
+
+// ProjectURLGT applies the GT predicate on the "project_url" field.
+func ProjectURLGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldProjectURL, v))
+}
+
+// ProjectURLGTE applies the GTE predicate on the "project_url" field.
+func ProjectURLGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldProjectURL, v))
+}
+
+// ProjectURLLT applies the LT predicate on the "project_url" field.
+func ProjectURLLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldProjectURL, v))
+}
+
+// ProjectURLLTE applies the LTE predicate on the "project_url" field.
+func ProjectURLLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldProjectURL, v))
+}
+
+// ProjectURLContains applies the Contains predicate on the "project_url" field.
+func ProjectURLContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldProjectURL, v))
+}
+
+// ProjectURLHasPrefix applies the HasPrefix predicate on the "project_url" field.
+func ProjectURLHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldProjectURL, v))
+}
+
+// ProjectURLHasSuffix applies the HasSuffix predicate on the "project_url" field.
+func ProjectURLHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldProjectURL, v))
+}
+
+// ProjectURLIsNil applies the IsNil predicate on the "project_url" field.
+func ProjectURLIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldProjectURL))
+}
+
+// ProjectURLNotNil applies the NotNil predicate on the "project_url" field.
+func ProjectURLNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldProjectURL))
+}
+
+// ProjectURLEqualFold applies the EqualFold predicate on the "project_url" field.
+func ProjectURLEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldProjectURL, v))
+}
+
+// ProjectURLContainsFold applies the ContainsFold predicate on the "project_url" field.
+func ProjectURLContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldProjectURL, v))
+}
+
+// RunnerIDEQ applies the EQ predicate on the "runner_id" field.
+func RunnerIDEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldRunnerID, v))
+}
+
+// RunnerIDNEQ applies the NEQ predicate on the "runner_id" field.
+func RunnerIDNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldRunnerID, v))
+}
+
+// RunnerIDIn applies the In predicate on the "runner_id" field.
+func RunnerIDIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldRunnerID, vs...))
+}
+
+// RunnerIDNotIn applies the NotIn predicate on the "runner_id" field.
+func RunnerIDNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldRunnerID, vs...))
+}
+
+// RunnerIDGT applies the GT predicate on the "runner_id" field.
+func RunnerIDGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldRunnerID, v))
+}
+
+// RunnerIDGTE applies the GTE predicate on the "runner_id" field.
+func RunnerIDGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldRunnerID, v))
+}
+
+// RunnerIDLT applies the LT predicate on the "runner_id" field.
+func RunnerIDLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldRunnerID, v))
+}
+
+// RunnerIDLTE applies the LTE predicate on the "runner_id" field.
+func RunnerIDLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldRunnerID, v))
+}
+
+// RunnerIDContains applies the Contains predicate on the "runner_id" field.
+func RunnerIDContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldRunnerID, v))
+}
+
+// RunnerIDHasPrefix applies the HasPrefix predicate on the "runner_id" field.
+func RunnerIDHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldRunnerID, v))
+}
+
+// RunnerIDHasSuffix applies the HasSuffix predicate on the "runner_id" field.
+func RunnerIDHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldRunnerID, v))
+}
+
+// RunnerIDIsNil applies the IsNil predicate on the "runner_id" field.
+func RunnerIDIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldRunnerID))
+}
+
+// RunnerIDNotNil applies the NotNil predicate on the "runner_id" field.
+func RunnerIDNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldRunnerID))
+}
+
+// RunnerIDEqualFold applies the EqualFold predicate on the "runner_id" field.
+func RunnerIDEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldRunnerID, v))
+}
+
+// RunnerIDContainsFold applies the ContainsFold predicate on the "runner_id" field.
+func RunnerIDContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldRunnerID, v))
+}
+
+// CiHostEQ applies the EQ predicate on the "ci_host" field.
+func CiHostEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldCiHost, v))
+}
+
+// CiHostNEQ applies the NEQ predicate on the "ci_host" field.
+func CiHostNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldCiHost, v))
+}
+
+// CiHostIn applies the In predicate on the "ci_host" field.
+func CiHostIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldCiHost, vs...))
+}
+
+// CiHostNotIn applies the NotIn predicate on the "ci_host" field.
+func CiHostNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldCiHost, vs...))
+}
+
+// CiHostGT applies the GT predicate on the "ci_host" field.
+func CiHostGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldCiHost, v))
+}
+
+// CiHostGTE applies the GTE predicate on the "ci_host" field.
+func CiHostGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldCiHost, v))
+}
+
+// CiHostLT applies the LT predicate on the "ci_host" field.
+func CiHostLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldCiHost, v))
+}
+
+// CiHostLTE applies the LTE predicate on the "ci_host" field.
+func CiHostLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldCiHost, v))
+}
+
+// CiHostContains applies the Contains predicate on the "ci_host" field.
+func CiHostContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldCiHost, v))
+}
+
+// CiHostHasPrefix applies the HasPrefix predicate on the "ci_host" field.
+func CiHostHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldCiHost, v))
+}
+
+// CiHostHasSuffix applies the HasSuffix predicate on the "ci_host" field.
+func CiHostHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldCiHost, v))
+}
+
+// CiHostIsNil applies the IsNil predicate on the "ci_host" field.
+func CiHostIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldCiHost))
+}
+
+// CiHostNotNil applies the NotNil predicate on the "ci_host" field.
+func CiHostNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldCiHost))
+}
+
+// CiHostEqualFold applies the EqualFold predicate on the "ci_host" field.
+func CiHostEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldCiHost, v))
+}
+
+// CiHostContainsFold applies the ContainsFold predicate on the "ci_host" field.
+func CiHostContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldCiHost, v))
+}
+
+// CiServerURLEQ applies the EQ predicate on the "ci_server_url" field.
+func CiServerURLEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldCiServerURL, v))
+}
+
+// CiServerURLNEQ applies the NEQ predicate on the "ci_server_url" field.
+func CiServerURLNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldCiServerURL, v))
+}
+
+// CiServerURLIn applies the In predicate on the "ci_server_url" field.
+func CiServerURLIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldCiServerURL, vs...))
+}
+
+// CiServerURLNotIn applies the NotIn predicate on the "ci_server_url" field.
+func CiServerURLNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldCiServerURL, vs...))
+}
+
+// CiServerURLGT applies the GT predicate on the "ci_server_url" field.
+func CiServerURLGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldCiServerURL, v))
+}
+
+// CiServerURLGTE applies the GTE predicate on the "ci_server_url" field.
+func CiServerURLGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldCiServerURL, v))
+}
+
+// CiServerURLLT applies the LT predicate on the "ci_server_url" field.
+func CiServerURLLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldCiServerURL, v))
+}
+
+// CiServerURLLTE applies the LTE predicate on the "ci_server_url" field.
+func CiServerURLLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldCiServerURL, v))
+}
+
+// CiServerURLContains applies the Contains predicate on the "ci_server_url" field.
+func CiServerURLContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldCiServerURL, v))
+}
+
+// CiServerURLHasPrefix applies the HasPrefix predicate on the "ci_server_url" field.
+func CiServerURLHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldCiServerURL, v))
+}
+
+// CiServerURLHasSuffix applies the HasSuffix predicate on the "ci_server_url" field.
+func CiServerURLHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldCiServerURL, v))
+}
+
+// CiServerURLIsNil applies the IsNil predicate on the "ci_server_url" field.
+func CiServerURLIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldCiServerURL))
+}
+
+// CiServerURLNotNil applies the NotNil predicate on the "ci_server_url" field.
+func CiServerURLNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldCiServerURL))
+}
+
+// CiServerURLEqualFold applies the EqualFold predicate on the "ci_server_url" field.
+func CiServerURLEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldCiServerURL, v))
+}
+
+// CiServerURLContainsFold applies the ContainsFold predicate on the "ci_server_url" field.
+func CiServerURLContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldCiServerURL, v))
+}
+
+// RunnerArchEQ applies the EQ predicate on the "runner_arch" field.
+func RunnerArchEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldRunnerArch, v))
+}
+
+// RunnerArchNEQ applies the NEQ predicate on the "runner_arch" field.
+func RunnerArchNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldRunnerArch, v))
+}
+
+// RunnerArchIn applies the In predicate on the "runner_arch" field.
+func RunnerArchIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldRunnerArch, vs...))
+}
+
+// RunnerArchNotIn applies the NotIn predicate on the "runner_arch" field.
+func RunnerArchNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldRunnerArch, vs...))
+}
+
+// RunnerArchGT applies the GT predicate on the "runner_arch" field.
+func RunnerArchGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldRunnerArch, v))
+}
+
+// RunnerArchGTE applies the GTE predicate on the "runner_arch" field.
+func RunnerArchGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldRunnerArch, v))
+}
+
+// RunnerArchLT applies the LT predicate on the "runner_arch" field.
+func RunnerArchLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldRunnerArch, v))
+}
+
+// RunnerArchLTE applies the LTE predicate on the "runner_arch" field.
+func RunnerArchLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldRunnerArch, v))
+}
+
+// RunnerArchContains applies the Contains predicate on the "runner_arch" field.
+func RunnerArchContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldRunnerArch, v))
+}
+
+// RunnerArchHasPrefix applies the HasPrefix predicate on the "runner_arch" field.
+func RunnerArchHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldRunnerArch, v))
+}
+
+// RunnerArchHasSuffix applies the HasSuffix predicate on the "runner_arch" field.
+func RunnerArchHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldRunnerArch, v))
+}
+
+// RunnerArchIsNil applies the IsNil predicate on the "runner_arch" field.
+func RunnerArchIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldRunnerArch))
+}
+
+// RunnerArchNotNil applies the NotNil predicate on the "runner_arch" field.
+func RunnerArchNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldRunnerArch))
+}
+
+// RunnerArchEqualFold applies the EqualFold predicate on the "runner_arch" field.
+func RunnerArchEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldRunnerArch, v))
+}
+
+// RunnerArchContainsFold applies the ContainsFold predicate on the "runner_arch" field.
+func RunnerArchContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldRunnerArch, v))
+}
+
+// RunnerOsEQ applies the EQ predicate on the "runner_os" field.
+func RunnerOsEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEQ(FieldRunnerOs, v))
+}
+
+// RunnerOsNEQ applies the NEQ predicate on the "runner_os" field.
+func RunnerOsNEQ(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNEQ(FieldRunnerOs, v))
+}
+
+// RunnerOsIn applies the In predicate on the "runner_os" field.
+func RunnerOsIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIn(FieldRunnerOs, vs...))
+}
+
+// RunnerOsNotIn applies the NotIn predicate on the "runner_os" field.
+func RunnerOsNotIn(vs ...string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotIn(FieldRunnerOs, vs...))
+}
+
+// RunnerOsGT applies the GT predicate on the "runner_os" field.
+func RunnerOsGT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGT(FieldRunnerOs, v))
+}
+
+// RunnerOsGTE applies the GTE predicate on the "runner_os" field.
+func RunnerOsGTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldGTE(FieldRunnerOs, v))
+}
+
+// RunnerOsLT applies the LT predicate on the "runner_os" field.
+func RunnerOsLT(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLT(FieldRunnerOs, v))
+}
+
+// RunnerOsLTE applies the LTE predicate on the "runner_os" field.
+func RunnerOsLTE(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldLTE(FieldRunnerOs, v))
+}
+
+// RunnerOsContains applies the Contains predicate on the "runner_os" field.
+func RunnerOsContains(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContains(FieldRunnerOs, v))
+}
+
+// RunnerOsHasPrefix applies the HasPrefix predicate on the "runner_os" field.
+func RunnerOsHasPrefix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasPrefix(FieldRunnerOs, v))
+}
+
+// RunnerOsHasSuffix applies the HasSuffix predicate on the "runner_os" field.
+func RunnerOsHasSuffix(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldHasSuffix(FieldRunnerOs, v))
+}
+
+// RunnerOsIsNil applies the IsNil predicate on the "runner_os" field.
+func RunnerOsIsNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldIsNull(FieldRunnerOs))
+}
+
+// RunnerOsNotNil applies the NotNil predicate on the "runner_os" field.
+func RunnerOsNotNil() predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldNotNull(FieldRunnerOs))
+}
+
+// RunnerOsEqualFold applies the EqualFold predicate on the "runner_os" field.
+func RunnerOsEqualFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldEqualFold(FieldRunnerOs, v))
+}
+
+// RunnerOsContainsFold applies the ContainsFold predicate on the "runner_os" field.
+func RunnerOsContainsFold(v string) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.FieldContainsFold(FieldRunnerOs, v))
+}
+
+// HasAttestation applies the HasEdge predicate on the "attestation" edge.
+func HasAttestation() predicate.GithubAttestation {
+	return predicate.GithubAttestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AttestationTable, AttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttestationWith applies the HasEdge predicate on the "attestation" edge with a given conditions (other predicates).
+func HasAttestationWith(preds ...predicate.Attestation) predicate.GithubAttestation {
+	return predicate.GithubAttestation(func(s *sql.Selector) {
+		step := newAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GithubAttestation) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GithubAttestation) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GithubAttestation) predicate.GithubAttestation {
+	return predicate.GithubAttestation(sql.NotPredicates(p))
+}
@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+)
+
+// GithubAttestationCreate is the builder for creating a GithubAttestation entity.
+type GithubAttestationCreate struct {
+	config
+	mutation *GithubAttestationMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *GithubAttestationCreate) SetTenant(v string) *GithubAttestationCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillableTenant(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetCiConfigPath sets the "ci_config_path" field.
+func (_c *GithubAttestationCreate) SetCiConfigPath(v string) *GithubAttestationCreate {
+	_c.mutation.SetCiConfigPath(v)
+	return _c
+}
+
+// SetNillableCiConfigPath sets the "ci_config_path" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillableCiConfigPath(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetCiConfigPath(*v)
+	}
+	return _c
+}
+
+// SetPipelineID sets the "pipeline_id" field.
+func (_c *GithubAttestationCreate) SetPipelineID(v string) *GithubAttestationCreate {
+	_c.mutation.SetPipelineID(v)
+	return _c
+}
+
+// SetNillablePipelineID sets the "pipeline_id" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillablePipelineID(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetPipelineID(*v)
+	}
+	return _c
+}
+
+// SetPipelineName sets the "pipeline_name" field.
+func (_c *GithubAttestationCreate) SetPipelineName(v string) *GithubAttestationCreate {
+	_c.mutation.SetPipelineName(v)
+	return _c
+}
+
+// SetNillablePipelineName sets the "pipeline_name" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillablePipelineName(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetPipelineName(*v)
+	}
+	return _c
+}
+
+// SetPipelineURL sets the "pipeline_url" field.
+func (_c *GithubAttestationCreate) SetPipelineURL(v string) *GithubAttestationCreate {
+	_c.mutation.SetPipelineURL(v)
+	return _c
+}
+
+// SetNillablePipelineURL sets the "pipeline_url" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillablePipelineURL(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetPipelineURL(*v)
+	}
+	return _c
+}
+
+// SetProjectURL sets the "project_url" field.
+func (_c *GithubAttestationCreate) SetProjectURL(v string) *GithubAttestationCreate {
+	_c.mutation.SetProjectURL(v)
+	return _c
+}
+
+// SetNillableProjectURL sets the "project_url" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillableProjectURL(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetProjectURL(*v)
+	}
+	return _c
+}
+
+// SetRunnerID sets the "runner_id" field.
+func (_c *GithubAttestationCreate) SetRunnerID(v string) *GithubAttestationCreate {
+	_c.mutation.SetRunnerID(v)
+	return _c
+}
+
+// SetNillableRunnerID sets the "runner_id" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillableRunnerID(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetRunnerID(*v)
+	}
+	return _c
+}
+
+// SetCiHost sets the "ci_host" field.
+func (_c *GithubAttestationCreate) SetCiHost(v string) *GithubAttestationCreate {
+	_c.mutation.SetCiHost(v)
+	return _c
+}
+
+// SetNillableCiHost sets the "ci_host" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillableCiHost(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetCiHost(*v)
+	}
+	return _c
+}
+
+// SetCiServerURL sets the "ci_server_url" field.
+func (_c *GithubAttestationCreate) SetCiServerURL(v string) *GithubAttestationCreate {
+	_c.mutation.SetCiServerURL(v)
+	return _c
+}
+
+// SetNillableCiServerURL sets the "ci_server_url" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillableCiServerURL(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetCiServerURL(*v)
+	}
+	return _c
+}
+
+// SetRunnerArch sets the "runner_arch" field.
+func (_c *GithubAttestationCreate) SetRunnerArch(v string) *GithubAttestationCreate {
+	_c.mutation.SetRunnerArch(v)
+	return _c
+}
+
+// SetNillableRunnerArch sets the "runner_arch" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillableRunnerArch(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetRunnerArch(*v)
+	}
+	return _c
+}
+
+// SetRunnerOs sets the "runner_os" field.
+func (_c *GithubAttestationCreate) SetRunnerOs(v string) *GithubAttestationCreate {
+	_c.mutation.SetRunnerOs(v)
+	return _c
+}
+
+// SetNillableRunnerOs sets the "runner_os" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillableRunnerOs(v *string) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetRunnerOs(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GithubAttestationCreate) SetID(v uuid.UUID) *GithubAttestationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GithubAttestationCreate) SetNillableID(v *uuid.UUID) *GithubAttestationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_c *GithubAttestationCreate) SetAttestationID(id uuid.UUID) *GithubAttestationCreate {
+	_c.mutation.SetAttestationID(id)
+	return _c
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_c *GithubAttestationCreate) SetAttestation(v *Attestation) *GithubAttestationCreate {
+	return _c.SetAttestationID(v.ID)
+}
+
+// Mutation returns the GithubAttestationMutation object of the builder.
+func (_c *GithubAttestationCreate) Mutation() *GithubAttestationMutation {
+	return _c.mutation
+}
+
+// Save creates the GithubAttestation in the database.
+func (_c *GithubAttestationCreate) Save(ctx context.Context) (*GithubAttestation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GithubAttestationCreate) SaveX(ctx context.Context) *GithubAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GithubAttestationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GithubAttestationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GithubAttestationCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := githubattestation.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if githubattestation.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized githubattestation.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := githubattestation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GithubAttestationCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "GithubAttestation.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := githubattestation.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "GithubAttestation.tenant": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AttestationIDs()) == 0 {
+		return &ValidationError{Name: "attestation", err: errors.New(`ent: missing required edge "GithubAttestation.attestation"`)}
+	}
+	return nil
+}
+
+func (_c *GithubAttestationCreate) sqlSave(ctx context.Context) (*GithubAttestation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GithubAttestationCreate) createSpec() (*GithubAttestation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GithubAttestation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(githubattestation.Table, sqlgraph.NewFieldSpec(githubattestation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(githubattestation.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.CiConfigPath(); ok {
+		_spec.SetField(githubattestation.FieldCiConfigPath, field.TypeString, value)
+		_node.CiConfigPath = value
+	}
+	if value, ok := _c.mutation.PipelineID(); ok {
+		_spec.SetField(githubattestation.FieldPipelineID, field.TypeString, value)
+		_node.PipelineID = value
+	}
+	if value, ok := _c.mutation.PipelineName(); ok {
+		_spec.SetField(githubattestation.FieldPipelineName, field.TypeString, value)
+		_node.PipelineName = value
+	}
+	if value, ok := _c.mutation.PipelineURL(); ok {
+		_spec.SetField(githubattestation.FieldPipelineURL, field.TypeString, value)
+		_node.PipelineURL = value
+	}
+	if value, ok := _c.mutation.ProjectURL(); ok {
+		_spec.SetField(githubattestation.FieldProjectURL, field.TypeString, value)
+		_node.ProjectURL = value
+	}
+	if value, ok := _c.mutation.RunnerID(); ok {
+		_spec.SetField(githubattestation.FieldRunnerID, field.TypeString, value)
+		_node.RunnerID = value
+	}
+	if value, ok := _c.mutation.CiHost(); ok {
+		_spec.SetField(githubattestation.FieldCiHost, field.TypeString, value)
+		_node.CiHost = value
+	}
+	if value, ok := _c.mutation.CiServerURL(); ok {
+		_spec.SetField(githubattestation.FieldCiServerURL, field.TypeString, value)
+		_node.CiServerURL = value
+	}
+	if value, ok := _c.mutation.RunnerArch(); ok {
+		_spec.SetField(githubattestation.FieldRunnerArch, field.TypeString, value)
+		_node.RunnerArch = value
+	}
+	if value, ok := _c.mutation.RunnerOs(); ok {
+		_spec.SetField(githubattestation.FieldRunnerOs, field.TypeString, value)
+		_node.RunnerOs = value
+	}
+	if nodes := _c.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   githubattestation.AttestationTable,
+			Columns: []string{githubattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.attestation_github_attestation = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GithubAttestationCreateBulk is the builder for creating many GithubAttestation entities in bulk.
+type GithubAttestationCreateBulk struct {
+	config
+	err      error
+	builders []*GithubAttestationCreate
+}
+
+// Save creates the GithubAttestation entities in the database.
+func (_c *GithubAttestationCreateBulk) Save(ctx context.Context) ([]*GithubAttestation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GithubAttestation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GithubAttestationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GithubAttestationCreateBulk) SaveX(ctx context.Context) []*GithubAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GithubAttestationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GithubAttestationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,811 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// GithubAttestationUpdate is the builder for updating GithubAttestation entities.
+type GithubAttestationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GithubAttestationMutation
+}
+
+// Where appends a list predicates to the GithubAttestationUpdate builder.
+func (_u *GithubAttestationUpdate) Where(ps ...predicate.GithubAttestation) *GithubAttestationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCiConfigPath sets the "ci_config_path" field.
+func (_u *GithubAttestationUpdate) SetCiConfigPath(v string) *GithubAttestationUpdate {
+	_u.mutation.SetCiConfigPath(v)
+	return _u
+}
+
+// SetNillableCiConfigPath sets the "ci_config_path" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillableCiConfigPath(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetCiConfigPath(*v)
+	}
+	return _u
+}
+
+// ClearCiConfigPath clears the value of the "ci_config_path" field.
+func (_u *GithubAttestationUpdate) ClearCiConfigPath() *GithubAttestationUpdate {
+	_u.mutation.ClearCiConfigPath()
+	return _u
+}
+
+// SetPipelineID sets the "pipeline_id" field.
+func (_u *GithubAttestationUpdate) SetPipelineID(v string) *GithubAttestationUpdate {
+	_u.mutation.SetPipelineID(v)
+	return _u
+}
+
+// SetNillablePipelineID sets the "pipeline_id" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillablePipelineID(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetPipelineID(*v)
+	}
+	return _u
+}
+
+// ClearPipelineID clears the value of the "pipeline_id" field.
+func (_u *GithubAttestationUpdate) ClearPipelineID() *GithubAttestationUpdate {
+	_u.mutation.ClearPipelineID()
+	return _u
+}
+
+// SetPipelineName sets the "pipeline_name" field.
+func (_u *GithubAttestationUpdate) SetPipelineName(v string) *GithubAttestationUpdate {
+	_u.mutation.SetPipelineName(v)
+	return _u
+}
+
+// SetNillablePipelineName sets the "pipeline_name" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillablePipelineName(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetPipelineName(*v)
+	}
+	return _u
+}
+
+// ClearPipelineName clears the value of the "pipeline_name" field.
+func (_u *GithubAttestationUpdate) ClearPipelineName() *GithubAttestationUpdate {
+	_u.mutation.ClearPipelineName()
+	return _u
+}
+
+// SetPipelineURL sets the "pipeline_url" field.
+func (_u *GithubAttestationUpdate) SetPipelineURL(v string) *GithubAttestationUpdate {
+	_u.mutation.SetPipelineURL(v)
+	return _u
+}
+
+// SetNillablePipelineURL sets the "pipeline_url" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillablePipelineURL(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetPipelineURL(*v)
+	}
+	return _u
+}
+
+// ClearPipelineURL clears the value of the "pipeline_url" field.
+func (_u *GithubAttestationUpdate) ClearPipelineURL() *GithubAttestationUpdate {
+	_u.mutation.ClearPipelineURL()
+	return _u
+}
+
+// SetProjectURL sets the "project_url" field.
+func (_u *GithubAttestationUpdate) SetProjectURL(v string) *GithubAttestationUpdate {
+	_u.mutation.SetProjectURL(v)
+	return _u
+}
+
+// SetNillableProjectURL sets the "project_url" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillableProjectURL(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetProjectURL(*v)
+	}
+	return _u
+}
+
+// ClearProjectURL clears the value of the "project_url" field.
+func (_u *GithubAttestationUpdate) ClearProjectURL() *GithubAttestationUpdate {
+	_u.mutation.ClearProjectURL()
+	return _u
+}
+
+// SetRunnerID sets the "runner_id" field.
+func (_u *GithubAttestationUpdate) SetRunnerID(v string) *GithubAttestationUpdate {
+	_u.mutation.SetRunnerID(v)
+	return _u
+}
+
+// SetNillableRunnerID sets the "runner_id" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillableRunnerID(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetRunnerID(*v)
+	}
+	return _u
+}
+
+// ClearRunnerID clears the value of the "runner_id" field.
+func (_u *GithubAttestationUpdate) ClearRunnerID() *GithubAttestationUpdate {
+	_u.mutation.ClearRunnerID()
+	return _u
+}
+
+// SetCiHost sets the "ci_host" field.
+func (_u *GithubAttestationUpdate) SetCiHost(v string) *GithubAttestationUpdate {
+	_u.mutation.SetCiHost(v)
+	return _u
+}
+
+// SetNillableCiHost sets the "ci_host" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillableCiHost(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetCiHost(*v)
+	}
+	return _u
+}
+
+// ClearCiHost clears the value of the "ci_host" field.
+func (_u *GithubAttestationUpdate) ClearCiHost() *GithubAttestationUpdate {
+	_u.mutation.ClearCiHost()
+	return _u
+}
+
+// SetCiServerURL sets the "ci_server_url" field.
+func (_u *GithubAttestationUpdate) SetCiServerURL(v string) *GithubAttestationUpdate {
+	_u.mutation.SetCiServerURL(v)
+	return _u
+}
+
+// SetNillableCiServerURL sets the "ci_server_url" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillableCiServerURL(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetCiServerURL(*v)
+	}
+	return _u
+}
+
+// ClearCiServerURL clears the value of the "ci_server_url" field.
+func (_u *GithubAttestationUpdate) ClearCiServerURL() *GithubAttestationUpdate {
+	_u.mutation.ClearCiServerURL()
+	return _u
+}
+
+// SetRunnerArch sets the "runner_arch" field.
+func (_u *GithubAttestationUpdate) SetRunnerArch(v string) *GithubAttestationUpdate {
+	_u.mutation.SetRunnerArch(v)
+	return _u
+}
+
+// SetNillableRunnerArch sets the "runner_arch" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillableRunnerArch(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetRunnerArch(*v)
+	}
+	return _u
+}
+
+// ClearRunnerArch clears the value of the "runner_arch" field.
+func (_u *GithubAttestationUpdate) ClearRunnerArch() *GithubAttestationUpdate {
+	_u.mutation.ClearRunnerArch()
+	return _u
+}
+
+// SetRunnerOs sets the "runner_os" field.
+func (_u *GithubAttestationUpdate) SetRunnerOs(v string) *GithubAttestationUpdate {
+	_u.mutation.SetRunnerOs(v)
+	return _u
+}
+
+// SetNillableRunnerOs sets the "runner_os" field if the given value is not nil.
+func (_u *GithubAttestationUpdate) SetNillableRunnerOs(v *string) *GithubAttestationUpdate {
+	if v != nil {
+		_u.SetRunnerOs(*v)
+	}
+	return _u
+}
+
+// ClearRunnerOs clears the value of the "runner_os" field.
+func (_u *GithubAttestationUpdate) ClearRunnerOs() *GithubAttestationUpdate {
+	_u.mutation.ClearRunnerOs()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *GithubAttestationUpdate) SetAttestationID(id uuid.UUID) *GithubAttestationUpdate {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *GithubAttestationUpdate) SetAttestation(v *Attestation) *GithubAttestationUpdate {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the GithubAttestationMutation object of the builder.
+func (_u *GithubAttestationUpdate) Mutation() *GithubAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *GithubAttestationUpdate) ClearAttestation() *GithubAttestationUpdate {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GithubAttestationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GithubAttestationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GithubAttestationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GithubAttestationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GithubAttestationUpdate) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GithubAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *GithubAttestationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(githubattestation.Table, githubattestation.Columns, sqlgraph.NewFieldSpec(githubattestation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CiConfigPath(); ok {
+		_spec.SetField(githubattestation.FieldCiConfigPath, field.TypeString, value)
+	}
+	if _u.mutation.CiConfigPathCleared() {
+		_spec.ClearField(githubattestation.FieldCiConfigPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineID(); ok {
+		_spec.SetField(githubattestation.FieldPipelineID, field.TypeString, value)
+	}
+	if _u.mutation.PipelineIDCleared() {
+		_spec.ClearField(githubattestation.FieldPipelineID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineName(); ok {
+		_spec.SetField(githubattestation.FieldPipelineName, field.TypeString, value)
+	}
+	if _u.mutation.PipelineNameCleared() {
+		_spec.ClearField(githubattestation.FieldPipelineName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineURL(); ok {
+		_spec.SetField(githubattestation.FieldPipelineURL, field.TypeString, value)
+	}
+	if _u.mutation.PipelineURLCleared() {
+		_spec.ClearField(githubattestation.FieldPipelineURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProjectURL(); ok {
+		_spec.SetField(githubattestation.FieldProjectURL, field.TypeString, value)
+	}
+	if _u.mutation.ProjectURLCleared() {
+		_spec.ClearField(githubattestation.FieldProjectURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerID(); ok {
+		_spec.SetField(githubattestation.FieldRunnerID, field.TypeString, value)
+	}
+	if _u.mutation.RunnerIDCleared() {
+		_spec.ClearField(githubattestation.FieldRunnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CiHost(); ok {
+		_spec.SetField(githubattestation.FieldCiHost, field.TypeString, value)
+	}
+	if _u.mutation.CiHostCleared() {
+		_spec.ClearField(githubattestation.FieldCiHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.CiServerURL(); ok {
+		_spec.SetField(githubattestation.FieldCiServerURL, field.TypeString, value)
+	}
+	if _u.mutation.CiServerURLCleared() {
+		_spec.ClearField(githubattestation.FieldCiServerURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerArch(); ok {
+		_spec.SetField(githubattestation.FieldRunnerArch, field.TypeString, value)
+	}
+	if _u.mutation.RunnerArchCleared() {
+		_spec.ClearField(githubattestation.FieldRunnerArch, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerOs(); ok {
+		_spec.SetField(githubattestation.FieldRunnerOs, field.TypeString, value)
+	}
+	if _u.mutation.RunnerOsCleared() {
+		_spec.ClearField(githubattestation.FieldRunnerOs, field.TypeString)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   githubattestation.AttestationTable,
+			Columns: []string{githubattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   githubattestation.AttestationTable,
+			Columns: []string{githubattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{githubattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GithubAttestationUpdateOne is the builder for updating a single GithubAttestation entity.
+type GithubAttestationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GithubAttestationMutation
+}
+
+// SetCiConfigPath sets the "ci_config_path" field.
+func (_u *GithubAttestationUpdateOne) SetCiConfigPath(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetCiConfigPath(v)
+	return _u
+}
+
+// SetNillableCiConfigPath sets the "ci_config_path" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillableCiConfigPath(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetCiConfigPath(*v)
+	}
+	return _u
+}
+
+// ClearCiConfigPath clears the value of the "ci_config_path" field.
+func (_u *GithubAttestationUpdateOne) ClearCiConfigPath() *GithubAttestationUpdateOne {
+	_u.mutation.ClearCiConfigPath()
+	return _u
+}
+
+// SetPipelineID sets the "pipeline_id" field.
+func (_u *GithubAttestationUpdateOne) SetPipelineID(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetPipelineID(v)
+	return _u
+}
+
+// SetNillablePipelineID sets the "pipeline_id" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillablePipelineID(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetPipelineID(*v)
+	}
+	return _u
+}
+
+// ClearPipelineID clears the value of the "pipeline_id" field.
+func (_u *GithubAttestationUpdateOne) ClearPipelineID() *GithubAttestationUpdateOne {
+	_u.mutation.ClearPipelineID()
+	return _u
+}
+
+// SetPipelineName sets the "pipeline_name" field.
+func (_u *GithubAttestationUpdateOne) SetPipelineName(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetPipelineName(v)
+	return _u
+}
+
+// SetNillablePipelineName sets the "pipeline_name" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillablePipelineName(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetPipelineName(*v)
+	}
+	return _u
+}
+
+// ClearPipelineName clears the value of the "pipeline_name" field.
+func (_u *GithubAttestationUpdateOne) ClearPipelineName() *GithubAttestationUpdateOne {
+	_u.mutation.ClearPipelineName()
+	return _u
+}
+
+// SetPipelineURL sets the "pipeline_url" field.
+func (_u *GithubAttestationUpdateOne) SetPipelineURL(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetPipelineURL(v)
+	return _u
+}
+
+// SetNillablePipelineURL sets the "pipeline_url" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillablePipelineURL(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetPipelineURL(*v)
+	}
+	return _u
+}
+
+// ClearPipelineURL clears the value of the "pipeline_url" field.
+func (_u *GithubAttestationUpdateOne) ClearPipelineURL() *GithubAttestationUpdateOne {
+	_u.mutation.ClearPipelineURL()
+	return _u
+}
+
+// SetProjectURL sets the "project_url" field.
+func (_u *GithubAttestationUpdateOne) SetProjectURL(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetProjectURL(v)
+	return _u
+}
+
+// SetNillableProjectURL sets the "project_url" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillableProjectURL(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetProjectURL(*v)
+	}
+	return _u
+}
+
+// ClearProjectURL clears the value of the "project_url" field.
+func (_u *GithubAttestationUpdateOne) ClearProjectURL() *GithubAttestationUpdateOne {
+	_u.mutation.ClearProjectURL()
+	return _u
+}
+
+// SetRunnerID sets the "runner_id" field.
+func (_u *GithubAttestationUpdateOne) SetRunnerID(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetRunnerID(v)
+	return _u
+}
+
+// SetNillableRunnerID sets the "runner_id" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillableRunnerID(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetRunnerID(*v)
+	}
+	return _u
+}
+
+// ClearRunnerID clears the value of the "runner_id" field.
+func (_u *GithubAttestationUpdateOne) ClearRunnerID() *GithubAttestationUpdateOne {
+	_u.mutation.ClearRunnerID()
+	return _u
+}
+
+// SetCiHost sets the "ci_host" field.
+func (_u *GithubAttestationUpdateOne) SetCiHost(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetCiHost(v)
+	return _u
+}
+
+// SetNillableCiHost sets the "ci_host" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillableCiHost(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetCiHost(*v)
+	}
+	return _u
+}
+
+// ClearCiHost clears the value of the "ci_host" field.
+func (_u *GithubAttestationUpdateOne) ClearCiHost() *GithubAttestationUpdateOne {
+	_u.mutation.ClearCiHost()
+	return _u
+}
+
+// SetCiServerURL sets the "ci_server_url" field.
+func (_u *GithubAttestationUpdateOne) SetCiServerURL(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetCiServerURL(v)
+	return _u
+}
+
+// SetNillableCiServerURL sets the "ci_server_url" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillableCiServerURL(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetCiServerURL(*v)
+	}
+	return _u
+}
+
+// ClearCiServerURL clears the value of the "ci_server_url" field.
+func (_u *GithubAttestationUpdateOne) ClearCiServerURL() *GithubAttestationUpdateOne {
+	_u.mutation.ClearCiServerURL()
+	return _u
+}
+
+// SetRunnerArch sets the "runner_arch" field.
+func (_u *GithubAttestationUpdateOne) SetRunnerArch(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetRunnerArch(v)
+	return _u
+}
+
+// SetNillableRunnerArch sets the "runner_arch" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillableRunnerArch(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetRunnerArch(*v)
+	}
+	return _u
+}
+
+// ClearRunnerArch clears the value of the "runner_arch" field.
+func (_u *GithubAttestationUpdateOne) ClearRunnerArch() *GithubAttestationUpdateOne {
+	_u.mutation.ClearRunnerArch()
+	return _u
+}
+
+// SetRunnerOs sets the "runner_os" field.
+func (_u *GithubAttestationUpdateOne) SetRunnerOs(v string) *GithubAttestationUpdateOne {
+	_u.mutation.SetRunnerOs(v)
+	return _u
+}
+
+// SetNillableRunnerOs sets the "runner_os" field if the given value is not nil.
+func (_u *GithubAttestationUpdateOne) SetNillableRunnerOs(v *string) *GithubAttestationUpdateOne {
+	if v != nil {
+		_u.SetRunnerOs(*v)
+	}
+	return _u
+}
+
+// ClearRunnerOs clears the value of the "runner_os" field.
+func (_u *GithubAttestationUpdateOne) ClearRunnerOs() *GithubAttestationUpdateOne {
+	_u.mutation.ClearRunnerOs()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *GithubAttestationUpdateOne) SetAttestationID(id uuid.UUID) *GithubAttestationUpdateOne {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *GithubAttestationUpdateOne) SetAttestation(v *Attestation) *GithubAttestationUpdateOne {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the GithubAttestationMutation object of the builder.
+func (_u *GithubAttestationUpdateOne) Mutation() *GithubAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *GithubAttestationUpdateOne) ClearAttestation() *GithubAttestationUpdateOne {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Where appends a list predicates to the GithubAttestationUpdate builder.
+func (_u *GithubAttestationUpdateOne) Where(ps ...predicate.GithubAttestation) *GithubAttestationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GithubAttestationUpdateOne) Select(field string, fields ...string) *GithubAttestationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GithubAttestation entity.
+func (_u *GithubAttestationUpdateOne) Save(ctx context.Context) (*GithubAttestation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GithubAttestationUpdateOne) SaveX(ctx context.Context) *GithubAttestation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GithubAttestationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GithubAttestationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GithubAttestationUpdateOne) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GithubAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *GithubAttestationUpdateOne) sqlSave(ctx context.Context) (_node *GithubAttestation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(githubattestation.Table, githubattestation.Columns, sqlgraph.NewFieldSpec(githubattestation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GithubAttestation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, githubattestation.FieldID)
+		for _, f := range fields {
+			if !githubattestation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != githubattestation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CiConfigPath(); ok {
+		_spec.SetField(githubattestation.FieldCiConfigPath, field.TypeString, value)
+	}
+	if _u.mutation.CiConfigPathCleared() {
+		_spec.ClearField(githubattestation.FieldCiConfigPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineID(); ok {
+		_spec.SetField(githubattestation.FieldPipelineID, field.TypeString, value)
+	}
+	if _u.mutation.PipelineIDCleared() {
+		_spec.ClearField(githubattestation.FieldPipelineID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineName(); ok {
+		_spec.SetField(githubattestation.FieldPipelineName, field.TypeString, value)
+	}
+	if _u.mutation.PipelineNameCleared() {
+		_spec.ClearField(githubattestation.FieldPipelineName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineURL(); ok {
+		_spec.SetField(githubattestation.FieldPipelineURL, field.TypeString, value)
+	}
+	if _u.mutation.PipelineURLCleared() {
+		_spec.ClearField(githubattestation.FieldPipelineURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProjectURL(); ok {
+		_spec.SetField(githubattestation.FieldProjectURL, field.TypeString, value)
+	}
+	if _u.mutation.ProjectURLCleared() {
+		_spec.ClearField(githubattestation.FieldProjectURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerID(); ok {
+		_spec.SetField(githubattestation.FieldRunnerID, field.TypeString, value)
+	}
+	if _u.mutation.RunnerIDCleared() {
+		_spec.ClearField(githubattestation.FieldRunnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CiHost(); ok {
+		_spec.SetField(githubattestation.FieldCiHost, field.TypeString, value)
+	}
+	if _u.mutation.CiHostCleared() {
+		_spec.ClearField(githubattestation.FieldCiHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.CiServerURL(); ok {
+		_spec.SetField(githubattestation.FieldCiServerURL, field.TypeString, value)
+	}
+	if _u.mutation.CiServerURLCleared() {
+		_spec.ClearField(githubattestation.FieldCiServerURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerArch(); ok {
+		_spec.SetField(githubattestation.FieldRunnerArch, field.TypeString, value)
+	}
+	if _u.mutation.RunnerArchCleared() {
+		_spec.ClearField(githubattestation.FieldRunnerArch, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerOs(); ok {
+		_spec.SetField(githubattestation.FieldRunnerOs, field.TypeString, value)
+	}
+	if _u.mutation.RunnerOsCleared() {
+		_spec.ClearField(githubattestation.FieldRunnerOs, field.TypeString)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   githubattestation.AttestationTable,
+			Columns: []string{githubattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   githubattestation.AttestationTable,
+			Columns: []string{githubattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GithubAttestation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{githubattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
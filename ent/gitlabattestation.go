@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+)
+
+// GitlabAttestation is the model entity for the GitlabAttestation schema.
+type GitlabAttestation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// CiConfigPath holds the value of the "ci_config_path" field.
+	CiConfigPath string `json:"ci_config_path,omitempty"`
+	// PipelineID holds the value of the "pipeline_id" field.
+	PipelineID string `json:"pipeline_id,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID string `json:"job_id,omitempty"`
+	// JobImage holds the value of the "job_image" field.
+	JobImage string `json:"job_image,omitempty"`
+	// JobName holds the value of the "job_name" field.
+	JobName string `json:"job_name,omitempty"`
+	// JobStage holds the value of the "job_stage" field.
+	JobStage string `json:"job_stage,omitempty"`
+	// JobURL holds the value of the "job_url" field.
+	JobURL string `json:"job_url,omitempty"`
+	// PipelineURL holds the value of the "pipeline_url" field.
+	PipelineURL string `json:"pipeline_url,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID string `json:"project_id,omitempty"`
+	// ProjectURL holds the value of the "project_url" field.
+	ProjectURL string `json:"project_url,omitempty"`
+	// RunnerID holds the value of the "runner_id" field.
+	RunnerID string `json:"runner_id,omitempty"`
+	// CiHost holds the value of the "ci_host" field.
+	CiHost string `json:"ci_host,omitempty"`
+	// CiServerURL holds the value of the "ci_server_url" field.
+	CiServerURL string `json:"ci_server_url,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GitlabAttestationQuery when eager-loading is set.
+	Edges                          GitlabAttestationEdges `json:"edges"`
+	attestation_gitlab_attestation *uuid.UUID
+	selectValues                   sql.SelectValues
+}
+
+// GitlabAttestationEdges holds the relations/edges for other nodes in the graph.
+type GitlabAttestationEdges struct {
+	// Attestation holds the value of the attestation edge.
+	Attestation *Attestation `json:"attestation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// AttestationOrErr returns the Attestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GitlabAttestationEdges) AttestationOrErr() (*Attestation, error) {
+	if e.Attestation != nil {
+		return e.Attestation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "attestation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GitlabAttestation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gitlabattestation.FieldTenant, gitlabattestation.FieldCiConfigPath, gitlabattestation.FieldPipelineID, gitlabattestation.FieldJobID, gitlabattestation.FieldJobImage, gitlabattestation.FieldJobName, gitlabattestation.FieldJobStage, gitlabattestation.FieldJobURL, gitlabattestation.FieldPipelineURL, gitlabattestation.FieldProjectID, gitlabattestation.FieldProjectURL, gitlabattestation.FieldRunnerID, gitlabattestation.FieldCiHost, gitlabattestation.FieldCiServerURL:
+			values[i] = new(sql.NullString)
+		case gitlabattestation.FieldID:
+			values[i] = new(uuid.UUID)
+		case gitlabattestation.ForeignKeys[0]: // attestation_gitlab_attestation
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GitlabAttestation fields.
+func (_m *GitlabAttestation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gitlabattestation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case gitlabattestation.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case gitlabattestation.FieldCiConfigPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ci_config_path", values[i])
+			} else if value.Valid {
+				_m.CiConfigPath = value.String
+			}
+		case gitlabattestation.FieldPipelineID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline_id", values[i])
+			} else if value.Valid {
+				_m.PipelineID = value.String
+			}
+		case gitlabattestation.FieldJobID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				_m.JobID = value.String
+			}
+		case gitlabattestation.FieldJobImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_image", values[i])
+			} else if value.Valid {
+				_m.JobImage = value.String
+			}
+		case gitlabattestation.FieldJobName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_name", values[i])
+			} else if value.Valid {
+				_m.JobName = value.String
+			}
+		case gitlabattestation.FieldJobStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_stage", values[i])
+			} else if value.Valid {
+				_m.JobStage = value.String
+			}
+		case gitlabattestation.FieldJobURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_url", values[i])
+			} else if value.Valid {
+				_m.JobURL = value.String
+			}
+		case gitlabattestation.FieldPipelineURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline_url", values[i])
+			} else if value.Valid {
+				_m.PipelineURL = value.String
+			}
+		case gitlabattestation.FieldProjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				_m.ProjectID = value.String
+			}
+		case gitlabattestation.FieldProjectURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_url", values[i])
+			} else if value.Valid {
+				_m.ProjectURL = value.String
+			}
+		case gitlabattestation.FieldRunnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field runner_id", values[i])
+			} else if value.Valid {
+				_m.RunnerID = value.String
+			}
+		case gitlabattestation.FieldCiHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ci_host", values[i])
+			} else if value.Valid {
+				_m.CiHost = value.String
+			}
+		case gitlabattestation.FieldCiServerURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ci_server_url", values[i])
+			} else if value.Valid {
+				_m.CiServerURL = value.String
+			}
+		case gitlabattestation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_gitlab_attestation", values[i])
+			} else if value.Valid {
+				_m.attestation_gitlab_attestation = new(uuid.UUID)
+				*_m.attestation_gitlab_attestation = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GitlabAttestation.
+// This includes values selected through modifiers, order, etc.
+func (_m *GitlabAttestation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttestation queries the "attestation" edge of the GitlabAttestation entity.
+func (_m *GitlabAttestation) QueryAttestation() *AttestationQuery {
+	return NewGitlabAttestationClient(_m.config).QueryAttestation(_m)
+}
+
+// Update returns a builder for updating this GitlabAttestation.
+// Note that you need to call GitlabAttestation.Unwrap() before calling this method if this GitlabAttestation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GitlabAttestation) Update() *GitlabAttestationUpdateOne {
+	return NewGitlabAttestationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GitlabAttestation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GitlabAttestation) Unwrap() *GitlabAttestation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GitlabAttestation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GitlabAttestation) String() string {
+	var builder strings.Builder
+	builder.WriteString("GitlabAttestation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("ci_config_path=")
+	builder.WriteString(_m.CiConfigPath)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline_id=")
+	builder.WriteString(_m.PipelineID)
+	builder.WriteString(", ")
+	builder.WriteString("job_id=")
+	builder.WriteString(_m.JobID)
+	builder.WriteString(", ")
+	builder.WriteString("job_image=")
+	builder.WriteString(_m.JobImage)
+	builder.WriteString(", ")
+	builder.WriteString("job_name=")
+	builder.WriteString(_m.JobName)
+	builder.WriteString(", ")
+	builder.WriteString("job_stage=")
+	builder.WriteString(_m.JobStage)
+	builder.WriteString(", ")
+	builder.WriteString("job_url=")
+	builder.WriteString(_m.JobURL)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline_url=")
+	builder.WriteString(_m.PipelineURL)
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(_m.ProjectID)
+	builder.WriteString(", ")
+	builder.WriteString("project_url=")
+	builder.WriteString(_m.ProjectURL)
+	builder.WriteString(", ")
+	builder.WriteString("runner_id=")
+	builder.WriteString(_m.RunnerID)
+	builder.WriteString(", ")
+	builder.WriteString("ci_host=")
+	builder.WriteString(_m.CiHost)
+	builder.WriteString(", ")
+	builder.WriteString("ci_server_url=")
+	builder.WriteString(_m.CiServerURL)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GitlabAttestations is a parsable slice of GitlabAttestation.
+type GitlabAttestations []*GitlabAttestation
@@ -0,0 +1,1203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gitlabattestation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// CiConfigPath applies equality check predicate on the "ci_config_path" field. It's identical to CiConfigPathEQ.
+func CiConfigPath(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldCiConfigPath, v))
+}
+
+// PipelineID applies equality check predicate on the "pipeline_id" field. It's identical to PipelineIDEQ.
+func PipelineID(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldPipelineID, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobImage applies equality check predicate on the "job_image" field. It's identical to JobImageEQ.
+func JobImage(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobImage, v))
+}
+
+// JobName applies equality check predicate on the "job_name" field. It's identical to JobNameEQ.
+func JobName(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobName, v))
+}
+
+// JobStage applies equality check predicate on the "job_stage" field. It's identical to JobStageEQ.
+func JobStage(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobStage, v))
+}
+
+// JobURL applies equality check predicate on the "job_url" field. It's identical to JobURLEQ.
+func JobURL(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobURL, v))
+}
+
+// PipelineURL applies equality check predicate on the "pipeline_url" field. It's identical to PipelineURLEQ.
+func PipelineURL(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldPipelineURL, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectURL applies equality check predicate on the "project_url" field. It's identical to ProjectURLEQ.
+func ProjectURL(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldProjectURL, v))
+}
+
+// RunnerID applies equality check predicate on the "runner_id" field. It's identical to RunnerIDEQ.
+func RunnerID(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldRunnerID, v))
+}
+
+// CiHost applies equality check predicate on the "ci_host" field. It's identical to CiHostEQ.
+func CiHost(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldCiHost, v))
+}
+
+// CiServerURL applies equality check predicate on the "ci_server_url" field. It's identical to CiServerURLEQ.
+func CiServerURL(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldCiServerURL, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// CiConfigPathEQ applies the EQ predicate on the "ci_config_path" field.
+func CiConfigPathEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldCiConfigPath, v))
+}
+
+// CiConfigPathNEQ applies the NEQ predicate on the "ci_config_path" field.
+func CiConfigPathNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldCiConfigPath, v))
+}
+
+// CiConfigPathIn applies the In predicate on the "ci_config_path" field.
+func CiConfigPathIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldCiConfigPath, vs...))
+}
+
+// CiConfigPathNotIn applies the NotIn predicate on the "ci_config_path" field.
+func CiConfigPathNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldCiConfigPath, vs...))
+}
+
+// CiConfigPathGT applies the GT predicate on the "ci_config_path" field.
+func CiConfigPathGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldCiConfigPath, v))
+}
+
+// CiConfigPathGTE applies the GTE predicate on the "ci_config_path" field.
+func CiConfigPathGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldCiConfigPath, v))
+}
+
+// CiConfigPathLT applies the LT predicate on the "ci_config_path" field.
+func CiConfigPathLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldCiConfigPath, v))
+}
+
+// CiConfigPathLTE applies the LTE predicate on the "ci_config_path" field.
+func CiConfigPathLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldCiConfigPath, v))
+}
+
+// CiConfigPathContains applies the Contains predicate on the "ci_config_path" field.
+func CiConfigPathContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldCiConfigPath, v))
+}
+
+// CiConfigPathHasPrefix applies the HasPrefix predicate on the "ci_config_path" field.
+func CiConfigPathHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldCiConfigPath, v))
+}
+
+// CiConfigPathHasSuffix applies the HasSuffix predicate on the "ci_config_path" field.
+func CiConfigPathHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldCiConfigPath, v))
+}
+
+// CiConfigPathIsNil applies the IsNil predicate on the "ci_config_path" field.
+func CiConfigPathIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldCiConfigPath))
+}
+
+// CiConfigPathNotNil applies the NotNil predicate on the "ci_config_path" field.
+func CiConfigPathNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldCiConfigPath))
+}
+
+// CiConfigPathEqualFold applies the EqualFold predicate on the "ci_config_path" field.
+func CiConfigPathEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldCiConfigPath, v))
+}
+
+// CiConfigPathContainsFold applies the ContainsFold predicate on the "ci_config_path" field.
+func CiConfigPathContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldCiConfigPath, v))
+}
+
+// PipelineIDEQ applies the EQ predicate on the "pipeline_id" field.
+func PipelineIDEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldPipelineID, v))
+}
+
+// PipelineIDNEQ applies the NEQ predicate on the "pipeline_id" field.
+func PipelineIDNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldPipelineID, v))
+}
+
+// PipelineIDIn applies the In predicate on the "pipeline_id" field.
+func PipelineIDIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldPipelineID, vs...))
+}
+
+// PipelineIDNotIn applies the NotIn predicate on the "pipeline_id" field.
+func PipelineIDNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldPipelineID, vs...))
+}
+
+// PipelineIDGT applies the GT predicate on the "pipeline_id" field.
+func PipelineIDGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldPipelineID, v))
+}
+
+// PipelineIDGTE applies the GTE predicate on the "pipeline_id" field.
+func PipelineIDGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldPipelineID, v))
+}
+
+// PipelineIDLT applies the LT predicate on the "pipeline_id" field.
+func PipelineIDLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldPipelineID, v))
+}
+
+// PipelineIDLTE applies the LTE predicate on the "pipeline_id" field.
+func PipelineIDLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldPipelineID, v))
+}
+
+// PipelineIDContains applies the Contains predicate on the "pipeline_id" field.
+func PipelineIDContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldPipelineID, v))
+}
+
+// PipelineIDHasPrefix applies the HasPrefix predicate on the "pipeline_id" field.
+func PipelineIDHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldPipelineID, v))
+}
+
+// PipelineIDHasSuffix applies the HasSuffix predicate on the "pipeline_id" field.
+func PipelineIDHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldPipelineID, v))
+}
+
+// PipelineIDIsNil applies the IsNil predicate on the "pipeline_id" field.
+func PipelineIDIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldPipelineID))
+}
+
+// PipelineIDNotNil applies the NotNil predicate on the "pipeline_id" field.
+func PipelineIDNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldPipelineID))
+}
+
+// PipelineIDEqualFold applies the EqualFold predicate on the "pipeline_id" field.
+func PipelineIDEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldPipelineID, v))
+}
+
+// PipelineIDContainsFold applies the ContainsFold predicate on the "pipeline_id" field.
+func PipelineIDContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldPipelineID, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldJobID, v))
+}
+
+// JobIDContains applies the Contains predicate on the "job_id" field.
+func JobIDContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldJobID, v))
+}
+
+// JobIDHasPrefix applies the HasPrefix predicate on the "job_id" field.
+func JobIDHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldJobID, v))
+}
+
+// JobIDHasSuffix applies the HasSuffix predicate on the "job_id" field.
+func JobIDHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldJobID, v))
+}
+
+// JobIDIsNil applies the IsNil predicate on the "job_id" field.
+func JobIDIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldJobID))
+}
+
+// JobIDNotNil applies the NotNil predicate on the "job_id" field.
+func JobIDNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldJobID))
+}
+
+// JobIDEqualFold applies the EqualFold predicate on the "job_id" field.
+func JobIDEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldJobID, v))
+}
+
+// JobIDContainsFold applies the ContainsFold predicate on the "job_id" field.
+func JobIDContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldJobID, v))
+}
+
+// JobImageEQ applies the EQ predicate on the "job_image" field.
+func JobImageEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobImage, v))
+}
+
+// JobImageNEQ applies the NEQ predicate on the "job_image" field.
+func JobImageNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldJobImage, v))
+}
+
+// JobImageIn applies the In predicate on the "job_image" field.
+func JobImageIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldJobImage, vs...))
+}
+
+// JobImageNotIn applies the NotIn predicate on the "job_image" field.
+func JobImageNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldJobImage, vs...))
+}
+
+// JobImageGT applies the GT predicate on the "job_image" field.
+func JobImageGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldJobImage, v))
+}
+
+// JobImageGTE applies the GTE predicate on the "job_image" field.
+func JobImageGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldJobImage, v))
+}
+
+// JobImageLT applies the LT predicate on the "job_image" field.
+func JobImageLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldJobImage, v))
+}
+
+// JobImageLTE applies the LTE predicate on the "job_image" field.
+func JobImageLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldJobImage, v))
+}
+
+// JobImageContains applies the Contains predicate on the "job_image" field.
+func JobImageContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldJobImage, v))
+}
+
+// JobImageHasPrefix applies the HasPrefix predicate on the "job_image" field.
+func JobImageHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldJobImage, v))
+}
+
+// JobImageHasSuffix applies the HasSuffix predicate on the "job_image" field.
+func JobImageHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldJobImage, v))
+}
+
+// JobImageIsNil applies the IsNil predicate on the "job_image" field.
+func JobImageIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldJobImage))
+}
+
+// JobImageNotNil applies the NotNil predicate on the "job_image" field.
+func JobImageNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldJobImage))
+}
+
+// JobImageEqualFold applies the EqualFold predicate on the "job_image" field.
+func JobImageEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldJobImage, v))
+}
+
+// JobImageContainsFold applies the ContainsFold predicate on the "job_image" field.
+func JobImageContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldJobImage, v))
+}
+
+// JobNameEQ applies the EQ predicate on the "job_name" field.
+func JobNameEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobName, v))
+}
+
+// JobNameNEQ applies the NEQ predicate on the "job_name" field.
+func JobNameNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldJobName, v))
+}
+
+// JobNameIn applies the In predicate on the "job_name" field.
+func JobNameIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldJobName, vs...))
+}
+
+// JobNameNotIn applies the NotIn predicate on the "job_name" field.
+func JobNameNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldJobName, vs...))
+}
+
+// JobNameGT applies the GT predicate on the "job_name" field.
+func JobNameGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldJobName, v))
+}
+
+// JobNameGTE applies the GTE predicate on the "job_name" field.
+func JobNameGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldJobName, v))
+}
+
+// JobNameLT applies the LT predicate on the "job_name" field.
+func JobNameLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldJobName, v))
+}
+
+// JobNameLTE applies the LTE predicate on the "job_name" field.
+func JobNameLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldJobName, v))
+}
+
+// JobNameContains applies the Contains predicate on the "job_name" field.
+func JobNameContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldJobName, v))
+}
+
+// JobNameHasPrefix applies the HasPrefix predicate on the "job_name" field.
+func JobNameHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldJobName, v))
+}
+
+// JobNameHasSuffix applies the HasSuffix predicate on the "job_name" field.
+func JobNameHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldJobName, v))
+}
+
+// JobNameIsNil applies the IsNil predicate on the "job_name" field.
+func JobNameIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldJobName))
+}
+
+// JobNameNotNil applies the NotNil predicate on the "job_name" field.
+func JobNameNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldJobName))
+}
+
+// JobNameEqualFold applies the EqualFold predicate on the "job_name" field.
+func JobNameEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldJobName, v))
+}
+
+// JobNameContainsFold applies the ContainsFold predicate on the "job_name" field.
+func JobNameContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldJobName, v))
+}
+
+// JobStageEQ applies the EQ predicate on the "job_stage" field.
+func JobStageEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobStage, v))
+}
+
+// JobStageNEQ applies the NEQ predicate on the "job_stage" field.
+func JobStageNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldJobStage, v))
+}
+
+// JobStageIn applies the In predicate on the "job_stage" field.
+func JobStageIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldJobStage, vs...))
+}
+
+// JobStageNotIn applies the NotIn predicate on the "job_stage" field.
+func JobStageNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldJobStage, vs...))
+}
+
+// JobStageGT applies the GT predicate on the "job_stage" field.
+func JobStageGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldJobStage, v))
+}
+
+// JobStageGTE applies the GTE predicate on the "job_stage" field.
+func JobStageGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldJobStage, v))
+}
+
+// JobStageLT applies the LT predicate on the "job_stage" field.
+func JobStageLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldJobStage, v))
+}
+
+// JobStageLTE applies the LTE predicate on the "job_stage" field.
+func JobStageLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldJobStage, v))
+}
+
+// JobStageContains applies the Contains predicate on the "job_stage" field.
+func JobStageContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldJobStage, v))
+}
+
+// JobStageHasPrefix applies the HasPrefix predicate on the "job_stage" field.
+func JobStageHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldJobStage, v))
+}
+
+// JobStageHasSuffix applies the HasSuffix predicate on the "job_stage" field.
+func JobStageHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldJobStage, v))
+}
+
+// JobStageIsNil applies the IsNil predicate on the "job_stage" field.
+func JobStageIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldJobStage))
+}
+
+// JobStageNotNil applies the NotNil predicate on the "job_stage" field.
+func JobStageNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldJobStage))
+}
+
+// JobStageEqualFold applies the EqualFold predicate on the "job_stage" field.
+func JobStageEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldJobStage, v))
+}
+
+// JobStageContainsFold applies the ContainsFold predicate on the "job_stage" field.
+func JobStageContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldJobStage, v))
+}
+
+// JobURLEQ applies the EQ predicate on the "job_url" field.
+func JobURLEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldJobURL, v))
+}
+
+// JobURLNEQ applies the NEQ predicate on the "job_url" field.
+func JobURLNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldJobURL, v))
+}
+
+// JobURLIn applies the In predicate on the "job_url" field.
+func JobURLIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldJobURL, vs...))
+}
+
+// JobURLNotIn applies the NotIn predicate on the "job_url" field.
+func JobURLNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldJobURL, vs...))
+}
+
+// JobURLGT applies the GT predicate on the "job_url" field.
+func JobURLGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldJobURL, v))
+}
+
+// JobURLGTE applies the GTE predicate on the "job_url" field.
+func JobURLGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldJobURL, v))
+}
+
+// JobURLLT applies the LT predicate on the "job_url" field.
+func JobURLLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldJobURL, v))
+}
+
+// JobURLLTE applies the LTE predicate on the "job_url" field.
+func JobURLLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldJobURL, v))
+}
+
+// JobURLContains applies the Contains predicate on the "job_url" field.
+func JobURLContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldJobURL, v))
+}
+
+// JobURLHasPrefix applies the HasPrefix predicate on the "job_url" field.
+func JobURLHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldJobURL, v))
+}
+
+// JobURLHasSuffix applies the HasSuffix predicate on the "job_url" field.
+func JobURLHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldJobURL, v))
+}
+
+// JobURLIsNil applies the IsNil predicate on the "job_url" field.
+func JobURLIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldJobURL))
+}
+
+// JobURLNotNil applies the NotNil predicate on the "job_url" field.
+func JobURLNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldJobURL))
+}
+
+// JobURLEqualFold applies the EqualFold predicate on the "job_url" field.
+func JobURLEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldJobURL, v))
+}
+
+// JobURLContainsFold applies the ContainsFold predicate on the "job_url" field.
+func JobURLContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldJobURL, v))
+}
+
+// PipelineURLEQ applies the EQ predicate on the "pipeline_url" field.
+func PipelineURLEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldPipelineURL, v))
+}
+
+// PipelineURLNEQ applies the NEQ predicate on the "pipeline_url" field.
+func PipelineURLNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldPipelineURL, v))
+}
+
+// PipelineURLIn applies the In predicate on the "pipeline_url" field.
+func PipelineURLIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldPipelineURL, vs...))
+}
+
+// PipelineURLNotIn applies the NotIn predicate on the "pipeline_url" field.
+func PipelineURLNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldPipelineURL, vs...))
+}
+
+// PipelineURLGT applies the GT predicate on the "pipeline_url" field.
+func PipelineURLGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldPipelineURL, v))
+}
+
+// PipelineURLGTE applies the GTE predicate on the "pipeline_url" field.
+func PipelineURLGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldPipelineURL, v))
+}
+
+// PipelineURLLT applies the LT predicate on the "pipeline_url" field.
+func PipelineURLLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldPipelineURL, v))
+}
+
+// PipelineURLLTE applies the LTE predicate on the "pipeline_url" field.
+func PipelineURLLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldPipelineURL, v))
+}
+
+// PipelineURLContains applies the Contains predicate on the "pipeline_url" field.
+func PipelineURLContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldPipelineURL, v))
+}
+
+// PipelineURLHasPrefix applies the HasPrefix predicate on the "pipeline_url" field.
+func PipelineURLHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldPipelineURL, v))
+}
+
+// PipelineURLHasSuffix applies the HasSuffix predicate on the "pipeline_url" field.
+func PipelineURLHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldPipelineURL, v))
+}
+
+// PipelineURLIsNil applies the IsNil predicate on the "pipeline_url" field.
+func PipelineURLIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldPipelineURL))
+}
+
+// PipelineURLNotNil applies the NotNil predicate on the "pipeline_url" field.
+func PipelineURLNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldPipelineURL))
+}
+
+// PipelineURLEqualFold applies the EqualFold predicate on the "pipeline_url" field.
+func PipelineURLEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldPipelineURL, v))
+}
+
+// PipelineURLContainsFold applies the ContainsFold predicate on the "pipeline_url" field.
+func PipelineURLContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldPipelineURL, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDContains applies the Contains predicate on the "project_id" field.
+func ProjectIDContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldProjectID, v))
+}
+
+// ProjectIDHasPrefix applies the HasPrefix predicate on the "project_id" field.
+func ProjectIDHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldProjectID, v))
+}
+
+// ProjectIDHasSuffix applies the HasSuffix predicate on the "project_id" field.
+func ProjectIDHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldProjectID, v))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldProjectID))
+}
+
+// ProjectIDEqualFold applies the EqualFold predicate on the "project_id" field.
+func ProjectIDEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldProjectID, v))
+}
+
+// ProjectIDContainsFold applies the ContainsFold predicate on the "project_id" field.
+func ProjectIDContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldProjectID, v))
+}
+
+// ProjectURLEQ applies the EQ predicate on the "project_url" field.
+func ProjectURLEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldProjectURL, v))
+}
+
+// ProjectURLNEQ applies the NEQ predicate on the "project_url" field.
+func ProjectURLNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldProjectURL, v))
+}
+
+// ProjectURLIn applies the In predicate on the "project_url" field.
+func ProjectURLIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldProjectURL, vs...))
+}
+
+// ProjectURLNotIn applies the NotIn predicate on the "project_url" field.
+func ProjectURLNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldProjectURL, vs...))
+}
+
+// ProjectURLGT applies the GT predicate on the "project_url" field.
+func ProjectURLGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldProjectURL, v))
+}
+
+// ProjectURLGTE applies the GTE predicate on the "project_url" field.
+func ProjectURLGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldProjectURL, v))
+}
+
+// ProjectURLLT applies the LT predicate on the "project_url" field.
+func ProjectURLLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldProjectURL, v))
+}
+
+// ProjectURLLTE applies the LTE predicate on the "project_url" field.
+func ProjectURLLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldProjectURL, v))
+}
+
+// ProjectURLContains applies the Contains predicate on the "project_url" field.
+func ProjectURLContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldProjectURL, v))
+}
+
+// ProjectURLHasPrefix applies the HasPrefix predicate on the "project_url" field.
+func ProjectURLHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldProjectURL, v))
+}
+
+// ProjectURLHasSuffix applies the HasSuffix predicate on the "project_url" field.
+func ProjectURLHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldProjectURL, v))
+}
+
+// ProjectURLIsNil applies the IsNil predicate on the "project_url" field.
+func ProjectURLIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldProjectURL))
+}
+
+// ProjectURLNotNil applies the NotNil predicate on the "project_url" field.
+func ProjectURLNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldProjectURL))
+}
+
+// ProjectURLEqualFold applies the EqualFold predicate on the "project_url" field.
+func ProjectURLEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldProjectURL, v))
+}
+
+// ProjectURLContainsFold applies the ContainsFold predicate on the "project_url" field.
+func ProjectURLContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldProjectURL, v))
+}
+
+// RunnerIDEQ applies the EQ predicate on the "runner_id" field.
+func RunnerIDEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldRunnerID, v))
+}
+
+// RunnerIDNEQ applies the NEQ predicate on the "runner_id" field.
+func RunnerIDNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldRunnerID, v))
+}
+
+// RunnerIDIn applies the In predicate on the "runner_id" field.
+func RunnerIDIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldRunnerID, vs...))
+}
+
+// RunnerIDNotIn applies the NotIn predicate on the "runner_id" field.
+func RunnerIDNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldRunnerID, vs...))
+}
+
+// RunnerIDGT applies the GT predicate on the "runner_id" field.
+func RunnerIDGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldRunnerID, v))
+}
+
+// RunnerIDGTE applies the GTE predicate on the "runner_id" field.
+func RunnerIDGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldRunnerID, v))
+}
+
+// RunnerIDLT applies the LT predicate on the "runner_id" field.
+func RunnerIDLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldRunnerID, v))
+}
+
+// RunnerIDLTE applies the LTE predicate on the "runner_id" field.
+func RunnerIDLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldRunnerID, v))
+}
+
+// RunnerIDContains applies the Contains predicate on the "runner_id" field.
+func RunnerIDContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldRunnerID, v))
+}
+
+// RunnerIDHasPrefix applies the HasPrefix predicate on the "runner_id" field.
+func RunnerIDHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldRunnerID, v))
+}
+
+// RunnerIDHasSuffix applies the HasSuffix predicate on the "runner_id" field.
+func RunnerIDHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldRunnerID, v))
+}
+
+// RunnerIDIsNil applies the IsNil predicate on the "runner_id" field.
+func RunnerIDIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldRunnerID))
+}
+
+// RunnerIDNotNil applies the NotNil predicate on the "runner_id" field.
+func RunnerIDNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldRunnerID))
+}
+
+// RunnerIDEqualFold applies the EqualFold predicate on the "runner_id" field.
+func RunnerIDEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldRunnerID, v))
+}
+
+// RunnerIDContainsFold applies the ContainsFold predicate on the "runner_id" field.
+func RunnerIDContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldRunnerID, v))
+}
+
+// CiHostEQ applies the EQ predicate on the "ci_host" field.
+func CiHostEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldCiHost, v))
+}
+
+// CiHostNEQ applies the NEQ predicate on the "ci_host" field.
+func CiHostNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldCiHost, v))
+}
+
+// CiHostIn applies the In predicate on the "ci_host" field.
+func CiHostIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldCiHost, vs...))
+}
+
+// CiHostNotIn applies the NotIn predicate on the "ci_host" field.
+func CiHostNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldCiHost, vs...))
+}
+
+// CiHostGT applies the GT predicate on the "ci_host" field.
+func CiHostGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldCiHost, v))
+}
+
+// CiHostGTE applies the GTE predicate on the "ci_host" field.
+func CiHostGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldCiHost, v))
+}
+
+// CiHostLT applies the LT predicate on the "ci_host" field.
+func CiHostLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldCiHost, v))
+}
+
+// CiHostLTE applies the LTE predicate on the "ci_host" field.
+func CiHostLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldCiHost, v))
+}
+
+// CiHostContains applies the Contains predicate on the "ci_host" field.
+func CiHostContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldCiHost, v))
+}
+
+// CiHostHasPrefix applies the HasPrefix predicate on the "ci_host" field.
+func CiHostHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldCiHost, v))
+}
+
+// CiHostHasSuffix applies the HasSuffix predicate on the "ci_host" field.
+func CiHostHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldCiHost, v))
+}
+
+// CiHostIsNil applies the IsNil predicate on the "ci_host" field.
+func CiHostIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldCiHost))
+}
+
+// CiHostNotNil applies the NotNil predicate on the "ci_host" field.
+func CiHostNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldCiHost))
+}
+
+// CiHostEqualFold applies the EqualFold predicate on the "ci_host" field.
+func CiHostEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldCiHost, v))
+}
+
+// CiHostContainsFold applies the ContainsFold predicate on the "ci_host" field.
+func CiHostContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldCiHost, v))
+}
+
+// CiServerURLEQ applies the EQ predicate on the "ci_server_url" field.
+func CiServerURLEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEQ(FieldCiServerURL, v))
+}
+
+// CiServerURLNEQ applies the NEQ predicate on the "ci_server_url" field.
+func CiServerURLNEQ(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNEQ(FieldCiServerURL, v))
+}
+
+// CiServerURLIn applies the In predicate on the "ci_server_url" field.
+func CiServerURLIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIn(FieldCiServerURL, vs...))
+}
+
+// CiServerURLNotIn applies the NotIn predicate on the "ci_server_url" field.
+func CiServerURLNotIn(vs ...string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotIn(FieldCiServerURL, vs...))
+}
+
+// CiServerURLGT applies the GT predicate on the "ci_server_url" field.
+func CiServerURLGT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGT(FieldCiServerURL, v))
+}
+
+// CiServerURLGTE applies the GTE predicate on the "ci_server_url" field.
+func CiServerURLGTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldGTE(FieldCiServerURL, v))
+}
+
+// CiServerURLLT applies the LT predicate on the "ci_server_url" field.
+func CiServerURLLT(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLT(FieldCiServerURL, v))
+}
+
+// CiServerURLLTE applies the LTE predicate on the "ci_server_url" field.
+func CiServerURLLTE(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldLTE(FieldCiServerURL, v))
+}
+
+// CiServerURLContains applies the Contains predicate on the "ci_server_url" field.
+func CiServerURLContains(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContains(FieldCiServerURL, v))
+}
+
+// CiServerURLHasPrefix applies the HasPrefix predicate on the "ci_server_url" field.
+func CiServerURLHasPrefix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasPrefix(FieldCiServerURL, v))
+}
+
+// CiServerURLHasSuffix applies the HasSuffix predicate on the "ci_server_url" field.
+func CiServerURLHasSuffix(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldHasSuffix(FieldCiServerURL, v))
+}
+
+// CiServerURLIsNil applies the IsNil predicate on the "ci_server_url" field.
+func CiServerURLIsNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldIsNull(FieldCiServerURL))
+}
+
+// CiServerURLNotNil applies the NotNil predicate on the "ci_server_url" field.
+func CiServerURLNotNil() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldNotNull(FieldCiServerURL))
+}
+
+// CiServerURLEqualFold applies the EqualFold predicate on the "ci_server_url" field.
+func CiServerURLEqualFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldEqualFold(FieldCiServerURL, v))
+}
+
+// CiServerURLContainsFold applies the ContainsFold predicate on the "ci_server_url" field.
+func CiServerURLContainsFold(v string) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.FieldContainsFold(FieldCiServerURL, v))
+}
+
+// HasAttestation applies the HasEdge predicate on the "attestation" edge.
+func HasAttestation() predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AttestationTable, AttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttestationWith applies the HasEdge predicate on the "attestation" edge with a given conditions (other predicates).
+func HasAttestationWith(preds ...predicate.Attestation) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(func(s *sql.Selector) {
+		step := newAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GitlabAttestation) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GitlabAttestation) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GitlabAttestation) predicate.GitlabAttestation {
+	return predicate.GitlabAttestation(sql.NotPredicates(p))
+}
@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+)
+
+// GitlabAttestationCreate is the builder for creating a GitlabAttestation entity.
+type GitlabAttestationCreate struct {
+	config
+	mutation *GitlabAttestationMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *GitlabAttestationCreate) SetTenant(v string) *GitlabAttestationCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableTenant(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetCiConfigPath sets the "ci_config_path" field.
+func (_c *GitlabAttestationCreate) SetCiConfigPath(v string) *GitlabAttestationCreate {
+	_c.mutation.SetCiConfigPath(v)
+	return _c
+}
+
+// SetNillableCiConfigPath sets the "ci_config_path" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableCiConfigPath(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetCiConfigPath(*v)
+	}
+	return _c
+}
+
+// SetPipelineID sets the "pipeline_id" field.
+func (_c *GitlabAttestationCreate) SetPipelineID(v string) *GitlabAttestationCreate {
+	_c.mutation.SetPipelineID(v)
+	return _c
+}
+
+// SetNillablePipelineID sets the "pipeline_id" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillablePipelineID(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetPipelineID(*v)
+	}
+	return _c
+}
+
+// SetJobID sets the "job_id" field.
+func (_c *GitlabAttestationCreate) SetJobID(v string) *GitlabAttestationCreate {
+	_c.mutation.SetJobID(v)
+	return _c
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableJobID(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetJobID(*v)
+	}
+	return _c
+}
+
+// SetJobImage sets the "job_image" field.
+func (_c *GitlabAttestationCreate) SetJobImage(v string) *GitlabAttestationCreate {
+	_c.mutation.SetJobImage(v)
+	return _c
+}
+
+// SetNillableJobImage sets the "job_image" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableJobImage(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetJobImage(*v)
+	}
+	return _c
+}
+
+// SetJobName sets the "job_name" field.
+func (_c *GitlabAttestationCreate) SetJobName(v string) *GitlabAttestationCreate {
+	_c.mutation.SetJobName(v)
+	return _c
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableJobName(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetJobName(*v)
+	}
+	return _c
+}
+
+// SetJobStage sets the "job_stage" field.
+func (_c *GitlabAttestationCreate) SetJobStage(v string) *GitlabAttestationCreate {
+	_c.mutation.SetJobStage(v)
+	return _c
+}
+
+// SetNillableJobStage sets the "job_stage" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableJobStage(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetJobStage(*v)
+	}
+	return _c
+}
+
+// SetJobURL sets the "job_url" field.
+func (_c *GitlabAttestationCreate) SetJobURL(v string) *GitlabAttestationCreate {
+	_c.mutation.SetJobURL(v)
+	return _c
+}
+
+// SetNillableJobURL sets the "job_url" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableJobURL(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetJobURL(*v)
+	}
+	return _c
+}
+
+// SetPipelineURL sets the "pipeline_url" field.
+func (_c *GitlabAttestationCreate) SetPipelineURL(v string) *GitlabAttestationCreate {
+	_c.mutation.SetPipelineURL(v)
+	return _c
+}
+
+// SetNillablePipelineURL sets the "pipeline_url" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillablePipelineURL(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetPipelineURL(*v)
+	}
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *GitlabAttestationCreate) SetProjectID(v string) *GitlabAttestationCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableProjectID(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetProjectID(*v)
+	}
+	return _c
+}
+
+// SetProjectURL sets the "project_url" field.
+func (_c *GitlabAttestationCreate) SetProjectURL(v string) *GitlabAttestationCreate {
+	_c.mutation.SetProjectURL(v)
+	return _c
+}
+
+// SetNillableProjectURL sets the "project_url" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableProjectURL(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetProjectURL(*v)
+	}
+	return _c
+}
+
+// SetRunnerID sets the "runner_id" field.
+func (_c *GitlabAttestationCreate) SetRunnerID(v string) *GitlabAttestationCreate {
+	_c.mutation.SetRunnerID(v)
+	return _c
+}
+
+// SetNillableRunnerID sets the "runner_id" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableRunnerID(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetRunnerID(*v)
+	}
+	return _c
+}
+
+// SetCiHost sets the "ci_host" field.
+func (_c *GitlabAttestationCreate) SetCiHost(v string) *GitlabAttestationCreate {
+	_c.mutation.SetCiHost(v)
+	return _c
+}
+
+// SetNillableCiHost sets the "ci_host" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableCiHost(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetCiHost(*v)
+	}
+	return _c
+}
+
+// SetCiServerURL sets the "ci_server_url" field.
+func (_c *GitlabAttestationCreate) SetCiServerURL(v string) *GitlabAttestationCreate {
+	_c.mutation.SetCiServerURL(v)
+	return _c
+}
+
+// SetNillableCiServerURL sets the "ci_server_url" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableCiServerURL(v *string) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetCiServerURL(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GitlabAttestationCreate) SetID(v uuid.UUID) *GitlabAttestationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GitlabAttestationCreate) SetNillableID(v *uuid.UUID) *GitlabAttestationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_c *GitlabAttestationCreate) SetAttestationID(id uuid.UUID) *GitlabAttestationCreate {
+	_c.mutation.SetAttestationID(id)
+	return _c
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_c *GitlabAttestationCreate) SetAttestation(v *Attestation) *GitlabAttestationCreate {
+	return _c.SetAttestationID(v.ID)
+}
+
+// Mutation returns the GitlabAttestationMutation object of the builder.
+func (_c *GitlabAttestationCreate) Mutation() *GitlabAttestationMutation {
+	return _c.mutation
+}
+
+// Save creates the GitlabAttestation in the database.
+func (_c *GitlabAttestationCreate) Save(ctx context.Context) (*GitlabAttestation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GitlabAttestationCreate) SaveX(ctx context.Context) *GitlabAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GitlabAttestationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GitlabAttestationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GitlabAttestationCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := gitlabattestation.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if gitlabattestation.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized gitlabattestation.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := gitlabattestation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GitlabAttestationCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "GitlabAttestation.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := gitlabattestation.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "GitlabAttestation.tenant": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AttestationIDs()) == 0 {
+		return &ValidationError{Name: "attestation", err: errors.New(`ent: missing required edge "GitlabAttestation.attestation"`)}
+	}
+	return nil
+}
+
+func (_c *GitlabAttestationCreate) sqlSave(ctx context.Context) (*GitlabAttestation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GitlabAttestationCreate) createSpec() (*GitlabAttestation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GitlabAttestation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gitlabattestation.Table, sqlgraph.NewFieldSpec(gitlabattestation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(gitlabattestation.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.CiConfigPath(); ok {
+		_spec.SetField(gitlabattestation.FieldCiConfigPath, field.TypeString, value)
+		_node.CiConfigPath = value
+	}
+	if value, ok := _c.mutation.PipelineID(); ok {
+		_spec.SetField(gitlabattestation.FieldPipelineID, field.TypeString, value)
+		_node.PipelineID = value
+	}
+	if value, ok := _c.mutation.JobID(); ok {
+		_spec.SetField(gitlabattestation.FieldJobID, field.TypeString, value)
+		_node.JobID = value
+	}
+	if value, ok := _c.mutation.JobImage(); ok {
+		_spec.SetField(gitlabattestation.FieldJobImage, field.TypeString, value)
+		_node.JobImage = value
+	}
+	if value, ok := _c.mutation.JobName(); ok {
+		_spec.SetField(gitlabattestation.FieldJobName, field.TypeString, value)
+		_node.JobName = value
+	}
+	if value, ok := _c.mutation.JobStage(); ok {
+		_spec.SetField(gitlabattestation.FieldJobStage, field.TypeString, value)
+		_node.JobStage = value
+	}
+	if value, ok := _c.mutation.JobURL(); ok {
+		_spec.SetField(gitlabattestation.FieldJobURL, field.TypeString, value)
+		_node.JobURL = value
+	}
+	if value, ok := _c.mutation.PipelineURL(); ok {
+		_spec.SetField(gitlabattestation.FieldPipelineURL, field.TypeString, value)
+		_node.PipelineURL = value
+	}
+	if value, ok := _c.mutation.ProjectID(); ok {
+		_spec.SetField(gitlabattestation.FieldProjectID, field.TypeString, value)
+		_node.ProjectID = value
+	}
+	if value, ok := _c.mutation.ProjectURL(); ok {
+		_spec.SetField(gitlabattestation.FieldProjectURL, field.TypeString, value)
+		_node.ProjectURL = value
+	}
+	if value, ok := _c.mutation.RunnerID(); ok {
+		_spec.SetField(gitlabattestation.FieldRunnerID, field.TypeString, value)
+		_node.RunnerID = value
+	}
+	if value, ok := _c.mutation.CiHost(); ok {
+		_spec.SetField(gitlabattestation.FieldCiHost, field.TypeString, value)
+		_node.CiHost = value
+	}
+	if value, ok := _c.mutation.CiServerURL(); ok {
+		_spec.SetField(gitlabattestation.FieldCiServerURL, field.TypeString, value)
+		_node.CiServerURL = value
+	}
+	if nodes := _c.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitlabattestation.AttestationTable,
+			Columns: []string{gitlabattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.attestation_gitlab_attestation = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GitlabAttestationCreateBulk is the builder for creating many GitlabAttestation entities in bulk.
+type GitlabAttestationCreateBulk struct {
+	config
+	err      error
+	builders []*GitlabAttestationCreate
+}
+
+// Save creates the GitlabAttestation entities in the database.
+func (_c *GitlabAttestationCreateBulk) Save(ctx context.Context) ([]*GitlabAttestation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GitlabAttestation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GitlabAttestationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GitlabAttestationCreateBulk) SaveX(ctx context.Context) []*GitlabAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GitlabAttestationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GitlabAttestationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,967 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// GitlabAttestationUpdate is the builder for updating GitlabAttestation entities.
+type GitlabAttestationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GitlabAttestationMutation
+}
+
+// Where appends a list predicates to the GitlabAttestationUpdate builder.
+func (_u *GitlabAttestationUpdate) Where(ps ...predicate.GitlabAttestation) *GitlabAttestationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCiConfigPath sets the "ci_config_path" field.
+func (_u *GitlabAttestationUpdate) SetCiConfigPath(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetCiConfigPath(v)
+	return _u
+}
+
+// SetNillableCiConfigPath sets the "ci_config_path" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableCiConfigPath(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetCiConfigPath(*v)
+	}
+	return _u
+}
+
+// ClearCiConfigPath clears the value of the "ci_config_path" field.
+func (_u *GitlabAttestationUpdate) ClearCiConfigPath() *GitlabAttestationUpdate {
+	_u.mutation.ClearCiConfigPath()
+	return _u
+}
+
+// SetPipelineID sets the "pipeline_id" field.
+func (_u *GitlabAttestationUpdate) SetPipelineID(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetPipelineID(v)
+	return _u
+}
+
+// SetNillablePipelineID sets the "pipeline_id" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillablePipelineID(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetPipelineID(*v)
+	}
+	return _u
+}
+
+// ClearPipelineID clears the value of the "pipeline_id" field.
+func (_u *GitlabAttestationUpdate) ClearPipelineID() *GitlabAttestationUpdate {
+	_u.mutation.ClearPipelineID()
+	return _u
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *GitlabAttestationUpdate) SetJobID(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableJobID(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (_u *GitlabAttestationUpdate) ClearJobID() *GitlabAttestationUpdate {
+	_u.mutation.ClearJobID()
+	return _u
+}
+
+// SetJobImage sets the "job_image" field.
+func (_u *GitlabAttestationUpdate) SetJobImage(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetJobImage(v)
+	return _u
+}
+
+// SetNillableJobImage sets the "job_image" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableJobImage(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetJobImage(*v)
+	}
+	return _u
+}
+
+// ClearJobImage clears the value of the "job_image" field.
+func (_u *GitlabAttestationUpdate) ClearJobImage() *GitlabAttestationUpdate {
+	_u.mutation.ClearJobImage()
+	return _u
+}
+
+// SetJobName sets the "job_name" field.
+func (_u *GitlabAttestationUpdate) SetJobName(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetJobName(v)
+	return _u
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableJobName(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetJobName(*v)
+	}
+	return _u
+}
+
+// ClearJobName clears the value of the "job_name" field.
+func (_u *GitlabAttestationUpdate) ClearJobName() *GitlabAttestationUpdate {
+	_u.mutation.ClearJobName()
+	return _u
+}
+
+// SetJobStage sets the "job_stage" field.
+func (_u *GitlabAttestationUpdate) SetJobStage(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetJobStage(v)
+	return _u
+}
+
+// SetNillableJobStage sets the "job_stage" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableJobStage(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetJobStage(*v)
+	}
+	return _u
+}
+
+// ClearJobStage clears the value of the "job_stage" field.
+func (_u *GitlabAttestationUpdate) ClearJobStage() *GitlabAttestationUpdate {
+	_u.mutation.ClearJobStage()
+	return _u
+}
+
+// SetJobURL sets the "job_url" field.
+func (_u *GitlabAttestationUpdate) SetJobURL(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetJobURL(v)
+	return _u
+}
+
+// SetNillableJobURL sets the "job_url" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableJobURL(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetJobURL(*v)
+	}
+	return _u
+}
+
+// ClearJobURL clears the value of the "job_url" field.
+func (_u *GitlabAttestationUpdate) ClearJobURL() *GitlabAttestationUpdate {
+	_u.mutation.ClearJobURL()
+	return _u
+}
+
+// SetPipelineURL sets the "pipeline_url" field.
+func (_u *GitlabAttestationUpdate) SetPipelineURL(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetPipelineURL(v)
+	return _u
+}
+
+// SetNillablePipelineURL sets the "pipeline_url" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillablePipelineURL(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetPipelineURL(*v)
+	}
+	return _u
+}
+
+// ClearPipelineURL clears the value of the "pipeline_url" field.
+func (_u *GitlabAttestationUpdate) ClearPipelineURL() *GitlabAttestationUpdate {
+	_u.mutation.ClearPipelineURL()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *GitlabAttestationUpdate) SetProjectID(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableProjectID(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (_u *GitlabAttestationUpdate) ClearProjectID() *GitlabAttestationUpdate {
+	_u.mutation.ClearProjectID()
+	return _u
+}
+
+// SetProjectURL sets the "project_url" field.
+func (_u *GitlabAttestationUpdate) SetProjectURL(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetProjectURL(v)
+	return _u
+}
+
+// SetNillableProjectURL sets the "project_url" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableProjectURL(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetProjectURL(*v)
+	}
+	return _u
+}
+
+// ClearProjectURL clears the value of the "project_url" field.
+func (_u *GitlabAttestationUpdate) ClearProjectURL() *GitlabAttestationUpdate {
+	_u.mutation.ClearProjectURL()
+	return _u
+}
+
+// SetRunnerID sets the "runner_id" field.
+func (_u *GitlabAttestationUpdate) SetRunnerID(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetRunnerID(v)
+	return _u
+}
+
+// SetNillableRunnerID sets the "runner_id" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableRunnerID(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetRunnerID(*v)
+	}
+	return _u
+}
+
+// ClearRunnerID clears the value of the "runner_id" field.
+func (_u *GitlabAttestationUpdate) ClearRunnerID() *GitlabAttestationUpdate {
+	_u.mutation.ClearRunnerID()
+	return _u
+}
+
+// SetCiHost sets the "ci_host" field.
+func (_u *GitlabAttestationUpdate) SetCiHost(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetCiHost(v)
+	return _u
+}
+
+// SetNillableCiHost sets the "ci_host" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableCiHost(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetCiHost(*v)
+	}
+	return _u
+}
+
+// ClearCiHost clears the value of the "ci_host" field.
+func (_u *GitlabAttestationUpdate) ClearCiHost() *GitlabAttestationUpdate {
+	_u.mutation.ClearCiHost()
+	return _u
+}
+
+// SetCiServerURL sets the "ci_server_url" field.
+func (_u *GitlabAttestationUpdate) SetCiServerURL(v string) *GitlabAttestationUpdate {
+	_u.mutation.SetCiServerURL(v)
+	return _u
+}
+
+// SetNillableCiServerURL sets the "ci_server_url" field if the given value is not nil.
+func (_u *GitlabAttestationUpdate) SetNillableCiServerURL(v *string) *GitlabAttestationUpdate {
+	if v != nil {
+		_u.SetCiServerURL(*v)
+	}
+	return _u
+}
+
+// ClearCiServerURL clears the value of the "ci_server_url" field.
+func (_u *GitlabAttestationUpdate) ClearCiServerURL() *GitlabAttestationUpdate {
+	_u.mutation.ClearCiServerURL()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *GitlabAttestationUpdate) SetAttestationID(id uuid.UUID) *GitlabAttestationUpdate {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *GitlabAttestationUpdate) SetAttestation(v *Attestation) *GitlabAttestationUpdate {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the GitlabAttestationMutation object of the builder.
+func (_u *GitlabAttestationUpdate) Mutation() *GitlabAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *GitlabAttestationUpdate) ClearAttestation() *GitlabAttestationUpdate {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GitlabAttestationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GitlabAttestationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GitlabAttestationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GitlabAttestationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GitlabAttestationUpdate) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GitlabAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *GitlabAttestationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gitlabattestation.Table, gitlabattestation.Columns, sqlgraph.NewFieldSpec(gitlabattestation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CiConfigPath(); ok {
+		_spec.SetField(gitlabattestation.FieldCiConfigPath, field.TypeString, value)
+	}
+	if _u.mutation.CiConfigPathCleared() {
+		_spec.ClearField(gitlabattestation.FieldCiConfigPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineID(); ok {
+		_spec.SetField(gitlabattestation.FieldPipelineID, field.TypeString, value)
+	}
+	if _u.mutation.PipelineIDCleared() {
+		_spec.ClearField(gitlabattestation.FieldPipelineID, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobID(); ok {
+		_spec.SetField(gitlabattestation.FieldJobID, field.TypeString, value)
+	}
+	if _u.mutation.JobIDCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobID, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobImage(); ok {
+		_spec.SetField(gitlabattestation.FieldJobImage, field.TypeString, value)
+	}
+	if _u.mutation.JobImageCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobImage, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobName(); ok {
+		_spec.SetField(gitlabattestation.FieldJobName, field.TypeString, value)
+	}
+	if _u.mutation.JobNameCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobName, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobStage(); ok {
+		_spec.SetField(gitlabattestation.FieldJobStage, field.TypeString, value)
+	}
+	if _u.mutation.JobStageCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobStage, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobURL(); ok {
+		_spec.SetField(gitlabattestation.FieldJobURL, field.TypeString, value)
+	}
+	if _u.mutation.JobURLCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineURL(); ok {
+		_spec.SetField(gitlabattestation.FieldPipelineURL, field.TypeString, value)
+	}
+	if _u.mutation.PipelineURLCleared() {
+		_spec.ClearField(gitlabattestation.FieldPipelineURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProjectID(); ok {
+		_spec.SetField(gitlabattestation.FieldProjectID, field.TypeString, value)
+	}
+	if _u.mutation.ProjectIDCleared() {
+		_spec.ClearField(gitlabattestation.FieldProjectID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProjectURL(); ok {
+		_spec.SetField(gitlabattestation.FieldProjectURL, field.TypeString, value)
+	}
+	if _u.mutation.ProjectURLCleared() {
+		_spec.ClearField(gitlabattestation.FieldProjectURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerID(); ok {
+		_spec.SetField(gitlabattestation.FieldRunnerID, field.TypeString, value)
+	}
+	if _u.mutation.RunnerIDCleared() {
+		_spec.ClearField(gitlabattestation.FieldRunnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CiHost(); ok {
+		_spec.SetField(gitlabattestation.FieldCiHost, field.TypeString, value)
+	}
+	if _u.mutation.CiHostCleared() {
+		_spec.ClearField(gitlabattestation.FieldCiHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.CiServerURL(); ok {
+		_spec.SetField(gitlabattestation.FieldCiServerURL, field.TypeString, value)
+	}
+	if _u.mutation.CiServerURLCleared() {
+		_spec.ClearField(gitlabattestation.FieldCiServerURL, field.TypeString)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitlabattestation.AttestationTable,
+			Columns: []string{gitlabattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitlabattestation.AttestationTable,
+			Columns: []string{gitlabattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gitlabattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GitlabAttestationUpdateOne is the builder for updating a single GitlabAttestation entity.
+type GitlabAttestationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GitlabAttestationMutation
+}
+
+// SetCiConfigPath sets the "ci_config_path" field.
+func (_u *GitlabAttestationUpdateOne) SetCiConfigPath(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetCiConfigPath(v)
+	return _u
+}
+
+// SetNillableCiConfigPath sets the "ci_config_path" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableCiConfigPath(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetCiConfigPath(*v)
+	}
+	return _u
+}
+
+// ClearCiConfigPath clears the value of the "ci_config_path" field.
+func (_u *GitlabAttestationUpdateOne) ClearCiConfigPath() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearCiConfigPath()
+	return _u
+}
+
+// SetPipelineID sets the "pipeline_id" field.
+func (_u *GitlabAttestationUpdateOne) SetPipelineID(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetPipelineID(v)
+	return _u
+}
+
+// SetNillablePipelineID sets the "pipeline_id" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillablePipelineID(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetPipelineID(*v)
+	}
+	return _u
+}
+
+// ClearPipelineID clears the value of the "pipeline_id" field.
+func (_u *GitlabAttestationUpdateOne) ClearPipelineID() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearPipelineID()
+	return _u
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *GitlabAttestationUpdateOne) SetJobID(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableJobID(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (_u *GitlabAttestationUpdateOne) ClearJobID() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearJobID()
+	return _u
+}
+
+// SetJobImage sets the "job_image" field.
+func (_u *GitlabAttestationUpdateOne) SetJobImage(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetJobImage(v)
+	return _u
+}
+
+// SetNillableJobImage sets the "job_image" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableJobImage(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetJobImage(*v)
+	}
+	return _u
+}
+
+// ClearJobImage clears the value of the "job_image" field.
+func (_u *GitlabAttestationUpdateOne) ClearJobImage() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearJobImage()
+	return _u
+}
+
+// SetJobName sets the "job_name" field.
+func (_u *GitlabAttestationUpdateOne) SetJobName(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetJobName(v)
+	return _u
+}
+
+// SetNillableJobName sets the "job_name" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableJobName(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetJobName(*v)
+	}
+	return _u
+}
+
+// ClearJobName clears the value of the "job_name" field.
+func (_u *GitlabAttestationUpdateOne) ClearJobName() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearJobName()
+	return _u
+}
+
+// SetJobStage sets the "job_stage" field.
+func (_u *GitlabAttestationUpdateOne) SetJobStage(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetJobStage(v)
+	return _u
+}
+
+// SetNillableJobStage sets the "job_stage" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableJobStage(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetJobStage(*v)
+	}
+	return _u
+}
+
+// ClearJobStage clears the value of the "job_stage" field.
+func (_u *GitlabAttestationUpdateOne) ClearJobStage() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearJobStage()
+	return _u
+}
+
+// SetJobURL sets the "job_url" field.
+func (_u *GitlabAttestationUpdateOne) SetJobURL(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetJobURL(v)
+	return _u
+}
+
+// SetNillableJobURL sets the "job_url" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableJobURL(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetJobURL(*v)
+	}
+	return _u
+}
+
+// ClearJobURL clears the value of the "job_url" field.
+func (_u *GitlabAttestationUpdateOne) ClearJobURL() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearJobURL()
+	return _u
+}
+
+// SetPipelineURL sets the "pipeline_url" field.
+func (_u *GitlabAttestationUpdateOne) SetPipelineURL(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetPipelineURL(v)
+	return _u
+}
+
+// SetNillablePipelineURL sets the "pipeline_url" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillablePipelineURL(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetPipelineURL(*v)
+	}
+	return _u
+}
+
+// ClearPipelineURL clears the value of the "pipeline_url" field.
+func (_u *GitlabAttestationUpdateOne) ClearPipelineURL() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearPipelineURL()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *GitlabAttestationUpdateOne) SetProjectID(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableProjectID(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (_u *GitlabAttestationUpdateOne) ClearProjectID() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearProjectID()
+	return _u
+}
+
+// SetProjectURL sets the "project_url" field.
+func (_u *GitlabAttestationUpdateOne) SetProjectURL(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetProjectURL(v)
+	return _u
+}
+
+// SetNillableProjectURL sets the "project_url" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableProjectURL(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetProjectURL(*v)
+	}
+	return _u
+}
+
+// ClearProjectURL clears the value of the "project_url" field.
+func (_u *GitlabAttestationUpdateOne) ClearProjectURL() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearProjectURL()
+	return _u
+}
+
+// SetRunnerID sets the "runner_id" field.
+func (_u *GitlabAttestationUpdateOne) SetRunnerID(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetRunnerID(v)
+	return _u
+}
+
+// SetNillableRunnerID sets the "runner_id" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableRunnerID(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetRunnerID(*v)
+	}
+	return _u
+}
+
+// ClearRunnerID clears the value of the "runner_id" field.
+func (_u *GitlabAttestationUpdateOne) ClearRunnerID() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearRunnerID()
+	return _u
+}
+
+// SetCiHost sets the "ci_host" field.
+func (_u *GitlabAttestationUpdateOne) SetCiHost(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetCiHost(v)
+	return _u
+}
+
+// SetNillableCiHost sets the "ci_host" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableCiHost(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetCiHost(*v)
+	}
+	return _u
+}
+
+// ClearCiHost clears the value of the "ci_host" field.
+func (_u *GitlabAttestationUpdateOne) ClearCiHost() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearCiHost()
+	return _u
+}
+
+// SetCiServerURL sets the "ci_server_url" field.
+func (_u *GitlabAttestationUpdateOne) SetCiServerURL(v string) *GitlabAttestationUpdateOne {
+	_u.mutation.SetCiServerURL(v)
+	return _u
+}
+
+// SetNillableCiServerURL sets the "ci_server_url" field if the given value is not nil.
+func (_u *GitlabAttestationUpdateOne) SetNillableCiServerURL(v *string) *GitlabAttestationUpdateOne {
+	if v != nil {
+		_u.SetCiServerURL(*v)
+	}
+	return _u
+}
+
+// ClearCiServerURL clears the value of the "ci_server_url" field.
+func (_u *GitlabAttestationUpdateOne) ClearCiServerURL() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearCiServerURL()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *GitlabAttestationUpdateOne) SetAttestationID(id uuid.UUID) *GitlabAttestationUpdateOne {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *GitlabAttestationUpdateOne) SetAttestation(v *Attestation) *GitlabAttestationUpdateOne {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the GitlabAttestationMutation object of the builder.
+func (_u *GitlabAttestationUpdateOne) Mutation() *GitlabAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *GitlabAttestationUpdateOne) ClearAttestation() *GitlabAttestationUpdateOne {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Where appends a list predicates to the GitlabAttestationUpdate builder.
+func (_u *GitlabAttestationUpdateOne) Where(ps ...predicate.GitlabAttestation) *GitlabAttestationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GitlabAttestationUpdateOne) Select(field string, fields ...string) *GitlabAttestationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GitlabAttestation entity.
+func (_u *GitlabAttestationUpdateOne) Save(ctx context.Context) (*GitlabAttestation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GitlabAttestationUpdateOne) SaveX(ctx context.Context) *GitlabAttestation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GitlabAttestationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GitlabAttestationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GitlabAttestationUpdateOne) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GitlabAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *GitlabAttestationUpdateOne) sqlSave(ctx context.Context) (_node *GitlabAttestation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gitlabattestation.Table, gitlabattestation.Columns, sqlgraph.NewFieldSpec(gitlabattestation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GitlabAttestation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gitlabattestation.FieldID)
+		for _, f := range fields {
+			if !gitlabattestation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gitlabattestation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CiConfigPath(); ok {
+		_spec.SetField(gitlabattestation.FieldCiConfigPath, field.TypeString, value)
+	}
+	if _u.mutation.CiConfigPathCleared() {
+		_spec.ClearField(gitlabattestation.FieldCiConfigPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineID(); ok {
+		_spec.SetField(gitlabattestation.FieldPipelineID, field.TypeString, value)
+	}
+	if _u.mutation.PipelineIDCleared() {
+		_spec.ClearField(gitlabattestation.FieldPipelineID, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobID(); ok {
+		_spec.SetField(gitlabattestation.FieldJobID, field.TypeString, value)
+	}
+	if _u.mutation.JobIDCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobID, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobImage(); ok {
+		_spec.SetField(gitlabattestation.FieldJobImage, field.TypeString, value)
+	}
+	if _u.mutation.JobImageCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobImage, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobName(); ok {
+		_spec.SetField(gitlabattestation.FieldJobName, field.TypeString, value)
+	}
+	if _u.mutation.JobNameCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobName, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobStage(); ok {
+		_spec.SetField(gitlabattestation.FieldJobStage, field.TypeString, value)
+	}
+	if _u.mutation.JobStageCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobStage, field.TypeString)
+	}
+	if value, ok := _u.mutation.JobURL(); ok {
+		_spec.SetField(gitlabattestation.FieldJobURL, field.TypeString, value)
+	}
+	if _u.mutation.JobURLCleared() {
+		_spec.ClearField(gitlabattestation.FieldJobURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.PipelineURL(); ok {
+		_spec.SetField(gitlabattestation.FieldPipelineURL, field.TypeString, value)
+	}
+	if _u.mutation.PipelineURLCleared() {
+		_spec.ClearField(gitlabattestation.FieldPipelineURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProjectID(); ok {
+		_spec.SetField(gitlabattestation.FieldProjectID, field.TypeString, value)
+	}
+	if _u.mutation.ProjectIDCleared() {
+		_spec.ClearField(gitlabattestation.FieldProjectID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProjectURL(); ok {
+		_spec.SetField(gitlabattestation.FieldProjectURL, field.TypeString, value)
+	}
+	if _u.mutation.ProjectURLCleared() {
+		_spec.ClearField(gitlabattestation.FieldProjectURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerID(); ok {
+		_spec.SetField(gitlabattestation.FieldRunnerID, field.TypeString, value)
+	}
+	if _u.mutation.RunnerIDCleared() {
+		_spec.ClearField(gitlabattestation.FieldRunnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CiHost(); ok {
+		_spec.SetField(gitlabattestation.FieldCiHost, field.TypeString, value)
+	}
+	if _u.mutation.CiHostCleared() {
+		_spec.ClearField(gitlabattestation.FieldCiHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.CiServerURL(); ok {
+		_spec.SetField(gitlabattestation.FieldCiServerURL, field.TypeString, value)
+	}
+	if _u.mutation.CiServerURLCleared() {
+		_spec.ClearField(gitlabattestation.FieldCiServerURL, field.TypeString)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitlabattestation.AttestationTable,
+			Columns: []string{gitlabattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gitlabattestation.AttestationTable,
+			Columns: []string{gitlabattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GitlabAttestation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gitlabattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
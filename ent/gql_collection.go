@@ -14,13 +14,32 @@ import (
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
 	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
 	"github.com/in-toto/archivista/ent/payloaddigest"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
 	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
 	"github.com/in-toto/archivista/ent/subjectdigest"
 	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
@@ -55,6 +74,103 @@ func (_q *AttestationQuery) collectField(ctx context.Context, oneNode bool, opCt
 				return err
 			}
 			_q.withAttestationCollection = query
+
+		case "gitAttestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&GitAttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, gitattestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withGitAttestation = query
+
+		case "githubAttestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&GithubAttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, githubattestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withGithubAttestation = query
+
+		case "gitlabAttestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&GitlabAttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, gitlabattestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withGitlabAttestation = query
+
+		case "environmentAttestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&EnvironmentAttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, environmentattestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withEnvironmentAttestation = query
+
+		case "commandRunAttestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CommandRunAttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, commandrunattestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withCommandRunAttestation = query
+
+		case "ociAttestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OciAttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, ociattestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withOciAttestation = query
+
+		case "materials":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&MaterialClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, materialImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedMaterials(alias, func(wq *MaterialQuery) {
+				*wq = *query
+			})
+
+		case "products":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProductClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, productImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedProducts(alias, func(wq *ProductQuery) {
+				*wq = *query
+			})
+		case "tenant":
+			if _, ok := fieldSeen[attestation.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, attestation.FieldTenant)
+				fieldSeen[attestation.FieldTenant] = struct{}{}
+			}
 		case "type":
 			if _, ok := fieldSeen[attestation.FieldType]; !ok {
 				selectedFields = append(selectedFields, attestation.FieldType)
@@ -146,6 +262,11 @@ func (_q *AttestationCollectionQuery) collectField(ctx context.Context, oneNode
 				return err
 			}
 			_q.withStatement = query
+		case "tenant":
+			if _, ok := fieldSeen[attestationcollection.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, attestationcollection.FieldTenant)
+				fieldSeen[attestationcollection.FieldTenant] = struct{}{}
+			}
 		case "name":
 			if _, ok := fieldSeen[attestationcollection.FieldName]; !ok {
 				selectedFields = append(selectedFields, attestationcollection.FieldName)
@@ -224,6 +345,11 @@ func (_q *AttestationPolicyQuery) collectField(ctx context.Context, oneNode bool
 				return err
 			}
 			_q.withStatement = query
+		case "tenant":
+			if _, ok := fieldSeen[attestationpolicy.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, attestationpolicy.FieldTenant)
+				fieldSeen[attestationpolicy.FieldTenant] = struct{}{}
+			}
 		case "name":
 			if _, ok := fieldSeen[attestationpolicy.FieldName]; !ok {
 				selectedFields = append(selectedFields, attestationpolicy.FieldName)
@@ -271,7 +397,7 @@ func newAttestationPolicyPaginateArgs(rv map[string]any) *attestationpolicyPagin
 }
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
-func (_q *DsseQuery) CollectFields(ctx context.Context, satisfies ...string) (*DsseQuery, error) {
+func (_q *CertificateQuery) CollectFields(ctx context.Context, satisfies ...string) (*CertificateQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
 		return _q, nil
@@ -282,66 +408,128 @@ func (_q *DsseQuery) CollectFields(ctx context.Context, satisfies ...string) (*D
 	return _q, nil
 }
 
-func (_q *DsseQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+func (_q *CertificateQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
 	var (
 		unknownSeen    bool
-		fieldSeen      = make(map[string]struct{}, len(dsse.Columns))
-		selectedFields = []string{dsse.FieldID}
+		fieldSeen      = make(map[string]struct{}, len(certificate.Columns))
+		selectedFields = []string{certificate.FieldID}
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
 
-		case "statement":
-			var (
-				alias = field.Alias
-				path  = append(path, alias)
-				query = (&StatementClient{config: _q.config}).Query()
-			)
-			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, statementImplementors)...); err != nil {
-				return err
-			}
-			_q.withStatement = query
-
-		case "signatures":
+		case "subjectAltNames":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&SignatureClient{config: _q.config}).Query()
+				query = (&SubjectAltNameClient{config: _q.config}).Query()
 			)
-			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, signatureImplementors)...); err != nil {
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, subjectaltnameImplementors)...); err != nil {
 				return err
 			}
-			_q.WithNamedSignatures(alias, func(wq *SignatureQuery) {
+			_q.WithNamedSubjectAltNames(alias, func(wq *SubjectAltNameQuery) {
 				*wq = *query
 			})
 
-		case "payloadDigests":
+		case "signature":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&PayloadDigestClient{config: _q.config}).Query()
+				query = (&SignatureClient{config: _q.config}).Query()
 			)
-			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, payloaddigestImplementors)...); err != nil {
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, signatureImplementors)...); err != nil {
 				return err
 			}
-			_q.WithNamedPayloadDigests(alias, func(wq *PayloadDigestQuery) {
-				*wq = *query
-			})
-		case "createdAt":
-			if _, ok := fieldSeen[dsse.FieldCreatedAt]; !ok {
-				selectedFields = append(selectedFields, dsse.FieldCreatedAt)
-				fieldSeen[dsse.FieldCreatedAt] = struct{}{}
+			_q.withSignature = query
+		case "tenant":
+			if _, ok := fieldSeen[certificate.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldTenant)
+				fieldSeen[certificate.FieldTenant] = struct{}{}
 			}
-		case "gitoidSha256":
-			if _, ok := fieldSeen[dsse.FieldGitoidSha256]; !ok {
-				selectedFields = append(selectedFields, dsse.FieldGitoidSha256)
-				fieldSeen[dsse.FieldGitoidSha256] = struct{}{}
+		case "chainIndex":
+			if _, ok := fieldSeen[certificate.FieldChainIndex]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldChainIndex)
+				fieldSeen[certificate.FieldChainIndex] = struct{}{}
 			}
-		case "payloadType":
-			if _, ok := fieldSeen[dsse.FieldPayloadType]; !ok {
-				selectedFields = append(selectedFields, dsse.FieldPayloadType)
-				fieldSeen[dsse.FieldPayloadType] = struct{}{}
+		case "subject":
+			if _, ok := fieldSeen[certificate.FieldSubject]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldSubject)
+				fieldSeen[certificate.FieldSubject] = struct{}{}
+			}
+		case "issuer":
+			if _, ok := fieldSeen[certificate.FieldIssuer]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldIssuer)
+				fieldSeen[certificate.FieldIssuer] = struct{}{}
+			}
+		case "serialNumber":
+			if _, ok := fieldSeen[certificate.FieldSerialNumber]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldSerialNumber)
+				fieldSeen[certificate.FieldSerialNumber] = struct{}{}
+			}
+		case "notBefore":
+			if _, ok := fieldSeen[certificate.FieldNotBefore]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldNotBefore)
+				fieldSeen[certificate.FieldNotBefore] = struct{}{}
+			}
+		case "notAfter":
+			if _, ok := fieldSeen[certificate.FieldNotAfter]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldNotAfter)
+				fieldSeen[certificate.FieldNotAfter] = struct{}{}
+			}
+		case "spiffeID":
+			if _, ok := fieldSeen[certificate.FieldSpiffeID]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldSpiffeID)
+				fieldSeen[certificate.FieldSpiffeID] = struct{}{}
+			}
+		case "oidcIssuer":
+			if _, ok := fieldSeen[certificate.FieldOidcIssuer]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldOidcIssuer)
+				fieldSeen[certificate.FieldOidcIssuer] = struct{}{}
+			}
+		case "buildSignerURI":
+			if _, ok := fieldSeen[certificate.FieldBuildSignerURI]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldBuildSignerURI)
+				fieldSeen[certificate.FieldBuildSignerURI] = struct{}{}
+			}
+		case "buildSignerDigest":
+			if _, ok := fieldSeen[certificate.FieldBuildSignerDigest]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldBuildSignerDigest)
+				fieldSeen[certificate.FieldBuildSignerDigest] = struct{}{}
+			}
+		case "runnerEnvironment":
+			if _, ok := fieldSeen[certificate.FieldRunnerEnvironment]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldRunnerEnvironment)
+				fieldSeen[certificate.FieldRunnerEnvironment] = struct{}{}
+			}
+		case "sourceRepositoryURI":
+			if _, ok := fieldSeen[certificate.FieldSourceRepositoryURI]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldSourceRepositoryURI)
+				fieldSeen[certificate.FieldSourceRepositoryURI] = struct{}{}
+			}
+		case "sourceRepositoryDigest":
+			if _, ok := fieldSeen[certificate.FieldSourceRepositoryDigest]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldSourceRepositoryDigest)
+				fieldSeen[certificate.FieldSourceRepositoryDigest] = struct{}{}
+			}
+		case "sourceRepositoryRef":
+			if _, ok := fieldSeen[certificate.FieldSourceRepositoryRef]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldSourceRepositoryRef)
+				fieldSeen[certificate.FieldSourceRepositoryRef] = struct{}{}
+			}
+		case "buildConfigURI":
+			if _, ok := fieldSeen[certificate.FieldBuildConfigURI]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldBuildConfigURI)
+				fieldSeen[certificate.FieldBuildConfigURI] = struct{}{}
+			}
+		case "buildTrigger":
+			if _, ok := fieldSeen[certificate.FieldBuildTrigger]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldBuildTrigger)
+				fieldSeen[certificate.FieldBuildTrigger] = struct{}{}
+			}
+		case "runInvocationURI":
+			if _, ok := fieldSeen[certificate.FieldRunInvocationURI]; !ok {
+				selectedFields = append(selectedFields, certificate.FieldRunInvocationURI)
+				fieldSeen[certificate.FieldRunInvocationURI] = struct{}{}
 			}
 		case "id":
 		case "__typename":
@@ -355,14 +543,14 @@ func (_q *DsseQuery) collectField(ctx context.Context, oneNode bool, opCtx *grap
 	return nil
 }
 
-type dssePaginateArgs struct {
+type certificatePaginateArgs struct {
 	first, last   *int
 	after, before *Cursor
-	opts          []DssePaginateOption
+	opts          []CertificatePaginateOption
 }
 
-func newDssePaginateArgs(rv map[string]any) *dssePaginateArgs {
-	args := &dssePaginateArgs{}
+func newCertificatePaginateArgs(rv map[string]any) *certificatePaginateArgs {
+	args := &certificatePaginateArgs{}
 	if rv == nil {
 		return args
 	}
@@ -378,36 +566,14 @@ func newDssePaginateArgs(rv map[string]any) *dssePaginateArgs {
 	if v := rv[beforeField]; v != nil {
 		args.before = v.(*Cursor)
 	}
-	if v, ok := rv[orderByField]; ok {
-		switch v := v.(type) {
-		case map[string]any:
-			var (
-				err1, err2 error
-				order      = &DsseOrder{Field: &DsseOrderField{}, Direction: entgql.OrderDirectionAsc}
-			)
-			if d, ok := v[directionField]; ok {
-				err1 = order.Direction.UnmarshalGQL(d)
-			}
-			if f, ok := v[fieldField]; ok {
-				err2 = order.Field.UnmarshalGQL(f)
-			}
-			if err1 == nil && err2 == nil {
-				args.opts = append(args.opts, WithDsseOrder(order))
-			}
-		case *DsseOrder:
-			if v != nil {
-				args.opts = append(args.opts, WithDsseOrder(v))
-			}
-		}
-	}
-	if v, ok := rv[whereField].(*DsseWhereInput); ok {
-		args.opts = append(args.opts, WithDsseFilter(v.Filter))
+	if v, ok := rv[whereField].(*CertificateWhereInput); ok {
+		args.opts = append(args.opts, WithCertificateFilter(v.Filter))
 	}
 	return args
 }
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
-func (_q *PayloadDigestQuery) CollectFields(ctx context.Context, satisfies ...string) (*PayloadDigestQuery, error) {
+func (_q *CommandRunAttestationQuery) CollectFields(ctx context.Context, satisfies ...string) (*CommandRunAttestationQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
 		return _q, nil
@@ -418,35 +584,40 @@ func (_q *PayloadDigestQuery) CollectFields(ctx context.Context, satisfies ...st
 	return _q, nil
 }
 
-func (_q *PayloadDigestQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+func (_q *CommandRunAttestationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
 	var (
 		unknownSeen    bool
-		fieldSeen      = make(map[string]struct{}, len(payloaddigest.Columns))
-		selectedFields = []string{payloaddigest.FieldID}
+		fieldSeen      = make(map[string]struct{}, len(commandrunattestation.Columns))
+		selectedFields = []string{commandrunattestation.FieldID}
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
 
-		case "dsse":
+		case "attestation":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&DsseClient{config: _q.config}).Query()
+				query = (&AttestationClient{config: _q.config}).Query()
 			)
-			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, dsseImplementors)...); err != nil {
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationImplementors)...); err != nil {
 				return err
 			}
-			_q.withDsse = query
-		case "algorithm":
-			if _, ok := fieldSeen[payloaddigest.FieldAlgorithm]; !ok {
-				selectedFields = append(selectedFields, payloaddigest.FieldAlgorithm)
-				fieldSeen[payloaddigest.FieldAlgorithm] = struct{}{}
+			_q.withAttestation = query
+		case "tenant":
+			if _, ok := fieldSeen[commandrunattestation.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, commandrunattestation.FieldTenant)
+				fieldSeen[commandrunattestation.FieldTenant] = struct{}{}
 			}
-		case "value":
-			if _, ok := fieldSeen[payloaddigest.FieldValue]; !ok {
-				selectedFields = append(selectedFields, payloaddigest.FieldValue)
-				fieldSeen[payloaddigest.FieldValue] = struct{}{}
+		case "cmd":
+			if _, ok := fieldSeen[commandrunattestation.FieldCmd]; !ok {
+				selectedFields = append(selectedFields, commandrunattestation.FieldCmd)
+				fieldSeen[commandrunattestation.FieldCmd] = struct{}{}
+			}
+		case "exitCode":
+			if _, ok := fieldSeen[commandrunattestation.FieldExitCode]; !ok {
+				selectedFields = append(selectedFields, commandrunattestation.FieldExitCode)
+				fieldSeen[commandrunattestation.FieldExitCode] = struct{}{}
 			}
 		case "id":
 		case "__typename":
@@ -460,14 +631,14 @@ func (_q *PayloadDigestQuery) collectField(ctx context.Context, oneNode bool, op
 	return nil
 }
 
-type payloaddigestPaginateArgs struct {
+type commandrunattestationPaginateArgs struct {
 	first, last   *int
 	after, before *Cursor
-	opts          []PayloadDigestPaginateOption
+	opts          []CommandRunAttestationPaginateOption
 }
 
-func newPayloadDigestPaginateArgs(rv map[string]any) *payloaddigestPaginateArgs {
-	args := &payloaddigestPaginateArgs{}
+func newCommandRunAttestationPaginateArgs(rv map[string]any) *commandrunattestationPaginateArgs {
+	args := &commandrunattestationPaginateArgs{}
 	if rv == nil {
 		return args
 	}
@@ -483,14 +654,14 @@ func newPayloadDigestPaginateArgs(rv map[string]any) *payloaddigestPaginateArgs
 	if v := rv[beforeField]; v != nil {
 		args.before = v.(*Cursor)
 	}
-	if v, ok := rv[whereField].(*PayloadDigestWhereInput); ok {
-		args.opts = append(args.opts, WithPayloadDigestFilter(v.Filter))
+	if v, ok := rv[whereField].(*CommandRunAttestationWhereInput); ok {
+		args.opts = append(args.opts, WithCommandRunAttestationFilter(v.Filter))
 	}
 	return args
 }
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
-func (_q *SignatureQuery) CollectFields(ctx context.Context, satisfies ...string) (*SignatureQuery, error) {
+func (_q *DsseQuery) CollectFields(ctx context.Context, satisfies ...string) (*DsseQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
 		return _q, nil
@@ -501,75 +672,1879 @@ func (_q *SignatureQuery) CollectFields(ctx context.Context, satisfies ...string
 	return _q, nil
 }
 
-func (_q *SignatureQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+func (_q *DsseQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
 	var (
 		unknownSeen    bool
-		fieldSeen      = make(map[string]struct{}, len(signature.Columns))
-		selectedFields = []string{signature.FieldID}
+		fieldSeen      = make(map[string]struct{}, len(dsse.Columns))
+		selectedFields = []string{dsse.FieldID}
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
 
-		case "dsse":
+		case "statement":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&DsseClient{config: _q.config}).Query()
+				query = (&StatementClient{config: _q.config}).Query()
 			)
-			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, dsseImplementors)...); err != nil {
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, statementImplementors)...); err != nil {
 				return err
 			}
-			_q.withDsse = query
+			_q.withStatement = query
 
-		case "timestamps":
+		case "signatures":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&TimestampClient{config: _q.config}).Query()
+				query = (&SignatureClient{config: _q.config}).Query()
 			)
-			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, timestampImplementors)...); err != nil {
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, signatureImplementors)...); err != nil {
 				return err
 			}
-			_q.WithNamedTimestamps(alias, func(wq *TimestampQuery) {
+			_q.WithNamedSignatures(alias, func(wq *SignatureQuery) {
 				*wq = *query
 			})
-		case "keyID":
-			if _, ok := fieldSeen[signature.FieldKeyID]; !ok {
-				selectedFields = append(selectedFields, signature.FieldKeyID)
-				fieldSeen[signature.FieldKeyID] = struct{}{}
+
+		case "payloadDigests":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&PayloadDigestClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, payloaddigestImplementors)...); err != nil {
+				return err
 			}
-		case "signature":
-			if _, ok := fieldSeen[signature.FieldSignature]; !ok {
-				selectedFields = append(selectedFields, signature.FieldSignature)
-				fieldSeen[signature.FieldSignature] = struct{}{}
+			_q.WithNamedPayloadDigests(alias, func(wq *PayloadDigestQuery) {
+				*wq = *query
+			})
+
+		case "publishDeliveries":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&PublishDeliveryClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, publishdeliveryImplementors)...); err != nil {
+				return err
 			}
-		case "id":
-		case "__typename":
-		default:
-			unknownSeen = true
-		}
-	}
-	if !unknownSeen {
-		_q.Select(selectedFields...)
-	}
-	return nil
-}
+			_q.WithNamedPublishDeliveries(alias, func(wq *PublishDeliveryQuery) {
+				*wq = *query
+			})
 
-type signaturePaginateArgs struct {
-	first, last   *int
-	after, before *Cursor
-	opts          []SignaturePaginateOption
-}
+		case "publications":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&PublicationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, publicationImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedPublications(alias, func(wq *PublicationQuery) {
+				*wq = *query
+			})
 
-func newSignaturePaginateArgs(rv map[string]any) *signaturePaginateArgs {
-	args := &signaturePaginateArgs{}
-	if rv == nil {
-		return args
-	}
-	if v := rv[firstField]; v != nil {
-		args.first = v.(*int)
-	}
+		case "transparencyLogEntries":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&TransparencyLogEntryClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, transparencylogentryImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedTransparencyLogEntries(alias, func(wq *TransparencyLogEntryQuery) {
+				*wq = *query
+			})
+
+		case "legalHolds":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&LegalHoldClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, legalholdImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedLegalHolds(alias, func(wq *LegalHoldQuery) {
+				*wq = *query
+			})
+		case "tenant":
+			if _, ok := fieldSeen[dsse.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, dsse.FieldTenant)
+				fieldSeen[dsse.FieldTenant] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[dsse.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, dsse.FieldCreatedAt)
+				fieldSeen[dsse.FieldCreatedAt] = struct{}{}
+			}
+		case "gitoidSha256":
+			if _, ok := fieldSeen[dsse.FieldGitoidSha256]; !ok {
+				selectedFields = append(selectedFields, dsse.FieldGitoidSha256)
+				fieldSeen[dsse.FieldGitoidSha256] = struct{}{}
+			}
+		case "payloadType":
+			if _, ok := fieldSeen[dsse.FieldPayloadType]; !ok {
+				selectedFields = append(selectedFields, dsse.FieldPayloadType)
+				fieldSeen[dsse.FieldPayloadType] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type dssePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []DssePaginateOption
+}
+
+func newDssePaginateArgs(rv map[string]any) *dssePaginateArgs {
+	args := &dssePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &DsseOrder{Field: &DsseOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithDsseOrder(order))
+			}
+		case *DsseOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithDsseOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*DsseWhereInput); ok {
+		args.opts = append(args.opts, WithDsseFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *EnvironmentAttestationQuery) CollectFields(ctx context.Context, satisfies ...string) (*EnvironmentAttestationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *EnvironmentAttestationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(environmentattestation.Columns))
+		selectedFields = []string{environmentattestation.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "attestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withAttestation = query
+		case "tenant":
+			if _, ok := fieldSeen[environmentattestation.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, environmentattestation.FieldTenant)
+				fieldSeen[environmentattestation.FieldTenant] = struct{}{}
+			}
+		case "os":
+			if _, ok := fieldSeen[environmentattestation.FieldOs]; !ok {
+				selectedFields = append(selectedFields, environmentattestation.FieldOs)
+				fieldSeen[environmentattestation.FieldOs] = struct{}{}
+			}
+		case "hostname":
+			if _, ok := fieldSeen[environmentattestation.FieldHostname]; !ok {
+				selectedFields = append(selectedFields, environmentattestation.FieldHostname)
+				fieldSeen[environmentattestation.FieldHostname] = struct{}{}
+			}
+		case "username":
+			if _, ok := fieldSeen[environmentattestation.FieldUsername]; !ok {
+				selectedFields = append(selectedFields, environmentattestation.FieldUsername)
+				fieldSeen[environmentattestation.FieldUsername] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type environmentattestationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []EnvironmentAttestationPaginateOption
+}
+
+func newEnvironmentAttestationPaginateArgs(rv map[string]any) *environmentattestationPaginateArgs {
+	args := &environmentattestationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*EnvironmentAttestationWhereInput); ok {
+		args.opts = append(args.opts, WithEnvironmentAttestationFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *GitAttestationQuery) CollectFields(ctx context.Context, satisfies ...string) (*GitAttestationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *GitAttestationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(gitattestation.Columns))
+		selectedFields = []string{gitattestation.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "attestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withAttestation = query
+		case "tenant":
+			if _, ok := fieldSeen[gitattestation.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldTenant)
+				fieldSeen[gitattestation.FieldTenant] = struct{}{}
+			}
+		case "commitHash":
+			if _, ok := fieldSeen[gitattestation.FieldCommitHash]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldCommitHash)
+				fieldSeen[gitattestation.FieldCommitHash] = struct{}{}
+			}
+		case "branch":
+			if _, ok := fieldSeen[gitattestation.FieldBranch]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldBranch)
+				fieldSeen[gitattestation.FieldBranch] = struct{}{}
+			}
+		case "treeHash":
+			if _, ok := fieldSeen[gitattestation.FieldTreeHash]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldTreeHash)
+				fieldSeen[gitattestation.FieldTreeHash] = struct{}{}
+			}
+		case "author":
+			if _, ok := fieldSeen[gitattestation.FieldAuthor]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldAuthor)
+				fieldSeen[gitattestation.FieldAuthor] = struct{}{}
+			}
+		case "authorEmail":
+			if _, ok := fieldSeen[gitattestation.FieldAuthorEmail]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldAuthorEmail)
+				fieldSeen[gitattestation.FieldAuthorEmail] = struct{}{}
+			}
+		case "committerName":
+			if _, ok := fieldSeen[gitattestation.FieldCommitterName]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldCommitterName)
+				fieldSeen[gitattestation.FieldCommitterName] = struct{}{}
+			}
+		case "committerEmail":
+			if _, ok := fieldSeen[gitattestation.FieldCommitterEmail]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldCommitterEmail)
+				fieldSeen[gitattestation.FieldCommitterEmail] = struct{}{}
+			}
+		case "commitDate":
+			if _, ok := fieldSeen[gitattestation.FieldCommitDate]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldCommitDate)
+				fieldSeen[gitattestation.FieldCommitDate] = struct{}{}
+			}
+		case "commitMessage":
+			if _, ok := fieldSeen[gitattestation.FieldCommitMessage]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldCommitMessage)
+				fieldSeen[gitattestation.FieldCommitMessage] = struct{}{}
+			}
+		case "parentHashes":
+			if _, ok := fieldSeen[gitattestation.FieldParentHashes]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldParentHashes)
+				fieldSeen[gitattestation.FieldParentHashes] = struct{}{}
+			}
+		case "refs":
+			if _, ok := fieldSeen[gitattestation.FieldRefs]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldRefs)
+				fieldSeen[gitattestation.FieldRefs] = struct{}{}
+			}
+		case "remotes":
+			if _, ok := fieldSeen[gitattestation.FieldRemotes]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldRemotes)
+				fieldSeen[gitattestation.FieldRemotes] = struct{}{}
+			}
+		case "tags":
+			if _, ok := fieldSeen[gitattestation.FieldTags]; !ok {
+				selectedFields = append(selectedFields, gitattestation.FieldTags)
+				fieldSeen[gitattestation.FieldTags] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type gitattestationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []GitAttestationPaginateOption
+}
+
+func newGitAttestationPaginateArgs(rv map[string]any) *gitattestationPaginateArgs {
+	args := &gitattestationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*GitAttestationWhereInput); ok {
+		args.opts = append(args.opts, WithGitAttestationFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *GithubAttestationQuery) CollectFields(ctx context.Context, satisfies ...string) (*GithubAttestationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *GithubAttestationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(githubattestation.Columns))
+		selectedFields = []string{githubattestation.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "attestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withAttestation = query
+		case "tenant":
+			if _, ok := fieldSeen[githubattestation.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldTenant)
+				fieldSeen[githubattestation.FieldTenant] = struct{}{}
+			}
+		case "ciConfigPath":
+			if _, ok := fieldSeen[githubattestation.FieldCiConfigPath]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldCiConfigPath)
+				fieldSeen[githubattestation.FieldCiConfigPath] = struct{}{}
+			}
+		case "pipelineID":
+			if _, ok := fieldSeen[githubattestation.FieldPipelineID]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldPipelineID)
+				fieldSeen[githubattestation.FieldPipelineID] = struct{}{}
+			}
+		case "pipelineName":
+			if _, ok := fieldSeen[githubattestation.FieldPipelineName]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldPipelineName)
+				fieldSeen[githubattestation.FieldPipelineName] = struct{}{}
+			}
+		case "pipelineURL":
+			if _, ok := fieldSeen[githubattestation.FieldPipelineURL]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldPipelineURL)
+				fieldSeen[githubattestation.FieldPipelineURL] = struct{}{}
+			}
+		case "projectURL":
+			if _, ok := fieldSeen[githubattestation.FieldProjectURL]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldProjectURL)
+				fieldSeen[githubattestation.FieldProjectURL] = struct{}{}
+			}
+		case "runnerID":
+			if _, ok := fieldSeen[githubattestation.FieldRunnerID]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldRunnerID)
+				fieldSeen[githubattestation.FieldRunnerID] = struct{}{}
+			}
+		case "ciHost":
+			if _, ok := fieldSeen[githubattestation.FieldCiHost]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldCiHost)
+				fieldSeen[githubattestation.FieldCiHost] = struct{}{}
+			}
+		case "ciServerURL":
+			if _, ok := fieldSeen[githubattestation.FieldCiServerURL]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldCiServerURL)
+				fieldSeen[githubattestation.FieldCiServerURL] = struct{}{}
+			}
+		case "runnerArch":
+			if _, ok := fieldSeen[githubattestation.FieldRunnerArch]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldRunnerArch)
+				fieldSeen[githubattestation.FieldRunnerArch] = struct{}{}
+			}
+		case "runnerOs":
+			if _, ok := fieldSeen[githubattestation.FieldRunnerOs]; !ok {
+				selectedFields = append(selectedFields, githubattestation.FieldRunnerOs)
+				fieldSeen[githubattestation.FieldRunnerOs] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type githubattestationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []GithubAttestationPaginateOption
+}
+
+func newGithubAttestationPaginateArgs(rv map[string]any) *githubattestationPaginateArgs {
+	args := &githubattestationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*GithubAttestationWhereInput); ok {
+		args.opts = append(args.opts, WithGithubAttestationFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *GitlabAttestationQuery) CollectFields(ctx context.Context, satisfies ...string) (*GitlabAttestationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *GitlabAttestationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(gitlabattestation.Columns))
+		selectedFields = []string{gitlabattestation.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "attestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withAttestation = query
+		case "tenant":
+			if _, ok := fieldSeen[gitlabattestation.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldTenant)
+				fieldSeen[gitlabattestation.FieldTenant] = struct{}{}
+			}
+		case "ciConfigPath":
+			if _, ok := fieldSeen[gitlabattestation.FieldCiConfigPath]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldCiConfigPath)
+				fieldSeen[gitlabattestation.FieldCiConfigPath] = struct{}{}
+			}
+		case "pipelineID":
+			if _, ok := fieldSeen[gitlabattestation.FieldPipelineID]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldPipelineID)
+				fieldSeen[gitlabattestation.FieldPipelineID] = struct{}{}
+			}
+		case "jobID":
+			if _, ok := fieldSeen[gitlabattestation.FieldJobID]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldJobID)
+				fieldSeen[gitlabattestation.FieldJobID] = struct{}{}
+			}
+		case "jobImage":
+			if _, ok := fieldSeen[gitlabattestation.FieldJobImage]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldJobImage)
+				fieldSeen[gitlabattestation.FieldJobImage] = struct{}{}
+			}
+		case "jobName":
+			if _, ok := fieldSeen[gitlabattestation.FieldJobName]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldJobName)
+				fieldSeen[gitlabattestation.FieldJobName] = struct{}{}
+			}
+		case "jobStage":
+			if _, ok := fieldSeen[gitlabattestation.FieldJobStage]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldJobStage)
+				fieldSeen[gitlabattestation.FieldJobStage] = struct{}{}
+			}
+		case "jobURL":
+			if _, ok := fieldSeen[gitlabattestation.FieldJobURL]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldJobURL)
+				fieldSeen[gitlabattestation.FieldJobURL] = struct{}{}
+			}
+		case "pipelineURL":
+			if _, ok := fieldSeen[gitlabattestation.FieldPipelineURL]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldPipelineURL)
+				fieldSeen[gitlabattestation.FieldPipelineURL] = struct{}{}
+			}
+		case "projectID":
+			if _, ok := fieldSeen[gitlabattestation.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldProjectID)
+				fieldSeen[gitlabattestation.FieldProjectID] = struct{}{}
+			}
+		case "projectURL":
+			if _, ok := fieldSeen[gitlabattestation.FieldProjectURL]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldProjectURL)
+				fieldSeen[gitlabattestation.FieldProjectURL] = struct{}{}
+			}
+		case "runnerID":
+			if _, ok := fieldSeen[gitlabattestation.FieldRunnerID]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldRunnerID)
+				fieldSeen[gitlabattestation.FieldRunnerID] = struct{}{}
+			}
+		case "ciHost":
+			if _, ok := fieldSeen[gitlabattestation.FieldCiHost]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldCiHost)
+				fieldSeen[gitlabattestation.FieldCiHost] = struct{}{}
+			}
+		case "ciServerURL":
+			if _, ok := fieldSeen[gitlabattestation.FieldCiServerURL]; !ok {
+				selectedFields = append(selectedFields, gitlabattestation.FieldCiServerURL)
+				fieldSeen[gitlabattestation.FieldCiServerURL] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type gitlabattestationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []GitlabAttestationPaginateOption
+}
+
+func newGitlabAttestationPaginateArgs(rv map[string]any) *gitlabattestationPaginateArgs {
+	args := &gitlabattestationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*GitlabAttestationWhereInput); ok {
+		args.opts = append(args.opts, WithGitlabAttestationFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *LegalHoldQuery) CollectFields(ctx context.Context, satisfies ...string) (*LegalHoldQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *LegalHoldQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(legalhold.Columns))
+		selectedFields = []string{legalhold.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "dsses":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&DsseClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, dsseImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedDsses(alias, func(wq *DsseQuery) {
+				*wq = *query
+			})
+		case "tenant":
+			if _, ok := fieldSeen[legalhold.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, legalhold.FieldTenant)
+				fieldSeen[legalhold.FieldTenant] = struct{}{}
+			}
+		case "reason":
+			if _, ok := fieldSeen[legalhold.FieldReason]; !ok {
+				selectedFields = append(selectedFields, legalhold.FieldReason)
+				fieldSeen[legalhold.FieldReason] = struct{}{}
+			}
+		case "subjectDigest":
+			if _, ok := fieldSeen[legalhold.FieldSubjectDigest]; !ok {
+				selectedFields = append(selectedFields, legalhold.FieldSubjectDigest)
+				fieldSeen[legalhold.FieldSubjectDigest] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[legalhold.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, legalhold.FieldCreatedBy)
+				fieldSeen[legalhold.FieldCreatedBy] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[legalhold.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, legalhold.FieldCreatedAt)
+				fieldSeen[legalhold.FieldCreatedAt] = struct{}{}
+			}
+		case "releasedBy":
+			if _, ok := fieldSeen[legalhold.FieldReleasedBy]; !ok {
+				selectedFields = append(selectedFields, legalhold.FieldReleasedBy)
+				fieldSeen[legalhold.FieldReleasedBy] = struct{}{}
+			}
+		case "releasedAt":
+			if _, ok := fieldSeen[legalhold.FieldReleasedAt]; !ok {
+				selectedFields = append(selectedFields, legalhold.FieldReleasedAt)
+				fieldSeen[legalhold.FieldReleasedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type legalholdPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []LegalHoldPaginateOption
+}
+
+func newLegalHoldPaginateArgs(rv map[string]any) *legalholdPaginateArgs {
+	args := &legalholdPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &LegalHoldOrder{Field: &LegalHoldOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithLegalHoldOrder(order))
+			}
+		case *LegalHoldOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithLegalHoldOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*LegalHoldWhereInput); ok {
+		args.opts = append(args.opts, WithLegalHoldFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *MaterialQuery) CollectFields(ctx context.Context, satisfies ...string) (*MaterialQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *MaterialQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(material.Columns))
+		selectedFields = []string{material.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "attestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withAttestation = query
+		case "tenant":
+			if _, ok := fieldSeen[material.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, material.FieldTenant)
+				fieldSeen[material.FieldTenant] = struct{}{}
+			}
+		case "path":
+			if _, ok := fieldSeen[material.FieldPath]; !ok {
+				selectedFields = append(selectedFields, material.FieldPath)
+				fieldSeen[material.FieldPath] = struct{}{}
+			}
+		case "algorithm":
+			if _, ok := fieldSeen[material.FieldAlgorithm]; !ok {
+				selectedFields = append(selectedFields, material.FieldAlgorithm)
+				fieldSeen[material.FieldAlgorithm] = struct{}{}
+			}
+		case "value":
+			if _, ok := fieldSeen[material.FieldValue]; !ok {
+				selectedFields = append(selectedFields, material.FieldValue)
+				fieldSeen[material.FieldValue] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type materialPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []MaterialPaginateOption
+}
+
+func newMaterialPaginateArgs(rv map[string]any) *materialPaginateArgs {
+	args := &materialPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*MaterialWhereInput); ok {
+		args.opts = append(args.opts, WithMaterialFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *OciAttestationQuery) CollectFields(ctx context.Context, satisfies ...string) (*OciAttestationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *OciAttestationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(ociattestation.Columns))
+		selectedFields = []string{ociattestation.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "attestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withAttestation = query
+		case "tenant":
+			if _, ok := fieldSeen[ociattestation.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, ociattestation.FieldTenant)
+				fieldSeen[ociattestation.FieldTenant] = struct{}{}
+			}
+		case "tarDigest":
+			if _, ok := fieldSeen[ociattestation.FieldTarDigest]; !ok {
+				selectedFields = append(selectedFields, ociattestation.FieldTarDigest)
+				fieldSeen[ociattestation.FieldTarDigest] = struct{}{}
+			}
+		case "imageID":
+			if _, ok := fieldSeen[ociattestation.FieldImageID]; !ok {
+				selectedFields = append(selectedFields, ociattestation.FieldImageID)
+				fieldSeen[ociattestation.FieldImageID] = struct{}{}
+			}
+		case "manifestDigest":
+			if _, ok := fieldSeen[ociattestation.FieldManifestDigest]; !ok {
+				selectedFields = append(selectedFields, ociattestation.FieldManifestDigest)
+				fieldSeen[ociattestation.FieldManifestDigest] = struct{}{}
+			}
+		case "imageTags":
+			if _, ok := fieldSeen[ociattestation.FieldImageTags]; !ok {
+				selectedFields = append(selectedFields, ociattestation.FieldImageTags)
+				fieldSeen[ociattestation.FieldImageTags] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type ociattestationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []OciAttestationPaginateOption
+}
+
+func newOciAttestationPaginateArgs(rv map[string]any) *ociattestationPaginateArgs {
+	args := &ociattestationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*OciAttestationWhereInput); ok {
+		args.opts = append(args.opts, WithOciAttestationFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *PayloadDigestQuery) CollectFields(ctx context.Context, satisfies ...string) (*PayloadDigestQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *PayloadDigestQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(payloaddigest.Columns))
+		selectedFields = []string{payloaddigest.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "dsse":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&DsseClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, dsseImplementors)...); err != nil {
+				return err
+			}
+			_q.withDsse = query
+		case "tenant":
+			if _, ok := fieldSeen[payloaddigest.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, payloaddigest.FieldTenant)
+				fieldSeen[payloaddigest.FieldTenant] = struct{}{}
+			}
+		case "algorithm":
+			if _, ok := fieldSeen[payloaddigest.FieldAlgorithm]; !ok {
+				selectedFields = append(selectedFields, payloaddigest.FieldAlgorithm)
+				fieldSeen[payloaddigest.FieldAlgorithm] = struct{}{}
+			}
+		case "value":
+			if _, ok := fieldSeen[payloaddigest.FieldValue]; !ok {
+				selectedFields = append(selectedFields, payloaddigest.FieldValue)
+				fieldSeen[payloaddigest.FieldValue] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type payloaddigestPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []PayloadDigestPaginateOption
+}
+
+func newPayloadDigestPaginateArgs(rv map[string]any) *payloaddigestPaginateArgs {
+	args := &payloaddigestPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*PayloadDigestWhereInput); ok {
+		args.opts = append(args.opts, WithPayloadDigestFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *ProductQuery) CollectFields(ctx context.Context, satisfies ...string) (*ProductQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *ProductQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(product.Columns))
+		selectedFields = []string{product.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "attestation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AttestationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationImplementors)...); err != nil {
+				return err
+			}
+			_q.withAttestation = query
+		case "tenant":
+			if _, ok := fieldSeen[product.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, product.FieldTenant)
+				fieldSeen[product.FieldTenant] = struct{}{}
+			}
+		case "path":
+			if _, ok := fieldSeen[product.FieldPath]; !ok {
+				selectedFields = append(selectedFields, product.FieldPath)
+				fieldSeen[product.FieldPath] = struct{}{}
+			}
+		case "mimeType":
+			if _, ok := fieldSeen[product.FieldMimeType]; !ok {
+				selectedFields = append(selectedFields, product.FieldMimeType)
+				fieldSeen[product.FieldMimeType] = struct{}{}
+			}
+		case "algorithm":
+			if _, ok := fieldSeen[product.FieldAlgorithm]; !ok {
+				selectedFields = append(selectedFields, product.FieldAlgorithm)
+				fieldSeen[product.FieldAlgorithm] = struct{}{}
+			}
+		case "value":
+			if _, ok := fieldSeen[product.FieldValue]; !ok {
+				selectedFields = append(selectedFields, product.FieldValue)
+				fieldSeen[product.FieldValue] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type productPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ProductPaginateOption
+}
+
+func newProductPaginateArgs(rv map[string]any) *productPaginateArgs {
+	args := &productPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*ProductWhereInput); ok {
+		args.opts = append(args.opts, WithProductFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *PublicationQuery) CollectFields(ctx context.Context, satisfies ...string) (*PublicationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *PublicationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(publication.Columns))
+		selectedFields = []string{publication.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "dsse":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&DsseClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, dsseImplementors)...); err != nil {
+				return err
+			}
+			_q.withDsse = query
+		case "tenant":
+			if _, ok := fieldSeen[publication.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, publication.FieldTenant)
+				fieldSeen[publication.FieldTenant] = struct{}{}
+			}
+		case "gitoidSha256":
+			if _, ok := fieldSeen[publication.FieldGitoidSha256]; !ok {
+				selectedFields = append(selectedFields, publication.FieldGitoidSha256)
+				fieldSeen[publication.FieldGitoidSha256] = struct{}{}
+			}
+		case "publisher":
+			if _, ok := fieldSeen[publication.FieldPublisher]; !ok {
+				selectedFields = append(selectedFields, publication.FieldPublisher)
+				fieldSeen[publication.FieldPublisher] = struct{}{}
+			}
+		case "taskID":
+			if _, ok := fieldSeen[publication.FieldTaskID]; !ok {
+				selectedFields = append(selectedFields, publication.FieldTaskID)
+				fieldSeen[publication.FieldTaskID] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[publication.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, publication.FieldStatus)
+				fieldSeen[publication.FieldStatus] = struct{}{}
+			}
+		case "message":
+			if _, ok := fieldSeen[publication.FieldMessage]; !ok {
+				selectedFields = append(selectedFields, publication.FieldMessage)
+				fieldSeen[publication.FieldMessage] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[publication.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, publication.FieldCreatedAt)
+				fieldSeen[publication.FieldCreatedAt] = struct{}{}
+			}
+		case "completedAt":
+			if _, ok := fieldSeen[publication.FieldCompletedAt]; !ok {
+				selectedFields = append(selectedFields, publication.FieldCompletedAt)
+				fieldSeen[publication.FieldCompletedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type publicationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []PublicationPaginateOption
+}
+
+func newPublicationPaginateArgs(rv map[string]any) *publicationPaginateArgs {
+	args := &publicationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &PublicationOrder{Field: &PublicationOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithPublicationOrder(order))
+			}
+		case *PublicationOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithPublicationOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*PublicationWhereInput); ok {
+		args.opts = append(args.opts, WithPublicationFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *PublishDeliveryQuery) CollectFields(ctx context.Context, satisfies ...string) (*PublishDeliveryQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *PublishDeliveryQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(publishdelivery.Columns))
+		selectedFields = []string{publishdelivery.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "dsse":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&DsseClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, dsseImplementors)...); err != nil {
+				return err
+			}
+			_q.withDsse = query
+		case "tenant":
+			if _, ok := fieldSeen[publishdelivery.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, publishdelivery.FieldTenant)
+				fieldSeen[publishdelivery.FieldTenant] = struct{}{}
+			}
+		case "gitoidSha256":
+			if _, ok := fieldSeen[publishdelivery.FieldGitoidSha256]; !ok {
+				selectedFields = append(selectedFields, publishdelivery.FieldGitoidSha256)
+				fieldSeen[publishdelivery.FieldGitoidSha256] = struct{}{}
+			}
+		case "publisher":
+			if _, ok := fieldSeen[publishdelivery.FieldPublisher]; !ok {
+				selectedFields = append(selectedFields, publishdelivery.FieldPublisher)
+				fieldSeen[publishdelivery.FieldPublisher] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[publishdelivery.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, publishdelivery.FieldStatus)
+				fieldSeen[publishdelivery.FieldStatus] = struct{}{}
+			}
+		case "attempts":
+			if _, ok := fieldSeen[publishdelivery.FieldAttempts]; !ok {
+				selectedFields = append(selectedFields, publishdelivery.FieldAttempts)
+				fieldSeen[publishdelivery.FieldAttempts] = struct{}{}
+			}
+		case "nextAttemptAt":
+			if _, ok := fieldSeen[publishdelivery.FieldNextAttemptAt]; !ok {
+				selectedFields = append(selectedFields, publishdelivery.FieldNextAttemptAt)
+				fieldSeen[publishdelivery.FieldNextAttemptAt] = struct{}{}
+			}
+		case "lastError":
+			if _, ok := fieldSeen[publishdelivery.FieldLastError]; !ok {
+				selectedFields = append(selectedFields, publishdelivery.FieldLastError)
+				fieldSeen[publishdelivery.FieldLastError] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[publishdelivery.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, publishdelivery.FieldCreatedAt)
+				fieldSeen[publishdelivery.FieldCreatedAt] = struct{}{}
+			}
+		case "deliveredAt":
+			if _, ok := fieldSeen[publishdelivery.FieldDeliveredAt]; !ok {
+				selectedFields = append(selectedFields, publishdelivery.FieldDeliveredAt)
+				fieldSeen[publishdelivery.FieldDeliveredAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type publishdeliveryPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []PublishDeliveryPaginateOption
+}
+
+func newPublishDeliveryPaginateArgs(rv map[string]any) *publishdeliveryPaginateArgs {
+	args := &publishdeliveryPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &PublishDeliveryOrder{Field: &PublishDeliveryOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithPublishDeliveryOrder(order))
+			}
+		case *PublishDeliveryOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithPublishDeliveryOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*PublishDeliveryWhereInput); ok {
+		args.opts = append(args.opts, WithPublishDeliveryFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *SbomPackageQuery) CollectFields(ctx context.Context, satisfies ...string) (*SbomPackageQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *SbomPackageQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(sbompackage.Columns))
+		selectedFields = []string{sbompackage.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "digests":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SbomPackageDigestClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, sbompackagedigestImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedDigests(alias, func(wq *SbomPackageDigestQuery) {
+				*wq = *query
+			})
+
+		case "statement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&StatementClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, statementImplementors)...); err != nil {
+				return err
+			}
+			_q.withStatement = query
+		case "tenant":
+			if _, ok := fieldSeen[sbompackage.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, sbompackage.FieldTenant)
+				fieldSeen[sbompackage.FieldTenant] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[sbompackage.FieldName]; !ok {
+				selectedFields = append(selectedFields, sbompackage.FieldName)
+				fieldSeen[sbompackage.FieldName] = struct{}{}
+			}
+		case "version":
+			if _, ok := fieldSeen[sbompackage.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, sbompackage.FieldVersion)
+				fieldSeen[sbompackage.FieldVersion] = struct{}{}
+			}
+		case "purl":
+			if _, ok := fieldSeen[sbompackage.FieldPurl]; !ok {
+				selectedFields = append(selectedFields, sbompackage.FieldPurl)
+				fieldSeen[sbompackage.FieldPurl] = struct{}{}
+			}
+		case "license":
+			if _, ok := fieldSeen[sbompackage.FieldLicense]; !ok {
+				selectedFields = append(selectedFields, sbompackage.FieldLicense)
+				fieldSeen[sbompackage.FieldLicense] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type sbompackagePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []SbomPackagePaginateOption
+}
+
+func newSbomPackagePaginateArgs(rv map[string]any) *sbompackagePaginateArgs {
+	args := &sbompackagePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*SbomPackageWhereInput); ok {
+		args.opts = append(args.opts, WithSbomPackageFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *SbomPackageDigestQuery) CollectFields(ctx context.Context, satisfies ...string) (*SbomPackageDigestQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *SbomPackageDigestQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(sbompackagedigest.Columns))
+		selectedFields = []string{sbompackagedigest.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "sbomPackage":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SbomPackageClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, sbompackageImplementors)...); err != nil {
+				return err
+			}
+			_q.withSbomPackage = query
+		case "tenant":
+			if _, ok := fieldSeen[sbompackagedigest.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, sbompackagedigest.FieldTenant)
+				fieldSeen[sbompackagedigest.FieldTenant] = struct{}{}
+			}
+		case "algorithm":
+			if _, ok := fieldSeen[sbompackagedigest.FieldAlgorithm]; !ok {
+				selectedFields = append(selectedFields, sbompackagedigest.FieldAlgorithm)
+				fieldSeen[sbompackagedigest.FieldAlgorithm] = struct{}{}
+			}
+		case "value":
+			if _, ok := fieldSeen[sbompackagedigest.FieldValue]; !ok {
+				selectedFields = append(selectedFields, sbompackagedigest.FieldValue)
+				fieldSeen[sbompackagedigest.FieldValue] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type sbompackagedigestPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []SbomPackageDigestPaginateOption
+}
+
+func newSbomPackageDigestPaginateArgs(rv map[string]any) *sbompackagedigestPaginateArgs {
+	args := &sbompackagedigestPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*SbomPackageDigestWhereInput); ok {
+		args.opts = append(args.opts, WithSbomPackageDigestFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *SignatureQuery) CollectFields(ctx context.Context, satisfies ...string) (*SignatureQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *SignatureQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(signature.Columns))
+		selectedFields = []string{signature.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "dsse":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&DsseClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, dsseImplementors)...); err != nil {
+				return err
+			}
+			_q.withDsse = query
+
+		case "timestamps":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&TimestampClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, timestampImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedTimestamps(alias, func(wq *TimestampQuery) {
+				*wq = *query
+			})
+
+		case "certificates":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CertificateClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, certificateImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedCertificates(alias, func(wq *CertificateQuery) {
+				*wq = *query
+			})
+		case "tenant":
+			if _, ok := fieldSeen[signature.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, signature.FieldTenant)
+				fieldSeen[signature.FieldTenant] = struct{}{}
+			}
+		case "keyID":
+			if _, ok := fieldSeen[signature.FieldKeyID]; !ok {
+				selectedFields = append(selectedFields, signature.FieldKeyID)
+				fieldSeen[signature.FieldKeyID] = struct{}{}
+			}
+		case "signature":
+			if _, ok := fieldSeen[signature.FieldSignature]; !ok {
+				selectedFields = append(selectedFields, signature.FieldSignature)
+				fieldSeen[signature.FieldSignature] = struct{}{}
+			}
+		case "verified":
+			if _, ok := fieldSeen[signature.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, signature.FieldVerified)
+				fieldSeen[signature.FieldVerified] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type signaturePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []SignaturePaginateOption
+}
+
+func newSignaturePaginateArgs(rv map[string]any) *signaturePaginateArgs {
+	args := &signaturePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*SignatureWhereInput); ok {
+		args.opts = append(args.opts, WithSignatureFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *SlsaDependencyQuery) CollectFields(ctx context.Context, satisfies ...string) (*SlsaDependencyQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *SlsaDependencyQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(slsadependency.Columns))
+		selectedFields = []string{slsadependency.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "slsaProvenance":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SlsaProvenanceClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, slsaprovenanceImplementors)...); err != nil {
+				return err
+			}
+			_q.withSlsaProvenance = query
+		case "tenant":
+			if _, ok := fieldSeen[slsadependency.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, slsadependency.FieldTenant)
+				fieldSeen[slsadependency.FieldTenant] = struct{}{}
+			}
+		case "uri":
+			if _, ok := fieldSeen[slsadependency.FieldURI]; !ok {
+				selectedFields = append(selectedFields, slsadependency.FieldURI)
+				fieldSeen[slsadependency.FieldURI] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[slsadependency.FieldName]; !ok {
+				selectedFields = append(selectedFields, slsadependency.FieldName)
+				fieldSeen[slsadependency.FieldName] = struct{}{}
+			}
+		case "algorithm":
+			if _, ok := fieldSeen[slsadependency.FieldAlgorithm]; !ok {
+				selectedFields = append(selectedFields, slsadependency.FieldAlgorithm)
+				fieldSeen[slsadependency.FieldAlgorithm] = struct{}{}
+			}
+		case "value":
+			if _, ok := fieldSeen[slsadependency.FieldValue]; !ok {
+				selectedFields = append(selectedFields, slsadependency.FieldValue)
+				fieldSeen[slsadependency.FieldValue] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type slsadependencyPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []SlsaDependencyPaginateOption
+}
+
+func newSlsaDependencyPaginateArgs(rv map[string]any) *slsadependencyPaginateArgs {
+	args := &slsadependencyPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
 	if v := rv[lastField]; v != nil {
 		args.last = v.(*int)
 	}
@@ -579,8 +2554,129 @@ func newSignaturePaginateArgs(rv map[string]any) *signaturePaginateArgs {
 	if v := rv[beforeField]; v != nil {
 		args.before = v.(*Cursor)
 	}
-	if v, ok := rv[whereField].(*SignatureWhereInput); ok {
-		args.opts = append(args.opts, WithSignatureFilter(v.Filter))
+	if v, ok := rv[whereField].(*SlsaDependencyWhereInput); ok {
+		args.opts = append(args.opts, WithSlsaDependencyFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *SlsaProvenanceQuery) CollectFields(ctx context.Context, satisfies ...string) (*SlsaProvenanceQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *SlsaProvenanceQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(slsaprovenance.Columns))
+		selectedFields = []string{slsaprovenance.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "resolvedDependencies":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SlsaDependencyClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, slsadependencyImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedResolvedDependencies(alias, func(wq *SlsaDependencyQuery) {
+				*wq = *query
+			})
+
+		case "statement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&StatementClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, statementImplementors)...); err != nil {
+				return err
+			}
+			_q.withStatement = query
+		case "tenant":
+			if _, ok := fieldSeen[slsaprovenance.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, slsaprovenance.FieldTenant)
+				fieldSeen[slsaprovenance.FieldTenant] = struct{}{}
+			}
+		case "builderID":
+			if _, ok := fieldSeen[slsaprovenance.FieldBuilderID]; !ok {
+				selectedFields = append(selectedFields, slsaprovenance.FieldBuilderID)
+				fieldSeen[slsaprovenance.FieldBuilderID] = struct{}{}
+			}
+		case "buildType":
+			if _, ok := fieldSeen[slsaprovenance.FieldBuildType]; !ok {
+				selectedFields = append(selectedFields, slsaprovenance.FieldBuildType)
+				fieldSeen[slsaprovenance.FieldBuildType] = struct{}{}
+			}
+		case "invocationID":
+			if _, ok := fieldSeen[slsaprovenance.FieldInvocationID]; !ok {
+				selectedFields = append(selectedFields, slsaprovenance.FieldInvocationID)
+				fieldSeen[slsaprovenance.FieldInvocationID] = struct{}{}
+			}
+		case "startedOn":
+			if _, ok := fieldSeen[slsaprovenance.FieldStartedOn]; !ok {
+				selectedFields = append(selectedFields, slsaprovenance.FieldStartedOn)
+				fieldSeen[slsaprovenance.FieldStartedOn] = struct{}{}
+			}
+		case "finishedOn":
+			if _, ok := fieldSeen[slsaprovenance.FieldFinishedOn]; !ok {
+				selectedFields = append(selectedFields, slsaprovenance.FieldFinishedOn)
+				fieldSeen[slsaprovenance.FieldFinishedOn] = struct{}{}
+			}
+		case "externalParameters":
+			if _, ok := fieldSeen[slsaprovenance.FieldExternalParameters]; !ok {
+				selectedFields = append(selectedFields, slsaprovenance.FieldExternalParameters)
+				fieldSeen[slsaprovenance.FieldExternalParameters] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type slsaprovenancePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []SlsaProvenancePaginateOption
+}
+
+func newSlsaProvenancePaginateArgs(rv map[string]any) *slsaprovenancePaginateArgs {
+	args := &slsaprovenancePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*SlsaProvenanceWhereInput); ok {
+		args.opts = append(args.opts, WithSlsaProvenanceFilter(v.Filter))
 	}
 	return args
 }
@@ -607,17 +2703,150 @@ func (_q *StatementQuery) collectField(ctx context.Context, oneNode bool, opCtx
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
 
-		case "subjects":
+		case "subjects":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SubjectClient{config: _q.config}).Query()
+			)
+			args := newSubjectPaginateArgs(fieldArgs(ctx, new(SubjectWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newSubjectPager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*Statement) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID uuid.UUID `sql:"statement_subjects"`
+							Count  int       `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(statement.SubjectsColumn), ids...))
+						})
+						if err := query.GroupBy(statement.SubjectsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[uuid.UUID]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				} else {
+					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Statement) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.Subjects)
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, subjectImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(statement.SubjectsColumn, limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			_q.WithNamedSubjects(alias, func(wq *SubjectQuery) {
+				*wq = *query
+			})
+
+		case "policy":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AttestationPolicyClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationpolicyImplementors)...); err != nil {
+				return err
+			}
+			_q.withPolicy = query
+
+		case "attestationCollections":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AttestationCollectionClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationcollectionImplementors)...); err != nil {
+				return err
+			}
+			_q.withAttestationCollections = query
+
+		case "slsaProvenance":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SlsaProvenanceClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, slsaprovenanceImplementors)...); err != nil {
+				return err
+			}
+			_q.withSlsaProvenance = query
+
+		case "verificationSummary":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&SubjectClient{config: _q.config}).Query()
+				query = (&VerificationSummaryClient{config: _q.config}).Query()
 			)
-			args := newSubjectPaginateArgs(fieldArgs(ctx, new(SubjectWhereInput), path...))
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, verificationsummaryImplementors)...); err != nil {
+				return err
+			}
+			_q.withVerificationSummary = query
+
+		case "sbomPackages":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SbomPackageClient{config: _q.config}).Query()
+			)
+			args := newSbomPackagePaginateArgs(fieldArgs(ctx, new(SbomPackageWhereInput), path...))
 			if err := validateFirstLast(args.first, args.last); err != nil {
 				return fmt.Errorf("validate first and last in path %q: %w", path, err)
 			}
-			pager, err := newSubjectPager(args.opts, args.last != nil)
+			pager, err := newSbomPackagePager(args.opts, args.last != nil)
 			if err != nil {
 				return fmt.Errorf("create new pager in path %q: %w", path, err)
 			}
@@ -635,13 +2864,13 @@ func (_q *StatementQuery) collectField(ctx context.Context, oneNode bool, opCtx
 							ids[i] = nodes[i].ID
 						}
 						var v []struct {
-							NodeID uuid.UUID `sql:"statement_subjects"`
+							NodeID uuid.UUID `sql:"statement_sbom_packages"`
 							Count  int       `sql:"count"`
 						}
 						query.Where(func(s *sql.Selector) {
-							s.Where(sql.InValues(s.C(statement.SubjectsColumn), ids...))
+							s.Where(sql.InValues(s.C(statement.SbomPackagesColumn), ids...))
 						})
-						if err := query.GroupBy(statement.SubjectsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+						if err := query.GroupBy(statement.SbomPackagesColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
 							return err
 						}
 						m := make(map[uuid.UUID]int, len(v))
@@ -650,21 +2879,21 @@ func (_q *StatementQuery) collectField(ctx context.Context, oneNode bool, opCtx
 						}
 						for i := range nodes {
 							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[0] == nil {
-								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							if nodes[i].Edges.totalCount[5] == nil {
+								nodes[i].Edges.totalCount[5] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[0][alias] = n
+							nodes[i].Edges.totalCount[5][alias] = n
 						}
 						return nil
 					})
 				} else {
 					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Statement) error {
 						for i := range nodes {
-							n := len(nodes[i].Edges.Subjects)
-							if nodes[i].Edges.totalCount[0] == nil {
-								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							n := len(nodes[i].Edges.SbomPackages)
+							if nodes[i].Edges.totalCount[5] == nil {
+								nodes[i].Edges.totalCount[5] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[0][alias] = n
+							nodes[i].Edges.totalCount[5][alias] = n
 						}
 						return nil
 					})
@@ -678,7 +2907,7 @@ func (_q *StatementQuery) collectField(ctx context.Context, oneNode bool, opCtx
 			}
 			path = append(path, edgesField, nodeField)
 			if field := collectedField(ctx, path...); field != nil {
-				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, subjectImplementors)...); err != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, sbompackageImplementors)...); err != nil {
 					return err
 				}
 			}
@@ -686,38 +2915,16 @@ func (_q *StatementQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				if oneNode {
 					pager.applyOrder(query.Limit(limit))
 				} else {
-					modify := entgql.LimitPerRow(statement.SubjectsColumn, limit, pager.orderExpr(query))
+					modify := entgql.LimitPerRow(statement.SbomPackagesColumn, limit, pager.orderExpr(query))
 					query.modifiers = append(query.modifiers, modify)
 				}
 			} else {
 				query = pager.applyOrder(query)
 			}
-			_q.WithNamedSubjects(alias, func(wq *SubjectQuery) {
+			_q.WithNamedSbomPackages(alias, func(wq *SbomPackageQuery) {
 				*wq = *query
 			})
 
-		case "policy":
-			var (
-				alias = field.Alias
-				path  = append(path, alias)
-				query = (&AttestationPolicyClient{config: _q.config}).Query()
-			)
-			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationpolicyImplementors)...); err != nil {
-				return err
-			}
-			_q.withPolicy = query
-
-		case "attestationCollections":
-			var (
-				alias = field.Alias
-				path  = append(path, alias)
-				query = (&AttestationCollectionClient{config: _q.config}).Query()
-			)
-			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, attestationcollectionImplementors)...); err != nil {
-				return err
-			}
-			_q.withAttestationCollections = query
-
 		case "dsse":
 			var (
 				alias = field.Alias
@@ -730,6 +2937,11 @@ func (_q *StatementQuery) collectField(ctx context.Context, oneNode bool, opCtx
 			_q.WithNamedDsse(alias, func(wq *DsseQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[statement.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, statement.FieldTenant)
+				fieldSeen[statement.FieldTenant] = struct{}{}
+			}
 		case "predicate":
 			if _, ok := fieldSeen[statement.FieldPredicate]; !ok {
 				selectedFields = append(selectedFields, statement.FieldPredicate)
@@ -821,6 +3033,11 @@ func (_q *SubjectQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				return err
 			}
 			_q.withStatement = query
+		case "tenant":
+			if _, ok := fieldSeen[subject.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, subject.FieldTenant)
+				fieldSeen[subject.FieldTenant] = struct{}{}
+			}
 		case "createdAt":
 			if _, ok := fieldSeen[subject.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, subject.FieldCreatedAt)
@@ -894,6 +3111,94 @@ func newSubjectPaginateArgs(rv map[string]any) *subjectPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *SubjectAltNameQuery) CollectFields(ctx context.Context, satisfies ...string) (*SubjectAltNameQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *SubjectAltNameQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(subjectaltname.Columns))
+		selectedFields = []string{subjectaltname.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "certificate":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CertificateClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, certificateImplementors)...); err != nil {
+				return err
+			}
+			_q.withCertificate = query
+		case "tenant":
+			if _, ok := fieldSeen[subjectaltname.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, subjectaltname.FieldTenant)
+				fieldSeen[subjectaltname.FieldTenant] = struct{}{}
+			}
+		case "type":
+			if _, ok := fieldSeen[subjectaltname.FieldType]; !ok {
+				selectedFields = append(selectedFields, subjectaltname.FieldType)
+				fieldSeen[subjectaltname.FieldType] = struct{}{}
+			}
+		case "value":
+			if _, ok := fieldSeen[subjectaltname.FieldValue]; !ok {
+				selectedFields = append(selectedFields, subjectaltname.FieldValue)
+				fieldSeen[subjectaltname.FieldValue] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type subjectaltnamePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []SubjectAltNamePaginateOption
+}
+
+func newSubjectAltNamePaginateArgs(rv map[string]any) *subjectaltnamePaginateArgs {
+	args := &subjectaltnamePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*SubjectAltNameWhereInput); ok {
+		args.opts = append(args.opts, WithSubjectAltNameFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (_q *SubjectDigestQuery) CollectFields(ctx context.Context, satisfies ...string) (*SubjectDigestQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -926,6 +3231,11 @@ func (_q *SubjectDigestQuery) collectField(ctx context.Context, oneNode bool, op
 				return err
 			}
 			_q.withSubject = query
+		case "tenant":
+			if _, ok := fieldSeen[subjectdigest.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, subjectdigest.FieldTenant)
+				fieldSeen[subjectdigest.FieldTenant] = struct{}{}
+			}
 		case "algorithm":
 			if _, ok := fieldSeen[subjectdigest.FieldAlgorithm]; !ok {
 				selectedFields = append(selectedFields, subjectdigest.FieldAlgorithm)
@@ -1009,6 +3319,11 @@ func (_q *TimestampQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				return err
 			}
 			_q.withSignature = query
+		case "tenant":
+			if _, ok := fieldSeen[timestamp.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, timestamp.FieldTenant)
+				fieldSeen[timestamp.FieldTenant] = struct{}{}
+			}
 		case "type":
 			if _, ok := fieldSeen[timestamp.FieldType]; !ok {
 				selectedFields = append(selectedFields, timestamp.FieldType)
@@ -1060,6 +3375,227 @@ func newTimestampPaginateArgs(rv map[string]any) *timestampPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *TransparencyLogEntryQuery) CollectFields(ctx context.Context, satisfies ...string) (*TransparencyLogEntryQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *TransparencyLogEntryQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(transparencylogentry.Columns))
+		selectedFields = []string{transparencylogentry.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "dsse":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&DsseClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, dsseImplementors)...); err != nil {
+				return err
+			}
+			_q.withDsse = query
+		case "tenant":
+			if _, ok := fieldSeen[transparencylogentry.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, transparencylogentry.FieldTenant)
+				fieldSeen[transparencylogentry.FieldTenant] = struct{}{}
+			}
+		case "logIndex":
+			if _, ok := fieldSeen[transparencylogentry.FieldLogIndex]; !ok {
+				selectedFields = append(selectedFields, transparencylogentry.FieldLogIndex)
+				fieldSeen[transparencylogentry.FieldLogIndex] = struct{}{}
+			}
+		case "logID":
+			if _, ok := fieldSeen[transparencylogentry.FieldLogID]; !ok {
+				selectedFields = append(selectedFields, transparencylogentry.FieldLogID)
+				fieldSeen[transparencylogentry.FieldLogID] = struct{}{}
+			}
+		case "kind":
+			if _, ok := fieldSeen[transparencylogentry.FieldKind]; !ok {
+				selectedFields = append(selectedFields, transparencylogentry.FieldKind)
+				fieldSeen[transparencylogentry.FieldKind] = struct{}{}
+			}
+		case "kindVersion":
+			if _, ok := fieldSeen[transparencylogentry.FieldKindVersion]; !ok {
+				selectedFields = append(selectedFields, transparencylogentry.FieldKindVersion)
+				fieldSeen[transparencylogentry.FieldKindVersion] = struct{}{}
+			}
+		case "integratedTime":
+			if _, ok := fieldSeen[transparencylogentry.FieldIntegratedTime]; !ok {
+				selectedFields = append(selectedFields, transparencylogentry.FieldIntegratedTime)
+				fieldSeen[transparencylogentry.FieldIntegratedTime] = struct{}{}
+			}
+		case "entry":
+			if _, ok := fieldSeen[transparencylogentry.FieldEntry]; !ok {
+				selectedFields = append(selectedFields, transparencylogentry.FieldEntry)
+				fieldSeen[transparencylogentry.FieldEntry] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type transparencylogentryPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []TransparencyLogEntryPaginateOption
+}
+
+func newTransparencyLogEntryPaginateArgs(rv map[string]any) *transparencylogentryPaginateArgs {
+	args := &transparencylogentryPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*TransparencyLogEntryWhereInput); ok {
+		args.opts = append(args.opts, WithTransparencyLogEntryFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *VerificationSummaryQuery) CollectFields(ctx context.Context, satisfies ...string) (*VerificationSummaryQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *VerificationSummaryQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(verificationsummary.Columns))
+		selectedFields = []string{verificationsummary.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "statement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&StatementClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, statementImplementors)...); err != nil {
+				return err
+			}
+			_q.withStatement = query
+		case "tenant":
+			if _, ok := fieldSeen[verificationsummary.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, verificationsummary.FieldTenant)
+				fieldSeen[verificationsummary.FieldTenant] = struct{}{}
+			}
+		case "verifierID":
+			if _, ok := fieldSeen[verificationsummary.FieldVerifierID]; !ok {
+				selectedFields = append(selectedFields, verificationsummary.FieldVerifierID)
+				fieldSeen[verificationsummary.FieldVerifierID] = struct{}{}
+			}
+		case "timeVerified":
+			if _, ok := fieldSeen[verificationsummary.FieldTimeVerified]; !ok {
+				selectedFields = append(selectedFields, verificationsummary.FieldTimeVerified)
+				fieldSeen[verificationsummary.FieldTimeVerified] = struct{}{}
+			}
+		case "resourceURI":
+			if _, ok := fieldSeen[verificationsummary.FieldResourceURI]; !ok {
+				selectedFields = append(selectedFields, verificationsummary.FieldResourceURI)
+				fieldSeen[verificationsummary.FieldResourceURI] = struct{}{}
+			}
+		case "policyURI":
+			if _, ok := fieldSeen[verificationsummary.FieldPolicyURI]; !ok {
+				selectedFields = append(selectedFields, verificationsummary.FieldPolicyURI)
+				fieldSeen[verificationsummary.FieldPolicyURI] = struct{}{}
+			}
+		case "verificationResult":
+			if _, ok := fieldSeen[verificationsummary.FieldVerificationResult]; !ok {
+				selectedFields = append(selectedFields, verificationsummary.FieldVerificationResult)
+				fieldSeen[verificationsummary.FieldVerificationResult] = struct{}{}
+			}
+		case "verifiedLevels":
+			if _, ok := fieldSeen[verificationsummary.FieldVerifiedLevels]; !ok {
+				selectedFields = append(selectedFields, verificationsummary.FieldVerifiedLevels)
+				fieldSeen[verificationsummary.FieldVerifiedLevels] = struct{}{}
+			}
+		case "slsaVersion":
+			if _, ok := fieldSeen[verificationsummary.FieldSlsaVersion]; !ok {
+				selectedFields = append(selectedFields, verificationsummary.FieldSlsaVersion)
+				fieldSeen[verificationsummary.FieldSlsaVersion] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type verificationsummaryPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []VerificationSummaryPaginateOption
+}
+
+func newVerificationSummaryPaginateArgs(rv map[string]any) *verificationsummaryPaginateArgs {
+	args := &verificationsummaryPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*VerificationSummaryWhereInput); ok {
+		args.opts = append(args.opts, WithVerificationSummaryFilter(v.Filter))
+	}
+	return args
+}
+
 const (
 	afterField     = "after"
 	firstField     = "first"
@@ -16,6 +16,78 @@ func (_m *Attestation) AttestationCollection(ctx context.Context) (*AttestationC
 	return result, err
 }
 
+func (_m *Attestation) GitAttestation(ctx context.Context) (*GitAttestation, error) {
+	result, err := _m.Edges.GitAttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryGitAttestation().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Attestation) GithubAttestation(ctx context.Context) (*GithubAttestation, error) {
+	result, err := _m.Edges.GithubAttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryGithubAttestation().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Attestation) GitlabAttestation(ctx context.Context) (*GitlabAttestation, error) {
+	result, err := _m.Edges.GitlabAttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryGitlabAttestation().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Attestation) EnvironmentAttestation(ctx context.Context) (*EnvironmentAttestation, error) {
+	result, err := _m.Edges.EnvironmentAttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryEnvironmentAttestation().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Attestation) CommandRunAttestation(ctx context.Context) (*CommandRunAttestation, error) {
+	result, err := _m.Edges.CommandRunAttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryCommandRunAttestation().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Attestation) OciAttestation(ctx context.Context) (*OciAttestation, error) {
+	result, err := _m.Edges.OciAttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryOciAttestation().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Attestation) Materials(ctx context.Context) (result []*Material, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedMaterials(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.MaterialsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryMaterials().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Attestation) Products(ctx context.Context) (result []*Product, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedProducts(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.ProductsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryProducts().All(ctx)
+	}
+	return result, err
+}
+
 func (_m *AttestationCollection) Attestations(ctx context.Context) (result []*Attestation, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = _m.NamedAttestations(graphql.GetFieldContext(ctx).Field.Alias)
@@ -44,6 +116,34 @@ func (_m *AttestationPolicy) Statement(ctx context.Context) (*Statement, error)
 	return result, MaskNotFound(err)
 }
 
+func (_m *Certificate) SubjectAltNames(ctx context.Context) (result []*SubjectAltName, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedSubjectAltNames(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.SubjectAltNamesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QuerySubjectAltNames().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Certificate) Signature(ctx context.Context) (*Signature, error) {
+	result, err := _m.Edges.SignatureOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QuerySignature().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *CommandRunAttestation) Attestation(ctx context.Context) (*Attestation, error) {
+	result, err := _m.Edges.AttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAttestation().Only(ctx)
+	}
+	return result, err
+}
+
 func (_m *Dsse) Statement(ctx context.Context) (*Statement, error) {
 	result, err := _m.Edges.StatementOrErr()
 	if IsNotLoaded(err) {
@@ -76,6 +176,114 @@ func (_m *Dsse) PayloadDigests(ctx context.Context) (result []*PayloadDigest, er
 	return result, err
 }
 
+func (_m *Dsse) PublishDeliveries(ctx context.Context) (result []*PublishDelivery, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedPublishDeliveries(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.PublishDeliveriesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryPublishDeliveries().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Dsse) Publications(ctx context.Context) (result []*Publication, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedPublications(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.PublicationsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryPublications().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Dsse) TransparencyLogEntries(ctx context.Context) (result []*TransparencyLogEntry, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedTransparencyLogEntries(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.TransparencyLogEntriesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryTransparencyLogEntries().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Dsse) LegalHolds(ctx context.Context) (result []*LegalHold, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedLegalHolds(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.LegalHoldsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryLegalHolds().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *EnvironmentAttestation) Attestation(ctx context.Context) (*Attestation, error) {
+	result, err := _m.Edges.AttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAttestation().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *GitAttestation) Attestation(ctx context.Context) (*Attestation, error) {
+	result, err := _m.Edges.AttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAttestation().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *GithubAttestation) Attestation(ctx context.Context) (*Attestation, error) {
+	result, err := _m.Edges.AttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAttestation().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *GitlabAttestation) Attestation(ctx context.Context) (*Attestation, error) {
+	result, err := _m.Edges.AttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAttestation().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *LegalHold) Dsses(ctx context.Context) (result []*Dsse, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedDsses(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.DssesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryDsses().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Material) Attestation(ctx context.Context) (*Attestation, error) {
+	result, err := _m.Edges.AttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAttestation().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *OciAttestation) Attestation(ctx context.Context) (*Attestation, error) {
+	result, err := _m.Edges.AttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAttestation().Only(ctx)
+	}
+	return result, err
+}
+
 func (_m *PayloadDigest) Dsse(ctx context.Context) (*Dsse, error) {
 	result, err := _m.Edges.DsseOrErr()
 	if IsNotLoaded(err) {
@@ -84,6 +292,58 @@ func (_m *PayloadDigest) Dsse(ctx context.Context) (*Dsse, error) {
 	return result, MaskNotFound(err)
 }
 
+func (_m *Product) Attestation(ctx context.Context) (*Attestation, error) {
+	result, err := _m.Edges.AttestationOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAttestation().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Publication) Dsse(ctx context.Context) (*Dsse, error) {
+	result, err := _m.Edges.DsseOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryDsse().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *PublishDelivery) Dsse(ctx context.Context) (*Dsse, error) {
+	result, err := _m.Edges.DsseOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryDsse().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *SbomPackage) Digests(ctx context.Context) (result []*SbomPackageDigest, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedDigests(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.DigestsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryDigests().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *SbomPackage) Statement(ctx context.Context) (*Statement, error) {
+	result, err := _m.Edges.StatementOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryStatement().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *SbomPackageDigest) SbomPackage(ctx context.Context) (*SbomPackage, error) {
+	result, err := _m.Edges.SbomPackageOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QuerySbomPackage().Only(ctx)
+	}
+	return result, err
+}
+
 func (_m *Signature) Dsse(ctx context.Context) (*Dsse, error) {
 	result, err := _m.Edges.DsseOrErr()
 	if IsNotLoaded(err) {
@@ -104,6 +364,46 @@ func (_m *Signature) Timestamps(ctx context.Context) (result []*Timestamp, err e
 	return result, err
 }
 
+func (_m *Signature) Certificates(ctx context.Context) (result []*Certificate, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedCertificates(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.CertificatesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryCertificates().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *SlsaDependency) SlsaProvenance(ctx context.Context) (*SlsaProvenance, error) {
+	result, err := _m.Edges.SlsaProvenanceOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QuerySlsaProvenance().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *SlsaProvenance) ResolvedDependencies(ctx context.Context) (result []*SlsaDependency, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedResolvedDependencies(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.ResolvedDependenciesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryResolvedDependencies().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *SlsaProvenance) Statement(ctx context.Context) (*Statement, error) {
+	result, err := _m.Edges.StatementOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryStatement().Only(ctx)
+	}
+	return result, err
+}
+
 func (_m *Statement) Subjects(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *SubjectOrder, where *SubjectWhereInput,
 ) (*SubjectConnection, error) {
@@ -141,6 +441,42 @@ func (_m *Statement) AttestationCollections(ctx context.Context) (*AttestationCo
 	return result, MaskNotFound(err)
 }
 
+func (_m *Statement) SlsaProvenance(ctx context.Context) (*SlsaProvenance, error) {
+	result, err := _m.Edges.SlsaProvenanceOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QuerySlsaProvenance().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Statement) VerificationSummary(ctx context.Context) (*VerificationSummary, error) {
+	result, err := _m.Edges.VerificationSummaryOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryVerificationSummary().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Statement) SbomPackages(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, where *SbomPackageWhereInput,
+) (*SbomPackageConnection, error) {
+	opts := []SbomPackagePaginateOption{
+		WithSbomPackageFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[5][alias]
+	if nodes, err := _m.NamedSbomPackages(alias); err == nil || hasTotalCount {
+		pager, err := newSbomPackagePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &SbomPackageConnection{Edges: []*SbomPackageEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QuerySbomPackages().Paginate(ctx, after, first, before, last, opts...)
+}
+
 func (_m *Statement) Dsse(ctx context.Context) (result []*Dsse, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = _m.NamedDsse(graphql.GetFieldContext(ctx).Field.Alias)
@@ -173,6 +509,14 @@ func (_m *Subject) Statement(ctx context.Context) (*Statement, error) {
 	return result, MaskNotFound(err)
 }
 
+func (_m *SubjectAltName) Certificate(ctx context.Context) (*Certificate, error) {
+	result, err := _m.Edges.CertificateOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryCertificate().Only(ctx)
+	}
+	return result, err
+}
+
 func (_m *SubjectDigest) Subject(ctx context.Context) (*Subject, error) {
 	result, err := _m.Edges.SubjectOrErr()
 	if IsNotLoaded(err) {
@@ -188,3 +532,19 @@ func (_m *Timestamp) Signature(ctx context.Context) (*Signature, error) {
 	}
 	return result, MaskNotFound(err)
 }
+
+func (_m *TransparencyLogEntry) Dsse(ctx context.Context) (*Dsse, error) {
+	result, err := _m.Edges.DsseOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryDsse().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *VerificationSummary) Statement(ctx context.Context) (*Statement, error) {
+	result, err := _m.Edges.StatementOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryStatement().Only(ctx)
+	}
+	return result, err
+}
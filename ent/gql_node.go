@@ -13,13 +13,32 @@ import (
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
 	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
 	"github.com/in-toto/archivista/ent/payloaddigest"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
 	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
 	"github.com/in-toto/archivista/ent/subjectdigest"
 	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 // Noder wraps the basic Node method.
@@ -42,21 +61,101 @@ var attestationpolicyImplementors = []string{"AttestationPolicy", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*AttestationPolicy) IsNode() {}
 
+var certificateImplementors = []string{"Certificate", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Certificate) IsNode() {}
+
+var commandrunattestationImplementors = []string{"CommandRunAttestation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*CommandRunAttestation) IsNode() {}
+
 var dsseImplementors = []string{"Dsse", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*Dsse) IsNode() {}
 
+var environmentattestationImplementors = []string{"EnvironmentAttestation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*EnvironmentAttestation) IsNode() {}
+
+var gitattestationImplementors = []string{"GitAttestation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*GitAttestation) IsNode() {}
+
+var githubattestationImplementors = []string{"GithubAttestation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*GithubAttestation) IsNode() {}
+
+var gitlabattestationImplementors = []string{"GitlabAttestation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*GitlabAttestation) IsNode() {}
+
+var legalholdImplementors = []string{"LegalHold", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*LegalHold) IsNode() {}
+
+var materialImplementors = []string{"Material", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Material) IsNode() {}
+
+var ociattestationImplementors = []string{"OciAttestation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*OciAttestation) IsNode() {}
+
 var payloaddigestImplementors = []string{"PayloadDigest", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*PayloadDigest) IsNode() {}
 
+var productImplementors = []string{"Product", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Product) IsNode() {}
+
+var publicationImplementors = []string{"Publication", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Publication) IsNode() {}
+
+var publishdeliveryImplementors = []string{"PublishDelivery", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*PublishDelivery) IsNode() {}
+
+var sbompackageImplementors = []string{"SbomPackage", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*SbomPackage) IsNode() {}
+
+var sbompackagedigestImplementors = []string{"SbomPackageDigest", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*SbomPackageDigest) IsNode() {}
+
 var signatureImplementors = []string{"Signature", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*Signature) IsNode() {}
 
+var slsadependencyImplementors = []string{"SlsaDependency", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*SlsaDependency) IsNode() {}
+
+var slsaprovenanceImplementors = []string{"SlsaProvenance", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*SlsaProvenance) IsNode() {}
+
 var statementImplementors = []string{"Statement", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -67,6 +166,11 @@ var subjectImplementors = []string{"Subject", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*Subject) IsNode() {}
 
+var subjectaltnameImplementors = []string{"SubjectAltName", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*SubjectAltName) IsNode() {}
+
 var subjectdigestImplementors = []string{"SubjectDigest", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -77,6 +181,16 @@ var timestampImplementors = []string{"Timestamp", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*Timestamp) IsNode() {}
 
+var transparencylogentryImplementors = []string{"TransparencyLogEntry", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*TransparencyLogEntry) IsNode() {}
+
+var verificationsummaryImplementors = []string{"VerificationSummary", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*VerificationSummary) IsNode() {}
+
 var errNodeInvalidID = &NotFoundError{"node"}
 
 // NodeOption allows configuring the Noder execution using functional options.
@@ -162,6 +276,24 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case certificate.Table:
+		query := c.Certificate.Query().
+			Where(certificate.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, certificateImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case commandrunattestation.Table:
+		query := c.CommandRunAttestation.Query().
+			Where(commandrunattestation.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, commandrunattestationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case dsse.Table:
 		query := c.Dsse.Query().
 			Where(dsse.ID(id))
@@ -171,6 +303,69 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case environmentattestation.Table:
+		query := c.EnvironmentAttestation.Query().
+			Where(environmentattestation.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, environmentattestationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case gitattestation.Table:
+		query := c.GitAttestation.Query().
+			Where(gitattestation.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, gitattestationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case githubattestation.Table:
+		query := c.GithubAttestation.Query().
+			Where(githubattestation.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, githubattestationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case gitlabattestation.Table:
+		query := c.GitlabAttestation.Query().
+			Where(gitlabattestation.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, gitlabattestationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case legalhold.Table:
+		query := c.LegalHold.Query().
+			Where(legalhold.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, legalholdImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case material.Table:
+		query := c.Material.Query().
+			Where(material.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, materialImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case ociattestation.Table:
+		query := c.OciAttestation.Query().
+			Where(ociattestation.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, ociattestationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case payloaddigest.Table:
 		query := c.PayloadDigest.Query().
 			Where(payloaddigest.ID(id))
@@ -180,6 +375,51 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case product.Table:
+		query := c.Product.Query().
+			Where(product.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, productImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case publication.Table:
+		query := c.Publication.Query().
+			Where(publication.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, publicationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case publishdelivery.Table:
+		query := c.PublishDelivery.Query().
+			Where(publishdelivery.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, publishdeliveryImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case sbompackage.Table:
+		query := c.SbomPackage.Query().
+			Where(sbompackage.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, sbompackageImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case sbompackagedigest.Table:
+		query := c.SbomPackageDigest.Query().
+			Where(sbompackagedigest.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, sbompackagedigestImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case signature.Table:
 		query := c.Signature.Query().
 			Where(signature.ID(id))
@@ -189,6 +429,24 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case slsadependency.Table:
+		query := c.SlsaDependency.Query().
+			Where(slsadependency.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, slsadependencyImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case slsaprovenance.Table:
+		query := c.SlsaProvenance.Query().
+			Where(slsaprovenance.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, slsaprovenanceImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case statement.Table:
 		query := c.Statement.Query().
 			Where(statement.ID(id))
@@ -207,6 +465,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case subjectaltname.Table:
+		query := c.SubjectAltName.Query().
+			Where(subjectaltname.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, subjectaltnameImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case subjectdigest.Table:
 		query := c.SubjectDigest.Query().
 			Where(subjectdigest.ID(id))
@@ -225,6 +492,24 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case transparencylogentry.Table:
+		query := c.TransparencyLogEntry.Query().
+			Where(transparencylogentry.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, transparencylogentryImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case verificationsummary.Table:
+		query := c.VerificationSummary.Query().
+			Where(verificationsummary.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, verificationsummaryImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	default:
 		return nil, fmt.Errorf("cannot resolve noder from table %q: %w", table, errNodeInvalidID)
 	}
@@ -346,6 +631,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case certificate.Table:
+		query := c.Certificate.Query().
+			Where(certificate.IDIn(ids...))
+		query, err := query.CollectFields(ctx, certificateImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case commandrunattestation.Table:
+		query := c.CommandRunAttestation.Query().
+			Where(commandrunattestation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, commandrunattestationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case dsse.Table:
 		query := c.Dsse.Query().
 			Where(dsse.IDIn(ids...))
@@ -362,6 +679,118 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case environmentattestation.Table:
+		query := c.EnvironmentAttestation.Query().
+			Where(environmentattestation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, environmentattestationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case gitattestation.Table:
+		query := c.GitAttestation.Query().
+			Where(gitattestation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, gitattestationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case githubattestation.Table:
+		query := c.GithubAttestation.Query().
+			Where(githubattestation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, githubattestationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case gitlabattestation.Table:
+		query := c.GitlabAttestation.Query().
+			Where(gitlabattestation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, gitlabattestationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case legalhold.Table:
+		query := c.LegalHold.Query().
+			Where(legalhold.IDIn(ids...))
+		query, err := query.CollectFields(ctx, legalholdImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case material.Table:
+		query := c.Material.Query().
+			Where(material.IDIn(ids...))
+		query, err := query.CollectFields(ctx, materialImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case ociattestation.Table:
+		query := c.OciAttestation.Query().
+			Where(ociattestation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, ociattestationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case payloaddigest.Table:
 		query := c.PayloadDigest.Query().
 			Where(payloaddigest.IDIn(ids...))
@@ -378,6 +807,86 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case product.Table:
+		query := c.Product.Query().
+			Where(product.IDIn(ids...))
+		query, err := query.CollectFields(ctx, productImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case publication.Table:
+		query := c.Publication.Query().
+			Where(publication.IDIn(ids...))
+		query, err := query.CollectFields(ctx, publicationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case publishdelivery.Table:
+		query := c.PublishDelivery.Query().
+			Where(publishdelivery.IDIn(ids...))
+		query, err := query.CollectFields(ctx, publishdeliveryImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case sbompackage.Table:
+		query := c.SbomPackage.Query().
+			Where(sbompackage.IDIn(ids...))
+		query, err := query.CollectFields(ctx, sbompackageImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case sbompackagedigest.Table:
+		query := c.SbomPackageDigest.Query().
+			Where(sbompackagedigest.IDIn(ids...))
+		query, err := query.CollectFields(ctx, sbompackagedigestImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case signature.Table:
 		query := c.Signature.Query().
 			Where(signature.IDIn(ids...))
@@ -394,6 +903,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case slsadependency.Table:
+		query := c.SlsaDependency.Query().
+			Where(slsadependency.IDIn(ids...))
+		query, err := query.CollectFields(ctx, slsadependencyImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case slsaprovenance.Table:
+		query := c.SlsaProvenance.Query().
+			Where(slsaprovenance.IDIn(ids...))
+		query, err := query.CollectFields(ctx, slsaprovenanceImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case statement.Table:
 		query := c.Statement.Query().
 			Where(statement.IDIn(ids...))
@@ -426,6 +967,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case subjectaltname.Table:
+		query := c.SubjectAltName.Query().
+			Where(subjectaltname.IDIn(ids...))
+		query, err := query.CollectFields(ctx, subjectaltnameImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case subjectdigest.Table:
 		query := c.SubjectDigest.Query().
 			Where(subjectdigest.IDIn(ids...))
@@ -458,6 +1015,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case transparencylogentry.Table:
+		query := c.TransparencyLogEntry.Query().
+			Where(transparencylogentry.IDIn(ids...))
+		query, err := query.CollectFields(ctx, transparencylogentryImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case verificationsummary.Table:
+		query := c.VerificationSummary.Query().
+			Where(verificationsummary.IDIn(ids...))
+		query, err := query.CollectFields(ctx, verificationsummaryImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	default:
 		return nil, fmt.Errorf("cannot resolve noders from table %q: %w", table, errNodeInvalidID)
 	}
@@ -18,13 +18,32 @@ import (
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
 	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
 	"github.com/in-toto/archivista/ent/payloaddigest"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
 	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
 	"github.com/in-toto/archivista/ent/subjectdigest"
 	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -855,20 +874,20 @@ func (_m *AttestationPolicy) ToEdge(order *AttestationPolicyOrder) *AttestationP
 	}
 }
 
-// DsseEdge is the edge representation of Dsse.
-type DsseEdge struct {
-	Node   *Dsse  `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// CertificateEdge is the edge representation of Certificate.
+type CertificateEdge struct {
+	Node   *Certificate `json:"node"`
+	Cursor Cursor       `json:"cursor"`
 }
 
-// DsseConnection is the connection containing edges to Dsse.
-type DsseConnection struct {
-	Edges      []*DsseEdge `json:"edges"`
-	PageInfo   PageInfo    `json:"pageInfo"`
-	TotalCount int         `json:"totalCount"`
+// CertificateConnection is the connection containing edges to Certificate.
+type CertificateConnection struct {
+	Edges      []*CertificateEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
 }
 
-func (c *DsseConnection) build(nodes []*Dsse, pager *dssePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *CertificateConnection) build(nodes []*Certificate, pager *certificatePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -878,21 +897,21 @@ func (c *DsseConnection) build(nodes []*Dsse, pager *dssePager, after *Cursor, f
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Dsse
+	var nodeAt func(int) *Certificate
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Dsse {
+		nodeAt = func(i int) *Certificate {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Dsse {
+		nodeAt = func(i int) *Certificate {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DsseEdge, len(nodes))
+	c.Edges = make([]*CertificateEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DsseEdge{
+		c.Edges[i] = &CertificateEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -906,87 +925,87 @@ func (c *DsseConnection) build(nodes []*Dsse, pager *dssePager, after *Cursor, f
 	}
 }
 
-// DssePaginateOption enables pagination customization.
-type DssePaginateOption func(*dssePager) error
+// CertificatePaginateOption enables pagination customization.
+type CertificatePaginateOption func(*certificatePager) error
 
-// WithDsseOrder configures pagination ordering.
-func WithDsseOrder(order *DsseOrder) DssePaginateOption {
+// WithCertificateOrder configures pagination ordering.
+func WithCertificateOrder(order *CertificateOrder) CertificatePaginateOption {
 	if order == nil {
-		order = DefaultDsseOrder
+		order = DefaultCertificateOrder
 	}
 	o := *order
-	return func(pager *dssePager) error {
+	return func(pager *certificatePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultDsseOrder.Field
+			o.Field = DefaultCertificateOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithDsseFilter configures pagination filter.
-func WithDsseFilter(filter func(*DsseQuery) (*DsseQuery, error)) DssePaginateOption {
-	return func(pager *dssePager) error {
+// WithCertificateFilter configures pagination filter.
+func WithCertificateFilter(filter func(*CertificateQuery) (*CertificateQuery, error)) CertificatePaginateOption {
+	return func(pager *certificatePager) error {
 		if filter == nil {
-			return errors.New("DsseQuery filter cannot be nil")
+			return errors.New("CertificateQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type dssePager struct {
+type certificatePager struct {
 	reverse bool
-	order   *DsseOrder
-	filter  func(*DsseQuery) (*DsseQuery, error)
+	order   *CertificateOrder
+	filter  func(*CertificateQuery) (*CertificateQuery, error)
 }
 
-func newDssePager(opts []DssePaginateOption, reverse bool) (*dssePager, error) {
-	pager := &dssePager{reverse: reverse}
+func newCertificatePager(opts []CertificatePaginateOption, reverse bool) (*certificatePager, error) {
+	pager := &certificatePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultDsseOrder
+		pager.order = DefaultCertificateOrder
 	}
 	return pager, nil
 }
 
-func (p *dssePager) applyFilter(query *DsseQuery) (*DsseQuery, error) {
+func (p *certificatePager) applyFilter(query *CertificateQuery) (*CertificateQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *dssePager) toCursor(_m *Dsse) Cursor {
+func (p *certificatePager) toCursor(_m *Certificate) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *dssePager) applyCursors(query *DsseQuery, after, before *Cursor) (*DsseQuery, error) {
+func (p *certificatePager) applyCursors(query *CertificateQuery, after, before *Cursor) (*CertificateQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultDsseOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCertificateOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *dssePager) applyOrder(query *DsseQuery) *DsseQuery {
+func (p *certificatePager) applyOrder(query *CertificateQuery) *CertificateQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultDsseOrder.Field {
-		query = query.Order(DefaultDsseOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultCertificateOrder.Field {
+		query = query.Order(DefaultCertificateOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -994,7 +1013,7 @@ func (p *dssePager) applyOrder(query *DsseQuery) *DsseQuery {
 	return query
 }
 
-func (p *dssePager) orderExpr(query *DsseQuery) sql.Querier {
+func (p *certificatePager) orderExpr(query *CertificateQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1004,28 +1023,28 @@ func (p *dssePager) orderExpr(query *DsseQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultDsseOrder.Field {
-			b.Comma().Ident(DefaultDsseOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultCertificateOrder.Field {
+			b.Comma().Ident(DefaultCertificateOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Dsse.
-func (_m *DsseQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Certificate.
+func (_m *CertificateQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DssePaginateOption,
-) (*DsseConnection, error) {
+	before *Cursor, last *int, opts ...CertificatePaginateOption,
+) (*CertificateConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDssePager(opts, last != nil)
+	pager, err := newCertificatePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &DsseConnection{Edges: []*DsseEdge{}}
+	conn := &CertificateConnection{Edges: []*CertificateEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -1063,108 +1082,61 @@ func (_m *DsseQuery) Paginate(
 	return conn, nil
 }
 
-var (
-	// DsseOrderFieldCreatedAt orders Dsse by created_at.
-	DsseOrderFieldCreatedAt = &DsseOrderField{
-		Value: func(_m *Dsse) (ent.Value, error) {
-			return _m.CreatedAt, nil
-		},
-		column: dsse.FieldCreatedAt,
-		toTerm: dsse.ByCreatedAt,
-		toCursor: func(_m *Dsse) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.CreatedAt,
-			}
-		},
-	}
-)
-
-// String implement fmt.Stringer interface.
-func (f DsseOrderField) String() string {
-	var str string
-	switch f.column {
-	case DsseOrderFieldCreatedAt.column:
-		str = "CREATED_AT"
-	}
-	return str
-}
-
-// MarshalGQL implements graphql.Marshaler interface.
-func (f DsseOrderField) MarshalGQL(w io.Writer) {
-	io.WriteString(w, strconv.Quote(f.String()))
-}
-
-// UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DsseOrderField) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("DsseOrderField %T must be a string", v)
-	}
-	switch str {
-	case "CREATED_AT":
-		*f = *DsseOrderFieldCreatedAt
-	default:
-		return fmt.Errorf("%s is not a valid DsseOrderField", str)
-	}
-	return nil
-}
-
-// DsseOrderField defines the ordering field of Dsse.
-type DsseOrderField struct {
-	// Value extracts the ordering value from the given Dsse.
-	Value    func(*Dsse) (ent.Value, error)
+// CertificateOrderField defines the ordering field of Certificate.
+type CertificateOrderField struct {
+	// Value extracts the ordering value from the given Certificate.
+	Value    func(*Certificate) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) dsse.OrderOption
-	toCursor func(*Dsse) Cursor
+	toTerm   func(...sql.OrderTermOption) certificate.OrderOption
+	toCursor func(*Certificate) Cursor
 }
 
-// DsseOrder defines the ordering of Dsse.
-type DsseOrder struct {
-	Direction OrderDirection  `json:"direction"`
-	Field     *DsseOrderField `json:"field"`
+// CertificateOrder defines the ordering of Certificate.
+type CertificateOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *CertificateOrderField `json:"field"`
 }
 
-// DefaultDsseOrder is the default ordering of Dsse.
-var DefaultDsseOrder = &DsseOrder{
+// DefaultCertificateOrder is the default ordering of Certificate.
+var DefaultCertificateOrder = &CertificateOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DsseOrderField{
-		Value: func(_m *Dsse) (ent.Value, error) {
+	Field: &CertificateOrderField{
+		Value: func(_m *Certificate) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: dsse.FieldID,
-		toTerm: dsse.ByID,
-		toCursor: func(_m *Dsse) Cursor {
+		column: certificate.FieldID,
+		toTerm: certificate.ByID,
+		toCursor: func(_m *Certificate) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Dsse into DsseEdge.
-func (_m *Dsse) ToEdge(order *DsseOrder) *DsseEdge {
+// ToEdge converts Certificate into CertificateEdge.
+func (_m *Certificate) ToEdge(order *CertificateOrder) *CertificateEdge {
 	if order == nil {
-		order = DefaultDsseOrder
+		order = DefaultCertificateOrder
 	}
-	return &DsseEdge{
+	return &CertificateEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// PayloadDigestEdge is the edge representation of PayloadDigest.
-type PayloadDigestEdge struct {
-	Node   *PayloadDigest `json:"node"`
-	Cursor Cursor         `json:"cursor"`
+// CommandRunAttestationEdge is the edge representation of CommandRunAttestation.
+type CommandRunAttestationEdge struct {
+	Node   *CommandRunAttestation `json:"node"`
+	Cursor Cursor                 `json:"cursor"`
 }
 
-// PayloadDigestConnection is the connection containing edges to PayloadDigest.
-type PayloadDigestConnection struct {
-	Edges      []*PayloadDigestEdge `json:"edges"`
-	PageInfo   PageInfo             `json:"pageInfo"`
-	TotalCount int                  `json:"totalCount"`
+// CommandRunAttestationConnection is the connection containing edges to CommandRunAttestation.
+type CommandRunAttestationConnection struct {
+	Edges      []*CommandRunAttestationEdge `json:"edges"`
+	PageInfo   PageInfo                     `json:"pageInfo"`
+	TotalCount int                          `json:"totalCount"`
 }
 
-func (c *PayloadDigestConnection) build(nodes []*PayloadDigest, pager *payloaddigestPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *CommandRunAttestationConnection) build(nodes []*CommandRunAttestation, pager *commandrunattestationPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1174,21 +1146,21 @@ func (c *PayloadDigestConnection) build(nodes []*PayloadDigest, pager *payloaddi
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *PayloadDigest
+	var nodeAt func(int) *CommandRunAttestation
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *PayloadDigest {
+		nodeAt = func(i int) *CommandRunAttestation {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *PayloadDigest {
+		nodeAt = func(i int) *CommandRunAttestation {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*PayloadDigestEdge, len(nodes))
+	c.Edges = make([]*CommandRunAttestationEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &PayloadDigestEdge{
+		c.Edges[i] = &CommandRunAttestationEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1202,87 +1174,87 @@ func (c *PayloadDigestConnection) build(nodes []*PayloadDigest, pager *payloaddi
 	}
 }
 
-// PayloadDigestPaginateOption enables pagination customization.
-type PayloadDigestPaginateOption func(*payloaddigestPager) error
+// CommandRunAttestationPaginateOption enables pagination customization.
+type CommandRunAttestationPaginateOption func(*commandrunattestationPager) error
 
-// WithPayloadDigestOrder configures pagination ordering.
-func WithPayloadDigestOrder(order *PayloadDigestOrder) PayloadDigestPaginateOption {
+// WithCommandRunAttestationOrder configures pagination ordering.
+func WithCommandRunAttestationOrder(order *CommandRunAttestationOrder) CommandRunAttestationPaginateOption {
 	if order == nil {
-		order = DefaultPayloadDigestOrder
+		order = DefaultCommandRunAttestationOrder
 	}
 	o := *order
-	return func(pager *payloaddigestPager) error {
+	return func(pager *commandrunattestationPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultPayloadDigestOrder.Field
+			o.Field = DefaultCommandRunAttestationOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithPayloadDigestFilter configures pagination filter.
-func WithPayloadDigestFilter(filter func(*PayloadDigestQuery) (*PayloadDigestQuery, error)) PayloadDigestPaginateOption {
-	return func(pager *payloaddigestPager) error {
+// WithCommandRunAttestationFilter configures pagination filter.
+func WithCommandRunAttestationFilter(filter func(*CommandRunAttestationQuery) (*CommandRunAttestationQuery, error)) CommandRunAttestationPaginateOption {
+	return func(pager *commandrunattestationPager) error {
 		if filter == nil {
-			return errors.New("PayloadDigestQuery filter cannot be nil")
+			return errors.New("CommandRunAttestationQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type payloaddigestPager struct {
+type commandrunattestationPager struct {
 	reverse bool
-	order   *PayloadDigestOrder
-	filter  func(*PayloadDigestQuery) (*PayloadDigestQuery, error)
+	order   *CommandRunAttestationOrder
+	filter  func(*CommandRunAttestationQuery) (*CommandRunAttestationQuery, error)
 }
 
-func newPayloadDigestPager(opts []PayloadDigestPaginateOption, reverse bool) (*payloaddigestPager, error) {
-	pager := &payloaddigestPager{reverse: reverse}
+func newCommandRunAttestationPager(opts []CommandRunAttestationPaginateOption, reverse bool) (*commandrunattestationPager, error) {
+	pager := &commandrunattestationPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultPayloadDigestOrder
+		pager.order = DefaultCommandRunAttestationOrder
 	}
 	return pager, nil
 }
 
-func (p *payloaddigestPager) applyFilter(query *PayloadDigestQuery) (*PayloadDigestQuery, error) {
+func (p *commandrunattestationPager) applyFilter(query *CommandRunAttestationQuery) (*CommandRunAttestationQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *payloaddigestPager) toCursor(_m *PayloadDigest) Cursor {
+func (p *commandrunattestationPager) toCursor(_m *CommandRunAttestation) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *payloaddigestPager) applyCursors(query *PayloadDigestQuery, after, before *Cursor) (*PayloadDigestQuery, error) {
+func (p *commandrunattestationPager) applyCursors(query *CommandRunAttestationQuery, after, before *Cursor) (*CommandRunAttestationQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPayloadDigestOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCommandRunAttestationOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *payloaddigestPager) applyOrder(query *PayloadDigestQuery) *PayloadDigestQuery {
+func (p *commandrunattestationPager) applyOrder(query *CommandRunAttestationQuery) *CommandRunAttestationQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultPayloadDigestOrder.Field {
-		query = query.Order(DefaultPayloadDigestOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultCommandRunAttestationOrder.Field {
+		query = query.Order(DefaultCommandRunAttestationOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1290,7 +1262,7 @@ func (p *payloaddigestPager) applyOrder(query *PayloadDigestQuery) *PayloadDiges
 	return query
 }
 
-func (p *payloaddigestPager) orderExpr(query *PayloadDigestQuery) sql.Querier {
+func (p *commandrunattestationPager) orderExpr(query *CommandRunAttestationQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1300,28 +1272,28 @@ func (p *payloaddigestPager) orderExpr(query *PayloadDigestQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultPayloadDigestOrder.Field {
-			b.Comma().Ident(DefaultPayloadDigestOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultCommandRunAttestationOrder.Field {
+			b.Comma().Ident(DefaultCommandRunAttestationOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to PayloadDigest.
-func (_m *PayloadDigestQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to CommandRunAttestation.
+func (_m *CommandRunAttestationQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...PayloadDigestPaginateOption,
-) (*PayloadDigestConnection, error) {
+	before *Cursor, last *int, opts ...CommandRunAttestationPaginateOption,
+) (*CommandRunAttestationConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newPayloadDigestPager(opts, last != nil)
+	pager, err := newCommandRunAttestationPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &PayloadDigestConnection{Edges: []*PayloadDigestEdge{}}
+	conn := &CommandRunAttestationConnection{Edges: []*CommandRunAttestationEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -1359,61 +1331,61 @@ func (_m *PayloadDigestQuery) Paginate(
 	return conn, nil
 }
 
-// PayloadDigestOrderField defines the ordering field of PayloadDigest.
-type PayloadDigestOrderField struct {
-	// Value extracts the ordering value from the given PayloadDigest.
-	Value    func(*PayloadDigest) (ent.Value, error)
+// CommandRunAttestationOrderField defines the ordering field of CommandRunAttestation.
+type CommandRunAttestationOrderField struct {
+	// Value extracts the ordering value from the given CommandRunAttestation.
+	Value    func(*CommandRunAttestation) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) payloaddigest.OrderOption
-	toCursor func(*PayloadDigest) Cursor
+	toTerm   func(...sql.OrderTermOption) commandrunattestation.OrderOption
+	toCursor func(*CommandRunAttestation) Cursor
 }
 
-// PayloadDigestOrder defines the ordering of PayloadDigest.
-type PayloadDigestOrder struct {
-	Direction OrderDirection           `json:"direction"`
-	Field     *PayloadDigestOrderField `json:"field"`
+// CommandRunAttestationOrder defines the ordering of CommandRunAttestation.
+type CommandRunAttestationOrder struct {
+	Direction OrderDirection                   `json:"direction"`
+	Field     *CommandRunAttestationOrderField `json:"field"`
 }
 
-// DefaultPayloadDigestOrder is the default ordering of PayloadDigest.
-var DefaultPayloadDigestOrder = &PayloadDigestOrder{
+// DefaultCommandRunAttestationOrder is the default ordering of CommandRunAttestation.
+var DefaultCommandRunAttestationOrder = &CommandRunAttestationOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &PayloadDigestOrderField{
-		Value: func(_m *PayloadDigest) (ent.Value, error) {
+	Field: &CommandRunAttestationOrderField{
+		Value: func(_m *CommandRunAttestation) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: payloaddigest.FieldID,
-		toTerm: payloaddigest.ByID,
-		toCursor: func(_m *PayloadDigest) Cursor {
+		column: commandrunattestation.FieldID,
+		toTerm: commandrunattestation.ByID,
+		toCursor: func(_m *CommandRunAttestation) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts PayloadDigest into PayloadDigestEdge.
-func (_m *PayloadDigest) ToEdge(order *PayloadDigestOrder) *PayloadDigestEdge {
+// ToEdge converts CommandRunAttestation into CommandRunAttestationEdge.
+func (_m *CommandRunAttestation) ToEdge(order *CommandRunAttestationOrder) *CommandRunAttestationEdge {
 	if order == nil {
-		order = DefaultPayloadDigestOrder
+		order = DefaultCommandRunAttestationOrder
 	}
-	return &PayloadDigestEdge{
+	return &CommandRunAttestationEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// SignatureEdge is the edge representation of Signature.
-type SignatureEdge struct {
-	Node   *Signature `json:"node"`
-	Cursor Cursor     `json:"cursor"`
+// DsseEdge is the edge representation of Dsse.
+type DsseEdge struct {
+	Node   *Dsse  `json:"node"`
+	Cursor Cursor `json:"cursor"`
 }
 
-// SignatureConnection is the connection containing edges to Signature.
-type SignatureConnection struct {
-	Edges      []*SignatureEdge `json:"edges"`
-	PageInfo   PageInfo         `json:"pageInfo"`
-	TotalCount int              `json:"totalCount"`
+// DsseConnection is the connection containing edges to Dsse.
+type DsseConnection struct {
+	Edges      []*DsseEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
 }
 
-func (c *SignatureConnection) build(nodes []*Signature, pager *signaturePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *DsseConnection) build(nodes []*Dsse, pager *dssePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1423,21 +1395,21 @@ func (c *SignatureConnection) build(nodes []*Signature, pager *signaturePager, a
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Signature
+	var nodeAt func(int) *Dsse
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Signature {
+		nodeAt = func(i int) *Dsse {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Signature {
+		nodeAt = func(i int) *Dsse {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*SignatureEdge, len(nodes))
+	c.Edges = make([]*DsseEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &SignatureEdge{
+		c.Edges[i] = &DsseEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1451,87 +1423,5053 @@ func (c *SignatureConnection) build(nodes []*Signature, pager *signaturePager, a
 	}
 }
 
-// SignaturePaginateOption enables pagination customization.
-type SignaturePaginateOption func(*signaturePager) error
+// DssePaginateOption enables pagination customization.
+type DssePaginateOption func(*dssePager) error
 
-// WithSignatureOrder configures pagination ordering.
-func WithSignatureOrder(order *SignatureOrder) SignaturePaginateOption {
+// WithDsseOrder configures pagination ordering.
+func WithDsseOrder(order *DsseOrder) DssePaginateOption {
 	if order == nil {
-		order = DefaultSignatureOrder
+		order = DefaultDsseOrder
 	}
 	o := *order
-	return func(pager *signaturePager) error {
+	return func(pager *dssePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultSignatureOrder.Field
+			o.Field = DefaultDsseOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithSignatureFilter configures pagination filter.
-func WithSignatureFilter(filter func(*SignatureQuery) (*SignatureQuery, error)) SignaturePaginateOption {
-	return func(pager *signaturePager) error {
+// WithDsseFilter configures pagination filter.
+func WithDsseFilter(filter func(*DsseQuery) (*DsseQuery, error)) DssePaginateOption {
+	return func(pager *dssePager) error {
 		if filter == nil {
-			return errors.New("SignatureQuery filter cannot be nil")
+			return errors.New("DsseQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type signaturePager struct {
+type dssePager struct {
 	reverse bool
-	order   *SignatureOrder
-	filter  func(*SignatureQuery) (*SignatureQuery, error)
+	order   *DsseOrder
+	filter  func(*DsseQuery) (*DsseQuery, error)
 }
 
-func newSignaturePager(opts []SignaturePaginateOption, reverse bool) (*signaturePager, error) {
-	pager := &signaturePager{reverse: reverse}
+func newDssePager(opts []DssePaginateOption, reverse bool) (*dssePager, error) {
+	pager := &dssePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultSignatureOrder
+		pager.order = DefaultDsseOrder
+	}
+	return pager, nil
+}
+
+func (p *dssePager) applyFilter(query *DsseQuery) (*DsseQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *dssePager) toCursor(_m *Dsse) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *dssePager) applyCursors(query *DsseQuery, after, before *Cursor) (*DsseQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultDsseOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *dssePager) applyOrder(query *DsseQuery) *DsseQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultDsseOrder.Field {
+		query = query.Order(DefaultDsseOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *dssePager) orderExpr(query *DsseQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultDsseOrder.Field {
+			b.Comma().Ident(DefaultDsseOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Dsse.
+func (_m *DsseQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...DssePaginateOption,
+) (*DsseConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newDssePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &DsseConnection{Edges: []*DsseEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// DsseOrderFieldCreatedAt orders Dsse by created_at.
+	DsseOrderFieldCreatedAt = &DsseOrderField{
+		Value: func(_m *Dsse) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: dsse.FieldCreatedAt,
+		toTerm: dsse.ByCreatedAt,
+		toCursor: func(_m *Dsse) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f DsseOrderField) String() string {
+	var str string
+	switch f.column {
+	case DsseOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f DsseOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *DsseOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("DsseOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *DsseOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid DsseOrderField", str)
+	}
+	return nil
+}
+
+// DsseOrderField defines the ordering field of Dsse.
+type DsseOrderField struct {
+	// Value extracts the ordering value from the given Dsse.
+	Value    func(*Dsse) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) dsse.OrderOption
+	toCursor func(*Dsse) Cursor
+}
+
+// DsseOrder defines the ordering of Dsse.
+type DsseOrder struct {
+	Direction OrderDirection  `json:"direction"`
+	Field     *DsseOrderField `json:"field"`
+}
+
+// DefaultDsseOrder is the default ordering of Dsse.
+var DefaultDsseOrder = &DsseOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &DsseOrderField{
+		Value: func(_m *Dsse) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: dsse.FieldID,
+		toTerm: dsse.ByID,
+		toCursor: func(_m *Dsse) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Dsse into DsseEdge.
+func (_m *Dsse) ToEdge(order *DsseOrder) *DsseEdge {
+	if order == nil {
+		order = DefaultDsseOrder
+	}
+	return &DsseEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// EnvironmentAttestationEdge is the edge representation of EnvironmentAttestation.
+type EnvironmentAttestationEdge struct {
+	Node   *EnvironmentAttestation `json:"node"`
+	Cursor Cursor                  `json:"cursor"`
+}
+
+// EnvironmentAttestationConnection is the connection containing edges to EnvironmentAttestation.
+type EnvironmentAttestationConnection struct {
+	Edges      []*EnvironmentAttestationEdge `json:"edges"`
+	PageInfo   PageInfo                      `json:"pageInfo"`
+	TotalCount int                           `json:"totalCount"`
+}
+
+func (c *EnvironmentAttestationConnection) build(nodes []*EnvironmentAttestation, pager *environmentattestationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *EnvironmentAttestation
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *EnvironmentAttestation {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *EnvironmentAttestation {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*EnvironmentAttestationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &EnvironmentAttestationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// EnvironmentAttestationPaginateOption enables pagination customization.
+type EnvironmentAttestationPaginateOption func(*environmentattestationPager) error
+
+// WithEnvironmentAttestationOrder configures pagination ordering.
+func WithEnvironmentAttestationOrder(order *EnvironmentAttestationOrder) EnvironmentAttestationPaginateOption {
+	if order == nil {
+		order = DefaultEnvironmentAttestationOrder
+	}
+	o := *order
+	return func(pager *environmentattestationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultEnvironmentAttestationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithEnvironmentAttestationFilter configures pagination filter.
+func WithEnvironmentAttestationFilter(filter func(*EnvironmentAttestationQuery) (*EnvironmentAttestationQuery, error)) EnvironmentAttestationPaginateOption {
+	return func(pager *environmentattestationPager) error {
+		if filter == nil {
+			return errors.New("EnvironmentAttestationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type environmentattestationPager struct {
+	reverse bool
+	order   *EnvironmentAttestationOrder
+	filter  func(*EnvironmentAttestationQuery) (*EnvironmentAttestationQuery, error)
+}
+
+func newEnvironmentAttestationPager(opts []EnvironmentAttestationPaginateOption, reverse bool) (*environmentattestationPager, error) {
+	pager := &environmentattestationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultEnvironmentAttestationOrder
+	}
+	return pager, nil
+}
+
+func (p *environmentattestationPager) applyFilter(query *EnvironmentAttestationQuery) (*EnvironmentAttestationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *environmentattestationPager) toCursor(_m *EnvironmentAttestation) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *environmentattestationPager) applyCursors(query *EnvironmentAttestationQuery, after, before *Cursor) (*EnvironmentAttestationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultEnvironmentAttestationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *environmentattestationPager) applyOrder(query *EnvironmentAttestationQuery) *EnvironmentAttestationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultEnvironmentAttestationOrder.Field {
+		query = query.Order(DefaultEnvironmentAttestationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *environmentattestationPager) orderExpr(query *EnvironmentAttestationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultEnvironmentAttestationOrder.Field {
+			b.Comma().Ident(DefaultEnvironmentAttestationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to EnvironmentAttestation.
+func (_m *EnvironmentAttestationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...EnvironmentAttestationPaginateOption,
+) (*EnvironmentAttestationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newEnvironmentAttestationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &EnvironmentAttestationConnection{Edges: []*EnvironmentAttestationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// EnvironmentAttestationOrderField defines the ordering field of EnvironmentAttestation.
+type EnvironmentAttestationOrderField struct {
+	// Value extracts the ordering value from the given EnvironmentAttestation.
+	Value    func(*EnvironmentAttestation) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) environmentattestation.OrderOption
+	toCursor func(*EnvironmentAttestation) Cursor
+}
+
+// EnvironmentAttestationOrder defines the ordering of EnvironmentAttestation.
+type EnvironmentAttestationOrder struct {
+	Direction OrderDirection                    `json:"direction"`
+	Field     *EnvironmentAttestationOrderField `json:"field"`
+}
+
+// DefaultEnvironmentAttestationOrder is the default ordering of EnvironmentAttestation.
+var DefaultEnvironmentAttestationOrder = &EnvironmentAttestationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &EnvironmentAttestationOrderField{
+		Value: func(_m *EnvironmentAttestation) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: environmentattestation.FieldID,
+		toTerm: environmentattestation.ByID,
+		toCursor: func(_m *EnvironmentAttestation) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts EnvironmentAttestation into EnvironmentAttestationEdge.
+func (_m *EnvironmentAttestation) ToEdge(order *EnvironmentAttestationOrder) *EnvironmentAttestationEdge {
+	if order == nil {
+		order = DefaultEnvironmentAttestationOrder
+	}
+	return &EnvironmentAttestationEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// GitAttestationEdge is the edge representation of GitAttestation.
+type GitAttestationEdge struct {
+	Node   *GitAttestation `json:"node"`
+	Cursor Cursor          `json:"cursor"`
+}
+
+// GitAttestationConnection is the connection containing edges to GitAttestation.
+type GitAttestationConnection struct {
+	Edges      []*GitAttestationEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+func (c *GitAttestationConnection) build(nodes []*GitAttestation, pager *gitattestationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *GitAttestation
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *GitAttestation {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *GitAttestation {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*GitAttestationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &GitAttestationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// GitAttestationPaginateOption enables pagination customization.
+type GitAttestationPaginateOption func(*gitattestationPager) error
+
+// WithGitAttestationOrder configures pagination ordering.
+func WithGitAttestationOrder(order *GitAttestationOrder) GitAttestationPaginateOption {
+	if order == nil {
+		order = DefaultGitAttestationOrder
+	}
+	o := *order
+	return func(pager *gitattestationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultGitAttestationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithGitAttestationFilter configures pagination filter.
+func WithGitAttestationFilter(filter func(*GitAttestationQuery) (*GitAttestationQuery, error)) GitAttestationPaginateOption {
+	return func(pager *gitattestationPager) error {
+		if filter == nil {
+			return errors.New("GitAttestationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type gitattestationPager struct {
+	reverse bool
+	order   *GitAttestationOrder
+	filter  func(*GitAttestationQuery) (*GitAttestationQuery, error)
+}
+
+func newGitAttestationPager(opts []GitAttestationPaginateOption, reverse bool) (*gitattestationPager, error) {
+	pager := &gitattestationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultGitAttestationOrder
+	}
+	return pager, nil
+}
+
+func (p *gitattestationPager) applyFilter(query *GitAttestationQuery) (*GitAttestationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *gitattestationPager) toCursor(_m *GitAttestation) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *gitattestationPager) applyCursors(query *GitAttestationQuery, after, before *Cursor) (*GitAttestationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultGitAttestationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *gitattestationPager) applyOrder(query *GitAttestationQuery) *GitAttestationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultGitAttestationOrder.Field {
+		query = query.Order(DefaultGitAttestationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *gitattestationPager) orderExpr(query *GitAttestationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultGitAttestationOrder.Field {
+			b.Comma().Ident(DefaultGitAttestationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to GitAttestation.
+func (_m *GitAttestationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...GitAttestationPaginateOption,
+) (*GitAttestationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newGitAttestationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &GitAttestationConnection{Edges: []*GitAttestationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// GitAttestationOrderField defines the ordering field of GitAttestation.
+type GitAttestationOrderField struct {
+	// Value extracts the ordering value from the given GitAttestation.
+	Value    func(*GitAttestation) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) gitattestation.OrderOption
+	toCursor func(*GitAttestation) Cursor
+}
+
+// GitAttestationOrder defines the ordering of GitAttestation.
+type GitAttestationOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *GitAttestationOrderField `json:"field"`
+}
+
+// DefaultGitAttestationOrder is the default ordering of GitAttestation.
+var DefaultGitAttestationOrder = &GitAttestationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &GitAttestationOrderField{
+		Value: func(_m *GitAttestation) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: gitattestation.FieldID,
+		toTerm: gitattestation.ByID,
+		toCursor: func(_m *GitAttestation) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts GitAttestation into GitAttestationEdge.
+func (_m *GitAttestation) ToEdge(order *GitAttestationOrder) *GitAttestationEdge {
+	if order == nil {
+		order = DefaultGitAttestationOrder
+	}
+	return &GitAttestationEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// GithubAttestationEdge is the edge representation of GithubAttestation.
+type GithubAttestationEdge struct {
+	Node   *GithubAttestation `json:"node"`
+	Cursor Cursor             `json:"cursor"`
+}
+
+// GithubAttestationConnection is the connection containing edges to GithubAttestation.
+type GithubAttestationConnection struct {
+	Edges      []*GithubAttestationEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
+}
+
+func (c *GithubAttestationConnection) build(nodes []*GithubAttestation, pager *githubattestationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *GithubAttestation
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *GithubAttestation {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *GithubAttestation {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*GithubAttestationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &GithubAttestationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// GithubAttestationPaginateOption enables pagination customization.
+type GithubAttestationPaginateOption func(*githubattestationPager) error
+
+// WithGithubAttestationOrder configures pagination ordering.
+func WithGithubAttestationOrder(order *GithubAttestationOrder) GithubAttestationPaginateOption {
+	if order == nil {
+		order = DefaultGithubAttestationOrder
+	}
+	o := *order
+	return func(pager *githubattestationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultGithubAttestationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithGithubAttestationFilter configures pagination filter.
+func WithGithubAttestationFilter(filter func(*GithubAttestationQuery) (*GithubAttestationQuery, error)) GithubAttestationPaginateOption {
+	return func(pager *githubattestationPager) error {
+		if filter == nil {
+			return errors.New("GithubAttestationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type githubattestationPager struct {
+	reverse bool
+	order   *GithubAttestationOrder
+	filter  func(*GithubAttestationQuery) (*GithubAttestationQuery, error)
+}
+
+func newGithubAttestationPager(opts []GithubAttestationPaginateOption, reverse bool) (*githubattestationPager, error) {
+	pager := &githubattestationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultGithubAttestationOrder
+	}
+	return pager, nil
+}
+
+func (p *githubattestationPager) applyFilter(query *GithubAttestationQuery) (*GithubAttestationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *githubattestationPager) toCursor(_m *GithubAttestation) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *githubattestationPager) applyCursors(query *GithubAttestationQuery, after, before *Cursor) (*GithubAttestationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultGithubAttestationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *githubattestationPager) applyOrder(query *GithubAttestationQuery) *GithubAttestationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultGithubAttestationOrder.Field {
+		query = query.Order(DefaultGithubAttestationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *githubattestationPager) orderExpr(query *GithubAttestationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultGithubAttestationOrder.Field {
+			b.Comma().Ident(DefaultGithubAttestationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to GithubAttestation.
+func (_m *GithubAttestationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...GithubAttestationPaginateOption,
+) (*GithubAttestationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newGithubAttestationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &GithubAttestationConnection{Edges: []*GithubAttestationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// GithubAttestationOrderField defines the ordering field of GithubAttestation.
+type GithubAttestationOrderField struct {
+	// Value extracts the ordering value from the given GithubAttestation.
+	Value    func(*GithubAttestation) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) githubattestation.OrderOption
+	toCursor func(*GithubAttestation) Cursor
+}
+
+// GithubAttestationOrder defines the ordering of GithubAttestation.
+type GithubAttestationOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *GithubAttestationOrderField `json:"field"`
+}
+
+// DefaultGithubAttestationOrder is the default ordering of GithubAttestation.
+var DefaultGithubAttestationOrder = &GithubAttestationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &GithubAttestationOrderField{
+		Value: func(_m *GithubAttestation) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: githubattestation.FieldID,
+		toTerm: githubattestation.ByID,
+		toCursor: func(_m *GithubAttestation) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts GithubAttestation into GithubAttestationEdge.
+func (_m *GithubAttestation) ToEdge(order *GithubAttestationOrder) *GithubAttestationEdge {
+	if order == nil {
+		order = DefaultGithubAttestationOrder
+	}
+	return &GithubAttestationEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// GitlabAttestationEdge is the edge representation of GitlabAttestation.
+type GitlabAttestationEdge struct {
+	Node   *GitlabAttestation `json:"node"`
+	Cursor Cursor             `json:"cursor"`
+}
+
+// GitlabAttestationConnection is the connection containing edges to GitlabAttestation.
+type GitlabAttestationConnection struct {
+	Edges      []*GitlabAttestationEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
+}
+
+func (c *GitlabAttestationConnection) build(nodes []*GitlabAttestation, pager *gitlabattestationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *GitlabAttestation
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *GitlabAttestation {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *GitlabAttestation {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*GitlabAttestationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &GitlabAttestationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// GitlabAttestationPaginateOption enables pagination customization.
+type GitlabAttestationPaginateOption func(*gitlabattestationPager) error
+
+// WithGitlabAttestationOrder configures pagination ordering.
+func WithGitlabAttestationOrder(order *GitlabAttestationOrder) GitlabAttestationPaginateOption {
+	if order == nil {
+		order = DefaultGitlabAttestationOrder
+	}
+	o := *order
+	return func(pager *gitlabattestationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultGitlabAttestationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithGitlabAttestationFilter configures pagination filter.
+func WithGitlabAttestationFilter(filter func(*GitlabAttestationQuery) (*GitlabAttestationQuery, error)) GitlabAttestationPaginateOption {
+	return func(pager *gitlabattestationPager) error {
+		if filter == nil {
+			return errors.New("GitlabAttestationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type gitlabattestationPager struct {
+	reverse bool
+	order   *GitlabAttestationOrder
+	filter  func(*GitlabAttestationQuery) (*GitlabAttestationQuery, error)
+}
+
+func newGitlabAttestationPager(opts []GitlabAttestationPaginateOption, reverse bool) (*gitlabattestationPager, error) {
+	pager := &gitlabattestationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultGitlabAttestationOrder
+	}
+	return pager, nil
+}
+
+func (p *gitlabattestationPager) applyFilter(query *GitlabAttestationQuery) (*GitlabAttestationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *gitlabattestationPager) toCursor(_m *GitlabAttestation) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *gitlabattestationPager) applyCursors(query *GitlabAttestationQuery, after, before *Cursor) (*GitlabAttestationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultGitlabAttestationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *gitlabattestationPager) applyOrder(query *GitlabAttestationQuery) *GitlabAttestationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultGitlabAttestationOrder.Field {
+		query = query.Order(DefaultGitlabAttestationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *gitlabattestationPager) orderExpr(query *GitlabAttestationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultGitlabAttestationOrder.Field {
+			b.Comma().Ident(DefaultGitlabAttestationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to GitlabAttestation.
+func (_m *GitlabAttestationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...GitlabAttestationPaginateOption,
+) (*GitlabAttestationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newGitlabAttestationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &GitlabAttestationConnection{Edges: []*GitlabAttestationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// GitlabAttestationOrderField defines the ordering field of GitlabAttestation.
+type GitlabAttestationOrderField struct {
+	// Value extracts the ordering value from the given GitlabAttestation.
+	Value    func(*GitlabAttestation) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) gitlabattestation.OrderOption
+	toCursor func(*GitlabAttestation) Cursor
+}
+
+// GitlabAttestationOrder defines the ordering of GitlabAttestation.
+type GitlabAttestationOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *GitlabAttestationOrderField `json:"field"`
+}
+
+// DefaultGitlabAttestationOrder is the default ordering of GitlabAttestation.
+var DefaultGitlabAttestationOrder = &GitlabAttestationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &GitlabAttestationOrderField{
+		Value: func(_m *GitlabAttestation) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: gitlabattestation.FieldID,
+		toTerm: gitlabattestation.ByID,
+		toCursor: func(_m *GitlabAttestation) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts GitlabAttestation into GitlabAttestationEdge.
+func (_m *GitlabAttestation) ToEdge(order *GitlabAttestationOrder) *GitlabAttestationEdge {
+	if order == nil {
+		order = DefaultGitlabAttestationOrder
+	}
+	return &GitlabAttestationEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// LegalHoldEdge is the edge representation of LegalHold.
+type LegalHoldEdge struct {
+	Node   *LegalHold `json:"node"`
+	Cursor Cursor     `json:"cursor"`
+}
+
+// LegalHoldConnection is the connection containing edges to LegalHold.
+type LegalHoldConnection struct {
+	Edges      []*LegalHoldEdge `json:"edges"`
+	PageInfo   PageInfo         `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
+}
+
+func (c *LegalHoldConnection) build(nodes []*LegalHold, pager *legalholdPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *LegalHold
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *LegalHold {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *LegalHold {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*LegalHoldEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &LegalHoldEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// LegalHoldPaginateOption enables pagination customization.
+type LegalHoldPaginateOption func(*legalholdPager) error
+
+// WithLegalHoldOrder configures pagination ordering.
+func WithLegalHoldOrder(order *LegalHoldOrder) LegalHoldPaginateOption {
+	if order == nil {
+		order = DefaultLegalHoldOrder
+	}
+	o := *order
+	return func(pager *legalholdPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultLegalHoldOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithLegalHoldFilter configures pagination filter.
+func WithLegalHoldFilter(filter func(*LegalHoldQuery) (*LegalHoldQuery, error)) LegalHoldPaginateOption {
+	return func(pager *legalholdPager) error {
+		if filter == nil {
+			return errors.New("LegalHoldQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type legalholdPager struct {
+	reverse bool
+	order   *LegalHoldOrder
+	filter  func(*LegalHoldQuery) (*LegalHoldQuery, error)
+}
+
+func newLegalHoldPager(opts []LegalHoldPaginateOption, reverse bool) (*legalholdPager, error) {
+	pager := &legalholdPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultLegalHoldOrder
+	}
+	return pager, nil
+}
+
+func (p *legalholdPager) applyFilter(query *LegalHoldQuery) (*LegalHoldQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *legalholdPager) toCursor(_m *LegalHold) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *legalholdPager) applyCursors(query *LegalHoldQuery, after, before *Cursor) (*LegalHoldQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultLegalHoldOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *legalholdPager) applyOrder(query *LegalHoldQuery) *LegalHoldQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultLegalHoldOrder.Field {
+		query = query.Order(DefaultLegalHoldOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *legalholdPager) orderExpr(query *LegalHoldQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultLegalHoldOrder.Field {
+			b.Comma().Ident(DefaultLegalHoldOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to LegalHold.
+func (_m *LegalHoldQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...LegalHoldPaginateOption,
+) (*LegalHoldConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newLegalHoldPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &LegalHoldConnection{Edges: []*LegalHoldEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// LegalHoldOrderFieldCreatedAt orders LegalHold by created_at.
+	LegalHoldOrderFieldCreatedAt = &LegalHoldOrderField{
+		Value: func(_m *LegalHold) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: legalhold.FieldCreatedAt,
+		toTerm: legalhold.ByCreatedAt,
+		toCursor: func(_m *LegalHold) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f LegalHoldOrderField) String() string {
+	var str string
+	switch f.column {
+	case LegalHoldOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f LegalHoldOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *LegalHoldOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("LegalHoldOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *LegalHoldOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid LegalHoldOrderField", str)
+	}
+	return nil
+}
+
+// LegalHoldOrderField defines the ordering field of LegalHold.
+type LegalHoldOrderField struct {
+	// Value extracts the ordering value from the given LegalHold.
+	Value    func(*LegalHold) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) legalhold.OrderOption
+	toCursor func(*LegalHold) Cursor
+}
+
+// LegalHoldOrder defines the ordering of LegalHold.
+type LegalHoldOrder struct {
+	Direction OrderDirection       `json:"direction"`
+	Field     *LegalHoldOrderField `json:"field"`
+}
+
+// DefaultLegalHoldOrder is the default ordering of LegalHold.
+var DefaultLegalHoldOrder = &LegalHoldOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &LegalHoldOrderField{
+		Value: func(_m *LegalHold) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: legalhold.FieldID,
+		toTerm: legalhold.ByID,
+		toCursor: func(_m *LegalHold) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts LegalHold into LegalHoldEdge.
+func (_m *LegalHold) ToEdge(order *LegalHoldOrder) *LegalHoldEdge {
+	if order == nil {
+		order = DefaultLegalHoldOrder
+	}
+	return &LegalHoldEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// MaterialEdge is the edge representation of Material.
+type MaterialEdge struct {
+	Node   *Material `json:"node"`
+	Cursor Cursor    `json:"cursor"`
+}
+
+// MaterialConnection is the connection containing edges to Material.
+type MaterialConnection struct {
+	Edges      []*MaterialEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+func (c *MaterialConnection) build(nodes []*Material, pager *materialPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Material
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Material {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Material {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*MaterialEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &MaterialEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// MaterialPaginateOption enables pagination customization.
+type MaterialPaginateOption func(*materialPager) error
+
+// WithMaterialOrder configures pagination ordering.
+func WithMaterialOrder(order *MaterialOrder) MaterialPaginateOption {
+	if order == nil {
+		order = DefaultMaterialOrder
+	}
+	o := *order
+	return func(pager *materialPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultMaterialOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithMaterialFilter configures pagination filter.
+func WithMaterialFilter(filter func(*MaterialQuery) (*MaterialQuery, error)) MaterialPaginateOption {
+	return func(pager *materialPager) error {
+		if filter == nil {
+			return errors.New("MaterialQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type materialPager struct {
+	reverse bool
+	order   *MaterialOrder
+	filter  func(*MaterialQuery) (*MaterialQuery, error)
+}
+
+func newMaterialPager(opts []MaterialPaginateOption, reverse bool) (*materialPager, error) {
+	pager := &materialPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultMaterialOrder
+	}
+	return pager, nil
+}
+
+func (p *materialPager) applyFilter(query *MaterialQuery) (*MaterialQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *materialPager) toCursor(_m *Material) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *materialPager) applyCursors(query *MaterialQuery, after, before *Cursor) (*MaterialQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultMaterialOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *materialPager) applyOrder(query *MaterialQuery) *MaterialQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultMaterialOrder.Field {
+		query = query.Order(DefaultMaterialOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *materialPager) orderExpr(query *MaterialQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultMaterialOrder.Field {
+			b.Comma().Ident(DefaultMaterialOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Material.
+func (_m *MaterialQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...MaterialPaginateOption,
+) (*MaterialConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newMaterialPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &MaterialConnection{Edges: []*MaterialEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// MaterialOrderField defines the ordering field of Material.
+type MaterialOrderField struct {
+	// Value extracts the ordering value from the given Material.
+	Value    func(*Material) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) material.OrderOption
+	toCursor func(*Material) Cursor
+}
+
+// MaterialOrder defines the ordering of Material.
+type MaterialOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *MaterialOrderField `json:"field"`
+}
+
+// DefaultMaterialOrder is the default ordering of Material.
+var DefaultMaterialOrder = &MaterialOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &MaterialOrderField{
+		Value: func(_m *Material) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: material.FieldID,
+		toTerm: material.ByID,
+		toCursor: func(_m *Material) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Material into MaterialEdge.
+func (_m *Material) ToEdge(order *MaterialOrder) *MaterialEdge {
+	if order == nil {
+		order = DefaultMaterialOrder
+	}
+	return &MaterialEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// OciAttestationEdge is the edge representation of OciAttestation.
+type OciAttestationEdge struct {
+	Node   *OciAttestation `json:"node"`
+	Cursor Cursor          `json:"cursor"`
+}
+
+// OciAttestationConnection is the connection containing edges to OciAttestation.
+type OciAttestationConnection struct {
+	Edges      []*OciAttestationEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+func (c *OciAttestationConnection) build(nodes []*OciAttestation, pager *ociattestationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *OciAttestation
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *OciAttestation {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *OciAttestation {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*OciAttestationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &OciAttestationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// OciAttestationPaginateOption enables pagination customization.
+type OciAttestationPaginateOption func(*ociattestationPager) error
+
+// WithOciAttestationOrder configures pagination ordering.
+func WithOciAttestationOrder(order *OciAttestationOrder) OciAttestationPaginateOption {
+	if order == nil {
+		order = DefaultOciAttestationOrder
+	}
+	o := *order
+	return func(pager *ociattestationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultOciAttestationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithOciAttestationFilter configures pagination filter.
+func WithOciAttestationFilter(filter func(*OciAttestationQuery) (*OciAttestationQuery, error)) OciAttestationPaginateOption {
+	return func(pager *ociattestationPager) error {
+		if filter == nil {
+			return errors.New("OciAttestationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type ociattestationPager struct {
+	reverse bool
+	order   *OciAttestationOrder
+	filter  func(*OciAttestationQuery) (*OciAttestationQuery, error)
+}
+
+func newOciAttestationPager(opts []OciAttestationPaginateOption, reverse bool) (*ociattestationPager, error) {
+	pager := &ociattestationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultOciAttestationOrder
+	}
+	return pager, nil
+}
+
+func (p *ociattestationPager) applyFilter(query *OciAttestationQuery) (*OciAttestationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *ociattestationPager) toCursor(_m *OciAttestation) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *ociattestationPager) applyCursors(query *OciAttestationQuery, after, before *Cursor) (*OciAttestationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOciAttestationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *ociattestationPager) applyOrder(query *OciAttestationQuery) *OciAttestationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultOciAttestationOrder.Field {
+		query = query.Order(DefaultOciAttestationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *ociattestationPager) orderExpr(query *OciAttestationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultOciAttestationOrder.Field {
+			b.Comma().Ident(DefaultOciAttestationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to OciAttestation.
+func (_m *OciAttestationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...OciAttestationPaginateOption,
+) (*OciAttestationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newOciAttestationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &OciAttestationConnection{Edges: []*OciAttestationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// OciAttestationOrderField defines the ordering field of OciAttestation.
+type OciAttestationOrderField struct {
+	// Value extracts the ordering value from the given OciAttestation.
+	Value    func(*OciAttestation) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) ociattestation.OrderOption
+	toCursor func(*OciAttestation) Cursor
+}
+
+// OciAttestationOrder defines the ordering of OciAttestation.
+type OciAttestationOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *OciAttestationOrderField `json:"field"`
+}
+
+// DefaultOciAttestationOrder is the default ordering of OciAttestation.
+var DefaultOciAttestationOrder = &OciAttestationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &OciAttestationOrderField{
+		Value: func(_m *OciAttestation) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: ociattestation.FieldID,
+		toTerm: ociattestation.ByID,
+		toCursor: func(_m *OciAttestation) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts OciAttestation into OciAttestationEdge.
+func (_m *OciAttestation) ToEdge(order *OciAttestationOrder) *OciAttestationEdge {
+	if order == nil {
+		order = DefaultOciAttestationOrder
+	}
+	return &OciAttestationEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// PayloadDigestEdge is the edge representation of PayloadDigest.
+type PayloadDigestEdge struct {
+	Node   *PayloadDigest `json:"node"`
+	Cursor Cursor         `json:"cursor"`
+}
+
+// PayloadDigestConnection is the connection containing edges to PayloadDigest.
+type PayloadDigestConnection struct {
+	Edges      []*PayloadDigestEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+func (c *PayloadDigestConnection) build(nodes []*PayloadDigest, pager *payloaddigestPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *PayloadDigest
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *PayloadDigest {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *PayloadDigest {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*PayloadDigestEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &PayloadDigestEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// PayloadDigestPaginateOption enables pagination customization.
+type PayloadDigestPaginateOption func(*payloaddigestPager) error
+
+// WithPayloadDigestOrder configures pagination ordering.
+func WithPayloadDigestOrder(order *PayloadDigestOrder) PayloadDigestPaginateOption {
+	if order == nil {
+		order = DefaultPayloadDigestOrder
+	}
+	o := *order
+	return func(pager *payloaddigestPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultPayloadDigestOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithPayloadDigestFilter configures pagination filter.
+func WithPayloadDigestFilter(filter func(*PayloadDigestQuery) (*PayloadDigestQuery, error)) PayloadDigestPaginateOption {
+	return func(pager *payloaddigestPager) error {
+		if filter == nil {
+			return errors.New("PayloadDigestQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type payloaddigestPager struct {
+	reverse bool
+	order   *PayloadDigestOrder
+	filter  func(*PayloadDigestQuery) (*PayloadDigestQuery, error)
+}
+
+func newPayloadDigestPager(opts []PayloadDigestPaginateOption, reverse bool) (*payloaddigestPager, error) {
+	pager := &payloaddigestPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultPayloadDigestOrder
+	}
+	return pager, nil
+}
+
+func (p *payloaddigestPager) applyFilter(query *PayloadDigestQuery) (*PayloadDigestQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *payloaddigestPager) toCursor(_m *PayloadDigest) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *payloaddigestPager) applyCursors(query *PayloadDigestQuery, after, before *Cursor) (*PayloadDigestQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPayloadDigestOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *payloaddigestPager) applyOrder(query *PayloadDigestQuery) *PayloadDigestQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultPayloadDigestOrder.Field {
+		query = query.Order(DefaultPayloadDigestOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *payloaddigestPager) orderExpr(query *PayloadDigestQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultPayloadDigestOrder.Field {
+			b.Comma().Ident(DefaultPayloadDigestOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to PayloadDigest.
+func (_m *PayloadDigestQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...PayloadDigestPaginateOption,
+) (*PayloadDigestConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newPayloadDigestPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &PayloadDigestConnection{Edges: []*PayloadDigestEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// PayloadDigestOrderField defines the ordering field of PayloadDigest.
+type PayloadDigestOrderField struct {
+	// Value extracts the ordering value from the given PayloadDigest.
+	Value    func(*PayloadDigest) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) payloaddigest.OrderOption
+	toCursor func(*PayloadDigest) Cursor
+}
+
+// PayloadDigestOrder defines the ordering of PayloadDigest.
+type PayloadDigestOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *PayloadDigestOrderField `json:"field"`
+}
+
+// DefaultPayloadDigestOrder is the default ordering of PayloadDigest.
+var DefaultPayloadDigestOrder = &PayloadDigestOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &PayloadDigestOrderField{
+		Value: func(_m *PayloadDigest) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: payloaddigest.FieldID,
+		toTerm: payloaddigest.ByID,
+		toCursor: func(_m *PayloadDigest) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts PayloadDigest into PayloadDigestEdge.
+func (_m *PayloadDigest) ToEdge(order *PayloadDigestOrder) *PayloadDigestEdge {
+	if order == nil {
+		order = DefaultPayloadDigestOrder
+	}
+	return &PayloadDigestEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// ProductEdge is the edge representation of Product.
+type ProductEdge struct {
+	Node   *Product `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// ProductConnection is the connection containing edges to Product.
+type ProductConnection struct {
+	Edges      []*ProductEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (c *ProductConnection) build(nodes []*Product, pager *productPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Product
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Product {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Product {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ProductEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ProductEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ProductPaginateOption enables pagination customization.
+type ProductPaginateOption func(*productPager) error
+
+// WithProductOrder configures pagination ordering.
+func WithProductOrder(order *ProductOrder) ProductPaginateOption {
+	if order == nil {
+		order = DefaultProductOrder
+	}
+	o := *order
+	return func(pager *productPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultProductOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithProductFilter configures pagination filter.
+func WithProductFilter(filter func(*ProductQuery) (*ProductQuery, error)) ProductPaginateOption {
+	return func(pager *productPager) error {
+		if filter == nil {
+			return errors.New("ProductQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type productPager struct {
+	reverse bool
+	order   *ProductOrder
+	filter  func(*ProductQuery) (*ProductQuery, error)
+}
+
+func newProductPager(opts []ProductPaginateOption, reverse bool) (*productPager, error) {
+	pager := &productPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultProductOrder
+	}
+	return pager, nil
+}
+
+func (p *productPager) applyFilter(query *ProductQuery) (*ProductQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *productPager) toCursor(_m *Product) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *productPager) applyCursors(query *ProductQuery, after, before *Cursor) (*ProductQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProductOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *productPager) applyOrder(query *ProductQuery) *ProductQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProductOrder.Field {
+		query = query.Order(DefaultProductOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *productPager) orderExpr(query *ProductQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProductOrder.Field {
+			b.Comma().Ident(DefaultProductOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Product.
+func (_m *ProductQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ProductPaginateOption,
+) (*ProductConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newProductPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &ProductConnection{Edges: []*ProductEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// ProductOrderField defines the ordering field of Product.
+type ProductOrderField struct {
+	// Value extracts the ordering value from the given Product.
+	Value    func(*Product) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) product.OrderOption
+	toCursor func(*Product) Cursor
+}
+
+// ProductOrder defines the ordering of Product.
+type ProductOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *ProductOrderField `json:"field"`
+}
+
+// DefaultProductOrder is the default ordering of Product.
+var DefaultProductOrder = &ProductOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ProductOrderField{
+		Value: func(_m *Product) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: product.FieldID,
+		toTerm: product.ByID,
+		toCursor: func(_m *Product) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Product into ProductEdge.
+func (_m *Product) ToEdge(order *ProductOrder) *ProductEdge {
+	if order == nil {
+		order = DefaultProductOrder
+	}
+	return &ProductEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// PublicationEdge is the edge representation of Publication.
+type PublicationEdge struct {
+	Node   *Publication `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// PublicationConnection is the connection containing edges to Publication.
+type PublicationConnection struct {
+	Edges      []*PublicationEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *PublicationConnection) build(nodes []*Publication, pager *publicationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Publication
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Publication {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Publication {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*PublicationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &PublicationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// PublicationPaginateOption enables pagination customization.
+type PublicationPaginateOption func(*publicationPager) error
+
+// WithPublicationOrder configures pagination ordering.
+func WithPublicationOrder(order *PublicationOrder) PublicationPaginateOption {
+	if order == nil {
+		order = DefaultPublicationOrder
+	}
+	o := *order
+	return func(pager *publicationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultPublicationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithPublicationFilter configures pagination filter.
+func WithPublicationFilter(filter func(*PublicationQuery) (*PublicationQuery, error)) PublicationPaginateOption {
+	return func(pager *publicationPager) error {
+		if filter == nil {
+			return errors.New("PublicationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type publicationPager struct {
+	reverse bool
+	order   *PublicationOrder
+	filter  func(*PublicationQuery) (*PublicationQuery, error)
+}
+
+func newPublicationPager(opts []PublicationPaginateOption, reverse bool) (*publicationPager, error) {
+	pager := &publicationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultPublicationOrder
+	}
+	return pager, nil
+}
+
+func (p *publicationPager) applyFilter(query *PublicationQuery) (*PublicationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *publicationPager) toCursor(_m *Publication) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *publicationPager) applyCursors(query *PublicationQuery, after, before *Cursor) (*PublicationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPublicationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *publicationPager) applyOrder(query *PublicationQuery) *PublicationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultPublicationOrder.Field {
+		query = query.Order(DefaultPublicationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *publicationPager) orderExpr(query *PublicationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultPublicationOrder.Field {
+			b.Comma().Ident(DefaultPublicationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Publication.
+func (_m *PublicationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...PublicationPaginateOption,
+) (*PublicationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newPublicationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &PublicationConnection{Edges: []*PublicationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// PublicationOrderFieldCreatedAt orders Publication by created_at.
+	PublicationOrderFieldCreatedAt = &PublicationOrderField{
+		Value: func(_m *Publication) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: publication.FieldCreatedAt,
+		toTerm: publication.ByCreatedAt,
+		toCursor: func(_m *Publication) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f PublicationOrderField) String() string {
+	var str string
+	switch f.column {
+	case PublicationOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f PublicationOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *PublicationOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("PublicationOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *PublicationOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid PublicationOrderField", str)
+	}
+	return nil
+}
+
+// PublicationOrderField defines the ordering field of Publication.
+type PublicationOrderField struct {
+	// Value extracts the ordering value from the given Publication.
+	Value    func(*Publication) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) publication.OrderOption
+	toCursor func(*Publication) Cursor
+}
+
+// PublicationOrder defines the ordering of Publication.
+type PublicationOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *PublicationOrderField `json:"field"`
+}
+
+// DefaultPublicationOrder is the default ordering of Publication.
+var DefaultPublicationOrder = &PublicationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &PublicationOrderField{
+		Value: func(_m *Publication) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: publication.FieldID,
+		toTerm: publication.ByID,
+		toCursor: func(_m *Publication) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Publication into PublicationEdge.
+func (_m *Publication) ToEdge(order *PublicationOrder) *PublicationEdge {
+	if order == nil {
+		order = DefaultPublicationOrder
+	}
+	return &PublicationEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// PublishDeliveryEdge is the edge representation of PublishDelivery.
+type PublishDeliveryEdge struct {
+	Node   *PublishDelivery `json:"node"`
+	Cursor Cursor           `json:"cursor"`
+}
+
+// PublishDeliveryConnection is the connection containing edges to PublishDelivery.
+type PublishDeliveryConnection struct {
+	Edges      []*PublishDeliveryEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+func (c *PublishDeliveryConnection) build(nodes []*PublishDelivery, pager *publishdeliveryPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *PublishDelivery
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *PublishDelivery {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *PublishDelivery {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*PublishDeliveryEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &PublishDeliveryEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// PublishDeliveryPaginateOption enables pagination customization.
+type PublishDeliveryPaginateOption func(*publishdeliveryPager) error
+
+// WithPublishDeliveryOrder configures pagination ordering.
+func WithPublishDeliveryOrder(order *PublishDeliveryOrder) PublishDeliveryPaginateOption {
+	if order == nil {
+		order = DefaultPublishDeliveryOrder
+	}
+	o := *order
+	return func(pager *publishdeliveryPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultPublishDeliveryOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithPublishDeliveryFilter configures pagination filter.
+func WithPublishDeliveryFilter(filter func(*PublishDeliveryQuery) (*PublishDeliveryQuery, error)) PublishDeliveryPaginateOption {
+	return func(pager *publishdeliveryPager) error {
+		if filter == nil {
+			return errors.New("PublishDeliveryQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type publishdeliveryPager struct {
+	reverse bool
+	order   *PublishDeliveryOrder
+	filter  func(*PublishDeliveryQuery) (*PublishDeliveryQuery, error)
+}
+
+func newPublishDeliveryPager(opts []PublishDeliveryPaginateOption, reverse bool) (*publishdeliveryPager, error) {
+	pager := &publishdeliveryPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultPublishDeliveryOrder
+	}
+	return pager, nil
+}
+
+func (p *publishdeliveryPager) applyFilter(query *PublishDeliveryQuery) (*PublishDeliveryQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *publishdeliveryPager) toCursor(_m *PublishDelivery) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *publishdeliveryPager) applyCursors(query *PublishDeliveryQuery, after, before *Cursor) (*PublishDeliveryQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPublishDeliveryOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *publishdeliveryPager) applyOrder(query *PublishDeliveryQuery) *PublishDeliveryQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultPublishDeliveryOrder.Field {
+		query = query.Order(DefaultPublishDeliveryOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *publishdeliveryPager) orderExpr(query *PublishDeliveryQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultPublishDeliveryOrder.Field {
+			b.Comma().Ident(DefaultPublishDeliveryOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to PublishDelivery.
+func (_m *PublishDeliveryQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...PublishDeliveryPaginateOption,
+) (*PublishDeliveryConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newPublishDeliveryPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &PublishDeliveryConnection{Edges: []*PublishDeliveryEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// PublishDeliveryOrderFieldCreatedAt orders PublishDelivery by created_at.
+	PublishDeliveryOrderFieldCreatedAt = &PublishDeliveryOrderField{
+		Value: func(_m *PublishDelivery) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: publishdelivery.FieldCreatedAt,
+		toTerm: publishdelivery.ByCreatedAt,
+		toCursor: func(_m *PublishDelivery) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f PublishDeliveryOrderField) String() string {
+	var str string
+	switch f.column {
+	case PublishDeliveryOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f PublishDeliveryOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *PublishDeliveryOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("PublishDeliveryOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *PublishDeliveryOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid PublishDeliveryOrderField", str)
+	}
+	return nil
+}
+
+// PublishDeliveryOrderField defines the ordering field of PublishDelivery.
+type PublishDeliveryOrderField struct {
+	// Value extracts the ordering value from the given PublishDelivery.
+	Value    func(*PublishDelivery) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) publishdelivery.OrderOption
+	toCursor func(*PublishDelivery) Cursor
+}
+
+// PublishDeliveryOrder defines the ordering of PublishDelivery.
+type PublishDeliveryOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *PublishDeliveryOrderField `json:"field"`
+}
+
+// DefaultPublishDeliveryOrder is the default ordering of PublishDelivery.
+var DefaultPublishDeliveryOrder = &PublishDeliveryOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &PublishDeliveryOrderField{
+		Value: func(_m *PublishDelivery) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: publishdelivery.FieldID,
+		toTerm: publishdelivery.ByID,
+		toCursor: func(_m *PublishDelivery) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts PublishDelivery into PublishDeliveryEdge.
+func (_m *PublishDelivery) ToEdge(order *PublishDeliveryOrder) *PublishDeliveryEdge {
+	if order == nil {
+		order = DefaultPublishDeliveryOrder
+	}
+	return &PublishDeliveryEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// SbomPackageEdge is the edge representation of SbomPackage.
+type SbomPackageEdge struct {
+	Node   *SbomPackage `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// SbomPackageConnection is the connection containing edges to SbomPackage.
+type SbomPackageConnection struct {
+	Edges      []*SbomPackageEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *SbomPackageConnection) build(nodes []*SbomPackage, pager *sbompackagePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *SbomPackage
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *SbomPackage {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *SbomPackage {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*SbomPackageEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &SbomPackageEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// SbomPackagePaginateOption enables pagination customization.
+type SbomPackagePaginateOption func(*sbompackagePager) error
+
+// WithSbomPackageOrder configures pagination ordering.
+func WithSbomPackageOrder(order *SbomPackageOrder) SbomPackagePaginateOption {
+	if order == nil {
+		order = DefaultSbomPackageOrder
+	}
+	o := *order
+	return func(pager *sbompackagePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultSbomPackageOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithSbomPackageFilter configures pagination filter.
+func WithSbomPackageFilter(filter func(*SbomPackageQuery) (*SbomPackageQuery, error)) SbomPackagePaginateOption {
+	return func(pager *sbompackagePager) error {
+		if filter == nil {
+			return errors.New("SbomPackageQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type sbompackagePager struct {
+	reverse bool
+	order   *SbomPackageOrder
+	filter  func(*SbomPackageQuery) (*SbomPackageQuery, error)
+}
+
+func newSbomPackagePager(opts []SbomPackagePaginateOption, reverse bool) (*sbompackagePager, error) {
+	pager := &sbompackagePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultSbomPackageOrder
+	}
+	return pager, nil
+}
+
+func (p *sbompackagePager) applyFilter(query *SbomPackageQuery) (*SbomPackageQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *sbompackagePager) toCursor(_m *SbomPackage) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *sbompackagePager) applyCursors(query *SbomPackageQuery, after, before *Cursor) (*SbomPackageQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSbomPackageOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *sbompackagePager) applyOrder(query *SbomPackageQuery) *SbomPackageQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSbomPackageOrder.Field {
+		query = query.Order(DefaultSbomPackageOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *sbompackagePager) orderExpr(query *SbomPackageQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSbomPackageOrder.Field {
+			b.Comma().Ident(DefaultSbomPackageOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to SbomPackage.
+func (_m *SbomPackageQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...SbomPackagePaginateOption,
+) (*SbomPackageConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newSbomPackagePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &SbomPackageConnection{Edges: []*SbomPackageEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// SbomPackageOrderField defines the ordering field of SbomPackage.
+type SbomPackageOrderField struct {
+	// Value extracts the ordering value from the given SbomPackage.
+	Value    func(*SbomPackage) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) sbompackage.OrderOption
+	toCursor func(*SbomPackage) Cursor
+}
+
+// SbomPackageOrder defines the ordering of SbomPackage.
+type SbomPackageOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *SbomPackageOrderField `json:"field"`
+}
+
+// DefaultSbomPackageOrder is the default ordering of SbomPackage.
+var DefaultSbomPackageOrder = &SbomPackageOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &SbomPackageOrderField{
+		Value: func(_m *SbomPackage) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: sbompackage.FieldID,
+		toTerm: sbompackage.ByID,
+		toCursor: func(_m *SbomPackage) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts SbomPackage into SbomPackageEdge.
+func (_m *SbomPackage) ToEdge(order *SbomPackageOrder) *SbomPackageEdge {
+	if order == nil {
+		order = DefaultSbomPackageOrder
+	}
+	return &SbomPackageEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// SbomPackageDigestEdge is the edge representation of SbomPackageDigest.
+type SbomPackageDigestEdge struct {
+	Node   *SbomPackageDigest `json:"node"`
+	Cursor Cursor             `json:"cursor"`
+}
+
+// SbomPackageDigestConnection is the connection containing edges to SbomPackageDigest.
+type SbomPackageDigestConnection struct {
+	Edges      []*SbomPackageDigestEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
+}
+
+func (c *SbomPackageDigestConnection) build(nodes []*SbomPackageDigest, pager *sbompackagedigestPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *SbomPackageDigest
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *SbomPackageDigest {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *SbomPackageDigest {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*SbomPackageDigestEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &SbomPackageDigestEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// SbomPackageDigestPaginateOption enables pagination customization.
+type SbomPackageDigestPaginateOption func(*sbompackagedigestPager) error
+
+// WithSbomPackageDigestOrder configures pagination ordering.
+func WithSbomPackageDigestOrder(order *SbomPackageDigestOrder) SbomPackageDigestPaginateOption {
+	if order == nil {
+		order = DefaultSbomPackageDigestOrder
+	}
+	o := *order
+	return func(pager *sbompackagedigestPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultSbomPackageDigestOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithSbomPackageDigestFilter configures pagination filter.
+func WithSbomPackageDigestFilter(filter func(*SbomPackageDigestQuery) (*SbomPackageDigestQuery, error)) SbomPackageDigestPaginateOption {
+	return func(pager *sbompackagedigestPager) error {
+		if filter == nil {
+			return errors.New("SbomPackageDigestQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type sbompackagedigestPager struct {
+	reverse bool
+	order   *SbomPackageDigestOrder
+	filter  func(*SbomPackageDigestQuery) (*SbomPackageDigestQuery, error)
+}
+
+func newSbomPackageDigestPager(opts []SbomPackageDigestPaginateOption, reverse bool) (*sbompackagedigestPager, error) {
+	pager := &sbompackagedigestPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultSbomPackageDigestOrder
+	}
+	return pager, nil
+}
+
+func (p *sbompackagedigestPager) applyFilter(query *SbomPackageDigestQuery) (*SbomPackageDigestQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *sbompackagedigestPager) toCursor(_m *SbomPackageDigest) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *sbompackagedigestPager) applyCursors(query *SbomPackageDigestQuery, after, before *Cursor) (*SbomPackageDigestQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSbomPackageDigestOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *sbompackagedigestPager) applyOrder(query *SbomPackageDigestQuery) *SbomPackageDigestQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSbomPackageDigestOrder.Field {
+		query = query.Order(DefaultSbomPackageDigestOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *sbompackagedigestPager) orderExpr(query *SbomPackageDigestQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSbomPackageDigestOrder.Field {
+			b.Comma().Ident(DefaultSbomPackageDigestOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to SbomPackageDigest.
+func (_m *SbomPackageDigestQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...SbomPackageDigestPaginateOption,
+) (*SbomPackageDigestConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newSbomPackageDigestPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &SbomPackageDigestConnection{Edges: []*SbomPackageDigestEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// SbomPackageDigestOrderField defines the ordering field of SbomPackageDigest.
+type SbomPackageDigestOrderField struct {
+	// Value extracts the ordering value from the given SbomPackageDigest.
+	Value    func(*SbomPackageDigest) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) sbompackagedigest.OrderOption
+	toCursor func(*SbomPackageDigest) Cursor
+}
+
+// SbomPackageDigestOrder defines the ordering of SbomPackageDigest.
+type SbomPackageDigestOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *SbomPackageDigestOrderField `json:"field"`
+}
+
+// DefaultSbomPackageDigestOrder is the default ordering of SbomPackageDigest.
+var DefaultSbomPackageDigestOrder = &SbomPackageDigestOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &SbomPackageDigestOrderField{
+		Value: func(_m *SbomPackageDigest) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: sbompackagedigest.FieldID,
+		toTerm: sbompackagedigest.ByID,
+		toCursor: func(_m *SbomPackageDigest) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts SbomPackageDigest into SbomPackageDigestEdge.
+func (_m *SbomPackageDigest) ToEdge(order *SbomPackageDigestOrder) *SbomPackageDigestEdge {
+	if order == nil {
+		order = DefaultSbomPackageDigestOrder
+	}
+	return &SbomPackageDigestEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// SignatureEdge is the edge representation of Signature.
+type SignatureEdge struct {
+	Node   *Signature `json:"node"`
+	Cursor Cursor     `json:"cursor"`
+}
+
+// SignatureConnection is the connection containing edges to Signature.
+type SignatureConnection struct {
+	Edges      []*SignatureEdge `json:"edges"`
+	PageInfo   PageInfo         `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
+}
+
+func (c *SignatureConnection) build(nodes []*Signature, pager *signaturePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Signature
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Signature {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Signature {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*SignatureEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &SignatureEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// SignaturePaginateOption enables pagination customization.
+type SignaturePaginateOption func(*signaturePager) error
+
+// WithSignatureOrder configures pagination ordering.
+func WithSignatureOrder(order *SignatureOrder) SignaturePaginateOption {
+	if order == nil {
+		order = DefaultSignatureOrder
+	}
+	o := *order
+	return func(pager *signaturePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultSignatureOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithSignatureFilter configures pagination filter.
+func WithSignatureFilter(filter func(*SignatureQuery) (*SignatureQuery, error)) SignaturePaginateOption {
+	return func(pager *signaturePager) error {
+		if filter == nil {
+			return errors.New("SignatureQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type signaturePager struct {
+	reverse bool
+	order   *SignatureOrder
+	filter  func(*SignatureQuery) (*SignatureQuery, error)
+}
+
+func newSignaturePager(opts []SignaturePaginateOption, reverse bool) (*signaturePager, error) {
+	pager := &signaturePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultSignatureOrder
+	}
+	return pager, nil
+}
+
+func (p *signaturePager) applyFilter(query *SignatureQuery) (*SignatureQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *signaturePager) toCursor(_m *Signature) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *signaturePager) applyCursors(query *SignatureQuery, after, before *Cursor) (*SignatureQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSignatureOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *signaturePager) applyOrder(query *SignatureQuery) *SignatureQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSignatureOrder.Field {
+		query = query.Order(DefaultSignatureOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *signaturePager) orderExpr(query *SignatureQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSignatureOrder.Field {
+			b.Comma().Ident(DefaultSignatureOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Signature.
+func (_m *SignatureQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...SignaturePaginateOption,
+) (*SignatureConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newSignaturePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &SignatureConnection{Edges: []*SignatureEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// SignatureOrderField defines the ordering field of Signature.
+type SignatureOrderField struct {
+	// Value extracts the ordering value from the given Signature.
+	Value    func(*Signature) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) signature.OrderOption
+	toCursor func(*Signature) Cursor
+}
+
+// SignatureOrder defines the ordering of Signature.
+type SignatureOrder struct {
+	Direction OrderDirection       `json:"direction"`
+	Field     *SignatureOrderField `json:"field"`
+}
+
+// DefaultSignatureOrder is the default ordering of Signature.
+var DefaultSignatureOrder = &SignatureOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &SignatureOrderField{
+		Value: func(_m *Signature) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: signature.FieldID,
+		toTerm: signature.ByID,
+		toCursor: func(_m *Signature) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Signature into SignatureEdge.
+func (_m *Signature) ToEdge(order *SignatureOrder) *SignatureEdge {
+	if order == nil {
+		order = DefaultSignatureOrder
+	}
+	return &SignatureEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// SlsaDependencyEdge is the edge representation of SlsaDependency.
+type SlsaDependencyEdge struct {
+	Node   *SlsaDependency `json:"node"`
+	Cursor Cursor          `json:"cursor"`
+}
+
+// SlsaDependencyConnection is the connection containing edges to SlsaDependency.
+type SlsaDependencyConnection struct {
+	Edges      []*SlsaDependencyEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+func (c *SlsaDependencyConnection) build(nodes []*SlsaDependency, pager *slsadependencyPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *SlsaDependency
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *SlsaDependency {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *SlsaDependency {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*SlsaDependencyEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &SlsaDependencyEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// SlsaDependencyPaginateOption enables pagination customization.
+type SlsaDependencyPaginateOption func(*slsadependencyPager) error
+
+// WithSlsaDependencyOrder configures pagination ordering.
+func WithSlsaDependencyOrder(order *SlsaDependencyOrder) SlsaDependencyPaginateOption {
+	if order == nil {
+		order = DefaultSlsaDependencyOrder
+	}
+	o := *order
+	return func(pager *slsadependencyPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultSlsaDependencyOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithSlsaDependencyFilter configures pagination filter.
+func WithSlsaDependencyFilter(filter func(*SlsaDependencyQuery) (*SlsaDependencyQuery, error)) SlsaDependencyPaginateOption {
+	return func(pager *slsadependencyPager) error {
+		if filter == nil {
+			return errors.New("SlsaDependencyQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type slsadependencyPager struct {
+	reverse bool
+	order   *SlsaDependencyOrder
+	filter  func(*SlsaDependencyQuery) (*SlsaDependencyQuery, error)
+}
+
+func newSlsaDependencyPager(opts []SlsaDependencyPaginateOption, reverse bool) (*slsadependencyPager, error) {
+	pager := &slsadependencyPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultSlsaDependencyOrder
+	}
+	return pager, nil
+}
+
+func (p *slsadependencyPager) applyFilter(query *SlsaDependencyQuery) (*SlsaDependencyQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *slsadependencyPager) toCursor(_m *SlsaDependency) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *slsadependencyPager) applyCursors(query *SlsaDependencyQuery, after, before *Cursor) (*SlsaDependencyQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSlsaDependencyOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *slsadependencyPager) applyOrder(query *SlsaDependencyQuery) *SlsaDependencyQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSlsaDependencyOrder.Field {
+		query = query.Order(DefaultSlsaDependencyOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *slsadependencyPager) orderExpr(query *SlsaDependencyQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSlsaDependencyOrder.Field {
+			b.Comma().Ident(DefaultSlsaDependencyOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to SlsaDependency.
+func (_m *SlsaDependencyQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...SlsaDependencyPaginateOption,
+) (*SlsaDependencyConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newSlsaDependencyPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &SlsaDependencyConnection{Edges: []*SlsaDependencyEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// SlsaDependencyOrderField defines the ordering field of SlsaDependency.
+type SlsaDependencyOrderField struct {
+	// Value extracts the ordering value from the given SlsaDependency.
+	Value    func(*SlsaDependency) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) slsadependency.OrderOption
+	toCursor func(*SlsaDependency) Cursor
+}
+
+// SlsaDependencyOrder defines the ordering of SlsaDependency.
+type SlsaDependencyOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *SlsaDependencyOrderField `json:"field"`
+}
+
+// DefaultSlsaDependencyOrder is the default ordering of SlsaDependency.
+var DefaultSlsaDependencyOrder = &SlsaDependencyOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &SlsaDependencyOrderField{
+		Value: func(_m *SlsaDependency) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: slsadependency.FieldID,
+		toTerm: slsadependency.ByID,
+		toCursor: func(_m *SlsaDependency) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts SlsaDependency into SlsaDependencyEdge.
+func (_m *SlsaDependency) ToEdge(order *SlsaDependencyOrder) *SlsaDependencyEdge {
+	if order == nil {
+		order = DefaultSlsaDependencyOrder
+	}
+	return &SlsaDependencyEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// SlsaProvenanceEdge is the edge representation of SlsaProvenance.
+type SlsaProvenanceEdge struct {
+	Node   *SlsaProvenance `json:"node"`
+	Cursor Cursor          `json:"cursor"`
+}
+
+// SlsaProvenanceConnection is the connection containing edges to SlsaProvenance.
+type SlsaProvenanceConnection struct {
+	Edges      []*SlsaProvenanceEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+func (c *SlsaProvenanceConnection) build(nodes []*SlsaProvenance, pager *slsaprovenancePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *SlsaProvenance
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *SlsaProvenance {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *SlsaProvenance {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*SlsaProvenanceEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &SlsaProvenanceEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// SlsaProvenancePaginateOption enables pagination customization.
+type SlsaProvenancePaginateOption func(*slsaprovenancePager) error
+
+// WithSlsaProvenanceOrder configures pagination ordering.
+func WithSlsaProvenanceOrder(order *SlsaProvenanceOrder) SlsaProvenancePaginateOption {
+	if order == nil {
+		order = DefaultSlsaProvenanceOrder
+	}
+	o := *order
+	return func(pager *slsaprovenancePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultSlsaProvenanceOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithSlsaProvenanceFilter configures pagination filter.
+func WithSlsaProvenanceFilter(filter func(*SlsaProvenanceQuery) (*SlsaProvenanceQuery, error)) SlsaProvenancePaginateOption {
+	return func(pager *slsaprovenancePager) error {
+		if filter == nil {
+			return errors.New("SlsaProvenanceQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type slsaprovenancePager struct {
+	reverse bool
+	order   *SlsaProvenanceOrder
+	filter  func(*SlsaProvenanceQuery) (*SlsaProvenanceQuery, error)
+}
+
+func newSlsaProvenancePager(opts []SlsaProvenancePaginateOption, reverse bool) (*slsaprovenancePager, error) {
+	pager := &slsaprovenancePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultSlsaProvenanceOrder
+	}
+	return pager, nil
+}
+
+func (p *slsaprovenancePager) applyFilter(query *SlsaProvenanceQuery) (*SlsaProvenanceQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *slsaprovenancePager) toCursor(_m *SlsaProvenance) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *slsaprovenancePager) applyCursors(query *SlsaProvenanceQuery, after, before *Cursor) (*SlsaProvenanceQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSlsaProvenanceOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *slsaprovenancePager) applyOrder(query *SlsaProvenanceQuery) *SlsaProvenanceQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSlsaProvenanceOrder.Field {
+		query = query.Order(DefaultSlsaProvenanceOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *slsaprovenancePager) orderExpr(query *SlsaProvenanceQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSlsaProvenanceOrder.Field {
+			b.Comma().Ident(DefaultSlsaProvenanceOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to SlsaProvenance.
+func (_m *SlsaProvenanceQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...SlsaProvenancePaginateOption,
+) (*SlsaProvenanceConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newSlsaProvenancePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &SlsaProvenanceConnection{Edges: []*SlsaProvenanceEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// SlsaProvenanceOrderField defines the ordering field of SlsaProvenance.
+type SlsaProvenanceOrderField struct {
+	// Value extracts the ordering value from the given SlsaProvenance.
+	Value    func(*SlsaProvenance) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) slsaprovenance.OrderOption
+	toCursor func(*SlsaProvenance) Cursor
+}
+
+// SlsaProvenanceOrder defines the ordering of SlsaProvenance.
+type SlsaProvenanceOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *SlsaProvenanceOrderField `json:"field"`
+}
+
+// DefaultSlsaProvenanceOrder is the default ordering of SlsaProvenance.
+var DefaultSlsaProvenanceOrder = &SlsaProvenanceOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &SlsaProvenanceOrderField{
+		Value: func(_m *SlsaProvenance) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: slsaprovenance.FieldID,
+		toTerm: slsaprovenance.ByID,
+		toCursor: func(_m *SlsaProvenance) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts SlsaProvenance into SlsaProvenanceEdge.
+func (_m *SlsaProvenance) ToEdge(order *SlsaProvenanceOrder) *SlsaProvenanceEdge {
+	if order == nil {
+		order = DefaultSlsaProvenanceOrder
+	}
+	return &SlsaProvenanceEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// StatementEdge is the edge representation of Statement.
+type StatementEdge struct {
+	Node   *Statement `json:"node"`
+	Cursor Cursor     `json:"cursor"`
+}
+
+// StatementConnection is the connection containing edges to Statement.
+type StatementConnection struct {
+	Edges      []*StatementEdge `json:"edges"`
+	PageInfo   PageInfo         `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
+}
+
+func (c *StatementConnection) build(nodes []*Statement, pager *statementPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Statement
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Statement {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Statement {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*StatementEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &StatementEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// StatementPaginateOption enables pagination customization.
+type StatementPaginateOption func(*statementPager) error
+
+// WithStatementOrder configures pagination ordering.
+func WithStatementOrder(order *StatementOrder) StatementPaginateOption {
+	if order == nil {
+		order = DefaultStatementOrder
+	}
+	o := *order
+	return func(pager *statementPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultStatementOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithStatementFilter configures pagination filter.
+func WithStatementFilter(filter func(*StatementQuery) (*StatementQuery, error)) StatementPaginateOption {
+	return func(pager *statementPager) error {
+		if filter == nil {
+			return errors.New("StatementQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type statementPager struct {
+	reverse bool
+	order   *StatementOrder
+	filter  func(*StatementQuery) (*StatementQuery, error)
+}
+
+func newStatementPager(opts []StatementPaginateOption, reverse bool) (*statementPager, error) {
+	pager := &statementPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultStatementOrder
+	}
+	return pager, nil
+}
+
+func (p *statementPager) applyFilter(query *StatementQuery) (*StatementQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *statementPager) toCursor(_m *Statement) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *statementPager) applyCursors(query *StatementQuery, after, before *Cursor) (*StatementQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultStatementOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *statementPager) applyOrder(query *StatementQuery) *StatementQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultStatementOrder.Field {
+		query = query.Order(DefaultStatementOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *statementPager) orderExpr(query *StatementQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultStatementOrder.Field {
+			b.Comma().Ident(DefaultStatementOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Statement.
+func (_m *StatementQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...StatementPaginateOption,
+) (*StatementConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newStatementPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &StatementConnection{Edges: []*StatementEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// StatementOrderField defines the ordering field of Statement.
+type StatementOrderField struct {
+	// Value extracts the ordering value from the given Statement.
+	Value    func(*Statement) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) statement.OrderOption
+	toCursor func(*Statement) Cursor
+}
+
+// StatementOrder defines the ordering of Statement.
+type StatementOrder struct {
+	Direction OrderDirection       `json:"direction"`
+	Field     *StatementOrderField `json:"field"`
+}
+
+// DefaultStatementOrder is the default ordering of Statement.
+var DefaultStatementOrder = &StatementOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &StatementOrderField{
+		Value: func(_m *Statement) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: statement.FieldID,
+		toTerm: statement.ByID,
+		toCursor: func(_m *Statement) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Statement into StatementEdge.
+func (_m *Statement) ToEdge(order *StatementOrder) *StatementEdge {
+	if order == nil {
+		order = DefaultStatementOrder
+	}
+	return &StatementEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// SubjectEdge is the edge representation of Subject.
+type SubjectEdge struct {
+	Node   *Subject `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// SubjectConnection is the connection containing edges to Subject.
+type SubjectConnection struct {
+	Edges      []*SubjectEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (c *SubjectConnection) build(nodes []*Subject, pager *subjectPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Subject
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Subject {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Subject {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*SubjectEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &SubjectEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// SubjectPaginateOption enables pagination customization.
+type SubjectPaginateOption func(*subjectPager) error
+
+// WithSubjectOrder configures pagination ordering.
+func WithSubjectOrder(order *SubjectOrder) SubjectPaginateOption {
+	if order == nil {
+		order = DefaultSubjectOrder
+	}
+	o := *order
+	return func(pager *subjectPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultSubjectOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithSubjectFilter configures pagination filter.
+func WithSubjectFilter(filter func(*SubjectQuery) (*SubjectQuery, error)) SubjectPaginateOption {
+	return func(pager *subjectPager) error {
+		if filter == nil {
+			return errors.New("SubjectQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type subjectPager struct {
+	reverse bool
+	order   *SubjectOrder
+	filter  func(*SubjectQuery) (*SubjectQuery, error)
+}
+
+func newSubjectPager(opts []SubjectPaginateOption, reverse bool) (*subjectPager, error) {
+	pager := &subjectPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultSubjectOrder
+	}
+	return pager, nil
+}
+
+func (p *subjectPager) applyFilter(query *SubjectQuery) (*SubjectQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *subjectPager) toCursor(_m *Subject) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *subjectPager) applyCursors(query *SubjectQuery, after, before *Cursor) (*SubjectQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSubjectOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *subjectPager) applyOrder(query *SubjectQuery) *SubjectQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSubjectOrder.Field {
+		query = query.Order(DefaultSubjectOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *subjectPager) orderExpr(query *SubjectQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSubjectOrder.Field {
+			b.Comma().Ident(DefaultSubjectOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Subject.
+func (_m *SubjectQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...SubjectPaginateOption,
+) (*SubjectConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newSubjectPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &SubjectConnection{Edges: []*SubjectEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// SubjectOrderFieldCreatedAt orders Subject by created_at.
+	SubjectOrderFieldCreatedAt = &SubjectOrderField{
+		Value: func(_m *Subject) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: subject.FieldCreatedAt,
+		toTerm: subject.ByCreatedAt,
+		toCursor: func(_m *Subject) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f SubjectOrderField) String() string {
+	var str string
+	switch f.column {
+	case SubjectOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f SubjectOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *SubjectOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("SubjectOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *SubjectOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid SubjectOrderField", str)
+	}
+	return nil
+}
+
+// SubjectOrderField defines the ordering field of Subject.
+type SubjectOrderField struct {
+	// Value extracts the ordering value from the given Subject.
+	Value    func(*Subject) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) subject.OrderOption
+	toCursor func(*Subject) Cursor
+}
+
+// SubjectOrder defines the ordering of Subject.
+type SubjectOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *SubjectOrderField `json:"field"`
+}
+
+// DefaultSubjectOrder is the default ordering of Subject.
+var DefaultSubjectOrder = &SubjectOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &SubjectOrderField{
+		Value: func(_m *Subject) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: subject.FieldID,
+		toTerm: subject.ByID,
+		toCursor: func(_m *Subject) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Subject into SubjectEdge.
+func (_m *Subject) ToEdge(order *SubjectOrder) *SubjectEdge {
+	if order == nil {
+		order = DefaultSubjectOrder
+	}
+	return &SubjectEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// SubjectAltNameEdge is the edge representation of SubjectAltName.
+type SubjectAltNameEdge struct {
+	Node   *SubjectAltName `json:"node"`
+	Cursor Cursor          `json:"cursor"`
+}
+
+// SubjectAltNameConnection is the connection containing edges to SubjectAltName.
+type SubjectAltNameConnection struct {
+	Edges      []*SubjectAltNameEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+func (c *SubjectAltNameConnection) build(nodes []*SubjectAltName, pager *subjectaltnamePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *SubjectAltName
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *SubjectAltName {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *SubjectAltName {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*SubjectAltNameEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &SubjectAltNameEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// SubjectAltNamePaginateOption enables pagination customization.
+type SubjectAltNamePaginateOption func(*subjectaltnamePager) error
+
+// WithSubjectAltNameOrder configures pagination ordering.
+func WithSubjectAltNameOrder(order *SubjectAltNameOrder) SubjectAltNamePaginateOption {
+	if order == nil {
+		order = DefaultSubjectAltNameOrder
+	}
+	o := *order
+	return func(pager *subjectaltnamePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultSubjectAltNameOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithSubjectAltNameFilter configures pagination filter.
+func WithSubjectAltNameFilter(filter func(*SubjectAltNameQuery) (*SubjectAltNameQuery, error)) SubjectAltNamePaginateOption {
+	return func(pager *subjectaltnamePager) error {
+		if filter == nil {
+			return errors.New("SubjectAltNameQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type subjectaltnamePager struct {
+	reverse bool
+	order   *SubjectAltNameOrder
+	filter  func(*SubjectAltNameQuery) (*SubjectAltNameQuery, error)
+}
+
+func newSubjectAltNamePager(opts []SubjectAltNamePaginateOption, reverse bool) (*subjectaltnamePager, error) {
+	pager := &subjectaltnamePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultSubjectAltNameOrder
 	}
 	return pager, nil
 }
 
-func (p *signaturePager) applyFilter(query *SignatureQuery) (*SignatureQuery, error) {
+func (p *subjectaltnamePager) applyFilter(query *SubjectAltNameQuery) (*SubjectAltNameQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *signaturePager) toCursor(_m *Signature) Cursor {
+func (p *subjectaltnamePager) toCursor(_m *SubjectAltName) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *signaturePager) applyCursors(query *SignatureQuery, after, before *Cursor) (*SignatureQuery, error) {
+func (p *subjectaltnamePager) applyCursors(query *SubjectAltNameQuery, after, before *Cursor) (*SubjectAltNameQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSignatureOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSubjectAltNameOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *signaturePager) applyOrder(query *SignatureQuery) *SignatureQuery {
+func (p *subjectaltnamePager) applyOrder(query *SubjectAltNameQuery) *SubjectAltNameQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultSignatureOrder.Field {
-		query = query.Order(DefaultSignatureOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSubjectAltNameOrder.Field {
+		query = query.Order(DefaultSubjectAltNameOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1539,7 +6477,7 @@ func (p *signaturePager) applyOrder(query *SignatureQuery) *SignatureQuery {
 	return query
 }
 
-func (p *signaturePager) orderExpr(query *SignatureQuery) sql.Querier {
+func (p *subjectaltnamePager) orderExpr(query *SubjectAltNameQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1549,28 +6487,28 @@ func (p *signaturePager) orderExpr(query *SignatureQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultSignatureOrder.Field {
-			b.Comma().Ident(DefaultSignatureOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSubjectAltNameOrder.Field {
+			b.Comma().Ident(DefaultSubjectAltNameOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Signature.
-func (_m *SignatureQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to SubjectAltName.
+func (_m *SubjectAltNameQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...SignaturePaginateOption,
-) (*SignatureConnection, error) {
+	before *Cursor, last *int, opts ...SubjectAltNamePaginateOption,
+) (*SubjectAltNameConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newSignaturePager(opts, last != nil)
+	pager, err := newSubjectAltNamePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &SignatureConnection{Edges: []*SignatureEdge{}}
+	conn := &SubjectAltNameConnection{Edges: []*SubjectAltNameEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -1608,61 +6546,61 @@ func (_m *SignatureQuery) Paginate(
 	return conn, nil
 }
 
-// SignatureOrderField defines the ordering field of Signature.
-type SignatureOrderField struct {
-	// Value extracts the ordering value from the given Signature.
-	Value    func(*Signature) (ent.Value, error)
+// SubjectAltNameOrderField defines the ordering field of SubjectAltName.
+type SubjectAltNameOrderField struct {
+	// Value extracts the ordering value from the given SubjectAltName.
+	Value    func(*SubjectAltName) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) signature.OrderOption
-	toCursor func(*Signature) Cursor
+	toTerm   func(...sql.OrderTermOption) subjectaltname.OrderOption
+	toCursor func(*SubjectAltName) Cursor
 }
 
-// SignatureOrder defines the ordering of Signature.
-type SignatureOrder struct {
-	Direction OrderDirection       `json:"direction"`
-	Field     *SignatureOrderField `json:"field"`
+// SubjectAltNameOrder defines the ordering of SubjectAltName.
+type SubjectAltNameOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *SubjectAltNameOrderField `json:"field"`
 }
 
-// DefaultSignatureOrder is the default ordering of Signature.
-var DefaultSignatureOrder = &SignatureOrder{
+// DefaultSubjectAltNameOrder is the default ordering of SubjectAltName.
+var DefaultSubjectAltNameOrder = &SubjectAltNameOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &SignatureOrderField{
-		Value: func(_m *Signature) (ent.Value, error) {
+	Field: &SubjectAltNameOrderField{
+		Value: func(_m *SubjectAltName) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: signature.FieldID,
-		toTerm: signature.ByID,
-		toCursor: func(_m *Signature) Cursor {
+		column: subjectaltname.FieldID,
+		toTerm: subjectaltname.ByID,
+		toCursor: func(_m *SubjectAltName) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Signature into SignatureEdge.
-func (_m *Signature) ToEdge(order *SignatureOrder) *SignatureEdge {
+// ToEdge converts SubjectAltName into SubjectAltNameEdge.
+func (_m *SubjectAltName) ToEdge(order *SubjectAltNameOrder) *SubjectAltNameEdge {
 	if order == nil {
-		order = DefaultSignatureOrder
+		order = DefaultSubjectAltNameOrder
 	}
-	return &SignatureEdge{
+	return &SubjectAltNameEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// StatementEdge is the edge representation of Statement.
-type StatementEdge struct {
-	Node   *Statement `json:"node"`
-	Cursor Cursor     `json:"cursor"`
+// SubjectDigestEdge is the edge representation of SubjectDigest.
+type SubjectDigestEdge struct {
+	Node   *SubjectDigest `json:"node"`
+	Cursor Cursor         `json:"cursor"`
 }
 
-// StatementConnection is the connection containing edges to Statement.
-type StatementConnection struct {
-	Edges      []*StatementEdge `json:"edges"`
-	PageInfo   PageInfo         `json:"pageInfo"`
-	TotalCount int              `json:"totalCount"`
+// SubjectDigestConnection is the connection containing edges to SubjectDigest.
+type SubjectDigestConnection struct {
+	Edges      []*SubjectDigestEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
 }
 
-func (c *StatementConnection) build(nodes []*Statement, pager *statementPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *SubjectDigestConnection) build(nodes []*SubjectDigest, pager *subjectdigestPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1672,21 +6610,21 @@ func (c *StatementConnection) build(nodes []*Statement, pager *statementPager, a
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Statement
+	var nodeAt func(int) *SubjectDigest
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Statement {
+		nodeAt = func(i int) *SubjectDigest {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Statement {
+		nodeAt = func(i int) *SubjectDigest {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*StatementEdge, len(nodes))
+	c.Edges = make([]*SubjectDigestEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &StatementEdge{
+		c.Edges[i] = &SubjectDigestEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1700,87 +6638,87 @@ func (c *StatementConnection) build(nodes []*Statement, pager *statementPager, a
 	}
 }
 
-// StatementPaginateOption enables pagination customization.
-type StatementPaginateOption func(*statementPager) error
+// SubjectDigestPaginateOption enables pagination customization.
+type SubjectDigestPaginateOption func(*subjectdigestPager) error
 
-// WithStatementOrder configures pagination ordering.
-func WithStatementOrder(order *StatementOrder) StatementPaginateOption {
+// WithSubjectDigestOrder configures pagination ordering.
+func WithSubjectDigestOrder(order *SubjectDigestOrder) SubjectDigestPaginateOption {
 	if order == nil {
-		order = DefaultStatementOrder
+		order = DefaultSubjectDigestOrder
 	}
 	o := *order
-	return func(pager *statementPager) error {
+	return func(pager *subjectdigestPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultStatementOrder.Field
+			o.Field = DefaultSubjectDigestOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithStatementFilter configures pagination filter.
-func WithStatementFilter(filter func(*StatementQuery) (*StatementQuery, error)) StatementPaginateOption {
-	return func(pager *statementPager) error {
+// WithSubjectDigestFilter configures pagination filter.
+func WithSubjectDigestFilter(filter func(*SubjectDigestQuery) (*SubjectDigestQuery, error)) SubjectDigestPaginateOption {
+	return func(pager *subjectdigestPager) error {
 		if filter == nil {
-			return errors.New("StatementQuery filter cannot be nil")
+			return errors.New("SubjectDigestQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type statementPager struct {
+type subjectdigestPager struct {
 	reverse bool
-	order   *StatementOrder
-	filter  func(*StatementQuery) (*StatementQuery, error)
+	order   *SubjectDigestOrder
+	filter  func(*SubjectDigestQuery) (*SubjectDigestQuery, error)
 }
 
-func newStatementPager(opts []StatementPaginateOption, reverse bool) (*statementPager, error) {
-	pager := &statementPager{reverse: reverse}
+func newSubjectDigestPager(opts []SubjectDigestPaginateOption, reverse bool) (*subjectdigestPager, error) {
+	pager := &subjectdigestPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultStatementOrder
+		pager.order = DefaultSubjectDigestOrder
 	}
 	return pager, nil
 }
 
-func (p *statementPager) applyFilter(query *StatementQuery) (*StatementQuery, error) {
+func (p *subjectdigestPager) applyFilter(query *SubjectDigestQuery) (*SubjectDigestQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *statementPager) toCursor(_m *Statement) Cursor {
+func (p *subjectdigestPager) toCursor(_m *SubjectDigest) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *statementPager) applyCursors(query *StatementQuery, after, before *Cursor) (*StatementQuery, error) {
+func (p *subjectdigestPager) applyCursors(query *SubjectDigestQuery, after, before *Cursor) (*SubjectDigestQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultStatementOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSubjectDigestOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *statementPager) applyOrder(query *StatementQuery) *StatementQuery {
+func (p *subjectdigestPager) applyOrder(query *SubjectDigestQuery) *SubjectDigestQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultStatementOrder.Field {
-		query = query.Order(DefaultStatementOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSubjectDigestOrder.Field {
+		query = query.Order(DefaultSubjectDigestOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1788,7 +6726,7 @@ func (p *statementPager) applyOrder(query *StatementQuery) *StatementQuery {
 	return query
 }
 
-func (p *statementPager) orderExpr(query *StatementQuery) sql.Querier {
+func (p *subjectdigestPager) orderExpr(query *SubjectDigestQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1798,28 +6736,28 @@ func (p *statementPager) orderExpr(query *StatementQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultStatementOrder.Field {
-			b.Comma().Ident(DefaultStatementOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSubjectDigestOrder.Field {
+			b.Comma().Ident(DefaultSubjectDigestOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Statement.
-func (_m *StatementQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to SubjectDigest.
+func (_m *SubjectDigestQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...StatementPaginateOption,
-) (*StatementConnection, error) {
+	before *Cursor, last *int, opts ...SubjectDigestPaginateOption,
+) (*SubjectDigestConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newStatementPager(opts, last != nil)
+	pager, err := newSubjectDigestPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &StatementConnection{Edges: []*StatementEdge{}}
+	conn := &SubjectDigestConnection{Edges: []*SubjectDigestEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -1857,61 +6795,61 @@ func (_m *StatementQuery) Paginate(
 	return conn, nil
 }
 
-// StatementOrderField defines the ordering field of Statement.
-type StatementOrderField struct {
-	// Value extracts the ordering value from the given Statement.
-	Value    func(*Statement) (ent.Value, error)
+// SubjectDigestOrderField defines the ordering field of SubjectDigest.
+type SubjectDigestOrderField struct {
+	// Value extracts the ordering value from the given SubjectDigest.
+	Value    func(*SubjectDigest) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) statement.OrderOption
-	toCursor func(*Statement) Cursor
+	toTerm   func(...sql.OrderTermOption) subjectdigest.OrderOption
+	toCursor func(*SubjectDigest) Cursor
 }
 
-// StatementOrder defines the ordering of Statement.
-type StatementOrder struct {
-	Direction OrderDirection       `json:"direction"`
-	Field     *StatementOrderField `json:"field"`
+// SubjectDigestOrder defines the ordering of SubjectDigest.
+type SubjectDigestOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *SubjectDigestOrderField `json:"field"`
 }
 
-// DefaultStatementOrder is the default ordering of Statement.
-var DefaultStatementOrder = &StatementOrder{
+// DefaultSubjectDigestOrder is the default ordering of SubjectDigest.
+var DefaultSubjectDigestOrder = &SubjectDigestOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &StatementOrderField{
-		Value: func(_m *Statement) (ent.Value, error) {
+	Field: &SubjectDigestOrderField{
+		Value: func(_m *SubjectDigest) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: statement.FieldID,
-		toTerm: statement.ByID,
-		toCursor: func(_m *Statement) Cursor {
+		column: subjectdigest.FieldID,
+		toTerm: subjectdigest.ByID,
+		toCursor: func(_m *SubjectDigest) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Statement into StatementEdge.
-func (_m *Statement) ToEdge(order *StatementOrder) *StatementEdge {
+// ToEdge converts SubjectDigest into SubjectDigestEdge.
+func (_m *SubjectDigest) ToEdge(order *SubjectDigestOrder) *SubjectDigestEdge {
 	if order == nil {
-		order = DefaultStatementOrder
+		order = DefaultSubjectDigestOrder
 	}
-	return &StatementEdge{
+	return &SubjectDigestEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// SubjectEdge is the edge representation of Subject.
-type SubjectEdge struct {
-	Node   *Subject `json:"node"`
-	Cursor Cursor   `json:"cursor"`
+// TimestampEdge is the edge representation of Timestamp.
+type TimestampEdge struct {
+	Node   *Timestamp `json:"node"`
+	Cursor Cursor     `json:"cursor"`
 }
 
-// SubjectConnection is the connection containing edges to Subject.
-type SubjectConnection struct {
-	Edges      []*SubjectEdge `json:"edges"`
-	PageInfo   PageInfo       `json:"pageInfo"`
-	TotalCount int            `json:"totalCount"`
+// TimestampConnection is the connection containing edges to Timestamp.
+type TimestampConnection struct {
+	Edges      []*TimestampEdge `json:"edges"`
+	PageInfo   PageInfo         `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
 }
 
-func (c *SubjectConnection) build(nodes []*Subject, pager *subjectPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *TimestampConnection) build(nodes []*Timestamp, pager *timestampPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1921,21 +6859,21 @@ func (c *SubjectConnection) build(nodes []*Subject, pager *subjectPager, after *
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Subject
+	var nodeAt func(int) *Timestamp
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Subject {
+		nodeAt = func(i int) *Timestamp {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Subject {
+		nodeAt = func(i int) *Timestamp {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*SubjectEdge, len(nodes))
+	c.Edges = make([]*TimestampEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &SubjectEdge{
+		c.Edges[i] = &TimestampEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1949,87 +6887,87 @@ func (c *SubjectConnection) build(nodes []*Subject, pager *subjectPager, after *
 	}
 }
 
-// SubjectPaginateOption enables pagination customization.
-type SubjectPaginateOption func(*subjectPager) error
+// TimestampPaginateOption enables pagination customization.
+type TimestampPaginateOption func(*timestampPager) error
 
-// WithSubjectOrder configures pagination ordering.
-func WithSubjectOrder(order *SubjectOrder) SubjectPaginateOption {
+// WithTimestampOrder configures pagination ordering.
+func WithTimestampOrder(order *TimestampOrder) TimestampPaginateOption {
 	if order == nil {
-		order = DefaultSubjectOrder
+		order = DefaultTimestampOrder
 	}
 	o := *order
-	return func(pager *subjectPager) error {
+	return func(pager *timestampPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultSubjectOrder.Field
+			o.Field = DefaultTimestampOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithSubjectFilter configures pagination filter.
-func WithSubjectFilter(filter func(*SubjectQuery) (*SubjectQuery, error)) SubjectPaginateOption {
-	return func(pager *subjectPager) error {
+// WithTimestampFilter configures pagination filter.
+func WithTimestampFilter(filter func(*TimestampQuery) (*TimestampQuery, error)) TimestampPaginateOption {
+	return func(pager *timestampPager) error {
 		if filter == nil {
-			return errors.New("SubjectQuery filter cannot be nil")
+			return errors.New("TimestampQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type subjectPager struct {
+type timestampPager struct {
 	reverse bool
-	order   *SubjectOrder
-	filter  func(*SubjectQuery) (*SubjectQuery, error)
+	order   *TimestampOrder
+	filter  func(*TimestampQuery) (*TimestampQuery, error)
 }
 
-func newSubjectPager(opts []SubjectPaginateOption, reverse bool) (*subjectPager, error) {
-	pager := &subjectPager{reverse: reverse}
+func newTimestampPager(opts []TimestampPaginateOption, reverse bool) (*timestampPager, error) {
+	pager := &timestampPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultSubjectOrder
+		pager.order = DefaultTimestampOrder
 	}
 	return pager, nil
 }
 
-func (p *subjectPager) applyFilter(query *SubjectQuery) (*SubjectQuery, error) {
+func (p *timestampPager) applyFilter(query *TimestampQuery) (*TimestampQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *subjectPager) toCursor(_m *Subject) Cursor {
+func (p *timestampPager) toCursor(_m *Timestamp) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *subjectPager) applyCursors(query *SubjectQuery, after, before *Cursor) (*SubjectQuery, error) {
+func (p *timestampPager) applyCursors(query *TimestampQuery, after, before *Cursor) (*TimestampQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSubjectOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultTimestampOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *subjectPager) applyOrder(query *SubjectQuery) *SubjectQuery {
+func (p *timestampPager) applyOrder(query *TimestampQuery) *TimestampQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultSubjectOrder.Field {
-		query = query.Order(DefaultSubjectOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultTimestampOrder.Field {
+		query = query.Order(DefaultTimestampOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -2037,7 +6975,7 @@ func (p *subjectPager) applyOrder(query *SubjectQuery) *SubjectQuery {
 	return query
 }
 
-func (p *subjectPager) orderExpr(query *SubjectQuery) sql.Querier {
+func (p *timestampPager) orderExpr(query *TimestampQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -2047,28 +6985,28 @@ func (p *subjectPager) orderExpr(query *SubjectQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultSubjectOrder.Field {
-			b.Comma().Ident(DefaultSubjectOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultTimestampOrder.Field {
+			b.Comma().Ident(DefaultTimestampOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Subject.
-func (_m *SubjectQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Timestamp.
+func (_m *TimestampQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...SubjectPaginateOption,
-) (*SubjectConnection, error) {
+	before *Cursor, last *int, opts ...TimestampPaginateOption,
+) (*TimestampConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newSubjectPager(opts, last != nil)
+	pager, err := newTimestampPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &SubjectConnection{Edges: []*SubjectEdge{}}
+	conn := &TimestampConnection{Edges: []*TimestampEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -2106,108 +7044,61 @@ func (_m *SubjectQuery) Paginate(
 	return conn, nil
 }
 
-var (
-	// SubjectOrderFieldCreatedAt orders Subject by created_at.
-	SubjectOrderFieldCreatedAt = &SubjectOrderField{
-		Value: func(_m *Subject) (ent.Value, error) {
-			return _m.CreatedAt, nil
-		},
-		column: subject.FieldCreatedAt,
-		toTerm: subject.ByCreatedAt,
-		toCursor: func(_m *Subject) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.CreatedAt,
-			}
-		},
-	}
-)
-
-// String implement fmt.Stringer interface.
-func (f SubjectOrderField) String() string {
-	var str string
-	switch f.column {
-	case SubjectOrderFieldCreatedAt.column:
-		str = "CREATED_AT"
-	}
-	return str
-}
-
-// MarshalGQL implements graphql.Marshaler interface.
-func (f SubjectOrderField) MarshalGQL(w io.Writer) {
-	io.WriteString(w, strconv.Quote(f.String()))
-}
-
-// UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *SubjectOrderField) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("SubjectOrderField %T must be a string", v)
-	}
-	switch str {
-	case "CREATED_AT":
-		*f = *SubjectOrderFieldCreatedAt
-	default:
-		return fmt.Errorf("%s is not a valid SubjectOrderField", str)
-	}
-	return nil
-}
-
-// SubjectOrderField defines the ordering field of Subject.
-type SubjectOrderField struct {
-	// Value extracts the ordering value from the given Subject.
-	Value    func(*Subject) (ent.Value, error)
+// TimestampOrderField defines the ordering field of Timestamp.
+type TimestampOrderField struct {
+	// Value extracts the ordering value from the given Timestamp.
+	Value    func(*Timestamp) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) subject.OrderOption
-	toCursor func(*Subject) Cursor
+	toTerm   func(...sql.OrderTermOption) timestamp.OrderOption
+	toCursor func(*Timestamp) Cursor
 }
 
-// SubjectOrder defines the ordering of Subject.
-type SubjectOrder struct {
-	Direction OrderDirection     `json:"direction"`
-	Field     *SubjectOrderField `json:"field"`
+// TimestampOrder defines the ordering of Timestamp.
+type TimestampOrder struct {
+	Direction OrderDirection       `json:"direction"`
+	Field     *TimestampOrderField `json:"field"`
 }
 
-// DefaultSubjectOrder is the default ordering of Subject.
-var DefaultSubjectOrder = &SubjectOrder{
+// DefaultTimestampOrder is the default ordering of Timestamp.
+var DefaultTimestampOrder = &TimestampOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &SubjectOrderField{
-		Value: func(_m *Subject) (ent.Value, error) {
+	Field: &TimestampOrderField{
+		Value: func(_m *Timestamp) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: subject.FieldID,
-		toTerm: subject.ByID,
-		toCursor: func(_m *Subject) Cursor {
+		column: timestamp.FieldID,
+		toTerm: timestamp.ByID,
+		toCursor: func(_m *Timestamp) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Subject into SubjectEdge.
-func (_m *Subject) ToEdge(order *SubjectOrder) *SubjectEdge {
+// ToEdge converts Timestamp into TimestampEdge.
+func (_m *Timestamp) ToEdge(order *TimestampOrder) *TimestampEdge {
 	if order == nil {
-		order = DefaultSubjectOrder
+		order = DefaultTimestampOrder
 	}
-	return &SubjectEdge{
+	return &TimestampEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// SubjectDigestEdge is the edge representation of SubjectDigest.
-type SubjectDigestEdge struct {
-	Node   *SubjectDigest `json:"node"`
-	Cursor Cursor         `json:"cursor"`
+// TransparencyLogEntryEdge is the edge representation of TransparencyLogEntry.
+type TransparencyLogEntryEdge struct {
+	Node   *TransparencyLogEntry `json:"node"`
+	Cursor Cursor                `json:"cursor"`
 }
 
-// SubjectDigestConnection is the connection containing edges to SubjectDigest.
-type SubjectDigestConnection struct {
-	Edges      []*SubjectDigestEdge `json:"edges"`
-	PageInfo   PageInfo             `json:"pageInfo"`
-	TotalCount int                  `json:"totalCount"`
+// TransparencyLogEntryConnection is the connection containing edges to TransparencyLogEntry.
+type TransparencyLogEntryConnection struct {
+	Edges      []*TransparencyLogEntryEdge `json:"edges"`
+	PageInfo   PageInfo                    `json:"pageInfo"`
+	TotalCount int                         `json:"totalCount"`
 }
 
-func (c *SubjectDigestConnection) build(nodes []*SubjectDigest, pager *subjectdigestPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *TransparencyLogEntryConnection) build(nodes []*TransparencyLogEntry, pager *transparencylogentryPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -2217,21 +7108,21 @@ func (c *SubjectDigestConnection) build(nodes []*SubjectDigest, pager *subjectdi
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *SubjectDigest
+	var nodeAt func(int) *TransparencyLogEntry
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *SubjectDigest {
+		nodeAt = func(i int) *TransparencyLogEntry {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *SubjectDigest {
+		nodeAt = func(i int) *TransparencyLogEntry {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*SubjectDigestEdge, len(nodes))
+	c.Edges = make([]*TransparencyLogEntryEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &SubjectDigestEdge{
+		c.Edges[i] = &TransparencyLogEntryEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2245,87 +7136,87 @@ func (c *SubjectDigestConnection) build(nodes []*SubjectDigest, pager *subjectdi
 	}
 }
 
-// SubjectDigestPaginateOption enables pagination customization.
-type SubjectDigestPaginateOption func(*subjectdigestPager) error
+// TransparencyLogEntryPaginateOption enables pagination customization.
+type TransparencyLogEntryPaginateOption func(*transparencylogentryPager) error
 
-// WithSubjectDigestOrder configures pagination ordering.
-func WithSubjectDigestOrder(order *SubjectDigestOrder) SubjectDigestPaginateOption {
+// WithTransparencyLogEntryOrder configures pagination ordering.
+func WithTransparencyLogEntryOrder(order *TransparencyLogEntryOrder) TransparencyLogEntryPaginateOption {
 	if order == nil {
-		order = DefaultSubjectDigestOrder
+		order = DefaultTransparencyLogEntryOrder
 	}
 	o := *order
-	return func(pager *subjectdigestPager) error {
+	return func(pager *transparencylogentryPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultSubjectDigestOrder.Field
+			o.Field = DefaultTransparencyLogEntryOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithSubjectDigestFilter configures pagination filter.
-func WithSubjectDigestFilter(filter func(*SubjectDigestQuery) (*SubjectDigestQuery, error)) SubjectDigestPaginateOption {
-	return func(pager *subjectdigestPager) error {
+// WithTransparencyLogEntryFilter configures pagination filter.
+func WithTransparencyLogEntryFilter(filter func(*TransparencyLogEntryQuery) (*TransparencyLogEntryQuery, error)) TransparencyLogEntryPaginateOption {
+	return func(pager *transparencylogentryPager) error {
 		if filter == nil {
-			return errors.New("SubjectDigestQuery filter cannot be nil")
+			return errors.New("TransparencyLogEntryQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type subjectdigestPager struct {
+type transparencylogentryPager struct {
 	reverse bool
-	order   *SubjectDigestOrder
-	filter  func(*SubjectDigestQuery) (*SubjectDigestQuery, error)
+	order   *TransparencyLogEntryOrder
+	filter  func(*TransparencyLogEntryQuery) (*TransparencyLogEntryQuery, error)
 }
 
-func newSubjectDigestPager(opts []SubjectDigestPaginateOption, reverse bool) (*subjectdigestPager, error) {
-	pager := &subjectdigestPager{reverse: reverse}
+func newTransparencyLogEntryPager(opts []TransparencyLogEntryPaginateOption, reverse bool) (*transparencylogentryPager, error) {
+	pager := &transparencylogentryPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultSubjectDigestOrder
+		pager.order = DefaultTransparencyLogEntryOrder
 	}
 	return pager, nil
 }
 
-func (p *subjectdigestPager) applyFilter(query *SubjectDigestQuery) (*SubjectDigestQuery, error) {
+func (p *transparencylogentryPager) applyFilter(query *TransparencyLogEntryQuery) (*TransparencyLogEntryQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *subjectdigestPager) toCursor(_m *SubjectDigest) Cursor {
+func (p *transparencylogentryPager) toCursor(_m *TransparencyLogEntry) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *subjectdigestPager) applyCursors(query *SubjectDigestQuery, after, before *Cursor) (*SubjectDigestQuery, error) {
+func (p *transparencylogentryPager) applyCursors(query *TransparencyLogEntryQuery, after, before *Cursor) (*TransparencyLogEntryQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSubjectDigestOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultTransparencyLogEntryOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *subjectdigestPager) applyOrder(query *SubjectDigestQuery) *SubjectDigestQuery {
+func (p *transparencylogentryPager) applyOrder(query *TransparencyLogEntryQuery) *TransparencyLogEntryQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultSubjectDigestOrder.Field {
-		query = query.Order(DefaultSubjectDigestOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultTransparencyLogEntryOrder.Field {
+		query = query.Order(DefaultTransparencyLogEntryOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -2333,7 +7224,7 @@ func (p *subjectdigestPager) applyOrder(query *SubjectDigestQuery) *SubjectDiges
 	return query
 }
 
-func (p *subjectdigestPager) orderExpr(query *SubjectDigestQuery) sql.Querier {
+func (p *transparencylogentryPager) orderExpr(query *TransparencyLogEntryQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -2343,28 +7234,28 @@ func (p *subjectdigestPager) orderExpr(query *SubjectDigestQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultSubjectDigestOrder.Field {
-			b.Comma().Ident(DefaultSubjectDigestOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultTransparencyLogEntryOrder.Field {
+			b.Comma().Ident(DefaultTransparencyLogEntryOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to SubjectDigest.
-func (_m *SubjectDigestQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to TransparencyLogEntry.
+func (_m *TransparencyLogEntryQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...SubjectDigestPaginateOption,
-) (*SubjectDigestConnection, error) {
+	before *Cursor, last *int, opts ...TransparencyLogEntryPaginateOption,
+) (*TransparencyLogEntryConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newSubjectDigestPager(opts, last != nil)
+	pager, err := newTransparencyLogEntryPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &SubjectDigestConnection{Edges: []*SubjectDigestEdge{}}
+	conn := &TransparencyLogEntryConnection{Edges: []*TransparencyLogEntryEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -2402,61 +7293,61 @@ func (_m *SubjectDigestQuery) Paginate(
 	return conn, nil
 }
 
-// SubjectDigestOrderField defines the ordering field of SubjectDigest.
-type SubjectDigestOrderField struct {
-	// Value extracts the ordering value from the given SubjectDigest.
-	Value    func(*SubjectDigest) (ent.Value, error)
+// TransparencyLogEntryOrderField defines the ordering field of TransparencyLogEntry.
+type TransparencyLogEntryOrderField struct {
+	// Value extracts the ordering value from the given TransparencyLogEntry.
+	Value    func(*TransparencyLogEntry) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) subjectdigest.OrderOption
-	toCursor func(*SubjectDigest) Cursor
+	toTerm   func(...sql.OrderTermOption) transparencylogentry.OrderOption
+	toCursor func(*TransparencyLogEntry) Cursor
 }
 
-// SubjectDigestOrder defines the ordering of SubjectDigest.
-type SubjectDigestOrder struct {
-	Direction OrderDirection           `json:"direction"`
-	Field     *SubjectDigestOrderField `json:"field"`
+// TransparencyLogEntryOrder defines the ordering of TransparencyLogEntry.
+type TransparencyLogEntryOrder struct {
+	Direction OrderDirection                  `json:"direction"`
+	Field     *TransparencyLogEntryOrderField `json:"field"`
 }
 
-// DefaultSubjectDigestOrder is the default ordering of SubjectDigest.
-var DefaultSubjectDigestOrder = &SubjectDigestOrder{
+// DefaultTransparencyLogEntryOrder is the default ordering of TransparencyLogEntry.
+var DefaultTransparencyLogEntryOrder = &TransparencyLogEntryOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &SubjectDigestOrderField{
-		Value: func(_m *SubjectDigest) (ent.Value, error) {
+	Field: &TransparencyLogEntryOrderField{
+		Value: func(_m *TransparencyLogEntry) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: subjectdigest.FieldID,
-		toTerm: subjectdigest.ByID,
-		toCursor: func(_m *SubjectDigest) Cursor {
+		column: transparencylogentry.FieldID,
+		toTerm: transparencylogentry.ByID,
+		toCursor: func(_m *TransparencyLogEntry) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts SubjectDigest into SubjectDigestEdge.
-func (_m *SubjectDigest) ToEdge(order *SubjectDigestOrder) *SubjectDigestEdge {
+// ToEdge converts TransparencyLogEntry into TransparencyLogEntryEdge.
+func (_m *TransparencyLogEntry) ToEdge(order *TransparencyLogEntryOrder) *TransparencyLogEntryEdge {
 	if order == nil {
-		order = DefaultSubjectDigestOrder
+		order = DefaultTransparencyLogEntryOrder
 	}
-	return &SubjectDigestEdge{
+	return &TransparencyLogEntryEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// TimestampEdge is the edge representation of Timestamp.
-type TimestampEdge struct {
-	Node   *Timestamp `json:"node"`
-	Cursor Cursor     `json:"cursor"`
+// VerificationSummaryEdge is the edge representation of VerificationSummary.
+type VerificationSummaryEdge struct {
+	Node   *VerificationSummary `json:"node"`
+	Cursor Cursor               `json:"cursor"`
 }
 
-// TimestampConnection is the connection containing edges to Timestamp.
-type TimestampConnection struct {
-	Edges      []*TimestampEdge `json:"edges"`
-	PageInfo   PageInfo         `json:"pageInfo"`
-	TotalCount int              `json:"totalCount"`
+// VerificationSummaryConnection is the connection containing edges to VerificationSummary.
+type VerificationSummaryConnection struct {
+	Edges      []*VerificationSummaryEdge `json:"edges"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	TotalCount int                        `json:"totalCount"`
 }
 
-func (c *TimestampConnection) build(nodes []*Timestamp, pager *timestampPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *VerificationSummaryConnection) build(nodes []*VerificationSummary, pager *verificationsummaryPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -2466,21 +7357,21 @@ func (c *TimestampConnection) build(nodes []*Timestamp, pager *timestampPager, a
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Timestamp
+	var nodeAt func(int) *VerificationSummary
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Timestamp {
+		nodeAt = func(i int) *VerificationSummary {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Timestamp {
+		nodeAt = func(i int) *VerificationSummary {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*TimestampEdge, len(nodes))
+	c.Edges = make([]*VerificationSummaryEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &TimestampEdge{
+		c.Edges[i] = &VerificationSummaryEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2494,87 +7385,87 @@ func (c *TimestampConnection) build(nodes []*Timestamp, pager *timestampPager, a
 	}
 }
 
-// TimestampPaginateOption enables pagination customization.
-type TimestampPaginateOption func(*timestampPager) error
+// VerificationSummaryPaginateOption enables pagination customization.
+type VerificationSummaryPaginateOption func(*verificationsummaryPager) error
 
-// WithTimestampOrder configures pagination ordering.
-func WithTimestampOrder(order *TimestampOrder) TimestampPaginateOption {
+// WithVerificationSummaryOrder configures pagination ordering.
+func WithVerificationSummaryOrder(order *VerificationSummaryOrder) VerificationSummaryPaginateOption {
 	if order == nil {
-		order = DefaultTimestampOrder
+		order = DefaultVerificationSummaryOrder
 	}
 	o := *order
-	return func(pager *timestampPager) error {
+	return func(pager *verificationsummaryPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultTimestampOrder.Field
+			o.Field = DefaultVerificationSummaryOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithTimestampFilter configures pagination filter.
-func WithTimestampFilter(filter func(*TimestampQuery) (*TimestampQuery, error)) TimestampPaginateOption {
-	return func(pager *timestampPager) error {
+// WithVerificationSummaryFilter configures pagination filter.
+func WithVerificationSummaryFilter(filter func(*VerificationSummaryQuery) (*VerificationSummaryQuery, error)) VerificationSummaryPaginateOption {
+	return func(pager *verificationsummaryPager) error {
 		if filter == nil {
-			return errors.New("TimestampQuery filter cannot be nil")
+			return errors.New("VerificationSummaryQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type timestampPager struct {
+type verificationsummaryPager struct {
 	reverse bool
-	order   *TimestampOrder
-	filter  func(*TimestampQuery) (*TimestampQuery, error)
+	order   *VerificationSummaryOrder
+	filter  func(*VerificationSummaryQuery) (*VerificationSummaryQuery, error)
 }
 
-func newTimestampPager(opts []TimestampPaginateOption, reverse bool) (*timestampPager, error) {
-	pager := &timestampPager{reverse: reverse}
+func newVerificationSummaryPager(opts []VerificationSummaryPaginateOption, reverse bool) (*verificationsummaryPager, error) {
+	pager := &verificationsummaryPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultTimestampOrder
+		pager.order = DefaultVerificationSummaryOrder
 	}
 	return pager, nil
 }
 
-func (p *timestampPager) applyFilter(query *TimestampQuery) (*TimestampQuery, error) {
+func (p *verificationsummaryPager) applyFilter(query *VerificationSummaryQuery) (*VerificationSummaryQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *timestampPager) toCursor(_m *Timestamp) Cursor {
+func (p *verificationsummaryPager) toCursor(_m *VerificationSummary) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *timestampPager) applyCursors(query *TimestampQuery, after, before *Cursor) (*TimestampQuery, error) {
+func (p *verificationsummaryPager) applyCursors(query *VerificationSummaryQuery, after, before *Cursor) (*VerificationSummaryQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultTimestampOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultVerificationSummaryOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *timestampPager) applyOrder(query *TimestampQuery) *TimestampQuery {
+func (p *verificationsummaryPager) applyOrder(query *VerificationSummaryQuery) *VerificationSummaryQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultTimestampOrder.Field {
-		query = query.Order(DefaultTimestampOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultVerificationSummaryOrder.Field {
+		query = query.Order(DefaultVerificationSummaryOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -2582,7 +7473,7 @@ func (p *timestampPager) applyOrder(query *TimestampQuery) *TimestampQuery {
 	return query
 }
 
-func (p *timestampPager) orderExpr(query *TimestampQuery) sql.Querier {
+func (p *verificationsummaryPager) orderExpr(query *VerificationSummaryQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -2592,28 +7483,28 @@ func (p *timestampPager) orderExpr(query *TimestampQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultTimestampOrder.Field {
-			b.Comma().Ident(DefaultTimestampOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultVerificationSummaryOrder.Field {
+			b.Comma().Ident(DefaultVerificationSummaryOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Timestamp.
-func (_m *TimestampQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to VerificationSummary.
+func (_m *VerificationSummaryQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...TimestampPaginateOption,
-) (*TimestampConnection, error) {
+	before *Cursor, last *int, opts ...VerificationSummaryPaginateOption,
+) (*VerificationSummaryConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newTimestampPager(opts, last != nil)
+	pager, err := newVerificationSummaryPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &TimestampConnection{Edges: []*TimestampEdge{}}
+	conn := &VerificationSummaryConnection{Edges: []*VerificationSummaryEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -2651,42 +7542,42 @@ func (_m *TimestampQuery) Paginate(
 	return conn, nil
 }
 
-// TimestampOrderField defines the ordering field of Timestamp.
-type TimestampOrderField struct {
-	// Value extracts the ordering value from the given Timestamp.
-	Value    func(*Timestamp) (ent.Value, error)
+// VerificationSummaryOrderField defines the ordering field of VerificationSummary.
+type VerificationSummaryOrderField struct {
+	// Value extracts the ordering value from the given VerificationSummary.
+	Value    func(*VerificationSummary) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) timestamp.OrderOption
-	toCursor func(*Timestamp) Cursor
+	toTerm   func(...sql.OrderTermOption) verificationsummary.OrderOption
+	toCursor func(*VerificationSummary) Cursor
 }
 
-// TimestampOrder defines the ordering of Timestamp.
-type TimestampOrder struct {
-	Direction OrderDirection       `json:"direction"`
-	Field     *TimestampOrderField `json:"field"`
+// VerificationSummaryOrder defines the ordering of VerificationSummary.
+type VerificationSummaryOrder struct {
+	Direction OrderDirection                 `json:"direction"`
+	Field     *VerificationSummaryOrderField `json:"field"`
 }
 
-// DefaultTimestampOrder is the default ordering of Timestamp.
-var DefaultTimestampOrder = &TimestampOrder{
+// DefaultVerificationSummaryOrder is the default ordering of VerificationSummary.
+var DefaultVerificationSummaryOrder = &VerificationSummaryOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &TimestampOrderField{
-		Value: func(_m *Timestamp) (ent.Value, error) {
+	Field: &VerificationSummaryOrderField{
+		Value: func(_m *VerificationSummary) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: timestamp.FieldID,
-		toTerm: timestamp.ByID,
-		toCursor: func(_m *Timestamp) Cursor {
+		column: verificationsummary.FieldID,
+		toTerm: verificationsummary.ByID,
+		toCursor: func(_m *VerificationSummary) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Timestamp into TimestampEdge.
-func (_m *Timestamp) ToEdge(order *TimestampOrder) *TimestampEdge {
+// ToEdge converts VerificationSummary into VerificationSummaryEdge.
+func (_m *VerificationSummary) ToEdge(order *VerificationSummaryOrder) *VerificationSummaryEdge {
 	if order == nil {
-		order = DefaultTimestampOrder
+		order = DefaultVerificationSummaryOrder
 	}
-	return &TimestampEdge{
+	return &VerificationSummaryEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
@@ -11,14 +11,33 @@ import (
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
 	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
 	"github.com/in-toto/archivista/ent/payloaddigest"
 	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
 	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
 	"github.com/in-toto/archivista/ent/subjectdigest"
 	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 // AttestationWhereInput represents a where input for filtering Attestation queries.
@@ -56,6 +75,38 @@ type AttestationWhereInput struct {
 	// "attestation_collection" edge predicates.
 	HasAttestationCollection     *bool                              `json:"hasAttestationCollection,omitempty"`
 	HasAttestationCollectionWith []*AttestationCollectionWhereInput `json:"hasAttestationCollectionWith,omitempty"`
+
+	// "git_attestation" edge predicates.
+	HasGitAttestation     *bool                       `json:"hasGitAttestation,omitempty"`
+	HasGitAttestationWith []*GitAttestationWhereInput `json:"hasGitAttestationWith,omitempty"`
+
+	// "github_attestation" edge predicates.
+	HasGithubAttestation     *bool                          `json:"hasGithubAttestation,omitempty"`
+	HasGithubAttestationWith []*GithubAttestationWhereInput `json:"hasGithubAttestationWith,omitempty"`
+
+	// "gitlab_attestation" edge predicates.
+	HasGitlabAttestation     *bool                          `json:"hasGitlabAttestation,omitempty"`
+	HasGitlabAttestationWith []*GitlabAttestationWhereInput `json:"hasGitlabAttestationWith,omitempty"`
+
+	// "environment_attestation" edge predicates.
+	HasEnvironmentAttestation     *bool                               `json:"hasEnvironmentAttestation,omitempty"`
+	HasEnvironmentAttestationWith []*EnvironmentAttestationWhereInput `json:"hasEnvironmentAttestationWith,omitempty"`
+
+	// "command_run_attestation" edge predicates.
+	HasCommandRunAttestation     *bool                              `json:"hasCommandRunAttestation,omitempty"`
+	HasCommandRunAttestationWith []*CommandRunAttestationWhereInput `json:"hasCommandRunAttestationWith,omitempty"`
+
+	// "oci_attestation" edge predicates.
+	HasOciAttestation     *bool                       `json:"hasOciAttestation,omitempty"`
+	HasOciAttestationWith []*OciAttestationWhereInput `json:"hasOciAttestationWith,omitempty"`
+
+	// "materials" edge predicates.
+	HasMaterials     *bool                 `json:"hasMaterials,omitempty"`
+	HasMaterialsWith []*MaterialWhereInput `json:"hasMaterialsWith,omitempty"`
+
+	// "products" edge predicates.
+	HasProducts     *bool                `json:"hasProducts,omitempty"`
+	HasProductsWith []*ProductWhereInput `json:"hasProductsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -211,6 +262,150 @@ func (i *AttestationWhereInput) P() (predicate.Attestation, error) {
 		}
 		predicates = append(predicates, attestation.HasAttestationCollectionWith(with...))
 	}
+	if i.HasGitAttestation != nil {
+		p := attestation.HasGitAttestation()
+		if !*i.HasGitAttestation {
+			p = attestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasGitAttestationWith) > 0 {
+		with := make([]predicate.GitAttestation, 0, len(i.HasGitAttestationWith))
+		for _, w := range i.HasGitAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasGitAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, attestation.HasGitAttestationWith(with...))
+	}
+	if i.HasGithubAttestation != nil {
+		p := attestation.HasGithubAttestation()
+		if !*i.HasGithubAttestation {
+			p = attestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasGithubAttestationWith) > 0 {
+		with := make([]predicate.GithubAttestation, 0, len(i.HasGithubAttestationWith))
+		for _, w := range i.HasGithubAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasGithubAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, attestation.HasGithubAttestationWith(with...))
+	}
+	if i.HasGitlabAttestation != nil {
+		p := attestation.HasGitlabAttestation()
+		if !*i.HasGitlabAttestation {
+			p = attestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasGitlabAttestationWith) > 0 {
+		with := make([]predicate.GitlabAttestation, 0, len(i.HasGitlabAttestationWith))
+		for _, w := range i.HasGitlabAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasGitlabAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, attestation.HasGitlabAttestationWith(with...))
+	}
+	if i.HasEnvironmentAttestation != nil {
+		p := attestation.HasEnvironmentAttestation()
+		if !*i.HasEnvironmentAttestation {
+			p = attestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasEnvironmentAttestationWith) > 0 {
+		with := make([]predicate.EnvironmentAttestation, 0, len(i.HasEnvironmentAttestationWith))
+		for _, w := range i.HasEnvironmentAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasEnvironmentAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, attestation.HasEnvironmentAttestationWith(with...))
+	}
+	if i.HasCommandRunAttestation != nil {
+		p := attestation.HasCommandRunAttestation()
+		if !*i.HasCommandRunAttestation {
+			p = attestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasCommandRunAttestationWith) > 0 {
+		with := make([]predicate.CommandRunAttestation, 0, len(i.HasCommandRunAttestationWith))
+		for _, w := range i.HasCommandRunAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasCommandRunAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, attestation.HasCommandRunAttestationWith(with...))
+	}
+	if i.HasOciAttestation != nil {
+		p := attestation.HasOciAttestation()
+		if !*i.HasOciAttestation {
+			p = attestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOciAttestationWith) > 0 {
+		with := make([]predicate.OciAttestation, 0, len(i.HasOciAttestationWith))
+		for _, w := range i.HasOciAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOciAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, attestation.HasOciAttestationWith(with...))
+	}
+	if i.HasMaterials != nil {
+		p := attestation.HasMaterials()
+		if !*i.HasMaterials {
+			p = attestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMaterialsWith) > 0 {
+		with := make([]predicate.Material, 0, len(i.HasMaterialsWith))
+		for _, w := range i.HasMaterialsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMaterialsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, attestation.HasMaterialsWith(with...))
+	}
+	if i.HasProducts != nil {
+		p := attestation.HasProducts()
+		if !*i.HasProducts {
+			p = attestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProductsWith) > 0 {
+		with := make([]predicate.Product, 0, len(i.HasProductsWith))
+		for _, w := range i.HasProductsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProductsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, attestation.HasProductsWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyAttestationWhereInput
@@ -643,12 +838,12 @@ func (i *AttestationPolicyWhereInput) P() (predicate.AttestationPolicy, error) {
 	}
 }
 
-// DsseWhereInput represents a where input for filtering Dsse queries.
-type DsseWhereInput struct {
-	Predicates []predicate.Dsse  `json:"-"`
-	Not        *DsseWhereInput   `json:"not,omitempty"`
-	Or         []*DsseWhereInput `json:"or,omitempty"`
-	And        []*DsseWhereInput `json:"and,omitempty"`
+// CertificateWhereInput represents a where input for filtering Certificate queries.
+type CertificateWhereInput struct {
+	Predicates []predicate.Certificate  `json:"-"`
+	Not        *CertificateWhereInput   `json:"not,omitempty"`
+	Or         []*CertificateWhereInput `json:"or,omitempty"`
+	And        []*CertificateWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -660,74 +855,290 @@ type DsseWhereInput struct {
 	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
 	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
 
-	// "created_at" field predicates.
-	CreatedAt       *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ    *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn     []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn  []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT     *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE    *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT     *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE    *time.Time  `json:"createdAtLTE,omitempty"`
-	CreatedAtIsNil  bool        `json:"createdAtIsNil,omitempty"`
-	CreatedAtNotNil bool        `json:"createdAtNotNil,omitempty"`
+	// "chain_index" field predicates.
+	ChainIndex      *int  `json:"chainIndex,omitempty"`
+	ChainIndexNEQ   *int  `json:"chainIndexNEQ,omitempty"`
+	ChainIndexIn    []int `json:"chainIndexIn,omitempty"`
+	ChainIndexNotIn []int `json:"chainIndexNotIn,omitempty"`
+	ChainIndexGT    *int  `json:"chainIndexGT,omitempty"`
+	ChainIndexGTE   *int  `json:"chainIndexGTE,omitempty"`
+	ChainIndexLT    *int  `json:"chainIndexLT,omitempty"`
+	ChainIndexLTE   *int  `json:"chainIndexLTE,omitempty"`
 
-	// "gitoid_sha256" field predicates.
-	GitoidSha256             *string  `json:"gitoidSha256,omitempty"`
-	GitoidSha256NEQ          *string  `json:"gitoidSha256NEQ,omitempty"`
-	GitoidSha256In           []string `json:"gitoidSha256In,omitempty"`
-	GitoidSha256NotIn        []string `json:"gitoidSha256NotIn,omitempty"`
-	GitoidSha256GT           *string  `json:"gitoidSha256GT,omitempty"`
-	GitoidSha256GTE          *string  `json:"gitoidSha256GTE,omitempty"`
-	GitoidSha256LT           *string  `json:"gitoidSha256LT,omitempty"`
-	GitoidSha256LTE          *string  `json:"gitoidSha256LTE,omitempty"`
-	GitoidSha256Contains     *string  `json:"gitoidSha256Contains,omitempty"`
-	GitoidSha256HasPrefix    *string  `json:"gitoidSha256HasPrefix,omitempty"`
-	GitoidSha256HasSuffix    *string  `json:"gitoidSha256HasSuffix,omitempty"`
-	GitoidSha256EqualFold    *string  `json:"gitoidSha256EqualFold,omitempty"`
-	GitoidSha256ContainsFold *string  `json:"gitoidSha256ContainsFold,omitempty"`
+	// "subject" field predicates.
+	Subject             *string  `json:"subject,omitempty"`
+	SubjectNEQ          *string  `json:"subjectNEQ,omitempty"`
+	SubjectIn           []string `json:"subjectIn,omitempty"`
+	SubjectNotIn        []string `json:"subjectNotIn,omitempty"`
+	SubjectGT           *string  `json:"subjectGT,omitempty"`
+	SubjectGTE          *string  `json:"subjectGTE,omitempty"`
+	SubjectLT           *string  `json:"subjectLT,omitempty"`
+	SubjectLTE          *string  `json:"subjectLTE,omitempty"`
+	SubjectContains     *string  `json:"subjectContains,omitempty"`
+	SubjectHasPrefix    *string  `json:"subjectHasPrefix,omitempty"`
+	SubjectHasSuffix    *string  `json:"subjectHasSuffix,omitempty"`
+	SubjectEqualFold    *string  `json:"subjectEqualFold,omitempty"`
+	SubjectContainsFold *string  `json:"subjectContainsFold,omitempty"`
 
-	// "payload_type" field predicates.
-	PayloadType             *string  `json:"payloadType,omitempty"`
-	PayloadTypeNEQ          *string  `json:"payloadTypeNEQ,omitempty"`
-	PayloadTypeIn           []string `json:"payloadTypeIn,omitempty"`
-	PayloadTypeNotIn        []string `json:"payloadTypeNotIn,omitempty"`
-	PayloadTypeGT           *string  `json:"payloadTypeGT,omitempty"`
-	PayloadTypeGTE          *string  `json:"payloadTypeGTE,omitempty"`
-	PayloadTypeLT           *string  `json:"payloadTypeLT,omitempty"`
-	PayloadTypeLTE          *string  `json:"payloadTypeLTE,omitempty"`
-	PayloadTypeContains     *string  `json:"payloadTypeContains,omitempty"`
-	PayloadTypeHasPrefix    *string  `json:"payloadTypeHasPrefix,omitempty"`
-	PayloadTypeHasSuffix    *string  `json:"payloadTypeHasSuffix,omitempty"`
-	PayloadTypeEqualFold    *string  `json:"payloadTypeEqualFold,omitempty"`
-	PayloadTypeContainsFold *string  `json:"payloadTypeContainsFold,omitempty"`
+	// "issuer" field predicates.
+	Issuer             *string  `json:"issuer,omitempty"`
+	IssuerNEQ          *string  `json:"issuerNEQ,omitempty"`
+	IssuerIn           []string `json:"issuerIn,omitempty"`
+	IssuerNotIn        []string `json:"issuerNotIn,omitempty"`
+	IssuerGT           *string  `json:"issuerGT,omitempty"`
+	IssuerGTE          *string  `json:"issuerGTE,omitempty"`
+	IssuerLT           *string  `json:"issuerLT,omitempty"`
+	IssuerLTE          *string  `json:"issuerLTE,omitempty"`
+	IssuerContains     *string  `json:"issuerContains,omitempty"`
+	IssuerHasPrefix    *string  `json:"issuerHasPrefix,omitempty"`
+	IssuerHasSuffix    *string  `json:"issuerHasSuffix,omitempty"`
+	IssuerEqualFold    *string  `json:"issuerEqualFold,omitempty"`
+	IssuerContainsFold *string  `json:"issuerContainsFold,omitempty"`
 
-	// "statement" edge predicates.
-	HasStatement     *bool                  `json:"hasStatement,omitempty"`
-	HasStatementWith []*StatementWhereInput `json:"hasStatementWith,omitempty"`
+	// "serial_number" field predicates.
+	SerialNumber             *string  `json:"serialNumber,omitempty"`
+	SerialNumberNEQ          *string  `json:"serialNumberNEQ,omitempty"`
+	SerialNumberIn           []string `json:"serialNumberIn,omitempty"`
+	SerialNumberNotIn        []string `json:"serialNumberNotIn,omitempty"`
+	SerialNumberGT           *string  `json:"serialNumberGT,omitempty"`
+	SerialNumberGTE          *string  `json:"serialNumberGTE,omitempty"`
+	SerialNumberLT           *string  `json:"serialNumberLT,omitempty"`
+	SerialNumberLTE          *string  `json:"serialNumberLTE,omitempty"`
+	SerialNumberContains     *string  `json:"serialNumberContains,omitempty"`
+	SerialNumberHasPrefix    *string  `json:"serialNumberHasPrefix,omitempty"`
+	SerialNumberHasSuffix    *string  `json:"serialNumberHasSuffix,omitempty"`
+	SerialNumberEqualFold    *string  `json:"serialNumberEqualFold,omitempty"`
+	SerialNumberContainsFold *string  `json:"serialNumberContainsFold,omitempty"`
 
-	// "signatures" edge predicates.
-	HasSignatures     *bool                  `json:"hasSignatures,omitempty"`
-	HasSignaturesWith []*SignatureWhereInput `json:"hasSignaturesWith,omitempty"`
+	// "not_before" field predicates.
+	NotBefore      *time.Time  `json:"notBefore,omitempty"`
+	NotBeforeNEQ   *time.Time  `json:"notBeforeNEQ,omitempty"`
+	NotBeforeIn    []time.Time `json:"notBeforeIn,omitempty"`
+	NotBeforeNotIn []time.Time `json:"notBeforeNotIn,omitempty"`
+	NotBeforeGT    *time.Time  `json:"notBeforeGT,omitempty"`
+	NotBeforeGTE   *time.Time  `json:"notBeforeGTE,omitempty"`
+	NotBeforeLT    *time.Time  `json:"notBeforeLT,omitempty"`
+	NotBeforeLTE   *time.Time  `json:"notBeforeLTE,omitempty"`
 
-	// "payload_digests" edge predicates.
-	HasPayloadDigests     *bool                      `json:"hasPayloadDigests,omitempty"`
-	HasPayloadDigestsWith []*PayloadDigestWhereInput `json:"hasPayloadDigestsWith,omitempty"`
+	// "not_after" field predicates.
+	NotAfter      *time.Time  `json:"notAfter,omitempty"`
+	NotAfterNEQ   *time.Time  `json:"notAfterNEQ,omitempty"`
+	NotAfterIn    []time.Time `json:"notAfterIn,omitempty"`
+	NotAfterNotIn []time.Time `json:"notAfterNotIn,omitempty"`
+	NotAfterGT    *time.Time  `json:"notAfterGT,omitempty"`
+	NotAfterGTE   *time.Time  `json:"notAfterGTE,omitempty"`
+	NotAfterLT    *time.Time  `json:"notAfterLT,omitempty"`
+	NotAfterLTE   *time.Time  `json:"notAfterLTE,omitempty"`
+
+	// "spiffe_id" field predicates.
+	SpiffeID             *string  `json:"spiffeID,omitempty"`
+	SpiffeIDNEQ          *string  `json:"spiffeIDNEQ,omitempty"`
+	SpiffeIDIn           []string `json:"spiffeIDIn,omitempty"`
+	SpiffeIDNotIn        []string `json:"spiffeIDNotIn,omitempty"`
+	SpiffeIDGT           *string  `json:"spiffeIDGT,omitempty"`
+	SpiffeIDGTE          *string  `json:"spiffeIDGTE,omitempty"`
+	SpiffeIDLT           *string  `json:"spiffeIDLT,omitempty"`
+	SpiffeIDLTE          *string  `json:"spiffeIDLTE,omitempty"`
+	SpiffeIDContains     *string  `json:"spiffeIDContains,omitempty"`
+	SpiffeIDHasPrefix    *string  `json:"spiffeIDHasPrefix,omitempty"`
+	SpiffeIDHasSuffix    *string  `json:"spiffeIDHasSuffix,omitempty"`
+	SpiffeIDIsNil        bool     `json:"spiffeIDIsNil,omitempty"`
+	SpiffeIDNotNil       bool     `json:"spiffeIDNotNil,omitempty"`
+	SpiffeIDEqualFold    *string  `json:"spiffeIDEqualFold,omitempty"`
+	SpiffeIDContainsFold *string  `json:"spiffeIDContainsFold,omitempty"`
+
+	// "oidc_issuer" field predicates.
+	OidcIssuer             *string  `json:"oidcIssuer,omitempty"`
+	OidcIssuerNEQ          *string  `json:"oidcIssuerNEQ,omitempty"`
+	OidcIssuerIn           []string `json:"oidcIssuerIn,omitempty"`
+	OidcIssuerNotIn        []string `json:"oidcIssuerNotIn,omitempty"`
+	OidcIssuerGT           *string  `json:"oidcIssuerGT,omitempty"`
+	OidcIssuerGTE          *string  `json:"oidcIssuerGTE,omitempty"`
+	OidcIssuerLT           *string  `json:"oidcIssuerLT,omitempty"`
+	OidcIssuerLTE          *string  `json:"oidcIssuerLTE,omitempty"`
+	OidcIssuerContains     *string  `json:"oidcIssuerContains,omitempty"`
+	OidcIssuerHasPrefix    *string  `json:"oidcIssuerHasPrefix,omitempty"`
+	OidcIssuerHasSuffix    *string  `json:"oidcIssuerHasSuffix,omitempty"`
+	OidcIssuerIsNil        bool     `json:"oidcIssuerIsNil,omitempty"`
+	OidcIssuerNotNil       bool     `json:"oidcIssuerNotNil,omitempty"`
+	OidcIssuerEqualFold    *string  `json:"oidcIssuerEqualFold,omitempty"`
+	OidcIssuerContainsFold *string  `json:"oidcIssuerContainsFold,omitempty"`
+
+	// "build_signer_uri" field predicates.
+	BuildSignerURI             *string  `json:"buildSignerURI,omitempty"`
+	BuildSignerURINEQ          *string  `json:"buildSignerURINEQ,omitempty"`
+	BuildSignerURIIn           []string `json:"buildSignerURIIn,omitempty"`
+	BuildSignerURINotIn        []string `json:"buildSignerURINotIn,omitempty"`
+	BuildSignerURIGT           *string  `json:"buildSignerURIGT,omitempty"`
+	BuildSignerURIGTE          *string  `json:"buildSignerURIGTE,omitempty"`
+	BuildSignerURILT           *string  `json:"buildSignerURILT,omitempty"`
+	BuildSignerURILTE          *string  `json:"buildSignerURILTE,omitempty"`
+	BuildSignerURIContains     *string  `json:"buildSignerURIContains,omitempty"`
+	BuildSignerURIHasPrefix    *string  `json:"buildSignerURIHasPrefix,omitempty"`
+	BuildSignerURIHasSuffix    *string  `json:"buildSignerURIHasSuffix,omitempty"`
+	BuildSignerURIIsNil        bool     `json:"buildSignerURIIsNil,omitempty"`
+	BuildSignerURINotNil       bool     `json:"buildSignerURINotNil,omitempty"`
+	BuildSignerURIEqualFold    *string  `json:"buildSignerURIEqualFold,omitempty"`
+	BuildSignerURIContainsFold *string  `json:"buildSignerURIContainsFold,omitempty"`
+
+	// "build_signer_digest" field predicates.
+	BuildSignerDigest             *string  `json:"buildSignerDigest,omitempty"`
+	BuildSignerDigestNEQ          *string  `json:"buildSignerDigestNEQ,omitempty"`
+	BuildSignerDigestIn           []string `json:"buildSignerDigestIn,omitempty"`
+	BuildSignerDigestNotIn        []string `json:"buildSignerDigestNotIn,omitempty"`
+	BuildSignerDigestGT           *string  `json:"buildSignerDigestGT,omitempty"`
+	BuildSignerDigestGTE          *string  `json:"buildSignerDigestGTE,omitempty"`
+	BuildSignerDigestLT           *string  `json:"buildSignerDigestLT,omitempty"`
+	BuildSignerDigestLTE          *string  `json:"buildSignerDigestLTE,omitempty"`
+	BuildSignerDigestContains     *string  `json:"buildSignerDigestContains,omitempty"`
+	BuildSignerDigestHasPrefix    *string  `json:"buildSignerDigestHasPrefix,omitempty"`
+	BuildSignerDigestHasSuffix    *string  `json:"buildSignerDigestHasSuffix,omitempty"`
+	BuildSignerDigestIsNil        bool     `json:"buildSignerDigestIsNil,omitempty"`
+	BuildSignerDigestNotNil       bool     `json:"buildSignerDigestNotNil,omitempty"`
+	BuildSignerDigestEqualFold    *string  `json:"buildSignerDigestEqualFold,omitempty"`
+	BuildSignerDigestContainsFold *string  `json:"buildSignerDigestContainsFold,omitempty"`
+
+	// "runner_environment" field predicates.
+	RunnerEnvironment             *string  `json:"runnerEnvironment,omitempty"`
+	RunnerEnvironmentNEQ          *string  `json:"runnerEnvironmentNEQ,omitempty"`
+	RunnerEnvironmentIn           []string `json:"runnerEnvironmentIn,omitempty"`
+	RunnerEnvironmentNotIn        []string `json:"runnerEnvironmentNotIn,omitempty"`
+	RunnerEnvironmentGT           *string  `json:"runnerEnvironmentGT,omitempty"`
+	RunnerEnvironmentGTE          *string  `json:"runnerEnvironmentGTE,omitempty"`
+	RunnerEnvironmentLT           *string  `json:"runnerEnvironmentLT,omitempty"`
+	RunnerEnvironmentLTE          *string  `json:"runnerEnvironmentLTE,omitempty"`
+	RunnerEnvironmentContains     *string  `json:"runnerEnvironmentContains,omitempty"`
+	RunnerEnvironmentHasPrefix    *string  `json:"runnerEnvironmentHasPrefix,omitempty"`
+	RunnerEnvironmentHasSuffix    *string  `json:"runnerEnvironmentHasSuffix,omitempty"`
+	RunnerEnvironmentIsNil        bool     `json:"runnerEnvironmentIsNil,omitempty"`
+	RunnerEnvironmentNotNil       bool     `json:"runnerEnvironmentNotNil,omitempty"`
+	RunnerEnvironmentEqualFold    *string  `json:"runnerEnvironmentEqualFold,omitempty"`
+	RunnerEnvironmentContainsFold *string  `json:"runnerEnvironmentContainsFold,omitempty"`
+
+	// "source_repository_uri" field predicates.
+	SourceRepositoryURI             *string  `json:"sourceRepositoryURI,omitempty"`
+	SourceRepositoryURINEQ          *string  `json:"sourceRepositoryURINEQ,omitempty"`
+	SourceRepositoryURIIn           []string `json:"sourceRepositoryURIIn,omitempty"`
+	SourceRepositoryURINotIn        []string `json:"sourceRepositoryURINotIn,omitempty"`
+	SourceRepositoryURIGT           *string  `json:"sourceRepositoryURIGT,omitempty"`
+	SourceRepositoryURIGTE          *string  `json:"sourceRepositoryURIGTE,omitempty"`
+	SourceRepositoryURILT           *string  `json:"sourceRepositoryURILT,omitempty"`
+	SourceRepositoryURILTE          *string  `json:"sourceRepositoryURILTE,omitempty"`
+	SourceRepositoryURIContains     *string  `json:"sourceRepositoryURIContains,omitempty"`
+	SourceRepositoryURIHasPrefix    *string  `json:"sourceRepositoryURIHasPrefix,omitempty"`
+	SourceRepositoryURIHasSuffix    *string  `json:"sourceRepositoryURIHasSuffix,omitempty"`
+	SourceRepositoryURIIsNil        bool     `json:"sourceRepositoryURIIsNil,omitempty"`
+	SourceRepositoryURINotNil       bool     `json:"sourceRepositoryURINotNil,omitempty"`
+	SourceRepositoryURIEqualFold    *string  `json:"sourceRepositoryURIEqualFold,omitempty"`
+	SourceRepositoryURIContainsFold *string  `json:"sourceRepositoryURIContainsFold,omitempty"`
+
+	// "source_repository_digest" field predicates.
+	SourceRepositoryDigest             *string  `json:"sourceRepositoryDigest,omitempty"`
+	SourceRepositoryDigestNEQ          *string  `json:"sourceRepositoryDigestNEQ,omitempty"`
+	SourceRepositoryDigestIn           []string `json:"sourceRepositoryDigestIn,omitempty"`
+	SourceRepositoryDigestNotIn        []string `json:"sourceRepositoryDigestNotIn,omitempty"`
+	SourceRepositoryDigestGT           *string  `json:"sourceRepositoryDigestGT,omitempty"`
+	SourceRepositoryDigestGTE          *string  `json:"sourceRepositoryDigestGTE,omitempty"`
+	SourceRepositoryDigestLT           *string  `json:"sourceRepositoryDigestLT,omitempty"`
+	SourceRepositoryDigestLTE          *string  `json:"sourceRepositoryDigestLTE,omitempty"`
+	SourceRepositoryDigestContains     *string  `json:"sourceRepositoryDigestContains,omitempty"`
+	SourceRepositoryDigestHasPrefix    *string  `json:"sourceRepositoryDigestHasPrefix,omitempty"`
+	SourceRepositoryDigestHasSuffix    *string  `json:"sourceRepositoryDigestHasSuffix,omitempty"`
+	SourceRepositoryDigestIsNil        bool     `json:"sourceRepositoryDigestIsNil,omitempty"`
+	SourceRepositoryDigestNotNil       bool     `json:"sourceRepositoryDigestNotNil,omitempty"`
+	SourceRepositoryDigestEqualFold    *string  `json:"sourceRepositoryDigestEqualFold,omitempty"`
+	SourceRepositoryDigestContainsFold *string  `json:"sourceRepositoryDigestContainsFold,omitempty"`
+
+	// "source_repository_ref" field predicates.
+	SourceRepositoryRef             *string  `json:"sourceRepositoryRef,omitempty"`
+	SourceRepositoryRefNEQ          *string  `json:"sourceRepositoryRefNEQ,omitempty"`
+	SourceRepositoryRefIn           []string `json:"sourceRepositoryRefIn,omitempty"`
+	SourceRepositoryRefNotIn        []string `json:"sourceRepositoryRefNotIn,omitempty"`
+	SourceRepositoryRefGT           *string  `json:"sourceRepositoryRefGT,omitempty"`
+	SourceRepositoryRefGTE          *string  `json:"sourceRepositoryRefGTE,omitempty"`
+	SourceRepositoryRefLT           *string  `json:"sourceRepositoryRefLT,omitempty"`
+	SourceRepositoryRefLTE          *string  `json:"sourceRepositoryRefLTE,omitempty"`
+	SourceRepositoryRefContains     *string  `json:"sourceRepositoryRefContains,omitempty"`
+	SourceRepositoryRefHasPrefix    *string  `json:"sourceRepositoryRefHasPrefix,omitempty"`
+	SourceRepositoryRefHasSuffix    *string  `json:"sourceRepositoryRefHasSuffix,omitempty"`
+	SourceRepositoryRefIsNil        bool     `json:"sourceRepositoryRefIsNil,omitempty"`
+	SourceRepositoryRefNotNil       bool     `json:"sourceRepositoryRefNotNil,omitempty"`
+	SourceRepositoryRefEqualFold    *string  `json:"sourceRepositoryRefEqualFold,omitempty"`
+	SourceRepositoryRefContainsFold *string  `json:"sourceRepositoryRefContainsFold,omitempty"`
+
+	// "build_config_uri" field predicates.
+	BuildConfigURI             *string  `json:"buildConfigURI,omitempty"`
+	BuildConfigURINEQ          *string  `json:"buildConfigURINEQ,omitempty"`
+	BuildConfigURIIn           []string `json:"buildConfigURIIn,omitempty"`
+	BuildConfigURINotIn        []string `json:"buildConfigURINotIn,omitempty"`
+	BuildConfigURIGT           *string  `json:"buildConfigURIGT,omitempty"`
+	BuildConfigURIGTE          *string  `json:"buildConfigURIGTE,omitempty"`
+	BuildConfigURILT           *string  `json:"buildConfigURILT,omitempty"`
+	BuildConfigURILTE          *string  `json:"buildConfigURILTE,omitempty"`
+	BuildConfigURIContains     *string  `json:"buildConfigURIContains,omitempty"`
+	BuildConfigURIHasPrefix    *string  `json:"buildConfigURIHasPrefix,omitempty"`
+	BuildConfigURIHasSuffix    *string  `json:"buildConfigURIHasSuffix,omitempty"`
+	BuildConfigURIIsNil        bool     `json:"buildConfigURIIsNil,omitempty"`
+	BuildConfigURINotNil       bool     `json:"buildConfigURINotNil,omitempty"`
+	BuildConfigURIEqualFold    *string  `json:"buildConfigURIEqualFold,omitempty"`
+	BuildConfigURIContainsFold *string  `json:"buildConfigURIContainsFold,omitempty"`
+
+	// "build_trigger" field predicates.
+	BuildTrigger             *string  `json:"buildTrigger,omitempty"`
+	BuildTriggerNEQ          *string  `json:"buildTriggerNEQ,omitempty"`
+	BuildTriggerIn           []string `json:"buildTriggerIn,omitempty"`
+	BuildTriggerNotIn        []string `json:"buildTriggerNotIn,omitempty"`
+	BuildTriggerGT           *string  `json:"buildTriggerGT,omitempty"`
+	BuildTriggerGTE          *string  `json:"buildTriggerGTE,omitempty"`
+	BuildTriggerLT           *string  `json:"buildTriggerLT,omitempty"`
+	BuildTriggerLTE          *string  `json:"buildTriggerLTE,omitempty"`
+	BuildTriggerContains     *string  `json:"buildTriggerContains,omitempty"`
+	BuildTriggerHasPrefix    *string  `json:"buildTriggerHasPrefix,omitempty"`
+	BuildTriggerHasSuffix    *string  `json:"buildTriggerHasSuffix,omitempty"`
+	BuildTriggerIsNil        bool     `json:"buildTriggerIsNil,omitempty"`
+	BuildTriggerNotNil       bool     `json:"buildTriggerNotNil,omitempty"`
+	BuildTriggerEqualFold    *string  `json:"buildTriggerEqualFold,omitempty"`
+	BuildTriggerContainsFold *string  `json:"buildTriggerContainsFold,omitempty"`
+
+	// "run_invocation_uri" field predicates.
+	RunInvocationURI             *string  `json:"runInvocationURI,omitempty"`
+	RunInvocationURINEQ          *string  `json:"runInvocationURINEQ,omitempty"`
+	RunInvocationURIIn           []string `json:"runInvocationURIIn,omitempty"`
+	RunInvocationURINotIn        []string `json:"runInvocationURINotIn,omitempty"`
+	RunInvocationURIGT           *string  `json:"runInvocationURIGT,omitempty"`
+	RunInvocationURIGTE          *string  `json:"runInvocationURIGTE,omitempty"`
+	RunInvocationURILT           *string  `json:"runInvocationURILT,omitempty"`
+	RunInvocationURILTE          *string  `json:"runInvocationURILTE,omitempty"`
+	RunInvocationURIContains     *string  `json:"runInvocationURIContains,omitempty"`
+	RunInvocationURIHasPrefix    *string  `json:"runInvocationURIHasPrefix,omitempty"`
+	RunInvocationURIHasSuffix    *string  `json:"runInvocationURIHasSuffix,omitempty"`
+	RunInvocationURIIsNil        bool     `json:"runInvocationURIIsNil,omitempty"`
+	RunInvocationURINotNil       bool     `json:"runInvocationURINotNil,omitempty"`
+	RunInvocationURIEqualFold    *string  `json:"runInvocationURIEqualFold,omitempty"`
+	RunInvocationURIContainsFold *string  `json:"runInvocationURIContainsFold,omitempty"`
+
+	// "subject_alt_names" edge predicates.
+	HasSubjectAltNames     *bool                       `json:"hasSubjectAltNames,omitempty"`
+	HasSubjectAltNamesWith []*SubjectAltNameWhereInput `json:"hasSubjectAltNamesWith,omitempty"`
+
+	// "signature" edge predicates.
+	HasSignature     *bool                  `json:"hasSignature,omitempty"`
+	HasSignatureWith []*SignatureWhereInput `json:"hasSignatureWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *DsseWhereInput) AddPredicates(predicates ...predicate.Dsse) {
+func (i *CertificateWhereInput) AddPredicates(predicates ...predicate.Certificate) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the DsseWhereInput filter on the DsseQuery builder.
-func (i *DsseWhereInput) Filter(q *DsseQuery) (*DsseQuery, error) {
+// Filter applies the CertificateWhereInput filter on the CertificateQuery builder.
+func (i *CertificateWhereInput) Filter(q *CertificateQuery) (*CertificateQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyDsseWhereInput {
+		if err == ErrEmptyCertificateWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -735,19 +1146,19 @@ func (i *DsseWhereInput) Filter(q *DsseQuery) (*DsseQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyDsseWhereInput is returned in case the DsseWhereInput is empty.
-var ErrEmptyDsseWhereInput = errors.New("ent: empty predicate DsseWhereInput")
+// ErrEmptyCertificateWhereInput is returned in case the CertificateWhereInput is empty.
+var ErrEmptyCertificateWhereInput = errors.New("ent: empty predicate CertificateWhereInput")
 
-// P returns a predicate for filtering dsses.
+// P returns a predicate for filtering certificates.
 // An error is returned if the input is empty or invalid.
-func (i *DsseWhereInput) P() (predicate.Dsse, error) {
-	var predicates []predicate.Dsse
+func (i *CertificateWhereInput) P() (predicate.Certificate, error) {
+	var predicates []predicate.Certificate
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, dsse.Not(p))
+		predicates = append(predicates, certificate.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -757,7 +1168,7 @@ func (i *DsseWhereInput) P() (predicate.Dsse, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Dsse, 0, n)
+		or := make([]predicate.Certificate, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -765,7 +1176,7 @@ func (i *DsseWhereInput) P() (predicate.Dsse, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, dsse.Or(or...))
+		predicates = append(predicates, certificate.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -775,7 +1186,7 @@ func (i *DsseWhereInput) P() (predicate.Dsse, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Dsse, 0, n)
+		and := make([]predicate.Certificate, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -783,212 +1194,9026 @@ func (i *DsseWhereInput) P() (predicate.Dsse, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, dsse.And(and...))
+		predicates = append(predicates, certificate.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, dsse.IDEQ(*i.ID))
+		predicates = append(predicates, certificate.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, dsse.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, certificate.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, dsse.IDIn(i.IDIn...))
+		predicates = append(predicates, certificate.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, dsse.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, certificate.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, dsse.IDGT(*i.IDGT))
+		predicates = append(predicates, certificate.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, dsse.IDGTE(*i.IDGTE))
+		predicates = append(predicates, certificate.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, dsse.IDLT(*i.IDLT))
+		predicates = append(predicates, certificate.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, dsse.IDLTE(*i.IDLTE))
+		predicates = append(predicates, certificate.IDLTE(*i.IDLTE))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, dsse.CreatedAtEQ(*i.CreatedAt))
+	if i.ChainIndex != nil {
+		predicates = append(predicates, certificate.ChainIndexEQ(*i.ChainIndex))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, dsse.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.ChainIndexNEQ != nil {
+		predicates = append(predicates, certificate.ChainIndexNEQ(*i.ChainIndexNEQ))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, dsse.CreatedAtIn(i.CreatedAtIn...))
+	if len(i.ChainIndexIn) > 0 {
+		predicates = append(predicates, certificate.ChainIndexIn(i.ChainIndexIn...))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, dsse.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if len(i.ChainIndexNotIn) > 0 {
+		predicates = append(predicates, certificate.ChainIndexNotIn(i.ChainIndexNotIn...))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, dsse.CreatedAtGT(*i.CreatedAtGT))
+	if i.ChainIndexGT != nil {
+		predicates = append(predicates, certificate.ChainIndexGT(*i.ChainIndexGT))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, dsse.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.ChainIndexGTE != nil {
+		predicates = append(predicates, certificate.ChainIndexGTE(*i.ChainIndexGTE))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, dsse.CreatedAtLT(*i.CreatedAtLT))
+	if i.ChainIndexLT != nil {
+		predicates = append(predicates, certificate.ChainIndexLT(*i.ChainIndexLT))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, dsse.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.ChainIndexLTE != nil {
+		predicates = append(predicates, certificate.ChainIndexLTE(*i.ChainIndexLTE))
 	}
-	if i.CreatedAtIsNil {
-		predicates = append(predicates, dsse.CreatedAtIsNil())
+	if i.Subject != nil {
+		predicates = append(predicates, certificate.SubjectEQ(*i.Subject))
 	}
-	if i.CreatedAtNotNil {
-		predicates = append(predicates, dsse.CreatedAtNotNil())
+	if i.SubjectNEQ != nil {
+		predicates = append(predicates, certificate.SubjectNEQ(*i.SubjectNEQ))
 	}
-	if i.GitoidSha256 != nil {
-		predicates = append(predicates, dsse.GitoidSha256EQ(*i.GitoidSha256))
+	if len(i.SubjectIn) > 0 {
+		predicates = append(predicates, certificate.SubjectIn(i.SubjectIn...))
 	}
-	if i.GitoidSha256NEQ != nil {
-		predicates = append(predicates, dsse.GitoidSha256NEQ(*i.GitoidSha256NEQ))
+	if len(i.SubjectNotIn) > 0 {
+		predicates = append(predicates, certificate.SubjectNotIn(i.SubjectNotIn...))
 	}
-	if len(i.GitoidSha256In) > 0 {
-		predicates = append(predicates, dsse.GitoidSha256In(i.GitoidSha256In...))
+	if i.SubjectGT != nil {
+		predicates = append(predicates, certificate.SubjectGT(*i.SubjectGT))
 	}
-	if len(i.GitoidSha256NotIn) > 0 {
-		predicates = append(predicates, dsse.GitoidSha256NotIn(i.GitoidSha256NotIn...))
+	if i.SubjectGTE != nil {
+		predicates = append(predicates, certificate.SubjectGTE(*i.SubjectGTE))
 	}
-	if i.GitoidSha256GT != nil {
-		predicates = append(predicates, dsse.GitoidSha256GT(*i.GitoidSha256GT))
+	if i.SubjectLT != nil {
+		predicates = append(predicates, certificate.SubjectLT(*i.SubjectLT))
 	}
-	if i.GitoidSha256GTE != nil {
-		predicates = append(predicates, dsse.GitoidSha256GTE(*i.GitoidSha256GTE))
+	if i.SubjectLTE != nil {
+		predicates = append(predicates, certificate.SubjectLTE(*i.SubjectLTE))
 	}
-	if i.GitoidSha256LT != nil {
-		predicates = append(predicates, dsse.GitoidSha256LT(*i.GitoidSha256LT))
+	if i.SubjectContains != nil {
+		predicates = append(predicates, certificate.SubjectContains(*i.SubjectContains))
 	}
-	if i.GitoidSha256LTE != nil {
-		predicates = append(predicates, dsse.GitoidSha256LTE(*i.GitoidSha256LTE))
+	if i.SubjectHasPrefix != nil {
+		predicates = append(predicates, certificate.SubjectHasPrefix(*i.SubjectHasPrefix))
 	}
-	if i.GitoidSha256Contains != nil {
-		predicates = append(predicates, dsse.GitoidSha256Contains(*i.GitoidSha256Contains))
+	if i.SubjectHasSuffix != nil {
+		predicates = append(predicates, certificate.SubjectHasSuffix(*i.SubjectHasSuffix))
 	}
-	if i.GitoidSha256HasPrefix != nil {
-		predicates = append(predicates, dsse.GitoidSha256HasPrefix(*i.GitoidSha256HasPrefix))
+	if i.SubjectEqualFold != nil {
+		predicates = append(predicates, certificate.SubjectEqualFold(*i.SubjectEqualFold))
 	}
-	if i.GitoidSha256HasSuffix != nil {
-		predicates = append(predicates, dsse.GitoidSha256HasSuffix(*i.GitoidSha256HasSuffix))
+	if i.SubjectContainsFold != nil {
+		predicates = append(predicates, certificate.SubjectContainsFold(*i.SubjectContainsFold))
 	}
-	if i.GitoidSha256EqualFold != nil {
-		predicates = append(predicates, dsse.GitoidSha256EqualFold(*i.GitoidSha256EqualFold))
+	if i.Issuer != nil {
+		predicates = append(predicates, certificate.IssuerEQ(*i.Issuer))
 	}
-	if i.GitoidSha256ContainsFold != nil {
-		predicates = append(predicates, dsse.GitoidSha256ContainsFold(*i.GitoidSha256ContainsFold))
+	if i.IssuerNEQ != nil {
+		predicates = append(predicates, certificate.IssuerNEQ(*i.IssuerNEQ))
 	}
-	if i.PayloadType != nil {
-		predicates = append(predicates, dsse.PayloadTypeEQ(*i.PayloadType))
+	if len(i.IssuerIn) > 0 {
+		predicates = append(predicates, certificate.IssuerIn(i.IssuerIn...))
 	}
-	if i.PayloadTypeNEQ != nil {
-		predicates = append(predicates, dsse.PayloadTypeNEQ(*i.PayloadTypeNEQ))
+	if len(i.IssuerNotIn) > 0 {
+		predicates = append(predicates, certificate.IssuerNotIn(i.IssuerNotIn...))
 	}
-	if len(i.PayloadTypeIn) > 0 {
-		predicates = append(predicates, dsse.PayloadTypeIn(i.PayloadTypeIn...))
+	if i.IssuerGT != nil {
+		predicates = append(predicates, certificate.IssuerGT(*i.IssuerGT))
 	}
-	if len(i.PayloadTypeNotIn) > 0 {
-		predicates = append(predicates, dsse.PayloadTypeNotIn(i.PayloadTypeNotIn...))
+	if i.IssuerGTE != nil {
+		predicates = append(predicates, certificate.IssuerGTE(*i.IssuerGTE))
 	}
-	if i.PayloadTypeGT != nil {
-		predicates = append(predicates, dsse.PayloadTypeGT(*i.PayloadTypeGT))
+	if i.IssuerLT != nil {
+		predicates = append(predicates, certificate.IssuerLT(*i.IssuerLT))
 	}
-	if i.PayloadTypeGTE != nil {
-		predicates = append(predicates, dsse.PayloadTypeGTE(*i.PayloadTypeGTE))
+	if i.IssuerLTE != nil {
+		predicates = append(predicates, certificate.IssuerLTE(*i.IssuerLTE))
 	}
-	if i.PayloadTypeLT != nil {
-		predicates = append(predicates, dsse.PayloadTypeLT(*i.PayloadTypeLT))
+	if i.IssuerContains != nil {
+		predicates = append(predicates, certificate.IssuerContains(*i.IssuerContains))
 	}
-	if i.PayloadTypeLTE != nil {
-		predicates = append(predicates, dsse.PayloadTypeLTE(*i.PayloadTypeLTE))
+	if i.IssuerHasPrefix != nil {
+		predicates = append(predicates, certificate.IssuerHasPrefix(*i.IssuerHasPrefix))
 	}
-	if i.PayloadTypeContains != nil {
-		predicates = append(predicates, dsse.PayloadTypeContains(*i.PayloadTypeContains))
+	if i.IssuerHasSuffix != nil {
+		predicates = append(predicates, certificate.IssuerHasSuffix(*i.IssuerHasSuffix))
 	}
-	if i.PayloadTypeHasPrefix != nil {
-		predicates = append(predicates, dsse.PayloadTypeHasPrefix(*i.PayloadTypeHasPrefix))
+	if i.IssuerEqualFold != nil {
+		predicates = append(predicates, certificate.IssuerEqualFold(*i.IssuerEqualFold))
 	}
-	if i.PayloadTypeHasSuffix != nil {
-		predicates = append(predicates, dsse.PayloadTypeHasSuffix(*i.PayloadTypeHasSuffix))
+	if i.IssuerContainsFold != nil {
+		predicates = append(predicates, certificate.IssuerContainsFold(*i.IssuerContainsFold))
 	}
-	if i.PayloadTypeEqualFold != nil {
-		predicates = append(predicates, dsse.PayloadTypeEqualFold(*i.PayloadTypeEqualFold))
+	if i.SerialNumber != nil {
+		predicates = append(predicates, certificate.SerialNumberEQ(*i.SerialNumber))
 	}
-	if i.PayloadTypeContainsFold != nil {
-		predicates = append(predicates, dsse.PayloadTypeContainsFold(*i.PayloadTypeContainsFold))
+	if i.SerialNumberNEQ != nil {
+		predicates = append(predicates, certificate.SerialNumberNEQ(*i.SerialNumberNEQ))
 	}
-
-	if i.HasStatement != nil {
-		p := dsse.HasStatement()
-		if !*i.HasStatement {
+	if len(i.SerialNumberIn) > 0 {
+		predicates = append(predicates, certificate.SerialNumberIn(i.SerialNumberIn...))
+	}
+	if len(i.SerialNumberNotIn) > 0 {
+		predicates = append(predicates, certificate.SerialNumberNotIn(i.SerialNumberNotIn...))
+	}
+	if i.SerialNumberGT != nil {
+		predicates = append(predicates, certificate.SerialNumberGT(*i.SerialNumberGT))
+	}
+	if i.SerialNumberGTE != nil {
+		predicates = append(predicates, certificate.SerialNumberGTE(*i.SerialNumberGTE))
+	}
+	if i.SerialNumberLT != nil {
+		predicates = append(predicates, certificate.SerialNumberLT(*i.SerialNumberLT))
+	}
+	if i.SerialNumberLTE != nil {
+		predicates = append(predicates, certificate.SerialNumberLTE(*i.SerialNumberLTE))
+	}
+	if i.SerialNumberContains != nil {
+		predicates = append(predicates, certificate.SerialNumberContains(*i.SerialNumberContains))
+	}
+	if i.SerialNumberHasPrefix != nil {
+		predicates = append(predicates, certificate.SerialNumberHasPrefix(*i.SerialNumberHasPrefix))
+	}
+	if i.SerialNumberHasSuffix != nil {
+		predicates = append(predicates, certificate.SerialNumberHasSuffix(*i.SerialNumberHasSuffix))
+	}
+	if i.SerialNumberEqualFold != nil {
+		predicates = append(predicates, certificate.SerialNumberEqualFold(*i.SerialNumberEqualFold))
+	}
+	if i.SerialNumberContainsFold != nil {
+		predicates = append(predicates, certificate.SerialNumberContainsFold(*i.SerialNumberContainsFold))
+	}
+	if i.NotBefore != nil {
+		predicates = append(predicates, certificate.NotBeforeEQ(*i.NotBefore))
+	}
+	if i.NotBeforeNEQ != nil {
+		predicates = append(predicates, certificate.NotBeforeNEQ(*i.NotBeforeNEQ))
+	}
+	if len(i.NotBeforeIn) > 0 {
+		predicates = append(predicates, certificate.NotBeforeIn(i.NotBeforeIn...))
+	}
+	if len(i.NotBeforeNotIn) > 0 {
+		predicates = append(predicates, certificate.NotBeforeNotIn(i.NotBeforeNotIn...))
+	}
+	if i.NotBeforeGT != nil {
+		predicates = append(predicates, certificate.NotBeforeGT(*i.NotBeforeGT))
+	}
+	if i.NotBeforeGTE != nil {
+		predicates = append(predicates, certificate.NotBeforeGTE(*i.NotBeforeGTE))
+	}
+	if i.NotBeforeLT != nil {
+		predicates = append(predicates, certificate.NotBeforeLT(*i.NotBeforeLT))
+	}
+	if i.NotBeforeLTE != nil {
+		predicates = append(predicates, certificate.NotBeforeLTE(*i.NotBeforeLTE))
+	}
+	if i.NotAfter != nil {
+		predicates = append(predicates, certificate.NotAfterEQ(*i.NotAfter))
+	}
+	if i.NotAfterNEQ != nil {
+		predicates = append(predicates, certificate.NotAfterNEQ(*i.NotAfterNEQ))
+	}
+	if len(i.NotAfterIn) > 0 {
+		predicates = append(predicates, certificate.NotAfterIn(i.NotAfterIn...))
+	}
+	if len(i.NotAfterNotIn) > 0 {
+		predicates = append(predicates, certificate.NotAfterNotIn(i.NotAfterNotIn...))
+	}
+	if i.NotAfterGT != nil {
+		predicates = append(predicates, certificate.NotAfterGT(*i.NotAfterGT))
+	}
+	if i.NotAfterGTE != nil {
+		predicates = append(predicates, certificate.NotAfterGTE(*i.NotAfterGTE))
+	}
+	if i.NotAfterLT != nil {
+		predicates = append(predicates, certificate.NotAfterLT(*i.NotAfterLT))
+	}
+	if i.NotAfterLTE != nil {
+		predicates = append(predicates, certificate.NotAfterLTE(*i.NotAfterLTE))
+	}
+	if i.SpiffeID != nil {
+		predicates = append(predicates, certificate.SpiffeIDEQ(*i.SpiffeID))
+	}
+	if i.SpiffeIDNEQ != nil {
+		predicates = append(predicates, certificate.SpiffeIDNEQ(*i.SpiffeIDNEQ))
+	}
+	if len(i.SpiffeIDIn) > 0 {
+		predicates = append(predicates, certificate.SpiffeIDIn(i.SpiffeIDIn...))
+	}
+	if len(i.SpiffeIDNotIn) > 0 {
+		predicates = append(predicates, certificate.SpiffeIDNotIn(i.SpiffeIDNotIn...))
+	}
+	if i.SpiffeIDGT != nil {
+		predicates = append(predicates, certificate.SpiffeIDGT(*i.SpiffeIDGT))
+	}
+	if i.SpiffeIDGTE != nil {
+		predicates = append(predicates, certificate.SpiffeIDGTE(*i.SpiffeIDGTE))
+	}
+	if i.SpiffeIDLT != nil {
+		predicates = append(predicates, certificate.SpiffeIDLT(*i.SpiffeIDLT))
+	}
+	if i.SpiffeIDLTE != nil {
+		predicates = append(predicates, certificate.SpiffeIDLTE(*i.SpiffeIDLTE))
+	}
+	if i.SpiffeIDContains != nil {
+		predicates = append(predicates, certificate.SpiffeIDContains(*i.SpiffeIDContains))
+	}
+	if i.SpiffeIDHasPrefix != nil {
+		predicates = append(predicates, certificate.SpiffeIDHasPrefix(*i.SpiffeIDHasPrefix))
+	}
+	if i.SpiffeIDHasSuffix != nil {
+		predicates = append(predicates, certificate.SpiffeIDHasSuffix(*i.SpiffeIDHasSuffix))
+	}
+	if i.SpiffeIDIsNil {
+		predicates = append(predicates, certificate.SpiffeIDIsNil())
+	}
+	if i.SpiffeIDNotNil {
+		predicates = append(predicates, certificate.SpiffeIDNotNil())
+	}
+	if i.SpiffeIDEqualFold != nil {
+		predicates = append(predicates, certificate.SpiffeIDEqualFold(*i.SpiffeIDEqualFold))
+	}
+	if i.SpiffeIDContainsFold != nil {
+		predicates = append(predicates, certificate.SpiffeIDContainsFold(*i.SpiffeIDContainsFold))
+	}
+	if i.OidcIssuer != nil {
+		predicates = append(predicates, certificate.OidcIssuerEQ(*i.OidcIssuer))
+	}
+	if i.OidcIssuerNEQ != nil {
+		predicates = append(predicates, certificate.OidcIssuerNEQ(*i.OidcIssuerNEQ))
+	}
+	if len(i.OidcIssuerIn) > 0 {
+		predicates = append(predicates, certificate.OidcIssuerIn(i.OidcIssuerIn...))
+	}
+	if len(i.OidcIssuerNotIn) > 0 {
+		predicates = append(predicates, certificate.OidcIssuerNotIn(i.OidcIssuerNotIn...))
+	}
+	if i.OidcIssuerGT != nil {
+		predicates = append(predicates, certificate.OidcIssuerGT(*i.OidcIssuerGT))
+	}
+	if i.OidcIssuerGTE != nil {
+		predicates = append(predicates, certificate.OidcIssuerGTE(*i.OidcIssuerGTE))
+	}
+	if i.OidcIssuerLT != nil {
+		predicates = append(predicates, certificate.OidcIssuerLT(*i.OidcIssuerLT))
+	}
+	if i.OidcIssuerLTE != nil {
+		predicates = append(predicates, certificate.OidcIssuerLTE(*i.OidcIssuerLTE))
+	}
+	if i.OidcIssuerContains != nil {
+		predicates = append(predicates, certificate.OidcIssuerContains(*i.OidcIssuerContains))
+	}
+	if i.OidcIssuerHasPrefix != nil {
+		predicates = append(predicates, certificate.OidcIssuerHasPrefix(*i.OidcIssuerHasPrefix))
+	}
+	if i.OidcIssuerHasSuffix != nil {
+		predicates = append(predicates, certificate.OidcIssuerHasSuffix(*i.OidcIssuerHasSuffix))
+	}
+	if i.OidcIssuerIsNil {
+		predicates = append(predicates, certificate.OidcIssuerIsNil())
+	}
+	if i.OidcIssuerNotNil {
+		predicates = append(predicates, certificate.OidcIssuerNotNil())
+	}
+	if i.OidcIssuerEqualFold != nil {
+		predicates = append(predicates, certificate.OidcIssuerEqualFold(*i.OidcIssuerEqualFold))
+	}
+	if i.OidcIssuerContainsFold != nil {
+		predicates = append(predicates, certificate.OidcIssuerContainsFold(*i.OidcIssuerContainsFold))
+	}
+	if i.BuildSignerURI != nil {
+		predicates = append(predicates, certificate.BuildSignerURIEQ(*i.BuildSignerURI))
+	}
+	if i.BuildSignerURINEQ != nil {
+		predicates = append(predicates, certificate.BuildSignerURINEQ(*i.BuildSignerURINEQ))
+	}
+	if len(i.BuildSignerURIIn) > 0 {
+		predicates = append(predicates, certificate.BuildSignerURIIn(i.BuildSignerURIIn...))
+	}
+	if len(i.BuildSignerURINotIn) > 0 {
+		predicates = append(predicates, certificate.BuildSignerURINotIn(i.BuildSignerURINotIn...))
+	}
+	if i.BuildSignerURIGT != nil {
+		predicates = append(predicates, certificate.BuildSignerURIGT(*i.BuildSignerURIGT))
+	}
+	if i.BuildSignerURIGTE != nil {
+		predicates = append(predicates, certificate.BuildSignerURIGTE(*i.BuildSignerURIGTE))
+	}
+	if i.BuildSignerURILT != nil {
+		predicates = append(predicates, certificate.BuildSignerURILT(*i.BuildSignerURILT))
+	}
+	if i.BuildSignerURILTE != nil {
+		predicates = append(predicates, certificate.BuildSignerURILTE(*i.BuildSignerURILTE))
+	}
+	if i.BuildSignerURIContains != nil {
+		predicates = append(predicates, certificate.BuildSignerURIContains(*i.BuildSignerURIContains))
+	}
+	if i.BuildSignerURIHasPrefix != nil {
+		predicates = append(predicates, certificate.BuildSignerURIHasPrefix(*i.BuildSignerURIHasPrefix))
+	}
+	if i.BuildSignerURIHasSuffix != nil {
+		predicates = append(predicates, certificate.BuildSignerURIHasSuffix(*i.BuildSignerURIHasSuffix))
+	}
+	if i.BuildSignerURIIsNil {
+		predicates = append(predicates, certificate.BuildSignerURIIsNil())
+	}
+	if i.BuildSignerURINotNil {
+		predicates = append(predicates, certificate.BuildSignerURINotNil())
+	}
+	if i.BuildSignerURIEqualFold != nil {
+		predicates = append(predicates, certificate.BuildSignerURIEqualFold(*i.BuildSignerURIEqualFold))
+	}
+	if i.BuildSignerURIContainsFold != nil {
+		predicates = append(predicates, certificate.BuildSignerURIContainsFold(*i.BuildSignerURIContainsFold))
+	}
+	if i.BuildSignerDigest != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestEQ(*i.BuildSignerDigest))
+	}
+	if i.BuildSignerDigestNEQ != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestNEQ(*i.BuildSignerDigestNEQ))
+	}
+	if len(i.BuildSignerDigestIn) > 0 {
+		predicates = append(predicates, certificate.BuildSignerDigestIn(i.BuildSignerDigestIn...))
+	}
+	if len(i.BuildSignerDigestNotIn) > 0 {
+		predicates = append(predicates, certificate.BuildSignerDigestNotIn(i.BuildSignerDigestNotIn...))
+	}
+	if i.BuildSignerDigestGT != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestGT(*i.BuildSignerDigestGT))
+	}
+	if i.BuildSignerDigestGTE != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestGTE(*i.BuildSignerDigestGTE))
+	}
+	if i.BuildSignerDigestLT != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestLT(*i.BuildSignerDigestLT))
+	}
+	if i.BuildSignerDigestLTE != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestLTE(*i.BuildSignerDigestLTE))
+	}
+	if i.BuildSignerDigestContains != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestContains(*i.BuildSignerDigestContains))
+	}
+	if i.BuildSignerDigestHasPrefix != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestHasPrefix(*i.BuildSignerDigestHasPrefix))
+	}
+	if i.BuildSignerDigestHasSuffix != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestHasSuffix(*i.BuildSignerDigestHasSuffix))
+	}
+	if i.BuildSignerDigestIsNil {
+		predicates = append(predicates, certificate.BuildSignerDigestIsNil())
+	}
+	if i.BuildSignerDigestNotNil {
+		predicates = append(predicates, certificate.BuildSignerDigestNotNil())
+	}
+	if i.BuildSignerDigestEqualFold != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestEqualFold(*i.BuildSignerDigestEqualFold))
+	}
+	if i.BuildSignerDigestContainsFold != nil {
+		predicates = append(predicates, certificate.BuildSignerDigestContainsFold(*i.BuildSignerDigestContainsFold))
+	}
+	if i.RunnerEnvironment != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentEQ(*i.RunnerEnvironment))
+	}
+	if i.RunnerEnvironmentNEQ != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentNEQ(*i.RunnerEnvironmentNEQ))
+	}
+	if len(i.RunnerEnvironmentIn) > 0 {
+		predicates = append(predicates, certificate.RunnerEnvironmentIn(i.RunnerEnvironmentIn...))
+	}
+	if len(i.RunnerEnvironmentNotIn) > 0 {
+		predicates = append(predicates, certificate.RunnerEnvironmentNotIn(i.RunnerEnvironmentNotIn...))
+	}
+	if i.RunnerEnvironmentGT != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentGT(*i.RunnerEnvironmentGT))
+	}
+	if i.RunnerEnvironmentGTE != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentGTE(*i.RunnerEnvironmentGTE))
+	}
+	if i.RunnerEnvironmentLT != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentLT(*i.RunnerEnvironmentLT))
+	}
+	if i.RunnerEnvironmentLTE != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentLTE(*i.RunnerEnvironmentLTE))
+	}
+	if i.RunnerEnvironmentContains != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentContains(*i.RunnerEnvironmentContains))
+	}
+	if i.RunnerEnvironmentHasPrefix != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentHasPrefix(*i.RunnerEnvironmentHasPrefix))
+	}
+	if i.RunnerEnvironmentHasSuffix != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentHasSuffix(*i.RunnerEnvironmentHasSuffix))
+	}
+	if i.RunnerEnvironmentIsNil {
+		predicates = append(predicates, certificate.RunnerEnvironmentIsNil())
+	}
+	if i.RunnerEnvironmentNotNil {
+		predicates = append(predicates, certificate.RunnerEnvironmentNotNil())
+	}
+	if i.RunnerEnvironmentEqualFold != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentEqualFold(*i.RunnerEnvironmentEqualFold))
+	}
+	if i.RunnerEnvironmentContainsFold != nil {
+		predicates = append(predicates, certificate.RunnerEnvironmentContainsFold(*i.RunnerEnvironmentContainsFold))
+	}
+	if i.SourceRepositoryURI != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURIEQ(*i.SourceRepositoryURI))
+	}
+	if i.SourceRepositoryURINEQ != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURINEQ(*i.SourceRepositoryURINEQ))
+	}
+	if len(i.SourceRepositoryURIIn) > 0 {
+		predicates = append(predicates, certificate.SourceRepositoryURIIn(i.SourceRepositoryURIIn...))
+	}
+	if len(i.SourceRepositoryURINotIn) > 0 {
+		predicates = append(predicates, certificate.SourceRepositoryURINotIn(i.SourceRepositoryURINotIn...))
+	}
+	if i.SourceRepositoryURIGT != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURIGT(*i.SourceRepositoryURIGT))
+	}
+	if i.SourceRepositoryURIGTE != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURIGTE(*i.SourceRepositoryURIGTE))
+	}
+	if i.SourceRepositoryURILT != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURILT(*i.SourceRepositoryURILT))
+	}
+	if i.SourceRepositoryURILTE != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURILTE(*i.SourceRepositoryURILTE))
+	}
+	if i.SourceRepositoryURIContains != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURIContains(*i.SourceRepositoryURIContains))
+	}
+	if i.SourceRepositoryURIHasPrefix != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURIHasPrefix(*i.SourceRepositoryURIHasPrefix))
+	}
+	if i.SourceRepositoryURIHasSuffix != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURIHasSuffix(*i.SourceRepositoryURIHasSuffix))
+	}
+	if i.SourceRepositoryURIIsNil {
+		predicates = append(predicates, certificate.SourceRepositoryURIIsNil())
+	}
+	if i.SourceRepositoryURINotNil {
+		predicates = append(predicates, certificate.SourceRepositoryURINotNil())
+	}
+	if i.SourceRepositoryURIEqualFold != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURIEqualFold(*i.SourceRepositoryURIEqualFold))
+	}
+	if i.SourceRepositoryURIContainsFold != nil {
+		predicates = append(predicates, certificate.SourceRepositoryURIContainsFold(*i.SourceRepositoryURIContainsFold))
+	}
+	if i.SourceRepositoryDigest != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestEQ(*i.SourceRepositoryDigest))
+	}
+	if i.SourceRepositoryDigestNEQ != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestNEQ(*i.SourceRepositoryDigestNEQ))
+	}
+	if len(i.SourceRepositoryDigestIn) > 0 {
+		predicates = append(predicates, certificate.SourceRepositoryDigestIn(i.SourceRepositoryDigestIn...))
+	}
+	if len(i.SourceRepositoryDigestNotIn) > 0 {
+		predicates = append(predicates, certificate.SourceRepositoryDigestNotIn(i.SourceRepositoryDigestNotIn...))
+	}
+	if i.SourceRepositoryDigestGT != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestGT(*i.SourceRepositoryDigestGT))
+	}
+	if i.SourceRepositoryDigestGTE != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestGTE(*i.SourceRepositoryDigestGTE))
+	}
+	if i.SourceRepositoryDigestLT != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestLT(*i.SourceRepositoryDigestLT))
+	}
+	if i.SourceRepositoryDigestLTE != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestLTE(*i.SourceRepositoryDigestLTE))
+	}
+	if i.SourceRepositoryDigestContains != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestContains(*i.SourceRepositoryDigestContains))
+	}
+	if i.SourceRepositoryDigestHasPrefix != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestHasPrefix(*i.SourceRepositoryDigestHasPrefix))
+	}
+	if i.SourceRepositoryDigestHasSuffix != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestHasSuffix(*i.SourceRepositoryDigestHasSuffix))
+	}
+	if i.SourceRepositoryDigestIsNil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestIsNil())
+	}
+	if i.SourceRepositoryDigestNotNil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestNotNil())
+	}
+	if i.SourceRepositoryDigestEqualFold != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestEqualFold(*i.SourceRepositoryDigestEqualFold))
+	}
+	if i.SourceRepositoryDigestContainsFold != nil {
+		predicates = append(predicates, certificate.SourceRepositoryDigestContainsFold(*i.SourceRepositoryDigestContainsFold))
+	}
+	if i.SourceRepositoryRef != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefEQ(*i.SourceRepositoryRef))
+	}
+	if i.SourceRepositoryRefNEQ != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefNEQ(*i.SourceRepositoryRefNEQ))
+	}
+	if len(i.SourceRepositoryRefIn) > 0 {
+		predicates = append(predicates, certificate.SourceRepositoryRefIn(i.SourceRepositoryRefIn...))
+	}
+	if len(i.SourceRepositoryRefNotIn) > 0 {
+		predicates = append(predicates, certificate.SourceRepositoryRefNotIn(i.SourceRepositoryRefNotIn...))
+	}
+	if i.SourceRepositoryRefGT != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefGT(*i.SourceRepositoryRefGT))
+	}
+	if i.SourceRepositoryRefGTE != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefGTE(*i.SourceRepositoryRefGTE))
+	}
+	if i.SourceRepositoryRefLT != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefLT(*i.SourceRepositoryRefLT))
+	}
+	if i.SourceRepositoryRefLTE != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefLTE(*i.SourceRepositoryRefLTE))
+	}
+	if i.SourceRepositoryRefContains != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefContains(*i.SourceRepositoryRefContains))
+	}
+	if i.SourceRepositoryRefHasPrefix != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefHasPrefix(*i.SourceRepositoryRefHasPrefix))
+	}
+	if i.SourceRepositoryRefHasSuffix != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefHasSuffix(*i.SourceRepositoryRefHasSuffix))
+	}
+	if i.SourceRepositoryRefIsNil {
+		predicates = append(predicates, certificate.SourceRepositoryRefIsNil())
+	}
+	if i.SourceRepositoryRefNotNil {
+		predicates = append(predicates, certificate.SourceRepositoryRefNotNil())
+	}
+	if i.SourceRepositoryRefEqualFold != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefEqualFold(*i.SourceRepositoryRefEqualFold))
+	}
+	if i.SourceRepositoryRefContainsFold != nil {
+		predicates = append(predicates, certificate.SourceRepositoryRefContainsFold(*i.SourceRepositoryRefContainsFold))
+	}
+	if i.BuildConfigURI != nil {
+		predicates = append(predicates, certificate.BuildConfigURIEQ(*i.BuildConfigURI))
+	}
+	if i.BuildConfigURINEQ != nil {
+		predicates = append(predicates, certificate.BuildConfigURINEQ(*i.BuildConfigURINEQ))
+	}
+	if len(i.BuildConfigURIIn) > 0 {
+		predicates = append(predicates, certificate.BuildConfigURIIn(i.BuildConfigURIIn...))
+	}
+	if len(i.BuildConfigURINotIn) > 0 {
+		predicates = append(predicates, certificate.BuildConfigURINotIn(i.BuildConfigURINotIn...))
+	}
+	if i.BuildConfigURIGT != nil {
+		predicates = append(predicates, certificate.BuildConfigURIGT(*i.BuildConfigURIGT))
+	}
+	if i.BuildConfigURIGTE != nil {
+		predicates = append(predicates, certificate.BuildConfigURIGTE(*i.BuildConfigURIGTE))
+	}
+	if i.BuildConfigURILT != nil {
+		predicates = append(predicates, certificate.BuildConfigURILT(*i.BuildConfigURILT))
+	}
+	if i.BuildConfigURILTE != nil {
+		predicates = append(predicates, certificate.BuildConfigURILTE(*i.BuildConfigURILTE))
+	}
+	if i.BuildConfigURIContains != nil {
+		predicates = append(predicates, certificate.BuildConfigURIContains(*i.BuildConfigURIContains))
+	}
+	if i.BuildConfigURIHasPrefix != nil {
+		predicates = append(predicates, certificate.BuildConfigURIHasPrefix(*i.BuildConfigURIHasPrefix))
+	}
+	if i.BuildConfigURIHasSuffix != nil {
+		predicates = append(predicates, certificate.BuildConfigURIHasSuffix(*i.BuildConfigURIHasSuffix))
+	}
+	if i.BuildConfigURIIsNil {
+		predicates = append(predicates, certificate.BuildConfigURIIsNil())
+	}
+	if i.BuildConfigURINotNil {
+		predicates = append(predicates, certificate.BuildConfigURINotNil())
+	}
+	if i.BuildConfigURIEqualFold != nil {
+		predicates = append(predicates, certificate.BuildConfigURIEqualFold(*i.BuildConfigURIEqualFold))
+	}
+	if i.BuildConfigURIContainsFold != nil {
+		predicates = append(predicates, certificate.BuildConfigURIContainsFold(*i.BuildConfigURIContainsFold))
+	}
+	if i.BuildTrigger != nil {
+		predicates = append(predicates, certificate.BuildTriggerEQ(*i.BuildTrigger))
+	}
+	if i.BuildTriggerNEQ != nil {
+		predicates = append(predicates, certificate.BuildTriggerNEQ(*i.BuildTriggerNEQ))
+	}
+	if len(i.BuildTriggerIn) > 0 {
+		predicates = append(predicates, certificate.BuildTriggerIn(i.BuildTriggerIn...))
+	}
+	if len(i.BuildTriggerNotIn) > 0 {
+		predicates = append(predicates, certificate.BuildTriggerNotIn(i.BuildTriggerNotIn...))
+	}
+	if i.BuildTriggerGT != nil {
+		predicates = append(predicates, certificate.BuildTriggerGT(*i.BuildTriggerGT))
+	}
+	if i.BuildTriggerGTE != nil {
+		predicates = append(predicates, certificate.BuildTriggerGTE(*i.BuildTriggerGTE))
+	}
+	if i.BuildTriggerLT != nil {
+		predicates = append(predicates, certificate.BuildTriggerLT(*i.BuildTriggerLT))
+	}
+	if i.BuildTriggerLTE != nil {
+		predicates = append(predicates, certificate.BuildTriggerLTE(*i.BuildTriggerLTE))
+	}
+	if i.BuildTriggerContains != nil {
+		predicates = append(predicates, certificate.BuildTriggerContains(*i.BuildTriggerContains))
+	}
+	if i.BuildTriggerHasPrefix != nil {
+		predicates = append(predicates, certificate.BuildTriggerHasPrefix(*i.BuildTriggerHasPrefix))
+	}
+	if i.BuildTriggerHasSuffix != nil {
+		predicates = append(predicates, certificate.BuildTriggerHasSuffix(*i.BuildTriggerHasSuffix))
+	}
+	if i.BuildTriggerIsNil {
+		predicates = append(predicates, certificate.BuildTriggerIsNil())
+	}
+	if i.BuildTriggerNotNil {
+		predicates = append(predicates, certificate.BuildTriggerNotNil())
+	}
+	if i.BuildTriggerEqualFold != nil {
+		predicates = append(predicates, certificate.BuildTriggerEqualFold(*i.BuildTriggerEqualFold))
+	}
+	if i.BuildTriggerContainsFold != nil {
+		predicates = append(predicates, certificate.BuildTriggerContainsFold(*i.BuildTriggerContainsFold))
+	}
+	if i.RunInvocationURI != nil {
+		predicates = append(predicates, certificate.RunInvocationURIEQ(*i.RunInvocationURI))
+	}
+	if i.RunInvocationURINEQ != nil {
+		predicates = append(predicates, certificate.RunInvocationURINEQ(*i.RunInvocationURINEQ))
+	}
+	if len(i.RunInvocationURIIn) > 0 {
+		predicates = append(predicates, certificate.RunInvocationURIIn(i.RunInvocationURIIn...))
+	}
+	if len(i.RunInvocationURINotIn) > 0 {
+		predicates = append(predicates, certificate.RunInvocationURINotIn(i.RunInvocationURINotIn...))
+	}
+	if i.RunInvocationURIGT != nil {
+		predicates = append(predicates, certificate.RunInvocationURIGT(*i.RunInvocationURIGT))
+	}
+	if i.RunInvocationURIGTE != nil {
+		predicates = append(predicates, certificate.RunInvocationURIGTE(*i.RunInvocationURIGTE))
+	}
+	if i.RunInvocationURILT != nil {
+		predicates = append(predicates, certificate.RunInvocationURILT(*i.RunInvocationURILT))
+	}
+	if i.RunInvocationURILTE != nil {
+		predicates = append(predicates, certificate.RunInvocationURILTE(*i.RunInvocationURILTE))
+	}
+	if i.RunInvocationURIContains != nil {
+		predicates = append(predicates, certificate.RunInvocationURIContains(*i.RunInvocationURIContains))
+	}
+	if i.RunInvocationURIHasPrefix != nil {
+		predicates = append(predicates, certificate.RunInvocationURIHasPrefix(*i.RunInvocationURIHasPrefix))
+	}
+	if i.RunInvocationURIHasSuffix != nil {
+		predicates = append(predicates, certificate.RunInvocationURIHasSuffix(*i.RunInvocationURIHasSuffix))
+	}
+	if i.RunInvocationURIIsNil {
+		predicates = append(predicates, certificate.RunInvocationURIIsNil())
+	}
+	if i.RunInvocationURINotNil {
+		predicates = append(predicates, certificate.RunInvocationURINotNil())
+	}
+	if i.RunInvocationURIEqualFold != nil {
+		predicates = append(predicates, certificate.RunInvocationURIEqualFold(*i.RunInvocationURIEqualFold))
+	}
+	if i.RunInvocationURIContainsFold != nil {
+		predicates = append(predicates, certificate.RunInvocationURIContainsFold(*i.RunInvocationURIContainsFold))
+	}
+
+	if i.HasSubjectAltNames != nil {
+		p := certificate.HasSubjectAltNames()
+		if !*i.HasSubjectAltNames {
+			p = certificate.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSubjectAltNamesWith) > 0 {
+		with := make([]predicate.SubjectAltName, 0, len(i.HasSubjectAltNamesWith))
+		for _, w := range i.HasSubjectAltNamesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSubjectAltNamesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, certificate.HasSubjectAltNamesWith(with...))
+	}
+	if i.HasSignature != nil {
+		p := certificate.HasSignature()
+		if !*i.HasSignature {
+			p = certificate.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSignatureWith) > 0 {
+		with := make([]predicate.Signature, 0, len(i.HasSignatureWith))
+		for _, w := range i.HasSignatureWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSignatureWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, certificate.HasSignatureWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyCertificateWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return certificate.And(predicates...), nil
+	}
+}
+
+// CommandRunAttestationWhereInput represents a where input for filtering CommandRunAttestation queries.
+type CommandRunAttestationWhereInput struct {
+	Predicates []predicate.CommandRunAttestation  `json:"-"`
+	Not        *CommandRunAttestationWhereInput   `json:"not,omitempty"`
+	Or         []*CommandRunAttestationWhereInput `json:"or,omitempty"`
+	And        []*CommandRunAttestationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "cmd" field predicates.
+	Cmd             *string  `json:"cmd,omitempty"`
+	CmdNEQ          *string  `json:"cmdNEQ,omitempty"`
+	CmdIn           []string `json:"cmdIn,omitempty"`
+	CmdNotIn        []string `json:"cmdNotIn,omitempty"`
+	CmdGT           *string  `json:"cmdGT,omitempty"`
+	CmdGTE          *string  `json:"cmdGTE,omitempty"`
+	CmdLT           *string  `json:"cmdLT,omitempty"`
+	CmdLTE          *string  `json:"cmdLTE,omitempty"`
+	CmdContains     *string  `json:"cmdContains,omitempty"`
+	CmdHasPrefix    *string  `json:"cmdHasPrefix,omitempty"`
+	CmdHasSuffix    *string  `json:"cmdHasSuffix,omitempty"`
+	CmdEqualFold    *string  `json:"cmdEqualFold,omitempty"`
+	CmdContainsFold *string  `json:"cmdContainsFold,omitempty"`
+
+	// "exit_code" field predicates.
+	ExitCode      *int  `json:"exitCode,omitempty"`
+	ExitCodeNEQ   *int  `json:"exitCodeNEQ,omitempty"`
+	ExitCodeIn    []int `json:"exitCodeIn,omitempty"`
+	ExitCodeNotIn []int `json:"exitCodeNotIn,omitempty"`
+	ExitCodeGT    *int  `json:"exitCodeGT,omitempty"`
+	ExitCodeGTE   *int  `json:"exitCodeGTE,omitempty"`
+	ExitCodeLT    *int  `json:"exitCodeLT,omitempty"`
+	ExitCodeLTE   *int  `json:"exitCodeLTE,omitempty"`
+
+	// "attestation" edge predicates.
+	HasAttestation     *bool                    `json:"hasAttestation,omitempty"`
+	HasAttestationWith []*AttestationWhereInput `json:"hasAttestationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *CommandRunAttestationWhereInput) AddPredicates(predicates ...predicate.CommandRunAttestation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the CommandRunAttestationWhereInput filter on the CommandRunAttestationQuery builder.
+func (i *CommandRunAttestationWhereInput) Filter(q *CommandRunAttestationQuery) (*CommandRunAttestationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyCommandRunAttestationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyCommandRunAttestationWhereInput is returned in case the CommandRunAttestationWhereInput is empty.
+var ErrEmptyCommandRunAttestationWhereInput = errors.New("ent: empty predicate CommandRunAttestationWhereInput")
+
+// P returns a predicate for filtering commandrunattestations.
+// An error is returned if the input is empty or invalid.
+func (i *CommandRunAttestationWhereInput) P() (predicate.CommandRunAttestation, error) {
+	var predicates []predicate.CommandRunAttestation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, commandrunattestation.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.CommandRunAttestation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, commandrunattestation.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.CommandRunAttestation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, commandrunattestation.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, commandrunattestation.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, commandrunattestation.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, commandrunattestation.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, commandrunattestation.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, commandrunattestation.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, commandrunattestation.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, commandrunattestation.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, commandrunattestation.IDLTE(*i.IDLTE))
+	}
+	if i.Cmd != nil {
+		predicates = append(predicates, commandrunattestation.CmdEQ(*i.Cmd))
+	}
+	if i.CmdNEQ != nil {
+		predicates = append(predicates, commandrunattestation.CmdNEQ(*i.CmdNEQ))
+	}
+	if len(i.CmdIn) > 0 {
+		predicates = append(predicates, commandrunattestation.CmdIn(i.CmdIn...))
+	}
+	if len(i.CmdNotIn) > 0 {
+		predicates = append(predicates, commandrunattestation.CmdNotIn(i.CmdNotIn...))
+	}
+	if i.CmdGT != nil {
+		predicates = append(predicates, commandrunattestation.CmdGT(*i.CmdGT))
+	}
+	if i.CmdGTE != nil {
+		predicates = append(predicates, commandrunattestation.CmdGTE(*i.CmdGTE))
+	}
+	if i.CmdLT != nil {
+		predicates = append(predicates, commandrunattestation.CmdLT(*i.CmdLT))
+	}
+	if i.CmdLTE != nil {
+		predicates = append(predicates, commandrunattestation.CmdLTE(*i.CmdLTE))
+	}
+	if i.CmdContains != nil {
+		predicates = append(predicates, commandrunattestation.CmdContains(*i.CmdContains))
+	}
+	if i.CmdHasPrefix != nil {
+		predicates = append(predicates, commandrunattestation.CmdHasPrefix(*i.CmdHasPrefix))
+	}
+	if i.CmdHasSuffix != nil {
+		predicates = append(predicates, commandrunattestation.CmdHasSuffix(*i.CmdHasSuffix))
+	}
+	if i.CmdEqualFold != nil {
+		predicates = append(predicates, commandrunattestation.CmdEqualFold(*i.CmdEqualFold))
+	}
+	if i.CmdContainsFold != nil {
+		predicates = append(predicates, commandrunattestation.CmdContainsFold(*i.CmdContainsFold))
+	}
+	if i.ExitCode != nil {
+		predicates = append(predicates, commandrunattestation.ExitCodeEQ(*i.ExitCode))
+	}
+	if i.ExitCodeNEQ != nil {
+		predicates = append(predicates, commandrunattestation.ExitCodeNEQ(*i.ExitCodeNEQ))
+	}
+	if len(i.ExitCodeIn) > 0 {
+		predicates = append(predicates, commandrunattestation.ExitCodeIn(i.ExitCodeIn...))
+	}
+	if len(i.ExitCodeNotIn) > 0 {
+		predicates = append(predicates, commandrunattestation.ExitCodeNotIn(i.ExitCodeNotIn...))
+	}
+	if i.ExitCodeGT != nil {
+		predicates = append(predicates, commandrunattestation.ExitCodeGT(*i.ExitCodeGT))
+	}
+	if i.ExitCodeGTE != nil {
+		predicates = append(predicates, commandrunattestation.ExitCodeGTE(*i.ExitCodeGTE))
+	}
+	if i.ExitCodeLT != nil {
+		predicates = append(predicates, commandrunattestation.ExitCodeLT(*i.ExitCodeLT))
+	}
+	if i.ExitCodeLTE != nil {
+		predicates = append(predicates, commandrunattestation.ExitCodeLTE(*i.ExitCodeLTE))
+	}
+
+	if i.HasAttestation != nil {
+		p := commandrunattestation.HasAttestation()
+		if !*i.HasAttestation {
+			p = commandrunattestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAttestationWith) > 0 {
+		with := make([]predicate.Attestation, 0, len(i.HasAttestationWith))
+		for _, w := range i.HasAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, commandrunattestation.HasAttestationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyCommandRunAttestationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return commandrunattestation.And(predicates...), nil
+	}
+}
+
+// DsseWhereInput represents a where input for filtering Dsse queries.
+type DsseWhereInput struct {
+	Predicates []predicate.Dsse  `json:"-"`
+	Not        *DsseWhereInput   `json:"not,omitempty"`
+	Or         []*DsseWhereInput `json:"or,omitempty"`
+	And        []*DsseWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt       *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ    *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT     *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE    *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT     *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE    *time.Time  `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil bool        `json:"createdAtNotNil,omitempty"`
+
+	// "gitoid_sha256" field predicates.
+	GitoidSha256             *string  `json:"gitoidSha256,omitempty"`
+	GitoidSha256NEQ          *string  `json:"gitoidSha256NEQ,omitempty"`
+	GitoidSha256In           []string `json:"gitoidSha256In,omitempty"`
+	GitoidSha256NotIn        []string `json:"gitoidSha256NotIn,omitempty"`
+	GitoidSha256GT           *string  `json:"gitoidSha256GT,omitempty"`
+	GitoidSha256GTE          *string  `json:"gitoidSha256GTE,omitempty"`
+	GitoidSha256LT           *string  `json:"gitoidSha256LT,omitempty"`
+	GitoidSha256LTE          *string  `json:"gitoidSha256LTE,omitempty"`
+	GitoidSha256Contains     *string  `json:"gitoidSha256Contains,omitempty"`
+	GitoidSha256HasPrefix    *string  `json:"gitoidSha256HasPrefix,omitempty"`
+	GitoidSha256HasSuffix    *string  `json:"gitoidSha256HasSuffix,omitempty"`
+	GitoidSha256EqualFold    *string  `json:"gitoidSha256EqualFold,omitempty"`
+	GitoidSha256ContainsFold *string  `json:"gitoidSha256ContainsFold,omitempty"`
+
+	// "payload_type" field predicates.
+	PayloadType             *string  `json:"payloadType,omitempty"`
+	PayloadTypeNEQ          *string  `json:"payloadTypeNEQ,omitempty"`
+	PayloadTypeIn           []string `json:"payloadTypeIn,omitempty"`
+	PayloadTypeNotIn        []string `json:"payloadTypeNotIn,omitempty"`
+	PayloadTypeGT           *string  `json:"payloadTypeGT,omitempty"`
+	PayloadTypeGTE          *string  `json:"payloadTypeGTE,omitempty"`
+	PayloadTypeLT           *string  `json:"payloadTypeLT,omitempty"`
+	PayloadTypeLTE          *string  `json:"payloadTypeLTE,omitempty"`
+	PayloadTypeContains     *string  `json:"payloadTypeContains,omitempty"`
+	PayloadTypeHasPrefix    *string  `json:"payloadTypeHasPrefix,omitempty"`
+	PayloadTypeHasSuffix    *string  `json:"payloadTypeHasSuffix,omitempty"`
+	PayloadTypeEqualFold    *string  `json:"payloadTypeEqualFold,omitempty"`
+	PayloadTypeContainsFold *string  `json:"payloadTypeContainsFold,omitempty"`
+
+	// "statement" edge predicates.
+	HasStatement     *bool                  `json:"hasStatement,omitempty"`
+	HasStatementWith []*StatementWhereInput `json:"hasStatementWith,omitempty"`
+
+	// "signatures" edge predicates.
+	HasSignatures     *bool                  `json:"hasSignatures,omitempty"`
+	HasSignaturesWith []*SignatureWhereInput `json:"hasSignaturesWith,omitempty"`
+
+	// "payload_digests" edge predicates.
+	HasPayloadDigests     *bool                      `json:"hasPayloadDigests,omitempty"`
+	HasPayloadDigestsWith []*PayloadDigestWhereInput `json:"hasPayloadDigestsWith,omitempty"`
+
+	// "publish_deliveries" edge predicates.
+	HasPublishDeliveries     *bool                        `json:"hasPublishDeliveries,omitempty"`
+	HasPublishDeliveriesWith []*PublishDeliveryWhereInput `json:"hasPublishDeliveriesWith,omitempty"`
+
+	// "publications" edge predicates.
+	HasPublications     *bool                    `json:"hasPublications,omitempty"`
+	HasPublicationsWith []*PublicationWhereInput `json:"hasPublicationsWith,omitempty"`
+
+	// "transparency_log_entries" edge predicates.
+	HasTransparencyLogEntries     *bool                             `json:"hasTransparencyLogEntries,omitempty"`
+	HasTransparencyLogEntriesWith []*TransparencyLogEntryWhereInput `json:"hasTransparencyLogEntriesWith,omitempty"`
+
+	// "legal_holds" edge predicates.
+	HasLegalHolds     *bool                  `json:"hasLegalHolds,omitempty"`
+	HasLegalHoldsWith []*LegalHoldWhereInput `json:"hasLegalHoldsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *DsseWhereInput) AddPredicates(predicates ...predicate.Dsse) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the DsseWhereInput filter on the DsseQuery builder.
+func (i *DsseWhereInput) Filter(q *DsseQuery) (*DsseQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyDsseWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyDsseWhereInput is returned in case the DsseWhereInput is empty.
+var ErrEmptyDsseWhereInput = errors.New("ent: empty predicate DsseWhereInput")
+
+// P returns a predicate for filtering dsses.
+// An error is returned if the input is empty or invalid.
+func (i *DsseWhereInput) P() (predicate.Dsse, error) {
+	var predicates []predicate.Dsse
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, dsse.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Dsse, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, dsse.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Dsse, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, dsse.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, dsse.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, dsse.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, dsse.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, dsse.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, dsse.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, dsse.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, dsse.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, dsse.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, dsse.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, dsse.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, dsse.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, dsse.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, dsse.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, dsse.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, dsse.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, dsse.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.CreatedAtIsNil {
+		predicates = append(predicates, dsse.CreatedAtIsNil())
+	}
+	if i.CreatedAtNotNil {
+		predicates = append(predicates, dsse.CreatedAtNotNil())
+	}
+	if i.GitoidSha256 != nil {
+		predicates = append(predicates, dsse.GitoidSha256EQ(*i.GitoidSha256))
+	}
+	if i.GitoidSha256NEQ != nil {
+		predicates = append(predicates, dsse.GitoidSha256NEQ(*i.GitoidSha256NEQ))
+	}
+	if len(i.GitoidSha256In) > 0 {
+		predicates = append(predicates, dsse.GitoidSha256In(i.GitoidSha256In...))
+	}
+	if len(i.GitoidSha256NotIn) > 0 {
+		predicates = append(predicates, dsse.GitoidSha256NotIn(i.GitoidSha256NotIn...))
+	}
+	if i.GitoidSha256GT != nil {
+		predicates = append(predicates, dsse.GitoidSha256GT(*i.GitoidSha256GT))
+	}
+	if i.GitoidSha256GTE != nil {
+		predicates = append(predicates, dsse.GitoidSha256GTE(*i.GitoidSha256GTE))
+	}
+	if i.GitoidSha256LT != nil {
+		predicates = append(predicates, dsse.GitoidSha256LT(*i.GitoidSha256LT))
+	}
+	if i.GitoidSha256LTE != nil {
+		predicates = append(predicates, dsse.GitoidSha256LTE(*i.GitoidSha256LTE))
+	}
+	if i.GitoidSha256Contains != nil {
+		predicates = append(predicates, dsse.GitoidSha256Contains(*i.GitoidSha256Contains))
+	}
+	if i.GitoidSha256HasPrefix != nil {
+		predicates = append(predicates, dsse.GitoidSha256HasPrefix(*i.GitoidSha256HasPrefix))
+	}
+	if i.GitoidSha256HasSuffix != nil {
+		predicates = append(predicates, dsse.GitoidSha256HasSuffix(*i.GitoidSha256HasSuffix))
+	}
+	if i.GitoidSha256EqualFold != nil {
+		predicates = append(predicates, dsse.GitoidSha256EqualFold(*i.GitoidSha256EqualFold))
+	}
+	if i.GitoidSha256ContainsFold != nil {
+		predicates = append(predicates, dsse.GitoidSha256ContainsFold(*i.GitoidSha256ContainsFold))
+	}
+	if i.PayloadType != nil {
+		predicates = append(predicates, dsse.PayloadTypeEQ(*i.PayloadType))
+	}
+	if i.PayloadTypeNEQ != nil {
+		predicates = append(predicates, dsse.PayloadTypeNEQ(*i.PayloadTypeNEQ))
+	}
+	if len(i.PayloadTypeIn) > 0 {
+		predicates = append(predicates, dsse.PayloadTypeIn(i.PayloadTypeIn...))
+	}
+	if len(i.PayloadTypeNotIn) > 0 {
+		predicates = append(predicates, dsse.PayloadTypeNotIn(i.PayloadTypeNotIn...))
+	}
+	if i.PayloadTypeGT != nil {
+		predicates = append(predicates, dsse.PayloadTypeGT(*i.PayloadTypeGT))
+	}
+	if i.PayloadTypeGTE != nil {
+		predicates = append(predicates, dsse.PayloadTypeGTE(*i.PayloadTypeGTE))
+	}
+	if i.PayloadTypeLT != nil {
+		predicates = append(predicates, dsse.PayloadTypeLT(*i.PayloadTypeLT))
+	}
+	if i.PayloadTypeLTE != nil {
+		predicates = append(predicates, dsse.PayloadTypeLTE(*i.PayloadTypeLTE))
+	}
+	if i.PayloadTypeContains != nil {
+		predicates = append(predicates, dsse.PayloadTypeContains(*i.PayloadTypeContains))
+	}
+	if i.PayloadTypeHasPrefix != nil {
+		predicates = append(predicates, dsse.PayloadTypeHasPrefix(*i.PayloadTypeHasPrefix))
+	}
+	if i.PayloadTypeHasSuffix != nil {
+		predicates = append(predicates, dsse.PayloadTypeHasSuffix(*i.PayloadTypeHasSuffix))
+	}
+	if i.PayloadTypeEqualFold != nil {
+		predicates = append(predicates, dsse.PayloadTypeEqualFold(*i.PayloadTypeEqualFold))
+	}
+	if i.PayloadTypeContainsFold != nil {
+		predicates = append(predicates, dsse.PayloadTypeContainsFold(*i.PayloadTypeContainsFold))
+	}
+
+	if i.HasStatement != nil {
+		p := dsse.HasStatement()
+		if !*i.HasStatement {
+			p = dsse.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasStatementWith) > 0 {
+		with := make([]predicate.Statement, 0, len(i.HasStatementWith))
+		for _, w := range i.HasStatementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasStatementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, dsse.HasStatementWith(with...))
+	}
+	if i.HasSignatures != nil {
+		p := dsse.HasSignatures()
+		if !*i.HasSignatures {
+			p = dsse.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSignaturesWith) > 0 {
+		with := make([]predicate.Signature, 0, len(i.HasSignaturesWith))
+		for _, w := range i.HasSignaturesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSignaturesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, dsse.HasSignaturesWith(with...))
+	}
+	if i.HasPayloadDigests != nil {
+		p := dsse.HasPayloadDigests()
+		if !*i.HasPayloadDigests {
+			p = dsse.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasPayloadDigestsWith) > 0 {
+		with := make([]predicate.PayloadDigest, 0, len(i.HasPayloadDigestsWith))
+		for _, w := range i.HasPayloadDigestsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPayloadDigestsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, dsse.HasPayloadDigestsWith(with...))
+	}
+	if i.HasPublishDeliveries != nil {
+		p := dsse.HasPublishDeliveries()
+		if !*i.HasPublishDeliveries {
+			p = dsse.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasPublishDeliveriesWith) > 0 {
+		with := make([]predicate.PublishDelivery, 0, len(i.HasPublishDeliveriesWith))
+		for _, w := range i.HasPublishDeliveriesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPublishDeliveriesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, dsse.HasPublishDeliveriesWith(with...))
+	}
+	if i.HasPublications != nil {
+		p := dsse.HasPublications()
+		if !*i.HasPublications {
+			p = dsse.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasPublicationsWith) > 0 {
+		with := make([]predicate.Publication, 0, len(i.HasPublicationsWith))
+		for _, w := range i.HasPublicationsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPublicationsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, dsse.HasPublicationsWith(with...))
+	}
+	if i.HasTransparencyLogEntries != nil {
+		p := dsse.HasTransparencyLogEntries()
+		if !*i.HasTransparencyLogEntries {
+			p = dsse.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasTransparencyLogEntriesWith) > 0 {
+		with := make([]predicate.TransparencyLogEntry, 0, len(i.HasTransparencyLogEntriesWith))
+		for _, w := range i.HasTransparencyLogEntriesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTransparencyLogEntriesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, dsse.HasTransparencyLogEntriesWith(with...))
+	}
+	if i.HasLegalHolds != nil {
+		p := dsse.HasLegalHolds()
+		if !*i.HasLegalHolds {
 			p = dsse.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasStatementWith) > 0 {
-		with := make([]predicate.Statement, 0, len(i.HasStatementWith))
-		for _, w := range i.HasStatementWith {
+	if len(i.HasLegalHoldsWith) > 0 {
+		with := make([]predicate.LegalHold, 0, len(i.HasLegalHoldsWith))
+		for _, w := range i.HasLegalHoldsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasLegalHoldsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, dsse.HasLegalHoldsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyDsseWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return dsse.And(predicates...), nil
+	}
+}
+
+// EnvironmentAttestationWhereInput represents a where input for filtering EnvironmentAttestation queries.
+type EnvironmentAttestationWhereInput struct {
+	Predicates []predicate.EnvironmentAttestation  `json:"-"`
+	Not        *EnvironmentAttestationWhereInput   `json:"not,omitempty"`
+	Or         []*EnvironmentAttestationWhereInput `json:"or,omitempty"`
+	And        []*EnvironmentAttestationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "os" field predicates.
+	Os             *string  `json:"os,omitempty"`
+	OsNEQ          *string  `json:"osNEQ,omitempty"`
+	OsIn           []string `json:"osIn,omitempty"`
+	OsNotIn        []string `json:"osNotIn,omitempty"`
+	OsGT           *string  `json:"osGT,omitempty"`
+	OsGTE          *string  `json:"osGTE,omitempty"`
+	OsLT           *string  `json:"osLT,omitempty"`
+	OsLTE          *string  `json:"osLTE,omitempty"`
+	OsContains     *string  `json:"osContains,omitempty"`
+	OsHasPrefix    *string  `json:"osHasPrefix,omitempty"`
+	OsHasSuffix    *string  `json:"osHasSuffix,omitempty"`
+	OsIsNil        bool     `json:"osIsNil,omitempty"`
+	OsNotNil       bool     `json:"osNotNil,omitempty"`
+	OsEqualFold    *string  `json:"osEqualFold,omitempty"`
+	OsContainsFold *string  `json:"osContainsFold,omitempty"`
+
+	// "hostname" field predicates.
+	Hostname             *string  `json:"hostname,omitempty"`
+	HostnameNEQ          *string  `json:"hostnameNEQ,omitempty"`
+	HostnameIn           []string `json:"hostnameIn,omitempty"`
+	HostnameNotIn        []string `json:"hostnameNotIn,omitempty"`
+	HostnameGT           *string  `json:"hostnameGT,omitempty"`
+	HostnameGTE          *string  `json:"hostnameGTE,omitempty"`
+	HostnameLT           *string  `json:"hostnameLT,omitempty"`
+	HostnameLTE          *string  `json:"hostnameLTE,omitempty"`
+	HostnameContains     *string  `json:"hostnameContains,omitempty"`
+	HostnameHasPrefix    *string  `json:"hostnameHasPrefix,omitempty"`
+	HostnameHasSuffix    *string  `json:"hostnameHasSuffix,omitempty"`
+	HostnameIsNil        bool     `json:"hostnameIsNil,omitempty"`
+	HostnameNotNil       bool     `json:"hostnameNotNil,omitempty"`
+	HostnameEqualFold    *string  `json:"hostnameEqualFold,omitempty"`
+	HostnameContainsFold *string  `json:"hostnameContainsFold,omitempty"`
+
+	// "username" field predicates.
+	Username             *string  `json:"username,omitempty"`
+	UsernameNEQ          *string  `json:"usernameNEQ,omitempty"`
+	UsernameIn           []string `json:"usernameIn,omitempty"`
+	UsernameNotIn        []string `json:"usernameNotIn,omitempty"`
+	UsernameGT           *string  `json:"usernameGT,omitempty"`
+	UsernameGTE          *string  `json:"usernameGTE,omitempty"`
+	UsernameLT           *string  `json:"usernameLT,omitempty"`
+	UsernameLTE          *string  `json:"usernameLTE,omitempty"`
+	UsernameContains     *string  `json:"usernameContains,omitempty"`
+	UsernameHasPrefix    *string  `json:"usernameHasPrefix,omitempty"`
+	UsernameHasSuffix    *string  `json:"usernameHasSuffix,omitempty"`
+	UsernameIsNil        bool     `json:"usernameIsNil,omitempty"`
+	UsernameNotNil       bool     `json:"usernameNotNil,omitempty"`
+	UsernameEqualFold    *string  `json:"usernameEqualFold,omitempty"`
+	UsernameContainsFold *string  `json:"usernameContainsFold,omitempty"`
+
+	// "attestation" edge predicates.
+	HasAttestation     *bool                    `json:"hasAttestation,omitempty"`
+	HasAttestationWith []*AttestationWhereInput `json:"hasAttestationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *EnvironmentAttestationWhereInput) AddPredicates(predicates ...predicate.EnvironmentAttestation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the EnvironmentAttestationWhereInput filter on the EnvironmentAttestationQuery builder.
+func (i *EnvironmentAttestationWhereInput) Filter(q *EnvironmentAttestationQuery) (*EnvironmentAttestationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyEnvironmentAttestationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyEnvironmentAttestationWhereInput is returned in case the EnvironmentAttestationWhereInput is empty.
+var ErrEmptyEnvironmentAttestationWhereInput = errors.New("ent: empty predicate EnvironmentAttestationWhereInput")
+
+// P returns a predicate for filtering environmentattestations.
+// An error is returned if the input is empty or invalid.
+func (i *EnvironmentAttestationWhereInput) P() (predicate.EnvironmentAttestation, error) {
+	var predicates []predicate.EnvironmentAttestation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, environmentattestation.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.EnvironmentAttestation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, environmentattestation.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.EnvironmentAttestation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, environmentattestation.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, environmentattestation.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, environmentattestation.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, environmentattestation.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, environmentattestation.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, environmentattestation.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, environmentattestation.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, environmentattestation.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, environmentattestation.IDLTE(*i.IDLTE))
+	}
+	if i.Os != nil {
+		predicates = append(predicates, environmentattestation.OsEQ(*i.Os))
+	}
+	if i.OsNEQ != nil {
+		predicates = append(predicates, environmentattestation.OsNEQ(*i.OsNEQ))
+	}
+	if len(i.OsIn) > 0 {
+		predicates = append(predicates, environmentattestation.OsIn(i.OsIn...))
+	}
+	if len(i.OsNotIn) > 0 {
+		predicates = append(predicates, environmentattestation.OsNotIn(i.OsNotIn...))
+	}
+	if i.OsGT != nil {
+		predicates = append(predicates, environmentattestation.OsGT(*i.OsGT))
+	}
+	if i.OsGTE != nil {
+		predicates = append(predicates, environmentattestation.OsGTE(*i.OsGTE))
+	}
+	if i.OsLT != nil {
+		predicates = append(predicates, environmentattestation.OsLT(*i.OsLT))
+	}
+	if i.OsLTE != nil {
+		predicates = append(predicates, environmentattestation.OsLTE(*i.OsLTE))
+	}
+	if i.OsContains != nil {
+		predicates = append(predicates, environmentattestation.OsContains(*i.OsContains))
+	}
+	if i.OsHasPrefix != nil {
+		predicates = append(predicates, environmentattestation.OsHasPrefix(*i.OsHasPrefix))
+	}
+	if i.OsHasSuffix != nil {
+		predicates = append(predicates, environmentattestation.OsHasSuffix(*i.OsHasSuffix))
+	}
+	if i.OsIsNil {
+		predicates = append(predicates, environmentattestation.OsIsNil())
+	}
+	if i.OsNotNil {
+		predicates = append(predicates, environmentattestation.OsNotNil())
+	}
+	if i.OsEqualFold != nil {
+		predicates = append(predicates, environmentattestation.OsEqualFold(*i.OsEqualFold))
+	}
+	if i.OsContainsFold != nil {
+		predicates = append(predicates, environmentattestation.OsContainsFold(*i.OsContainsFold))
+	}
+	if i.Hostname != nil {
+		predicates = append(predicates, environmentattestation.HostnameEQ(*i.Hostname))
+	}
+	if i.HostnameNEQ != nil {
+		predicates = append(predicates, environmentattestation.HostnameNEQ(*i.HostnameNEQ))
+	}
+	if len(i.HostnameIn) > 0 {
+		predicates = append(predicates, environmentattestation.HostnameIn(i.HostnameIn...))
+	}
+	if len(i.HostnameNotIn) > 0 {
+		predicates = append(predicates, environmentattestation.HostnameNotIn(i.HostnameNotIn...))
+	}
+	if i.HostnameGT != nil {
+		predicates = append(predicates, environmentattestation.HostnameGT(*i.HostnameGT))
+	}
+	if i.HostnameGTE != nil {
+		predicates = append(predicates, environmentattestation.HostnameGTE(*i.HostnameGTE))
+	}
+	if i.HostnameLT != nil {
+		predicates = append(predicates, environmentattestation.HostnameLT(*i.HostnameLT))
+	}
+	if i.HostnameLTE != nil {
+		predicates = append(predicates, environmentattestation.HostnameLTE(*i.HostnameLTE))
+	}
+	if i.HostnameContains != nil {
+		predicates = append(predicates, environmentattestation.HostnameContains(*i.HostnameContains))
+	}
+	if i.HostnameHasPrefix != nil {
+		predicates = append(predicates, environmentattestation.HostnameHasPrefix(*i.HostnameHasPrefix))
+	}
+	if i.HostnameHasSuffix != nil {
+		predicates = append(predicates, environmentattestation.HostnameHasSuffix(*i.HostnameHasSuffix))
+	}
+	if i.HostnameIsNil {
+		predicates = append(predicates, environmentattestation.HostnameIsNil())
+	}
+	if i.HostnameNotNil {
+		predicates = append(predicates, environmentattestation.HostnameNotNil())
+	}
+	if i.HostnameEqualFold != nil {
+		predicates = append(predicates, environmentattestation.HostnameEqualFold(*i.HostnameEqualFold))
+	}
+	if i.HostnameContainsFold != nil {
+		predicates = append(predicates, environmentattestation.HostnameContainsFold(*i.HostnameContainsFold))
+	}
+	if i.Username != nil {
+		predicates = append(predicates, environmentattestation.UsernameEQ(*i.Username))
+	}
+	if i.UsernameNEQ != nil {
+		predicates = append(predicates, environmentattestation.UsernameNEQ(*i.UsernameNEQ))
+	}
+	if len(i.UsernameIn) > 0 {
+		predicates = append(predicates, environmentattestation.UsernameIn(i.UsernameIn...))
+	}
+	if len(i.UsernameNotIn) > 0 {
+		predicates = append(predicates, environmentattestation.UsernameNotIn(i.UsernameNotIn...))
+	}
+	if i.UsernameGT != nil {
+		predicates = append(predicates, environmentattestation.UsernameGT(*i.UsernameGT))
+	}
+	if i.UsernameGTE != nil {
+		predicates = append(predicates, environmentattestation.UsernameGTE(*i.UsernameGTE))
+	}
+	if i.UsernameLT != nil {
+		predicates = append(predicates, environmentattestation.UsernameLT(*i.UsernameLT))
+	}
+	if i.UsernameLTE != nil {
+		predicates = append(predicates, environmentattestation.UsernameLTE(*i.UsernameLTE))
+	}
+	if i.UsernameContains != nil {
+		predicates = append(predicates, environmentattestation.UsernameContains(*i.UsernameContains))
+	}
+	if i.UsernameHasPrefix != nil {
+		predicates = append(predicates, environmentattestation.UsernameHasPrefix(*i.UsernameHasPrefix))
+	}
+	if i.UsernameHasSuffix != nil {
+		predicates = append(predicates, environmentattestation.UsernameHasSuffix(*i.UsernameHasSuffix))
+	}
+	if i.UsernameIsNil {
+		predicates = append(predicates, environmentattestation.UsernameIsNil())
+	}
+	if i.UsernameNotNil {
+		predicates = append(predicates, environmentattestation.UsernameNotNil())
+	}
+	if i.UsernameEqualFold != nil {
+		predicates = append(predicates, environmentattestation.UsernameEqualFold(*i.UsernameEqualFold))
+	}
+	if i.UsernameContainsFold != nil {
+		predicates = append(predicates, environmentattestation.UsernameContainsFold(*i.UsernameContainsFold))
+	}
+
+	if i.HasAttestation != nil {
+		p := environmentattestation.HasAttestation()
+		if !*i.HasAttestation {
+			p = environmentattestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAttestationWith) > 0 {
+		with := make([]predicate.Attestation, 0, len(i.HasAttestationWith))
+		for _, w := range i.HasAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, environmentattestation.HasAttestationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyEnvironmentAttestationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return environmentattestation.And(predicates...), nil
+	}
+}
+
+// GitAttestationWhereInput represents a where input for filtering GitAttestation queries.
+type GitAttestationWhereInput struct {
+	Predicates []predicate.GitAttestation  `json:"-"`
+	Not        *GitAttestationWhereInput   `json:"not,omitempty"`
+	Or         []*GitAttestationWhereInput `json:"or,omitempty"`
+	And        []*GitAttestationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "commit_hash" field predicates.
+	CommitHash             *string  `json:"commitHash,omitempty"`
+	CommitHashNEQ          *string  `json:"commitHashNEQ,omitempty"`
+	CommitHashIn           []string `json:"commitHashIn,omitempty"`
+	CommitHashNotIn        []string `json:"commitHashNotIn,omitempty"`
+	CommitHashGT           *string  `json:"commitHashGT,omitempty"`
+	CommitHashGTE          *string  `json:"commitHashGTE,omitempty"`
+	CommitHashLT           *string  `json:"commitHashLT,omitempty"`
+	CommitHashLTE          *string  `json:"commitHashLTE,omitempty"`
+	CommitHashContains     *string  `json:"commitHashContains,omitempty"`
+	CommitHashHasPrefix    *string  `json:"commitHashHasPrefix,omitempty"`
+	CommitHashHasSuffix    *string  `json:"commitHashHasSuffix,omitempty"`
+	CommitHashEqualFold    *string  `json:"commitHashEqualFold,omitempty"`
+	CommitHashContainsFold *string  `json:"commitHashContainsFold,omitempty"`
+
+	// "branch" field predicates.
+	Branch             *string  `json:"branch,omitempty"`
+	BranchNEQ          *string  `json:"branchNEQ,omitempty"`
+	BranchIn           []string `json:"branchIn,omitempty"`
+	BranchNotIn        []string `json:"branchNotIn,omitempty"`
+	BranchGT           *string  `json:"branchGT,omitempty"`
+	BranchGTE          *string  `json:"branchGTE,omitempty"`
+	BranchLT           *string  `json:"branchLT,omitempty"`
+	BranchLTE          *string  `json:"branchLTE,omitempty"`
+	BranchContains     *string  `json:"branchContains,omitempty"`
+	BranchHasPrefix    *string  `json:"branchHasPrefix,omitempty"`
+	BranchHasSuffix    *string  `json:"branchHasSuffix,omitempty"`
+	BranchIsNil        bool     `json:"branchIsNil,omitempty"`
+	BranchNotNil       bool     `json:"branchNotNil,omitempty"`
+	BranchEqualFold    *string  `json:"branchEqualFold,omitempty"`
+	BranchContainsFold *string  `json:"branchContainsFold,omitempty"`
+
+	// "tree_hash" field predicates.
+	TreeHash             *string  `json:"treeHash,omitempty"`
+	TreeHashNEQ          *string  `json:"treeHashNEQ,omitempty"`
+	TreeHashIn           []string `json:"treeHashIn,omitempty"`
+	TreeHashNotIn        []string `json:"treeHashNotIn,omitempty"`
+	TreeHashGT           *string  `json:"treeHashGT,omitempty"`
+	TreeHashGTE          *string  `json:"treeHashGTE,omitempty"`
+	TreeHashLT           *string  `json:"treeHashLT,omitempty"`
+	TreeHashLTE          *string  `json:"treeHashLTE,omitempty"`
+	TreeHashContains     *string  `json:"treeHashContains,omitempty"`
+	TreeHashHasPrefix    *string  `json:"treeHashHasPrefix,omitempty"`
+	TreeHashHasSuffix    *string  `json:"treeHashHasSuffix,omitempty"`
+	TreeHashIsNil        bool     `json:"treeHashIsNil,omitempty"`
+	TreeHashNotNil       bool     `json:"treeHashNotNil,omitempty"`
+	TreeHashEqualFold    *string  `json:"treeHashEqualFold,omitempty"`
+	TreeHashContainsFold *string  `json:"treeHashContainsFold,omitempty"`
+
+	// "author" field predicates.
+	Author             *string  `json:"author,omitempty"`
+	AuthorNEQ          *string  `json:"authorNEQ,omitempty"`
+	AuthorIn           []string `json:"authorIn,omitempty"`
+	AuthorNotIn        []string `json:"authorNotIn,omitempty"`
+	AuthorGT           *string  `json:"authorGT,omitempty"`
+	AuthorGTE          *string  `json:"authorGTE,omitempty"`
+	AuthorLT           *string  `json:"authorLT,omitempty"`
+	AuthorLTE          *string  `json:"authorLTE,omitempty"`
+	AuthorContains     *string  `json:"authorContains,omitempty"`
+	AuthorHasPrefix    *string  `json:"authorHasPrefix,omitempty"`
+	AuthorHasSuffix    *string  `json:"authorHasSuffix,omitempty"`
+	AuthorIsNil        bool     `json:"authorIsNil,omitempty"`
+	AuthorNotNil       bool     `json:"authorNotNil,omitempty"`
+	AuthorEqualFold    *string  `json:"authorEqualFold,omitempty"`
+	AuthorContainsFold *string  `json:"authorContainsFold,omitempty"`
+
+	// "author_email" field predicates.
+	AuthorEmail             *string  `json:"authorEmail,omitempty"`
+	AuthorEmailNEQ          *string  `json:"authorEmailNEQ,omitempty"`
+	AuthorEmailIn           []string `json:"authorEmailIn,omitempty"`
+	AuthorEmailNotIn        []string `json:"authorEmailNotIn,omitempty"`
+	AuthorEmailGT           *string  `json:"authorEmailGT,omitempty"`
+	AuthorEmailGTE          *string  `json:"authorEmailGTE,omitempty"`
+	AuthorEmailLT           *string  `json:"authorEmailLT,omitempty"`
+	AuthorEmailLTE          *string  `json:"authorEmailLTE,omitempty"`
+	AuthorEmailContains     *string  `json:"authorEmailContains,omitempty"`
+	AuthorEmailHasPrefix    *string  `json:"authorEmailHasPrefix,omitempty"`
+	AuthorEmailHasSuffix    *string  `json:"authorEmailHasSuffix,omitempty"`
+	AuthorEmailIsNil        bool     `json:"authorEmailIsNil,omitempty"`
+	AuthorEmailNotNil       bool     `json:"authorEmailNotNil,omitempty"`
+	AuthorEmailEqualFold    *string  `json:"authorEmailEqualFold,omitempty"`
+	AuthorEmailContainsFold *string  `json:"authorEmailContainsFold,omitempty"`
+
+	// "committer_name" field predicates.
+	CommitterName             *string  `json:"committerName,omitempty"`
+	CommitterNameNEQ          *string  `json:"committerNameNEQ,omitempty"`
+	CommitterNameIn           []string `json:"committerNameIn,omitempty"`
+	CommitterNameNotIn        []string `json:"committerNameNotIn,omitempty"`
+	CommitterNameGT           *string  `json:"committerNameGT,omitempty"`
+	CommitterNameGTE          *string  `json:"committerNameGTE,omitempty"`
+	CommitterNameLT           *string  `json:"committerNameLT,omitempty"`
+	CommitterNameLTE          *string  `json:"committerNameLTE,omitempty"`
+	CommitterNameContains     *string  `json:"committerNameContains,omitempty"`
+	CommitterNameHasPrefix    *string  `json:"committerNameHasPrefix,omitempty"`
+	CommitterNameHasSuffix    *string  `json:"committerNameHasSuffix,omitempty"`
+	CommitterNameIsNil        bool     `json:"committerNameIsNil,omitempty"`
+	CommitterNameNotNil       bool     `json:"committerNameNotNil,omitempty"`
+	CommitterNameEqualFold    *string  `json:"committerNameEqualFold,omitempty"`
+	CommitterNameContainsFold *string  `json:"committerNameContainsFold,omitempty"`
+
+	// "committer_email" field predicates.
+	CommitterEmail             *string  `json:"committerEmail,omitempty"`
+	CommitterEmailNEQ          *string  `json:"committerEmailNEQ,omitempty"`
+	CommitterEmailIn           []string `json:"committerEmailIn,omitempty"`
+	CommitterEmailNotIn        []string `json:"committerEmailNotIn,omitempty"`
+	CommitterEmailGT           *string  `json:"committerEmailGT,omitempty"`
+	CommitterEmailGTE          *string  `json:"committerEmailGTE,omitempty"`
+	CommitterEmailLT           *string  `json:"committerEmailLT,omitempty"`
+	CommitterEmailLTE          *string  `json:"committerEmailLTE,omitempty"`
+	CommitterEmailContains     *string  `json:"committerEmailContains,omitempty"`
+	CommitterEmailHasPrefix    *string  `json:"committerEmailHasPrefix,omitempty"`
+	CommitterEmailHasSuffix    *string  `json:"committerEmailHasSuffix,omitempty"`
+	CommitterEmailIsNil        bool     `json:"committerEmailIsNil,omitempty"`
+	CommitterEmailNotNil       bool     `json:"committerEmailNotNil,omitempty"`
+	CommitterEmailEqualFold    *string  `json:"committerEmailEqualFold,omitempty"`
+	CommitterEmailContainsFold *string  `json:"committerEmailContainsFold,omitempty"`
+
+	// "commit_date" field predicates.
+	CommitDate             *string  `json:"commitDate,omitempty"`
+	CommitDateNEQ          *string  `json:"commitDateNEQ,omitempty"`
+	CommitDateIn           []string `json:"commitDateIn,omitempty"`
+	CommitDateNotIn        []string `json:"commitDateNotIn,omitempty"`
+	CommitDateGT           *string  `json:"commitDateGT,omitempty"`
+	CommitDateGTE          *string  `json:"commitDateGTE,omitempty"`
+	CommitDateLT           *string  `json:"commitDateLT,omitempty"`
+	CommitDateLTE          *string  `json:"commitDateLTE,omitempty"`
+	CommitDateContains     *string  `json:"commitDateContains,omitempty"`
+	CommitDateHasPrefix    *string  `json:"commitDateHasPrefix,omitempty"`
+	CommitDateHasSuffix    *string  `json:"commitDateHasSuffix,omitempty"`
+	CommitDateIsNil        bool     `json:"commitDateIsNil,omitempty"`
+	CommitDateNotNil       bool     `json:"commitDateNotNil,omitempty"`
+	CommitDateEqualFold    *string  `json:"commitDateEqualFold,omitempty"`
+	CommitDateContainsFold *string  `json:"commitDateContainsFold,omitempty"`
+
+	// "commit_message" field predicates.
+	CommitMessage             *string  `json:"commitMessage,omitempty"`
+	CommitMessageNEQ          *string  `json:"commitMessageNEQ,omitempty"`
+	CommitMessageIn           []string `json:"commitMessageIn,omitempty"`
+	CommitMessageNotIn        []string `json:"commitMessageNotIn,omitempty"`
+	CommitMessageGT           *string  `json:"commitMessageGT,omitempty"`
+	CommitMessageGTE          *string  `json:"commitMessageGTE,omitempty"`
+	CommitMessageLT           *string  `json:"commitMessageLT,omitempty"`
+	CommitMessageLTE          *string  `json:"commitMessageLTE,omitempty"`
+	CommitMessageContains     *string  `json:"commitMessageContains,omitempty"`
+	CommitMessageHasPrefix    *string  `json:"commitMessageHasPrefix,omitempty"`
+	CommitMessageHasSuffix    *string  `json:"commitMessageHasSuffix,omitempty"`
+	CommitMessageIsNil        bool     `json:"commitMessageIsNil,omitempty"`
+	CommitMessageNotNil       bool     `json:"commitMessageNotNil,omitempty"`
+	CommitMessageEqualFold    *string  `json:"commitMessageEqualFold,omitempty"`
+	CommitMessageContainsFold *string  `json:"commitMessageContainsFold,omitempty"`
+
+	// "attestation" edge predicates.
+	HasAttestation     *bool                    `json:"hasAttestation,omitempty"`
+	HasAttestationWith []*AttestationWhereInput `json:"hasAttestationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *GitAttestationWhereInput) AddPredicates(predicates ...predicate.GitAttestation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the GitAttestationWhereInput filter on the GitAttestationQuery builder.
+func (i *GitAttestationWhereInput) Filter(q *GitAttestationQuery) (*GitAttestationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyGitAttestationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyGitAttestationWhereInput is returned in case the GitAttestationWhereInput is empty.
+var ErrEmptyGitAttestationWhereInput = errors.New("ent: empty predicate GitAttestationWhereInput")
+
+// P returns a predicate for filtering gitattestations.
+// An error is returned if the input is empty or invalid.
+func (i *GitAttestationWhereInput) P() (predicate.GitAttestation, error) {
+	var predicates []predicate.GitAttestation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, gitattestation.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.GitAttestation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, gitattestation.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.GitAttestation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, gitattestation.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, gitattestation.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, gitattestation.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, gitattestation.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, gitattestation.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, gitattestation.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, gitattestation.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, gitattestation.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, gitattestation.IDLTE(*i.IDLTE))
+	}
+	if i.CommitHash != nil {
+		predicates = append(predicates, gitattestation.CommitHashEQ(*i.CommitHash))
+	}
+	if i.CommitHashNEQ != nil {
+		predicates = append(predicates, gitattestation.CommitHashNEQ(*i.CommitHashNEQ))
+	}
+	if len(i.CommitHashIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitHashIn(i.CommitHashIn...))
+	}
+	if len(i.CommitHashNotIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitHashNotIn(i.CommitHashNotIn...))
+	}
+	if i.CommitHashGT != nil {
+		predicates = append(predicates, gitattestation.CommitHashGT(*i.CommitHashGT))
+	}
+	if i.CommitHashGTE != nil {
+		predicates = append(predicates, gitattestation.CommitHashGTE(*i.CommitHashGTE))
+	}
+	if i.CommitHashLT != nil {
+		predicates = append(predicates, gitattestation.CommitHashLT(*i.CommitHashLT))
+	}
+	if i.CommitHashLTE != nil {
+		predicates = append(predicates, gitattestation.CommitHashLTE(*i.CommitHashLTE))
+	}
+	if i.CommitHashContains != nil {
+		predicates = append(predicates, gitattestation.CommitHashContains(*i.CommitHashContains))
+	}
+	if i.CommitHashHasPrefix != nil {
+		predicates = append(predicates, gitattestation.CommitHashHasPrefix(*i.CommitHashHasPrefix))
+	}
+	if i.CommitHashHasSuffix != nil {
+		predicates = append(predicates, gitattestation.CommitHashHasSuffix(*i.CommitHashHasSuffix))
+	}
+	if i.CommitHashEqualFold != nil {
+		predicates = append(predicates, gitattestation.CommitHashEqualFold(*i.CommitHashEqualFold))
+	}
+	if i.CommitHashContainsFold != nil {
+		predicates = append(predicates, gitattestation.CommitHashContainsFold(*i.CommitHashContainsFold))
+	}
+	if i.Branch != nil {
+		predicates = append(predicates, gitattestation.BranchEQ(*i.Branch))
+	}
+	if i.BranchNEQ != nil {
+		predicates = append(predicates, gitattestation.BranchNEQ(*i.BranchNEQ))
+	}
+	if len(i.BranchIn) > 0 {
+		predicates = append(predicates, gitattestation.BranchIn(i.BranchIn...))
+	}
+	if len(i.BranchNotIn) > 0 {
+		predicates = append(predicates, gitattestation.BranchNotIn(i.BranchNotIn...))
+	}
+	if i.BranchGT != nil {
+		predicates = append(predicates, gitattestation.BranchGT(*i.BranchGT))
+	}
+	if i.BranchGTE != nil {
+		predicates = append(predicates, gitattestation.BranchGTE(*i.BranchGTE))
+	}
+	if i.BranchLT != nil {
+		predicates = append(predicates, gitattestation.BranchLT(*i.BranchLT))
+	}
+	if i.BranchLTE != nil {
+		predicates = append(predicates, gitattestation.BranchLTE(*i.BranchLTE))
+	}
+	if i.BranchContains != nil {
+		predicates = append(predicates, gitattestation.BranchContains(*i.BranchContains))
+	}
+	if i.BranchHasPrefix != nil {
+		predicates = append(predicates, gitattestation.BranchHasPrefix(*i.BranchHasPrefix))
+	}
+	if i.BranchHasSuffix != nil {
+		predicates = append(predicates, gitattestation.BranchHasSuffix(*i.BranchHasSuffix))
+	}
+	if i.BranchIsNil {
+		predicates = append(predicates, gitattestation.BranchIsNil())
+	}
+	if i.BranchNotNil {
+		predicates = append(predicates, gitattestation.BranchNotNil())
+	}
+	if i.BranchEqualFold != nil {
+		predicates = append(predicates, gitattestation.BranchEqualFold(*i.BranchEqualFold))
+	}
+	if i.BranchContainsFold != nil {
+		predicates = append(predicates, gitattestation.BranchContainsFold(*i.BranchContainsFold))
+	}
+	if i.TreeHash != nil {
+		predicates = append(predicates, gitattestation.TreeHashEQ(*i.TreeHash))
+	}
+	if i.TreeHashNEQ != nil {
+		predicates = append(predicates, gitattestation.TreeHashNEQ(*i.TreeHashNEQ))
+	}
+	if len(i.TreeHashIn) > 0 {
+		predicates = append(predicates, gitattestation.TreeHashIn(i.TreeHashIn...))
+	}
+	if len(i.TreeHashNotIn) > 0 {
+		predicates = append(predicates, gitattestation.TreeHashNotIn(i.TreeHashNotIn...))
+	}
+	if i.TreeHashGT != nil {
+		predicates = append(predicates, gitattestation.TreeHashGT(*i.TreeHashGT))
+	}
+	if i.TreeHashGTE != nil {
+		predicates = append(predicates, gitattestation.TreeHashGTE(*i.TreeHashGTE))
+	}
+	if i.TreeHashLT != nil {
+		predicates = append(predicates, gitattestation.TreeHashLT(*i.TreeHashLT))
+	}
+	if i.TreeHashLTE != nil {
+		predicates = append(predicates, gitattestation.TreeHashLTE(*i.TreeHashLTE))
+	}
+	if i.TreeHashContains != nil {
+		predicates = append(predicates, gitattestation.TreeHashContains(*i.TreeHashContains))
+	}
+	if i.TreeHashHasPrefix != nil {
+		predicates = append(predicates, gitattestation.TreeHashHasPrefix(*i.TreeHashHasPrefix))
+	}
+	if i.TreeHashHasSuffix != nil {
+		predicates = append(predicates, gitattestation.TreeHashHasSuffix(*i.TreeHashHasSuffix))
+	}
+	if i.TreeHashIsNil {
+		predicates = append(predicates, gitattestation.TreeHashIsNil())
+	}
+	if i.TreeHashNotNil {
+		predicates = append(predicates, gitattestation.TreeHashNotNil())
+	}
+	if i.TreeHashEqualFold != nil {
+		predicates = append(predicates, gitattestation.TreeHashEqualFold(*i.TreeHashEqualFold))
+	}
+	if i.TreeHashContainsFold != nil {
+		predicates = append(predicates, gitattestation.TreeHashContainsFold(*i.TreeHashContainsFold))
+	}
+	if i.Author != nil {
+		predicates = append(predicates, gitattestation.AuthorEQ(*i.Author))
+	}
+	if i.AuthorNEQ != nil {
+		predicates = append(predicates, gitattestation.AuthorNEQ(*i.AuthorNEQ))
+	}
+	if len(i.AuthorIn) > 0 {
+		predicates = append(predicates, gitattestation.AuthorIn(i.AuthorIn...))
+	}
+	if len(i.AuthorNotIn) > 0 {
+		predicates = append(predicates, gitattestation.AuthorNotIn(i.AuthorNotIn...))
+	}
+	if i.AuthorGT != nil {
+		predicates = append(predicates, gitattestation.AuthorGT(*i.AuthorGT))
+	}
+	if i.AuthorGTE != nil {
+		predicates = append(predicates, gitattestation.AuthorGTE(*i.AuthorGTE))
+	}
+	if i.AuthorLT != nil {
+		predicates = append(predicates, gitattestation.AuthorLT(*i.AuthorLT))
+	}
+	if i.AuthorLTE != nil {
+		predicates = append(predicates, gitattestation.AuthorLTE(*i.AuthorLTE))
+	}
+	if i.AuthorContains != nil {
+		predicates = append(predicates, gitattestation.AuthorContains(*i.AuthorContains))
+	}
+	if i.AuthorHasPrefix != nil {
+		predicates = append(predicates, gitattestation.AuthorHasPrefix(*i.AuthorHasPrefix))
+	}
+	if i.AuthorHasSuffix != nil {
+		predicates = append(predicates, gitattestation.AuthorHasSuffix(*i.AuthorHasSuffix))
+	}
+	if i.AuthorIsNil {
+		predicates = append(predicates, gitattestation.AuthorIsNil())
+	}
+	if i.AuthorNotNil {
+		predicates = append(predicates, gitattestation.AuthorNotNil())
+	}
+	if i.AuthorEqualFold != nil {
+		predicates = append(predicates, gitattestation.AuthorEqualFold(*i.AuthorEqualFold))
+	}
+	if i.AuthorContainsFold != nil {
+		predicates = append(predicates, gitattestation.AuthorContainsFold(*i.AuthorContainsFold))
+	}
+	if i.AuthorEmail != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailEQ(*i.AuthorEmail))
+	}
+	if i.AuthorEmailNEQ != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailNEQ(*i.AuthorEmailNEQ))
+	}
+	if len(i.AuthorEmailIn) > 0 {
+		predicates = append(predicates, gitattestation.AuthorEmailIn(i.AuthorEmailIn...))
+	}
+	if len(i.AuthorEmailNotIn) > 0 {
+		predicates = append(predicates, gitattestation.AuthorEmailNotIn(i.AuthorEmailNotIn...))
+	}
+	if i.AuthorEmailGT != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailGT(*i.AuthorEmailGT))
+	}
+	if i.AuthorEmailGTE != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailGTE(*i.AuthorEmailGTE))
+	}
+	if i.AuthorEmailLT != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailLT(*i.AuthorEmailLT))
+	}
+	if i.AuthorEmailLTE != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailLTE(*i.AuthorEmailLTE))
+	}
+	if i.AuthorEmailContains != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailContains(*i.AuthorEmailContains))
+	}
+	if i.AuthorEmailHasPrefix != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailHasPrefix(*i.AuthorEmailHasPrefix))
+	}
+	if i.AuthorEmailHasSuffix != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailHasSuffix(*i.AuthorEmailHasSuffix))
+	}
+	if i.AuthorEmailIsNil {
+		predicates = append(predicates, gitattestation.AuthorEmailIsNil())
+	}
+	if i.AuthorEmailNotNil {
+		predicates = append(predicates, gitattestation.AuthorEmailNotNil())
+	}
+	if i.AuthorEmailEqualFold != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailEqualFold(*i.AuthorEmailEqualFold))
+	}
+	if i.AuthorEmailContainsFold != nil {
+		predicates = append(predicates, gitattestation.AuthorEmailContainsFold(*i.AuthorEmailContainsFold))
+	}
+	if i.CommitterName != nil {
+		predicates = append(predicates, gitattestation.CommitterNameEQ(*i.CommitterName))
+	}
+	if i.CommitterNameNEQ != nil {
+		predicates = append(predicates, gitattestation.CommitterNameNEQ(*i.CommitterNameNEQ))
+	}
+	if len(i.CommitterNameIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitterNameIn(i.CommitterNameIn...))
+	}
+	if len(i.CommitterNameNotIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitterNameNotIn(i.CommitterNameNotIn...))
+	}
+	if i.CommitterNameGT != nil {
+		predicates = append(predicates, gitattestation.CommitterNameGT(*i.CommitterNameGT))
+	}
+	if i.CommitterNameGTE != nil {
+		predicates = append(predicates, gitattestation.CommitterNameGTE(*i.CommitterNameGTE))
+	}
+	if i.CommitterNameLT != nil {
+		predicates = append(predicates, gitattestation.CommitterNameLT(*i.CommitterNameLT))
+	}
+	if i.CommitterNameLTE != nil {
+		predicates = append(predicates, gitattestation.CommitterNameLTE(*i.CommitterNameLTE))
+	}
+	if i.CommitterNameContains != nil {
+		predicates = append(predicates, gitattestation.CommitterNameContains(*i.CommitterNameContains))
+	}
+	if i.CommitterNameHasPrefix != nil {
+		predicates = append(predicates, gitattestation.CommitterNameHasPrefix(*i.CommitterNameHasPrefix))
+	}
+	if i.CommitterNameHasSuffix != nil {
+		predicates = append(predicates, gitattestation.CommitterNameHasSuffix(*i.CommitterNameHasSuffix))
+	}
+	if i.CommitterNameIsNil {
+		predicates = append(predicates, gitattestation.CommitterNameIsNil())
+	}
+	if i.CommitterNameNotNil {
+		predicates = append(predicates, gitattestation.CommitterNameNotNil())
+	}
+	if i.CommitterNameEqualFold != nil {
+		predicates = append(predicates, gitattestation.CommitterNameEqualFold(*i.CommitterNameEqualFold))
+	}
+	if i.CommitterNameContainsFold != nil {
+		predicates = append(predicates, gitattestation.CommitterNameContainsFold(*i.CommitterNameContainsFold))
+	}
+	if i.CommitterEmail != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailEQ(*i.CommitterEmail))
+	}
+	if i.CommitterEmailNEQ != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailNEQ(*i.CommitterEmailNEQ))
+	}
+	if len(i.CommitterEmailIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitterEmailIn(i.CommitterEmailIn...))
+	}
+	if len(i.CommitterEmailNotIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitterEmailNotIn(i.CommitterEmailNotIn...))
+	}
+	if i.CommitterEmailGT != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailGT(*i.CommitterEmailGT))
+	}
+	if i.CommitterEmailGTE != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailGTE(*i.CommitterEmailGTE))
+	}
+	if i.CommitterEmailLT != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailLT(*i.CommitterEmailLT))
+	}
+	if i.CommitterEmailLTE != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailLTE(*i.CommitterEmailLTE))
+	}
+	if i.CommitterEmailContains != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailContains(*i.CommitterEmailContains))
+	}
+	if i.CommitterEmailHasPrefix != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailHasPrefix(*i.CommitterEmailHasPrefix))
+	}
+	if i.CommitterEmailHasSuffix != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailHasSuffix(*i.CommitterEmailHasSuffix))
+	}
+	if i.CommitterEmailIsNil {
+		predicates = append(predicates, gitattestation.CommitterEmailIsNil())
+	}
+	if i.CommitterEmailNotNil {
+		predicates = append(predicates, gitattestation.CommitterEmailNotNil())
+	}
+	if i.CommitterEmailEqualFold != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailEqualFold(*i.CommitterEmailEqualFold))
+	}
+	if i.CommitterEmailContainsFold != nil {
+		predicates = append(predicates, gitattestation.CommitterEmailContainsFold(*i.CommitterEmailContainsFold))
+	}
+	if i.CommitDate != nil {
+		predicates = append(predicates, gitattestation.CommitDateEQ(*i.CommitDate))
+	}
+	if i.CommitDateNEQ != nil {
+		predicates = append(predicates, gitattestation.CommitDateNEQ(*i.CommitDateNEQ))
+	}
+	if len(i.CommitDateIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitDateIn(i.CommitDateIn...))
+	}
+	if len(i.CommitDateNotIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitDateNotIn(i.CommitDateNotIn...))
+	}
+	if i.CommitDateGT != nil {
+		predicates = append(predicates, gitattestation.CommitDateGT(*i.CommitDateGT))
+	}
+	if i.CommitDateGTE != nil {
+		predicates = append(predicates, gitattestation.CommitDateGTE(*i.CommitDateGTE))
+	}
+	if i.CommitDateLT != nil {
+		predicates = append(predicates, gitattestation.CommitDateLT(*i.CommitDateLT))
+	}
+	if i.CommitDateLTE != nil {
+		predicates = append(predicates, gitattestation.CommitDateLTE(*i.CommitDateLTE))
+	}
+	if i.CommitDateContains != nil {
+		predicates = append(predicates, gitattestation.CommitDateContains(*i.CommitDateContains))
+	}
+	if i.CommitDateHasPrefix != nil {
+		predicates = append(predicates, gitattestation.CommitDateHasPrefix(*i.CommitDateHasPrefix))
+	}
+	if i.CommitDateHasSuffix != nil {
+		predicates = append(predicates, gitattestation.CommitDateHasSuffix(*i.CommitDateHasSuffix))
+	}
+	if i.CommitDateIsNil {
+		predicates = append(predicates, gitattestation.CommitDateIsNil())
+	}
+	if i.CommitDateNotNil {
+		predicates = append(predicates, gitattestation.CommitDateNotNil())
+	}
+	if i.CommitDateEqualFold != nil {
+		predicates = append(predicates, gitattestation.CommitDateEqualFold(*i.CommitDateEqualFold))
+	}
+	if i.CommitDateContainsFold != nil {
+		predicates = append(predicates, gitattestation.CommitDateContainsFold(*i.CommitDateContainsFold))
+	}
+	if i.CommitMessage != nil {
+		predicates = append(predicates, gitattestation.CommitMessageEQ(*i.CommitMessage))
+	}
+	if i.CommitMessageNEQ != nil {
+		predicates = append(predicates, gitattestation.CommitMessageNEQ(*i.CommitMessageNEQ))
+	}
+	if len(i.CommitMessageIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitMessageIn(i.CommitMessageIn...))
+	}
+	if len(i.CommitMessageNotIn) > 0 {
+		predicates = append(predicates, gitattestation.CommitMessageNotIn(i.CommitMessageNotIn...))
+	}
+	if i.CommitMessageGT != nil {
+		predicates = append(predicates, gitattestation.CommitMessageGT(*i.CommitMessageGT))
+	}
+	if i.CommitMessageGTE != nil {
+		predicates = append(predicates, gitattestation.CommitMessageGTE(*i.CommitMessageGTE))
+	}
+	if i.CommitMessageLT != nil {
+		predicates = append(predicates, gitattestation.CommitMessageLT(*i.CommitMessageLT))
+	}
+	if i.CommitMessageLTE != nil {
+		predicates = append(predicates, gitattestation.CommitMessageLTE(*i.CommitMessageLTE))
+	}
+	if i.CommitMessageContains != nil {
+		predicates = append(predicates, gitattestation.CommitMessageContains(*i.CommitMessageContains))
+	}
+	if i.CommitMessageHasPrefix != nil {
+		predicates = append(predicates, gitattestation.CommitMessageHasPrefix(*i.CommitMessageHasPrefix))
+	}
+	if i.CommitMessageHasSuffix != nil {
+		predicates = append(predicates, gitattestation.CommitMessageHasSuffix(*i.CommitMessageHasSuffix))
+	}
+	if i.CommitMessageIsNil {
+		predicates = append(predicates, gitattestation.CommitMessageIsNil())
+	}
+	if i.CommitMessageNotNil {
+		predicates = append(predicates, gitattestation.CommitMessageNotNil())
+	}
+	if i.CommitMessageEqualFold != nil {
+		predicates = append(predicates, gitattestation.CommitMessageEqualFold(*i.CommitMessageEqualFold))
+	}
+	if i.CommitMessageContainsFold != nil {
+		predicates = append(predicates, gitattestation.CommitMessageContainsFold(*i.CommitMessageContainsFold))
+	}
+
+	if i.HasAttestation != nil {
+		p := gitattestation.HasAttestation()
+		if !*i.HasAttestation {
+			p = gitattestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAttestationWith) > 0 {
+		with := make([]predicate.Attestation, 0, len(i.HasAttestationWith))
+		for _, w := range i.HasAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, gitattestation.HasAttestationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyGitAttestationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return gitattestation.And(predicates...), nil
+	}
+}
+
+// GithubAttestationWhereInput represents a where input for filtering GithubAttestation queries.
+type GithubAttestationWhereInput struct {
+	Predicates []predicate.GithubAttestation  `json:"-"`
+	Not        *GithubAttestationWhereInput   `json:"not,omitempty"`
+	Or         []*GithubAttestationWhereInput `json:"or,omitempty"`
+	And        []*GithubAttestationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "ci_config_path" field predicates.
+	CiConfigPath             *string  `json:"ciConfigPath,omitempty"`
+	CiConfigPathNEQ          *string  `json:"ciConfigPathNEQ,omitempty"`
+	CiConfigPathIn           []string `json:"ciConfigPathIn,omitempty"`
+	CiConfigPathNotIn        []string `json:"ciConfigPathNotIn,omitempty"`
+	CiConfigPathGT           *string  `json:"ciConfigPathGT,omitempty"`
+	CiConfigPathGTE          *string  `json:"ciConfigPathGTE,omitempty"`
+	CiConfigPathLT           *string  `json:"ciConfigPathLT,omitempty"`
+	CiConfigPathLTE          *string  `json:"ciConfigPathLTE,omitempty"`
+	CiConfigPathContains     *string  `json:"ciConfigPathContains,omitempty"`
+	CiConfigPathHasPrefix    *string  `json:"ciConfigPathHasPrefix,omitempty"`
+	CiConfigPathHasSuffix    *string  `json:"ciConfigPathHasSuffix,omitempty"`
+	CiConfigPathIsNil        bool     `json:"ciConfigPathIsNil,omitempty"`
+	CiConfigPathNotNil       bool     `json:"ciConfigPathNotNil,omitempty"`
+	CiConfigPathEqualFold    *string  `json:"ciConfigPathEqualFold,omitempty"`
+	CiConfigPathContainsFold *string  `json:"ciConfigPathContainsFold,omitempty"`
+
+	// "pipeline_id" field predicates.
+	PipelineID             *string  `json:"pipelineID,omitempty"`
+	PipelineIDNEQ          *string  `json:"pipelineIDNEQ,omitempty"`
+	PipelineIDIn           []string `json:"pipelineIDIn,omitempty"`
+	PipelineIDNotIn        []string `json:"pipelineIDNotIn,omitempty"`
+	PipelineIDGT           *string  `json:"pipelineIDGT,omitempty"`
+	PipelineIDGTE          *string  `json:"pipelineIDGTE,omitempty"`
+	PipelineIDLT           *string  `json:"pipelineIDLT,omitempty"`
+	PipelineIDLTE          *string  `json:"pipelineIDLTE,omitempty"`
+	PipelineIDContains     *string  `json:"pipelineIDContains,omitempty"`
+	PipelineIDHasPrefix    *string  `json:"pipelineIDHasPrefix,omitempty"`
+	PipelineIDHasSuffix    *string  `json:"pipelineIDHasSuffix,omitempty"`
+	PipelineIDIsNil        bool     `json:"pipelineIDIsNil,omitempty"`
+	PipelineIDNotNil       bool     `json:"pipelineIDNotNil,omitempty"`
+	PipelineIDEqualFold    *string  `json:"pipelineIDEqualFold,omitempty"`
+	PipelineIDContainsFold *string  `json:"pipelineIDContainsFold,omitempty"`
+
+	// "pipeline_name" field predicates.
+	PipelineName             *string  `json:"pipelineName,omitempty"`
+	PipelineNameNEQ          *string  `json:"pipelineNameNEQ,omitempty"`
+	PipelineNameIn           []string `json:"pipelineNameIn,omitempty"`
+	PipelineNameNotIn        []string `json:"pipelineNameNotIn,omitempty"`
+	PipelineNameGT           *string  `json:"pipelineNameGT,omitempty"`
+	PipelineNameGTE          *string  `json:"pipelineNameGTE,omitempty"`
+	PipelineNameLT           *string  `json:"pipelineNameLT,omitempty"`
+	PipelineNameLTE          *string  `json:"pipelineNameLTE,omitempty"`
+	PipelineNameContains     *string  `json:"pipelineNameContains,omitempty"`
+	PipelineNameHasPrefix    *string  `json:"pipelineNameHasPrefix,omitempty"`
+	PipelineNameHasSuffix    *string  `json:"pipelineNameHasSuffix,omitempty"`
+	PipelineNameIsNil        bool     `json:"pipelineNameIsNil,omitempty"`
+	PipelineNameNotNil       bool     `json:"pipelineNameNotNil,omitempty"`
+	PipelineNameEqualFold    *string  `json:"pipelineNameEqualFold,omitempty"`
+	PipelineNameContainsFold *string  `json:"pipelineNameContainsFold,omitempty"`
+
+	// "pipeline_url" field predicates.
+	PipelineURL             *string  `json:"pipelineURL,omitempty"`
+	PipelineURLNEQ          *string  `json:"pipelineURLNEQ,omitempty"`
+	PipelineURLIn           []string `json:"pipelineURLIn,omitempty"`
+	PipelineURLNotIn        []string `json:"pipelineURLNotIn,omitempty"`
+	PipelineURLGT           *string  `json:"pipelineURLGT,omitempty"`
+	PipelineURLGTE          *string  `json:"pipelineURLGTE,omitempty"`
+	PipelineURLLT           *string  `json:"pipelineURLLT,omitempty"`
+	PipelineURLLTE          *string  `json:"pipelineURLLTE,omitempty"`
+	PipelineURLContains     *string  `json:"pipelineURLContains,omitempty"`
+	PipelineURLHasPrefix    *string  `json:"pipelineURLHasPrefix,omitempty"`
+	PipelineURLHasSuffix    *string  `json:"pipelineURLHasSuffix,omitempty"`
+	PipelineURLIsNil        bool     `json:"pipelineURLIsNil,omitempty"`
+	PipelineURLNotNil       bool     `json:"pipelineURLNotNil,omitempty"`
+	PipelineURLEqualFold    *string  `json:"pipelineURLEqualFold,omitempty"`
+	PipelineURLContainsFold *string  `json:"pipelineURLContainsFold,omitempty"`
+
+	// "project_url" field predicates.
+	ProjectURL             *string  `json:"projectURL,omitempty"`
+	ProjectURLNEQ          *string  `json:"projectURLNEQ,omitempty"`
+	ProjectURLIn           []string `json:"projectURLIn,omitempty"`
+	ProjectURLNotIn        []string `json:"projectURLNotIn,omitempty"`
+	ProjectURLGT           *string  `json:"projectURLGT,omitempty"`
+	ProjectURLGTE          *string  `json:"projectURLGTE,omitempty"`
+	ProjectURLLT           *string  `json:"projectURLLT,omitempty"`
+	ProjectURLLTE          *string  `json:"projectURLLTE,omitempty"`
+	ProjectURLContains     *string  `json:"projectURLContains,omitempty"`
+	ProjectURLHasPrefix    *string  `json:"projectURLHasPrefix,omitempty"`
+	ProjectURLHasSuffix    *string  `json:"projectURLHasSuffix,omitempty"`
+	ProjectURLIsNil        bool     `json:"projectURLIsNil,omitempty"`
+	ProjectURLNotNil       bool     `json:"projectURLNotNil,omitempty"`
+	ProjectURLEqualFold    *string  `json:"projectURLEqualFold,omitempty"`
+	ProjectURLContainsFold *string  `json:"projectURLContainsFold,omitempty"`
+
+	// "runner_id" field predicates.
+	RunnerID             *string  `json:"runnerID,omitempty"`
+	RunnerIDNEQ          *string  `json:"runnerIDNEQ,omitempty"`
+	RunnerIDIn           []string `json:"runnerIDIn,omitempty"`
+	RunnerIDNotIn        []string `json:"runnerIDNotIn,omitempty"`
+	RunnerIDGT           *string  `json:"runnerIDGT,omitempty"`
+	RunnerIDGTE          *string  `json:"runnerIDGTE,omitempty"`
+	RunnerIDLT           *string  `json:"runnerIDLT,omitempty"`
+	RunnerIDLTE          *string  `json:"runnerIDLTE,omitempty"`
+	RunnerIDContains     *string  `json:"runnerIDContains,omitempty"`
+	RunnerIDHasPrefix    *string  `json:"runnerIDHasPrefix,omitempty"`
+	RunnerIDHasSuffix    *string  `json:"runnerIDHasSuffix,omitempty"`
+	RunnerIDIsNil        bool     `json:"runnerIDIsNil,omitempty"`
+	RunnerIDNotNil       bool     `json:"runnerIDNotNil,omitempty"`
+	RunnerIDEqualFold    *string  `json:"runnerIDEqualFold,omitempty"`
+	RunnerIDContainsFold *string  `json:"runnerIDContainsFold,omitempty"`
+
+	// "ci_host" field predicates.
+	CiHost             *string  `json:"ciHost,omitempty"`
+	CiHostNEQ          *string  `json:"ciHostNEQ,omitempty"`
+	CiHostIn           []string `json:"ciHostIn,omitempty"`
+	CiHostNotIn        []string `json:"ciHostNotIn,omitempty"`
+	CiHostGT           *string  `json:"ciHostGT,omitempty"`
+	CiHostGTE          *string  `json:"ciHostGTE,omitempty"`
+	CiHostLT           *string  `json:"ciHostLT,omitempty"`
+	CiHostLTE          *string  `json:"ciHostLTE,omitempty"`
+	CiHostContains     *string  `json:"ciHostContains,omitempty"`
+	CiHostHasPrefix    *string  `json:"ciHostHasPrefix,omitempty"`
+	CiHostHasSuffix    *string  `json:"ciHostHasSuffix,omitempty"`
+	CiHostIsNil        bool     `json:"ciHostIsNil,omitempty"`
+	CiHostNotNil       bool     `json:"ciHostNotNil,omitempty"`
+	CiHostEqualFold    *string  `json:"ciHostEqualFold,omitempty"`
+	CiHostContainsFold *string  `json:"ciHostContainsFold,omitempty"`
+
+	// "ci_server_url" field predicates.
+	CiServerURL             *string  `json:"ciServerURL,omitempty"`
+	CiServerURLNEQ          *string  `json:"ciServerURLNEQ,omitempty"`
+	CiServerURLIn           []string `json:"ciServerURLIn,omitempty"`
+	CiServerURLNotIn        []string `json:"ciServerURLNotIn,omitempty"`
+	CiServerURLGT           *string  `json:"ciServerURLGT,omitempty"`
+	CiServerURLGTE          *string  `json:"ciServerURLGTE,omitempty"`
+	CiServerURLLT           *string  `json:"ciServerURLLT,omitempty"`
+	CiServerURLLTE          *string  `json:"ciServerURLLTE,omitempty"`
+	CiServerURLContains     *string  `json:"ciServerURLContains,omitempty"`
+	CiServerURLHasPrefix    *string  `json:"ciServerURLHasPrefix,omitempty"`
+	CiServerURLHasSuffix    *string  `json:"ciServerURLHasSuffix,omitempty"`
+	CiServerURLIsNil        bool     `json:"ciServerURLIsNil,omitempty"`
+	CiServerURLNotNil       bool     `json:"ciServerURLNotNil,omitempty"`
+	CiServerURLEqualFold    *string  `json:"ciServerURLEqualFold,omitempty"`
+	CiServerURLContainsFold *string  `json:"ciServerURLContainsFold,omitempty"`
+
+	// "runner_arch" field predicates.
+	RunnerArch             *string  `json:"runnerArch,omitempty"`
+	RunnerArchNEQ          *string  `json:"runnerArchNEQ,omitempty"`
+	RunnerArchIn           []string `json:"runnerArchIn,omitempty"`
+	RunnerArchNotIn        []string `json:"runnerArchNotIn,omitempty"`
+	RunnerArchGT           *string  `json:"runnerArchGT,omitempty"`
+	RunnerArchGTE          *string  `json:"runnerArchGTE,omitempty"`
+	RunnerArchLT           *string  `json:"runnerArchLT,omitempty"`
+	RunnerArchLTE          *string  `json:"runnerArchLTE,omitempty"`
+	RunnerArchContains     *string  `json:"runnerArchContains,omitempty"`
+	RunnerArchHasPrefix    *string  `json:"runnerArchHasPrefix,omitempty"`
+	RunnerArchHasSuffix    *string  `json:"runnerArchHasSuffix,omitempty"`
+	RunnerArchIsNil        bool     `json:"runnerArchIsNil,omitempty"`
+	RunnerArchNotNil       bool     `json:"runnerArchNotNil,omitempty"`
+	RunnerArchEqualFold    *string  `json:"runnerArchEqualFold,omitempty"`
+	RunnerArchContainsFold *string  `json:"runnerArchContainsFold,omitempty"`
+
+	// "runner_os" field predicates.
+	RunnerOs             *string  `json:"runnerOs,omitempty"`
+	RunnerOsNEQ          *string  `json:"runnerOsNEQ,omitempty"`
+	RunnerOsIn           []string `json:"runnerOsIn,omitempty"`
+	RunnerOsNotIn        []string `json:"runnerOsNotIn,omitempty"`
+	RunnerOsGT           *string  `json:"runnerOsGT,omitempty"`
+	RunnerOsGTE          *string  `json:"runnerOsGTE,omitempty"`
+	RunnerOsLT           *string  `json:"runnerOsLT,omitempty"`
+	RunnerOsLTE          *string  `json:"runnerOsLTE,omitempty"`
+	RunnerOsContains     *string  `json:"runnerOsContains,omitempty"`
+	RunnerOsHasPrefix    *string  `json:"runnerOsHasPrefix,omitempty"`
+	RunnerOsHasSuffix    *string  `json:"runnerOsHasSuffix,omitempty"`
+	RunnerOsIsNil        bool     `json:"runnerOsIsNil,omitempty"`
+	RunnerOsNotNil       bool     `json:"runnerOsNotNil,omitempty"`
+	RunnerOsEqualFold    *string  `json:"runnerOsEqualFold,omitempty"`
+	RunnerOsContainsFold *string  `json:"runnerOsContainsFold,omitempty"`
+
+	// "attestation" edge predicates.
+	HasAttestation     *bool                    `json:"hasAttestation,omitempty"`
+	HasAttestationWith []*AttestationWhereInput `json:"hasAttestationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *GithubAttestationWhereInput) AddPredicates(predicates ...predicate.GithubAttestation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the GithubAttestationWhereInput filter on the GithubAttestationQuery builder.
+func (i *GithubAttestationWhereInput) Filter(q *GithubAttestationQuery) (*GithubAttestationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyGithubAttestationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyGithubAttestationWhereInput is returned in case the GithubAttestationWhereInput is empty.
+var ErrEmptyGithubAttestationWhereInput = errors.New("ent: empty predicate GithubAttestationWhereInput")
+
+// P returns a predicate for filtering githubattestations.
+// An error is returned if the input is empty or invalid.
+func (i *GithubAttestationWhereInput) P() (predicate.GithubAttestation, error) {
+	var predicates []predicate.GithubAttestation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, githubattestation.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.GithubAttestation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, githubattestation.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.GithubAttestation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, githubattestation.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, githubattestation.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, githubattestation.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, githubattestation.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, githubattestation.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, githubattestation.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, githubattestation.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, githubattestation.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, githubattestation.IDLTE(*i.IDLTE))
+	}
+	if i.CiConfigPath != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathEQ(*i.CiConfigPath))
+	}
+	if i.CiConfigPathNEQ != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathNEQ(*i.CiConfigPathNEQ))
+	}
+	if len(i.CiConfigPathIn) > 0 {
+		predicates = append(predicates, githubattestation.CiConfigPathIn(i.CiConfigPathIn...))
+	}
+	if len(i.CiConfigPathNotIn) > 0 {
+		predicates = append(predicates, githubattestation.CiConfigPathNotIn(i.CiConfigPathNotIn...))
+	}
+	if i.CiConfigPathGT != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathGT(*i.CiConfigPathGT))
+	}
+	if i.CiConfigPathGTE != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathGTE(*i.CiConfigPathGTE))
+	}
+	if i.CiConfigPathLT != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathLT(*i.CiConfigPathLT))
+	}
+	if i.CiConfigPathLTE != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathLTE(*i.CiConfigPathLTE))
+	}
+	if i.CiConfigPathContains != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathContains(*i.CiConfigPathContains))
+	}
+	if i.CiConfigPathHasPrefix != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathHasPrefix(*i.CiConfigPathHasPrefix))
+	}
+	if i.CiConfigPathHasSuffix != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathHasSuffix(*i.CiConfigPathHasSuffix))
+	}
+	if i.CiConfigPathIsNil {
+		predicates = append(predicates, githubattestation.CiConfigPathIsNil())
+	}
+	if i.CiConfigPathNotNil {
+		predicates = append(predicates, githubattestation.CiConfigPathNotNil())
+	}
+	if i.CiConfigPathEqualFold != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathEqualFold(*i.CiConfigPathEqualFold))
+	}
+	if i.CiConfigPathContainsFold != nil {
+		predicates = append(predicates, githubattestation.CiConfigPathContainsFold(*i.CiConfigPathContainsFold))
+	}
+	if i.PipelineID != nil {
+		predicates = append(predicates, githubattestation.PipelineIDEQ(*i.PipelineID))
+	}
+	if i.PipelineIDNEQ != nil {
+		predicates = append(predicates, githubattestation.PipelineIDNEQ(*i.PipelineIDNEQ))
+	}
+	if len(i.PipelineIDIn) > 0 {
+		predicates = append(predicates, githubattestation.PipelineIDIn(i.PipelineIDIn...))
+	}
+	if len(i.PipelineIDNotIn) > 0 {
+		predicates = append(predicates, githubattestation.PipelineIDNotIn(i.PipelineIDNotIn...))
+	}
+	if i.PipelineIDGT != nil {
+		predicates = append(predicates, githubattestation.PipelineIDGT(*i.PipelineIDGT))
+	}
+	if i.PipelineIDGTE != nil {
+		predicates = append(predicates, githubattestation.PipelineIDGTE(*i.PipelineIDGTE))
+	}
+	if i.PipelineIDLT != nil {
+		predicates = append(predicates, githubattestation.PipelineIDLT(*i.PipelineIDLT))
+	}
+	if i.PipelineIDLTE != nil {
+		predicates = append(predicates, githubattestation.PipelineIDLTE(*i.PipelineIDLTE))
+	}
+	if i.PipelineIDContains != nil {
+		predicates = append(predicates, githubattestation.PipelineIDContains(*i.PipelineIDContains))
+	}
+	if i.PipelineIDHasPrefix != nil {
+		predicates = append(predicates, githubattestation.PipelineIDHasPrefix(*i.PipelineIDHasPrefix))
+	}
+	if i.PipelineIDHasSuffix != nil {
+		predicates = append(predicates, githubattestation.PipelineIDHasSuffix(*i.PipelineIDHasSuffix))
+	}
+	if i.PipelineIDIsNil {
+		predicates = append(predicates, githubattestation.PipelineIDIsNil())
+	}
+	if i.PipelineIDNotNil {
+		predicates = append(predicates, githubattestation.PipelineIDNotNil())
+	}
+	if i.PipelineIDEqualFold != nil {
+		predicates = append(predicates, githubattestation.PipelineIDEqualFold(*i.PipelineIDEqualFold))
+	}
+	if i.PipelineIDContainsFold != nil {
+		predicates = append(predicates, githubattestation.PipelineIDContainsFold(*i.PipelineIDContainsFold))
+	}
+	if i.PipelineName != nil {
+		predicates = append(predicates, githubattestation.PipelineNameEQ(*i.PipelineName))
+	}
+	if i.PipelineNameNEQ != nil {
+		predicates = append(predicates, githubattestation.PipelineNameNEQ(*i.PipelineNameNEQ))
+	}
+	if len(i.PipelineNameIn) > 0 {
+		predicates = append(predicates, githubattestation.PipelineNameIn(i.PipelineNameIn...))
+	}
+	if len(i.PipelineNameNotIn) > 0 {
+		predicates = append(predicates, githubattestation.PipelineNameNotIn(i.PipelineNameNotIn...))
+	}
+	if i.PipelineNameGT != nil {
+		predicates = append(predicates, githubattestation.PipelineNameGT(*i.PipelineNameGT))
+	}
+	if i.PipelineNameGTE != nil {
+		predicates = append(predicates, githubattestation.PipelineNameGTE(*i.PipelineNameGTE))
+	}
+	if i.PipelineNameLT != nil {
+		predicates = append(predicates, githubattestation.PipelineNameLT(*i.PipelineNameLT))
+	}
+	if i.PipelineNameLTE != nil {
+		predicates = append(predicates, githubattestation.PipelineNameLTE(*i.PipelineNameLTE))
+	}
+	if i.PipelineNameContains != nil {
+		predicates = append(predicates, githubattestation.PipelineNameContains(*i.PipelineNameContains))
+	}
+	if i.PipelineNameHasPrefix != nil {
+		predicates = append(predicates, githubattestation.PipelineNameHasPrefix(*i.PipelineNameHasPrefix))
+	}
+	if i.PipelineNameHasSuffix != nil {
+		predicates = append(predicates, githubattestation.PipelineNameHasSuffix(*i.PipelineNameHasSuffix))
+	}
+	if i.PipelineNameIsNil {
+		predicates = append(predicates, githubattestation.PipelineNameIsNil())
+	}
+	if i.PipelineNameNotNil {
+		predicates = append(predicates, githubattestation.PipelineNameNotNil())
+	}
+	if i.PipelineNameEqualFold != nil {
+		predicates = append(predicates, githubattestation.PipelineNameEqualFold(*i.PipelineNameEqualFold))
+	}
+	if i.PipelineNameContainsFold != nil {
+		predicates = append(predicates, githubattestation.PipelineNameContainsFold(*i.PipelineNameContainsFold))
+	}
+	if i.PipelineURL != nil {
+		predicates = append(predicates, githubattestation.PipelineURLEQ(*i.PipelineURL))
+	}
+	if i.PipelineURLNEQ != nil {
+		predicates = append(predicates, githubattestation.PipelineURLNEQ(*i.PipelineURLNEQ))
+	}
+	if len(i.PipelineURLIn) > 0 {
+		predicates = append(predicates, githubattestation.PipelineURLIn(i.PipelineURLIn...))
+	}
+	if len(i.PipelineURLNotIn) > 0 {
+		predicates = append(predicates, githubattestation.PipelineURLNotIn(i.PipelineURLNotIn...))
+	}
+	if i.PipelineURLGT != nil {
+		predicates = append(predicates, githubattestation.PipelineURLGT(*i.PipelineURLGT))
+	}
+	if i.PipelineURLGTE != nil {
+		predicates = append(predicates, githubattestation.PipelineURLGTE(*i.PipelineURLGTE))
+	}
+	if i.PipelineURLLT != nil {
+		predicates = append(predicates, githubattestation.PipelineURLLT(*i.PipelineURLLT))
+	}
+	if i.PipelineURLLTE != nil {
+		predicates = append(predicates, githubattestation.PipelineURLLTE(*i.PipelineURLLTE))
+	}
+	if i.PipelineURLContains != nil {
+		predicates = append(predicates, githubattestation.PipelineURLContains(*i.PipelineURLContains))
+	}
+	if i.PipelineURLHasPrefix != nil {
+		predicates = append(predicates, githubattestation.PipelineURLHasPrefix(*i.PipelineURLHasPrefix))
+	}
+	if i.PipelineURLHasSuffix != nil {
+		predicates = append(predicates, githubattestation.PipelineURLHasSuffix(*i.PipelineURLHasSuffix))
+	}
+	if i.PipelineURLIsNil {
+		predicates = append(predicates, githubattestation.PipelineURLIsNil())
+	}
+	if i.PipelineURLNotNil {
+		predicates = append(predicates, githubattestation.PipelineURLNotNil())
+	}
+	if i.PipelineURLEqualFold != nil {
+		predicates = append(predicates, githubattestation.PipelineURLEqualFold(*i.PipelineURLEqualFold))
+	}
+	if i.PipelineURLContainsFold != nil {
+		predicates = append(predicates, githubattestation.PipelineURLContainsFold(*i.PipelineURLContainsFold))
+	}
+	if i.ProjectURL != nil {
+		predicates = append(predicates, githubattestation.ProjectURLEQ(*i.ProjectURL))
+	}
+	if i.ProjectURLNEQ != nil {
+		predicates = append(predicates, githubattestation.ProjectURLNEQ(*i.ProjectURLNEQ))
+	}
+	if len(i.ProjectURLIn) > 0 {
+		predicates = append(predicates, githubattestation.ProjectURLIn(i.ProjectURLIn...))
+	}
+	if len(i.ProjectURLNotIn) > 0 {
+		predicates = append(predicates, githubattestation.ProjectURLNotIn(i.ProjectURLNotIn...))
+	}
+	if i.ProjectURLGT != nil {
+		predicates = append(predicates, githubattestation.ProjectURLGT(*i.ProjectURLGT))
+	}
+	if i.ProjectURLGTE != nil {
+		predicates = append(predicates, githubattestation.ProjectURLGTE(*i.ProjectURLGTE))
+	}
+	if i.ProjectURLLT != nil {
+		predicates = append(predicates, githubattestation.ProjectURLLT(*i.ProjectURLLT))
+	}
+	if i.ProjectURLLTE != nil {
+		predicates = append(predicates, githubattestation.ProjectURLLTE(*i.ProjectURLLTE))
+	}
+	if i.ProjectURLContains != nil {
+		predicates = append(predicates, githubattestation.ProjectURLContains(*i.ProjectURLContains))
+	}
+	if i.ProjectURLHasPrefix != nil {
+		predicates = append(predicates, githubattestation.ProjectURLHasPrefix(*i.ProjectURLHasPrefix))
+	}
+	if i.ProjectURLHasSuffix != nil {
+		predicates = append(predicates, githubattestation.ProjectURLHasSuffix(*i.ProjectURLHasSuffix))
+	}
+	if i.ProjectURLIsNil {
+		predicates = append(predicates, githubattestation.ProjectURLIsNil())
+	}
+	if i.ProjectURLNotNil {
+		predicates = append(predicates, githubattestation.ProjectURLNotNil())
+	}
+	if i.ProjectURLEqualFold != nil {
+		predicates = append(predicates, githubattestation.ProjectURLEqualFold(*i.ProjectURLEqualFold))
+	}
+	if i.ProjectURLContainsFold != nil {
+		predicates = append(predicates, githubattestation.ProjectURLContainsFold(*i.ProjectURLContainsFold))
+	}
+	if i.RunnerID != nil {
+		predicates = append(predicates, githubattestation.RunnerIDEQ(*i.RunnerID))
+	}
+	if i.RunnerIDNEQ != nil {
+		predicates = append(predicates, githubattestation.RunnerIDNEQ(*i.RunnerIDNEQ))
+	}
+	if len(i.RunnerIDIn) > 0 {
+		predicates = append(predicates, githubattestation.RunnerIDIn(i.RunnerIDIn...))
+	}
+	if len(i.RunnerIDNotIn) > 0 {
+		predicates = append(predicates, githubattestation.RunnerIDNotIn(i.RunnerIDNotIn...))
+	}
+	if i.RunnerIDGT != nil {
+		predicates = append(predicates, githubattestation.RunnerIDGT(*i.RunnerIDGT))
+	}
+	if i.RunnerIDGTE != nil {
+		predicates = append(predicates, githubattestation.RunnerIDGTE(*i.RunnerIDGTE))
+	}
+	if i.RunnerIDLT != nil {
+		predicates = append(predicates, githubattestation.RunnerIDLT(*i.RunnerIDLT))
+	}
+	if i.RunnerIDLTE != nil {
+		predicates = append(predicates, githubattestation.RunnerIDLTE(*i.RunnerIDLTE))
+	}
+	if i.RunnerIDContains != nil {
+		predicates = append(predicates, githubattestation.RunnerIDContains(*i.RunnerIDContains))
+	}
+	if i.RunnerIDHasPrefix != nil {
+		predicates = append(predicates, githubattestation.RunnerIDHasPrefix(*i.RunnerIDHasPrefix))
+	}
+	if i.RunnerIDHasSuffix != nil {
+		predicates = append(predicates, githubattestation.RunnerIDHasSuffix(*i.RunnerIDHasSuffix))
+	}
+	if i.RunnerIDIsNil {
+		predicates = append(predicates, githubattestation.RunnerIDIsNil())
+	}
+	if i.RunnerIDNotNil {
+		predicates = append(predicates, githubattestation.RunnerIDNotNil())
+	}
+	if i.RunnerIDEqualFold != nil {
+		predicates = append(predicates, githubattestation.RunnerIDEqualFold(*i.RunnerIDEqualFold))
+	}
+	if i.RunnerIDContainsFold != nil {
+		predicates = append(predicates, githubattestation.RunnerIDContainsFold(*i.RunnerIDContainsFold))
+	}
+	if i.CiHost != nil {
+		predicates = append(predicates, githubattestation.CiHostEQ(*i.CiHost))
+	}
+	if i.CiHostNEQ != nil {
+		predicates = append(predicates, githubattestation.CiHostNEQ(*i.CiHostNEQ))
+	}
+	if len(i.CiHostIn) > 0 {
+		predicates = append(predicates, githubattestation.CiHostIn(i.CiHostIn...))
+	}
+	if len(i.CiHostNotIn) > 0 {
+		predicates = append(predicates, githubattestation.CiHostNotIn(i.CiHostNotIn...))
+	}
+	if i.CiHostGT != nil {
+		predicates = append(predicates, githubattestation.CiHostGT(*i.CiHostGT))
+	}
+	if i.CiHostGTE != nil {
+		predicates = append(predicates, githubattestation.CiHostGTE(*i.CiHostGTE))
+	}
+	if i.CiHostLT != nil {
+		predicates = append(predicates, githubattestation.CiHostLT(*i.CiHostLT))
+	}
+	if i.CiHostLTE != nil {
+		predicates = append(predicates, githubattestation.CiHostLTE(*i.CiHostLTE))
+	}
+	if i.CiHostContains != nil {
+		predicates = append(predicates, githubattestation.CiHostContains(*i.CiHostContains))
+	}
+	if i.CiHostHasPrefix != nil {
+		predicates = append(predicates, githubattestation.CiHostHasPrefix(*i.CiHostHasPrefix))
+	}
+	if i.CiHostHasSuffix != nil {
+		predicates = append(predicates, githubattestation.CiHostHasSuffix(*i.CiHostHasSuffix))
+	}
+	if i.CiHostIsNil {
+		predicates = append(predicates, githubattestation.CiHostIsNil())
+	}
+	if i.CiHostNotNil {
+		predicates = append(predicates, githubattestation.CiHostNotNil())
+	}
+	if i.CiHostEqualFold != nil {
+		predicates = append(predicates, githubattestation.CiHostEqualFold(*i.CiHostEqualFold))
+	}
+	if i.CiHostContainsFold != nil {
+		predicates = append(predicates, githubattestation.CiHostContainsFold(*i.CiHostContainsFold))
+	}
+	if i.CiServerURL != nil {
+		predicates = append(predicates, githubattestation.CiServerURLEQ(*i.CiServerURL))
+	}
+	if i.CiServerURLNEQ != nil {
+		predicates = append(predicates, githubattestation.CiServerURLNEQ(*i.CiServerURLNEQ))
+	}
+	if len(i.CiServerURLIn) > 0 {
+		predicates = append(predicates, githubattestation.CiServerURLIn(i.CiServerURLIn...))
+	}
+	if len(i.CiServerURLNotIn) > 0 {
+		predicates = append(predicates, githubattestation.CiServerURLNotIn(i.CiServerURLNotIn...))
+	}
+	if i.CiServerURLGT != nil {
+		predicates = append(predicates, githubattestation.CiServerURLGT(*i.CiServerURLGT))
+	}
+	if i.CiServerURLGTE != nil {
+		predicates = append(predicates, githubattestation.CiServerURLGTE(*i.CiServerURLGTE))
+	}
+	if i.CiServerURLLT != nil {
+		predicates = append(predicates, githubattestation.CiServerURLLT(*i.CiServerURLLT))
+	}
+	if i.CiServerURLLTE != nil {
+		predicates = append(predicates, githubattestation.CiServerURLLTE(*i.CiServerURLLTE))
+	}
+	if i.CiServerURLContains != nil {
+		predicates = append(predicates, githubattestation.CiServerURLContains(*i.CiServerURLContains))
+	}
+	if i.CiServerURLHasPrefix != nil {
+		predicates = append(predicates, githubattestation.CiServerURLHasPrefix(*i.CiServerURLHasPrefix))
+	}
+	if i.CiServerURLHasSuffix != nil {
+		predicates = append(predicates, githubattestation.CiServerURLHasSuffix(*i.CiServerURLHasSuffix))
+	}
+	if i.CiServerURLIsNil {
+		predicates = append(predicates, githubattestation.CiServerURLIsNil())
+	}
+	if i.CiServerURLNotNil {
+		predicates = append(predicates, githubattestation.CiServerURLNotNil())
+	}
+	if i.CiServerURLEqualFold != nil {
+		predicates = append(predicates, githubattestation.CiServerURLEqualFold(*i.CiServerURLEqualFold))
+	}
+	if i.CiServerURLContainsFold != nil {
+		predicates = append(predicates, githubattestation.CiServerURLContainsFold(*i.CiServerURLContainsFold))
+	}
+	if i.RunnerArch != nil {
+		predicates = append(predicates, githubattestation.RunnerArchEQ(*i.RunnerArch))
+	}
+	if i.RunnerArchNEQ != nil {
+		predicates = append(predicates, githubattestation.RunnerArchNEQ(*i.RunnerArchNEQ))
+	}
+	if len(i.RunnerArchIn) > 0 {
+		predicates = append(predicates, githubattestation.RunnerArchIn(i.RunnerArchIn...))
+	}
+	if len(i.RunnerArchNotIn) > 0 {
+		predicates = append(predicates, githubattestation.RunnerArchNotIn(i.RunnerArchNotIn...))
+	}
+	if i.RunnerArchGT != nil {
+		predicates = append(predicates, githubattestation.RunnerArchGT(*i.RunnerArchGT))
+	}
+	if i.RunnerArchGTE != nil {
+		predicates = append(predicates, githubattestation.RunnerArchGTE(*i.RunnerArchGTE))
+	}
+	if i.RunnerArchLT != nil {
+		predicates = append(predicates, githubattestation.RunnerArchLT(*i.RunnerArchLT))
+	}
+	if i.RunnerArchLTE != nil {
+		predicates = append(predicates, githubattestation.RunnerArchLTE(*i.RunnerArchLTE))
+	}
+	if i.RunnerArchContains != nil {
+		predicates = append(predicates, githubattestation.RunnerArchContains(*i.RunnerArchContains))
+	}
+	if i.RunnerArchHasPrefix != nil {
+		predicates = append(predicates, githubattestation.RunnerArchHasPrefix(*i.RunnerArchHasPrefix))
+	}
+	if i.RunnerArchHasSuffix != nil {
+		predicates = append(predicates, githubattestation.RunnerArchHasSuffix(*i.RunnerArchHasSuffix))
+	}
+	if i.RunnerArchIsNil {
+		predicates = append(predicates, githubattestation.RunnerArchIsNil())
+	}
+	if i.RunnerArchNotNil {
+		predicates = append(predicates, githubattestation.RunnerArchNotNil())
+	}
+	if i.RunnerArchEqualFold != nil {
+		predicates = append(predicates, githubattestation.RunnerArchEqualFold(*i.RunnerArchEqualFold))
+	}
+	if i.RunnerArchContainsFold != nil {
+		predicates = append(predicates, githubattestation.RunnerArchContainsFold(*i.RunnerArchContainsFold))
+	}
+	if i.RunnerOs != nil {
+		predicates = append(predicates, githubattestation.RunnerOsEQ(*i.RunnerOs))
+	}
+	if i.RunnerOsNEQ != nil {
+		predicates = append(predicates, githubattestation.RunnerOsNEQ(*i.RunnerOsNEQ))
+	}
+	if len(i.RunnerOsIn) > 0 {
+		predicates = append(predicates, githubattestation.RunnerOsIn(i.RunnerOsIn...))
+	}
+	if len(i.RunnerOsNotIn) > 0 {
+		predicates = append(predicates, githubattestation.RunnerOsNotIn(i.RunnerOsNotIn...))
+	}
+	if i.RunnerOsGT != nil {
+		predicates = append(predicates, githubattestation.RunnerOsGT(*i.RunnerOsGT))
+	}
+	if i.RunnerOsGTE != nil {
+		predicates = append(predicates, githubattestation.RunnerOsGTE(*i.RunnerOsGTE))
+	}
+	if i.RunnerOsLT != nil {
+		predicates = append(predicates, githubattestation.RunnerOsLT(*i.RunnerOsLT))
+	}
+	if i.RunnerOsLTE != nil {
+		predicates = append(predicates, githubattestation.RunnerOsLTE(*i.RunnerOsLTE))
+	}
+	if i.RunnerOsContains != nil {
+		predicates = append(predicates, githubattestation.RunnerOsContains(*i.RunnerOsContains))
+	}
+	if i.RunnerOsHasPrefix != nil {
+		predicates = append(predicates, githubattestation.RunnerOsHasPrefix(*i.RunnerOsHasPrefix))
+	}
+	if i.RunnerOsHasSuffix != nil {
+		predicates = append(predicates, githubattestation.RunnerOsHasSuffix(*i.RunnerOsHasSuffix))
+	}
+	if i.RunnerOsIsNil {
+		predicates = append(predicates, githubattestation.RunnerOsIsNil())
+	}
+	if i.RunnerOsNotNil {
+		predicates = append(predicates, githubattestation.RunnerOsNotNil())
+	}
+	if i.RunnerOsEqualFold != nil {
+		predicates = append(predicates, githubattestation.RunnerOsEqualFold(*i.RunnerOsEqualFold))
+	}
+	if i.RunnerOsContainsFold != nil {
+		predicates = append(predicates, githubattestation.RunnerOsContainsFold(*i.RunnerOsContainsFold))
+	}
+
+	if i.HasAttestation != nil {
+		p := githubattestation.HasAttestation()
+		if !*i.HasAttestation {
+			p = githubattestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAttestationWith) > 0 {
+		with := make([]predicate.Attestation, 0, len(i.HasAttestationWith))
+		for _, w := range i.HasAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, githubattestation.HasAttestationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyGithubAttestationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return githubattestation.And(predicates...), nil
+	}
+}
+
+// GitlabAttestationWhereInput represents a where input for filtering GitlabAttestation queries.
+type GitlabAttestationWhereInput struct {
+	Predicates []predicate.GitlabAttestation  `json:"-"`
+	Not        *GitlabAttestationWhereInput   `json:"not,omitempty"`
+	Or         []*GitlabAttestationWhereInput `json:"or,omitempty"`
+	And        []*GitlabAttestationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "ci_config_path" field predicates.
+	CiConfigPath             *string  `json:"ciConfigPath,omitempty"`
+	CiConfigPathNEQ          *string  `json:"ciConfigPathNEQ,omitempty"`
+	CiConfigPathIn           []string `json:"ciConfigPathIn,omitempty"`
+	CiConfigPathNotIn        []string `json:"ciConfigPathNotIn,omitempty"`
+	CiConfigPathGT           *string  `json:"ciConfigPathGT,omitempty"`
+	CiConfigPathGTE          *string  `json:"ciConfigPathGTE,omitempty"`
+	CiConfigPathLT           *string  `json:"ciConfigPathLT,omitempty"`
+	CiConfigPathLTE          *string  `json:"ciConfigPathLTE,omitempty"`
+	CiConfigPathContains     *string  `json:"ciConfigPathContains,omitempty"`
+	CiConfigPathHasPrefix    *string  `json:"ciConfigPathHasPrefix,omitempty"`
+	CiConfigPathHasSuffix    *string  `json:"ciConfigPathHasSuffix,omitempty"`
+	CiConfigPathIsNil        bool     `json:"ciConfigPathIsNil,omitempty"`
+	CiConfigPathNotNil       bool     `json:"ciConfigPathNotNil,omitempty"`
+	CiConfigPathEqualFold    *string  `json:"ciConfigPathEqualFold,omitempty"`
+	CiConfigPathContainsFold *string  `json:"ciConfigPathContainsFold,omitempty"`
+
+	// "pipeline_id" field predicates.
+	PipelineID             *string  `json:"pipelineID,omitempty"`
+	PipelineIDNEQ          *string  `json:"pipelineIDNEQ,omitempty"`
+	PipelineIDIn           []string `json:"pipelineIDIn,omitempty"`
+	PipelineIDNotIn        []string `json:"pipelineIDNotIn,omitempty"`
+	PipelineIDGT           *string  `json:"pipelineIDGT,omitempty"`
+	PipelineIDGTE          *string  `json:"pipelineIDGTE,omitempty"`
+	PipelineIDLT           *string  `json:"pipelineIDLT,omitempty"`
+	PipelineIDLTE          *string  `json:"pipelineIDLTE,omitempty"`
+	PipelineIDContains     *string  `json:"pipelineIDContains,omitempty"`
+	PipelineIDHasPrefix    *string  `json:"pipelineIDHasPrefix,omitempty"`
+	PipelineIDHasSuffix    *string  `json:"pipelineIDHasSuffix,omitempty"`
+	PipelineIDIsNil        bool     `json:"pipelineIDIsNil,omitempty"`
+	PipelineIDNotNil       bool     `json:"pipelineIDNotNil,omitempty"`
+	PipelineIDEqualFold    *string  `json:"pipelineIDEqualFold,omitempty"`
+	PipelineIDContainsFold *string  `json:"pipelineIDContainsFold,omitempty"`
+
+	// "job_id" field predicates.
+	JobID             *string  `json:"jobID,omitempty"`
+	JobIDNEQ          *string  `json:"jobIDNEQ,omitempty"`
+	JobIDIn           []string `json:"jobIDIn,omitempty"`
+	JobIDNotIn        []string `json:"jobIDNotIn,omitempty"`
+	JobIDGT           *string  `json:"jobIDGT,omitempty"`
+	JobIDGTE          *string  `json:"jobIDGTE,omitempty"`
+	JobIDLT           *string  `json:"jobIDLT,omitempty"`
+	JobIDLTE          *string  `json:"jobIDLTE,omitempty"`
+	JobIDContains     *string  `json:"jobIDContains,omitempty"`
+	JobIDHasPrefix    *string  `json:"jobIDHasPrefix,omitempty"`
+	JobIDHasSuffix    *string  `json:"jobIDHasSuffix,omitempty"`
+	JobIDIsNil        bool     `json:"jobIDIsNil,omitempty"`
+	JobIDNotNil       bool     `json:"jobIDNotNil,omitempty"`
+	JobIDEqualFold    *string  `json:"jobIDEqualFold,omitempty"`
+	JobIDContainsFold *string  `json:"jobIDContainsFold,omitempty"`
+
+	// "job_image" field predicates.
+	JobImage             *string  `json:"jobImage,omitempty"`
+	JobImageNEQ          *string  `json:"jobImageNEQ,omitempty"`
+	JobImageIn           []string `json:"jobImageIn,omitempty"`
+	JobImageNotIn        []string `json:"jobImageNotIn,omitempty"`
+	JobImageGT           *string  `json:"jobImageGT,omitempty"`
+	JobImageGTE          *string  `json:"jobImageGTE,omitempty"`
+	JobImageLT           *string  `json:"jobImageLT,omitempty"`
+	JobImageLTE          *string  `json:"jobImageLTE,omitempty"`
+	JobImageContains     *string  `json:"jobImageContains,omitempty"`
+	JobImageHasPrefix    *string  `json:"jobImageHasPrefix,omitempty"`
+	JobImageHasSuffix    *string  `json:"jobImageHasSuffix,omitempty"`
+	JobImageIsNil        bool     `json:"jobImageIsNil,omitempty"`
+	JobImageNotNil       bool     `json:"jobImageNotNil,omitempty"`
+	JobImageEqualFold    *string  `json:"jobImageEqualFold,omitempty"`
+	JobImageContainsFold *string  `json:"jobImageContainsFold,omitempty"`
+
+	// "job_name" field predicates.
+	JobName             *string  `json:"jobName,omitempty"`
+	JobNameNEQ          *string  `json:"jobNameNEQ,omitempty"`
+	JobNameIn           []string `json:"jobNameIn,omitempty"`
+	JobNameNotIn        []string `json:"jobNameNotIn,omitempty"`
+	JobNameGT           *string  `json:"jobNameGT,omitempty"`
+	JobNameGTE          *string  `json:"jobNameGTE,omitempty"`
+	JobNameLT           *string  `json:"jobNameLT,omitempty"`
+	JobNameLTE          *string  `json:"jobNameLTE,omitempty"`
+	JobNameContains     *string  `json:"jobNameContains,omitempty"`
+	JobNameHasPrefix    *string  `json:"jobNameHasPrefix,omitempty"`
+	JobNameHasSuffix    *string  `json:"jobNameHasSuffix,omitempty"`
+	JobNameIsNil        bool     `json:"jobNameIsNil,omitempty"`
+	JobNameNotNil       bool     `json:"jobNameNotNil,omitempty"`
+	JobNameEqualFold    *string  `json:"jobNameEqualFold,omitempty"`
+	JobNameContainsFold *string  `json:"jobNameContainsFold,omitempty"`
+
+	// "job_stage" field predicates.
+	JobStage             *string  `json:"jobStage,omitempty"`
+	JobStageNEQ          *string  `json:"jobStageNEQ,omitempty"`
+	JobStageIn           []string `json:"jobStageIn,omitempty"`
+	JobStageNotIn        []string `json:"jobStageNotIn,omitempty"`
+	JobStageGT           *string  `json:"jobStageGT,omitempty"`
+	JobStageGTE          *string  `json:"jobStageGTE,omitempty"`
+	JobStageLT           *string  `json:"jobStageLT,omitempty"`
+	JobStageLTE          *string  `json:"jobStageLTE,omitempty"`
+	JobStageContains     *string  `json:"jobStageContains,omitempty"`
+	JobStageHasPrefix    *string  `json:"jobStageHasPrefix,omitempty"`
+	JobStageHasSuffix    *string  `json:"jobStageHasSuffix,omitempty"`
+	JobStageIsNil        bool     `json:"jobStageIsNil,omitempty"`
+	JobStageNotNil       bool     `json:"jobStageNotNil,omitempty"`
+	JobStageEqualFold    *string  `json:"jobStageEqualFold,omitempty"`
+	JobStageContainsFold *string  `json:"jobStageContainsFold,omitempty"`
+
+	// "job_url" field predicates.
+	JobURL             *string  `json:"jobURL,omitempty"`
+	JobURLNEQ          *string  `json:"jobURLNEQ,omitempty"`
+	JobURLIn           []string `json:"jobURLIn,omitempty"`
+	JobURLNotIn        []string `json:"jobURLNotIn,omitempty"`
+	JobURLGT           *string  `json:"jobURLGT,omitempty"`
+	JobURLGTE          *string  `json:"jobURLGTE,omitempty"`
+	JobURLLT           *string  `json:"jobURLLT,omitempty"`
+	JobURLLTE          *string  `json:"jobURLLTE,omitempty"`
+	JobURLContains     *string  `json:"jobURLContains,omitempty"`
+	JobURLHasPrefix    *string  `json:"jobURLHasPrefix,omitempty"`
+	JobURLHasSuffix    *string  `json:"jobURLHasSuffix,omitempty"`
+	JobURLIsNil        bool     `json:"jobURLIsNil,omitempty"`
+	JobURLNotNil       bool     `json:"jobURLNotNil,omitempty"`
+	JobURLEqualFold    *string  `json:"jobURLEqualFold,omitempty"`
+	JobURLContainsFold *string  `json:"jobURLContainsFold,omitempty"`
+
+	// "pipeline_url" field predicates.
+	PipelineURL             *string  `json:"pipelineURL,omitempty"`
+	PipelineURLNEQ          *string  `json:"pipelineURLNEQ,omitempty"`
+	PipelineURLIn           []string `json:"pipelineURLIn,omitempty"`
+	PipelineURLNotIn        []string `json:"pipelineURLNotIn,omitempty"`
+	PipelineURLGT           *string  `json:"pipelineURLGT,omitempty"`
+	PipelineURLGTE          *string  `json:"pipelineURLGTE,omitempty"`
+	PipelineURLLT           *string  `json:"pipelineURLLT,omitempty"`
+	PipelineURLLTE          *string  `json:"pipelineURLLTE,omitempty"`
+	PipelineURLContains     *string  `json:"pipelineURLContains,omitempty"`
+	PipelineURLHasPrefix    *string  `json:"pipelineURLHasPrefix,omitempty"`
+	PipelineURLHasSuffix    *string  `json:"pipelineURLHasSuffix,omitempty"`
+	PipelineURLIsNil        bool     `json:"pipelineURLIsNil,omitempty"`
+	PipelineURLNotNil       bool     `json:"pipelineURLNotNil,omitempty"`
+	PipelineURLEqualFold    *string  `json:"pipelineURLEqualFold,omitempty"`
+	PipelineURLContainsFold *string  `json:"pipelineURLContainsFold,omitempty"`
+
+	// "project_id" field predicates.
+	ProjectID             *string  `json:"projectID,omitempty"`
+	ProjectIDNEQ          *string  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn           []string `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn        []string `json:"projectIDNotIn,omitempty"`
+	ProjectIDGT           *string  `json:"projectIDGT,omitempty"`
+	ProjectIDGTE          *string  `json:"projectIDGTE,omitempty"`
+	ProjectIDLT           *string  `json:"projectIDLT,omitempty"`
+	ProjectIDLTE          *string  `json:"projectIDLTE,omitempty"`
+	ProjectIDContains     *string  `json:"projectIDContains,omitempty"`
+	ProjectIDHasPrefix    *string  `json:"projectIDHasPrefix,omitempty"`
+	ProjectIDHasSuffix    *string  `json:"projectIDHasSuffix,omitempty"`
+	ProjectIDIsNil        bool     `json:"projectIDIsNil,omitempty"`
+	ProjectIDNotNil       bool     `json:"projectIDNotNil,omitempty"`
+	ProjectIDEqualFold    *string  `json:"projectIDEqualFold,omitempty"`
+	ProjectIDContainsFold *string  `json:"projectIDContainsFold,omitempty"`
+
+	// "project_url" field predicates.
+	ProjectURL             *string  `json:"projectURL,omitempty"`
+	ProjectURLNEQ          *string  `json:"projectURLNEQ,omitempty"`
+	ProjectURLIn           []string `json:"projectURLIn,omitempty"`
+	ProjectURLNotIn        []string `json:"projectURLNotIn,omitempty"`
+	ProjectURLGT           *string  `json:"projectURLGT,omitempty"`
+	ProjectURLGTE          *string  `json:"projectURLGTE,omitempty"`
+	ProjectURLLT           *string  `json:"projectURLLT,omitempty"`
+	ProjectURLLTE          *string  `json:"projectURLLTE,omitempty"`
+	ProjectURLContains     *string  `json:"projectURLContains,omitempty"`
+	ProjectURLHasPrefix    *string  `json:"projectURLHasPrefix,omitempty"`
+	ProjectURLHasSuffix    *string  `json:"projectURLHasSuffix,omitempty"`
+	ProjectURLIsNil        bool     `json:"projectURLIsNil,omitempty"`
+	ProjectURLNotNil       bool     `json:"projectURLNotNil,omitempty"`
+	ProjectURLEqualFold    *string  `json:"projectURLEqualFold,omitempty"`
+	ProjectURLContainsFold *string  `json:"projectURLContainsFold,omitempty"`
+
+	// "runner_id" field predicates.
+	RunnerID             *string  `json:"runnerID,omitempty"`
+	RunnerIDNEQ          *string  `json:"runnerIDNEQ,omitempty"`
+	RunnerIDIn           []string `json:"runnerIDIn,omitempty"`
+	RunnerIDNotIn        []string `json:"runnerIDNotIn,omitempty"`
+	RunnerIDGT           *string  `json:"runnerIDGT,omitempty"`
+	RunnerIDGTE          *string  `json:"runnerIDGTE,omitempty"`
+	RunnerIDLT           *string  `json:"runnerIDLT,omitempty"`
+	RunnerIDLTE          *string  `json:"runnerIDLTE,omitempty"`
+	RunnerIDContains     *string  `json:"runnerIDContains,omitempty"`
+	RunnerIDHasPrefix    *string  `json:"runnerIDHasPrefix,omitempty"`
+	RunnerIDHasSuffix    *string  `json:"runnerIDHasSuffix,omitempty"`
+	RunnerIDIsNil        bool     `json:"runnerIDIsNil,omitempty"`
+	RunnerIDNotNil       bool     `json:"runnerIDNotNil,omitempty"`
+	RunnerIDEqualFold    *string  `json:"runnerIDEqualFold,omitempty"`
+	RunnerIDContainsFold *string  `json:"runnerIDContainsFold,omitempty"`
+
+	// "ci_host" field predicates.
+	CiHost             *string  `json:"ciHost,omitempty"`
+	CiHostNEQ          *string  `json:"ciHostNEQ,omitempty"`
+	CiHostIn           []string `json:"ciHostIn,omitempty"`
+	CiHostNotIn        []string `json:"ciHostNotIn,omitempty"`
+	CiHostGT           *string  `json:"ciHostGT,omitempty"`
+	CiHostGTE          *string  `json:"ciHostGTE,omitempty"`
+	CiHostLT           *string  `json:"ciHostLT,omitempty"`
+	CiHostLTE          *string  `json:"ciHostLTE,omitempty"`
+	CiHostContains     *string  `json:"ciHostContains,omitempty"`
+	CiHostHasPrefix    *string  `json:"ciHostHasPrefix,omitempty"`
+	CiHostHasSuffix    *string  `json:"ciHostHasSuffix,omitempty"`
+	CiHostIsNil        bool     `json:"ciHostIsNil,omitempty"`
+	CiHostNotNil       bool     `json:"ciHostNotNil,omitempty"`
+	CiHostEqualFold    *string  `json:"ciHostEqualFold,omitempty"`
+	CiHostContainsFold *string  `json:"ciHostContainsFold,omitempty"`
+
+	// "ci_server_url" field predicates.
+	CiServerURL             *string  `json:"ciServerURL,omitempty"`
+	CiServerURLNEQ          *string  `json:"ciServerURLNEQ,omitempty"`
+	CiServerURLIn           []string `json:"ciServerURLIn,omitempty"`
+	CiServerURLNotIn        []string `json:"ciServerURLNotIn,omitempty"`
+	CiServerURLGT           *string  `json:"ciServerURLGT,omitempty"`
+	CiServerURLGTE          *string  `json:"ciServerURLGTE,omitempty"`
+	CiServerURLLT           *string  `json:"ciServerURLLT,omitempty"`
+	CiServerURLLTE          *string  `json:"ciServerURLLTE,omitempty"`
+	CiServerURLContains     *string  `json:"ciServerURLContains,omitempty"`
+	CiServerURLHasPrefix    *string  `json:"ciServerURLHasPrefix,omitempty"`
+	CiServerURLHasSuffix    *string  `json:"ciServerURLHasSuffix,omitempty"`
+	CiServerURLIsNil        bool     `json:"ciServerURLIsNil,omitempty"`
+	CiServerURLNotNil       bool     `json:"ciServerURLNotNil,omitempty"`
+	CiServerURLEqualFold    *string  `json:"ciServerURLEqualFold,omitempty"`
+	CiServerURLContainsFold *string  `json:"ciServerURLContainsFold,omitempty"`
+
+	// "attestation" edge predicates.
+	HasAttestation     *bool                    `json:"hasAttestation,omitempty"`
+	HasAttestationWith []*AttestationWhereInput `json:"hasAttestationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *GitlabAttestationWhereInput) AddPredicates(predicates ...predicate.GitlabAttestation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the GitlabAttestationWhereInput filter on the GitlabAttestationQuery builder.
+func (i *GitlabAttestationWhereInput) Filter(q *GitlabAttestationQuery) (*GitlabAttestationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyGitlabAttestationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyGitlabAttestationWhereInput is returned in case the GitlabAttestationWhereInput is empty.
+var ErrEmptyGitlabAttestationWhereInput = errors.New("ent: empty predicate GitlabAttestationWhereInput")
+
+// P returns a predicate for filtering gitlabattestations.
+// An error is returned if the input is empty or invalid.
+func (i *GitlabAttestationWhereInput) P() (predicate.GitlabAttestation, error) {
+	var predicates []predicate.GitlabAttestation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, gitlabattestation.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.GitlabAttestation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, gitlabattestation.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.GitlabAttestation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, gitlabattestation.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, gitlabattestation.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, gitlabattestation.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, gitlabattestation.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, gitlabattestation.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, gitlabattestation.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, gitlabattestation.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, gitlabattestation.IDLTE(*i.IDLTE))
+	}
+	if i.CiConfigPath != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathEQ(*i.CiConfigPath))
+	}
+	if i.CiConfigPathNEQ != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathNEQ(*i.CiConfigPathNEQ))
+	}
+	if len(i.CiConfigPathIn) > 0 {
+		predicates = append(predicates, gitlabattestation.CiConfigPathIn(i.CiConfigPathIn...))
+	}
+	if len(i.CiConfigPathNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.CiConfigPathNotIn(i.CiConfigPathNotIn...))
+	}
+	if i.CiConfigPathGT != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathGT(*i.CiConfigPathGT))
+	}
+	if i.CiConfigPathGTE != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathGTE(*i.CiConfigPathGTE))
+	}
+	if i.CiConfigPathLT != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathLT(*i.CiConfigPathLT))
+	}
+	if i.CiConfigPathLTE != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathLTE(*i.CiConfigPathLTE))
+	}
+	if i.CiConfigPathContains != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathContains(*i.CiConfigPathContains))
+	}
+	if i.CiConfigPathHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathHasPrefix(*i.CiConfigPathHasPrefix))
+	}
+	if i.CiConfigPathHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathHasSuffix(*i.CiConfigPathHasSuffix))
+	}
+	if i.CiConfigPathIsNil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathIsNil())
+	}
+	if i.CiConfigPathNotNil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathNotNil())
+	}
+	if i.CiConfigPathEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathEqualFold(*i.CiConfigPathEqualFold))
+	}
+	if i.CiConfigPathContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.CiConfigPathContainsFold(*i.CiConfigPathContainsFold))
+	}
+	if i.PipelineID != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDEQ(*i.PipelineID))
+	}
+	if i.PipelineIDNEQ != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDNEQ(*i.PipelineIDNEQ))
+	}
+	if len(i.PipelineIDIn) > 0 {
+		predicates = append(predicates, gitlabattestation.PipelineIDIn(i.PipelineIDIn...))
+	}
+	if len(i.PipelineIDNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.PipelineIDNotIn(i.PipelineIDNotIn...))
+	}
+	if i.PipelineIDGT != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDGT(*i.PipelineIDGT))
+	}
+	if i.PipelineIDGTE != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDGTE(*i.PipelineIDGTE))
+	}
+	if i.PipelineIDLT != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDLT(*i.PipelineIDLT))
+	}
+	if i.PipelineIDLTE != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDLTE(*i.PipelineIDLTE))
+	}
+	if i.PipelineIDContains != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDContains(*i.PipelineIDContains))
+	}
+	if i.PipelineIDHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDHasPrefix(*i.PipelineIDHasPrefix))
+	}
+	if i.PipelineIDHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDHasSuffix(*i.PipelineIDHasSuffix))
+	}
+	if i.PipelineIDIsNil {
+		predicates = append(predicates, gitlabattestation.PipelineIDIsNil())
+	}
+	if i.PipelineIDNotNil {
+		predicates = append(predicates, gitlabattestation.PipelineIDNotNil())
+	}
+	if i.PipelineIDEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDEqualFold(*i.PipelineIDEqualFold))
+	}
+	if i.PipelineIDContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.PipelineIDContainsFold(*i.PipelineIDContainsFold))
+	}
+	if i.JobID != nil {
+		predicates = append(predicates, gitlabattestation.JobIDEQ(*i.JobID))
+	}
+	if i.JobIDNEQ != nil {
+		predicates = append(predicates, gitlabattestation.JobIDNEQ(*i.JobIDNEQ))
+	}
+	if len(i.JobIDIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobIDIn(i.JobIDIn...))
+	}
+	if len(i.JobIDNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobIDNotIn(i.JobIDNotIn...))
+	}
+	if i.JobIDGT != nil {
+		predicates = append(predicates, gitlabattestation.JobIDGT(*i.JobIDGT))
+	}
+	if i.JobIDGTE != nil {
+		predicates = append(predicates, gitlabattestation.JobIDGTE(*i.JobIDGTE))
+	}
+	if i.JobIDLT != nil {
+		predicates = append(predicates, gitlabattestation.JobIDLT(*i.JobIDLT))
+	}
+	if i.JobIDLTE != nil {
+		predicates = append(predicates, gitlabattestation.JobIDLTE(*i.JobIDLTE))
+	}
+	if i.JobIDContains != nil {
+		predicates = append(predicates, gitlabattestation.JobIDContains(*i.JobIDContains))
+	}
+	if i.JobIDHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.JobIDHasPrefix(*i.JobIDHasPrefix))
+	}
+	if i.JobIDHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.JobIDHasSuffix(*i.JobIDHasSuffix))
+	}
+	if i.JobIDIsNil {
+		predicates = append(predicates, gitlabattestation.JobIDIsNil())
+	}
+	if i.JobIDNotNil {
+		predicates = append(predicates, gitlabattestation.JobIDNotNil())
+	}
+	if i.JobIDEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.JobIDEqualFold(*i.JobIDEqualFold))
+	}
+	if i.JobIDContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.JobIDContainsFold(*i.JobIDContainsFold))
+	}
+	if i.JobImage != nil {
+		predicates = append(predicates, gitlabattestation.JobImageEQ(*i.JobImage))
+	}
+	if i.JobImageNEQ != nil {
+		predicates = append(predicates, gitlabattestation.JobImageNEQ(*i.JobImageNEQ))
+	}
+	if len(i.JobImageIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobImageIn(i.JobImageIn...))
+	}
+	if len(i.JobImageNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobImageNotIn(i.JobImageNotIn...))
+	}
+	if i.JobImageGT != nil {
+		predicates = append(predicates, gitlabattestation.JobImageGT(*i.JobImageGT))
+	}
+	if i.JobImageGTE != nil {
+		predicates = append(predicates, gitlabattestation.JobImageGTE(*i.JobImageGTE))
+	}
+	if i.JobImageLT != nil {
+		predicates = append(predicates, gitlabattestation.JobImageLT(*i.JobImageLT))
+	}
+	if i.JobImageLTE != nil {
+		predicates = append(predicates, gitlabattestation.JobImageLTE(*i.JobImageLTE))
+	}
+	if i.JobImageContains != nil {
+		predicates = append(predicates, gitlabattestation.JobImageContains(*i.JobImageContains))
+	}
+	if i.JobImageHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.JobImageHasPrefix(*i.JobImageHasPrefix))
+	}
+	if i.JobImageHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.JobImageHasSuffix(*i.JobImageHasSuffix))
+	}
+	if i.JobImageIsNil {
+		predicates = append(predicates, gitlabattestation.JobImageIsNil())
+	}
+	if i.JobImageNotNil {
+		predicates = append(predicates, gitlabattestation.JobImageNotNil())
+	}
+	if i.JobImageEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.JobImageEqualFold(*i.JobImageEqualFold))
+	}
+	if i.JobImageContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.JobImageContainsFold(*i.JobImageContainsFold))
+	}
+	if i.JobName != nil {
+		predicates = append(predicates, gitlabattestation.JobNameEQ(*i.JobName))
+	}
+	if i.JobNameNEQ != nil {
+		predicates = append(predicates, gitlabattestation.JobNameNEQ(*i.JobNameNEQ))
+	}
+	if len(i.JobNameIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobNameIn(i.JobNameIn...))
+	}
+	if len(i.JobNameNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobNameNotIn(i.JobNameNotIn...))
+	}
+	if i.JobNameGT != nil {
+		predicates = append(predicates, gitlabattestation.JobNameGT(*i.JobNameGT))
+	}
+	if i.JobNameGTE != nil {
+		predicates = append(predicates, gitlabattestation.JobNameGTE(*i.JobNameGTE))
+	}
+	if i.JobNameLT != nil {
+		predicates = append(predicates, gitlabattestation.JobNameLT(*i.JobNameLT))
+	}
+	if i.JobNameLTE != nil {
+		predicates = append(predicates, gitlabattestation.JobNameLTE(*i.JobNameLTE))
+	}
+	if i.JobNameContains != nil {
+		predicates = append(predicates, gitlabattestation.JobNameContains(*i.JobNameContains))
+	}
+	if i.JobNameHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.JobNameHasPrefix(*i.JobNameHasPrefix))
+	}
+	if i.JobNameHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.JobNameHasSuffix(*i.JobNameHasSuffix))
+	}
+	if i.JobNameIsNil {
+		predicates = append(predicates, gitlabattestation.JobNameIsNil())
+	}
+	if i.JobNameNotNil {
+		predicates = append(predicates, gitlabattestation.JobNameNotNil())
+	}
+	if i.JobNameEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.JobNameEqualFold(*i.JobNameEqualFold))
+	}
+	if i.JobNameContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.JobNameContainsFold(*i.JobNameContainsFold))
+	}
+	if i.JobStage != nil {
+		predicates = append(predicates, gitlabattestation.JobStageEQ(*i.JobStage))
+	}
+	if i.JobStageNEQ != nil {
+		predicates = append(predicates, gitlabattestation.JobStageNEQ(*i.JobStageNEQ))
+	}
+	if len(i.JobStageIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobStageIn(i.JobStageIn...))
+	}
+	if len(i.JobStageNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobStageNotIn(i.JobStageNotIn...))
+	}
+	if i.JobStageGT != nil {
+		predicates = append(predicates, gitlabattestation.JobStageGT(*i.JobStageGT))
+	}
+	if i.JobStageGTE != nil {
+		predicates = append(predicates, gitlabattestation.JobStageGTE(*i.JobStageGTE))
+	}
+	if i.JobStageLT != nil {
+		predicates = append(predicates, gitlabattestation.JobStageLT(*i.JobStageLT))
+	}
+	if i.JobStageLTE != nil {
+		predicates = append(predicates, gitlabattestation.JobStageLTE(*i.JobStageLTE))
+	}
+	if i.JobStageContains != nil {
+		predicates = append(predicates, gitlabattestation.JobStageContains(*i.JobStageContains))
+	}
+	if i.JobStageHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.JobStageHasPrefix(*i.JobStageHasPrefix))
+	}
+	if i.JobStageHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.JobStageHasSuffix(*i.JobStageHasSuffix))
+	}
+	if i.JobStageIsNil {
+		predicates = append(predicates, gitlabattestation.JobStageIsNil())
+	}
+	if i.JobStageNotNil {
+		predicates = append(predicates, gitlabattestation.JobStageNotNil())
+	}
+	if i.JobStageEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.JobStageEqualFold(*i.JobStageEqualFold))
+	}
+	if i.JobStageContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.JobStageContainsFold(*i.JobStageContainsFold))
+	}
+	if i.JobURL != nil {
+		predicates = append(predicates, gitlabattestation.JobURLEQ(*i.JobURL))
+	}
+	if i.JobURLNEQ != nil {
+		predicates = append(predicates, gitlabattestation.JobURLNEQ(*i.JobURLNEQ))
+	}
+	if len(i.JobURLIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobURLIn(i.JobURLIn...))
+	}
+	if len(i.JobURLNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.JobURLNotIn(i.JobURLNotIn...))
+	}
+	if i.JobURLGT != nil {
+		predicates = append(predicates, gitlabattestation.JobURLGT(*i.JobURLGT))
+	}
+	if i.JobURLGTE != nil {
+		predicates = append(predicates, gitlabattestation.JobURLGTE(*i.JobURLGTE))
+	}
+	if i.JobURLLT != nil {
+		predicates = append(predicates, gitlabattestation.JobURLLT(*i.JobURLLT))
+	}
+	if i.JobURLLTE != nil {
+		predicates = append(predicates, gitlabattestation.JobURLLTE(*i.JobURLLTE))
+	}
+	if i.JobURLContains != nil {
+		predicates = append(predicates, gitlabattestation.JobURLContains(*i.JobURLContains))
+	}
+	if i.JobURLHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.JobURLHasPrefix(*i.JobURLHasPrefix))
+	}
+	if i.JobURLHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.JobURLHasSuffix(*i.JobURLHasSuffix))
+	}
+	if i.JobURLIsNil {
+		predicates = append(predicates, gitlabattestation.JobURLIsNil())
+	}
+	if i.JobURLNotNil {
+		predicates = append(predicates, gitlabattestation.JobURLNotNil())
+	}
+	if i.JobURLEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.JobURLEqualFold(*i.JobURLEqualFold))
+	}
+	if i.JobURLContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.JobURLContainsFold(*i.JobURLContainsFold))
+	}
+	if i.PipelineURL != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLEQ(*i.PipelineURL))
+	}
+	if i.PipelineURLNEQ != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLNEQ(*i.PipelineURLNEQ))
+	}
+	if len(i.PipelineURLIn) > 0 {
+		predicates = append(predicates, gitlabattestation.PipelineURLIn(i.PipelineURLIn...))
+	}
+	if len(i.PipelineURLNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.PipelineURLNotIn(i.PipelineURLNotIn...))
+	}
+	if i.PipelineURLGT != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLGT(*i.PipelineURLGT))
+	}
+	if i.PipelineURLGTE != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLGTE(*i.PipelineURLGTE))
+	}
+	if i.PipelineURLLT != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLLT(*i.PipelineURLLT))
+	}
+	if i.PipelineURLLTE != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLLTE(*i.PipelineURLLTE))
+	}
+	if i.PipelineURLContains != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLContains(*i.PipelineURLContains))
+	}
+	if i.PipelineURLHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLHasPrefix(*i.PipelineURLHasPrefix))
+	}
+	if i.PipelineURLHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLHasSuffix(*i.PipelineURLHasSuffix))
+	}
+	if i.PipelineURLIsNil {
+		predicates = append(predicates, gitlabattestation.PipelineURLIsNil())
+	}
+	if i.PipelineURLNotNil {
+		predicates = append(predicates, gitlabattestation.PipelineURLNotNil())
+	}
+	if i.PipelineURLEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLEqualFold(*i.PipelineURLEqualFold))
+	}
+	if i.PipelineURLContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.PipelineURLContainsFold(*i.PipelineURLContainsFold))
+	}
+	if i.ProjectID != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDEQ(*i.ProjectID))
+	}
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDNEQ(*i.ProjectIDNEQ))
+	}
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, gitlabattestation.ProjectIDIn(i.ProjectIDIn...))
+	}
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.ProjectIDNotIn(i.ProjectIDNotIn...))
+	}
+	if i.ProjectIDGT != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDGT(*i.ProjectIDGT))
+	}
+	if i.ProjectIDGTE != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDGTE(*i.ProjectIDGTE))
+	}
+	if i.ProjectIDLT != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDLT(*i.ProjectIDLT))
+	}
+	if i.ProjectIDLTE != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDLTE(*i.ProjectIDLTE))
+	}
+	if i.ProjectIDContains != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDContains(*i.ProjectIDContains))
+	}
+	if i.ProjectIDHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDHasPrefix(*i.ProjectIDHasPrefix))
+	}
+	if i.ProjectIDHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDHasSuffix(*i.ProjectIDHasSuffix))
+	}
+	if i.ProjectIDIsNil {
+		predicates = append(predicates, gitlabattestation.ProjectIDIsNil())
+	}
+	if i.ProjectIDNotNil {
+		predicates = append(predicates, gitlabattestation.ProjectIDNotNil())
+	}
+	if i.ProjectIDEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDEqualFold(*i.ProjectIDEqualFold))
+	}
+	if i.ProjectIDContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.ProjectIDContainsFold(*i.ProjectIDContainsFold))
+	}
+	if i.ProjectURL != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLEQ(*i.ProjectURL))
+	}
+	if i.ProjectURLNEQ != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLNEQ(*i.ProjectURLNEQ))
+	}
+	if len(i.ProjectURLIn) > 0 {
+		predicates = append(predicates, gitlabattestation.ProjectURLIn(i.ProjectURLIn...))
+	}
+	if len(i.ProjectURLNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.ProjectURLNotIn(i.ProjectURLNotIn...))
+	}
+	if i.ProjectURLGT != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLGT(*i.ProjectURLGT))
+	}
+	if i.ProjectURLGTE != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLGTE(*i.ProjectURLGTE))
+	}
+	if i.ProjectURLLT != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLLT(*i.ProjectURLLT))
+	}
+	if i.ProjectURLLTE != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLLTE(*i.ProjectURLLTE))
+	}
+	if i.ProjectURLContains != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLContains(*i.ProjectURLContains))
+	}
+	if i.ProjectURLHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLHasPrefix(*i.ProjectURLHasPrefix))
+	}
+	if i.ProjectURLHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLHasSuffix(*i.ProjectURLHasSuffix))
+	}
+	if i.ProjectURLIsNil {
+		predicates = append(predicates, gitlabattestation.ProjectURLIsNil())
+	}
+	if i.ProjectURLNotNil {
+		predicates = append(predicates, gitlabattestation.ProjectURLNotNil())
+	}
+	if i.ProjectURLEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLEqualFold(*i.ProjectURLEqualFold))
+	}
+	if i.ProjectURLContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.ProjectURLContainsFold(*i.ProjectURLContainsFold))
+	}
+	if i.RunnerID != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDEQ(*i.RunnerID))
+	}
+	if i.RunnerIDNEQ != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDNEQ(*i.RunnerIDNEQ))
+	}
+	if len(i.RunnerIDIn) > 0 {
+		predicates = append(predicates, gitlabattestation.RunnerIDIn(i.RunnerIDIn...))
+	}
+	if len(i.RunnerIDNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.RunnerIDNotIn(i.RunnerIDNotIn...))
+	}
+	if i.RunnerIDGT != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDGT(*i.RunnerIDGT))
+	}
+	if i.RunnerIDGTE != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDGTE(*i.RunnerIDGTE))
+	}
+	if i.RunnerIDLT != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDLT(*i.RunnerIDLT))
+	}
+	if i.RunnerIDLTE != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDLTE(*i.RunnerIDLTE))
+	}
+	if i.RunnerIDContains != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDContains(*i.RunnerIDContains))
+	}
+	if i.RunnerIDHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDHasPrefix(*i.RunnerIDHasPrefix))
+	}
+	if i.RunnerIDHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDHasSuffix(*i.RunnerIDHasSuffix))
+	}
+	if i.RunnerIDIsNil {
+		predicates = append(predicates, gitlabattestation.RunnerIDIsNil())
+	}
+	if i.RunnerIDNotNil {
+		predicates = append(predicates, gitlabattestation.RunnerIDNotNil())
+	}
+	if i.RunnerIDEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDEqualFold(*i.RunnerIDEqualFold))
+	}
+	if i.RunnerIDContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.RunnerIDContainsFold(*i.RunnerIDContainsFold))
+	}
+	if i.CiHost != nil {
+		predicates = append(predicates, gitlabattestation.CiHostEQ(*i.CiHost))
+	}
+	if i.CiHostNEQ != nil {
+		predicates = append(predicates, gitlabattestation.CiHostNEQ(*i.CiHostNEQ))
+	}
+	if len(i.CiHostIn) > 0 {
+		predicates = append(predicates, gitlabattestation.CiHostIn(i.CiHostIn...))
+	}
+	if len(i.CiHostNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.CiHostNotIn(i.CiHostNotIn...))
+	}
+	if i.CiHostGT != nil {
+		predicates = append(predicates, gitlabattestation.CiHostGT(*i.CiHostGT))
+	}
+	if i.CiHostGTE != nil {
+		predicates = append(predicates, gitlabattestation.CiHostGTE(*i.CiHostGTE))
+	}
+	if i.CiHostLT != nil {
+		predicates = append(predicates, gitlabattestation.CiHostLT(*i.CiHostLT))
+	}
+	if i.CiHostLTE != nil {
+		predicates = append(predicates, gitlabattestation.CiHostLTE(*i.CiHostLTE))
+	}
+	if i.CiHostContains != nil {
+		predicates = append(predicates, gitlabattestation.CiHostContains(*i.CiHostContains))
+	}
+	if i.CiHostHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.CiHostHasPrefix(*i.CiHostHasPrefix))
+	}
+	if i.CiHostHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.CiHostHasSuffix(*i.CiHostHasSuffix))
+	}
+	if i.CiHostIsNil {
+		predicates = append(predicates, gitlabattestation.CiHostIsNil())
+	}
+	if i.CiHostNotNil {
+		predicates = append(predicates, gitlabattestation.CiHostNotNil())
+	}
+	if i.CiHostEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.CiHostEqualFold(*i.CiHostEqualFold))
+	}
+	if i.CiHostContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.CiHostContainsFold(*i.CiHostContainsFold))
+	}
+	if i.CiServerURL != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLEQ(*i.CiServerURL))
+	}
+	if i.CiServerURLNEQ != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLNEQ(*i.CiServerURLNEQ))
+	}
+	if len(i.CiServerURLIn) > 0 {
+		predicates = append(predicates, gitlabattestation.CiServerURLIn(i.CiServerURLIn...))
+	}
+	if len(i.CiServerURLNotIn) > 0 {
+		predicates = append(predicates, gitlabattestation.CiServerURLNotIn(i.CiServerURLNotIn...))
+	}
+	if i.CiServerURLGT != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLGT(*i.CiServerURLGT))
+	}
+	if i.CiServerURLGTE != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLGTE(*i.CiServerURLGTE))
+	}
+	if i.CiServerURLLT != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLLT(*i.CiServerURLLT))
+	}
+	if i.CiServerURLLTE != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLLTE(*i.CiServerURLLTE))
+	}
+	if i.CiServerURLContains != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLContains(*i.CiServerURLContains))
+	}
+	if i.CiServerURLHasPrefix != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLHasPrefix(*i.CiServerURLHasPrefix))
+	}
+	if i.CiServerURLHasSuffix != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLHasSuffix(*i.CiServerURLHasSuffix))
+	}
+	if i.CiServerURLIsNil {
+		predicates = append(predicates, gitlabattestation.CiServerURLIsNil())
+	}
+	if i.CiServerURLNotNil {
+		predicates = append(predicates, gitlabattestation.CiServerURLNotNil())
+	}
+	if i.CiServerURLEqualFold != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLEqualFold(*i.CiServerURLEqualFold))
+	}
+	if i.CiServerURLContainsFold != nil {
+		predicates = append(predicates, gitlabattestation.CiServerURLContainsFold(*i.CiServerURLContainsFold))
+	}
+
+	if i.HasAttestation != nil {
+		p := gitlabattestation.HasAttestation()
+		if !*i.HasAttestation {
+			p = gitlabattestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAttestationWith) > 0 {
+		with := make([]predicate.Attestation, 0, len(i.HasAttestationWith))
+		for _, w := range i.HasAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, gitlabattestation.HasAttestationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyGitlabAttestationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return gitlabattestation.And(predicates...), nil
+	}
+}
+
+// LegalHoldWhereInput represents a where input for filtering LegalHold queries.
+type LegalHoldWhereInput struct {
+	Predicates []predicate.LegalHold  `json:"-"`
+	Not        *LegalHoldWhereInput   `json:"not,omitempty"`
+	Or         []*LegalHoldWhereInput `json:"or,omitempty"`
+	And        []*LegalHoldWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "reason" field predicates.
+	Reason             *string  `json:"reason,omitempty"`
+	ReasonNEQ          *string  `json:"reasonNEQ,omitempty"`
+	ReasonIn           []string `json:"reasonIn,omitempty"`
+	ReasonNotIn        []string `json:"reasonNotIn,omitempty"`
+	ReasonGT           *string  `json:"reasonGT,omitempty"`
+	ReasonGTE          *string  `json:"reasonGTE,omitempty"`
+	ReasonLT           *string  `json:"reasonLT,omitempty"`
+	ReasonLTE          *string  `json:"reasonLTE,omitempty"`
+	ReasonContains     *string  `json:"reasonContains,omitempty"`
+	ReasonHasPrefix    *string  `json:"reasonHasPrefix,omitempty"`
+	ReasonHasSuffix    *string  `json:"reasonHasSuffix,omitempty"`
+	ReasonEqualFold    *string  `json:"reasonEqualFold,omitempty"`
+	ReasonContainsFold *string  `json:"reasonContainsFold,omitempty"`
+
+	// "subject_digest" field predicates.
+	SubjectDigest             *string  `json:"subjectDigest,omitempty"`
+	SubjectDigestNEQ          *string  `json:"subjectDigestNEQ,omitempty"`
+	SubjectDigestIn           []string `json:"subjectDigestIn,omitempty"`
+	SubjectDigestNotIn        []string `json:"subjectDigestNotIn,omitempty"`
+	SubjectDigestGT           *string  `json:"subjectDigestGT,omitempty"`
+	SubjectDigestGTE          *string  `json:"subjectDigestGTE,omitempty"`
+	SubjectDigestLT           *string  `json:"subjectDigestLT,omitempty"`
+	SubjectDigestLTE          *string  `json:"subjectDigestLTE,omitempty"`
+	SubjectDigestContains     *string  `json:"subjectDigestContains,omitempty"`
+	SubjectDigestHasPrefix    *string  `json:"subjectDigestHasPrefix,omitempty"`
+	SubjectDigestHasSuffix    *string  `json:"subjectDigestHasSuffix,omitempty"`
+	SubjectDigestIsNil        bool     `json:"subjectDigestIsNil,omitempty"`
+	SubjectDigestNotNil       bool     `json:"subjectDigestNotNil,omitempty"`
+	SubjectDigestEqualFold    *string  `json:"subjectDigestEqualFold,omitempty"`
+	SubjectDigestContainsFold *string  `json:"subjectDigestContainsFold,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "released_by" field predicates.
+	ReleasedBy             *string  `json:"releasedBy,omitempty"`
+	ReleasedByNEQ          *string  `json:"releasedByNEQ,omitempty"`
+	ReleasedByIn           []string `json:"releasedByIn,omitempty"`
+	ReleasedByNotIn        []string `json:"releasedByNotIn,omitempty"`
+	ReleasedByGT           *string  `json:"releasedByGT,omitempty"`
+	ReleasedByGTE          *string  `json:"releasedByGTE,omitempty"`
+	ReleasedByLT           *string  `json:"releasedByLT,omitempty"`
+	ReleasedByLTE          *string  `json:"releasedByLTE,omitempty"`
+	ReleasedByContains     *string  `json:"releasedByContains,omitempty"`
+	ReleasedByHasPrefix    *string  `json:"releasedByHasPrefix,omitempty"`
+	ReleasedByHasSuffix    *string  `json:"releasedByHasSuffix,omitempty"`
+	ReleasedByIsNil        bool     `json:"releasedByIsNil,omitempty"`
+	ReleasedByNotNil       bool     `json:"releasedByNotNil,omitempty"`
+	ReleasedByEqualFold    *string  `json:"releasedByEqualFold,omitempty"`
+	ReleasedByContainsFold *string  `json:"releasedByContainsFold,omitempty"`
+
+	// "released_at" field predicates.
+	ReleasedAt       *time.Time  `json:"releasedAt,omitempty"`
+	ReleasedAtNEQ    *time.Time  `json:"releasedAtNEQ,omitempty"`
+	ReleasedAtIn     []time.Time `json:"releasedAtIn,omitempty"`
+	ReleasedAtNotIn  []time.Time `json:"releasedAtNotIn,omitempty"`
+	ReleasedAtGT     *time.Time  `json:"releasedAtGT,omitempty"`
+	ReleasedAtGTE    *time.Time  `json:"releasedAtGTE,omitempty"`
+	ReleasedAtLT     *time.Time  `json:"releasedAtLT,omitempty"`
+	ReleasedAtLTE    *time.Time  `json:"releasedAtLTE,omitempty"`
+	ReleasedAtIsNil  bool        `json:"releasedAtIsNil,omitempty"`
+	ReleasedAtNotNil bool        `json:"releasedAtNotNil,omitempty"`
+
+	// "dsses" edge predicates.
+	HasDsses     *bool             `json:"hasDsses,omitempty"`
+	HasDssesWith []*DsseWhereInput `json:"hasDssesWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *LegalHoldWhereInput) AddPredicates(predicates ...predicate.LegalHold) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the LegalHoldWhereInput filter on the LegalHoldQuery builder.
+func (i *LegalHoldWhereInput) Filter(q *LegalHoldQuery) (*LegalHoldQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyLegalHoldWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyLegalHoldWhereInput is returned in case the LegalHoldWhereInput is empty.
+var ErrEmptyLegalHoldWhereInput = errors.New("ent: empty predicate LegalHoldWhereInput")
+
+// P returns a predicate for filtering legalholds.
+// An error is returned if the input is empty or invalid.
+func (i *LegalHoldWhereInput) P() (predicate.LegalHold, error) {
+	var predicates []predicate.LegalHold
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, legalhold.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.LegalHold, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, legalhold.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.LegalHold, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, legalhold.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, legalhold.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, legalhold.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, legalhold.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, legalhold.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, legalhold.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, legalhold.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, legalhold.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, legalhold.IDLTE(*i.IDLTE))
+	}
+	if i.Reason != nil {
+		predicates = append(predicates, legalhold.ReasonEQ(*i.Reason))
+	}
+	if i.ReasonNEQ != nil {
+		predicates = append(predicates, legalhold.ReasonNEQ(*i.ReasonNEQ))
+	}
+	if len(i.ReasonIn) > 0 {
+		predicates = append(predicates, legalhold.ReasonIn(i.ReasonIn...))
+	}
+	if len(i.ReasonNotIn) > 0 {
+		predicates = append(predicates, legalhold.ReasonNotIn(i.ReasonNotIn...))
+	}
+	if i.ReasonGT != nil {
+		predicates = append(predicates, legalhold.ReasonGT(*i.ReasonGT))
+	}
+	if i.ReasonGTE != nil {
+		predicates = append(predicates, legalhold.ReasonGTE(*i.ReasonGTE))
+	}
+	if i.ReasonLT != nil {
+		predicates = append(predicates, legalhold.ReasonLT(*i.ReasonLT))
+	}
+	if i.ReasonLTE != nil {
+		predicates = append(predicates, legalhold.ReasonLTE(*i.ReasonLTE))
+	}
+	if i.ReasonContains != nil {
+		predicates = append(predicates, legalhold.ReasonContains(*i.ReasonContains))
+	}
+	if i.ReasonHasPrefix != nil {
+		predicates = append(predicates, legalhold.ReasonHasPrefix(*i.ReasonHasPrefix))
+	}
+	if i.ReasonHasSuffix != nil {
+		predicates = append(predicates, legalhold.ReasonHasSuffix(*i.ReasonHasSuffix))
+	}
+	if i.ReasonEqualFold != nil {
+		predicates = append(predicates, legalhold.ReasonEqualFold(*i.ReasonEqualFold))
+	}
+	if i.ReasonContainsFold != nil {
+		predicates = append(predicates, legalhold.ReasonContainsFold(*i.ReasonContainsFold))
+	}
+	if i.SubjectDigest != nil {
+		predicates = append(predicates, legalhold.SubjectDigestEQ(*i.SubjectDigest))
+	}
+	if i.SubjectDigestNEQ != nil {
+		predicates = append(predicates, legalhold.SubjectDigestNEQ(*i.SubjectDigestNEQ))
+	}
+	if len(i.SubjectDigestIn) > 0 {
+		predicates = append(predicates, legalhold.SubjectDigestIn(i.SubjectDigestIn...))
+	}
+	if len(i.SubjectDigestNotIn) > 0 {
+		predicates = append(predicates, legalhold.SubjectDigestNotIn(i.SubjectDigestNotIn...))
+	}
+	if i.SubjectDigestGT != nil {
+		predicates = append(predicates, legalhold.SubjectDigestGT(*i.SubjectDigestGT))
+	}
+	if i.SubjectDigestGTE != nil {
+		predicates = append(predicates, legalhold.SubjectDigestGTE(*i.SubjectDigestGTE))
+	}
+	if i.SubjectDigestLT != nil {
+		predicates = append(predicates, legalhold.SubjectDigestLT(*i.SubjectDigestLT))
+	}
+	if i.SubjectDigestLTE != nil {
+		predicates = append(predicates, legalhold.SubjectDigestLTE(*i.SubjectDigestLTE))
+	}
+	if i.SubjectDigestContains != nil {
+		predicates = append(predicates, legalhold.SubjectDigestContains(*i.SubjectDigestContains))
+	}
+	if i.SubjectDigestHasPrefix != nil {
+		predicates = append(predicates, legalhold.SubjectDigestHasPrefix(*i.SubjectDigestHasPrefix))
+	}
+	if i.SubjectDigestHasSuffix != nil {
+		predicates = append(predicates, legalhold.SubjectDigestHasSuffix(*i.SubjectDigestHasSuffix))
+	}
+	if i.SubjectDigestIsNil {
+		predicates = append(predicates, legalhold.SubjectDigestIsNil())
+	}
+	if i.SubjectDigestNotNil {
+		predicates = append(predicates, legalhold.SubjectDigestNotNil())
+	}
+	if i.SubjectDigestEqualFold != nil {
+		predicates = append(predicates, legalhold.SubjectDigestEqualFold(*i.SubjectDigestEqualFold))
+	}
+	if i.SubjectDigestContainsFold != nil {
+		predicates = append(predicates, legalhold.SubjectDigestContainsFold(*i.SubjectDigestContainsFold))
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, legalhold.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, legalhold.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, legalhold.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, legalhold.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, legalhold.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, legalhold.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, legalhold.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, legalhold.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, legalhold.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, legalhold.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, legalhold.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByIsNil {
+		predicates = append(predicates, legalhold.CreatedByIsNil())
+	}
+	if i.CreatedByNotNil {
+		predicates = append(predicates, legalhold.CreatedByNotNil())
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, legalhold.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, legalhold.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, legalhold.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, legalhold.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, legalhold.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, legalhold.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, legalhold.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, legalhold.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, legalhold.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, legalhold.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.ReleasedBy != nil {
+		predicates = append(predicates, legalhold.ReleasedByEQ(*i.ReleasedBy))
+	}
+	if i.ReleasedByNEQ != nil {
+		predicates = append(predicates, legalhold.ReleasedByNEQ(*i.ReleasedByNEQ))
+	}
+	if len(i.ReleasedByIn) > 0 {
+		predicates = append(predicates, legalhold.ReleasedByIn(i.ReleasedByIn...))
+	}
+	if len(i.ReleasedByNotIn) > 0 {
+		predicates = append(predicates, legalhold.ReleasedByNotIn(i.ReleasedByNotIn...))
+	}
+	if i.ReleasedByGT != nil {
+		predicates = append(predicates, legalhold.ReleasedByGT(*i.ReleasedByGT))
+	}
+	if i.ReleasedByGTE != nil {
+		predicates = append(predicates, legalhold.ReleasedByGTE(*i.ReleasedByGTE))
+	}
+	if i.ReleasedByLT != nil {
+		predicates = append(predicates, legalhold.ReleasedByLT(*i.ReleasedByLT))
+	}
+	if i.ReleasedByLTE != nil {
+		predicates = append(predicates, legalhold.ReleasedByLTE(*i.ReleasedByLTE))
+	}
+	if i.ReleasedByContains != nil {
+		predicates = append(predicates, legalhold.ReleasedByContains(*i.ReleasedByContains))
+	}
+	if i.ReleasedByHasPrefix != nil {
+		predicates = append(predicates, legalhold.ReleasedByHasPrefix(*i.ReleasedByHasPrefix))
+	}
+	if i.ReleasedByHasSuffix != nil {
+		predicates = append(predicates, legalhold.ReleasedByHasSuffix(*i.ReleasedByHasSuffix))
+	}
+	if i.ReleasedByIsNil {
+		predicates = append(predicates, legalhold.ReleasedByIsNil())
+	}
+	if i.ReleasedByNotNil {
+		predicates = append(predicates, legalhold.ReleasedByNotNil())
+	}
+	if i.ReleasedByEqualFold != nil {
+		predicates = append(predicates, legalhold.ReleasedByEqualFold(*i.ReleasedByEqualFold))
+	}
+	if i.ReleasedByContainsFold != nil {
+		predicates = append(predicates, legalhold.ReleasedByContainsFold(*i.ReleasedByContainsFold))
+	}
+	if i.ReleasedAt != nil {
+		predicates = append(predicates, legalhold.ReleasedAtEQ(*i.ReleasedAt))
+	}
+	if i.ReleasedAtNEQ != nil {
+		predicates = append(predicates, legalhold.ReleasedAtNEQ(*i.ReleasedAtNEQ))
+	}
+	if len(i.ReleasedAtIn) > 0 {
+		predicates = append(predicates, legalhold.ReleasedAtIn(i.ReleasedAtIn...))
+	}
+	if len(i.ReleasedAtNotIn) > 0 {
+		predicates = append(predicates, legalhold.ReleasedAtNotIn(i.ReleasedAtNotIn...))
+	}
+	if i.ReleasedAtGT != nil {
+		predicates = append(predicates, legalhold.ReleasedAtGT(*i.ReleasedAtGT))
+	}
+	if i.ReleasedAtGTE != nil {
+		predicates = append(predicates, legalhold.ReleasedAtGTE(*i.ReleasedAtGTE))
+	}
+	if i.ReleasedAtLT != nil {
+		predicates = append(predicates, legalhold.ReleasedAtLT(*i.ReleasedAtLT))
+	}
+	if i.ReleasedAtLTE != nil {
+		predicates = append(predicates, legalhold.ReleasedAtLTE(*i.ReleasedAtLTE))
+	}
+	if i.ReleasedAtIsNil {
+		predicates = append(predicates, legalhold.ReleasedAtIsNil())
+	}
+	if i.ReleasedAtNotNil {
+		predicates = append(predicates, legalhold.ReleasedAtNotNil())
+	}
+
+	if i.HasDsses != nil {
+		p := legalhold.HasDsses()
+		if !*i.HasDsses {
+			p = legalhold.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDssesWith) > 0 {
+		with := make([]predicate.Dsse, 0, len(i.HasDssesWith))
+		for _, w := range i.HasDssesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDssesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, legalhold.HasDssesWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyLegalHoldWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return legalhold.And(predicates...), nil
+	}
+}
+
+// MaterialWhereInput represents a where input for filtering Material queries.
+type MaterialWhereInput struct {
+	Predicates []predicate.Material  `json:"-"`
+	Not        *MaterialWhereInput   `json:"not,omitempty"`
+	Or         []*MaterialWhereInput `json:"or,omitempty"`
+	And        []*MaterialWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "path" field predicates.
+	Path             *string  `json:"path,omitempty"`
+	PathNEQ          *string  `json:"pathNEQ,omitempty"`
+	PathIn           []string `json:"pathIn,omitempty"`
+	PathNotIn        []string `json:"pathNotIn,omitempty"`
+	PathGT           *string  `json:"pathGT,omitempty"`
+	PathGTE          *string  `json:"pathGTE,omitempty"`
+	PathLT           *string  `json:"pathLT,omitempty"`
+	PathLTE          *string  `json:"pathLTE,omitempty"`
+	PathContains     *string  `json:"pathContains,omitempty"`
+	PathHasPrefix    *string  `json:"pathHasPrefix,omitempty"`
+	PathHasSuffix    *string  `json:"pathHasSuffix,omitempty"`
+	PathEqualFold    *string  `json:"pathEqualFold,omitempty"`
+	PathContainsFold *string  `json:"pathContainsFold,omitempty"`
+
+	// "algorithm" field predicates.
+	Algorithm             *string  `json:"algorithm,omitempty"`
+	AlgorithmNEQ          *string  `json:"algorithmNEQ,omitempty"`
+	AlgorithmIn           []string `json:"algorithmIn,omitempty"`
+	AlgorithmNotIn        []string `json:"algorithmNotIn,omitempty"`
+	AlgorithmGT           *string  `json:"algorithmGT,omitempty"`
+	AlgorithmGTE          *string  `json:"algorithmGTE,omitempty"`
+	AlgorithmLT           *string  `json:"algorithmLT,omitempty"`
+	AlgorithmLTE          *string  `json:"algorithmLTE,omitempty"`
+	AlgorithmContains     *string  `json:"algorithmContains,omitempty"`
+	AlgorithmHasPrefix    *string  `json:"algorithmHasPrefix,omitempty"`
+	AlgorithmHasSuffix    *string  `json:"algorithmHasSuffix,omitempty"`
+	AlgorithmEqualFold    *string  `json:"algorithmEqualFold,omitempty"`
+	AlgorithmContainsFold *string  `json:"algorithmContainsFold,omitempty"`
+
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+
+	// "attestation" edge predicates.
+	HasAttestation     *bool                    `json:"hasAttestation,omitempty"`
+	HasAttestationWith []*AttestationWhereInput `json:"hasAttestationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *MaterialWhereInput) AddPredicates(predicates ...predicate.Material) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the MaterialWhereInput filter on the MaterialQuery builder.
+func (i *MaterialWhereInput) Filter(q *MaterialQuery) (*MaterialQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyMaterialWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyMaterialWhereInput is returned in case the MaterialWhereInput is empty.
+var ErrEmptyMaterialWhereInput = errors.New("ent: empty predicate MaterialWhereInput")
+
+// P returns a predicate for filtering materials.
+// An error is returned if the input is empty or invalid.
+func (i *MaterialWhereInput) P() (predicate.Material, error) {
+	var predicates []predicate.Material
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, material.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Material, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, material.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Material, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, material.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, material.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, material.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, material.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, material.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, material.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, material.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, material.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, material.IDLTE(*i.IDLTE))
+	}
+	if i.Path != nil {
+		predicates = append(predicates, material.PathEQ(*i.Path))
+	}
+	if i.PathNEQ != nil {
+		predicates = append(predicates, material.PathNEQ(*i.PathNEQ))
+	}
+	if len(i.PathIn) > 0 {
+		predicates = append(predicates, material.PathIn(i.PathIn...))
+	}
+	if len(i.PathNotIn) > 0 {
+		predicates = append(predicates, material.PathNotIn(i.PathNotIn...))
+	}
+	if i.PathGT != nil {
+		predicates = append(predicates, material.PathGT(*i.PathGT))
+	}
+	if i.PathGTE != nil {
+		predicates = append(predicates, material.PathGTE(*i.PathGTE))
+	}
+	if i.PathLT != nil {
+		predicates = append(predicates, material.PathLT(*i.PathLT))
+	}
+	if i.PathLTE != nil {
+		predicates = append(predicates, material.PathLTE(*i.PathLTE))
+	}
+	if i.PathContains != nil {
+		predicates = append(predicates, material.PathContains(*i.PathContains))
+	}
+	if i.PathHasPrefix != nil {
+		predicates = append(predicates, material.PathHasPrefix(*i.PathHasPrefix))
+	}
+	if i.PathHasSuffix != nil {
+		predicates = append(predicates, material.PathHasSuffix(*i.PathHasSuffix))
+	}
+	if i.PathEqualFold != nil {
+		predicates = append(predicates, material.PathEqualFold(*i.PathEqualFold))
+	}
+	if i.PathContainsFold != nil {
+		predicates = append(predicates, material.PathContainsFold(*i.PathContainsFold))
+	}
+	if i.Algorithm != nil {
+		predicates = append(predicates, material.AlgorithmEQ(*i.Algorithm))
+	}
+	if i.AlgorithmNEQ != nil {
+		predicates = append(predicates, material.AlgorithmNEQ(*i.AlgorithmNEQ))
+	}
+	if len(i.AlgorithmIn) > 0 {
+		predicates = append(predicates, material.AlgorithmIn(i.AlgorithmIn...))
+	}
+	if len(i.AlgorithmNotIn) > 0 {
+		predicates = append(predicates, material.AlgorithmNotIn(i.AlgorithmNotIn...))
+	}
+	if i.AlgorithmGT != nil {
+		predicates = append(predicates, material.AlgorithmGT(*i.AlgorithmGT))
+	}
+	if i.AlgorithmGTE != nil {
+		predicates = append(predicates, material.AlgorithmGTE(*i.AlgorithmGTE))
+	}
+	if i.AlgorithmLT != nil {
+		predicates = append(predicates, material.AlgorithmLT(*i.AlgorithmLT))
+	}
+	if i.AlgorithmLTE != nil {
+		predicates = append(predicates, material.AlgorithmLTE(*i.AlgorithmLTE))
+	}
+	if i.AlgorithmContains != nil {
+		predicates = append(predicates, material.AlgorithmContains(*i.AlgorithmContains))
+	}
+	if i.AlgorithmHasPrefix != nil {
+		predicates = append(predicates, material.AlgorithmHasPrefix(*i.AlgorithmHasPrefix))
+	}
+	if i.AlgorithmHasSuffix != nil {
+		predicates = append(predicates, material.AlgorithmHasSuffix(*i.AlgorithmHasSuffix))
+	}
+	if i.AlgorithmEqualFold != nil {
+		predicates = append(predicates, material.AlgorithmEqualFold(*i.AlgorithmEqualFold))
+	}
+	if i.AlgorithmContainsFold != nil {
+		predicates = append(predicates, material.AlgorithmContainsFold(*i.AlgorithmContainsFold))
+	}
+	if i.Value != nil {
+		predicates = append(predicates, material.ValueEQ(*i.Value))
+	}
+	if i.ValueNEQ != nil {
+		predicates = append(predicates, material.ValueNEQ(*i.ValueNEQ))
+	}
+	if len(i.ValueIn) > 0 {
+		predicates = append(predicates, material.ValueIn(i.ValueIn...))
+	}
+	if len(i.ValueNotIn) > 0 {
+		predicates = append(predicates, material.ValueNotIn(i.ValueNotIn...))
+	}
+	if i.ValueGT != nil {
+		predicates = append(predicates, material.ValueGT(*i.ValueGT))
+	}
+	if i.ValueGTE != nil {
+		predicates = append(predicates, material.ValueGTE(*i.ValueGTE))
+	}
+	if i.ValueLT != nil {
+		predicates = append(predicates, material.ValueLT(*i.ValueLT))
+	}
+	if i.ValueLTE != nil {
+		predicates = append(predicates, material.ValueLTE(*i.ValueLTE))
+	}
+	if i.ValueContains != nil {
+		predicates = append(predicates, material.ValueContains(*i.ValueContains))
+	}
+	if i.ValueHasPrefix != nil {
+		predicates = append(predicates, material.ValueHasPrefix(*i.ValueHasPrefix))
+	}
+	if i.ValueHasSuffix != nil {
+		predicates = append(predicates, material.ValueHasSuffix(*i.ValueHasSuffix))
+	}
+	if i.ValueEqualFold != nil {
+		predicates = append(predicates, material.ValueEqualFold(*i.ValueEqualFold))
+	}
+	if i.ValueContainsFold != nil {
+		predicates = append(predicates, material.ValueContainsFold(*i.ValueContainsFold))
+	}
+
+	if i.HasAttestation != nil {
+		p := material.HasAttestation()
+		if !*i.HasAttestation {
+			p = material.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAttestationWith) > 0 {
+		with := make([]predicate.Attestation, 0, len(i.HasAttestationWith))
+		for _, w := range i.HasAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, material.HasAttestationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyMaterialWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return material.And(predicates...), nil
+	}
+}
+
+// OciAttestationWhereInput represents a where input for filtering OciAttestation queries.
+type OciAttestationWhereInput struct {
+	Predicates []predicate.OciAttestation  `json:"-"`
+	Not        *OciAttestationWhereInput   `json:"not,omitempty"`
+	Or         []*OciAttestationWhereInput `json:"or,omitempty"`
+	And        []*OciAttestationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "tar_digest" field predicates.
+	TarDigest             *string  `json:"tarDigest,omitempty"`
+	TarDigestNEQ          *string  `json:"tarDigestNEQ,omitempty"`
+	TarDigestIn           []string `json:"tarDigestIn,omitempty"`
+	TarDigestNotIn        []string `json:"tarDigestNotIn,omitempty"`
+	TarDigestGT           *string  `json:"tarDigestGT,omitempty"`
+	TarDigestGTE          *string  `json:"tarDigestGTE,omitempty"`
+	TarDigestLT           *string  `json:"tarDigestLT,omitempty"`
+	TarDigestLTE          *string  `json:"tarDigestLTE,omitempty"`
+	TarDigestContains     *string  `json:"tarDigestContains,omitempty"`
+	TarDigestHasPrefix    *string  `json:"tarDigestHasPrefix,omitempty"`
+	TarDigestHasSuffix    *string  `json:"tarDigestHasSuffix,omitempty"`
+	TarDigestIsNil        bool     `json:"tarDigestIsNil,omitempty"`
+	TarDigestNotNil       bool     `json:"tarDigestNotNil,omitempty"`
+	TarDigestEqualFold    *string  `json:"tarDigestEqualFold,omitempty"`
+	TarDigestContainsFold *string  `json:"tarDigestContainsFold,omitempty"`
+
+	// "image_id" field predicates.
+	ImageID             *string  `json:"imageID,omitempty"`
+	ImageIDNEQ          *string  `json:"imageIDNEQ,omitempty"`
+	ImageIDIn           []string `json:"imageIDIn,omitempty"`
+	ImageIDNotIn        []string `json:"imageIDNotIn,omitempty"`
+	ImageIDGT           *string  `json:"imageIDGT,omitempty"`
+	ImageIDGTE          *string  `json:"imageIDGTE,omitempty"`
+	ImageIDLT           *string  `json:"imageIDLT,omitempty"`
+	ImageIDLTE          *string  `json:"imageIDLTE,omitempty"`
+	ImageIDContains     *string  `json:"imageIDContains,omitempty"`
+	ImageIDHasPrefix    *string  `json:"imageIDHasPrefix,omitempty"`
+	ImageIDHasSuffix    *string  `json:"imageIDHasSuffix,omitempty"`
+	ImageIDIsNil        bool     `json:"imageIDIsNil,omitempty"`
+	ImageIDNotNil       bool     `json:"imageIDNotNil,omitempty"`
+	ImageIDEqualFold    *string  `json:"imageIDEqualFold,omitempty"`
+	ImageIDContainsFold *string  `json:"imageIDContainsFold,omitempty"`
+
+	// "manifest_digest" field predicates.
+	ManifestDigest             *string  `json:"manifestDigest,omitempty"`
+	ManifestDigestNEQ          *string  `json:"manifestDigestNEQ,omitempty"`
+	ManifestDigestIn           []string `json:"manifestDigestIn,omitempty"`
+	ManifestDigestNotIn        []string `json:"manifestDigestNotIn,omitempty"`
+	ManifestDigestGT           *string  `json:"manifestDigestGT,omitempty"`
+	ManifestDigestGTE          *string  `json:"manifestDigestGTE,omitempty"`
+	ManifestDigestLT           *string  `json:"manifestDigestLT,omitempty"`
+	ManifestDigestLTE          *string  `json:"manifestDigestLTE,omitempty"`
+	ManifestDigestContains     *string  `json:"manifestDigestContains,omitempty"`
+	ManifestDigestHasPrefix    *string  `json:"manifestDigestHasPrefix,omitempty"`
+	ManifestDigestHasSuffix    *string  `json:"manifestDigestHasSuffix,omitempty"`
+	ManifestDigestIsNil        bool     `json:"manifestDigestIsNil,omitempty"`
+	ManifestDigestNotNil       bool     `json:"manifestDigestNotNil,omitempty"`
+	ManifestDigestEqualFold    *string  `json:"manifestDigestEqualFold,omitempty"`
+	ManifestDigestContainsFold *string  `json:"manifestDigestContainsFold,omitempty"`
+
+	// "attestation" edge predicates.
+	HasAttestation     *bool                    `json:"hasAttestation,omitempty"`
+	HasAttestationWith []*AttestationWhereInput `json:"hasAttestationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *OciAttestationWhereInput) AddPredicates(predicates ...predicate.OciAttestation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the OciAttestationWhereInput filter on the OciAttestationQuery builder.
+func (i *OciAttestationWhereInput) Filter(q *OciAttestationQuery) (*OciAttestationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyOciAttestationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyOciAttestationWhereInput is returned in case the OciAttestationWhereInput is empty.
+var ErrEmptyOciAttestationWhereInput = errors.New("ent: empty predicate OciAttestationWhereInput")
+
+// P returns a predicate for filtering ociattestations.
+// An error is returned if the input is empty or invalid.
+func (i *OciAttestationWhereInput) P() (predicate.OciAttestation, error) {
+	var predicates []predicate.OciAttestation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, ociattestation.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.OciAttestation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, ociattestation.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.OciAttestation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, ociattestation.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, ociattestation.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, ociattestation.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, ociattestation.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, ociattestation.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, ociattestation.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, ociattestation.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, ociattestation.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, ociattestation.IDLTE(*i.IDLTE))
+	}
+	if i.TarDigest != nil {
+		predicates = append(predicates, ociattestation.TarDigestEQ(*i.TarDigest))
+	}
+	if i.TarDigestNEQ != nil {
+		predicates = append(predicates, ociattestation.TarDigestNEQ(*i.TarDigestNEQ))
+	}
+	if len(i.TarDigestIn) > 0 {
+		predicates = append(predicates, ociattestation.TarDigestIn(i.TarDigestIn...))
+	}
+	if len(i.TarDigestNotIn) > 0 {
+		predicates = append(predicates, ociattestation.TarDigestNotIn(i.TarDigestNotIn...))
+	}
+	if i.TarDigestGT != nil {
+		predicates = append(predicates, ociattestation.TarDigestGT(*i.TarDigestGT))
+	}
+	if i.TarDigestGTE != nil {
+		predicates = append(predicates, ociattestation.TarDigestGTE(*i.TarDigestGTE))
+	}
+	if i.TarDigestLT != nil {
+		predicates = append(predicates, ociattestation.TarDigestLT(*i.TarDigestLT))
+	}
+	if i.TarDigestLTE != nil {
+		predicates = append(predicates, ociattestation.TarDigestLTE(*i.TarDigestLTE))
+	}
+	if i.TarDigestContains != nil {
+		predicates = append(predicates, ociattestation.TarDigestContains(*i.TarDigestContains))
+	}
+	if i.TarDigestHasPrefix != nil {
+		predicates = append(predicates, ociattestation.TarDigestHasPrefix(*i.TarDigestHasPrefix))
+	}
+	if i.TarDigestHasSuffix != nil {
+		predicates = append(predicates, ociattestation.TarDigestHasSuffix(*i.TarDigestHasSuffix))
+	}
+	if i.TarDigestIsNil {
+		predicates = append(predicates, ociattestation.TarDigestIsNil())
+	}
+	if i.TarDigestNotNil {
+		predicates = append(predicates, ociattestation.TarDigestNotNil())
+	}
+	if i.TarDigestEqualFold != nil {
+		predicates = append(predicates, ociattestation.TarDigestEqualFold(*i.TarDigestEqualFold))
+	}
+	if i.TarDigestContainsFold != nil {
+		predicates = append(predicates, ociattestation.TarDigestContainsFold(*i.TarDigestContainsFold))
+	}
+	if i.ImageID != nil {
+		predicates = append(predicates, ociattestation.ImageIDEQ(*i.ImageID))
+	}
+	if i.ImageIDNEQ != nil {
+		predicates = append(predicates, ociattestation.ImageIDNEQ(*i.ImageIDNEQ))
+	}
+	if len(i.ImageIDIn) > 0 {
+		predicates = append(predicates, ociattestation.ImageIDIn(i.ImageIDIn...))
+	}
+	if len(i.ImageIDNotIn) > 0 {
+		predicates = append(predicates, ociattestation.ImageIDNotIn(i.ImageIDNotIn...))
+	}
+	if i.ImageIDGT != nil {
+		predicates = append(predicates, ociattestation.ImageIDGT(*i.ImageIDGT))
+	}
+	if i.ImageIDGTE != nil {
+		predicates = append(predicates, ociattestation.ImageIDGTE(*i.ImageIDGTE))
+	}
+	if i.ImageIDLT != nil {
+		predicates = append(predicates, ociattestation.ImageIDLT(*i.ImageIDLT))
+	}
+	if i.ImageIDLTE != nil {
+		predicates = append(predicates, ociattestation.ImageIDLTE(*i.ImageIDLTE))
+	}
+	if i.ImageIDContains != nil {
+		predicates = append(predicates, ociattestation.ImageIDContains(*i.ImageIDContains))
+	}
+	if i.ImageIDHasPrefix != nil {
+		predicates = append(predicates, ociattestation.ImageIDHasPrefix(*i.ImageIDHasPrefix))
+	}
+	if i.ImageIDHasSuffix != nil {
+		predicates = append(predicates, ociattestation.ImageIDHasSuffix(*i.ImageIDHasSuffix))
+	}
+	if i.ImageIDIsNil {
+		predicates = append(predicates, ociattestation.ImageIDIsNil())
+	}
+	if i.ImageIDNotNil {
+		predicates = append(predicates, ociattestation.ImageIDNotNil())
+	}
+	if i.ImageIDEqualFold != nil {
+		predicates = append(predicates, ociattestation.ImageIDEqualFold(*i.ImageIDEqualFold))
+	}
+	if i.ImageIDContainsFold != nil {
+		predicates = append(predicates, ociattestation.ImageIDContainsFold(*i.ImageIDContainsFold))
+	}
+	if i.ManifestDigest != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestEQ(*i.ManifestDigest))
+	}
+	if i.ManifestDigestNEQ != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestNEQ(*i.ManifestDigestNEQ))
+	}
+	if len(i.ManifestDigestIn) > 0 {
+		predicates = append(predicates, ociattestation.ManifestDigestIn(i.ManifestDigestIn...))
+	}
+	if len(i.ManifestDigestNotIn) > 0 {
+		predicates = append(predicates, ociattestation.ManifestDigestNotIn(i.ManifestDigestNotIn...))
+	}
+	if i.ManifestDigestGT != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestGT(*i.ManifestDigestGT))
+	}
+	if i.ManifestDigestGTE != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestGTE(*i.ManifestDigestGTE))
+	}
+	if i.ManifestDigestLT != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestLT(*i.ManifestDigestLT))
+	}
+	if i.ManifestDigestLTE != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestLTE(*i.ManifestDigestLTE))
+	}
+	if i.ManifestDigestContains != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestContains(*i.ManifestDigestContains))
+	}
+	if i.ManifestDigestHasPrefix != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestHasPrefix(*i.ManifestDigestHasPrefix))
+	}
+	if i.ManifestDigestHasSuffix != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestHasSuffix(*i.ManifestDigestHasSuffix))
+	}
+	if i.ManifestDigestIsNil {
+		predicates = append(predicates, ociattestation.ManifestDigestIsNil())
+	}
+	if i.ManifestDigestNotNil {
+		predicates = append(predicates, ociattestation.ManifestDigestNotNil())
+	}
+	if i.ManifestDigestEqualFold != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestEqualFold(*i.ManifestDigestEqualFold))
+	}
+	if i.ManifestDigestContainsFold != nil {
+		predicates = append(predicates, ociattestation.ManifestDigestContainsFold(*i.ManifestDigestContainsFold))
+	}
+
+	if i.HasAttestation != nil {
+		p := ociattestation.HasAttestation()
+		if !*i.HasAttestation {
+			p = ociattestation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAttestationWith) > 0 {
+		with := make([]predicate.Attestation, 0, len(i.HasAttestationWith))
+		for _, w := range i.HasAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, ociattestation.HasAttestationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyOciAttestationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return ociattestation.And(predicates...), nil
+	}
+}
+
+// PayloadDigestWhereInput represents a where input for filtering PayloadDigest queries.
+type PayloadDigestWhereInput struct {
+	Predicates []predicate.PayloadDigest  `json:"-"`
+	Not        *PayloadDigestWhereInput   `json:"not,omitempty"`
+	Or         []*PayloadDigestWhereInput `json:"or,omitempty"`
+	And        []*PayloadDigestWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "algorithm" field predicates.
+	Algorithm             *string  `json:"algorithm,omitempty"`
+	AlgorithmNEQ          *string  `json:"algorithmNEQ,omitempty"`
+	AlgorithmIn           []string `json:"algorithmIn,omitempty"`
+	AlgorithmNotIn        []string `json:"algorithmNotIn,omitempty"`
+	AlgorithmGT           *string  `json:"algorithmGT,omitempty"`
+	AlgorithmGTE          *string  `json:"algorithmGTE,omitempty"`
+	AlgorithmLT           *string  `json:"algorithmLT,omitempty"`
+	AlgorithmLTE          *string  `json:"algorithmLTE,omitempty"`
+	AlgorithmContains     *string  `json:"algorithmContains,omitempty"`
+	AlgorithmHasPrefix    *string  `json:"algorithmHasPrefix,omitempty"`
+	AlgorithmHasSuffix    *string  `json:"algorithmHasSuffix,omitempty"`
+	AlgorithmEqualFold    *string  `json:"algorithmEqualFold,omitempty"`
+	AlgorithmContainsFold *string  `json:"algorithmContainsFold,omitempty"`
+
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+
+	// "dsse" edge predicates.
+	HasDsse     *bool             `json:"hasDsse,omitempty"`
+	HasDsseWith []*DsseWhereInput `json:"hasDsseWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *PayloadDigestWhereInput) AddPredicates(predicates ...predicate.PayloadDigest) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the PayloadDigestWhereInput filter on the PayloadDigestQuery builder.
+func (i *PayloadDigestWhereInput) Filter(q *PayloadDigestQuery) (*PayloadDigestQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyPayloadDigestWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyPayloadDigestWhereInput is returned in case the PayloadDigestWhereInput is empty.
+var ErrEmptyPayloadDigestWhereInput = errors.New("ent: empty predicate PayloadDigestWhereInput")
+
+// P returns a predicate for filtering payloaddigests.
+// An error is returned if the input is empty or invalid.
+func (i *PayloadDigestWhereInput) P() (predicate.PayloadDigest, error) {
+	var predicates []predicate.PayloadDigest
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, payloaddigest.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.PayloadDigest, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, payloaddigest.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.PayloadDigest, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, payloaddigest.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, payloaddigest.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, payloaddigest.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, payloaddigest.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, payloaddigest.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, payloaddigest.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, payloaddigest.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, payloaddigest.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, payloaddigest.IDLTE(*i.IDLTE))
+	}
+	if i.Algorithm != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmEQ(*i.Algorithm))
+	}
+	if i.AlgorithmNEQ != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmNEQ(*i.AlgorithmNEQ))
+	}
+	if len(i.AlgorithmIn) > 0 {
+		predicates = append(predicates, payloaddigest.AlgorithmIn(i.AlgorithmIn...))
+	}
+	if len(i.AlgorithmNotIn) > 0 {
+		predicates = append(predicates, payloaddigest.AlgorithmNotIn(i.AlgorithmNotIn...))
+	}
+	if i.AlgorithmGT != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmGT(*i.AlgorithmGT))
+	}
+	if i.AlgorithmGTE != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmGTE(*i.AlgorithmGTE))
+	}
+	if i.AlgorithmLT != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmLT(*i.AlgorithmLT))
+	}
+	if i.AlgorithmLTE != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmLTE(*i.AlgorithmLTE))
+	}
+	if i.AlgorithmContains != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmContains(*i.AlgorithmContains))
+	}
+	if i.AlgorithmHasPrefix != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmHasPrefix(*i.AlgorithmHasPrefix))
+	}
+	if i.AlgorithmHasSuffix != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmHasSuffix(*i.AlgorithmHasSuffix))
+	}
+	if i.AlgorithmEqualFold != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmEqualFold(*i.AlgorithmEqualFold))
+	}
+	if i.AlgorithmContainsFold != nil {
+		predicates = append(predicates, payloaddigest.AlgorithmContainsFold(*i.AlgorithmContainsFold))
+	}
+	if i.Value != nil {
+		predicates = append(predicates, payloaddigest.ValueEQ(*i.Value))
+	}
+	if i.ValueNEQ != nil {
+		predicates = append(predicates, payloaddigest.ValueNEQ(*i.ValueNEQ))
+	}
+	if len(i.ValueIn) > 0 {
+		predicates = append(predicates, payloaddigest.ValueIn(i.ValueIn...))
+	}
+	if len(i.ValueNotIn) > 0 {
+		predicates = append(predicates, payloaddigest.ValueNotIn(i.ValueNotIn...))
+	}
+	if i.ValueGT != nil {
+		predicates = append(predicates, payloaddigest.ValueGT(*i.ValueGT))
+	}
+	if i.ValueGTE != nil {
+		predicates = append(predicates, payloaddigest.ValueGTE(*i.ValueGTE))
+	}
+	if i.ValueLT != nil {
+		predicates = append(predicates, payloaddigest.ValueLT(*i.ValueLT))
+	}
+	if i.ValueLTE != nil {
+		predicates = append(predicates, payloaddigest.ValueLTE(*i.ValueLTE))
+	}
+	if i.ValueContains != nil {
+		predicates = append(predicates, payloaddigest.ValueContains(*i.ValueContains))
+	}
+	if i.ValueHasPrefix != nil {
+		predicates = append(predicates, payloaddigest.ValueHasPrefix(*i.ValueHasPrefix))
+	}
+	if i.ValueHasSuffix != nil {
+		predicates = append(predicates, payloaddigest.ValueHasSuffix(*i.ValueHasSuffix))
+	}
+	if i.ValueEqualFold != nil {
+		predicates = append(predicates, payloaddigest.ValueEqualFold(*i.ValueEqualFold))
+	}
+	if i.ValueContainsFold != nil {
+		predicates = append(predicates, payloaddigest.ValueContainsFold(*i.ValueContainsFold))
+	}
+
+	if i.HasDsse != nil {
+		p := payloaddigest.HasDsse()
+		if !*i.HasDsse {
+			p = payloaddigest.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDsseWith) > 0 {
+		with := make([]predicate.Dsse, 0, len(i.HasDsseWith))
+		for _, w := range i.HasDsseWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDsseWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, payloaddigest.HasDsseWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyPayloadDigestWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return payloaddigest.And(predicates...), nil
+	}
+}
+
+// ProductWhereInput represents a where input for filtering Product queries.
+type ProductWhereInput struct {
+	Predicates []predicate.Product  `json:"-"`
+	Not        *ProductWhereInput   `json:"not,omitempty"`
+	Or         []*ProductWhereInput `json:"or,omitempty"`
+	And        []*ProductWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "path" field predicates.
+	Path             *string  `json:"path,omitempty"`
+	PathNEQ          *string  `json:"pathNEQ,omitempty"`
+	PathIn           []string `json:"pathIn,omitempty"`
+	PathNotIn        []string `json:"pathNotIn,omitempty"`
+	PathGT           *string  `json:"pathGT,omitempty"`
+	PathGTE          *string  `json:"pathGTE,omitempty"`
+	PathLT           *string  `json:"pathLT,omitempty"`
+	PathLTE          *string  `json:"pathLTE,omitempty"`
+	PathContains     *string  `json:"pathContains,omitempty"`
+	PathHasPrefix    *string  `json:"pathHasPrefix,omitempty"`
+	PathHasSuffix    *string  `json:"pathHasSuffix,omitempty"`
+	PathEqualFold    *string  `json:"pathEqualFold,omitempty"`
+	PathContainsFold *string  `json:"pathContainsFold,omitempty"`
+
+	// "mime_type" field predicates.
+	MimeType             *string  `json:"mimeType,omitempty"`
+	MimeTypeNEQ          *string  `json:"mimeTypeNEQ,omitempty"`
+	MimeTypeIn           []string `json:"mimeTypeIn,omitempty"`
+	MimeTypeNotIn        []string `json:"mimeTypeNotIn,omitempty"`
+	MimeTypeGT           *string  `json:"mimeTypeGT,omitempty"`
+	MimeTypeGTE          *string  `json:"mimeTypeGTE,omitempty"`
+	MimeTypeLT           *string  `json:"mimeTypeLT,omitempty"`
+	MimeTypeLTE          *string  `json:"mimeTypeLTE,omitempty"`
+	MimeTypeContains     *string  `json:"mimeTypeContains,omitempty"`
+	MimeTypeHasPrefix    *string  `json:"mimeTypeHasPrefix,omitempty"`
+	MimeTypeHasSuffix    *string  `json:"mimeTypeHasSuffix,omitempty"`
+	MimeTypeIsNil        bool     `json:"mimeTypeIsNil,omitempty"`
+	MimeTypeNotNil       bool     `json:"mimeTypeNotNil,omitempty"`
+	MimeTypeEqualFold    *string  `json:"mimeTypeEqualFold,omitempty"`
+	MimeTypeContainsFold *string  `json:"mimeTypeContainsFold,omitempty"`
+
+	// "algorithm" field predicates.
+	Algorithm             *string  `json:"algorithm,omitempty"`
+	AlgorithmNEQ          *string  `json:"algorithmNEQ,omitempty"`
+	AlgorithmIn           []string `json:"algorithmIn,omitempty"`
+	AlgorithmNotIn        []string `json:"algorithmNotIn,omitempty"`
+	AlgorithmGT           *string  `json:"algorithmGT,omitempty"`
+	AlgorithmGTE          *string  `json:"algorithmGTE,omitempty"`
+	AlgorithmLT           *string  `json:"algorithmLT,omitempty"`
+	AlgorithmLTE          *string  `json:"algorithmLTE,omitempty"`
+	AlgorithmContains     *string  `json:"algorithmContains,omitempty"`
+	AlgorithmHasPrefix    *string  `json:"algorithmHasPrefix,omitempty"`
+	AlgorithmHasSuffix    *string  `json:"algorithmHasSuffix,omitempty"`
+	AlgorithmEqualFold    *string  `json:"algorithmEqualFold,omitempty"`
+	AlgorithmContainsFold *string  `json:"algorithmContainsFold,omitempty"`
+
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+
+	// "attestation" edge predicates.
+	HasAttestation     *bool                    `json:"hasAttestation,omitempty"`
+	HasAttestationWith []*AttestationWhereInput `json:"hasAttestationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProductWhereInput) AddPredicates(predicates ...predicate.Product) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ProductWhereInput filter on the ProductQuery builder.
+func (i *ProductWhereInput) Filter(q *ProductQuery) (*ProductQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyProductWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyProductWhereInput is returned in case the ProductWhereInput is empty.
+var ErrEmptyProductWhereInput = errors.New("ent: empty predicate ProductWhereInput")
+
+// P returns a predicate for filtering products.
+// An error is returned if the input is empty or invalid.
+func (i *ProductWhereInput) P() (predicate.Product, error) {
+	var predicates []predicate.Product
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, product.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Product, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, product.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Product, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, product.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, product.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, product.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, product.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, product.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, product.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, product.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, product.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, product.IDLTE(*i.IDLTE))
+	}
+	if i.Path != nil {
+		predicates = append(predicates, product.PathEQ(*i.Path))
+	}
+	if i.PathNEQ != nil {
+		predicates = append(predicates, product.PathNEQ(*i.PathNEQ))
+	}
+	if len(i.PathIn) > 0 {
+		predicates = append(predicates, product.PathIn(i.PathIn...))
+	}
+	if len(i.PathNotIn) > 0 {
+		predicates = append(predicates, product.PathNotIn(i.PathNotIn...))
+	}
+	if i.PathGT != nil {
+		predicates = append(predicates, product.PathGT(*i.PathGT))
+	}
+	if i.PathGTE != nil {
+		predicates = append(predicates, product.PathGTE(*i.PathGTE))
+	}
+	if i.PathLT != nil {
+		predicates = append(predicates, product.PathLT(*i.PathLT))
+	}
+	if i.PathLTE != nil {
+		predicates = append(predicates, product.PathLTE(*i.PathLTE))
+	}
+	if i.PathContains != nil {
+		predicates = append(predicates, product.PathContains(*i.PathContains))
+	}
+	if i.PathHasPrefix != nil {
+		predicates = append(predicates, product.PathHasPrefix(*i.PathHasPrefix))
+	}
+	if i.PathHasSuffix != nil {
+		predicates = append(predicates, product.PathHasSuffix(*i.PathHasSuffix))
+	}
+	if i.PathEqualFold != nil {
+		predicates = append(predicates, product.PathEqualFold(*i.PathEqualFold))
+	}
+	if i.PathContainsFold != nil {
+		predicates = append(predicates, product.PathContainsFold(*i.PathContainsFold))
+	}
+	if i.MimeType != nil {
+		predicates = append(predicates, product.MimeTypeEQ(*i.MimeType))
+	}
+	if i.MimeTypeNEQ != nil {
+		predicates = append(predicates, product.MimeTypeNEQ(*i.MimeTypeNEQ))
+	}
+	if len(i.MimeTypeIn) > 0 {
+		predicates = append(predicates, product.MimeTypeIn(i.MimeTypeIn...))
+	}
+	if len(i.MimeTypeNotIn) > 0 {
+		predicates = append(predicates, product.MimeTypeNotIn(i.MimeTypeNotIn...))
+	}
+	if i.MimeTypeGT != nil {
+		predicates = append(predicates, product.MimeTypeGT(*i.MimeTypeGT))
+	}
+	if i.MimeTypeGTE != nil {
+		predicates = append(predicates, product.MimeTypeGTE(*i.MimeTypeGTE))
+	}
+	if i.MimeTypeLT != nil {
+		predicates = append(predicates, product.MimeTypeLT(*i.MimeTypeLT))
+	}
+	if i.MimeTypeLTE != nil {
+		predicates = append(predicates, product.MimeTypeLTE(*i.MimeTypeLTE))
+	}
+	if i.MimeTypeContains != nil {
+		predicates = append(predicates, product.MimeTypeContains(*i.MimeTypeContains))
+	}
+	if i.MimeTypeHasPrefix != nil {
+		predicates = append(predicates, product.MimeTypeHasPrefix(*i.MimeTypeHasPrefix))
+	}
+	if i.MimeTypeHasSuffix != nil {
+		predicates = append(predicates, product.MimeTypeHasSuffix(*i.MimeTypeHasSuffix))
+	}
+	if i.MimeTypeIsNil {
+		predicates = append(predicates, product.MimeTypeIsNil())
+	}
+	if i.MimeTypeNotNil {
+		predicates = append(predicates, product.MimeTypeNotNil())
+	}
+	if i.MimeTypeEqualFold != nil {
+		predicates = append(predicates, product.MimeTypeEqualFold(*i.MimeTypeEqualFold))
+	}
+	if i.MimeTypeContainsFold != nil {
+		predicates = append(predicates, product.MimeTypeContainsFold(*i.MimeTypeContainsFold))
+	}
+	if i.Algorithm != nil {
+		predicates = append(predicates, product.AlgorithmEQ(*i.Algorithm))
+	}
+	if i.AlgorithmNEQ != nil {
+		predicates = append(predicates, product.AlgorithmNEQ(*i.AlgorithmNEQ))
+	}
+	if len(i.AlgorithmIn) > 0 {
+		predicates = append(predicates, product.AlgorithmIn(i.AlgorithmIn...))
+	}
+	if len(i.AlgorithmNotIn) > 0 {
+		predicates = append(predicates, product.AlgorithmNotIn(i.AlgorithmNotIn...))
+	}
+	if i.AlgorithmGT != nil {
+		predicates = append(predicates, product.AlgorithmGT(*i.AlgorithmGT))
+	}
+	if i.AlgorithmGTE != nil {
+		predicates = append(predicates, product.AlgorithmGTE(*i.AlgorithmGTE))
+	}
+	if i.AlgorithmLT != nil {
+		predicates = append(predicates, product.AlgorithmLT(*i.AlgorithmLT))
+	}
+	if i.AlgorithmLTE != nil {
+		predicates = append(predicates, product.AlgorithmLTE(*i.AlgorithmLTE))
+	}
+	if i.AlgorithmContains != nil {
+		predicates = append(predicates, product.AlgorithmContains(*i.AlgorithmContains))
+	}
+	if i.AlgorithmHasPrefix != nil {
+		predicates = append(predicates, product.AlgorithmHasPrefix(*i.AlgorithmHasPrefix))
+	}
+	if i.AlgorithmHasSuffix != nil {
+		predicates = append(predicates, product.AlgorithmHasSuffix(*i.AlgorithmHasSuffix))
+	}
+	if i.AlgorithmEqualFold != nil {
+		predicates = append(predicates, product.AlgorithmEqualFold(*i.AlgorithmEqualFold))
+	}
+	if i.AlgorithmContainsFold != nil {
+		predicates = append(predicates, product.AlgorithmContainsFold(*i.AlgorithmContainsFold))
+	}
+	if i.Value != nil {
+		predicates = append(predicates, product.ValueEQ(*i.Value))
+	}
+	if i.ValueNEQ != nil {
+		predicates = append(predicates, product.ValueNEQ(*i.ValueNEQ))
+	}
+	if len(i.ValueIn) > 0 {
+		predicates = append(predicates, product.ValueIn(i.ValueIn...))
+	}
+	if len(i.ValueNotIn) > 0 {
+		predicates = append(predicates, product.ValueNotIn(i.ValueNotIn...))
+	}
+	if i.ValueGT != nil {
+		predicates = append(predicates, product.ValueGT(*i.ValueGT))
+	}
+	if i.ValueGTE != nil {
+		predicates = append(predicates, product.ValueGTE(*i.ValueGTE))
+	}
+	if i.ValueLT != nil {
+		predicates = append(predicates, product.ValueLT(*i.ValueLT))
+	}
+	if i.ValueLTE != nil {
+		predicates = append(predicates, product.ValueLTE(*i.ValueLTE))
+	}
+	if i.ValueContains != nil {
+		predicates = append(predicates, product.ValueContains(*i.ValueContains))
+	}
+	if i.ValueHasPrefix != nil {
+		predicates = append(predicates, product.ValueHasPrefix(*i.ValueHasPrefix))
+	}
+	if i.ValueHasSuffix != nil {
+		predicates = append(predicates, product.ValueHasSuffix(*i.ValueHasSuffix))
+	}
+	if i.ValueEqualFold != nil {
+		predicates = append(predicates, product.ValueEqualFold(*i.ValueEqualFold))
+	}
+	if i.ValueContainsFold != nil {
+		predicates = append(predicates, product.ValueContainsFold(*i.ValueContainsFold))
+	}
+
+	if i.HasAttestation != nil {
+		p := product.HasAttestation()
+		if !*i.HasAttestation {
+			p = product.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAttestationWith) > 0 {
+		with := make([]predicate.Attestation, 0, len(i.HasAttestationWith))
+		for _, w := range i.HasAttestationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAttestationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, product.HasAttestationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProductWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return product.And(predicates...), nil
+	}
+}
+
+// PublicationWhereInput represents a where input for filtering Publication queries.
+type PublicationWhereInput struct {
+	Predicates []predicate.Publication  `json:"-"`
+	Not        *PublicationWhereInput   `json:"not,omitempty"`
+	Or         []*PublicationWhereInput `json:"or,omitempty"`
+	And        []*PublicationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "gitoid_sha256" field predicates.
+	GitoidSha256             *string  `json:"gitoidSha256,omitempty"`
+	GitoidSha256NEQ          *string  `json:"gitoidSha256NEQ,omitempty"`
+	GitoidSha256In           []string `json:"gitoidSha256In,omitempty"`
+	GitoidSha256NotIn        []string `json:"gitoidSha256NotIn,omitempty"`
+	GitoidSha256GT           *string  `json:"gitoidSha256GT,omitempty"`
+	GitoidSha256GTE          *string  `json:"gitoidSha256GTE,omitempty"`
+	GitoidSha256LT           *string  `json:"gitoidSha256LT,omitempty"`
+	GitoidSha256LTE          *string  `json:"gitoidSha256LTE,omitempty"`
+	GitoidSha256Contains     *string  `json:"gitoidSha256Contains,omitempty"`
+	GitoidSha256HasPrefix    *string  `json:"gitoidSha256HasPrefix,omitempty"`
+	GitoidSha256HasSuffix    *string  `json:"gitoidSha256HasSuffix,omitempty"`
+	GitoidSha256EqualFold    *string  `json:"gitoidSha256EqualFold,omitempty"`
+	GitoidSha256ContainsFold *string  `json:"gitoidSha256ContainsFold,omitempty"`
+
+	// "publisher" field predicates.
+	Publisher             *string  `json:"publisher,omitempty"`
+	PublisherNEQ          *string  `json:"publisherNEQ,omitempty"`
+	PublisherIn           []string `json:"publisherIn,omitempty"`
+	PublisherNotIn        []string `json:"publisherNotIn,omitempty"`
+	PublisherGT           *string  `json:"publisherGT,omitempty"`
+	PublisherGTE          *string  `json:"publisherGTE,omitempty"`
+	PublisherLT           *string  `json:"publisherLT,omitempty"`
+	PublisherLTE          *string  `json:"publisherLTE,omitempty"`
+	PublisherContains     *string  `json:"publisherContains,omitempty"`
+	PublisherHasPrefix    *string  `json:"publisherHasPrefix,omitempty"`
+	PublisherHasSuffix    *string  `json:"publisherHasSuffix,omitempty"`
+	PublisherEqualFold    *string  `json:"publisherEqualFold,omitempty"`
+	PublisherContainsFold *string  `json:"publisherContainsFold,omitempty"`
+
+	// "task_id" field predicates.
+	TaskID             *string  `json:"taskID,omitempty"`
+	TaskIDNEQ          *string  `json:"taskIDNEQ,omitempty"`
+	TaskIDIn           []string `json:"taskIDIn,omitempty"`
+	TaskIDNotIn        []string `json:"taskIDNotIn,omitempty"`
+	TaskIDGT           *string  `json:"taskIDGT,omitempty"`
+	TaskIDGTE          *string  `json:"taskIDGTE,omitempty"`
+	TaskIDLT           *string  `json:"taskIDLT,omitempty"`
+	TaskIDLTE          *string  `json:"taskIDLTE,omitempty"`
+	TaskIDContains     *string  `json:"taskIDContains,omitempty"`
+	TaskIDHasPrefix    *string  `json:"taskIDHasPrefix,omitempty"`
+	TaskIDHasSuffix    *string  `json:"taskIDHasSuffix,omitempty"`
+	TaskIDEqualFold    *string  `json:"taskIDEqualFold,omitempty"`
+	TaskIDContainsFold *string  `json:"taskIDContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *publication.Status  `json:"status,omitempty"`
+	StatusNEQ   *publication.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []publication.Status `json:"statusIn,omitempty"`
+	StatusNotIn []publication.Status `json:"statusNotIn,omitempty"`
+
+	// "message" field predicates.
+	Message             *string  `json:"message,omitempty"`
+	MessageNEQ          *string  `json:"messageNEQ,omitempty"`
+	MessageIn           []string `json:"messageIn,omitempty"`
+	MessageNotIn        []string `json:"messageNotIn,omitempty"`
+	MessageGT           *string  `json:"messageGT,omitempty"`
+	MessageGTE          *string  `json:"messageGTE,omitempty"`
+	MessageLT           *string  `json:"messageLT,omitempty"`
+	MessageLTE          *string  `json:"messageLTE,omitempty"`
+	MessageContains     *string  `json:"messageContains,omitempty"`
+	MessageHasPrefix    *string  `json:"messageHasPrefix,omitempty"`
+	MessageHasSuffix    *string  `json:"messageHasSuffix,omitempty"`
+	MessageIsNil        bool     `json:"messageIsNil,omitempty"`
+	MessageNotNil       bool     `json:"messageNotNil,omitempty"`
+	MessageEqualFold    *string  `json:"messageEqualFold,omitempty"`
+	MessageContainsFold *string  `json:"messageContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "completed_at" field predicates.
+	CompletedAt       *time.Time  `json:"completedAt,omitempty"`
+	CompletedAtNEQ    *time.Time  `json:"completedAtNEQ,omitempty"`
+	CompletedAtIn     []time.Time `json:"completedAtIn,omitempty"`
+	CompletedAtNotIn  []time.Time `json:"completedAtNotIn,omitempty"`
+	CompletedAtGT     *time.Time  `json:"completedAtGT,omitempty"`
+	CompletedAtGTE    *time.Time  `json:"completedAtGTE,omitempty"`
+	CompletedAtLT     *time.Time  `json:"completedAtLT,omitempty"`
+	CompletedAtLTE    *time.Time  `json:"completedAtLTE,omitempty"`
+	CompletedAtIsNil  bool        `json:"completedAtIsNil,omitempty"`
+	CompletedAtNotNil bool        `json:"completedAtNotNil,omitempty"`
+
+	// "dsse" edge predicates.
+	HasDsse     *bool             `json:"hasDsse,omitempty"`
+	HasDsseWith []*DsseWhereInput `json:"hasDsseWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *PublicationWhereInput) AddPredicates(predicates ...predicate.Publication) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the PublicationWhereInput filter on the PublicationQuery builder.
+func (i *PublicationWhereInput) Filter(q *PublicationQuery) (*PublicationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyPublicationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyPublicationWhereInput is returned in case the PublicationWhereInput is empty.
+var ErrEmptyPublicationWhereInput = errors.New("ent: empty predicate PublicationWhereInput")
+
+// P returns a predicate for filtering publications.
+// An error is returned if the input is empty or invalid.
+func (i *PublicationWhereInput) P() (predicate.Publication, error) {
+	var predicates []predicate.Publication
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, publication.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Publication, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, publication.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Publication, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, publication.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, publication.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, publication.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, publication.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, publication.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, publication.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, publication.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, publication.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, publication.IDLTE(*i.IDLTE))
+	}
+	if i.GitoidSha256 != nil {
+		predicates = append(predicates, publication.GitoidSha256EQ(*i.GitoidSha256))
+	}
+	if i.GitoidSha256NEQ != nil {
+		predicates = append(predicates, publication.GitoidSha256NEQ(*i.GitoidSha256NEQ))
+	}
+	if len(i.GitoidSha256In) > 0 {
+		predicates = append(predicates, publication.GitoidSha256In(i.GitoidSha256In...))
+	}
+	if len(i.GitoidSha256NotIn) > 0 {
+		predicates = append(predicates, publication.GitoidSha256NotIn(i.GitoidSha256NotIn...))
+	}
+	if i.GitoidSha256GT != nil {
+		predicates = append(predicates, publication.GitoidSha256GT(*i.GitoidSha256GT))
+	}
+	if i.GitoidSha256GTE != nil {
+		predicates = append(predicates, publication.GitoidSha256GTE(*i.GitoidSha256GTE))
+	}
+	if i.GitoidSha256LT != nil {
+		predicates = append(predicates, publication.GitoidSha256LT(*i.GitoidSha256LT))
+	}
+	if i.GitoidSha256LTE != nil {
+		predicates = append(predicates, publication.GitoidSha256LTE(*i.GitoidSha256LTE))
+	}
+	if i.GitoidSha256Contains != nil {
+		predicates = append(predicates, publication.GitoidSha256Contains(*i.GitoidSha256Contains))
+	}
+	if i.GitoidSha256HasPrefix != nil {
+		predicates = append(predicates, publication.GitoidSha256HasPrefix(*i.GitoidSha256HasPrefix))
+	}
+	if i.GitoidSha256HasSuffix != nil {
+		predicates = append(predicates, publication.GitoidSha256HasSuffix(*i.GitoidSha256HasSuffix))
+	}
+	if i.GitoidSha256EqualFold != nil {
+		predicates = append(predicates, publication.GitoidSha256EqualFold(*i.GitoidSha256EqualFold))
+	}
+	if i.GitoidSha256ContainsFold != nil {
+		predicates = append(predicates, publication.GitoidSha256ContainsFold(*i.GitoidSha256ContainsFold))
+	}
+	if i.Publisher != nil {
+		predicates = append(predicates, publication.PublisherEQ(*i.Publisher))
+	}
+	if i.PublisherNEQ != nil {
+		predicates = append(predicates, publication.PublisherNEQ(*i.PublisherNEQ))
+	}
+	if len(i.PublisherIn) > 0 {
+		predicates = append(predicates, publication.PublisherIn(i.PublisherIn...))
+	}
+	if len(i.PublisherNotIn) > 0 {
+		predicates = append(predicates, publication.PublisherNotIn(i.PublisherNotIn...))
+	}
+	if i.PublisherGT != nil {
+		predicates = append(predicates, publication.PublisherGT(*i.PublisherGT))
+	}
+	if i.PublisherGTE != nil {
+		predicates = append(predicates, publication.PublisherGTE(*i.PublisherGTE))
+	}
+	if i.PublisherLT != nil {
+		predicates = append(predicates, publication.PublisherLT(*i.PublisherLT))
+	}
+	if i.PublisherLTE != nil {
+		predicates = append(predicates, publication.PublisherLTE(*i.PublisherLTE))
+	}
+	if i.PublisherContains != nil {
+		predicates = append(predicates, publication.PublisherContains(*i.PublisherContains))
+	}
+	if i.PublisherHasPrefix != nil {
+		predicates = append(predicates, publication.PublisherHasPrefix(*i.PublisherHasPrefix))
+	}
+	if i.PublisherHasSuffix != nil {
+		predicates = append(predicates, publication.PublisherHasSuffix(*i.PublisherHasSuffix))
+	}
+	if i.PublisherEqualFold != nil {
+		predicates = append(predicates, publication.PublisherEqualFold(*i.PublisherEqualFold))
+	}
+	if i.PublisherContainsFold != nil {
+		predicates = append(predicates, publication.PublisherContainsFold(*i.PublisherContainsFold))
+	}
+	if i.TaskID != nil {
+		predicates = append(predicates, publication.TaskIDEQ(*i.TaskID))
+	}
+	if i.TaskIDNEQ != nil {
+		predicates = append(predicates, publication.TaskIDNEQ(*i.TaskIDNEQ))
+	}
+	if len(i.TaskIDIn) > 0 {
+		predicates = append(predicates, publication.TaskIDIn(i.TaskIDIn...))
+	}
+	if len(i.TaskIDNotIn) > 0 {
+		predicates = append(predicates, publication.TaskIDNotIn(i.TaskIDNotIn...))
+	}
+	if i.TaskIDGT != nil {
+		predicates = append(predicates, publication.TaskIDGT(*i.TaskIDGT))
+	}
+	if i.TaskIDGTE != nil {
+		predicates = append(predicates, publication.TaskIDGTE(*i.TaskIDGTE))
+	}
+	if i.TaskIDLT != nil {
+		predicates = append(predicates, publication.TaskIDLT(*i.TaskIDLT))
+	}
+	if i.TaskIDLTE != nil {
+		predicates = append(predicates, publication.TaskIDLTE(*i.TaskIDLTE))
+	}
+	if i.TaskIDContains != nil {
+		predicates = append(predicates, publication.TaskIDContains(*i.TaskIDContains))
+	}
+	if i.TaskIDHasPrefix != nil {
+		predicates = append(predicates, publication.TaskIDHasPrefix(*i.TaskIDHasPrefix))
+	}
+	if i.TaskIDHasSuffix != nil {
+		predicates = append(predicates, publication.TaskIDHasSuffix(*i.TaskIDHasSuffix))
+	}
+	if i.TaskIDEqualFold != nil {
+		predicates = append(predicates, publication.TaskIDEqualFold(*i.TaskIDEqualFold))
+	}
+	if i.TaskIDContainsFold != nil {
+		predicates = append(predicates, publication.TaskIDContainsFold(*i.TaskIDContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, publication.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, publication.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, publication.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, publication.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Message != nil {
+		predicates = append(predicates, publication.MessageEQ(*i.Message))
+	}
+	if i.MessageNEQ != nil {
+		predicates = append(predicates, publication.MessageNEQ(*i.MessageNEQ))
+	}
+	if len(i.MessageIn) > 0 {
+		predicates = append(predicates, publication.MessageIn(i.MessageIn...))
+	}
+	if len(i.MessageNotIn) > 0 {
+		predicates = append(predicates, publication.MessageNotIn(i.MessageNotIn...))
+	}
+	if i.MessageGT != nil {
+		predicates = append(predicates, publication.MessageGT(*i.MessageGT))
+	}
+	if i.MessageGTE != nil {
+		predicates = append(predicates, publication.MessageGTE(*i.MessageGTE))
+	}
+	if i.MessageLT != nil {
+		predicates = append(predicates, publication.MessageLT(*i.MessageLT))
+	}
+	if i.MessageLTE != nil {
+		predicates = append(predicates, publication.MessageLTE(*i.MessageLTE))
+	}
+	if i.MessageContains != nil {
+		predicates = append(predicates, publication.MessageContains(*i.MessageContains))
+	}
+	if i.MessageHasPrefix != nil {
+		predicates = append(predicates, publication.MessageHasPrefix(*i.MessageHasPrefix))
+	}
+	if i.MessageHasSuffix != nil {
+		predicates = append(predicates, publication.MessageHasSuffix(*i.MessageHasSuffix))
+	}
+	if i.MessageIsNil {
+		predicates = append(predicates, publication.MessageIsNil())
+	}
+	if i.MessageNotNil {
+		predicates = append(predicates, publication.MessageNotNil())
+	}
+	if i.MessageEqualFold != nil {
+		predicates = append(predicates, publication.MessageEqualFold(*i.MessageEqualFold))
+	}
+	if i.MessageContainsFold != nil {
+		predicates = append(predicates, publication.MessageContainsFold(*i.MessageContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, publication.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, publication.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, publication.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, publication.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, publication.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, publication.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, publication.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, publication.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.CompletedAt != nil {
+		predicates = append(predicates, publication.CompletedAtEQ(*i.CompletedAt))
+	}
+	if i.CompletedAtNEQ != nil {
+		predicates = append(predicates, publication.CompletedAtNEQ(*i.CompletedAtNEQ))
+	}
+	if len(i.CompletedAtIn) > 0 {
+		predicates = append(predicates, publication.CompletedAtIn(i.CompletedAtIn...))
+	}
+	if len(i.CompletedAtNotIn) > 0 {
+		predicates = append(predicates, publication.CompletedAtNotIn(i.CompletedAtNotIn...))
+	}
+	if i.CompletedAtGT != nil {
+		predicates = append(predicates, publication.CompletedAtGT(*i.CompletedAtGT))
+	}
+	if i.CompletedAtGTE != nil {
+		predicates = append(predicates, publication.CompletedAtGTE(*i.CompletedAtGTE))
+	}
+	if i.CompletedAtLT != nil {
+		predicates = append(predicates, publication.CompletedAtLT(*i.CompletedAtLT))
+	}
+	if i.CompletedAtLTE != nil {
+		predicates = append(predicates, publication.CompletedAtLTE(*i.CompletedAtLTE))
+	}
+	if i.CompletedAtIsNil {
+		predicates = append(predicates, publication.CompletedAtIsNil())
+	}
+	if i.CompletedAtNotNil {
+		predicates = append(predicates, publication.CompletedAtNotNil())
+	}
+
+	if i.HasDsse != nil {
+		p := publication.HasDsse()
+		if !*i.HasDsse {
+			p = publication.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDsseWith) > 0 {
+		with := make([]predicate.Dsse, 0, len(i.HasDsseWith))
+		for _, w := range i.HasDsseWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDsseWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, publication.HasDsseWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyPublicationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return publication.And(predicates...), nil
+	}
+}
+
+// PublishDeliveryWhereInput represents a where input for filtering PublishDelivery queries.
+type PublishDeliveryWhereInput struct {
+	Predicates []predicate.PublishDelivery  `json:"-"`
+	Not        *PublishDeliveryWhereInput   `json:"not,omitempty"`
+	Or         []*PublishDeliveryWhereInput `json:"or,omitempty"`
+	And        []*PublishDeliveryWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "gitoid_sha256" field predicates.
+	GitoidSha256             *string  `json:"gitoidSha256,omitempty"`
+	GitoidSha256NEQ          *string  `json:"gitoidSha256NEQ,omitempty"`
+	GitoidSha256In           []string `json:"gitoidSha256In,omitempty"`
+	GitoidSha256NotIn        []string `json:"gitoidSha256NotIn,omitempty"`
+	GitoidSha256GT           *string  `json:"gitoidSha256GT,omitempty"`
+	GitoidSha256GTE          *string  `json:"gitoidSha256GTE,omitempty"`
+	GitoidSha256LT           *string  `json:"gitoidSha256LT,omitempty"`
+	GitoidSha256LTE          *string  `json:"gitoidSha256LTE,omitempty"`
+	GitoidSha256Contains     *string  `json:"gitoidSha256Contains,omitempty"`
+	GitoidSha256HasPrefix    *string  `json:"gitoidSha256HasPrefix,omitempty"`
+	GitoidSha256HasSuffix    *string  `json:"gitoidSha256HasSuffix,omitempty"`
+	GitoidSha256EqualFold    *string  `json:"gitoidSha256EqualFold,omitempty"`
+	GitoidSha256ContainsFold *string  `json:"gitoidSha256ContainsFold,omitempty"`
+
+	// "publisher" field predicates.
+	Publisher             *string  `json:"publisher,omitempty"`
+	PublisherNEQ          *string  `json:"publisherNEQ,omitempty"`
+	PublisherIn           []string `json:"publisherIn,omitempty"`
+	PublisherNotIn        []string `json:"publisherNotIn,omitempty"`
+	PublisherGT           *string  `json:"publisherGT,omitempty"`
+	PublisherGTE          *string  `json:"publisherGTE,omitempty"`
+	PublisherLT           *string  `json:"publisherLT,omitempty"`
+	PublisherLTE          *string  `json:"publisherLTE,omitempty"`
+	PublisherContains     *string  `json:"publisherContains,omitempty"`
+	PublisherHasPrefix    *string  `json:"publisherHasPrefix,omitempty"`
+	PublisherHasSuffix    *string  `json:"publisherHasSuffix,omitempty"`
+	PublisherEqualFold    *string  `json:"publisherEqualFold,omitempty"`
+	PublisherContainsFold *string  `json:"publisherContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *publishdelivery.Status  `json:"status,omitempty"`
+	StatusNEQ   *publishdelivery.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []publishdelivery.Status `json:"statusIn,omitempty"`
+	StatusNotIn []publishdelivery.Status `json:"statusNotIn,omitempty"`
+
+	// "attempts" field predicates.
+	Attempts      *int  `json:"attempts,omitempty"`
+	AttemptsNEQ   *int  `json:"attemptsNEQ,omitempty"`
+	AttemptsIn    []int `json:"attemptsIn,omitempty"`
+	AttemptsNotIn []int `json:"attemptsNotIn,omitempty"`
+	AttemptsGT    *int  `json:"attemptsGT,omitempty"`
+	AttemptsGTE   *int  `json:"attemptsGTE,omitempty"`
+	AttemptsLT    *int  `json:"attemptsLT,omitempty"`
+	AttemptsLTE   *int  `json:"attemptsLTE,omitempty"`
+
+	// "next_attempt_at" field predicates.
+	NextAttemptAt      *time.Time  `json:"nextAttemptAt,omitempty"`
+	NextAttemptAtNEQ   *time.Time  `json:"nextAttemptAtNEQ,omitempty"`
+	NextAttemptAtIn    []time.Time `json:"nextAttemptAtIn,omitempty"`
+	NextAttemptAtNotIn []time.Time `json:"nextAttemptAtNotIn,omitempty"`
+	NextAttemptAtGT    *time.Time  `json:"nextAttemptAtGT,omitempty"`
+	NextAttemptAtGTE   *time.Time  `json:"nextAttemptAtGTE,omitempty"`
+	NextAttemptAtLT    *time.Time  `json:"nextAttemptAtLT,omitempty"`
+	NextAttemptAtLTE   *time.Time  `json:"nextAttemptAtLTE,omitempty"`
+
+	// "last_error" field predicates.
+	LastError             *string  `json:"lastError,omitempty"`
+	LastErrorNEQ          *string  `json:"lastErrorNEQ,omitempty"`
+	LastErrorIn           []string `json:"lastErrorIn,omitempty"`
+	LastErrorNotIn        []string `json:"lastErrorNotIn,omitempty"`
+	LastErrorGT           *string  `json:"lastErrorGT,omitempty"`
+	LastErrorGTE          *string  `json:"lastErrorGTE,omitempty"`
+	LastErrorLT           *string  `json:"lastErrorLT,omitempty"`
+	LastErrorLTE          *string  `json:"lastErrorLTE,omitempty"`
+	LastErrorContains     *string  `json:"lastErrorContains,omitempty"`
+	LastErrorHasPrefix    *string  `json:"lastErrorHasPrefix,omitempty"`
+	LastErrorHasSuffix    *string  `json:"lastErrorHasSuffix,omitempty"`
+	LastErrorIsNil        bool     `json:"lastErrorIsNil,omitempty"`
+	LastErrorNotNil       bool     `json:"lastErrorNotNil,omitempty"`
+	LastErrorEqualFold    *string  `json:"lastErrorEqualFold,omitempty"`
+	LastErrorContainsFold *string  `json:"lastErrorContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "delivered_at" field predicates.
+	DeliveredAt       *time.Time  `json:"deliveredAt,omitempty"`
+	DeliveredAtNEQ    *time.Time  `json:"deliveredAtNEQ,omitempty"`
+	DeliveredAtIn     []time.Time `json:"deliveredAtIn,omitempty"`
+	DeliveredAtNotIn  []time.Time `json:"deliveredAtNotIn,omitempty"`
+	DeliveredAtGT     *time.Time  `json:"deliveredAtGT,omitempty"`
+	DeliveredAtGTE    *time.Time  `json:"deliveredAtGTE,omitempty"`
+	DeliveredAtLT     *time.Time  `json:"deliveredAtLT,omitempty"`
+	DeliveredAtLTE    *time.Time  `json:"deliveredAtLTE,omitempty"`
+	DeliveredAtIsNil  bool        `json:"deliveredAtIsNil,omitempty"`
+	DeliveredAtNotNil bool        `json:"deliveredAtNotNil,omitempty"`
+
+	// "dsse" edge predicates.
+	HasDsse     *bool             `json:"hasDsse,omitempty"`
+	HasDsseWith []*DsseWhereInput `json:"hasDsseWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *PublishDeliveryWhereInput) AddPredicates(predicates ...predicate.PublishDelivery) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the PublishDeliveryWhereInput filter on the PublishDeliveryQuery builder.
+func (i *PublishDeliveryWhereInput) Filter(q *PublishDeliveryQuery) (*PublishDeliveryQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyPublishDeliveryWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyPublishDeliveryWhereInput is returned in case the PublishDeliveryWhereInput is empty.
+var ErrEmptyPublishDeliveryWhereInput = errors.New("ent: empty predicate PublishDeliveryWhereInput")
+
+// P returns a predicate for filtering publishdeliveries.
+// An error is returned if the input is empty or invalid.
+func (i *PublishDeliveryWhereInput) P() (predicate.PublishDelivery, error) {
+	var predicates []predicate.PublishDelivery
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, publishdelivery.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.PublishDelivery, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, publishdelivery.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.PublishDelivery, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, publishdelivery.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, publishdelivery.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, publishdelivery.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, publishdelivery.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, publishdelivery.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, publishdelivery.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, publishdelivery.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, publishdelivery.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, publishdelivery.IDLTE(*i.IDLTE))
+	}
+	if i.GitoidSha256 != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256EQ(*i.GitoidSha256))
+	}
+	if i.GitoidSha256NEQ != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256NEQ(*i.GitoidSha256NEQ))
+	}
+	if len(i.GitoidSha256In) > 0 {
+		predicates = append(predicates, publishdelivery.GitoidSha256In(i.GitoidSha256In...))
+	}
+	if len(i.GitoidSha256NotIn) > 0 {
+		predicates = append(predicates, publishdelivery.GitoidSha256NotIn(i.GitoidSha256NotIn...))
+	}
+	if i.GitoidSha256GT != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256GT(*i.GitoidSha256GT))
+	}
+	if i.GitoidSha256GTE != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256GTE(*i.GitoidSha256GTE))
+	}
+	if i.GitoidSha256LT != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256LT(*i.GitoidSha256LT))
+	}
+	if i.GitoidSha256LTE != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256LTE(*i.GitoidSha256LTE))
+	}
+	if i.GitoidSha256Contains != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256Contains(*i.GitoidSha256Contains))
+	}
+	if i.GitoidSha256HasPrefix != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256HasPrefix(*i.GitoidSha256HasPrefix))
+	}
+	if i.GitoidSha256HasSuffix != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256HasSuffix(*i.GitoidSha256HasSuffix))
+	}
+	if i.GitoidSha256EqualFold != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256EqualFold(*i.GitoidSha256EqualFold))
+	}
+	if i.GitoidSha256ContainsFold != nil {
+		predicates = append(predicates, publishdelivery.GitoidSha256ContainsFold(*i.GitoidSha256ContainsFold))
+	}
+	if i.Publisher != nil {
+		predicates = append(predicates, publishdelivery.PublisherEQ(*i.Publisher))
+	}
+	if i.PublisherNEQ != nil {
+		predicates = append(predicates, publishdelivery.PublisherNEQ(*i.PublisherNEQ))
+	}
+	if len(i.PublisherIn) > 0 {
+		predicates = append(predicates, publishdelivery.PublisherIn(i.PublisherIn...))
+	}
+	if len(i.PublisherNotIn) > 0 {
+		predicates = append(predicates, publishdelivery.PublisherNotIn(i.PublisherNotIn...))
+	}
+	if i.PublisherGT != nil {
+		predicates = append(predicates, publishdelivery.PublisherGT(*i.PublisherGT))
+	}
+	if i.PublisherGTE != nil {
+		predicates = append(predicates, publishdelivery.PublisherGTE(*i.PublisherGTE))
+	}
+	if i.PublisherLT != nil {
+		predicates = append(predicates, publishdelivery.PublisherLT(*i.PublisherLT))
+	}
+	if i.PublisherLTE != nil {
+		predicates = append(predicates, publishdelivery.PublisherLTE(*i.PublisherLTE))
+	}
+	if i.PublisherContains != nil {
+		predicates = append(predicates, publishdelivery.PublisherContains(*i.PublisherContains))
+	}
+	if i.PublisherHasPrefix != nil {
+		predicates = append(predicates, publishdelivery.PublisherHasPrefix(*i.PublisherHasPrefix))
+	}
+	if i.PublisherHasSuffix != nil {
+		predicates = append(predicates, publishdelivery.PublisherHasSuffix(*i.PublisherHasSuffix))
+	}
+	if i.PublisherEqualFold != nil {
+		predicates = append(predicates, publishdelivery.PublisherEqualFold(*i.PublisherEqualFold))
+	}
+	if i.PublisherContainsFold != nil {
+		predicates = append(predicates, publishdelivery.PublisherContainsFold(*i.PublisherContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, publishdelivery.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, publishdelivery.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, publishdelivery.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, publishdelivery.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Attempts != nil {
+		predicates = append(predicates, publishdelivery.AttemptsEQ(*i.Attempts))
+	}
+	if i.AttemptsNEQ != nil {
+		predicates = append(predicates, publishdelivery.AttemptsNEQ(*i.AttemptsNEQ))
+	}
+	if len(i.AttemptsIn) > 0 {
+		predicates = append(predicates, publishdelivery.AttemptsIn(i.AttemptsIn...))
+	}
+	if len(i.AttemptsNotIn) > 0 {
+		predicates = append(predicates, publishdelivery.AttemptsNotIn(i.AttemptsNotIn...))
+	}
+	if i.AttemptsGT != nil {
+		predicates = append(predicates, publishdelivery.AttemptsGT(*i.AttemptsGT))
+	}
+	if i.AttemptsGTE != nil {
+		predicates = append(predicates, publishdelivery.AttemptsGTE(*i.AttemptsGTE))
+	}
+	if i.AttemptsLT != nil {
+		predicates = append(predicates, publishdelivery.AttemptsLT(*i.AttemptsLT))
+	}
+	if i.AttemptsLTE != nil {
+		predicates = append(predicates, publishdelivery.AttemptsLTE(*i.AttemptsLTE))
+	}
+	if i.NextAttemptAt != nil {
+		predicates = append(predicates, publishdelivery.NextAttemptAtEQ(*i.NextAttemptAt))
+	}
+	if i.NextAttemptAtNEQ != nil {
+		predicates = append(predicates, publishdelivery.NextAttemptAtNEQ(*i.NextAttemptAtNEQ))
+	}
+	if len(i.NextAttemptAtIn) > 0 {
+		predicates = append(predicates, publishdelivery.NextAttemptAtIn(i.NextAttemptAtIn...))
+	}
+	if len(i.NextAttemptAtNotIn) > 0 {
+		predicates = append(predicates, publishdelivery.NextAttemptAtNotIn(i.NextAttemptAtNotIn...))
+	}
+	if i.NextAttemptAtGT != nil {
+		predicates = append(predicates, publishdelivery.NextAttemptAtGT(*i.NextAttemptAtGT))
+	}
+	if i.NextAttemptAtGTE != nil {
+		predicates = append(predicates, publishdelivery.NextAttemptAtGTE(*i.NextAttemptAtGTE))
+	}
+	if i.NextAttemptAtLT != nil {
+		predicates = append(predicates, publishdelivery.NextAttemptAtLT(*i.NextAttemptAtLT))
+	}
+	if i.NextAttemptAtLTE != nil {
+		predicates = append(predicates, publishdelivery.NextAttemptAtLTE(*i.NextAttemptAtLTE))
+	}
+	if i.LastError != nil {
+		predicates = append(predicates, publishdelivery.LastErrorEQ(*i.LastError))
+	}
+	if i.LastErrorNEQ != nil {
+		predicates = append(predicates, publishdelivery.LastErrorNEQ(*i.LastErrorNEQ))
+	}
+	if len(i.LastErrorIn) > 0 {
+		predicates = append(predicates, publishdelivery.LastErrorIn(i.LastErrorIn...))
+	}
+	if len(i.LastErrorNotIn) > 0 {
+		predicates = append(predicates, publishdelivery.LastErrorNotIn(i.LastErrorNotIn...))
+	}
+	if i.LastErrorGT != nil {
+		predicates = append(predicates, publishdelivery.LastErrorGT(*i.LastErrorGT))
+	}
+	if i.LastErrorGTE != nil {
+		predicates = append(predicates, publishdelivery.LastErrorGTE(*i.LastErrorGTE))
+	}
+	if i.LastErrorLT != nil {
+		predicates = append(predicates, publishdelivery.LastErrorLT(*i.LastErrorLT))
+	}
+	if i.LastErrorLTE != nil {
+		predicates = append(predicates, publishdelivery.LastErrorLTE(*i.LastErrorLTE))
+	}
+	if i.LastErrorContains != nil {
+		predicates = append(predicates, publishdelivery.LastErrorContains(*i.LastErrorContains))
+	}
+	if i.LastErrorHasPrefix != nil {
+		predicates = append(predicates, publishdelivery.LastErrorHasPrefix(*i.LastErrorHasPrefix))
+	}
+	if i.LastErrorHasSuffix != nil {
+		predicates = append(predicates, publishdelivery.LastErrorHasSuffix(*i.LastErrorHasSuffix))
+	}
+	if i.LastErrorIsNil {
+		predicates = append(predicates, publishdelivery.LastErrorIsNil())
+	}
+	if i.LastErrorNotNil {
+		predicates = append(predicates, publishdelivery.LastErrorNotNil())
+	}
+	if i.LastErrorEqualFold != nil {
+		predicates = append(predicates, publishdelivery.LastErrorEqualFold(*i.LastErrorEqualFold))
+	}
+	if i.LastErrorContainsFold != nil {
+		predicates = append(predicates, publishdelivery.LastErrorContainsFold(*i.LastErrorContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, publishdelivery.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, publishdelivery.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, publishdelivery.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, publishdelivery.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, publishdelivery.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, publishdelivery.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, publishdelivery.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, publishdelivery.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.DeliveredAt != nil {
+		predicates = append(predicates, publishdelivery.DeliveredAtEQ(*i.DeliveredAt))
+	}
+	if i.DeliveredAtNEQ != nil {
+		predicates = append(predicates, publishdelivery.DeliveredAtNEQ(*i.DeliveredAtNEQ))
+	}
+	if len(i.DeliveredAtIn) > 0 {
+		predicates = append(predicates, publishdelivery.DeliveredAtIn(i.DeliveredAtIn...))
+	}
+	if len(i.DeliveredAtNotIn) > 0 {
+		predicates = append(predicates, publishdelivery.DeliveredAtNotIn(i.DeliveredAtNotIn...))
+	}
+	if i.DeliveredAtGT != nil {
+		predicates = append(predicates, publishdelivery.DeliveredAtGT(*i.DeliveredAtGT))
+	}
+	if i.DeliveredAtGTE != nil {
+		predicates = append(predicates, publishdelivery.DeliveredAtGTE(*i.DeliveredAtGTE))
+	}
+	if i.DeliveredAtLT != nil {
+		predicates = append(predicates, publishdelivery.DeliveredAtLT(*i.DeliveredAtLT))
+	}
+	if i.DeliveredAtLTE != nil {
+		predicates = append(predicates, publishdelivery.DeliveredAtLTE(*i.DeliveredAtLTE))
+	}
+	if i.DeliveredAtIsNil {
+		predicates = append(predicates, publishdelivery.DeliveredAtIsNil())
+	}
+	if i.DeliveredAtNotNil {
+		predicates = append(predicates, publishdelivery.DeliveredAtNotNil())
+	}
+
+	if i.HasDsse != nil {
+		p := publishdelivery.HasDsse()
+		if !*i.HasDsse {
+			p = publishdelivery.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDsseWith) > 0 {
+		with := make([]predicate.Dsse, 0, len(i.HasDsseWith))
+		for _, w := range i.HasDsseWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDsseWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, publishdelivery.HasDsseWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyPublishDeliveryWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return publishdelivery.And(predicates...), nil
+	}
+}
+
+// SbomPackageWhereInput represents a where input for filtering SbomPackage queries.
+type SbomPackageWhereInput struct {
+	Predicates []predicate.SbomPackage  `json:"-"`
+	Not        *SbomPackageWhereInput   `json:"not,omitempty"`
+	Or         []*SbomPackageWhereInput `json:"or,omitempty"`
+	And        []*SbomPackageWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "version" field predicates.
+	Version             *string  `json:"version,omitempty"`
+	VersionNEQ          *string  `json:"versionNEQ,omitempty"`
+	VersionIn           []string `json:"versionIn,omitempty"`
+	VersionNotIn        []string `json:"versionNotIn,omitempty"`
+	VersionGT           *string  `json:"versionGT,omitempty"`
+	VersionGTE          *string  `json:"versionGTE,omitempty"`
+	VersionLT           *string  `json:"versionLT,omitempty"`
+	VersionLTE          *string  `json:"versionLTE,omitempty"`
+	VersionContains     *string  `json:"versionContains,omitempty"`
+	VersionHasPrefix    *string  `json:"versionHasPrefix,omitempty"`
+	VersionHasSuffix    *string  `json:"versionHasSuffix,omitempty"`
+	VersionIsNil        bool     `json:"versionIsNil,omitempty"`
+	VersionNotNil       bool     `json:"versionNotNil,omitempty"`
+	VersionEqualFold    *string  `json:"versionEqualFold,omitempty"`
+	VersionContainsFold *string  `json:"versionContainsFold,omitempty"`
+
+	// "purl" field predicates.
+	Purl             *string  `json:"purl,omitempty"`
+	PurlNEQ          *string  `json:"purlNEQ,omitempty"`
+	PurlIn           []string `json:"purlIn,omitempty"`
+	PurlNotIn        []string `json:"purlNotIn,omitempty"`
+	PurlGT           *string  `json:"purlGT,omitempty"`
+	PurlGTE          *string  `json:"purlGTE,omitempty"`
+	PurlLT           *string  `json:"purlLT,omitempty"`
+	PurlLTE          *string  `json:"purlLTE,omitempty"`
+	PurlContains     *string  `json:"purlContains,omitempty"`
+	PurlHasPrefix    *string  `json:"purlHasPrefix,omitempty"`
+	PurlHasSuffix    *string  `json:"purlHasSuffix,omitempty"`
+	PurlIsNil        bool     `json:"purlIsNil,omitempty"`
+	PurlNotNil       bool     `json:"purlNotNil,omitempty"`
+	PurlEqualFold    *string  `json:"purlEqualFold,omitempty"`
+	PurlContainsFold *string  `json:"purlContainsFold,omitempty"`
+
+	// "license" field predicates.
+	License             *string  `json:"license,omitempty"`
+	LicenseNEQ          *string  `json:"licenseNEQ,omitempty"`
+	LicenseIn           []string `json:"licenseIn,omitempty"`
+	LicenseNotIn        []string `json:"licenseNotIn,omitempty"`
+	LicenseGT           *string  `json:"licenseGT,omitempty"`
+	LicenseGTE          *string  `json:"licenseGTE,omitempty"`
+	LicenseLT           *string  `json:"licenseLT,omitempty"`
+	LicenseLTE          *string  `json:"licenseLTE,omitempty"`
+	LicenseContains     *string  `json:"licenseContains,omitempty"`
+	LicenseHasPrefix    *string  `json:"licenseHasPrefix,omitempty"`
+	LicenseHasSuffix    *string  `json:"licenseHasSuffix,omitempty"`
+	LicenseIsNil        bool     `json:"licenseIsNil,omitempty"`
+	LicenseNotNil       bool     `json:"licenseNotNil,omitempty"`
+	LicenseEqualFold    *string  `json:"licenseEqualFold,omitempty"`
+	LicenseContainsFold *string  `json:"licenseContainsFold,omitempty"`
+
+	// "digests" edge predicates.
+	HasDigests     *bool                          `json:"hasDigests,omitempty"`
+	HasDigestsWith []*SbomPackageDigestWhereInput `json:"hasDigestsWith,omitempty"`
+
+	// "statement" edge predicates.
+	HasStatement     *bool                  `json:"hasStatement,omitempty"`
+	HasStatementWith []*StatementWhereInput `json:"hasStatementWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SbomPackageWhereInput) AddPredicates(predicates ...predicate.SbomPackage) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SbomPackageWhereInput filter on the SbomPackageQuery builder.
+func (i *SbomPackageWhereInput) Filter(q *SbomPackageQuery) (*SbomPackageQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySbomPackageWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptySbomPackageWhereInput is returned in case the SbomPackageWhereInput is empty.
+var ErrEmptySbomPackageWhereInput = errors.New("ent: empty predicate SbomPackageWhereInput")
+
+// P returns a predicate for filtering sbompackages.
+// An error is returned if the input is empty or invalid.
+func (i *SbomPackageWhereInput) P() (predicate.SbomPackage, error) {
+	var predicates []predicate.SbomPackage
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, sbompackage.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.SbomPackage, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, sbompackage.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.SbomPackage, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, sbompackage.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, sbompackage.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, sbompackage.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, sbompackage.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, sbompackage.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, sbompackage.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, sbompackage.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, sbompackage.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, sbompackage.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, sbompackage.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, sbompackage.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, sbompackage.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, sbompackage.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, sbompackage.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, sbompackage.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, sbompackage.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, sbompackage.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, sbompackage.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, sbompackage.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, sbompackage.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, sbompackage.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, sbompackage.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Version != nil {
+		predicates = append(predicates, sbompackage.VersionEQ(*i.Version))
+	}
+	if i.VersionNEQ != nil {
+		predicates = append(predicates, sbompackage.VersionNEQ(*i.VersionNEQ))
+	}
+	if len(i.VersionIn) > 0 {
+		predicates = append(predicates, sbompackage.VersionIn(i.VersionIn...))
+	}
+	if len(i.VersionNotIn) > 0 {
+		predicates = append(predicates, sbompackage.VersionNotIn(i.VersionNotIn...))
+	}
+	if i.VersionGT != nil {
+		predicates = append(predicates, sbompackage.VersionGT(*i.VersionGT))
+	}
+	if i.VersionGTE != nil {
+		predicates = append(predicates, sbompackage.VersionGTE(*i.VersionGTE))
+	}
+	if i.VersionLT != nil {
+		predicates = append(predicates, sbompackage.VersionLT(*i.VersionLT))
+	}
+	if i.VersionLTE != nil {
+		predicates = append(predicates, sbompackage.VersionLTE(*i.VersionLTE))
+	}
+	if i.VersionContains != nil {
+		predicates = append(predicates, sbompackage.VersionContains(*i.VersionContains))
+	}
+	if i.VersionHasPrefix != nil {
+		predicates = append(predicates, sbompackage.VersionHasPrefix(*i.VersionHasPrefix))
+	}
+	if i.VersionHasSuffix != nil {
+		predicates = append(predicates, sbompackage.VersionHasSuffix(*i.VersionHasSuffix))
+	}
+	if i.VersionIsNil {
+		predicates = append(predicates, sbompackage.VersionIsNil())
+	}
+	if i.VersionNotNil {
+		predicates = append(predicates, sbompackage.VersionNotNil())
+	}
+	if i.VersionEqualFold != nil {
+		predicates = append(predicates, sbompackage.VersionEqualFold(*i.VersionEqualFold))
+	}
+	if i.VersionContainsFold != nil {
+		predicates = append(predicates, sbompackage.VersionContainsFold(*i.VersionContainsFold))
+	}
+	if i.Purl != nil {
+		predicates = append(predicates, sbompackage.PurlEQ(*i.Purl))
+	}
+	if i.PurlNEQ != nil {
+		predicates = append(predicates, sbompackage.PurlNEQ(*i.PurlNEQ))
+	}
+	if len(i.PurlIn) > 0 {
+		predicates = append(predicates, sbompackage.PurlIn(i.PurlIn...))
+	}
+	if len(i.PurlNotIn) > 0 {
+		predicates = append(predicates, sbompackage.PurlNotIn(i.PurlNotIn...))
+	}
+	if i.PurlGT != nil {
+		predicates = append(predicates, sbompackage.PurlGT(*i.PurlGT))
+	}
+	if i.PurlGTE != nil {
+		predicates = append(predicates, sbompackage.PurlGTE(*i.PurlGTE))
+	}
+	if i.PurlLT != nil {
+		predicates = append(predicates, sbompackage.PurlLT(*i.PurlLT))
+	}
+	if i.PurlLTE != nil {
+		predicates = append(predicates, sbompackage.PurlLTE(*i.PurlLTE))
+	}
+	if i.PurlContains != nil {
+		predicates = append(predicates, sbompackage.PurlContains(*i.PurlContains))
+	}
+	if i.PurlHasPrefix != nil {
+		predicates = append(predicates, sbompackage.PurlHasPrefix(*i.PurlHasPrefix))
+	}
+	if i.PurlHasSuffix != nil {
+		predicates = append(predicates, sbompackage.PurlHasSuffix(*i.PurlHasSuffix))
+	}
+	if i.PurlIsNil {
+		predicates = append(predicates, sbompackage.PurlIsNil())
+	}
+	if i.PurlNotNil {
+		predicates = append(predicates, sbompackage.PurlNotNil())
+	}
+	if i.PurlEqualFold != nil {
+		predicates = append(predicates, sbompackage.PurlEqualFold(*i.PurlEqualFold))
+	}
+	if i.PurlContainsFold != nil {
+		predicates = append(predicates, sbompackage.PurlContainsFold(*i.PurlContainsFold))
+	}
+	if i.License != nil {
+		predicates = append(predicates, sbompackage.LicenseEQ(*i.License))
+	}
+	if i.LicenseNEQ != nil {
+		predicates = append(predicates, sbompackage.LicenseNEQ(*i.LicenseNEQ))
+	}
+	if len(i.LicenseIn) > 0 {
+		predicates = append(predicates, sbompackage.LicenseIn(i.LicenseIn...))
+	}
+	if len(i.LicenseNotIn) > 0 {
+		predicates = append(predicates, sbompackage.LicenseNotIn(i.LicenseNotIn...))
+	}
+	if i.LicenseGT != nil {
+		predicates = append(predicates, sbompackage.LicenseGT(*i.LicenseGT))
+	}
+	if i.LicenseGTE != nil {
+		predicates = append(predicates, sbompackage.LicenseGTE(*i.LicenseGTE))
+	}
+	if i.LicenseLT != nil {
+		predicates = append(predicates, sbompackage.LicenseLT(*i.LicenseLT))
+	}
+	if i.LicenseLTE != nil {
+		predicates = append(predicates, sbompackage.LicenseLTE(*i.LicenseLTE))
+	}
+	if i.LicenseContains != nil {
+		predicates = append(predicates, sbompackage.LicenseContains(*i.LicenseContains))
+	}
+	if i.LicenseHasPrefix != nil {
+		predicates = append(predicates, sbompackage.LicenseHasPrefix(*i.LicenseHasPrefix))
+	}
+	if i.LicenseHasSuffix != nil {
+		predicates = append(predicates, sbompackage.LicenseHasSuffix(*i.LicenseHasSuffix))
+	}
+	if i.LicenseIsNil {
+		predicates = append(predicates, sbompackage.LicenseIsNil())
+	}
+	if i.LicenseNotNil {
+		predicates = append(predicates, sbompackage.LicenseNotNil())
+	}
+	if i.LicenseEqualFold != nil {
+		predicates = append(predicates, sbompackage.LicenseEqualFold(*i.LicenseEqualFold))
+	}
+	if i.LicenseContainsFold != nil {
+		predicates = append(predicates, sbompackage.LicenseContainsFold(*i.LicenseContainsFold))
+	}
+
+	if i.HasDigests != nil {
+		p := sbompackage.HasDigests()
+		if !*i.HasDigests {
+			p = sbompackage.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDigestsWith) > 0 {
+		with := make([]predicate.SbomPackageDigest, 0, len(i.HasDigestsWith))
+		for _, w := range i.HasDigestsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDigestsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, sbompackage.HasDigestsWith(with...))
+	}
+	if i.HasStatement != nil {
+		p := sbompackage.HasStatement()
+		if !*i.HasStatement {
+			p = sbompackage.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasStatementWith) > 0 {
+		with := make([]predicate.Statement, 0, len(i.HasStatementWith))
+		for _, w := range i.HasStatementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasStatementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, sbompackage.HasStatementWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptySbomPackageWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return sbompackage.And(predicates...), nil
+	}
+}
+
+// SbomPackageDigestWhereInput represents a where input for filtering SbomPackageDigest queries.
+type SbomPackageDigestWhereInput struct {
+	Predicates []predicate.SbomPackageDigest  `json:"-"`
+	Not        *SbomPackageDigestWhereInput   `json:"not,omitempty"`
+	Or         []*SbomPackageDigestWhereInput `json:"or,omitempty"`
+	And        []*SbomPackageDigestWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "algorithm" field predicates.
+	Algorithm             *string  `json:"algorithm,omitempty"`
+	AlgorithmNEQ          *string  `json:"algorithmNEQ,omitempty"`
+	AlgorithmIn           []string `json:"algorithmIn,omitempty"`
+	AlgorithmNotIn        []string `json:"algorithmNotIn,omitempty"`
+	AlgorithmGT           *string  `json:"algorithmGT,omitempty"`
+	AlgorithmGTE          *string  `json:"algorithmGTE,omitempty"`
+	AlgorithmLT           *string  `json:"algorithmLT,omitempty"`
+	AlgorithmLTE          *string  `json:"algorithmLTE,omitempty"`
+	AlgorithmContains     *string  `json:"algorithmContains,omitempty"`
+	AlgorithmHasPrefix    *string  `json:"algorithmHasPrefix,omitempty"`
+	AlgorithmHasSuffix    *string  `json:"algorithmHasSuffix,omitempty"`
+	AlgorithmEqualFold    *string  `json:"algorithmEqualFold,omitempty"`
+	AlgorithmContainsFold *string  `json:"algorithmContainsFold,omitempty"`
+
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+
+	// "sbom_package" edge predicates.
+	HasSbomPackage     *bool                    `json:"hasSbomPackage,omitempty"`
+	HasSbomPackageWith []*SbomPackageWhereInput `json:"hasSbomPackageWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SbomPackageDigestWhereInput) AddPredicates(predicates ...predicate.SbomPackageDigest) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SbomPackageDigestWhereInput filter on the SbomPackageDigestQuery builder.
+func (i *SbomPackageDigestWhereInput) Filter(q *SbomPackageDigestQuery) (*SbomPackageDigestQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySbomPackageDigestWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptySbomPackageDigestWhereInput is returned in case the SbomPackageDigestWhereInput is empty.
+var ErrEmptySbomPackageDigestWhereInput = errors.New("ent: empty predicate SbomPackageDigestWhereInput")
+
+// P returns a predicate for filtering sbompackagedigests.
+// An error is returned if the input is empty or invalid.
+func (i *SbomPackageDigestWhereInput) P() (predicate.SbomPackageDigest, error) {
+	var predicates []predicate.SbomPackageDigest
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, sbompackagedigest.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.SbomPackageDigest, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, sbompackagedigest.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.SbomPackageDigest, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, sbompackagedigest.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, sbompackagedigest.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, sbompackagedigest.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, sbompackagedigest.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, sbompackagedigest.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, sbompackagedigest.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, sbompackagedigest.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, sbompackagedigest.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, sbompackagedigest.IDLTE(*i.IDLTE))
+	}
+	if i.Algorithm != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmEQ(*i.Algorithm))
+	}
+	if i.AlgorithmNEQ != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmNEQ(*i.AlgorithmNEQ))
+	}
+	if len(i.AlgorithmIn) > 0 {
+		predicates = append(predicates, sbompackagedigest.AlgorithmIn(i.AlgorithmIn...))
+	}
+	if len(i.AlgorithmNotIn) > 0 {
+		predicates = append(predicates, sbompackagedigest.AlgorithmNotIn(i.AlgorithmNotIn...))
+	}
+	if i.AlgorithmGT != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmGT(*i.AlgorithmGT))
+	}
+	if i.AlgorithmGTE != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmGTE(*i.AlgorithmGTE))
+	}
+	if i.AlgorithmLT != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmLT(*i.AlgorithmLT))
+	}
+	if i.AlgorithmLTE != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmLTE(*i.AlgorithmLTE))
+	}
+	if i.AlgorithmContains != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmContains(*i.AlgorithmContains))
+	}
+	if i.AlgorithmHasPrefix != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmHasPrefix(*i.AlgorithmHasPrefix))
+	}
+	if i.AlgorithmHasSuffix != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmHasSuffix(*i.AlgorithmHasSuffix))
+	}
+	if i.AlgorithmEqualFold != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmEqualFold(*i.AlgorithmEqualFold))
+	}
+	if i.AlgorithmContainsFold != nil {
+		predicates = append(predicates, sbompackagedigest.AlgorithmContainsFold(*i.AlgorithmContainsFold))
+	}
+	if i.Value != nil {
+		predicates = append(predicates, sbompackagedigest.ValueEQ(*i.Value))
+	}
+	if i.ValueNEQ != nil {
+		predicates = append(predicates, sbompackagedigest.ValueNEQ(*i.ValueNEQ))
+	}
+	if len(i.ValueIn) > 0 {
+		predicates = append(predicates, sbompackagedigest.ValueIn(i.ValueIn...))
+	}
+	if len(i.ValueNotIn) > 0 {
+		predicates = append(predicates, sbompackagedigest.ValueNotIn(i.ValueNotIn...))
+	}
+	if i.ValueGT != nil {
+		predicates = append(predicates, sbompackagedigest.ValueGT(*i.ValueGT))
+	}
+	if i.ValueGTE != nil {
+		predicates = append(predicates, sbompackagedigest.ValueGTE(*i.ValueGTE))
+	}
+	if i.ValueLT != nil {
+		predicates = append(predicates, sbompackagedigest.ValueLT(*i.ValueLT))
+	}
+	if i.ValueLTE != nil {
+		predicates = append(predicates, sbompackagedigest.ValueLTE(*i.ValueLTE))
+	}
+	if i.ValueContains != nil {
+		predicates = append(predicates, sbompackagedigest.ValueContains(*i.ValueContains))
+	}
+	if i.ValueHasPrefix != nil {
+		predicates = append(predicates, sbompackagedigest.ValueHasPrefix(*i.ValueHasPrefix))
+	}
+	if i.ValueHasSuffix != nil {
+		predicates = append(predicates, sbompackagedigest.ValueHasSuffix(*i.ValueHasSuffix))
+	}
+	if i.ValueEqualFold != nil {
+		predicates = append(predicates, sbompackagedigest.ValueEqualFold(*i.ValueEqualFold))
+	}
+	if i.ValueContainsFold != nil {
+		predicates = append(predicates, sbompackagedigest.ValueContainsFold(*i.ValueContainsFold))
+	}
+
+	if i.HasSbomPackage != nil {
+		p := sbompackagedigest.HasSbomPackage()
+		if !*i.HasSbomPackage {
+			p = sbompackagedigest.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSbomPackageWith) > 0 {
+		with := make([]predicate.SbomPackage, 0, len(i.HasSbomPackageWith))
+		for _, w := range i.HasSbomPackageWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSbomPackageWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, sbompackagedigest.HasSbomPackageWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptySbomPackageDigestWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return sbompackagedigest.And(predicates...), nil
+	}
+}
+
+// SignatureWhereInput represents a where input for filtering Signature queries.
+type SignatureWhereInput struct {
+	Predicates []predicate.Signature  `json:"-"`
+	Not        *SignatureWhereInput   `json:"not,omitempty"`
+	Or         []*SignatureWhereInput `json:"or,omitempty"`
+	And        []*SignatureWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "key_id" field predicates.
+	KeyID             *string  `json:"keyID,omitempty"`
+	KeyIDNEQ          *string  `json:"keyIDNEQ,omitempty"`
+	KeyIDIn           []string `json:"keyIDIn,omitempty"`
+	KeyIDNotIn        []string `json:"keyIDNotIn,omitempty"`
+	KeyIDGT           *string  `json:"keyIDGT,omitempty"`
+	KeyIDGTE          *string  `json:"keyIDGTE,omitempty"`
+	KeyIDLT           *string  `json:"keyIDLT,omitempty"`
+	KeyIDLTE          *string  `json:"keyIDLTE,omitempty"`
+	KeyIDContains     *string  `json:"keyIDContains,omitempty"`
+	KeyIDHasPrefix    *string  `json:"keyIDHasPrefix,omitempty"`
+	KeyIDHasSuffix    *string  `json:"keyIDHasSuffix,omitempty"`
+	KeyIDEqualFold    *string  `json:"keyIDEqualFold,omitempty"`
+	KeyIDContainsFold *string  `json:"keyIDContainsFold,omitempty"`
+
+	// "signature" field predicates.
+	Signature             *string  `json:"signature,omitempty"`
+	SignatureNEQ          *string  `json:"signatureNEQ,omitempty"`
+	SignatureIn           []string `json:"signatureIn,omitempty"`
+	SignatureNotIn        []string `json:"signatureNotIn,omitempty"`
+	SignatureGT           *string  `json:"signatureGT,omitempty"`
+	SignatureGTE          *string  `json:"signatureGTE,omitempty"`
+	SignatureLT           *string  `json:"signatureLT,omitempty"`
+	SignatureLTE          *string  `json:"signatureLTE,omitempty"`
+	SignatureContains     *string  `json:"signatureContains,omitempty"`
+	SignatureHasPrefix    *string  `json:"signatureHasPrefix,omitempty"`
+	SignatureHasSuffix    *string  `json:"signatureHasSuffix,omitempty"`
+	SignatureEqualFold    *string  `json:"signatureEqualFold,omitempty"`
+	SignatureContainsFold *string  `json:"signatureContainsFold,omitempty"`
+
+	// "verified" field predicates.
+	Verified    *bool `json:"verified,omitempty"`
+	VerifiedNEQ *bool `json:"verifiedNEQ,omitempty"`
+
+	// "dsse" edge predicates.
+	HasDsse     *bool             `json:"hasDsse,omitempty"`
+	HasDsseWith []*DsseWhereInput `json:"hasDsseWith,omitempty"`
+
+	// "timestamps" edge predicates.
+	HasTimestamps     *bool                  `json:"hasTimestamps,omitempty"`
+	HasTimestampsWith []*TimestampWhereInput `json:"hasTimestampsWith,omitempty"`
+
+	// "certificates" edge predicates.
+	HasCertificates     *bool                    `json:"hasCertificates,omitempty"`
+	HasCertificatesWith []*CertificateWhereInput `json:"hasCertificatesWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SignatureWhereInput) AddPredicates(predicates ...predicate.Signature) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SignatureWhereInput filter on the SignatureQuery builder.
+func (i *SignatureWhereInput) Filter(q *SignatureQuery) (*SignatureQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySignatureWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptySignatureWhereInput is returned in case the SignatureWhereInput is empty.
+var ErrEmptySignatureWhereInput = errors.New("ent: empty predicate SignatureWhereInput")
+
+// P returns a predicate for filtering signatures.
+// An error is returned if the input is empty or invalid.
+func (i *SignatureWhereInput) P() (predicate.Signature, error) {
+	var predicates []predicate.Signature
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, signature.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Signature, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, signature.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Signature, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, signature.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, signature.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, signature.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, signature.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, signature.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, signature.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, signature.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, signature.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, signature.IDLTE(*i.IDLTE))
+	}
+	if i.KeyID != nil {
+		predicates = append(predicates, signature.KeyIDEQ(*i.KeyID))
+	}
+	if i.KeyIDNEQ != nil {
+		predicates = append(predicates, signature.KeyIDNEQ(*i.KeyIDNEQ))
+	}
+	if len(i.KeyIDIn) > 0 {
+		predicates = append(predicates, signature.KeyIDIn(i.KeyIDIn...))
+	}
+	if len(i.KeyIDNotIn) > 0 {
+		predicates = append(predicates, signature.KeyIDNotIn(i.KeyIDNotIn...))
+	}
+	if i.KeyIDGT != nil {
+		predicates = append(predicates, signature.KeyIDGT(*i.KeyIDGT))
+	}
+	if i.KeyIDGTE != nil {
+		predicates = append(predicates, signature.KeyIDGTE(*i.KeyIDGTE))
+	}
+	if i.KeyIDLT != nil {
+		predicates = append(predicates, signature.KeyIDLT(*i.KeyIDLT))
+	}
+	if i.KeyIDLTE != nil {
+		predicates = append(predicates, signature.KeyIDLTE(*i.KeyIDLTE))
+	}
+	if i.KeyIDContains != nil {
+		predicates = append(predicates, signature.KeyIDContains(*i.KeyIDContains))
+	}
+	if i.KeyIDHasPrefix != nil {
+		predicates = append(predicates, signature.KeyIDHasPrefix(*i.KeyIDHasPrefix))
+	}
+	if i.KeyIDHasSuffix != nil {
+		predicates = append(predicates, signature.KeyIDHasSuffix(*i.KeyIDHasSuffix))
+	}
+	if i.KeyIDEqualFold != nil {
+		predicates = append(predicates, signature.KeyIDEqualFold(*i.KeyIDEqualFold))
+	}
+	if i.KeyIDContainsFold != nil {
+		predicates = append(predicates, signature.KeyIDContainsFold(*i.KeyIDContainsFold))
+	}
+	if i.Signature != nil {
+		predicates = append(predicates, signature.SignatureEQ(*i.Signature))
+	}
+	if i.SignatureNEQ != nil {
+		predicates = append(predicates, signature.SignatureNEQ(*i.SignatureNEQ))
+	}
+	if len(i.SignatureIn) > 0 {
+		predicates = append(predicates, signature.SignatureIn(i.SignatureIn...))
+	}
+	if len(i.SignatureNotIn) > 0 {
+		predicates = append(predicates, signature.SignatureNotIn(i.SignatureNotIn...))
+	}
+	if i.SignatureGT != nil {
+		predicates = append(predicates, signature.SignatureGT(*i.SignatureGT))
+	}
+	if i.SignatureGTE != nil {
+		predicates = append(predicates, signature.SignatureGTE(*i.SignatureGTE))
+	}
+	if i.SignatureLT != nil {
+		predicates = append(predicates, signature.SignatureLT(*i.SignatureLT))
+	}
+	if i.SignatureLTE != nil {
+		predicates = append(predicates, signature.SignatureLTE(*i.SignatureLTE))
+	}
+	if i.SignatureContains != nil {
+		predicates = append(predicates, signature.SignatureContains(*i.SignatureContains))
+	}
+	if i.SignatureHasPrefix != nil {
+		predicates = append(predicates, signature.SignatureHasPrefix(*i.SignatureHasPrefix))
+	}
+	if i.SignatureHasSuffix != nil {
+		predicates = append(predicates, signature.SignatureHasSuffix(*i.SignatureHasSuffix))
+	}
+	if i.SignatureEqualFold != nil {
+		predicates = append(predicates, signature.SignatureEqualFold(*i.SignatureEqualFold))
+	}
+	if i.SignatureContainsFold != nil {
+		predicates = append(predicates, signature.SignatureContainsFold(*i.SignatureContainsFold))
+	}
+	if i.Verified != nil {
+		predicates = append(predicates, signature.VerifiedEQ(*i.Verified))
+	}
+	if i.VerifiedNEQ != nil {
+		predicates = append(predicates, signature.VerifiedNEQ(*i.VerifiedNEQ))
+	}
+
+	if i.HasDsse != nil {
+		p := signature.HasDsse()
+		if !*i.HasDsse {
+			p = signature.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDsseWith) > 0 {
+		with := make([]predicate.Dsse, 0, len(i.HasDsseWith))
+		for _, w := range i.HasDsseWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDsseWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, signature.HasDsseWith(with...))
+	}
+	if i.HasTimestamps != nil {
+		p := signature.HasTimestamps()
+		if !*i.HasTimestamps {
+			p = signature.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasTimestampsWith) > 0 {
+		with := make([]predicate.Timestamp, 0, len(i.HasTimestampsWith))
+		for _, w := range i.HasTimestampsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTimestampsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, signature.HasTimestampsWith(with...))
+	}
+	if i.HasCertificates != nil {
+		p := signature.HasCertificates()
+		if !*i.HasCertificates {
+			p = signature.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasCertificatesWith) > 0 {
+		with := make([]predicate.Certificate, 0, len(i.HasCertificatesWith))
+		for _, w := range i.HasCertificatesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasCertificatesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, signature.HasCertificatesWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptySignatureWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return signature.And(predicates...), nil
+	}
+}
+
+// SlsaDependencyWhereInput represents a where input for filtering SlsaDependency queries.
+type SlsaDependencyWhereInput struct {
+	Predicates []predicate.SlsaDependency  `json:"-"`
+	Not        *SlsaDependencyWhereInput   `json:"not,omitempty"`
+	Or         []*SlsaDependencyWhereInput `json:"or,omitempty"`
+	And        []*SlsaDependencyWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "uri" field predicates.
+	URI             *string  `json:"uri,omitempty"`
+	URINEQ          *string  `json:"uriNEQ,omitempty"`
+	URIIn           []string `json:"uriIn,omitempty"`
+	URINotIn        []string `json:"uriNotIn,omitempty"`
+	URIGT           *string  `json:"uriGT,omitempty"`
+	URIGTE          *string  `json:"uriGTE,omitempty"`
+	URILT           *string  `json:"uriLT,omitempty"`
+	URILTE          *string  `json:"uriLTE,omitempty"`
+	URIContains     *string  `json:"uriContains,omitempty"`
+	URIHasPrefix    *string  `json:"uriHasPrefix,omitempty"`
+	URIHasSuffix    *string  `json:"uriHasSuffix,omitempty"`
+	URIIsNil        bool     `json:"uriIsNil,omitempty"`
+	URINotNil       bool     `json:"uriNotNil,omitempty"`
+	URIEqualFold    *string  `json:"uriEqualFold,omitempty"`
+	URIContainsFold *string  `json:"uriContainsFold,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameIsNil        bool     `json:"nameIsNil,omitempty"`
+	NameNotNil       bool     `json:"nameNotNil,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "algorithm" field predicates.
+	Algorithm             *string  `json:"algorithm,omitempty"`
+	AlgorithmNEQ          *string  `json:"algorithmNEQ,omitempty"`
+	AlgorithmIn           []string `json:"algorithmIn,omitempty"`
+	AlgorithmNotIn        []string `json:"algorithmNotIn,omitempty"`
+	AlgorithmGT           *string  `json:"algorithmGT,omitempty"`
+	AlgorithmGTE          *string  `json:"algorithmGTE,omitempty"`
+	AlgorithmLT           *string  `json:"algorithmLT,omitempty"`
+	AlgorithmLTE          *string  `json:"algorithmLTE,omitempty"`
+	AlgorithmContains     *string  `json:"algorithmContains,omitempty"`
+	AlgorithmHasPrefix    *string  `json:"algorithmHasPrefix,omitempty"`
+	AlgorithmHasSuffix    *string  `json:"algorithmHasSuffix,omitempty"`
+	AlgorithmIsNil        bool     `json:"algorithmIsNil,omitempty"`
+	AlgorithmNotNil       bool     `json:"algorithmNotNil,omitempty"`
+	AlgorithmEqualFold    *string  `json:"algorithmEqualFold,omitempty"`
+	AlgorithmContainsFold *string  `json:"algorithmContainsFold,omitempty"`
+
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueIsNil        bool     `json:"valueIsNil,omitempty"`
+	ValueNotNil       bool     `json:"valueNotNil,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+
+	// "slsa_provenance" edge predicates.
+	HasSlsaProvenance     *bool                       `json:"hasSlsaProvenance,omitempty"`
+	HasSlsaProvenanceWith []*SlsaProvenanceWhereInput `json:"hasSlsaProvenanceWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SlsaDependencyWhereInput) AddPredicates(predicates ...predicate.SlsaDependency) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SlsaDependencyWhereInput filter on the SlsaDependencyQuery builder.
+func (i *SlsaDependencyWhereInput) Filter(q *SlsaDependencyQuery) (*SlsaDependencyQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySlsaDependencyWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptySlsaDependencyWhereInput is returned in case the SlsaDependencyWhereInput is empty.
+var ErrEmptySlsaDependencyWhereInput = errors.New("ent: empty predicate SlsaDependencyWhereInput")
+
+// P returns a predicate for filtering slsadependencies.
+// An error is returned if the input is empty or invalid.
+func (i *SlsaDependencyWhereInput) P() (predicate.SlsaDependency, error) {
+	var predicates []predicate.SlsaDependency
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, slsadependency.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.SlsaDependency, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, slsadependency.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.SlsaDependency, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, slsadependency.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, slsadependency.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, slsadependency.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, slsadependency.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, slsadependency.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, slsadependency.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, slsadependency.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, slsadependency.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, slsadependency.IDLTE(*i.IDLTE))
+	}
+	if i.URI != nil {
+		predicates = append(predicates, slsadependency.URIEQ(*i.URI))
+	}
+	if i.URINEQ != nil {
+		predicates = append(predicates, slsadependency.URINEQ(*i.URINEQ))
+	}
+	if len(i.URIIn) > 0 {
+		predicates = append(predicates, slsadependency.URIIn(i.URIIn...))
+	}
+	if len(i.URINotIn) > 0 {
+		predicates = append(predicates, slsadependency.URINotIn(i.URINotIn...))
+	}
+	if i.URIGT != nil {
+		predicates = append(predicates, slsadependency.URIGT(*i.URIGT))
+	}
+	if i.URIGTE != nil {
+		predicates = append(predicates, slsadependency.URIGTE(*i.URIGTE))
+	}
+	if i.URILT != nil {
+		predicates = append(predicates, slsadependency.URILT(*i.URILT))
+	}
+	if i.URILTE != nil {
+		predicates = append(predicates, slsadependency.URILTE(*i.URILTE))
+	}
+	if i.URIContains != nil {
+		predicates = append(predicates, slsadependency.URIContains(*i.URIContains))
+	}
+	if i.URIHasPrefix != nil {
+		predicates = append(predicates, slsadependency.URIHasPrefix(*i.URIHasPrefix))
+	}
+	if i.URIHasSuffix != nil {
+		predicates = append(predicates, slsadependency.URIHasSuffix(*i.URIHasSuffix))
+	}
+	if i.URIIsNil {
+		predicates = append(predicates, slsadependency.URIIsNil())
+	}
+	if i.URINotNil {
+		predicates = append(predicates, slsadependency.URINotNil())
+	}
+	if i.URIEqualFold != nil {
+		predicates = append(predicates, slsadependency.URIEqualFold(*i.URIEqualFold))
+	}
+	if i.URIContainsFold != nil {
+		predicates = append(predicates, slsadependency.URIContainsFold(*i.URIContainsFold))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, slsadependency.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, slsadependency.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, slsadependency.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, slsadependency.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, slsadependency.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, slsadependency.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, slsadependency.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, slsadependency.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, slsadependency.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, slsadependency.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, slsadependency.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameIsNil {
+		predicates = append(predicates, slsadependency.NameIsNil())
+	}
+	if i.NameNotNil {
+		predicates = append(predicates, slsadependency.NameNotNil())
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, slsadependency.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, slsadependency.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Algorithm != nil {
+		predicates = append(predicates, slsadependency.AlgorithmEQ(*i.Algorithm))
+	}
+	if i.AlgorithmNEQ != nil {
+		predicates = append(predicates, slsadependency.AlgorithmNEQ(*i.AlgorithmNEQ))
+	}
+	if len(i.AlgorithmIn) > 0 {
+		predicates = append(predicates, slsadependency.AlgorithmIn(i.AlgorithmIn...))
+	}
+	if len(i.AlgorithmNotIn) > 0 {
+		predicates = append(predicates, slsadependency.AlgorithmNotIn(i.AlgorithmNotIn...))
+	}
+	if i.AlgorithmGT != nil {
+		predicates = append(predicates, slsadependency.AlgorithmGT(*i.AlgorithmGT))
+	}
+	if i.AlgorithmGTE != nil {
+		predicates = append(predicates, slsadependency.AlgorithmGTE(*i.AlgorithmGTE))
+	}
+	if i.AlgorithmLT != nil {
+		predicates = append(predicates, slsadependency.AlgorithmLT(*i.AlgorithmLT))
+	}
+	if i.AlgorithmLTE != nil {
+		predicates = append(predicates, slsadependency.AlgorithmLTE(*i.AlgorithmLTE))
+	}
+	if i.AlgorithmContains != nil {
+		predicates = append(predicates, slsadependency.AlgorithmContains(*i.AlgorithmContains))
+	}
+	if i.AlgorithmHasPrefix != nil {
+		predicates = append(predicates, slsadependency.AlgorithmHasPrefix(*i.AlgorithmHasPrefix))
+	}
+	if i.AlgorithmHasSuffix != nil {
+		predicates = append(predicates, slsadependency.AlgorithmHasSuffix(*i.AlgorithmHasSuffix))
+	}
+	if i.AlgorithmIsNil {
+		predicates = append(predicates, slsadependency.AlgorithmIsNil())
+	}
+	if i.AlgorithmNotNil {
+		predicates = append(predicates, slsadependency.AlgorithmNotNil())
+	}
+	if i.AlgorithmEqualFold != nil {
+		predicates = append(predicates, slsadependency.AlgorithmEqualFold(*i.AlgorithmEqualFold))
+	}
+	if i.AlgorithmContainsFold != nil {
+		predicates = append(predicates, slsadependency.AlgorithmContainsFold(*i.AlgorithmContainsFold))
+	}
+	if i.Value != nil {
+		predicates = append(predicates, slsadependency.ValueEQ(*i.Value))
+	}
+	if i.ValueNEQ != nil {
+		predicates = append(predicates, slsadependency.ValueNEQ(*i.ValueNEQ))
+	}
+	if len(i.ValueIn) > 0 {
+		predicates = append(predicates, slsadependency.ValueIn(i.ValueIn...))
+	}
+	if len(i.ValueNotIn) > 0 {
+		predicates = append(predicates, slsadependency.ValueNotIn(i.ValueNotIn...))
+	}
+	if i.ValueGT != nil {
+		predicates = append(predicates, slsadependency.ValueGT(*i.ValueGT))
+	}
+	if i.ValueGTE != nil {
+		predicates = append(predicates, slsadependency.ValueGTE(*i.ValueGTE))
+	}
+	if i.ValueLT != nil {
+		predicates = append(predicates, slsadependency.ValueLT(*i.ValueLT))
+	}
+	if i.ValueLTE != nil {
+		predicates = append(predicates, slsadependency.ValueLTE(*i.ValueLTE))
+	}
+	if i.ValueContains != nil {
+		predicates = append(predicates, slsadependency.ValueContains(*i.ValueContains))
+	}
+	if i.ValueHasPrefix != nil {
+		predicates = append(predicates, slsadependency.ValueHasPrefix(*i.ValueHasPrefix))
+	}
+	if i.ValueHasSuffix != nil {
+		predicates = append(predicates, slsadependency.ValueHasSuffix(*i.ValueHasSuffix))
+	}
+	if i.ValueIsNil {
+		predicates = append(predicates, slsadependency.ValueIsNil())
+	}
+	if i.ValueNotNil {
+		predicates = append(predicates, slsadependency.ValueNotNil())
+	}
+	if i.ValueEqualFold != nil {
+		predicates = append(predicates, slsadependency.ValueEqualFold(*i.ValueEqualFold))
+	}
+	if i.ValueContainsFold != nil {
+		predicates = append(predicates, slsadependency.ValueContainsFold(*i.ValueContainsFold))
+	}
+
+	if i.HasSlsaProvenance != nil {
+		p := slsadependency.HasSlsaProvenance()
+		if !*i.HasSlsaProvenance {
+			p = slsadependency.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSlsaProvenanceWith) > 0 {
+		with := make([]predicate.SlsaProvenance, 0, len(i.HasSlsaProvenanceWith))
+		for _, w := range i.HasSlsaProvenanceWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSlsaProvenanceWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, slsadependency.HasSlsaProvenanceWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptySlsaDependencyWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return slsadependency.And(predicates...), nil
+	}
+}
+
+// SlsaProvenanceWhereInput represents a where input for filtering SlsaProvenance queries.
+type SlsaProvenanceWhereInput struct {
+	Predicates []predicate.SlsaProvenance  `json:"-"`
+	Not        *SlsaProvenanceWhereInput   `json:"not,omitempty"`
+	Or         []*SlsaProvenanceWhereInput `json:"or,omitempty"`
+	And        []*SlsaProvenanceWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "builder_id" field predicates.
+	BuilderID             *string  `json:"builderID,omitempty"`
+	BuilderIDNEQ          *string  `json:"builderIDNEQ,omitempty"`
+	BuilderIDIn           []string `json:"builderIDIn,omitempty"`
+	BuilderIDNotIn        []string `json:"builderIDNotIn,omitempty"`
+	BuilderIDGT           *string  `json:"builderIDGT,omitempty"`
+	BuilderIDGTE          *string  `json:"builderIDGTE,omitempty"`
+	BuilderIDLT           *string  `json:"builderIDLT,omitempty"`
+	BuilderIDLTE          *string  `json:"builderIDLTE,omitempty"`
+	BuilderIDContains     *string  `json:"builderIDContains,omitempty"`
+	BuilderIDHasPrefix    *string  `json:"builderIDHasPrefix,omitempty"`
+	BuilderIDHasSuffix    *string  `json:"builderIDHasSuffix,omitempty"`
+	BuilderIDEqualFold    *string  `json:"builderIDEqualFold,omitempty"`
+	BuilderIDContainsFold *string  `json:"builderIDContainsFold,omitempty"`
+
+	// "build_type" field predicates.
+	BuildType             *string  `json:"buildType,omitempty"`
+	BuildTypeNEQ          *string  `json:"buildTypeNEQ,omitempty"`
+	BuildTypeIn           []string `json:"buildTypeIn,omitempty"`
+	BuildTypeNotIn        []string `json:"buildTypeNotIn,omitempty"`
+	BuildTypeGT           *string  `json:"buildTypeGT,omitempty"`
+	BuildTypeGTE          *string  `json:"buildTypeGTE,omitempty"`
+	BuildTypeLT           *string  `json:"buildTypeLT,omitempty"`
+	BuildTypeLTE          *string  `json:"buildTypeLTE,omitempty"`
+	BuildTypeContains     *string  `json:"buildTypeContains,omitempty"`
+	BuildTypeHasPrefix    *string  `json:"buildTypeHasPrefix,omitempty"`
+	BuildTypeHasSuffix    *string  `json:"buildTypeHasSuffix,omitempty"`
+	BuildTypeEqualFold    *string  `json:"buildTypeEqualFold,omitempty"`
+	BuildTypeContainsFold *string  `json:"buildTypeContainsFold,omitempty"`
+
+	// "invocation_id" field predicates.
+	InvocationID             *string  `json:"invocationID,omitempty"`
+	InvocationIDNEQ          *string  `json:"invocationIDNEQ,omitempty"`
+	InvocationIDIn           []string `json:"invocationIDIn,omitempty"`
+	InvocationIDNotIn        []string `json:"invocationIDNotIn,omitempty"`
+	InvocationIDGT           *string  `json:"invocationIDGT,omitempty"`
+	InvocationIDGTE          *string  `json:"invocationIDGTE,omitempty"`
+	InvocationIDLT           *string  `json:"invocationIDLT,omitempty"`
+	InvocationIDLTE          *string  `json:"invocationIDLTE,omitempty"`
+	InvocationIDContains     *string  `json:"invocationIDContains,omitempty"`
+	InvocationIDHasPrefix    *string  `json:"invocationIDHasPrefix,omitempty"`
+	InvocationIDHasSuffix    *string  `json:"invocationIDHasSuffix,omitempty"`
+	InvocationIDIsNil        bool     `json:"invocationIDIsNil,omitempty"`
+	InvocationIDNotNil       bool     `json:"invocationIDNotNil,omitempty"`
+	InvocationIDEqualFold    *string  `json:"invocationIDEqualFold,omitempty"`
+	InvocationIDContainsFold *string  `json:"invocationIDContainsFold,omitempty"`
+
+	// "started_on" field predicates.
+	StartedOn       *time.Time  `json:"startedOn,omitempty"`
+	StartedOnNEQ    *time.Time  `json:"startedOnNEQ,omitempty"`
+	StartedOnIn     []time.Time `json:"startedOnIn,omitempty"`
+	StartedOnNotIn  []time.Time `json:"startedOnNotIn,omitempty"`
+	StartedOnGT     *time.Time  `json:"startedOnGT,omitempty"`
+	StartedOnGTE    *time.Time  `json:"startedOnGTE,omitempty"`
+	StartedOnLT     *time.Time  `json:"startedOnLT,omitempty"`
+	StartedOnLTE    *time.Time  `json:"startedOnLTE,omitempty"`
+	StartedOnIsNil  bool        `json:"startedOnIsNil,omitempty"`
+	StartedOnNotNil bool        `json:"startedOnNotNil,omitempty"`
+
+	// "finished_on" field predicates.
+	FinishedOn       *time.Time  `json:"finishedOn,omitempty"`
+	FinishedOnNEQ    *time.Time  `json:"finishedOnNEQ,omitempty"`
+	FinishedOnIn     []time.Time `json:"finishedOnIn,omitempty"`
+	FinishedOnNotIn  []time.Time `json:"finishedOnNotIn,omitempty"`
+	FinishedOnGT     *time.Time  `json:"finishedOnGT,omitempty"`
+	FinishedOnGTE    *time.Time  `json:"finishedOnGTE,omitempty"`
+	FinishedOnLT     *time.Time  `json:"finishedOnLT,omitempty"`
+	FinishedOnLTE    *time.Time  `json:"finishedOnLTE,omitempty"`
+	FinishedOnIsNil  bool        `json:"finishedOnIsNil,omitempty"`
+	FinishedOnNotNil bool        `json:"finishedOnNotNil,omitempty"`
+
+	// "external_parameters" field predicates.
+	ExternalParameters             *string  `json:"externalParameters,omitempty"`
+	ExternalParametersNEQ          *string  `json:"externalParametersNEQ,omitempty"`
+	ExternalParametersIn           []string `json:"externalParametersIn,omitempty"`
+	ExternalParametersNotIn        []string `json:"externalParametersNotIn,omitempty"`
+	ExternalParametersGT           *string  `json:"externalParametersGT,omitempty"`
+	ExternalParametersGTE          *string  `json:"externalParametersGTE,omitempty"`
+	ExternalParametersLT           *string  `json:"externalParametersLT,omitempty"`
+	ExternalParametersLTE          *string  `json:"externalParametersLTE,omitempty"`
+	ExternalParametersContains     *string  `json:"externalParametersContains,omitempty"`
+	ExternalParametersHasPrefix    *string  `json:"externalParametersHasPrefix,omitempty"`
+	ExternalParametersHasSuffix    *string  `json:"externalParametersHasSuffix,omitempty"`
+	ExternalParametersIsNil        bool     `json:"externalParametersIsNil,omitempty"`
+	ExternalParametersNotNil       bool     `json:"externalParametersNotNil,omitempty"`
+	ExternalParametersEqualFold    *string  `json:"externalParametersEqualFold,omitempty"`
+	ExternalParametersContainsFold *string  `json:"externalParametersContainsFold,omitempty"`
+
+	// "resolved_dependencies" edge predicates.
+	HasResolvedDependencies     *bool                       `json:"hasResolvedDependencies,omitempty"`
+	HasResolvedDependenciesWith []*SlsaDependencyWhereInput `json:"hasResolvedDependenciesWith,omitempty"`
+
+	// "statement" edge predicates.
+	HasStatement     *bool                  `json:"hasStatement,omitempty"`
+	HasStatementWith []*StatementWhereInput `json:"hasStatementWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SlsaProvenanceWhereInput) AddPredicates(predicates ...predicate.SlsaProvenance) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SlsaProvenanceWhereInput filter on the SlsaProvenanceQuery builder.
+func (i *SlsaProvenanceWhereInput) Filter(q *SlsaProvenanceQuery) (*SlsaProvenanceQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySlsaProvenanceWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptySlsaProvenanceWhereInput is returned in case the SlsaProvenanceWhereInput is empty.
+var ErrEmptySlsaProvenanceWhereInput = errors.New("ent: empty predicate SlsaProvenanceWhereInput")
+
+// P returns a predicate for filtering slsaprovenances.
+// An error is returned if the input is empty or invalid.
+func (i *SlsaProvenanceWhereInput) P() (predicate.SlsaProvenance, error) {
+	var predicates []predicate.SlsaProvenance
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, slsaprovenance.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.SlsaProvenance, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, slsaprovenance.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.SlsaProvenance, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, slsaprovenance.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, slsaprovenance.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, slsaprovenance.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, slsaprovenance.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, slsaprovenance.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, slsaprovenance.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, slsaprovenance.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, slsaprovenance.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, slsaprovenance.IDLTE(*i.IDLTE))
+	}
+	if i.BuilderID != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDEQ(*i.BuilderID))
+	}
+	if i.BuilderIDNEQ != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDNEQ(*i.BuilderIDNEQ))
+	}
+	if len(i.BuilderIDIn) > 0 {
+		predicates = append(predicates, slsaprovenance.BuilderIDIn(i.BuilderIDIn...))
+	}
+	if len(i.BuilderIDNotIn) > 0 {
+		predicates = append(predicates, slsaprovenance.BuilderIDNotIn(i.BuilderIDNotIn...))
+	}
+	if i.BuilderIDGT != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDGT(*i.BuilderIDGT))
+	}
+	if i.BuilderIDGTE != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDGTE(*i.BuilderIDGTE))
+	}
+	if i.BuilderIDLT != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDLT(*i.BuilderIDLT))
+	}
+	if i.BuilderIDLTE != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDLTE(*i.BuilderIDLTE))
+	}
+	if i.BuilderIDContains != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDContains(*i.BuilderIDContains))
+	}
+	if i.BuilderIDHasPrefix != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDHasPrefix(*i.BuilderIDHasPrefix))
+	}
+	if i.BuilderIDHasSuffix != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDHasSuffix(*i.BuilderIDHasSuffix))
+	}
+	if i.BuilderIDEqualFold != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDEqualFold(*i.BuilderIDEqualFold))
+	}
+	if i.BuilderIDContainsFold != nil {
+		predicates = append(predicates, slsaprovenance.BuilderIDContainsFold(*i.BuilderIDContainsFold))
+	}
+	if i.BuildType != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeEQ(*i.BuildType))
+	}
+	if i.BuildTypeNEQ != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeNEQ(*i.BuildTypeNEQ))
+	}
+	if len(i.BuildTypeIn) > 0 {
+		predicates = append(predicates, slsaprovenance.BuildTypeIn(i.BuildTypeIn...))
+	}
+	if len(i.BuildTypeNotIn) > 0 {
+		predicates = append(predicates, slsaprovenance.BuildTypeNotIn(i.BuildTypeNotIn...))
+	}
+	if i.BuildTypeGT != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeGT(*i.BuildTypeGT))
+	}
+	if i.BuildTypeGTE != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeGTE(*i.BuildTypeGTE))
+	}
+	if i.BuildTypeLT != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeLT(*i.BuildTypeLT))
+	}
+	if i.BuildTypeLTE != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeLTE(*i.BuildTypeLTE))
+	}
+	if i.BuildTypeContains != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeContains(*i.BuildTypeContains))
+	}
+	if i.BuildTypeHasPrefix != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeHasPrefix(*i.BuildTypeHasPrefix))
+	}
+	if i.BuildTypeHasSuffix != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeHasSuffix(*i.BuildTypeHasSuffix))
+	}
+	if i.BuildTypeEqualFold != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeEqualFold(*i.BuildTypeEqualFold))
+	}
+	if i.BuildTypeContainsFold != nil {
+		predicates = append(predicates, slsaprovenance.BuildTypeContainsFold(*i.BuildTypeContainsFold))
+	}
+	if i.InvocationID != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDEQ(*i.InvocationID))
+	}
+	if i.InvocationIDNEQ != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDNEQ(*i.InvocationIDNEQ))
+	}
+	if len(i.InvocationIDIn) > 0 {
+		predicates = append(predicates, slsaprovenance.InvocationIDIn(i.InvocationIDIn...))
+	}
+	if len(i.InvocationIDNotIn) > 0 {
+		predicates = append(predicates, slsaprovenance.InvocationIDNotIn(i.InvocationIDNotIn...))
+	}
+	if i.InvocationIDGT != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDGT(*i.InvocationIDGT))
+	}
+	if i.InvocationIDGTE != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDGTE(*i.InvocationIDGTE))
+	}
+	if i.InvocationIDLT != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDLT(*i.InvocationIDLT))
+	}
+	if i.InvocationIDLTE != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDLTE(*i.InvocationIDLTE))
+	}
+	if i.InvocationIDContains != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDContains(*i.InvocationIDContains))
+	}
+	if i.InvocationIDHasPrefix != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDHasPrefix(*i.InvocationIDHasPrefix))
+	}
+	if i.InvocationIDHasSuffix != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDHasSuffix(*i.InvocationIDHasSuffix))
+	}
+	if i.InvocationIDIsNil {
+		predicates = append(predicates, slsaprovenance.InvocationIDIsNil())
+	}
+	if i.InvocationIDNotNil {
+		predicates = append(predicates, slsaprovenance.InvocationIDNotNil())
+	}
+	if i.InvocationIDEqualFold != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDEqualFold(*i.InvocationIDEqualFold))
+	}
+	if i.InvocationIDContainsFold != nil {
+		predicates = append(predicates, slsaprovenance.InvocationIDContainsFold(*i.InvocationIDContainsFold))
+	}
+	if i.StartedOn != nil {
+		predicates = append(predicates, slsaprovenance.StartedOnEQ(*i.StartedOn))
+	}
+	if i.StartedOnNEQ != nil {
+		predicates = append(predicates, slsaprovenance.StartedOnNEQ(*i.StartedOnNEQ))
+	}
+	if len(i.StartedOnIn) > 0 {
+		predicates = append(predicates, slsaprovenance.StartedOnIn(i.StartedOnIn...))
+	}
+	if len(i.StartedOnNotIn) > 0 {
+		predicates = append(predicates, slsaprovenance.StartedOnNotIn(i.StartedOnNotIn...))
+	}
+	if i.StartedOnGT != nil {
+		predicates = append(predicates, slsaprovenance.StartedOnGT(*i.StartedOnGT))
+	}
+	if i.StartedOnGTE != nil {
+		predicates = append(predicates, slsaprovenance.StartedOnGTE(*i.StartedOnGTE))
+	}
+	if i.StartedOnLT != nil {
+		predicates = append(predicates, slsaprovenance.StartedOnLT(*i.StartedOnLT))
+	}
+	if i.StartedOnLTE != nil {
+		predicates = append(predicates, slsaprovenance.StartedOnLTE(*i.StartedOnLTE))
+	}
+	if i.StartedOnIsNil {
+		predicates = append(predicates, slsaprovenance.StartedOnIsNil())
+	}
+	if i.StartedOnNotNil {
+		predicates = append(predicates, slsaprovenance.StartedOnNotNil())
+	}
+	if i.FinishedOn != nil {
+		predicates = append(predicates, slsaprovenance.FinishedOnEQ(*i.FinishedOn))
+	}
+	if i.FinishedOnNEQ != nil {
+		predicates = append(predicates, slsaprovenance.FinishedOnNEQ(*i.FinishedOnNEQ))
+	}
+	if len(i.FinishedOnIn) > 0 {
+		predicates = append(predicates, slsaprovenance.FinishedOnIn(i.FinishedOnIn...))
+	}
+	if len(i.FinishedOnNotIn) > 0 {
+		predicates = append(predicates, slsaprovenance.FinishedOnNotIn(i.FinishedOnNotIn...))
+	}
+	if i.FinishedOnGT != nil {
+		predicates = append(predicates, slsaprovenance.FinishedOnGT(*i.FinishedOnGT))
+	}
+	if i.FinishedOnGTE != nil {
+		predicates = append(predicates, slsaprovenance.FinishedOnGTE(*i.FinishedOnGTE))
+	}
+	if i.FinishedOnLT != nil {
+		predicates = append(predicates, slsaprovenance.FinishedOnLT(*i.FinishedOnLT))
+	}
+	if i.FinishedOnLTE != nil {
+		predicates = append(predicates, slsaprovenance.FinishedOnLTE(*i.FinishedOnLTE))
+	}
+	if i.FinishedOnIsNil {
+		predicates = append(predicates, slsaprovenance.FinishedOnIsNil())
+	}
+	if i.FinishedOnNotNil {
+		predicates = append(predicates, slsaprovenance.FinishedOnNotNil())
+	}
+	if i.ExternalParameters != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersEQ(*i.ExternalParameters))
+	}
+	if i.ExternalParametersNEQ != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersNEQ(*i.ExternalParametersNEQ))
+	}
+	if len(i.ExternalParametersIn) > 0 {
+		predicates = append(predicates, slsaprovenance.ExternalParametersIn(i.ExternalParametersIn...))
+	}
+	if len(i.ExternalParametersNotIn) > 0 {
+		predicates = append(predicates, slsaprovenance.ExternalParametersNotIn(i.ExternalParametersNotIn...))
+	}
+	if i.ExternalParametersGT != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersGT(*i.ExternalParametersGT))
+	}
+	if i.ExternalParametersGTE != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersGTE(*i.ExternalParametersGTE))
+	}
+	if i.ExternalParametersLT != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersLT(*i.ExternalParametersLT))
+	}
+	if i.ExternalParametersLTE != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersLTE(*i.ExternalParametersLTE))
+	}
+	if i.ExternalParametersContains != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersContains(*i.ExternalParametersContains))
+	}
+	if i.ExternalParametersHasPrefix != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersHasPrefix(*i.ExternalParametersHasPrefix))
+	}
+	if i.ExternalParametersHasSuffix != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersHasSuffix(*i.ExternalParametersHasSuffix))
+	}
+	if i.ExternalParametersIsNil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersIsNil())
+	}
+	if i.ExternalParametersNotNil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersNotNil())
+	}
+	if i.ExternalParametersEqualFold != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersEqualFold(*i.ExternalParametersEqualFold))
+	}
+	if i.ExternalParametersContainsFold != nil {
+		predicates = append(predicates, slsaprovenance.ExternalParametersContainsFold(*i.ExternalParametersContainsFold))
+	}
+
+	if i.HasResolvedDependencies != nil {
+		p := slsaprovenance.HasResolvedDependencies()
+		if !*i.HasResolvedDependencies {
+			p = slsaprovenance.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasResolvedDependenciesWith) > 0 {
+		with := make([]predicate.SlsaDependency, 0, len(i.HasResolvedDependenciesWith))
+		for _, w := range i.HasResolvedDependenciesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasResolvedDependenciesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, slsaprovenance.HasResolvedDependenciesWith(with...))
+	}
+	if i.HasStatement != nil {
+		p := slsaprovenance.HasStatement()
+		if !*i.HasStatement {
+			p = slsaprovenance.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasStatementWith) > 0 {
+		with := make([]predicate.Statement, 0, len(i.HasStatementWith))
+		for _, w := range i.HasStatementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasStatementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, slsaprovenance.HasStatementWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptySlsaProvenanceWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return slsaprovenance.And(predicates...), nil
+	}
+}
+
+// StatementWhereInput represents a where input for filtering Statement queries.
+type StatementWhereInput struct {
+	Predicates []predicate.Statement  `json:"-"`
+	Not        *StatementWhereInput   `json:"not,omitempty"`
+	Or         []*StatementWhereInput `json:"or,omitempty"`
+	And        []*StatementWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "predicate" field predicates.
+	Predicate             *string  `json:"predicate,omitempty"`
+	PredicateNEQ          *string  `json:"predicateNEQ,omitempty"`
+	PredicateIn           []string `json:"predicateIn,omitempty"`
+	PredicateNotIn        []string `json:"predicateNotIn,omitempty"`
+	PredicateGT           *string  `json:"predicateGT,omitempty"`
+	PredicateGTE          *string  `json:"predicateGTE,omitempty"`
+	PredicateLT           *string  `json:"predicateLT,omitempty"`
+	PredicateLTE          *string  `json:"predicateLTE,omitempty"`
+	PredicateContains     *string  `json:"predicateContains,omitempty"`
+	PredicateHasPrefix    *string  `json:"predicateHasPrefix,omitempty"`
+	PredicateHasSuffix    *string  `json:"predicateHasSuffix,omitempty"`
+	PredicateEqualFold    *string  `json:"predicateEqualFold,omitempty"`
+	PredicateContainsFold *string  `json:"predicateContainsFold,omitempty"`
+
+	// "subjects" edge predicates.
+	HasSubjects     *bool                `json:"hasSubjects,omitempty"`
+	HasSubjectsWith []*SubjectWhereInput `json:"hasSubjectsWith,omitempty"`
+
+	// "policy" edge predicates.
+	HasPolicy     *bool                          `json:"hasPolicy,omitempty"`
+	HasPolicyWith []*AttestationPolicyWhereInput `json:"hasPolicyWith,omitempty"`
+
+	// "attestation_collections" edge predicates.
+	HasAttestationCollections     *bool                              `json:"hasAttestationCollections,omitempty"`
+	HasAttestationCollectionsWith []*AttestationCollectionWhereInput `json:"hasAttestationCollectionsWith,omitempty"`
+
+	// "slsa_provenance" edge predicates.
+	HasSlsaProvenance     *bool                       `json:"hasSlsaProvenance,omitempty"`
+	HasSlsaProvenanceWith []*SlsaProvenanceWhereInput `json:"hasSlsaProvenanceWith,omitempty"`
+
+	// "verification_summary" edge predicates.
+	HasVerificationSummary     *bool                            `json:"hasVerificationSummary,omitempty"`
+	HasVerificationSummaryWith []*VerificationSummaryWhereInput `json:"hasVerificationSummaryWith,omitempty"`
+
+	// "sbom_packages" edge predicates.
+	HasSbomPackages     *bool                    `json:"hasSbomPackages,omitempty"`
+	HasSbomPackagesWith []*SbomPackageWhereInput `json:"hasSbomPackagesWith,omitempty"`
+
+	// "dsse" edge predicates.
+	HasDsse     *bool             `json:"hasDsse,omitempty"`
+	HasDsseWith []*DsseWhereInput `json:"hasDsseWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *StatementWhereInput) AddPredicates(predicates ...predicate.Statement) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the StatementWhereInput filter on the StatementQuery builder.
+func (i *StatementWhereInput) Filter(q *StatementQuery) (*StatementQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyStatementWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyStatementWhereInput is returned in case the StatementWhereInput is empty.
+var ErrEmptyStatementWhereInput = errors.New("ent: empty predicate StatementWhereInput")
+
+// P returns a predicate for filtering statements.
+// An error is returned if the input is empty or invalid.
+func (i *StatementWhereInput) P() (predicate.Statement, error) {
+	var predicates []predicate.Statement
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, statement.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Statement, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, statement.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Statement, 0, n)
+		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasStatementWith'", err)
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, statement.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, statement.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, statement.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, statement.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, statement.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, statement.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, statement.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, statement.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, statement.IDLTE(*i.IDLTE))
+	}
+	if i.Predicate != nil {
+		predicates = append(predicates, statement.PredicateEQ(*i.Predicate))
+	}
+	if i.PredicateNEQ != nil {
+		predicates = append(predicates, statement.PredicateNEQ(*i.PredicateNEQ))
+	}
+	if len(i.PredicateIn) > 0 {
+		predicates = append(predicates, statement.PredicateIn(i.PredicateIn...))
+	}
+	if len(i.PredicateNotIn) > 0 {
+		predicates = append(predicates, statement.PredicateNotIn(i.PredicateNotIn...))
+	}
+	if i.PredicateGT != nil {
+		predicates = append(predicates, statement.PredicateGT(*i.PredicateGT))
+	}
+	if i.PredicateGTE != nil {
+		predicates = append(predicates, statement.PredicateGTE(*i.PredicateGTE))
+	}
+	if i.PredicateLT != nil {
+		predicates = append(predicates, statement.PredicateLT(*i.PredicateLT))
+	}
+	if i.PredicateLTE != nil {
+		predicates = append(predicates, statement.PredicateLTE(*i.PredicateLTE))
+	}
+	if i.PredicateContains != nil {
+		predicates = append(predicates, statement.PredicateContains(*i.PredicateContains))
+	}
+	if i.PredicateHasPrefix != nil {
+		predicates = append(predicates, statement.PredicateHasPrefix(*i.PredicateHasPrefix))
+	}
+	if i.PredicateHasSuffix != nil {
+		predicates = append(predicates, statement.PredicateHasSuffix(*i.PredicateHasSuffix))
+	}
+	if i.PredicateEqualFold != nil {
+		predicates = append(predicates, statement.PredicateEqualFold(*i.PredicateEqualFold))
+	}
+	if i.PredicateContainsFold != nil {
+		predicates = append(predicates, statement.PredicateContainsFold(*i.PredicateContainsFold))
+	}
+
+	if i.HasSubjects != nil {
+		p := statement.HasSubjects()
+		if !*i.HasSubjects {
+			p = statement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSubjectsWith) > 0 {
+		with := make([]predicate.Subject, 0, len(i.HasSubjectsWith))
+		for _, w := range i.HasSubjectsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSubjectsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, dsse.HasStatementWith(with...))
+		predicates = append(predicates, statement.HasSubjectsWith(with...))
 	}
-	if i.HasSignatures != nil {
-		p := dsse.HasSignatures()
-		if !*i.HasSignatures {
-			p = dsse.Not(p)
+	if i.HasPolicy != nil {
+		p := statement.HasPolicy()
+		if !*i.HasPolicy {
+			p = statement.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasSignaturesWith) > 0 {
-		with := make([]predicate.Signature, 0, len(i.HasSignaturesWith))
-		for _, w := range i.HasSignaturesWith {
+	if len(i.HasPolicyWith) > 0 {
+		with := make([]predicate.AttestationPolicy, 0, len(i.HasPolicyWith))
+		for _, w := range i.HasPolicyWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSignaturesWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasPolicyWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, dsse.HasSignaturesWith(with...))
+		predicates = append(predicates, statement.HasPolicyWith(with...))
 	}
-	if i.HasPayloadDigests != nil {
-		p := dsse.HasPayloadDigests()
-		if !*i.HasPayloadDigests {
-			p = dsse.Not(p)
+	if i.HasAttestationCollections != nil {
+		p := statement.HasAttestationCollections()
+		if !*i.HasAttestationCollections {
+			p = statement.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasPayloadDigestsWith) > 0 {
-		with := make([]predicate.PayloadDigest, 0, len(i.HasPayloadDigestsWith))
-		for _, w := range i.HasPayloadDigestsWith {
+	if len(i.HasAttestationCollectionsWith) > 0 {
+		with := make([]predicate.AttestationCollection, 0, len(i.HasAttestationCollectionsWith))
+		for _, w := range i.HasAttestationCollectionsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasPayloadDigestsWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasAttestationCollectionsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, dsse.HasPayloadDigestsWith(with...))
+		predicates = append(predicates, statement.HasAttestationCollectionsWith(with...))
+	}
+	if i.HasSlsaProvenance != nil {
+		p := statement.HasSlsaProvenance()
+		if !*i.HasSlsaProvenance {
+			p = statement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSlsaProvenanceWith) > 0 {
+		with := make([]predicate.SlsaProvenance, 0, len(i.HasSlsaProvenanceWith))
+		for _, w := range i.HasSlsaProvenanceWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSlsaProvenanceWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, statement.HasSlsaProvenanceWith(with...))
+	}
+	if i.HasVerificationSummary != nil {
+		p := statement.HasVerificationSummary()
+		if !*i.HasVerificationSummary {
+			p = statement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasVerificationSummaryWith) > 0 {
+		with := make([]predicate.VerificationSummary, 0, len(i.HasVerificationSummaryWith))
+		for _, w := range i.HasVerificationSummaryWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasVerificationSummaryWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, statement.HasVerificationSummaryWith(with...))
+	}
+	if i.HasSbomPackages != nil {
+		p := statement.HasSbomPackages()
+		if !*i.HasSbomPackages {
+			p = statement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSbomPackagesWith) > 0 {
+		with := make([]predicate.SbomPackage, 0, len(i.HasSbomPackagesWith))
+		for _, w := range i.HasSbomPackagesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSbomPackagesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, statement.HasSbomPackagesWith(with...))
+	}
+	if i.HasDsse != nil {
+		p := statement.HasDsse()
+		if !*i.HasDsse {
+			p = statement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDsseWith) > 0 {
+		with := make([]predicate.Dsse, 0, len(i.HasDsseWith))
+		for _, w := range i.HasDsseWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDsseWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, statement.HasDsseWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyDsseWhereInput
+		return nil, ErrEmptyStatementWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return dsse.And(predicates...), nil
+		return statement.And(predicates...), nil
 	}
 }
 
-// PayloadDigestWhereInput represents a where input for filtering PayloadDigest queries.
-type PayloadDigestWhereInput struct {
-	Predicates []predicate.PayloadDigest  `json:"-"`
-	Not        *PayloadDigestWhereInput   `json:"not,omitempty"`
-	Or         []*PayloadDigestWhereInput `json:"or,omitempty"`
-	And        []*PayloadDigestWhereInput `json:"and,omitempty"`
+// SubjectWhereInput represents a where input for filtering Subject queries.
+type SubjectWhereInput struct {
+	Predicates []predicate.Subject  `json:"-"`
+	Not        *SubjectWhereInput   `json:"not,omitempty"`
+	Or         []*SubjectWhereInput `json:"or,omitempty"`
+	And        []*SubjectWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -1000,54 +10225,55 @@ type PayloadDigestWhereInput struct {
 	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
 	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
 
-	// "algorithm" field predicates.
-	Algorithm             *string  `json:"algorithm,omitempty"`
-	AlgorithmNEQ          *string  `json:"algorithmNEQ,omitempty"`
-	AlgorithmIn           []string `json:"algorithmIn,omitempty"`
-	AlgorithmNotIn        []string `json:"algorithmNotIn,omitempty"`
-	AlgorithmGT           *string  `json:"algorithmGT,omitempty"`
-	AlgorithmGTE          *string  `json:"algorithmGTE,omitempty"`
-	AlgorithmLT           *string  `json:"algorithmLT,omitempty"`
-	AlgorithmLTE          *string  `json:"algorithmLTE,omitempty"`
-	AlgorithmContains     *string  `json:"algorithmContains,omitempty"`
-	AlgorithmHasPrefix    *string  `json:"algorithmHasPrefix,omitempty"`
-	AlgorithmHasSuffix    *string  `json:"algorithmHasSuffix,omitempty"`
-	AlgorithmEqualFold    *string  `json:"algorithmEqualFold,omitempty"`
-	AlgorithmContainsFold *string  `json:"algorithmContainsFold,omitempty"`
+	// "created_at" field predicates.
+	CreatedAt       *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ    *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT     *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE    *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT     *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE    *time.Time  `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil bool        `json:"createdAtNotNil,omitempty"`
 
-	// "value" field predicates.
-	Value             *string  `json:"value,omitempty"`
-	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
-	ValueIn           []string `json:"valueIn,omitempty"`
-	ValueNotIn        []string `json:"valueNotIn,omitempty"`
-	ValueGT           *string  `json:"valueGT,omitempty"`
-	ValueGTE          *string  `json:"valueGTE,omitempty"`
-	ValueLT           *string  `json:"valueLT,omitempty"`
-	ValueLTE          *string  `json:"valueLTE,omitempty"`
-	ValueContains     *string  `json:"valueContains,omitempty"`
-	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
-	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
-	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
-	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "dsse" edge predicates.
-	HasDsse     *bool             `json:"hasDsse,omitempty"`
-	HasDsseWith []*DsseWhereInput `json:"hasDsseWith,omitempty"`
+	// "subject_digests" edge predicates.
+	HasSubjectDigests     *bool                      `json:"hasSubjectDigests,omitempty"`
+	HasSubjectDigestsWith []*SubjectDigestWhereInput `json:"hasSubjectDigestsWith,omitempty"`
+
+	// "statement" edge predicates.
+	HasStatement     *bool                  `json:"hasStatement,omitempty"`
+	HasStatementWith []*StatementWhereInput `json:"hasStatementWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *PayloadDigestWhereInput) AddPredicates(predicates ...predicate.PayloadDigest) {
+func (i *SubjectWhereInput) AddPredicates(predicates ...predicate.Subject) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the PayloadDigestWhereInput filter on the PayloadDigestQuery builder.
-func (i *PayloadDigestWhereInput) Filter(q *PayloadDigestQuery) (*PayloadDigestQuery, error) {
+// Filter applies the SubjectWhereInput filter on the SubjectQuery builder.
+func (i *SubjectWhereInput) Filter(q *SubjectQuery) (*SubjectQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyPayloadDigestWhereInput {
+		if err == ErrEmptySubjectWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1055,19 +10281,19 @@ func (i *PayloadDigestWhereInput) Filter(q *PayloadDigestQuery) (*PayloadDigestQ
 	return q.Where(p), nil
 }
 
-// ErrEmptyPayloadDigestWhereInput is returned in case the PayloadDigestWhereInput is empty.
-var ErrEmptyPayloadDigestWhereInput = errors.New("ent: empty predicate PayloadDigestWhereInput")
+// ErrEmptySubjectWhereInput is returned in case the SubjectWhereInput is empty.
+var ErrEmptySubjectWhereInput = errors.New("ent: empty predicate SubjectWhereInput")
 
-// P returns a predicate for filtering payloaddigests.
+// P returns a predicate for filtering subjects.
 // An error is returned if the input is empty or invalid.
-func (i *PayloadDigestWhereInput) P() (predicate.PayloadDigest, error) {
-	var predicates []predicate.PayloadDigest
+func (i *SubjectWhereInput) P() (predicate.Subject, error) {
+	var predicates []predicate.Subject
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, payloaddigest.Not(p))
+		predicates = append(predicates, subject.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1077,7 +10303,7 @@ func (i *PayloadDigestWhereInput) P() (predicate.PayloadDigest, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.PayloadDigest, 0, n)
+		or := make([]predicate.Subject, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1085,7 +10311,7 @@ func (i *PayloadDigestWhereInput) P() (predicate.PayloadDigest, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, payloaddigest.Or(or...))
+		predicates = append(predicates, subject.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1095,7 +10321,7 @@ func (i *PayloadDigestWhereInput) P() (predicate.PayloadDigest, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.PayloadDigest, 0, n)
+		and := make([]predicate.Subject, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1103,146 +10329,155 @@ func (i *PayloadDigestWhereInput) P() (predicate.PayloadDigest, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, payloaddigest.And(and...))
+		predicates = append(predicates, subject.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, payloaddigest.IDEQ(*i.ID))
+		predicates = append(predicates, subject.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, payloaddigest.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, subject.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, payloaddigest.IDIn(i.IDIn...))
+		predicates = append(predicates, subject.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, payloaddigest.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, subject.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, payloaddigest.IDGT(*i.IDGT))
+		predicates = append(predicates, subject.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, payloaddigest.IDGTE(*i.IDGTE))
+		predicates = append(predicates, subject.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, payloaddigest.IDLT(*i.IDLT))
+		predicates = append(predicates, subject.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, payloaddigest.IDLTE(*i.IDLTE))
-	}
-	if i.Algorithm != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmEQ(*i.Algorithm))
+		predicates = append(predicates, subject.IDLTE(*i.IDLTE))
 	}
-	if i.AlgorithmNEQ != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmNEQ(*i.AlgorithmNEQ))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, subject.CreatedAtEQ(*i.CreatedAt))
 	}
-	if len(i.AlgorithmIn) > 0 {
-		predicates = append(predicates, payloaddigest.AlgorithmIn(i.AlgorithmIn...))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, subject.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if len(i.AlgorithmNotIn) > 0 {
-		predicates = append(predicates, payloaddigest.AlgorithmNotIn(i.AlgorithmNotIn...))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, subject.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.AlgorithmGT != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmGT(*i.AlgorithmGT))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, subject.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.AlgorithmGTE != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmGTE(*i.AlgorithmGTE))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, subject.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.AlgorithmLT != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmLT(*i.AlgorithmLT))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, subject.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.AlgorithmLTE != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmLTE(*i.AlgorithmLTE))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, subject.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.AlgorithmContains != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmContains(*i.AlgorithmContains))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, subject.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.AlgorithmHasPrefix != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmHasPrefix(*i.AlgorithmHasPrefix))
+	if i.CreatedAtIsNil {
+		predicates = append(predicates, subject.CreatedAtIsNil())
 	}
-	if i.AlgorithmHasSuffix != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmHasSuffix(*i.AlgorithmHasSuffix))
+	if i.CreatedAtNotNil {
+		predicates = append(predicates, subject.CreatedAtNotNil())
 	}
-	if i.AlgorithmEqualFold != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmEqualFold(*i.AlgorithmEqualFold))
+	if i.Name != nil {
+		predicates = append(predicates, subject.NameEQ(*i.Name))
 	}
-	if i.AlgorithmContainsFold != nil {
-		predicates = append(predicates, payloaddigest.AlgorithmContainsFold(*i.AlgorithmContainsFold))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, subject.NameNEQ(*i.NameNEQ))
 	}
-	if i.Value != nil {
-		predicates = append(predicates, payloaddigest.ValueEQ(*i.Value))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, subject.NameIn(i.NameIn...))
 	}
-	if i.ValueNEQ != nil {
-		predicates = append(predicates, payloaddigest.ValueNEQ(*i.ValueNEQ))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, subject.NameNotIn(i.NameNotIn...))
 	}
-	if len(i.ValueIn) > 0 {
-		predicates = append(predicates, payloaddigest.ValueIn(i.ValueIn...))
+	if i.NameGT != nil {
+		predicates = append(predicates, subject.NameGT(*i.NameGT))
 	}
-	if len(i.ValueNotIn) > 0 {
-		predicates = append(predicates, payloaddigest.ValueNotIn(i.ValueNotIn...))
+	if i.NameGTE != nil {
+		predicates = append(predicates, subject.NameGTE(*i.NameGTE))
 	}
-	if i.ValueGT != nil {
-		predicates = append(predicates, payloaddigest.ValueGT(*i.ValueGT))
+	if i.NameLT != nil {
+		predicates = append(predicates, subject.NameLT(*i.NameLT))
 	}
-	if i.ValueGTE != nil {
-		predicates = append(predicates, payloaddigest.ValueGTE(*i.ValueGTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, subject.NameLTE(*i.NameLTE))
 	}
-	if i.ValueLT != nil {
-		predicates = append(predicates, payloaddigest.ValueLT(*i.ValueLT))
+	if i.NameContains != nil {
+		predicates = append(predicates, subject.NameContains(*i.NameContains))
 	}
-	if i.ValueLTE != nil {
-		predicates = append(predicates, payloaddigest.ValueLTE(*i.ValueLTE))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, subject.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.ValueContains != nil {
-		predicates = append(predicates, payloaddigest.ValueContains(*i.ValueContains))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, subject.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.ValueHasPrefix != nil {
-		predicates = append(predicates, payloaddigest.ValueHasPrefix(*i.ValueHasPrefix))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, subject.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.ValueHasSuffix != nil {
-		predicates = append(predicates, payloaddigest.ValueHasSuffix(*i.ValueHasSuffix))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, subject.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.ValueEqualFold != nil {
-		predicates = append(predicates, payloaddigest.ValueEqualFold(*i.ValueEqualFold))
+
+	if i.HasSubjectDigests != nil {
+		p := subject.HasSubjectDigests()
+		if !*i.HasSubjectDigests {
+			p = subject.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ValueContainsFold != nil {
-		predicates = append(predicates, payloaddigest.ValueContainsFold(*i.ValueContainsFold))
+	if len(i.HasSubjectDigestsWith) > 0 {
+		with := make([]predicate.SubjectDigest, 0, len(i.HasSubjectDigestsWith))
+		for _, w := range i.HasSubjectDigestsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSubjectDigestsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, subject.HasSubjectDigestsWith(with...))
 	}
-
-	if i.HasDsse != nil {
-		p := payloaddigest.HasDsse()
-		if !*i.HasDsse {
-			p = payloaddigest.Not(p)
+	if i.HasStatement != nil {
+		p := subject.HasStatement()
+		if !*i.HasStatement {
+			p = subject.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasDsseWith) > 0 {
-		with := make([]predicate.Dsse, 0, len(i.HasDsseWith))
-		for _, w := range i.HasDsseWith {
+	if len(i.HasStatementWith) > 0 {
+		with := make([]predicate.Statement, 0, len(i.HasStatementWith))
+		for _, w := range i.HasStatementWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasDsseWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasStatementWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, payloaddigest.HasDsseWith(with...))
+		predicates = append(predicates, subject.HasStatementWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyPayloadDigestWhereInput
+		return nil, ErrEmptySubjectWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return payloaddigest.And(predicates...), nil
+		return subject.And(predicates...), nil
 	}
 }
 
-// SignatureWhereInput represents a where input for filtering Signature queries.
-type SignatureWhereInput struct {
-	Predicates []predicate.Signature  `json:"-"`
-	Not        *SignatureWhereInput   `json:"not,omitempty"`
-	Or         []*SignatureWhereInput `json:"or,omitempty"`
-	And        []*SignatureWhereInput `json:"and,omitempty"`
+// SubjectAltNameWhereInput represents a where input for filtering SubjectAltName queries.
+type SubjectAltNameWhereInput struct {
+	Predicates []predicate.SubjectAltName  `json:"-"`
+	Not        *SubjectAltNameWhereInput   `json:"not,omitempty"`
+	Or         []*SubjectAltNameWhereInput `json:"or,omitempty"`
+	And        []*SubjectAltNameWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -1254,58 +10489,45 @@ type SignatureWhereInput struct {
 	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
 	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
 
-	// "key_id" field predicates.
-	KeyID             *string  `json:"keyID,omitempty"`
-	KeyIDNEQ          *string  `json:"keyIDNEQ,omitempty"`
-	KeyIDIn           []string `json:"keyIDIn,omitempty"`
-	KeyIDNotIn        []string `json:"keyIDNotIn,omitempty"`
-	KeyIDGT           *string  `json:"keyIDGT,omitempty"`
-	KeyIDGTE          *string  `json:"keyIDGTE,omitempty"`
-	KeyIDLT           *string  `json:"keyIDLT,omitempty"`
-	KeyIDLTE          *string  `json:"keyIDLTE,omitempty"`
-	KeyIDContains     *string  `json:"keyIDContains,omitempty"`
-	KeyIDHasPrefix    *string  `json:"keyIDHasPrefix,omitempty"`
-	KeyIDHasSuffix    *string  `json:"keyIDHasSuffix,omitempty"`
-	KeyIDEqualFold    *string  `json:"keyIDEqualFold,omitempty"`
-	KeyIDContainsFold *string  `json:"keyIDContainsFold,omitempty"`
-
-	// "signature" field predicates.
-	Signature             *string  `json:"signature,omitempty"`
-	SignatureNEQ          *string  `json:"signatureNEQ,omitempty"`
-	SignatureIn           []string `json:"signatureIn,omitempty"`
-	SignatureNotIn        []string `json:"signatureNotIn,omitempty"`
-	SignatureGT           *string  `json:"signatureGT,omitempty"`
-	SignatureGTE          *string  `json:"signatureGTE,omitempty"`
-	SignatureLT           *string  `json:"signatureLT,omitempty"`
-	SignatureLTE          *string  `json:"signatureLTE,omitempty"`
-	SignatureContains     *string  `json:"signatureContains,omitempty"`
-	SignatureHasPrefix    *string  `json:"signatureHasPrefix,omitempty"`
-	SignatureHasSuffix    *string  `json:"signatureHasSuffix,omitempty"`
-	SignatureEqualFold    *string  `json:"signatureEqualFold,omitempty"`
-	SignatureContainsFold *string  `json:"signatureContainsFold,omitempty"`
-
-	// "dsse" edge predicates.
-	HasDsse     *bool             `json:"hasDsse,omitempty"`
-	HasDsseWith []*DsseWhereInput `json:"hasDsseWith,omitempty"`
+	// "type" field predicates.
+	Type      *subjectaltname.Type  `json:"type,omitempty"`
+	TypeNEQ   *subjectaltname.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []subjectaltname.Type `json:"typeIn,omitempty"`
+	TypeNotIn []subjectaltname.Type `json:"typeNotIn,omitempty"`
 
-	// "timestamps" edge predicates.
-	HasTimestamps     *bool                  `json:"hasTimestamps,omitempty"`
-	HasTimestampsWith []*TimestampWhereInput `json:"hasTimestampsWith,omitempty"`
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+
+	// "certificate" edge predicates.
+	HasCertificate     *bool                    `json:"hasCertificate,omitempty"`
+	HasCertificateWith []*CertificateWhereInput `json:"hasCertificateWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *SignatureWhereInput) AddPredicates(predicates ...predicate.Signature) {
+func (i *SubjectAltNameWhereInput) AddPredicates(predicates ...predicate.SubjectAltName) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the SignatureWhereInput filter on the SignatureQuery builder.
-func (i *SignatureWhereInput) Filter(q *SignatureQuery) (*SignatureQuery, error) {
+// Filter applies the SubjectAltNameWhereInput filter on the SubjectAltNameQuery builder.
+func (i *SubjectAltNameWhereInput) Filter(q *SubjectAltNameQuery) (*SubjectAltNameQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptySignatureWhereInput {
+		if err == ErrEmptySubjectAltNameWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1313,19 +10535,19 @@ func (i *SignatureWhereInput) Filter(q *SignatureQuery) (*SignatureQuery, error)
 	return q.Where(p), nil
 }
 
-// ErrEmptySignatureWhereInput is returned in case the SignatureWhereInput is empty.
-var ErrEmptySignatureWhereInput = errors.New("ent: empty predicate SignatureWhereInput")
+// ErrEmptySubjectAltNameWhereInput is returned in case the SubjectAltNameWhereInput is empty.
+var ErrEmptySubjectAltNameWhereInput = errors.New("ent: empty predicate SubjectAltNameWhereInput")
 
-// P returns a predicate for filtering signatures.
+// P returns a predicate for filtering subjectaltnames.
 // An error is returned if the input is empty or invalid.
-func (i *SignatureWhereInput) P() (predicate.Signature, error) {
-	var predicates []predicate.Signature
+func (i *SubjectAltNameWhereInput) P() (predicate.SubjectAltName, error) {
+	var predicates []predicate.SubjectAltName
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, signature.Not(p))
+		predicates = append(predicates, subjectaltname.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1335,7 +10557,7 @@ func (i *SignatureWhereInput) P() (predicate.Signature, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Signature, 0, n)
+		or := make([]predicate.SubjectAltName, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1343,7 +10565,7 @@ func (i *SignatureWhereInput) P() (predicate.Signature, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, signature.Or(or...))
+		predicates = append(predicates, subjectaltname.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1353,7 +10575,7 @@ func (i *SignatureWhereInput) P() (predicate.Signature, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Signature, 0, n)
+		and := make([]predicate.SubjectAltName, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1361,164 +10583,119 @@ func (i *SignatureWhereInput) P() (predicate.Signature, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, signature.And(and...))
+		predicates = append(predicates, subjectaltname.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, signature.IDEQ(*i.ID))
+		predicates = append(predicates, subjectaltname.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, signature.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, subjectaltname.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, signature.IDIn(i.IDIn...))
+		predicates = append(predicates, subjectaltname.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, signature.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, subjectaltname.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, signature.IDGT(*i.IDGT))
+		predicates = append(predicates, subjectaltname.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, signature.IDGTE(*i.IDGTE))
+		predicates = append(predicates, subjectaltname.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, signature.IDLT(*i.IDLT))
+		predicates = append(predicates, subjectaltname.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, signature.IDLTE(*i.IDLTE))
-	}
-	if i.KeyID != nil {
-		predicates = append(predicates, signature.KeyIDEQ(*i.KeyID))
-	}
-	if i.KeyIDNEQ != nil {
-		predicates = append(predicates, signature.KeyIDNEQ(*i.KeyIDNEQ))
-	}
-	if len(i.KeyIDIn) > 0 {
-		predicates = append(predicates, signature.KeyIDIn(i.KeyIDIn...))
-	}
-	if len(i.KeyIDNotIn) > 0 {
-		predicates = append(predicates, signature.KeyIDNotIn(i.KeyIDNotIn...))
-	}
-	if i.KeyIDGT != nil {
-		predicates = append(predicates, signature.KeyIDGT(*i.KeyIDGT))
-	}
-	if i.KeyIDGTE != nil {
-		predicates = append(predicates, signature.KeyIDGTE(*i.KeyIDGTE))
-	}
-	if i.KeyIDLT != nil {
-		predicates = append(predicates, signature.KeyIDLT(*i.KeyIDLT))
-	}
-	if i.KeyIDLTE != nil {
-		predicates = append(predicates, signature.KeyIDLTE(*i.KeyIDLTE))
+		predicates = append(predicates, subjectaltname.IDLTE(*i.IDLTE))
 	}
-	if i.KeyIDContains != nil {
-		predicates = append(predicates, signature.KeyIDContains(*i.KeyIDContains))
-	}
-	if i.KeyIDHasPrefix != nil {
-		predicates = append(predicates, signature.KeyIDHasPrefix(*i.KeyIDHasPrefix))
+	if i.Type != nil {
+		predicates = append(predicates, subjectaltname.TypeEQ(*i.Type))
 	}
-	if i.KeyIDHasSuffix != nil {
-		predicates = append(predicates, signature.KeyIDHasSuffix(*i.KeyIDHasSuffix))
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, subjectaltname.TypeNEQ(*i.TypeNEQ))
 	}
-	if i.KeyIDEqualFold != nil {
-		predicates = append(predicates, signature.KeyIDEqualFold(*i.KeyIDEqualFold))
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, subjectaltname.TypeIn(i.TypeIn...))
 	}
-	if i.KeyIDContainsFold != nil {
-		predicates = append(predicates, signature.KeyIDContainsFold(*i.KeyIDContainsFold))
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, subjectaltname.TypeNotIn(i.TypeNotIn...))
 	}
-	if i.Signature != nil {
-		predicates = append(predicates, signature.SignatureEQ(*i.Signature))
+	if i.Value != nil {
+		predicates = append(predicates, subjectaltname.ValueEQ(*i.Value))
 	}
-	if i.SignatureNEQ != nil {
-		predicates = append(predicates, signature.SignatureNEQ(*i.SignatureNEQ))
+	if i.ValueNEQ != nil {
+		predicates = append(predicates, subjectaltname.ValueNEQ(*i.ValueNEQ))
 	}
-	if len(i.SignatureIn) > 0 {
-		predicates = append(predicates, signature.SignatureIn(i.SignatureIn...))
+	if len(i.ValueIn) > 0 {
+		predicates = append(predicates, subjectaltname.ValueIn(i.ValueIn...))
 	}
-	if len(i.SignatureNotIn) > 0 {
-		predicates = append(predicates, signature.SignatureNotIn(i.SignatureNotIn...))
+	if len(i.ValueNotIn) > 0 {
+		predicates = append(predicates, subjectaltname.ValueNotIn(i.ValueNotIn...))
 	}
-	if i.SignatureGT != nil {
-		predicates = append(predicates, signature.SignatureGT(*i.SignatureGT))
+	if i.ValueGT != nil {
+		predicates = append(predicates, subjectaltname.ValueGT(*i.ValueGT))
 	}
-	if i.SignatureGTE != nil {
-		predicates = append(predicates, signature.SignatureGTE(*i.SignatureGTE))
+	if i.ValueGTE != nil {
+		predicates = append(predicates, subjectaltname.ValueGTE(*i.ValueGTE))
 	}
-	if i.SignatureLT != nil {
-		predicates = append(predicates, signature.SignatureLT(*i.SignatureLT))
+	if i.ValueLT != nil {
+		predicates = append(predicates, subjectaltname.ValueLT(*i.ValueLT))
 	}
-	if i.SignatureLTE != nil {
-		predicates = append(predicates, signature.SignatureLTE(*i.SignatureLTE))
+	if i.ValueLTE != nil {
+		predicates = append(predicates, subjectaltname.ValueLTE(*i.ValueLTE))
 	}
-	if i.SignatureContains != nil {
-		predicates = append(predicates, signature.SignatureContains(*i.SignatureContains))
+	if i.ValueContains != nil {
+		predicates = append(predicates, subjectaltname.ValueContains(*i.ValueContains))
 	}
-	if i.SignatureHasPrefix != nil {
-		predicates = append(predicates, signature.SignatureHasPrefix(*i.SignatureHasPrefix))
+	if i.ValueHasPrefix != nil {
+		predicates = append(predicates, subjectaltname.ValueHasPrefix(*i.ValueHasPrefix))
 	}
-	if i.SignatureHasSuffix != nil {
-		predicates = append(predicates, signature.SignatureHasSuffix(*i.SignatureHasSuffix))
+	if i.ValueHasSuffix != nil {
+		predicates = append(predicates, subjectaltname.ValueHasSuffix(*i.ValueHasSuffix))
 	}
-	if i.SignatureEqualFold != nil {
-		predicates = append(predicates, signature.SignatureEqualFold(*i.SignatureEqualFold))
+	if i.ValueEqualFold != nil {
+		predicates = append(predicates, subjectaltname.ValueEqualFold(*i.ValueEqualFold))
 	}
-	if i.SignatureContainsFold != nil {
-		predicates = append(predicates, signature.SignatureContainsFold(*i.SignatureContainsFold))
+	if i.ValueContainsFold != nil {
+		predicates = append(predicates, subjectaltname.ValueContainsFold(*i.ValueContainsFold))
 	}
 
-	if i.HasDsse != nil {
-		p := signature.HasDsse()
-		if !*i.HasDsse {
-			p = signature.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasDsseWith) > 0 {
-		with := make([]predicate.Dsse, 0, len(i.HasDsseWith))
-		for _, w := range i.HasDsseWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasDsseWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, signature.HasDsseWith(with...))
-	}
-	if i.HasTimestamps != nil {
-		p := signature.HasTimestamps()
-		if !*i.HasTimestamps {
-			p = signature.Not(p)
+	if i.HasCertificate != nil {
+		p := subjectaltname.HasCertificate()
+		if !*i.HasCertificate {
+			p = subjectaltname.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasTimestampsWith) > 0 {
-		with := make([]predicate.Timestamp, 0, len(i.HasTimestampsWith))
-		for _, w := range i.HasTimestampsWith {
+	if len(i.HasCertificateWith) > 0 {
+		with := make([]predicate.Certificate, 0, len(i.HasCertificateWith))
+		for _, w := range i.HasCertificateWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasTimestampsWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasCertificateWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, signature.HasTimestampsWith(with...))
+		predicates = append(predicates, subjectaltname.HasCertificateWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptySignatureWhereInput
+		return nil, ErrEmptySubjectAltNameWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return signature.And(predicates...), nil
+		return subjectaltname.And(predicates...), nil
 	}
 }
 
-// StatementWhereInput represents a where input for filtering Statement queries.
-type StatementWhereInput struct {
-	Predicates []predicate.Statement  `json:"-"`
-	Not        *StatementWhereInput   `json:"not,omitempty"`
-	Or         []*StatementWhereInput `json:"or,omitempty"`
-	And        []*StatementWhereInput `json:"and,omitempty"`
+// SubjectDigestWhereInput represents a where input for filtering SubjectDigest queries.
+type SubjectDigestWhereInput struct {
+	Predicates []predicate.SubjectDigest  `json:"-"`
+	Not        *SubjectDigestWhereInput   `json:"not,omitempty"`
+	Or         []*SubjectDigestWhereInput `json:"or,omitempty"`
+	And        []*SubjectDigestWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -1530,51 +10707,54 @@ type StatementWhereInput struct {
 	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
 	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
 
-	// "predicate" field predicates.
-	Predicate             *string  `json:"predicate,omitempty"`
-	PredicateNEQ          *string  `json:"predicateNEQ,omitempty"`
-	PredicateIn           []string `json:"predicateIn,omitempty"`
-	PredicateNotIn        []string `json:"predicateNotIn,omitempty"`
-	PredicateGT           *string  `json:"predicateGT,omitempty"`
-	PredicateGTE          *string  `json:"predicateGTE,omitempty"`
-	PredicateLT           *string  `json:"predicateLT,omitempty"`
-	PredicateLTE          *string  `json:"predicateLTE,omitempty"`
-	PredicateContains     *string  `json:"predicateContains,omitempty"`
-	PredicateHasPrefix    *string  `json:"predicateHasPrefix,omitempty"`
-	PredicateHasSuffix    *string  `json:"predicateHasSuffix,omitempty"`
-	PredicateEqualFold    *string  `json:"predicateEqualFold,omitempty"`
-	PredicateContainsFold *string  `json:"predicateContainsFold,omitempty"`
-
-	// "subjects" edge predicates.
-	HasSubjects     *bool                `json:"hasSubjects,omitempty"`
-	HasSubjectsWith []*SubjectWhereInput `json:"hasSubjectsWith,omitempty"`
-
-	// "policy" edge predicates.
-	HasPolicy     *bool                          `json:"hasPolicy,omitempty"`
-	HasPolicyWith []*AttestationPolicyWhereInput `json:"hasPolicyWith,omitempty"`
+	// "algorithm" field predicates.
+	Algorithm             *string  `json:"algorithm,omitempty"`
+	AlgorithmNEQ          *string  `json:"algorithmNEQ,omitempty"`
+	AlgorithmIn           []string `json:"algorithmIn,omitempty"`
+	AlgorithmNotIn        []string `json:"algorithmNotIn,omitempty"`
+	AlgorithmGT           *string  `json:"algorithmGT,omitempty"`
+	AlgorithmGTE          *string  `json:"algorithmGTE,omitempty"`
+	AlgorithmLT           *string  `json:"algorithmLT,omitempty"`
+	AlgorithmLTE          *string  `json:"algorithmLTE,omitempty"`
+	AlgorithmContains     *string  `json:"algorithmContains,omitempty"`
+	AlgorithmHasPrefix    *string  `json:"algorithmHasPrefix,omitempty"`
+	AlgorithmHasSuffix    *string  `json:"algorithmHasSuffix,omitempty"`
+	AlgorithmEqualFold    *string  `json:"algorithmEqualFold,omitempty"`
+	AlgorithmContainsFold *string  `json:"algorithmContainsFold,omitempty"`
 
-	// "attestation_collections" edge predicates.
-	HasAttestationCollections     *bool                              `json:"hasAttestationCollections,omitempty"`
-	HasAttestationCollectionsWith []*AttestationCollectionWhereInput `json:"hasAttestationCollectionsWith,omitempty"`
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
 
-	// "dsse" edge predicates.
-	HasDsse     *bool             `json:"hasDsse,omitempty"`
-	HasDsseWith []*DsseWhereInput `json:"hasDsseWith,omitempty"`
+	// "subject" edge predicates.
+	HasSubject     *bool                `json:"hasSubject,omitempty"`
+	HasSubjectWith []*SubjectWhereInput `json:"hasSubjectWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *StatementWhereInput) AddPredicates(predicates ...predicate.Statement) {
+func (i *SubjectDigestWhereInput) AddPredicates(predicates ...predicate.SubjectDigest) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the StatementWhereInput filter on the StatementQuery builder.
-func (i *StatementWhereInput) Filter(q *StatementQuery) (*StatementQuery, error) {
+// Filter applies the SubjectDigestWhereInput filter on the SubjectDigestQuery builder.
+func (i *SubjectDigestWhereInput) Filter(q *SubjectDigestQuery) (*SubjectDigestQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyStatementWhereInput {
+		if err == ErrEmptySubjectDigestWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1582,19 +10762,19 @@ func (i *StatementWhereInput) Filter(q *StatementQuery) (*StatementQuery, error)
 	return q.Where(p), nil
 }
 
-// ErrEmptyStatementWhereInput is returned in case the StatementWhereInput is empty.
-var ErrEmptyStatementWhereInput = errors.New("ent: empty predicate StatementWhereInput")
+// ErrEmptySubjectDigestWhereInput is returned in case the SubjectDigestWhereInput is empty.
+var ErrEmptySubjectDigestWhereInput = errors.New("ent: empty predicate SubjectDigestWhereInput")
 
-// P returns a predicate for filtering statements.
+// P returns a predicate for filtering subjectdigests.
 // An error is returned if the input is empty or invalid.
-func (i *StatementWhereInput) P() (predicate.Statement, error) {
-	var predicates []predicate.Statement
+func (i *SubjectDigestWhereInput) P() (predicate.SubjectDigest, error) {
+	var predicates []predicate.SubjectDigest
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, statement.Not(p))
+		predicates = append(predicates, subjectdigest.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1604,7 +10784,7 @@ func (i *StatementWhereInput) P() (predicate.Statement, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Statement, 0, n)
+		or := make([]predicate.SubjectDigest, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1612,7 +10792,7 @@ func (i *StatementWhereInput) P() (predicate.Statement, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, statement.Or(or...))
+		predicates = append(predicates, subjectdigest.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1622,7 +10802,7 @@ func (i *StatementWhereInput) P() (predicate.Statement, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Statement, 0, n)
+		and := make([]predicate.SubjectDigest, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1630,161 +10810,146 @@ func (i *StatementWhereInput) P() (predicate.Statement, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, statement.And(and...))
+		predicates = append(predicates, subjectdigest.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, statement.IDEQ(*i.ID))
+		predicates = append(predicates, subjectdigest.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, statement.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, subjectdigest.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, statement.IDIn(i.IDIn...))
+		predicates = append(predicates, subjectdigest.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, statement.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, subjectdigest.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, subjectdigest.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, subjectdigest.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, subjectdigest.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, subjectdigest.IDLTE(*i.IDLTE))
+	}
+	if i.Algorithm != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmEQ(*i.Algorithm))
+	}
+	if i.AlgorithmNEQ != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmNEQ(*i.AlgorithmNEQ))
 	}
-	if i.IDGT != nil {
-		predicates = append(predicates, statement.IDGT(*i.IDGT))
+	if len(i.AlgorithmIn) > 0 {
+		predicates = append(predicates, subjectdigest.AlgorithmIn(i.AlgorithmIn...))
 	}
-	if i.IDGTE != nil {
-		predicates = append(predicates, statement.IDGTE(*i.IDGTE))
+	if len(i.AlgorithmNotIn) > 0 {
+		predicates = append(predicates, subjectdigest.AlgorithmNotIn(i.AlgorithmNotIn...))
 	}
-	if i.IDLT != nil {
-		predicates = append(predicates, statement.IDLT(*i.IDLT))
+	if i.AlgorithmGT != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmGT(*i.AlgorithmGT))
 	}
-	if i.IDLTE != nil {
-		predicates = append(predicates, statement.IDLTE(*i.IDLTE))
+	if i.AlgorithmGTE != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmGTE(*i.AlgorithmGTE))
 	}
-	if i.Predicate != nil {
-		predicates = append(predicates, statement.PredicateEQ(*i.Predicate))
+	if i.AlgorithmLT != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmLT(*i.AlgorithmLT))
 	}
-	if i.PredicateNEQ != nil {
-		predicates = append(predicates, statement.PredicateNEQ(*i.PredicateNEQ))
+	if i.AlgorithmLTE != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmLTE(*i.AlgorithmLTE))
 	}
-	if len(i.PredicateIn) > 0 {
-		predicates = append(predicates, statement.PredicateIn(i.PredicateIn...))
+	if i.AlgorithmContains != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmContains(*i.AlgorithmContains))
 	}
-	if len(i.PredicateNotIn) > 0 {
-		predicates = append(predicates, statement.PredicateNotIn(i.PredicateNotIn...))
+	if i.AlgorithmHasPrefix != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmHasPrefix(*i.AlgorithmHasPrefix))
 	}
-	if i.PredicateGT != nil {
-		predicates = append(predicates, statement.PredicateGT(*i.PredicateGT))
+	if i.AlgorithmHasSuffix != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmHasSuffix(*i.AlgorithmHasSuffix))
 	}
-	if i.PredicateGTE != nil {
-		predicates = append(predicates, statement.PredicateGTE(*i.PredicateGTE))
+	if i.AlgorithmEqualFold != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmEqualFold(*i.AlgorithmEqualFold))
 	}
-	if i.PredicateLT != nil {
-		predicates = append(predicates, statement.PredicateLT(*i.PredicateLT))
+	if i.AlgorithmContainsFold != nil {
+		predicates = append(predicates, subjectdigest.AlgorithmContainsFold(*i.AlgorithmContainsFold))
 	}
-	if i.PredicateLTE != nil {
-		predicates = append(predicates, statement.PredicateLTE(*i.PredicateLTE))
+	if i.Value != nil {
+		predicates = append(predicates, subjectdigest.ValueEQ(*i.Value))
 	}
-	if i.PredicateContains != nil {
-		predicates = append(predicates, statement.PredicateContains(*i.PredicateContains))
+	if i.ValueNEQ != nil {
+		predicates = append(predicates, subjectdigest.ValueNEQ(*i.ValueNEQ))
 	}
-	if i.PredicateHasPrefix != nil {
-		predicates = append(predicates, statement.PredicateHasPrefix(*i.PredicateHasPrefix))
+	if len(i.ValueIn) > 0 {
+		predicates = append(predicates, subjectdigest.ValueIn(i.ValueIn...))
 	}
-	if i.PredicateHasSuffix != nil {
-		predicates = append(predicates, statement.PredicateHasSuffix(*i.PredicateHasSuffix))
+	if len(i.ValueNotIn) > 0 {
+		predicates = append(predicates, subjectdigest.ValueNotIn(i.ValueNotIn...))
 	}
-	if i.PredicateEqualFold != nil {
-		predicates = append(predicates, statement.PredicateEqualFold(*i.PredicateEqualFold))
+	if i.ValueGT != nil {
+		predicates = append(predicates, subjectdigest.ValueGT(*i.ValueGT))
 	}
-	if i.PredicateContainsFold != nil {
-		predicates = append(predicates, statement.PredicateContainsFold(*i.PredicateContainsFold))
+	if i.ValueGTE != nil {
+		predicates = append(predicates, subjectdigest.ValueGTE(*i.ValueGTE))
 	}
-
-	if i.HasSubjects != nil {
-		p := statement.HasSubjects()
-		if !*i.HasSubjects {
-			p = statement.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ValueLT != nil {
+		predicates = append(predicates, subjectdigest.ValueLT(*i.ValueLT))
 	}
-	if len(i.HasSubjectsWith) > 0 {
-		with := make([]predicate.Subject, 0, len(i.HasSubjectsWith))
-		for _, w := range i.HasSubjectsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSubjectsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, statement.HasSubjectsWith(with...))
+	if i.ValueLTE != nil {
+		predicates = append(predicates, subjectdigest.ValueLTE(*i.ValueLTE))
 	}
-	if i.HasPolicy != nil {
-		p := statement.HasPolicy()
-		if !*i.HasPolicy {
-			p = statement.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ValueContains != nil {
+		predicates = append(predicates, subjectdigest.ValueContains(*i.ValueContains))
 	}
-	if len(i.HasPolicyWith) > 0 {
-		with := make([]predicate.AttestationPolicy, 0, len(i.HasPolicyWith))
-		for _, w := range i.HasPolicyWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasPolicyWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, statement.HasPolicyWith(with...))
+	if i.ValueHasPrefix != nil {
+		predicates = append(predicates, subjectdigest.ValueHasPrefix(*i.ValueHasPrefix))
 	}
-	if i.HasAttestationCollections != nil {
-		p := statement.HasAttestationCollections()
-		if !*i.HasAttestationCollections {
-			p = statement.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ValueHasSuffix != nil {
+		predicates = append(predicates, subjectdigest.ValueHasSuffix(*i.ValueHasSuffix))
 	}
-	if len(i.HasAttestationCollectionsWith) > 0 {
-		with := make([]predicate.AttestationCollection, 0, len(i.HasAttestationCollectionsWith))
-		for _, w := range i.HasAttestationCollectionsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasAttestationCollectionsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, statement.HasAttestationCollectionsWith(with...))
+	if i.ValueEqualFold != nil {
+		predicates = append(predicates, subjectdigest.ValueEqualFold(*i.ValueEqualFold))
 	}
-	if i.HasDsse != nil {
-		p := statement.HasDsse()
-		if !*i.HasDsse {
-			p = statement.Not(p)
+	if i.ValueContainsFold != nil {
+		predicates = append(predicates, subjectdigest.ValueContainsFold(*i.ValueContainsFold))
+	}
+
+	if i.HasSubject != nil {
+		p := subjectdigest.HasSubject()
+		if !*i.HasSubject {
+			p = subjectdigest.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasDsseWith) > 0 {
-		with := make([]predicate.Dsse, 0, len(i.HasDsseWith))
-		for _, w := range i.HasDsseWith {
+	if len(i.HasSubjectWith) > 0 {
+		with := make([]predicate.Subject, 0, len(i.HasSubjectWith))
+		for _, w := range i.HasSubjectWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasDsseWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasSubjectWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, statement.HasDsseWith(with...))
+		predicates = append(predicates, subjectdigest.HasSubjectWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyStatementWhereInput
+		return nil, ErrEmptySubjectDigestWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return statement.And(predicates...), nil
+		return subjectdigest.And(predicates...), nil
 	}
 }
 
-// SubjectWhereInput represents a where input for filtering Subject queries.
-type SubjectWhereInput struct {
-	Predicates []predicate.Subject  `json:"-"`
-	Not        *SubjectWhereInput   `json:"not,omitempty"`
-	Or         []*SubjectWhereInput `json:"or,omitempty"`
-	And        []*SubjectWhereInput `json:"and,omitempty"`
+// TimestampWhereInput represents a where input for filtering Timestamp queries.
+type TimestampWhereInput struct {
+	Predicates []predicate.Timestamp  `json:"-"`
+	Not        *TimestampWhereInput   `json:"not,omitempty"`
+	Or         []*TimestampWhereInput `json:"or,omitempty"`
+	And        []*TimestampWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -1796,55 +10961,49 @@ type SubjectWhereInput struct {
 	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
 	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
 
-	// "created_at" field predicates.
-	CreatedAt       *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ    *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn     []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn  []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT     *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE    *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT     *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE    *time.Time  `json:"createdAtLTE,omitempty"`
-	CreatedAtIsNil  bool        `json:"createdAtIsNil,omitempty"`
-	CreatedAtNotNil bool        `json:"createdAtNotNil,omitempty"`
-
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// "type" field predicates.
+	Type             *string  `json:"type,omitempty"`
+	TypeNEQ          *string  `json:"typeNEQ,omitempty"`
+	TypeIn           []string `json:"typeIn,omitempty"`
+	TypeNotIn        []string `json:"typeNotIn,omitempty"`
+	TypeGT           *string  `json:"typeGT,omitempty"`
+	TypeGTE          *string  `json:"typeGTE,omitempty"`
+	TypeLT           *string  `json:"typeLT,omitempty"`
+	TypeLTE          *string  `json:"typeLTE,omitempty"`
+	TypeContains     *string  `json:"typeContains,omitempty"`
+	TypeHasPrefix    *string  `json:"typeHasPrefix,omitempty"`
+	TypeHasSuffix    *string  `json:"typeHasSuffix,omitempty"`
+	TypeEqualFold    *string  `json:"typeEqualFold,omitempty"`
+	TypeContainsFold *string  `json:"typeContainsFold,omitempty"`
 
-	// "subject_digests" edge predicates.
-	HasSubjectDigests     *bool                      `json:"hasSubjectDigests,omitempty"`
-	HasSubjectDigestsWith []*SubjectDigestWhereInput `json:"hasSubjectDigestsWith,omitempty"`
+	// "timestamp" field predicates.
+	Timestamp      *time.Time  `json:"timestamp,omitempty"`
+	TimestampNEQ   *time.Time  `json:"timestampNEQ,omitempty"`
+	TimestampIn    []time.Time `json:"timestampIn,omitempty"`
+	TimestampNotIn []time.Time `json:"timestampNotIn,omitempty"`
+	TimestampGT    *time.Time  `json:"timestampGT,omitempty"`
+	TimestampGTE   *time.Time  `json:"timestampGTE,omitempty"`
+	TimestampLT    *time.Time  `json:"timestampLT,omitempty"`
+	TimestampLTE   *time.Time  `json:"timestampLTE,omitempty"`
 
-	// "statement" edge predicates.
-	HasStatement     *bool                  `json:"hasStatement,omitempty"`
-	HasStatementWith []*StatementWhereInput `json:"hasStatementWith,omitempty"`
+	// "signature" edge predicates.
+	HasSignature     *bool                  `json:"hasSignature,omitempty"`
+	HasSignatureWith []*SignatureWhereInput `json:"hasSignatureWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *SubjectWhereInput) AddPredicates(predicates ...predicate.Subject) {
+func (i *TimestampWhereInput) AddPredicates(predicates ...predicate.Timestamp) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the SubjectWhereInput filter on the SubjectQuery builder.
-func (i *SubjectWhereInput) Filter(q *SubjectQuery) (*SubjectQuery, error) {
+// Filter applies the TimestampWhereInput filter on the TimestampQuery builder.
+func (i *TimestampWhereInput) Filter(q *TimestampQuery) (*TimestampQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptySubjectWhereInput {
+		if err == ErrEmptyTimestampWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1852,19 +11011,19 @@ func (i *SubjectWhereInput) Filter(q *SubjectQuery) (*SubjectQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptySubjectWhereInput is returned in case the SubjectWhereInput is empty.
-var ErrEmptySubjectWhereInput = errors.New("ent: empty predicate SubjectWhereInput")
+// ErrEmptyTimestampWhereInput is returned in case the TimestampWhereInput is empty.
+var ErrEmptyTimestampWhereInput = errors.New("ent: empty predicate TimestampWhereInput")
 
-// P returns a predicate for filtering subjects.
+// P returns a predicate for filtering timestamps.
 // An error is returned if the input is empty or invalid.
-func (i *SubjectWhereInput) P() (predicate.Subject, error) {
-	var predicates []predicate.Subject
+func (i *TimestampWhereInput) P() (predicate.Timestamp, error) {
+	var predicates []predicate.Timestamp
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, subject.Not(p))
+		predicates = append(predicates, timestamp.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1874,7 +11033,7 @@ func (i *SubjectWhereInput) P() (predicate.Subject, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Subject, 0, n)
+		or := make([]predicate.Timestamp, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1882,7 +11041,7 @@ func (i *SubjectWhereInput) P() (predicate.Subject, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, subject.Or(or...))
+		predicates = append(predicates, timestamp.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1892,7 +11051,7 @@ func (i *SubjectWhereInput) P() (predicate.Subject, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Subject, 0, n)
+		and := make([]predicate.Timestamp, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1900,155 +11059,131 @@ func (i *SubjectWhereInput) P() (predicate.Subject, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, subject.And(and...))
+		predicates = append(predicates, timestamp.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, subject.IDEQ(*i.ID))
+		predicates = append(predicates, timestamp.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, subject.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, timestamp.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, subject.IDIn(i.IDIn...))
+		predicates = append(predicates, timestamp.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, subject.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, timestamp.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, subject.IDGT(*i.IDGT))
+		predicates = append(predicates, timestamp.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, subject.IDGTE(*i.IDGTE))
+		predicates = append(predicates, timestamp.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, subject.IDLT(*i.IDLT))
+		predicates = append(predicates, timestamp.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, subject.IDLTE(*i.IDLTE))
-	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, subject.CreatedAtEQ(*i.CreatedAt))
-	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, subject.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, timestamp.IDLTE(*i.IDLTE))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, subject.CreatedAtIn(i.CreatedAtIn...))
+	if i.Type != nil {
+		predicates = append(predicates, timestamp.TypeEQ(*i.Type))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, subject.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, timestamp.TypeNEQ(*i.TypeNEQ))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, subject.CreatedAtGT(*i.CreatedAtGT))
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, timestamp.TypeIn(i.TypeIn...))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, subject.CreatedAtGTE(*i.CreatedAtGTE))
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, timestamp.TypeNotIn(i.TypeNotIn...))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, subject.CreatedAtLT(*i.CreatedAtLT))
+	if i.TypeGT != nil {
+		predicates = append(predicates, timestamp.TypeGT(*i.TypeGT))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, subject.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.TypeGTE != nil {
+		predicates = append(predicates, timestamp.TypeGTE(*i.TypeGTE))
 	}
-	if i.CreatedAtIsNil {
-		predicates = append(predicates, subject.CreatedAtIsNil())
+	if i.TypeLT != nil {
+		predicates = append(predicates, timestamp.TypeLT(*i.TypeLT))
 	}
-	if i.CreatedAtNotNil {
-		predicates = append(predicates, subject.CreatedAtNotNil())
+	if i.TypeLTE != nil {
+		predicates = append(predicates, timestamp.TypeLTE(*i.TypeLTE))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, subject.NameEQ(*i.Name))
+	if i.TypeContains != nil {
+		predicates = append(predicates, timestamp.TypeContains(*i.TypeContains))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, subject.NameNEQ(*i.NameNEQ))
+	if i.TypeHasPrefix != nil {
+		predicates = append(predicates, timestamp.TypeHasPrefix(*i.TypeHasPrefix))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, subject.NameIn(i.NameIn...))
+	if i.TypeHasSuffix != nil {
+		predicates = append(predicates, timestamp.TypeHasSuffix(*i.TypeHasSuffix))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, subject.NameNotIn(i.NameNotIn...))
+	if i.TypeEqualFold != nil {
+		predicates = append(predicates, timestamp.TypeEqualFold(*i.TypeEqualFold))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, subject.NameGT(*i.NameGT))
+	if i.TypeContainsFold != nil {
+		predicates = append(predicates, timestamp.TypeContainsFold(*i.TypeContainsFold))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, subject.NameGTE(*i.NameGTE))
+	if i.Timestamp != nil {
+		predicates = append(predicates, timestamp.TimestampEQ(*i.Timestamp))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, subject.NameLT(*i.NameLT))
+	if i.TimestampNEQ != nil {
+		predicates = append(predicates, timestamp.TimestampNEQ(*i.TimestampNEQ))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, subject.NameLTE(*i.NameLTE))
+	if len(i.TimestampIn) > 0 {
+		predicates = append(predicates, timestamp.TimestampIn(i.TimestampIn...))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, subject.NameContains(*i.NameContains))
+	if len(i.TimestampNotIn) > 0 {
+		predicates = append(predicates, timestamp.TimestampNotIn(i.TimestampNotIn...))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, subject.NameHasPrefix(*i.NameHasPrefix))
+	if i.TimestampGT != nil {
+		predicates = append(predicates, timestamp.TimestampGT(*i.TimestampGT))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, subject.NameHasSuffix(*i.NameHasSuffix))
+	if i.TimestampGTE != nil {
+		predicates = append(predicates, timestamp.TimestampGTE(*i.TimestampGTE))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, subject.NameEqualFold(*i.NameEqualFold))
+	if i.TimestampLT != nil {
+		predicates = append(predicates, timestamp.TimestampLT(*i.TimestampLT))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, subject.NameContainsFold(*i.NameContainsFold))
+	if i.TimestampLTE != nil {
+		predicates = append(predicates, timestamp.TimestampLTE(*i.TimestampLTE))
 	}
 
-	if i.HasSubjectDigests != nil {
-		p := subject.HasSubjectDigests()
-		if !*i.HasSubjectDigests {
-			p = subject.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasSubjectDigestsWith) > 0 {
-		with := make([]predicate.SubjectDigest, 0, len(i.HasSubjectDigestsWith))
-		for _, w := range i.HasSubjectDigestsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSubjectDigestsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, subject.HasSubjectDigestsWith(with...))
-	}
-	if i.HasStatement != nil {
-		p := subject.HasStatement()
-		if !*i.HasStatement {
-			p = subject.Not(p)
+	if i.HasSignature != nil {
+		p := timestamp.HasSignature()
+		if !*i.HasSignature {
+			p = timestamp.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasStatementWith) > 0 {
-		with := make([]predicate.Statement, 0, len(i.HasStatementWith))
-		for _, w := range i.HasStatementWith {
+	if len(i.HasSignatureWith) > 0 {
+		with := make([]predicate.Signature, 0, len(i.HasSignatureWith))
+		for _, w := range i.HasSignatureWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasStatementWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasSignatureWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, subject.HasStatementWith(with...))
+		predicates = append(predicates, timestamp.HasSignatureWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptySubjectWhereInput
+		return nil, ErrEmptyTimestampWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return subject.And(predicates...), nil
+		return timestamp.And(predicates...), nil
 	}
 }
 
-// SubjectDigestWhereInput represents a where input for filtering SubjectDigest queries.
-type SubjectDigestWhereInput struct {
-	Predicates []predicate.SubjectDigest  `json:"-"`
-	Not        *SubjectDigestWhereInput   `json:"not,omitempty"`
-	Or         []*SubjectDigestWhereInput `json:"or,omitempty"`
-	And        []*SubjectDigestWhereInput `json:"and,omitempty"`
+// TransparencyLogEntryWhereInput represents a where input for filtering TransparencyLogEntry queries.
+type TransparencyLogEntryWhereInput struct {
+	Predicates []predicate.TransparencyLogEntry  `json:"-"`
+	Not        *TransparencyLogEntryWhereInput   `json:"not,omitempty"`
+	Or         []*TransparencyLogEntryWhereInput `json:"or,omitempty"`
+	And        []*TransparencyLogEntryWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -2060,54 +11195,110 @@ type SubjectDigestWhereInput struct {
 	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
 	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
 
-	// "algorithm" field predicates.
-	Algorithm             *string  `json:"algorithm,omitempty"`
-	AlgorithmNEQ          *string  `json:"algorithmNEQ,omitempty"`
-	AlgorithmIn           []string `json:"algorithmIn,omitempty"`
-	AlgorithmNotIn        []string `json:"algorithmNotIn,omitempty"`
-	AlgorithmGT           *string  `json:"algorithmGT,omitempty"`
-	AlgorithmGTE          *string  `json:"algorithmGTE,omitempty"`
-	AlgorithmLT           *string  `json:"algorithmLT,omitempty"`
-	AlgorithmLTE          *string  `json:"algorithmLTE,omitempty"`
-	AlgorithmContains     *string  `json:"algorithmContains,omitempty"`
-	AlgorithmHasPrefix    *string  `json:"algorithmHasPrefix,omitempty"`
-	AlgorithmHasSuffix    *string  `json:"algorithmHasSuffix,omitempty"`
-	AlgorithmEqualFold    *string  `json:"algorithmEqualFold,omitempty"`
-	AlgorithmContainsFold *string  `json:"algorithmContainsFold,omitempty"`
+	// "log_index" field predicates.
+	LogIndex      *int64  `json:"logIndex,omitempty"`
+	LogIndexNEQ   *int64  `json:"logIndexNEQ,omitempty"`
+	LogIndexIn    []int64 `json:"logIndexIn,omitempty"`
+	LogIndexNotIn []int64 `json:"logIndexNotIn,omitempty"`
+	LogIndexGT    *int64  `json:"logIndexGT,omitempty"`
+	LogIndexGTE   *int64  `json:"logIndexGTE,omitempty"`
+	LogIndexLT    *int64  `json:"logIndexLT,omitempty"`
+	LogIndexLTE   *int64  `json:"logIndexLTE,omitempty"`
 
-	// "value" field predicates.
-	Value             *string  `json:"value,omitempty"`
-	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
-	ValueIn           []string `json:"valueIn,omitempty"`
-	ValueNotIn        []string `json:"valueNotIn,omitempty"`
-	ValueGT           *string  `json:"valueGT,omitempty"`
-	ValueGTE          *string  `json:"valueGTE,omitempty"`
-	ValueLT           *string  `json:"valueLT,omitempty"`
-	ValueLTE          *string  `json:"valueLTE,omitempty"`
-	ValueContains     *string  `json:"valueContains,omitempty"`
-	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
-	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
-	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
-	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+	// "log_id" field predicates.
+	LogID             *string  `json:"logID,omitempty"`
+	LogIDNEQ          *string  `json:"logIDNEQ,omitempty"`
+	LogIDIn           []string `json:"logIDIn,omitempty"`
+	LogIDNotIn        []string `json:"logIDNotIn,omitempty"`
+	LogIDGT           *string  `json:"logIDGT,omitempty"`
+	LogIDGTE          *string  `json:"logIDGTE,omitempty"`
+	LogIDLT           *string  `json:"logIDLT,omitempty"`
+	LogIDLTE          *string  `json:"logIDLTE,omitempty"`
+	LogIDContains     *string  `json:"logIDContains,omitempty"`
+	LogIDHasPrefix    *string  `json:"logIDHasPrefix,omitempty"`
+	LogIDHasSuffix    *string  `json:"logIDHasSuffix,omitempty"`
+	LogIDEqualFold    *string  `json:"logIDEqualFold,omitempty"`
+	LogIDContainsFold *string  `json:"logIDContainsFold,omitempty"`
 
-	// "subject" edge predicates.
-	HasSubject     *bool                `json:"hasSubject,omitempty"`
-	HasSubjectWith []*SubjectWhereInput `json:"hasSubjectWith,omitempty"`
+	// "kind" field predicates.
+	Kind             *string  `json:"kind,omitempty"`
+	KindNEQ          *string  `json:"kindNEQ,omitempty"`
+	KindIn           []string `json:"kindIn,omitempty"`
+	KindNotIn        []string `json:"kindNotIn,omitempty"`
+	KindGT           *string  `json:"kindGT,omitempty"`
+	KindGTE          *string  `json:"kindGTE,omitempty"`
+	KindLT           *string  `json:"kindLT,omitempty"`
+	KindLTE          *string  `json:"kindLTE,omitempty"`
+	KindContains     *string  `json:"kindContains,omitempty"`
+	KindHasPrefix    *string  `json:"kindHasPrefix,omitempty"`
+	KindHasSuffix    *string  `json:"kindHasSuffix,omitempty"`
+	KindIsNil        bool     `json:"kindIsNil,omitempty"`
+	KindNotNil       bool     `json:"kindNotNil,omitempty"`
+	KindEqualFold    *string  `json:"kindEqualFold,omitempty"`
+	KindContainsFold *string  `json:"kindContainsFold,omitempty"`
+
+	// "kind_version" field predicates.
+	KindVersion             *string  `json:"kindVersion,omitempty"`
+	KindVersionNEQ          *string  `json:"kindVersionNEQ,omitempty"`
+	KindVersionIn           []string `json:"kindVersionIn,omitempty"`
+	KindVersionNotIn        []string `json:"kindVersionNotIn,omitempty"`
+	KindVersionGT           *string  `json:"kindVersionGT,omitempty"`
+	KindVersionGTE          *string  `json:"kindVersionGTE,omitempty"`
+	KindVersionLT           *string  `json:"kindVersionLT,omitempty"`
+	KindVersionLTE          *string  `json:"kindVersionLTE,omitempty"`
+	KindVersionContains     *string  `json:"kindVersionContains,omitempty"`
+	KindVersionHasPrefix    *string  `json:"kindVersionHasPrefix,omitempty"`
+	KindVersionHasSuffix    *string  `json:"kindVersionHasSuffix,omitempty"`
+	KindVersionIsNil        bool     `json:"kindVersionIsNil,omitempty"`
+	KindVersionNotNil       bool     `json:"kindVersionNotNil,omitempty"`
+	KindVersionEqualFold    *string  `json:"kindVersionEqualFold,omitempty"`
+	KindVersionContainsFold *string  `json:"kindVersionContainsFold,omitempty"`
+
+	// "integrated_time" field predicates.
+	IntegratedTime       *time.Time  `json:"integratedTime,omitempty"`
+	IntegratedTimeNEQ    *time.Time  `json:"integratedTimeNEQ,omitempty"`
+	IntegratedTimeIn     []time.Time `json:"integratedTimeIn,omitempty"`
+	IntegratedTimeNotIn  []time.Time `json:"integratedTimeNotIn,omitempty"`
+	IntegratedTimeGT     *time.Time  `json:"integratedTimeGT,omitempty"`
+	IntegratedTimeGTE    *time.Time  `json:"integratedTimeGTE,omitempty"`
+	IntegratedTimeLT     *time.Time  `json:"integratedTimeLT,omitempty"`
+	IntegratedTimeLTE    *time.Time  `json:"integratedTimeLTE,omitempty"`
+	IntegratedTimeIsNil  bool        `json:"integratedTimeIsNil,omitempty"`
+	IntegratedTimeNotNil bool        `json:"integratedTimeNotNil,omitempty"`
+
+	// "entry" field predicates.
+	Entry             *string  `json:"entry,omitempty"`
+	EntryNEQ          *string  `json:"entryNEQ,omitempty"`
+	EntryIn           []string `json:"entryIn,omitempty"`
+	EntryNotIn        []string `json:"entryNotIn,omitempty"`
+	EntryGT           *string  `json:"entryGT,omitempty"`
+	EntryGTE          *string  `json:"entryGTE,omitempty"`
+	EntryLT           *string  `json:"entryLT,omitempty"`
+	EntryLTE          *string  `json:"entryLTE,omitempty"`
+	EntryContains     *string  `json:"entryContains,omitempty"`
+	EntryHasPrefix    *string  `json:"entryHasPrefix,omitempty"`
+	EntryHasSuffix    *string  `json:"entryHasSuffix,omitempty"`
+	EntryEqualFold    *string  `json:"entryEqualFold,omitempty"`
+	EntryContainsFold *string  `json:"entryContainsFold,omitempty"`
+
+	// "dsse" edge predicates.
+	HasDsse     *bool             `json:"hasDsse,omitempty"`
+	HasDsseWith []*DsseWhereInput `json:"hasDsseWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *SubjectDigestWhereInput) AddPredicates(predicates ...predicate.SubjectDigest) {
+func (i *TransparencyLogEntryWhereInput) AddPredicates(predicates ...predicate.TransparencyLogEntry) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the SubjectDigestWhereInput filter on the SubjectDigestQuery builder.
-func (i *SubjectDigestWhereInput) Filter(q *SubjectDigestQuery) (*SubjectDigestQuery, error) {
+// Filter applies the TransparencyLogEntryWhereInput filter on the TransparencyLogEntryQuery builder.
+func (i *TransparencyLogEntryWhereInput) Filter(q *TransparencyLogEntryQuery) (*TransparencyLogEntryQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptySubjectDigestWhereInput {
+		if err == ErrEmptyTransparencyLogEntryWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2115,19 +11306,19 @@ func (i *SubjectDigestWhereInput) Filter(q *SubjectDigestQuery) (*SubjectDigestQ
 	return q.Where(p), nil
 }
 
-// ErrEmptySubjectDigestWhereInput is returned in case the SubjectDigestWhereInput is empty.
-var ErrEmptySubjectDigestWhereInput = errors.New("ent: empty predicate SubjectDigestWhereInput")
+// ErrEmptyTransparencyLogEntryWhereInput is returned in case the TransparencyLogEntryWhereInput is empty.
+var ErrEmptyTransparencyLogEntryWhereInput = errors.New("ent: empty predicate TransparencyLogEntryWhereInput")
 
-// P returns a predicate for filtering subjectdigests.
+// P returns a predicate for filtering transparencylogentries.
 // An error is returned if the input is empty or invalid.
-func (i *SubjectDigestWhereInput) P() (predicate.SubjectDigest, error) {
-	var predicates []predicate.SubjectDigest
+func (i *TransparencyLogEntryWhereInput) P() (predicate.TransparencyLogEntry, error) {
+	var predicates []predicate.TransparencyLogEntry
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, subjectdigest.Not(p))
+		predicates = append(predicates, transparencylogentry.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2137,7 +11328,7 @@ func (i *SubjectDigestWhereInput) P() (predicate.SubjectDigest, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.SubjectDigest, 0, n)
+		or := make([]predicate.TransparencyLogEntry, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2145,7 +11336,7 @@ func (i *SubjectDigestWhereInput) P() (predicate.SubjectDigest, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, subjectdigest.Or(or...))
+		predicates = append(predicates, transparencylogentry.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2155,7 +11346,7 @@ func (i *SubjectDigestWhereInput) P() (predicate.SubjectDigest, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.SubjectDigest, 0, n)
+		and := make([]predicate.TransparencyLogEntry, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2163,146 +11354,290 @@ func (i *SubjectDigestWhereInput) P() (predicate.SubjectDigest, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, subjectdigest.And(and...))
+		predicates = append(predicates, transparencylogentry.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, subjectdigest.IDEQ(*i.ID))
+		predicates = append(predicates, transparencylogentry.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, subjectdigest.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, transparencylogentry.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, subjectdigest.IDIn(i.IDIn...))
+		predicates = append(predicates, transparencylogentry.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, subjectdigest.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, transparencylogentry.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, transparencylogentry.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, transparencylogentry.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, transparencylogentry.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, transparencylogentry.IDLTE(*i.IDLTE))
+	}
+	if i.LogIndex != nil {
+		predicates = append(predicates, transparencylogentry.LogIndexEQ(*i.LogIndex))
+	}
+	if i.LogIndexNEQ != nil {
+		predicates = append(predicates, transparencylogentry.LogIndexNEQ(*i.LogIndexNEQ))
+	}
+	if len(i.LogIndexIn) > 0 {
+		predicates = append(predicates, transparencylogentry.LogIndexIn(i.LogIndexIn...))
+	}
+	if len(i.LogIndexNotIn) > 0 {
+		predicates = append(predicates, transparencylogentry.LogIndexNotIn(i.LogIndexNotIn...))
+	}
+	if i.LogIndexGT != nil {
+		predicates = append(predicates, transparencylogentry.LogIndexGT(*i.LogIndexGT))
+	}
+	if i.LogIndexGTE != nil {
+		predicates = append(predicates, transparencylogentry.LogIndexGTE(*i.LogIndexGTE))
+	}
+	if i.LogIndexLT != nil {
+		predicates = append(predicates, transparencylogentry.LogIndexLT(*i.LogIndexLT))
+	}
+	if i.LogIndexLTE != nil {
+		predicates = append(predicates, transparencylogentry.LogIndexLTE(*i.LogIndexLTE))
+	}
+	if i.LogID != nil {
+		predicates = append(predicates, transparencylogentry.LogIDEQ(*i.LogID))
+	}
+	if i.LogIDNEQ != nil {
+		predicates = append(predicates, transparencylogentry.LogIDNEQ(*i.LogIDNEQ))
+	}
+	if len(i.LogIDIn) > 0 {
+		predicates = append(predicates, transparencylogentry.LogIDIn(i.LogIDIn...))
+	}
+	if len(i.LogIDNotIn) > 0 {
+		predicates = append(predicates, transparencylogentry.LogIDNotIn(i.LogIDNotIn...))
+	}
+	if i.LogIDGT != nil {
+		predicates = append(predicates, transparencylogentry.LogIDGT(*i.LogIDGT))
+	}
+	if i.LogIDGTE != nil {
+		predicates = append(predicates, transparencylogentry.LogIDGTE(*i.LogIDGTE))
+	}
+	if i.LogIDLT != nil {
+		predicates = append(predicates, transparencylogentry.LogIDLT(*i.LogIDLT))
+	}
+	if i.LogIDLTE != nil {
+		predicates = append(predicates, transparencylogentry.LogIDLTE(*i.LogIDLTE))
+	}
+	if i.LogIDContains != nil {
+		predicates = append(predicates, transparencylogentry.LogIDContains(*i.LogIDContains))
+	}
+	if i.LogIDHasPrefix != nil {
+		predicates = append(predicates, transparencylogentry.LogIDHasPrefix(*i.LogIDHasPrefix))
+	}
+	if i.LogIDHasSuffix != nil {
+		predicates = append(predicates, transparencylogentry.LogIDHasSuffix(*i.LogIDHasSuffix))
+	}
+	if i.LogIDEqualFold != nil {
+		predicates = append(predicates, transparencylogentry.LogIDEqualFold(*i.LogIDEqualFold))
+	}
+	if i.LogIDContainsFold != nil {
+		predicates = append(predicates, transparencylogentry.LogIDContainsFold(*i.LogIDContainsFold))
+	}
+	if i.Kind != nil {
+		predicates = append(predicates, transparencylogentry.KindEQ(*i.Kind))
+	}
+	if i.KindNEQ != nil {
+		predicates = append(predicates, transparencylogentry.KindNEQ(*i.KindNEQ))
+	}
+	if len(i.KindIn) > 0 {
+		predicates = append(predicates, transparencylogentry.KindIn(i.KindIn...))
+	}
+	if len(i.KindNotIn) > 0 {
+		predicates = append(predicates, transparencylogentry.KindNotIn(i.KindNotIn...))
+	}
+	if i.KindGT != nil {
+		predicates = append(predicates, transparencylogentry.KindGT(*i.KindGT))
+	}
+	if i.KindGTE != nil {
+		predicates = append(predicates, transparencylogentry.KindGTE(*i.KindGTE))
+	}
+	if i.KindLT != nil {
+		predicates = append(predicates, transparencylogentry.KindLT(*i.KindLT))
+	}
+	if i.KindLTE != nil {
+		predicates = append(predicates, transparencylogentry.KindLTE(*i.KindLTE))
+	}
+	if i.KindContains != nil {
+		predicates = append(predicates, transparencylogentry.KindContains(*i.KindContains))
+	}
+	if i.KindHasPrefix != nil {
+		predicates = append(predicates, transparencylogentry.KindHasPrefix(*i.KindHasPrefix))
+	}
+	if i.KindHasSuffix != nil {
+		predicates = append(predicates, transparencylogentry.KindHasSuffix(*i.KindHasSuffix))
+	}
+	if i.KindIsNil {
+		predicates = append(predicates, transparencylogentry.KindIsNil())
+	}
+	if i.KindNotNil {
+		predicates = append(predicates, transparencylogentry.KindNotNil())
+	}
+	if i.KindEqualFold != nil {
+		predicates = append(predicates, transparencylogentry.KindEqualFold(*i.KindEqualFold))
+	}
+	if i.KindContainsFold != nil {
+		predicates = append(predicates, transparencylogentry.KindContainsFold(*i.KindContainsFold))
+	}
+	if i.KindVersion != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionEQ(*i.KindVersion))
+	}
+	if i.KindVersionNEQ != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionNEQ(*i.KindVersionNEQ))
+	}
+	if len(i.KindVersionIn) > 0 {
+		predicates = append(predicates, transparencylogentry.KindVersionIn(i.KindVersionIn...))
+	}
+	if len(i.KindVersionNotIn) > 0 {
+		predicates = append(predicates, transparencylogentry.KindVersionNotIn(i.KindVersionNotIn...))
+	}
+	if i.KindVersionGT != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionGT(*i.KindVersionGT))
+	}
+	if i.KindVersionGTE != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionGTE(*i.KindVersionGTE))
+	}
+	if i.KindVersionLT != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionLT(*i.KindVersionLT))
 	}
-	if i.IDGT != nil {
-		predicates = append(predicates, subjectdigest.IDGT(*i.IDGT))
+	if i.KindVersionLTE != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionLTE(*i.KindVersionLTE))
 	}
-	if i.IDGTE != nil {
-		predicates = append(predicates, subjectdigest.IDGTE(*i.IDGTE))
+	if i.KindVersionContains != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionContains(*i.KindVersionContains))
 	}
-	if i.IDLT != nil {
-		predicates = append(predicates, subjectdigest.IDLT(*i.IDLT))
+	if i.KindVersionHasPrefix != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionHasPrefix(*i.KindVersionHasPrefix))
 	}
-	if i.IDLTE != nil {
-		predicates = append(predicates, subjectdigest.IDLTE(*i.IDLTE))
+	if i.KindVersionHasSuffix != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionHasSuffix(*i.KindVersionHasSuffix))
 	}
-	if i.Algorithm != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmEQ(*i.Algorithm))
+	if i.KindVersionIsNil {
+		predicates = append(predicates, transparencylogentry.KindVersionIsNil())
 	}
-	if i.AlgorithmNEQ != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmNEQ(*i.AlgorithmNEQ))
+	if i.KindVersionNotNil {
+		predicates = append(predicates, transparencylogentry.KindVersionNotNil())
 	}
-	if len(i.AlgorithmIn) > 0 {
-		predicates = append(predicates, subjectdigest.AlgorithmIn(i.AlgorithmIn...))
+	if i.KindVersionEqualFold != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionEqualFold(*i.KindVersionEqualFold))
 	}
-	if len(i.AlgorithmNotIn) > 0 {
-		predicates = append(predicates, subjectdigest.AlgorithmNotIn(i.AlgorithmNotIn...))
+	if i.KindVersionContainsFold != nil {
+		predicates = append(predicates, transparencylogentry.KindVersionContainsFold(*i.KindVersionContainsFold))
 	}
-	if i.AlgorithmGT != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmGT(*i.AlgorithmGT))
+	if i.IntegratedTime != nil {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeEQ(*i.IntegratedTime))
 	}
-	if i.AlgorithmGTE != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmGTE(*i.AlgorithmGTE))
+	if i.IntegratedTimeNEQ != nil {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeNEQ(*i.IntegratedTimeNEQ))
 	}
-	if i.AlgorithmLT != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmLT(*i.AlgorithmLT))
+	if len(i.IntegratedTimeIn) > 0 {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeIn(i.IntegratedTimeIn...))
 	}
-	if i.AlgorithmLTE != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmLTE(*i.AlgorithmLTE))
+	if len(i.IntegratedTimeNotIn) > 0 {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeNotIn(i.IntegratedTimeNotIn...))
 	}
-	if i.AlgorithmContains != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmContains(*i.AlgorithmContains))
+	if i.IntegratedTimeGT != nil {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeGT(*i.IntegratedTimeGT))
 	}
-	if i.AlgorithmHasPrefix != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmHasPrefix(*i.AlgorithmHasPrefix))
+	if i.IntegratedTimeGTE != nil {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeGTE(*i.IntegratedTimeGTE))
 	}
-	if i.AlgorithmHasSuffix != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmHasSuffix(*i.AlgorithmHasSuffix))
+	if i.IntegratedTimeLT != nil {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeLT(*i.IntegratedTimeLT))
 	}
-	if i.AlgorithmEqualFold != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmEqualFold(*i.AlgorithmEqualFold))
+	if i.IntegratedTimeLTE != nil {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeLTE(*i.IntegratedTimeLTE))
 	}
-	if i.AlgorithmContainsFold != nil {
-		predicates = append(predicates, subjectdigest.AlgorithmContainsFold(*i.AlgorithmContainsFold))
+	if i.IntegratedTimeIsNil {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeIsNil())
 	}
-	if i.Value != nil {
-		predicates = append(predicates, subjectdigest.ValueEQ(*i.Value))
+	if i.IntegratedTimeNotNil {
+		predicates = append(predicates, transparencylogentry.IntegratedTimeNotNil())
 	}
-	if i.ValueNEQ != nil {
-		predicates = append(predicates, subjectdigest.ValueNEQ(*i.ValueNEQ))
+	if i.Entry != nil {
+		predicates = append(predicates, transparencylogentry.EntryEQ(*i.Entry))
 	}
-	if len(i.ValueIn) > 0 {
-		predicates = append(predicates, subjectdigest.ValueIn(i.ValueIn...))
+	if i.EntryNEQ != nil {
+		predicates = append(predicates, transparencylogentry.EntryNEQ(*i.EntryNEQ))
 	}
-	if len(i.ValueNotIn) > 0 {
-		predicates = append(predicates, subjectdigest.ValueNotIn(i.ValueNotIn...))
+	if len(i.EntryIn) > 0 {
+		predicates = append(predicates, transparencylogentry.EntryIn(i.EntryIn...))
 	}
-	if i.ValueGT != nil {
-		predicates = append(predicates, subjectdigest.ValueGT(*i.ValueGT))
+	if len(i.EntryNotIn) > 0 {
+		predicates = append(predicates, transparencylogentry.EntryNotIn(i.EntryNotIn...))
 	}
-	if i.ValueGTE != nil {
-		predicates = append(predicates, subjectdigest.ValueGTE(*i.ValueGTE))
+	if i.EntryGT != nil {
+		predicates = append(predicates, transparencylogentry.EntryGT(*i.EntryGT))
 	}
-	if i.ValueLT != nil {
-		predicates = append(predicates, subjectdigest.ValueLT(*i.ValueLT))
+	if i.EntryGTE != nil {
+		predicates = append(predicates, transparencylogentry.EntryGTE(*i.EntryGTE))
 	}
-	if i.ValueLTE != nil {
-		predicates = append(predicates, subjectdigest.ValueLTE(*i.ValueLTE))
+	if i.EntryLT != nil {
+		predicates = append(predicates, transparencylogentry.EntryLT(*i.EntryLT))
 	}
-	if i.ValueContains != nil {
-		predicates = append(predicates, subjectdigest.ValueContains(*i.ValueContains))
+	if i.EntryLTE != nil {
+		predicates = append(predicates, transparencylogentry.EntryLTE(*i.EntryLTE))
 	}
-	if i.ValueHasPrefix != nil {
-		predicates = append(predicates, subjectdigest.ValueHasPrefix(*i.ValueHasPrefix))
+	if i.EntryContains != nil {
+		predicates = append(predicates, transparencylogentry.EntryContains(*i.EntryContains))
 	}
-	if i.ValueHasSuffix != nil {
-		predicates = append(predicates, subjectdigest.ValueHasSuffix(*i.ValueHasSuffix))
+	if i.EntryHasPrefix != nil {
+		predicates = append(predicates, transparencylogentry.EntryHasPrefix(*i.EntryHasPrefix))
 	}
-	if i.ValueEqualFold != nil {
-		predicates = append(predicates, subjectdigest.ValueEqualFold(*i.ValueEqualFold))
+	if i.EntryHasSuffix != nil {
+		predicates = append(predicates, transparencylogentry.EntryHasSuffix(*i.EntryHasSuffix))
 	}
-	if i.ValueContainsFold != nil {
-		predicates = append(predicates, subjectdigest.ValueContainsFold(*i.ValueContainsFold))
+	if i.EntryEqualFold != nil {
+		predicates = append(predicates, transparencylogentry.EntryEqualFold(*i.EntryEqualFold))
+	}
+	if i.EntryContainsFold != nil {
+		predicates = append(predicates, transparencylogentry.EntryContainsFold(*i.EntryContainsFold))
 	}
 
-	if i.HasSubject != nil {
-		p := subjectdigest.HasSubject()
-		if !*i.HasSubject {
-			p = subjectdigest.Not(p)
+	if i.HasDsse != nil {
+		p := transparencylogentry.HasDsse()
+		if !*i.HasDsse {
+			p = transparencylogentry.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasSubjectWith) > 0 {
-		with := make([]predicate.Subject, 0, len(i.HasSubjectWith))
-		for _, w := range i.HasSubjectWith {
+	if len(i.HasDsseWith) > 0 {
+		with := make([]predicate.Dsse, 0, len(i.HasDsseWith))
+		for _, w := range i.HasDsseWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSubjectWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasDsseWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, subjectdigest.HasSubjectWith(with...))
+		predicates = append(predicates, transparencylogentry.HasDsseWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptySubjectDigestWhereInput
+		return nil, ErrEmptyTransparencyLogEntryWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return subjectdigest.And(predicates...), nil
+		return transparencylogentry.And(predicates...), nil
 	}
 }
 
-// TimestampWhereInput represents a where input for filtering Timestamp queries.
-type TimestampWhereInput struct {
-	Predicates []predicate.Timestamp  `json:"-"`
-	Not        *TimestampWhereInput   `json:"not,omitempty"`
-	Or         []*TimestampWhereInput `json:"or,omitempty"`
-	And        []*TimestampWhereInput `json:"and,omitempty"`
+// VerificationSummaryWhereInput represents a where input for filtering VerificationSummary queries.
+type VerificationSummaryWhereInput struct {
+	Predicates []predicate.VerificationSummary  `json:"-"`
+	Not        *VerificationSummaryWhereInput   `json:"not,omitempty"`
+	Or         []*VerificationSummaryWhereInput `json:"or,omitempty"`
+	And        []*VerificationSummaryWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -2314,49 +11649,115 @@ type TimestampWhereInput struct {
 	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
 	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
 
-	// "type" field predicates.
-	Type             *string  `json:"type,omitempty"`
-	TypeNEQ          *string  `json:"typeNEQ,omitempty"`
-	TypeIn           []string `json:"typeIn,omitempty"`
-	TypeNotIn        []string `json:"typeNotIn,omitempty"`
-	TypeGT           *string  `json:"typeGT,omitempty"`
-	TypeGTE          *string  `json:"typeGTE,omitempty"`
-	TypeLT           *string  `json:"typeLT,omitempty"`
-	TypeLTE          *string  `json:"typeLTE,omitempty"`
-	TypeContains     *string  `json:"typeContains,omitempty"`
-	TypeHasPrefix    *string  `json:"typeHasPrefix,omitempty"`
-	TypeHasSuffix    *string  `json:"typeHasSuffix,omitempty"`
-	TypeEqualFold    *string  `json:"typeEqualFold,omitempty"`
-	TypeContainsFold *string  `json:"typeContainsFold,omitempty"`
+	// "verifier_id" field predicates.
+	VerifierID             *string  `json:"verifierID,omitempty"`
+	VerifierIDNEQ          *string  `json:"verifierIDNEQ,omitempty"`
+	VerifierIDIn           []string `json:"verifierIDIn,omitempty"`
+	VerifierIDNotIn        []string `json:"verifierIDNotIn,omitempty"`
+	VerifierIDGT           *string  `json:"verifierIDGT,omitempty"`
+	VerifierIDGTE          *string  `json:"verifierIDGTE,omitempty"`
+	VerifierIDLT           *string  `json:"verifierIDLT,omitempty"`
+	VerifierIDLTE          *string  `json:"verifierIDLTE,omitempty"`
+	VerifierIDContains     *string  `json:"verifierIDContains,omitempty"`
+	VerifierIDHasPrefix    *string  `json:"verifierIDHasPrefix,omitempty"`
+	VerifierIDHasSuffix    *string  `json:"verifierIDHasSuffix,omitempty"`
+	VerifierIDEqualFold    *string  `json:"verifierIDEqualFold,omitempty"`
+	VerifierIDContainsFold *string  `json:"verifierIDContainsFold,omitempty"`
 
-	// "timestamp" field predicates.
-	Timestamp      *time.Time  `json:"timestamp,omitempty"`
-	TimestampNEQ   *time.Time  `json:"timestampNEQ,omitempty"`
-	TimestampIn    []time.Time `json:"timestampIn,omitempty"`
-	TimestampNotIn []time.Time `json:"timestampNotIn,omitempty"`
-	TimestampGT    *time.Time  `json:"timestampGT,omitempty"`
-	TimestampGTE   *time.Time  `json:"timestampGTE,omitempty"`
-	TimestampLT    *time.Time  `json:"timestampLT,omitempty"`
-	TimestampLTE   *time.Time  `json:"timestampLTE,omitempty"`
+	// "time_verified" field predicates.
+	TimeVerified       *time.Time  `json:"timeVerified,omitempty"`
+	TimeVerifiedNEQ    *time.Time  `json:"timeVerifiedNEQ,omitempty"`
+	TimeVerifiedIn     []time.Time `json:"timeVerifiedIn,omitempty"`
+	TimeVerifiedNotIn  []time.Time `json:"timeVerifiedNotIn,omitempty"`
+	TimeVerifiedGT     *time.Time  `json:"timeVerifiedGT,omitempty"`
+	TimeVerifiedGTE    *time.Time  `json:"timeVerifiedGTE,omitempty"`
+	TimeVerifiedLT     *time.Time  `json:"timeVerifiedLT,omitempty"`
+	TimeVerifiedLTE    *time.Time  `json:"timeVerifiedLTE,omitempty"`
+	TimeVerifiedIsNil  bool        `json:"timeVerifiedIsNil,omitempty"`
+	TimeVerifiedNotNil bool        `json:"timeVerifiedNotNil,omitempty"`
 
-	// "signature" edge predicates.
-	HasSignature     *bool                  `json:"hasSignature,omitempty"`
-	HasSignatureWith []*SignatureWhereInput `json:"hasSignatureWith,omitempty"`
+	// "resource_uri" field predicates.
+	ResourceURI             *string  `json:"resourceURI,omitempty"`
+	ResourceURINEQ          *string  `json:"resourceURINEQ,omitempty"`
+	ResourceURIIn           []string `json:"resourceURIIn,omitempty"`
+	ResourceURINotIn        []string `json:"resourceURINotIn,omitempty"`
+	ResourceURIGT           *string  `json:"resourceURIGT,omitempty"`
+	ResourceURIGTE          *string  `json:"resourceURIGTE,omitempty"`
+	ResourceURILT           *string  `json:"resourceURILT,omitempty"`
+	ResourceURILTE          *string  `json:"resourceURILTE,omitempty"`
+	ResourceURIContains     *string  `json:"resourceURIContains,omitempty"`
+	ResourceURIHasPrefix    *string  `json:"resourceURIHasPrefix,omitempty"`
+	ResourceURIHasSuffix    *string  `json:"resourceURIHasSuffix,omitempty"`
+	ResourceURIEqualFold    *string  `json:"resourceURIEqualFold,omitempty"`
+	ResourceURIContainsFold *string  `json:"resourceURIContainsFold,omitempty"`
+
+	// "policy_uri" field predicates.
+	PolicyURI             *string  `json:"policyURI,omitempty"`
+	PolicyURINEQ          *string  `json:"policyURINEQ,omitempty"`
+	PolicyURIIn           []string `json:"policyURIIn,omitempty"`
+	PolicyURINotIn        []string `json:"policyURINotIn,omitempty"`
+	PolicyURIGT           *string  `json:"policyURIGT,omitempty"`
+	PolicyURIGTE          *string  `json:"policyURIGTE,omitempty"`
+	PolicyURILT           *string  `json:"policyURILT,omitempty"`
+	PolicyURILTE          *string  `json:"policyURILTE,omitempty"`
+	PolicyURIContains     *string  `json:"policyURIContains,omitempty"`
+	PolicyURIHasPrefix    *string  `json:"policyURIHasPrefix,omitempty"`
+	PolicyURIHasSuffix    *string  `json:"policyURIHasSuffix,omitempty"`
+	PolicyURIIsNil        bool     `json:"policyURIIsNil,omitempty"`
+	PolicyURINotNil       bool     `json:"policyURINotNil,omitempty"`
+	PolicyURIEqualFold    *string  `json:"policyURIEqualFold,omitempty"`
+	PolicyURIContainsFold *string  `json:"policyURIContainsFold,omitempty"`
+
+	// "verification_result" field predicates.
+	VerificationResult             *string  `json:"verificationResult,omitempty"`
+	VerificationResultNEQ          *string  `json:"verificationResultNEQ,omitempty"`
+	VerificationResultIn           []string `json:"verificationResultIn,omitempty"`
+	VerificationResultNotIn        []string `json:"verificationResultNotIn,omitempty"`
+	VerificationResultGT           *string  `json:"verificationResultGT,omitempty"`
+	VerificationResultGTE          *string  `json:"verificationResultGTE,omitempty"`
+	VerificationResultLT           *string  `json:"verificationResultLT,omitempty"`
+	VerificationResultLTE          *string  `json:"verificationResultLTE,omitempty"`
+	VerificationResultContains     *string  `json:"verificationResultContains,omitempty"`
+	VerificationResultHasPrefix    *string  `json:"verificationResultHasPrefix,omitempty"`
+	VerificationResultHasSuffix    *string  `json:"verificationResultHasSuffix,omitempty"`
+	VerificationResultEqualFold    *string  `json:"verificationResultEqualFold,omitempty"`
+	VerificationResultContainsFold *string  `json:"verificationResultContainsFold,omitempty"`
+
+	// "slsa_version" field predicates.
+	SlsaVersion             *string  `json:"slsaVersion,omitempty"`
+	SlsaVersionNEQ          *string  `json:"slsaVersionNEQ,omitempty"`
+	SlsaVersionIn           []string `json:"slsaVersionIn,omitempty"`
+	SlsaVersionNotIn        []string `json:"slsaVersionNotIn,omitempty"`
+	SlsaVersionGT           *string  `json:"slsaVersionGT,omitempty"`
+	SlsaVersionGTE          *string  `json:"slsaVersionGTE,omitempty"`
+	SlsaVersionLT           *string  `json:"slsaVersionLT,omitempty"`
+	SlsaVersionLTE          *string  `json:"slsaVersionLTE,omitempty"`
+	SlsaVersionContains     *string  `json:"slsaVersionContains,omitempty"`
+	SlsaVersionHasPrefix    *string  `json:"slsaVersionHasPrefix,omitempty"`
+	SlsaVersionHasSuffix    *string  `json:"slsaVersionHasSuffix,omitempty"`
+	SlsaVersionIsNil        bool     `json:"slsaVersionIsNil,omitempty"`
+	SlsaVersionNotNil       bool     `json:"slsaVersionNotNil,omitempty"`
+	SlsaVersionEqualFold    *string  `json:"slsaVersionEqualFold,omitempty"`
+	SlsaVersionContainsFold *string  `json:"slsaVersionContainsFold,omitempty"`
+
+	// "statement" edge predicates.
+	HasStatement     *bool                  `json:"hasStatement,omitempty"`
+	HasStatementWith []*StatementWhereInput `json:"hasStatementWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *TimestampWhereInput) AddPredicates(predicates ...predicate.Timestamp) {
+func (i *VerificationSummaryWhereInput) AddPredicates(predicates ...predicate.VerificationSummary) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the TimestampWhereInput filter on the TimestampQuery builder.
-func (i *TimestampWhereInput) Filter(q *TimestampQuery) (*TimestampQuery, error) {
+// Filter applies the VerificationSummaryWhereInput filter on the VerificationSummaryQuery builder.
+func (i *VerificationSummaryWhereInput) Filter(q *VerificationSummaryQuery) (*VerificationSummaryQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyTimestampWhereInput {
+		if err == ErrEmptyVerificationSummaryWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2364,19 +11765,19 @@ func (i *TimestampWhereInput) Filter(q *TimestampQuery) (*TimestampQuery, error)
 	return q.Where(p), nil
 }
 
-// ErrEmptyTimestampWhereInput is returned in case the TimestampWhereInput is empty.
-var ErrEmptyTimestampWhereInput = errors.New("ent: empty predicate TimestampWhereInput")
+// ErrEmptyVerificationSummaryWhereInput is returned in case the VerificationSummaryWhereInput is empty.
+var ErrEmptyVerificationSummaryWhereInput = errors.New("ent: empty predicate VerificationSummaryWhereInput")
 
-// P returns a predicate for filtering timestamps.
+// P returns a predicate for filtering verificationsummaries.
 // An error is returned if the input is empty or invalid.
-func (i *TimestampWhereInput) P() (predicate.Timestamp, error) {
-	var predicates []predicate.Timestamp
+func (i *VerificationSummaryWhereInput) P() (predicate.VerificationSummary, error) {
+	var predicates []predicate.VerificationSummary
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, timestamp.Not(p))
+		predicates = append(predicates, verificationsummary.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2386,7 +11787,7 @@ func (i *TimestampWhereInput) P() (predicate.Timestamp, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Timestamp, 0, n)
+		or := make([]predicate.VerificationSummary, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2394,7 +11795,7 @@ func (i *TimestampWhereInput) P() (predicate.Timestamp, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, timestamp.Or(or...))
+		predicates = append(predicates, verificationsummary.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2404,7 +11805,7 @@ func (i *TimestampWhereInput) P() (predicate.Timestamp, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Timestamp, 0, n)
+		and := make([]predicate.VerificationSummary, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2412,121 +11813,295 @@ func (i *TimestampWhereInput) P() (predicate.Timestamp, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, timestamp.And(and...))
+		predicates = append(predicates, verificationsummary.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, timestamp.IDEQ(*i.ID))
+		predicates = append(predicates, verificationsummary.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, timestamp.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, verificationsummary.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, timestamp.IDIn(i.IDIn...))
+		predicates = append(predicates, verificationsummary.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, timestamp.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, verificationsummary.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, timestamp.IDGT(*i.IDGT))
+		predicates = append(predicates, verificationsummary.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, timestamp.IDGTE(*i.IDGTE))
+		predicates = append(predicates, verificationsummary.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, timestamp.IDLT(*i.IDLT))
+		predicates = append(predicates, verificationsummary.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, timestamp.IDLTE(*i.IDLTE))
+		predicates = append(predicates, verificationsummary.IDLTE(*i.IDLTE))
 	}
-	if i.Type != nil {
-		predicates = append(predicates, timestamp.TypeEQ(*i.Type))
+	if i.VerifierID != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDEQ(*i.VerifierID))
 	}
-	if i.TypeNEQ != nil {
-		predicates = append(predicates, timestamp.TypeNEQ(*i.TypeNEQ))
+	if i.VerifierIDNEQ != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDNEQ(*i.VerifierIDNEQ))
 	}
-	if len(i.TypeIn) > 0 {
-		predicates = append(predicates, timestamp.TypeIn(i.TypeIn...))
+	if len(i.VerifierIDIn) > 0 {
+		predicates = append(predicates, verificationsummary.VerifierIDIn(i.VerifierIDIn...))
 	}
-	if len(i.TypeNotIn) > 0 {
-		predicates = append(predicates, timestamp.TypeNotIn(i.TypeNotIn...))
+	if len(i.VerifierIDNotIn) > 0 {
+		predicates = append(predicates, verificationsummary.VerifierIDNotIn(i.VerifierIDNotIn...))
 	}
-	if i.TypeGT != nil {
-		predicates = append(predicates, timestamp.TypeGT(*i.TypeGT))
+	if i.VerifierIDGT != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDGT(*i.VerifierIDGT))
 	}
-	if i.TypeGTE != nil {
-		predicates = append(predicates, timestamp.TypeGTE(*i.TypeGTE))
+	if i.VerifierIDGTE != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDGTE(*i.VerifierIDGTE))
 	}
-	if i.TypeLT != nil {
-		predicates = append(predicates, timestamp.TypeLT(*i.TypeLT))
+	if i.VerifierIDLT != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDLT(*i.VerifierIDLT))
 	}
-	if i.TypeLTE != nil {
-		predicates = append(predicates, timestamp.TypeLTE(*i.TypeLTE))
+	if i.VerifierIDLTE != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDLTE(*i.VerifierIDLTE))
 	}
-	if i.TypeContains != nil {
-		predicates = append(predicates, timestamp.TypeContains(*i.TypeContains))
+	if i.VerifierIDContains != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDContains(*i.VerifierIDContains))
 	}
-	if i.TypeHasPrefix != nil {
-		predicates = append(predicates, timestamp.TypeHasPrefix(*i.TypeHasPrefix))
+	if i.VerifierIDHasPrefix != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDHasPrefix(*i.VerifierIDHasPrefix))
 	}
-	if i.TypeHasSuffix != nil {
-		predicates = append(predicates, timestamp.TypeHasSuffix(*i.TypeHasSuffix))
+	if i.VerifierIDHasSuffix != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDHasSuffix(*i.VerifierIDHasSuffix))
 	}
-	if i.TypeEqualFold != nil {
-		predicates = append(predicates, timestamp.TypeEqualFold(*i.TypeEqualFold))
+	if i.VerifierIDEqualFold != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDEqualFold(*i.VerifierIDEqualFold))
 	}
-	if i.TypeContainsFold != nil {
-		predicates = append(predicates, timestamp.TypeContainsFold(*i.TypeContainsFold))
+	if i.VerifierIDContainsFold != nil {
+		predicates = append(predicates, verificationsummary.VerifierIDContainsFold(*i.VerifierIDContainsFold))
 	}
-	if i.Timestamp != nil {
-		predicates = append(predicates, timestamp.TimestampEQ(*i.Timestamp))
+	if i.TimeVerified != nil {
+		predicates = append(predicates, verificationsummary.TimeVerifiedEQ(*i.TimeVerified))
 	}
-	if i.TimestampNEQ != nil {
-		predicates = append(predicates, timestamp.TimestampNEQ(*i.TimestampNEQ))
+	if i.TimeVerifiedNEQ != nil {
+		predicates = append(predicates, verificationsummary.TimeVerifiedNEQ(*i.TimeVerifiedNEQ))
 	}
-	if len(i.TimestampIn) > 0 {
-		predicates = append(predicates, timestamp.TimestampIn(i.TimestampIn...))
+	if len(i.TimeVerifiedIn) > 0 {
+		predicates = append(predicates, verificationsummary.TimeVerifiedIn(i.TimeVerifiedIn...))
 	}
-	if len(i.TimestampNotIn) > 0 {
-		predicates = append(predicates, timestamp.TimestampNotIn(i.TimestampNotIn...))
+	if len(i.TimeVerifiedNotIn) > 0 {
+		predicates = append(predicates, verificationsummary.TimeVerifiedNotIn(i.TimeVerifiedNotIn...))
 	}
-	if i.TimestampGT != nil {
-		predicates = append(predicates, timestamp.TimestampGT(*i.TimestampGT))
+	if i.TimeVerifiedGT != nil {
+		predicates = append(predicates, verificationsummary.TimeVerifiedGT(*i.TimeVerifiedGT))
 	}
-	if i.TimestampGTE != nil {
-		predicates = append(predicates, timestamp.TimestampGTE(*i.TimestampGTE))
+	if i.TimeVerifiedGTE != nil {
+		predicates = append(predicates, verificationsummary.TimeVerifiedGTE(*i.TimeVerifiedGTE))
 	}
-	if i.TimestampLT != nil {
-		predicates = append(predicates, timestamp.TimestampLT(*i.TimestampLT))
+	if i.TimeVerifiedLT != nil {
+		predicates = append(predicates, verificationsummary.TimeVerifiedLT(*i.TimeVerifiedLT))
 	}
-	if i.TimestampLTE != nil {
-		predicates = append(predicates, timestamp.TimestampLTE(*i.TimestampLTE))
+	if i.TimeVerifiedLTE != nil {
+		predicates = append(predicates, verificationsummary.TimeVerifiedLTE(*i.TimeVerifiedLTE))
+	}
+	if i.TimeVerifiedIsNil {
+		predicates = append(predicates, verificationsummary.TimeVerifiedIsNil())
+	}
+	if i.TimeVerifiedNotNil {
+		predicates = append(predicates, verificationsummary.TimeVerifiedNotNil())
+	}
+	if i.ResourceURI != nil {
+		predicates = append(predicates, verificationsummary.ResourceURIEQ(*i.ResourceURI))
+	}
+	if i.ResourceURINEQ != nil {
+		predicates = append(predicates, verificationsummary.ResourceURINEQ(*i.ResourceURINEQ))
+	}
+	if len(i.ResourceURIIn) > 0 {
+		predicates = append(predicates, verificationsummary.ResourceURIIn(i.ResourceURIIn...))
+	}
+	if len(i.ResourceURINotIn) > 0 {
+		predicates = append(predicates, verificationsummary.ResourceURINotIn(i.ResourceURINotIn...))
+	}
+	if i.ResourceURIGT != nil {
+		predicates = append(predicates, verificationsummary.ResourceURIGT(*i.ResourceURIGT))
+	}
+	if i.ResourceURIGTE != nil {
+		predicates = append(predicates, verificationsummary.ResourceURIGTE(*i.ResourceURIGTE))
+	}
+	if i.ResourceURILT != nil {
+		predicates = append(predicates, verificationsummary.ResourceURILT(*i.ResourceURILT))
+	}
+	if i.ResourceURILTE != nil {
+		predicates = append(predicates, verificationsummary.ResourceURILTE(*i.ResourceURILTE))
+	}
+	if i.ResourceURIContains != nil {
+		predicates = append(predicates, verificationsummary.ResourceURIContains(*i.ResourceURIContains))
+	}
+	if i.ResourceURIHasPrefix != nil {
+		predicates = append(predicates, verificationsummary.ResourceURIHasPrefix(*i.ResourceURIHasPrefix))
+	}
+	if i.ResourceURIHasSuffix != nil {
+		predicates = append(predicates, verificationsummary.ResourceURIHasSuffix(*i.ResourceURIHasSuffix))
+	}
+	if i.ResourceURIEqualFold != nil {
+		predicates = append(predicates, verificationsummary.ResourceURIEqualFold(*i.ResourceURIEqualFold))
+	}
+	if i.ResourceURIContainsFold != nil {
+		predicates = append(predicates, verificationsummary.ResourceURIContainsFold(*i.ResourceURIContainsFold))
+	}
+	if i.PolicyURI != nil {
+		predicates = append(predicates, verificationsummary.PolicyURIEQ(*i.PolicyURI))
+	}
+	if i.PolicyURINEQ != nil {
+		predicates = append(predicates, verificationsummary.PolicyURINEQ(*i.PolicyURINEQ))
+	}
+	if len(i.PolicyURIIn) > 0 {
+		predicates = append(predicates, verificationsummary.PolicyURIIn(i.PolicyURIIn...))
+	}
+	if len(i.PolicyURINotIn) > 0 {
+		predicates = append(predicates, verificationsummary.PolicyURINotIn(i.PolicyURINotIn...))
+	}
+	if i.PolicyURIGT != nil {
+		predicates = append(predicates, verificationsummary.PolicyURIGT(*i.PolicyURIGT))
+	}
+	if i.PolicyURIGTE != nil {
+		predicates = append(predicates, verificationsummary.PolicyURIGTE(*i.PolicyURIGTE))
+	}
+	if i.PolicyURILT != nil {
+		predicates = append(predicates, verificationsummary.PolicyURILT(*i.PolicyURILT))
+	}
+	if i.PolicyURILTE != nil {
+		predicates = append(predicates, verificationsummary.PolicyURILTE(*i.PolicyURILTE))
+	}
+	if i.PolicyURIContains != nil {
+		predicates = append(predicates, verificationsummary.PolicyURIContains(*i.PolicyURIContains))
+	}
+	if i.PolicyURIHasPrefix != nil {
+		predicates = append(predicates, verificationsummary.PolicyURIHasPrefix(*i.PolicyURIHasPrefix))
+	}
+	if i.PolicyURIHasSuffix != nil {
+		predicates = append(predicates, verificationsummary.PolicyURIHasSuffix(*i.PolicyURIHasSuffix))
+	}
+	if i.PolicyURIIsNil {
+		predicates = append(predicates, verificationsummary.PolicyURIIsNil())
+	}
+	if i.PolicyURINotNil {
+		predicates = append(predicates, verificationsummary.PolicyURINotNil())
+	}
+	if i.PolicyURIEqualFold != nil {
+		predicates = append(predicates, verificationsummary.PolicyURIEqualFold(*i.PolicyURIEqualFold))
+	}
+	if i.PolicyURIContainsFold != nil {
+		predicates = append(predicates, verificationsummary.PolicyURIContainsFold(*i.PolicyURIContainsFold))
+	}
+	if i.VerificationResult != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultEQ(*i.VerificationResult))
+	}
+	if i.VerificationResultNEQ != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultNEQ(*i.VerificationResultNEQ))
+	}
+	if len(i.VerificationResultIn) > 0 {
+		predicates = append(predicates, verificationsummary.VerificationResultIn(i.VerificationResultIn...))
+	}
+	if len(i.VerificationResultNotIn) > 0 {
+		predicates = append(predicates, verificationsummary.VerificationResultNotIn(i.VerificationResultNotIn...))
+	}
+	if i.VerificationResultGT != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultGT(*i.VerificationResultGT))
+	}
+	if i.VerificationResultGTE != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultGTE(*i.VerificationResultGTE))
+	}
+	if i.VerificationResultLT != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultLT(*i.VerificationResultLT))
+	}
+	if i.VerificationResultLTE != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultLTE(*i.VerificationResultLTE))
+	}
+	if i.VerificationResultContains != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultContains(*i.VerificationResultContains))
+	}
+	if i.VerificationResultHasPrefix != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultHasPrefix(*i.VerificationResultHasPrefix))
+	}
+	if i.VerificationResultHasSuffix != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultHasSuffix(*i.VerificationResultHasSuffix))
+	}
+	if i.VerificationResultEqualFold != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultEqualFold(*i.VerificationResultEqualFold))
+	}
+	if i.VerificationResultContainsFold != nil {
+		predicates = append(predicates, verificationsummary.VerificationResultContainsFold(*i.VerificationResultContainsFold))
+	}
+	if i.SlsaVersion != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionEQ(*i.SlsaVersion))
+	}
+	if i.SlsaVersionNEQ != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionNEQ(*i.SlsaVersionNEQ))
+	}
+	if len(i.SlsaVersionIn) > 0 {
+		predicates = append(predicates, verificationsummary.SlsaVersionIn(i.SlsaVersionIn...))
+	}
+	if len(i.SlsaVersionNotIn) > 0 {
+		predicates = append(predicates, verificationsummary.SlsaVersionNotIn(i.SlsaVersionNotIn...))
+	}
+	if i.SlsaVersionGT != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionGT(*i.SlsaVersionGT))
+	}
+	if i.SlsaVersionGTE != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionGTE(*i.SlsaVersionGTE))
+	}
+	if i.SlsaVersionLT != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionLT(*i.SlsaVersionLT))
+	}
+	if i.SlsaVersionLTE != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionLTE(*i.SlsaVersionLTE))
+	}
+	if i.SlsaVersionContains != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionContains(*i.SlsaVersionContains))
+	}
+	if i.SlsaVersionHasPrefix != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionHasPrefix(*i.SlsaVersionHasPrefix))
+	}
+	if i.SlsaVersionHasSuffix != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionHasSuffix(*i.SlsaVersionHasSuffix))
+	}
+	if i.SlsaVersionIsNil {
+		predicates = append(predicates, verificationsummary.SlsaVersionIsNil())
+	}
+	if i.SlsaVersionNotNil {
+		predicates = append(predicates, verificationsummary.SlsaVersionNotNil())
+	}
+	if i.SlsaVersionEqualFold != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionEqualFold(*i.SlsaVersionEqualFold))
+	}
+	if i.SlsaVersionContainsFold != nil {
+		predicates = append(predicates, verificationsummary.SlsaVersionContainsFold(*i.SlsaVersionContainsFold))
 	}
 
-	if i.HasSignature != nil {
-		p := timestamp.HasSignature()
-		if !*i.HasSignature {
-			p = timestamp.Not(p)
+	if i.HasStatement != nil {
+		p := verificationsummary.HasStatement()
+		if !*i.HasStatement {
+			p = verificationsummary.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasSignatureWith) > 0 {
-		with := make([]predicate.Signature, 0, len(i.HasSignatureWith))
-		for _, w := range i.HasSignatureWith {
+	if len(i.HasStatementWith) > 0 {
+		with := make([]predicate.Statement, 0, len(i.HasStatementWith))
+		for _, w := range i.HasStatementWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSignatureWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasStatementWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, timestamp.HasSignatureWith(with...))
+		predicates = append(predicates, verificationsummary.HasStatementWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyTimestampWhereInput
+		return nil, ErrEmptyVerificationSummaryWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return timestamp.And(predicates...), nil
+		return verificationsummary.And(predicates...), nil
 	}
 }
@@ -45,6 +45,30 @@ func (f AttestationPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttestationPolicyMutation", m)
 }
 
+// The CertificateFunc type is an adapter to allow the use of ordinary
+// function as Certificate mutator.
+type CertificateFunc func(context.Context, *ent.CertificateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertificateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CertificateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CertificateMutation", m)
+}
+
+// The CommandRunAttestationFunc type is an adapter to allow the use of ordinary
+// function as CommandRunAttestation mutator.
+type CommandRunAttestationFunc func(context.Context, *ent.CommandRunAttestationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommandRunAttestationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommandRunAttestationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommandRunAttestationMutation", m)
+}
+
 // The DsseFunc type is an adapter to allow the use of ordinary
 // function as Dsse mutator.
 type DsseFunc func(context.Context, *ent.DsseMutation) (ent.Value, error)
@@ -57,6 +81,90 @@ func (f DsseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DsseMutation", m)
 }
 
+// The EnvironmentAttestationFunc type is an adapter to allow the use of ordinary
+// function as EnvironmentAttestation mutator.
+type EnvironmentAttestationFunc func(context.Context, *ent.EnvironmentAttestationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnvironmentAttestationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnvironmentAttestationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnvironmentAttestationMutation", m)
+}
+
+// The GitAttestationFunc type is an adapter to allow the use of ordinary
+// function as GitAttestation mutator.
+type GitAttestationFunc func(context.Context, *ent.GitAttestationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GitAttestationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GitAttestationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GitAttestationMutation", m)
+}
+
+// The GithubAttestationFunc type is an adapter to allow the use of ordinary
+// function as GithubAttestation mutator.
+type GithubAttestationFunc func(context.Context, *ent.GithubAttestationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GithubAttestationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GithubAttestationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GithubAttestationMutation", m)
+}
+
+// The GitlabAttestationFunc type is an adapter to allow the use of ordinary
+// function as GitlabAttestation mutator.
+type GitlabAttestationFunc func(context.Context, *ent.GitlabAttestationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GitlabAttestationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GitlabAttestationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GitlabAttestationMutation", m)
+}
+
+// The LegalHoldFunc type is an adapter to allow the use of ordinary
+// function as LegalHold mutator.
+type LegalHoldFunc func(context.Context, *ent.LegalHoldMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LegalHoldFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LegalHoldMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LegalHoldMutation", m)
+}
+
+// The MaterialFunc type is an adapter to allow the use of ordinary
+// function as Material mutator.
+type MaterialFunc func(context.Context, *ent.MaterialMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MaterialFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MaterialMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MaterialMutation", m)
+}
+
+// The OciAttestationFunc type is an adapter to allow the use of ordinary
+// function as OciAttestation mutator.
+type OciAttestationFunc func(context.Context, *ent.OciAttestationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OciAttestationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OciAttestationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OciAttestationMutation", m)
+}
+
 // The PayloadDigestFunc type is an adapter to allow the use of ordinary
 // function as PayloadDigest mutator.
 type PayloadDigestFunc func(context.Context, *ent.PayloadDigestMutation) (ent.Value, error)
@@ -69,6 +177,66 @@ func (f PayloadDigestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayloadDigestMutation", m)
 }
 
+// The ProductFunc type is an adapter to allow the use of ordinary
+// function as Product mutator.
+type ProductFunc func(context.Context, *ent.ProductMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProductMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductMutation", m)
+}
+
+// The PublicationFunc type is an adapter to allow the use of ordinary
+// function as Publication mutator.
+type PublicationFunc func(context.Context, *ent.PublicationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PublicationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PublicationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PublicationMutation", m)
+}
+
+// The PublishDeliveryFunc type is an adapter to allow the use of ordinary
+// function as PublishDelivery mutator.
+type PublishDeliveryFunc func(context.Context, *ent.PublishDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PublishDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PublishDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PublishDeliveryMutation", m)
+}
+
+// The SbomPackageFunc type is an adapter to allow the use of ordinary
+// function as SbomPackage mutator.
+type SbomPackageFunc func(context.Context, *ent.SbomPackageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SbomPackageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SbomPackageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SbomPackageMutation", m)
+}
+
+// The SbomPackageDigestFunc type is an adapter to allow the use of ordinary
+// function as SbomPackageDigest mutator.
+type SbomPackageDigestFunc func(context.Context, *ent.SbomPackageDigestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SbomPackageDigestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SbomPackageDigestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SbomPackageDigestMutation", m)
+}
+
 // The SignatureFunc type is an adapter to allow the use of ordinary
 // function as Signature mutator.
 type SignatureFunc func(context.Context, *ent.SignatureMutation) (ent.Value, error)
@@ -81,6 +249,30 @@ func (f SignatureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SignatureMutation", m)
 }
 
+// The SlsaDependencyFunc type is an adapter to allow the use of ordinary
+// function as SlsaDependency mutator.
+type SlsaDependencyFunc func(context.Context, *ent.SlsaDependencyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SlsaDependencyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SlsaDependencyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SlsaDependencyMutation", m)
+}
+
+// The SlsaProvenanceFunc type is an adapter to allow the use of ordinary
+// function as SlsaProvenance mutator.
+type SlsaProvenanceFunc func(context.Context, *ent.SlsaProvenanceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SlsaProvenanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SlsaProvenanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SlsaProvenanceMutation", m)
+}
+
 // The StatementFunc type is an adapter to allow the use of ordinary
 // function as Statement mutator.
 type StatementFunc func(context.Context, *ent.StatementMutation) (ent.Value, error)
@@ -105,6 +297,18 @@ func (f SubjectFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubjectMutation", m)
 }
 
+// The SubjectAltNameFunc type is an adapter to allow the use of ordinary
+// function as SubjectAltName mutator.
+type SubjectAltNameFunc func(context.Context, *ent.SubjectAltNameMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubjectAltNameFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SubjectAltNameMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubjectAltNameMutation", m)
+}
+
 // The SubjectDigestFunc type is an adapter to allow the use of ordinary
 // function as SubjectDigest mutator.
 type SubjectDigestFunc func(context.Context, *ent.SubjectDigestMutation) (ent.Value, error)
@@ -129,6 +333,30 @@ func (f TimestampFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TimestampMutation", m)
 }
 
+// The TransparencyLogEntryFunc type is an adapter to allow the use of ordinary
+// function as TransparencyLogEntry mutator.
+type TransparencyLogEntryFunc func(context.Context, *ent.TransparencyLogEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TransparencyLogEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TransparencyLogEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TransparencyLogEntryMutation", m)
+}
+
+// The VerificationSummaryFunc type is an adapter to allow the use of ordinary
+// function as VerificationSummary mutator.
+type VerificationSummaryFunc func(context.Context, *ent.VerificationSummaryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VerificationSummaryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VerificationSummaryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VerificationSummaryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
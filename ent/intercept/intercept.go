@@ -0,0 +1,989 @@
+// Code generated by ent, DO NOT EDIT.
+
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/attestationcollection"
+	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
+	"github.com/in-toto/archivista/ent/payloaddigest"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+	"github.com/in-toto/archivista/ent/subjectdigest"
+	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
+)
+
+// The Query interface represents an operation that queries a graph.
+// By using this interface, users can write generic code that manipulates
+// query builders of different types.
+type Query interface {
+	// Type returns the string representation of the query type.
+	Type() string
+	// Limit the number of records to be returned by this query.
+	Limit(int)
+	// Offset to start from.
+	Offset(int)
+	// Unique configures the query builder to filter duplicate records.
+	Unique(bool)
+	// Order specifies how the records should be ordered.
+	Order(...func(*sql.Selector))
+	// WhereP appends storage-level predicates to the query builder. Using this method, users
+	// can use type-assertion to append predicates that do not depend on any generated package.
+	WhereP(...func(*sql.Selector))
+}
+
+// The Func type is an adapter that allows ordinary functions to be used as interceptors.
+// Unlike traversal functions, interceptors are skipped during graph traversals. Note that the
+// implementation of Func is different from the one defined in entgo.io/ent.InterceptFunc.
+type Func func(context.Context, Query) error
+
+// Intercept calls f(ctx, q) and then applied the next Querier.
+func (f Func) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		query, err := NewQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		if err := f(ctx, query); err != nil {
+			return nil, err
+		}
+		return next.Query(ctx, q)
+	})
+}
+
+// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that calls f.
+type TraverseFunc func(context.Context, Query) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
+	query, err := NewQuery(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, query)
+}
+
+// The AttestationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AttestationFunc func(context.Context, *ent.AttestationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AttestationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AttestationQuery", q)
+}
+
+// The TraverseAttestation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAttestation func(context.Context, *ent.AttestationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAttestation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAttestation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AttestationQuery", q)
+}
+
+// The AttestationCollectionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AttestationCollectionFunc func(context.Context, *ent.AttestationCollectionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AttestationCollectionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AttestationCollectionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AttestationCollectionQuery", q)
+}
+
+// The TraverseAttestationCollection type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAttestationCollection func(context.Context, *ent.AttestationCollectionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAttestationCollection) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAttestationCollection) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AttestationCollectionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AttestationCollectionQuery", q)
+}
+
+// The AttestationPolicyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AttestationPolicyFunc func(context.Context, *ent.AttestationPolicyQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AttestationPolicyFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AttestationPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AttestationPolicyQuery", q)
+}
+
+// The TraverseAttestationPolicy type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAttestationPolicy func(context.Context, *ent.AttestationPolicyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAttestationPolicy) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAttestationPolicy) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AttestationPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AttestationPolicyQuery", q)
+}
+
+// The CertificateFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CertificateFunc func(context.Context, *ent.CertificateQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CertificateFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CertificateQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CertificateQuery", q)
+}
+
+// The TraverseCertificate type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCertificate func(context.Context, *ent.CertificateQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCertificate) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCertificate) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CertificateQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CertificateQuery", q)
+}
+
+// The CommandRunAttestationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CommandRunAttestationFunc func(context.Context, *ent.CommandRunAttestationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CommandRunAttestationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CommandRunAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CommandRunAttestationQuery", q)
+}
+
+// The TraverseCommandRunAttestation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCommandRunAttestation func(context.Context, *ent.CommandRunAttestationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCommandRunAttestation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCommandRunAttestation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CommandRunAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CommandRunAttestationQuery", q)
+}
+
+// The DsseFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DsseFunc func(context.Context, *ent.DsseQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DsseFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DsseQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DsseQuery", q)
+}
+
+// The TraverseDsse type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDsse func(context.Context, *ent.DsseQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDsse) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDsse) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DsseQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DsseQuery", q)
+}
+
+// The EnvironmentAttestationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type EnvironmentAttestationFunc func(context.Context, *ent.EnvironmentAttestationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f EnvironmentAttestationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.EnvironmentAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.EnvironmentAttestationQuery", q)
+}
+
+// The TraverseEnvironmentAttestation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseEnvironmentAttestation func(context.Context, *ent.EnvironmentAttestationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseEnvironmentAttestation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseEnvironmentAttestation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.EnvironmentAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.EnvironmentAttestationQuery", q)
+}
+
+// The GitAttestationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GitAttestationFunc func(context.Context, *ent.GitAttestationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f GitAttestationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.GitAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.GitAttestationQuery", q)
+}
+
+// The TraverseGitAttestation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGitAttestation func(context.Context, *ent.GitAttestationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGitAttestation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGitAttestation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GitAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.GitAttestationQuery", q)
+}
+
+// The GithubAttestationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GithubAttestationFunc func(context.Context, *ent.GithubAttestationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f GithubAttestationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.GithubAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.GithubAttestationQuery", q)
+}
+
+// The TraverseGithubAttestation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGithubAttestation func(context.Context, *ent.GithubAttestationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGithubAttestation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGithubAttestation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GithubAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.GithubAttestationQuery", q)
+}
+
+// The GitlabAttestationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GitlabAttestationFunc func(context.Context, *ent.GitlabAttestationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f GitlabAttestationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.GitlabAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.GitlabAttestationQuery", q)
+}
+
+// The TraverseGitlabAttestation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGitlabAttestation func(context.Context, *ent.GitlabAttestationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGitlabAttestation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGitlabAttestation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GitlabAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.GitlabAttestationQuery", q)
+}
+
+// The LegalHoldFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LegalHoldFunc func(context.Context, *ent.LegalHoldQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LegalHoldFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LegalHoldQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LegalHoldQuery", q)
+}
+
+// The TraverseLegalHold type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLegalHold func(context.Context, *ent.LegalHoldQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLegalHold) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLegalHold) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LegalHoldQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LegalHoldQuery", q)
+}
+
+// The MaterialFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MaterialFunc func(context.Context, *ent.MaterialQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MaterialFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MaterialQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MaterialQuery", q)
+}
+
+// The TraverseMaterial type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMaterial func(context.Context, *ent.MaterialQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMaterial) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMaterial) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MaterialQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MaterialQuery", q)
+}
+
+// The OciAttestationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OciAttestationFunc func(context.Context, *ent.OciAttestationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OciAttestationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OciAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OciAttestationQuery", q)
+}
+
+// The TraverseOciAttestation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOciAttestation func(context.Context, *ent.OciAttestationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOciAttestation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOciAttestation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OciAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OciAttestationQuery", q)
+}
+
+// The PayloadDigestFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PayloadDigestFunc func(context.Context, *ent.PayloadDigestQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PayloadDigestFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PayloadDigestQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PayloadDigestQuery", q)
+}
+
+// The TraversePayloadDigest type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePayloadDigest func(context.Context, *ent.PayloadDigestQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePayloadDigest) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePayloadDigest) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PayloadDigestQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PayloadDigestQuery", q)
+}
+
+// The ProductFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ProductFunc func(context.Context, *ent.ProductQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ProductFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ProductQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ProductQuery", q)
+}
+
+// The TraverseProduct type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseProduct func(context.Context, *ent.ProductQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseProduct) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseProduct) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ProductQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ProductQuery", q)
+}
+
+// The PublicationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PublicationFunc func(context.Context, *ent.PublicationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PublicationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PublicationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PublicationQuery", q)
+}
+
+// The TraversePublication type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePublication func(context.Context, *ent.PublicationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePublication) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePublication) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PublicationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PublicationQuery", q)
+}
+
+// The PublishDeliveryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PublishDeliveryFunc func(context.Context, *ent.PublishDeliveryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PublishDeliveryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PublishDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PublishDeliveryQuery", q)
+}
+
+// The TraversePublishDelivery type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePublishDelivery func(context.Context, *ent.PublishDeliveryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePublishDelivery) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePublishDelivery) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PublishDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PublishDeliveryQuery", q)
+}
+
+// The SbomPackageFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SbomPackageFunc func(context.Context, *ent.SbomPackageQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SbomPackageFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SbomPackageQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SbomPackageQuery", q)
+}
+
+// The TraverseSbomPackage type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSbomPackage func(context.Context, *ent.SbomPackageQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSbomPackage) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSbomPackage) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SbomPackageQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SbomPackageQuery", q)
+}
+
+// The SbomPackageDigestFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SbomPackageDigestFunc func(context.Context, *ent.SbomPackageDigestQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SbomPackageDigestFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SbomPackageDigestQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SbomPackageDigestQuery", q)
+}
+
+// The TraverseSbomPackageDigest type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSbomPackageDigest func(context.Context, *ent.SbomPackageDigestQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSbomPackageDigest) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSbomPackageDigest) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SbomPackageDigestQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SbomPackageDigestQuery", q)
+}
+
+// The SignatureFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SignatureFunc func(context.Context, *ent.SignatureQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SignatureFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SignatureQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SignatureQuery", q)
+}
+
+// The TraverseSignature type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSignature func(context.Context, *ent.SignatureQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSignature) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSignature) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SignatureQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SignatureQuery", q)
+}
+
+// The SlsaDependencyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SlsaDependencyFunc func(context.Context, *ent.SlsaDependencyQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SlsaDependencyFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SlsaDependencyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SlsaDependencyQuery", q)
+}
+
+// The TraverseSlsaDependency type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSlsaDependency func(context.Context, *ent.SlsaDependencyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSlsaDependency) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSlsaDependency) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SlsaDependencyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SlsaDependencyQuery", q)
+}
+
+// The SlsaProvenanceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SlsaProvenanceFunc func(context.Context, *ent.SlsaProvenanceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SlsaProvenanceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SlsaProvenanceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SlsaProvenanceQuery", q)
+}
+
+// The TraverseSlsaProvenance type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSlsaProvenance func(context.Context, *ent.SlsaProvenanceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSlsaProvenance) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSlsaProvenance) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SlsaProvenanceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SlsaProvenanceQuery", q)
+}
+
+// The StatementFunc type is an adapter to allow the use of ordinary function as a Querier.
+type StatementFunc func(context.Context, *ent.StatementQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f StatementFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.StatementQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.StatementQuery", q)
+}
+
+// The TraverseStatement type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseStatement func(context.Context, *ent.StatementQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseStatement) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseStatement) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StatementQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.StatementQuery", q)
+}
+
+// The SubjectFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SubjectFunc func(context.Context, *ent.SubjectQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SubjectFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SubjectQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SubjectQuery", q)
+}
+
+// The TraverseSubject type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSubject func(context.Context, *ent.SubjectQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSubject) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSubject) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SubjectQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SubjectQuery", q)
+}
+
+// The SubjectAltNameFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SubjectAltNameFunc func(context.Context, *ent.SubjectAltNameQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SubjectAltNameFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SubjectAltNameQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SubjectAltNameQuery", q)
+}
+
+// The TraverseSubjectAltName type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSubjectAltName func(context.Context, *ent.SubjectAltNameQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSubjectAltName) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSubjectAltName) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SubjectAltNameQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SubjectAltNameQuery", q)
+}
+
+// The SubjectDigestFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SubjectDigestFunc func(context.Context, *ent.SubjectDigestQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SubjectDigestFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SubjectDigestQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SubjectDigestQuery", q)
+}
+
+// The TraverseSubjectDigest type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSubjectDigest func(context.Context, *ent.SubjectDigestQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSubjectDigest) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSubjectDigest) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SubjectDigestQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SubjectDigestQuery", q)
+}
+
+// The TimestampFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TimestampFunc func(context.Context, *ent.TimestampQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TimestampFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TimestampQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TimestampQuery", q)
+}
+
+// The TraverseTimestamp type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTimestamp func(context.Context, *ent.TimestampQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTimestamp) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTimestamp) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TimestampQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TimestampQuery", q)
+}
+
+// The TransparencyLogEntryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TransparencyLogEntryFunc func(context.Context, *ent.TransparencyLogEntryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TransparencyLogEntryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TransparencyLogEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TransparencyLogEntryQuery", q)
+}
+
+// The TraverseTransparencyLogEntry type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTransparencyLogEntry func(context.Context, *ent.TransparencyLogEntryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTransparencyLogEntry) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTransparencyLogEntry) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TransparencyLogEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TransparencyLogEntryQuery", q)
+}
+
+// The VerificationSummaryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VerificationSummaryFunc func(context.Context, *ent.VerificationSummaryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f VerificationSummaryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.VerificationSummaryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.VerificationSummaryQuery", q)
+}
+
+// The TraverseVerificationSummary type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVerificationSummary func(context.Context, *ent.VerificationSummaryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVerificationSummary) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVerificationSummary) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VerificationSummaryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.VerificationSummaryQuery", q)
+}
+
+// NewQuery returns the generic Query interface for the given typed query.
+func NewQuery(q ent.Query) (Query, error) {
+	switch q := q.(type) {
+	case *ent.AttestationQuery:
+		return &query[*ent.AttestationQuery, predicate.Attestation, attestation.OrderOption]{typ: ent.TypeAttestation, tq: q}, nil
+	case *ent.AttestationCollectionQuery:
+		return &query[*ent.AttestationCollectionQuery, predicate.AttestationCollection, attestationcollection.OrderOption]{typ: ent.TypeAttestationCollection, tq: q}, nil
+	case *ent.AttestationPolicyQuery:
+		return &query[*ent.AttestationPolicyQuery, predicate.AttestationPolicy, attestationpolicy.OrderOption]{typ: ent.TypeAttestationPolicy, tq: q}, nil
+	case *ent.CertificateQuery:
+		return &query[*ent.CertificateQuery, predicate.Certificate, certificate.OrderOption]{typ: ent.TypeCertificate, tq: q}, nil
+	case *ent.CommandRunAttestationQuery:
+		return &query[*ent.CommandRunAttestationQuery, predicate.CommandRunAttestation, commandrunattestation.OrderOption]{typ: ent.TypeCommandRunAttestation, tq: q}, nil
+	case *ent.DsseQuery:
+		return &query[*ent.DsseQuery, predicate.Dsse, dsse.OrderOption]{typ: ent.TypeDsse, tq: q}, nil
+	case *ent.EnvironmentAttestationQuery:
+		return &query[*ent.EnvironmentAttestationQuery, predicate.EnvironmentAttestation, environmentattestation.OrderOption]{typ: ent.TypeEnvironmentAttestation, tq: q}, nil
+	case *ent.GitAttestationQuery:
+		return &query[*ent.GitAttestationQuery, predicate.GitAttestation, gitattestation.OrderOption]{typ: ent.TypeGitAttestation, tq: q}, nil
+	case *ent.GithubAttestationQuery:
+		return &query[*ent.GithubAttestationQuery, predicate.GithubAttestation, githubattestation.OrderOption]{typ: ent.TypeGithubAttestation, tq: q}, nil
+	case *ent.GitlabAttestationQuery:
+		return &query[*ent.GitlabAttestationQuery, predicate.GitlabAttestation, gitlabattestation.OrderOption]{typ: ent.TypeGitlabAttestation, tq: q}, nil
+	case *ent.LegalHoldQuery:
+		return &query[*ent.LegalHoldQuery, predicate.LegalHold, legalhold.OrderOption]{typ: ent.TypeLegalHold, tq: q}, nil
+	case *ent.MaterialQuery:
+		return &query[*ent.MaterialQuery, predicate.Material, material.OrderOption]{typ: ent.TypeMaterial, tq: q}, nil
+	case *ent.OciAttestationQuery:
+		return &query[*ent.OciAttestationQuery, predicate.OciAttestation, ociattestation.OrderOption]{typ: ent.TypeOciAttestation, tq: q}, nil
+	case *ent.PayloadDigestQuery:
+		return &query[*ent.PayloadDigestQuery, predicate.PayloadDigest, payloaddigest.OrderOption]{typ: ent.TypePayloadDigest, tq: q}, nil
+	case *ent.ProductQuery:
+		return &query[*ent.ProductQuery, predicate.Product, product.OrderOption]{typ: ent.TypeProduct, tq: q}, nil
+	case *ent.PublicationQuery:
+		return &query[*ent.PublicationQuery, predicate.Publication, publication.OrderOption]{typ: ent.TypePublication, tq: q}, nil
+	case *ent.PublishDeliveryQuery:
+		return &query[*ent.PublishDeliveryQuery, predicate.PublishDelivery, publishdelivery.OrderOption]{typ: ent.TypePublishDelivery, tq: q}, nil
+	case *ent.SbomPackageQuery:
+		return &query[*ent.SbomPackageQuery, predicate.SbomPackage, sbompackage.OrderOption]{typ: ent.TypeSbomPackage, tq: q}, nil
+	case *ent.SbomPackageDigestQuery:
+		return &query[*ent.SbomPackageDigestQuery, predicate.SbomPackageDigest, sbompackagedigest.OrderOption]{typ: ent.TypeSbomPackageDigest, tq: q}, nil
+	case *ent.SignatureQuery:
+		return &query[*ent.SignatureQuery, predicate.Signature, signature.OrderOption]{typ: ent.TypeSignature, tq: q}, nil
+	case *ent.SlsaDependencyQuery:
+		return &query[*ent.SlsaDependencyQuery, predicate.SlsaDependency, slsadependency.OrderOption]{typ: ent.TypeSlsaDependency, tq: q}, nil
+	case *ent.SlsaProvenanceQuery:
+		return &query[*ent.SlsaProvenanceQuery, predicate.SlsaProvenance, slsaprovenance.OrderOption]{typ: ent.TypeSlsaProvenance, tq: q}, nil
+	case *ent.StatementQuery:
+		return &query[*ent.StatementQuery, predicate.Statement, statement.OrderOption]{typ: ent.TypeStatement, tq: q}, nil
+	case *ent.SubjectQuery:
+		return &query[*ent.SubjectQuery, predicate.Subject, subject.OrderOption]{typ: ent.TypeSubject, tq: q}, nil
+	case *ent.SubjectAltNameQuery:
+		return &query[*ent.SubjectAltNameQuery, predicate.SubjectAltName, subjectaltname.OrderOption]{typ: ent.TypeSubjectAltName, tq: q}, nil
+	case *ent.SubjectDigestQuery:
+		return &query[*ent.SubjectDigestQuery, predicate.SubjectDigest, subjectdigest.OrderOption]{typ: ent.TypeSubjectDigest, tq: q}, nil
+	case *ent.TimestampQuery:
+		return &query[*ent.TimestampQuery, predicate.Timestamp, timestamp.OrderOption]{typ: ent.TypeTimestamp, tq: q}, nil
+	case *ent.TransparencyLogEntryQuery:
+		return &query[*ent.TransparencyLogEntryQuery, predicate.TransparencyLogEntry, transparencylogentry.OrderOption]{typ: ent.TypeTransparencyLogEntry, tq: q}, nil
+	case *ent.VerificationSummaryQuery:
+		return &query[*ent.VerificationSummaryQuery, predicate.VerificationSummary, verificationsummary.OrderOption]{typ: ent.TypeVerificationSummary, tq: q}, nil
+	default:
+		return nil, fmt.Errorf("unknown query type %T", q)
+	}
+}
+
+type query[T any, P ~func(*sql.Selector), R ~func(*sql.Selector)] struct {
+	typ string
+	tq  interface {
+		Limit(int) T
+		Offset(int) T
+		Unique(bool) T
+		Order(...R) T
+		Where(...P) T
+	}
+}
+
+func (q query[T, P, R]) Type() string {
+	return q.typ
+}
+
+func (q query[T, P, R]) Limit(limit int) {
+	q.tq.Limit(limit)
+}
+
+func (q query[T, P, R]) Offset(offset int) {
+	q.tq.Offset(offset)
+}
+
+func (q query[T, P, R]) Unique(unique bool) {
+	q.tq.Unique(unique)
+}
+
+func (q query[T, P, R]) Order(orders ...func(*sql.Selector)) {
+	rs := make([]R, len(orders))
+	for i := range orders {
+		rs[i] = orders[i]
+	}
+	q.tq.Order(rs...)
+}
+
+func (q query[T, P, R]) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]P, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	q.tq.Where(p...)
+}
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/legalhold"
+)
+
+// LegalHold is the model entity for the LegalHold schema.
+type LegalHold struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// SubjectDigest holds the value of the "subject_digest" field.
+	SubjectDigest string `json:"subject_digest,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ReleasedBy holds the value of the "released_by" field.
+	ReleasedBy string `json:"released_by,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LegalHoldQuery when eager-loading is set.
+	Edges        LegalHoldEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LegalHoldEdges holds the relations/edges for other nodes in the graph.
+type LegalHoldEdges struct {
+	// Dsses holds the value of the dsses edge.
+	Dsses []*Dsse `json:"dsses,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedDsses map[string][]*Dsse
+}
+
+// DssesOrErr returns the Dsses value or an error if the edge
+// was not loaded in eager-loading.
+func (e LegalHoldEdges) DssesOrErr() ([]*Dsse, error) {
+	if e.loadedTypes[0] {
+		return e.Dsses, nil
+	}
+	return nil, &NotLoadedError{edge: "dsses"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LegalHold) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case legalhold.FieldTenant, legalhold.FieldReason, legalhold.FieldSubjectDigest, legalhold.FieldCreatedBy, legalhold.FieldReleasedBy:
+			values[i] = new(sql.NullString)
+		case legalhold.FieldCreatedAt, legalhold.FieldReleasedAt:
+			values[i] = new(sql.NullTime)
+		case legalhold.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LegalHold fields.
+func (_m *LegalHold) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case legalhold.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case legalhold.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case legalhold.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case legalhold.FieldSubjectDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_digest", values[i])
+			} else if value.Valid {
+				_m.SubjectDigest = value.String
+			}
+		case legalhold.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case legalhold.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case legalhold.FieldReleasedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field released_by", values[i])
+			} else if value.Valid {
+				_m.ReleasedBy = value.String
+			}
+		case legalhold.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				_m.ReleasedAt = new(time.Time)
+				*_m.ReleasedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LegalHold.
+// This includes values selected through modifiers, order, etc.
+func (_m *LegalHold) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDsses queries the "dsses" edge of the LegalHold entity.
+func (_m *LegalHold) QueryDsses() *DsseQuery {
+	return NewLegalHoldClient(_m.config).QueryDsses(_m)
+}
+
+// Update returns a builder for updating this LegalHold.
+// Note that you need to call LegalHold.Unwrap() before calling this method if this LegalHold
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LegalHold) Update() *LegalHoldUpdateOne {
+	return NewLegalHoldClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LegalHold entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LegalHold) Unwrap() *LegalHold {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LegalHold is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LegalHold) String() string {
+	var builder strings.Builder
+	builder.WriteString("LegalHold(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("subject_digest=")
+	builder.WriteString(_m.SubjectDigest)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("released_by=")
+	builder.WriteString(_m.ReleasedBy)
+	builder.WriteString(", ")
+	if v := _m.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedDsses returns the Dsses named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *LegalHold) NamedDsses(name string) ([]*Dsse, error) {
+	if _m.Edges.namedDsses == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedDsses[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *LegalHold) appendNamedDsses(name string, edges ...*Dsse) {
+	if _m.Edges.namedDsses == nil {
+		_m.Edges.namedDsses = make(map[string][]*Dsse)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedDsses[name] = []*Dsse{}
+	} else {
+		_m.Edges.namedDsses[name] = append(_m.Edges.namedDsses[name], edges...)
+	}
+}
+
+// LegalHolds is a parsable slice of LegalHold.
+type LegalHolds []*LegalHold
@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package legalhold
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the legalhold type in the database.
+	Label = "legal_hold"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldSubjectDigest holds the string denoting the subject_digest field in the database.
+	FieldSubjectDigest = "subject_digest"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldReleasedBy holds the string denoting the released_by field in the database.
+	FieldReleasedBy = "released_by"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// EdgeDsses holds the string denoting the dsses edge name in mutations.
+	EdgeDsses = "dsses"
+	// Table holds the table name of the legalhold in the database.
+	Table = "legal_holds"
+	// DssesTable is the table that holds the dsses relation/edge. The primary key declared below.
+	DssesTable = "legal_hold_dsses"
+	// DssesInverseTable is the table name for the Dsse entity.
+	// It exists in this package in order to avoid circular dependency with the "dsse" package.
+	DssesInverseTable = "dsses"
+)
+
+// Columns holds all SQL columns for legalhold fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldReason,
+	FieldSubjectDigest,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldReleasedBy,
+	FieldReleasedAt,
+}
+
+var (
+	// DssesPrimaryKey and DssesColumn2 are the table columns denoting the
+	// primary key for the dsses relation (M2M).
+	DssesPrimaryKey = []string{"legal_hold_id", "dsse_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LegalHold queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// BySubjectDigest orders the results by the subject_digest field.
+func BySubjectDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectDigest, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReleasedBy orders the results by the released_by field.
+func ByReleasedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedBy, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByDssesCount orders the results by dsses count.
+func ByDssesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDssesStep(), opts...)
+	}
+}
+
+// ByDsses orders the results by dsses terms.
+func ByDsses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDssesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newDssesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DssesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, DssesTable, DssesPrimaryKey...),
+	)
+}
@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package legalhold
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldTenant, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldReason, v))
+}
+
+// SubjectDigest applies equality check predicate on the "subject_digest" field. It's identical to SubjectDigestEQ.
+func SubjectDigest(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldSubjectDigest, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReleasedBy applies equality check predicate on the "released_by" field. It's identical to ReleasedByEQ.
+func ReleasedBy(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldReleasedBy, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContainsFold(FieldReason, v))
+}
+
+// SubjectDigestEQ applies the EQ predicate on the "subject_digest" field.
+func SubjectDigestEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldSubjectDigest, v))
+}
+
+// SubjectDigestNEQ applies the NEQ predicate on the "subject_digest" field.
+func SubjectDigestNEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNEQ(FieldSubjectDigest, v))
+}
+
+// SubjectDigestIn applies the In predicate on the "subject_digest" field.
+func SubjectDigestIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIn(FieldSubjectDigest, vs...))
+}
+
+// SubjectDigestNotIn applies the NotIn predicate on the "subject_digest" field.
+func SubjectDigestNotIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotIn(FieldSubjectDigest, vs...))
+}
+
+// SubjectDigestGT applies the GT predicate on the "subject_digest" field.
+func SubjectDigestGT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGT(FieldSubjectDigest, v))
+}
+
+// SubjectDigestGTE applies the GTE predicate on the "subject_digest" field.
+func SubjectDigestGTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGTE(FieldSubjectDigest, v))
+}
+
+// SubjectDigestLT applies the LT predicate on the "subject_digest" field.
+func SubjectDigestLT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLT(FieldSubjectDigest, v))
+}
+
+// SubjectDigestLTE applies the LTE predicate on the "subject_digest" field.
+func SubjectDigestLTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLTE(FieldSubjectDigest, v))
+}
+
+// SubjectDigestContains applies the Contains predicate on the "subject_digest" field.
+func SubjectDigestContains(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContains(FieldSubjectDigest, v))
+}
+
+// SubjectDigestHasPrefix applies the HasPrefix predicate on the "subject_digest" field.
+func SubjectDigestHasPrefix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasPrefix(FieldSubjectDigest, v))
+}
+
+// SubjectDigestHasSuffix applies the HasSuffix predicate on the "subject_digest" field.
+func SubjectDigestHasSuffix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasSuffix(FieldSubjectDigest, v))
+}
+
+// SubjectDigestIsNil applies the IsNil predicate on the "subject_digest" field.
+func SubjectDigestIsNil() predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIsNull(FieldSubjectDigest))
+}
+
+// SubjectDigestNotNil applies the NotNil predicate on the "subject_digest" field.
+func SubjectDigestNotNil() predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotNull(FieldSubjectDigest))
+}
+
+// SubjectDigestEqualFold applies the EqualFold predicate on the "subject_digest" field.
+func SubjectDigestEqualFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEqualFold(FieldSubjectDigest, v))
+}
+
+// SubjectDigestContainsFold applies the ContainsFold predicate on the "subject_digest" field.
+func SubjectDigestContainsFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContainsFold(FieldSubjectDigest, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReleasedByEQ applies the EQ predicate on the "released_by" field.
+func ReleasedByEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldReleasedBy, v))
+}
+
+// ReleasedByNEQ applies the NEQ predicate on the "released_by" field.
+func ReleasedByNEQ(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNEQ(FieldReleasedBy, v))
+}
+
+// ReleasedByIn applies the In predicate on the "released_by" field.
+func ReleasedByIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIn(FieldReleasedBy, vs...))
+}
+
+// ReleasedByNotIn applies the NotIn predicate on the "released_by" field.
+func ReleasedByNotIn(vs ...string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotIn(FieldReleasedBy, vs...))
+}
+
+// ReleasedByGT applies the GT predicate on the "released_by" field.
+func ReleasedByGT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGT(FieldReleasedBy, v))
+}
+
+// ReleasedByGTE applies the GTE predicate on the "released_by" field.
+func ReleasedByGTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGTE(FieldReleasedBy, v))
+}
+
+// ReleasedByLT applies the LT predicate on the "released_by" field.
+func ReleasedByLT(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLT(FieldReleasedBy, v))
+}
+
+// ReleasedByLTE applies the LTE predicate on the "released_by" field.
+func ReleasedByLTE(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLTE(FieldReleasedBy, v))
+}
+
+// ReleasedByContains applies the Contains predicate on the "released_by" field.
+func ReleasedByContains(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContains(FieldReleasedBy, v))
+}
+
+// ReleasedByHasPrefix applies the HasPrefix predicate on the "released_by" field.
+func ReleasedByHasPrefix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasPrefix(FieldReleasedBy, v))
+}
+
+// ReleasedByHasSuffix applies the HasSuffix predicate on the "released_by" field.
+func ReleasedByHasSuffix(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldHasSuffix(FieldReleasedBy, v))
+}
+
+// ReleasedByIsNil applies the IsNil predicate on the "released_by" field.
+func ReleasedByIsNil() predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIsNull(FieldReleasedBy))
+}
+
+// ReleasedByNotNil applies the NotNil predicate on the "released_by" field.
+func ReleasedByNotNil() predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotNull(FieldReleasedBy))
+}
+
+// ReleasedByEqualFold applies the EqualFold predicate on the "released_by" field.
+func ReleasedByEqualFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEqualFold(FieldReleasedBy, v))
+}
+
+// ReleasedByContainsFold applies the ContainsFold predicate on the "released_by" field.
+func ReleasedByContainsFold(v string) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldContainsFold(FieldReleasedBy, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.LegalHold {
+	return predicate.LegalHold(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// HasDsses applies the HasEdge predicate on the "dsses" edge.
+func HasDsses() predicate.LegalHold {
+	return predicate.LegalHold(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, DssesTable, DssesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDssesWith applies the HasEdge predicate on the "dsses" edge with a given conditions (other predicates).
+func HasDssesWith(preds ...predicate.Dsse) predicate.LegalHold {
+	return predicate.LegalHold(func(s *sql.Selector) {
+		step := newDssesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LegalHold) predicate.LegalHold {
+	return predicate.LegalHold(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LegalHold) predicate.LegalHold {
+	return predicate.LegalHold(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LegalHold) predicate.LegalHold {
+	return predicate.LegalHold(sql.NotPredicates(p))
+}
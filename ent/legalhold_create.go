@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/legalhold"
+)
+
+// LegalHoldCreate is the builder for creating a LegalHold entity.
+type LegalHoldCreate struct {
+	config
+	mutation *LegalHoldMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *LegalHoldCreate) SetTenant(v string) *LegalHoldCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *LegalHoldCreate) SetNillableTenant(v *string) *LegalHoldCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *LegalHoldCreate) SetReason(v string) *LegalHoldCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetSubjectDigest sets the "subject_digest" field.
+func (_c *LegalHoldCreate) SetSubjectDigest(v string) *LegalHoldCreate {
+	_c.mutation.SetSubjectDigest(v)
+	return _c
+}
+
+// SetNillableSubjectDigest sets the "subject_digest" field if the given value is not nil.
+func (_c *LegalHoldCreate) SetNillableSubjectDigest(v *string) *LegalHoldCreate {
+	if v != nil {
+		_c.SetSubjectDigest(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *LegalHoldCreate) SetCreatedBy(v string) *LegalHoldCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *LegalHoldCreate) SetNillableCreatedBy(v *string) *LegalHoldCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LegalHoldCreate) SetCreatedAt(v time.Time) *LegalHoldCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LegalHoldCreate) SetNillableCreatedAt(v *time.Time) *LegalHoldCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (_c *LegalHoldCreate) SetReleasedBy(v string) *LegalHoldCreate {
+	_c.mutation.SetReleasedBy(v)
+	return _c
+}
+
+// SetNillableReleasedBy sets the "released_by" field if the given value is not nil.
+func (_c *LegalHoldCreate) SetNillableReleasedBy(v *string) *LegalHoldCreate {
+	if v != nil {
+		_c.SetReleasedBy(*v)
+	}
+	return _c
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_c *LegalHoldCreate) SetReleasedAt(v time.Time) *LegalHoldCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *LegalHoldCreate) SetNillableReleasedAt(v *time.Time) *LegalHoldCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LegalHoldCreate) SetID(v uuid.UUID) *LegalHoldCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LegalHoldCreate) SetNillableID(v *uuid.UUID) *LegalHoldCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddDssIDs adds the "dsses" edge to the Dsse entity by IDs.
+func (_c *LegalHoldCreate) AddDssIDs(ids ...uuid.UUID) *LegalHoldCreate {
+	_c.mutation.AddDssIDs(ids...)
+	return _c
+}
+
+// AddDsses adds the "dsses" edges to the Dsse entity.
+func (_c *LegalHoldCreate) AddDsses(v ...*Dsse) *LegalHoldCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDssIDs(ids...)
+}
+
+// Mutation returns the LegalHoldMutation object of the builder.
+func (_c *LegalHoldCreate) Mutation() *LegalHoldMutation {
+	return _c.mutation
+}
+
+// Save creates the LegalHold in the database.
+func (_c *LegalHoldCreate) Save(ctx context.Context) (*LegalHold, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LegalHoldCreate) SaveX(ctx context.Context) *LegalHold {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LegalHoldCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LegalHoldCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LegalHoldCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := legalhold.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if legalhold.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized legalhold.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := legalhold.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if legalhold.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized legalhold.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := legalhold.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LegalHoldCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "LegalHold.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := legalhold.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "LegalHold.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "LegalHold.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := legalhold.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LegalHold.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LegalHold.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LegalHoldCreate) sqlSave(ctx context.Context) (*LegalHold, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LegalHoldCreate) createSpec() (*LegalHold, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LegalHold{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(legalhold.Table, sqlgraph.NewFieldSpec(legalhold.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(legalhold.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(legalhold.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.SubjectDigest(); ok {
+		_spec.SetField(legalhold.FieldSubjectDigest, field.TypeString, value)
+		_node.SubjectDigest = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(legalhold.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(legalhold.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ReleasedBy(); ok {
+		_spec.SetField(legalhold.FieldReleasedBy, field.TypeString, value)
+		_node.ReleasedBy = value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(legalhold.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if nodes := _c.mutation.DssesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   legalhold.DssesTable,
+			Columns: legalhold.DssesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LegalHoldCreateBulk is the builder for creating many LegalHold entities in bulk.
+type LegalHoldCreateBulk struct {
+	config
+	err      error
+	builders []*LegalHoldCreate
+}
+
+// Save creates the LegalHold entities in the database.
+func (_c *LegalHoldCreateBulk) Save(ctx context.Context) ([]*LegalHold, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LegalHold, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LegalHoldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LegalHoldCreateBulk) SaveX(ctx context.Context) []*LegalHold {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LegalHoldCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LegalHoldCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// MaterialUpdate is the builder for updating Material entities.
+type MaterialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MaterialMutation
+}
+
+// Where appends a list predicates to the MaterialUpdate builder.
+func (_u *MaterialUpdate) Where(ps ...predicate.Material) *MaterialUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *MaterialUpdate) SetPath(v string) *MaterialUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *MaterialUpdate) SetNillablePath(v *string) *MaterialUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *MaterialUpdate) SetAlgorithm(v string) *MaterialUpdate {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *MaterialUpdate) SetNillableAlgorithm(v *string) *MaterialUpdate {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *MaterialUpdate) SetValue(v string) *MaterialUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *MaterialUpdate) SetNillableValue(v *string) *MaterialUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *MaterialUpdate) SetAttestationID(id uuid.UUID) *MaterialUpdate {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *MaterialUpdate) SetAttestation(v *Attestation) *MaterialUpdate {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the MaterialMutation object of the builder.
+func (_u *MaterialUpdate) Mutation() *MaterialMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *MaterialUpdate) ClearAttestation() *MaterialUpdate {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MaterialUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MaterialUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MaterialUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MaterialUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MaterialUpdate) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := material.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Material.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Algorithm(); ok {
+		if err := material.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "Material.algorithm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := material.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Material.value": %w`, err)}
+		}
+	}
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Material.attestation"`)
+	}
+	return nil
+}
+
+func (_u *MaterialUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(material.Table, material.Columns, sqlgraph.NewFieldSpec(material.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(material.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(material.FieldAlgorithm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(material.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   material.AttestationTable,
+			Columns: []string{material.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   material.AttestationTable,
+			Columns: []string{material.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{material.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MaterialUpdateOne is the builder for updating a single Material entity.
+type MaterialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MaterialMutation
+}
+
+// SetPath sets the "path" field.
+func (_u *MaterialUpdateOne) SetPath(v string) *MaterialUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *MaterialUpdateOne) SetNillablePath(v *string) *MaterialUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *MaterialUpdateOne) SetAlgorithm(v string) *MaterialUpdateOne {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *MaterialUpdateOne) SetNillableAlgorithm(v *string) *MaterialUpdateOne {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *MaterialUpdateOne) SetValue(v string) *MaterialUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *MaterialUpdateOne) SetNillableValue(v *string) *MaterialUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *MaterialUpdateOne) SetAttestationID(id uuid.UUID) *MaterialUpdateOne {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *MaterialUpdateOne) SetAttestation(v *Attestation) *MaterialUpdateOne {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the MaterialMutation object of the builder.
+func (_u *MaterialUpdateOne) Mutation() *MaterialMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *MaterialUpdateOne) ClearAttestation() *MaterialUpdateOne {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Where appends a list predicates to the MaterialUpdate builder.
+func (_u *MaterialUpdateOne) Where(ps ...predicate.Material) *MaterialUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MaterialUpdateOne) Select(field string, fields ...string) *MaterialUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Material entity.
+func (_u *MaterialUpdateOne) Save(ctx context.Context) (*Material, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MaterialUpdateOne) SaveX(ctx context.Context) *Material {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MaterialUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MaterialUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MaterialUpdateOne) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := material.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Material.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Algorithm(); ok {
+		if err := material.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "Material.algorithm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := material.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Material.value": %w`, err)}
+		}
+	}
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Material.attestation"`)
+	}
+	return nil
+}
+
+func (_u *MaterialUpdateOne) sqlSave(ctx context.Context) (_node *Material, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(material.Table, material.Columns, sqlgraph.NewFieldSpec(material.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Material.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, material.FieldID)
+		for _, f := range fields {
+			if !material.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != material.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(material.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(material.FieldAlgorithm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(material.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   material.AttestationTable,
+			Columns: []string{material.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   material.AttestationTable,
+			Columns: []string{material.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Material{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{material.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,2 @@
+-- Modify "signatures" table
+ALTER TABLE `signatures` ADD COLUMN `verified` bool NOT NULL DEFAULT 0;
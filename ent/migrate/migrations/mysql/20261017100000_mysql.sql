@@ -0,0 +1,2 @@
+-- Create "publish_deliveries" table
+CREATE TABLE `publish_deliveries` (`id` char(36) NOT NULL, `gitoid_sha256` varchar(255) NOT NULL, `publisher` varchar(255) NOT NULL, `status` enum('PENDING','DELIVERED','DEAD_LETTER') NOT NULL DEFAULT "PENDING", `attempts` bigint NOT NULL DEFAULT 0, `next_attempt_at` timestamp NOT NULL, `last_error` text NULL, `created_at` timestamp NOT NULL, `delivered_at` timestamp NULL, `dsse_publish_deliveries` char(36) NULL, PRIMARY KEY (`id`), INDEX `publish_deliveries_dsses_publish_deliveries` (`dsse_publish_deliveries`), UNIQUE INDEX `publishdelivery_gitoid_sha256_publisher` (`gitoid_sha256`, `publisher`), INDEX `publishdelivery_status_next_attempt_at` (`status`, `next_attempt_at`), CONSTRAINT `publish_deliveries_dsses_publish_deliveries` FOREIGN KEY (`dsse_publish_deliveries`) REFERENCES `dsses` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
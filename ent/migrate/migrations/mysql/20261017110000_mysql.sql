@@ -0,0 +1,2 @@
+-- Create "publications" table
+CREATE TABLE `publications` (`id` char(36) NOT NULL, `gitoid_sha256` varchar(255) NOT NULL, `publisher` varchar(255) NOT NULL, `task_id` varchar(255) NOT NULL, `status` enum('PENDING','SUCCEEDED','FAILED') NOT NULL DEFAULT "PENDING", `message` text NULL, `created_at` timestamp NOT NULL, `completed_at` timestamp NULL, `dsse_publications` char(36) NULL, PRIMARY KEY (`id`), INDEX `publication_gitoid_sha256` (`gitoid_sha256`), UNIQUE INDEX `publication_publisher_task_id` (`publisher`, `task_id`), INDEX `publication_status` (`status`), INDEX `publications_dsses_publications` (`dsse_publications`), CONSTRAINT `publications_dsses_publications` FOREIGN KEY (`dsse_publications`) REFERENCES `dsses` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,24 @@
+-- Modify "attestations" table
+ALTER TABLE `attestations` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `attestation_tenant` (`tenant`);
+-- Modify "attestation_collections" table
+ALTER TABLE `attestation_collections` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `attestationcollection_tenant` (`tenant`);
+-- Modify "attestation_policies" table
+ALTER TABLE `attestation_policies` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `attestationpolicy_tenant` (`tenant`);
+-- Modify "dsses" table
+ALTER TABLE `dsses` DROP INDEX `gitoid_sha256`, ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `dsse_tenant` (`tenant`), ADD UNIQUE INDEX `dsse_tenant_gitoid_sha256` (`tenant`, `gitoid_sha256`);
+-- Modify "payload_digests" table
+ALTER TABLE `payload_digests` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `payloaddigest_tenant` (`tenant`);
+-- Modify "publications" table
+ALTER TABLE `publications` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `publication_tenant` (`tenant`);
+-- Modify "publish_deliveries" table
+ALTER TABLE `publish_deliveries` DROP INDEX `publishdelivery_gitoid_sha256_publisher`, ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `publishdelivery_tenant` (`tenant`), ADD UNIQUE INDEX `publishdelivery_tenant_gitoid_sha256_publisher` (`tenant`, `gitoid_sha256`, `publisher`);
+-- Modify "signatures" table
+ALTER TABLE `signatures` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `signature_tenant` (`tenant`);
+-- Modify "statements" table
+ALTER TABLE `statements` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `statement_tenant` (`tenant`);
+-- Modify "subjects" table
+ALTER TABLE `subjects` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `subject_tenant` (`tenant`);
+-- Modify "subject_digests" table
+ALTER TABLE `subject_digests` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `subjectdigest_tenant` (`tenant`);
+-- Modify "timestamps" table
+ALTER TABLE `timestamps` ADD COLUMN `tenant` varchar(255) NOT NULL DEFAULT "default", ADD INDEX `timestamp_tenant` (`tenant`);
@@ -0,0 +1,4 @@
+-- Create "legal_holds" table
+CREATE TABLE `legal_holds` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `reason` text NOT NULL, `subject_digest` varchar(255) NULL, `created_by` varchar(255) NULL, `created_at` timestamp NOT NULL, `released_by` varchar(255) NULL, `released_at` timestamp NULL, PRIMARY KEY (`id`), INDEX `legalhold_released_at` (`released_at`), INDEX `legalhold_tenant` (`tenant`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "legal_hold_dsses" table
+CREATE TABLE `legal_hold_dsses` (`legal_hold_id` char(36) NOT NULL, `dsse_id` char(36) NOT NULL, PRIMARY KEY (`legal_hold_id`, `dsse_id`), INDEX `legal_hold_dsses_dsse_id` (`dsse_id`), CONSTRAINT `legal_hold_dsses_dsse_id` FOREIGN KEY (`dsse_id`) REFERENCES `dsses` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT `legal_hold_dsses_legal_hold_id` FOREIGN KEY (`legal_hold_id`) REFERENCES `legal_holds` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
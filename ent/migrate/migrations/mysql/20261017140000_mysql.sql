@@ -0,0 +1,16 @@
+-- Create "command_run_attestations" table
+CREATE TABLE `command_run_attestations` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `cmd` text NOT NULL, `exit_code` bigint NOT NULL, `attestation_command_run_attestation` char(36) NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `attestation_command_run_attestation` (`attestation_command_run_attestation`), INDEX `commandrunattestation_tenant` (`tenant`), CONSTRAINT `command_run_attestations_attestations_command_run_attestation` FOREIGN KEY (`attestation_command_run_attestation`) REFERENCES `attestations` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "environment_attestations" table
+CREATE TABLE `environment_attestations` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `os` varchar(255) NULL, `hostname` varchar(255) NULL, `username` varchar(255) NULL, `attestation_environment_attestation` char(36) NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `attestation_environment_attestation` (`attestation_environment_attestation`), INDEX `environmentattestation_hostname` (`hostname`), INDEX `environmentattestation_tenant` (`tenant`), CONSTRAINT `environment_attestations_attestations_environment_attestation` FOREIGN KEY (`attestation_environment_attestation`) REFERENCES `attestations` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "git_attestations" table
+CREATE TABLE `git_attestations` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `commit_hash` varchar(255) NOT NULL, `branch` varchar(255) NULL, `tree_hash` varchar(255) NULL, `author` varchar(255) NULL, `author_email` varchar(255) NULL, `committer_name` varchar(255) NULL, `committer_email` varchar(255) NULL, `commit_date` varchar(255) NULL, `commit_message` text NULL, `parent_hashes` json NULL, `refs` json NULL, `remotes` json NULL, `tags` json NULL, `attestation_git_attestation` char(36) NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `attestation_git_attestation` (`attestation_git_attestation`), INDEX `gitattestation_branch` (`branch`), INDEX `gitattestation_commit_hash` (`commit_hash`), INDEX `gitattestation_tenant` (`tenant`), CONSTRAINT `git_attestations_attestations_git_attestation` FOREIGN KEY (`attestation_git_attestation`) REFERENCES `attestations` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "github_attestations" table
+CREATE TABLE `github_attestations` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `ci_config_path` varchar(255) NULL, `pipeline_id` varchar(255) NULL, `pipeline_name` varchar(255) NULL, `pipeline_url` varchar(255) NULL, `project_url` varchar(255) NULL, `runner_id` varchar(255) NULL, `ci_host` varchar(255) NULL, `ci_server_url` varchar(255) NULL, `runner_arch` varchar(255) NULL, `runner_os` varchar(255) NULL, `attestation_github_attestation` char(36) NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `attestation_github_attestation` (`attestation_github_attestation`), INDEX `githubattestation_pipeline_id` (`pipeline_id`), INDEX `githubattestation_project_url` (`project_url`), INDEX `githubattestation_tenant` (`tenant`), CONSTRAINT `github_attestations_attestations_github_attestation` FOREIGN KEY (`attestation_github_attestation`) REFERENCES `attestations` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "gitlab_attestations" table
+CREATE TABLE `gitlab_attestations` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `ci_config_path` varchar(255) NULL, `pipeline_id` varchar(255) NULL, `job_id` varchar(255) NULL, `job_image` varchar(255) NULL, `job_name` varchar(255) NULL, `job_stage` varchar(255) NULL, `job_url` varchar(255) NULL, `pipeline_url` varchar(255) NULL, `project_id` varchar(255) NULL, `project_url` varchar(255) NULL, `runner_id` varchar(255) NULL, `ci_host` varchar(255) NULL, `ci_server_url` varchar(255) NULL, `attestation_gitlab_attestation` char(36) NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `attestation_gitlab_attestation` (`attestation_gitlab_attestation`), INDEX `gitlabattestation_pipeline_id` (`pipeline_id`), INDEX `gitlabattestation_project_url` (`project_url`), INDEX `gitlabattestation_tenant` (`tenant`), CONSTRAINT `gitlab_attestations_attestations_gitlab_attestation` FOREIGN KEY (`attestation_gitlab_attestation`) REFERENCES `attestations` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "materials" table
+CREATE TABLE `materials` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `path` text NOT NULL, `algorithm` varchar(255) NOT NULL, `value` varchar(255) NOT NULL, `attestation_materials` char(36) NOT NULL, PRIMARY KEY (`id`), INDEX `material_tenant` (`tenant`), INDEX `material_value` (`value`), INDEX `materials_attestations_materials` (`attestation_materials`), CONSTRAINT `materials_attestations_materials` FOREIGN KEY (`attestation_materials`) REFERENCES `attestations` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "oci_attestations" table
+CREATE TABLE `oci_attestations` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `tar_digest` varchar(255) NULL, `image_id` varchar(255) NULL, `manifest_digest` varchar(255) NULL, `image_tags` json NULL, `attestation_oci_attestation` char(36) NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `attestation_oci_attestation` (`attestation_oci_attestation`), INDEX `ociattestation_image_id` (`image_id`), INDEX `ociattestation_manifest_digest` (`manifest_digest`), INDEX `ociattestation_tenant` (`tenant`), CONSTRAINT `oci_attestations_attestations_oci_attestation` FOREIGN KEY (`attestation_oci_attestation`) REFERENCES `attestations` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "products" table
+CREATE TABLE `products` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `path` text NOT NULL, `mime_type` varchar(255) NULL, `algorithm` varchar(255) NOT NULL, `value` varchar(255) NOT NULL, `attestation_products` char(36) NOT NULL, PRIMARY KEY (`id`), INDEX `product_tenant` (`tenant`), INDEX `product_value` (`value`), INDEX `products_attestations_products` (`attestation_products`), CONSTRAINT `products_attestations_products` FOREIGN KEY (`attestation_products`) REFERENCES `attestations` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,6 @@
+-- Create "slsa_provenances" table
+CREATE TABLE `slsa_provenances` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `builder_id` varchar(255) NOT NULL, `build_type` varchar(255) NOT NULL, `invocation_id` varchar(255) NULL, `started_on` timestamp NULL, `finished_on` timestamp NULL, `external_parameters` text NULL, `statement_slsa_provenance` char(36) NOT NULL, PRIMARY KEY (`id`), INDEX `slsaprovenance_build_type` (`build_type`), INDEX `slsaprovenance_builder_id` (`builder_id`), INDEX `slsaprovenance_tenant` (`tenant`), UNIQUE INDEX `statement_slsa_provenance` (`statement_slsa_provenance`), CONSTRAINT `slsa_provenances_statements_slsa_provenance` FOREIGN KEY (`statement_slsa_provenance`) REFERENCES `statements` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "slsa_dependencies" table
+CREATE TABLE `slsa_dependencies` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `uri` text NULL, `name` varchar(255) NULL, `algorithm` varchar(255) NULL, `value` varchar(255) NULL, `slsa_provenance_resolved_dependencies` char(36) NOT NULL, PRIMARY KEY (`id`), INDEX `slsa_dependencies_slsa_provenances_resolved_dependencies` (`slsa_provenance_resolved_dependencies`), INDEX `slsadependency_tenant` (`tenant`), INDEX `slsadependency_value` (`value`), CONSTRAINT `slsa_dependencies_slsa_provenances_resolved_dependencies` FOREIGN KEY (`slsa_provenance_resolved_dependencies`) REFERENCES `slsa_provenances` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "verification_summaries" table
+CREATE TABLE `verification_summaries` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `verifier_id` varchar(255) NOT NULL, `time_verified` timestamp NULL, `resource_uri` varchar(255) NOT NULL, `policy_uri` varchar(255) NULL, `verification_result` varchar(255) NOT NULL, `verified_levels` json NULL, `slsa_version` varchar(255) NULL, `statement_verification_summary` char(36) NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `statement_verification_summary` (`statement_verification_summary`), INDEX `verificationsummary_resource_uri` (`resource_uri`), INDEX `verificationsummary_tenant` (`tenant`), INDEX `verificationsummary_verification_result` (`verification_result`), INDEX `verificationsummary_verifier_id` (`verifier_id`), CONSTRAINT `verification_summaries_statements_verification_summary` FOREIGN KEY (`statement_verification_summary`) REFERENCES `statements` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
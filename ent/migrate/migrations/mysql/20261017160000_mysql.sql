@@ -0,0 +1,4 @@
+-- Create "sbom_packages" table
+CREATE TABLE `sbom_packages` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `name` varchar(255) NOT NULL, `version` varchar(255) NULL, `purl` varchar(255) NULL, `license` varchar(255) NULL, `statement_sbom_packages` char(36) NOT NULL, PRIMARY KEY (`id`), INDEX `sbom_packages_statements_sbom_packages` (`statement_sbom_packages`), INDEX `sbompackage_name_version` (`name`, `version`), INDEX `sbompackage_purl` (`purl`), INDEX `sbompackage_tenant` (`tenant`), CONSTRAINT `sbom_packages_statements_sbom_packages` FOREIGN KEY (`statement_sbom_packages`) REFERENCES `statements` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "sbom_package_digests" table
+CREATE TABLE `sbom_package_digests` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `algorithm` varchar(255) NOT NULL, `value` varchar(255) NOT NULL, `sbom_package_digests` char(36) NOT NULL, PRIMARY KEY (`id`), INDEX `sbom_package_digests_sbom_packages_digests` (`sbom_package_digests`), INDEX `sbompackagedigest_tenant` (`tenant`), INDEX `sbompackagedigest_value` (`value`), CONSTRAINT `sbom_package_digests_sbom_packages_digests` FOREIGN KEY (`sbom_package_digests`) REFERENCES `sbom_packages` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- Create "transparency_log_entries" table
+CREATE TABLE `transparency_log_entries` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `log_index` bigint NOT NULL, `log_id` varchar(255) NOT NULL, `kind` varchar(255) NULL, `kind_version` varchar(255) NULL, `integrated_time` timestamp NULL, `entry` text NOT NULL, `dsse_transparency_log_entries` char(36) NOT NULL, PRIMARY KEY (`id`), INDEX `transparency_log_entries_dsses_transparency_log_entries` (`dsse_transparency_log_entries`), INDEX `transparencylogentry_log_id_log_index` (`log_id`, `log_index`), INDEX `transparencylogentry_tenant` (`tenant`), CONSTRAINT `transparency_log_entries_dsses_transparency_log_entries` FOREIGN KEY (`dsse_transparency_log_entries`) REFERENCES `dsses` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,4 @@
+-- Create "certificates" table
+CREATE TABLE `certificates` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `chain_index` bigint NOT NULL, `subject` varchar(255) NOT NULL, `issuer` varchar(255) NOT NULL, `serial_number` varchar(255) NOT NULL, `not_before` timestamp NOT NULL, `not_after` timestamp NOT NULL, `spiffe_id` varchar(255) NULL, `oidc_issuer` varchar(255) NULL, `build_signer_uri` varchar(255) NULL, `build_signer_digest` varchar(255) NULL, `runner_environment` varchar(255) NULL, `source_repository_uri` varchar(255) NULL, `source_repository_digest` varchar(255) NULL, `source_repository_ref` varchar(255) NULL, `build_config_uri` varchar(255) NULL, `build_trigger` varchar(255) NULL, `run_invocation_uri` varchar(255) NULL, `signature_certificates` char(36) NOT NULL, PRIMARY KEY (`id`), INDEX `certificate_build_signer_uri` (`build_signer_uri`), INDEX `certificate_oidc_issuer` (`oidc_issuer`), INDEX `certificate_source_repository_uri` (`source_repository_uri`), INDEX `certificate_spiffe_id` (`spiffe_id`), INDEX `certificate_tenant` (`tenant`), INDEX `certificates_signatures_certificates` (`signature_certificates`), CONSTRAINT `certificates_signatures_certificates` FOREIGN KEY (`signature_certificates`) REFERENCES `signatures` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "subject_alt_names" table
+CREATE TABLE `subject_alt_names` (`id` char(36) NOT NULL, `tenant` varchar(255) NOT NULL DEFAULT "default", `type` enum('EMAIL','URI','DNS','IP') NOT NULL, `value` varchar(255) NOT NULL, `certificate_subject_alt_names` char(36) NOT NULL, PRIMARY KEY (`id`), INDEX `subject_alt_names_certificates_subject_alt_names` (`certificate_subject_alt_names`), INDEX `subjectaltname_tenant` (`tenant`), INDEX `subjectaltname_value` (`value`), CONSTRAINT `subject_alt_names_certificates_subject_alt_names` FOREIGN KEY (`certificate_subject_alt_names`) REFERENCES `certificates` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
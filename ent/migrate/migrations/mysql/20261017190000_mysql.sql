@@ -0,0 +1,8 @@
+-- Modify "environment_attestations" table
+ALTER TABLE `environment_attestations` DROP INDEX `environmentattestation_hostname`, MODIFY COLUMN `hostname` text NULL, MODIFY COLUMN `username` text NULL, ADD INDEX `environmentattestation_hostname` (`hostname` (255));
+-- Modify "git_attestations" table
+ALTER TABLE `git_attestations` DROP INDEX `gitattestation_branch`, MODIFY COLUMN `branch` text NULL, MODIFY COLUMN `author` text NULL, MODIFY COLUMN `author_email` text NULL, MODIFY COLUMN `committer_name` text NULL, MODIFY COLUMN `committer_email` text NULL, ADD INDEX `gitattestation_branch` (`branch` (255));
+-- Modify "github_attestations" table
+ALTER TABLE `github_attestations` DROP INDEX `githubattestation_project_url`, MODIFY COLUMN `ci_config_path` text NULL, MODIFY COLUMN `pipeline_name` text NULL, MODIFY COLUMN `pipeline_url` text NULL, MODIFY COLUMN `project_url` text NULL, MODIFY COLUMN `ci_host` text NULL, MODIFY COLUMN `ci_server_url` text NULL, ADD INDEX `githubattestation_project_url` (`project_url` (255));
+-- Modify "gitlab_attestations" table
+ALTER TABLE `gitlab_attestations` DROP INDEX `gitlabattestation_project_url`, MODIFY COLUMN `ci_config_path` text NULL, MODIFY COLUMN `job_image` text NULL, MODIFY COLUMN `job_name` text NULL, MODIFY COLUMN `job_url` text NULL, MODIFY COLUMN `pipeline_url` text NULL, MODIFY COLUMN `project_url` text NULL, MODIFY COLUMN `ci_host` text NULL, MODIFY COLUMN `ci_server_url` text NULL, ADD INDEX `gitlabattestation_project_url` (`project_url` (255));
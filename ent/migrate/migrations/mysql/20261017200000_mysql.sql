@@ -0,0 +1,4 @@
+-- Modify "certificates" table
+ALTER TABLE `certificates` DROP INDEX `certificate_build_signer_uri`, DROP INDEX `certificate_oidc_issuer`, DROP INDEX `certificate_source_repository_uri`, DROP INDEX `certificate_spiffe_id`, MODIFY COLUMN `subject` text NOT NULL, MODIFY COLUMN `issuer` text NOT NULL, MODIFY COLUMN `spiffe_id` text NULL, MODIFY COLUMN `oidc_issuer` text NULL, MODIFY COLUMN `build_signer_uri` text NULL, MODIFY COLUMN `build_signer_digest` text NULL, MODIFY COLUMN `runner_environment` text NULL, MODIFY COLUMN `source_repository_uri` text NULL, MODIFY COLUMN `source_repository_digest` text NULL, MODIFY COLUMN `source_repository_ref` text NULL, MODIFY COLUMN `build_config_uri` text NULL, MODIFY COLUMN `build_trigger` text NULL, MODIFY COLUMN `run_invocation_uri` text NULL, ADD INDEX `certificate_build_signer_uri` (`build_signer_uri` (255)), ADD INDEX `certificate_oidc_issuer` (`oidc_issuer` (255)), ADD INDEX `certificate_source_repository_uri` (`source_repository_uri` (255)), ADD INDEX `certificate_spiffe_id` (`spiffe_id` (255));
+-- Modify "subject_alt_names" table
+ALTER TABLE `subject_alt_names` DROP INDEX `subjectaltname_value`, MODIFY COLUMN `value` text NOT NULL, ADD INDEX `subjectaltname_value` (`value` (255));
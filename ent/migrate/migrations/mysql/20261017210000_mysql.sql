@@ -0,0 +1,2 @@
+-- Modify "sbom_packages" table
+ALTER TABLE `sbom_packages` DROP INDEX `sbompackage_name_version`, DROP INDEX `sbompackage_purl`, MODIFY COLUMN `name` text NOT NULL, MODIFY COLUMN `version` text NULL, MODIFY COLUMN `purl` text NULL, MODIFY COLUMN `license` text NULL, ADD INDEX `sbompackage_name_version` (`name` (255), `version` (255)), ADD INDEX `sbompackage_purl` (`purl` (255));
@@ -0,0 +1,6 @@
+-- Modify "slsa_provenances" table
+ALTER TABLE `slsa_provenances` DROP INDEX `slsaprovenance_build_type`, DROP INDEX `slsaprovenance_builder_id`, MODIFY COLUMN `builder_id` text NOT NULL, MODIFY COLUMN `build_type` text NOT NULL, MODIFY COLUMN `invocation_id` text NULL, ADD INDEX `slsaprovenance_build_type` (`build_type` (255)), ADD INDEX `slsaprovenance_builder_id` (`builder_id` (255));
+-- Modify "slsa_dependencies" table
+ALTER TABLE `slsa_dependencies` MODIFY COLUMN `name` text NULL;
+-- Modify "verification_summaries" table
+ALTER TABLE `verification_summaries` DROP INDEX `verificationsummary_resource_uri`, DROP INDEX `verificationsummary_verifier_id`, MODIFY COLUMN `verifier_id` text NOT NULL, MODIFY COLUMN `resource_uri` text NOT NULL, MODIFY COLUMN `policy_uri` text NULL, ADD INDEX `verificationsummary_resource_uri` (`resource_uri` (255)), ADD INDEX `verificationsummary_verifier_id` (`verifier_id` (255));
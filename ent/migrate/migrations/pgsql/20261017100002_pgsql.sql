@@ -0,0 +1,6 @@
+-- Create "publish_deliveries" table
+CREATE TABLE "publish_deliveries" ("id" uuid NOT NULL, "gitoid_sha256" character varying NOT NULL, "publisher" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'PENDING', "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NOT NULL, "last_error" character varying NULL, "created_at" timestamptz NOT NULL, "delivered_at" timestamptz NULL, "dsse_publish_deliveries" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "publish_deliveries_dsses_publish_deliveries" FOREIGN KEY ("dsse_publish_deliveries") REFERENCES "dsses" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "publishdelivery_gitoid_sha256_publisher" to table: "publish_deliveries"
+CREATE UNIQUE INDEX "publishdelivery_gitoid_sha256_publisher" ON "publish_deliveries" ("gitoid_sha256", "publisher");
+-- Create index "publishdelivery_status_next_attempt_at" to table: "publish_deliveries"
+CREATE INDEX "publishdelivery_status_next_attempt_at" ON "publish_deliveries" ("status", "next_attempt_at");
@@ -0,0 +1,8 @@
+-- Create "publications" table
+CREATE TABLE "publications" ("id" uuid NOT NULL, "gitoid_sha256" character varying NOT NULL, "publisher" character varying NOT NULL, "task_id" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'PENDING', "message" character varying NULL, "created_at" timestamptz NOT NULL, "completed_at" timestamptz NULL, "dsse_publications" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "publications_dsses_publications" FOREIGN KEY ("dsse_publications") REFERENCES "dsses" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "publication_gitoid_sha256" to table: "publications"
+CREATE INDEX "publication_gitoid_sha256" ON "publications" ("gitoid_sha256");
+-- Create index "publication_publisher_task_id" to table: "publications"
+CREATE UNIQUE INDEX "publication_publisher_task_id" ON "publications" ("publisher", "task_id");
+-- Create index "publication_status" to table: "publications"
+CREATE INDEX "publication_status" ON "publications" ("status");
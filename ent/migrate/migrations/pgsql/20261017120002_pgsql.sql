@@ -0,0 +1,56 @@
+-- Modify "attestations" table
+ALTER TABLE "attestations" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "attestation_tenant" to table: "attestations"
+CREATE INDEX "attestation_tenant" ON "attestations" ("tenant");
+-- Modify "attestation_collections" table
+ALTER TABLE "attestation_collections" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "attestationcollection_tenant" to table: "attestation_collections"
+CREATE INDEX "attestationcollection_tenant" ON "attestation_collections" ("tenant");
+-- Modify "attestation_policies" table
+ALTER TABLE "attestation_policies" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "attestationpolicy_tenant" to table: "attestation_policies"
+CREATE INDEX "attestationpolicy_tenant" ON "attestation_policies" ("tenant");
+-- Drop index "dsses_gitoid_sha256_key" from table: "dsses"
+DROP INDEX "dsses_gitoid_sha256_key";
+-- Modify "dsses" table
+ALTER TABLE "dsses" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "dsse_tenant" to table: "dsses"
+CREATE INDEX "dsse_tenant" ON "dsses" ("tenant");
+-- Create index "dsse_tenant_gitoid_sha256" to table: "dsses"
+CREATE UNIQUE INDEX "dsse_tenant_gitoid_sha256" ON "dsses" ("tenant", "gitoid_sha256");
+-- Modify "payload_digests" table
+ALTER TABLE "payload_digests" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "payloaddigest_tenant" to table: "payload_digests"
+CREATE INDEX "payloaddigest_tenant" ON "payload_digests" ("tenant");
+-- Modify "publications" table
+ALTER TABLE "publications" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "publication_tenant" to table: "publications"
+CREATE INDEX "publication_tenant" ON "publications" ("tenant");
+-- Drop index "publishdelivery_gitoid_sha256_publisher" from table: "publish_deliveries"
+DROP INDEX "publishdelivery_gitoid_sha256_publisher";
+-- Modify "publish_deliveries" table
+ALTER TABLE "publish_deliveries" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "publishdelivery_tenant" to table: "publish_deliveries"
+CREATE INDEX "publishdelivery_tenant" ON "publish_deliveries" ("tenant");
+-- Create index "publishdelivery_tenant_gitoid_sha256_publisher" to table: "publish_deliveries"
+CREATE UNIQUE INDEX "publishdelivery_tenant_gitoid_sha256_publisher" ON "publish_deliveries" ("tenant", "gitoid_sha256", "publisher");
+-- Modify "signatures" table
+ALTER TABLE "signatures" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "signature_tenant" to table: "signatures"
+CREATE INDEX "signature_tenant" ON "signatures" ("tenant");
+-- Modify "statements" table
+ALTER TABLE "statements" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "statement_tenant" to table: "statements"
+CREATE INDEX "statement_tenant" ON "statements" ("tenant");
+-- Modify "subjects" table
+ALTER TABLE "subjects" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "subject_tenant" to table: "subjects"
+CREATE INDEX "subject_tenant" ON "subjects" ("tenant");
+-- Modify "subject_digests" table
+ALTER TABLE "subject_digests" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "subjectdigest_tenant" to table: "subject_digests"
+CREATE INDEX "subjectdigest_tenant" ON "subject_digests" ("tenant");
+-- Modify "timestamps" table
+ALTER TABLE "timestamps" ADD COLUMN "tenant" character varying NOT NULL DEFAULT 'default';
+-- Create index "timestamp_tenant" to table: "timestamps"
+CREATE INDEX "timestamp_tenant" ON "timestamps" ("tenant");
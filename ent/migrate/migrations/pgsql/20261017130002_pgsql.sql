@@ -0,0 +1,8 @@
+-- Create "legal_holds" table
+CREATE TABLE "legal_holds" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "reason" character varying NOT NULL, "subject_digest" character varying NULL, "created_by" character varying NULL, "created_at" timestamptz NOT NULL, "released_by" character varying NULL, "released_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "legalhold_released_at" to table: "legal_holds"
+CREATE INDEX "legalhold_released_at" ON "legal_holds" ("released_at");
+-- Create index "legalhold_tenant" to table: "legal_holds"
+CREATE INDEX "legalhold_tenant" ON "legal_holds" ("tenant");
+-- Create "legal_hold_dsses" table
+CREATE TABLE "legal_hold_dsses" ("legal_hold_id" uuid NOT NULL, "dsse_id" uuid NOT NULL, PRIMARY KEY ("legal_hold_id", "dsse_id"), CONSTRAINT "legal_hold_dsses_dsse_id" FOREIGN KEY ("dsse_id") REFERENCES "dsses" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "legal_hold_dsses_legal_hold_id" FOREIGN KEY ("legal_hold_id") REFERENCES "legal_holds" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
@@ -0,0 +1,66 @@
+-- Create "command_run_attestations" table
+CREATE TABLE "command_run_attestations" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "cmd" character varying NOT NULL, "exit_code" bigint NOT NULL, "attestation_command_run_attestation" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "command_run_attestations_attestations_command_run_attestation" FOREIGN KEY ("attestation_command_run_attestation") REFERENCES "attestations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "command_run_attestations_attestation_command_run_attestation_key" to table: "command_run_attestations"
+CREATE UNIQUE INDEX "command_run_attestations_attestation_command_run_attestation_key" ON "command_run_attestations" ("attestation_command_run_attestation");
+-- Create index "commandrunattestation_tenant" to table: "command_run_attestations"
+CREATE INDEX "commandrunattestation_tenant" ON "command_run_attestations" ("tenant");
+-- Create "environment_attestations" table
+CREATE TABLE "environment_attestations" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "os" character varying NULL, "hostname" character varying NULL, "username" character varying NULL, "attestation_environment_attestation" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "environment_attestations_attestations_environment_attestation" FOREIGN KEY ("attestation_environment_attestation") REFERENCES "attestations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "environment_attestations_attestation_environment_attestation_key" to table: "environment_attestations"
+CREATE UNIQUE INDEX "environment_attestations_attestation_environment_attestation_key" ON "environment_attestations" ("attestation_environment_attestation");
+-- Create index "environmentattestation_hostname" to table: "environment_attestations"
+CREATE INDEX "environmentattestation_hostname" ON "environment_attestations" ("hostname");
+-- Create index "environmentattestation_tenant" to table: "environment_attestations"
+CREATE INDEX "environmentattestation_tenant" ON "environment_attestations" ("tenant");
+-- Create "git_attestations" table
+CREATE TABLE "git_attestations" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "commit_hash" character varying NOT NULL, "branch" character varying NULL, "tree_hash" character varying NULL, "author" character varying NULL, "author_email" character varying NULL, "committer_name" character varying NULL, "committer_email" character varying NULL, "commit_date" character varying NULL, "commit_message" character varying NULL, "parent_hashes" jsonb NULL, "refs" jsonb NULL, "remotes" jsonb NULL, "tags" jsonb NULL, "attestation_git_attestation" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "git_attestations_attestations_git_attestation" FOREIGN KEY ("attestation_git_attestation") REFERENCES "attestations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "git_attestations_attestation_git_attestation_key" to table: "git_attestations"
+CREATE UNIQUE INDEX "git_attestations_attestation_git_attestation_key" ON "git_attestations" ("attestation_git_attestation");
+-- Create index "gitattestation_branch" to table: "git_attestations"
+CREATE INDEX "gitattestation_branch" ON "git_attestations" ("branch");
+-- Create index "gitattestation_commit_hash" to table: "git_attestations"
+CREATE INDEX "gitattestation_commit_hash" ON "git_attestations" ("commit_hash");
+-- Create index "gitattestation_tenant" to table: "git_attestations"
+CREATE INDEX "gitattestation_tenant" ON "git_attestations" ("tenant");
+-- Create "github_attestations" table
+CREATE TABLE "github_attestations" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "ci_config_path" character varying NULL, "pipeline_id" character varying NULL, "pipeline_name" character varying NULL, "pipeline_url" character varying NULL, "project_url" character varying NULL, "runner_id" character varying NULL, "ci_host" character varying NULL, "ci_server_url" character varying NULL, "runner_arch" character varying NULL, "runner_os" character varying NULL, "attestation_github_attestation" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "github_attestations_attestations_github_attestation" FOREIGN KEY ("attestation_github_attestation") REFERENCES "attestations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "github_attestations_attestation_github_attestation_key" to table: "github_attestations"
+CREATE UNIQUE INDEX "github_attestations_attestation_github_attestation_key" ON "github_attestations" ("attestation_github_attestation");
+-- Create index "githubattestation_pipeline_id" to table: "github_attestations"
+CREATE INDEX "githubattestation_pipeline_id" ON "github_attestations" ("pipeline_id");
+-- Create index "githubattestation_project_url" to table: "github_attestations"
+CREATE INDEX "githubattestation_project_url" ON "github_attestations" ("project_url");
+-- Create index "githubattestation_tenant" to table: "github_attestations"
+CREATE INDEX "githubattestation_tenant" ON "github_attestations" ("tenant");
+-- Create "gitlab_attestations" table
+CREATE TABLE "gitlab_attestations" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "ci_config_path" character varying NULL, "pipeline_id" character varying NULL, "job_id" character varying NULL, "job_image" character varying NULL, "job_name" character varying NULL, "job_stage" character varying NULL, "job_url" character varying NULL, "pipeline_url" character varying NULL, "project_id" character varying NULL, "project_url" character varying NULL, "runner_id" character varying NULL, "ci_host" character varying NULL, "ci_server_url" character varying NULL, "attestation_gitlab_attestation" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "gitlab_attestations_attestations_gitlab_attestation" FOREIGN KEY ("attestation_gitlab_attestation") REFERENCES "attestations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "gitlab_attestations_attestation_gitlab_attestation_key" to table: "gitlab_attestations"
+CREATE UNIQUE INDEX "gitlab_attestations_attestation_gitlab_attestation_key" ON "gitlab_attestations" ("attestation_gitlab_attestation");
+-- Create index "gitlabattestation_pipeline_id" to table: "gitlab_attestations"
+CREATE INDEX "gitlabattestation_pipeline_id" ON "gitlab_attestations" ("pipeline_id");
+-- Create index "gitlabattestation_project_url" to table: "gitlab_attestations"
+CREATE INDEX "gitlabattestation_project_url" ON "gitlab_attestations" ("project_url");
+-- Create index "gitlabattestation_tenant" to table: "gitlab_attestations"
+CREATE INDEX "gitlabattestation_tenant" ON "gitlab_attestations" ("tenant");
+-- Create "materials" table
+CREATE TABLE "materials" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "path" character varying NOT NULL, "algorithm" character varying NOT NULL, "value" character varying NOT NULL, "attestation_materials" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "materials_attestations_materials" FOREIGN KEY ("attestation_materials") REFERENCES "attestations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "material_tenant" to table: "materials"
+CREATE INDEX "material_tenant" ON "materials" ("tenant");
+-- Create index "material_value" to table: "materials"
+CREATE INDEX "material_value" ON "materials" ("value");
+-- Create "oci_attestations" table
+CREATE TABLE "oci_attestations" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "tar_digest" character varying NULL, "image_id" character varying NULL, "manifest_digest" character varying NULL, "image_tags" jsonb NULL, "attestation_oci_attestation" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "oci_attestations_attestations_oci_attestation" FOREIGN KEY ("attestation_oci_attestation") REFERENCES "attestations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "oci_attestations_attestation_oci_attestation_key" to table: "oci_attestations"
+CREATE UNIQUE INDEX "oci_attestations_attestation_oci_attestation_key" ON "oci_attestations" ("attestation_oci_attestation");
+-- Create index "ociattestation_image_id" to table: "oci_attestations"
+CREATE INDEX "ociattestation_image_id" ON "oci_attestations" ("image_id");
+-- Create index "ociattestation_manifest_digest" to table: "oci_attestations"
+CREATE INDEX "ociattestation_manifest_digest" ON "oci_attestations" ("manifest_digest");
+-- Create index "ociattestation_tenant" to table: "oci_attestations"
+CREATE INDEX "ociattestation_tenant" ON "oci_attestations" ("tenant");
+-- Create "products" table
+CREATE TABLE "products" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "path" character varying NOT NULL, "mime_type" character varying NULL, "algorithm" character varying NOT NULL, "value" character varying NOT NULL, "attestation_products" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "products_attestations_products" FOREIGN KEY ("attestation_products") REFERENCES "attestations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "product_tenant" to table: "products"
+CREATE INDEX "product_tenant" ON "products" ("tenant");
+-- Create index "product_value" to table: "products"
+CREATE INDEX "product_value" ON "products" ("value");
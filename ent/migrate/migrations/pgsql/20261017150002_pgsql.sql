@@ -0,0 +1,28 @@
+-- Create "slsa_provenances" table
+CREATE TABLE "slsa_provenances" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "builder_id" character varying NOT NULL, "build_type" character varying NOT NULL, "invocation_id" character varying NULL, "started_on" timestamptz NULL, "finished_on" timestamptz NULL, "external_parameters" character varying NULL, "statement_slsa_provenance" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "slsa_provenances_statements_slsa_provenance" FOREIGN KEY ("statement_slsa_provenance") REFERENCES "statements" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "slsa_provenances_statement_slsa_provenance_key" to table: "slsa_provenances"
+CREATE UNIQUE INDEX "slsa_provenances_statement_slsa_provenance_key" ON "slsa_provenances" ("statement_slsa_provenance");
+-- Create index "slsaprovenance_build_type" to table: "slsa_provenances"
+CREATE INDEX "slsaprovenance_build_type" ON "slsa_provenances" ("build_type");
+-- Create index "slsaprovenance_builder_id" to table: "slsa_provenances"
+CREATE INDEX "slsaprovenance_builder_id" ON "slsa_provenances" ("builder_id");
+-- Create index "slsaprovenance_tenant" to table: "slsa_provenances"
+CREATE INDEX "slsaprovenance_tenant" ON "slsa_provenances" ("tenant");
+-- Create "slsa_dependencies" table
+CREATE TABLE "slsa_dependencies" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "uri" character varying NULL, "name" character varying NULL, "algorithm" character varying NULL, "value" character varying NULL, "slsa_provenance_resolved_dependencies" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "slsa_dependencies_slsa_provenances_resolved_dependencies" FOREIGN KEY ("slsa_provenance_resolved_dependencies") REFERENCES "slsa_provenances" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "slsadependency_tenant" to table: "slsa_dependencies"
+CREATE INDEX "slsadependency_tenant" ON "slsa_dependencies" ("tenant");
+-- Create index "slsadependency_value" to table: "slsa_dependencies"
+CREATE INDEX "slsadependency_value" ON "slsa_dependencies" ("value");
+-- Create "verification_summaries" table
+CREATE TABLE "verification_summaries" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "verifier_id" character varying NOT NULL, "time_verified" timestamptz NULL, "resource_uri" character varying NOT NULL, "policy_uri" character varying NULL, "verification_result" character varying NOT NULL, "verified_levels" jsonb NULL, "slsa_version" character varying NULL, "statement_verification_summary" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "verification_summaries_statements_verification_summary" FOREIGN KEY ("statement_verification_summary") REFERENCES "statements" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "verification_summaries_statement_verification_summary_key" to table: "verification_summaries"
+CREATE UNIQUE INDEX "verification_summaries_statement_verification_summary_key" ON "verification_summaries" ("statement_verification_summary");
+-- Create index "verificationsummary_resource_uri" to table: "verification_summaries"
+CREATE INDEX "verificationsummary_resource_uri" ON "verification_summaries" ("resource_uri");
+-- Create index "verificationsummary_tenant" to table: "verification_summaries"
+CREATE INDEX "verificationsummary_tenant" ON "verification_summaries" ("tenant");
+-- Create index "verificationsummary_verification_result" to table: "verification_summaries"
+CREATE INDEX "verificationsummary_verification_result" ON "verification_summaries" ("verification_result");
+-- Create index "verificationsummary_verifier_id" to table: "verification_summaries"
+CREATE INDEX "verificationsummary_verifier_id" ON "verification_summaries" ("verifier_id");
@@ -0,0 +1,14 @@
+-- Create "sbom_packages" table
+CREATE TABLE "sbom_packages" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "name" character varying NOT NULL, "version" character varying NULL, "purl" character varying NULL, "license" character varying NULL, "statement_sbom_packages" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "sbom_packages_statements_sbom_packages" FOREIGN KEY ("statement_sbom_packages") REFERENCES "statements" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "sbompackage_name_version" to table: "sbom_packages"
+CREATE INDEX "sbompackage_name_version" ON "sbom_packages" ("name", "version");
+-- Create index "sbompackage_purl" to table: "sbom_packages"
+CREATE INDEX "sbompackage_purl" ON "sbom_packages" ("purl");
+-- Create index "sbompackage_tenant" to table: "sbom_packages"
+CREATE INDEX "sbompackage_tenant" ON "sbom_packages" ("tenant");
+-- Create "sbom_package_digests" table
+CREATE TABLE "sbom_package_digests" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "algorithm" character varying NOT NULL, "value" character varying NOT NULL, "sbom_package_digests" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "sbom_package_digests_sbom_packages_digests" FOREIGN KEY ("sbom_package_digests") REFERENCES "sbom_packages" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "sbompackagedigest_tenant" to table: "sbom_package_digests"
+CREATE INDEX "sbompackagedigest_tenant" ON "sbom_package_digests" ("tenant");
+-- Create index "sbompackagedigest_value" to table: "sbom_package_digests"
+CREATE INDEX "sbompackagedigest_value" ON "sbom_package_digests" ("value");
@@ -0,0 +1,6 @@
+-- Create "transparency_log_entries" table
+CREATE TABLE "transparency_log_entries" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "log_index" bigint NOT NULL, "log_id" character varying NOT NULL, "kind" character varying NULL, "kind_version" character varying NULL, "integrated_time" timestamptz NULL, "entry" character varying NOT NULL, "dsse_transparency_log_entries" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "transparency_log_entries_dsses_transparency_log_entries" FOREIGN KEY ("dsse_transparency_log_entries") REFERENCES "dsses" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "transparencylogentry_log_id_log_index" to table: "transparency_log_entries"
+CREATE INDEX "transparencylogentry_log_id_log_index" ON "transparency_log_entries" ("log_id", "log_index");
+-- Create index "transparencylogentry_tenant" to table: "transparency_log_entries"
+CREATE INDEX "transparencylogentry_tenant" ON "transparency_log_entries" ("tenant");
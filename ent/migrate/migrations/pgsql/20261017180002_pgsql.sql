@@ -0,0 +1,18 @@
+-- Create "certificates" table
+CREATE TABLE "certificates" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "chain_index" bigint NOT NULL, "subject" character varying NOT NULL, "issuer" character varying NOT NULL, "serial_number" character varying NOT NULL, "not_before" timestamptz NOT NULL, "not_after" timestamptz NOT NULL, "spiffe_id" character varying NULL, "oidc_issuer" character varying NULL, "build_signer_uri" character varying NULL, "build_signer_digest" character varying NULL, "runner_environment" character varying NULL, "source_repository_uri" character varying NULL, "source_repository_digest" character varying NULL, "source_repository_ref" character varying NULL, "build_config_uri" character varying NULL, "build_trigger" character varying NULL, "run_invocation_uri" character varying NULL, "signature_certificates" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "certificates_signatures_certificates" FOREIGN KEY ("signature_certificates") REFERENCES "signatures" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "certificate_build_signer_uri" to table: "certificates"
+CREATE INDEX "certificate_build_signer_uri" ON "certificates" ("build_signer_uri");
+-- Create index "certificate_oidc_issuer" to table: "certificates"
+CREATE INDEX "certificate_oidc_issuer" ON "certificates" ("oidc_issuer");
+-- Create index "certificate_source_repository_uri" to table: "certificates"
+CREATE INDEX "certificate_source_repository_uri" ON "certificates" ("source_repository_uri");
+-- Create index "certificate_spiffe_id" to table: "certificates"
+CREATE INDEX "certificate_spiffe_id" ON "certificates" ("spiffe_id");
+-- Create index "certificate_tenant" to table: "certificates"
+CREATE INDEX "certificate_tenant" ON "certificates" ("tenant");
+-- Create "subject_alt_names" table
+CREATE TABLE "subject_alt_names" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT 'default', "type" character varying NOT NULL, "value" character varying NOT NULL, "certificate_subject_alt_names" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "subject_alt_names_certificates_subject_alt_names" FOREIGN KEY ("certificate_subject_alt_names") REFERENCES "certificates" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "subjectaltname_tenant" to table: "subject_alt_names"
+CREATE INDEX "subjectaltname_tenant" ON "subject_alt_names" ("tenant");
+-- Create index "subjectaltname_value" to table: "subject_alt_names"
+CREATE INDEX "subjectaltname_value" ON "subject_alt_names" ("value");
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -11,6 +12,7 @@ var (
 	// AttestationsColumns holds the columns for the "attestations" table.
 	AttestationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "type", Type: field.TypeString},
 		{Name: "attestation_collection_attestations", Type: field.TypeUUID},
 	}
@@ -22,22 +24,28 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "attestations_attestation_collections_attestations",
-				Columns:    []*schema.Column{AttestationsColumns[2]},
+				Columns:    []*schema.Column{AttestationsColumns[3]},
 				RefColumns: []*schema.Column{AttestationCollectionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "attestation_type",
+				Name:    "attestation_tenant",
 				Unique:  false,
 				Columns: []*schema.Column{AttestationsColumns[1]},
 			},
+			{
+				Name:    "attestation_type",
+				Unique:  false,
+				Columns: []*schema.Column{AttestationsColumns[2]},
+			},
 		},
 	}
 	// AttestationCollectionsColumns holds the columns for the "attestation_collections" table.
 	AttestationCollectionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "name", Type: field.TypeString},
 		{Name: "statement_attestation_collections", Type: field.TypeUUID, Unique: true},
 	}
@@ -49,22 +57,28 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "attestation_collections_statements_attestation_collections",
-				Columns:    []*schema.Column{AttestationCollectionsColumns[2]},
+				Columns:    []*schema.Column{AttestationCollectionsColumns[3]},
 				RefColumns: []*schema.Column{StatementsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "attestationcollection_name",
+				Name:    "attestationcollection_tenant",
 				Unique:  false,
 				Columns: []*schema.Column{AttestationCollectionsColumns[1]},
 			},
+			{
+				Name:    "attestationcollection_name",
+				Unique:  false,
+				Columns: []*schema.Column{AttestationCollectionsColumns[2]},
+			},
 		},
 	}
 	// AttestationPoliciesColumns holds the columns for the "attestation_policies" table.
 	AttestationPoliciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "name", Type: field.TypeString},
 		{Name: "statement_policy", Type: field.TypeUUID, Unique: true, Nullable: true},
 	}
@@ -76,24 +90,135 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "attestation_policies_statements_policy",
-				Columns:    []*schema.Column{AttestationPoliciesColumns[2]},
+				Columns:    []*schema.Column{AttestationPoliciesColumns[3]},
 				RefColumns: []*schema.Column{StatementsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "attestationpolicy_name",
+				Name:    "attestationpolicy_tenant",
 				Unique:  false,
 				Columns: []*schema.Column{AttestationPoliciesColumns[1]},
 			},
+			{
+				Name:    "attestationpolicy_name",
+				Unique:  false,
+				Columns: []*schema.Column{AttestationPoliciesColumns[2]},
+			},
+		},
+	}
+	// CertificatesColumns holds the columns for the "certificates" table.
+	CertificatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "chain_index", Type: field.TypeInt},
+		{Name: "subject", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "issuer", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "serial_number", Type: field.TypeString},
+		{Name: "not_before", Type: field.TypeTime},
+		{Name: "not_after", Type: field.TypeTime},
+		{Name: "spiffe_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "oidc_issuer", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "build_signer_uri", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "build_signer_digest", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "runner_environment", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "source_repository_uri", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "source_repository_digest", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "source_repository_ref", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "build_config_uri", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "build_trigger", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "run_invocation_uri", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "signature_certificates", Type: field.TypeUUID},
+	}
+	// CertificatesTable holds the schema information for the "certificates" table.
+	CertificatesTable = &schema.Table{
+		Name:       "certificates",
+		Columns:    CertificatesColumns,
+		PrimaryKey: []*schema.Column{CertificatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "certificates_signatures_certificates",
+				Columns:    []*schema.Column{CertificatesColumns[19]},
+				RefColumns: []*schema.Column{SignaturesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certificate_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[1]},
+			},
+			{
+				Name:    "certificate_spiffe_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+			{
+				Name:    "certificate_oidc_issuer",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+			{
+				Name:    "certificate_build_signer_uri",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+			{
+				Name:    "certificate_source_repository_uri",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[13]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+		},
+	}
+	// CommandRunAttestationsColumns holds the columns for the "command_run_attestations" table.
+	CommandRunAttestationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "cmd", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "exit_code", Type: field.TypeInt},
+		{Name: "attestation_command_run_attestation", Type: field.TypeUUID, Unique: true},
+	}
+	// CommandRunAttestationsTable holds the schema information for the "command_run_attestations" table.
+	CommandRunAttestationsTable = &schema.Table{
+		Name:       "command_run_attestations",
+		Columns:    CommandRunAttestationsColumns,
+		PrimaryKey: []*schema.Column{CommandRunAttestationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "command_run_attestations_attestations_command_run_attestation",
+				Columns:    []*schema.Column{CommandRunAttestationsColumns[4]},
+				RefColumns: []*schema.Column{AttestationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commandrunattestation_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CommandRunAttestationsColumns[1]},
+			},
 		},
 	}
 	// DssesColumns holds the columns for the "dsses" table.
 	DssesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
-		{Name: "gitoid_sha256", Type: field.TypeString, Unique: true},
+		{Name: "gitoid_sha256", Type: field.TypeString},
 		{Name: "payload_type", Type: field.TypeString},
 		{Name: "dsse_statement", Type: field.TypeUUID, Nullable: true},
 	}
@@ -105,15 +230,327 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "dsses_statements_statement",
-				Columns:    []*schema.Column{DssesColumns[4]},
+				Columns:    []*schema.Column{DssesColumns[5]},
 				RefColumns: []*schema.Column{StatementsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dsse_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{DssesColumns[1]},
+			},
+			{
+				Name:    "dsse_tenant_gitoid_sha256",
+				Unique:  true,
+				Columns: []*schema.Column{DssesColumns[1], DssesColumns[3]},
+			},
+		},
+	}
+	// EnvironmentAttestationsColumns holds the columns for the "environment_attestations" table.
+	EnvironmentAttestationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "os", Type: field.TypeString, Nullable: true},
+		{Name: "hostname", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "username", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "attestation_environment_attestation", Type: field.TypeUUID, Unique: true},
+	}
+	// EnvironmentAttestationsTable holds the schema information for the "environment_attestations" table.
+	EnvironmentAttestationsTable = &schema.Table{
+		Name:       "environment_attestations",
+		Columns:    EnvironmentAttestationsColumns,
+		PrimaryKey: []*schema.Column{EnvironmentAttestationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "environment_attestations_attestations_environment_attestation",
+				Columns:    []*schema.Column{EnvironmentAttestationsColumns[5]},
+				RefColumns: []*schema.Column{AttestationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "environmentattestation_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{EnvironmentAttestationsColumns[1]},
+			},
+			{
+				Name:    "environmentattestation_hostname",
+				Unique:  false,
+				Columns: []*schema.Column{EnvironmentAttestationsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+		},
+	}
+	// GitAttestationsColumns holds the columns for the "git_attestations" table.
+	GitAttestationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "commit_hash", Type: field.TypeString},
+		{Name: "branch", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "tree_hash", Type: field.TypeString, Nullable: true},
+		{Name: "author", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "author_email", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "committer_name", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "committer_email", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "commit_date", Type: field.TypeString, Nullable: true},
+		{Name: "commit_message", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "parent_hashes", Type: field.TypeJSON, Nullable: true},
+		{Name: "refs", Type: field.TypeJSON, Nullable: true},
+		{Name: "remotes", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "attestation_git_attestation", Type: field.TypeUUID, Unique: true},
+	}
+	// GitAttestationsTable holds the schema information for the "git_attestations" table.
+	GitAttestationsTable = &schema.Table{
+		Name:       "git_attestations",
+		Columns:    GitAttestationsColumns,
+		PrimaryKey: []*schema.Column{GitAttestationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "git_attestations_attestations_git_attestation",
+				Columns:    []*schema.Column{GitAttestationsColumns[15]},
+				RefColumns: []*schema.Column{AttestationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gitattestation_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{GitAttestationsColumns[1]},
+			},
+			{
+				Name:    "gitattestation_commit_hash",
+				Unique:  false,
+				Columns: []*schema.Column{GitAttestationsColumns[2]},
+			},
+			{
+				Name:    "gitattestation_branch",
+				Unique:  false,
+				Columns: []*schema.Column{GitAttestationsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+		},
+	}
+	// GithubAttestationsColumns holds the columns for the "github_attestations" table.
+	GithubAttestationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "ci_config_path", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "pipeline_id", Type: field.TypeString, Nullable: true},
+		{Name: "pipeline_name", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "pipeline_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "project_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "runner_id", Type: field.TypeString, Nullable: true},
+		{Name: "ci_host", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "ci_server_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "runner_arch", Type: field.TypeString, Nullable: true},
+		{Name: "runner_os", Type: field.TypeString, Nullable: true},
+		{Name: "attestation_github_attestation", Type: field.TypeUUID, Unique: true},
+	}
+	// GithubAttestationsTable holds the schema information for the "github_attestations" table.
+	GithubAttestationsTable = &schema.Table{
+		Name:       "github_attestations",
+		Columns:    GithubAttestationsColumns,
+		PrimaryKey: []*schema.Column{GithubAttestationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "github_attestations_attestations_github_attestation",
+				Columns:    []*schema.Column{GithubAttestationsColumns[12]},
+				RefColumns: []*schema.Column{AttestationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "githubattestation_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{GithubAttestationsColumns[1]},
+			},
+			{
+				Name:    "githubattestation_pipeline_id",
+				Unique:  false,
+				Columns: []*schema.Column{GithubAttestationsColumns[3]},
+			},
+			{
+				Name:    "githubattestation_project_url",
+				Unique:  false,
+				Columns: []*schema.Column{GithubAttestationsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+		},
+	}
+	// GitlabAttestationsColumns holds the columns for the "gitlab_attestations" table.
+	GitlabAttestationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "ci_config_path", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "pipeline_id", Type: field.TypeString, Nullable: true},
+		{Name: "job_id", Type: field.TypeString, Nullable: true},
+		{Name: "job_image", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "job_name", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "job_stage", Type: field.TypeString, Nullable: true},
+		{Name: "job_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "pipeline_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "project_id", Type: field.TypeString, Nullable: true},
+		{Name: "project_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "runner_id", Type: field.TypeString, Nullable: true},
+		{Name: "ci_host", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "ci_server_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "attestation_gitlab_attestation", Type: field.TypeUUID, Unique: true},
+	}
+	// GitlabAttestationsTable holds the schema information for the "gitlab_attestations" table.
+	GitlabAttestationsTable = &schema.Table{
+		Name:       "gitlab_attestations",
+		Columns:    GitlabAttestationsColumns,
+		PrimaryKey: []*schema.Column{GitlabAttestationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "gitlab_attestations_attestations_gitlab_attestation",
+				Columns:    []*schema.Column{GitlabAttestationsColumns[15]},
+				RefColumns: []*schema.Column{AttestationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gitlabattestation_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{GitlabAttestationsColumns[1]},
+			},
+			{
+				Name:    "gitlabattestation_pipeline_id",
+				Unique:  false,
+				Columns: []*schema.Column{GitlabAttestationsColumns[3]},
+			},
+			{
+				Name:    "gitlabattestation_project_url",
+				Unique:  false,
+				Columns: []*schema.Column{GitlabAttestationsColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+		},
+	}
+	// LegalHoldsColumns holds the columns for the "legal_holds" table.
+	LegalHoldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "reason", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "subject_digest", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "released_by", Type: field.TypeString, Nullable: true},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+	}
+	// LegalHoldsTable holds the schema information for the "legal_holds" table.
+	LegalHoldsTable = &schema.Table{
+		Name:       "legal_holds",
+		Columns:    LegalHoldsColumns,
+		PrimaryKey: []*schema.Column{LegalHoldsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "legalhold_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{LegalHoldsColumns[1]},
+			},
+			{
+				Name:    "legalhold_released_at",
+				Unique:  false,
+				Columns: []*schema.Column{LegalHoldsColumns[7]},
+			},
+		},
+	}
+	// MaterialsColumns holds the columns for the "materials" table.
+	MaterialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "path", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "algorithm", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "attestation_materials", Type: field.TypeUUID},
+	}
+	// MaterialsTable holds the schema information for the "materials" table.
+	MaterialsTable = &schema.Table{
+		Name:       "materials",
+		Columns:    MaterialsColumns,
+		PrimaryKey: []*schema.Column{MaterialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "materials_attestations_materials",
+				Columns:    []*schema.Column{MaterialsColumns[5]},
+				RefColumns: []*schema.Column{AttestationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "material_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{MaterialsColumns[1]},
+			},
+			{
+				Name:    "material_value",
+				Unique:  false,
+				Columns: []*schema.Column{MaterialsColumns[4]},
+			},
+		},
+	}
+	// OciAttestationsColumns holds the columns for the "oci_attestations" table.
+	OciAttestationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "tar_digest", Type: field.TypeString, Nullable: true},
+		{Name: "image_id", Type: field.TypeString, Nullable: true},
+		{Name: "manifest_digest", Type: field.TypeString, Nullable: true},
+		{Name: "image_tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "attestation_oci_attestation", Type: field.TypeUUID, Unique: true},
+	}
+	// OciAttestationsTable holds the schema information for the "oci_attestations" table.
+	OciAttestationsTable = &schema.Table{
+		Name:       "oci_attestations",
+		Columns:    OciAttestationsColumns,
+		PrimaryKey: []*schema.Column{OciAttestationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oci_attestations_attestations_oci_attestation",
+				Columns:    []*schema.Column{OciAttestationsColumns[6]},
+				RefColumns: []*schema.Column{AttestationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ociattestation_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{OciAttestationsColumns[1]},
+			},
+			{
+				Name:    "ociattestation_image_id",
+				Unique:  false,
+				Columns: []*schema.Column{OciAttestationsColumns[3]},
+			},
+			{
+				Name:    "ociattestation_manifest_digest",
+				Unique:  false,
+				Columns: []*schema.Column{OciAttestationsColumns[4]},
+			},
+		},
 	}
 	// PayloadDigestsColumns holds the columns for the "payload_digests" table.
 	PayloadDigestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "algorithm", Type: field.TypeString},
 		{Name: "value", Type: field.TypeString},
 		{Name: "dsse_payload_digests", Type: field.TypeUUID, Nullable: true},
@@ -126,24 +563,246 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "payload_digests_dsses_payload_digests",
-				Columns:    []*schema.Column{PayloadDigestsColumns[3]},
+				Columns:    []*schema.Column{PayloadDigestsColumns[4]},
 				RefColumns: []*schema.Column{DssesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "payloaddigest_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{PayloadDigestsColumns[1]},
+			},
 			{
 				Name:    "payloaddigest_value",
 				Unique:  false,
-				Columns: []*schema.Column{PayloadDigestsColumns[2]},
+				Columns: []*schema.Column{PayloadDigestsColumns[3]},
+			},
+		},
+	}
+	// ProductsColumns holds the columns for the "products" table.
+	ProductsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "path", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
+		{Name: "algorithm", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "attestation_products", Type: field.TypeUUID},
+	}
+	// ProductsTable holds the schema information for the "products" table.
+	ProductsTable = &schema.Table{
+		Name:       "products",
+		Columns:    ProductsColumns,
+		PrimaryKey: []*schema.Column{ProductsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "products_attestations_products",
+				Columns:    []*schema.Column{ProductsColumns[6]},
+				RefColumns: []*schema.Column{AttestationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "product_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[1]},
+			},
+			{
+				Name:    "product_value",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[5]},
+			},
+		},
+	}
+	// PublicationsColumns holds the columns for the "publications" table.
+	PublicationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "gitoid_sha256", Type: field.TypeString},
+		{Name: "publisher", Type: field.TypeString},
+		{Name: "task_id", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "SUCCEEDED", "FAILED"}, Default: "PENDING"},
+		{Name: "message", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "dsse_publications", Type: field.TypeUUID, Nullable: true},
+	}
+	// PublicationsTable holds the schema information for the "publications" table.
+	PublicationsTable = &schema.Table{
+		Name:       "publications",
+		Columns:    PublicationsColumns,
+		PrimaryKey: []*schema.Column{PublicationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "publications_dsses_publications",
+				Columns:    []*schema.Column{PublicationsColumns[9]},
+				RefColumns: []*schema.Column{DssesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "publication_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{PublicationsColumns[1]},
+			},
+			{
+				Name:    "publication_gitoid_sha256",
+				Unique:  false,
+				Columns: []*schema.Column{PublicationsColumns[2]},
+			},
+			{
+				Name:    "publication_publisher_task_id",
+				Unique:  true,
+				Columns: []*schema.Column{PublicationsColumns[3], PublicationsColumns[4]},
+			},
+			{
+				Name:    "publication_status",
+				Unique:  false,
+				Columns: []*schema.Column{PublicationsColumns[5]},
+			},
+		},
+	}
+	// PublishDeliveriesColumns holds the columns for the "publish_deliveries" table.
+	PublishDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "gitoid_sha256", Type: field.TypeString},
+		{Name: "publisher", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "DELIVERED", "DEAD_LETTER"}, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "dsse_publish_deliveries", Type: field.TypeUUID, Nullable: true},
+	}
+	// PublishDeliveriesTable holds the schema information for the "publish_deliveries" table.
+	PublishDeliveriesTable = &schema.Table{
+		Name:       "publish_deliveries",
+		Columns:    PublishDeliveriesColumns,
+		PrimaryKey: []*schema.Column{PublishDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "publish_deliveries_dsses_publish_deliveries",
+				Columns:    []*schema.Column{PublishDeliveriesColumns[10]},
+				RefColumns: []*schema.Column{DssesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "publishdelivery_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{PublishDeliveriesColumns[1]},
+			},
+			{
+				Name:    "publishdelivery_tenant_gitoid_sha256_publisher",
+				Unique:  true,
+				Columns: []*schema.Column{PublishDeliveriesColumns[1], PublishDeliveriesColumns[2], PublishDeliveriesColumns[3]},
+			},
+			{
+				Name:    "publishdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{PublishDeliveriesColumns[4], PublishDeliveriesColumns[6]},
+			},
+		},
+	}
+	// SbomPackagesColumns holds the columns for the "sbom_packages" table.
+	SbomPackagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "version", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "purl", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "license", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "statement_sbom_packages", Type: field.TypeUUID},
+	}
+	// SbomPackagesTable holds the schema information for the "sbom_packages" table.
+	SbomPackagesTable = &schema.Table{
+		Name:       "sbom_packages",
+		Columns:    SbomPackagesColumns,
+		PrimaryKey: []*schema.Column{SbomPackagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sbom_packages_statements_sbom_packages",
+				Columns:    []*schema.Column{SbomPackagesColumns[6]},
+				RefColumns: []*schema.Column{StatementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sbompackage_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SbomPackagesColumns[1]},
+			},
+			{
+				Name:    "sbompackage_name_version",
+				Unique:  false,
+				Columns: []*schema.Column{SbomPackagesColumns[2], SbomPackagesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					PrefixColumns: map[string]uint{
+						SbomPackagesColumns[2].Name: 255,
+
+						SbomPackagesColumns[3].Name: 255,
+					},
+				},
+			},
+			{
+				Name:    "sbompackage_purl",
+				Unique:  false,
+				Columns: []*schema.Column{SbomPackagesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+		},
+	}
+	// SbomPackageDigestsColumns holds the columns for the "sbom_package_digests" table.
+	SbomPackageDigestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "algorithm", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "sbom_package_digests", Type: field.TypeUUID},
+	}
+	// SbomPackageDigestsTable holds the schema information for the "sbom_package_digests" table.
+	SbomPackageDigestsTable = &schema.Table{
+		Name:       "sbom_package_digests",
+		Columns:    SbomPackageDigestsColumns,
+		PrimaryKey: []*schema.Column{SbomPackageDigestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sbom_package_digests_sbom_packages_digests",
+				Columns:    []*schema.Column{SbomPackageDigestsColumns[4]},
+				RefColumns: []*schema.Column{SbomPackagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sbompackagedigest_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SbomPackageDigestsColumns[1]},
+			},
+			{
+				Name:    "sbompackagedigest_value",
+				Unique:  false,
+				Columns: []*schema.Column{SbomPackageDigestsColumns[3]},
 			},
 		},
 	}
 	// SignaturesColumns holds the columns for the "signatures" table.
 	SignaturesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "key_id", Type: field.TypeString},
 		{Name: "signature", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "verified", Type: field.TypeBool, Default: false},
 		{Name: "dsse_signatures", Type: field.TypeUUID, Nullable: true},
 	}
 	// SignaturesTable holds the schema information for the "signatures" table.
@@ -154,22 +813,113 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "signatures_dsses_signatures",
-				Columns:    []*schema.Column{SignaturesColumns[3]},
+				Columns:    []*schema.Column{SignaturesColumns[5]},
 				RefColumns: []*schema.Column{DssesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "signature_key_id",
+				Name:    "signature_tenant",
 				Unique:  false,
 				Columns: []*schema.Column{SignaturesColumns[1]},
 			},
+			{
+				Name:    "signature_key_id",
+				Unique:  false,
+				Columns: []*schema.Column{SignaturesColumns[2]},
+			},
+		},
+	}
+	// SlsaDependenciesColumns holds the columns for the "slsa_dependencies" table.
+	SlsaDependenciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "uri", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "name", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "algorithm", Type: field.TypeString, Nullable: true},
+		{Name: "value", Type: field.TypeString, Nullable: true},
+		{Name: "slsa_provenance_resolved_dependencies", Type: field.TypeUUID},
+	}
+	// SlsaDependenciesTable holds the schema information for the "slsa_dependencies" table.
+	SlsaDependenciesTable = &schema.Table{
+		Name:       "slsa_dependencies",
+		Columns:    SlsaDependenciesColumns,
+		PrimaryKey: []*schema.Column{SlsaDependenciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "slsa_dependencies_slsa_provenances_resolved_dependencies",
+				Columns:    []*schema.Column{SlsaDependenciesColumns[6]},
+				RefColumns: []*schema.Column{SlsaProvenancesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "slsadependency_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SlsaDependenciesColumns[1]},
+			},
+			{
+				Name:    "slsadependency_value",
+				Unique:  false,
+				Columns: []*schema.Column{SlsaDependenciesColumns[5]},
+			},
+		},
+	}
+	// SlsaProvenancesColumns holds the columns for the "slsa_provenances" table.
+	SlsaProvenancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "builder_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "build_type", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "invocation_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "started_on", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_on", Type: field.TypeTime, Nullable: true},
+		{Name: "external_parameters", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "statement_slsa_provenance", Type: field.TypeUUID, Unique: true},
+	}
+	// SlsaProvenancesTable holds the schema information for the "slsa_provenances" table.
+	SlsaProvenancesTable = &schema.Table{
+		Name:       "slsa_provenances",
+		Columns:    SlsaProvenancesColumns,
+		PrimaryKey: []*schema.Column{SlsaProvenancesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "slsa_provenances_statements_slsa_provenance",
+				Columns:    []*schema.Column{SlsaProvenancesColumns[8]},
+				RefColumns: []*schema.Column{StatementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "slsaprovenance_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SlsaProvenancesColumns[1]},
+			},
+			{
+				Name:    "slsaprovenance_builder_id",
+				Unique:  false,
+				Columns: []*schema.Column{SlsaProvenancesColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+			{
+				Name:    "slsaprovenance_build_type",
+				Unique:  false,
+				Columns: []*schema.Column{SlsaProvenancesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
 		},
 	}
 	// StatementsColumns holds the columns for the "statements" table.
 	StatementsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "predicate", Type: field.TypeString},
 	}
 	// StatementsTable holds the schema information for the "statements" table.
@@ -179,15 +929,21 @@ var (
 		PrimaryKey: []*schema.Column{StatementsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "statement_predicate",
+				Name:    "statement_tenant",
 				Unique:  false,
 				Columns: []*schema.Column{StatementsColumns[1]},
 			},
+			{
+				Name:    "statement_predicate",
+				Unique:  false,
+				Columns: []*schema.Column{StatementsColumns[2]},
+			},
 		},
 	}
 	// SubjectsColumns holds the columns for the "subjects" table.
 	SubjectsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "statement_subjects", Type: field.TypeUUID, Nullable: true},
@@ -200,22 +956,65 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subjects_statements_subjects",
-				Columns:    []*schema.Column{SubjectsColumns[3]},
+				Columns:    []*schema.Column{SubjectsColumns[4]},
 				RefColumns: []*schema.Column{StatementsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "subject_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SubjectsColumns[1]},
+			},
 			{
 				Name:    "subject_name",
 				Unique:  false,
-				Columns: []*schema.Column{SubjectsColumns[2]},
+				Columns: []*schema.Column{SubjectsColumns[3]},
+			},
+		},
+	}
+	// SubjectAltNamesColumns holds the columns for the "subject_alt_names" table.
+	SubjectAltNamesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"EMAIL", "URI", "DNS", "IP"}},
+		{Name: "value", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "certificate_subject_alt_names", Type: field.TypeUUID},
+	}
+	// SubjectAltNamesTable holds the schema information for the "subject_alt_names" table.
+	SubjectAltNamesTable = &schema.Table{
+		Name:       "subject_alt_names",
+		Columns:    SubjectAltNamesColumns,
+		PrimaryKey: []*schema.Column{SubjectAltNamesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "subject_alt_names_certificates_subject_alt_names",
+				Columns:    []*schema.Column{SubjectAltNamesColumns[4]},
+				RefColumns: []*schema.Column{CertificatesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subjectaltname_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SubjectAltNamesColumns[1]},
+			},
+			{
+				Name:    "subjectaltname_value",
+				Unique:  false,
+				Columns: []*schema.Column{SubjectAltNamesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
 			},
 		},
 	}
 	// SubjectDigestsColumns holds the columns for the "subject_digests" table.
 	SubjectDigestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "algorithm", Type: field.TypeString},
 		{Name: "value", Type: field.TypeString},
 		{Name: "subject_subject_digests", Type: field.TypeUUID, Nullable: true},
@@ -228,22 +1027,28 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subject_digests_subjects_subject_digests",
-				Columns:    []*schema.Column{SubjectDigestsColumns[3]},
+				Columns:    []*schema.Column{SubjectDigestsColumns[4]},
 				RefColumns: []*schema.Column{SubjectsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "subjectdigest_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SubjectDigestsColumns[1]},
+			},
 			{
 				Name:    "subjectdigest_value",
 				Unique:  false,
-				Columns: []*schema.Column{SubjectDigestsColumns[2]},
+				Columns: []*schema.Column{SubjectDigestsColumns[3]},
 			},
 		},
 	}
 	// TimestampsColumns holds the columns for the "timestamps" table.
 	TimestampsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
 		{Name: "type", Type: field.TypeString},
 		{Name: "timestamp", Type: field.TypeTime},
 		{Name: "signature_timestamps", Type: field.TypeUUID, Nullable: true},
@@ -256,24 +1061,169 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "timestamps_signatures_timestamps",
-				Columns:    []*schema.Column{TimestampsColumns[3]},
+				Columns:    []*schema.Column{TimestampsColumns[4]},
 				RefColumns: []*schema.Column{SignaturesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "timestamp_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{TimestampsColumns[1]},
+			},
+		},
+	}
+	// TransparencyLogEntriesColumns holds the columns for the "transparency_log_entries" table.
+	TransparencyLogEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "log_index", Type: field.TypeInt64},
+		{Name: "log_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString, Nullable: true},
+		{Name: "kind_version", Type: field.TypeString, Nullable: true},
+		{Name: "integrated_time", Type: field.TypeTime, Nullable: true},
+		{Name: "entry", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "dsse_transparency_log_entries", Type: field.TypeUUID},
+	}
+	// TransparencyLogEntriesTable holds the schema information for the "transparency_log_entries" table.
+	TransparencyLogEntriesTable = &schema.Table{
+		Name:       "transparency_log_entries",
+		Columns:    TransparencyLogEntriesColumns,
+		PrimaryKey: []*schema.Column{TransparencyLogEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transparency_log_entries_dsses_transparency_log_entries",
+				Columns:    []*schema.Column{TransparencyLogEntriesColumns[8]},
+				RefColumns: []*schema.Column{DssesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transparencylogentry_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{TransparencyLogEntriesColumns[1]},
+			},
+			{
+				Name:    "transparencylogentry_log_id_log_index",
+				Unique:  false,
+				Columns: []*schema.Column{TransparencyLogEntriesColumns[3], TransparencyLogEntriesColumns[2]},
+			},
+		},
+	}
+	// VerificationSummariesColumns holds the columns for the "verification_summaries" table.
+	VerificationSummariesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: "default"},
+		{Name: "verifier_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "time_verified", Type: field.TypeTime, Nullable: true},
+		{Name: "resource_uri", Type: field.TypeString, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "policy_uri", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "text"}},
+		{Name: "verification_result", Type: field.TypeString},
+		{Name: "verified_levels", Type: field.TypeJSON, Nullable: true},
+		{Name: "slsa_version", Type: field.TypeString, Nullable: true},
+		{Name: "statement_verification_summary", Type: field.TypeUUID, Unique: true},
+	}
+	// VerificationSummariesTable holds the schema information for the "verification_summaries" table.
+	VerificationSummariesTable = &schema.Table{
+		Name:       "verification_summaries",
+		Columns:    VerificationSummariesColumns,
+		PrimaryKey: []*schema.Column{VerificationSummariesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "verification_summaries_statements_verification_summary",
+				Columns:    []*schema.Column{VerificationSummariesColumns[9]},
+				RefColumns: []*schema.Column{StatementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "verificationsummary_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationSummariesColumns[1]},
+			},
+			{
+				Name:    "verificationsummary_verifier_id",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationSummariesColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+			{
+				Name:    "verificationsummary_resource_uri",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationSummariesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 255,
+				},
+			},
+			{
+				Name:    "verificationsummary_verification_result",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationSummariesColumns[6]},
+			},
+		},
+	}
+	// LegalHoldDssesColumns holds the columns for the "legal_hold_dsses" table.
+	LegalHoldDssesColumns = []*schema.Column{
+		{Name: "legal_hold_id", Type: field.TypeUUID},
+		{Name: "dsse_id", Type: field.TypeUUID},
+	}
+	// LegalHoldDssesTable holds the schema information for the "legal_hold_dsses" table.
+	LegalHoldDssesTable = &schema.Table{
+		Name:       "legal_hold_dsses",
+		Columns:    LegalHoldDssesColumns,
+		PrimaryKey: []*schema.Column{LegalHoldDssesColumns[0], LegalHoldDssesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "legal_hold_dsses_legal_hold_id",
+				Columns:    []*schema.Column{LegalHoldDssesColumns[0]},
+				RefColumns: []*schema.Column{LegalHoldsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "legal_hold_dsses_dsse_id",
+				Columns:    []*schema.Column{LegalHoldDssesColumns[1]},
+				RefColumns: []*schema.Column{DssesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AttestationsTable,
 		AttestationCollectionsTable,
 		AttestationPoliciesTable,
+		CertificatesTable,
+		CommandRunAttestationsTable,
 		DssesTable,
+		EnvironmentAttestationsTable,
+		GitAttestationsTable,
+		GithubAttestationsTable,
+		GitlabAttestationsTable,
+		LegalHoldsTable,
+		MaterialsTable,
+		OciAttestationsTable,
 		PayloadDigestsTable,
+		ProductsTable,
+		PublicationsTable,
+		PublishDeliveriesTable,
+		SbomPackagesTable,
+		SbomPackageDigestsTable,
 		SignaturesTable,
+		SlsaDependenciesTable,
+		SlsaProvenancesTable,
 		StatementsTable,
 		SubjectsTable,
+		SubjectAltNamesTable,
 		SubjectDigestsTable,
 		TimestampsTable,
+		TransparencyLogEntriesTable,
+		VerificationSummariesTable,
+		LegalHoldDssesTable,
 	}
 )
 
@@ -281,10 +1231,30 @@ func init() {
 	AttestationsTable.ForeignKeys[0].RefTable = AttestationCollectionsTable
 	AttestationCollectionsTable.ForeignKeys[0].RefTable = StatementsTable
 	AttestationPoliciesTable.ForeignKeys[0].RefTable = StatementsTable
+	CertificatesTable.ForeignKeys[0].RefTable = SignaturesTable
+	CommandRunAttestationsTable.ForeignKeys[0].RefTable = AttestationsTable
 	DssesTable.ForeignKeys[0].RefTable = StatementsTable
+	EnvironmentAttestationsTable.ForeignKeys[0].RefTable = AttestationsTable
+	GitAttestationsTable.ForeignKeys[0].RefTable = AttestationsTable
+	GithubAttestationsTable.ForeignKeys[0].RefTable = AttestationsTable
+	GitlabAttestationsTable.ForeignKeys[0].RefTable = AttestationsTable
+	MaterialsTable.ForeignKeys[0].RefTable = AttestationsTable
+	OciAttestationsTable.ForeignKeys[0].RefTable = AttestationsTable
 	PayloadDigestsTable.ForeignKeys[0].RefTable = DssesTable
+	ProductsTable.ForeignKeys[0].RefTable = AttestationsTable
+	PublicationsTable.ForeignKeys[0].RefTable = DssesTable
+	PublishDeliveriesTable.ForeignKeys[0].RefTable = DssesTable
+	SbomPackagesTable.ForeignKeys[0].RefTable = StatementsTable
+	SbomPackageDigestsTable.ForeignKeys[0].RefTable = SbomPackagesTable
 	SignaturesTable.ForeignKeys[0].RefTable = DssesTable
+	SlsaDependenciesTable.ForeignKeys[0].RefTable = SlsaProvenancesTable
+	SlsaProvenancesTable.ForeignKeys[0].RefTable = StatementsTable
 	SubjectsTable.ForeignKeys[0].RefTable = StatementsTable
+	SubjectAltNamesTable.ForeignKeys[0].RefTable = CertificatesTable
 	SubjectDigestsTable.ForeignKeys[0].RefTable = SubjectsTable
 	TimestampsTable.ForeignKeys[0].RefTable = SignaturesTable
+	TransparencyLogEntriesTable.ForeignKeys[0].RefTable = DssesTable
+	VerificationSummariesTable.ForeignKeys[0].RefTable = StatementsTable
+	LegalHoldDssesTable.ForeignKeys[0].RefTable = LegalHoldsTable
+	LegalHoldDssesTable.ForeignKeys[1].RefTable = DssesTable
 }
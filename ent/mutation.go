@@ -15,14 +15,33 @@ import (
 	"github.com/in-toto/archivista/ent/attestation"
 	"github.com/in-toto/archivista/ent/attestationcollection"
 	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
 	"github.com/in-toto/archivista/ent/payloaddigest"
 	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
 	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
 	"github.com/in-toto/archivista/ent/subjectdigest"
 	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 const (
@@ -34,31 +53,69 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAttestation           = "Attestation"
-	TypeAttestationCollection = "AttestationCollection"
-	TypeAttestationPolicy     = "AttestationPolicy"
-	TypeDsse                  = "Dsse"
-	TypePayloadDigest         = "PayloadDigest"
-	TypeSignature             = "Signature"
-	TypeStatement             = "Statement"
-	TypeSubject               = "Subject"
-	TypeSubjectDigest         = "SubjectDigest"
-	TypeTimestamp             = "Timestamp"
+	TypeAttestation            = "Attestation"
+	TypeAttestationCollection  = "AttestationCollection"
+	TypeAttestationPolicy      = "AttestationPolicy"
+	TypeCertificate            = "Certificate"
+	TypeCommandRunAttestation  = "CommandRunAttestation"
+	TypeDsse                   = "Dsse"
+	TypeEnvironmentAttestation = "EnvironmentAttestation"
+	TypeGitAttestation         = "GitAttestation"
+	TypeGithubAttestation      = "GithubAttestation"
+	TypeGitlabAttestation      = "GitlabAttestation"
+	TypeLegalHold              = "LegalHold"
+	TypeMaterial               = "Material"
+	TypeOciAttestation         = "OciAttestation"
+	TypePayloadDigest          = "PayloadDigest"
+	TypeProduct                = "Product"
+	TypePublication            = "Publication"
+	TypePublishDelivery        = "PublishDelivery"
+	TypeSbomPackage            = "SbomPackage"
+	TypeSbomPackageDigest      = "SbomPackageDigest"
+	TypeSignature              = "Signature"
+	TypeSlsaDependency         = "SlsaDependency"
+	TypeSlsaProvenance         = "SlsaProvenance"
+	TypeStatement              = "Statement"
+	TypeSubject                = "Subject"
+	TypeSubjectAltName         = "SubjectAltName"
+	TypeSubjectDigest          = "SubjectDigest"
+	TypeTimestamp              = "Timestamp"
+	TypeTransparencyLogEntry   = "TransparencyLogEntry"
+	TypeVerificationSummary    = "VerificationSummary"
 )
 
 // AttestationMutation represents an operation that mutates the Attestation nodes in the graph.
 type AttestationMutation struct {
 	config
-	op                            Op
-	typ                           string
-	id                            *uuid.UUID
-	_type                         *string
-	clearedFields                 map[string]struct{}
-	attestation_collection        *uuid.UUID
-	clearedattestation_collection bool
-	done                          bool
-	oldValue                      func(context.Context) (*Attestation, error)
-	predicates                    []predicate.Attestation
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	tenant                         *string
+	_type                          *string
+	clearedFields                  map[string]struct{}
+	attestation_collection         *uuid.UUID
+	clearedattestation_collection  bool
+	git_attestation                *uuid.UUID
+	clearedgit_attestation         bool
+	github_attestation             *uuid.UUID
+	clearedgithub_attestation      bool
+	gitlab_attestation             *uuid.UUID
+	clearedgitlab_attestation      bool
+	environment_attestation        *uuid.UUID
+	clearedenvironment_attestation bool
+	command_run_attestation        *uuid.UUID
+	clearedcommand_run_attestation bool
+	oci_attestation                *uuid.UUID
+	clearedoci_attestation         bool
+	materials                      map[uuid.UUID]struct{}
+	removedmaterials               map[uuid.UUID]struct{}
+	clearedmaterials               bool
+	products                       map[uuid.UUID]struct{}
+	removedproducts                map[uuid.UUID]struct{}
+	clearedproducts                bool
+	done                           bool
+	oldValue                       func(context.Context) (*Attestation, error)
+	predicates                     []predicate.Attestation
 }
 
 var _ ent.Mutation = (*AttestationMutation)(nil)
@@ -165,6 +222,42 @@ func (m *AttestationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *AttestationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *AttestationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Attestation entity.
+// If the Attestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttestationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *AttestationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetType sets the "type" field.
 func (m *AttestationMutation) SetType(s string) {
 	m._type = &s
@@ -240,6 +333,348 @@ func (m *AttestationMutation) ResetAttestationCollection() {
 	m.clearedattestation_collection = false
 }
 
+// SetGitAttestationID sets the "git_attestation" edge to the GitAttestation entity by id.
+func (m *AttestationMutation) SetGitAttestationID(id uuid.UUID) {
+	m.git_attestation = &id
+}
+
+// ClearGitAttestation clears the "git_attestation" edge to the GitAttestation entity.
+func (m *AttestationMutation) ClearGitAttestation() {
+	m.clearedgit_attestation = true
+}
+
+// GitAttestationCleared reports if the "git_attestation" edge to the GitAttestation entity was cleared.
+func (m *AttestationMutation) GitAttestationCleared() bool {
+	return m.clearedgit_attestation
+}
+
+// GitAttestationID returns the "git_attestation" edge ID in the mutation.
+func (m *AttestationMutation) GitAttestationID() (id uuid.UUID, exists bool) {
+	if m.git_attestation != nil {
+		return *m.git_attestation, true
+	}
+	return
+}
+
+// GitAttestationIDs returns the "git_attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GitAttestationID instead. It exists only for internal usage by the builders.
+func (m *AttestationMutation) GitAttestationIDs() (ids []uuid.UUID) {
+	if id := m.git_attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGitAttestation resets all changes to the "git_attestation" edge.
+func (m *AttestationMutation) ResetGitAttestation() {
+	m.git_attestation = nil
+	m.clearedgit_attestation = false
+}
+
+// SetGithubAttestationID sets the "github_attestation" edge to the GithubAttestation entity by id.
+func (m *AttestationMutation) SetGithubAttestationID(id uuid.UUID) {
+	m.github_attestation = &id
+}
+
+// ClearGithubAttestation clears the "github_attestation" edge to the GithubAttestation entity.
+func (m *AttestationMutation) ClearGithubAttestation() {
+	m.clearedgithub_attestation = true
+}
+
+// GithubAttestationCleared reports if the "github_attestation" edge to the GithubAttestation entity was cleared.
+func (m *AttestationMutation) GithubAttestationCleared() bool {
+	return m.clearedgithub_attestation
+}
+
+// GithubAttestationID returns the "github_attestation" edge ID in the mutation.
+func (m *AttestationMutation) GithubAttestationID() (id uuid.UUID, exists bool) {
+	if m.github_attestation != nil {
+		return *m.github_attestation, true
+	}
+	return
+}
+
+// GithubAttestationIDs returns the "github_attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GithubAttestationID instead. It exists only for internal usage by the builders.
+func (m *AttestationMutation) GithubAttestationIDs() (ids []uuid.UUID) {
+	if id := m.github_attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGithubAttestation resets all changes to the "github_attestation" edge.
+func (m *AttestationMutation) ResetGithubAttestation() {
+	m.github_attestation = nil
+	m.clearedgithub_attestation = false
+}
+
+// SetGitlabAttestationID sets the "gitlab_attestation" edge to the GitlabAttestation entity by id.
+func (m *AttestationMutation) SetGitlabAttestationID(id uuid.UUID) {
+	m.gitlab_attestation = &id
+}
+
+// ClearGitlabAttestation clears the "gitlab_attestation" edge to the GitlabAttestation entity.
+func (m *AttestationMutation) ClearGitlabAttestation() {
+	m.clearedgitlab_attestation = true
+}
+
+// GitlabAttestationCleared reports if the "gitlab_attestation" edge to the GitlabAttestation entity was cleared.
+func (m *AttestationMutation) GitlabAttestationCleared() bool {
+	return m.clearedgitlab_attestation
+}
+
+// GitlabAttestationID returns the "gitlab_attestation" edge ID in the mutation.
+func (m *AttestationMutation) GitlabAttestationID() (id uuid.UUID, exists bool) {
+	if m.gitlab_attestation != nil {
+		return *m.gitlab_attestation, true
+	}
+	return
+}
+
+// GitlabAttestationIDs returns the "gitlab_attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GitlabAttestationID instead. It exists only for internal usage by the builders.
+func (m *AttestationMutation) GitlabAttestationIDs() (ids []uuid.UUID) {
+	if id := m.gitlab_attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGitlabAttestation resets all changes to the "gitlab_attestation" edge.
+func (m *AttestationMutation) ResetGitlabAttestation() {
+	m.gitlab_attestation = nil
+	m.clearedgitlab_attestation = false
+}
+
+// SetEnvironmentAttestationID sets the "environment_attestation" edge to the EnvironmentAttestation entity by id.
+func (m *AttestationMutation) SetEnvironmentAttestationID(id uuid.UUID) {
+	m.environment_attestation = &id
+}
+
+// ClearEnvironmentAttestation clears the "environment_attestation" edge to the EnvironmentAttestation entity.
+func (m *AttestationMutation) ClearEnvironmentAttestation() {
+	m.clearedenvironment_attestation = true
+}
+
+// EnvironmentAttestationCleared reports if the "environment_attestation" edge to the EnvironmentAttestation entity was cleared.
+func (m *AttestationMutation) EnvironmentAttestationCleared() bool {
+	return m.clearedenvironment_attestation
+}
+
+// EnvironmentAttestationID returns the "environment_attestation" edge ID in the mutation.
+func (m *AttestationMutation) EnvironmentAttestationID() (id uuid.UUID, exists bool) {
+	if m.environment_attestation != nil {
+		return *m.environment_attestation, true
+	}
+	return
+}
+
+// EnvironmentAttestationIDs returns the "environment_attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvironmentAttestationID instead. It exists only for internal usage by the builders.
+func (m *AttestationMutation) EnvironmentAttestationIDs() (ids []uuid.UUID) {
+	if id := m.environment_attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnvironmentAttestation resets all changes to the "environment_attestation" edge.
+func (m *AttestationMutation) ResetEnvironmentAttestation() {
+	m.environment_attestation = nil
+	m.clearedenvironment_attestation = false
+}
+
+// SetCommandRunAttestationID sets the "command_run_attestation" edge to the CommandRunAttestation entity by id.
+func (m *AttestationMutation) SetCommandRunAttestationID(id uuid.UUID) {
+	m.command_run_attestation = &id
+}
+
+// ClearCommandRunAttestation clears the "command_run_attestation" edge to the CommandRunAttestation entity.
+func (m *AttestationMutation) ClearCommandRunAttestation() {
+	m.clearedcommand_run_attestation = true
+}
+
+// CommandRunAttestationCleared reports if the "command_run_attestation" edge to the CommandRunAttestation entity was cleared.
+func (m *AttestationMutation) CommandRunAttestationCleared() bool {
+	return m.clearedcommand_run_attestation
+}
+
+// CommandRunAttestationID returns the "command_run_attestation" edge ID in the mutation.
+func (m *AttestationMutation) CommandRunAttestationID() (id uuid.UUID, exists bool) {
+	if m.command_run_attestation != nil {
+		return *m.command_run_attestation, true
+	}
+	return
+}
+
+// CommandRunAttestationIDs returns the "command_run_attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CommandRunAttestationID instead. It exists only for internal usage by the builders.
+func (m *AttestationMutation) CommandRunAttestationIDs() (ids []uuid.UUID) {
+	if id := m.command_run_attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCommandRunAttestation resets all changes to the "command_run_attestation" edge.
+func (m *AttestationMutation) ResetCommandRunAttestation() {
+	m.command_run_attestation = nil
+	m.clearedcommand_run_attestation = false
+}
+
+// SetOciAttestationID sets the "oci_attestation" edge to the OciAttestation entity by id.
+func (m *AttestationMutation) SetOciAttestationID(id uuid.UUID) {
+	m.oci_attestation = &id
+}
+
+// ClearOciAttestation clears the "oci_attestation" edge to the OciAttestation entity.
+func (m *AttestationMutation) ClearOciAttestation() {
+	m.clearedoci_attestation = true
+}
+
+// OciAttestationCleared reports if the "oci_attestation" edge to the OciAttestation entity was cleared.
+func (m *AttestationMutation) OciAttestationCleared() bool {
+	return m.clearedoci_attestation
+}
+
+// OciAttestationID returns the "oci_attestation" edge ID in the mutation.
+func (m *AttestationMutation) OciAttestationID() (id uuid.UUID, exists bool) {
+	if m.oci_attestation != nil {
+		return *m.oci_attestation, true
+	}
+	return
+}
+
+// OciAttestationIDs returns the "oci_attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OciAttestationID instead. It exists only for internal usage by the builders.
+func (m *AttestationMutation) OciAttestationIDs() (ids []uuid.UUID) {
+	if id := m.oci_attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOciAttestation resets all changes to the "oci_attestation" edge.
+func (m *AttestationMutation) ResetOciAttestation() {
+	m.oci_attestation = nil
+	m.clearedoci_attestation = false
+}
+
+// AddMaterialIDs adds the "materials" edge to the Material entity by ids.
+func (m *AttestationMutation) AddMaterialIDs(ids ...uuid.UUID) {
+	if m.materials == nil {
+		m.materials = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.materials[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMaterials clears the "materials" edge to the Material entity.
+func (m *AttestationMutation) ClearMaterials() {
+	m.clearedmaterials = true
+}
+
+// MaterialsCleared reports if the "materials" edge to the Material entity was cleared.
+func (m *AttestationMutation) MaterialsCleared() bool {
+	return m.clearedmaterials
+}
+
+// RemoveMaterialIDs removes the "materials" edge to the Material entity by IDs.
+func (m *AttestationMutation) RemoveMaterialIDs(ids ...uuid.UUID) {
+	if m.removedmaterials == nil {
+		m.removedmaterials = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.materials, ids[i])
+		m.removedmaterials[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMaterials returns the removed IDs of the "materials" edge to the Material entity.
+func (m *AttestationMutation) RemovedMaterialsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmaterials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MaterialsIDs returns the "materials" edge IDs in the mutation.
+func (m *AttestationMutation) MaterialsIDs() (ids []uuid.UUID) {
+	for id := range m.materials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMaterials resets all changes to the "materials" edge.
+func (m *AttestationMutation) ResetMaterials() {
+	m.materials = nil
+	m.clearedmaterials = false
+	m.removedmaterials = nil
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by ids.
+func (m *AttestationMutation) AddProductIDs(ids ...uuid.UUID) {
+	if m.products == nil {
+		m.products = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.products[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProducts clears the "products" edge to the Product entity.
+func (m *AttestationMutation) ClearProducts() {
+	m.clearedproducts = true
+}
+
+// ProductsCleared reports if the "products" edge to the Product entity was cleared.
+func (m *AttestationMutation) ProductsCleared() bool {
+	return m.clearedproducts
+}
+
+// RemoveProductIDs removes the "products" edge to the Product entity by IDs.
+func (m *AttestationMutation) RemoveProductIDs(ids ...uuid.UUID) {
+	if m.removedproducts == nil {
+		m.removedproducts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.products, ids[i])
+		m.removedproducts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProducts returns the removed IDs of the "products" edge to the Product entity.
+func (m *AttestationMutation) RemovedProductsIDs() (ids []uuid.UUID) {
+	for id := range m.removedproducts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProductsIDs returns the "products" edge IDs in the mutation.
+func (m *AttestationMutation) ProductsIDs() (ids []uuid.UUID) {
+	for id := range m.products {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProducts resets all changes to the "products" edge.
+func (m *AttestationMutation) ResetProducts() {
+	m.products = nil
+	m.clearedproducts = false
+	m.removedproducts = nil
+}
+
 // Where appends a list predicates to the AttestationMutation builder.
 func (m *AttestationMutation) Where(ps ...predicate.Attestation) {
 	m.predicates = append(m.predicates, ps...)
@@ -274,7 +709,10 @@ func (m *AttestationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AttestationMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+	fields := make([]string, 0, 2)
+	if m.tenant != nil {
+		fields = append(fields, attestation.FieldTenant)
+	}
 	if m._type != nil {
 		fields = append(fields, attestation.FieldType)
 	}
@@ -286,6 +724,8 @@ func (m *AttestationMutation) Fields() []string {
 // schema.
 func (m *AttestationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case attestation.FieldTenant:
+		return m.Tenant()
 	case attestation.FieldType:
 		return m.GetType()
 	}
@@ -297,6 +737,8 @@ func (m *AttestationMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *AttestationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case attestation.FieldTenant:
+		return m.OldTenant(ctx)
 	case attestation.FieldType:
 		return m.OldType(ctx)
 	}
@@ -308,6 +750,13 @@ func (m *AttestationMutation) OldField(ctx context.Context, name string) (ent.Va
 // type.
 func (m *AttestationMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case attestation.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case attestation.FieldType:
 		v, ok := value.(string)
 		if !ok {
@@ -364,6 +813,9 @@ func (m *AttestationMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *AttestationMutation) ResetField(name string) error {
 	switch name {
+	case attestation.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case attestation.FieldType:
 		m.ResetType()
 		return nil
@@ -373,10 +825,34 @@ func (m *AttestationMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AttestationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 9)
 	if m.attestation_collection != nil {
 		edges = append(edges, attestation.EdgeAttestationCollection)
 	}
+	if m.git_attestation != nil {
+		edges = append(edges, attestation.EdgeGitAttestation)
+	}
+	if m.github_attestation != nil {
+		edges = append(edges, attestation.EdgeGithubAttestation)
+	}
+	if m.gitlab_attestation != nil {
+		edges = append(edges, attestation.EdgeGitlabAttestation)
+	}
+	if m.environment_attestation != nil {
+		edges = append(edges, attestation.EdgeEnvironmentAttestation)
+	}
+	if m.command_run_attestation != nil {
+		edges = append(edges, attestation.EdgeCommandRunAttestation)
+	}
+	if m.oci_attestation != nil {
+		edges = append(edges, attestation.EdgeOciAttestation)
+	}
+	if m.materials != nil {
+		edges = append(edges, attestation.EdgeMaterials)
+	}
+	if m.products != nil {
+		edges = append(edges, attestation.EdgeProducts)
+	}
 	return edges
 }
 
@@ -388,28 +864,108 @@ func (m *AttestationMutation) AddedIDs(name string) []ent.Value {
 		if id := m.attestation_collection; id != nil {
 			return []ent.Value{*id}
 		}
+	case attestation.EdgeGitAttestation:
+		if id := m.git_attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	case attestation.EdgeGithubAttestation:
+		if id := m.github_attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	case attestation.EdgeGitlabAttestation:
+		if id := m.gitlab_attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	case attestation.EdgeEnvironmentAttestation:
+		if id := m.environment_attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	case attestation.EdgeCommandRunAttestation:
+		if id := m.command_run_attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	case attestation.EdgeOciAttestation:
+		if id := m.oci_attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	case attestation.EdgeMaterials:
+		ids := make([]ent.Value, 0, len(m.materials))
+		for id := range m.materials {
+			ids = append(ids, id)
+		}
+		return ids
+	case attestation.EdgeProducts:
+		ids := make([]ent.Value, 0, len(m.products))
+		for id := range m.products {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AttestationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 9)
+	if m.removedmaterials != nil {
+		edges = append(edges, attestation.EdgeMaterials)
+	}
+	if m.removedproducts != nil {
+		edges = append(edges, attestation.EdgeProducts)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *AttestationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case attestation.EdgeMaterials:
+		ids := make([]ent.Value, 0, len(m.removedmaterials))
+		for id := range m.removedmaterials {
+			ids = append(ids, id)
+		}
+		return ids
+	case attestation.EdgeProducts:
+		ids := make([]ent.Value, 0, len(m.removedproducts))
+		for id := range m.removedproducts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AttestationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 9)
 	if m.clearedattestation_collection {
 		edges = append(edges, attestation.EdgeAttestationCollection)
 	}
+	if m.clearedgit_attestation {
+		edges = append(edges, attestation.EdgeGitAttestation)
+	}
+	if m.clearedgithub_attestation {
+		edges = append(edges, attestation.EdgeGithubAttestation)
+	}
+	if m.clearedgitlab_attestation {
+		edges = append(edges, attestation.EdgeGitlabAttestation)
+	}
+	if m.clearedenvironment_attestation {
+		edges = append(edges, attestation.EdgeEnvironmentAttestation)
+	}
+	if m.clearedcommand_run_attestation {
+		edges = append(edges, attestation.EdgeCommandRunAttestation)
+	}
+	if m.clearedoci_attestation {
+		edges = append(edges, attestation.EdgeOciAttestation)
+	}
+	if m.clearedmaterials {
+		edges = append(edges, attestation.EdgeMaterials)
+	}
+	if m.clearedproducts {
+		edges = append(edges, attestation.EdgeProducts)
+	}
 	return edges
 }
 
@@ -419,6 +975,22 @@ func (m *AttestationMutation) EdgeCleared(name string) bool {
 	switch name {
 	case attestation.EdgeAttestationCollection:
 		return m.clearedattestation_collection
+	case attestation.EdgeGitAttestation:
+		return m.clearedgit_attestation
+	case attestation.EdgeGithubAttestation:
+		return m.clearedgithub_attestation
+	case attestation.EdgeGitlabAttestation:
+		return m.clearedgitlab_attestation
+	case attestation.EdgeEnvironmentAttestation:
+		return m.clearedenvironment_attestation
+	case attestation.EdgeCommandRunAttestation:
+		return m.clearedcommand_run_attestation
+	case attestation.EdgeOciAttestation:
+		return m.clearedoci_attestation
+	case attestation.EdgeMaterials:
+		return m.clearedmaterials
+	case attestation.EdgeProducts:
+		return m.clearedproducts
 	}
 	return false
 }
@@ -430,6 +1002,24 @@ func (m *AttestationMutation) ClearEdge(name string) error {
 	case attestation.EdgeAttestationCollection:
 		m.ClearAttestationCollection()
 		return nil
+	case attestation.EdgeGitAttestation:
+		m.ClearGitAttestation()
+		return nil
+	case attestation.EdgeGithubAttestation:
+		m.ClearGithubAttestation()
+		return nil
+	case attestation.EdgeGitlabAttestation:
+		m.ClearGitlabAttestation()
+		return nil
+	case attestation.EdgeEnvironmentAttestation:
+		m.ClearEnvironmentAttestation()
+		return nil
+	case attestation.EdgeCommandRunAttestation:
+		m.ClearCommandRunAttestation()
+		return nil
+	case attestation.EdgeOciAttestation:
+		m.ClearOciAttestation()
+		return nil
 	}
 	return fmt.Errorf("unknown Attestation unique edge %s", name)
 }
@@ -441,6 +1031,30 @@ func (m *AttestationMutation) ResetEdge(name string) error {
 	case attestation.EdgeAttestationCollection:
 		m.ResetAttestationCollection()
 		return nil
+	case attestation.EdgeGitAttestation:
+		m.ResetGitAttestation()
+		return nil
+	case attestation.EdgeGithubAttestation:
+		m.ResetGithubAttestation()
+		return nil
+	case attestation.EdgeGitlabAttestation:
+		m.ResetGitlabAttestation()
+		return nil
+	case attestation.EdgeEnvironmentAttestation:
+		m.ResetEnvironmentAttestation()
+		return nil
+	case attestation.EdgeCommandRunAttestation:
+		m.ResetCommandRunAttestation()
+		return nil
+	case attestation.EdgeOciAttestation:
+		m.ResetOciAttestation()
+		return nil
+	case attestation.EdgeMaterials:
+		m.ResetMaterials()
+		return nil
+	case attestation.EdgeProducts:
+		m.ResetProducts()
+		return nil
 	}
 	return fmt.Errorf("unknown Attestation edge %s", name)
 }
@@ -451,6 +1065,7 @@ type AttestationCollectionMutation struct {
 	op                  Op
 	typ                 string
 	id                  *uuid.UUID
+	tenant              *string
 	name                *string
 	clearedFields       map[string]struct{}
 	attestations        map[uuid.UUID]struct{}
@@ -567,7 +1182,43 @@ func (m *AttestationCollectionMutation) IDs(ctx context.Context) ([]uuid.UUID, e
 	}
 }
 
-// SetName sets the "name" field.
+// SetTenant sets the "tenant" field.
+func (m *AttestationCollectionMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *AttestationCollectionMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the AttestationCollection entity.
+// If the AttestationCollection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttestationCollectionMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *AttestationCollectionMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetName sets the "name" field.
 func (m *AttestationCollectionMutation) SetName(s string) {
 	m.name = &s
 }
@@ -730,7 +1381,10 @@ func (m *AttestationCollectionMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AttestationCollectionMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+	fields := make([]string, 0, 2)
+	if m.tenant != nil {
+		fields = append(fields, attestationcollection.FieldTenant)
+	}
 	if m.name != nil {
 		fields = append(fields, attestationcollection.FieldName)
 	}
@@ -742,6 +1396,8 @@ func (m *AttestationCollectionMutation) Fields() []string {
 // schema.
 func (m *AttestationCollectionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case attestationcollection.FieldTenant:
+		return m.Tenant()
 	case attestationcollection.FieldName:
 		return m.Name()
 	}
@@ -753,6 +1409,8 @@ func (m *AttestationCollectionMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *AttestationCollectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case attestationcollection.FieldTenant:
+		return m.OldTenant(ctx)
 	case attestationcollection.FieldName:
 		return m.OldName(ctx)
 	}
@@ -764,6 +1422,13 @@ func (m *AttestationCollectionMutation) OldField(ctx context.Context, name strin
 // type.
 func (m *AttestationCollectionMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case attestationcollection.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case attestationcollection.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -820,6 +1485,9 @@ func (m *AttestationCollectionMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *AttestationCollectionMutation) ResetField(name string) error {
 	switch name {
+	case attestationcollection.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case attestationcollection.FieldName:
 		m.ResetName()
 		return nil
@@ -935,6 +1603,7 @@ type AttestationPolicyMutation struct {
 	op               Op
 	typ              string
 	id               *uuid.UUID
+	tenant           *string
 	name             *string
 	clearedFields    map[string]struct{}
 	statement        *uuid.UUID
@@ -1048,6 +1717,42 @@ func (m *AttestationPolicyMutation) IDs(ctx context.Context) ([]uuid.UUID, error
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *AttestationPolicyMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *AttestationPolicyMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the AttestationPolicy entity.
+// If the AttestationPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttestationPolicyMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *AttestationPolicyMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetName sets the "name" field.
 func (m *AttestationPolicyMutation) SetName(s string) {
 	m.name = &s
@@ -1157,7 +1862,10 @@ func (m *AttestationPolicyMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AttestationPolicyMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+	fields := make([]string, 0, 2)
+	if m.tenant != nil {
+		fields = append(fields, attestationpolicy.FieldTenant)
+	}
 	if m.name != nil {
 		fields = append(fields, attestationpolicy.FieldName)
 	}
@@ -1169,6 +1877,8 @@ func (m *AttestationPolicyMutation) Fields() []string {
 // schema.
 func (m *AttestationPolicyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case attestationpolicy.FieldTenant:
+		return m.Tenant()
 	case attestationpolicy.FieldName:
 		return m.Name()
 	}
@@ -1180,6 +1890,8 @@ func (m *AttestationPolicyMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *AttestationPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case attestationpolicy.FieldTenant:
+		return m.OldTenant(ctx)
 	case attestationpolicy.FieldName:
 		return m.OldName(ctx)
 	}
@@ -1191,6 +1903,13 @@ func (m *AttestationPolicyMutation) OldField(ctx context.Context, name string) (
 // type.
 func (m *AttestationPolicyMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case attestationpolicy.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case attestationpolicy.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -1247,6 +1966,9 @@ func (m *AttestationPolicyMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *AttestationPolicyMutation) ResetField(name string) error {
 	switch name {
+	case attestationpolicy.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case attestationpolicy.FieldName:
 		m.ResetName()
 		return nil
@@ -1328,40 +2050,53 @@ func (m *AttestationPolicyMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AttestationPolicy edge %s", name)
 }
 
-// DsseMutation represents an operation that mutates the Dsse nodes in the graph.
-type DsseMutation struct {
+// CertificateMutation represents an operation that mutates the Certificate nodes in the graph.
+type CertificateMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	created_at             *time.Time
-	gitoid_sha256          *string
-	payload_type           *string
-	clearedFields          map[string]struct{}
-	statement              *uuid.UUID
-	clearedstatement       bool
-	signatures             map[uuid.UUID]struct{}
-	removedsignatures      map[uuid.UUID]struct{}
-	clearedsignatures      bool
-	payload_digests        map[uuid.UUID]struct{}
-	removedpayload_digests map[uuid.UUID]struct{}
-	clearedpayload_digests bool
-	done                   bool
-	oldValue               func(context.Context) (*Dsse, error)
-	predicates             []predicate.Dsse
-}
-
-var _ ent.Mutation = (*DsseMutation)(nil)
-
-// dsseOption allows management of the mutation configuration using functional options.
-type dsseOption func(*DsseMutation)
-
-// newDsseMutation creates new mutation for the Dsse entity.
-func newDsseMutation(c config, op Op, opts ...dsseOption) *DsseMutation {
-	m := &DsseMutation{
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	tenant                   *string
+	chain_index              *int
+	addchain_index           *int
+	subject                  *string
+	issuer                   *string
+	serial_number            *string
+	not_before               *time.Time
+	not_after                *time.Time
+	spiffe_id                *string
+	oidc_issuer              *string
+	build_signer_uri         *string
+	build_signer_digest      *string
+	runner_environment       *string
+	source_repository_uri    *string
+	source_repository_digest *string
+	source_repository_ref    *string
+	build_config_uri         *string
+	build_trigger            *string
+	run_invocation_uri       *string
+	clearedFields            map[string]struct{}
+	subject_alt_names        map[uuid.UUID]struct{}
+	removedsubject_alt_names map[uuid.UUID]struct{}
+	clearedsubject_alt_names bool
+	signature                *uuid.UUID
+	clearedsignature         bool
+	done                     bool
+	oldValue                 func(context.Context) (*Certificate, error)
+	predicates               []predicate.Certificate
+}
+
+var _ ent.Mutation = (*CertificateMutation)(nil)
+
+// certificateOption allows management of the mutation configuration using functional options.
+type certificateOption func(*CertificateMutation)
+
+// newCertificateMutation creates new mutation for the Certificate entity.
+func newCertificateMutation(c config, op Op, opts ...certificateOption) *CertificateMutation {
+	m := &CertificateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDsse,
+		typ:           TypeCertificate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1370,20 +2105,20 @@ func newDsseMutation(c config, op Op, opts ...dsseOption) *DsseMutation {
 	return m
 }
 
-// withDsseID sets the ID field of the mutation.
-func withDsseID(id uuid.UUID) dsseOption {
-	return func(m *DsseMutation) {
+// withCertificateID sets the ID field of the mutation.
+func withCertificateID(id uuid.UUID) certificateOption {
+	return func(m *CertificateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Dsse
+			value *Certificate
 		)
-		m.oldValue = func(ctx context.Context) (*Dsse, error) {
+		m.oldValue = func(ctx context.Context) (*Certificate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Dsse.Get(ctx, id)
+					value, err = m.Client().Certificate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1392,10 +2127,10 @@ func withDsseID(id uuid.UUID) dsseOption {
 	}
 }
 
-// withDsse sets the old Dsse of the mutation.
-func withDsse(node *Dsse) dsseOption {
-	return func(m *DsseMutation) {
-		m.oldValue = func(context.Context) (*Dsse, error) {
+// withCertificate sets the old Certificate of the mutation.
+func withCertificate(node *Certificate) certificateOption {
+	return func(m *CertificateMutation) {
+		m.oldValue = func(context.Context) (*Certificate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1404,7 +2139,7 @@ func withDsse(node *Dsse) dsseOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DsseMutation) Client() *Client {
+func (m CertificateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1412,7 +2147,7 @@ func (m DsseMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DsseMutation) Tx() (*Tx, error) {
+func (m CertificateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1422,14 +2157,14 @@ func (m DsseMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Dsse entities.
-func (m *DsseMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Certificate entities.
+func (m *CertificateMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DsseMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CertificateMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1440,7 +2175,7 @@ func (m *DsseMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DsseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CertificateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1449,289 +2184,16733 @@ func (m *DsseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Dsse.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Certificate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DsseMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTenant sets the "tenant" field.
+func (m *CertificateMutation) SetTenant(s string) {
+	m.tenant = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DsseMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *CertificateMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Dsse entity.
-// If the Dsse object wasn't provided to the builder, the object is fetched from the database.
+// OldTenant returns the old "tenant" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DsseMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *CertificateMutation) OldTenant(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTenant requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Tenant, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *DsseMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[dsse.FieldCreatedAt] = struct{}{}
+// ResetTenant resets all changes to the "tenant" field.
+func (m *CertificateMutation) ResetTenant() {
+	m.tenant = nil
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *DsseMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[dsse.FieldCreatedAt]
-	return ok
+// SetChainIndex sets the "chain_index" field.
+func (m *CertificateMutation) SetChainIndex(i int) {
+	m.chain_index = &i
+	m.addchain_index = nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DsseMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, dsse.FieldCreatedAt)
+// ChainIndex returns the value of the "chain_index" field in the mutation.
+func (m *CertificateMutation) ChainIndex() (r int, exists bool) {
+	v := m.chain_index
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetGitoidSha256 sets the "gitoid_sha256" field.
-func (m *DsseMutation) SetGitoidSha256(s string) {
-	m.gitoid_sha256 = &s
+// OldChainIndex returns the old "chain_index" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldChainIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChainIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChainIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChainIndex: %w", err)
+	}
+	return oldValue.ChainIndex, nil
 }
 
-// GitoidSha256 returns the value of the "gitoid_sha256" field in the mutation.
-func (m *DsseMutation) GitoidSha256() (r string, exists bool) {
-	v := m.gitoid_sha256
+// AddChainIndex adds i to the "chain_index" field.
+func (m *CertificateMutation) AddChainIndex(i int) {
+	if m.addchain_index != nil {
+		*m.addchain_index += i
+	} else {
+		m.addchain_index = &i
+	}
+}
+
+// AddedChainIndex returns the value that was added to the "chain_index" field in this mutation.
+func (m *CertificateMutation) AddedChainIndex() (r int, exists bool) {
+	v := m.addchain_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGitoidSha256 returns the old "gitoid_sha256" field's value of the Dsse entity.
-// If the Dsse object wasn't provided to the builder, the object is fetched from the database.
+// ResetChainIndex resets all changes to the "chain_index" field.
+func (m *CertificateMutation) ResetChainIndex() {
+	m.chain_index = nil
+	m.addchain_index = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *CertificateMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *CertificateMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DsseMutation) OldGitoidSha256(ctx context.Context) (v string, err error) {
+func (m *CertificateMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGitoidSha256 is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGitoidSha256 requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGitoidSha256: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.GitoidSha256, nil
+	return oldValue.Subject, nil
 }
 
-// ResetGitoidSha256 resets all changes to the "gitoid_sha256" field.
-func (m *DsseMutation) ResetGitoidSha256() {
-	m.gitoid_sha256 = nil
+// ResetSubject resets all changes to the "subject" field.
+func (m *CertificateMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// SetPayloadType sets the "payload_type" field.
-func (m *DsseMutation) SetPayloadType(s string) {
-	m.payload_type = &s
+// SetIssuer sets the "issuer" field.
+func (m *CertificateMutation) SetIssuer(s string) {
+	m.issuer = &s
 }
 
-// PayloadType returns the value of the "payload_type" field in the mutation.
-func (m *DsseMutation) PayloadType() (r string, exists bool) {
-	v := m.payload_type
+// Issuer returns the value of the "issuer" field in the mutation.
+func (m *CertificateMutation) Issuer() (r string, exists bool) {
+	v := m.issuer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPayloadType returns the old "payload_type" field's value of the Dsse entity.
-// If the Dsse object wasn't provided to the builder, the object is fetched from the database.
+// OldIssuer returns the old "issuer" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DsseMutation) OldPayloadType(ctx context.Context) (v string, err error) {
+func (m *CertificateMutation) OldIssuer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayloadType is only allowed on UpdateOne operations")
+		return v, errors.New("OldIssuer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayloadType requires an ID field in the mutation")
+		return v, errors.New("OldIssuer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayloadType: %w", err)
+		return v, fmt.Errorf("querying old value for OldIssuer: %w", err)
 	}
-	return oldValue.PayloadType, nil
+	return oldValue.Issuer, nil
 }
 
-// ResetPayloadType resets all changes to the "payload_type" field.
-func (m *DsseMutation) ResetPayloadType() {
-	m.payload_type = nil
+// ResetIssuer resets all changes to the "issuer" field.
+func (m *CertificateMutation) ResetIssuer() {
+	m.issuer = nil
 }
 
-// SetStatementID sets the "statement" edge to the Statement entity by id.
-func (m *DsseMutation) SetStatementID(id uuid.UUID) {
-	m.statement = &id
+// SetSerialNumber sets the "serial_number" field.
+func (m *CertificateMutation) SetSerialNumber(s string) {
+	m.serial_number = &s
 }
 
-// ClearStatement clears the "statement" edge to the Statement entity.
-func (m *DsseMutation) ClearStatement() {
-	m.clearedstatement = true
+// SerialNumber returns the value of the "serial_number" field in the mutation.
+func (m *CertificateMutation) SerialNumber() (r string, exists bool) {
+	v := m.serial_number
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// StatementCleared reports if the "statement" edge to the Statement entity was cleared.
-func (m *DsseMutation) StatementCleared() bool {
-	return m.clearedstatement
+// OldSerialNumber returns the old "serial_number" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSerialNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSerialNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSerialNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSerialNumber: %w", err)
+	}
+	return oldValue.SerialNumber, nil
 }
 
-// StatementID returns the "statement" edge ID in the mutation.
-func (m *DsseMutation) StatementID() (id uuid.UUID, exists bool) {
-	if m.statement != nil {
-		return *m.statement, true
-	}
-	return
+// ResetSerialNumber resets all changes to the "serial_number" field.
+func (m *CertificateMutation) ResetSerialNumber() {
+	m.serial_number = nil
 }
 
-// StatementIDs returns the "statement" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StatementID instead. It exists only for internal usage by the builders.
-func (m *DsseMutation) StatementIDs() (ids []uuid.UUID) {
-	if id := m.statement; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetNotBefore sets the "not_before" field.
+func (m *CertificateMutation) SetNotBefore(t time.Time) {
+	m.not_before = &t
 }
 
-// ResetStatement resets all changes to the "statement" edge.
-func (m *DsseMutation) ResetStatement() {
-	m.statement = nil
-	m.clearedstatement = false
+// NotBefore returns the value of the "not_before" field in the mutation.
+func (m *CertificateMutation) NotBefore() (r time.Time, exists bool) {
+	v := m.not_before
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddSignatureIDs adds the "signatures" edge to the Signature entity by ids.
-func (m *DsseMutation) AddSignatureIDs(ids ...uuid.UUID) {
-	if m.signatures == nil {
-		m.signatures = make(map[uuid.UUID]struct{})
+// OldNotBefore returns the old "not_before" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldNotBefore(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotBefore is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.signatures[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotBefore requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotBefore: %w", err)
+	}
+	return oldValue.NotBefore, nil
 }
 
-// ClearSignatures clears the "signatures" edge to the Signature entity.
-func (m *DsseMutation) ClearSignatures() {
-	m.clearedsignatures = true
+// ResetNotBefore resets all changes to the "not_before" field.
+func (m *CertificateMutation) ResetNotBefore() {
+	m.not_before = nil
 }
 
-// SignaturesCleared reports if the "signatures" edge to the Signature entity was cleared.
-func (m *DsseMutation) SignaturesCleared() bool {
-	return m.clearedsignatures
+// SetNotAfter sets the "not_after" field.
+func (m *CertificateMutation) SetNotAfter(t time.Time) {
+	m.not_after = &t
 }
 
-// RemoveSignatureIDs removes the "signatures" edge to the Signature entity by IDs.
-func (m *DsseMutation) RemoveSignatureIDs(ids ...uuid.UUID) {
-	if m.removedsignatures == nil {
-		m.removedsignatures = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.signatures, ids[i])
-		m.removedsignatures[ids[i]] = struct{}{}
+// NotAfter returns the value of the "not_after" field in the mutation.
+func (m *CertificateMutation) NotAfter() (r time.Time, exists bool) {
+	v := m.not_after
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedSignatures returns the removed IDs of the "signatures" edge to the Signature entity.
-func (m *DsseMutation) RemovedSignaturesIDs() (ids []uuid.UUID) {
-	for id := range m.removedsignatures {
-		ids = append(ids, id)
+// OldNotAfter returns the old "not_after" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldNotAfter(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotAfter: %w", err)
+	}
+	return oldValue.NotAfter, nil
+}
+
+// ResetNotAfter resets all changes to the "not_after" field.
+func (m *CertificateMutation) ResetNotAfter() {
+	m.not_after = nil
+}
+
+// SetSpiffeID sets the "spiffe_id" field.
+func (m *CertificateMutation) SetSpiffeID(s string) {
+	m.spiffe_id = &s
+}
+
+// SpiffeID returns the value of the "spiffe_id" field in the mutation.
+func (m *CertificateMutation) SpiffeID() (r string, exists bool) {
+	v := m.spiffe_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpiffeID returns the old "spiffe_id" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSpiffeID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpiffeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpiffeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpiffeID: %w", err)
+	}
+	return oldValue.SpiffeID, nil
+}
+
+// ClearSpiffeID clears the value of the "spiffe_id" field.
+func (m *CertificateMutation) ClearSpiffeID() {
+	m.spiffe_id = nil
+	m.clearedFields[certificate.FieldSpiffeID] = struct{}{}
+}
+
+// SpiffeIDCleared returns if the "spiffe_id" field was cleared in this mutation.
+func (m *CertificateMutation) SpiffeIDCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSpiffeID]
+	return ok
+}
+
+// ResetSpiffeID resets all changes to the "spiffe_id" field.
+func (m *CertificateMutation) ResetSpiffeID() {
+	m.spiffe_id = nil
+	delete(m.clearedFields, certificate.FieldSpiffeID)
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (m *CertificateMutation) SetOidcIssuer(s string) {
+	m.oidc_issuer = &s
+}
+
+// OidcIssuer returns the value of the "oidc_issuer" field in the mutation.
+func (m *CertificateMutation) OidcIssuer() (r string, exists bool) {
+	v := m.oidc_issuer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOidcIssuer returns the old "oidc_issuer" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldOidcIssuer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOidcIssuer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOidcIssuer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOidcIssuer: %w", err)
+	}
+	return oldValue.OidcIssuer, nil
+}
+
+// ClearOidcIssuer clears the value of the "oidc_issuer" field.
+func (m *CertificateMutation) ClearOidcIssuer() {
+	m.oidc_issuer = nil
+	m.clearedFields[certificate.FieldOidcIssuer] = struct{}{}
+}
+
+// OidcIssuerCleared returns if the "oidc_issuer" field was cleared in this mutation.
+func (m *CertificateMutation) OidcIssuerCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldOidcIssuer]
+	return ok
+}
+
+// ResetOidcIssuer resets all changes to the "oidc_issuer" field.
+func (m *CertificateMutation) ResetOidcIssuer() {
+	m.oidc_issuer = nil
+	delete(m.clearedFields, certificate.FieldOidcIssuer)
+}
+
+// SetBuildSignerURI sets the "build_signer_uri" field.
+func (m *CertificateMutation) SetBuildSignerURI(s string) {
+	m.build_signer_uri = &s
+}
+
+// BuildSignerURI returns the value of the "build_signer_uri" field in the mutation.
+func (m *CertificateMutation) BuildSignerURI() (r string, exists bool) {
+	v := m.build_signer_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildSignerURI returns the old "build_signer_uri" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldBuildSignerURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildSignerURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildSignerURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildSignerURI: %w", err)
+	}
+	return oldValue.BuildSignerURI, nil
+}
+
+// ClearBuildSignerURI clears the value of the "build_signer_uri" field.
+func (m *CertificateMutation) ClearBuildSignerURI() {
+	m.build_signer_uri = nil
+	m.clearedFields[certificate.FieldBuildSignerURI] = struct{}{}
+}
+
+// BuildSignerURICleared returns if the "build_signer_uri" field was cleared in this mutation.
+func (m *CertificateMutation) BuildSignerURICleared() bool {
+	_, ok := m.clearedFields[certificate.FieldBuildSignerURI]
+	return ok
+}
+
+// ResetBuildSignerURI resets all changes to the "build_signer_uri" field.
+func (m *CertificateMutation) ResetBuildSignerURI() {
+	m.build_signer_uri = nil
+	delete(m.clearedFields, certificate.FieldBuildSignerURI)
+}
+
+// SetBuildSignerDigest sets the "build_signer_digest" field.
+func (m *CertificateMutation) SetBuildSignerDigest(s string) {
+	m.build_signer_digest = &s
+}
+
+// BuildSignerDigest returns the value of the "build_signer_digest" field in the mutation.
+func (m *CertificateMutation) BuildSignerDigest() (r string, exists bool) {
+	v := m.build_signer_digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildSignerDigest returns the old "build_signer_digest" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldBuildSignerDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildSignerDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildSignerDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildSignerDigest: %w", err)
+	}
+	return oldValue.BuildSignerDigest, nil
+}
+
+// ClearBuildSignerDigest clears the value of the "build_signer_digest" field.
+func (m *CertificateMutation) ClearBuildSignerDigest() {
+	m.build_signer_digest = nil
+	m.clearedFields[certificate.FieldBuildSignerDigest] = struct{}{}
+}
+
+// BuildSignerDigestCleared returns if the "build_signer_digest" field was cleared in this mutation.
+func (m *CertificateMutation) BuildSignerDigestCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldBuildSignerDigest]
+	return ok
+}
+
+// ResetBuildSignerDigest resets all changes to the "build_signer_digest" field.
+func (m *CertificateMutation) ResetBuildSignerDigest() {
+	m.build_signer_digest = nil
+	delete(m.clearedFields, certificate.FieldBuildSignerDigest)
+}
+
+// SetRunnerEnvironment sets the "runner_environment" field.
+func (m *CertificateMutation) SetRunnerEnvironment(s string) {
+	m.runner_environment = &s
+}
+
+// RunnerEnvironment returns the value of the "runner_environment" field in the mutation.
+func (m *CertificateMutation) RunnerEnvironment() (r string, exists bool) {
+	v := m.runner_environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunnerEnvironment returns the old "runner_environment" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldRunnerEnvironment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunnerEnvironment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunnerEnvironment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunnerEnvironment: %w", err)
+	}
+	return oldValue.RunnerEnvironment, nil
+}
+
+// ClearRunnerEnvironment clears the value of the "runner_environment" field.
+func (m *CertificateMutation) ClearRunnerEnvironment() {
+	m.runner_environment = nil
+	m.clearedFields[certificate.FieldRunnerEnvironment] = struct{}{}
+}
+
+// RunnerEnvironmentCleared returns if the "runner_environment" field was cleared in this mutation.
+func (m *CertificateMutation) RunnerEnvironmentCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldRunnerEnvironment]
+	return ok
+}
+
+// ResetRunnerEnvironment resets all changes to the "runner_environment" field.
+func (m *CertificateMutation) ResetRunnerEnvironment() {
+	m.runner_environment = nil
+	delete(m.clearedFields, certificate.FieldRunnerEnvironment)
+}
+
+// SetSourceRepositoryURI sets the "source_repository_uri" field.
+func (m *CertificateMutation) SetSourceRepositoryURI(s string) {
+	m.source_repository_uri = &s
+}
+
+// SourceRepositoryURI returns the value of the "source_repository_uri" field in the mutation.
+func (m *CertificateMutation) SourceRepositoryURI() (r string, exists bool) {
+	v := m.source_repository_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceRepositoryURI returns the old "source_repository_uri" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSourceRepositoryURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceRepositoryURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceRepositoryURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceRepositoryURI: %w", err)
+	}
+	return oldValue.SourceRepositoryURI, nil
+}
+
+// ClearSourceRepositoryURI clears the value of the "source_repository_uri" field.
+func (m *CertificateMutation) ClearSourceRepositoryURI() {
+	m.source_repository_uri = nil
+	m.clearedFields[certificate.FieldSourceRepositoryURI] = struct{}{}
+}
+
+// SourceRepositoryURICleared returns if the "source_repository_uri" field was cleared in this mutation.
+func (m *CertificateMutation) SourceRepositoryURICleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSourceRepositoryURI]
+	return ok
+}
+
+// ResetSourceRepositoryURI resets all changes to the "source_repository_uri" field.
+func (m *CertificateMutation) ResetSourceRepositoryURI() {
+	m.source_repository_uri = nil
+	delete(m.clearedFields, certificate.FieldSourceRepositoryURI)
+}
+
+// SetSourceRepositoryDigest sets the "source_repository_digest" field.
+func (m *CertificateMutation) SetSourceRepositoryDigest(s string) {
+	m.source_repository_digest = &s
+}
+
+// SourceRepositoryDigest returns the value of the "source_repository_digest" field in the mutation.
+func (m *CertificateMutation) SourceRepositoryDigest() (r string, exists bool) {
+	v := m.source_repository_digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceRepositoryDigest returns the old "source_repository_digest" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSourceRepositoryDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceRepositoryDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceRepositoryDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceRepositoryDigest: %w", err)
+	}
+	return oldValue.SourceRepositoryDigest, nil
+}
+
+// ClearSourceRepositoryDigest clears the value of the "source_repository_digest" field.
+func (m *CertificateMutation) ClearSourceRepositoryDigest() {
+	m.source_repository_digest = nil
+	m.clearedFields[certificate.FieldSourceRepositoryDigest] = struct{}{}
+}
+
+// SourceRepositoryDigestCleared returns if the "source_repository_digest" field was cleared in this mutation.
+func (m *CertificateMutation) SourceRepositoryDigestCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSourceRepositoryDigest]
+	return ok
+}
+
+// ResetSourceRepositoryDigest resets all changes to the "source_repository_digest" field.
+func (m *CertificateMutation) ResetSourceRepositoryDigest() {
+	m.source_repository_digest = nil
+	delete(m.clearedFields, certificate.FieldSourceRepositoryDigest)
+}
+
+// SetSourceRepositoryRef sets the "source_repository_ref" field.
+func (m *CertificateMutation) SetSourceRepositoryRef(s string) {
+	m.source_repository_ref = &s
+}
+
+// SourceRepositoryRef returns the value of the "source_repository_ref" field in the mutation.
+func (m *CertificateMutation) SourceRepositoryRef() (r string, exists bool) {
+	v := m.source_repository_ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceRepositoryRef returns the old "source_repository_ref" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSourceRepositoryRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceRepositoryRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceRepositoryRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceRepositoryRef: %w", err)
+	}
+	return oldValue.SourceRepositoryRef, nil
+}
+
+// ClearSourceRepositoryRef clears the value of the "source_repository_ref" field.
+func (m *CertificateMutation) ClearSourceRepositoryRef() {
+	m.source_repository_ref = nil
+	m.clearedFields[certificate.FieldSourceRepositoryRef] = struct{}{}
+}
+
+// SourceRepositoryRefCleared returns if the "source_repository_ref" field was cleared in this mutation.
+func (m *CertificateMutation) SourceRepositoryRefCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSourceRepositoryRef]
+	return ok
+}
+
+// ResetSourceRepositoryRef resets all changes to the "source_repository_ref" field.
+func (m *CertificateMutation) ResetSourceRepositoryRef() {
+	m.source_repository_ref = nil
+	delete(m.clearedFields, certificate.FieldSourceRepositoryRef)
+}
+
+// SetBuildConfigURI sets the "build_config_uri" field.
+func (m *CertificateMutation) SetBuildConfigURI(s string) {
+	m.build_config_uri = &s
+}
+
+// BuildConfigURI returns the value of the "build_config_uri" field in the mutation.
+func (m *CertificateMutation) BuildConfigURI() (r string, exists bool) {
+	v := m.build_config_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildConfigURI returns the old "build_config_uri" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldBuildConfigURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildConfigURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildConfigURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildConfigURI: %w", err)
+	}
+	return oldValue.BuildConfigURI, nil
+}
+
+// ClearBuildConfigURI clears the value of the "build_config_uri" field.
+func (m *CertificateMutation) ClearBuildConfigURI() {
+	m.build_config_uri = nil
+	m.clearedFields[certificate.FieldBuildConfigURI] = struct{}{}
+}
+
+// BuildConfigURICleared returns if the "build_config_uri" field was cleared in this mutation.
+func (m *CertificateMutation) BuildConfigURICleared() bool {
+	_, ok := m.clearedFields[certificate.FieldBuildConfigURI]
+	return ok
+}
+
+// ResetBuildConfigURI resets all changes to the "build_config_uri" field.
+func (m *CertificateMutation) ResetBuildConfigURI() {
+	m.build_config_uri = nil
+	delete(m.clearedFields, certificate.FieldBuildConfigURI)
+}
+
+// SetBuildTrigger sets the "build_trigger" field.
+func (m *CertificateMutation) SetBuildTrigger(s string) {
+	m.build_trigger = &s
+}
+
+// BuildTrigger returns the value of the "build_trigger" field in the mutation.
+func (m *CertificateMutation) BuildTrigger() (r string, exists bool) {
+	v := m.build_trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildTrigger returns the old "build_trigger" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldBuildTrigger(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildTrigger: %w", err)
+	}
+	return oldValue.BuildTrigger, nil
+}
+
+// ClearBuildTrigger clears the value of the "build_trigger" field.
+func (m *CertificateMutation) ClearBuildTrigger() {
+	m.build_trigger = nil
+	m.clearedFields[certificate.FieldBuildTrigger] = struct{}{}
+}
+
+// BuildTriggerCleared returns if the "build_trigger" field was cleared in this mutation.
+func (m *CertificateMutation) BuildTriggerCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldBuildTrigger]
+	return ok
+}
+
+// ResetBuildTrigger resets all changes to the "build_trigger" field.
+func (m *CertificateMutation) ResetBuildTrigger() {
+	m.build_trigger = nil
+	delete(m.clearedFields, certificate.FieldBuildTrigger)
+}
+
+// SetRunInvocationURI sets the "run_invocation_uri" field.
+func (m *CertificateMutation) SetRunInvocationURI(s string) {
+	m.run_invocation_uri = &s
+}
+
+// RunInvocationURI returns the value of the "run_invocation_uri" field in the mutation.
+func (m *CertificateMutation) RunInvocationURI() (r string, exists bool) {
+	v := m.run_invocation_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunInvocationURI returns the old "run_invocation_uri" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldRunInvocationURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunInvocationURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunInvocationURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunInvocationURI: %w", err)
+	}
+	return oldValue.RunInvocationURI, nil
+}
+
+// ClearRunInvocationURI clears the value of the "run_invocation_uri" field.
+func (m *CertificateMutation) ClearRunInvocationURI() {
+	m.run_invocation_uri = nil
+	m.clearedFields[certificate.FieldRunInvocationURI] = struct{}{}
+}
+
+// RunInvocationURICleared returns if the "run_invocation_uri" field was cleared in this mutation.
+func (m *CertificateMutation) RunInvocationURICleared() bool {
+	_, ok := m.clearedFields[certificate.FieldRunInvocationURI]
+	return ok
+}
+
+// ResetRunInvocationURI resets all changes to the "run_invocation_uri" field.
+func (m *CertificateMutation) ResetRunInvocationURI() {
+	m.run_invocation_uri = nil
+	delete(m.clearedFields, certificate.FieldRunInvocationURI)
+}
+
+// AddSubjectAltNameIDs adds the "subject_alt_names" edge to the SubjectAltName entity by ids.
+func (m *CertificateMutation) AddSubjectAltNameIDs(ids ...uuid.UUID) {
+	if m.subject_alt_names == nil {
+		m.subject_alt_names = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.subject_alt_names[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSubjectAltNames clears the "subject_alt_names" edge to the SubjectAltName entity.
+func (m *CertificateMutation) ClearSubjectAltNames() {
+	m.clearedsubject_alt_names = true
+}
+
+// SubjectAltNamesCleared reports if the "subject_alt_names" edge to the SubjectAltName entity was cleared.
+func (m *CertificateMutation) SubjectAltNamesCleared() bool {
+	return m.clearedsubject_alt_names
+}
+
+// RemoveSubjectAltNameIDs removes the "subject_alt_names" edge to the SubjectAltName entity by IDs.
+func (m *CertificateMutation) RemoveSubjectAltNameIDs(ids ...uuid.UUID) {
+	if m.removedsubject_alt_names == nil {
+		m.removedsubject_alt_names = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.subject_alt_names, ids[i])
+		m.removedsubject_alt_names[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubjectAltNames returns the removed IDs of the "subject_alt_names" edge to the SubjectAltName entity.
+func (m *CertificateMutation) RemovedSubjectAltNamesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsubject_alt_names {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SubjectAltNamesIDs returns the "subject_alt_names" edge IDs in the mutation.
+func (m *CertificateMutation) SubjectAltNamesIDs() (ids []uuid.UUID) {
+	for id := range m.subject_alt_names {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSubjectAltNames resets all changes to the "subject_alt_names" edge.
+func (m *CertificateMutation) ResetSubjectAltNames() {
+	m.subject_alt_names = nil
+	m.clearedsubject_alt_names = false
+	m.removedsubject_alt_names = nil
+}
+
+// SetSignatureID sets the "signature" edge to the Signature entity by id.
+func (m *CertificateMutation) SetSignatureID(id uuid.UUID) {
+	m.signature = &id
+}
+
+// ClearSignature clears the "signature" edge to the Signature entity.
+func (m *CertificateMutation) ClearSignature() {
+	m.clearedsignature = true
+}
+
+// SignatureCleared reports if the "signature" edge to the Signature entity was cleared.
+func (m *CertificateMutation) SignatureCleared() bool {
+	return m.clearedsignature
+}
+
+// SignatureID returns the "signature" edge ID in the mutation.
+func (m *CertificateMutation) SignatureID() (id uuid.UUID, exists bool) {
+	if m.signature != nil {
+		return *m.signature, true
+	}
+	return
+}
+
+// SignatureIDs returns the "signature" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SignatureID instead. It exists only for internal usage by the builders.
+func (m *CertificateMutation) SignatureIDs() (ids []uuid.UUID) {
+	if id := m.signature; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSignature resets all changes to the "signature" edge.
+func (m *CertificateMutation) ResetSignature() {
+	m.signature = nil
+	m.clearedsignature = false
+}
+
+// Where appends a list predicates to the CertificateMutation builder.
+func (m *CertificateMutation) Where(ps ...predicate.Certificate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CertificateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CertificateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Certificate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CertificateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CertificateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Certificate).
+func (m *CertificateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CertificateMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.tenant != nil {
+		fields = append(fields, certificate.FieldTenant)
+	}
+	if m.chain_index != nil {
+		fields = append(fields, certificate.FieldChainIndex)
+	}
+	if m.subject != nil {
+		fields = append(fields, certificate.FieldSubject)
+	}
+	if m.issuer != nil {
+		fields = append(fields, certificate.FieldIssuer)
+	}
+	if m.serial_number != nil {
+		fields = append(fields, certificate.FieldSerialNumber)
+	}
+	if m.not_before != nil {
+		fields = append(fields, certificate.FieldNotBefore)
+	}
+	if m.not_after != nil {
+		fields = append(fields, certificate.FieldNotAfter)
+	}
+	if m.spiffe_id != nil {
+		fields = append(fields, certificate.FieldSpiffeID)
+	}
+	if m.oidc_issuer != nil {
+		fields = append(fields, certificate.FieldOidcIssuer)
+	}
+	if m.build_signer_uri != nil {
+		fields = append(fields, certificate.FieldBuildSignerURI)
+	}
+	if m.build_signer_digest != nil {
+		fields = append(fields, certificate.FieldBuildSignerDigest)
+	}
+	if m.runner_environment != nil {
+		fields = append(fields, certificate.FieldRunnerEnvironment)
+	}
+	if m.source_repository_uri != nil {
+		fields = append(fields, certificate.FieldSourceRepositoryURI)
+	}
+	if m.source_repository_digest != nil {
+		fields = append(fields, certificate.FieldSourceRepositoryDigest)
+	}
+	if m.source_repository_ref != nil {
+		fields = append(fields, certificate.FieldSourceRepositoryRef)
+	}
+	if m.build_config_uri != nil {
+		fields = append(fields, certificate.FieldBuildConfigURI)
+	}
+	if m.build_trigger != nil {
+		fields = append(fields, certificate.FieldBuildTrigger)
+	}
+	if m.run_invocation_uri != nil {
+		fields = append(fields, certificate.FieldRunInvocationURI)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CertificateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case certificate.FieldTenant:
+		return m.Tenant()
+	case certificate.FieldChainIndex:
+		return m.ChainIndex()
+	case certificate.FieldSubject:
+		return m.Subject()
+	case certificate.FieldIssuer:
+		return m.Issuer()
+	case certificate.FieldSerialNumber:
+		return m.SerialNumber()
+	case certificate.FieldNotBefore:
+		return m.NotBefore()
+	case certificate.FieldNotAfter:
+		return m.NotAfter()
+	case certificate.FieldSpiffeID:
+		return m.SpiffeID()
+	case certificate.FieldOidcIssuer:
+		return m.OidcIssuer()
+	case certificate.FieldBuildSignerURI:
+		return m.BuildSignerURI()
+	case certificate.FieldBuildSignerDigest:
+		return m.BuildSignerDigest()
+	case certificate.FieldRunnerEnvironment:
+		return m.RunnerEnvironment()
+	case certificate.FieldSourceRepositoryURI:
+		return m.SourceRepositoryURI()
+	case certificate.FieldSourceRepositoryDigest:
+		return m.SourceRepositoryDigest()
+	case certificate.FieldSourceRepositoryRef:
+		return m.SourceRepositoryRef()
+	case certificate.FieldBuildConfigURI:
+		return m.BuildConfigURI()
+	case certificate.FieldBuildTrigger:
+		return m.BuildTrigger()
+	case certificate.FieldRunInvocationURI:
+		return m.RunInvocationURI()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CertificateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case certificate.FieldTenant:
+		return m.OldTenant(ctx)
+	case certificate.FieldChainIndex:
+		return m.OldChainIndex(ctx)
+	case certificate.FieldSubject:
+		return m.OldSubject(ctx)
+	case certificate.FieldIssuer:
+		return m.OldIssuer(ctx)
+	case certificate.FieldSerialNumber:
+		return m.OldSerialNumber(ctx)
+	case certificate.FieldNotBefore:
+		return m.OldNotBefore(ctx)
+	case certificate.FieldNotAfter:
+		return m.OldNotAfter(ctx)
+	case certificate.FieldSpiffeID:
+		return m.OldSpiffeID(ctx)
+	case certificate.FieldOidcIssuer:
+		return m.OldOidcIssuer(ctx)
+	case certificate.FieldBuildSignerURI:
+		return m.OldBuildSignerURI(ctx)
+	case certificate.FieldBuildSignerDigest:
+		return m.OldBuildSignerDigest(ctx)
+	case certificate.FieldRunnerEnvironment:
+		return m.OldRunnerEnvironment(ctx)
+	case certificate.FieldSourceRepositoryURI:
+		return m.OldSourceRepositoryURI(ctx)
+	case certificate.FieldSourceRepositoryDigest:
+		return m.OldSourceRepositoryDigest(ctx)
+	case certificate.FieldSourceRepositoryRef:
+		return m.OldSourceRepositoryRef(ctx)
+	case certificate.FieldBuildConfigURI:
+		return m.OldBuildConfigURI(ctx)
+	case certificate.FieldBuildTrigger:
+		return m.OldBuildTrigger(ctx)
+	case certificate.FieldRunInvocationURI:
+		return m.OldRunInvocationURI(ctx)
+	}
+	return nil, fmt.Errorf("unknown Certificate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertificateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case certificate.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case certificate.FieldChainIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChainIndex(v)
+		return nil
+	case certificate.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case certificate.FieldIssuer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuer(v)
+		return nil
+	case certificate.FieldSerialNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSerialNumber(v)
+		return nil
+	case certificate.FieldNotBefore:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotBefore(v)
+		return nil
+	case certificate.FieldNotAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotAfter(v)
+		return nil
+	case certificate.FieldSpiffeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpiffeID(v)
+		return nil
+	case certificate.FieldOidcIssuer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOidcIssuer(v)
+		return nil
+	case certificate.FieldBuildSignerURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildSignerURI(v)
+		return nil
+	case certificate.FieldBuildSignerDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildSignerDigest(v)
+		return nil
+	case certificate.FieldRunnerEnvironment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunnerEnvironment(v)
+		return nil
+	case certificate.FieldSourceRepositoryURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceRepositoryURI(v)
+		return nil
+	case certificate.FieldSourceRepositoryDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceRepositoryDigest(v)
+		return nil
+	case certificate.FieldSourceRepositoryRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceRepositoryRef(v)
+		return nil
+	case certificate.FieldBuildConfigURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildConfigURI(v)
+		return nil
+	case certificate.FieldBuildTrigger:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildTrigger(v)
+		return nil
+	case certificate.FieldRunInvocationURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunInvocationURI(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertificateMutation) AddedFields() []string {
+	var fields []string
+	if m.addchain_index != nil {
+		fields = append(fields, certificate.FieldChainIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertificateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case certificate.FieldChainIndex:
+		return m.AddedChainIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertificateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case certificate.FieldChainIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChainIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertificateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(certificate.FieldSpiffeID) {
+		fields = append(fields, certificate.FieldSpiffeID)
+	}
+	if m.FieldCleared(certificate.FieldOidcIssuer) {
+		fields = append(fields, certificate.FieldOidcIssuer)
+	}
+	if m.FieldCleared(certificate.FieldBuildSignerURI) {
+		fields = append(fields, certificate.FieldBuildSignerURI)
+	}
+	if m.FieldCleared(certificate.FieldBuildSignerDigest) {
+		fields = append(fields, certificate.FieldBuildSignerDigest)
+	}
+	if m.FieldCleared(certificate.FieldRunnerEnvironment) {
+		fields = append(fields, certificate.FieldRunnerEnvironment)
+	}
+	if m.FieldCleared(certificate.FieldSourceRepositoryURI) {
+		fields = append(fields, certificate.FieldSourceRepositoryURI)
+	}
+	if m.FieldCleared(certificate.FieldSourceRepositoryDigest) {
+		fields = append(fields, certificate.FieldSourceRepositoryDigest)
+	}
+	if m.FieldCleared(certificate.FieldSourceRepositoryRef) {
+		fields = append(fields, certificate.FieldSourceRepositoryRef)
+	}
+	if m.FieldCleared(certificate.FieldBuildConfigURI) {
+		fields = append(fields, certificate.FieldBuildConfigURI)
+	}
+	if m.FieldCleared(certificate.FieldBuildTrigger) {
+		fields = append(fields, certificate.FieldBuildTrigger)
+	}
+	if m.FieldCleared(certificate.FieldRunInvocationURI) {
+		fields = append(fields, certificate.FieldRunInvocationURI)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertificateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertificateMutation) ClearField(name string) error {
+	switch name {
+	case certificate.FieldSpiffeID:
+		m.ClearSpiffeID()
+		return nil
+	case certificate.FieldOidcIssuer:
+		m.ClearOidcIssuer()
+		return nil
+	case certificate.FieldBuildSignerURI:
+		m.ClearBuildSignerURI()
+		return nil
+	case certificate.FieldBuildSignerDigest:
+		m.ClearBuildSignerDigest()
+		return nil
+	case certificate.FieldRunnerEnvironment:
+		m.ClearRunnerEnvironment()
+		return nil
+	case certificate.FieldSourceRepositoryURI:
+		m.ClearSourceRepositoryURI()
+		return nil
+	case certificate.FieldSourceRepositoryDigest:
+		m.ClearSourceRepositoryDigest()
+		return nil
+	case certificate.FieldSourceRepositoryRef:
+		m.ClearSourceRepositoryRef()
+		return nil
+	case certificate.FieldBuildConfigURI:
+		m.ClearBuildConfigURI()
+		return nil
+	case certificate.FieldBuildTrigger:
+		m.ClearBuildTrigger()
+		return nil
+	case certificate.FieldRunInvocationURI:
+		m.ClearRunInvocationURI()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertificateMutation) ResetField(name string) error {
+	switch name {
+	case certificate.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case certificate.FieldChainIndex:
+		m.ResetChainIndex()
+		return nil
+	case certificate.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case certificate.FieldIssuer:
+		m.ResetIssuer()
+		return nil
+	case certificate.FieldSerialNumber:
+		m.ResetSerialNumber()
+		return nil
+	case certificate.FieldNotBefore:
+		m.ResetNotBefore()
+		return nil
+	case certificate.FieldNotAfter:
+		m.ResetNotAfter()
+		return nil
+	case certificate.FieldSpiffeID:
+		m.ResetSpiffeID()
+		return nil
+	case certificate.FieldOidcIssuer:
+		m.ResetOidcIssuer()
+		return nil
+	case certificate.FieldBuildSignerURI:
+		m.ResetBuildSignerURI()
+		return nil
+	case certificate.FieldBuildSignerDigest:
+		m.ResetBuildSignerDigest()
+		return nil
+	case certificate.FieldRunnerEnvironment:
+		m.ResetRunnerEnvironment()
+		return nil
+	case certificate.FieldSourceRepositoryURI:
+		m.ResetSourceRepositoryURI()
+		return nil
+	case certificate.FieldSourceRepositoryDigest:
+		m.ResetSourceRepositoryDigest()
+		return nil
+	case certificate.FieldSourceRepositoryRef:
+		m.ResetSourceRepositoryRef()
+		return nil
+	case certificate.FieldBuildConfigURI:
+		m.ResetBuildConfigURI()
+		return nil
+	case certificate.FieldBuildTrigger:
+		m.ResetBuildTrigger()
+		return nil
+	case certificate.FieldRunInvocationURI:
+		m.ResetRunInvocationURI()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertificateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.subject_alt_names != nil {
+		edges = append(edges, certificate.EdgeSubjectAltNames)
+	}
+	if m.signature != nil {
+		edges = append(edges, certificate.EdgeSignature)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertificateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case certificate.EdgeSubjectAltNames:
+		ids := make([]ent.Value, 0, len(m.subject_alt_names))
+		for id := range m.subject_alt_names {
+			ids = append(ids, id)
+		}
+		return ids
+	case certificate.EdgeSignature:
+		if id := m.signature; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertificateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedsubject_alt_names != nil {
+		edges = append(edges, certificate.EdgeSubjectAltNames)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertificateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case certificate.EdgeSubjectAltNames:
+		ids := make([]ent.Value, 0, len(m.removedsubject_alt_names))
+		for id := range m.removedsubject_alt_names {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertificateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsubject_alt_names {
+		edges = append(edges, certificate.EdgeSubjectAltNames)
+	}
+	if m.clearedsignature {
+		edges = append(edges, certificate.EdgeSignature)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertificateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case certificate.EdgeSubjectAltNames:
+		return m.clearedsubject_alt_names
+	case certificate.EdgeSignature:
+		return m.clearedsignature
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertificateMutation) ClearEdge(name string) error {
+	switch name {
+	case certificate.EdgeSignature:
+		m.ClearSignature()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertificateMutation) ResetEdge(name string) error {
+	switch name {
+	case certificate.EdgeSubjectAltNames:
+		m.ResetSubjectAltNames()
+		return nil
+	case certificate.EdgeSignature:
+		m.ResetSignature()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate edge %s", name)
+}
+
+// CommandRunAttestationMutation represents an operation that mutates the CommandRunAttestation nodes in the graph.
+type CommandRunAttestationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	tenant             *string
+	cmd                *string
+	exit_code          *int
+	addexit_code       *int
+	clearedFields      map[string]struct{}
+	attestation        *uuid.UUID
+	clearedattestation bool
+	done               bool
+	oldValue           func(context.Context) (*CommandRunAttestation, error)
+	predicates         []predicate.CommandRunAttestation
+}
+
+var _ ent.Mutation = (*CommandRunAttestationMutation)(nil)
+
+// commandrunattestationOption allows management of the mutation configuration using functional options.
+type commandrunattestationOption func(*CommandRunAttestationMutation)
+
+// newCommandRunAttestationMutation creates new mutation for the CommandRunAttestation entity.
+func newCommandRunAttestationMutation(c config, op Op, opts ...commandrunattestationOption) *CommandRunAttestationMutation {
+	m := &CommandRunAttestationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCommandRunAttestation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommandRunAttestationID sets the ID field of the mutation.
+func withCommandRunAttestationID(id uuid.UUID) commandrunattestationOption {
+	return func(m *CommandRunAttestationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CommandRunAttestation
+		)
+		m.oldValue = func(ctx context.Context) (*CommandRunAttestation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CommandRunAttestation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCommandRunAttestation sets the old CommandRunAttestation of the mutation.
+func withCommandRunAttestation(node *CommandRunAttestation) commandrunattestationOption {
+	return func(m *CommandRunAttestationMutation) {
+		m.oldValue = func(context.Context) (*CommandRunAttestation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommandRunAttestationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommandRunAttestationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CommandRunAttestation entities.
+func (m *CommandRunAttestationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommandRunAttestationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommandRunAttestationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CommandRunAttestation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *CommandRunAttestationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *CommandRunAttestationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the CommandRunAttestation entity.
+// If the CommandRunAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandRunAttestationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *CommandRunAttestationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetCmd sets the "cmd" field.
+func (m *CommandRunAttestationMutation) SetCmd(s string) {
+	m.cmd = &s
+}
+
+// Cmd returns the value of the "cmd" field in the mutation.
+func (m *CommandRunAttestationMutation) Cmd() (r string, exists bool) {
+	v := m.cmd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCmd returns the old "cmd" field's value of the CommandRunAttestation entity.
+// If the CommandRunAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandRunAttestationMutation) OldCmd(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCmd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCmd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCmd: %w", err)
+	}
+	return oldValue.Cmd, nil
+}
+
+// ResetCmd resets all changes to the "cmd" field.
+func (m *CommandRunAttestationMutation) ResetCmd() {
+	m.cmd = nil
+}
+
+// SetExitCode sets the "exit_code" field.
+func (m *CommandRunAttestationMutation) SetExitCode(i int) {
+	m.exit_code = &i
+	m.addexit_code = nil
+}
+
+// ExitCode returns the value of the "exit_code" field in the mutation.
+func (m *CommandRunAttestationMutation) ExitCode() (r int, exists bool) {
+	v := m.exit_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitCode returns the old "exit_code" field's value of the CommandRunAttestation entity.
+// If the CommandRunAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandRunAttestationMutation) OldExitCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitCode: %w", err)
+	}
+	return oldValue.ExitCode, nil
+}
+
+// AddExitCode adds i to the "exit_code" field.
+func (m *CommandRunAttestationMutation) AddExitCode(i int) {
+	if m.addexit_code != nil {
+		*m.addexit_code += i
+	} else {
+		m.addexit_code = &i
+	}
+}
+
+// AddedExitCode returns the value that was added to the "exit_code" field in this mutation.
+func (m *CommandRunAttestationMutation) AddedExitCode() (r int, exists bool) {
+	v := m.addexit_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExitCode resets all changes to the "exit_code" field.
+func (m *CommandRunAttestationMutation) ResetExitCode() {
+	m.exit_code = nil
+	m.addexit_code = nil
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by id.
+func (m *CommandRunAttestationMutation) SetAttestationID(id uuid.UUID) {
+	m.attestation = &id
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (m *CommandRunAttestationMutation) ClearAttestation() {
+	m.clearedattestation = true
+}
+
+// AttestationCleared reports if the "attestation" edge to the Attestation entity was cleared.
+func (m *CommandRunAttestationMutation) AttestationCleared() bool {
+	return m.clearedattestation
+}
+
+// AttestationID returns the "attestation" edge ID in the mutation.
+func (m *CommandRunAttestationMutation) AttestationID() (id uuid.UUID, exists bool) {
+	if m.attestation != nil {
+		return *m.attestation, true
+	}
+	return
+}
+
+// AttestationIDs returns the "attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttestationID instead. It exists only for internal usage by the builders.
+func (m *CommandRunAttestationMutation) AttestationIDs() (ids []uuid.UUID) {
+	if id := m.attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttestation resets all changes to the "attestation" edge.
+func (m *CommandRunAttestationMutation) ResetAttestation() {
+	m.attestation = nil
+	m.clearedattestation = false
+}
+
+// Where appends a list predicates to the CommandRunAttestationMutation builder.
+func (m *CommandRunAttestationMutation) Where(ps ...predicate.CommandRunAttestation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommandRunAttestationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommandRunAttestationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CommandRunAttestation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommandRunAttestationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommandRunAttestationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CommandRunAttestation).
+func (m *CommandRunAttestationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommandRunAttestationMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, commandrunattestation.FieldTenant)
+	}
+	if m.cmd != nil {
+		fields = append(fields, commandrunattestation.FieldCmd)
+	}
+	if m.exit_code != nil {
+		fields = append(fields, commandrunattestation.FieldExitCode)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommandRunAttestationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case commandrunattestation.FieldTenant:
+		return m.Tenant()
+	case commandrunattestation.FieldCmd:
+		return m.Cmd()
+	case commandrunattestation.FieldExitCode:
+		return m.ExitCode()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommandRunAttestationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case commandrunattestation.FieldTenant:
+		return m.OldTenant(ctx)
+	case commandrunattestation.FieldCmd:
+		return m.OldCmd(ctx)
+	case commandrunattestation.FieldExitCode:
+		return m.OldExitCode(ctx)
+	}
+	return nil, fmt.Errorf("unknown CommandRunAttestation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommandRunAttestationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case commandrunattestation.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case commandrunattestation.FieldCmd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCmd(v)
+		return nil
+	case commandrunattestation.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CommandRunAttestation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommandRunAttestationMutation) AddedFields() []string {
+	var fields []string
+	if m.addexit_code != nil {
+		fields = append(fields, commandrunattestation.FieldExitCode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommandRunAttestationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case commandrunattestation.FieldExitCode:
+		return m.AddedExitCode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommandRunAttestationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case commandrunattestation.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExitCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CommandRunAttestation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommandRunAttestationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommandRunAttestationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommandRunAttestationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CommandRunAttestation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommandRunAttestationMutation) ResetField(name string) error {
+	switch name {
+	case commandrunattestation.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case commandrunattestation.FieldCmd:
+		m.ResetCmd()
+		return nil
+	case commandrunattestation.FieldExitCode:
+		m.ResetExitCode()
+		return nil
+	}
+	return fmt.Errorf("unknown CommandRunAttestation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommandRunAttestationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.attestation != nil {
+		edges = append(edges, commandrunattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommandRunAttestationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case commandrunattestation.EdgeAttestation:
+		if id := m.attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommandRunAttestationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommandRunAttestationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommandRunAttestationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedattestation {
+		edges = append(edges, commandrunattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommandRunAttestationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case commandrunattestation.EdgeAttestation:
+		return m.clearedattestation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommandRunAttestationMutation) ClearEdge(name string) error {
+	switch name {
+	case commandrunattestation.EdgeAttestation:
+		m.ClearAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown CommandRunAttestation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommandRunAttestationMutation) ResetEdge(name string) error {
+	switch name {
+	case commandrunattestation.EdgeAttestation:
+		m.ResetAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown CommandRunAttestation edge %s", name)
+}
+
+// DsseMutation represents an operation that mutates the Dsse nodes in the graph.
+type DsseMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *uuid.UUID
+	tenant                          *string
+	created_at                      *time.Time
+	gitoid_sha256                   *string
+	payload_type                    *string
+	clearedFields                   map[string]struct{}
+	statement                       *uuid.UUID
+	clearedstatement                bool
+	signatures                      map[uuid.UUID]struct{}
+	removedsignatures               map[uuid.UUID]struct{}
+	clearedsignatures               bool
+	payload_digests                 map[uuid.UUID]struct{}
+	removedpayload_digests          map[uuid.UUID]struct{}
+	clearedpayload_digests          bool
+	publish_deliveries              map[uuid.UUID]struct{}
+	removedpublish_deliveries       map[uuid.UUID]struct{}
+	clearedpublish_deliveries       bool
+	publications                    map[uuid.UUID]struct{}
+	removedpublications             map[uuid.UUID]struct{}
+	clearedpublications             bool
+	transparency_log_entries        map[uuid.UUID]struct{}
+	removedtransparency_log_entries map[uuid.UUID]struct{}
+	clearedtransparency_log_entries bool
+	legal_holds                     map[uuid.UUID]struct{}
+	removedlegal_holds              map[uuid.UUID]struct{}
+	clearedlegal_holds              bool
+	done                            bool
+	oldValue                        func(context.Context) (*Dsse, error)
+	predicates                      []predicate.Dsse
+}
+
+var _ ent.Mutation = (*DsseMutation)(nil)
+
+// dsseOption allows management of the mutation configuration using functional options.
+type dsseOption func(*DsseMutation)
+
+// newDsseMutation creates new mutation for the Dsse entity.
+func newDsseMutation(c config, op Op, opts ...dsseOption) *DsseMutation {
+	m := &DsseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDsse,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDsseID sets the ID field of the mutation.
+func withDsseID(id uuid.UUID) dsseOption {
+	return func(m *DsseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Dsse
+		)
+		m.oldValue = func(ctx context.Context) (*Dsse, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Dsse.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDsse sets the old Dsse of the mutation.
+func withDsse(node *Dsse) dsseOption {
+	return func(m *DsseMutation) {
+		m.oldValue = func(context.Context) (*Dsse, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DsseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DsseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Dsse entities.
+func (m *DsseMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DsseMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DsseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Dsse.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *DsseMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *DsseMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Dsse entity.
+// If the Dsse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DsseMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *DsseMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DsseMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DsseMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Dsse entity.
+// If the Dsse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DsseMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *DsseMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[dsse.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *DsseMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[dsse.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DsseMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, dsse.FieldCreatedAt)
+}
+
+// SetGitoidSha256 sets the "gitoid_sha256" field.
+func (m *DsseMutation) SetGitoidSha256(s string) {
+	m.gitoid_sha256 = &s
+}
+
+// GitoidSha256 returns the value of the "gitoid_sha256" field in the mutation.
+func (m *DsseMutation) GitoidSha256() (r string, exists bool) {
+	v := m.gitoid_sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGitoidSha256 returns the old "gitoid_sha256" field's value of the Dsse entity.
+// If the Dsse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DsseMutation) OldGitoidSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGitoidSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGitoidSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGitoidSha256: %w", err)
+	}
+	return oldValue.GitoidSha256, nil
+}
+
+// ResetGitoidSha256 resets all changes to the "gitoid_sha256" field.
+func (m *DsseMutation) ResetGitoidSha256() {
+	m.gitoid_sha256 = nil
+}
+
+// SetPayloadType sets the "payload_type" field.
+func (m *DsseMutation) SetPayloadType(s string) {
+	m.payload_type = &s
+}
+
+// PayloadType returns the value of the "payload_type" field in the mutation.
+func (m *DsseMutation) PayloadType() (r string, exists bool) {
+	v := m.payload_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayloadType returns the old "payload_type" field's value of the Dsse entity.
+// If the Dsse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DsseMutation) OldPayloadType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayloadType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayloadType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayloadType: %w", err)
+	}
+	return oldValue.PayloadType, nil
+}
+
+// ResetPayloadType resets all changes to the "payload_type" field.
+func (m *DsseMutation) ResetPayloadType() {
+	m.payload_type = nil
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by id.
+func (m *DsseMutation) SetStatementID(id uuid.UUID) {
+	m.statement = &id
+}
+
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (m *DsseMutation) ClearStatement() {
+	m.clearedstatement = true
+}
+
+// StatementCleared reports if the "statement" edge to the Statement entity was cleared.
+func (m *DsseMutation) StatementCleared() bool {
+	return m.clearedstatement
+}
+
+// StatementID returns the "statement" edge ID in the mutation.
+func (m *DsseMutation) StatementID() (id uuid.UUID, exists bool) {
+	if m.statement != nil {
+		return *m.statement, true
+	}
+	return
+}
+
+// StatementIDs returns the "statement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StatementID instead. It exists only for internal usage by the builders.
+func (m *DsseMutation) StatementIDs() (ids []uuid.UUID) {
+	if id := m.statement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStatement resets all changes to the "statement" edge.
+func (m *DsseMutation) ResetStatement() {
+	m.statement = nil
+	m.clearedstatement = false
+}
+
+// AddSignatureIDs adds the "signatures" edge to the Signature entity by ids.
+func (m *DsseMutation) AddSignatureIDs(ids ...uuid.UUID) {
+	if m.signatures == nil {
+		m.signatures = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.signatures[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSignatures clears the "signatures" edge to the Signature entity.
+func (m *DsseMutation) ClearSignatures() {
+	m.clearedsignatures = true
+}
+
+// SignaturesCleared reports if the "signatures" edge to the Signature entity was cleared.
+func (m *DsseMutation) SignaturesCleared() bool {
+	return m.clearedsignatures
+}
+
+// RemoveSignatureIDs removes the "signatures" edge to the Signature entity by IDs.
+func (m *DsseMutation) RemoveSignatureIDs(ids ...uuid.UUID) {
+	if m.removedsignatures == nil {
+		m.removedsignatures = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.signatures, ids[i])
+		m.removedsignatures[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSignatures returns the removed IDs of the "signatures" edge to the Signature entity.
+func (m *DsseMutation) RemovedSignaturesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsignatures {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SignaturesIDs returns the "signatures" edge IDs in the mutation.
+func (m *DsseMutation) SignaturesIDs() (ids []uuid.UUID) {
+	for id := range m.signatures {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSignatures resets all changes to the "signatures" edge.
+func (m *DsseMutation) ResetSignatures() {
+	m.signatures = nil
+	m.clearedsignatures = false
+	m.removedsignatures = nil
+}
+
+// AddPayloadDigestIDs adds the "payload_digests" edge to the PayloadDigest entity by ids.
+func (m *DsseMutation) AddPayloadDigestIDs(ids ...uuid.UUID) {
+	if m.payload_digests == nil {
+		m.payload_digests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.payload_digests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPayloadDigests clears the "payload_digests" edge to the PayloadDigest entity.
+func (m *DsseMutation) ClearPayloadDigests() {
+	m.clearedpayload_digests = true
+}
+
+// PayloadDigestsCleared reports if the "payload_digests" edge to the PayloadDigest entity was cleared.
+func (m *DsseMutation) PayloadDigestsCleared() bool {
+	return m.clearedpayload_digests
+}
+
+// RemovePayloadDigestIDs removes the "payload_digests" edge to the PayloadDigest entity by IDs.
+func (m *DsseMutation) RemovePayloadDigestIDs(ids ...uuid.UUID) {
+	if m.removedpayload_digests == nil {
+		m.removedpayload_digests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.payload_digests, ids[i])
+		m.removedpayload_digests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPayloadDigests returns the removed IDs of the "payload_digests" edge to the PayloadDigest entity.
+func (m *DsseMutation) RemovedPayloadDigestsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpayload_digests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PayloadDigestsIDs returns the "payload_digests" edge IDs in the mutation.
+func (m *DsseMutation) PayloadDigestsIDs() (ids []uuid.UUID) {
+	for id := range m.payload_digests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPayloadDigests resets all changes to the "payload_digests" edge.
+func (m *DsseMutation) ResetPayloadDigests() {
+	m.payload_digests = nil
+	m.clearedpayload_digests = false
+	m.removedpayload_digests = nil
+}
+
+// AddPublishDeliveryIDs adds the "publish_deliveries" edge to the PublishDelivery entity by ids.
+func (m *DsseMutation) AddPublishDeliveryIDs(ids ...uuid.UUID) {
+	if m.publish_deliveries == nil {
+		m.publish_deliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.publish_deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPublishDeliveries clears the "publish_deliveries" edge to the PublishDelivery entity.
+func (m *DsseMutation) ClearPublishDeliveries() {
+	m.clearedpublish_deliveries = true
+}
+
+// PublishDeliveriesCleared reports if the "publish_deliveries" edge to the PublishDelivery entity was cleared.
+func (m *DsseMutation) PublishDeliveriesCleared() bool {
+	return m.clearedpublish_deliveries
+}
+
+// RemovePublishDeliveryIDs removes the "publish_deliveries" edge to the PublishDelivery entity by IDs.
+func (m *DsseMutation) RemovePublishDeliveryIDs(ids ...uuid.UUID) {
+	if m.removedpublish_deliveries == nil {
+		m.removedpublish_deliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.publish_deliveries, ids[i])
+		m.removedpublish_deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPublishDeliveries returns the removed IDs of the "publish_deliveries" edge to the PublishDelivery entity.
+func (m *DsseMutation) RemovedPublishDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedpublish_deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PublishDeliveriesIDs returns the "publish_deliveries" edge IDs in the mutation.
+func (m *DsseMutation) PublishDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.publish_deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPublishDeliveries resets all changes to the "publish_deliveries" edge.
+func (m *DsseMutation) ResetPublishDeliveries() {
+	m.publish_deliveries = nil
+	m.clearedpublish_deliveries = false
+	m.removedpublish_deliveries = nil
+}
+
+// AddPublicationIDs adds the "publications" edge to the Publication entity by ids.
+func (m *DsseMutation) AddPublicationIDs(ids ...uuid.UUID) {
+	if m.publications == nil {
+		m.publications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.publications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPublications clears the "publications" edge to the Publication entity.
+func (m *DsseMutation) ClearPublications() {
+	m.clearedpublications = true
+}
+
+// PublicationsCleared reports if the "publications" edge to the Publication entity was cleared.
+func (m *DsseMutation) PublicationsCleared() bool {
+	return m.clearedpublications
+}
+
+// RemovePublicationIDs removes the "publications" edge to the Publication entity by IDs.
+func (m *DsseMutation) RemovePublicationIDs(ids ...uuid.UUID) {
+	if m.removedpublications == nil {
+		m.removedpublications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.publications, ids[i])
+		m.removedpublications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPublications returns the removed IDs of the "publications" edge to the Publication entity.
+func (m *DsseMutation) RemovedPublicationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpublications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PublicationsIDs returns the "publications" edge IDs in the mutation.
+func (m *DsseMutation) PublicationsIDs() (ids []uuid.UUID) {
+	for id := range m.publications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPublications resets all changes to the "publications" edge.
+func (m *DsseMutation) ResetPublications() {
+	m.publications = nil
+	m.clearedpublications = false
+	m.removedpublications = nil
+}
+
+// AddTransparencyLogEntryIDs adds the "transparency_log_entries" edge to the TransparencyLogEntry entity by ids.
+func (m *DsseMutation) AddTransparencyLogEntryIDs(ids ...uuid.UUID) {
+	if m.transparency_log_entries == nil {
+		m.transparency_log_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.transparency_log_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransparencyLogEntries clears the "transparency_log_entries" edge to the TransparencyLogEntry entity.
+func (m *DsseMutation) ClearTransparencyLogEntries() {
+	m.clearedtransparency_log_entries = true
+}
+
+// TransparencyLogEntriesCleared reports if the "transparency_log_entries" edge to the TransparencyLogEntry entity was cleared.
+func (m *DsseMutation) TransparencyLogEntriesCleared() bool {
+	return m.clearedtransparency_log_entries
+}
+
+// RemoveTransparencyLogEntryIDs removes the "transparency_log_entries" edge to the TransparencyLogEntry entity by IDs.
+func (m *DsseMutation) RemoveTransparencyLogEntryIDs(ids ...uuid.UUID) {
+	if m.removedtransparency_log_entries == nil {
+		m.removedtransparency_log_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.transparency_log_entries, ids[i])
+		m.removedtransparency_log_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransparencyLogEntries returns the removed IDs of the "transparency_log_entries" edge to the TransparencyLogEntry entity.
+func (m *DsseMutation) RemovedTransparencyLogEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtransparency_log_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransparencyLogEntriesIDs returns the "transparency_log_entries" edge IDs in the mutation.
+func (m *DsseMutation) TransparencyLogEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.transparency_log_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransparencyLogEntries resets all changes to the "transparency_log_entries" edge.
+func (m *DsseMutation) ResetTransparencyLogEntries() {
+	m.transparency_log_entries = nil
+	m.clearedtransparency_log_entries = false
+	m.removedtransparency_log_entries = nil
+}
+
+// AddLegalHoldIDs adds the "legal_holds" edge to the LegalHold entity by ids.
+func (m *DsseMutation) AddLegalHoldIDs(ids ...uuid.UUID) {
+	if m.legal_holds == nil {
+		m.legal_holds = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.legal_holds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLegalHolds clears the "legal_holds" edge to the LegalHold entity.
+func (m *DsseMutation) ClearLegalHolds() {
+	m.clearedlegal_holds = true
+}
+
+// LegalHoldsCleared reports if the "legal_holds" edge to the LegalHold entity was cleared.
+func (m *DsseMutation) LegalHoldsCleared() bool {
+	return m.clearedlegal_holds
+}
+
+// RemoveLegalHoldIDs removes the "legal_holds" edge to the LegalHold entity by IDs.
+func (m *DsseMutation) RemoveLegalHoldIDs(ids ...uuid.UUID) {
+	if m.removedlegal_holds == nil {
+		m.removedlegal_holds = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.legal_holds, ids[i])
+		m.removedlegal_holds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLegalHolds returns the removed IDs of the "legal_holds" edge to the LegalHold entity.
+func (m *DsseMutation) RemovedLegalHoldsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlegal_holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LegalHoldsIDs returns the "legal_holds" edge IDs in the mutation.
+func (m *DsseMutation) LegalHoldsIDs() (ids []uuid.UUID) {
+	for id := range m.legal_holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLegalHolds resets all changes to the "legal_holds" edge.
+func (m *DsseMutation) ResetLegalHolds() {
+	m.legal_holds = nil
+	m.clearedlegal_holds = false
+	m.removedlegal_holds = nil
+}
+
+// Where appends a list predicates to the DsseMutation builder.
+func (m *DsseMutation) Where(ps ...predicate.Dsse) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DsseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DsseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Dsse, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DsseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DsseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Dsse).
+func (m *DsseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DsseMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.tenant != nil {
+		fields = append(fields, dsse.FieldTenant)
+	}
+	if m.created_at != nil {
+		fields = append(fields, dsse.FieldCreatedAt)
+	}
+	if m.gitoid_sha256 != nil {
+		fields = append(fields, dsse.FieldGitoidSha256)
+	}
+	if m.payload_type != nil {
+		fields = append(fields, dsse.FieldPayloadType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DsseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dsse.FieldTenant:
+		return m.Tenant()
+	case dsse.FieldCreatedAt:
+		return m.CreatedAt()
+	case dsse.FieldGitoidSha256:
+		return m.GitoidSha256()
+	case dsse.FieldPayloadType:
+		return m.PayloadType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DsseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dsse.FieldTenant:
+		return m.OldTenant(ctx)
+	case dsse.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case dsse.FieldGitoidSha256:
+		return m.OldGitoidSha256(ctx)
+	case dsse.FieldPayloadType:
+		return m.OldPayloadType(ctx)
+	}
+	return nil, fmt.Errorf("unknown Dsse field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DsseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dsse.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case dsse.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case dsse.FieldGitoidSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitoidSha256(v)
+		return nil
+	case dsse.FieldPayloadType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayloadType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Dsse field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DsseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DsseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DsseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Dsse numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DsseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(dsse.FieldCreatedAt) {
+		fields = append(fields, dsse.FieldCreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DsseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DsseMutation) ClearField(name string) error {
+	switch name {
+	case dsse.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Dsse nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DsseMutation) ResetField(name string) error {
+	switch name {
+	case dsse.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case dsse.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case dsse.FieldGitoidSha256:
+		m.ResetGitoidSha256()
+		return nil
+	case dsse.FieldPayloadType:
+		m.ResetPayloadType()
+		return nil
+	}
+	return fmt.Errorf("unknown Dsse field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DsseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.statement != nil {
+		edges = append(edges, dsse.EdgeStatement)
+	}
+	if m.signatures != nil {
+		edges = append(edges, dsse.EdgeSignatures)
+	}
+	if m.payload_digests != nil {
+		edges = append(edges, dsse.EdgePayloadDigests)
+	}
+	if m.publish_deliveries != nil {
+		edges = append(edges, dsse.EdgePublishDeliveries)
+	}
+	if m.publications != nil {
+		edges = append(edges, dsse.EdgePublications)
+	}
+	if m.transparency_log_entries != nil {
+		edges = append(edges, dsse.EdgeTransparencyLogEntries)
+	}
+	if m.legal_holds != nil {
+		edges = append(edges, dsse.EdgeLegalHolds)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DsseMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case dsse.EdgeStatement:
+		if id := m.statement; id != nil {
+			return []ent.Value{*id}
+		}
+	case dsse.EdgeSignatures:
+		ids := make([]ent.Value, 0, len(m.signatures))
+		for id := range m.signatures {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgePayloadDigests:
+		ids := make([]ent.Value, 0, len(m.payload_digests))
+		for id := range m.payload_digests {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgePublishDeliveries:
+		ids := make([]ent.Value, 0, len(m.publish_deliveries))
+		for id := range m.publish_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgePublications:
+		ids := make([]ent.Value, 0, len(m.publications))
+		for id := range m.publications {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgeTransparencyLogEntries:
+		ids := make([]ent.Value, 0, len(m.transparency_log_entries))
+		for id := range m.transparency_log_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgeLegalHolds:
+		ids := make([]ent.Value, 0, len(m.legal_holds))
+		for id := range m.legal_holds {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DsseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedsignatures != nil {
+		edges = append(edges, dsse.EdgeSignatures)
+	}
+	if m.removedpayload_digests != nil {
+		edges = append(edges, dsse.EdgePayloadDigests)
+	}
+	if m.removedpublish_deliveries != nil {
+		edges = append(edges, dsse.EdgePublishDeliveries)
+	}
+	if m.removedpublications != nil {
+		edges = append(edges, dsse.EdgePublications)
+	}
+	if m.removedtransparency_log_entries != nil {
+		edges = append(edges, dsse.EdgeTransparencyLogEntries)
+	}
+	if m.removedlegal_holds != nil {
+		edges = append(edges, dsse.EdgeLegalHolds)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DsseMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case dsse.EdgeSignatures:
+		ids := make([]ent.Value, 0, len(m.removedsignatures))
+		for id := range m.removedsignatures {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgePayloadDigests:
+		ids := make([]ent.Value, 0, len(m.removedpayload_digests))
+		for id := range m.removedpayload_digests {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgePublishDeliveries:
+		ids := make([]ent.Value, 0, len(m.removedpublish_deliveries))
+		for id := range m.removedpublish_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgePublications:
+		ids := make([]ent.Value, 0, len(m.removedpublications))
+		for id := range m.removedpublications {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgeTransparencyLogEntries:
+		ids := make([]ent.Value, 0, len(m.removedtransparency_log_entries))
+		for id := range m.removedtransparency_log_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case dsse.EdgeLegalHolds:
+		ids := make([]ent.Value, 0, len(m.removedlegal_holds))
+		for id := range m.removedlegal_holds {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DsseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedstatement {
+		edges = append(edges, dsse.EdgeStatement)
+	}
+	if m.clearedsignatures {
+		edges = append(edges, dsse.EdgeSignatures)
+	}
+	if m.clearedpayload_digests {
+		edges = append(edges, dsse.EdgePayloadDigests)
+	}
+	if m.clearedpublish_deliveries {
+		edges = append(edges, dsse.EdgePublishDeliveries)
+	}
+	if m.clearedpublications {
+		edges = append(edges, dsse.EdgePublications)
+	}
+	if m.clearedtransparency_log_entries {
+		edges = append(edges, dsse.EdgeTransparencyLogEntries)
+	}
+	if m.clearedlegal_holds {
+		edges = append(edges, dsse.EdgeLegalHolds)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DsseMutation) EdgeCleared(name string) bool {
+	switch name {
+	case dsse.EdgeStatement:
+		return m.clearedstatement
+	case dsse.EdgeSignatures:
+		return m.clearedsignatures
+	case dsse.EdgePayloadDigests:
+		return m.clearedpayload_digests
+	case dsse.EdgePublishDeliveries:
+		return m.clearedpublish_deliveries
+	case dsse.EdgePublications:
+		return m.clearedpublications
+	case dsse.EdgeTransparencyLogEntries:
+		return m.clearedtransparency_log_entries
+	case dsse.EdgeLegalHolds:
+		return m.clearedlegal_holds
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DsseMutation) ClearEdge(name string) error {
+	switch name {
+	case dsse.EdgeStatement:
+		m.ClearStatement()
+		return nil
+	}
+	return fmt.Errorf("unknown Dsse unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DsseMutation) ResetEdge(name string) error {
+	switch name {
+	case dsse.EdgeStatement:
+		m.ResetStatement()
+		return nil
+	case dsse.EdgeSignatures:
+		m.ResetSignatures()
+		return nil
+	case dsse.EdgePayloadDigests:
+		m.ResetPayloadDigests()
+		return nil
+	case dsse.EdgePublishDeliveries:
+		m.ResetPublishDeliveries()
+		return nil
+	case dsse.EdgePublications:
+		m.ResetPublications()
+		return nil
+	case dsse.EdgeTransparencyLogEntries:
+		m.ResetTransparencyLogEntries()
+		return nil
+	case dsse.EdgeLegalHolds:
+		m.ResetLegalHolds()
+		return nil
+	}
+	return fmt.Errorf("unknown Dsse edge %s", name)
+}
+
+// EnvironmentAttestationMutation represents an operation that mutates the EnvironmentAttestation nodes in the graph.
+type EnvironmentAttestationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	tenant             *string
+	os                 *string
+	hostname           *string
+	username           *string
+	clearedFields      map[string]struct{}
+	attestation        *uuid.UUID
+	clearedattestation bool
+	done               bool
+	oldValue           func(context.Context) (*EnvironmentAttestation, error)
+	predicates         []predicate.EnvironmentAttestation
+}
+
+var _ ent.Mutation = (*EnvironmentAttestationMutation)(nil)
+
+// environmentattestationOption allows management of the mutation configuration using functional options.
+type environmentattestationOption func(*EnvironmentAttestationMutation)
+
+// newEnvironmentAttestationMutation creates new mutation for the EnvironmentAttestation entity.
+func newEnvironmentAttestationMutation(c config, op Op, opts ...environmentattestationOption) *EnvironmentAttestationMutation {
+	m := &EnvironmentAttestationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvironmentAttestation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvironmentAttestationID sets the ID field of the mutation.
+func withEnvironmentAttestationID(id uuid.UUID) environmentattestationOption {
+	return func(m *EnvironmentAttestationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvironmentAttestation
+		)
+		m.oldValue = func(ctx context.Context) (*EnvironmentAttestation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvironmentAttestation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvironmentAttestation sets the old EnvironmentAttestation of the mutation.
+func withEnvironmentAttestation(node *EnvironmentAttestation) environmentattestationOption {
+	return func(m *EnvironmentAttestationMutation) {
+		m.oldValue = func(context.Context) (*EnvironmentAttestation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvironmentAttestationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvironmentAttestationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnvironmentAttestation entities.
+func (m *EnvironmentAttestationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvironmentAttestationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvironmentAttestationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvironmentAttestation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *EnvironmentAttestationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *EnvironmentAttestationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the EnvironmentAttestation entity.
+// If the EnvironmentAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentAttestationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *EnvironmentAttestationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetOs sets the "os" field.
+func (m *EnvironmentAttestationMutation) SetOs(s string) {
+	m.os = &s
+}
+
+// Os returns the value of the "os" field in the mutation.
+func (m *EnvironmentAttestationMutation) Os() (r string, exists bool) {
+	v := m.os
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOs returns the old "os" field's value of the EnvironmentAttestation entity.
+// If the EnvironmentAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentAttestationMutation) OldOs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOs: %w", err)
+	}
+	return oldValue.Os, nil
+}
+
+// ClearOs clears the value of the "os" field.
+func (m *EnvironmentAttestationMutation) ClearOs() {
+	m.os = nil
+	m.clearedFields[environmentattestation.FieldOs] = struct{}{}
+}
+
+// OsCleared returns if the "os" field was cleared in this mutation.
+func (m *EnvironmentAttestationMutation) OsCleared() bool {
+	_, ok := m.clearedFields[environmentattestation.FieldOs]
+	return ok
+}
+
+// ResetOs resets all changes to the "os" field.
+func (m *EnvironmentAttestationMutation) ResetOs() {
+	m.os = nil
+	delete(m.clearedFields, environmentattestation.FieldOs)
+}
+
+// SetHostname sets the "hostname" field.
+func (m *EnvironmentAttestationMutation) SetHostname(s string) {
+	m.hostname = &s
+}
+
+// Hostname returns the value of the "hostname" field in the mutation.
+func (m *EnvironmentAttestationMutation) Hostname() (r string, exists bool) {
+	v := m.hostname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostname returns the old "hostname" field's value of the EnvironmentAttestation entity.
+// If the EnvironmentAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentAttestationMutation) OldHostname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+	}
+	return oldValue.Hostname, nil
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (m *EnvironmentAttestationMutation) ClearHostname() {
+	m.hostname = nil
+	m.clearedFields[environmentattestation.FieldHostname] = struct{}{}
+}
+
+// HostnameCleared returns if the "hostname" field was cleared in this mutation.
+func (m *EnvironmentAttestationMutation) HostnameCleared() bool {
+	_, ok := m.clearedFields[environmentattestation.FieldHostname]
+	return ok
+}
+
+// ResetHostname resets all changes to the "hostname" field.
+func (m *EnvironmentAttestationMutation) ResetHostname() {
+	m.hostname = nil
+	delete(m.clearedFields, environmentattestation.FieldHostname)
+}
+
+// SetUsername sets the "username" field.
+func (m *EnvironmentAttestationMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *EnvironmentAttestationMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the EnvironmentAttestation entity.
+// If the EnvironmentAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentAttestationMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ClearUsername clears the value of the "username" field.
+func (m *EnvironmentAttestationMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[environmentattestation.FieldUsername] = struct{}{}
+}
+
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *EnvironmentAttestationMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[environmentattestation.FieldUsername]
+	return ok
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *EnvironmentAttestationMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, environmentattestation.FieldUsername)
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by id.
+func (m *EnvironmentAttestationMutation) SetAttestationID(id uuid.UUID) {
+	m.attestation = &id
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (m *EnvironmentAttestationMutation) ClearAttestation() {
+	m.clearedattestation = true
+}
+
+// AttestationCleared reports if the "attestation" edge to the Attestation entity was cleared.
+func (m *EnvironmentAttestationMutation) AttestationCleared() bool {
+	return m.clearedattestation
+}
+
+// AttestationID returns the "attestation" edge ID in the mutation.
+func (m *EnvironmentAttestationMutation) AttestationID() (id uuid.UUID, exists bool) {
+	if m.attestation != nil {
+		return *m.attestation, true
+	}
+	return
+}
+
+// AttestationIDs returns the "attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttestationID instead. It exists only for internal usage by the builders.
+func (m *EnvironmentAttestationMutation) AttestationIDs() (ids []uuid.UUID) {
+	if id := m.attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttestation resets all changes to the "attestation" edge.
+func (m *EnvironmentAttestationMutation) ResetAttestation() {
+	m.attestation = nil
+	m.clearedattestation = false
+}
+
+// Where appends a list predicates to the EnvironmentAttestationMutation builder.
+func (m *EnvironmentAttestationMutation) Where(ps ...predicate.EnvironmentAttestation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvironmentAttestationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvironmentAttestationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvironmentAttestation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvironmentAttestationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvironmentAttestationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvironmentAttestation).
+func (m *EnvironmentAttestationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvironmentAttestationMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.tenant != nil {
+		fields = append(fields, environmentattestation.FieldTenant)
+	}
+	if m.os != nil {
+		fields = append(fields, environmentattestation.FieldOs)
+	}
+	if m.hostname != nil {
+		fields = append(fields, environmentattestation.FieldHostname)
+	}
+	if m.username != nil {
+		fields = append(fields, environmentattestation.FieldUsername)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvironmentAttestationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case environmentattestation.FieldTenant:
+		return m.Tenant()
+	case environmentattestation.FieldOs:
+		return m.Os()
+	case environmentattestation.FieldHostname:
+		return m.Hostname()
+	case environmentattestation.FieldUsername:
+		return m.Username()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvironmentAttestationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case environmentattestation.FieldTenant:
+		return m.OldTenant(ctx)
+	case environmentattestation.FieldOs:
+		return m.OldOs(ctx)
+	case environmentattestation.FieldHostname:
+		return m.OldHostname(ctx)
+	case environmentattestation.FieldUsername:
+		return m.OldUsername(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvironmentAttestation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvironmentAttestationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case environmentattestation.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case environmentattestation.FieldOs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOs(v)
+		return nil
+	case environmentattestation.FieldHostname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostname(v)
+		return nil
+	case environmentattestation.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvironmentAttestation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvironmentAttestationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvironmentAttestationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvironmentAttestationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EnvironmentAttestation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvironmentAttestationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(environmentattestation.FieldOs) {
+		fields = append(fields, environmentattestation.FieldOs)
+	}
+	if m.FieldCleared(environmentattestation.FieldHostname) {
+		fields = append(fields, environmentattestation.FieldHostname)
+	}
+	if m.FieldCleared(environmentattestation.FieldUsername) {
+		fields = append(fields, environmentattestation.FieldUsername)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvironmentAttestationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvironmentAttestationMutation) ClearField(name string) error {
+	switch name {
+	case environmentattestation.FieldOs:
+		m.ClearOs()
+		return nil
+	case environmentattestation.FieldHostname:
+		m.ClearHostname()
+		return nil
+	case environmentattestation.FieldUsername:
+		m.ClearUsername()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvironmentAttestation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvironmentAttestationMutation) ResetField(name string) error {
+	switch name {
+	case environmentattestation.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case environmentattestation.FieldOs:
+		m.ResetOs()
+		return nil
+	case environmentattestation.FieldHostname:
+		m.ResetHostname()
+		return nil
+	case environmentattestation.FieldUsername:
+		m.ResetUsername()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvironmentAttestation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvironmentAttestationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.attestation != nil {
+		edges = append(edges, environmentattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvironmentAttestationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case environmentattestation.EdgeAttestation:
+		if id := m.attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvironmentAttestationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvironmentAttestationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvironmentAttestationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedattestation {
+		edges = append(edges, environmentattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvironmentAttestationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case environmentattestation.EdgeAttestation:
+		return m.clearedattestation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvironmentAttestationMutation) ClearEdge(name string) error {
+	switch name {
+	case environmentattestation.EdgeAttestation:
+		m.ClearAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvironmentAttestation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvironmentAttestationMutation) ResetEdge(name string) error {
+	switch name {
+	case environmentattestation.EdgeAttestation:
+		m.ResetAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvironmentAttestation edge %s", name)
+}
+
+// GitAttestationMutation represents an operation that mutates the GitAttestation nodes in the graph.
+type GitAttestationMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	tenant              *string
+	commit_hash         *string
+	branch              *string
+	tree_hash           *string
+	author              *string
+	author_email        *string
+	committer_name      *string
+	committer_email     *string
+	commit_date         *string
+	commit_message      *string
+	parent_hashes       *[]string
+	appendparent_hashes []string
+	refs                *[]string
+	appendrefs          []string
+	remotes             *[]string
+	appendremotes       []string
+	tags                *[]string
+	appendtags          []string
+	clearedFields       map[string]struct{}
+	attestation         *uuid.UUID
+	clearedattestation  bool
+	done                bool
+	oldValue            func(context.Context) (*GitAttestation, error)
+	predicates          []predicate.GitAttestation
+}
+
+var _ ent.Mutation = (*GitAttestationMutation)(nil)
+
+// gitattestationOption allows management of the mutation configuration using functional options.
+type gitattestationOption func(*GitAttestationMutation)
+
+// newGitAttestationMutation creates new mutation for the GitAttestation entity.
+func newGitAttestationMutation(c config, op Op, opts ...gitattestationOption) *GitAttestationMutation {
+	m := &GitAttestationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGitAttestation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGitAttestationID sets the ID field of the mutation.
+func withGitAttestationID(id uuid.UUID) gitattestationOption {
+	return func(m *GitAttestationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GitAttestation
+		)
+		m.oldValue = func(ctx context.Context) (*GitAttestation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GitAttestation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGitAttestation sets the old GitAttestation of the mutation.
+func withGitAttestation(node *GitAttestation) gitattestationOption {
+	return func(m *GitAttestationMutation) {
+		m.oldValue = func(context.Context) (*GitAttestation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GitAttestationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GitAttestationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GitAttestation entities.
+func (m *GitAttestationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GitAttestationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GitAttestationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GitAttestation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *GitAttestationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *GitAttestationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *GitAttestationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetCommitHash sets the "commit_hash" field.
+func (m *GitAttestationMutation) SetCommitHash(s string) {
+	m.commit_hash = &s
+}
+
+// CommitHash returns the value of the "commit_hash" field in the mutation.
+func (m *GitAttestationMutation) CommitHash() (r string, exists bool) {
+	v := m.commit_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitHash returns the old "commit_hash" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldCommitHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitHash: %w", err)
+	}
+	return oldValue.CommitHash, nil
+}
+
+// ResetCommitHash resets all changes to the "commit_hash" field.
+func (m *GitAttestationMutation) ResetCommitHash() {
+	m.commit_hash = nil
+}
+
+// SetBranch sets the "branch" field.
+func (m *GitAttestationMutation) SetBranch(s string) {
+	m.branch = &s
+}
+
+// Branch returns the value of the "branch" field in the mutation.
+func (m *GitAttestationMutation) Branch() (r string, exists bool) {
+	v := m.branch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBranch returns the old "branch" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldBranch(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBranch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBranch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBranch: %w", err)
+	}
+	return oldValue.Branch, nil
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (m *GitAttestationMutation) ClearBranch() {
+	m.branch = nil
+	m.clearedFields[gitattestation.FieldBranch] = struct{}{}
+}
+
+// BranchCleared returns if the "branch" field was cleared in this mutation.
+func (m *GitAttestationMutation) BranchCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldBranch]
+	return ok
+}
+
+// ResetBranch resets all changes to the "branch" field.
+func (m *GitAttestationMutation) ResetBranch() {
+	m.branch = nil
+	delete(m.clearedFields, gitattestation.FieldBranch)
+}
+
+// SetTreeHash sets the "tree_hash" field.
+func (m *GitAttestationMutation) SetTreeHash(s string) {
+	m.tree_hash = &s
+}
+
+// TreeHash returns the value of the "tree_hash" field in the mutation.
+func (m *GitAttestationMutation) TreeHash() (r string, exists bool) {
+	v := m.tree_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTreeHash returns the old "tree_hash" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldTreeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTreeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTreeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTreeHash: %w", err)
+	}
+	return oldValue.TreeHash, nil
+}
+
+// ClearTreeHash clears the value of the "tree_hash" field.
+func (m *GitAttestationMutation) ClearTreeHash() {
+	m.tree_hash = nil
+	m.clearedFields[gitattestation.FieldTreeHash] = struct{}{}
+}
+
+// TreeHashCleared returns if the "tree_hash" field was cleared in this mutation.
+func (m *GitAttestationMutation) TreeHashCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldTreeHash]
+	return ok
+}
+
+// ResetTreeHash resets all changes to the "tree_hash" field.
+func (m *GitAttestationMutation) ResetTreeHash() {
+	m.tree_hash = nil
+	delete(m.clearedFields, gitattestation.FieldTreeHash)
+}
+
+// SetAuthor sets the "author" field.
+func (m *GitAttestationMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *GitAttestationMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (m *GitAttestationMutation) ClearAuthor() {
+	m.author = nil
+	m.clearedFields[gitattestation.FieldAuthor] = struct{}{}
+}
+
+// AuthorCleared returns if the "author" field was cleared in this mutation.
+func (m *GitAttestationMutation) AuthorCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldAuthor]
+	return ok
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *GitAttestationMutation) ResetAuthor() {
+	m.author = nil
+	delete(m.clearedFields, gitattestation.FieldAuthor)
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (m *GitAttestationMutation) SetAuthorEmail(s string) {
+	m.author_email = &s
+}
+
+// AuthorEmail returns the value of the "author_email" field in the mutation.
+func (m *GitAttestationMutation) AuthorEmail() (r string, exists bool) {
+	v := m.author_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorEmail returns the old "author_email" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldAuthorEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorEmail: %w", err)
+	}
+	return oldValue.AuthorEmail, nil
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (m *GitAttestationMutation) ClearAuthorEmail() {
+	m.author_email = nil
+	m.clearedFields[gitattestation.FieldAuthorEmail] = struct{}{}
+}
+
+// AuthorEmailCleared returns if the "author_email" field was cleared in this mutation.
+func (m *GitAttestationMutation) AuthorEmailCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldAuthorEmail]
+	return ok
+}
+
+// ResetAuthorEmail resets all changes to the "author_email" field.
+func (m *GitAttestationMutation) ResetAuthorEmail() {
+	m.author_email = nil
+	delete(m.clearedFields, gitattestation.FieldAuthorEmail)
+}
+
+// SetCommitterName sets the "committer_name" field.
+func (m *GitAttestationMutation) SetCommitterName(s string) {
+	m.committer_name = &s
+}
+
+// CommitterName returns the value of the "committer_name" field in the mutation.
+func (m *GitAttestationMutation) CommitterName() (r string, exists bool) {
+	v := m.committer_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitterName returns the old "committer_name" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldCommitterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitterName: %w", err)
+	}
+	return oldValue.CommitterName, nil
+}
+
+// ClearCommitterName clears the value of the "committer_name" field.
+func (m *GitAttestationMutation) ClearCommitterName() {
+	m.committer_name = nil
+	m.clearedFields[gitattestation.FieldCommitterName] = struct{}{}
+}
+
+// CommitterNameCleared returns if the "committer_name" field was cleared in this mutation.
+func (m *GitAttestationMutation) CommitterNameCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldCommitterName]
+	return ok
+}
+
+// ResetCommitterName resets all changes to the "committer_name" field.
+func (m *GitAttestationMutation) ResetCommitterName() {
+	m.committer_name = nil
+	delete(m.clearedFields, gitattestation.FieldCommitterName)
+}
+
+// SetCommitterEmail sets the "committer_email" field.
+func (m *GitAttestationMutation) SetCommitterEmail(s string) {
+	m.committer_email = &s
+}
+
+// CommitterEmail returns the value of the "committer_email" field in the mutation.
+func (m *GitAttestationMutation) CommitterEmail() (r string, exists bool) {
+	v := m.committer_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitterEmail returns the old "committer_email" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldCommitterEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitterEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitterEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitterEmail: %w", err)
+	}
+	return oldValue.CommitterEmail, nil
+}
+
+// ClearCommitterEmail clears the value of the "committer_email" field.
+func (m *GitAttestationMutation) ClearCommitterEmail() {
+	m.committer_email = nil
+	m.clearedFields[gitattestation.FieldCommitterEmail] = struct{}{}
+}
+
+// CommitterEmailCleared returns if the "committer_email" field was cleared in this mutation.
+func (m *GitAttestationMutation) CommitterEmailCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldCommitterEmail]
+	return ok
+}
+
+// ResetCommitterEmail resets all changes to the "committer_email" field.
+func (m *GitAttestationMutation) ResetCommitterEmail() {
+	m.committer_email = nil
+	delete(m.clearedFields, gitattestation.FieldCommitterEmail)
+}
+
+// SetCommitDate sets the "commit_date" field.
+func (m *GitAttestationMutation) SetCommitDate(s string) {
+	m.commit_date = &s
+}
+
+// CommitDate returns the value of the "commit_date" field in the mutation.
+func (m *GitAttestationMutation) CommitDate() (r string, exists bool) {
+	v := m.commit_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitDate returns the old "commit_date" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldCommitDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitDate: %w", err)
+	}
+	return oldValue.CommitDate, nil
+}
+
+// ClearCommitDate clears the value of the "commit_date" field.
+func (m *GitAttestationMutation) ClearCommitDate() {
+	m.commit_date = nil
+	m.clearedFields[gitattestation.FieldCommitDate] = struct{}{}
+}
+
+// CommitDateCleared returns if the "commit_date" field was cleared in this mutation.
+func (m *GitAttestationMutation) CommitDateCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldCommitDate]
+	return ok
+}
+
+// ResetCommitDate resets all changes to the "commit_date" field.
+func (m *GitAttestationMutation) ResetCommitDate() {
+	m.commit_date = nil
+	delete(m.clearedFields, gitattestation.FieldCommitDate)
+}
+
+// SetCommitMessage sets the "commit_message" field.
+func (m *GitAttestationMutation) SetCommitMessage(s string) {
+	m.commit_message = &s
+}
+
+// CommitMessage returns the value of the "commit_message" field in the mutation.
+func (m *GitAttestationMutation) CommitMessage() (r string, exists bool) {
+	v := m.commit_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitMessage returns the old "commit_message" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldCommitMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitMessage: %w", err)
+	}
+	return oldValue.CommitMessage, nil
+}
+
+// ClearCommitMessage clears the value of the "commit_message" field.
+func (m *GitAttestationMutation) ClearCommitMessage() {
+	m.commit_message = nil
+	m.clearedFields[gitattestation.FieldCommitMessage] = struct{}{}
+}
+
+// CommitMessageCleared returns if the "commit_message" field was cleared in this mutation.
+func (m *GitAttestationMutation) CommitMessageCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldCommitMessage]
+	return ok
+}
+
+// ResetCommitMessage resets all changes to the "commit_message" field.
+func (m *GitAttestationMutation) ResetCommitMessage() {
+	m.commit_message = nil
+	delete(m.clearedFields, gitattestation.FieldCommitMessage)
+}
+
+// SetParentHashes sets the "parent_hashes" field.
+func (m *GitAttestationMutation) SetParentHashes(s []string) {
+	m.parent_hashes = &s
+	m.appendparent_hashes = nil
+}
+
+// ParentHashes returns the value of the "parent_hashes" field in the mutation.
+func (m *GitAttestationMutation) ParentHashes() (r []string, exists bool) {
+	v := m.parent_hashes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentHashes returns the old "parent_hashes" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldParentHashes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentHashes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentHashes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentHashes: %w", err)
+	}
+	return oldValue.ParentHashes, nil
+}
+
+// AppendParentHashes adds s to the "parent_hashes" field.
+func (m *GitAttestationMutation) AppendParentHashes(s []string) {
+	m.appendparent_hashes = append(m.appendparent_hashes, s...)
+}
+
+// AppendedParentHashes returns the list of values that were appended to the "parent_hashes" field in this mutation.
+func (m *GitAttestationMutation) AppendedParentHashes() ([]string, bool) {
+	if len(m.appendparent_hashes) == 0 {
+		return nil, false
+	}
+	return m.appendparent_hashes, true
+}
+
+// ClearParentHashes clears the value of the "parent_hashes" field.
+func (m *GitAttestationMutation) ClearParentHashes() {
+	m.parent_hashes = nil
+	m.appendparent_hashes = nil
+	m.clearedFields[gitattestation.FieldParentHashes] = struct{}{}
+}
+
+// ParentHashesCleared returns if the "parent_hashes" field was cleared in this mutation.
+func (m *GitAttestationMutation) ParentHashesCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldParentHashes]
+	return ok
+}
+
+// ResetParentHashes resets all changes to the "parent_hashes" field.
+func (m *GitAttestationMutation) ResetParentHashes() {
+	m.parent_hashes = nil
+	m.appendparent_hashes = nil
+	delete(m.clearedFields, gitattestation.FieldParentHashes)
+}
+
+// SetRefs sets the "refs" field.
+func (m *GitAttestationMutation) SetRefs(s []string) {
+	m.refs = &s
+	m.appendrefs = nil
+}
+
+// Refs returns the value of the "refs" field in the mutation.
+func (m *GitAttestationMutation) Refs() (r []string, exists bool) {
+	v := m.refs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefs returns the old "refs" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldRefs(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefs: %w", err)
+	}
+	return oldValue.Refs, nil
+}
+
+// AppendRefs adds s to the "refs" field.
+func (m *GitAttestationMutation) AppendRefs(s []string) {
+	m.appendrefs = append(m.appendrefs, s...)
+}
+
+// AppendedRefs returns the list of values that were appended to the "refs" field in this mutation.
+func (m *GitAttestationMutation) AppendedRefs() ([]string, bool) {
+	if len(m.appendrefs) == 0 {
+		return nil, false
+	}
+	return m.appendrefs, true
+}
+
+// ClearRefs clears the value of the "refs" field.
+func (m *GitAttestationMutation) ClearRefs() {
+	m.refs = nil
+	m.appendrefs = nil
+	m.clearedFields[gitattestation.FieldRefs] = struct{}{}
+}
+
+// RefsCleared returns if the "refs" field was cleared in this mutation.
+func (m *GitAttestationMutation) RefsCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldRefs]
+	return ok
+}
+
+// ResetRefs resets all changes to the "refs" field.
+func (m *GitAttestationMutation) ResetRefs() {
+	m.refs = nil
+	m.appendrefs = nil
+	delete(m.clearedFields, gitattestation.FieldRefs)
+}
+
+// SetRemotes sets the "remotes" field.
+func (m *GitAttestationMutation) SetRemotes(s []string) {
+	m.remotes = &s
+	m.appendremotes = nil
+}
+
+// Remotes returns the value of the "remotes" field in the mutation.
+func (m *GitAttestationMutation) Remotes() (r []string, exists bool) {
+	v := m.remotes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemotes returns the old "remotes" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldRemotes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemotes: %w", err)
+	}
+	return oldValue.Remotes, nil
+}
+
+// AppendRemotes adds s to the "remotes" field.
+func (m *GitAttestationMutation) AppendRemotes(s []string) {
+	m.appendremotes = append(m.appendremotes, s...)
+}
+
+// AppendedRemotes returns the list of values that were appended to the "remotes" field in this mutation.
+func (m *GitAttestationMutation) AppendedRemotes() ([]string, bool) {
+	if len(m.appendremotes) == 0 {
+		return nil, false
+	}
+	return m.appendremotes, true
+}
+
+// ClearRemotes clears the value of the "remotes" field.
+func (m *GitAttestationMutation) ClearRemotes() {
+	m.remotes = nil
+	m.appendremotes = nil
+	m.clearedFields[gitattestation.FieldRemotes] = struct{}{}
+}
+
+// RemotesCleared returns if the "remotes" field was cleared in this mutation.
+func (m *GitAttestationMutation) RemotesCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldRemotes]
+	return ok
+}
+
+// ResetRemotes resets all changes to the "remotes" field.
+func (m *GitAttestationMutation) ResetRemotes() {
+	m.remotes = nil
+	m.appendremotes = nil
+	delete(m.clearedFields, gitattestation.FieldRemotes)
+}
+
+// SetTags sets the "tags" field.
+func (m *GitAttestationMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *GitAttestationMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the GitAttestation entity.
+// If the GitAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitAttestationMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *GitAttestationMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *GitAttestationMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *GitAttestationMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[gitattestation.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *GitAttestationMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[gitattestation.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *GitAttestationMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, gitattestation.FieldTags)
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by id.
+func (m *GitAttestationMutation) SetAttestationID(id uuid.UUID) {
+	m.attestation = &id
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (m *GitAttestationMutation) ClearAttestation() {
+	m.clearedattestation = true
+}
+
+// AttestationCleared reports if the "attestation" edge to the Attestation entity was cleared.
+func (m *GitAttestationMutation) AttestationCleared() bool {
+	return m.clearedattestation
+}
+
+// AttestationID returns the "attestation" edge ID in the mutation.
+func (m *GitAttestationMutation) AttestationID() (id uuid.UUID, exists bool) {
+	if m.attestation != nil {
+		return *m.attestation, true
+	}
+	return
+}
+
+// AttestationIDs returns the "attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttestationID instead. It exists only for internal usage by the builders.
+func (m *GitAttestationMutation) AttestationIDs() (ids []uuid.UUID) {
+	if id := m.attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttestation resets all changes to the "attestation" edge.
+func (m *GitAttestationMutation) ResetAttestation() {
+	m.attestation = nil
+	m.clearedattestation = false
+}
+
+// Where appends a list predicates to the GitAttestationMutation builder.
+func (m *GitAttestationMutation) Where(ps ...predicate.GitAttestation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GitAttestationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GitAttestationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GitAttestation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GitAttestationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GitAttestationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GitAttestation).
+func (m *GitAttestationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GitAttestationMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.tenant != nil {
+		fields = append(fields, gitattestation.FieldTenant)
+	}
+	if m.commit_hash != nil {
+		fields = append(fields, gitattestation.FieldCommitHash)
+	}
+	if m.branch != nil {
+		fields = append(fields, gitattestation.FieldBranch)
+	}
+	if m.tree_hash != nil {
+		fields = append(fields, gitattestation.FieldTreeHash)
+	}
+	if m.author != nil {
+		fields = append(fields, gitattestation.FieldAuthor)
+	}
+	if m.author_email != nil {
+		fields = append(fields, gitattestation.FieldAuthorEmail)
+	}
+	if m.committer_name != nil {
+		fields = append(fields, gitattestation.FieldCommitterName)
+	}
+	if m.committer_email != nil {
+		fields = append(fields, gitattestation.FieldCommitterEmail)
+	}
+	if m.commit_date != nil {
+		fields = append(fields, gitattestation.FieldCommitDate)
+	}
+	if m.commit_message != nil {
+		fields = append(fields, gitattestation.FieldCommitMessage)
+	}
+	if m.parent_hashes != nil {
+		fields = append(fields, gitattestation.FieldParentHashes)
+	}
+	if m.refs != nil {
+		fields = append(fields, gitattestation.FieldRefs)
+	}
+	if m.remotes != nil {
+		fields = append(fields, gitattestation.FieldRemotes)
+	}
+	if m.tags != nil {
+		fields = append(fields, gitattestation.FieldTags)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GitAttestationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gitattestation.FieldTenant:
+		return m.Tenant()
+	case gitattestation.FieldCommitHash:
+		return m.CommitHash()
+	case gitattestation.FieldBranch:
+		return m.Branch()
+	case gitattestation.FieldTreeHash:
+		return m.TreeHash()
+	case gitattestation.FieldAuthor:
+		return m.Author()
+	case gitattestation.FieldAuthorEmail:
+		return m.AuthorEmail()
+	case gitattestation.FieldCommitterName:
+		return m.CommitterName()
+	case gitattestation.FieldCommitterEmail:
+		return m.CommitterEmail()
+	case gitattestation.FieldCommitDate:
+		return m.CommitDate()
+	case gitattestation.FieldCommitMessage:
+		return m.CommitMessage()
+	case gitattestation.FieldParentHashes:
+		return m.ParentHashes()
+	case gitattestation.FieldRefs:
+		return m.Refs()
+	case gitattestation.FieldRemotes:
+		return m.Remotes()
+	case gitattestation.FieldTags:
+		return m.Tags()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GitAttestationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gitattestation.FieldTenant:
+		return m.OldTenant(ctx)
+	case gitattestation.FieldCommitHash:
+		return m.OldCommitHash(ctx)
+	case gitattestation.FieldBranch:
+		return m.OldBranch(ctx)
+	case gitattestation.FieldTreeHash:
+		return m.OldTreeHash(ctx)
+	case gitattestation.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case gitattestation.FieldAuthorEmail:
+		return m.OldAuthorEmail(ctx)
+	case gitattestation.FieldCommitterName:
+		return m.OldCommitterName(ctx)
+	case gitattestation.FieldCommitterEmail:
+		return m.OldCommitterEmail(ctx)
+	case gitattestation.FieldCommitDate:
+		return m.OldCommitDate(ctx)
+	case gitattestation.FieldCommitMessage:
+		return m.OldCommitMessage(ctx)
+	case gitattestation.FieldParentHashes:
+		return m.OldParentHashes(ctx)
+	case gitattestation.FieldRefs:
+		return m.OldRefs(ctx)
+	case gitattestation.FieldRemotes:
+		return m.OldRemotes(ctx)
+	case gitattestation.FieldTags:
+		return m.OldTags(ctx)
+	}
+	return nil, fmt.Errorf("unknown GitAttestation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GitAttestationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gitattestation.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case gitattestation.FieldCommitHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitHash(v)
+		return nil
+	case gitattestation.FieldBranch:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBranch(v)
+		return nil
+	case gitattestation.FieldTreeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTreeHash(v)
+		return nil
+	case gitattestation.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case gitattestation.FieldAuthorEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorEmail(v)
+		return nil
+	case gitattestation.FieldCommitterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitterName(v)
+		return nil
+	case gitattestation.FieldCommitterEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitterEmail(v)
+		return nil
+	case gitattestation.FieldCommitDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitDate(v)
+		return nil
+	case gitattestation.FieldCommitMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitMessage(v)
+		return nil
+	case gitattestation.FieldParentHashes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentHashes(v)
+		return nil
+	case gitattestation.FieldRefs:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefs(v)
+		return nil
+	case gitattestation.FieldRemotes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemotes(v)
+		return nil
+	case gitattestation.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GitAttestation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GitAttestationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GitAttestationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GitAttestationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GitAttestation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GitAttestationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gitattestation.FieldBranch) {
+		fields = append(fields, gitattestation.FieldBranch)
+	}
+	if m.FieldCleared(gitattestation.FieldTreeHash) {
+		fields = append(fields, gitattestation.FieldTreeHash)
+	}
+	if m.FieldCleared(gitattestation.FieldAuthor) {
+		fields = append(fields, gitattestation.FieldAuthor)
+	}
+	if m.FieldCleared(gitattestation.FieldAuthorEmail) {
+		fields = append(fields, gitattestation.FieldAuthorEmail)
+	}
+	if m.FieldCleared(gitattestation.FieldCommitterName) {
+		fields = append(fields, gitattestation.FieldCommitterName)
+	}
+	if m.FieldCleared(gitattestation.FieldCommitterEmail) {
+		fields = append(fields, gitattestation.FieldCommitterEmail)
+	}
+	if m.FieldCleared(gitattestation.FieldCommitDate) {
+		fields = append(fields, gitattestation.FieldCommitDate)
+	}
+	if m.FieldCleared(gitattestation.FieldCommitMessage) {
+		fields = append(fields, gitattestation.FieldCommitMessage)
+	}
+	if m.FieldCleared(gitattestation.FieldParentHashes) {
+		fields = append(fields, gitattestation.FieldParentHashes)
+	}
+	if m.FieldCleared(gitattestation.FieldRefs) {
+		fields = append(fields, gitattestation.FieldRefs)
+	}
+	if m.FieldCleared(gitattestation.FieldRemotes) {
+		fields = append(fields, gitattestation.FieldRemotes)
+	}
+	if m.FieldCleared(gitattestation.FieldTags) {
+		fields = append(fields, gitattestation.FieldTags)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GitAttestationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GitAttestationMutation) ClearField(name string) error {
+	switch name {
+	case gitattestation.FieldBranch:
+		m.ClearBranch()
+		return nil
+	case gitattestation.FieldTreeHash:
+		m.ClearTreeHash()
+		return nil
+	case gitattestation.FieldAuthor:
+		m.ClearAuthor()
+		return nil
+	case gitattestation.FieldAuthorEmail:
+		m.ClearAuthorEmail()
+		return nil
+	case gitattestation.FieldCommitterName:
+		m.ClearCommitterName()
+		return nil
+	case gitattestation.FieldCommitterEmail:
+		m.ClearCommitterEmail()
+		return nil
+	case gitattestation.FieldCommitDate:
+		m.ClearCommitDate()
+		return nil
+	case gitattestation.FieldCommitMessage:
+		m.ClearCommitMessage()
+		return nil
+	case gitattestation.FieldParentHashes:
+		m.ClearParentHashes()
+		return nil
+	case gitattestation.FieldRefs:
+		m.ClearRefs()
+		return nil
+	case gitattestation.FieldRemotes:
+		m.ClearRemotes()
+		return nil
+	case gitattestation.FieldTags:
+		m.ClearTags()
+		return nil
+	}
+	return fmt.Errorf("unknown GitAttestation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GitAttestationMutation) ResetField(name string) error {
+	switch name {
+	case gitattestation.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case gitattestation.FieldCommitHash:
+		m.ResetCommitHash()
+		return nil
+	case gitattestation.FieldBranch:
+		m.ResetBranch()
+		return nil
+	case gitattestation.FieldTreeHash:
+		m.ResetTreeHash()
+		return nil
+	case gitattestation.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case gitattestation.FieldAuthorEmail:
+		m.ResetAuthorEmail()
+		return nil
+	case gitattestation.FieldCommitterName:
+		m.ResetCommitterName()
+		return nil
+	case gitattestation.FieldCommitterEmail:
+		m.ResetCommitterEmail()
+		return nil
+	case gitattestation.FieldCommitDate:
+		m.ResetCommitDate()
+		return nil
+	case gitattestation.FieldCommitMessage:
+		m.ResetCommitMessage()
+		return nil
+	case gitattestation.FieldParentHashes:
+		m.ResetParentHashes()
+		return nil
+	case gitattestation.FieldRefs:
+		m.ResetRefs()
+		return nil
+	case gitattestation.FieldRemotes:
+		m.ResetRemotes()
+		return nil
+	case gitattestation.FieldTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown GitAttestation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GitAttestationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.attestation != nil {
+		edges = append(edges, gitattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GitAttestationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gitattestation.EdgeAttestation:
+		if id := m.attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GitAttestationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GitAttestationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GitAttestationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedattestation {
+		edges = append(edges, gitattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GitAttestationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gitattestation.EdgeAttestation:
+		return m.clearedattestation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GitAttestationMutation) ClearEdge(name string) error {
+	switch name {
+	case gitattestation.EdgeAttestation:
+		m.ClearAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown GitAttestation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GitAttestationMutation) ResetEdge(name string) error {
+	switch name {
+	case gitattestation.EdgeAttestation:
+		m.ResetAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown GitAttestation edge %s", name)
+}
+
+// GithubAttestationMutation represents an operation that mutates the GithubAttestation nodes in the graph.
+type GithubAttestationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	tenant             *string
+	ci_config_path     *string
+	pipeline_id        *string
+	pipeline_name      *string
+	pipeline_url       *string
+	project_url        *string
+	runner_id          *string
+	ci_host            *string
+	ci_server_url      *string
+	runner_arch        *string
+	runner_os          *string
+	clearedFields      map[string]struct{}
+	attestation        *uuid.UUID
+	clearedattestation bool
+	done               bool
+	oldValue           func(context.Context) (*GithubAttestation, error)
+	predicates         []predicate.GithubAttestation
+}
+
+var _ ent.Mutation = (*GithubAttestationMutation)(nil)
+
+// githubattestationOption allows management of the mutation configuration using functional options.
+type githubattestationOption func(*GithubAttestationMutation)
+
+// newGithubAttestationMutation creates new mutation for the GithubAttestation entity.
+func newGithubAttestationMutation(c config, op Op, opts ...githubattestationOption) *GithubAttestationMutation {
+	m := &GithubAttestationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGithubAttestation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGithubAttestationID sets the ID field of the mutation.
+func withGithubAttestationID(id uuid.UUID) githubattestationOption {
+	return func(m *GithubAttestationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GithubAttestation
+		)
+		m.oldValue = func(ctx context.Context) (*GithubAttestation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GithubAttestation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGithubAttestation sets the old GithubAttestation of the mutation.
+func withGithubAttestation(node *GithubAttestation) githubattestationOption {
+	return func(m *GithubAttestationMutation) {
+		m.oldValue = func(context.Context) (*GithubAttestation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GithubAttestationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GithubAttestationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GithubAttestation entities.
+func (m *GithubAttestationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GithubAttestationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GithubAttestationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GithubAttestation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *GithubAttestationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *GithubAttestationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *GithubAttestationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetCiConfigPath sets the "ci_config_path" field.
+func (m *GithubAttestationMutation) SetCiConfigPath(s string) {
+	m.ci_config_path = &s
+}
+
+// CiConfigPath returns the value of the "ci_config_path" field in the mutation.
+func (m *GithubAttestationMutation) CiConfigPath() (r string, exists bool) {
+	v := m.ci_config_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiConfigPath returns the old "ci_config_path" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldCiConfigPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiConfigPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiConfigPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiConfigPath: %w", err)
+	}
+	return oldValue.CiConfigPath, nil
+}
+
+// ClearCiConfigPath clears the value of the "ci_config_path" field.
+func (m *GithubAttestationMutation) ClearCiConfigPath() {
+	m.ci_config_path = nil
+	m.clearedFields[githubattestation.FieldCiConfigPath] = struct{}{}
+}
+
+// CiConfigPathCleared returns if the "ci_config_path" field was cleared in this mutation.
+func (m *GithubAttestationMutation) CiConfigPathCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldCiConfigPath]
+	return ok
+}
+
+// ResetCiConfigPath resets all changes to the "ci_config_path" field.
+func (m *GithubAttestationMutation) ResetCiConfigPath() {
+	m.ci_config_path = nil
+	delete(m.clearedFields, githubattestation.FieldCiConfigPath)
+}
+
+// SetPipelineID sets the "pipeline_id" field.
+func (m *GithubAttestationMutation) SetPipelineID(s string) {
+	m.pipeline_id = &s
+}
+
+// PipelineID returns the value of the "pipeline_id" field in the mutation.
+func (m *GithubAttestationMutation) PipelineID() (r string, exists bool) {
+	v := m.pipeline_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipelineID returns the old "pipeline_id" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldPipelineID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipelineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipelineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipelineID: %w", err)
+	}
+	return oldValue.PipelineID, nil
+}
+
+// ClearPipelineID clears the value of the "pipeline_id" field.
+func (m *GithubAttestationMutation) ClearPipelineID() {
+	m.pipeline_id = nil
+	m.clearedFields[githubattestation.FieldPipelineID] = struct{}{}
+}
+
+// PipelineIDCleared returns if the "pipeline_id" field was cleared in this mutation.
+func (m *GithubAttestationMutation) PipelineIDCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldPipelineID]
+	return ok
+}
+
+// ResetPipelineID resets all changes to the "pipeline_id" field.
+func (m *GithubAttestationMutation) ResetPipelineID() {
+	m.pipeline_id = nil
+	delete(m.clearedFields, githubattestation.FieldPipelineID)
+}
+
+// SetPipelineName sets the "pipeline_name" field.
+func (m *GithubAttestationMutation) SetPipelineName(s string) {
+	m.pipeline_name = &s
+}
+
+// PipelineName returns the value of the "pipeline_name" field in the mutation.
+func (m *GithubAttestationMutation) PipelineName() (r string, exists bool) {
+	v := m.pipeline_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipelineName returns the old "pipeline_name" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldPipelineName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipelineName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipelineName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipelineName: %w", err)
+	}
+	return oldValue.PipelineName, nil
+}
+
+// ClearPipelineName clears the value of the "pipeline_name" field.
+func (m *GithubAttestationMutation) ClearPipelineName() {
+	m.pipeline_name = nil
+	m.clearedFields[githubattestation.FieldPipelineName] = struct{}{}
+}
+
+// PipelineNameCleared returns if the "pipeline_name" field was cleared in this mutation.
+func (m *GithubAttestationMutation) PipelineNameCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldPipelineName]
+	return ok
+}
+
+// ResetPipelineName resets all changes to the "pipeline_name" field.
+func (m *GithubAttestationMutation) ResetPipelineName() {
+	m.pipeline_name = nil
+	delete(m.clearedFields, githubattestation.FieldPipelineName)
+}
+
+// SetPipelineURL sets the "pipeline_url" field.
+func (m *GithubAttestationMutation) SetPipelineURL(s string) {
+	m.pipeline_url = &s
+}
+
+// PipelineURL returns the value of the "pipeline_url" field in the mutation.
+func (m *GithubAttestationMutation) PipelineURL() (r string, exists bool) {
+	v := m.pipeline_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipelineURL returns the old "pipeline_url" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldPipelineURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipelineURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipelineURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipelineURL: %w", err)
+	}
+	return oldValue.PipelineURL, nil
+}
+
+// ClearPipelineURL clears the value of the "pipeline_url" field.
+func (m *GithubAttestationMutation) ClearPipelineURL() {
+	m.pipeline_url = nil
+	m.clearedFields[githubattestation.FieldPipelineURL] = struct{}{}
+}
+
+// PipelineURLCleared returns if the "pipeline_url" field was cleared in this mutation.
+func (m *GithubAttestationMutation) PipelineURLCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldPipelineURL]
+	return ok
+}
+
+// ResetPipelineURL resets all changes to the "pipeline_url" field.
+func (m *GithubAttestationMutation) ResetPipelineURL() {
+	m.pipeline_url = nil
+	delete(m.clearedFields, githubattestation.FieldPipelineURL)
+}
+
+// SetProjectURL sets the "project_url" field.
+func (m *GithubAttestationMutation) SetProjectURL(s string) {
+	m.project_url = &s
+}
+
+// ProjectURL returns the value of the "project_url" field in the mutation.
+func (m *GithubAttestationMutation) ProjectURL() (r string, exists bool) {
+	v := m.project_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectURL returns the old "project_url" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldProjectURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectURL: %w", err)
+	}
+	return oldValue.ProjectURL, nil
+}
+
+// ClearProjectURL clears the value of the "project_url" field.
+func (m *GithubAttestationMutation) ClearProjectURL() {
+	m.project_url = nil
+	m.clearedFields[githubattestation.FieldProjectURL] = struct{}{}
+}
+
+// ProjectURLCleared returns if the "project_url" field was cleared in this mutation.
+func (m *GithubAttestationMutation) ProjectURLCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldProjectURL]
+	return ok
+}
+
+// ResetProjectURL resets all changes to the "project_url" field.
+func (m *GithubAttestationMutation) ResetProjectURL() {
+	m.project_url = nil
+	delete(m.clearedFields, githubattestation.FieldProjectURL)
+}
+
+// SetRunnerID sets the "runner_id" field.
+func (m *GithubAttestationMutation) SetRunnerID(s string) {
+	m.runner_id = &s
+}
+
+// RunnerID returns the value of the "runner_id" field in the mutation.
+func (m *GithubAttestationMutation) RunnerID() (r string, exists bool) {
+	v := m.runner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunnerID returns the old "runner_id" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldRunnerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunnerID: %w", err)
+	}
+	return oldValue.RunnerID, nil
+}
+
+// ClearRunnerID clears the value of the "runner_id" field.
+func (m *GithubAttestationMutation) ClearRunnerID() {
+	m.runner_id = nil
+	m.clearedFields[githubattestation.FieldRunnerID] = struct{}{}
+}
+
+// RunnerIDCleared returns if the "runner_id" field was cleared in this mutation.
+func (m *GithubAttestationMutation) RunnerIDCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldRunnerID]
+	return ok
+}
+
+// ResetRunnerID resets all changes to the "runner_id" field.
+func (m *GithubAttestationMutation) ResetRunnerID() {
+	m.runner_id = nil
+	delete(m.clearedFields, githubattestation.FieldRunnerID)
+}
+
+// SetCiHost sets the "ci_host" field.
+func (m *GithubAttestationMutation) SetCiHost(s string) {
+	m.ci_host = &s
+}
+
+// CiHost returns the value of the "ci_host" field in the mutation.
+func (m *GithubAttestationMutation) CiHost() (r string, exists bool) {
+	v := m.ci_host
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiHost returns the old "ci_host" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldCiHost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiHost: %w", err)
+	}
+	return oldValue.CiHost, nil
+}
+
+// ClearCiHost clears the value of the "ci_host" field.
+func (m *GithubAttestationMutation) ClearCiHost() {
+	m.ci_host = nil
+	m.clearedFields[githubattestation.FieldCiHost] = struct{}{}
+}
+
+// CiHostCleared returns if the "ci_host" field was cleared in this mutation.
+func (m *GithubAttestationMutation) CiHostCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldCiHost]
+	return ok
+}
+
+// ResetCiHost resets all changes to the "ci_host" field.
+func (m *GithubAttestationMutation) ResetCiHost() {
+	m.ci_host = nil
+	delete(m.clearedFields, githubattestation.FieldCiHost)
+}
+
+// SetCiServerURL sets the "ci_server_url" field.
+func (m *GithubAttestationMutation) SetCiServerURL(s string) {
+	m.ci_server_url = &s
+}
+
+// CiServerURL returns the value of the "ci_server_url" field in the mutation.
+func (m *GithubAttestationMutation) CiServerURL() (r string, exists bool) {
+	v := m.ci_server_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiServerURL returns the old "ci_server_url" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldCiServerURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiServerURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiServerURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiServerURL: %w", err)
+	}
+	return oldValue.CiServerURL, nil
+}
+
+// ClearCiServerURL clears the value of the "ci_server_url" field.
+func (m *GithubAttestationMutation) ClearCiServerURL() {
+	m.ci_server_url = nil
+	m.clearedFields[githubattestation.FieldCiServerURL] = struct{}{}
+}
+
+// CiServerURLCleared returns if the "ci_server_url" field was cleared in this mutation.
+func (m *GithubAttestationMutation) CiServerURLCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldCiServerURL]
+	return ok
+}
+
+// ResetCiServerURL resets all changes to the "ci_server_url" field.
+func (m *GithubAttestationMutation) ResetCiServerURL() {
+	m.ci_server_url = nil
+	delete(m.clearedFields, githubattestation.FieldCiServerURL)
+}
+
+// SetRunnerArch sets the "runner_arch" field.
+func (m *GithubAttestationMutation) SetRunnerArch(s string) {
+	m.runner_arch = &s
+}
+
+// RunnerArch returns the value of the "runner_arch" field in the mutation.
+func (m *GithubAttestationMutation) RunnerArch() (r string, exists bool) {
+	v := m.runner_arch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunnerArch returns the old "runner_arch" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldRunnerArch(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunnerArch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunnerArch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunnerArch: %w", err)
+	}
+	return oldValue.RunnerArch, nil
+}
+
+// ClearRunnerArch clears the value of the "runner_arch" field.
+func (m *GithubAttestationMutation) ClearRunnerArch() {
+	m.runner_arch = nil
+	m.clearedFields[githubattestation.FieldRunnerArch] = struct{}{}
+}
+
+// RunnerArchCleared returns if the "runner_arch" field was cleared in this mutation.
+func (m *GithubAttestationMutation) RunnerArchCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldRunnerArch]
+	return ok
+}
+
+// ResetRunnerArch resets all changes to the "runner_arch" field.
+func (m *GithubAttestationMutation) ResetRunnerArch() {
+	m.runner_arch = nil
+	delete(m.clearedFields, githubattestation.FieldRunnerArch)
+}
+
+// SetRunnerOs sets the "runner_os" field.
+func (m *GithubAttestationMutation) SetRunnerOs(s string) {
+	m.runner_os = &s
+}
+
+// RunnerOs returns the value of the "runner_os" field in the mutation.
+func (m *GithubAttestationMutation) RunnerOs() (r string, exists bool) {
+	v := m.runner_os
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunnerOs returns the old "runner_os" field's value of the GithubAttestation entity.
+// If the GithubAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAttestationMutation) OldRunnerOs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunnerOs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunnerOs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunnerOs: %w", err)
+	}
+	return oldValue.RunnerOs, nil
+}
+
+// ClearRunnerOs clears the value of the "runner_os" field.
+func (m *GithubAttestationMutation) ClearRunnerOs() {
+	m.runner_os = nil
+	m.clearedFields[githubattestation.FieldRunnerOs] = struct{}{}
+}
+
+// RunnerOsCleared returns if the "runner_os" field was cleared in this mutation.
+func (m *GithubAttestationMutation) RunnerOsCleared() bool {
+	_, ok := m.clearedFields[githubattestation.FieldRunnerOs]
+	return ok
+}
+
+// ResetRunnerOs resets all changes to the "runner_os" field.
+func (m *GithubAttestationMutation) ResetRunnerOs() {
+	m.runner_os = nil
+	delete(m.clearedFields, githubattestation.FieldRunnerOs)
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by id.
+func (m *GithubAttestationMutation) SetAttestationID(id uuid.UUID) {
+	m.attestation = &id
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (m *GithubAttestationMutation) ClearAttestation() {
+	m.clearedattestation = true
+}
+
+// AttestationCleared reports if the "attestation" edge to the Attestation entity was cleared.
+func (m *GithubAttestationMutation) AttestationCleared() bool {
+	return m.clearedattestation
+}
+
+// AttestationID returns the "attestation" edge ID in the mutation.
+func (m *GithubAttestationMutation) AttestationID() (id uuid.UUID, exists bool) {
+	if m.attestation != nil {
+		return *m.attestation, true
+	}
+	return
+}
+
+// AttestationIDs returns the "attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttestationID instead. It exists only for internal usage by the builders.
+func (m *GithubAttestationMutation) AttestationIDs() (ids []uuid.UUID) {
+	if id := m.attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttestation resets all changes to the "attestation" edge.
+func (m *GithubAttestationMutation) ResetAttestation() {
+	m.attestation = nil
+	m.clearedattestation = false
+}
+
+// Where appends a list predicates to the GithubAttestationMutation builder.
+func (m *GithubAttestationMutation) Where(ps ...predicate.GithubAttestation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GithubAttestationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GithubAttestationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GithubAttestation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GithubAttestationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GithubAttestationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GithubAttestation).
+func (m *GithubAttestationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GithubAttestationMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.tenant != nil {
+		fields = append(fields, githubattestation.FieldTenant)
+	}
+	if m.ci_config_path != nil {
+		fields = append(fields, githubattestation.FieldCiConfigPath)
+	}
+	if m.pipeline_id != nil {
+		fields = append(fields, githubattestation.FieldPipelineID)
+	}
+	if m.pipeline_name != nil {
+		fields = append(fields, githubattestation.FieldPipelineName)
+	}
+	if m.pipeline_url != nil {
+		fields = append(fields, githubattestation.FieldPipelineURL)
+	}
+	if m.project_url != nil {
+		fields = append(fields, githubattestation.FieldProjectURL)
+	}
+	if m.runner_id != nil {
+		fields = append(fields, githubattestation.FieldRunnerID)
+	}
+	if m.ci_host != nil {
+		fields = append(fields, githubattestation.FieldCiHost)
+	}
+	if m.ci_server_url != nil {
+		fields = append(fields, githubattestation.FieldCiServerURL)
+	}
+	if m.runner_arch != nil {
+		fields = append(fields, githubattestation.FieldRunnerArch)
+	}
+	if m.runner_os != nil {
+		fields = append(fields, githubattestation.FieldRunnerOs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GithubAttestationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case githubattestation.FieldTenant:
+		return m.Tenant()
+	case githubattestation.FieldCiConfigPath:
+		return m.CiConfigPath()
+	case githubattestation.FieldPipelineID:
+		return m.PipelineID()
+	case githubattestation.FieldPipelineName:
+		return m.PipelineName()
+	case githubattestation.FieldPipelineURL:
+		return m.PipelineURL()
+	case githubattestation.FieldProjectURL:
+		return m.ProjectURL()
+	case githubattestation.FieldRunnerID:
+		return m.RunnerID()
+	case githubattestation.FieldCiHost:
+		return m.CiHost()
+	case githubattestation.FieldCiServerURL:
+		return m.CiServerURL()
+	case githubattestation.FieldRunnerArch:
+		return m.RunnerArch()
+	case githubattestation.FieldRunnerOs:
+		return m.RunnerOs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GithubAttestationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case githubattestation.FieldTenant:
+		return m.OldTenant(ctx)
+	case githubattestation.FieldCiConfigPath:
+		return m.OldCiConfigPath(ctx)
+	case githubattestation.FieldPipelineID:
+		return m.OldPipelineID(ctx)
+	case githubattestation.FieldPipelineName:
+		return m.OldPipelineName(ctx)
+	case githubattestation.FieldPipelineURL:
+		return m.OldPipelineURL(ctx)
+	case githubattestation.FieldProjectURL:
+		return m.OldProjectURL(ctx)
+	case githubattestation.FieldRunnerID:
+		return m.OldRunnerID(ctx)
+	case githubattestation.FieldCiHost:
+		return m.OldCiHost(ctx)
+	case githubattestation.FieldCiServerURL:
+		return m.OldCiServerURL(ctx)
+	case githubattestation.FieldRunnerArch:
+		return m.OldRunnerArch(ctx)
+	case githubattestation.FieldRunnerOs:
+		return m.OldRunnerOs(ctx)
+	}
+	return nil, fmt.Errorf("unknown GithubAttestation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GithubAttestationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case githubattestation.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case githubattestation.FieldCiConfigPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiConfigPath(v)
+		return nil
+	case githubattestation.FieldPipelineID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipelineID(v)
+		return nil
+	case githubattestation.FieldPipelineName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipelineName(v)
+		return nil
+	case githubattestation.FieldPipelineURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipelineURL(v)
+		return nil
+	case githubattestation.FieldProjectURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectURL(v)
+		return nil
+	case githubattestation.FieldRunnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunnerID(v)
+		return nil
+	case githubattestation.FieldCiHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiHost(v)
+		return nil
+	case githubattestation.FieldCiServerURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiServerURL(v)
+		return nil
+	case githubattestation.FieldRunnerArch:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunnerArch(v)
+		return nil
+	case githubattestation.FieldRunnerOs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunnerOs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GithubAttestation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GithubAttestationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GithubAttestationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GithubAttestationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GithubAttestation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GithubAttestationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(githubattestation.FieldCiConfigPath) {
+		fields = append(fields, githubattestation.FieldCiConfigPath)
+	}
+	if m.FieldCleared(githubattestation.FieldPipelineID) {
+		fields = append(fields, githubattestation.FieldPipelineID)
+	}
+	if m.FieldCleared(githubattestation.FieldPipelineName) {
+		fields = append(fields, githubattestation.FieldPipelineName)
+	}
+	if m.FieldCleared(githubattestation.FieldPipelineURL) {
+		fields = append(fields, githubattestation.FieldPipelineURL)
+	}
+	if m.FieldCleared(githubattestation.FieldProjectURL) {
+		fields = append(fields, githubattestation.FieldProjectURL)
+	}
+	if m.FieldCleared(githubattestation.FieldRunnerID) {
+		fields = append(fields, githubattestation.FieldRunnerID)
+	}
+	if m.FieldCleared(githubattestation.FieldCiHost) {
+		fields = append(fields, githubattestation.FieldCiHost)
+	}
+	if m.FieldCleared(githubattestation.FieldCiServerURL) {
+		fields = append(fields, githubattestation.FieldCiServerURL)
+	}
+	if m.FieldCleared(githubattestation.FieldRunnerArch) {
+		fields = append(fields, githubattestation.FieldRunnerArch)
+	}
+	if m.FieldCleared(githubattestation.FieldRunnerOs) {
+		fields = append(fields, githubattestation.FieldRunnerOs)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GithubAttestationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GithubAttestationMutation) ClearField(name string) error {
+	switch name {
+	case githubattestation.FieldCiConfigPath:
+		m.ClearCiConfigPath()
+		return nil
+	case githubattestation.FieldPipelineID:
+		m.ClearPipelineID()
+		return nil
+	case githubattestation.FieldPipelineName:
+		m.ClearPipelineName()
+		return nil
+	case githubattestation.FieldPipelineURL:
+		m.ClearPipelineURL()
+		return nil
+	case githubattestation.FieldProjectURL:
+		m.ClearProjectURL()
+		return nil
+	case githubattestation.FieldRunnerID:
+		m.ClearRunnerID()
+		return nil
+	case githubattestation.FieldCiHost:
+		m.ClearCiHost()
+		return nil
+	case githubattestation.FieldCiServerURL:
+		m.ClearCiServerURL()
+		return nil
+	case githubattestation.FieldRunnerArch:
+		m.ClearRunnerArch()
+		return nil
+	case githubattestation.FieldRunnerOs:
+		m.ClearRunnerOs()
+		return nil
+	}
+	return fmt.Errorf("unknown GithubAttestation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GithubAttestationMutation) ResetField(name string) error {
+	switch name {
+	case githubattestation.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case githubattestation.FieldCiConfigPath:
+		m.ResetCiConfigPath()
+		return nil
+	case githubattestation.FieldPipelineID:
+		m.ResetPipelineID()
+		return nil
+	case githubattestation.FieldPipelineName:
+		m.ResetPipelineName()
+		return nil
+	case githubattestation.FieldPipelineURL:
+		m.ResetPipelineURL()
+		return nil
+	case githubattestation.FieldProjectURL:
+		m.ResetProjectURL()
+		return nil
+	case githubattestation.FieldRunnerID:
+		m.ResetRunnerID()
+		return nil
+	case githubattestation.FieldCiHost:
+		m.ResetCiHost()
+		return nil
+	case githubattestation.FieldCiServerURL:
+		m.ResetCiServerURL()
+		return nil
+	case githubattestation.FieldRunnerArch:
+		m.ResetRunnerArch()
+		return nil
+	case githubattestation.FieldRunnerOs:
+		m.ResetRunnerOs()
+		return nil
+	}
+	return fmt.Errorf("unknown GithubAttestation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GithubAttestationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.attestation != nil {
+		edges = append(edges, githubattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GithubAttestationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case githubattestation.EdgeAttestation:
+		if id := m.attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GithubAttestationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GithubAttestationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GithubAttestationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedattestation {
+		edges = append(edges, githubattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GithubAttestationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case githubattestation.EdgeAttestation:
+		return m.clearedattestation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GithubAttestationMutation) ClearEdge(name string) error {
+	switch name {
+	case githubattestation.EdgeAttestation:
+		m.ClearAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown GithubAttestation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GithubAttestationMutation) ResetEdge(name string) error {
+	switch name {
+	case githubattestation.EdgeAttestation:
+		m.ResetAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown GithubAttestation edge %s", name)
+}
+
+// GitlabAttestationMutation represents an operation that mutates the GitlabAttestation nodes in the graph.
+type GitlabAttestationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	tenant             *string
+	ci_config_path     *string
+	pipeline_id        *string
+	job_id             *string
+	job_image          *string
+	job_name           *string
+	job_stage          *string
+	job_url            *string
+	pipeline_url       *string
+	project_id         *string
+	project_url        *string
+	runner_id          *string
+	ci_host            *string
+	ci_server_url      *string
+	clearedFields      map[string]struct{}
+	attestation        *uuid.UUID
+	clearedattestation bool
+	done               bool
+	oldValue           func(context.Context) (*GitlabAttestation, error)
+	predicates         []predicate.GitlabAttestation
+}
+
+var _ ent.Mutation = (*GitlabAttestationMutation)(nil)
+
+// gitlabattestationOption allows management of the mutation configuration using functional options.
+type gitlabattestationOption func(*GitlabAttestationMutation)
+
+// newGitlabAttestationMutation creates new mutation for the GitlabAttestation entity.
+func newGitlabAttestationMutation(c config, op Op, opts ...gitlabattestationOption) *GitlabAttestationMutation {
+	m := &GitlabAttestationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGitlabAttestation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGitlabAttestationID sets the ID field of the mutation.
+func withGitlabAttestationID(id uuid.UUID) gitlabattestationOption {
+	return func(m *GitlabAttestationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GitlabAttestation
+		)
+		m.oldValue = func(ctx context.Context) (*GitlabAttestation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GitlabAttestation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGitlabAttestation sets the old GitlabAttestation of the mutation.
+func withGitlabAttestation(node *GitlabAttestation) gitlabattestationOption {
+	return func(m *GitlabAttestationMutation) {
+		m.oldValue = func(context.Context) (*GitlabAttestation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GitlabAttestationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GitlabAttestationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GitlabAttestation entities.
+func (m *GitlabAttestationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GitlabAttestationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GitlabAttestationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GitlabAttestation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *GitlabAttestationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *GitlabAttestationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *GitlabAttestationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetCiConfigPath sets the "ci_config_path" field.
+func (m *GitlabAttestationMutation) SetCiConfigPath(s string) {
+	m.ci_config_path = &s
+}
+
+// CiConfigPath returns the value of the "ci_config_path" field in the mutation.
+func (m *GitlabAttestationMutation) CiConfigPath() (r string, exists bool) {
+	v := m.ci_config_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiConfigPath returns the old "ci_config_path" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldCiConfigPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiConfigPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiConfigPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiConfigPath: %w", err)
+	}
+	return oldValue.CiConfigPath, nil
+}
+
+// ClearCiConfigPath clears the value of the "ci_config_path" field.
+func (m *GitlabAttestationMutation) ClearCiConfigPath() {
+	m.ci_config_path = nil
+	m.clearedFields[gitlabattestation.FieldCiConfigPath] = struct{}{}
+}
+
+// CiConfigPathCleared returns if the "ci_config_path" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) CiConfigPathCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldCiConfigPath]
+	return ok
+}
+
+// ResetCiConfigPath resets all changes to the "ci_config_path" field.
+func (m *GitlabAttestationMutation) ResetCiConfigPath() {
+	m.ci_config_path = nil
+	delete(m.clearedFields, gitlabattestation.FieldCiConfigPath)
+}
+
+// SetPipelineID sets the "pipeline_id" field.
+func (m *GitlabAttestationMutation) SetPipelineID(s string) {
+	m.pipeline_id = &s
+}
+
+// PipelineID returns the value of the "pipeline_id" field in the mutation.
+func (m *GitlabAttestationMutation) PipelineID() (r string, exists bool) {
+	v := m.pipeline_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipelineID returns the old "pipeline_id" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldPipelineID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipelineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipelineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipelineID: %w", err)
+	}
+	return oldValue.PipelineID, nil
+}
+
+// ClearPipelineID clears the value of the "pipeline_id" field.
+func (m *GitlabAttestationMutation) ClearPipelineID() {
+	m.pipeline_id = nil
+	m.clearedFields[gitlabattestation.FieldPipelineID] = struct{}{}
+}
+
+// PipelineIDCleared returns if the "pipeline_id" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) PipelineIDCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldPipelineID]
+	return ok
+}
+
+// ResetPipelineID resets all changes to the "pipeline_id" field.
+func (m *GitlabAttestationMutation) ResetPipelineID() {
+	m.pipeline_id = nil
+	delete(m.clearedFields, gitlabattestation.FieldPipelineID)
+}
+
+// SetJobID sets the "job_id" field.
+func (m *GitlabAttestationMutation) SetJobID(s string) {
+	m.job_id = &s
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *GitlabAttestationMutation) JobID() (r string, exists bool) {
+	v := m.job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldJobID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ClearJobID clears the value of the "job_id" field.
+func (m *GitlabAttestationMutation) ClearJobID() {
+	m.job_id = nil
+	m.clearedFields[gitlabattestation.FieldJobID] = struct{}{}
+}
+
+// JobIDCleared returns if the "job_id" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) JobIDCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldJobID]
+	return ok
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *GitlabAttestationMutation) ResetJobID() {
+	m.job_id = nil
+	delete(m.clearedFields, gitlabattestation.FieldJobID)
+}
+
+// SetJobImage sets the "job_image" field.
+func (m *GitlabAttestationMutation) SetJobImage(s string) {
+	m.job_image = &s
+}
+
+// JobImage returns the value of the "job_image" field in the mutation.
+func (m *GitlabAttestationMutation) JobImage() (r string, exists bool) {
+	v := m.job_image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobImage returns the old "job_image" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldJobImage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobImage: %w", err)
+	}
+	return oldValue.JobImage, nil
+}
+
+// ClearJobImage clears the value of the "job_image" field.
+func (m *GitlabAttestationMutation) ClearJobImage() {
+	m.job_image = nil
+	m.clearedFields[gitlabattestation.FieldJobImage] = struct{}{}
+}
+
+// JobImageCleared returns if the "job_image" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) JobImageCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldJobImage]
+	return ok
+}
+
+// ResetJobImage resets all changes to the "job_image" field.
+func (m *GitlabAttestationMutation) ResetJobImage() {
+	m.job_image = nil
+	delete(m.clearedFields, gitlabattestation.FieldJobImage)
+}
+
+// SetJobName sets the "job_name" field.
+func (m *GitlabAttestationMutation) SetJobName(s string) {
+	m.job_name = &s
+}
+
+// JobName returns the value of the "job_name" field in the mutation.
+func (m *GitlabAttestationMutation) JobName() (r string, exists bool) {
+	v := m.job_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobName returns the old "job_name" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldJobName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
+	}
+	return oldValue.JobName, nil
+}
+
+// ClearJobName clears the value of the "job_name" field.
+func (m *GitlabAttestationMutation) ClearJobName() {
+	m.job_name = nil
+	m.clearedFields[gitlabattestation.FieldJobName] = struct{}{}
+}
+
+// JobNameCleared returns if the "job_name" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) JobNameCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldJobName]
+	return ok
+}
+
+// ResetJobName resets all changes to the "job_name" field.
+func (m *GitlabAttestationMutation) ResetJobName() {
+	m.job_name = nil
+	delete(m.clearedFields, gitlabattestation.FieldJobName)
+}
+
+// SetJobStage sets the "job_stage" field.
+func (m *GitlabAttestationMutation) SetJobStage(s string) {
+	m.job_stage = &s
+}
+
+// JobStage returns the value of the "job_stage" field in the mutation.
+func (m *GitlabAttestationMutation) JobStage() (r string, exists bool) {
+	v := m.job_stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobStage returns the old "job_stage" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldJobStage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobStage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobStage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobStage: %w", err)
+	}
+	return oldValue.JobStage, nil
+}
+
+// ClearJobStage clears the value of the "job_stage" field.
+func (m *GitlabAttestationMutation) ClearJobStage() {
+	m.job_stage = nil
+	m.clearedFields[gitlabattestation.FieldJobStage] = struct{}{}
+}
+
+// JobStageCleared returns if the "job_stage" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) JobStageCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldJobStage]
+	return ok
+}
+
+// ResetJobStage resets all changes to the "job_stage" field.
+func (m *GitlabAttestationMutation) ResetJobStage() {
+	m.job_stage = nil
+	delete(m.clearedFields, gitlabattestation.FieldJobStage)
+}
+
+// SetJobURL sets the "job_url" field.
+func (m *GitlabAttestationMutation) SetJobURL(s string) {
+	m.job_url = &s
+}
+
+// JobURL returns the value of the "job_url" field in the mutation.
+func (m *GitlabAttestationMutation) JobURL() (r string, exists bool) {
+	v := m.job_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobURL returns the old "job_url" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldJobURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobURL: %w", err)
+	}
+	return oldValue.JobURL, nil
+}
+
+// ClearJobURL clears the value of the "job_url" field.
+func (m *GitlabAttestationMutation) ClearJobURL() {
+	m.job_url = nil
+	m.clearedFields[gitlabattestation.FieldJobURL] = struct{}{}
+}
+
+// JobURLCleared returns if the "job_url" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) JobURLCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldJobURL]
+	return ok
+}
+
+// ResetJobURL resets all changes to the "job_url" field.
+func (m *GitlabAttestationMutation) ResetJobURL() {
+	m.job_url = nil
+	delete(m.clearedFields, gitlabattestation.FieldJobURL)
+}
+
+// SetPipelineURL sets the "pipeline_url" field.
+func (m *GitlabAttestationMutation) SetPipelineURL(s string) {
+	m.pipeline_url = &s
+}
+
+// PipelineURL returns the value of the "pipeline_url" field in the mutation.
+func (m *GitlabAttestationMutation) PipelineURL() (r string, exists bool) {
+	v := m.pipeline_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipelineURL returns the old "pipeline_url" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldPipelineURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipelineURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipelineURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipelineURL: %w", err)
+	}
+	return oldValue.PipelineURL, nil
+}
+
+// ClearPipelineURL clears the value of the "pipeline_url" field.
+func (m *GitlabAttestationMutation) ClearPipelineURL() {
+	m.pipeline_url = nil
+	m.clearedFields[gitlabattestation.FieldPipelineURL] = struct{}{}
+}
+
+// PipelineURLCleared returns if the "pipeline_url" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) PipelineURLCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldPipelineURL]
+	return ok
+}
+
+// ResetPipelineURL resets all changes to the "pipeline_url" field.
+func (m *GitlabAttestationMutation) ResetPipelineURL() {
+	m.pipeline_url = nil
+	delete(m.clearedFields, gitlabattestation.FieldPipelineURL)
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *GitlabAttestationMutation) SetProjectID(s string) {
+	m.project_id = &s
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *GitlabAttestationMutation) ProjectID() (r string, exists bool) {
+	v := m.project_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldProjectID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (m *GitlabAttestationMutation) ClearProjectID() {
+	m.project_id = nil
+	m.clearedFields[gitlabattestation.FieldProjectID] = struct{}{}
+}
+
+// ProjectIDCleared returns if the "project_id" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) ProjectIDCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldProjectID]
+	return ok
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *GitlabAttestationMutation) ResetProjectID() {
+	m.project_id = nil
+	delete(m.clearedFields, gitlabattestation.FieldProjectID)
+}
+
+// SetProjectURL sets the "project_url" field.
+func (m *GitlabAttestationMutation) SetProjectURL(s string) {
+	m.project_url = &s
+}
+
+// ProjectURL returns the value of the "project_url" field in the mutation.
+func (m *GitlabAttestationMutation) ProjectURL() (r string, exists bool) {
+	v := m.project_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectURL returns the old "project_url" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldProjectURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectURL: %w", err)
+	}
+	return oldValue.ProjectURL, nil
+}
+
+// ClearProjectURL clears the value of the "project_url" field.
+func (m *GitlabAttestationMutation) ClearProjectURL() {
+	m.project_url = nil
+	m.clearedFields[gitlabattestation.FieldProjectURL] = struct{}{}
+}
+
+// ProjectURLCleared returns if the "project_url" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) ProjectURLCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldProjectURL]
+	return ok
+}
+
+// ResetProjectURL resets all changes to the "project_url" field.
+func (m *GitlabAttestationMutation) ResetProjectURL() {
+	m.project_url = nil
+	delete(m.clearedFields, gitlabattestation.FieldProjectURL)
+}
+
+// SetRunnerID sets the "runner_id" field.
+func (m *GitlabAttestationMutation) SetRunnerID(s string) {
+	m.runner_id = &s
+}
+
+// RunnerID returns the value of the "runner_id" field in the mutation.
+func (m *GitlabAttestationMutation) RunnerID() (r string, exists bool) {
+	v := m.runner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunnerID returns the old "runner_id" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldRunnerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunnerID: %w", err)
+	}
+	return oldValue.RunnerID, nil
+}
+
+// ClearRunnerID clears the value of the "runner_id" field.
+func (m *GitlabAttestationMutation) ClearRunnerID() {
+	m.runner_id = nil
+	m.clearedFields[gitlabattestation.FieldRunnerID] = struct{}{}
+}
+
+// RunnerIDCleared returns if the "runner_id" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) RunnerIDCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldRunnerID]
+	return ok
+}
+
+// ResetRunnerID resets all changes to the "runner_id" field.
+func (m *GitlabAttestationMutation) ResetRunnerID() {
+	m.runner_id = nil
+	delete(m.clearedFields, gitlabattestation.FieldRunnerID)
+}
+
+// SetCiHost sets the "ci_host" field.
+func (m *GitlabAttestationMutation) SetCiHost(s string) {
+	m.ci_host = &s
+}
+
+// CiHost returns the value of the "ci_host" field in the mutation.
+func (m *GitlabAttestationMutation) CiHost() (r string, exists bool) {
+	v := m.ci_host
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiHost returns the old "ci_host" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldCiHost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiHost: %w", err)
+	}
+	return oldValue.CiHost, nil
+}
+
+// ClearCiHost clears the value of the "ci_host" field.
+func (m *GitlabAttestationMutation) ClearCiHost() {
+	m.ci_host = nil
+	m.clearedFields[gitlabattestation.FieldCiHost] = struct{}{}
+}
+
+// CiHostCleared returns if the "ci_host" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) CiHostCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldCiHost]
+	return ok
+}
+
+// ResetCiHost resets all changes to the "ci_host" field.
+func (m *GitlabAttestationMutation) ResetCiHost() {
+	m.ci_host = nil
+	delete(m.clearedFields, gitlabattestation.FieldCiHost)
+}
+
+// SetCiServerURL sets the "ci_server_url" field.
+func (m *GitlabAttestationMutation) SetCiServerURL(s string) {
+	m.ci_server_url = &s
+}
+
+// CiServerURL returns the value of the "ci_server_url" field in the mutation.
+func (m *GitlabAttestationMutation) CiServerURL() (r string, exists bool) {
+	v := m.ci_server_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiServerURL returns the old "ci_server_url" field's value of the GitlabAttestation entity.
+// If the GitlabAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabAttestationMutation) OldCiServerURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiServerURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiServerURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiServerURL: %w", err)
+	}
+	return oldValue.CiServerURL, nil
+}
+
+// ClearCiServerURL clears the value of the "ci_server_url" field.
+func (m *GitlabAttestationMutation) ClearCiServerURL() {
+	m.ci_server_url = nil
+	m.clearedFields[gitlabattestation.FieldCiServerURL] = struct{}{}
+}
+
+// CiServerURLCleared returns if the "ci_server_url" field was cleared in this mutation.
+func (m *GitlabAttestationMutation) CiServerURLCleared() bool {
+	_, ok := m.clearedFields[gitlabattestation.FieldCiServerURL]
+	return ok
+}
+
+// ResetCiServerURL resets all changes to the "ci_server_url" field.
+func (m *GitlabAttestationMutation) ResetCiServerURL() {
+	m.ci_server_url = nil
+	delete(m.clearedFields, gitlabattestation.FieldCiServerURL)
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by id.
+func (m *GitlabAttestationMutation) SetAttestationID(id uuid.UUID) {
+	m.attestation = &id
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (m *GitlabAttestationMutation) ClearAttestation() {
+	m.clearedattestation = true
+}
+
+// AttestationCleared reports if the "attestation" edge to the Attestation entity was cleared.
+func (m *GitlabAttestationMutation) AttestationCleared() bool {
+	return m.clearedattestation
+}
+
+// AttestationID returns the "attestation" edge ID in the mutation.
+func (m *GitlabAttestationMutation) AttestationID() (id uuid.UUID, exists bool) {
+	if m.attestation != nil {
+		return *m.attestation, true
+	}
+	return
+}
+
+// AttestationIDs returns the "attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttestationID instead. It exists only for internal usage by the builders.
+func (m *GitlabAttestationMutation) AttestationIDs() (ids []uuid.UUID) {
+	if id := m.attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttestation resets all changes to the "attestation" edge.
+func (m *GitlabAttestationMutation) ResetAttestation() {
+	m.attestation = nil
+	m.clearedattestation = false
+}
+
+// Where appends a list predicates to the GitlabAttestationMutation builder.
+func (m *GitlabAttestationMutation) Where(ps ...predicate.GitlabAttestation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GitlabAttestationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GitlabAttestationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GitlabAttestation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GitlabAttestationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GitlabAttestationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GitlabAttestation).
+func (m *GitlabAttestationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GitlabAttestationMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.tenant != nil {
+		fields = append(fields, gitlabattestation.FieldTenant)
+	}
+	if m.ci_config_path != nil {
+		fields = append(fields, gitlabattestation.FieldCiConfigPath)
+	}
+	if m.pipeline_id != nil {
+		fields = append(fields, gitlabattestation.FieldPipelineID)
+	}
+	if m.job_id != nil {
+		fields = append(fields, gitlabattestation.FieldJobID)
+	}
+	if m.job_image != nil {
+		fields = append(fields, gitlabattestation.FieldJobImage)
+	}
+	if m.job_name != nil {
+		fields = append(fields, gitlabattestation.FieldJobName)
+	}
+	if m.job_stage != nil {
+		fields = append(fields, gitlabattestation.FieldJobStage)
+	}
+	if m.job_url != nil {
+		fields = append(fields, gitlabattestation.FieldJobURL)
+	}
+	if m.pipeline_url != nil {
+		fields = append(fields, gitlabattestation.FieldPipelineURL)
+	}
+	if m.project_id != nil {
+		fields = append(fields, gitlabattestation.FieldProjectID)
+	}
+	if m.project_url != nil {
+		fields = append(fields, gitlabattestation.FieldProjectURL)
+	}
+	if m.runner_id != nil {
+		fields = append(fields, gitlabattestation.FieldRunnerID)
+	}
+	if m.ci_host != nil {
+		fields = append(fields, gitlabattestation.FieldCiHost)
+	}
+	if m.ci_server_url != nil {
+		fields = append(fields, gitlabattestation.FieldCiServerURL)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GitlabAttestationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gitlabattestation.FieldTenant:
+		return m.Tenant()
+	case gitlabattestation.FieldCiConfigPath:
+		return m.CiConfigPath()
+	case gitlabattestation.FieldPipelineID:
+		return m.PipelineID()
+	case gitlabattestation.FieldJobID:
+		return m.JobID()
+	case gitlabattestation.FieldJobImage:
+		return m.JobImage()
+	case gitlabattestation.FieldJobName:
+		return m.JobName()
+	case gitlabattestation.FieldJobStage:
+		return m.JobStage()
+	case gitlabattestation.FieldJobURL:
+		return m.JobURL()
+	case gitlabattestation.FieldPipelineURL:
+		return m.PipelineURL()
+	case gitlabattestation.FieldProjectID:
+		return m.ProjectID()
+	case gitlabattestation.FieldProjectURL:
+		return m.ProjectURL()
+	case gitlabattestation.FieldRunnerID:
+		return m.RunnerID()
+	case gitlabattestation.FieldCiHost:
+		return m.CiHost()
+	case gitlabattestation.FieldCiServerURL:
+		return m.CiServerURL()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GitlabAttestationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gitlabattestation.FieldTenant:
+		return m.OldTenant(ctx)
+	case gitlabattestation.FieldCiConfigPath:
+		return m.OldCiConfigPath(ctx)
+	case gitlabattestation.FieldPipelineID:
+		return m.OldPipelineID(ctx)
+	case gitlabattestation.FieldJobID:
+		return m.OldJobID(ctx)
+	case gitlabattestation.FieldJobImage:
+		return m.OldJobImage(ctx)
+	case gitlabattestation.FieldJobName:
+		return m.OldJobName(ctx)
+	case gitlabattestation.FieldJobStage:
+		return m.OldJobStage(ctx)
+	case gitlabattestation.FieldJobURL:
+		return m.OldJobURL(ctx)
+	case gitlabattestation.FieldPipelineURL:
+		return m.OldPipelineURL(ctx)
+	case gitlabattestation.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case gitlabattestation.FieldProjectURL:
+		return m.OldProjectURL(ctx)
+	case gitlabattestation.FieldRunnerID:
+		return m.OldRunnerID(ctx)
+	case gitlabattestation.FieldCiHost:
+		return m.OldCiHost(ctx)
+	case gitlabattestation.FieldCiServerURL:
+		return m.OldCiServerURL(ctx)
+	}
+	return nil, fmt.Errorf("unknown GitlabAttestation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GitlabAttestationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gitlabattestation.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case gitlabattestation.FieldCiConfigPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiConfigPath(v)
+		return nil
+	case gitlabattestation.FieldPipelineID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipelineID(v)
+		return nil
+	case gitlabattestation.FieldJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case gitlabattestation.FieldJobImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobImage(v)
+		return nil
+	case gitlabattestation.FieldJobName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobName(v)
+		return nil
+	case gitlabattestation.FieldJobStage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobStage(v)
+		return nil
+	case gitlabattestation.FieldJobURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobURL(v)
+		return nil
+	case gitlabattestation.FieldPipelineURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipelineURL(v)
+		return nil
+	case gitlabattestation.FieldProjectID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case gitlabattestation.FieldProjectURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectURL(v)
+		return nil
+	case gitlabattestation.FieldRunnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunnerID(v)
+		return nil
+	case gitlabattestation.FieldCiHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiHost(v)
+		return nil
+	case gitlabattestation.FieldCiServerURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiServerURL(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GitlabAttestation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GitlabAttestationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GitlabAttestationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GitlabAttestationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GitlabAttestation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GitlabAttestationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gitlabattestation.FieldCiConfigPath) {
+		fields = append(fields, gitlabattestation.FieldCiConfigPath)
+	}
+	if m.FieldCleared(gitlabattestation.FieldPipelineID) {
+		fields = append(fields, gitlabattestation.FieldPipelineID)
+	}
+	if m.FieldCleared(gitlabattestation.FieldJobID) {
+		fields = append(fields, gitlabattestation.FieldJobID)
+	}
+	if m.FieldCleared(gitlabattestation.FieldJobImage) {
+		fields = append(fields, gitlabattestation.FieldJobImage)
+	}
+	if m.FieldCleared(gitlabattestation.FieldJobName) {
+		fields = append(fields, gitlabattestation.FieldJobName)
+	}
+	if m.FieldCleared(gitlabattestation.FieldJobStage) {
+		fields = append(fields, gitlabattestation.FieldJobStage)
+	}
+	if m.FieldCleared(gitlabattestation.FieldJobURL) {
+		fields = append(fields, gitlabattestation.FieldJobURL)
+	}
+	if m.FieldCleared(gitlabattestation.FieldPipelineURL) {
+		fields = append(fields, gitlabattestation.FieldPipelineURL)
+	}
+	if m.FieldCleared(gitlabattestation.FieldProjectID) {
+		fields = append(fields, gitlabattestation.FieldProjectID)
+	}
+	if m.FieldCleared(gitlabattestation.FieldProjectURL) {
+		fields = append(fields, gitlabattestation.FieldProjectURL)
+	}
+	if m.FieldCleared(gitlabattestation.FieldRunnerID) {
+		fields = append(fields, gitlabattestation.FieldRunnerID)
+	}
+	if m.FieldCleared(gitlabattestation.FieldCiHost) {
+		fields = append(fields, gitlabattestation.FieldCiHost)
+	}
+	if m.FieldCleared(gitlabattestation.FieldCiServerURL) {
+		fields = append(fields, gitlabattestation.FieldCiServerURL)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GitlabAttestationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GitlabAttestationMutation) ClearField(name string) error {
+	switch name {
+	case gitlabattestation.FieldCiConfigPath:
+		m.ClearCiConfigPath()
+		return nil
+	case gitlabattestation.FieldPipelineID:
+		m.ClearPipelineID()
+		return nil
+	case gitlabattestation.FieldJobID:
+		m.ClearJobID()
+		return nil
+	case gitlabattestation.FieldJobImage:
+		m.ClearJobImage()
+		return nil
+	case gitlabattestation.FieldJobName:
+		m.ClearJobName()
+		return nil
+	case gitlabattestation.FieldJobStage:
+		m.ClearJobStage()
+		return nil
+	case gitlabattestation.FieldJobURL:
+		m.ClearJobURL()
+		return nil
+	case gitlabattestation.FieldPipelineURL:
+		m.ClearPipelineURL()
+		return nil
+	case gitlabattestation.FieldProjectID:
+		m.ClearProjectID()
+		return nil
+	case gitlabattestation.FieldProjectURL:
+		m.ClearProjectURL()
+		return nil
+	case gitlabattestation.FieldRunnerID:
+		m.ClearRunnerID()
+		return nil
+	case gitlabattestation.FieldCiHost:
+		m.ClearCiHost()
+		return nil
+	case gitlabattestation.FieldCiServerURL:
+		m.ClearCiServerURL()
+		return nil
+	}
+	return fmt.Errorf("unknown GitlabAttestation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GitlabAttestationMutation) ResetField(name string) error {
+	switch name {
+	case gitlabattestation.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case gitlabattestation.FieldCiConfigPath:
+		m.ResetCiConfigPath()
+		return nil
+	case gitlabattestation.FieldPipelineID:
+		m.ResetPipelineID()
+		return nil
+	case gitlabattestation.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case gitlabattestation.FieldJobImage:
+		m.ResetJobImage()
+		return nil
+	case gitlabattestation.FieldJobName:
+		m.ResetJobName()
+		return nil
+	case gitlabattestation.FieldJobStage:
+		m.ResetJobStage()
+		return nil
+	case gitlabattestation.FieldJobURL:
+		m.ResetJobURL()
+		return nil
+	case gitlabattestation.FieldPipelineURL:
+		m.ResetPipelineURL()
+		return nil
+	case gitlabattestation.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case gitlabattestation.FieldProjectURL:
+		m.ResetProjectURL()
+		return nil
+	case gitlabattestation.FieldRunnerID:
+		m.ResetRunnerID()
+		return nil
+	case gitlabattestation.FieldCiHost:
+		m.ResetCiHost()
+		return nil
+	case gitlabattestation.FieldCiServerURL:
+		m.ResetCiServerURL()
+		return nil
+	}
+	return fmt.Errorf("unknown GitlabAttestation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GitlabAttestationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.attestation != nil {
+		edges = append(edges, gitlabattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GitlabAttestationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gitlabattestation.EdgeAttestation:
+		if id := m.attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GitlabAttestationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GitlabAttestationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GitlabAttestationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedattestation {
+		edges = append(edges, gitlabattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GitlabAttestationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gitlabattestation.EdgeAttestation:
+		return m.clearedattestation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GitlabAttestationMutation) ClearEdge(name string) error {
+	switch name {
+	case gitlabattestation.EdgeAttestation:
+		m.ClearAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown GitlabAttestation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GitlabAttestationMutation) ResetEdge(name string) error {
+	switch name {
+	case gitlabattestation.EdgeAttestation:
+		m.ResetAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown GitlabAttestation edge %s", name)
+}
+
+// LegalHoldMutation represents an operation that mutates the LegalHold nodes in the graph.
+type LegalHoldMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	tenant         *string
+	reason         *string
+	subject_digest *string
+	created_by     *string
+	created_at     *time.Time
+	released_by    *string
+	released_at    *time.Time
+	clearedFields  map[string]struct{}
+	dsses          map[uuid.UUID]struct{}
+	removeddsses   map[uuid.UUID]struct{}
+	cleareddsses   bool
+	done           bool
+	oldValue       func(context.Context) (*LegalHold, error)
+	predicates     []predicate.LegalHold
+}
+
+var _ ent.Mutation = (*LegalHoldMutation)(nil)
+
+// legalholdOption allows management of the mutation configuration using functional options.
+type legalholdOption func(*LegalHoldMutation)
+
+// newLegalHoldMutation creates new mutation for the LegalHold entity.
+func newLegalHoldMutation(c config, op Op, opts ...legalholdOption) *LegalHoldMutation {
+	m := &LegalHoldMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLegalHold,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLegalHoldID sets the ID field of the mutation.
+func withLegalHoldID(id uuid.UUID) legalholdOption {
+	return func(m *LegalHoldMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LegalHold
+		)
+		m.oldValue = func(ctx context.Context) (*LegalHold, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LegalHold.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLegalHold sets the old LegalHold of the mutation.
+func withLegalHold(node *LegalHold) legalholdOption {
+	return func(m *LegalHoldMutation) {
+		m.oldValue = func(context.Context) (*LegalHold, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LegalHoldMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LegalHoldMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LegalHold entities.
+func (m *LegalHoldMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LegalHoldMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LegalHoldMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LegalHold.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *LegalHoldMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *LegalHoldMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the LegalHold entity.
+// If the LegalHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalHoldMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *LegalHoldMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *LegalHoldMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *LegalHoldMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the LegalHold entity.
+// If the LegalHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalHoldMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *LegalHoldMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetSubjectDigest sets the "subject_digest" field.
+func (m *LegalHoldMutation) SetSubjectDigest(s string) {
+	m.subject_digest = &s
+}
+
+// SubjectDigest returns the value of the "subject_digest" field in the mutation.
+func (m *LegalHoldMutation) SubjectDigest() (r string, exists bool) {
+	v := m.subject_digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectDigest returns the old "subject_digest" field's value of the LegalHold entity.
+// If the LegalHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalHoldMutation) OldSubjectDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectDigest: %w", err)
+	}
+	return oldValue.SubjectDigest, nil
+}
+
+// ClearSubjectDigest clears the value of the "subject_digest" field.
+func (m *LegalHoldMutation) ClearSubjectDigest() {
+	m.subject_digest = nil
+	m.clearedFields[legalhold.FieldSubjectDigest] = struct{}{}
+}
+
+// SubjectDigestCleared returns if the "subject_digest" field was cleared in this mutation.
+func (m *LegalHoldMutation) SubjectDigestCleared() bool {
+	_, ok := m.clearedFields[legalhold.FieldSubjectDigest]
+	return ok
+}
+
+// ResetSubjectDigest resets all changes to the "subject_digest" field.
+func (m *LegalHoldMutation) ResetSubjectDigest() {
+	m.subject_digest = nil
+	delete(m.clearedFields, legalhold.FieldSubjectDigest)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *LegalHoldMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *LegalHoldMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the LegalHold entity.
+// If the LegalHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalHoldMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *LegalHoldMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[legalhold.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *LegalHoldMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[legalhold.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *LegalHoldMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, legalhold.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LegalHoldMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LegalHoldMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LegalHold entity.
+// If the LegalHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalHoldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LegalHoldMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (m *LegalHoldMutation) SetReleasedBy(s string) {
+	m.released_by = &s
+}
+
+// ReleasedBy returns the value of the "released_by" field in the mutation.
+func (m *LegalHoldMutation) ReleasedBy() (r string, exists bool) {
+	v := m.released_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleasedBy returns the old "released_by" field's value of the LegalHold entity.
+// If the LegalHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalHoldMutation) OldReleasedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleasedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleasedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleasedBy: %w", err)
+	}
+	return oldValue.ReleasedBy, nil
+}
+
+// ClearReleasedBy clears the value of the "released_by" field.
+func (m *LegalHoldMutation) ClearReleasedBy() {
+	m.released_by = nil
+	m.clearedFields[legalhold.FieldReleasedBy] = struct{}{}
+}
+
+// ReleasedByCleared returns if the "released_by" field was cleared in this mutation.
+func (m *LegalHoldMutation) ReleasedByCleared() bool {
+	_, ok := m.clearedFields[legalhold.FieldReleasedBy]
+	return ok
+}
+
+// ResetReleasedBy resets all changes to the "released_by" field.
+func (m *LegalHoldMutation) ResetReleasedBy() {
+	m.released_by = nil
+	delete(m.clearedFields, legalhold.FieldReleasedBy)
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (m *LegalHoldMutation) SetReleasedAt(t time.Time) {
+	m.released_at = &t
+}
+
+// ReleasedAt returns the value of the "released_at" field in the mutation.
+func (m *LegalHoldMutation) ReleasedAt() (r time.Time, exists bool) {
+	v := m.released_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleasedAt returns the old "released_at" field's value of the LegalHold entity.
+// If the LegalHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalHoldMutation) OldReleasedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleasedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleasedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleasedAt: %w", err)
+	}
+	return oldValue.ReleasedAt, nil
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (m *LegalHoldMutation) ClearReleasedAt() {
+	m.released_at = nil
+	m.clearedFields[legalhold.FieldReleasedAt] = struct{}{}
+}
+
+// ReleasedAtCleared returns if the "released_at" field was cleared in this mutation.
+func (m *LegalHoldMutation) ReleasedAtCleared() bool {
+	_, ok := m.clearedFields[legalhold.FieldReleasedAt]
+	return ok
+}
+
+// ResetReleasedAt resets all changes to the "released_at" field.
+func (m *LegalHoldMutation) ResetReleasedAt() {
+	m.released_at = nil
+	delete(m.clearedFields, legalhold.FieldReleasedAt)
+}
+
+// AddDssIDs adds the "dsses" edge to the Dsse entity by ids.
+func (m *LegalHoldMutation) AddDssIDs(ids ...uuid.UUID) {
+	if m.dsses == nil {
+		m.dsses = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.dsses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDsses clears the "dsses" edge to the Dsse entity.
+func (m *LegalHoldMutation) ClearDsses() {
+	m.cleareddsses = true
+}
+
+// DssesCleared reports if the "dsses" edge to the Dsse entity was cleared.
+func (m *LegalHoldMutation) DssesCleared() bool {
+	return m.cleareddsses
+}
+
+// RemoveDssIDs removes the "dsses" edge to the Dsse entity by IDs.
+func (m *LegalHoldMutation) RemoveDssIDs(ids ...uuid.UUID) {
+	if m.removeddsses == nil {
+		m.removeddsses = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.dsses, ids[i])
+		m.removeddsses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDsses returns the removed IDs of the "dsses" edge to the Dsse entity.
+func (m *LegalHoldMutation) RemovedDssesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddsses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DssesIDs returns the "dsses" edge IDs in the mutation.
+func (m *LegalHoldMutation) DssesIDs() (ids []uuid.UUID) {
+	for id := range m.dsses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDsses resets all changes to the "dsses" edge.
+func (m *LegalHoldMutation) ResetDsses() {
+	m.dsses = nil
+	m.cleareddsses = false
+	m.removeddsses = nil
+}
+
+// Where appends a list predicates to the LegalHoldMutation builder.
+func (m *LegalHoldMutation) Where(ps ...predicate.LegalHold) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LegalHoldMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LegalHoldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LegalHold, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LegalHoldMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LegalHoldMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LegalHold).
+func (m *LegalHoldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LegalHoldMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.tenant != nil {
+		fields = append(fields, legalhold.FieldTenant)
+	}
+	if m.reason != nil {
+		fields = append(fields, legalhold.FieldReason)
+	}
+	if m.subject_digest != nil {
+		fields = append(fields, legalhold.FieldSubjectDigest)
+	}
+	if m.created_by != nil {
+		fields = append(fields, legalhold.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, legalhold.FieldCreatedAt)
+	}
+	if m.released_by != nil {
+		fields = append(fields, legalhold.FieldReleasedBy)
+	}
+	if m.released_at != nil {
+		fields = append(fields, legalhold.FieldReleasedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LegalHoldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case legalhold.FieldTenant:
+		return m.Tenant()
+	case legalhold.FieldReason:
+		return m.Reason()
+	case legalhold.FieldSubjectDigest:
+		return m.SubjectDigest()
+	case legalhold.FieldCreatedBy:
+		return m.CreatedBy()
+	case legalhold.FieldCreatedAt:
+		return m.CreatedAt()
+	case legalhold.FieldReleasedBy:
+		return m.ReleasedBy()
+	case legalhold.FieldReleasedAt:
+		return m.ReleasedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LegalHoldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case legalhold.FieldTenant:
+		return m.OldTenant(ctx)
+	case legalhold.FieldReason:
+		return m.OldReason(ctx)
+	case legalhold.FieldSubjectDigest:
+		return m.OldSubjectDigest(ctx)
+	case legalhold.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case legalhold.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case legalhold.FieldReleasedBy:
+		return m.OldReleasedBy(ctx)
+	case legalhold.FieldReleasedAt:
+		return m.OldReleasedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LegalHold field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LegalHoldMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case legalhold.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case legalhold.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case legalhold.FieldSubjectDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectDigest(v)
+		return nil
+	case legalhold.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case legalhold.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case legalhold.FieldReleasedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleasedBy(v)
+		return nil
+	case legalhold.FieldReleasedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleasedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LegalHold field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LegalHoldMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LegalHoldMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LegalHoldMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LegalHold numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LegalHoldMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(legalhold.FieldSubjectDigest) {
+		fields = append(fields, legalhold.FieldSubjectDigest)
+	}
+	if m.FieldCleared(legalhold.FieldCreatedBy) {
+		fields = append(fields, legalhold.FieldCreatedBy)
+	}
+	if m.FieldCleared(legalhold.FieldReleasedBy) {
+		fields = append(fields, legalhold.FieldReleasedBy)
+	}
+	if m.FieldCleared(legalhold.FieldReleasedAt) {
+		fields = append(fields, legalhold.FieldReleasedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LegalHoldMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LegalHoldMutation) ClearField(name string) error {
+	switch name {
+	case legalhold.FieldSubjectDigest:
+		m.ClearSubjectDigest()
+		return nil
+	case legalhold.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case legalhold.FieldReleasedBy:
+		m.ClearReleasedBy()
+		return nil
+	case legalhold.FieldReleasedAt:
+		m.ClearReleasedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LegalHold nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LegalHoldMutation) ResetField(name string) error {
+	switch name {
+	case legalhold.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case legalhold.FieldReason:
+		m.ResetReason()
+		return nil
+	case legalhold.FieldSubjectDigest:
+		m.ResetSubjectDigest()
+		return nil
+	case legalhold.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case legalhold.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case legalhold.FieldReleasedBy:
+		m.ResetReleasedBy()
+		return nil
+	case legalhold.FieldReleasedAt:
+		m.ResetReleasedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LegalHold field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LegalHoldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.dsses != nil {
+		edges = append(edges, legalhold.EdgeDsses)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LegalHoldMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case legalhold.EdgeDsses:
+		ids := make([]ent.Value, 0, len(m.dsses))
+		for id := range m.dsses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LegalHoldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddsses != nil {
+		edges = append(edges, legalhold.EdgeDsses)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LegalHoldMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case legalhold.EdgeDsses:
+		ids := make([]ent.Value, 0, len(m.removeddsses))
+		for id := range m.removeddsses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LegalHoldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddsses {
+		edges = append(edges, legalhold.EdgeDsses)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LegalHoldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case legalhold.EdgeDsses:
+		return m.cleareddsses
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LegalHoldMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LegalHold unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LegalHoldMutation) ResetEdge(name string) error {
+	switch name {
+	case legalhold.EdgeDsses:
+		m.ResetDsses()
+		return nil
+	}
+	return fmt.Errorf("unknown LegalHold edge %s", name)
+}
+
+// MaterialMutation represents an operation that mutates the Material nodes in the graph.
+type MaterialMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	tenant             *string
+	_path              *string
+	algorithm          *string
+	value              *string
+	clearedFields      map[string]struct{}
+	attestation        *uuid.UUID
+	clearedattestation bool
+	done               bool
+	oldValue           func(context.Context) (*Material, error)
+	predicates         []predicate.Material
+}
+
+var _ ent.Mutation = (*MaterialMutation)(nil)
+
+// materialOption allows management of the mutation configuration using functional options.
+type materialOption func(*MaterialMutation)
+
+// newMaterialMutation creates new mutation for the Material entity.
+func newMaterialMutation(c config, op Op, opts ...materialOption) *MaterialMutation {
+	m := &MaterialMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMaterial,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMaterialID sets the ID field of the mutation.
+func withMaterialID(id uuid.UUID) materialOption {
+	return func(m *MaterialMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Material
+		)
+		m.oldValue = func(ctx context.Context) (*Material, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Material.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMaterial sets the old Material of the mutation.
+func withMaterial(node *Material) materialOption {
+	return func(m *MaterialMutation) {
+		m.oldValue = func(context.Context) (*Material, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MaterialMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MaterialMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Material entities.
+func (m *MaterialMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MaterialMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MaterialMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Material.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *MaterialMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *MaterialMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Material entity.
+// If the Material object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaterialMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *MaterialMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetPath sets the "path" field.
+func (m *MaterialMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *MaterialMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Material entity.
+// If the Material object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaterialMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *MaterialMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *MaterialMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *MaterialMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the Material entity.
+// If the Material object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaterialMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *MaterialMutation) ResetAlgorithm() {
+	m.algorithm = nil
+}
+
+// SetValue sets the "value" field.
+func (m *MaterialMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *MaterialMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Material entity.
+// If the Material object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaterialMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *MaterialMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by id.
+func (m *MaterialMutation) SetAttestationID(id uuid.UUID) {
+	m.attestation = &id
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (m *MaterialMutation) ClearAttestation() {
+	m.clearedattestation = true
+}
+
+// AttestationCleared reports if the "attestation" edge to the Attestation entity was cleared.
+func (m *MaterialMutation) AttestationCleared() bool {
+	return m.clearedattestation
+}
+
+// AttestationID returns the "attestation" edge ID in the mutation.
+func (m *MaterialMutation) AttestationID() (id uuid.UUID, exists bool) {
+	if m.attestation != nil {
+		return *m.attestation, true
+	}
+	return
+}
+
+// AttestationIDs returns the "attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttestationID instead. It exists only for internal usage by the builders.
+func (m *MaterialMutation) AttestationIDs() (ids []uuid.UUID) {
+	if id := m.attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttestation resets all changes to the "attestation" edge.
+func (m *MaterialMutation) ResetAttestation() {
+	m.attestation = nil
+	m.clearedattestation = false
+}
+
+// Where appends a list predicates to the MaterialMutation builder.
+func (m *MaterialMutation) Where(ps ...predicate.Material) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MaterialMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MaterialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Material, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MaterialMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MaterialMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Material).
+func (m *MaterialMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MaterialMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.tenant != nil {
+		fields = append(fields, material.FieldTenant)
+	}
+	if m._path != nil {
+		fields = append(fields, material.FieldPath)
+	}
+	if m.algorithm != nil {
+		fields = append(fields, material.FieldAlgorithm)
+	}
+	if m.value != nil {
+		fields = append(fields, material.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MaterialMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case material.FieldTenant:
+		return m.Tenant()
+	case material.FieldPath:
+		return m.Path()
+	case material.FieldAlgorithm:
+		return m.Algorithm()
+	case material.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MaterialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case material.FieldTenant:
+		return m.OldTenant(ctx)
+	case material.FieldPath:
+		return m.OldPath(ctx)
+	case material.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case material.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown Material field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaterialMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case material.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case material.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case material.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case material.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Material field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MaterialMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MaterialMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaterialMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Material numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MaterialMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MaterialMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MaterialMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Material nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MaterialMutation) ResetField(name string) error {
+	switch name {
+	case material.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case material.FieldPath:
+		m.ResetPath()
+		return nil
+	case material.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case material.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown Material field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MaterialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.attestation != nil {
+		edges = append(edges, material.EdgeAttestation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MaterialMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case material.EdgeAttestation:
+		if id := m.attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MaterialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MaterialMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MaterialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedattestation {
+		edges = append(edges, material.EdgeAttestation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MaterialMutation) EdgeCleared(name string) bool {
+	switch name {
+	case material.EdgeAttestation:
+		return m.clearedattestation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MaterialMutation) ClearEdge(name string) error {
+	switch name {
+	case material.EdgeAttestation:
+		m.ClearAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown Material unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MaterialMutation) ResetEdge(name string) error {
+	switch name {
+	case material.EdgeAttestation:
+		m.ResetAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown Material edge %s", name)
+}
+
+// OciAttestationMutation represents an operation that mutates the OciAttestation nodes in the graph.
+type OciAttestationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	tenant             *string
+	tar_digest         *string
+	image_id           *string
+	manifest_digest    *string
+	image_tags         *[]string
+	appendimage_tags   []string
+	clearedFields      map[string]struct{}
+	attestation        *uuid.UUID
+	clearedattestation bool
+	done               bool
+	oldValue           func(context.Context) (*OciAttestation, error)
+	predicates         []predicate.OciAttestation
+}
+
+var _ ent.Mutation = (*OciAttestationMutation)(nil)
+
+// ociattestationOption allows management of the mutation configuration using functional options.
+type ociattestationOption func(*OciAttestationMutation)
+
+// newOciAttestationMutation creates new mutation for the OciAttestation entity.
+func newOciAttestationMutation(c config, op Op, opts ...ociattestationOption) *OciAttestationMutation {
+	m := &OciAttestationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOciAttestation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOciAttestationID sets the ID field of the mutation.
+func withOciAttestationID(id uuid.UUID) ociattestationOption {
+	return func(m *OciAttestationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OciAttestation
+		)
+		m.oldValue = func(ctx context.Context) (*OciAttestation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OciAttestation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOciAttestation sets the old OciAttestation of the mutation.
+func withOciAttestation(node *OciAttestation) ociattestationOption {
+	return func(m *OciAttestationMutation) {
+		m.oldValue = func(context.Context) (*OciAttestation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OciAttestationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OciAttestationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OciAttestation entities.
+func (m *OciAttestationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OciAttestationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OciAttestationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OciAttestation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *OciAttestationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *OciAttestationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the OciAttestation entity.
+// If the OciAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OciAttestationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *OciAttestationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetTarDigest sets the "tar_digest" field.
+func (m *OciAttestationMutation) SetTarDigest(s string) {
+	m.tar_digest = &s
+}
+
+// TarDigest returns the value of the "tar_digest" field in the mutation.
+func (m *OciAttestationMutation) TarDigest() (r string, exists bool) {
+	v := m.tar_digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarDigest returns the old "tar_digest" field's value of the OciAttestation entity.
+// If the OciAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OciAttestationMutation) OldTarDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarDigest: %w", err)
+	}
+	return oldValue.TarDigest, nil
+}
+
+// ClearTarDigest clears the value of the "tar_digest" field.
+func (m *OciAttestationMutation) ClearTarDigest() {
+	m.tar_digest = nil
+	m.clearedFields[ociattestation.FieldTarDigest] = struct{}{}
+}
+
+// TarDigestCleared returns if the "tar_digest" field was cleared in this mutation.
+func (m *OciAttestationMutation) TarDigestCleared() bool {
+	_, ok := m.clearedFields[ociattestation.FieldTarDigest]
+	return ok
+}
+
+// ResetTarDigest resets all changes to the "tar_digest" field.
+func (m *OciAttestationMutation) ResetTarDigest() {
+	m.tar_digest = nil
+	delete(m.clearedFields, ociattestation.FieldTarDigest)
+}
+
+// SetImageID sets the "image_id" field.
+func (m *OciAttestationMutation) SetImageID(s string) {
+	m.image_id = &s
+}
+
+// ImageID returns the value of the "image_id" field in the mutation.
+func (m *OciAttestationMutation) ImageID() (r string, exists bool) {
+	v := m.image_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageID returns the old "image_id" field's value of the OciAttestation entity.
+// If the OciAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OciAttestationMutation) OldImageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageID: %w", err)
+	}
+	return oldValue.ImageID, nil
+}
+
+// ClearImageID clears the value of the "image_id" field.
+func (m *OciAttestationMutation) ClearImageID() {
+	m.image_id = nil
+	m.clearedFields[ociattestation.FieldImageID] = struct{}{}
+}
+
+// ImageIDCleared returns if the "image_id" field was cleared in this mutation.
+func (m *OciAttestationMutation) ImageIDCleared() bool {
+	_, ok := m.clearedFields[ociattestation.FieldImageID]
+	return ok
+}
+
+// ResetImageID resets all changes to the "image_id" field.
+func (m *OciAttestationMutation) ResetImageID() {
+	m.image_id = nil
+	delete(m.clearedFields, ociattestation.FieldImageID)
+}
+
+// SetManifestDigest sets the "manifest_digest" field.
+func (m *OciAttestationMutation) SetManifestDigest(s string) {
+	m.manifest_digest = &s
+}
+
+// ManifestDigest returns the value of the "manifest_digest" field in the mutation.
+func (m *OciAttestationMutation) ManifestDigest() (r string, exists bool) {
+	v := m.manifest_digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManifestDigest returns the old "manifest_digest" field's value of the OciAttestation entity.
+// If the OciAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OciAttestationMutation) OldManifestDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManifestDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManifestDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManifestDigest: %w", err)
+	}
+	return oldValue.ManifestDigest, nil
+}
+
+// ClearManifestDigest clears the value of the "manifest_digest" field.
+func (m *OciAttestationMutation) ClearManifestDigest() {
+	m.manifest_digest = nil
+	m.clearedFields[ociattestation.FieldManifestDigest] = struct{}{}
+}
+
+// ManifestDigestCleared returns if the "manifest_digest" field was cleared in this mutation.
+func (m *OciAttestationMutation) ManifestDigestCleared() bool {
+	_, ok := m.clearedFields[ociattestation.FieldManifestDigest]
+	return ok
+}
+
+// ResetManifestDigest resets all changes to the "manifest_digest" field.
+func (m *OciAttestationMutation) ResetManifestDigest() {
+	m.manifest_digest = nil
+	delete(m.clearedFields, ociattestation.FieldManifestDigest)
+}
+
+// SetImageTags sets the "image_tags" field.
+func (m *OciAttestationMutation) SetImageTags(s []string) {
+	m.image_tags = &s
+	m.appendimage_tags = nil
+}
+
+// ImageTags returns the value of the "image_tags" field in the mutation.
+func (m *OciAttestationMutation) ImageTags() (r []string, exists bool) {
+	v := m.image_tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageTags returns the old "image_tags" field's value of the OciAttestation entity.
+// If the OciAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OciAttestationMutation) OldImageTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageTags: %w", err)
+	}
+	return oldValue.ImageTags, nil
+}
+
+// AppendImageTags adds s to the "image_tags" field.
+func (m *OciAttestationMutation) AppendImageTags(s []string) {
+	m.appendimage_tags = append(m.appendimage_tags, s...)
+}
+
+// AppendedImageTags returns the list of values that were appended to the "image_tags" field in this mutation.
+func (m *OciAttestationMutation) AppendedImageTags() ([]string, bool) {
+	if len(m.appendimage_tags) == 0 {
+		return nil, false
+	}
+	return m.appendimage_tags, true
+}
+
+// ClearImageTags clears the value of the "image_tags" field.
+func (m *OciAttestationMutation) ClearImageTags() {
+	m.image_tags = nil
+	m.appendimage_tags = nil
+	m.clearedFields[ociattestation.FieldImageTags] = struct{}{}
+}
+
+// ImageTagsCleared returns if the "image_tags" field was cleared in this mutation.
+func (m *OciAttestationMutation) ImageTagsCleared() bool {
+	_, ok := m.clearedFields[ociattestation.FieldImageTags]
+	return ok
+}
+
+// ResetImageTags resets all changes to the "image_tags" field.
+func (m *OciAttestationMutation) ResetImageTags() {
+	m.image_tags = nil
+	m.appendimage_tags = nil
+	delete(m.clearedFields, ociattestation.FieldImageTags)
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by id.
+func (m *OciAttestationMutation) SetAttestationID(id uuid.UUID) {
+	m.attestation = &id
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (m *OciAttestationMutation) ClearAttestation() {
+	m.clearedattestation = true
+}
+
+// AttestationCleared reports if the "attestation" edge to the Attestation entity was cleared.
+func (m *OciAttestationMutation) AttestationCleared() bool {
+	return m.clearedattestation
+}
+
+// AttestationID returns the "attestation" edge ID in the mutation.
+func (m *OciAttestationMutation) AttestationID() (id uuid.UUID, exists bool) {
+	if m.attestation != nil {
+		return *m.attestation, true
+	}
+	return
+}
+
+// AttestationIDs returns the "attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttestationID instead. It exists only for internal usage by the builders.
+func (m *OciAttestationMutation) AttestationIDs() (ids []uuid.UUID) {
+	if id := m.attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttestation resets all changes to the "attestation" edge.
+func (m *OciAttestationMutation) ResetAttestation() {
+	m.attestation = nil
+	m.clearedattestation = false
+}
+
+// Where appends a list predicates to the OciAttestationMutation builder.
+func (m *OciAttestationMutation) Where(ps ...predicate.OciAttestation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OciAttestationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OciAttestationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OciAttestation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OciAttestationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OciAttestationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OciAttestation).
+func (m *OciAttestationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OciAttestationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant != nil {
+		fields = append(fields, ociattestation.FieldTenant)
+	}
+	if m.tar_digest != nil {
+		fields = append(fields, ociattestation.FieldTarDigest)
+	}
+	if m.image_id != nil {
+		fields = append(fields, ociattestation.FieldImageID)
+	}
+	if m.manifest_digest != nil {
+		fields = append(fields, ociattestation.FieldManifestDigest)
+	}
+	if m.image_tags != nil {
+		fields = append(fields, ociattestation.FieldImageTags)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OciAttestationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ociattestation.FieldTenant:
+		return m.Tenant()
+	case ociattestation.FieldTarDigest:
+		return m.TarDigest()
+	case ociattestation.FieldImageID:
+		return m.ImageID()
+	case ociattestation.FieldManifestDigest:
+		return m.ManifestDigest()
+	case ociattestation.FieldImageTags:
+		return m.ImageTags()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OciAttestationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ociattestation.FieldTenant:
+		return m.OldTenant(ctx)
+	case ociattestation.FieldTarDigest:
+		return m.OldTarDigest(ctx)
+	case ociattestation.FieldImageID:
+		return m.OldImageID(ctx)
+	case ociattestation.FieldManifestDigest:
+		return m.OldManifestDigest(ctx)
+	case ociattestation.FieldImageTags:
+		return m.OldImageTags(ctx)
+	}
+	return nil, fmt.Errorf("unknown OciAttestation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OciAttestationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ociattestation.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case ociattestation.FieldTarDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarDigest(v)
+		return nil
+	case ociattestation.FieldImageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageID(v)
+		return nil
+	case ociattestation.FieldManifestDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManifestDigest(v)
+		return nil
+	case ociattestation.FieldImageTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageTags(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OciAttestation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OciAttestationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OciAttestationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OciAttestationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OciAttestation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OciAttestationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ociattestation.FieldTarDigest) {
+		fields = append(fields, ociattestation.FieldTarDigest)
+	}
+	if m.FieldCleared(ociattestation.FieldImageID) {
+		fields = append(fields, ociattestation.FieldImageID)
+	}
+	if m.FieldCleared(ociattestation.FieldManifestDigest) {
+		fields = append(fields, ociattestation.FieldManifestDigest)
+	}
+	if m.FieldCleared(ociattestation.FieldImageTags) {
+		fields = append(fields, ociattestation.FieldImageTags)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OciAttestationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OciAttestationMutation) ClearField(name string) error {
+	switch name {
+	case ociattestation.FieldTarDigest:
+		m.ClearTarDigest()
+		return nil
+	case ociattestation.FieldImageID:
+		m.ClearImageID()
+		return nil
+	case ociattestation.FieldManifestDigest:
+		m.ClearManifestDigest()
+		return nil
+	case ociattestation.FieldImageTags:
+		m.ClearImageTags()
+		return nil
+	}
+	return fmt.Errorf("unknown OciAttestation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OciAttestationMutation) ResetField(name string) error {
+	switch name {
+	case ociattestation.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case ociattestation.FieldTarDigest:
+		m.ResetTarDigest()
+		return nil
+	case ociattestation.FieldImageID:
+		m.ResetImageID()
+		return nil
+	case ociattestation.FieldManifestDigest:
+		m.ResetManifestDigest()
+		return nil
+	case ociattestation.FieldImageTags:
+		m.ResetImageTags()
+		return nil
+	}
+	return fmt.Errorf("unknown OciAttestation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OciAttestationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.attestation != nil {
+		edges = append(edges, ociattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OciAttestationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ociattestation.EdgeAttestation:
+		if id := m.attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OciAttestationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OciAttestationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OciAttestationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedattestation {
+		edges = append(edges, ociattestation.EdgeAttestation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OciAttestationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ociattestation.EdgeAttestation:
+		return m.clearedattestation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OciAttestationMutation) ClearEdge(name string) error {
+	switch name {
+	case ociattestation.EdgeAttestation:
+		m.ClearAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown OciAttestation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OciAttestationMutation) ResetEdge(name string) error {
+	switch name {
+	case ociattestation.EdgeAttestation:
+		m.ResetAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown OciAttestation edge %s", name)
+}
+
+// PayloadDigestMutation represents an operation that mutates the PayloadDigest nodes in the graph.
+type PayloadDigestMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	tenant        *string
+	algorithm     *string
+	value         *string
+	clearedFields map[string]struct{}
+	dsse          *uuid.UUID
+	cleareddsse   bool
+	done          bool
+	oldValue      func(context.Context) (*PayloadDigest, error)
+	predicates    []predicate.PayloadDigest
+}
+
+var _ ent.Mutation = (*PayloadDigestMutation)(nil)
+
+// payloaddigestOption allows management of the mutation configuration using functional options.
+type payloaddigestOption func(*PayloadDigestMutation)
+
+// newPayloadDigestMutation creates new mutation for the PayloadDigest entity.
+func newPayloadDigestMutation(c config, op Op, opts ...payloaddigestOption) *PayloadDigestMutation {
+	m := &PayloadDigestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePayloadDigest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPayloadDigestID sets the ID field of the mutation.
+func withPayloadDigestID(id uuid.UUID) payloaddigestOption {
+	return func(m *PayloadDigestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PayloadDigest
+		)
+		m.oldValue = func(ctx context.Context) (*PayloadDigest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PayloadDigest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPayloadDigest sets the old PayloadDigest of the mutation.
+func withPayloadDigest(node *PayloadDigest) payloaddigestOption {
+	return func(m *PayloadDigestMutation) {
+		m.oldValue = func(context.Context) (*PayloadDigest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PayloadDigestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PayloadDigestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PayloadDigest entities.
+func (m *PayloadDigestMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PayloadDigestMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PayloadDigestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PayloadDigest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *PayloadDigestMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *PayloadDigestMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the PayloadDigest entity.
+// If the PayloadDigest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayloadDigestMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *PayloadDigestMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *PayloadDigestMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *PayloadDigestMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the PayloadDigest entity.
+// If the PayloadDigest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayloadDigestMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *PayloadDigestMutation) ResetAlgorithm() {
+	m.algorithm = nil
+}
+
+// SetValue sets the "value" field.
+func (m *PayloadDigestMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *PayloadDigestMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the PayloadDigest entity.
+// If the PayloadDigest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayloadDigestMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *PayloadDigestMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by id.
+func (m *PayloadDigestMutation) SetDsseID(id uuid.UUID) {
+	m.dsse = &id
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (m *PayloadDigestMutation) ClearDsse() {
+	m.cleareddsse = true
+}
+
+// DsseCleared reports if the "dsse" edge to the Dsse entity was cleared.
+func (m *PayloadDigestMutation) DsseCleared() bool {
+	return m.cleareddsse
+}
+
+// DsseID returns the "dsse" edge ID in the mutation.
+func (m *PayloadDigestMutation) DsseID() (id uuid.UUID, exists bool) {
+	if m.dsse != nil {
+		return *m.dsse, true
+	}
+	return
+}
+
+// DsseIDs returns the "dsse" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DsseID instead. It exists only for internal usage by the builders.
+func (m *PayloadDigestMutation) DsseIDs() (ids []uuid.UUID) {
+	if id := m.dsse; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDsse resets all changes to the "dsse" edge.
+func (m *PayloadDigestMutation) ResetDsse() {
+	m.dsse = nil
+	m.cleareddsse = false
+}
+
+// Where appends a list predicates to the PayloadDigestMutation builder.
+func (m *PayloadDigestMutation) Where(ps ...predicate.PayloadDigest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PayloadDigestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PayloadDigestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PayloadDigest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PayloadDigestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PayloadDigestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PayloadDigest).
+func (m *PayloadDigestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PayloadDigestMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, payloaddigest.FieldTenant)
+	}
+	if m.algorithm != nil {
+		fields = append(fields, payloaddigest.FieldAlgorithm)
+	}
+	if m.value != nil {
+		fields = append(fields, payloaddigest.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PayloadDigestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case payloaddigest.FieldTenant:
+		return m.Tenant()
+	case payloaddigest.FieldAlgorithm:
+		return m.Algorithm()
+	case payloaddigest.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PayloadDigestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case payloaddigest.FieldTenant:
+		return m.OldTenant(ctx)
+	case payloaddigest.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case payloaddigest.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown PayloadDigest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PayloadDigestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case payloaddigest.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case payloaddigest.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case payloaddigest.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PayloadDigest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PayloadDigestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PayloadDigestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PayloadDigestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PayloadDigest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PayloadDigestMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PayloadDigestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PayloadDigestMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PayloadDigest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PayloadDigestMutation) ResetField(name string) error {
+	switch name {
+	case payloaddigest.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case payloaddigest.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case payloaddigest.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown PayloadDigest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PayloadDigestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.dsse != nil {
+		edges = append(edges, payloaddigest.EdgeDsse)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PayloadDigestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case payloaddigest.EdgeDsse:
+		if id := m.dsse; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PayloadDigestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PayloadDigestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PayloadDigestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddsse {
+		edges = append(edges, payloaddigest.EdgeDsse)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PayloadDigestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case payloaddigest.EdgeDsse:
+		return m.cleareddsse
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PayloadDigestMutation) ClearEdge(name string) error {
+	switch name {
+	case payloaddigest.EdgeDsse:
+		m.ClearDsse()
+		return nil
+	}
+	return fmt.Errorf("unknown PayloadDigest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PayloadDigestMutation) ResetEdge(name string) error {
+	switch name {
+	case payloaddigest.EdgeDsse:
+		m.ResetDsse()
+		return nil
+	}
+	return fmt.Errorf("unknown PayloadDigest edge %s", name)
+}
+
+// ProductMutation represents an operation that mutates the Product nodes in the graph.
+type ProductMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	tenant             *string
+	_path              *string
+	mime_type          *string
+	algorithm          *string
+	value              *string
+	clearedFields      map[string]struct{}
+	attestation        *uuid.UUID
+	clearedattestation bool
+	done               bool
+	oldValue           func(context.Context) (*Product, error)
+	predicates         []predicate.Product
+}
+
+var _ ent.Mutation = (*ProductMutation)(nil)
+
+// productOption allows management of the mutation configuration using functional options.
+type productOption func(*ProductMutation)
+
+// newProductMutation creates new mutation for the Product entity.
+func newProductMutation(c config, op Op, opts ...productOption) *ProductMutation {
+	m := &ProductMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProduct,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProductID sets the ID field of the mutation.
+func withProductID(id uuid.UUID) productOption {
+	return func(m *ProductMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Product
+		)
+		m.oldValue = func(ctx context.Context) (*Product, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Product.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProduct sets the old Product of the mutation.
+func withProduct(node *Product) productOption {
+	return func(m *ProductMutation) {
+		m.oldValue = func(context.Context) (*Product, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProductMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProductMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Product entities.
+func (m *ProductMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProductMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProductMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Product.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *ProductMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *ProductMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *ProductMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetPath sets the "path" field.
+func (m *ProductMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *ProductMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *ProductMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *ProductMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *ProductMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *ProductMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[product.FieldMimeType] = struct{}{}
+}
+
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *ProductMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[product.FieldMimeType]
+	return ok
+}
+
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *ProductMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, product.FieldMimeType)
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *ProductMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *ProductMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *ProductMutation) ResetAlgorithm() {
+	m.algorithm = nil
+}
+
+// SetValue sets the "value" field.
+func (m *ProductMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ProductMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ProductMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by id.
+func (m *ProductMutation) SetAttestationID(id uuid.UUID) {
+	m.attestation = &id
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (m *ProductMutation) ClearAttestation() {
+	m.clearedattestation = true
+}
+
+// AttestationCleared reports if the "attestation" edge to the Attestation entity was cleared.
+func (m *ProductMutation) AttestationCleared() bool {
+	return m.clearedattestation
+}
+
+// AttestationID returns the "attestation" edge ID in the mutation.
+func (m *ProductMutation) AttestationID() (id uuid.UUID, exists bool) {
+	if m.attestation != nil {
+		return *m.attestation, true
+	}
+	return
+}
+
+// AttestationIDs returns the "attestation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttestationID instead. It exists only for internal usage by the builders.
+func (m *ProductMutation) AttestationIDs() (ids []uuid.UUID) {
+	if id := m.attestation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttestation resets all changes to the "attestation" edge.
+func (m *ProductMutation) ResetAttestation() {
+	m.attestation = nil
+	m.clearedattestation = false
+}
+
+// Where appends a list predicates to the ProductMutation builder.
+func (m *ProductMutation) Where(ps ...predicate.Product) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProductMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProductMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Product, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProductMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProductMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Product).
+func (m *ProductMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProductMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant != nil {
+		fields = append(fields, product.FieldTenant)
+	}
+	if m._path != nil {
+		fields = append(fields, product.FieldPath)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, product.FieldMimeType)
+	}
+	if m.algorithm != nil {
+		fields = append(fields, product.FieldAlgorithm)
+	}
+	if m.value != nil {
+		fields = append(fields, product.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProductMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case product.FieldTenant:
+		return m.Tenant()
+	case product.FieldPath:
+		return m.Path()
+	case product.FieldMimeType:
+		return m.MimeType()
+	case product.FieldAlgorithm:
+		return m.Algorithm()
+	case product.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProductMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case product.FieldTenant:
+		return m.OldTenant(ctx)
+	case product.FieldPath:
+		return m.OldPath(ctx)
+	case product.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case product.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case product.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown Product field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProductMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case product.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case product.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case product.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case product.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case product.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Product field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProductMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProductMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProductMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Product numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProductMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(product.FieldMimeType) {
+		fields = append(fields, product.FieldMimeType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProductMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProductMutation) ClearField(name string) error {
+	switch name {
+	case product.FieldMimeType:
+		m.ClearMimeType()
+		return nil
+	}
+	return fmt.Errorf("unknown Product nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProductMutation) ResetField(name string) error {
+	switch name {
+	case product.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case product.FieldPath:
+		m.ResetPath()
+		return nil
+	case product.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case product.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case product.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown Product field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProductMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.attestation != nil {
+		edges = append(edges, product.EdgeAttestation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProductMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case product.EdgeAttestation:
+		if id := m.attestation; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProductMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProductMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProductMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedattestation {
+		edges = append(edges, product.EdgeAttestation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProductMutation) EdgeCleared(name string) bool {
+	switch name {
+	case product.EdgeAttestation:
+		return m.clearedattestation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProductMutation) ClearEdge(name string) error {
+	switch name {
+	case product.EdgeAttestation:
+		m.ClearAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown Product unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProductMutation) ResetEdge(name string) error {
+	switch name {
+	case product.EdgeAttestation:
+		m.ResetAttestation()
+		return nil
+	}
+	return fmt.Errorf("unknown Product edge %s", name)
+}
+
+// PublicationMutation represents an operation that mutates the Publication nodes in the graph.
+type PublicationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	tenant        *string
+	gitoid_sha256 *string
+	publisher     *string
+	task_id       *string
+	status        *publication.Status
+	message       *string
+	created_at    *time.Time
+	completed_at  *time.Time
+	clearedFields map[string]struct{}
+	dsse          *uuid.UUID
+	cleareddsse   bool
+	done          bool
+	oldValue      func(context.Context) (*Publication, error)
+	predicates    []predicate.Publication
+}
+
+var _ ent.Mutation = (*PublicationMutation)(nil)
+
+// publicationOption allows management of the mutation configuration using functional options.
+type publicationOption func(*PublicationMutation)
+
+// newPublicationMutation creates new mutation for the Publication entity.
+func newPublicationMutation(c config, op Op, opts ...publicationOption) *PublicationMutation {
+	m := &PublicationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePublication,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPublicationID sets the ID field of the mutation.
+func withPublicationID(id uuid.UUID) publicationOption {
+	return func(m *PublicationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Publication
+		)
+		m.oldValue = func(ctx context.Context) (*Publication, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Publication.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPublication sets the old Publication of the mutation.
+func withPublication(node *Publication) publicationOption {
+	return func(m *PublicationMutation) {
+		m.oldValue = func(context.Context) (*Publication, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PublicationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PublicationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Publication entities.
+func (m *PublicationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PublicationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PublicationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Publication.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *PublicationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *PublicationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Publication entity.
+// If the Publication object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *PublicationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetGitoidSha256 sets the "gitoid_sha256" field.
+func (m *PublicationMutation) SetGitoidSha256(s string) {
+	m.gitoid_sha256 = &s
+}
+
+// GitoidSha256 returns the value of the "gitoid_sha256" field in the mutation.
+func (m *PublicationMutation) GitoidSha256() (r string, exists bool) {
+	v := m.gitoid_sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGitoidSha256 returns the old "gitoid_sha256" field's value of the Publication entity.
+// If the Publication object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicationMutation) OldGitoidSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGitoidSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGitoidSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGitoidSha256: %w", err)
+	}
+	return oldValue.GitoidSha256, nil
+}
+
+// ResetGitoidSha256 resets all changes to the "gitoid_sha256" field.
+func (m *PublicationMutation) ResetGitoidSha256() {
+	m.gitoid_sha256 = nil
+}
+
+// SetPublisher sets the "publisher" field.
+func (m *PublicationMutation) SetPublisher(s string) {
+	m.publisher = &s
+}
+
+// Publisher returns the value of the "publisher" field in the mutation.
+func (m *PublicationMutation) Publisher() (r string, exists bool) {
+	v := m.publisher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublisher returns the old "publisher" field's value of the Publication entity.
+// If the Publication object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicationMutation) OldPublisher(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublisher is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublisher requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublisher: %w", err)
+	}
+	return oldValue.Publisher, nil
+}
+
+// ResetPublisher resets all changes to the "publisher" field.
+func (m *PublicationMutation) ResetPublisher() {
+	m.publisher = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *PublicationMutation) SetTaskID(s string) {
+	m.task_id = &s
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *PublicationMutation) TaskID() (r string, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the Publication entity.
+// If the Publication object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicationMutation) OldTaskID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *PublicationMutation) ResetTaskID() {
+	m.task_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PublicationMutation) SetStatus(pu publication.Status) {
+	m.status = &pu
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PublicationMutation) Status() (r publication.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Publication entity.
+// If the Publication object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicationMutation) OldStatus(ctx context.Context) (v publication.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PublicationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *PublicationMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *PublicationMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the Publication entity.
+// If the Publication object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicationMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *PublicationMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[publication.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *PublicationMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[publication.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *PublicationMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, publication.FieldMessage)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PublicationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PublicationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Publication entity.
+// If the Publication object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PublicationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *PublicationMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *PublicationMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Publication entity.
+// If the Publication object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicationMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *PublicationMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[publication.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *PublicationMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[publication.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *PublicationMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, publication.FieldCompletedAt)
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by id.
+func (m *PublicationMutation) SetDsseID(id uuid.UUID) {
+	m.dsse = &id
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (m *PublicationMutation) ClearDsse() {
+	m.cleareddsse = true
+}
+
+// DsseCleared reports if the "dsse" edge to the Dsse entity was cleared.
+func (m *PublicationMutation) DsseCleared() bool {
+	return m.cleareddsse
+}
+
+// DsseID returns the "dsse" edge ID in the mutation.
+func (m *PublicationMutation) DsseID() (id uuid.UUID, exists bool) {
+	if m.dsse != nil {
+		return *m.dsse, true
+	}
+	return
+}
+
+// DsseIDs returns the "dsse" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DsseID instead. It exists only for internal usage by the builders.
+func (m *PublicationMutation) DsseIDs() (ids []uuid.UUID) {
+	if id := m.dsse; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDsse resets all changes to the "dsse" edge.
+func (m *PublicationMutation) ResetDsse() {
+	m.dsse = nil
+	m.cleareddsse = false
+}
+
+// Where appends a list predicates to the PublicationMutation builder.
+func (m *PublicationMutation) Where(ps ...predicate.Publication) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PublicationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PublicationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Publication, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PublicationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PublicationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Publication).
+func (m *PublicationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PublicationMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, publication.FieldTenant)
+	}
+	if m.gitoid_sha256 != nil {
+		fields = append(fields, publication.FieldGitoidSha256)
+	}
+	if m.publisher != nil {
+		fields = append(fields, publication.FieldPublisher)
+	}
+	if m.task_id != nil {
+		fields = append(fields, publication.FieldTaskID)
+	}
+	if m.status != nil {
+		fields = append(fields, publication.FieldStatus)
+	}
+	if m.message != nil {
+		fields = append(fields, publication.FieldMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, publication.FieldCreatedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, publication.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PublicationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case publication.FieldTenant:
+		return m.Tenant()
+	case publication.FieldGitoidSha256:
+		return m.GitoidSha256()
+	case publication.FieldPublisher:
+		return m.Publisher()
+	case publication.FieldTaskID:
+		return m.TaskID()
+	case publication.FieldStatus:
+		return m.Status()
+	case publication.FieldMessage:
+		return m.Message()
+	case publication.FieldCreatedAt:
+		return m.CreatedAt()
+	case publication.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PublicationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case publication.FieldTenant:
+		return m.OldTenant(ctx)
+	case publication.FieldGitoidSha256:
+		return m.OldGitoidSha256(ctx)
+	case publication.FieldPublisher:
+		return m.OldPublisher(ctx)
+	case publication.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case publication.FieldStatus:
+		return m.OldStatus(ctx)
+	case publication.FieldMessage:
+		return m.OldMessage(ctx)
+	case publication.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case publication.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Publication field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PublicationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case publication.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case publication.FieldGitoidSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitoidSha256(v)
+		return nil
+	case publication.FieldPublisher:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublisher(v)
+		return nil
+	case publication.FieldTaskID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case publication.FieldStatus:
+		v, ok := value.(publication.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case publication.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case publication.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case publication.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Publication field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PublicationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PublicationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PublicationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Publication numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PublicationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(publication.FieldMessage) {
+		fields = append(fields, publication.FieldMessage)
+	}
+	if m.FieldCleared(publication.FieldCompletedAt) {
+		fields = append(fields, publication.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PublicationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PublicationMutation) ClearField(name string) error {
+	switch name {
+	case publication.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case publication.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Publication nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PublicationMutation) ResetField(name string) error {
+	switch name {
+	case publication.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case publication.FieldGitoidSha256:
+		m.ResetGitoidSha256()
+		return nil
+	case publication.FieldPublisher:
+		m.ResetPublisher()
+		return nil
+	case publication.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case publication.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case publication.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case publication.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case publication.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Publication field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PublicationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.dsse != nil {
+		edges = append(edges, publication.EdgeDsse)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PublicationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case publication.EdgeDsse:
+		if id := m.dsse; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PublicationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PublicationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PublicationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddsse {
+		edges = append(edges, publication.EdgeDsse)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PublicationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case publication.EdgeDsse:
+		return m.cleareddsse
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PublicationMutation) ClearEdge(name string) error {
+	switch name {
+	case publication.EdgeDsse:
+		m.ClearDsse()
+		return nil
+	}
+	return fmt.Errorf("unknown Publication unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PublicationMutation) ResetEdge(name string) error {
+	switch name {
+	case publication.EdgeDsse:
+		m.ResetDsse()
+		return nil
+	}
+	return fmt.Errorf("unknown Publication edge %s", name)
+}
+
+// PublishDeliveryMutation represents an operation that mutates the PublishDelivery nodes in the graph.
+type PublishDeliveryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	tenant          *string
+	gitoid_sha256   *string
+	publisher       *string
+	status          *publishdelivery.Status
+	attempts        *int
+	addattempts     *int
+	next_attempt_at *time.Time
+	last_error      *string
+	created_at      *time.Time
+	delivered_at    *time.Time
+	clearedFields   map[string]struct{}
+	dsse            *uuid.UUID
+	cleareddsse     bool
+	done            bool
+	oldValue        func(context.Context) (*PublishDelivery, error)
+	predicates      []predicate.PublishDelivery
+}
+
+var _ ent.Mutation = (*PublishDeliveryMutation)(nil)
+
+// publishdeliveryOption allows management of the mutation configuration using functional options.
+type publishdeliveryOption func(*PublishDeliveryMutation)
+
+// newPublishDeliveryMutation creates new mutation for the PublishDelivery entity.
+func newPublishDeliveryMutation(c config, op Op, opts ...publishdeliveryOption) *PublishDeliveryMutation {
+	m := &PublishDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePublishDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPublishDeliveryID sets the ID field of the mutation.
+func withPublishDeliveryID(id uuid.UUID) publishdeliveryOption {
+	return func(m *PublishDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PublishDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*PublishDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PublishDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPublishDelivery sets the old PublishDelivery of the mutation.
+func withPublishDelivery(node *PublishDelivery) publishdeliveryOption {
+	return func(m *PublishDeliveryMutation) {
+		m.oldValue = func(context.Context) (*PublishDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PublishDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PublishDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PublishDelivery entities.
+func (m *PublishDeliveryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PublishDeliveryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PublishDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PublishDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *PublishDeliveryMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *PublishDeliveryMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the PublishDelivery entity.
+// If the PublishDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublishDeliveryMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *PublishDeliveryMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetGitoidSha256 sets the "gitoid_sha256" field.
+func (m *PublishDeliveryMutation) SetGitoidSha256(s string) {
+	m.gitoid_sha256 = &s
+}
+
+// GitoidSha256 returns the value of the "gitoid_sha256" field in the mutation.
+func (m *PublishDeliveryMutation) GitoidSha256() (r string, exists bool) {
+	v := m.gitoid_sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGitoidSha256 returns the old "gitoid_sha256" field's value of the PublishDelivery entity.
+// If the PublishDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublishDeliveryMutation) OldGitoidSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGitoidSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGitoidSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGitoidSha256: %w", err)
+	}
+	return oldValue.GitoidSha256, nil
+}
+
+// ResetGitoidSha256 resets all changes to the "gitoid_sha256" field.
+func (m *PublishDeliveryMutation) ResetGitoidSha256() {
+	m.gitoid_sha256 = nil
+}
+
+// SetPublisher sets the "publisher" field.
+func (m *PublishDeliveryMutation) SetPublisher(s string) {
+	m.publisher = &s
+}
+
+// Publisher returns the value of the "publisher" field in the mutation.
+func (m *PublishDeliveryMutation) Publisher() (r string, exists bool) {
+	v := m.publisher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublisher returns the old "publisher" field's value of the PublishDelivery entity.
+// If the PublishDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublishDeliveryMutation) OldPublisher(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublisher is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublisher requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublisher: %w", err)
+	}
+	return oldValue.Publisher, nil
+}
+
+// ResetPublisher resets all changes to the "publisher" field.
+func (m *PublishDeliveryMutation) ResetPublisher() {
+	m.publisher = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PublishDeliveryMutation) SetStatus(pu publishdelivery.Status) {
+	m.status = &pu
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PublishDeliveryMutation) Status() (r publishdelivery.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PublishDelivery entity.
+// If the PublishDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublishDeliveryMutation) OldStatus(ctx context.Context) (v publishdelivery.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PublishDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *PublishDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *PublishDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the PublishDelivery entity.
+// If the PublishDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublishDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *PublishDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *PublishDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *PublishDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *PublishDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *PublishDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the PublishDelivery entity.
+// If the PublishDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublishDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *PublishDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *PublishDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *PublishDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the PublishDelivery entity.
+// If the PublishDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublishDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *PublishDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[publishdelivery.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *PublishDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[publishdelivery.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *PublishDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, publishdelivery.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PublishDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PublishDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PublishDelivery entity.
+// If the PublishDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublishDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PublishDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *PublishDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *PublishDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the PublishDelivery entity.
+// If the PublishDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublishDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *PublishDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[publishdelivery.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *PublishDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[publishdelivery.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *PublishDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, publishdelivery.FieldDeliveredAt)
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by id.
+func (m *PublishDeliveryMutation) SetDsseID(id uuid.UUID) {
+	m.dsse = &id
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (m *PublishDeliveryMutation) ClearDsse() {
+	m.cleareddsse = true
+}
+
+// DsseCleared reports if the "dsse" edge to the Dsse entity was cleared.
+func (m *PublishDeliveryMutation) DsseCleared() bool {
+	return m.cleareddsse
+}
+
+// DsseID returns the "dsse" edge ID in the mutation.
+func (m *PublishDeliveryMutation) DsseID() (id uuid.UUID, exists bool) {
+	if m.dsse != nil {
+		return *m.dsse, true
+	}
+	return
+}
+
+// DsseIDs returns the "dsse" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DsseID instead. It exists only for internal usage by the builders.
+func (m *PublishDeliveryMutation) DsseIDs() (ids []uuid.UUID) {
+	if id := m.dsse; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDsse resets all changes to the "dsse" edge.
+func (m *PublishDeliveryMutation) ResetDsse() {
+	m.dsse = nil
+	m.cleareddsse = false
+}
+
+// Where appends a list predicates to the PublishDeliveryMutation builder.
+func (m *PublishDeliveryMutation) Where(ps ...predicate.PublishDelivery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PublishDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PublishDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PublishDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PublishDeliveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PublishDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PublishDelivery).
+func (m *PublishDeliveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PublishDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.tenant != nil {
+		fields = append(fields, publishdelivery.FieldTenant)
+	}
+	if m.gitoid_sha256 != nil {
+		fields = append(fields, publishdelivery.FieldGitoidSha256)
+	}
+	if m.publisher != nil {
+		fields = append(fields, publishdelivery.FieldPublisher)
+	}
+	if m.status != nil {
+		fields = append(fields, publishdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, publishdelivery.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, publishdelivery.FieldNextAttemptAt)
+	}
+	if m.last_error != nil {
+		fields = append(fields, publishdelivery.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, publishdelivery.FieldCreatedAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, publishdelivery.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PublishDeliveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case publishdelivery.FieldTenant:
+		return m.Tenant()
+	case publishdelivery.FieldGitoidSha256:
+		return m.GitoidSha256()
+	case publishdelivery.FieldPublisher:
+		return m.Publisher()
+	case publishdelivery.FieldStatus:
+		return m.Status()
+	case publishdelivery.FieldAttempts:
+		return m.Attempts()
+	case publishdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case publishdelivery.FieldLastError:
+		return m.LastError()
+	case publishdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case publishdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PublishDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case publishdelivery.FieldTenant:
+		return m.OldTenant(ctx)
+	case publishdelivery.FieldGitoidSha256:
+		return m.OldGitoidSha256(ctx)
+	case publishdelivery.FieldPublisher:
+		return m.OldPublisher(ctx)
+	case publishdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case publishdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case publishdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case publishdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case publishdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case publishdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PublishDelivery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PublishDeliveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case publishdelivery.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case publishdelivery.FieldGitoidSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitoidSha256(v)
+		return nil
+	case publishdelivery.FieldPublisher:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublisher(v)
+		return nil
+	case publishdelivery.FieldStatus:
+		v, ok := value.(publishdelivery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case publishdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case publishdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case publishdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case publishdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case publishdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PublishDelivery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PublishDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, publishdelivery.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PublishDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case publishdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PublishDeliveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case publishdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PublishDelivery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PublishDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(publishdelivery.FieldLastError) {
+		fields = append(fields, publishdelivery.FieldLastError)
+	}
+	if m.FieldCleared(publishdelivery.FieldDeliveredAt) {
+		fields = append(fields, publishdelivery.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PublishDeliveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PublishDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case publishdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case publishdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PublishDelivery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PublishDeliveryMutation) ResetField(name string) error {
+	switch name {
+	case publishdelivery.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case publishdelivery.FieldGitoidSha256:
+		m.ResetGitoidSha256()
+		return nil
+	case publishdelivery.FieldPublisher:
+		m.ResetPublisher()
+		return nil
+	case publishdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case publishdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case publishdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case publishdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case publishdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case publishdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PublishDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PublishDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.dsse != nil {
+		edges = append(edges, publishdelivery.EdgeDsse)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PublishDeliveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case publishdelivery.EdgeDsse:
+		if id := m.dsse; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PublishDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PublishDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PublishDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddsse {
+		edges = append(edges, publishdelivery.EdgeDsse)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PublishDeliveryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case publishdelivery.EdgeDsse:
+		return m.cleareddsse
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PublishDeliveryMutation) ClearEdge(name string) error {
+	switch name {
+	case publishdelivery.EdgeDsse:
+		m.ClearDsse()
+		return nil
+	}
+	return fmt.Errorf("unknown PublishDelivery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PublishDeliveryMutation) ResetEdge(name string) error {
+	switch name {
+	case publishdelivery.EdgeDsse:
+		m.ResetDsse()
+		return nil
+	}
+	return fmt.Errorf("unknown PublishDelivery edge %s", name)
+}
+
+// SbomPackageMutation represents an operation that mutates the SbomPackage nodes in the graph.
+type SbomPackageMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	tenant           *string
+	name             *string
+	version          *string
+	purl             *string
+	license          *string
+	clearedFields    map[string]struct{}
+	digests          map[uuid.UUID]struct{}
+	removeddigests   map[uuid.UUID]struct{}
+	cleareddigests   bool
+	statement        *uuid.UUID
+	clearedstatement bool
+	done             bool
+	oldValue         func(context.Context) (*SbomPackage, error)
+	predicates       []predicate.SbomPackage
+}
+
+var _ ent.Mutation = (*SbomPackageMutation)(nil)
+
+// sbompackageOption allows management of the mutation configuration using functional options.
+type sbompackageOption func(*SbomPackageMutation)
+
+// newSbomPackageMutation creates new mutation for the SbomPackage entity.
+func newSbomPackageMutation(c config, op Op, opts ...sbompackageOption) *SbomPackageMutation {
+	m := &SbomPackageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSbomPackage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSbomPackageID sets the ID field of the mutation.
+func withSbomPackageID(id uuid.UUID) sbompackageOption {
+	return func(m *SbomPackageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SbomPackage
+		)
+		m.oldValue = func(ctx context.Context) (*SbomPackage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SbomPackage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSbomPackage sets the old SbomPackage of the mutation.
+func withSbomPackage(node *SbomPackage) sbompackageOption {
+	return func(m *SbomPackageMutation) {
+		m.oldValue = func(context.Context) (*SbomPackage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SbomPackageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SbomPackageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SbomPackage entities.
+func (m *SbomPackageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SbomPackageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SbomPackageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SbomPackage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *SbomPackageMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SbomPackageMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the SbomPackage entity.
+// If the SbomPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SbomPackageMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SbomPackageMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetName sets the "name" field.
+func (m *SbomPackageMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SbomPackageMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SbomPackage entity.
+// If the SbomPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SbomPackageMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SbomPackageMutation) ResetName() {
+	m.name = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *SbomPackageMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *SbomPackageMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the SbomPackage entity.
+// If the SbomPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SbomPackageMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ClearVersion clears the value of the "version" field.
+func (m *SbomPackageMutation) ClearVersion() {
+	m.version = nil
+	m.clearedFields[sbompackage.FieldVersion] = struct{}{}
+}
+
+// VersionCleared returns if the "version" field was cleared in this mutation.
+func (m *SbomPackageMutation) VersionCleared() bool {
+	_, ok := m.clearedFields[sbompackage.FieldVersion]
+	return ok
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *SbomPackageMutation) ResetVersion() {
+	m.version = nil
+	delete(m.clearedFields, sbompackage.FieldVersion)
+}
+
+// SetPurl sets the "purl" field.
+func (m *SbomPackageMutation) SetPurl(s string) {
+	m.purl = &s
+}
+
+// Purl returns the value of the "purl" field in the mutation.
+func (m *SbomPackageMutation) Purl() (r string, exists bool) {
+	v := m.purl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurl returns the old "purl" field's value of the SbomPackage entity.
+// If the SbomPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SbomPackageMutation) OldPurl(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurl: %w", err)
+	}
+	return oldValue.Purl, nil
+}
+
+// ClearPurl clears the value of the "purl" field.
+func (m *SbomPackageMutation) ClearPurl() {
+	m.purl = nil
+	m.clearedFields[sbompackage.FieldPurl] = struct{}{}
+}
+
+// PurlCleared returns if the "purl" field was cleared in this mutation.
+func (m *SbomPackageMutation) PurlCleared() bool {
+	_, ok := m.clearedFields[sbompackage.FieldPurl]
+	return ok
+}
+
+// ResetPurl resets all changes to the "purl" field.
+func (m *SbomPackageMutation) ResetPurl() {
+	m.purl = nil
+	delete(m.clearedFields, sbompackage.FieldPurl)
+}
+
+// SetLicense sets the "license" field.
+func (m *SbomPackageMutation) SetLicense(s string) {
+	m.license = &s
+}
+
+// License returns the value of the "license" field in the mutation.
+func (m *SbomPackageMutation) License() (r string, exists bool) {
+	v := m.license
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLicense returns the old "license" field's value of the SbomPackage entity.
+// If the SbomPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SbomPackageMutation) OldLicense(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLicense is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLicense requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLicense: %w", err)
+	}
+	return oldValue.License, nil
+}
+
+// ClearLicense clears the value of the "license" field.
+func (m *SbomPackageMutation) ClearLicense() {
+	m.license = nil
+	m.clearedFields[sbompackage.FieldLicense] = struct{}{}
+}
+
+// LicenseCleared returns if the "license" field was cleared in this mutation.
+func (m *SbomPackageMutation) LicenseCleared() bool {
+	_, ok := m.clearedFields[sbompackage.FieldLicense]
+	return ok
+}
+
+// ResetLicense resets all changes to the "license" field.
+func (m *SbomPackageMutation) ResetLicense() {
+	m.license = nil
+	delete(m.clearedFields, sbompackage.FieldLicense)
+}
+
+// AddDigestIDs adds the "digests" edge to the SbomPackageDigest entity by ids.
+func (m *SbomPackageMutation) AddDigestIDs(ids ...uuid.UUID) {
+	if m.digests == nil {
+		m.digests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.digests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDigests clears the "digests" edge to the SbomPackageDigest entity.
+func (m *SbomPackageMutation) ClearDigests() {
+	m.cleareddigests = true
+}
+
+// DigestsCleared reports if the "digests" edge to the SbomPackageDigest entity was cleared.
+func (m *SbomPackageMutation) DigestsCleared() bool {
+	return m.cleareddigests
+}
+
+// RemoveDigestIDs removes the "digests" edge to the SbomPackageDigest entity by IDs.
+func (m *SbomPackageMutation) RemoveDigestIDs(ids ...uuid.UUID) {
+	if m.removeddigests == nil {
+		m.removeddigests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.digests, ids[i])
+		m.removeddigests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDigests returns the removed IDs of the "digests" edge to the SbomPackageDigest entity.
+func (m *SbomPackageMutation) RemovedDigestsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddigests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DigestsIDs returns the "digests" edge IDs in the mutation.
+func (m *SbomPackageMutation) DigestsIDs() (ids []uuid.UUID) {
+	for id := range m.digests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDigests resets all changes to the "digests" edge.
+func (m *SbomPackageMutation) ResetDigests() {
+	m.digests = nil
+	m.cleareddigests = false
+	m.removeddigests = nil
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by id.
+func (m *SbomPackageMutation) SetStatementID(id uuid.UUID) {
+	m.statement = &id
+}
+
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (m *SbomPackageMutation) ClearStatement() {
+	m.clearedstatement = true
+}
+
+// StatementCleared reports if the "statement" edge to the Statement entity was cleared.
+func (m *SbomPackageMutation) StatementCleared() bool {
+	return m.clearedstatement
+}
+
+// StatementID returns the "statement" edge ID in the mutation.
+func (m *SbomPackageMutation) StatementID() (id uuid.UUID, exists bool) {
+	if m.statement != nil {
+		return *m.statement, true
+	}
+	return
+}
+
+// StatementIDs returns the "statement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StatementID instead. It exists only for internal usage by the builders.
+func (m *SbomPackageMutation) StatementIDs() (ids []uuid.UUID) {
+	if id := m.statement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStatement resets all changes to the "statement" edge.
+func (m *SbomPackageMutation) ResetStatement() {
+	m.statement = nil
+	m.clearedstatement = false
+}
+
+// Where appends a list predicates to the SbomPackageMutation builder.
+func (m *SbomPackageMutation) Where(ps ...predicate.SbomPackage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SbomPackageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SbomPackageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SbomPackage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SbomPackageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SbomPackageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SbomPackage).
+func (m *SbomPackageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SbomPackageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant != nil {
+		fields = append(fields, sbompackage.FieldTenant)
+	}
+	if m.name != nil {
+		fields = append(fields, sbompackage.FieldName)
+	}
+	if m.version != nil {
+		fields = append(fields, sbompackage.FieldVersion)
+	}
+	if m.purl != nil {
+		fields = append(fields, sbompackage.FieldPurl)
+	}
+	if m.license != nil {
+		fields = append(fields, sbompackage.FieldLicense)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SbomPackageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sbompackage.FieldTenant:
+		return m.Tenant()
+	case sbompackage.FieldName:
+		return m.Name()
+	case sbompackage.FieldVersion:
+		return m.Version()
+	case sbompackage.FieldPurl:
+		return m.Purl()
+	case sbompackage.FieldLicense:
+		return m.License()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SbomPackageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sbompackage.FieldTenant:
+		return m.OldTenant(ctx)
+	case sbompackage.FieldName:
+		return m.OldName(ctx)
+	case sbompackage.FieldVersion:
+		return m.OldVersion(ctx)
+	case sbompackage.FieldPurl:
+		return m.OldPurl(ctx)
+	case sbompackage.FieldLicense:
+		return m.OldLicense(ctx)
+	}
+	return nil, fmt.Errorf("unknown SbomPackage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SbomPackageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sbompackage.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case sbompackage.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sbompackage.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case sbompackage.FieldPurl:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurl(v)
+		return nil
+	case sbompackage.FieldLicense:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLicense(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SbomPackage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SbomPackageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SbomPackageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SbomPackageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SbomPackage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SbomPackageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sbompackage.FieldVersion) {
+		fields = append(fields, sbompackage.FieldVersion)
+	}
+	if m.FieldCleared(sbompackage.FieldPurl) {
+		fields = append(fields, sbompackage.FieldPurl)
+	}
+	if m.FieldCleared(sbompackage.FieldLicense) {
+		fields = append(fields, sbompackage.FieldLicense)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SbomPackageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SbomPackageMutation) ClearField(name string) error {
+	switch name {
+	case sbompackage.FieldVersion:
+		m.ClearVersion()
+		return nil
+	case sbompackage.FieldPurl:
+		m.ClearPurl()
+		return nil
+	case sbompackage.FieldLicense:
+		m.ClearLicense()
+		return nil
+	}
+	return fmt.Errorf("unknown SbomPackage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SbomPackageMutation) ResetField(name string) error {
+	switch name {
+	case sbompackage.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case sbompackage.FieldName:
+		m.ResetName()
+		return nil
+	case sbompackage.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case sbompackage.FieldPurl:
+		m.ResetPurl()
+		return nil
+	case sbompackage.FieldLicense:
+		m.ResetLicense()
+		return nil
+	}
+	return fmt.Errorf("unknown SbomPackage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SbomPackageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.digests != nil {
+		edges = append(edges, sbompackage.EdgeDigests)
+	}
+	if m.statement != nil {
+		edges = append(edges, sbompackage.EdgeStatement)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SbomPackageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sbompackage.EdgeDigests:
+		ids := make([]ent.Value, 0, len(m.digests))
+		for id := range m.digests {
+			ids = append(ids, id)
+		}
+		return ids
+	case sbompackage.EdgeStatement:
+		if id := m.statement; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SbomPackageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddigests != nil {
+		edges = append(edges, sbompackage.EdgeDigests)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SbomPackageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case sbompackage.EdgeDigests:
+		ids := make([]ent.Value, 0, len(m.removeddigests))
+		for id := range m.removeddigests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SbomPackageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddigests {
+		edges = append(edges, sbompackage.EdgeDigests)
+	}
+	if m.clearedstatement {
+		edges = append(edges, sbompackage.EdgeStatement)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SbomPackageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sbompackage.EdgeDigests:
+		return m.cleareddigests
+	case sbompackage.EdgeStatement:
+		return m.clearedstatement
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SbomPackageMutation) ClearEdge(name string) error {
+	switch name {
+	case sbompackage.EdgeStatement:
+		m.ClearStatement()
+		return nil
+	}
+	return fmt.Errorf("unknown SbomPackage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SbomPackageMutation) ResetEdge(name string) error {
+	switch name {
+	case sbompackage.EdgeDigests:
+		m.ResetDigests()
+		return nil
+	case sbompackage.EdgeStatement:
+		m.ResetStatement()
+		return nil
+	}
+	return fmt.Errorf("unknown SbomPackage edge %s", name)
+}
+
+// SbomPackageDigestMutation represents an operation that mutates the SbomPackageDigest nodes in the graph.
+type SbomPackageDigestMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	tenant              *string
+	algorithm           *string
+	value               *string
+	clearedFields       map[string]struct{}
+	sbom_package        *uuid.UUID
+	clearedsbom_package bool
+	done                bool
+	oldValue            func(context.Context) (*SbomPackageDigest, error)
+	predicates          []predicate.SbomPackageDigest
+}
+
+var _ ent.Mutation = (*SbomPackageDigestMutation)(nil)
+
+// sbompackagedigestOption allows management of the mutation configuration using functional options.
+type sbompackagedigestOption func(*SbomPackageDigestMutation)
+
+// newSbomPackageDigestMutation creates new mutation for the SbomPackageDigest entity.
+func newSbomPackageDigestMutation(c config, op Op, opts ...sbompackagedigestOption) *SbomPackageDigestMutation {
+	m := &SbomPackageDigestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSbomPackageDigest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSbomPackageDigestID sets the ID field of the mutation.
+func withSbomPackageDigestID(id uuid.UUID) sbompackagedigestOption {
+	return func(m *SbomPackageDigestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SbomPackageDigest
+		)
+		m.oldValue = func(ctx context.Context) (*SbomPackageDigest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SbomPackageDigest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSbomPackageDigest sets the old SbomPackageDigest of the mutation.
+func withSbomPackageDigest(node *SbomPackageDigest) sbompackagedigestOption {
+	return func(m *SbomPackageDigestMutation) {
+		m.oldValue = func(context.Context) (*SbomPackageDigest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SbomPackageDigestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SbomPackageDigestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SbomPackageDigest entities.
+func (m *SbomPackageDigestMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SbomPackageDigestMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SbomPackageDigestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SbomPackageDigest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *SbomPackageDigestMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SbomPackageDigestMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the SbomPackageDigest entity.
+// If the SbomPackageDigest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SbomPackageDigestMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SbomPackageDigestMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *SbomPackageDigestMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *SbomPackageDigestMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the SbomPackageDigest entity.
+// If the SbomPackageDigest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SbomPackageDigestMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *SbomPackageDigestMutation) ResetAlgorithm() {
+	m.algorithm = nil
+}
+
+// SetValue sets the "value" field.
+func (m *SbomPackageDigestMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SbomPackageDigestMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the SbomPackageDigest entity.
+// If the SbomPackageDigest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SbomPackageDigestMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SbomPackageDigestMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetSbomPackageID sets the "sbom_package" edge to the SbomPackage entity by id.
+func (m *SbomPackageDigestMutation) SetSbomPackageID(id uuid.UUID) {
+	m.sbom_package = &id
+}
+
+// ClearSbomPackage clears the "sbom_package" edge to the SbomPackage entity.
+func (m *SbomPackageDigestMutation) ClearSbomPackage() {
+	m.clearedsbom_package = true
+}
+
+// SbomPackageCleared reports if the "sbom_package" edge to the SbomPackage entity was cleared.
+func (m *SbomPackageDigestMutation) SbomPackageCleared() bool {
+	return m.clearedsbom_package
+}
+
+// SbomPackageID returns the "sbom_package" edge ID in the mutation.
+func (m *SbomPackageDigestMutation) SbomPackageID() (id uuid.UUID, exists bool) {
+	if m.sbom_package != nil {
+		return *m.sbom_package, true
+	}
+	return
+}
+
+// SbomPackageIDs returns the "sbom_package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SbomPackageID instead. It exists only for internal usage by the builders.
+func (m *SbomPackageDigestMutation) SbomPackageIDs() (ids []uuid.UUID) {
+	if id := m.sbom_package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSbomPackage resets all changes to the "sbom_package" edge.
+func (m *SbomPackageDigestMutation) ResetSbomPackage() {
+	m.sbom_package = nil
+	m.clearedsbom_package = false
+}
+
+// Where appends a list predicates to the SbomPackageDigestMutation builder.
+func (m *SbomPackageDigestMutation) Where(ps ...predicate.SbomPackageDigest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SbomPackageDigestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SbomPackageDigestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SbomPackageDigest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SbomPackageDigestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SbomPackageDigestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SbomPackageDigest).
+func (m *SbomPackageDigestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SbomPackageDigestMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, sbompackagedigest.FieldTenant)
+	}
+	if m.algorithm != nil {
+		fields = append(fields, sbompackagedigest.FieldAlgorithm)
+	}
+	if m.value != nil {
+		fields = append(fields, sbompackagedigest.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SbomPackageDigestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sbompackagedigest.FieldTenant:
+		return m.Tenant()
+	case sbompackagedigest.FieldAlgorithm:
+		return m.Algorithm()
+	case sbompackagedigest.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SbomPackageDigestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sbompackagedigest.FieldTenant:
+		return m.OldTenant(ctx)
+	case sbompackagedigest.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case sbompackagedigest.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown SbomPackageDigest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SbomPackageDigestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sbompackagedigest.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case sbompackagedigest.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case sbompackagedigest.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SbomPackageDigest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SbomPackageDigestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SbomPackageDigestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SbomPackageDigestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SbomPackageDigest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SbomPackageDigestMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SbomPackageDigestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SbomPackageDigestMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SbomPackageDigest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SbomPackageDigestMutation) ResetField(name string) error {
+	switch name {
+	case sbompackagedigest.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case sbompackagedigest.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case sbompackagedigest.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown SbomPackageDigest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SbomPackageDigestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.sbom_package != nil {
+		edges = append(edges, sbompackagedigest.EdgeSbomPackage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SbomPackageDigestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sbompackagedigest.EdgeSbomPackage:
+		if id := m.sbom_package; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SbomPackageDigestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SbomPackageDigestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SbomPackageDigestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsbom_package {
+		edges = append(edges, sbompackagedigest.EdgeSbomPackage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SbomPackageDigestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sbompackagedigest.EdgeSbomPackage:
+		return m.clearedsbom_package
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SbomPackageDigestMutation) ClearEdge(name string) error {
+	switch name {
+	case sbompackagedigest.EdgeSbomPackage:
+		m.ClearSbomPackage()
+		return nil
+	}
+	return fmt.Errorf("unknown SbomPackageDigest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SbomPackageDigestMutation) ResetEdge(name string) error {
+	switch name {
+	case sbompackagedigest.EdgeSbomPackage:
+		m.ResetSbomPackage()
+		return nil
+	}
+	return fmt.Errorf("unknown SbomPackageDigest edge %s", name)
+}
+
+// SignatureMutation represents an operation that mutates the Signature nodes in the graph.
+type SignatureMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	tenant              *string
+	key_id              *string
+	signature           *string
+	verified            *bool
+	clearedFields       map[string]struct{}
+	dsse                *uuid.UUID
+	cleareddsse         bool
+	timestamps          map[uuid.UUID]struct{}
+	removedtimestamps   map[uuid.UUID]struct{}
+	clearedtimestamps   bool
+	certificates        map[uuid.UUID]struct{}
+	removedcertificates map[uuid.UUID]struct{}
+	clearedcertificates bool
+	done                bool
+	oldValue            func(context.Context) (*Signature, error)
+	predicates          []predicate.Signature
+}
+
+var _ ent.Mutation = (*SignatureMutation)(nil)
+
+// signatureOption allows management of the mutation configuration using functional options.
+type signatureOption func(*SignatureMutation)
+
+// newSignatureMutation creates new mutation for the Signature entity.
+func newSignatureMutation(c config, op Op, opts ...signatureOption) *SignatureMutation {
+	m := &SignatureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSignature,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSignatureID sets the ID field of the mutation.
+func withSignatureID(id uuid.UUID) signatureOption {
+	return func(m *SignatureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Signature
+		)
+		m.oldValue = func(ctx context.Context) (*Signature, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Signature.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSignature sets the old Signature of the mutation.
+func withSignature(node *Signature) signatureOption {
+	return func(m *SignatureMutation) {
+		m.oldValue = func(context.Context) (*Signature, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SignatureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SignatureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Signature entities.
+func (m *SignatureMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SignatureMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SignatureMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Signature.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *SignatureMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SignatureMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Signature entity.
+// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignatureMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SignatureMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *SignatureMutation) SetKeyID(s string) {
+	m.key_id = &s
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *SignatureMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyID returns the old "key_id" field's value of the Signature entity.
+// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignatureMutation) OldKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+	}
+	return oldValue.KeyID, nil
+}
+
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *SignatureMutation) ResetKeyID() {
+	m.key_id = nil
+}
+
+// SetSignature sets the "signature" field.
+func (m *SignatureMutation) SetSignature(s string) {
+	m.signature = &s
+}
+
+// Signature returns the value of the "signature" field in the mutation.
+func (m *SignatureMutation) Signature() (r string, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignature returns the old "signature" field's value of the Signature entity.
+// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignatureMutation) OldSignature(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+	}
+	return oldValue.Signature, nil
+}
+
+// ResetSignature resets all changes to the "signature" field.
+func (m *SignatureMutation) ResetSignature() {
+	m.signature = nil
+}
+
+// SetVerified sets the "verified" field.
+func (m *SignatureMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *SignatureMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the Signature entity.
+// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignatureMutation) OldVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *SignatureMutation) ResetVerified() {
+	m.verified = nil
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by id.
+func (m *SignatureMutation) SetDsseID(id uuid.UUID) {
+	m.dsse = &id
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (m *SignatureMutation) ClearDsse() {
+	m.cleareddsse = true
+}
+
+// DsseCleared reports if the "dsse" edge to the Dsse entity was cleared.
+func (m *SignatureMutation) DsseCleared() bool {
+	return m.cleareddsse
+}
+
+// DsseID returns the "dsse" edge ID in the mutation.
+func (m *SignatureMutation) DsseID() (id uuid.UUID, exists bool) {
+	if m.dsse != nil {
+		return *m.dsse, true
+	}
+	return
+}
+
+// DsseIDs returns the "dsse" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DsseID instead. It exists only for internal usage by the builders.
+func (m *SignatureMutation) DsseIDs() (ids []uuid.UUID) {
+	if id := m.dsse; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDsse resets all changes to the "dsse" edge.
+func (m *SignatureMutation) ResetDsse() {
+	m.dsse = nil
+	m.cleareddsse = false
+}
+
+// AddTimestampIDs adds the "timestamps" edge to the Timestamp entity by ids.
+func (m *SignatureMutation) AddTimestampIDs(ids ...uuid.UUID) {
+	if m.timestamps == nil {
+		m.timestamps = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.timestamps[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTimestamps clears the "timestamps" edge to the Timestamp entity.
+func (m *SignatureMutation) ClearTimestamps() {
+	m.clearedtimestamps = true
+}
+
+// TimestampsCleared reports if the "timestamps" edge to the Timestamp entity was cleared.
+func (m *SignatureMutation) TimestampsCleared() bool {
+	return m.clearedtimestamps
+}
+
+// RemoveTimestampIDs removes the "timestamps" edge to the Timestamp entity by IDs.
+func (m *SignatureMutation) RemoveTimestampIDs(ids ...uuid.UUID) {
+	if m.removedtimestamps == nil {
+		m.removedtimestamps = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.timestamps, ids[i])
+		m.removedtimestamps[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTimestamps returns the removed IDs of the "timestamps" edge to the Timestamp entity.
+func (m *SignatureMutation) RemovedTimestampsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtimestamps {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TimestampsIDs returns the "timestamps" edge IDs in the mutation.
+func (m *SignatureMutation) TimestampsIDs() (ids []uuid.UUID) {
+	for id := range m.timestamps {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTimestamps resets all changes to the "timestamps" edge.
+func (m *SignatureMutation) ResetTimestamps() {
+	m.timestamps = nil
+	m.clearedtimestamps = false
+	m.removedtimestamps = nil
+}
+
+// AddCertificateIDs adds the "certificates" edge to the Certificate entity by ids.
+func (m *SignatureMutation) AddCertificateIDs(ids ...uuid.UUID) {
+	if m.certificates == nil {
+		m.certificates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.certificates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCertificates clears the "certificates" edge to the Certificate entity.
+func (m *SignatureMutation) ClearCertificates() {
+	m.clearedcertificates = true
+}
+
+// CertificatesCleared reports if the "certificates" edge to the Certificate entity was cleared.
+func (m *SignatureMutation) CertificatesCleared() bool {
+	return m.clearedcertificates
+}
+
+// RemoveCertificateIDs removes the "certificates" edge to the Certificate entity by IDs.
+func (m *SignatureMutation) RemoveCertificateIDs(ids ...uuid.UUID) {
+	if m.removedcertificates == nil {
+		m.removedcertificates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.certificates, ids[i])
+		m.removedcertificates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCertificates returns the removed IDs of the "certificates" edge to the Certificate entity.
+func (m *SignatureMutation) RemovedCertificatesIDs() (ids []uuid.UUID) {
+	for id := range m.removedcertificates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CertificatesIDs returns the "certificates" edge IDs in the mutation.
+func (m *SignatureMutation) CertificatesIDs() (ids []uuid.UUID) {
+	for id := range m.certificates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCertificates resets all changes to the "certificates" edge.
+func (m *SignatureMutation) ResetCertificates() {
+	m.certificates = nil
+	m.clearedcertificates = false
+	m.removedcertificates = nil
+}
+
+// Where appends a list predicates to the SignatureMutation builder.
+func (m *SignatureMutation) Where(ps ...predicate.Signature) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SignatureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SignatureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Signature, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SignatureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SignatureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Signature).
+func (m *SignatureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SignatureMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.tenant != nil {
+		fields = append(fields, signature.FieldTenant)
+	}
+	if m.key_id != nil {
+		fields = append(fields, signature.FieldKeyID)
+	}
+	if m.signature != nil {
+		fields = append(fields, signature.FieldSignature)
+	}
+	if m.verified != nil {
+		fields = append(fields, signature.FieldVerified)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SignatureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case signature.FieldTenant:
+		return m.Tenant()
+	case signature.FieldKeyID:
+		return m.KeyID()
+	case signature.FieldSignature:
+		return m.Signature()
+	case signature.FieldVerified:
+		return m.Verified()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SignatureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case signature.FieldTenant:
+		return m.OldTenant(ctx)
+	case signature.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case signature.FieldSignature:
+		return m.OldSignature(ctx)
+	case signature.FieldVerified:
+		return m.OldVerified(ctx)
+	}
+	return nil, fmt.Errorf("unknown Signature field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SignatureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case signature.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case signature.FieldKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case signature.FieldSignature:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignature(v)
+		return nil
+	case signature.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Signature field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SignatureMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SignatureMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SignatureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Signature numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SignatureMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SignatureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SignatureMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Signature nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SignatureMutation) ResetField(name string) error {
+	switch name {
+	case signature.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case signature.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case signature.FieldSignature:
+		m.ResetSignature()
+		return nil
+	case signature.FieldVerified:
+		m.ResetVerified()
+		return nil
+	}
+	return fmt.Errorf("unknown Signature field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SignatureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.dsse != nil {
+		edges = append(edges, signature.EdgeDsse)
+	}
+	if m.timestamps != nil {
+		edges = append(edges, signature.EdgeTimestamps)
+	}
+	if m.certificates != nil {
+		edges = append(edges, signature.EdgeCertificates)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SignatureMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case signature.EdgeDsse:
+		if id := m.dsse; id != nil {
+			return []ent.Value{*id}
+		}
+	case signature.EdgeTimestamps:
+		ids := make([]ent.Value, 0, len(m.timestamps))
+		for id := range m.timestamps {
+			ids = append(ids, id)
+		}
+		return ids
+	case signature.EdgeCertificates:
+		ids := make([]ent.Value, 0, len(m.certificates))
+		for id := range m.certificates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SignatureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtimestamps != nil {
+		edges = append(edges, signature.EdgeTimestamps)
+	}
+	if m.removedcertificates != nil {
+		edges = append(edges, signature.EdgeCertificates)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SignatureMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case signature.EdgeTimestamps:
+		ids := make([]ent.Value, 0, len(m.removedtimestamps))
+		for id := range m.removedtimestamps {
+			ids = append(ids, id)
+		}
+		return ids
+	case signature.EdgeCertificates:
+		ids := make([]ent.Value, 0, len(m.removedcertificates))
+		for id := range m.removedcertificates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SignatureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareddsse {
+		edges = append(edges, signature.EdgeDsse)
+	}
+	if m.clearedtimestamps {
+		edges = append(edges, signature.EdgeTimestamps)
+	}
+	if m.clearedcertificates {
+		edges = append(edges, signature.EdgeCertificates)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SignatureMutation) EdgeCleared(name string) bool {
+	switch name {
+	case signature.EdgeDsse:
+		return m.cleareddsse
+	case signature.EdgeTimestamps:
+		return m.clearedtimestamps
+	case signature.EdgeCertificates:
+		return m.clearedcertificates
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SignatureMutation) ClearEdge(name string) error {
+	switch name {
+	case signature.EdgeDsse:
+		m.ClearDsse()
+		return nil
+	}
+	return fmt.Errorf("unknown Signature unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SignatureMutation) ResetEdge(name string) error {
+	switch name {
+	case signature.EdgeDsse:
+		m.ResetDsse()
+		return nil
+	case signature.EdgeTimestamps:
+		m.ResetTimestamps()
+		return nil
+	case signature.EdgeCertificates:
+		m.ResetCertificates()
+		return nil
+	}
+	return fmt.Errorf("unknown Signature edge %s", name)
+}
+
+// SlsaDependencyMutation represents an operation that mutates the SlsaDependency nodes in the graph.
+type SlsaDependencyMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	tenant                 *string
+	uri                    *string
+	name                   *string
+	algorithm              *string
+	value                  *string
+	clearedFields          map[string]struct{}
+	slsa_provenance        *uuid.UUID
+	clearedslsa_provenance bool
+	done                   bool
+	oldValue               func(context.Context) (*SlsaDependency, error)
+	predicates             []predicate.SlsaDependency
+}
+
+var _ ent.Mutation = (*SlsaDependencyMutation)(nil)
+
+// slsadependencyOption allows management of the mutation configuration using functional options.
+type slsadependencyOption func(*SlsaDependencyMutation)
+
+// newSlsaDependencyMutation creates new mutation for the SlsaDependency entity.
+func newSlsaDependencyMutation(c config, op Op, opts ...slsadependencyOption) *SlsaDependencyMutation {
+	m := &SlsaDependencyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSlsaDependency,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSlsaDependencyID sets the ID field of the mutation.
+func withSlsaDependencyID(id uuid.UUID) slsadependencyOption {
+	return func(m *SlsaDependencyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SlsaDependency
+		)
+		m.oldValue = func(ctx context.Context) (*SlsaDependency, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SlsaDependency.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSlsaDependency sets the old SlsaDependency of the mutation.
+func withSlsaDependency(node *SlsaDependency) slsadependencyOption {
+	return func(m *SlsaDependencyMutation) {
+		m.oldValue = func(context.Context) (*SlsaDependency, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SlsaDependencyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SlsaDependencyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SlsaDependency entities.
+func (m *SlsaDependencyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SlsaDependencyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SlsaDependencyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SlsaDependency.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *SlsaDependencyMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SlsaDependencyMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the SlsaDependency entity.
+// If the SlsaDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaDependencyMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SlsaDependencyMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *SlsaDependencyMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *SlsaDependencyMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the SlsaDependency entity.
+// If the SlsaDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaDependencyMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ClearURI clears the value of the "uri" field.
+func (m *SlsaDependencyMutation) ClearURI() {
+	m.uri = nil
+	m.clearedFields[slsadependency.FieldURI] = struct{}{}
+}
+
+// URICleared returns if the "uri" field was cleared in this mutation.
+func (m *SlsaDependencyMutation) URICleared() bool {
+	_, ok := m.clearedFields[slsadependency.FieldURI]
+	return ok
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *SlsaDependencyMutation) ResetURI() {
+	m.uri = nil
+	delete(m.clearedFields, slsadependency.FieldURI)
+}
+
+// SetName sets the "name" field.
+func (m *SlsaDependencyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SlsaDependencyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SlsaDependency entity.
+// If the SlsaDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaDependencyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *SlsaDependencyMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[slsadependency.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *SlsaDependencyMutation) NameCleared() bool {
+	_, ok := m.clearedFields[slsadependency.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SlsaDependencyMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, slsadependency.FieldName)
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *SlsaDependencyMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *SlsaDependencyMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the SlsaDependency entity.
+// If the SlsaDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaDependencyMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// ClearAlgorithm clears the value of the "algorithm" field.
+func (m *SlsaDependencyMutation) ClearAlgorithm() {
+	m.algorithm = nil
+	m.clearedFields[slsadependency.FieldAlgorithm] = struct{}{}
+}
+
+// AlgorithmCleared returns if the "algorithm" field was cleared in this mutation.
+func (m *SlsaDependencyMutation) AlgorithmCleared() bool {
+	_, ok := m.clearedFields[slsadependency.FieldAlgorithm]
+	return ok
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *SlsaDependencyMutation) ResetAlgorithm() {
+	m.algorithm = nil
+	delete(m.clearedFields, slsadependency.FieldAlgorithm)
+}
+
+// SetValue sets the "value" field.
+func (m *SlsaDependencyMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SlsaDependencyMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the SlsaDependency entity.
+// If the SlsaDependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaDependencyMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ClearValue clears the value of the "value" field.
+func (m *SlsaDependencyMutation) ClearValue() {
+	m.value = nil
+	m.clearedFields[slsadependency.FieldValue] = struct{}{}
+}
+
+// ValueCleared returns if the "value" field was cleared in this mutation.
+func (m *SlsaDependencyMutation) ValueCleared() bool {
+	_, ok := m.clearedFields[slsadependency.FieldValue]
+	return ok
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SlsaDependencyMutation) ResetValue() {
+	m.value = nil
+	delete(m.clearedFields, slsadependency.FieldValue)
+}
+
+// SetSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by id.
+func (m *SlsaDependencyMutation) SetSlsaProvenanceID(id uuid.UUID) {
+	m.slsa_provenance = &id
+}
+
+// ClearSlsaProvenance clears the "slsa_provenance" edge to the SlsaProvenance entity.
+func (m *SlsaDependencyMutation) ClearSlsaProvenance() {
+	m.clearedslsa_provenance = true
+}
+
+// SlsaProvenanceCleared reports if the "slsa_provenance" edge to the SlsaProvenance entity was cleared.
+func (m *SlsaDependencyMutation) SlsaProvenanceCleared() bool {
+	return m.clearedslsa_provenance
+}
+
+// SlsaProvenanceID returns the "slsa_provenance" edge ID in the mutation.
+func (m *SlsaDependencyMutation) SlsaProvenanceID() (id uuid.UUID, exists bool) {
+	if m.slsa_provenance != nil {
+		return *m.slsa_provenance, true
+	}
+	return
+}
+
+// SlsaProvenanceIDs returns the "slsa_provenance" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SlsaProvenanceID instead. It exists only for internal usage by the builders.
+func (m *SlsaDependencyMutation) SlsaProvenanceIDs() (ids []uuid.UUID) {
+	if id := m.slsa_provenance; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSlsaProvenance resets all changes to the "slsa_provenance" edge.
+func (m *SlsaDependencyMutation) ResetSlsaProvenance() {
+	m.slsa_provenance = nil
+	m.clearedslsa_provenance = false
+}
+
+// Where appends a list predicates to the SlsaDependencyMutation builder.
+func (m *SlsaDependencyMutation) Where(ps ...predicate.SlsaDependency) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SlsaDependencyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SlsaDependencyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SlsaDependency, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SlsaDependencyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SlsaDependencyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SlsaDependency).
+func (m *SlsaDependencyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SlsaDependencyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant != nil {
+		fields = append(fields, slsadependency.FieldTenant)
+	}
+	if m.uri != nil {
+		fields = append(fields, slsadependency.FieldURI)
+	}
+	if m.name != nil {
+		fields = append(fields, slsadependency.FieldName)
+	}
+	if m.algorithm != nil {
+		fields = append(fields, slsadependency.FieldAlgorithm)
+	}
+	if m.value != nil {
+		fields = append(fields, slsadependency.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SlsaDependencyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case slsadependency.FieldTenant:
+		return m.Tenant()
+	case slsadependency.FieldURI:
+		return m.URI()
+	case slsadependency.FieldName:
+		return m.Name()
+	case slsadependency.FieldAlgorithm:
+		return m.Algorithm()
+	case slsadependency.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SlsaDependencyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case slsadependency.FieldTenant:
+		return m.OldTenant(ctx)
+	case slsadependency.FieldURI:
+		return m.OldURI(ctx)
+	case slsadependency.FieldName:
+		return m.OldName(ctx)
+	case slsadependency.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case slsadependency.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown SlsaDependency field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlsaDependencyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case slsadependency.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case slsadependency.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case slsadependency.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case slsadependency.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case slsadependency.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaDependency field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SlsaDependencyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SlsaDependencyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlsaDependencyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SlsaDependency numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SlsaDependencyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(slsadependency.FieldURI) {
+		fields = append(fields, slsadependency.FieldURI)
+	}
+	if m.FieldCleared(slsadependency.FieldName) {
+		fields = append(fields, slsadependency.FieldName)
+	}
+	if m.FieldCleared(slsadependency.FieldAlgorithm) {
+		fields = append(fields, slsadependency.FieldAlgorithm)
+	}
+	if m.FieldCleared(slsadependency.FieldValue) {
+		fields = append(fields, slsadependency.FieldValue)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SlsaDependencyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SlsaDependencyMutation) ClearField(name string) error {
+	switch name {
+	case slsadependency.FieldURI:
+		m.ClearURI()
+		return nil
+	case slsadependency.FieldName:
+		m.ClearName()
+		return nil
+	case slsadependency.FieldAlgorithm:
+		m.ClearAlgorithm()
+		return nil
+	case slsadependency.FieldValue:
+		m.ClearValue()
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaDependency nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SlsaDependencyMutation) ResetField(name string) error {
+	switch name {
+	case slsadependency.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case slsadependency.FieldURI:
+		m.ResetURI()
+		return nil
+	case slsadependency.FieldName:
+		m.ResetName()
+		return nil
+	case slsadependency.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case slsadependency.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaDependency field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SlsaDependencyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.slsa_provenance != nil {
+		edges = append(edges, slsadependency.EdgeSlsaProvenance)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SlsaDependencyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case slsadependency.EdgeSlsaProvenance:
+		if id := m.slsa_provenance; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SlsaDependencyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SlsaDependencyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SlsaDependencyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedslsa_provenance {
+		edges = append(edges, slsadependency.EdgeSlsaProvenance)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SlsaDependencyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case slsadependency.EdgeSlsaProvenance:
+		return m.clearedslsa_provenance
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SlsaDependencyMutation) ClearEdge(name string) error {
+	switch name {
+	case slsadependency.EdgeSlsaProvenance:
+		m.ClearSlsaProvenance()
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaDependency unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SlsaDependencyMutation) ResetEdge(name string) error {
+	switch name {
+	case slsadependency.EdgeSlsaProvenance:
+		m.ResetSlsaProvenance()
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaDependency edge %s", name)
+}
+
+// SlsaProvenanceMutation represents an operation that mutates the SlsaProvenance nodes in the graph.
+type SlsaProvenanceMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	tenant                       *string
+	builder_id                   *string
+	build_type                   *string
+	invocation_id                *string
+	started_on                   *time.Time
+	finished_on                  *time.Time
+	external_parameters          *string
+	clearedFields                map[string]struct{}
+	resolved_dependencies        map[uuid.UUID]struct{}
+	removedresolved_dependencies map[uuid.UUID]struct{}
+	clearedresolved_dependencies bool
+	statement                    *uuid.UUID
+	clearedstatement             bool
+	done                         bool
+	oldValue                     func(context.Context) (*SlsaProvenance, error)
+	predicates                   []predicate.SlsaProvenance
+}
+
+var _ ent.Mutation = (*SlsaProvenanceMutation)(nil)
+
+// slsaprovenanceOption allows management of the mutation configuration using functional options.
+type slsaprovenanceOption func(*SlsaProvenanceMutation)
+
+// newSlsaProvenanceMutation creates new mutation for the SlsaProvenance entity.
+func newSlsaProvenanceMutation(c config, op Op, opts ...slsaprovenanceOption) *SlsaProvenanceMutation {
+	m := &SlsaProvenanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSlsaProvenance,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSlsaProvenanceID sets the ID field of the mutation.
+func withSlsaProvenanceID(id uuid.UUID) slsaprovenanceOption {
+	return func(m *SlsaProvenanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SlsaProvenance
+		)
+		m.oldValue = func(ctx context.Context) (*SlsaProvenance, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SlsaProvenance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSlsaProvenance sets the old SlsaProvenance of the mutation.
+func withSlsaProvenance(node *SlsaProvenance) slsaprovenanceOption {
+	return func(m *SlsaProvenanceMutation) {
+		m.oldValue = func(context.Context) (*SlsaProvenance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SlsaProvenanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SlsaProvenanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SlsaProvenance entities.
+func (m *SlsaProvenanceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SlsaProvenanceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SlsaProvenanceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SlsaProvenance.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *SlsaProvenanceMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SlsaProvenanceMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the SlsaProvenance entity.
+// If the SlsaProvenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaProvenanceMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SlsaProvenanceMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetBuilderID sets the "builder_id" field.
+func (m *SlsaProvenanceMutation) SetBuilderID(s string) {
+	m.builder_id = &s
+}
+
+// BuilderID returns the value of the "builder_id" field in the mutation.
+func (m *SlsaProvenanceMutation) BuilderID() (r string, exists bool) {
+	v := m.builder_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuilderID returns the old "builder_id" field's value of the SlsaProvenance entity.
+// If the SlsaProvenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaProvenanceMutation) OldBuilderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuilderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuilderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuilderID: %w", err)
+	}
+	return oldValue.BuilderID, nil
+}
+
+// ResetBuilderID resets all changes to the "builder_id" field.
+func (m *SlsaProvenanceMutation) ResetBuilderID() {
+	m.builder_id = nil
+}
+
+// SetBuildType sets the "build_type" field.
+func (m *SlsaProvenanceMutation) SetBuildType(s string) {
+	m.build_type = &s
+}
+
+// BuildType returns the value of the "build_type" field in the mutation.
+func (m *SlsaProvenanceMutation) BuildType() (r string, exists bool) {
+	v := m.build_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildType returns the old "build_type" field's value of the SlsaProvenance entity.
+// If the SlsaProvenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaProvenanceMutation) OldBuildType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildType: %w", err)
+	}
+	return oldValue.BuildType, nil
+}
+
+// ResetBuildType resets all changes to the "build_type" field.
+func (m *SlsaProvenanceMutation) ResetBuildType() {
+	m.build_type = nil
+}
+
+// SetInvocationID sets the "invocation_id" field.
+func (m *SlsaProvenanceMutation) SetInvocationID(s string) {
+	m.invocation_id = &s
+}
+
+// InvocationID returns the value of the "invocation_id" field in the mutation.
+func (m *SlsaProvenanceMutation) InvocationID() (r string, exists bool) {
+	v := m.invocation_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvocationID returns the old "invocation_id" field's value of the SlsaProvenance entity.
+// If the SlsaProvenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaProvenanceMutation) OldInvocationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvocationID: %w", err)
+	}
+	return oldValue.InvocationID, nil
+}
+
+// ClearInvocationID clears the value of the "invocation_id" field.
+func (m *SlsaProvenanceMutation) ClearInvocationID() {
+	m.invocation_id = nil
+	m.clearedFields[slsaprovenance.FieldInvocationID] = struct{}{}
+}
+
+// InvocationIDCleared returns if the "invocation_id" field was cleared in this mutation.
+func (m *SlsaProvenanceMutation) InvocationIDCleared() bool {
+	_, ok := m.clearedFields[slsaprovenance.FieldInvocationID]
+	return ok
+}
+
+// ResetInvocationID resets all changes to the "invocation_id" field.
+func (m *SlsaProvenanceMutation) ResetInvocationID() {
+	m.invocation_id = nil
+	delete(m.clearedFields, slsaprovenance.FieldInvocationID)
+}
+
+// SetStartedOn sets the "started_on" field.
+func (m *SlsaProvenanceMutation) SetStartedOn(t time.Time) {
+	m.started_on = &t
+}
+
+// StartedOn returns the value of the "started_on" field in the mutation.
+func (m *SlsaProvenanceMutation) StartedOn() (r time.Time, exists bool) {
+	v := m.started_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedOn returns the old "started_on" field's value of the SlsaProvenance entity.
+// If the SlsaProvenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaProvenanceMutation) OldStartedOn(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedOn: %w", err)
+	}
+	return oldValue.StartedOn, nil
+}
+
+// ClearStartedOn clears the value of the "started_on" field.
+func (m *SlsaProvenanceMutation) ClearStartedOn() {
+	m.started_on = nil
+	m.clearedFields[slsaprovenance.FieldStartedOn] = struct{}{}
+}
+
+// StartedOnCleared returns if the "started_on" field was cleared in this mutation.
+func (m *SlsaProvenanceMutation) StartedOnCleared() bool {
+	_, ok := m.clearedFields[slsaprovenance.FieldStartedOn]
+	return ok
+}
+
+// ResetStartedOn resets all changes to the "started_on" field.
+func (m *SlsaProvenanceMutation) ResetStartedOn() {
+	m.started_on = nil
+	delete(m.clearedFields, slsaprovenance.FieldStartedOn)
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (m *SlsaProvenanceMutation) SetFinishedOn(t time.Time) {
+	m.finished_on = &t
+}
+
+// FinishedOn returns the value of the "finished_on" field in the mutation.
+func (m *SlsaProvenanceMutation) FinishedOn() (r time.Time, exists bool) {
+	v := m.finished_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedOn returns the old "finished_on" field's value of the SlsaProvenance entity.
+// If the SlsaProvenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaProvenanceMutation) OldFinishedOn(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedOn: %w", err)
+	}
+	return oldValue.FinishedOn, nil
+}
+
+// ClearFinishedOn clears the value of the "finished_on" field.
+func (m *SlsaProvenanceMutation) ClearFinishedOn() {
+	m.finished_on = nil
+	m.clearedFields[slsaprovenance.FieldFinishedOn] = struct{}{}
+}
+
+// FinishedOnCleared returns if the "finished_on" field was cleared in this mutation.
+func (m *SlsaProvenanceMutation) FinishedOnCleared() bool {
+	_, ok := m.clearedFields[slsaprovenance.FieldFinishedOn]
+	return ok
+}
+
+// ResetFinishedOn resets all changes to the "finished_on" field.
+func (m *SlsaProvenanceMutation) ResetFinishedOn() {
+	m.finished_on = nil
+	delete(m.clearedFields, slsaprovenance.FieldFinishedOn)
+}
+
+// SetExternalParameters sets the "external_parameters" field.
+func (m *SlsaProvenanceMutation) SetExternalParameters(s string) {
+	m.external_parameters = &s
+}
+
+// ExternalParameters returns the value of the "external_parameters" field in the mutation.
+func (m *SlsaProvenanceMutation) ExternalParameters() (r string, exists bool) {
+	v := m.external_parameters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalParameters returns the old "external_parameters" field's value of the SlsaProvenance entity.
+// If the SlsaProvenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlsaProvenanceMutation) OldExternalParameters(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalParameters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalParameters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalParameters: %w", err)
+	}
+	return oldValue.ExternalParameters, nil
+}
+
+// ClearExternalParameters clears the value of the "external_parameters" field.
+func (m *SlsaProvenanceMutation) ClearExternalParameters() {
+	m.external_parameters = nil
+	m.clearedFields[slsaprovenance.FieldExternalParameters] = struct{}{}
+}
+
+// ExternalParametersCleared returns if the "external_parameters" field was cleared in this mutation.
+func (m *SlsaProvenanceMutation) ExternalParametersCleared() bool {
+	_, ok := m.clearedFields[slsaprovenance.FieldExternalParameters]
+	return ok
+}
+
+// ResetExternalParameters resets all changes to the "external_parameters" field.
+func (m *SlsaProvenanceMutation) ResetExternalParameters() {
+	m.external_parameters = nil
+	delete(m.clearedFields, slsaprovenance.FieldExternalParameters)
+}
+
+// AddResolvedDependencyIDs adds the "resolved_dependencies" edge to the SlsaDependency entity by ids.
+func (m *SlsaProvenanceMutation) AddResolvedDependencyIDs(ids ...uuid.UUID) {
+	if m.resolved_dependencies == nil {
+		m.resolved_dependencies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.resolved_dependencies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearResolvedDependencies clears the "resolved_dependencies" edge to the SlsaDependency entity.
+func (m *SlsaProvenanceMutation) ClearResolvedDependencies() {
+	m.clearedresolved_dependencies = true
+}
+
+// ResolvedDependenciesCleared reports if the "resolved_dependencies" edge to the SlsaDependency entity was cleared.
+func (m *SlsaProvenanceMutation) ResolvedDependenciesCleared() bool {
+	return m.clearedresolved_dependencies
+}
+
+// RemoveResolvedDependencyIDs removes the "resolved_dependencies" edge to the SlsaDependency entity by IDs.
+func (m *SlsaProvenanceMutation) RemoveResolvedDependencyIDs(ids ...uuid.UUID) {
+	if m.removedresolved_dependencies == nil {
+		m.removedresolved_dependencies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.resolved_dependencies, ids[i])
+		m.removedresolved_dependencies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedResolvedDependencies returns the removed IDs of the "resolved_dependencies" edge to the SlsaDependency entity.
+func (m *SlsaProvenanceMutation) RemovedResolvedDependenciesIDs() (ids []uuid.UUID) {
+	for id := range m.removedresolved_dependencies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResolvedDependenciesIDs returns the "resolved_dependencies" edge IDs in the mutation.
+func (m *SlsaProvenanceMutation) ResolvedDependenciesIDs() (ids []uuid.UUID) {
+	for id := range m.resolved_dependencies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetResolvedDependencies resets all changes to the "resolved_dependencies" edge.
+func (m *SlsaProvenanceMutation) ResetResolvedDependencies() {
+	m.resolved_dependencies = nil
+	m.clearedresolved_dependencies = false
+	m.removedresolved_dependencies = nil
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by id.
+func (m *SlsaProvenanceMutation) SetStatementID(id uuid.UUID) {
+	m.statement = &id
+}
+
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (m *SlsaProvenanceMutation) ClearStatement() {
+	m.clearedstatement = true
+}
+
+// StatementCleared reports if the "statement" edge to the Statement entity was cleared.
+func (m *SlsaProvenanceMutation) StatementCleared() bool {
+	return m.clearedstatement
+}
+
+// StatementID returns the "statement" edge ID in the mutation.
+func (m *SlsaProvenanceMutation) StatementID() (id uuid.UUID, exists bool) {
+	if m.statement != nil {
+		return *m.statement, true
+	}
+	return
+}
+
+// StatementIDs returns the "statement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StatementID instead. It exists only for internal usage by the builders.
+func (m *SlsaProvenanceMutation) StatementIDs() (ids []uuid.UUID) {
+	if id := m.statement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStatement resets all changes to the "statement" edge.
+func (m *SlsaProvenanceMutation) ResetStatement() {
+	m.statement = nil
+	m.clearedstatement = false
+}
+
+// Where appends a list predicates to the SlsaProvenanceMutation builder.
+func (m *SlsaProvenanceMutation) Where(ps ...predicate.SlsaProvenance) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SlsaProvenanceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SlsaProvenanceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SlsaProvenance, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SlsaProvenanceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SlsaProvenanceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SlsaProvenance).
+func (m *SlsaProvenanceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SlsaProvenanceMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.tenant != nil {
+		fields = append(fields, slsaprovenance.FieldTenant)
+	}
+	if m.builder_id != nil {
+		fields = append(fields, slsaprovenance.FieldBuilderID)
+	}
+	if m.build_type != nil {
+		fields = append(fields, slsaprovenance.FieldBuildType)
+	}
+	if m.invocation_id != nil {
+		fields = append(fields, slsaprovenance.FieldInvocationID)
+	}
+	if m.started_on != nil {
+		fields = append(fields, slsaprovenance.FieldStartedOn)
+	}
+	if m.finished_on != nil {
+		fields = append(fields, slsaprovenance.FieldFinishedOn)
+	}
+	if m.external_parameters != nil {
+		fields = append(fields, slsaprovenance.FieldExternalParameters)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SlsaProvenanceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case slsaprovenance.FieldTenant:
+		return m.Tenant()
+	case slsaprovenance.FieldBuilderID:
+		return m.BuilderID()
+	case slsaprovenance.FieldBuildType:
+		return m.BuildType()
+	case slsaprovenance.FieldInvocationID:
+		return m.InvocationID()
+	case slsaprovenance.FieldStartedOn:
+		return m.StartedOn()
+	case slsaprovenance.FieldFinishedOn:
+		return m.FinishedOn()
+	case slsaprovenance.FieldExternalParameters:
+		return m.ExternalParameters()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SlsaProvenanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case slsaprovenance.FieldTenant:
+		return m.OldTenant(ctx)
+	case slsaprovenance.FieldBuilderID:
+		return m.OldBuilderID(ctx)
+	case slsaprovenance.FieldBuildType:
+		return m.OldBuildType(ctx)
+	case slsaprovenance.FieldInvocationID:
+		return m.OldInvocationID(ctx)
+	case slsaprovenance.FieldStartedOn:
+		return m.OldStartedOn(ctx)
+	case slsaprovenance.FieldFinishedOn:
+		return m.OldFinishedOn(ctx)
+	case slsaprovenance.FieldExternalParameters:
+		return m.OldExternalParameters(ctx)
+	}
+	return nil, fmt.Errorf("unknown SlsaProvenance field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlsaProvenanceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case slsaprovenance.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case slsaprovenance.FieldBuilderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuilderID(v)
+		return nil
+	case slsaprovenance.FieldBuildType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildType(v)
+		return nil
+	case slsaprovenance.FieldInvocationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvocationID(v)
+		return nil
+	case slsaprovenance.FieldStartedOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedOn(v)
+		return nil
+	case slsaprovenance.FieldFinishedOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedOn(v)
+		return nil
+	case slsaprovenance.FieldExternalParameters:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalParameters(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaProvenance field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SlsaProvenanceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SlsaProvenanceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlsaProvenanceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SlsaProvenance numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SlsaProvenanceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(slsaprovenance.FieldInvocationID) {
+		fields = append(fields, slsaprovenance.FieldInvocationID)
+	}
+	if m.FieldCleared(slsaprovenance.FieldStartedOn) {
+		fields = append(fields, slsaprovenance.FieldStartedOn)
+	}
+	if m.FieldCleared(slsaprovenance.FieldFinishedOn) {
+		fields = append(fields, slsaprovenance.FieldFinishedOn)
+	}
+	if m.FieldCleared(slsaprovenance.FieldExternalParameters) {
+		fields = append(fields, slsaprovenance.FieldExternalParameters)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SlsaProvenanceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SlsaProvenanceMutation) ClearField(name string) error {
+	switch name {
+	case slsaprovenance.FieldInvocationID:
+		m.ClearInvocationID()
+		return nil
+	case slsaprovenance.FieldStartedOn:
+		m.ClearStartedOn()
+		return nil
+	case slsaprovenance.FieldFinishedOn:
+		m.ClearFinishedOn()
+		return nil
+	case slsaprovenance.FieldExternalParameters:
+		m.ClearExternalParameters()
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaProvenance nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SlsaProvenanceMutation) ResetField(name string) error {
+	switch name {
+	case slsaprovenance.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case slsaprovenance.FieldBuilderID:
+		m.ResetBuilderID()
+		return nil
+	case slsaprovenance.FieldBuildType:
+		m.ResetBuildType()
+		return nil
+	case slsaprovenance.FieldInvocationID:
+		m.ResetInvocationID()
+		return nil
+	case slsaprovenance.FieldStartedOn:
+		m.ResetStartedOn()
+		return nil
+	case slsaprovenance.FieldFinishedOn:
+		m.ResetFinishedOn()
+		return nil
+	case slsaprovenance.FieldExternalParameters:
+		m.ResetExternalParameters()
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaProvenance field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SlsaProvenanceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.resolved_dependencies != nil {
+		edges = append(edges, slsaprovenance.EdgeResolvedDependencies)
+	}
+	if m.statement != nil {
+		edges = append(edges, slsaprovenance.EdgeStatement)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SlsaProvenanceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case slsaprovenance.EdgeResolvedDependencies:
+		ids := make([]ent.Value, 0, len(m.resolved_dependencies))
+		for id := range m.resolved_dependencies {
+			ids = append(ids, id)
+		}
+		return ids
+	case slsaprovenance.EdgeStatement:
+		if id := m.statement; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SlsaProvenanceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedresolved_dependencies != nil {
+		edges = append(edges, slsaprovenance.EdgeResolvedDependencies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SlsaProvenanceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case slsaprovenance.EdgeResolvedDependencies:
+		ids := make([]ent.Value, 0, len(m.removedresolved_dependencies))
+		for id := range m.removedresolved_dependencies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SlsaProvenanceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedresolved_dependencies {
+		edges = append(edges, slsaprovenance.EdgeResolvedDependencies)
+	}
+	if m.clearedstatement {
+		edges = append(edges, slsaprovenance.EdgeStatement)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SlsaProvenanceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case slsaprovenance.EdgeResolvedDependencies:
+		return m.clearedresolved_dependencies
+	case slsaprovenance.EdgeStatement:
+		return m.clearedstatement
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SlsaProvenanceMutation) ClearEdge(name string) error {
+	switch name {
+	case slsaprovenance.EdgeStatement:
+		m.ClearStatement()
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaProvenance unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SlsaProvenanceMutation) ResetEdge(name string) error {
+	switch name {
+	case slsaprovenance.EdgeResolvedDependencies:
+		m.ResetResolvedDependencies()
+		return nil
+	case slsaprovenance.EdgeStatement:
+		m.ResetStatement()
+		return nil
+	}
+	return fmt.Errorf("unknown SlsaProvenance edge %s", name)
+}
+
+// StatementMutation represents an operation that mutates the Statement nodes in the graph.
+type StatementMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	tenant                         *string
+	predicate                      *string
+	clearedFields                  map[string]struct{}
+	subjects                       map[uuid.UUID]struct{}
+	removedsubjects                map[uuid.UUID]struct{}
+	clearedsubjects                bool
+	policy                         *uuid.UUID
+	clearedpolicy                  bool
+	attestation_collections        *uuid.UUID
+	clearedattestation_collections bool
+	slsa_provenance                *uuid.UUID
+	clearedslsa_provenance         bool
+	verification_summary           *uuid.UUID
+	clearedverification_summary    bool
+	sbom_packages                  map[uuid.UUID]struct{}
+	removedsbom_packages           map[uuid.UUID]struct{}
+	clearedsbom_packages           bool
+	dsse                           map[uuid.UUID]struct{}
+	removeddsse                    map[uuid.UUID]struct{}
+	cleareddsse                    bool
+	done                           bool
+	oldValue                       func(context.Context) (*Statement, error)
+	predicates                     []predicate.Statement
+}
+
+var _ ent.Mutation = (*StatementMutation)(nil)
+
+// statementOption allows management of the mutation configuration using functional options.
+type statementOption func(*StatementMutation)
+
+// newStatementMutation creates new mutation for the Statement entity.
+func newStatementMutation(c config, op Op, opts ...statementOption) *StatementMutation {
+	m := &StatementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStatement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStatementID sets the ID field of the mutation.
+func withStatementID(id uuid.UUID) statementOption {
+	return func(m *StatementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Statement
+		)
+		m.oldValue = func(ctx context.Context) (*Statement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Statement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStatement sets the old Statement of the mutation.
+func withStatement(node *Statement) statementOption {
+	return func(m *StatementMutation) {
+		m.oldValue = func(context.Context) (*Statement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StatementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StatementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Statement entities.
+func (m *StatementMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StatementMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StatementMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Statement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *StatementMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *StatementMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Statement entity.
+// If the Statement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *StatementMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetPredicate sets the "predicate" field.
+func (m *StatementMutation) SetPredicate(s string) {
+	m.predicate = &s
+}
+
+// Predicate returns the value of the "predicate" field in the mutation.
+func (m *StatementMutation) Predicate() (r string, exists bool) {
+	v := m.predicate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPredicate returns the old "predicate" field's value of the Statement entity.
+// If the Statement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementMutation) OldPredicate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPredicate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPredicate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPredicate: %w", err)
+	}
+	return oldValue.Predicate, nil
+}
+
+// ResetPredicate resets all changes to the "predicate" field.
+func (m *StatementMutation) ResetPredicate() {
+	m.predicate = nil
+}
+
+// AddSubjectIDs adds the "subjects" edge to the Subject entity by ids.
+func (m *StatementMutation) AddSubjectIDs(ids ...uuid.UUID) {
+	if m.subjects == nil {
+		m.subjects = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.subjects[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSubjects clears the "subjects" edge to the Subject entity.
+func (m *StatementMutation) ClearSubjects() {
+	m.clearedsubjects = true
+}
+
+// SubjectsCleared reports if the "subjects" edge to the Subject entity was cleared.
+func (m *StatementMutation) SubjectsCleared() bool {
+	return m.clearedsubjects
+}
+
+// RemoveSubjectIDs removes the "subjects" edge to the Subject entity by IDs.
+func (m *StatementMutation) RemoveSubjectIDs(ids ...uuid.UUID) {
+	if m.removedsubjects == nil {
+		m.removedsubjects = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.subjects, ids[i])
+		m.removedsubjects[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubjects returns the removed IDs of the "subjects" edge to the Subject entity.
+func (m *StatementMutation) RemovedSubjectsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsubjects {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// SignaturesIDs returns the "signatures" edge IDs in the mutation.
-func (m *DsseMutation) SignaturesIDs() (ids []uuid.UUID) {
-	for id := range m.signatures {
+// SubjectsIDs returns the "subjects" edge IDs in the mutation.
+func (m *StatementMutation) SubjectsIDs() (ids []uuid.UUID) {
+	for id := range m.subjects {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetSignatures resets all changes to the "signatures" edge.
-func (m *DsseMutation) ResetSignatures() {
-	m.signatures = nil
-	m.clearedsignatures = false
-	m.removedsignatures = nil
+// ResetSubjects resets all changes to the "subjects" edge.
+func (m *StatementMutation) ResetSubjects() {
+	m.subjects = nil
+	m.clearedsubjects = false
+	m.removedsubjects = nil
 }
 
-// AddPayloadDigestIDs adds the "payload_digests" edge to the PayloadDigest entity by ids.
-func (m *DsseMutation) AddPayloadDigestIDs(ids ...uuid.UUID) {
-	if m.payload_digests == nil {
-		m.payload_digests = make(map[uuid.UUID]struct{})
+// SetPolicyID sets the "policy" edge to the AttestationPolicy entity by id.
+func (m *StatementMutation) SetPolicyID(id uuid.UUID) {
+	m.policy = &id
+}
+
+// ClearPolicy clears the "policy" edge to the AttestationPolicy entity.
+func (m *StatementMutation) ClearPolicy() {
+	m.clearedpolicy = true
+}
+
+// PolicyCleared reports if the "policy" edge to the AttestationPolicy entity was cleared.
+func (m *StatementMutation) PolicyCleared() bool {
+	return m.clearedpolicy
+}
+
+// PolicyID returns the "policy" edge ID in the mutation.
+func (m *StatementMutation) PolicyID() (id uuid.UUID, exists bool) {
+	if m.policy != nil {
+		return *m.policy, true
+	}
+	return
+}
+
+// PolicyIDs returns the "policy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PolicyID instead. It exists only for internal usage by the builders.
+func (m *StatementMutation) PolicyIDs() (ids []uuid.UUID) {
+	if id := m.policy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPolicy resets all changes to the "policy" edge.
+func (m *StatementMutation) ResetPolicy() {
+	m.policy = nil
+	m.clearedpolicy = false
+}
+
+// SetAttestationCollectionsID sets the "attestation_collections" edge to the AttestationCollection entity by id.
+func (m *StatementMutation) SetAttestationCollectionsID(id uuid.UUID) {
+	m.attestation_collections = &id
+}
+
+// ClearAttestationCollections clears the "attestation_collections" edge to the AttestationCollection entity.
+func (m *StatementMutation) ClearAttestationCollections() {
+	m.clearedattestation_collections = true
+}
+
+// AttestationCollectionsCleared reports if the "attestation_collections" edge to the AttestationCollection entity was cleared.
+func (m *StatementMutation) AttestationCollectionsCleared() bool {
+	return m.clearedattestation_collections
+}
+
+// AttestationCollectionsID returns the "attestation_collections" edge ID in the mutation.
+func (m *StatementMutation) AttestationCollectionsID() (id uuid.UUID, exists bool) {
+	if m.attestation_collections != nil {
+		return *m.attestation_collections, true
+	}
+	return
+}
+
+// AttestationCollectionsIDs returns the "attestation_collections" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttestationCollectionsID instead. It exists only for internal usage by the builders.
+func (m *StatementMutation) AttestationCollectionsIDs() (ids []uuid.UUID) {
+	if id := m.attestation_collections; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttestationCollections resets all changes to the "attestation_collections" edge.
+func (m *StatementMutation) ResetAttestationCollections() {
+	m.attestation_collections = nil
+	m.clearedattestation_collections = false
+}
+
+// SetSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by id.
+func (m *StatementMutation) SetSlsaProvenanceID(id uuid.UUID) {
+	m.slsa_provenance = &id
+}
+
+// ClearSlsaProvenance clears the "slsa_provenance" edge to the SlsaProvenance entity.
+func (m *StatementMutation) ClearSlsaProvenance() {
+	m.clearedslsa_provenance = true
+}
+
+// SlsaProvenanceCleared reports if the "slsa_provenance" edge to the SlsaProvenance entity was cleared.
+func (m *StatementMutation) SlsaProvenanceCleared() bool {
+	return m.clearedslsa_provenance
+}
+
+// SlsaProvenanceID returns the "slsa_provenance" edge ID in the mutation.
+func (m *StatementMutation) SlsaProvenanceID() (id uuid.UUID, exists bool) {
+	if m.slsa_provenance != nil {
+		return *m.slsa_provenance, true
+	}
+	return
+}
+
+// SlsaProvenanceIDs returns the "slsa_provenance" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SlsaProvenanceID instead. It exists only for internal usage by the builders.
+func (m *StatementMutation) SlsaProvenanceIDs() (ids []uuid.UUID) {
+	if id := m.slsa_provenance; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSlsaProvenance resets all changes to the "slsa_provenance" edge.
+func (m *StatementMutation) ResetSlsaProvenance() {
+	m.slsa_provenance = nil
+	m.clearedslsa_provenance = false
+}
+
+// SetVerificationSummaryID sets the "verification_summary" edge to the VerificationSummary entity by id.
+func (m *StatementMutation) SetVerificationSummaryID(id uuid.UUID) {
+	m.verification_summary = &id
+}
+
+// ClearVerificationSummary clears the "verification_summary" edge to the VerificationSummary entity.
+func (m *StatementMutation) ClearVerificationSummary() {
+	m.clearedverification_summary = true
+}
+
+// VerificationSummaryCleared reports if the "verification_summary" edge to the VerificationSummary entity was cleared.
+func (m *StatementMutation) VerificationSummaryCleared() bool {
+	return m.clearedverification_summary
+}
+
+// VerificationSummaryID returns the "verification_summary" edge ID in the mutation.
+func (m *StatementMutation) VerificationSummaryID() (id uuid.UUID, exists bool) {
+	if m.verification_summary != nil {
+		return *m.verification_summary, true
+	}
+	return
+}
+
+// VerificationSummaryIDs returns the "verification_summary" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VerificationSummaryID instead. It exists only for internal usage by the builders.
+func (m *StatementMutation) VerificationSummaryIDs() (ids []uuid.UUID) {
+	if id := m.verification_summary; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVerificationSummary resets all changes to the "verification_summary" edge.
+func (m *StatementMutation) ResetVerificationSummary() {
+	m.verification_summary = nil
+	m.clearedverification_summary = false
+}
+
+// AddSbomPackageIDs adds the "sbom_packages" edge to the SbomPackage entity by ids.
+func (m *StatementMutation) AddSbomPackageIDs(ids ...uuid.UUID) {
+	if m.sbom_packages == nil {
+		m.sbom_packages = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.payload_digests[ids[i]] = struct{}{}
+		m.sbom_packages[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPayloadDigests clears the "payload_digests" edge to the PayloadDigest entity.
-func (m *DsseMutation) ClearPayloadDigests() {
-	m.clearedpayload_digests = true
+// ClearSbomPackages clears the "sbom_packages" edge to the SbomPackage entity.
+func (m *StatementMutation) ClearSbomPackages() {
+	m.clearedsbom_packages = true
 }
 
-// PayloadDigestsCleared reports if the "payload_digests" edge to the PayloadDigest entity was cleared.
-func (m *DsseMutation) PayloadDigestsCleared() bool {
-	return m.clearedpayload_digests
+// SbomPackagesCleared reports if the "sbom_packages" edge to the SbomPackage entity was cleared.
+func (m *StatementMutation) SbomPackagesCleared() bool {
+	return m.clearedsbom_packages
 }
 
-// RemovePayloadDigestIDs removes the "payload_digests" edge to the PayloadDigest entity by IDs.
-func (m *DsseMutation) RemovePayloadDigestIDs(ids ...uuid.UUID) {
-	if m.removedpayload_digests == nil {
-		m.removedpayload_digests = make(map[uuid.UUID]struct{})
+// RemoveSbomPackageIDs removes the "sbom_packages" edge to the SbomPackage entity by IDs.
+func (m *StatementMutation) RemoveSbomPackageIDs(ids ...uuid.UUID) {
+	if m.removedsbom_packages == nil {
+		m.removedsbom_packages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sbom_packages, ids[i])
+		m.removedsbom_packages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSbomPackages returns the removed IDs of the "sbom_packages" edge to the SbomPackage entity.
+func (m *StatementMutation) RemovedSbomPackagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsbom_packages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SbomPackagesIDs returns the "sbom_packages" edge IDs in the mutation.
+func (m *StatementMutation) SbomPackagesIDs() (ids []uuid.UUID) {
+	for id := range m.sbom_packages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSbomPackages resets all changes to the "sbom_packages" edge.
+func (m *StatementMutation) ResetSbomPackages() {
+	m.sbom_packages = nil
+	m.clearedsbom_packages = false
+	m.removedsbom_packages = nil
+}
+
+// AddDsseIDs adds the "dsse" edge to the Dsse entity by ids.
+func (m *StatementMutation) AddDsseIDs(ids ...uuid.UUID) {
+	if m.dsse == nil {
+		m.dsse = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.dsse[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (m *StatementMutation) ClearDsse() {
+	m.cleareddsse = true
+}
+
+// DsseCleared reports if the "dsse" edge to the Dsse entity was cleared.
+func (m *StatementMutation) DsseCleared() bool {
+	return m.cleareddsse
+}
+
+// RemoveDsseIDs removes the "dsse" edge to the Dsse entity by IDs.
+func (m *StatementMutation) RemoveDsseIDs(ids ...uuid.UUID) {
+	if m.removeddsse == nil {
+		m.removeddsse = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.payload_digests, ids[i])
-		m.removedpayload_digests[ids[i]] = struct{}{}
+		delete(m.dsse, ids[i])
+		m.removeddsse[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPayloadDigests returns the removed IDs of the "payload_digests" edge to the PayloadDigest entity.
-func (m *DsseMutation) RemovedPayloadDigestsIDs() (ids []uuid.UUID) {
-	for id := range m.removedpayload_digests {
+// RemovedDsse returns the removed IDs of the "dsse" edge to the Dsse entity.
+func (m *StatementMutation) RemovedDsseIDs() (ids []uuid.UUID) {
+	for id := range m.removeddsse {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PayloadDigestsIDs returns the "payload_digests" edge IDs in the mutation.
-func (m *DsseMutation) PayloadDigestsIDs() (ids []uuid.UUID) {
-	for id := range m.payload_digests {
+// DsseIDs returns the "dsse" edge IDs in the mutation.
+func (m *StatementMutation) DsseIDs() (ids []uuid.UUID) {
+	for id := range m.dsse {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPayloadDigests resets all changes to the "payload_digests" edge.
-func (m *DsseMutation) ResetPayloadDigests() {
-	m.payload_digests = nil
-	m.clearedpayload_digests = false
-	m.removedpayload_digests = nil
+// ResetDsse resets all changes to the "dsse" edge.
+func (m *StatementMutation) ResetDsse() {
+	m.dsse = nil
+	m.cleareddsse = false
+	m.removeddsse = nil
 }
 
-// Where appends a list predicates to the DsseMutation builder.
-func (m *DsseMutation) Where(ps ...predicate.Dsse) {
+// Where appends a list predicates to the StatementMutation builder.
+func (m *StatementMutation) Where(ps ...predicate.Statement) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DsseMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StatementMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DsseMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Dsse, len(ps))
+func (m *StatementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Statement, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1739,33 +18918,30 @@ func (m *DsseMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DsseMutation) Op() Op {
+func (m *StatementMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DsseMutation) SetOp(op Op) {
+func (m *StatementMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Dsse).
-func (m *DsseMutation) Type() string {
+// Type returns the node type of this mutation (Statement).
+func (m *StatementMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DsseMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.created_at != nil {
-		fields = append(fields, dsse.FieldCreatedAt)
-	}
-	if m.gitoid_sha256 != nil {
-		fields = append(fields, dsse.FieldGitoidSha256)
+func (m *StatementMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.tenant != nil {
+		fields = append(fields, statement.FieldTenant)
 	}
-	if m.payload_type != nil {
-		fields = append(fields, dsse.FieldPayloadType)
+	if m.predicate != nil {
+		fields = append(fields, statement.FieldPredicate)
 	}
 	return fields
 }
@@ -1773,14 +18949,12 @@ func (m *DsseMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DsseMutation) Field(name string) (ent.Value, bool) {
+func (m *StatementMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dsse.FieldCreatedAt:
-		return m.CreatedAt()
-	case dsse.FieldGitoidSha256:
-		return m.GitoidSha256()
-	case dsse.FieldPayloadType:
-		return m.PayloadType()
+	case statement.FieldTenant:
+		return m.Tenant()
+	case statement.FieldPredicate:
+		return m.Predicate()
 	}
 	return nil, false
 }
@@ -1788,147 +18962,156 @@ func (m *DsseMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DsseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StatementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dsse.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case dsse.FieldGitoidSha256:
-		return m.OldGitoidSha256(ctx)
-	case dsse.FieldPayloadType:
-		return m.OldPayloadType(ctx)
+	case statement.FieldTenant:
+		return m.OldTenant(ctx)
+	case statement.FieldPredicate:
+		return m.OldPredicate(ctx)
 	}
-	return nil, fmt.Errorf("unknown Dsse field %s", name)
+	return nil, fmt.Errorf("unknown Statement field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DsseMutation) SetField(name string, value ent.Value) error {
+func (m *StatementMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dsse.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case dsse.FieldGitoidSha256:
+	case statement.FieldTenant:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGitoidSha256(v)
+		m.SetTenant(v)
 		return nil
-	case dsse.FieldPayloadType:
+	case statement.FieldPredicate:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPayloadType(v)
+		m.SetPredicate(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Dsse field %s", name)
+	return fmt.Errorf("unknown Statement field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DsseMutation) AddedFields() []string {
+func (m *StatementMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DsseMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StatementMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DsseMutation) AddField(name string, value ent.Value) error {
+func (m *StatementMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Dsse numeric field %s", name)
+	return fmt.Errorf("unknown Statement numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DsseMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(dsse.FieldCreatedAt) {
-		fields = append(fields, dsse.FieldCreatedAt)
-	}
-	return fields
+func (m *StatementMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DsseMutation) FieldCleared(name string) bool {
+func (m *StatementMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DsseMutation) ClearField(name string) error {
-	switch name {
-	case dsse.FieldCreatedAt:
-		m.ClearCreatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Dsse nullable field %s", name)
+func (m *StatementMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Statement nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DsseMutation) ResetField(name string) error {
+func (m *StatementMutation) ResetField(name string) error {
 	switch name {
-	case dsse.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case dsse.FieldGitoidSha256:
-		m.ResetGitoidSha256()
+	case statement.FieldTenant:
+		m.ResetTenant()
 		return nil
-	case dsse.FieldPayloadType:
-		m.ResetPayloadType()
+	case statement.FieldPredicate:
+		m.ResetPredicate()
 		return nil
 	}
-	return fmt.Errorf("unknown Dsse field %s", name)
+	return fmt.Errorf("unknown Statement field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DsseMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.statement != nil {
-		edges = append(edges, dsse.EdgeStatement)
+func (m *StatementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.subjects != nil {
+		edges = append(edges, statement.EdgeSubjects)
 	}
-	if m.signatures != nil {
-		edges = append(edges, dsse.EdgeSignatures)
+	if m.policy != nil {
+		edges = append(edges, statement.EdgePolicy)
 	}
-	if m.payload_digests != nil {
-		edges = append(edges, dsse.EdgePayloadDigests)
+	if m.attestation_collections != nil {
+		edges = append(edges, statement.EdgeAttestationCollections)
+	}
+	if m.slsa_provenance != nil {
+		edges = append(edges, statement.EdgeSlsaProvenance)
+	}
+	if m.verification_summary != nil {
+		edges = append(edges, statement.EdgeVerificationSummary)
+	}
+	if m.sbom_packages != nil {
+		edges = append(edges, statement.EdgeSbomPackages)
+	}
+	if m.dsse != nil {
+		edges = append(edges, statement.EdgeDsse)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DsseMutation) AddedIDs(name string) []ent.Value {
+func (m *StatementMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case dsse.EdgeStatement:
-		if id := m.statement; id != nil {
+	case statement.EdgeSubjects:
+		ids := make([]ent.Value, 0, len(m.subjects))
+		for id := range m.subjects {
+			ids = append(ids, id)
+		}
+		return ids
+	case statement.EdgePolicy:
+		if id := m.policy; id != nil {
 			return []ent.Value{*id}
 		}
-	case dsse.EdgeSignatures:
-		ids := make([]ent.Value, 0, len(m.signatures))
-		for id := range m.signatures {
+	case statement.EdgeAttestationCollections:
+		if id := m.attestation_collections; id != nil {
+			return []ent.Value{*id}
+		}
+	case statement.EdgeSlsaProvenance:
+		if id := m.slsa_provenance; id != nil {
+			return []ent.Value{*id}
+		}
+	case statement.EdgeVerificationSummary:
+		if id := m.verification_summary; id != nil {
+			return []ent.Value{*id}
+		}
+	case statement.EdgeSbomPackages:
+		ids := make([]ent.Value, 0, len(m.sbom_packages))
+		for id := range m.sbom_packages {
 			ids = append(ids, id)
 		}
 		return ids
-	case dsse.EdgePayloadDigests:
-		ids := make([]ent.Value, 0, len(m.payload_digests))
-		for id := range m.payload_digests {
+	case statement.EdgeDsse:
+		ids := make([]ent.Value, 0, len(m.dsse))
+		for id := range m.dsse {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1937,30 +19120,39 @@ func (m *DsseMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DsseMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedsignatures != nil {
-		edges = append(edges, dsse.EdgeSignatures)
+func (m *StatementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedsubjects != nil {
+		edges = append(edges, statement.EdgeSubjects)
 	}
-	if m.removedpayload_digests != nil {
-		edges = append(edges, dsse.EdgePayloadDigests)
+	if m.removedsbom_packages != nil {
+		edges = append(edges, statement.EdgeSbomPackages)
+	}
+	if m.removeddsse != nil {
+		edges = append(edges, statement.EdgeDsse)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DsseMutation) RemovedIDs(name string) []ent.Value {
+func (m *StatementMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case dsse.EdgeSignatures:
-		ids := make([]ent.Value, 0, len(m.removedsignatures))
-		for id := range m.removedsignatures {
+	case statement.EdgeSubjects:
+		ids := make([]ent.Value, 0, len(m.removedsubjects))
+		for id := range m.removedsubjects {
 			ids = append(ids, id)
 		}
 		return ids
-	case dsse.EdgePayloadDigests:
-		ids := make([]ent.Value, 0, len(m.removedpayload_digests))
-		for id := range m.removedpayload_digests {
+	case statement.EdgeSbomPackages:
+		ids := make([]ent.Value, 0, len(m.removedsbom_packages))
+		for id := range m.removedsbom_packages {
+			ids = append(ids, id)
+		}
+		return ids
+	case statement.EdgeDsse:
+		ids := make([]ent.Value, 0, len(m.removeddsse))
+		for id := range m.removeddsse {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1969,89 +19161,134 @@ func (m *DsseMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DsseMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedstatement {
-		edges = append(edges, dsse.EdgeStatement)
+func (m *StatementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedsubjects {
+		edges = append(edges, statement.EdgeSubjects)
 	}
-	if m.clearedsignatures {
-		edges = append(edges, dsse.EdgeSignatures)
+	if m.clearedpolicy {
+		edges = append(edges, statement.EdgePolicy)
 	}
-	if m.clearedpayload_digests {
-		edges = append(edges, dsse.EdgePayloadDigests)
+	if m.clearedattestation_collections {
+		edges = append(edges, statement.EdgeAttestationCollections)
+	}
+	if m.clearedslsa_provenance {
+		edges = append(edges, statement.EdgeSlsaProvenance)
+	}
+	if m.clearedverification_summary {
+		edges = append(edges, statement.EdgeVerificationSummary)
+	}
+	if m.clearedsbom_packages {
+		edges = append(edges, statement.EdgeSbomPackages)
+	}
+	if m.cleareddsse {
+		edges = append(edges, statement.EdgeDsse)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DsseMutation) EdgeCleared(name string) bool {
+func (m *StatementMutation) EdgeCleared(name string) bool {
 	switch name {
-	case dsse.EdgeStatement:
-		return m.clearedstatement
-	case dsse.EdgeSignatures:
-		return m.clearedsignatures
-	case dsse.EdgePayloadDigests:
-		return m.clearedpayload_digests
+	case statement.EdgeSubjects:
+		return m.clearedsubjects
+	case statement.EdgePolicy:
+		return m.clearedpolicy
+	case statement.EdgeAttestationCollections:
+		return m.clearedattestation_collections
+	case statement.EdgeSlsaProvenance:
+		return m.clearedslsa_provenance
+	case statement.EdgeVerificationSummary:
+		return m.clearedverification_summary
+	case statement.EdgeSbomPackages:
+		return m.clearedsbom_packages
+	case statement.EdgeDsse:
+		return m.cleareddsse
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DsseMutation) ClearEdge(name string) error {
+func (m *StatementMutation) ClearEdge(name string) error {
 	switch name {
-	case dsse.EdgeStatement:
-		m.ClearStatement()
+	case statement.EdgePolicy:
+		m.ClearPolicy()
+		return nil
+	case statement.EdgeAttestationCollections:
+		m.ClearAttestationCollections()
+		return nil
+	case statement.EdgeSlsaProvenance:
+		m.ClearSlsaProvenance()
+		return nil
+	case statement.EdgeVerificationSummary:
+		m.ClearVerificationSummary()
 		return nil
 	}
-	return fmt.Errorf("unknown Dsse unique edge %s", name)
+	return fmt.Errorf("unknown Statement unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DsseMutation) ResetEdge(name string) error {
+func (m *StatementMutation) ResetEdge(name string) error {
 	switch name {
-	case dsse.EdgeStatement:
-		m.ResetStatement()
+	case statement.EdgeSubjects:
+		m.ResetSubjects()
 		return nil
-	case dsse.EdgeSignatures:
-		m.ResetSignatures()
+	case statement.EdgePolicy:
+		m.ResetPolicy()
 		return nil
-	case dsse.EdgePayloadDigests:
-		m.ResetPayloadDigests()
+	case statement.EdgeAttestationCollections:
+		m.ResetAttestationCollections()
+		return nil
+	case statement.EdgeSlsaProvenance:
+		m.ResetSlsaProvenance()
+		return nil
+	case statement.EdgeVerificationSummary:
+		m.ResetVerificationSummary()
+		return nil
+	case statement.EdgeSbomPackages:
+		m.ResetSbomPackages()
+		return nil
+	case statement.EdgeDsse:
+		m.ResetDsse()
 		return nil
 	}
-	return fmt.Errorf("unknown Dsse edge %s", name)
+	return fmt.Errorf("unknown Statement edge %s", name)
 }
 
-// PayloadDigestMutation represents an operation that mutates the PayloadDigest nodes in the graph.
-type PayloadDigestMutation struct {
+// SubjectMutation represents an operation that mutates the Subject nodes in the graph.
+type SubjectMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	algorithm     *string
-	value         *string
-	clearedFields map[string]struct{}
-	dsse          *uuid.UUID
-	cleareddsse   bool
-	done          bool
-	oldValue      func(context.Context) (*PayloadDigest, error)
-	predicates    []predicate.PayloadDigest
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	tenant                 *string
+	created_at             *time.Time
+	name                   *string
+	clearedFields          map[string]struct{}
+	subject_digests        map[uuid.UUID]struct{}
+	removedsubject_digests map[uuid.UUID]struct{}
+	clearedsubject_digests bool
+	statement              *uuid.UUID
+	clearedstatement       bool
+	done                   bool
+	oldValue               func(context.Context) (*Subject, error)
+	predicates             []predicate.Subject
 }
 
-var _ ent.Mutation = (*PayloadDigestMutation)(nil)
+var _ ent.Mutation = (*SubjectMutation)(nil)
 
-// payloaddigestOption allows management of the mutation configuration using functional options.
-type payloaddigestOption func(*PayloadDigestMutation)
+// subjectOption allows management of the mutation configuration using functional options.
+type subjectOption func(*SubjectMutation)
 
-// newPayloadDigestMutation creates new mutation for the PayloadDigest entity.
-func newPayloadDigestMutation(c config, op Op, opts ...payloaddigestOption) *PayloadDigestMutation {
-	m := &PayloadDigestMutation{
+// newSubjectMutation creates new mutation for the Subject entity.
+func newSubjectMutation(c config, op Op, opts ...subjectOption) *SubjectMutation {
+	m := &SubjectMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePayloadDigest,
+		typ:           TypeSubject,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2060,20 +19297,20 @@ func newPayloadDigestMutation(c config, op Op, opts ...payloaddigestOption) *Pay
 	return m
 }
 
-// withPayloadDigestID sets the ID field of the mutation.
-func withPayloadDigestID(id uuid.UUID) payloaddigestOption {
-	return func(m *PayloadDigestMutation) {
+// withSubjectID sets the ID field of the mutation.
+func withSubjectID(id uuid.UUID) subjectOption {
+	return func(m *SubjectMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PayloadDigest
+			value *Subject
 		)
-		m.oldValue = func(ctx context.Context) (*PayloadDigest, error) {
+		m.oldValue = func(ctx context.Context) (*Subject, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PayloadDigest.Get(ctx, id)
+					value, err = m.Client().Subject.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2082,10 +19319,10 @@ func withPayloadDigestID(id uuid.UUID) payloaddigestOption {
 	}
 }
 
-// withPayloadDigest sets the old PayloadDigest of the mutation.
-func withPayloadDigest(node *PayloadDigest) payloaddigestOption {
-	return func(m *PayloadDigestMutation) {
-		m.oldValue = func(context.Context) (*PayloadDigest, error) {
+// withSubject sets the old Subject of the mutation.
+func withSubject(node *Subject) subjectOption {
+	return func(m *SubjectMutation) {
+		m.oldValue = func(context.Context) (*Subject, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2094,7 +19331,7 @@ func withPayloadDigest(node *PayloadDigest) payloaddigestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PayloadDigestMutation) Client() *Client {
+func (m SubjectMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2102,7 +19339,7 @@ func (m PayloadDigestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PayloadDigestMutation) Tx() (*Tx, error) {
+func (m SubjectMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2112,14 +19349,14 @@ func (m PayloadDigestMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PayloadDigest entities.
-func (m *PayloadDigestMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Subject entities.
+func (m *SubjectMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PayloadDigestMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SubjectMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2130,7 +19367,7 @@ func (m *PayloadDigestMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PayloadDigestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SubjectMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2139,132 +19376,235 @@ func (m *PayloadDigestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PayloadDigest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Subject.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAlgorithm sets the "algorithm" field.
-func (m *PayloadDigestMutation) SetAlgorithm(s string) {
-	m.algorithm = &s
+// SetTenant sets the "tenant" field.
+func (m *SubjectMutation) SetTenant(s string) {
+	m.tenant = &s
 }
 
-// Algorithm returns the value of the "algorithm" field in the mutation.
-func (m *PayloadDigestMutation) Algorithm() (r string, exists bool) {
-	v := m.algorithm
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SubjectMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAlgorithm returns the old "algorithm" field's value of the PayloadDigest entity.
-// If the PayloadDigest object wasn't provided to the builder, the object is fetched from the database.
+// OldTenant returns the old "tenant" field's value of the Subject entity.
+// If the Subject object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PayloadDigestMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+func (m *SubjectMutation) OldTenant(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+		return v, errors.New("OldTenant requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
 	}
-	return oldValue.Algorithm, nil
+	return oldValue.Tenant, nil
 }
 
-// ResetAlgorithm resets all changes to the "algorithm" field.
-func (m *PayloadDigestMutation) ResetAlgorithm() {
-	m.algorithm = nil
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SubjectMutation) ResetTenant() {
+	m.tenant = nil
 }
 
-// SetValue sets the "value" field.
-func (m *PayloadDigestMutation) SetValue(s string) {
-	m.value = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *SubjectMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *PayloadDigestMutation) Value() (r string, exists bool) {
-	v := m.value
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SubjectMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the PayloadDigest entity.
-// If the PayloadDigest object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Subject entity.
+// If the Subject object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PayloadDigestMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *SubjectMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SubjectMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[subject.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SubjectMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[subject.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SubjectMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, subject.FieldCreatedAt)
+}
+
+// SetName sets the "name" field.
+func (m *SubjectMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SubjectMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Subject entity.
+// If the Subject object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubjectMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SubjectMutation) ResetName() {
+	m.name = nil
+}
+
+// AddSubjectDigestIDs adds the "subject_digests" edge to the SubjectDigest entity by ids.
+func (m *SubjectMutation) AddSubjectDigestIDs(ids ...uuid.UUID) {
+	if m.subject_digests == nil {
+		m.subject_digests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.subject_digests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSubjectDigests clears the "subject_digests" edge to the SubjectDigest entity.
+func (m *SubjectMutation) ClearSubjectDigests() {
+	m.clearedsubject_digests = true
+}
+
+// SubjectDigestsCleared reports if the "subject_digests" edge to the SubjectDigest entity was cleared.
+func (m *SubjectMutation) SubjectDigestsCleared() bool {
+	return m.clearedsubject_digests
+}
+
+// RemoveSubjectDigestIDs removes the "subject_digests" edge to the SubjectDigest entity by IDs.
+func (m *SubjectMutation) RemoveSubjectDigestIDs(ids ...uuid.UUID) {
+	if m.removedsubject_digests == nil {
+		m.removedsubject_digests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.subject_digests, ids[i])
+		m.removedsubject_digests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubjectDigests returns the removed IDs of the "subject_digests" edge to the SubjectDigest entity.
+func (m *SubjectMutation) RemovedSubjectDigestsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsubject_digests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SubjectDigestsIDs returns the "subject_digests" edge IDs in the mutation.
+func (m *SubjectMutation) SubjectDigestsIDs() (ids []uuid.UUID) {
+	for id := range m.subject_digests {
+		ids = append(ids, id)
 	}
-	return oldValue.Value, nil
+	return
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *PayloadDigestMutation) ResetValue() {
-	m.value = nil
+// ResetSubjectDigests resets all changes to the "subject_digests" edge.
+func (m *SubjectMutation) ResetSubjectDigests() {
+	m.subject_digests = nil
+	m.clearedsubject_digests = false
+	m.removedsubject_digests = nil
 }
 
-// SetDsseID sets the "dsse" edge to the Dsse entity by id.
-func (m *PayloadDigestMutation) SetDsseID(id uuid.UUID) {
-	m.dsse = &id
+// SetStatementID sets the "statement" edge to the Statement entity by id.
+func (m *SubjectMutation) SetStatementID(id uuid.UUID) {
+	m.statement = &id
 }
 
-// ClearDsse clears the "dsse" edge to the Dsse entity.
-func (m *PayloadDigestMutation) ClearDsse() {
-	m.cleareddsse = true
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (m *SubjectMutation) ClearStatement() {
+	m.clearedstatement = true
 }
 
-// DsseCleared reports if the "dsse" edge to the Dsse entity was cleared.
-func (m *PayloadDigestMutation) DsseCleared() bool {
-	return m.cleareddsse
+// StatementCleared reports if the "statement" edge to the Statement entity was cleared.
+func (m *SubjectMutation) StatementCleared() bool {
+	return m.clearedstatement
 }
 
-// DsseID returns the "dsse" edge ID in the mutation.
-func (m *PayloadDigestMutation) DsseID() (id uuid.UUID, exists bool) {
-	if m.dsse != nil {
-		return *m.dsse, true
+// StatementID returns the "statement" edge ID in the mutation.
+func (m *SubjectMutation) StatementID() (id uuid.UUID, exists bool) {
+	if m.statement != nil {
+		return *m.statement, true
 	}
 	return
 }
 
-// DsseIDs returns the "dsse" edge IDs in the mutation.
+// StatementIDs returns the "statement" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DsseID instead. It exists only for internal usage by the builders.
-func (m *PayloadDigestMutation) DsseIDs() (ids []uuid.UUID) {
-	if id := m.dsse; id != nil {
+// StatementID instead. It exists only for internal usage by the builders.
+func (m *SubjectMutation) StatementIDs() (ids []uuid.UUID) {
+	if id := m.statement; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetDsse resets all changes to the "dsse" edge.
-func (m *PayloadDigestMutation) ResetDsse() {
-	m.dsse = nil
-	m.cleareddsse = false
+// ResetStatement resets all changes to the "statement" edge.
+func (m *SubjectMutation) ResetStatement() {
+	m.statement = nil
+	m.clearedstatement = false
 }
 
-// Where appends a list predicates to the PayloadDigestMutation builder.
-func (m *PayloadDigestMutation) Where(ps ...predicate.PayloadDigest) {
+// Where appends a list predicates to the SubjectMutation builder.
+func (m *SubjectMutation) Where(ps ...predicate.Subject) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PayloadDigestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SubjectMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PayloadDigestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PayloadDigest, len(ps))
+func (m *SubjectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Subject, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2272,30 +19612,33 @@ func (m *PayloadDigestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PayloadDigestMutation) Op() Op {
+func (m *SubjectMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PayloadDigestMutation) SetOp(op Op) {
+func (m *SubjectMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PayloadDigest).
-func (m *PayloadDigestMutation) Type() string {
+// Type returns the node type of this mutation (Subject).
+func (m *SubjectMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PayloadDigestMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.algorithm != nil {
-		fields = append(fields, payloaddigest.FieldAlgorithm)
+func (m *SubjectMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, subject.FieldTenant)
 	}
-	if m.value != nil {
-		fields = append(fields, payloaddigest.FieldValue)
+	if m.created_at != nil {
+		fields = append(fields, subject.FieldCreatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, subject.FieldName)
 	}
 	return fields
 }
@@ -2303,12 +19646,14 @@ func (m *PayloadDigestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PayloadDigestMutation) Field(name string) (ent.Value, bool) {
+func (m *SubjectMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case payloaddigest.FieldAlgorithm:
-		return m.Algorithm()
-	case payloaddigest.FieldValue:
-		return m.Value()
+	case subject.FieldTenant:
+		return m.Tenant()
+	case subject.FieldCreatedAt:
+		return m.CreatedAt()
+	case subject.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -2316,109 +19661,139 @@ func (m *PayloadDigestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PayloadDigestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SubjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case payloaddigest.FieldAlgorithm:
-		return m.OldAlgorithm(ctx)
-	case payloaddigest.FieldValue:
-		return m.OldValue(ctx)
+	case subject.FieldTenant:
+		return m.OldTenant(ctx)
+	case subject.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case subject.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown PayloadDigest field %s", name)
+	return nil, fmt.Errorf("unknown Subject field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PayloadDigestMutation) SetField(name string, value ent.Value) error {
+func (m *SubjectMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case payloaddigest.FieldAlgorithm:
+	case subject.FieldTenant:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlgorithm(v)
+		m.SetTenant(v)
 		return nil
-	case payloaddigest.FieldValue:
+	case subject.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case subject.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PayloadDigest field %s", name)
+	return fmt.Errorf("unknown Subject field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PayloadDigestMutation) AddedFields() []string {
+func (m *SubjectMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PayloadDigestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SubjectMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PayloadDigestMutation) AddField(name string, value ent.Value) error {
+func (m *SubjectMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PayloadDigest numeric field %s", name)
+	return fmt.Errorf("unknown Subject numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PayloadDigestMutation) ClearedFields() []string {
-	return nil
+func (m *SubjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(subject.FieldCreatedAt) {
+		fields = append(fields, subject.FieldCreatedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PayloadDigestMutation) FieldCleared(name string) bool {
+func (m *SubjectMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PayloadDigestMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PayloadDigest nullable field %s", name)
+func (m *SubjectMutation) ClearField(name string) error {
+	switch name {
+	case subject.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Subject nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PayloadDigestMutation) ResetField(name string) error {
+func (m *SubjectMutation) ResetField(name string) error {
 	switch name {
-	case payloaddigest.FieldAlgorithm:
-		m.ResetAlgorithm()
+	case subject.FieldTenant:
+		m.ResetTenant()
 		return nil
-	case payloaddigest.FieldValue:
-		m.ResetValue()
+	case subject.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case subject.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown PayloadDigest field %s", name)
+	return fmt.Errorf("unknown Subject field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PayloadDigestMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.dsse != nil {
-		edges = append(edges, payloaddigest.EdgeDsse)
+func (m *SubjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.subject_digests != nil {
+		edges = append(edges, subject.EdgeSubjectDigests)
+	}
+	if m.statement != nil {
+		edges = append(edges, subject.EdgeStatement)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PayloadDigestMutation) AddedIDs(name string) []ent.Value {
+func (m *SubjectMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case payloaddigest.EdgeDsse:
-		if id := m.dsse; id != nil {
+	case subject.EdgeSubjectDigests:
+		ids := make([]ent.Value, 0, len(m.subject_digests))
+		for id := range m.subject_digests {
+			ids = append(ids, id)
+		}
+		return ids
+	case subject.EdgeStatement:
+		if id := m.statement; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2426,88 +19801,105 @@ func (m *PayloadDigestMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PayloadDigestMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *SubjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedsubject_digests != nil {
+		edges = append(edges, subject.EdgeSubjectDigests)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PayloadDigestMutation) RemovedIDs(name string) []ent.Value {
+func (m *SubjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case subject.EdgeSubjectDigests:
+		ids := make([]ent.Value, 0, len(m.removedsubject_digests))
+		for id := range m.removedsubject_digests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PayloadDigestMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareddsse {
-		edges = append(edges, payloaddigest.EdgeDsse)
+func (m *SubjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsubject_digests {
+		edges = append(edges, subject.EdgeSubjectDigests)
+	}
+	if m.clearedstatement {
+		edges = append(edges, subject.EdgeStatement)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PayloadDigestMutation) EdgeCleared(name string) bool {
+func (m *SubjectMutation) EdgeCleared(name string) bool {
 	switch name {
-	case payloaddigest.EdgeDsse:
-		return m.cleareddsse
+	case subject.EdgeSubjectDigests:
+		return m.clearedsubject_digests
+	case subject.EdgeStatement:
+		return m.clearedstatement
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PayloadDigestMutation) ClearEdge(name string) error {
+func (m *SubjectMutation) ClearEdge(name string) error {
 	switch name {
-	case payloaddigest.EdgeDsse:
-		m.ClearDsse()
+	case subject.EdgeStatement:
+		m.ClearStatement()
 		return nil
 	}
-	return fmt.Errorf("unknown PayloadDigest unique edge %s", name)
+	return fmt.Errorf("unknown Subject unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PayloadDigestMutation) ResetEdge(name string) error {
+func (m *SubjectMutation) ResetEdge(name string) error {
 	switch name {
-	case payloaddigest.EdgeDsse:
-		m.ResetDsse()
+	case subject.EdgeSubjectDigests:
+		m.ResetSubjectDigests()
+		return nil
+	case subject.EdgeStatement:
+		m.ResetStatement()
 		return nil
 	}
-	return fmt.Errorf("unknown PayloadDigest edge %s", name)
+	return fmt.Errorf("unknown Subject edge %s", name)
 }
 
-// SignatureMutation represents an operation that mutates the Signature nodes in the graph.
-type SignatureMutation struct {
+// SubjectAltNameMutation represents an operation that mutates the SubjectAltName nodes in the graph.
+type SubjectAltNameMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	key_id            *string
-	signature         *string
-	clearedFields     map[string]struct{}
-	dsse              *uuid.UUID
-	cleareddsse       bool
-	timestamps        map[uuid.UUID]struct{}
-	removedtimestamps map[uuid.UUID]struct{}
-	clearedtimestamps bool
-	done              bool
-	oldValue          func(context.Context) (*Signature, error)
-	predicates        []predicate.Signature
-}
-
-var _ ent.Mutation = (*SignatureMutation)(nil)
-
-// signatureOption allows management of the mutation configuration using functional options.
-type signatureOption func(*SignatureMutation)
-
-// newSignatureMutation creates new mutation for the Signature entity.
-func newSignatureMutation(c config, op Op, opts ...signatureOption) *SignatureMutation {
-	m := &SignatureMutation{
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	tenant             *string
+	_type              *subjectaltname.Type
+	value              *string
+	clearedFields      map[string]struct{}
+	certificate        *uuid.UUID
+	clearedcertificate bool
+	done               bool
+	oldValue           func(context.Context) (*SubjectAltName, error)
+	predicates         []predicate.SubjectAltName
+}
+
+var _ ent.Mutation = (*SubjectAltNameMutation)(nil)
+
+// subjectaltnameOption allows management of the mutation configuration using functional options.
+type subjectaltnameOption func(*SubjectAltNameMutation)
+
+// newSubjectAltNameMutation creates new mutation for the SubjectAltName entity.
+func newSubjectAltNameMutation(c config, op Op, opts ...subjectaltnameOption) *SubjectAltNameMutation {
+	m := &SubjectAltNameMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSignature,
+		typ:           TypeSubjectAltName,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2516,20 +19908,20 @@ func newSignatureMutation(c config, op Op, opts ...signatureOption) *SignatureMu
 	return m
 }
 
-// withSignatureID sets the ID field of the mutation.
-func withSignatureID(id uuid.UUID) signatureOption {
-	return func(m *SignatureMutation) {
+// withSubjectAltNameID sets the ID field of the mutation.
+func withSubjectAltNameID(id uuid.UUID) subjectaltnameOption {
+	return func(m *SubjectAltNameMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Signature
+			value *SubjectAltName
 		)
-		m.oldValue = func(ctx context.Context) (*Signature, error) {
+		m.oldValue = func(ctx context.Context) (*SubjectAltName, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Signature.Get(ctx, id)
+					value, err = m.Client().SubjectAltName.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2538,10 +19930,10 @@ func withSignatureID(id uuid.UUID) signatureOption {
 	}
 }
 
-// withSignature sets the old Signature of the mutation.
-func withSignature(node *Signature) signatureOption {
-	return func(m *SignatureMutation) {
-		m.oldValue = func(context.Context) (*Signature, error) {
+// withSubjectAltName sets the old SubjectAltName of the mutation.
+func withSubjectAltName(node *SubjectAltName) subjectaltnameOption {
+	return func(m *SubjectAltNameMutation) {
+		m.oldValue = func(context.Context) (*SubjectAltName, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2550,7 +19942,7 @@ func withSignature(node *Signature) signatureOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SignatureMutation) Client() *Client {
+func (m SubjectAltNameMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2558,7 +19950,7 @@ func (m SignatureMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SignatureMutation) Tx() (*Tx, error) {
+func (m SubjectAltNameMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2568,14 +19960,14 @@ func (m SignatureMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Signature entities.
-func (m *SignatureMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SubjectAltName entities.
+func (m *SubjectAltNameMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SignatureMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SubjectAltNameMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2586,7 +19978,7 @@ func (m *SignatureMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SignatureMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SubjectAltNameMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2595,186 +19987,168 @@ func (m *SignatureMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Signature.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SubjectAltName.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKeyID sets the "key_id" field.
-func (m *SignatureMutation) SetKeyID(s string) {
-	m.key_id = &s
+// SetTenant sets the "tenant" field.
+func (m *SubjectAltNameMutation) SetTenant(s string) {
+	m.tenant = &s
 }
 
-// KeyID returns the value of the "key_id" field in the mutation.
-func (m *SignatureMutation) KeyID() (r string, exists bool) {
-	v := m.key_id
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SubjectAltNameMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKeyID returns the old "key_id" field's value of the Signature entity.
-// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// OldTenant returns the old "tenant" field's value of the SubjectAltName entity.
+// If the SubjectAltName object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SignatureMutation) OldKeyID(ctx context.Context) (v string, err error) {
+func (m *SubjectAltNameMutation) OldTenant(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeyID requires an ID field in the mutation")
+		return v, errors.New("OldTenant requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
 	}
-	return oldValue.KeyID, nil
+	return oldValue.Tenant, nil
 }
 
-// ResetKeyID resets all changes to the "key_id" field.
-func (m *SignatureMutation) ResetKeyID() {
-	m.key_id = nil
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SubjectAltNameMutation) ResetTenant() {
+	m.tenant = nil
 }
 
-// SetSignature sets the "signature" field.
-func (m *SignatureMutation) SetSignature(s string) {
-	m.signature = &s
+// SetType sets the "type" field.
+func (m *SubjectAltNameMutation) SetType(s subjectaltname.Type) {
+	m._type = &s
 }
 
-// Signature returns the value of the "signature" field in the mutation.
-func (m *SignatureMutation) Signature() (r string, exists bool) {
-	v := m.signature
+// GetType returns the value of the "type" field in the mutation.
+func (m *SubjectAltNameMutation) GetType() (r subjectaltname.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSignature returns the old "signature" field's value of the Signature entity.
-// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the SubjectAltName entity.
+// If the SubjectAltName object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SignatureMutation) OldSignature(ctx context.Context) (v string, err error) {
+func (m *SubjectAltNameMutation) OldType(ctx context.Context) (v subjectaltname.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSignature requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Signature, nil
-}
-
-// ResetSignature resets all changes to the "signature" field.
-func (m *SignatureMutation) ResetSignature() {
-	m.signature = nil
-}
-
-// SetDsseID sets the "dsse" edge to the Dsse entity by id.
-func (m *SignatureMutation) SetDsseID(id uuid.UUID) {
-	m.dsse = &id
+	return oldValue.Type, nil
 }
 
-// ClearDsse clears the "dsse" edge to the Dsse entity.
-func (m *SignatureMutation) ClearDsse() {
-	m.cleareddsse = true
+// ResetType resets all changes to the "type" field.
+func (m *SubjectAltNameMutation) ResetType() {
+	m._type = nil
 }
 
-// DsseCleared reports if the "dsse" edge to the Dsse entity was cleared.
-func (m *SignatureMutation) DsseCleared() bool {
-	return m.cleareddsse
+// SetValue sets the "value" field.
+func (m *SubjectAltNameMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// DsseID returns the "dsse" edge ID in the mutation.
-func (m *SignatureMutation) DsseID() (id uuid.UUID, exists bool) {
-	if m.dsse != nil {
-		return *m.dsse, true
+// Value returns the value of the "value" field in the mutation.
+func (m *SubjectAltNameMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// DsseIDs returns the "dsse" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DsseID instead. It exists only for internal usage by the builders.
-func (m *SignatureMutation) DsseIDs() (ids []uuid.UUID) {
-	if id := m.dsse; id != nil {
-		ids = append(ids, *id)
+// OldValue returns the old "value" field's value of the SubjectAltName entity.
+// If the SubjectAltName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubjectAltNameMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetDsse resets all changes to the "dsse" edge.
-func (m *SignatureMutation) ResetDsse() {
-	m.dsse = nil
-	m.cleareddsse = false
-}
-
-// AddTimestampIDs adds the "timestamps" edge to the Timestamp entity by ids.
-func (m *SignatureMutation) AddTimestampIDs(ids ...uuid.UUID) {
-	if m.timestamps == nil {
-		m.timestamps = make(map[uuid.UUID]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.timestamps[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
+	return oldValue.Value, nil
 }
 
-// ClearTimestamps clears the "timestamps" edge to the Timestamp entity.
-func (m *SignatureMutation) ClearTimestamps() {
-	m.clearedtimestamps = true
+// ResetValue resets all changes to the "value" field.
+func (m *SubjectAltNameMutation) ResetValue() {
+	m.value = nil
 }
 
-// TimestampsCleared reports if the "timestamps" edge to the Timestamp entity was cleared.
-func (m *SignatureMutation) TimestampsCleared() bool {
-	return m.clearedtimestamps
+// SetCertificateID sets the "certificate" edge to the Certificate entity by id.
+func (m *SubjectAltNameMutation) SetCertificateID(id uuid.UUID) {
+	m.certificate = &id
 }
 
-// RemoveTimestampIDs removes the "timestamps" edge to the Timestamp entity by IDs.
-func (m *SignatureMutation) RemoveTimestampIDs(ids ...uuid.UUID) {
-	if m.removedtimestamps == nil {
-		m.removedtimestamps = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.timestamps, ids[i])
-		m.removedtimestamps[ids[i]] = struct{}{}
-	}
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (m *SubjectAltNameMutation) ClearCertificate() {
+	m.clearedcertificate = true
 }
 
-// RemovedTimestamps returns the removed IDs of the "timestamps" edge to the Timestamp entity.
-func (m *SignatureMutation) RemovedTimestampsIDs() (ids []uuid.UUID) {
-	for id := range m.removedtimestamps {
-		ids = append(ids, id)
+// CertificateCleared reports if the "certificate" edge to the Certificate entity was cleared.
+func (m *SubjectAltNameMutation) CertificateCleared() bool {
+	return m.clearedcertificate
+}
+
+// CertificateID returns the "certificate" edge ID in the mutation.
+func (m *SubjectAltNameMutation) CertificateID() (id uuid.UUID, exists bool) {
+	if m.certificate != nil {
+		return *m.certificate, true
 	}
 	return
 }
 
-// TimestampsIDs returns the "timestamps" edge IDs in the mutation.
-func (m *SignatureMutation) TimestampsIDs() (ids []uuid.UUID) {
-	for id := range m.timestamps {
-		ids = append(ids, id)
+// CertificateIDs returns the "certificate" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CertificateID instead. It exists only for internal usage by the builders.
+func (m *SubjectAltNameMutation) CertificateIDs() (ids []uuid.UUID) {
+	if id := m.certificate; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTimestamps resets all changes to the "timestamps" edge.
-func (m *SignatureMutation) ResetTimestamps() {
-	m.timestamps = nil
-	m.clearedtimestamps = false
-	m.removedtimestamps = nil
+// ResetCertificate resets all changes to the "certificate" edge.
+func (m *SubjectAltNameMutation) ResetCertificate() {
+	m.certificate = nil
+	m.clearedcertificate = false
 }
 
-// Where appends a list predicates to the SignatureMutation builder.
-func (m *SignatureMutation) Where(ps ...predicate.Signature) {
+// Where appends a list predicates to the SubjectAltNameMutation builder.
+func (m *SubjectAltNameMutation) Where(ps ...predicate.SubjectAltName) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SignatureMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SubjectAltNameMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SignatureMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Signature, len(ps))
+func (m *SubjectAltNameMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SubjectAltName, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2782,30 +20156,33 @@ func (m *SignatureMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SignatureMutation) Op() Op {
+func (m *SubjectAltNameMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SignatureMutation) SetOp(op Op) {
+func (m *SubjectAltNameMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Signature).
-func (m *SignatureMutation) Type() string {
+// Type returns the node type of this mutation (SubjectAltName).
+func (m *SubjectAltNameMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SignatureMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.key_id != nil {
-		fields = append(fields, signature.FieldKeyID)
+func (m *SubjectAltNameMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, subjectaltname.FieldTenant)
 	}
-	if m.signature != nil {
-		fields = append(fields, signature.FieldSignature)
+	if m._type != nil {
+		fields = append(fields, subjectaltname.FieldType)
+	}
+	if m.value != nil {
+		fields = append(fields, subjectaltname.FieldValue)
 	}
 	return fields
 }
@@ -2813,12 +20190,14 @@ func (m *SignatureMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SignatureMutation) Field(name string) (ent.Value, bool) {
+func (m *SubjectAltNameMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case signature.FieldKeyID:
-		return m.KeyID()
-	case signature.FieldSignature:
-		return m.Signature()
+	case subjectaltname.FieldTenant:
+		return m.Tenant()
+	case subjectaltname.FieldType:
+		return m.GetType()
+	case subjectaltname.FieldValue:
+		return m.Value()
 	}
 	return nil, false
 }
@@ -2826,230 +20205,208 @@ func (m *SignatureMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SignatureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SubjectAltNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case signature.FieldKeyID:
-		return m.OldKeyID(ctx)
-	case signature.FieldSignature:
-		return m.OldSignature(ctx)
+	case subjectaltname.FieldTenant:
+		return m.OldTenant(ctx)
+	case subjectaltname.FieldType:
+		return m.OldType(ctx)
+	case subjectaltname.FieldValue:
+		return m.OldValue(ctx)
 	}
-	return nil, fmt.Errorf("unknown Signature field %s", name)
+	return nil, fmt.Errorf("unknown SubjectAltName field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SignatureMutation) SetField(name string, value ent.Value) error {
+func (m *SubjectAltNameMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case signature.FieldKeyID:
+	case subjectaltname.FieldTenant:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeyID(v)
+		m.SetTenant(v)
 		return nil
-	case signature.FieldSignature:
+	case subjectaltname.FieldType:
+		v, ok := value.(subjectaltname.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case subjectaltname.FieldValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSignature(v)
+		m.SetValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Signature field %s", name)
+	return fmt.Errorf("unknown SubjectAltName field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SignatureMutation) AddedFields() []string {
+func (m *SubjectAltNameMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SignatureMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SubjectAltNameMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SignatureMutation) AddField(name string, value ent.Value) error {
+func (m *SubjectAltNameMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Signature numeric field %s", name)
+	return fmt.Errorf("unknown SubjectAltName numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SignatureMutation) ClearedFields() []string {
+func (m *SubjectAltNameMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SignatureMutation) FieldCleared(name string) bool {
+func (m *SubjectAltNameMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SignatureMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Signature nullable field %s", name)
+func (m *SubjectAltNameMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SubjectAltName nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SignatureMutation) ResetField(name string) error {
+func (m *SubjectAltNameMutation) ResetField(name string) error {
 	switch name {
-	case signature.FieldKeyID:
-		m.ResetKeyID()
+	case subjectaltname.FieldTenant:
+		m.ResetTenant()
 		return nil
-	case signature.FieldSignature:
-		m.ResetSignature()
+	case subjectaltname.FieldType:
+		m.ResetType()
+		return nil
+	case subjectaltname.FieldValue:
+		m.ResetValue()
 		return nil
 	}
-	return fmt.Errorf("unknown Signature field %s", name)
+	return fmt.Errorf("unknown SubjectAltName field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SignatureMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.dsse != nil {
-		edges = append(edges, signature.EdgeDsse)
-	}
-	if m.timestamps != nil {
-		edges = append(edges, signature.EdgeTimestamps)
+func (m *SubjectAltNameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.certificate != nil {
+		edges = append(edges, subjectaltname.EdgeCertificate)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SignatureMutation) AddedIDs(name string) []ent.Value {
+func (m *SubjectAltNameMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case signature.EdgeDsse:
-		if id := m.dsse; id != nil {
+	case subjectaltname.EdgeCertificate:
+		if id := m.certificate; id != nil {
 			return []ent.Value{*id}
 		}
-	case signature.EdgeTimestamps:
-		ids := make([]ent.Value, 0, len(m.timestamps))
-		for id := range m.timestamps {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SignatureMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedtimestamps != nil {
-		edges = append(edges, signature.EdgeTimestamps)
-	}
+func (m *SubjectAltNameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SignatureMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case signature.EdgeTimestamps:
-		ids := make([]ent.Value, 0, len(m.removedtimestamps))
-		for id := range m.removedtimestamps {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SubjectAltNameMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SignatureMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareddsse {
-		edges = append(edges, signature.EdgeDsse)
-	}
-	if m.clearedtimestamps {
-		edges = append(edges, signature.EdgeTimestamps)
+func (m *SubjectAltNameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcertificate {
+		edges = append(edges, subjectaltname.EdgeCertificate)
 	}
 	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *SignatureMutation) EdgeCleared(name string) bool {
-	switch name {
-	case signature.EdgeDsse:
-		return m.cleareddsse
-	case signature.EdgeTimestamps:
-		return m.clearedtimestamps
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SubjectAltNameMutation) EdgeCleared(name string) bool {
+	switch name {
+	case subjectaltname.EdgeCertificate:
+		return m.clearedcertificate
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SignatureMutation) ClearEdge(name string) error {
+func (m *SubjectAltNameMutation) ClearEdge(name string) error {
 	switch name {
-	case signature.EdgeDsse:
-		m.ClearDsse()
+	case subjectaltname.EdgeCertificate:
+		m.ClearCertificate()
 		return nil
 	}
-	return fmt.Errorf("unknown Signature unique edge %s", name)
+	return fmt.Errorf("unknown SubjectAltName unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SignatureMutation) ResetEdge(name string) error {
+func (m *SubjectAltNameMutation) ResetEdge(name string) error {
 	switch name {
-	case signature.EdgeDsse:
-		m.ResetDsse()
-		return nil
-	case signature.EdgeTimestamps:
-		m.ResetTimestamps()
+	case subjectaltname.EdgeCertificate:
+		m.ResetCertificate()
 		return nil
 	}
-	return fmt.Errorf("unknown Signature edge %s", name)
+	return fmt.Errorf("unknown SubjectAltName edge %s", name)
 }
 
-// StatementMutation represents an operation that mutates the Statement nodes in the graph.
-type StatementMutation struct {
+// SubjectDigestMutation represents an operation that mutates the SubjectDigest nodes in the graph.
+type SubjectDigestMutation struct {
 	config
-	op                             Op
-	typ                            string
-	id                             *uuid.UUID
-	predicate                      *string
-	clearedFields                  map[string]struct{}
-	subjects                       map[uuid.UUID]struct{}
-	removedsubjects                map[uuid.UUID]struct{}
-	clearedsubjects                bool
-	policy                         *uuid.UUID
-	clearedpolicy                  bool
-	attestation_collections        *uuid.UUID
-	clearedattestation_collections bool
-	dsse                           map[uuid.UUID]struct{}
-	removeddsse                    map[uuid.UUID]struct{}
-	cleareddsse                    bool
-	done                           bool
-	oldValue                       func(context.Context) (*Statement, error)
-	predicates                     []predicate.Statement
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	tenant         *string
+	algorithm      *string
+	value          *string
+	clearedFields  map[string]struct{}
+	subject        *uuid.UUID
+	clearedsubject bool
+	done           bool
+	oldValue       func(context.Context) (*SubjectDigest, error)
+	predicates     []predicate.SubjectDigest
 }
 
-var _ ent.Mutation = (*StatementMutation)(nil)
+var _ ent.Mutation = (*SubjectDigestMutation)(nil)
 
-// statementOption allows management of the mutation configuration using functional options.
-type statementOption func(*StatementMutation)
+// subjectdigestOption allows management of the mutation configuration using functional options.
+type subjectdigestOption func(*SubjectDigestMutation)
 
-// newStatementMutation creates new mutation for the Statement entity.
-func newStatementMutation(c config, op Op, opts ...statementOption) *StatementMutation {
-	m := &StatementMutation{
+// newSubjectDigestMutation creates new mutation for the SubjectDigest entity.
+func newSubjectDigestMutation(c config, op Op, opts ...subjectdigestOption) *SubjectDigestMutation {
+	m := &SubjectDigestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStatement,
+		typ:           TypeSubjectDigest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3058,20 +20415,20 @@ func newStatementMutation(c config, op Op, opts ...statementOption) *StatementMu
 	return m
 }
 
-// withStatementID sets the ID field of the mutation.
-func withStatementID(id uuid.UUID) statementOption {
-	return func(m *StatementMutation) {
+// withSubjectDigestID sets the ID field of the mutation.
+func withSubjectDigestID(id uuid.UUID) subjectdigestOption {
+	return func(m *SubjectDigestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Statement
+			value *SubjectDigest
 		)
-		m.oldValue = func(ctx context.Context) (*Statement, error) {
+		m.oldValue = func(ctx context.Context) (*SubjectDigest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Statement.Get(ctx, id)
+					value, err = m.Client().SubjectDigest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3080,10 +20437,10 @@ func withStatementID(id uuid.UUID) statementOption {
 	}
 }
 
-// withStatement sets the old Statement of the mutation.
-func withStatement(node *Statement) statementOption {
-	return func(m *StatementMutation) {
-		m.oldValue = func(context.Context) (*Statement, error) {
+// withSubjectDigest sets the old SubjectDigest of the mutation.
+func withSubjectDigest(node *SubjectDigest) subjectdigestOption {
+	return func(m *SubjectDigestMutation) {
+		m.oldValue = func(context.Context) (*SubjectDigest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3092,7 +20449,7 @@ func withStatement(node *Statement) statementOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StatementMutation) Client() *Client {
+func (m SubjectDigestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3100,7 +20457,7 @@ func (m StatementMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StatementMutation) Tx() (*Tx, error) {
+func (m SubjectDigestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3110,14 +20467,14 @@ func (m StatementMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Statement entities.
-func (m *StatementMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SubjectDigest entities.
+func (m *SubjectDigestMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StatementMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SubjectDigestMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3128,7 +20485,7 @@ func (m *StatementMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StatementMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SubjectDigestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3137,243 +20494,168 @@ func (m *StatementMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Statement.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SubjectDigest.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPredicate sets the "predicate" field.
-func (m *StatementMutation) SetPredicate(s string) {
-	m.predicate = &s
+// SetTenant sets the "tenant" field.
+func (m *SubjectDigestMutation) SetTenant(s string) {
+	m.tenant = &s
 }
 
-// Predicate returns the value of the "predicate" field in the mutation.
-func (m *StatementMutation) Predicate() (r string, exists bool) {
-	v := m.predicate
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SubjectDigestMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPredicate returns the old "predicate" field's value of the Statement entity.
-// If the Statement object wasn't provided to the builder, the object is fetched from the database.
+// OldTenant returns the old "tenant" field's value of the SubjectDigest entity.
+// If the SubjectDigest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StatementMutation) OldPredicate(ctx context.Context) (v string, err error) {
+func (m *SubjectDigestMutation) OldTenant(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPredicate is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPredicate requires an ID field in the mutation")
+		return v, errors.New("OldTenant requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPredicate: %w", err)
-	}
-	return oldValue.Predicate, nil
-}
-
-// ResetPredicate resets all changes to the "predicate" field.
-func (m *StatementMutation) ResetPredicate() {
-	m.predicate = nil
-}
-
-// AddSubjectIDs adds the "subjects" edge to the Subject entity by ids.
-func (m *StatementMutation) AddSubjectIDs(ids ...uuid.UUID) {
-	if m.subjects == nil {
-		m.subjects = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.subjects[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
 	}
+	return oldValue.Tenant, nil
 }
 
-// ClearSubjects clears the "subjects" edge to the Subject entity.
-func (m *StatementMutation) ClearSubjects() {
-	m.clearedsubjects = true
-}
-
-// SubjectsCleared reports if the "subjects" edge to the Subject entity was cleared.
-func (m *StatementMutation) SubjectsCleared() bool {
-	return m.clearedsubjects
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SubjectDigestMutation) ResetTenant() {
+	m.tenant = nil
 }
 
-// RemoveSubjectIDs removes the "subjects" edge to the Subject entity by IDs.
-func (m *StatementMutation) RemoveSubjectIDs(ids ...uuid.UUID) {
-	if m.removedsubjects == nil {
-		m.removedsubjects = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.subjects, ids[i])
-		m.removedsubjects[ids[i]] = struct{}{}
-	}
+// SetAlgorithm sets the "algorithm" field.
+func (m *SubjectDigestMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
 }
 
-// RemovedSubjects returns the removed IDs of the "subjects" edge to the Subject entity.
-func (m *StatementMutation) RemovedSubjectsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsubjects {
-		ids = append(ids, id)
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *SubjectDigestMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SubjectsIDs returns the "subjects" edge IDs in the mutation.
-func (m *StatementMutation) SubjectsIDs() (ids []uuid.UUID) {
-	for id := range m.subjects {
-		ids = append(ids, id)
+// OldAlgorithm returns the old "algorithm" field's value of the SubjectDigest entity.
+// If the SubjectDigest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubjectDigestMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetSubjects resets all changes to the "subjects" edge.
-func (m *StatementMutation) ResetSubjects() {
-	m.subjects = nil
-	m.clearedsubjects = false
-	m.removedsubjects = nil
-}
-
-// SetPolicyID sets the "policy" edge to the AttestationPolicy entity by id.
-func (m *StatementMutation) SetPolicyID(id uuid.UUID) {
-	m.policy = &id
-}
-
-// ClearPolicy clears the "policy" edge to the AttestationPolicy entity.
-func (m *StatementMutation) ClearPolicy() {
-	m.clearedpolicy = true
-}
-
-// PolicyCleared reports if the "policy" edge to the AttestationPolicy entity was cleared.
-func (m *StatementMutation) PolicyCleared() bool {
-	return m.clearedpolicy
-}
-
-// PolicyID returns the "policy" edge ID in the mutation.
-func (m *StatementMutation) PolicyID() (id uuid.UUID, exists bool) {
-	if m.policy != nil {
-		return *m.policy, true
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
 	}
-	return
-}
-
-// PolicyIDs returns the "policy" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PolicyID instead. It exists only for internal usage by the builders.
-func (m *StatementMutation) PolicyIDs() (ids []uuid.UUID) {
-	if id := m.policy; id != nil {
-		ids = append(ids, *id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
 	}
-	return
-}
-
-// ResetPolicy resets all changes to the "policy" edge.
-func (m *StatementMutation) ResetPolicy() {
-	m.policy = nil
-	m.clearedpolicy = false
-}
-
-// SetAttestationCollectionsID sets the "attestation_collections" edge to the AttestationCollection entity by id.
-func (m *StatementMutation) SetAttestationCollectionsID(id uuid.UUID) {
-	m.attestation_collections = &id
-}
-
-// ClearAttestationCollections clears the "attestation_collections" edge to the AttestationCollection entity.
-func (m *StatementMutation) ClearAttestationCollections() {
-	m.clearedattestation_collections = true
-}
-
-// AttestationCollectionsCleared reports if the "attestation_collections" edge to the AttestationCollection entity was cleared.
-func (m *StatementMutation) AttestationCollectionsCleared() bool {
-	return m.clearedattestation_collections
+	return oldValue.Algorithm, nil
 }
 
-// AttestationCollectionsID returns the "attestation_collections" edge ID in the mutation.
-func (m *StatementMutation) AttestationCollectionsID() (id uuid.UUID, exists bool) {
-	if m.attestation_collections != nil {
-		return *m.attestation_collections, true
-	}
-	return
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *SubjectDigestMutation) ResetAlgorithm() {
+	m.algorithm = nil
 }
 
-// AttestationCollectionsIDs returns the "attestation_collections" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AttestationCollectionsID instead. It exists only for internal usage by the builders.
-func (m *StatementMutation) AttestationCollectionsIDs() (ids []uuid.UUID) {
-	if id := m.attestation_collections; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetValue sets the "value" field.
+func (m *SubjectDigestMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// ResetAttestationCollections resets all changes to the "attestation_collections" edge.
-func (m *StatementMutation) ResetAttestationCollections() {
-	m.attestation_collections = nil
-	m.clearedattestation_collections = false
+// Value returns the value of the "value" field in the mutation.
+func (m *SubjectDigestMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddDsseIDs adds the "dsse" edge to the Dsse entity by ids.
-func (m *StatementMutation) AddDsseIDs(ids ...uuid.UUID) {
-	if m.dsse == nil {
-		m.dsse = make(map[uuid.UUID]struct{})
+// OldValue returns the old "value" field's value of the SubjectDigest entity.
+// If the SubjectDigest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubjectDigestMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.dsse[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
+	return oldValue.Value, nil
 }
 
-// ClearDsse clears the "dsse" edge to the Dsse entity.
-func (m *StatementMutation) ClearDsse() {
-	m.cleareddsse = true
+// ResetValue resets all changes to the "value" field.
+func (m *SubjectDigestMutation) ResetValue() {
+	m.value = nil
 }
 
-// DsseCleared reports if the "dsse" edge to the Dsse entity was cleared.
-func (m *StatementMutation) DsseCleared() bool {
-	return m.cleareddsse
+// SetSubjectID sets the "subject" edge to the Subject entity by id.
+func (m *SubjectDigestMutation) SetSubjectID(id uuid.UUID) {
+	m.subject = &id
 }
 
-// RemoveDsseIDs removes the "dsse" edge to the Dsse entity by IDs.
-func (m *StatementMutation) RemoveDsseIDs(ids ...uuid.UUID) {
-	if m.removeddsse == nil {
-		m.removeddsse = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.dsse, ids[i])
-		m.removeddsse[ids[i]] = struct{}{}
-	}
+// ClearSubject clears the "subject" edge to the Subject entity.
+func (m *SubjectDigestMutation) ClearSubject() {
+	m.clearedsubject = true
 }
 
-// RemovedDsse returns the removed IDs of the "dsse" edge to the Dsse entity.
-func (m *StatementMutation) RemovedDsseIDs() (ids []uuid.UUID) {
-	for id := range m.removeddsse {
-		ids = append(ids, id)
+// SubjectCleared reports if the "subject" edge to the Subject entity was cleared.
+func (m *SubjectDigestMutation) SubjectCleared() bool {
+	return m.clearedsubject
+}
+
+// SubjectID returns the "subject" edge ID in the mutation.
+func (m *SubjectDigestMutation) SubjectID() (id uuid.UUID, exists bool) {
+	if m.subject != nil {
+		return *m.subject, true
 	}
 	return
 }
 
-// DsseIDs returns the "dsse" edge IDs in the mutation.
-func (m *StatementMutation) DsseIDs() (ids []uuid.UUID) {
-	for id := range m.dsse {
-		ids = append(ids, id)
+// SubjectIDs returns the "subject" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubjectID instead. It exists only for internal usage by the builders.
+func (m *SubjectDigestMutation) SubjectIDs() (ids []uuid.UUID) {
+	if id := m.subject; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetDsse resets all changes to the "dsse" edge.
-func (m *StatementMutation) ResetDsse() {
-	m.dsse = nil
-	m.cleareddsse = false
-	m.removeddsse = nil
+// ResetSubject resets all changes to the "subject" edge.
+func (m *SubjectDigestMutation) ResetSubject() {
+	m.subject = nil
+	m.clearedsubject = false
 }
 
-// Where appends a list predicates to the StatementMutation builder.
-func (m *StatementMutation) Where(ps ...predicate.Statement) {
+// Where appends a list predicates to the SubjectDigestMutation builder.
+func (m *SubjectDigestMutation) Where(ps ...predicate.SubjectDigest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StatementMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SubjectDigestMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StatementMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Statement, len(ps))
+func (m *SubjectDigestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SubjectDigest, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3381,27 +20663,33 @@ func (m *StatementMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StatementMutation) Op() Op {
+func (m *SubjectDigestMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StatementMutation) SetOp(op Op) {
+func (m *SubjectDigestMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Statement).
-func (m *StatementMutation) Type() string {
+// Type returns the node type of this mutation (SubjectDigest).
+func (m *SubjectDigestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StatementMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.predicate != nil {
-		fields = append(fields, statement.FieldPredicate)
+func (m *SubjectDigestMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, subjectdigest.FieldTenant)
+	}
+	if m.algorithm != nil {
+		fields = append(fields, subjectdigest.FieldAlgorithm)
+	}
+	if m.value != nil {
+		fields = append(fields, subjectdigest.FieldValue)
 	}
 	return fields
 }
@@ -3409,10 +20697,14 @@ func (m *StatementMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StatementMutation) Field(name string) (ent.Value, bool) {
+func (m *SubjectDigestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case statement.FieldPredicate:
-		return m.Predicate()
+	case subjectdigest.FieldTenant:
+		return m.Tenant()
+	case subjectdigest.FieldAlgorithm:
+		return m.Algorithm()
+	case subjectdigest.FieldValue:
+		return m.Value()
 	}
 	return nil, false
 }
@@ -3420,258 +20712,208 @@ func (m *StatementMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StatementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SubjectDigestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case statement.FieldPredicate:
-		return m.OldPredicate(ctx)
+	case subjectdigest.FieldTenant:
+		return m.OldTenant(ctx)
+	case subjectdigest.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case subjectdigest.FieldValue:
+		return m.OldValue(ctx)
 	}
-	return nil, fmt.Errorf("unknown Statement field %s", name)
+	return nil, fmt.Errorf("unknown SubjectDigest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StatementMutation) SetField(name string, value ent.Value) error {
+func (m *SubjectDigestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case statement.FieldPredicate:
+	case subjectdigest.FieldTenant:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPredicate(v)
+		m.SetTenant(v)
+		return nil
+	case subjectdigest.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case subjectdigest.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Statement field %s", name)
+	return fmt.Errorf("unknown SubjectDigest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StatementMutation) AddedFields() []string {
+func (m *SubjectDigestMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StatementMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SubjectDigestMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StatementMutation) AddField(name string, value ent.Value) error {
+func (m *SubjectDigestMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Statement numeric field %s", name)
+	return fmt.Errorf("unknown SubjectDigest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StatementMutation) ClearedFields() []string {
+func (m *SubjectDigestMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StatementMutation) FieldCleared(name string) bool {
+func (m *SubjectDigestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StatementMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Statement nullable field %s", name)
+func (m *SubjectDigestMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SubjectDigest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StatementMutation) ResetField(name string) error {
+func (m *SubjectDigestMutation) ResetField(name string) error {
 	switch name {
-	case statement.FieldPredicate:
-		m.ResetPredicate()
+	case subjectdigest.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case subjectdigest.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case subjectdigest.FieldValue:
+		m.ResetValue()
 		return nil
 	}
-	return fmt.Errorf("unknown Statement field %s", name)
+	return fmt.Errorf("unknown SubjectDigest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StatementMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.subjects != nil {
-		edges = append(edges, statement.EdgeSubjects)
-	}
-	if m.policy != nil {
-		edges = append(edges, statement.EdgePolicy)
-	}
-	if m.attestation_collections != nil {
-		edges = append(edges, statement.EdgeAttestationCollections)
-	}
-	if m.dsse != nil {
-		edges = append(edges, statement.EdgeDsse)
+func (m *SubjectDigestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.subject != nil {
+		edges = append(edges, subjectdigest.EdgeSubject)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StatementMutation) AddedIDs(name string) []ent.Value {
+func (m *SubjectDigestMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case statement.EdgeSubjects:
-		ids := make([]ent.Value, 0, len(m.subjects))
-		for id := range m.subjects {
-			ids = append(ids, id)
-		}
-		return ids
-	case statement.EdgePolicy:
-		if id := m.policy; id != nil {
-			return []ent.Value{*id}
-		}
-	case statement.EdgeAttestationCollections:
-		if id := m.attestation_collections; id != nil {
+	case subjectdigest.EdgeSubject:
+		if id := m.subject; id != nil {
 			return []ent.Value{*id}
 		}
-	case statement.EdgeDsse:
-		ids := make([]ent.Value, 0, len(m.dsse))
-		for id := range m.dsse {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StatementMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedsubjects != nil {
-		edges = append(edges, statement.EdgeSubjects)
-	}
-	if m.removeddsse != nil {
-		edges = append(edges, statement.EdgeDsse)
-	}
+func (m *SubjectDigestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *StatementMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case statement.EdgeSubjects:
-		ids := make([]ent.Value, 0, len(m.removedsubjects))
-		for id := range m.removedsubjects {
-			ids = append(ids, id)
-		}
-		return ids
-	case statement.EdgeDsse:
-		ids := make([]ent.Value, 0, len(m.removeddsse))
-		for id := range m.removeddsse {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StatementMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedsubjects {
-		edges = append(edges, statement.EdgeSubjects)
-	}
-	if m.clearedpolicy {
-		edges = append(edges, statement.EdgePolicy)
-	}
-	if m.clearedattestation_collections {
-		edges = append(edges, statement.EdgeAttestationCollections)
-	}
-	if m.cleareddsse {
-		edges = append(edges, statement.EdgeDsse)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SubjectDigestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SubjectDigestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsubject {
+		edges = append(edges, subjectdigest.EdgeSubject)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StatementMutation) EdgeCleared(name string) bool {
+func (m *SubjectDigestMutation) EdgeCleared(name string) bool {
 	switch name {
-	case statement.EdgeSubjects:
-		return m.clearedsubjects
-	case statement.EdgePolicy:
-		return m.clearedpolicy
-	case statement.EdgeAttestationCollections:
-		return m.clearedattestation_collections
-	case statement.EdgeDsse:
-		return m.cleareddsse
+	case subjectdigest.EdgeSubject:
+		return m.clearedsubject
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StatementMutation) ClearEdge(name string) error {
+func (m *SubjectDigestMutation) ClearEdge(name string) error {
 	switch name {
-	case statement.EdgePolicy:
-		m.ClearPolicy()
-		return nil
-	case statement.EdgeAttestationCollections:
-		m.ClearAttestationCollections()
+	case subjectdigest.EdgeSubject:
+		m.ClearSubject()
 		return nil
 	}
-	return fmt.Errorf("unknown Statement unique edge %s", name)
+	return fmt.Errorf("unknown SubjectDigest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StatementMutation) ResetEdge(name string) error {
+func (m *SubjectDigestMutation) ResetEdge(name string) error {
 	switch name {
-	case statement.EdgeSubjects:
-		m.ResetSubjects()
-		return nil
-	case statement.EdgePolicy:
-		m.ResetPolicy()
-		return nil
-	case statement.EdgeAttestationCollections:
-		m.ResetAttestationCollections()
-		return nil
-	case statement.EdgeDsse:
-		m.ResetDsse()
+	case subjectdigest.EdgeSubject:
+		m.ResetSubject()
 		return nil
 	}
-	return fmt.Errorf("unknown Statement edge %s", name)
+	return fmt.Errorf("unknown SubjectDigest edge %s", name)
 }
 
-// SubjectMutation represents an operation that mutates the Subject nodes in the graph.
-type SubjectMutation struct {
+// TimestampMutation represents an operation that mutates the Timestamp nodes in the graph.
+type TimestampMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	created_at             *time.Time
-	name                   *string
-	clearedFields          map[string]struct{}
-	subject_digests        map[uuid.UUID]struct{}
-	removedsubject_digests map[uuid.UUID]struct{}
-	clearedsubject_digests bool
-	statement              *uuid.UUID
-	clearedstatement       bool
-	done                   bool
-	oldValue               func(context.Context) (*Subject, error)
-	predicates             []predicate.Subject
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	tenant           *string
+	_type            *string
+	timestamp        *time.Time
+	clearedFields    map[string]struct{}
+	signature        *uuid.UUID
+	clearedsignature bool
+	done             bool
+	oldValue         func(context.Context) (*Timestamp, error)
+	predicates       []predicate.Timestamp
 }
 
-var _ ent.Mutation = (*SubjectMutation)(nil)
+var _ ent.Mutation = (*TimestampMutation)(nil)
 
-// subjectOption allows management of the mutation configuration using functional options.
-type subjectOption func(*SubjectMutation)
+// timestampOption allows management of the mutation configuration using functional options.
+type timestampOption func(*TimestampMutation)
 
-// newSubjectMutation creates new mutation for the Subject entity.
-func newSubjectMutation(c config, op Op, opts ...subjectOption) *SubjectMutation {
-	m := &SubjectMutation{
+// newTimestampMutation creates new mutation for the Timestamp entity.
+func newTimestampMutation(c config, op Op, opts ...timestampOption) *TimestampMutation {
+	m := &TimestampMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSubject,
+		typ:           TypeTimestamp,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3680,20 +20922,20 @@ func newSubjectMutation(c config, op Op, opts ...subjectOption) *SubjectMutation
 	return m
 }
 
-// withSubjectID sets the ID field of the mutation.
-func withSubjectID(id uuid.UUID) subjectOption {
-	return func(m *SubjectMutation) {
+// withTimestampID sets the ID field of the mutation.
+func withTimestampID(id uuid.UUID) timestampOption {
+	return func(m *TimestampMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Subject
+			value *Timestamp
 		)
-		m.oldValue = func(ctx context.Context) (*Subject, error) {
+		m.oldValue = func(ctx context.Context) (*Timestamp, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Subject.Get(ctx, id)
+					value, err = m.Client().Timestamp.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3702,10 +20944,10 @@ func withSubjectID(id uuid.UUID) subjectOption {
 	}
 }
 
-// withSubject sets the old Subject of the mutation.
-func withSubject(node *Subject) subjectOption {
-	return func(m *SubjectMutation) {
-		m.oldValue = func(context.Context) (*Subject, error) {
+// withTimestamp sets the old Timestamp of the mutation.
+func withTimestamp(node *Timestamp) timestampOption {
+	return func(m *TimestampMutation) {
+		m.oldValue = func(context.Context) (*Timestamp, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3714,7 +20956,7 @@ func withSubject(node *Subject) subjectOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubjectMutation) Client() *Client {
+func (m TimestampMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3722,7 +20964,7 @@ func (m SubjectMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SubjectMutation) Tx() (*Tx, error) {
+func (m TimestampMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3732,14 +20974,14 @@ func (m SubjectMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Subject entities.
-func (m *SubjectMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Timestamp entities.
+func (m *TimestampMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SubjectMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TimestampMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3750,7 +20992,7 @@ func (m *SubjectMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SubjectMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TimestampMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3759,199 +21001,168 @@ func (m *SubjectMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Subject.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Timestamp.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SubjectMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTenant sets the "tenant" field.
+func (m *TimestampMutation) SetTenant(s string) {
+	m.tenant = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SubjectMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *TimestampMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Subject entity.
-// If the Subject object wasn't provided to the builder, the object is fetched from the database.
+// OldTenant returns the old "tenant" field's value of the Timestamp entity.
+// If the Timestamp object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubjectMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TimestampMutation) OldTenant(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTenant requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *SubjectMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[subject.FieldCreatedAt] = struct{}{}
-}
-
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *SubjectMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[subject.FieldCreatedAt]
-	return ok
+	return oldValue.Tenant, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SubjectMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, subject.FieldCreatedAt)
+// ResetTenant resets all changes to the "tenant" field.
+func (m *TimestampMutation) ResetTenant() {
+	m.tenant = nil
 }
 
-// SetName sets the "name" field.
-func (m *SubjectMutation) SetName(s string) {
-	m.name = &s
+// SetType sets the "type" field.
+func (m *TimestampMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *SubjectMutation) Name() (r string, exists bool) {
-	v := m.name
+// GetType returns the value of the "type" field in the mutation.
+func (m *TimestampMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Subject entity.
-// If the Subject object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Timestamp entity.
+// If the Timestamp object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubjectMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TimestampMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *SubjectMutation) ResetName() {
-	m.name = nil
-}
-
-// AddSubjectDigestIDs adds the "subject_digests" edge to the SubjectDigest entity by ids.
-func (m *SubjectMutation) AddSubjectDigestIDs(ids ...uuid.UUID) {
-	if m.subject_digests == nil {
-		m.subject_digests = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.subject_digests[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
+	return oldValue.Type, nil
 }
 
-// ClearSubjectDigests clears the "subject_digests" edge to the SubjectDigest entity.
-func (m *SubjectMutation) ClearSubjectDigests() {
-	m.clearedsubject_digests = true
+// ResetType resets all changes to the "type" field.
+func (m *TimestampMutation) ResetType() {
+	m._type = nil
 }
 
-// SubjectDigestsCleared reports if the "subject_digests" edge to the SubjectDigest entity was cleared.
-func (m *SubjectMutation) SubjectDigestsCleared() bool {
-	return m.clearedsubject_digests
+// SetTimestamp sets the "timestamp" field.
+func (m *TimestampMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
 }
 
-// RemoveSubjectDigestIDs removes the "subject_digests" edge to the SubjectDigest entity by IDs.
-func (m *SubjectMutation) RemoveSubjectDigestIDs(ids ...uuid.UUID) {
-	if m.removedsubject_digests == nil {
-		m.removedsubject_digests = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.subject_digests, ids[i])
-		m.removedsubject_digests[ids[i]] = struct{}{}
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *TimestampMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedSubjectDigests returns the removed IDs of the "subject_digests" edge to the SubjectDigest entity.
-func (m *SubjectMutation) RemovedSubjectDigestsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsubject_digests {
-		ids = append(ids, id)
+// OldTimestamp returns the old "timestamp" field's value of the Timestamp entity.
+// If the Timestamp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimestampMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// SubjectDigestsIDs returns the "subject_digests" edge IDs in the mutation.
-func (m *SubjectMutation) SubjectDigestsIDs() (ids []uuid.UUID) {
-	for id := range m.subject_digests {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
 }
 
-// ResetSubjectDigests resets all changes to the "subject_digests" edge.
-func (m *SubjectMutation) ResetSubjectDigests() {
-	m.subject_digests = nil
-	m.clearedsubject_digests = false
-	m.removedsubject_digests = nil
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *TimestampMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// SetStatementID sets the "statement" edge to the Statement entity by id.
-func (m *SubjectMutation) SetStatementID(id uuid.UUID) {
-	m.statement = &id
+// SetSignatureID sets the "signature" edge to the Signature entity by id.
+func (m *TimestampMutation) SetSignatureID(id uuid.UUID) {
+	m.signature = &id
 }
 
-// ClearStatement clears the "statement" edge to the Statement entity.
-func (m *SubjectMutation) ClearStatement() {
-	m.clearedstatement = true
+// ClearSignature clears the "signature" edge to the Signature entity.
+func (m *TimestampMutation) ClearSignature() {
+	m.clearedsignature = true
 }
 
-// StatementCleared reports if the "statement" edge to the Statement entity was cleared.
-func (m *SubjectMutation) StatementCleared() bool {
-	return m.clearedstatement
+// SignatureCleared reports if the "signature" edge to the Signature entity was cleared.
+func (m *TimestampMutation) SignatureCleared() bool {
+	return m.clearedsignature
 }
 
-// StatementID returns the "statement" edge ID in the mutation.
-func (m *SubjectMutation) StatementID() (id uuid.UUID, exists bool) {
-	if m.statement != nil {
-		return *m.statement, true
+// SignatureID returns the "signature" edge ID in the mutation.
+func (m *TimestampMutation) SignatureID() (id uuid.UUID, exists bool) {
+	if m.signature != nil {
+		return *m.signature, true
 	}
 	return
 }
 
-// StatementIDs returns the "statement" edge IDs in the mutation.
+// SignatureIDs returns the "signature" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StatementID instead. It exists only for internal usage by the builders.
-func (m *SubjectMutation) StatementIDs() (ids []uuid.UUID) {
-	if id := m.statement; id != nil {
+// SignatureID instead. It exists only for internal usage by the builders.
+func (m *TimestampMutation) SignatureIDs() (ids []uuid.UUID) {
+	if id := m.signature; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetStatement resets all changes to the "statement" edge.
-func (m *SubjectMutation) ResetStatement() {
-	m.statement = nil
-	m.clearedstatement = false
+// ResetSignature resets all changes to the "signature" edge.
+func (m *TimestampMutation) ResetSignature() {
+	m.signature = nil
+	m.clearedsignature = false
 }
 
-// Where appends a list predicates to the SubjectMutation builder.
-func (m *SubjectMutation) Where(ps ...predicate.Subject) {
+// Where appends a list predicates to the TimestampMutation builder.
+func (m *TimestampMutation) Where(ps ...predicate.Timestamp) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SubjectMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TimestampMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SubjectMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Subject, len(ps))
+func (m *TimestampMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Timestamp, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3959,30 +21170,33 @@ func (m *SubjectMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SubjectMutation) Op() Op {
+func (m *TimestampMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SubjectMutation) SetOp(op Op) {
+func (m *TimestampMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Subject).
-func (m *SubjectMutation) Type() string {
+// Type returns the node type of this mutation (Timestamp).
+func (m *TimestampMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SubjectMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.created_at != nil {
-		fields = append(fields, subject.FieldCreatedAt)
+func (m *TimestampMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, timestamp.FieldTenant)
 	}
-	if m.name != nil {
-		fields = append(fields, subject.FieldName)
+	if m._type != nil {
+		fields = append(fields, timestamp.FieldType)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, timestamp.FieldTimestamp)
 	}
 	return fields
 }
@@ -3990,12 +21204,14 @@ func (m *SubjectMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubjectMutation) Field(name string) (ent.Value, bool) {
+func (m *TimestampMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case subject.FieldCreatedAt:
-		return m.CreatedAt()
-	case subject.FieldName:
-		return m.Name()
+	case timestamp.FieldTenant:
+		return m.Tenant()
+	case timestamp.FieldType:
+		return m.GetType()
+	case timestamp.FieldTimestamp:
+		return m.Timestamp()
 	}
 	return nil, false
 }
@@ -4003,127 +21219,121 @@ func (m *SubjectMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SubjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TimestampMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case subject.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case subject.FieldName:
-		return m.OldName(ctx)
+	case timestamp.FieldTenant:
+		return m.OldTenant(ctx)
+	case timestamp.FieldType:
+		return m.OldType(ctx)
+	case timestamp.FieldTimestamp:
+		return m.OldTimestamp(ctx)
 	}
-	return nil, fmt.Errorf("unknown Subject field %s", name)
+	return nil, fmt.Errorf("unknown Timestamp field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubjectMutation) SetField(name string, value ent.Value) error {
+func (m *TimestampMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case subject.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case timestamp.FieldTenant:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetTenant(v)
 		return nil
-	case subject.FieldName:
+	case timestamp.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetType(v)
+		return nil
+	case timestamp.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Subject field %s", name)
+	return fmt.Errorf("unknown Timestamp field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SubjectMutation) AddedFields() []string {
+func (m *TimestampMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SubjectMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TimestampMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubjectMutation) AddField(name string, value ent.Value) error {
+func (m *TimestampMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Subject numeric field %s", name)
+	return fmt.Errorf("unknown Timestamp numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SubjectMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(subject.FieldCreatedAt) {
-		fields = append(fields, subject.FieldCreatedAt)
-	}
-	return fields
+func (m *TimestampMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SubjectMutation) FieldCleared(name string) bool {
+func (m *TimestampMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SubjectMutation) ClearField(name string) error {
-	switch name {
-	case subject.FieldCreatedAt:
-		m.ClearCreatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Subject nullable field %s", name)
+func (m *TimestampMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Timestamp nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SubjectMutation) ResetField(name string) error {
+func (m *TimestampMutation) ResetField(name string) error {
 	switch name {
-	case subject.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case timestamp.FieldTenant:
+		m.ResetTenant()
 		return nil
-	case subject.FieldName:
-		m.ResetName()
+	case timestamp.FieldType:
+		m.ResetType()
+		return nil
+	case timestamp.FieldTimestamp:
+		m.ResetTimestamp()
 		return nil
 	}
-	return fmt.Errorf("unknown Subject field %s", name)
+	return fmt.Errorf("unknown Timestamp field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubjectMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.subject_digests != nil {
-		edges = append(edges, subject.EdgeSubjectDigests)
-	}
-	if m.statement != nil {
-		edges = append(edges, subject.EdgeStatement)
+func (m *TimestampMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.signature != nil {
+		edges = append(edges, timestamp.EdgeSignature)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SubjectMutation) AddedIDs(name string) []ent.Value {
+func (m *TimestampMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case subject.EdgeSubjectDigests:
-		ids := make([]ent.Value, 0, len(m.subject_digests))
-		for id := range m.subject_digests {
-			ids = append(ids, id)
-		}
-		return ids
-	case subject.EdgeStatement:
-		if id := m.statement; id != nil {
+	case timestamp.EdgeSignature:
+		if id := m.signature; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4131,104 +21341,91 @@ func (m *SubjectMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubjectMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedsubject_digests != nil {
-		edges = append(edges, subject.EdgeSubjectDigests)
-	}
+func (m *TimestampMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SubjectMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case subject.EdgeSubjectDigests:
-		ids := make([]ent.Value, 0, len(m.removedsubject_digests))
-		for id := range m.removedsubject_digests {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TimestampMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubjectMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedsubject_digests {
-		edges = append(edges, subject.EdgeSubjectDigests)
-	}
-	if m.clearedstatement {
-		edges = append(edges, subject.EdgeStatement)
+func (m *TimestampMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsignature {
+		edges = append(edges, timestamp.EdgeSignature)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SubjectMutation) EdgeCleared(name string) bool {
+func (m *TimestampMutation) EdgeCleared(name string) bool {
 	switch name {
-	case subject.EdgeSubjectDigests:
-		return m.clearedsubject_digests
-	case subject.EdgeStatement:
-		return m.clearedstatement
+	case timestamp.EdgeSignature:
+		return m.clearedsignature
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SubjectMutation) ClearEdge(name string) error {
+func (m *TimestampMutation) ClearEdge(name string) error {
 	switch name {
-	case subject.EdgeStatement:
-		m.ClearStatement()
+	case timestamp.EdgeSignature:
+		m.ClearSignature()
 		return nil
 	}
-	return fmt.Errorf("unknown Subject unique edge %s", name)
+	return fmt.Errorf("unknown Timestamp unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SubjectMutation) ResetEdge(name string) error {
+func (m *TimestampMutation) ResetEdge(name string) error {
 	switch name {
-	case subject.EdgeSubjectDigests:
-		m.ResetSubjectDigests()
-		return nil
-	case subject.EdgeStatement:
-		m.ResetStatement()
+	case timestamp.EdgeSignature:
+		m.ResetSignature()
 		return nil
 	}
-	return fmt.Errorf("unknown Subject edge %s", name)
-}
-
-// SubjectDigestMutation represents an operation that mutates the SubjectDigest nodes in the graph.
-type SubjectDigestMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	algorithm      *string
-	value          *string
-	clearedFields  map[string]struct{}
-	subject        *uuid.UUID
-	clearedsubject bool
-	done           bool
-	oldValue       func(context.Context) (*SubjectDigest, error)
-	predicates     []predicate.SubjectDigest
-}
-
-var _ ent.Mutation = (*SubjectDigestMutation)(nil)
-
-// subjectdigestOption allows management of the mutation configuration using functional options.
-type subjectdigestOption func(*SubjectDigestMutation)
+	return fmt.Errorf("unknown Timestamp edge %s", name)
+}
 
-// newSubjectDigestMutation creates new mutation for the SubjectDigest entity.
-func newSubjectDigestMutation(c config, op Op, opts ...subjectdigestOption) *SubjectDigestMutation {
-	m := &SubjectDigestMutation{
+// TransparencyLogEntryMutation represents an operation that mutates the TransparencyLogEntry nodes in the graph.
+type TransparencyLogEntryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	tenant          *string
+	log_index       *int64
+	addlog_index    *int64
+	log_id          *string
+	kind            *string
+	kind_version    *string
+	integrated_time *time.Time
+	entry           *string
+	clearedFields   map[string]struct{}
+	dsse            *uuid.UUID
+	cleareddsse     bool
+	done            bool
+	oldValue        func(context.Context) (*TransparencyLogEntry, error)
+	predicates      []predicate.TransparencyLogEntry
+}
+
+var _ ent.Mutation = (*TransparencyLogEntryMutation)(nil)
+
+// transparencylogentryOption allows management of the mutation configuration using functional options.
+type transparencylogentryOption func(*TransparencyLogEntryMutation)
+
+// newTransparencyLogEntryMutation creates new mutation for the TransparencyLogEntry entity.
+func newTransparencyLogEntryMutation(c config, op Op, opts ...transparencylogentryOption) *TransparencyLogEntryMutation {
+	m := &TransparencyLogEntryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSubjectDigest,
+		typ:           TypeTransparencyLogEntry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4237,20 +21434,20 @@ func newSubjectDigestMutation(c config, op Op, opts ...subjectdigestOption) *Sub
 	return m
 }
 
-// withSubjectDigestID sets the ID field of the mutation.
-func withSubjectDigestID(id uuid.UUID) subjectdigestOption {
-	return func(m *SubjectDigestMutation) {
+// withTransparencyLogEntryID sets the ID field of the mutation.
+func withTransparencyLogEntryID(id uuid.UUID) transparencylogentryOption {
+	return func(m *TransparencyLogEntryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SubjectDigest
+			value *TransparencyLogEntry
 		)
-		m.oldValue = func(ctx context.Context) (*SubjectDigest, error) {
+		m.oldValue = func(ctx context.Context) (*TransparencyLogEntry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SubjectDigest.Get(ctx, id)
+					value, err = m.Client().TransparencyLogEntry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4259,10 +21456,10 @@ func withSubjectDigestID(id uuid.UUID) subjectdigestOption {
 	}
 }
 
-// withSubjectDigest sets the old SubjectDigest of the mutation.
-func withSubjectDigest(node *SubjectDigest) subjectdigestOption {
-	return func(m *SubjectDigestMutation) {
-		m.oldValue = func(context.Context) (*SubjectDigest, error) {
+// withTransparencyLogEntry sets the old TransparencyLogEntry of the mutation.
+func withTransparencyLogEntry(node *TransparencyLogEntry) transparencylogentryOption {
+	return func(m *TransparencyLogEntryMutation) {
+		m.oldValue = func(context.Context) (*TransparencyLogEntry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4271,7 +21468,7 @@ func withSubjectDigest(node *SubjectDigest) subjectdigestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubjectDigestMutation) Client() *Client {
+func (m TransparencyLogEntryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4279,7 +21476,7 @@ func (m SubjectDigestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SubjectDigestMutation) Tx() (*Tx, error) {
+func (m TransparencyLogEntryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4289,14 +21486,14 @@ func (m SubjectDigestMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SubjectDigest entities.
-func (m *SubjectDigestMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TransparencyLogEntry entities.
+func (m *TransparencyLogEntryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SubjectDigestMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TransparencyLogEntryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4307,7 +21504,7 @@ func (m *SubjectDigestMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SubjectDigestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TransparencyLogEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4316,132 +21513,371 @@ func (m *SubjectDigestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SubjectDigest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TransparencyLogEntry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAlgorithm sets the "algorithm" field.
-func (m *SubjectDigestMutation) SetAlgorithm(s string) {
-	m.algorithm = &s
+// SetTenant sets the "tenant" field.
+func (m *TransparencyLogEntryMutation) SetTenant(s string) {
+	m.tenant = &s
 }
 
-// Algorithm returns the value of the "algorithm" field in the mutation.
-func (m *SubjectDigestMutation) Algorithm() (r string, exists bool) {
-	v := m.algorithm
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *TransparencyLogEntryMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAlgorithm returns the old "algorithm" field's value of the SubjectDigest entity.
-// If the SubjectDigest object wasn't provided to the builder, the object is fetched from the database.
+// OldTenant returns the old "tenant" field's value of the TransparencyLogEntry entity.
+// If the TransparencyLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubjectDigestMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+func (m *TransparencyLogEntryMutation) OldTenant(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+		return v, errors.New("OldTenant requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
 	}
-	return oldValue.Algorithm, nil
+	return oldValue.Tenant, nil
 }
 
-// ResetAlgorithm resets all changes to the "algorithm" field.
-func (m *SubjectDigestMutation) ResetAlgorithm() {
-	m.algorithm = nil
+// ResetTenant resets all changes to the "tenant" field.
+func (m *TransparencyLogEntryMutation) ResetTenant() {
+	m.tenant = nil
 }
 
-// SetValue sets the "value" field.
-func (m *SubjectDigestMutation) SetValue(s string) {
-	m.value = &s
+// SetLogIndex sets the "log_index" field.
+func (m *TransparencyLogEntryMutation) SetLogIndex(i int64) {
+	m.log_index = &i
+	m.addlog_index = nil
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SubjectDigestMutation) Value() (r string, exists bool) {
-	v := m.value
+// LogIndex returns the value of the "log_index" field in the mutation.
+func (m *TransparencyLogEntryMutation) LogIndex() (r int64, exists bool) {
+	v := m.log_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the SubjectDigest entity.
-// If the SubjectDigest object wasn't provided to the builder, the object is fetched from the database.
+// OldLogIndex returns the old "log_index" field's value of the TransparencyLogEntry entity.
+// If the TransparencyLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubjectDigestMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *TransparencyLogEntryMutation) OldLogIndex(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldLogIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldLogIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldLogIndex: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.LogIndex, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SubjectDigestMutation) ResetValue() {
-	m.value = nil
+// AddLogIndex adds i to the "log_index" field.
+func (m *TransparencyLogEntryMutation) AddLogIndex(i int64) {
+	if m.addlog_index != nil {
+		*m.addlog_index += i
+	} else {
+		m.addlog_index = &i
+	}
 }
 
-// SetSubjectID sets the "subject" edge to the Subject entity by id.
-func (m *SubjectDigestMutation) SetSubjectID(id uuid.UUID) {
-	m.subject = &id
+// AddedLogIndex returns the value that was added to the "log_index" field in this mutation.
+func (m *TransparencyLogEntryMutation) AddedLogIndex() (r int64, exists bool) {
+	v := m.addlog_index
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearSubject clears the "subject" edge to the Subject entity.
-func (m *SubjectDigestMutation) ClearSubject() {
-	m.clearedsubject = true
+// ResetLogIndex resets all changes to the "log_index" field.
+func (m *TransparencyLogEntryMutation) ResetLogIndex() {
+	m.log_index = nil
+	m.addlog_index = nil
 }
 
-// SubjectCleared reports if the "subject" edge to the Subject entity was cleared.
-func (m *SubjectDigestMutation) SubjectCleared() bool {
-	return m.clearedsubject
+// SetLogID sets the "log_id" field.
+func (m *TransparencyLogEntryMutation) SetLogID(s string) {
+	m.log_id = &s
 }
 
-// SubjectID returns the "subject" edge ID in the mutation.
-func (m *SubjectDigestMutation) SubjectID() (id uuid.UUID, exists bool) {
-	if m.subject != nil {
-		return *m.subject, true
+// LogID returns the value of the "log_id" field in the mutation.
+func (m *TransparencyLogEntryMutation) LogID() (r string, exists bool) {
+	v := m.log_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogID returns the old "log_id" field's value of the TransparencyLogEntry entity.
+// If the TransparencyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransparencyLogEntryMutation) OldLogID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogID: %w", err)
+	}
+	return oldValue.LogID, nil
+}
+
+// ResetLogID resets all changes to the "log_id" field.
+func (m *TransparencyLogEntryMutation) ResetLogID() {
+	m.log_id = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *TransparencyLogEntryMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *TransparencyLogEntryMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the TransparencyLogEntry entity.
+// If the TransparencyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransparencyLogEntryMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ClearKind clears the value of the "kind" field.
+func (m *TransparencyLogEntryMutation) ClearKind() {
+	m.kind = nil
+	m.clearedFields[transparencylogentry.FieldKind] = struct{}{}
+}
+
+// KindCleared returns if the "kind" field was cleared in this mutation.
+func (m *TransparencyLogEntryMutation) KindCleared() bool {
+	_, ok := m.clearedFields[transparencylogentry.FieldKind]
+	return ok
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *TransparencyLogEntryMutation) ResetKind() {
+	m.kind = nil
+	delete(m.clearedFields, transparencylogentry.FieldKind)
+}
+
+// SetKindVersion sets the "kind_version" field.
+func (m *TransparencyLogEntryMutation) SetKindVersion(s string) {
+	m.kind_version = &s
+}
+
+// KindVersion returns the value of the "kind_version" field in the mutation.
+func (m *TransparencyLogEntryMutation) KindVersion() (r string, exists bool) {
+	v := m.kind_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKindVersion returns the old "kind_version" field's value of the TransparencyLogEntry entity.
+// If the TransparencyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransparencyLogEntryMutation) OldKindVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKindVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKindVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKindVersion: %w", err)
+	}
+	return oldValue.KindVersion, nil
+}
+
+// ClearKindVersion clears the value of the "kind_version" field.
+func (m *TransparencyLogEntryMutation) ClearKindVersion() {
+	m.kind_version = nil
+	m.clearedFields[transparencylogentry.FieldKindVersion] = struct{}{}
+}
+
+// KindVersionCleared returns if the "kind_version" field was cleared in this mutation.
+func (m *TransparencyLogEntryMutation) KindVersionCleared() bool {
+	_, ok := m.clearedFields[transparencylogentry.FieldKindVersion]
+	return ok
+}
+
+// ResetKindVersion resets all changes to the "kind_version" field.
+func (m *TransparencyLogEntryMutation) ResetKindVersion() {
+	m.kind_version = nil
+	delete(m.clearedFields, transparencylogentry.FieldKindVersion)
+}
+
+// SetIntegratedTime sets the "integrated_time" field.
+func (m *TransparencyLogEntryMutation) SetIntegratedTime(t time.Time) {
+	m.integrated_time = &t
+}
+
+// IntegratedTime returns the value of the "integrated_time" field in the mutation.
+func (m *TransparencyLogEntryMutation) IntegratedTime() (r time.Time, exists bool) {
+	v := m.integrated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntegratedTime returns the old "integrated_time" field's value of the TransparencyLogEntry entity.
+// If the TransparencyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransparencyLogEntryMutation) OldIntegratedTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntegratedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntegratedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntegratedTime: %w", err)
+	}
+	return oldValue.IntegratedTime, nil
+}
+
+// ClearIntegratedTime clears the value of the "integrated_time" field.
+func (m *TransparencyLogEntryMutation) ClearIntegratedTime() {
+	m.integrated_time = nil
+	m.clearedFields[transparencylogentry.FieldIntegratedTime] = struct{}{}
+}
+
+// IntegratedTimeCleared returns if the "integrated_time" field was cleared in this mutation.
+func (m *TransparencyLogEntryMutation) IntegratedTimeCleared() bool {
+	_, ok := m.clearedFields[transparencylogentry.FieldIntegratedTime]
+	return ok
+}
+
+// ResetIntegratedTime resets all changes to the "integrated_time" field.
+func (m *TransparencyLogEntryMutation) ResetIntegratedTime() {
+	m.integrated_time = nil
+	delete(m.clearedFields, transparencylogentry.FieldIntegratedTime)
+}
+
+// SetEntry sets the "entry" field.
+func (m *TransparencyLogEntryMutation) SetEntry(s string) {
+	m.entry = &s
+}
+
+// Entry returns the value of the "entry" field in the mutation.
+func (m *TransparencyLogEntryMutation) Entry() (r string, exists bool) {
+	v := m.entry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntry returns the old "entry" field's value of the TransparencyLogEntry entity.
+// If the TransparencyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransparencyLogEntryMutation) OldEntry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntry: %w", err)
+	}
+	return oldValue.Entry, nil
+}
+
+// ResetEntry resets all changes to the "entry" field.
+func (m *TransparencyLogEntryMutation) ResetEntry() {
+	m.entry = nil
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by id.
+func (m *TransparencyLogEntryMutation) SetDsseID(id uuid.UUID) {
+	m.dsse = &id
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (m *TransparencyLogEntryMutation) ClearDsse() {
+	m.cleareddsse = true
+}
+
+// DsseCleared reports if the "dsse" edge to the Dsse entity was cleared.
+func (m *TransparencyLogEntryMutation) DsseCleared() bool {
+	return m.cleareddsse
+}
+
+// DsseID returns the "dsse" edge ID in the mutation.
+func (m *TransparencyLogEntryMutation) DsseID() (id uuid.UUID, exists bool) {
+	if m.dsse != nil {
+		return *m.dsse, true
 	}
 	return
 }
 
-// SubjectIDs returns the "subject" edge IDs in the mutation.
+// DsseIDs returns the "dsse" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SubjectID instead. It exists only for internal usage by the builders.
-func (m *SubjectDigestMutation) SubjectIDs() (ids []uuid.UUID) {
-	if id := m.subject; id != nil {
+// DsseID instead. It exists only for internal usage by the builders.
+func (m *TransparencyLogEntryMutation) DsseIDs() (ids []uuid.UUID) {
+	if id := m.dsse; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSubject resets all changes to the "subject" edge.
-func (m *SubjectDigestMutation) ResetSubject() {
-	m.subject = nil
-	m.clearedsubject = false
+// ResetDsse resets all changes to the "dsse" edge.
+func (m *TransparencyLogEntryMutation) ResetDsse() {
+	m.dsse = nil
+	m.cleareddsse = false
 }
 
-// Where appends a list predicates to the SubjectDigestMutation builder.
-func (m *SubjectDigestMutation) Where(ps ...predicate.SubjectDigest) {
+// Where appends a list predicates to the TransparencyLogEntryMutation builder.
+func (m *TransparencyLogEntryMutation) Where(ps ...predicate.TransparencyLogEntry) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SubjectDigestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TransparencyLogEntryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SubjectDigestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SubjectDigest, len(ps))
+func (m *TransparencyLogEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TransparencyLogEntry, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4449,30 +21885,45 @@ func (m *SubjectDigestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SubjectDigestMutation) Op() Op {
+func (m *TransparencyLogEntryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SubjectDigestMutation) SetOp(op Op) {
+func (m *TransparencyLogEntryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SubjectDigest).
-func (m *SubjectDigestMutation) Type() string {
+// Type returns the node type of this mutation (TransparencyLogEntry).
+func (m *TransparencyLogEntryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SubjectDigestMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.algorithm != nil {
-		fields = append(fields, subjectdigest.FieldAlgorithm)
+func (m *TransparencyLogEntryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.tenant != nil {
+		fields = append(fields, transparencylogentry.FieldTenant)
 	}
-	if m.value != nil {
-		fields = append(fields, subjectdigest.FieldValue)
+	if m.log_index != nil {
+		fields = append(fields, transparencylogentry.FieldLogIndex)
+	}
+	if m.log_id != nil {
+		fields = append(fields, transparencylogentry.FieldLogID)
+	}
+	if m.kind != nil {
+		fields = append(fields, transparencylogentry.FieldKind)
+	}
+	if m.kind_version != nil {
+		fields = append(fields, transparencylogentry.FieldKindVersion)
+	}
+	if m.integrated_time != nil {
+		fields = append(fields, transparencylogentry.FieldIntegratedTime)
+	}
+	if m.entry != nil {
+		fields = append(fields, transparencylogentry.FieldEntry)
 	}
 	return fields
 }
@@ -4480,12 +21931,22 @@ func (m *SubjectDigestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubjectDigestMutation) Field(name string) (ent.Value, bool) {
+func (m *TransparencyLogEntryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case subjectdigest.FieldAlgorithm:
-		return m.Algorithm()
-	case subjectdigest.FieldValue:
-		return m.Value()
+	case transparencylogentry.FieldTenant:
+		return m.Tenant()
+	case transparencylogentry.FieldLogIndex:
+		return m.LogIndex()
+	case transparencylogentry.FieldLogID:
+		return m.LogID()
+	case transparencylogentry.FieldKind:
+		return m.Kind()
+	case transparencylogentry.FieldKindVersion:
+		return m.KindVersion()
+	case transparencylogentry.FieldIntegratedTime:
+		return m.IntegratedTime()
+	case transparencylogentry.FieldEntry:
+		return m.Entry()
 	}
 	return nil, false
 }
@@ -4493,109 +21954,205 @@ func (m *SubjectDigestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SubjectDigestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TransparencyLogEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case subjectdigest.FieldAlgorithm:
-		return m.OldAlgorithm(ctx)
-	case subjectdigest.FieldValue:
-		return m.OldValue(ctx)
-	}
-	return nil, fmt.Errorf("unknown SubjectDigest field %s", name)
+	case transparencylogentry.FieldTenant:
+		return m.OldTenant(ctx)
+	case transparencylogentry.FieldLogIndex:
+		return m.OldLogIndex(ctx)
+	case transparencylogentry.FieldLogID:
+		return m.OldLogID(ctx)
+	case transparencylogentry.FieldKind:
+		return m.OldKind(ctx)
+	case transparencylogentry.FieldKindVersion:
+		return m.OldKindVersion(ctx)
+	case transparencylogentry.FieldIntegratedTime:
+		return m.OldIntegratedTime(ctx)
+	case transparencylogentry.FieldEntry:
+		return m.OldEntry(ctx)
+	}
+	return nil, fmt.Errorf("unknown TransparencyLogEntry field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubjectDigestMutation) SetField(name string, value ent.Value) error {
+func (m *TransparencyLogEntryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case subjectdigest.FieldAlgorithm:
+	case transparencylogentry.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case transparencylogentry.FieldLogIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogIndex(v)
+		return nil
+	case transparencylogentry.FieldLogID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlgorithm(v)
+		m.SetLogID(v)
 		return nil
-	case subjectdigest.FieldValue:
+	case transparencylogentry.FieldKind:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetKind(v)
+		return nil
+	case transparencylogentry.FieldKindVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKindVersion(v)
+		return nil
+	case transparencylogentry.FieldIntegratedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntegratedTime(v)
+		return nil
+	case transparencylogentry.FieldEntry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntry(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SubjectDigest field %s", name)
+	return fmt.Errorf("unknown TransparencyLogEntry field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SubjectDigestMutation) AddedFields() []string {
-	return nil
+func (m *TransparencyLogEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addlog_index != nil {
+		fields = append(fields, transparencylogentry.FieldLogIndex)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SubjectDigestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TransparencyLogEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case transparencylogentry.FieldLogIndex:
+		return m.AddedLogIndex()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubjectDigestMutation) AddField(name string, value ent.Value) error {
+func (m *TransparencyLogEntryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case transparencylogentry.FieldLogIndex:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLogIndex(v)
+		return nil
 	}
-	return fmt.Errorf("unknown SubjectDigest numeric field %s", name)
+	return fmt.Errorf("unknown TransparencyLogEntry numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SubjectDigestMutation) ClearedFields() []string {
-	return nil
+func (m *TransparencyLogEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transparencylogentry.FieldKind) {
+		fields = append(fields, transparencylogentry.FieldKind)
+	}
+	if m.FieldCleared(transparencylogentry.FieldKindVersion) {
+		fields = append(fields, transparencylogentry.FieldKindVersion)
+	}
+	if m.FieldCleared(transparencylogentry.FieldIntegratedTime) {
+		fields = append(fields, transparencylogentry.FieldIntegratedTime)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SubjectDigestMutation) FieldCleared(name string) bool {
+func (m *TransparencyLogEntryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SubjectDigestMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SubjectDigest nullable field %s", name)
+func (m *TransparencyLogEntryMutation) ClearField(name string) error {
+	switch name {
+	case transparencylogentry.FieldKind:
+		m.ClearKind()
+		return nil
+	case transparencylogentry.FieldKindVersion:
+		m.ClearKindVersion()
+		return nil
+	case transparencylogentry.FieldIntegratedTime:
+		m.ClearIntegratedTime()
+		return nil
+	}
+	return fmt.Errorf("unknown TransparencyLogEntry nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SubjectDigestMutation) ResetField(name string) error {
+func (m *TransparencyLogEntryMutation) ResetField(name string) error {
 	switch name {
-	case subjectdigest.FieldAlgorithm:
-		m.ResetAlgorithm()
+	case transparencylogentry.FieldTenant:
+		m.ResetTenant()
 		return nil
-	case subjectdigest.FieldValue:
-		m.ResetValue()
+	case transparencylogentry.FieldLogIndex:
+		m.ResetLogIndex()
+		return nil
+	case transparencylogentry.FieldLogID:
+		m.ResetLogID()
+		return nil
+	case transparencylogentry.FieldKind:
+		m.ResetKind()
+		return nil
+	case transparencylogentry.FieldKindVersion:
+		m.ResetKindVersion()
+		return nil
+	case transparencylogentry.FieldIntegratedTime:
+		m.ResetIntegratedTime()
+		return nil
+	case transparencylogentry.FieldEntry:
+		m.ResetEntry()
 		return nil
 	}
-	return fmt.Errorf("unknown SubjectDigest field %s", name)
+	return fmt.Errorf("unknown TransparencyLogEntry field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubjectDigestMutation) AddedEdges() []string {
+func (m *TransparencyLogEntryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.subject != nil {
-		edges = append(edges, subjectdigest.EdgeSubject)
+	if m.dsse != nil {
+		edges = append(edges, transparencylogentry.EdgeDsse)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SubjectDigestMutation) AddedIDs(name string) []ent.Value {
+func (m *TransparencyLogEntryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case subjectdigest.EdgeSubject:
-		if id := m.subject; id != nil {
+	case transparencylogentry.EdgeDsse:
+		if id := m.dsse; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4603,85 +22160,92 @@ func (m *SubjectDigestMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubjectDigestMutation) RemovedEdges() []string {
+func (m *TransparencyLogEntryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SubjectDigestMutation) RemovedIDs(name string) []ent.Value {
+func (m *TransparencyLogEntryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubjectDigestMutation) ClearedEdges() []string {
+func (m *TransparencyLogEntryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedsubject {
-		edges = append(edges, subjectdigest.EdgeSubject)
+	if m.cleareddsse {
+		edges = append(edges, transparencylogentry.EdgeDsse)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SubjectDigestMutation) EdgeCleared(name string) bool {
+func (m *TransparencyLogEntryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case subjectdigest.EdgeSubject:
-		return m.clearedsubject
+	case transparencylogentry.EdgeDsse:
+		return m.cleareddsse
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SubjectDigestMutation) ClearEdge(name string) error {
+func (m *TransparencyLogEntryMutation) ClearEdge(name string) error {
 	switch name {
-	case subjectdigest.EdgeSubject:
-		m.ClearSubject()
+	case transparencylogentry.EdgeDsse:
+		m.ClearDsse()
 		return nil
 	}
-	return fmt.Errorf("unknown SubjectDigest unique edge %s", name)
+	return fmt.Errorf("unknown TransparencyLogEntry unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SubjectDigestMutation) ResetEdge(name string) error {
+func (m *TransparencyLogEntryMutation) ResetEdge(name string) error {
 	switch name {
-	case subjectdigest.EdgeSubject:
-		m.ResetSubject()
+	case transparencylogentry.EdgeDsse:
+		m.ResetDsse()
 		return nil
 	}
-	return fmt.Errorf("unknown SubjectDigest edge %s", name)
+	return fmt.Errorf("unknown TransparencyLogEntry edge %s", name)
 }
 
-// TimestampMutation represents an operation that mutates the Timestamp nodes in the graph.
-type TimestampMutation struct {
+// VerificationSummaryMutation represents an operation that mutates the VerificationSummary nodes in the graph.
+type VerificationSummaryMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	_type            *string
-	timestamp        *time.Time
-	clearedFields    map[string]struct{}
-	signature        *uuid.UUID
-	clearedsignature bool
-	done             bool
-	oldValue         func(context.Context) (*Timestamp, error)
-	predicates       []predicate.Timestamp
-}
-
-var _ ent.Mutation = (*TimestampMutation)(nil)
-
-// timestampOption allows management of the mutation configuration using functional options.
-type timestampOption func(*TimestampMutation)
-
-// newTimestampMutation creates new mutation for the Timestamp entity.
-func newTimestampMutation(c config, op Op, opts ...timestampOption) *TimestampMutation {
-	m := &TimestampMutation{
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	tenant                *string
+	verifier_id           *string
+	time_verified         *time.Time
+	resource_uri          *string
+	policy_uri            *string
+	verification_result   *string
+	verified_levels       *[]string
+	appendverified_levels []string
+	slsa_version          *string
+	clearedFields         map[string]struct{}
+	statement             *uuid.UUID
+	clearedstatement      bool
+	done                  bool
+	oldValue              func(context.Context) (*VerificationSummary, error)
+	predicates            []predicate.VerificationSummary
+}
+
+var _ ent.Mutation = (*VerificationSummaryMutation)(nil)
+
+// verificationsummaryOption allows management of the mutation configuration using functional options.
+type verificationsummaryOption func(*VerificationSummaryMutation)
+
+// newVerificationSummaryMutation creates new mutation for the VerificationSummary entity.
+func newVerificationSummaryMutation(c config, op Op, opts ...verificationsummaryOption) *VerificationSummaryMutation {
+	m := &VerificationSummaryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTimestamp,
+		typ:           TypeVerificationSummary,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4690,20 +22254,20 @@ func newTimestampMutation(c config, op Op, opts ...timestampOption) *TimestampMu
 	return m
 }
 
-// withTimestampID sets the ID field of the mutation.
-func withTimestampID(id uuid.UUID) timestampOption {
-	return func(m *TimestampMutation) {
+// withVerificationSummaryID sets the ID field of the mutation.
+func withVerificationSummaryID(id uuid.UUID) verificationsummaryOption {
+	return func(m *VerificationSummaryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Timestamp
+			value *VerificationSummary
 		)
-		m.oldValue = func(ctx context.Context) (*Timestamp, error) {
+		m.oldValue = func(ctx context.Context) (*VerificationSummary, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Timestamp.Get(ctx, id)
+					value, err = m.Client().VerificationSummary.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4712,10 +22276,10 @@ func withTimestampID(id uuid.UUID) timestampOption {
 	}
 }
 
-// withTimestamp sets the old Timestamp of the mutation.
-func withTimestamp(node *Timestamp) timestampOption {
-	return func(m *TimestampMutation) {
-		m.oldValue = func(context.Context) (*Timestamp, error) {
+// withVerificationSummary sets the old VerificationSummary of the mutation.
+func withVerificationSummary(node *VerificationSummary) verificationsummaryOption {
+	return func(m *VerificationSummaryMutation) {
+		m.oldValue = func(context.Context) (*VerificationSummary, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4724,7 +22288,7 @@ func withTimestamp(node *Timestamp) timestampOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TimestampMutation) Client() *Client {
+func (m VerificationSummaryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4732,7 +22296,7 @@ func (m TimestampMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TimestampMutation) Tx() (*Tx, error) {
+func (m VerificationSummaryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4741,160 +22305,444 @@ func (m TimestampMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Timestamp entities.
-func (m *TimestampMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of VerificationSummary entities.
+func (m *VerificationSummaryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VerificationSummaryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VerificationSummaryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VerificationSummary.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *VerificationSummaryMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *VerificationSummaryMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the VerificationSummary entity.
+// If the VerificationSummary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationSummaryMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *VerificationSummaryMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetVerifierID sets the "verifier_id" field.
+func (m *VerificationSummaryMutation) SetVerifierID(s string) {
+	m.verifier_id = &s
+}
+
+// VerifierID returns the value of the "verifier_id" field in the mutation.
+func (m *VerificationSummaryMutation) VerifierID() (r string, exists bool) {
+	v := m.verifier_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifierID returns the old "verifier_id" field's value of the VerificationSummary entity.
+// If the VerificationSummary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationSummaryMutation) OldVerifierID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifierID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifierID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifierID: %w", err)
+	}
+	return oldValue.VerifierID, nil
+}
+
+// ResetVerifierID resets all changes to the "verifier_id" field.
+func (m *VerificationSummaryMutation) ResetVerifierID() {
+	m.verifier_id = nil
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (m *VerificationSummaryMutation) SetTimeVerified(t time.Time) {
+	m.time_verified = &t
+}
+
+// TimeVerified returns the value of the "time_verified" field in the mutation.
+func (m *VerificationSummaryMutation) TimeVerified() (r time.Time, exists bool) {
+	v := m.time_verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeVerified returns the old "time_verified" field's value of the VerificationSummary entity.
+// If the VerificationSummary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationSummaryMutation) OldTimeVerified(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeVerified: %w", err)
+	}
+	return oldValue.TimeVerified, nil
+}
+
+// ClearTimeVerified clears the value of the "time_verified" field.
+func (m *VerificationSummaryMutation) ClearTimeVerified() {
+	m.time_verified = nil
+	m.clearedFields[verificationsummary.FieldTimeVerified] = struct{}{}
+}
+
+// TimeVerifiedCleared returns if the "time_verified" field was cleared in this mutation.
+func (m *VerificationSummaryMutation) TimeVerifiedCleared() bool {
+	_, ok := m.clearedFields[verificationsummary.FieldTimeVerified]
+	return ok
+}
+
+// ResetTimeVerified resets all changes to the "time_verified" field.
+func (m *VerificationSummaryMutation) ResetTimeVerified() {
+	m.time_verified = nil
+	delete(m.clearedFields, verificationsummary.FieldTimeVerified)
+}
+
+// SetResourceURI sets the "resource_uri" field.
+func (m *VerificationSummaryMutation) SetResourceURI(s string) {
+	m.resource_uri = &s
+}
+
+// ResourceURI returns the value of the "resource_uri" field in the mutation.
+func (m *VerificationSummaryMutation) ResourceURI() (r string, exists bool) {
+	v := m.resource_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceURI returns the old "resource_uri" field's value of the VerificationSummary entity.
+// If the VerificationSummary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationSummaryMutation) OldResourceURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceURI: %w", err)
+	}
+	return oldValue.ResourceURI, nil
+}
+
+// ResetResourceURI resets all changes to the "resource_uri" field.
+func (m *VerificationSummaryMutation) ResetResourceURI() {
+	m.resource_uri = nil
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (m *VerificationSummaryMutation) SetPolicyURI(s string) {
+	m.policy_uri = &s
+}
+
+// PolicyURI returns the value of the "policy_uri" field in the mutation.
+func (m *VerificationSummaryMutation) PolicyURI() (r string, exists bool) {
+	v := m.policy_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyURI returns the old "policy_uri" field's value of the VerificationSummary entity.
+// If the VerificationSummary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationSummaryMutation) OldPolicyURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyURI: %w", err)
+	}
+	return oldValue.PolicyURI, nil
+}
+
+// ClearPolicyURI clears the value of the "policy_uri" field.
+func (m *VerificationSummaryMutation) ClearPolicyURI() {
+	m.policy_uri = nil
+	m.clearedFields[verificationsummary.FieldPolicyURI] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TimestampMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// PolicyURICleared returns if the "policy_uri" field was cleared in this mutation.
+func (m *VerificationSummaryMutation) PolicyURICleared() bool {
+	_, ok := m.clearedFields[verificationsummary.FieldPolicyURI]
+	return ok
+}
+
+// ResetPolicyURI resets all changes to the "policy_uri" field.
+func (m *VerificationSummaryMutation) ResetPolicyURI() {
+	m.policy_uri = nil
+	delete(m.clearedFields, verificationsummary.FieldPolicyURI)
+}
+
+// SetVerificationResult sets the "verification_result" field.
+func (m *VerificationSummaryMutation) SetVerificationResult(s string) {
+	m.verification_result = &s
+}
+
+// VerificationResult returns the value of the "verification_result" field in the mutation.
+func (m *VerificationSummaryMutation) VerificationResult() (r string, exists bool) {
+	v := m.verification_result
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TimestampMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Timestamp.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldVerificationResult returns the old "verification_result" field's value of the VerificationSummary entity.
+// If the VerificationSummary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationSummaryMutation) OldVerificationResult(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationResult: %w", err)
 	}
+	return oldValue.VerificationResult, nil
 }
 
-// SetType sets the "type" field.
-func (m *TimestampMutation) SetType(s string) {
-	m._type = &s
+// ResetVerificationResult resets all changes to the "verification_result" field.
+func (m *VerificationSummaryMutation) ResetVerificationResult() {
+	m.verification_result = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *TimestampMutation) GetType() (r string, exists bool) {
-	v := m._type
+// SetVerifiedLevels sets the "verified_levels" field.
+func (m *VerificationSummaryMutation) SetVerifiedLevels(s []string) {
+	m.verified_levels = &s
+	m.appendverified_levels = nil
+}
+
+// VerifiedLevels returns the value of the "verified_levels" field in the mutation.
+func (m *VerificationSummaryMutation) VerifiedLevels() (r []string, exists bool) {
+	v := m.verified_levels
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Timestamp entity.
-// If the Timestamp object wasn't provided to the builder, the object is fetched from the database.
+// OldVerifiedLevels returns the old "verified_levels" field's value of the VerificationSummary entity.
+// If the VerificationSummary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TimestampMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *VerificationSummaryMutation) OldVerifiedLevels(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldVerifiedLevels is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldVerifiedLevels requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldVerifiedLevels: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.VerifiedLevels, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *TimestampMutation) ResetType() {
-	m._type = nil
+// AppendVerifiedLevels adds s to the "verified_levels" field.
+func (m *VerificationSummaryMutation) AppendVerifiedLevels(s []string) {
+	m.appendverified_levels = append(m.appendverified_levels, s...)
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *TimestampMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// AppendedVerifiedLevels returns the list of values that were appended to the "verified_levels" field in this mutation.
+func (m *VerificationSummaryMutation) AppendedVerifiedLevels() ([]string, bool) {
+	if len(m.appendverified_levels) == 0 {
+		return nil, false
+	}
+	return m.appendverified_levels, true
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *TimestampMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// ClearVerifiedLevels clears the value of the "verified_levels" field.
+func (m *VerificationSummaryMutation) ClearVerifiedLevels() {
+	m.verified_levels = nil
+	m.appendverified_levels = nil
+	m.clearedFields[verificationsummary.FieldVerifiedLevels] = struct{}{}
+}
+
+// VerifiedLevelsCleared returns if the "verified_levels" field was cleared in this mutation.
+func (m *VerificationSummaryMutation) VerifiedLevelsCleared() bool {
+	_, ok := m.clearedFields[verificationsummary.FieldVerifiedLevels]
+	return ok
+}
+
+// ResetVerifiedLevels resets all changes to the "verified_levels" field.
+func (m *VerificationSummaryMutation) ResetVerifiedLevels() {
+	m.verified_levels = nil
+	m.appendverified_levels = nil
+	delete(m.clearedFields, verificationsummary.FieldVerifiedLevels)
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (m *VerificationSummaryMutation) SetSlsaVersion(s string) {
+	m.slsa_version = &s
+}
+
+// SlsaVersion returns the value of the "slsa_version" field in the mutation.
+func (m *VerificationSummaryMutation) SlsaVersion() (r string, exists bool) {
+	v := m.slsa_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the Timestamp entity.
-// If the Timestamp object wasn't provided to the builder, the object is fetched from the database.
+// OldSlsaVersion returns the old "slsa_version" field's value of the VerificationSummary entity.
+// If the VerificationSummary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TimestampMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *VerificationSummaryMutation) OldSlsaVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldSlsaVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldSlsaVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldSlsaVersion: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.SlsaVersion, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *TimestampMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ClearSlsaVersion clears the value of the "slsa_version" field.
+func (m *VerificationSummaryMutation) ClearSlsaVersion() {
+	m.slsa_version = nil
+	m.clearedFields[verificationsummary.FieldSlsaVersion] = struct{}{}
 }
 
-// SetSignatureID sets the "signature" edge to the Signature entity by id.
-func (m *TimestampMutation) SetSignatureID(id uuid.UUID) {
-	m.signature = &id
+// SlsaVersionCleared returns if the "slsa_version" field was cleared in this mutation.
+func (m *VerificationSummaryMutation) SlsaVersionCleared() bool {
+	_, ok := m.clearedFields[verificationsummary.FieldSlsaVersion]
+	return ok
 }
 
-// ClearSignature clears the "signature" edge to the Signature entity.
-func (m *TimestampMutation) ClearSignature() {
-	m.clearedsignature = true
+// ResetSlsaVersion resets all changes to the "slsa_version" field.
+func (m *VerificationSummaryMutation) ResetSlsaVersion() {
+	m.slsa_version = nil
+	delete(m.clearedFields, verificationsummary.FieldSlsaVersion)
 }
 
-// SignatureCleared reports if the "signature" edge to the Signature entity was cleared.
-func (m *TimestampMutation) SignatureCleared() bool {
-	return m.clearedsignature
+// SetStatementID sets the "statement" edge to the Statement entity by id.
+func (m *VerificationSummaryMutation) SetStatementID(id uuid.UUID) {
+	m.statement = &id
 }
 
-// SignatureID returns the "signature" edge ID in the mutation.
-func (m *TimestampMutation) SignatureID() (id uuid.UUID, exists bool) {
-	if m.signature != nil {
-		return *m.signature, true
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (m *VerificationSummaryMutation) ClearStatement() {
+	m.clearedstatement = true
+}
+
+// StatementCleared reports if the "statement" edge to the Statement entity was cleared.
+func (m *VerificationSummaryMutation) StatementCleared() bool {
+	return m.clearedstatement
+}
+
+// StatementID returns the "statement" edge ID in the mutation.
+func (m *VerificationSummaryMutation) StatementID() (id uuid.UUID, exists bool) {
+	if m.statement != nil {
+		return *m.statement, true
 	}
 	return
 }
 
-// SignatureIDs returns the "signature" edge IDs in the mutation.
+// StatementIDs returns the "statement" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SignatureID instead. It exists only for internal usage by the builders.
-func (m *TimestampMutation) SignatureIDs() (ids []uuid.UUID) {
-	if id := m.signature; id != nil {
+// StatementID instead. It exists only for internal usage by the builders.
+func (m *VerificationSummaryMutation) StatementIDs() (ids []uuid.UUID) {
+	if id := m.statement; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSignature resets all changes to the "signature" edge.
-func (m *TimestampMutation) ResetSignature() {
-	m.signature = nil
-	m.clearedsignature = false
+// ResetStatement resets all changes to the "statement" edge.
+func (m *VerificationSummaryMutation) ResetStatement() {
+	m.statement = nil
+	m.clearedstatement = false
 }
 
-// Where appends a list predicates to the TimestampMutation builder.
-func (m *TimestampMutation) Where(ps ...predicate.Timestamp) {
+// Where appends a list predicates to the VerificationSummaryMutation builder.
+func (m *VerificationSummaryMutation) Where(ps ...predicate.VerificationSummary) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TimestampMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VerificationSummaryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TimestampMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Timestamp, len(ps))
+func (m *VerificationSummaryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VerificationSummary, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4902,30 +22750,48 @@ func (m *TimestampMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TimestampMutation) Op() Op {
+func (m *VerificationSummaryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TimestampMutation) SetOp(op Op) {
+func (m *VerificationSummaryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Timestamp).
-func (m *TimestampMutation) Type() string {
+// Type returns the node type of this mutation (VerificationSummary).
+func (m *VerificationSummaryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TimestampMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m._type != nil {
-		fields = append(fields, timestamp.FieldType)
+func (m *VerificationSummaryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, verificationsummary.FieldTenant)
 	}
-	if m.timestamp != nil {
-		fields = append(fields, timestamp.FieldTimestamp)
+	if m.verifier_id != nil {
+		fields = append(fields, verificationsummary.FieldVerifierID)
+	}
+	if m.time_verified != nil {
+		fields = append(fields, verificationsummary.FieldTimeVerified)
+	}
+	if m.resource_uri != nil {
+		fields = append(fields, verificationsummary.FieldResourceURI)
+	}
+	if m.policy_uri != nil {
+		fields = append(fields, verificationsummary.FieldPolicyURI)
+	}
+	if m.verification_result != nil {
+		fields = append(fields, verificationsummary.FieldVerificationResult)
+	}
+	if m.verified_levels != nil {
+		fields = append(fields, verificationsummary.FieldVerifiedLevels)
+	}
+	if m.slsa_version != nil {
+		fields = append(fields, verificationsummary.FieldSlsaVersion)
 	}
 	return fields
 }
@@ -4933,12 +22799,24 @@ func (m *TimestampMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TimestampMutation) Field(name string) (ent.Value, bool) {
+func (m *VerificationSummaryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case timestamp.FieldType:
-		return m.GetType()
-	case timestamp.FieldTimestamp:
-		return m.Timestamp()
+	case verificationsummary.FieldTenant:
+		return m.Tenant()
+	case verificationsummary.FieldVerifierID:
+		return m.VerifierID()
+	case verificationsummary.FieldTimeVerified:
+		return m.TimeVerified()
+	case verificationsummary.FieldResourceURI:
+		return m.ResourceURI()
+	case verificationsummary.FieldPolicyURI:
+		return m.PolicyURI()
+	case verificationsummary.FieldVerificationResult:
+		return m.VerificationResult()
+	case verificationsummary.FieldVerifiedLevels:
+		return m.VerifiedLevels()
+	case verificationsummary.FieldSlsaVersion:
+		return m.SlsaVersion()
 	}
 	return nil, false
 }
@@ -4946,109 +22824,208 @@ func (m *TimestampMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TimestampMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VerificationSummaryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case timestamp.FieldType:
-		return m.OldType(ctx)
-	case timestamp.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	}
-	return nil, fmt.Errorf("unknown Timestamp field %s", name)
+	case verificationsummary.FieldTenant:
+		return m.OldTenant(ctx)
+	case verificationsummary.FieldVerifierID:
+		return m.OldVerifierID(ctx)
+	case verificationsummary.FieldTimeVerified:
+		return m.OldTimeVerified(ctx)
+	case verificationsummary.FieldResourceURI:
+		return m.OldResourceURI(ctx)
+	case verificationsummary.FieldPolicyURI:
+		return m.OldPolicyURI(ctx)
+	case verificationsummary.FieldVerificationResult:
+		return m.OldVerificationResult(ctx)
+	case verificationsummary.FieldVerifiedLevels:
+		return m.OldVerifiedLevels(ctx)
+	case verificationsummary.FieldSlsaVersion:
+		return m.OldSlsaVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown VerificationSummary field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TimestampMutation) SetField(name string, value ent.Value) error {
+func (m *VerificationSummaryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case timestamp.FieldType:
+	case verificationsummary.FieldTenant:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetTenant(v)
 		return nil
-	case timestamp.FieldTimestamp:
+	case verificationsummary.FieldVerifierID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifierID(v)
+		return nil
+	case verificationsummary.FieldTimeVerified:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimestamp(v)
+		m.SetTimeVerified(v)
+		return nil
+	case verificationsummary.FieldResourceURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceURI(v)
+		return nil
+	case verificationsummary.FieldPolicyURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyURI(v)
+		return nil
+	case verificationsummary.FieldVerificationResult:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationResult(v)
+		return nil
+	case verificationsummary.FieldVerifiedLevels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifiedLevels(v)
+		return nil
+	case verificationsummary.FieldSlsaVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlsaVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Timestamp field %s", name)
+	return fmt.Errorf("unknown VerificationSummary field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TimestampMutation) AddedFields() []string {
+func (m *VerificationSummaryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TimestampMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VerificationSummaryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TimestampMutation) AddField(name string, value ent.Value) error {
+func (m *VerificationSummaryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Timestamp numeric field %s", name)
+	return fmt.Errorf("unknown VerificationSummary numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TimestampMutation) ClearedFields() []string {
-	return nil
+func (m *VerificationSummaryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(verificationsummary.FieldTimeVerified) {
+		fields = append(fields, verificationsummary.FieldTimeVerified)
+	}
+	if m.FieldCleared(verificationsummary.FieldPolicyURI) {
+		fields = append(fields, verificationsummary.FieldPolicyURI)
+	}
+	if m.FieldCleared(verificationsummary.FieldVerifiedLevels) {
+		fields = append(fields, verificationsummary.FieldVerifiedLevels)
+	}
+	if m.FieldCleared(verificationsummary.FieldSlsaVersion) {
+		fields = append(fields, verificationsummary.FieldSlsaVersion)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TimestampMutation) FieldCleared(name string) bool {
+func (m *VerificationSummaryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TimestampMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Timestamp nullable field %s", name)
+func (m *VerificationSummaryMutation) ClearField(name string) error {
+	switch name {
+	case verificationsummary.FieldTimeVerified:
+		m.ClearTimeVerified()
+		return nil
+	case verificationsummary.FieldPolicyURI:
+		m.ClearPolicyURI()
+		return nil
+	case verificationsummary.FieldVerifiedLevels:
+		m.ClearVerifiedLevels()
+		return nil
+	case verificationsummary.FieldSlsaVersion:
+		m.ClearSlsaVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown VerificationSummary nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TimestampMutation) ResetField(name string) error {
+func (m *VerificationSummaryMutation) ResetField(name string) error {
 	switch name {
-	case timestamp.FieldType:
-		m.ResetType()
+	case verificationsummary.FieldTenant:
+		m.ResetTenant()
 		return nil
-	case timestamp.FieldTimestamp:
-		m.ResetTimestamp()
+	case verificationsummary.FieldVerifierID:
+		m.ResetVerifierID()
+		return nil
+	case verificationsummary.FieldTimeVerified:
+		m.ResetTimeVerified()
+		return nil
+	case verificationsummary.FieldResourceURI:
+		m.ResetResourceURI()
+		return nil
+	case verificationsummary.FieldPolicyURI:
+		m.ResetPolicyURI()
+		return nil
+	case verificationsummary.FieldVerificationResult:
+		m.ResetVerificationResult()
+		return nil
+	case verificationsummary.FieldVerifiedLevels:
+		m.ResetVerifiedLevels()
+		return nil
+	case verificationsummary.FieldSlsaVersion:
+		m.ResetSlsaVersion()
 		return nil
 	}
-	return fmt.Errorf("unknown Timestamp field %s", name)
+	return fmt.Errorf("unknown VerificationSummary field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TimestampMutation) AddedEdges() []string {
+func (m *VerificationSummaryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.signature != nil {
-		edges = append(edges, timestamp.EdgeSignature)
+	if m.statement != nil {
+		edges = append(edges, verificationsummary.EdgeStatement)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TimestampMutation) AddedIDs(name string) []ent.Value {
+func (m *VerificationSummaryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case timestamp.EdgeSignature:
-		if id := m.signature; id != nil {
+	case verificationsummary.EdgeStatement:
+		if id := m.statement; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5056,54 +23033,54 @@ func (m *TimestampMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TimestampMutation) RemovedEdges() []string {
+func (m *VerificationSummaryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TimestampMutation) RemovedIDs(name string) []ent.Value {
+func (m *VerificationSummaryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TimestampMutation) ClearedEdges() []string {
+func (m *VerificationSummaryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedsignature {
-		edges = append(edges, timestamp.EdgeSignature)
+	if m.clearedstatement {
+		edges = append(edges, verificationsummary.EdgeStatement)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TimestampMutation) EdgeCleared(name string) bool {
+func (m *VerificationSummaryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case timestamp.EdgeSignature:
-		return m.clearedsignature
+	case verificationsummary.EdgeStatement:
+		return m.clearedstatement
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TimestampMutation) ClearEdge(name string) error {
+func (m *VerificationSummaryMutation) ClearEdge(name string) error {
 	switch name {
-	case timestamp.EdgeSignature:
-		m.ClearSignature()
+	case verificationsummary.EdgeStatement:
+		m.ClearStatement()
 		return nil
 	}
-	return fmt.Errorf("unknown Timestamp unique edge %s", name)
+	return fmt.Errorf("unknown VerificationSummary unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TimestampMutation) ResetEdge(name string) error {
+func (m *VerificationSummaryMutation) ResetEdge(name string) error {
 	switch name {
-	case timestamp.EdgeSignature:
-		m.ResetSignature()
+	case verificationsummary.EdgeStatement:
+		m.ResetStatement()
 		return nil
 	}
-	return fmt.Errorf("unknown Timestamp edge %s", name)
+	return fmt.Errorf("unknown VerificationSummary edge %s", name)
 }
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/ociattestation"
+)
+
+// OciAttestation is the model entity for the OciAttestation schema.
+type OciAttestation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// TarDigest holds the value of the "tar_digest" field.
+	TarDigest string `json:"tar_digest,omitempty"`
+	// ImageID holds the value of the "image_id" field.
+	ImageID string `json:"image_id,omitempty"`
+	// ManifestDigest holds the value of the "manifest_digest" field.
+	ManifestDigest string `json:"manifest_digest,omitempty"`
+	// ImageTags holds the value of the "image_tags" field.
+	ImageTags []string `json:"image_tags,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OciAttestationQuery when eager-loading is set.
+	Edges                       OciAttestationEdges `json:"edges"`
+	attestation_oci_attestation *uuid.UUID
+	selectValues                sql.SelectValues
+}
+
+// OciAttestationEdges holds the relations/edges for other nodes in the graph.
+type OciAttestationEdges struct {
+	// Attestation holds the value of the attestation edge.
+	Attestation *Attestation `json:"attestation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// AttestationOrErr returns the Attestation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OciAttestationEdges) AttestationOrErr() (*Attestation, error) {
+	if e.Attestation != nil {
+		return e.Attestation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attestation.Label}
+	}
+	return nil, &NotLoadedError{edge: "attestation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OciAttestation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ociattestation.FieldImageTags:
+			values[i] = new([]byte)
+		case ociattestation.FieldTenant, ociattestation.FieldTarDigest, ociattestation.FieldImageID, ociattestation.FieldManifestDigest:
+			values[i] = new(sql.NullString)
+		case ociattestation.FieldID:
+			values[i] = new(uuid.UUID)
+		case ociattestation.ForeignKeys[0]: // attestation_oci_attestation
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OciAttestation fields.
+func (_m *OciAttestation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ociattestation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ociattestation.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case ociattestation.FieldTarDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tar_digest", values[i])
+			} else if value.Valid {
+				_m.TarDigest = value.String
+			}
+		case ociattestation.FieldImageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image_id", values[i])
+			} else if value.Valid {
+				_m.ImageID = value.String
+			}
+		case ociattestation.FieldManifestDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field manifest_digest", values[i])
+			} else if value.Valid {
+				_m.ManifestDigest = value.String
+			}
+		case ociattestation.FieldImageTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field image_tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ImageTags); err != nil {
+					return fmt.Errorf("unmarshal field image_tags: %w", err)
+				}
+			}
+		case ociattestation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_oci_attestation", values[i])
+			} else if value.Valid {
+				_m.attestation_oci_attestation = new(uuid.UUID)
+				*_m.attestation_oci_attestation = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OciAttestation.
+// This includes values selected through modifiers, order, etc.
+func (_m *OciAttestation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttestation queries the "attestation" edge of the OciAttestation entity.
+func (_m *OciAttestation) QueryAttestation() *AttestationQuery {
+	return NewOciAttestationClient(_m.config).QueryAttestation(_m)
+}
+
+// Update returns a builder for updating this OciAttestation.
+// Note that you need to call OciAttestation.Unwrap() before calling this method if this OciAttestation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OciAttestation) Update() *OciAttestationUpdateOne {
+	return NewOciAttestationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OciAttestation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OciAttestation) Unwrap() *OciAttestation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OciAttestation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OciAttestation) String() string {
+	var builder strings.Builder
+	builder.WriteString("OciAttestation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("tar_digest=")
+	builder.WriteString(_m.TarDigest)
+	builder.WriteString(", ")
+	builder.WriteString("image_id=")
+	builder.WriteString(_m.ImageID)
+	builder.WriteString(", ")
+	builder.WriteString("manifest_digest=")
+	builder.WriteString(_m.ManifestDigest)
+	builder.WriteString(", ")
+	builder.WriteString("image_tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImageTags))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OciAttestations is a parsable slice of OciAttestation.
+type OciAttestations []*OciAttestation
@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ociattestation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// TarDigest applies equality check predicate on the "tar_digest" field. It's identical to TarDigestEQ.
+func TarDigest(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldTarDigest, v))
+}
+
+// ImageID applies equality check predicate on the "image_id" field. It's identical to ImageIDEQ.
+func ImageID(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldImageID, v))
+}
+
+// ManifestDigest applies equality check predicate on the "manifest_digest" field. It's identical to ManifestDigestEQ.
+func ManifestDigest(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldManifestDigest, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// TarDigestEQ applies the EQ predicate on the "tar_digest" field.
+func TarDigestEQ(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldTarDigest, v))
+}
+
+// TarDigestNEQ applies the NEQ predicate on the "tar_digest" field.
+func TarDigestNEQ(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNEQ(FieldTarDigest, v))
+}
+
+// TarDigestIn applies the In predicate on the "tar_digest" field.
+func TarDigestIn(vs ...string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldIn(FieldTarDigest, vs...))
+}
+
+// TarDigestNotIn applies the NotIn predicate on the "tar_digest" field.
+func TarDigestNotIn(vs ...string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNotIn(FieldTarDigest, vs...))
+}
+
+// TarDigestGT applies the GT predicate on the "tar_digest" field.
+func TarDigestGT(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGT(FieldTarDigest, v))
+}
+
+// TarDigestGTE applies the GTE predicate on the "tar_digest" field.
+func TarDigestGTE(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGTE(FieldTarDigest, v))
+}
+
+// TarDigestLT applies the LT predicate on the "tar_digest" field.
+func TarDigestLT(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLT(FieldTarDigest, v))
+}
+
+// TarDigestLTE applies the LTE predicate on the "tar_digest" field.
+func TarDigestLTE(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLTE(FieldTarDigest, v))
+}
+
+// TarDigestContains applies the Contains predicate on the "tar_digest" field.
+func TarDigestContains(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldContains(FieldTarDigest, v))
+}
+
+// TarDigestHasPrefix applies the HasPrefix predicate on the "tar_digest" field.
+func TarDigestHasPrefix(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldHasPrefix(FieldTarDigest, v))
+}
+
+// TarDigestHasSuffix applies the HasSuffix predicate on the "tar_digest" field.
+func TarDigestHasSuffix(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldHasSuffix(FieldTarDigest, v))
+}
+
+// TarDigestIsNil applies the IsNil predicate on the "tar_digest" field.
+func TarDigestIsNil() predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldIsNull(FieldTarDigest))
+}
+
+// TarDigestNotNil applies the NotNil predicate on the "tar_digest" field.
+func TarDigestNotNil() predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNotNull(FieldTarDigest))
+}
+
+// TarDigestEqualFold applies the EqualFold predicate on the "tar_digest" field.
+func TarDigestEqualFold(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEqualFold(FieldTarDigest, v))
+}
+
+// TarDigestContainsFold applies the ContainsFold predicate on the "tar_digest" field.
+func TarDigestContainsFold(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldContainsFold(FieldTarDigest, v))
+}
+
+// ImageIDEQ applies the EQ predicate on the "image_id" field.
+func ImageIDEQ(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldImageID, v))
+}
+
+// ImageIDNEQ applies the NEQ predicate on the "image_id" field.
+func ImageIDNEQ(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNEQ(FieldImageID, v))
+}
+
+// ImageIDIn applies the In predicate on the "image_id" field.
+func ImageIDIn(vs ...string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldIn(FieldImageID, vs...))
+}
+
+// ImageIDNotIn applies the NotIn predicate on the "image_id" field.
+func ImageIDNotIn(vs ...string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNotIn(FieldImageID, vs...))
+}
+
+// ImageIDGT applies the GT predicate on the "image_id" field.
+func ImageIDGT(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGT(FieldImageID, v))
+}
+
+// ImageIDGTE applies the GTE predicate on the "image_id" field.
+func ImageIDGTE(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGTE(FieldImageID, v))
+}
+
+// ImageIDLT applies the LT predicate on the "image_id" field.
+func ImageIDLT(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLT(FieldImageID, v))
+}
+
+// ImageIDLTE applies the LTE predicate on the "image_id" field.
+func ImageIDLTE(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLTE(FieldImageID, v))
+}
+
+// ImageIDContains applies the Contains predicate on the "image_id" field.
+func ImageIDContains(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldContains(FieldImageID, v))
+}
+
+// ImageIDHasPrefix applies the HasPrefix predicate on the "image_id" field.
+func ImageIDHasPrefix(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldHasPrefix(FieldImageID, v))
+}
+
+// ImageIDHasSuffix applies the HasSuffix predicate on the "image_id" field.
+func ImageIDHasSuffix(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldHasSuffix(FieldImageID, v))
+}
+
+// ImageIDIsNil applies the IsNil predicate on the "image_id" field.
+func ImageIDIsNil() predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldIsNull(FieldImageID))
+}
+
+// ImageIDNotNil applies the NotNil predicate on the "image_id" field.
+func ImageIDNotNil() predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNotNull(FieldImageID))
+}
+
+// ImageIDEqualFold applies the EqualFold predicate on the "image_id" field.
+func ImageIDEqualFold(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEqualFold(FieldImageID, v))
+}
+
+// ImageIDContainsFold applies the ContainsFold predicate on the "image_id" field.
+func ImageIDContainsFold(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldContainsFold(FieldImageID, v))
+}
+
+// ManifestDigestEQ applies the EQ predicate on the "manifest_digest" field.
+func ManifestDigestEQ(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEQ(FieldManifestDigest, v))
+}
+
+// ManifestDigestNEQ applies the NEQ predicate on the "manifest_digest" field.
+func ManifestDigestNEQ(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNEQ(FieldManifestDigest, v))
+}
+
+// ManifestDigestIn applies the In predicate on the "manifest_digest" field.
+func ManifestDigestIn(vs ...string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldIn(FieldManifestDigest, vs...))
+}
+
+// ManifestDigestNotIn applies the NotIn predicate on the "manifest_digest" field.
+func ManifestDigestNotIn(vs ...string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNotIn(FieldManifestDigest, vs...))
+}
+
+// ManifestDigestGT applies the GT predicate on the "manifest_digest" field.
+func ManifestDigestGT(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGT(FieldManifestDigest, v))
+}
+
+// ManifestDigestGTE applies the GTE predicate on the "manifest_digest" field.
+func ManifestDigestGTE(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldGTE(FieldManifestDigest, v))
+}
+
+// ManifestDigestLT applies the LT predicate on the "manifest_digest" field.
+func ManifestDigestLT(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLT(FieldManifestDigest, v))
+}
+
+// ManifestDigestLTE applies the LTE predicate on the "manifest_digest" field.
+func ManifestDigestLTE(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldLTE(FieldManifestDigest, v))
+}
+
+// ManifestDigestContains applies the Contains predicate on the "manifest_digest" field.
+func ManifestDigestContains(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldContains(FieldManifestDigest, v))
+}
+
+// ManifestDigestHasPrefix applies the HasPrefix predicate on the "manifest_digest" field.
+func ManifestDigestHasPrefix(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldHasPrefix(FieldManifestDigest, v))
+}
+
+// ManifestDigestHasSuffix applies the HasSuffix predicate on the "manifest_digest" field.
+func ManifestDigestHasSuffix(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldHasSuffix(FieldManifestDigest, v))
+}
+
+// ManifestDigestIsNil applies the IsNil predicate on the "manifest_digest" field.
+func ManifestDigestIsNil() predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldIsNull(FieldManifestDigest))
+}
+
+// ManifestDigestNotNil applies the NotNil predicate on the "manifest_digest" field.
+func ManifestDigestNotNil() predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNotNull(FieldManifestDigest))
+}
+
+// ManifestDigestEqualFold applies the EqualFold predicate on the "manifest_digest" field.
+func ManifestDigestEqualFold(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldEqualFold(FieldManifestDigest, v))
+}
+
+// ManifestDigestContainsFold applies the ContainsFold predicate on the "manifest_digest" field.
+func ManifestDigestContainsFold(v string) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldContainsFold(FieldManifestDigest, v))
+}
+
+// ImageTagsIsNil applies the IsNil predicate on the "image_tags" field.
+func ImageTagsIsNil() predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldIsNull(FieldImageTags))
+}
+
+// ImageTagsNotNil applies the NotNil predicate on the "image_tags" field.
+func ImageTagsNotNil() predicate.OciAttestation {
+	return predicate.OciAttestation(sql.FieldNotNull(FieldImageTags))
+}
+
+// HasAttestation applies the HasEdge predicate on the "attestation" edge.
+func HasAttestation() predicate.OciAttestation {
+	return predicate.OciAttestation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AttestationTable, AttestationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttestationWith applies the HasEdge predicate on the "attestation" edge with a given conditions (other predicates).
+func HasAttestationWith(preds ...predicate.Attestation) predicate.OciAttestation {
+	return predicate.OciAttestation(func(s *sql.Selector) {
+		step := newAttestationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OciAttestation) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OciAttestation) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OciAttestation) predicate.OciAttestation {
+	return predicate.OciAttestation(sql.NotPredicates(p))
+}
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/ociattestation"
+)
+
+// OciAttestationCreate is the builder for creating a OciAttestation entity.
+type OciAttestationCreate struct {
+	config
+	mutation *OciAttestationMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *OciAttestationCreate) SetTenant(v string) *OciAttestationCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *OciAttestationCreate) SetNillableTenant(v *string) *OciAttestationCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetTarDigest sets the "tar_digest" field.
+func (_c *OciAttestationCreate) SetTarDigest(v string) *OciAttestationCreate {
+	_c.mutation.SetTarDigest(v)
+	return _c
+}
+
+// SetNillableTarDigest sets the "tar_digest" field if the given value is not nil.
+func (_c *OciAttestationCreate) SetNillableTarDigest(v *string) *OciAttestationCreate {
+	if v != nil {
+		_c.SetTarDigest(*v)
+	}
+	return _c
+}
+
+// SetImageID sets the "image_id" field.
+func (_c *OciAttestationCreate) SetImageID(v string) *OciAttestationCreate {
+	_c.mutation.SetImageID(v)
+	return _c
+}
+
+// SetNillableImageID sets the "image_id" field if the given value is not nil.
+func (_c *OciAttestationCreate) SetNillableImageID(v *string) *OciAttestationCreate {
+	if v != nil {
+		_c.SetImageID(*v)
+	}
+	return _c
+}
+
+// SetManifestDigest sets the "manifest_digest" field.
+func (_c *OciAttestationCreate) SetManifestDigest(v string) *OciAttestationCreate {
+	_c.mutation.SetManifestDigest(v)
+	return _c
+}
+
+// SetNillableManifestDigest sets the "manifest_digest" field if the given value is not nil.
+func (_c *OciAttestationCreate) SetNillableManifestDigest(v *string) *OciAttestationCreate {
+	if v != nil {
+		_c.SetManifestDigest(*v)
+	}
+	return _c
+}
+
+// SetImageTags sets the "image_tags" field.
+func (_c *OciAttestationCreate) SetImageTags(v []string) *OciAttestationCreate {
+	_c.mutation.SetImageTags(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OciAttestationCreate) SetID(v uuid.UUID) *OciAttestationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OciAttestationCreate) SetNillableID(v *uuid.UUID) *OciAttestationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_c *OciAttestationCreate) SetAttestationID(id uuid.UUID) *OciAttestationCreate {
+	_c.mutation.SetAttestationID(id)
+	return _c
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_c *OciAttestationCreate) SetAttestation(v *Attestation) *OciAttestationCreate {
+	return _c.SetAttestationID(v.ID)
+}
+
+// Mutation returns the OciAttestationMutation object of the builder.
+func (_c *OciAttestationCreate) Mutation() *OciAttestationMutation {
+	return _c.mutation
+}
+
+// Save creates the OciAttestation in the database.
+func (_c *OciAttestationCreate) Save(ctx context.Context) (*OciAttestation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OciAttestationCreate) SaveX(ctx context.Context) *OciAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OciAttestationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OciAttestationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OciAttestationCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := ociattestation.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if ociattestation.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized ociattestation.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := ociattestation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OciAttestationCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "OciAttestation.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := ociattestation.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "OciAttestation.tenant": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AttestationIDs()) == 0 {
+		return &ValidationError{Name: "attestation", err: errors.New(`ent: missing required edge "OciAttestation.attestation"`)}
+	}
+	return nil
+}
+
+func (_c *OciAttestationCreate) sqlSave(ctx context.Context) (*OciAttestation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OciAttestationCreate) createSpec() (*OciAttestation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OciAttestation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ociattestation.Table, sqlgraph.NewFieldSpec(ociattestation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(ociattestation.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.TarDigest(); ok {
+		_spec.SetField(ociattestation.FieldTarDigest, field.TypeString, value)
+		_node.TarDigest = value
+	}
+	if value, ok := _c.mutation.ImageID(); ok {
+		_spec.SetField(ociattestation.FieldImageID, field.TypeString, value)
+		_node.ImageID = value
+	}
+	if value, ok := _c.mutation.ManifestDigest(); ok {
+		_spec.SetField(ociattestation.FieldManifestDigest, field.TypeString, value)
+		_node.ManifestDigest = value
+	}
+	if value, ok := _c.mutation.ImageTags(); ok {
+		_spec.SetField(ociattestation.FieldImageTags, field.TypeJSON, value)
+		_node.ImageTags = value
+	}
+	if nodes := _c.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ociattestation.AttestationTable,
+			Columns: []string{ociattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.attestation_oci_attestation = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OciAttestationCreateBulk is the builder for creating many OciAttestation entities in bulk.
+type OciAttestationCreateBulk struct {
+	config
+	err      error
+	builders []*OciAttestationCreate
+}
+
+// Save creates the OciAttestation entities in the database.
+func (_c *OciAttestationCreateBulk) Save(ctx context.Context) ([]*OciAttestation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OciAttestation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OciAttestationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OciAttestationCreateBulk) SaveX(ctx context.Context) []*OciAttestation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OciAttestationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OciAttestationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
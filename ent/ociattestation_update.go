@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/ociattestation"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// OciAttestationUpdate is the builder for updating OciAttestation entities.
+type OciAttestationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OciAttestationMutation
+}
+
+// Where appends a list predicates to the OciAttestationUpdate builder.
+func (_u *OciAttestationUpdate) Where(ps ...predicate.OciAttestation) *OciAttestationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTarDigest sets the "tar_digest" field.
+func (_u *OciAttestationUpdate) SetTarDigest(v string) *OciAttestationUpdate {
+	_u.mutation.SetTarDigest(v)
+	return _u
+}
+
+// SetNillableTarDigest sets the "tar_digest" field if the given value is not nil.
+func (_u *OciAttestationUpdate) SetNillableTarDigest(v *string) *OciAttestationUpdate {
+	if v != nil {
+		_u.SetTarDigest(*v)
+	}
+	return _u
+}
+
+// ClearTarDigest clears the value of the "tar_digest" field.
+func (_u *OciAttestationUpdate) ClearTarDigest() *OciAttestationUpdate {
+	_u.mutation.ClearTarDigest()
+	return _u
+}
+
+// SetImageID sets the "image_id" field.
+func (_u *OciAttestationUpdate) SetImageID(v string) *OciAttestationUpdate {
+	_u.mutation.SetImageID(v)
+	return _u
+}
+
+// SetNillableImageID sets the "image_id" field if the given value is not nil.
+func (_u *OciAttestationUpdate) SetNillableImageID(v *string) *OciAttestationUpdate {
+	if v != nil {
+		_u.SetImageID(*v)
+	}
+	return _u
+}
+
+// ClearImageID clears the value of the "image_id" field.
+func (_u *OciAttestationUpdate) ClearImageID() *OciAttestationUpdate {
+	_u.mutation.ClearImageID()
+	return _u
+}
+
+// SetManifestDigest sets the "manifest_digest" field.
+func (_u *OciAttestationUpdate) SetManifestDigest(v string) *OciAttestationUpdate {
+	_u.mutation.SetManifestDigest(v)
+	return _u
+}
+
+// SetNillableManifestDigest sets the "manifest_digest" field if the given value is not nil.
+func (_u *OciAttestationUpdate) SetNillableManifestDigest(v *string) *OciAttestationUpdate {
+	if v != nil {
+		_u.SetManifestDigest(*v)
+	}
+	return _u
+}
+
+// ClearManifestDigest clears the value of the "manifest_digest" field.
+func (_u *OciAttestationUpdate) ClearManifestDigest() *OciAttestationUpdate {
+	_u.mutation.ClearManifestDigest()
+	return _u
+}
+
+// SetImageTags sets the "image_tags" field.
+func (_u *OciAttestationUpdate) SetImageTags(v []string) *OciAttestationUpdate {
+	_u.mutation.SetImageTags(v)
+	return _u
+}
+
+// AppendImageTags appends value to the "image_tags" field.
+func (_u *OciAttestationUpdate) AppendImageTags(v []string) *OciAttestationUpdate {
+	_u.mutation.AppendImageTags(v)
+	return _u
+}
+
+// ClearImageTags clears the value of the "image_tags" field.
+func (_u *OciAttestationUpdate) ClearImageTags() *OciAttestationUpdate {
+	_u.mutation.ClearImageTags()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *OciAttestationUpdate) SetAttestationID(id uuid.UUID) *OciAttestationUpdate {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *OciAttestationUpdate) SetAttestation(v *Attestation) *OciAttestationUpdate {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the OciAttestationMutation object of the builder.
+func (_u *OciAttestationUpdate) Mutation() *OciAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *OciAttestationUpdate) ClearAttestation() *OciAttestationUpdate {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OciAttestationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OciAttestationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OciAttestationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OciAttestationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OciAttestationUpdate) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OciAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *OciAttestationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ociattestation.Table, ociattestation.Columns, sqlgraph.NewFieldSpec(ociattestation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TarDigest(); ok {
+		_spec.SetField(ociattestation.FieldTarDigest, field.TypeString, value)
+	}
+	if _u.mutation.TarDigestCleared() {
+		_spec.ClearField(ociattestation.FieldTarDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageID(); ok {
+		_spec.SetField(ociattestation.FieldImageID, field.TypeString, value)
+	}
+	if _u.mutation.ImageIDCleared() {
+		_spec.ClearField(ociattestation.FieldImageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ManifestDigest(); ok {
+		_spec.SetField(ociattestation.FieldManifestDigest, field.TypeString, value)
+	}
+	if _u.mutation.ManifestDigestCleared() {
+		_spec.ClearField(ociattestation.FieldManifestDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageTags(); ok {
+		_spec.SetField(ociattestation.FieldImageTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ociattestation.FieldImageTags, value)
+		})
+	}
+	if _u.mutation.ImageTagsCleared() {
+		_spec.ClearField(ociattestation.FieldImageTags, field.TypeJSON)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ociattestation.AttestationTable,
+			Columns: []string{ociattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ociattestation.AttestationTable,
+			Columns: []string{ociattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ociattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OciAttestationUpdateOne is the builder for updating a single OciAttestation entity.
+type OciAttestationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OciAttestationMutation
+}
+
+// SetTarDigest sets the "tar_digest" field.
+func (_u *OciAttestationUpdateOne) SetTarDigest(v string) *OciAttestationUpdateOne {
+	_u.mutation.SetTarDigest(v)
+	return _u
+}
+
+// SetNillableTarDigest sets the "tar_digest" field if the given value is not nil.
+func (_u *OciAttestationUpdateOne) SetNillableTarDigest(v *string) *OciAttestationUpdateOne {
+	if v != nil {
+		_u.SetTarDigest(*v)
+	}
+	return _u
+}
+
+// ClearTarDigest clears the value of the "tar_digest" field.
+func (_u *OciAttestationUpdateOne) ClearTarDigest() *OciAttestationUpdateOne {
+	_u.mutation.ClearTarDigest()
+	return _u
+}
+
+// SetImageID sets the "image_id" field.
+func (_u *OciAttestationUpdateOne) SetImageID(v string) *OciAttestationUpdateOne {
+	_u.mutation.SetImageID(v)
+	return _u
+}
+
+// SetNillableImageID sets the "image_id" field if the given value is not nil.
+func (_u *OciAttestationUpdateOne) SetNillableImageID(v *string) *OciAttestationUpdateOne {
+	if v != nil {
+		_u.SetImageID(*v)
+	}
+	return _u
+}
+
+// ClearImageID clears the value of the "image_id" field.
+func (_u *OciAttestationUpdateOne) ClearImageID() *OciAttestationUpdateOne {
+	_u.mutation.ClearImageID()
+	return _u
+}
+
+// SetManifestDigest sets the "manifest_digest" field.
+func (_u *OciAttestationUpdateOne) SetManifestDigest(v string) *OciAttestationUpdateOne {
+	_u.mutation.SetManifestDigest(v)
+	return _u
+}
+
+// SetNillableManifestDigest sets the "manifest_digest" field if the given value is not nil.
+func (_u *OciAttestationUpdateOne) SetNillableManifestDigest(v *string) *OciAttestationUpdateOne {
+	if v != nil {
+		_u.SetManifestDigest(*v)
+	}
+	return _u
+}
+
+// ClearManifestDigest clears the value of the "manifest_digest" field.
+func (_u *OciAttestationUpdateOne) ClearManifestDigest() *OciAttestationUpdateOne {
+	_u.mutation.ClearManifestDigest()
+	return _u
+}
+
+// SetImageTags sets the "image_tags" field.
+func (_u *OciAttestationUpdateOne) SetImageTags(v []string) *OciAttestationUpdateOne {
+	_u.mutation.SetImageTags(v)
+	return _u
+}
+
+// AppendImageTags appends value to the "image_tags" field.
+func (_u *OciAttestationUpdateOne) AppendImageTags(v []string) *OciAttestationUpdateOne {
+	_u.mutation.AppendImageTags(v)
+	return _u
+}
+
+// ClearImageTags clears the value of the "image_tags" field.
+func (_u *OciAttestationUpdateOne) ClearImageTags() *OciAttestationUpdateOne {
+	_u.mutation.ClearImageTags()
+	return _u
+}
+
+// SetAttestationID sets the "attestation" edge to the Attestation entity by ID.
+func (_u *OciAttestationUpdateOne) SetAttestationID(id uuid.UUID) *OciAttestationUpdateOne {
+	_u.mutation.SetAttestationID(id)
+	return _u
+}
+
+// SetAttestation sets the "attestation" edge to the Attestation entity.
+func (_u *OciAttestationUpdateOne) SetAttestation(v *Attestation) *OciAttestationUpdateOne {
+	return _u.SetAttestationID(v.ID)
+}
+
+// Mutation returns the OciAttestationMutation object of the builder.
+func (_u *OciAttestationUpdateOne) Mutation() *OciAttestationMutation {
+	return _u.mutation
+}
+
+// ClearAttestation clears the "attestation" edge to the Attestation entity.
+func (_u *OciAttestationUpdateOne) ClearAttestation() *OciAttestationUpdateOne {
+	_u.mutation.ClearAttestation()
+	return _u
+}
+
+// Where appends a list predicates to the OciAttestationUpdate builder.
+func (_u *OciAttestationUpdateOne) Where(ps ...predicate.OciAttestation) *OciAttestationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OciAttestationUpdateOne) Select(field string, fields ...string) *OciAttestationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OciAttestation entity.
+func (_u *OciAttestationUpdateOne) Save(ctx context.Context) (*OciAttestation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OciAttestationUpdateOne) SaveX(ctx context.Context) *OciAttestation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OciAttestationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OciAttestationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OciAttestationUpdateOne) check() error {
+	if _u.mutation.AttestationCleared() && len(_u.mutation.AttestationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OciAttestation.attestation"`)
+	}
+	return nil
+}
+
+func (_u *OciAttestationUpdateOne) sqlSave(ctx context.Context) (_node *OciAttestation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ociattestation.Table, ociattestation.Columns, sqlgraph.NewFieldSpec(ociattestation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OciAttestation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ociattestation.FieldID)
+		for _, f := range fields {
+			if !ociattestation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ociattestation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TarDigest(); ok {
+		_spec.SetField(ociattestation.FieldTarDigest, field.TypeString, value)
+	}
+	if _u.mutation.TarDigestCleared() {
+		_spec.ClearField(ociattestation.FieldTarDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageID(); ok {
+		_spec.SetField(ociattestation.FieldImageID, field.TypeString, value)
+	}
+	if _u.mutation.ImageIDCleared() {
+		_spec.ClearField(ociattestation.FieldImageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ManifestDigest(); ok {
+		_spec.SetField(ociattestation.FieldManifestDigest, field.TypeString, value)
+	}
+	if _u.mutation.ManifestDigestCleared() {
+		_spec.ClearField(ociattestation.FieldManifestDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageTags(); ok {
+		_spec.SetField(ociattestation.FieldImageTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ociattestation.FieldImageTags, value)
+		})
+	}
+	if _u.mutation.ImageTagsCleared() {
+		_spec.ClearField(ociattestation.FieldImageTags, field.TypeJSON)
+	}
+	if _u.mutation.AttestationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ociattestation.AttestationTable,
+			Columns: []string{ociattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttestationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ociattestation.AttestationTable,
+			Columns: []string{ociattestation.AttestationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attestation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OciAttestation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ociattestation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,20 @@ type PayloadDigestCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *PayloadDigestCreate) SetTenant(v string) *PayloadDigestCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *PayloadDigestCreate) SetNillableTenant(v *string) *PayloadDigestCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetAlgorithm sets the "algorithm" field.
 func (_c *PayloadDigestCreate) SetAlgorithm(v string) *PayloadDigestCreate {
 	_c.mutation.SetAlgorithm(v)
@@ -73,7 +87,9 @@ func (_c *PayloadDigestCreate) Mutation() *PayloadDigestMutation {
 
 // Save creates the PayloadDigest in the database.
 func (_c *PayloadDigestCreate) Save(ctx context.Context) (*PayloadDigest, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -100,15 +116,31 @@ func (_c *PayloadDigestCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *PayloadDigestCreate) defaults() {
+func (_c *PayloadDigestCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := payloaddigest.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if payloaddigest.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized payloaddigest.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := payloaddigest.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *PayloadDigestCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "PayloadDigest.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := payloaddigest.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "PayloadDigest.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Algorithm(); !ok {
 		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "PayloadDigest.algorithm"`)}
 	}
@@ -160,6 +192,10 @@ func (_c *PayloadDigestCreate) createSpec() (*PayloadDigest, *sqlgraph.CreateSpe
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(payloaddigest.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.Algorithm(); ok {
 		_spec.SetField(payloaddigest.FieldAlgorithm, field.TypeString, value)
 		_node.Algorithm = value
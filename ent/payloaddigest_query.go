@@ -302,12 +302,12 @@ func (_q *PayloadDigestQuery) WithDsse(opts ...func(*DsseQuery)) *PayloadDigestQ
 // Example:
 //
 //	var v []struct {
-//		Algorithm string `json:"algorithm,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.PayloadDigest.Query().
-//		GroupBy(payloaddigest.FieldAlgorithm).
+//		GroupBy(payloaddigest.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *PayloadDigestQuery) GroupBy(field string, fields ...string) *PayloadDigestGroupBy {
@@ -325,11 +325,11 @@ func (_q *PayloadDigestQuery) GroupBy(field string, fields ...string) *PayloadDi
 // Example:
 //
 //	var v []struct {
-//		Algorithm string `json:"algorithm,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.PayloadDigest.Query().
-//		Select(payloaddigest.FieldAlgorithm).
+//		Select(payloaddigest.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *PayloadDigestQuery) Select(fields ...string) *PayloadDigestSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -15,23 +15,80 @@ type AttestationCollection func(*sql.Selector)
 // AttestationPolicy is the predicate function for attestationpolicy builders.
 type AttestationPolicy func(*sql.Selector)
 
+// Certificate is the predicate function for certificate builders.
+type Certificate func(*sql.Selector)
+
+// CommandRunAttestation is the predicate function for commandrunattestation builders.
+type CommandRunAttestation func(*sql.Selector)
+
 // Dsse is the predicate function for dsse builders.
 type Dsse func(*sql.Selector)
 
+// EnvironmentAttestation is the predicate function for environmentattestation builders.
+type EnvironmentAttestation func(*sql.Selector)
+
+// GitAttestation is the predicate function for gitattestation builders.
+type GitAttestation func(*sql.Selector)
+
+// GithubAttestation is the predicate function for githubattestation builders.
+type GithubAttestation func(*sql.Selector)
+
+// GitlabAttestation is the predicate function for gitlabattestation builders.
+type GitlabAttestation func(*sql.Selector)
+
+// LegalHold is the predicate function for legalhold builders.
+type LegalHold func(*sql.Selector)
+
+// Material is the predicate function for material builders.
+type Material func(*sql.Selector)
+
+// OciAttestation is the predicate function for ociattestation builders.
+type OciAttestation func(*sql.Selector)
+
 // PayloadDigest is the predicate function for payloaddigest builders.
 type PayloadDigest func(*sql.Selector)
 
+// Product is the predicate function for product builders.
+type Product func(*sql.Selector)
+
+// Publication is the predicate function for publication builders.
+type Publication func(*sql.Selector)
+
+// PublishDelivery is the predicate function for publishdelivery builders.
+type PublishDelivery func(*sql.Selector)
+
+// SbomPackage is the predicate function for sbompackage builders.
+type SbomPackage func(*sql.Selector)
+
+// SbomPackageDigest is the predicate function for sbompackagedigest builders.
+type SbomPackageDigest func(*sql.Selector)
+
 // Signature is the predicate function for signature builders.
 type Signature func(*sql.Selector)
 
+// SlsaDependency is the predicate function for slsadependency builders.
+type SlsaDependency func(*sql.Selector)
+
+// SlsaProvenance is the predicate function for slsaprovenance builders.
+type SlsaProvenance func(*sql.Selector)
+
 // Statement is the predicate function for statement builders.
 type Statement func(*sql.Selector)
 
 // Subject is the predicate function for subject builders.
 type Subject func(*sql.Selector)
 
+// SubjectAltName is the predicate function for subjectaltname builders.
+type SubjectAltName func(*sql.Selector)
+
 // SubjectDigest is the predicate function for subjectdigest builders.
 type SubjectDigest func(*sql.Selector)
 
 // Timestamp is the predicate function for timestamp builders.
 type Timestamp func(*sql.Selector)
+
+// TransparencyLogEntry is the predicate function for transparencylogentry builders.
+type TransparencyLogEntry func(*sql.Selector)
+
+// VerificationSummary is the predicate function for verificationsummary builders.
+type VerificationSummary func(*sql.Selector)
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/publication"
+)
+
+// Publication is the model entity for the Publication schema.
+type Publication struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// GitoidSha256 holds the value of the "gitoid_sha256" field.
+	GitoidSha256 string `json:"gitoid_sha256,omitempty"`
+	// Publisher holds the value of the "publisher" field.
+	Publisher string `json:"publisher,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status publication.Status `json:"status,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PublicationQuery when eager-loading is set.
+	Edges             PublicationEdges `json:"edges"`
+	dsse_publications *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// PublicationEdges holds the relations/edges for other nodes in the graph.
+type PublicationEdges struct {
+	// Dsse holds the value of the dsse edge.
+	Dsse *Dsse `json:"dsse,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// DsseOrErr returns the Dsse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PublicationEdges) DsseOrErr() (*Dsse, error) {
+	if e.Dsse != nil {
+		return e.Dsse, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: dsse.Label}
+	}
+	return nil, &NotLoadedError{edge: "dsse"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Publication) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case publication.FieldTenant, publication.FieldGitoidSha256, publication.FieldPublisher, publication.FieldTaskID, publication.FieldStatus, publication.FieldMessage:
+			values[i] = new(sql.NullString)
+		case publication.FieldCreatedAt, publication.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case publication.FieldID:
+			values[i] = new(uuid.UUID)
+		case publication.ForeignKeys[0]: // dsse_publications
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Publication fields.
+func (_m *Publication) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case publication.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case publication.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case publication.FieldGitoidSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gitoid_sha256", values[i])
+			} else if value.Valid {
+				_m.GitoidSha256 = value.String
+			}
+		case publication.FieldPublisher:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publisher", values[i])
+			} else if value.Valid {
+				_m.Publisher = value.String
+			}
+		case publication.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				_m.TaskID = value.String
+			}
+		case publication.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = publication.Status(value.String)
+			}
+		case publication.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case publication.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case publication.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case publication.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field dsse_publications", values[i])
+			} else if value.Valid {
+				_m.dsse_publications = new(uuid.UUID)
+				*_m.dsse_publications = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Publication.
+// This includes values selected through modifiers, order, etc.
+func (_m *Publication) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDsse queries the "dsse" edge of the Publication entity.
+func (_m *Publication) QueryDsse() *DsseQuery {
+	return NewPublicationClient(_m.config).QueryDsse(_m)
+}
+
+// Update returns a builder for updating this Publication.
+// Note that you need to call Publication.Unwrap() before calling this method if this Publication
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Publication) Update() *PublicationUpdateOne {
+	return NewPublicationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Publication entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Publication) Unwrap() *Publication {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Publication is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Publication) String() string {
+	var builder strings.Builder
+	builder.WriteString("Publication(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("gitoid_sha256=")
+	builder.WriteString(_m.GitoidSha256)
+	builder.WriteString(", ")
+	builder.WriteString("publisher=")
+	builder.WriteString(_m.Publisher)
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(_m.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Publications is a parsable slice of Publication.
+type Publications []*Publication
@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/publication"
+)
+
+// PublicationCreate is the builder for creating a Publication entity.
+type PublicationCreate struct {
+	config
+	mutation *PublicationMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *PublicationCreate) SetTenant(v string) *PublicationCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *PublicationCreate) SetNillableTenant(v *string) *PublicationCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetGitoidSha256 sets the "gitoid_sha256" field.
+func (_c *PublicationCreate) SetGitoidSha256(v string) *PublicationCreate {
+	_c.mutation.SetGitoidSha256(v)
+	return _c
+}
+
+// SetPublisher sets the "publisher" field.
+func (_c *PublicationCreate) SetPublisher(v string) *PublicationCreate {
+	_c.mutation.SetPublisher(v)
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *PublicationCreate) SetTaskID(v string) *PublicationCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PublicationCreate) SetStatus(v publication.Status) *PublicationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PublicationCreate) SetNillableStatus(v *publication.Status) *PublicationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *PublicationCreate) SetMessage(v string) *PublicationCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *PublicationCreate) SetNillableMessage(v *string) *PublicationCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PublicationCreate) SetCreatedAt(v time.Time) *PublicationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PublicationCreate) SetNillableCreatedAt(v *time.Time) *PublicationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *PublicationCreate) SetCompletedAt(v time.Time) *PublicationCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *PublicationCreate) SetNillableCompletedAt(v *time.Time) *PublicationCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PublicationCreate) SetID(v uuid.UUID) *PublicationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PublicationCreate) SetNillableID(v *uuid.UUID) *PublicationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by ID.
+func (_c *PublicationCreate) SetDsseID(id uuid.UUID) *PublicationCreate {
+	_c.mutation.SetDsseID(id)
+	return _c
+}
+
+// SetNillableDsseID sets the "dsse" edge to the Dsse entity by ID if the given value is not nil.
+func (_c *PublicationCreate) SetNillableDsseID(id *uuid.UUID) *PublicationCreate {
+	if id != nil {
+		_c = _c.SetDsseID(*id)
+	}
+	return _c
+}
+
+// SetDsse sets the "dsse" edge to the Dsse entity.
+func (_c *PublicationCreate) SetDsse(v *Dsse) *PublicationCreate {
+	return _c.SetDsseID(v.ID)
+}
+
+// Mutation returns the PublicationMutation object of the builder.
+func (_c *PublicationCreate) Mutation() *PublicationMutation {
+	return _c.mutation
+}
+
+// Save creates the Publication in the database.
+func (_c *PublicationCreate) Save(ctx context.Context) (*Publication, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PublicationCreate) SaveX(ctx context.Context) *Publication {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PublicationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PublicationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PublicationCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := publication.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := publication.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if publication.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized publication.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := publication.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if publication.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized publication.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := publication.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PublicationCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Publication.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := publication.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "Publication.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GitoidSha256(); !ok {
+		return &ValidationError{Name: "gitoid_sha256", err: errors.New(`ent: missing required field "Publication.gitoid_sha256"`)}
+	}
+	if v, ok := _c.mutation.GitoidSha256(); ok {
+		if err := publication.GitoidSha256Validator(v); err != nil {
+			return &ValidationError{Name: "gitoid_sha256", err: fmt.Errorf(`ent: validator failed for field "Publication.gitoid_sha256": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Publisher(); !ok {
+		return &ValidationError{Name: "publisher", err: errors.New(`ent: missing required field "Publication.publisher"`)}
+	}
+	if v, ok := _c.mutation.Publisher(); ok {
+		if err := publication.PublisherValidator(v); err != nil {
+			return &ValidationError{Name: "publisher", err: fmt.Errorf(`ent: validator failed for field "Publication.publisher": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "Publication.task_id"`)}
+	}
+	if v, ok := _c.mutation.TaskID(); ok {
+		if err := publication.TaskIDValidator(v); err != nil {
+			return &ValidationError{Name: "task_id", err: fmt.Errorf(`ent: validator failed for field "Publication.task_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Publication.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := publication.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Publication.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Publication.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PublicationCreate) sqlSave(ctx context.Context) (*Publication, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PublicationCreate) createSpec() (*Publication, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Publication{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(publication.Table, sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(publication.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.GitoidSha256(); ok {
+		_spec.SetField(publication.FieldGitoidSha256, field.TypeString, value)
+		_node.GitoidSha256 = value
+	}
+	if value, ok := _c.mutation.Publisher(); ok {
+		_spec.SetField(publication.FieldPublisher, field.TypeString, value)
+		_node.Publisher = value
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(publication.FieldTaskID, field.TypeString, value)
+		_node.TaskID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(publication.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(publication.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(publication.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(publication.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if nodes := _c.mutation.DsseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publication.DsseTable,
+			Columns: []string{publication.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.dsse_publications = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PublicationCreateBulk is the builder for creating many Publication entities in bulk.
+type PublicationCreateBulk struct {
+	config
+	err      error
+	builders []*PublicationCreate
+}
+
+// Save creates the Publication entities in the database.
+func (_c *PublicationCreateBulk) Save(ctx context.Context) ([]*Publication, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Publication, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PublicationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PublicationCreateBulk) SaveX(ctx context.Context) []*Publication {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PublicationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PublicationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
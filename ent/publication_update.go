@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/publication"
+)
+
+// PublicationUpdate is the builder for updating Publication entities.
+type PublicationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PublicationMutation
+}
+
+// Where appends a list predicates to the PublicationUpdate builder.
+func (_u *PublicationUpdate) Where(ps ...predicate.Publication) *PublicationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PublicationUpdate) SetStatus(v publication.Status) *PublicationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PublicationUpdate) SetNillableStatus(v *publication.Status) *PublicationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *PublicationUpdate) SetMessage(v string) *PublicationUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *PublicationUpdate) SetNillableMessage(v *string) *PublicationUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *PublicationUpdate) ClearMessage() *PublicationUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *PublicationUpdate) SetCompletedAt(v time.Time) *PublicationUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *PublicationUpdate) SetNillableCompletedAt(v *time.Time) *PublicationUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *PublicationUpdate) ClearCompletedAt() *PublicationUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by ID.
+func (_u *PublicationUpdate) SetDsseID(id uuid.UUID) *PublicationUpdate {
+	_u.mutation.SetDsseID(id)
+	return _u
+}
+
+// SetNillableDsseID sets the "dsse" edge to the Dsse entity by ID if the given value is not nil.
+func (_u *PublicationUpdate) SetNillableDsseID(id *uuid.UUID) *PublicationUpdate {
+	if id != nil {
+		_u = _u.SetDsseID(*id)
+	}
+	return _u
+}
+
+// SetDsse sets the "dsse" edge to the Dsse entity.
+func (_u *PublicationUpdate) SetDsse(v *Dsse) *PublicationUpdate {
+	return _u.SetDsseID(v.ID)
+}
+
+// Mutation returns the PublicationMutation object of the builder.
+func (_u *PublicationUpdate) Mutation() *PublicationMutation {
+	return _u.mutation
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (_u *PublicationUpdate) ClearDsse() *PublicationUpdate {
+	_u.mutation.ClearDsse()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PublicationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PublicationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PublicationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PublicationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PublicationUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := publication.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Publication.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PublicationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(publication.Table, publication.Columns, sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(publication.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(publication.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(publication.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(publication.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(publication.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.DsseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publication.DsseTable,
+			Columns: []string{publication.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DsseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publication.DsseTable,
+			Columns: []string{publication.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{publication.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PublicationUpdateOne is the builder for updating a single Publication entity.
+type PublicationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PublicationMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *PublicationUpdateOne) SetStatus(v publication.Status) *PublicationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PublicationUpdateOne) SetNillableStatus(v *publication.Status) *PublicationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *PublicationUpdateOne) SetMessage(v string) *PublicationUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *PublicationUpdateOne) SetNillableMessage(v *string) *PublicationUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *PublicationUpdateOne) ClearMessage() *PublicationUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *PublicationUpdateOne) SetCompletedAt(v time.Time) *PublicationUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *PublicationUpdateOne) SetNillableCompletedAt(v *time.Time) *PublicationUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *PublicationUpdateOne) ClearCompletedAt() *PublicationUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by ID.
+func (_u *PublicationUpdateOne) SetDsseID(id uuid.UUID) *PublicationUpdateOne {
+	_u.mutation.SetDsseID(id)
+	return _u
+}
+
+// SetNillableDsseID sets the "dsse" edge to the Dsse entity by ID if the given value is not nil.
+func (_u *PublicationUpdateOne) SetNillableDsseID(id *uuid.UUID) *PublicationUpdateOne {
+	if id != nil {
+		_u = _u.SetDsseID(*id)
+	}
+	return _u
+}
+
+// SetDsse sets the "dsse" edge to the Dsse entity.
+func (_u *PublicationUpdateOne) SetDsse(v *Dsse) *PublicationUpdateOne {
+	return _u.SetDsseID(v.ID)
+}
+
+// Mutation returns the PublicationMutation object of the builder.
+func (_u *PublicationUpdateOne) Mutation() *PublicationMutation {
+	return _u.mutation
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (_u *PublicationUpdateOne) ClearDsse() *PublicationUpdateOne {
+	_u.mutation.ClearDsse()
+	return _u
+}
+
+// Where appends a list predicates to the PublicationUpdate builder.
+func (_u *PublicationUpdateOne) Where(ps ...predicate.Publication) *PublicationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PublicationUpdateOne) Select(field string, fields ...string) *PublicationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Publication entity.
+func (_u *PublicationUpdateOne) Save(ctx context.Context) (*Publication, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PublicationUpdateOne) SaveX(ctx context.Context) *Publication {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PublicationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PublicationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PublicationUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := publication.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Publication.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PublicationUpdateOne) sqlSave(ctx context.Context) (_node *Publication, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(publication.Table, publication.Columns, sqlgraph.NewFieldSpec(publication.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Publication.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, publication.FieldID)
+		for _, f := range fields {
+			if !publication.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != publication.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(publication.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(publication.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(publication.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(publication.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(publication.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.DsseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publication.DsseTable,
+			Columns: []string{publication.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DsseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publication.DsseTable,
+			Columns: []string{publication.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Publication{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{publication.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+)
+
+// PublishDelivery is the model entity for the PublishDelivery schema.
+type PublishDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// GitoidSha256 holds the value of the "gitoid_sha256" field.
+	GitoidSha256 string `json:"gitoid_sha256,omitempty"`
+	// Publisher holds the value of the "publisher" field.
+	Publisher string `json:"publisher,omitempty"`
+	// Status holds the value of the "status" field.
+	Status publishdelivery.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PublishDeliveryQuery when eager-loading is set.
+	Edges                   PublishDeliveryEdges `json:"edges"`
+	dsse_publish_deliveries *uuid.UUID
+	selectValues            sql.SelectValues
+}
+
+// PublishDeliveryEdges holds the relations/edges for other nodes in the graph.
+type PublishDeliveryEdges struct {
+	// Dsse holds the value of the dsse edge.
+	Dsse *Dsse `json:"dsse,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// DsseOrErr returns the Dsse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PublishDeliveryEdges) DsseOrErr() (*Dsse, error) {
+	if e.Dsse != nil {
+		return e.Dsse, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: dsse.Label}
+	}
+	return nil, &NotLoadedError{edge: "dsse"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PublishDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case publishdelivery.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case publishdelivery.FieldTenant, publishdelivery.FieldGitoidSha256, publishdelivery.FieldPublisher, publishdelivery.FieldStatus, publishdelivery.FieldLastError:
+			values[i] = new(sql.NullString)
+		case publishdelivery.FieldNextAttemptAt, publishdelivery.FieldCreatedAt, publishdelivery.FieldDeliveredAt:
+			values[i] = new(sql.NullTime)
+		case publishdelivery.FieldID:
+			values[i] = new(uuid.UUID)
+		case publishdelivery.ForeignKeys[0]: // dsse_publish_deliveries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PublishDelivery fields.
+func (_m *PublishDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case publishdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case publishdelivery.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case publishdelivery.FieldGitoidSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gitoid_sha256", values[i])
+			} else if value.Valid {
+				_m.GitoidSha256 = value.String
+			}
+		case publishdelivery.FieldPublisher:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publisher", values[i])
+			} else if value.Valid {
+				_m.Publisher = value.String
+			}
+		case publishdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = publishdelivery.Status(value.String)
+			}
+		case publishdelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case publishdelivery.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = value.Time
+			}
+		case publishdelivery.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case publishdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case publishdelivery.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				_m.DeliveredAt = new(time.Time)
+				*_m.DeliveredAt = value.Time
+			}
+		case publishdelivery.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field dsse_publish_deliveries", values[i])
+			} else if value.Valid {
+				_m.dsse_publish_deliveries = new(uuid.UUID)
+				*_m.dsse_publish_deliveries = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PublishDelivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *PublishDelivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDsse queries the "dsse" edge of the PublishDelivery entity.
+func (_m *PublishDelivery) QueryDsse() *DsseQuery {
+	return NewPublishDeliveryClient(_m.config).QueryDsse(_m)
+}
+
+// Update returns a builder for updating this PublishDelivery.
+// Note that you need to call PublishDelivery.Unwrap() before calling this method if this PublishDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PublishDelivery) Update() *PublishDeliveryUpdateOne {
+	return NewPublishDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PublishDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PublishDelivery) Unwrap() *PublishDelivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PublishDelivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PublishDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("PublishDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("gitoid_sha256=")
+	builder.WriteString(_m.GitoidSha256)
+	builder.WriteString(", ")
+	builder.WriteString("publisher=")
+	builder.WriteString(_m.Publisher)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(_m.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PublishDeliveries is a parsable slice of PublishDelivery.
+type PublishDeliveries []*PublishDelivery
@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package publishdelivery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldTenant, v))
+}
+
+// GitoidSha256 applies equality check predicate on the "gitoid_sha256" field. It's identical to GitoidSha256EQ.
+func GitoidSha256(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldGitoidSha256, v))
+}
+
+// Publisher applies equality check predicate on the "publisher" field. It's identical to PublisherEQ.
+func Publisher(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldPublisher, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DeliveredAt applies equality check predicate on the "delivered_at" field. It's identical to DeliveredAtEQ.
+func DeliveredAt(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// GitoidSha256EQ applies the EQ predicate on the "gitoid_sha256" field.
+func GitoidSha256EQ(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldGitoidSha256, v))
+}
+
+// GitoidSha256NEQ applies the NEQ predicate on the "gitoid_sha256" field.
+func GitoidSha256NEQ(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldGitoidSha256, v))
+}
+
+// GitoidSha256In applies the In predicate on the "gitoid_sha256" field.
+func GitoidSha256In(vs ...string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldGitoidSha256, vs...))
+}
+
+// GitoidSha256NotIn applies the NotIn predicate on the "gitoid_sha256" field.
+func GitoidSha256NotIn(vs ...string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldGitoidSha256, vs...))
+}
+
+// GitoidSha256GT applies the GT predicate on the "gitoid_sha256" field.
+func GitoidSha256GT(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGT(FieldGitoidSha256, v))
+}
+
+// GitoidSha256GTE applies the GTE predicate on the "gitoid_sha256" field.
+func GitoidSha256GTE(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGTE(FieldGitoidSha256, v))
+}
+
+// GitoidSha256LT applies the LT predicate on the "gitoid_sha256" field.
+func GitoidSha256LT(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLT(FieldGitoidSha256, v))
+}
+
+// GitoidSha256LTE applies the LTE predicate on the "gitoid_sha256" field.
+func GitoidSha256LTE(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLTE(FieldGitoidSha256, v))
+}
+
+// GitoidSha256Contains applies the Contains predicate on the "gitoid_sha256" field.
+func GitoidSha256Contains(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldContains(FieldGitoidSha256, v))
+}
+
+// GitoidSha256HasPrefix applies the HasPrefix predicate on the "gitoid_sha256" field.
+func GitoidSha256HasPrefix(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldHasPrefix(FieldGitoidSha256, v))
+}
+
+// GitoidSha256HasSuffix applies the HasSuffix predicate on the "gitoid_sha256" field.
+func GitoidSha256HasSuffix(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldHasSuffix(FieldGitoidSha256, v))
+}
+
+// GitoidSha256EqualFold applies the EqualFold predicate on the "gitoid_sha256" field.
+func GitoidSha256EqualFold(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEqualFold(FieldGitoidSha256, v))
+}
+
+// GitoidSha256ContainsFold applies the ContainsFold predicate on the "gitoid_sha256" field.
+func GitoidSha256ContainsFold(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldContainsFold(FieldGitoidSha256, v))
+}
+
+// PublisherEQ applies the EQ predicate on the "publisher" field.
+func PublisherEQ(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldPublisher, v))
+}
+
+// PublisherNEQ applies the NEQ predicate on the "publisher" field.
+func PublisherNEQ(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldPublisher, v))
+}
+
+// PublisherIn applies the In predicate on the "publisher" field.
+func PublisherIn(vs ...string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldPublisher, vs...))
+}
+
+// PublisherNotIn applies the NotIn predicate on the "publisher" field.
+func PublisherNotIn(vs ...string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldPublisher, vs...))
+}
+
+// PublisherGT applies the GT predicate on the "publisher" field.
+func PublisherGT(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGT(FieldPublisher, v))
+}
+
+// PublisherGTE applies the GTE predicate on the "publisher" field.
+func PublisherGTE(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGTE(FieldPublisher, v))
+}
+
+// PublisherLT applies the LT predicate on the "publisher" field.
+func PublisherLT(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLT(FieldPublisher, v))
+}
+
+// PublisherLTE applies the LTE predicate on the "publisher" field.
+func PublisherLTE(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLTE(FieldPublisher, v))
+}
+
+// PublisherContains applies the Contains predicate on the "publisher" field.
+func PublisherContains(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldContains(FieldPublisher, v))
+}
+
+// PublisherHasPrefix applies the HasPrefix predicate on the "publisher" field.
+func PublisherHasPrefix(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldHasPrefix(FieldPublisher, v))
+}
+
+// PublisherHasSuffix applies the HasSuffix predicate on the "publisher" field.
+func PublisherHasSuffix(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldHasSuffix(FieldPublisher, v))
+}
+
+// PublisherEqualFold applies the EqualFold predicate on the "publisher" field.
+func PublisherEqualFold(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEqualFold(FieldPublisher, v))
+}
+
+// PublisherContainsFold applies the ContainsFold predicate on the "publisher" field.
+func PublisherContainsFold(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldContainsFold(FieldPublisher, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// DeliveredAtEQ applies the EQ predicate on the "delivered_at" field.
+func DeliveredAtEQ(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtNEQ applies the NEQ predicate on the "delivered_at" field.
+func DeliveredAtNEQ(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIn applies the In predicate on the "delivered_at" field.
+func DeliveredAtIn(vs ...time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtNotIn applies the NotIn predicate on the "delivered_at" field.
+func DeliveredAtNotIn(vs ...time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtGT applies the GT predicate on the "delivered_at" field.
+func DeliveredAtGT(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtGTE applies the GTE predicate on the "delivered_at" field.
+func DeliveredAtGTE(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldGTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLT applies the LT predicate on the "delivered_at" field.
+func DeliveredAtLT(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLTE applies the LTE predicate on the "delivered_at" field.
+func DeliveredAtLTE(v time.Time) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldLTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIsNil applies the IsNil predicate on the "delivered_at" field.
+func DeliveredAtIsNil() predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldIsNull(FieldDeliveredAt))
+}
+
+// DeliveredAtNotNil applies the NotNil predicate on the "delivered_at" field.
+func DeliveredAtNotNil() predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.FieldNotNull(FieldDeliveredAt))
+}
+
+// HasDsse applies the HasEdge predicate on the "dsse" edge.
+func HasDsse() predicate.PublishDelivery {
+	return predicate.PublishDelivery(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DsseTable, DsseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDsseWith applies the HasEdge predicate on the "dsse" edge with a given conditions (other predicates).
+func HasDsseWith(preds ...predicate.Dsse) predicate.PublishDelivery {
+	return predicate.PublishDelivery(func(s *sql.Selector) {
+		step := newDsseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PublishDelivery) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PublishDelivery) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PublishDelivery) predicate.PublishDelivery {
+	return predicate.PublishDelivery(sql.NotPredicates(p))
+}
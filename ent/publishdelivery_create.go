@@ -0,0 +1,462 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+)
+
+// PublishDeliveryCreate is the builder for creating a PublishDelivery entity.
+type PublishDeliveryCreate struct {
+	config
+	mutation *PublishDeliveryMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *PublishDeliveryCreate) SetTenant(v string) *PublishDeliveryCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *PublishDeliveryCreate) SetNillableTenant(v *string) *PublishDeliveryCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetGitoidSha256 sets the "gitoid_sha256" field.
+func (_c *PublishDeliveryCreate) SetGitoidSha256(v string) *PublishDeliveryCreate {
+	_c.mutation.SetGitoidSha256(v)
+	return _c
+}
+
+// SetPublisher sets the "publisher" field.
+func (_c *PublishDeliveryCreate) SetPublisher(v string) *PublishDeliveryCreate {
+	_c.mutation.SetPublisher(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PublishDeliveryCreate) SetStatus(v publishdelivery.Status) *PublishDeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PublishDeliveryCreate) SetNillableStatus(v *publishdelivery.Status) *PublishDeliveryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *PublishDeliveryCreate) SetAttempts(v int) *PublishDeliveryCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *PublishDeliveryCreate) SetNillableAttempts(v *int) *PublishDeliveryCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *PublishDeliveryCreate) SetNextAttemptAt(v time.Time) *PublishDeliveryCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *PublishDeliveryCreate) SetNillableNextAttemptAt(v *time.Time) *PublishDeliveryCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *PublishDeliveryCreate) SetLastError(v string) *PublishDeliveryCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *PublishDeliveryCreate) SetNillableLastError(v *string) *PublishDeliveryCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PublishDeliveryCreate) SetCreatedAt(v time.Time) *PublishDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PublishDeliveryCreate) SetNillableCreatedAt(v *time.Time) *PublishDeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_c *PublishDeliveryCreate) SetDeliveredAt(v time.Time) *PublishDeliveryCreate {
+	_c.mutation.SetDeliveredAt(v)
+	return _c
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_c *PublishDeliveryCreate) SetNillableDeliveredAt(v *time.Time) *PublishDeliveryCreate {
+	if v != nil {
+		_c.SetDeliveredAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PublishDeliveryCreate) SetID(v uuid.UUID) *PublishDeliveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PublishDeliveryCreate) SetNillableID(v *uuid.UUID) *PublishDeliveryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by ID.
+func (_c *PublishDeliveryCreate) SetDsseID(id uuid.UUID) *PublishDeliveryCreate {
+	_c.mutation.SetDsseID(id)
+	return _c
+}
+
+// SetNillableDsseID sets the "dsse" edge to the Dsse entity by ID if the given value is not nil.
+func (_c *PublishDeliveryCreate) SetNillableDsseID(id *uuid.UUID) *PublishDeliveryCreate {
+	if id != nil {
+		_c = _c.SetDsseID(*id)
+	}
+	return _c
+}
+
+// SetDsse sets the "dsse" edge to the Dsse entity.
+func (_c *PublishDeliveryCreate) SetDsse(v *Dsse) *PublishDeliveryCreate {
+	return _c.SetDsseID(v.ID)
+}
+
+// Mutation returns the PublishDeliveryMutation object of the builder.
+func (_c *PublishDeliveryCreate) Mutation() *PublishDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the PublishDelivery in the database.
+func (_c *PublishDeliveryCreate) Save(ctx context.Context) (*PublishDelivery, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PublishDeliveryCreate) SaveX(ctx context.Context) *PublishDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PublishDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PublishDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PublishDeliveryCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := publishdelivery.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := publishdelivery.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := publishdelivery.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		if publishdelivery.DefaultNextAttemptAt == nil {
+			return fmt.Errorf("ent: uninitialized publishdelivery.DefaultNextAttemptAt (forgotten import ent/runtime?)")
+		}
+		v := publishdelivery.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if publishdelivery.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized publishdelivery.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := publishdelivery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if publishdelivery.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized publishdelivery.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := publishdelivery.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PublishDeliveryCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "PublishDelivery.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := publishdelivery.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "PublishDelivery.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GitoidSha256(); !ok {
+		return &ValidationError{Name: "gitoid_sha256", err: errors.New(`ent: missing required field "PublishDelivery.gitoid_sha256"`)}
+	}
+	if v, ok := _c.mutation.GitoidSha256(); ok {
+		if err := publishdelivery.GitoidSha256Validator(v); err != nil {
+			return &ValidationError{Name: "gitoid_sha256", err: fmt.Errorf(`ent: validator failed for field "PublishDelivery.gitoid_sha256": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Publisher(); !ok {
+		return &ValidationError{Name: "publisher", err: errors.New(`ent: missing required field "PublishDelivery.publisher"`)}
+	}
+	if v, ok := _c.mutation.Publisher(); ok {
+		if err := publishdelivery.PublisherValidator(v); err != nil {
+			return &ValidationError{Name: "publisher", err: fmt.Errorf(`ent: validator failed for field "PublishDelivery.publisher": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PublishDelivery.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := publishdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PublishDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "PublishDelivery.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := publishdelivery.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "PublishDelivery.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "PublishDelivery.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PublishDelivery.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PublishDeliveryCreate) sqlSave(ctx context.Context) (*PublishDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PublishDeliveryCreate) createSpec() (*PublishDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PublishDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(publishdelivery.Table, sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(publishdelivery.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.GitoidSha256(); ok {
+		_spec.SetField(publishdelivery.FieldGitoidSha256, field.TypeString, value)
+		_node.GitoidSha256 = value
+	}
+	if value, ok := _c.mutation.Publisher(); ok {
+		_spec.SetField(publishdelivery.FieldPublisher, field.TypeString, value)
+		_node.Publisher = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(publishdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(publishdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(publishdelivery.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(publishdelivery.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(publishdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.DeliveredAt(); ok {
+		_spec.SetField(publishdelivery.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if nodes := _c.mutation.DsseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publishdelivery.DsseTable,
+			Columns: []string{publishdelivery.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.dsse_publish_deliveries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PublishDeliveryCreateBulk is the builder for creating many PublishDelivery entities in bulk.
+type PublishDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*PublishDeliveryCreate
+}
+
+// Save creates the PublishDelivery entities in the database.
+func (_c *PublishDeliveryCreateBulk) Save(ctx context.Context) ([]*PublishDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PublishDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PublishDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PublishDeliveryCreateBulk) SaveX(ctx context.Context) []*PublishDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PublishDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PublishDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+)
+
+// PublishDeliveryUpdate is the builder for updating PublishDelivery entities.
+type PublishDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PublishDeliveryMutation
+}
+
+// Where appends a list predicates to the PublishDeliveryUpdate builder.
+func (_u *PublishDeliveryUpdate) Where(ps ...predicate.PublishDelivery) *PublishDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PublishDeliveryUpdate) SetStatus(v publishdelivery.Status) *PublishDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PublishDeliveryUpdate) SetNillableStatus(v *publishdelivery.Status) *PublishDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *PublishDeliveryUpdate) SetAttempts(v int) *PublishDeliveryUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *PublishDeliveryUpdate) SetNillableAttempts(v *int) *PublishDeliveryUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *PublishDeliveryUpdate) AddAttempts(v int) *PublishDeliveryUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *PublishDeliveryUpdate) SetNextAttemptAt(v time.Time) *PublishDeliveryUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *PublishDeliveryUpdate) SetNillableNextAttemptAt(v *time.Time) *PublishDeliveryUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *PublishDeliveryUpdate) SetLastError(v string) *PublishDeliveryUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *PublishDeliveryUpdate) SetNillableLastError(v *string) *PublishDeliveryUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *PublishDeliveryUpdate) ClearLastError() *PublishDeliveryUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *PublishDeliveryUpdate) SetDeliveredAt(v time.Time) *PublishDeliveryUpdate {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *PublishDeliveryUpdate) SetNillableDeliveredAt(v *time.Time) *PublishDeliveryUpdate {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *PublishDeliveryUpdate) ClearDeliveredAt() *PublishDeliveryUpdate {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by ID.
+func (_u *PublishDeliveryUpdate) SetDsseID(id uuid.UUID) *PublishDeliveryUpdate {
+	_u.mutation.SetDsseID(id)
+	return _u
+}
+
+// SetNillableDsseID sets the "dsse" edge to the Dsse entity by ID if the given value is not nil.
+func (_u *PublishDeliveryUpdate) SetNillableDsseID(id *uuid.UUID) *PublishDeliveryUpdate {
+	if id != nil {
+		_u = _u.SetDsseID(*id)
+	}
+	return _u
+}
+
+// SetDsse sets the "dsse" edge to the Dsse entity.
+func (_u *PublishDeliveryUpdate) SetDsse(v *Dsse) *PublishDeliveryUpdate {
+	return _u.SetDsseID(v.ID)
+}
+
+// Mutation returns the PublishDeliveryMutation object of the builder.
+func (_u *PublishDeliveryUpdate) Mutation() *PublishDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (_u *PublishDeliveryUpdate) ClearDsse() *PublishDeliveryUpdate {
+	_u.mutation.ClearDsse()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PublishDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PublishDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PublishDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PublishDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PublishDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := publishdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PublishDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := publishdelivery.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "PublishDelivery.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PublishDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(publishdelivery.Table, publishdelivery.Columns, sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(publishdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(publishdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(publishdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(publishdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(publishdelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(publishdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(publishdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(publishdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if _u.mutation.DsseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publishdelivery.DsseTable,
+			Columns: []string{publishdelivery.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DsseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publishdelivery.DsseTable,
+			Columns: []string{publishdelivery.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{publishdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PublishDeliveryUpdateOne is the builder for updating a single PublishDelivery entity.
+type PublishDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PublishDeliveryMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *PublishDeliveryUpdateOne) SetStatus(v publishdelivery.Status) *PublishDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PublishDeliveryUpdateOne) SetNillableStatus(v *publishdelivery.Status) *PublishDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *PublishDeliveryUpdateOne) SetAttempts(v int) *PublishDeliveryUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *PublishDeliveryUpdateOne) SetNillableAttempts(v *int) *PublishDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *PublishDeliveryUpdateOne) AddAttempts(v int) *PublishDeliveryUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *PublishDeliveryUpdateOne) SetNextAttemptAt(v time.Time) *PublishDeliveryUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *PublishDeliveryUpdateOne) SetNillableNextAttemptAt(v *time.Time) *PublishDeliveryUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *PublishDeliveryUpdateOne) SetLastError(v string) *PublishDeliveryUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *PublishDeliveryUpdateOne) SetNillableLastError(v *string) *PublishDeliveryUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *PublishDeliveryUpdateOne) ClearLastError() *PublishDeliveryUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *PublishDeliveryUpdateOne) SetDeliveredAt(v time.Time) *PublishDeliveryUpdateOne {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *PublishDeliveryUpdateOne) SetNillableDeliveredAt(v *time.Time) *PublishDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *PublishDeliveryUpdateOne) ClearDeliveredAt() *PublishDeliveryUpdateOne {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by ID.
+func (_u *PublishDeliveryUpdateOne) SetDsseID(id uuid.UUID) *PublishDeliveryUpdateOne {
+	_u.mutation.SetDsseID(id)
+	return _u
+}
+
+// SetNillableDsseID sets the "dsse" edge to the Dsse entity by ID if the given value is not nil.
+func (_u *PublishDeliveryUpdateOne) SetNillableDsseID(id *uuid.UUID) *PublishDeliveryUpdateOne {
+	if id != nil {
+		_u = _u.SetDsseID(*id)
+	}
+	return _u
+}
+
+// SetDsse sets the "dsse" edge to the Dsse entity.
+func (_u *PublishDeliveryUpdateOne) SetDsse(v *Dsse) *PublishDeliveryUpdateOne {
+	return _u.SetDsseID(v.ID)
+}
+
+// Mutation returns the PublishDeliveryMutation object of the builder.
+func (_u *PublishDeliveryUpdateOne) Mutation() *PublishDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (_u *PublishDeliveryUpdateOne) ClearDsse() *PublishDeliveryUpdateOne {
+	_u.mutation.ClearDsse()
+	return _u
+}
+
+// Where appends a list predicates to the PublishDeliveryUpdate builder.
+func (_u *PublishDeliveryUpdateOne) Where(ps ...predicate.PublishDelivery) *PublishDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PublishDeliveryUpdateOne) Select(field string, fields ...string) *PublishDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PublishDelivery entity.
+func (_u *PublishDeliveryUpdateOne) Save(ctx context.Context) (*PublishDelivery, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PublishDeliveryUpdateOne) SaveX(ctx context.Context) *PublishDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PublishDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PublishDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PublishDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := publishdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PublishDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := publishdelivery.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "PublishDelivery.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PublishDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *PublishDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(publishdelivery.Table, publishdelivery.Columns, sqlgraph.NewFieldSpec(publishdelivery.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PublishDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, publishdelivery.FieldID)
+		for _, f := range fields {
+			if !publishdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != publishdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(publishdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(publishdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(publishdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(publishdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(publishdelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(publishdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(publishdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(publishdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if _u.mutation.DsseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publishdelivery.DsseTable,
+			Columns: []string{publishdelivery.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DsseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publishdelivery.DsseTable,
+			Columns: []string{publishdelivery.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PublishDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{publishdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
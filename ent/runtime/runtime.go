@@ -2,7 +2,772 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/in-toto/archivista/ent/runtime.go
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/attestationcollection"
+	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
+	"github.com/in-toto/archivista/ent/payloaddigest"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+	"github.com/in-toto/archivista/ent/schema"
+	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+	"github.com/in-toto/archivista/ent/subjectdigest"
+	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	attestationMixin := schema.Attestation{}.Mixin()
+	attestationMixinHooks0 := attestationMixin[0].Hooks()
+	attestation.Hooks[0] = attestationMixinHooks0[0]
+	attestationMixinInters0 := attestationMixin[0].Interceptors()
+	attestation.Interceptors[0] = attestationMixinInters0[0]
+	attestationMixinFields0 := attestationMixin[0].Fields()
+	_ = attestationMixinFields0
+	attestationFields := schema.Attestation{}.Fields()
+	_ = attestationFields
+	// attestationDescTenant is the schema descriptor for tenant field.
+	attestationDescTenant := attestationMixinFields0[0].Descriptor()
+	// attestation.DefaultTenant holds the default value on creation for the tenant field.
+	attestation.DefaultTenant = attestationDescTenant.Default.(string)
+	// attestation.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	attestation.TenantValidator = attestationDescTenant.Validators[0].(func(string) error)
+	// attestationDescType is the schema descriptor for type field.
+	attestationDescType := attestationFields[1].Descriptor()
+	// attestation.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	attestation.TypeValidator = attestationDescType.Validators[0].(func(string) error)
+	// attestationDescID is the schema descriptor for id field.
+	attestationDescID := attestationFields[0].Descriptor()
+	// attestation.DefaultID holds the default value on creation for the id field.
+	attestation.DefaultID = attestationDescID.Default.(func() uuid.UUID)
+	attestationcollectionMixin := schema.AttestationCollection{}.Mixin()
+	attestationcollectionMixinHooks0 := attestationcollectionMixin[0].Hooks()
+	attestationcollection.Hooks[0] = attestationcollectionMixinHooks0[0]
+	attestationcollectionMixinInters0 := attestationcollectionMixin[0].Interceptors()
+	attestationcollection.Interceptors[0] = attestationcollectionMixinInters0[0]
+	attestationcollectionMixinFields0 := attestationcollectionMixin[0].Fields()
+	_ = attestationcollectionMixinFields0
+	attestationcollectionFields := schema.AttestationCollection{}.Fields()
+	_ = attestationcollectionFields
+	// attestationcollectionDescTenant is the schema descriptor for tenant field.
+	attestationcollectionDescTenant := attestationcollectionMixinFields0[0].Descriptor()
+	// attestationcollection.DefaultTenant holds the default value on creation for the tenant field.
+	attestationcollection.DefaultTenant = attestationcollectionDescTenant.Default.(string)
+	// attestationcollection.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	attestationcollection.TenantValidator = attestationcollectionDescTenant.Validators[0].(func(string) error)
+	// attestationcollectionDescName is the schema descriptor for name field.
+	attestationcollectionDescName := attestationcollectionFields[1].Descriptor()
+	// attestationcollection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	attestationcollection.NameValidator = attestationcollectionDescName.Validators[0].(func(string) error)
+	// attestationcollectionDescID is the schema descriptor for id field.
+	attestationcollectionDescID := attestationcollectionFields[0].Descriptor()
+	// attestationcollection.DefaultID holds the default value on creation for the id field.
+	attestationcollection.DefaultID = attestationcollectionDescID.Default.(func() uuid.UUID)
+	attestationpolicyMixin := schema.AttestationPolicy{}.Mixin()
+	attestationpolicyMixinHooks0 := attestationpolicyMixin[0].Hooks()
+	attestationpolicy.Hooks[0] = attestationpolicyMixinHooks0[0]
+	attestationpolicyMixinInters0 := attestationpolicyMixin[0].Interceptors()
+	attestationpolicy.Interceptors[0] = attestationpolicyMixinInters0[0]
+	attestationpolicyMixinFields0 := attestationpolicyMixin[0].Fields()
+	_ = attestationpolicyMixinFields0
+	attestationpolicyFields := schema.AttestationPolicy{}.Fields()
+	_ = attestationpolicyFields
+	// attestationpolicyDescTenant is the schema descriptor for tenant field.
+	attestationpolicyDescTenant := attestationpolicyMixinFields0[0].Descriptor()
+	// attestationpolicy.DefaultTenant holds the default value on creation for the tenant field.
+	attestationpolicy.DefaultTenant = attestationpolicyDescTenant.Default.(string)
+	// attestationpolicy.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	attestationpolicy.TenantValidator = attestationpolicyDescTenant.Validators[0].(func(string) error)
+	// attestationpolicyDescName is the schema descriptor for name field.
+	attestationpolicyDescName := attestationpolicyFields[1].Descriptor()
+	// attestationpolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	attestationpolicy.NameValidator = attestationpolicyDescName.Validators[0].(func(string) error)
+	// attestationpolicyDescID is the schema descriptor for id field.
+	attestationpolicyDescID := attestationpolicyFields[0].Descriptor()
+	// attestationpolicy.DefaultID holds the default value on creation for the id field.
+	attestationpolicy.DefaultID = attestationpolicyDescID.Default.(func() uuid.UUID)
+	certificateMixin := schema.Certificate{}.Mixin()
+	certificateMixinHooks0 := certificateMixin[0].Hooks()
+	certificate.Hooks[0] = certificateMixinHooks0[0]
+	certificateMixinInters0 := certificateMixin[0].Interceptors()
+	certificate.Interceptors[0] = certificateMixinInters0[0]
+	certificateMixinFields0 := certificateMixin[0].Fields()
+	_ = certificateMixinFields0
+	certificateFields := schema.Certificate{}.Fields()
+	_ = certificateFields
+	// certificateDescTenant is the schema descriptor for tenant field.
+	certificateDescTenant := certificateMixinFields0[0].Descriptor()
+	// certificate.DefaultTenant holds the default value on creation for the tenant field.
+	certificate.DefaultTenant = certificateDescTenant.Default.(string)
+	// certificate.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	certificate.TenantValidator = certificateDescTenant.Validators[0].(func(string) error)
+	// certificateDescChainIndex is the schema descriptor for chain_index field.
+	certificateDescChainIndex := certificateFields[1].Descriptor()
+	// certificate.ChainIndexValidator is a validator for the "chain_index" field. It is called by the builders before save.
+	certificate.ChainIndexValidator = certificateDescChainIndex.Validators[0].(func(int) error)
+	// certificateDescID is the schema descriptor for id field.
+	certificateDescID := certificateFields[0].Descriptor()
+	// certificate.DefaultID holds the default value on creation for the id field.
+	certificate.DefaultID = certificateDescID.Default.(func() uuid.UUID)
+	commandrunattestationMixin := schema.CommandRunAttestation{}.Mixin()
+	commandrunattestationMixinHooks0 := commandrunattestationMixin[0].Hooks()
+	commandrunattestation.Hooks[0] = commandrunattestationMixinHooks0[0]
+	commandrunattestationMixinInters0 := commandrunattestationMixin[0].Interceptors()
+	commandrunattestation.Interceptors[0] = commandrunattestationMixinInters0[0]
+	commandrunattestationMixinFields0 := commandrunattestationMixin[0].Fields()
+	_ = commandrunattestationMixinFields0
+	commandrunattestationFields := schema.CommandRunAttestation{}.Fields()
+	_ = commandrunattestationFields
+	// commandrunattestationDescTenant is the schema descriptor for tenant field.
+	commandrunattestationDescTenant := commandrunattestationMixinFields0[0].Descriptor()
+	// commandrunattestation.DefaultTenant holds the default value on creation for the tenant field.
+	commandrunattestation.DefaultTenant = commandrunattestationDescTenant.Default.(string)
+	// commandrunattestation.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	commandrunattestation.TenantValidator = commandrunattestationDescTenant.Validators[0].(func(string) error)
+	// commandrunattestationDescID is the schema descriptor for id field.
+	commandrunattestationDescID := commandrunattestationFields[0].Descriptor()
+	// commandrunattestation.DefaultID holds the default value on creation for the id field.
+	commandrunattestation.DefaultID = commandrunattestationDescID.Default.(func() uuid.UUID)
+	dsseMixin := schema.Dsse{}.Mixin()
+	dsseMixinHooks0 := dsseMixin[0].Hooks()
+	dsse.Hooks[0] = dsseMixinHooks0[0]
+	dsseMixinInters0 := dsseMixin[0].Interceptors()
+	dsse.Interceptors[0] = dsseMixinInters0[0]
+	dsseMixinFields0 := dsseMixin[0].Fields()
+	_ = dsseMixinFields0
+	dsseFields := schema.Dsse{}.Fields()
+	_ = dsseFields
+	// dsseDescTenant is the schema descriptor for tenant field.
+	dsseDescTenant := dsseMixinFields0[0].Descriptor()
+	// dsse.DefaultTenant holds the default value on creation for the tenant field.
+	dsse.DefaultTenant = dsseDescTenant.Default.(string)
+	// dsse.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	dsse.TenantValidator = dsseDescTenant.Validators[0].(func(string) error)
+	// dsseDescCreatedAt is the schema descriptor for created_at field.
+	dsseDescCreatedAt := dsseFields[1].Descriptor()
+	// dsse.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dsse.DefaultCreatedAt = dsseDescCreatedAt.Default.(func() time.Time)
+	// dsseDescGitoidSha256 is the schema descriptor for gitoid_sha256 field.
+	dsseDescGitoidSha256 := dsseFields[2].Descriptor()
+	// dsse.GitoidSha256Validator is a validator for the "gitoid_sha256" field. It is called by the builders before save.
+	dsse.GitoidSha256Validator = dsseDescGitoidSha256.Validators[0].(func(string) error)
+	// dsseDescPayloadType is the schema descriptor for payload_type field.
+	dsseDescPayloadType := dsseFields[3].Descriptor()
+	// dsse.PayloadTypeValidator is a validator for the "payload_type" field. It is called by the builders before save.
+	dsse.PayloadTypeValidator = dsseDescPayloadType.Validators[0].(func(string) error)
+	// dsseDescID is the schema descriptor for id field.
+	dsseDescID := dsseFields[0].Descriptor()
+	// dsse.DefaultID holds the default value on creation for the id field.
+	dsse.DefaultID = dsseDescID.Default.(func() uuid.UUID)
+	environmentattestationMixin := schema.EnvironmentAttestation{}.Mixin()
+	environmentattestationMixinHooks0 := environmentattestationMixin[0].Hooks()
+	environmentattestation.Hooks[0] = environmentattestationMixinHooks0[0]
+	environmentattestationMixinInters0 := environmentattestationMixin[0].Interceptors()
+	environmentattestation.Interceptors[0] = environmentattestationMixinInters0[0]
+	environmentattestationMixinFields0 := environmentattestationMixin[0].Fields()
+	_ = environmentattestationMixinFields0
+	environmentattestationFields := schema.EnvironmentAttestation{}.Fields()
+	_ = environmentattestationFields
+	// environmentattestationDescTenant is the schema descriptor for tenant field.
+	environmentattestationDescTenant := environmentattestationMixinFields0[0].Descriptor()
+	// environmentattestation.DefaultTenant holds the default value on creation for the tenant field.
+	environmentattestation.DefaultTenant = environmentattestationDescTenant.Default.(string)
+	// environmentattestation.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	environmentattestation.TenantValidator = environmentattestationDescTenant.Validators[0].(func(string) error)
+	// environmentattestationDescID is the schema descriptor for id field.
+	environmentattestationDescID := environmentattestationFields[0].Descriptor()
+	// environmentattestation.DefaultID holds the default value on creation for the id field.
+	environmentattestation.DefaultID = environmentattestationDescID.Default.(func() uuid.UUID)
+	gitattestationMixin := schema.GitAttestation{}.Mixin()
+	gitattestationMixinHooks0 := gitattestationMixin[0].Hooks()
+	gitattestation.Hooks[0] = gitattestationMixinHooks0[0]
+	gitattestationMixinInters0 := gitattestationMixin[0].Interceptors()
+	gitattestation.Interceptors[0] = gitattestationMixinInters0[0]
+	gitattestationMixinFields0 := gitattestationMixin[0].Fields()
+	_ = gitattestationMixinFields0
+	gitattestationFields := schema.GitAttestation{}.Fields()
+	_ = gitattestationFields
+	// gitattestationDescTenant is the schema descriptor for tenant field.
+	gitattestationDescTenant := gitattestationMixinFields0[0].Descriptor()
+	// gitattestation.DefaultTenant holds the default value on creation for the tenant field.
+	gitattestation.DefaultTenant = gitattestationDescTenant.Default.(string)
+	// gitattestation.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	gitattestation.TenantValidator = gitattestationDescTenant.Validators[0].(func(string) error)
+	// gitattestationDescID is the schema descriptor for id field.
+	gitattestationDescID := gitattestationFields[0].Descriptor()
+	// gitattestation.DefaultID holds the default value on creation for the id field.
+	gitattestation.DefaultID = gitattestationDescID.Default.(func() uuid.UUID)
+	githubattestationMixin := schema.GithubAttestation{}.Mixin()
+	githubattestationMixinHooks0 := githubattestationMixin[0].Hooks()
+	githubattestation.Hooks[0] = githubattestationMixinHooks0[0]
+	githubattestationMixinInters0 := githubattestationMixin[0].Interceptors()
+	githubattestation.Interceptors[0] = githubattestationMixinInters0[0]
+	githubattestationMixinFields0 := githubattestationMixin[0].Fields()
+	_ = githubattestationMixinFields0
+	githubattestationFields := schema.GithubAttestation{}.Fields()
+	_ = githubattestationFields
+	// githubattestationDescTenant is the schema descriptor for tenant field.
+	githubattestationDescTenant := githubattestationMixinFields0[0].Descriptor()
+	// githubattestation.DefaultTenant holds the default value on creation for the tenant field.
+	githubattestation.DefaultTenant = githubattestationDescTenant.Default.(string)
+	// githubattestation.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	githubattestation.TenantValidator = githubattestationDescTenant.Validators[0].(func(string) error)
+	// githubattestationDescID is the schema descriptor for id field.
+	githubattestationDescID := githubattestationFields[0].Descriptor()
+	// githubattestation.DefaultID holds the default value on creation for the id field.
+	githubattestation.DefaultID = githubattestationDescID.Default.(func() uuid.UUID)
+	gitlabattestationMixin := schema.GitlabAttestation{}.Mixin()
+	gitlabattestationMixinHooks0 := gitlabattestationMixin[0].Hooks()
+	gitlabattestation.Hooks[0] = gitlabattestationMixinHooks0[0]
+	gitlabattestationMixinInters0 := gitlabattestationMixin[0].Interceptors()
+	gitlabattestation.Interceptors[0] = gitlabattestationMixinInters0[0]
+	gitlabattestationMixinFields0 := gitlabattestationMixin[0].Fields()
+	_ = gitlabattestationMixinFields0
+	gitlabattestationFields := schema.GitlabAttestation{}.Fields()
+	_ = gitlabattestationFields
+	// gitlabattestationDescTenant is the schema descriptor for tenant field.
+	gitlabattestationDescTenant := gitlabattestationMixinFields0[0].Descriptor()
+	// gitlabattestation.DefaultTenant holds the default value on creation for the tenant field.
+	gitlabattestation.DefaultTenant = gitlabattestationDescTenant.Default.(string)
+	// gitlabattestation.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	gitlabattestation.TenantValidator = gitlabattestationDescTenant.Validators[0].(func(string) error)
+	// gitlabattestationDescID is the schema descriptor for id field.
+	gitlabattestationDescID := gitlabattestationFields[0].Descriptor()
+	// gitlabattestation.DefaultID holds the default value on creation for the id field.
+	gitlabattestation.DefaultID = gitlabattestationDescID.Default.(func() uuid.UUID)
+	legalholdMixin := schema.LegalHold{}.Mixin()
+	legalholdMixinHooks0 := legalholdMixin[0].Hooks()
+	legalhold.Hooks[0] = legalholdMixinHooks0[0]
+	legalholdMixinInters0 := legalholdMixin[0].Interceptors()
+	legalhold.Interceptors[0] = legalholdMixinInters0[0]
+	legalholdMixinFields0 := legalholdMixin[0].Fields()
+	_ = legalholdMixinFields0
+	legalholdFields := schema.LegalHold{}.Fields()
+	_ = legalholdFields
+	// legalholdDescTenant is the schema descriptor for tenant field.
+	legalholdDescTenant := legalholdMixinFields0[0].Descriptor()
+	// legalhold.DefaultTenant holds the default value on creation for the tenant field.
+	legalhold.DefaultTenant = legalholdDescTenant.Default.(string)
+	// legalhold.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	legalhold.TenantValidator = legalholdDescTenant.Validators[0].(func(string) error)
+	// legalholdDescReason is the schema descriptor for reason field.
+	legalholdDescReason := legalholdFields[1].Descriptor()
+	// legalhold.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	legalhold.ReasonValidator = legalholdDescReason.Validators[0].(func(string) error)
+	// legalholdDescCreatedAt is the schema descriptor for created_at field.
+	legalholdDescCreatedAt := legalholdFields[4].Descriptor()
+	// legalhold.DefaultCreatedAt holds the default value on creation for the created_at field.
+	legalhold.DefaultCreatedAt = legalholdDescCreatedAt.Default.(func() time.Time)
+	// legalholdDescID is the schema descriptor for id field.
+	legalholdDescID := legalholdFields[0].Descriptor()
+	// legalhold.DefaultID holds the default value on creation for the id field.
+	legalhold.DefaultID = legalholdDescID.Default.(func() uuid.UUID)
+	materialMixin := schema.Material{}.Mixin()
+	materialMixinHooks0 := materialMixin[0].Hooks()
+	material.Hooks[0] = materialMixinHooks0[0]
+	materialMixinInters0 := materialMixin[0].Interceptors()
+	material.Interceptors[0] = materialMixinInters0[0]
+	materialMixinFields0 := materialMixin[0].Fields()
+	_ = materialMixinFields0
+	materialFields := schema.Material{}.Fields()
+	_ = materialFields
+	// materialDescTenant is the schema descriptor for tenant field.
+	materialDescTenant := materialMixinFields0[0].Descriptor()
+	// material.DefaultTenant holds the default value on creation for the tenant field.
+	material.DefaultTenant = materialDescTenant.Default.(string)
+	// material.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	material.TenantValidator = materialDescTenant.Validators[0].(func(string) error)
+	// materialDescPath is the schema descriptor for path field.
+	materialDescPath := materialFields[1].Descriptor()
+	// material.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	material.PathValidator = materialDescPath.Validators[0].(func(string) error)
+	// materialDescAlgorithm is the schema descriptor for algorithm field.
+	materialDescAlgorithm := materialFields[2].Descriptor()
+	// material.AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
+	material.AlgorithmValidator = materialDescAlgorithm.Validators[0].(func(string) error)
+	// materialDescValue is the schema descriptor for value field.
+	materialDescValue := materialFields[3].Descriptor()
+	// material.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	material.ValueValidator = materialDescValue.Validators[0].(func(string) error)
+	// materialDescID is the schema descriptor for id field.
+	materialDescID := materialFields[0].Descriptor()
+	// material.DefaultID holds the default value on creation for the id field.
+	material.DefaultID = materialDescID.Default.(func() uuid.UUID)
+	ociattestationMixin := schema.OciAttestation{}.Mixin()
+	ociattestationMixinHooks0 := ociattestationMixin[0].Hooks()
+	ociattestation.Hooks[0] = ociattestationMixinHooks0[0]
+	ociattestationMixinInters0 := ociattestationMixin[0].Interceptors()
+	ociattestation.Interceptors[0] = ociattestationMixinInters0[0]
+	ociattestationMixinFields0 := ociattestationMixin[0].Fields()
+	_ = ociattestationMixinFields0
+	ociattestationFields := schema.OciAttestation{}.Fields()
+	_ = ociattestationFields
+	// ociattestationDescTenant is the schema descriptor for tenant field.
+	ociattestationDescTenant := ociattestationMixinFields0[0].Descriptor()
+	// ociattestation.DefaultTenant holds the default value on creation for the tenant field.
+	ociattestation.DefaultTenant = ociattestationDescTenant.Default.(string)
+	// ociattestation.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	ociattestation.TenantValidator = ociattestationDescTenant.Validators[0].(func(string) error)
+	// ociattestationDescID is the schema descriptor for id field.
+	ociattestationDescID := ociattestationFields[0].Descriptor()
+	// ociattestation.DefaultID holds the default value on creation for the id field.
+	ociattestation.DefaultID = ociattestationDescID.Default.(func() uuid.UUID)
+	payloaddigestMixin := schema.PayloadDigest{}.Mixin()
+	payloaddigestMixinHooks0 := payloaddigestMixin[0].Hooks()
+	payloaddigest.Hooks[0] = payloaddigestMixinHooks0[0]
+	payloaddigestMixinInters0 := payloaddigestMixin[0].Interceptors()
+	payloaddigest.Interceptors[0] = payloaddigestMixinInters0[0]
+	payloaddigestMixinFields0 := payloaddigestMixin[0].Fields()
+	_ = payloaddigestMixinFields0
+	payloaddigestFields := schema.PayloadDigest{}.Fields()
+	_ = payloaddigestFields
+	// payloaddigestDescTenant is the schema descriptor for tenant field.
+	payloaddigestDescTenant := payloaddigestMixinFields0[0].Descriptor()
+	// payloaddigest.DefaultTenant holds the default value on creation for the tenant field.
+	payloaddigest.DefaultTenant = payloaddigestDescTenant.Default.(string)
+	// payloaddigest.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	payloaddigest.TenantValidator = payloaddigestDescTenant.Validators[0].(func(string) error)
+	// payloaddigestDescAlgorithm is the schema descriptor for algorithm field.
+	payloaddigestDescAlgorithm := payloaddigestFields[1].Descriptor()
+	// payloaddigest.AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
+	payloaddigest.AlgorithmValidator = payloaddigestDescAlgorithm.Validators[0].(func(string) error)
+	// payloaddigestDescValue is the schema descriptor for value field.
+	payloaddigestDescValue := payloaddigestFields[2].Descriptor()
+	// payloaddigest.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	payloaddigest.ValueValidator = payloaddigestDescValue.Validators[0].(func(string) error)
+	// payloaddigestDescID is the schema descriptor for id field.
+	payloaddigestDescID := payloaddigestFields[0].Descriptor()
+	// payloaddigest.DefaultID holds the default value on creation for the id field.
+	payloaddigest.DefaultID = payloaddigestDescID.Default.(func() uuid.UUID)
+	productMixin := schema.Product{}.Mixin()
+	productMixinHooks0 := productMixin[0].Hooks()
+	product.Hooks[0] = productMixinHooks0[0]
+	productMixinInters0 := productMixin[0].Interceptors()
+	product.Interceptors[0] = productMixinInters0[0]
+	productMixinFields0 := productMixin[0].Fields()
+	_ = productMixinFields0
+	productFields := schema.Product{}.Fields()
+	_ = productFields
+	// productDescTenant is the schema descriptor for tenant field.
+	productDescTenant := productMixinFields0[0].Descriptor()
+	// product.DefaultTenant holds the default value on creation for the tenant field.
+	product.DefaultTenant = productDescTenant.Default.(string)
+	// product.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	product.TenantValidator = productDescTenant.Validators[0].(func(string) error)
+	// productDescPath is the schema descriptor for path field.
+	productDescPath := productFields[1].Descriptor()
+	// product.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	product.PathValidator = productDescPath.Validators[0].(func(string) error)
+	// productDescAlgorithm is the schema descriptor for algorithm field.
+	productDescAlgorithm := productFields[3].Descriptor()
+	// product.AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
+	product.AlgorithmValidator = productDescAlgorithm.Validators[0].(func(string) error)
+	// productDescValue is the schema descriptor for value field.
+	productDescValue := productFields[4].Descriptor()
+	// product.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	product.ValueValidator = productDescValue.Validators[0].(func(string) error)
+	// productDescID is the schema descriptor for id field.
+	productDescID := productFields[0].Descriptor()
+	// product.DefaultID holds the default value on creation for the id field.
+	product.DefaultID = productDescID.Default.(func() uuid.UUID)
+	publicationMixin := schema.Publication{}.Mixin()
+	publicationMixinHooks0 := publicationMixin[0].Hooks()
+	publication.Hooks[0] = publicationMixinHooks0[0]
+	publicationMixinInters0 := publicationMixin[0].Interceptors()
+	publication.Interceptors[0] = publicationMixinInters0[0]
+	publicationMixinFields0 := publicationMixin[0].Fields()
+	_ = publicationMixinFields0
+	publicationFields := schema.Publication{}.Fields()
+	_ = publicationFields
+	// publicationDescTenant is the schema descriptor for tenant field.
+	publicationDescTenant := publicationMixinFields0[0].Descriptor()
+	// publication.DefaultTenant holds the default value on creation for the tenant field.
+	publication.DefaultTenant = publicationDescTenant.Default.(string)
+	// publication.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	publication.TenantValidator = publicationDescTenant.Validators[0].(func(string) error)
+	// publicationDescGitoidSha256 is the schema descriptor for gitoid_sha256 field.
+	publicationDescGitoidSha256 := publicationFields[1].Descriptor()
+	// publication.GitoidSha256Validator is a validator for the "gitoid_sha256" field. It is called by the builders before save.
+	publication.GitoidSha256Validator = publicationDescGitoidSha256.Validators[0].(func(string) error)
+	// publicationDescPublisher is the schema descriptor for publisher field.
+	publicationDescPublisher := publicationFields[2].Descriptor()
+	// publication.PublisherValidator is a validator for the "publisher" field. It is called by the builders before save.
+	publication.PublisherValidator = publicationDescPublisher.Validators[0].(func(string) error)
+	// publicationDescTaskID is the schema descriptor for task_id field.
+	publicationDescTaskID := publicationFields[3].Descriptor()
+	// publication.TaskIDValidator is a validator for the "task_id" field. It is called by the builders before save.
+	publication.TaskIDValidator = publicationDescTaskID.Validators[0].(func(string) error)
+	// publicationDescCreatedAt is the schema descriptor for created_at field.
+	publicationDescCreatedAt := publicationFields[6].Descriptor()
+	// publication.DefaultCreatedAt holds the default value on creation for the created_at field.
+	publication.DefaultCreatedAt = publicationDescCreatedAt.Default.(func() time.Time)
+	// publicationDescID is the schema descriptor for id field.
+	publicationDescID := publicationFields[0].Descriptor()
+	// publication.DefaultID holds the default value on creation for the id field.
+	publication.DefaultID = publicationDescID.Default.(func() uuid.UUID)
+	publishdeliveryMixin := schema.PublishDelivery{}.Mixin()
+	publishdeliveryMixinHooks0 := publishdeliveryMixin[0].Hooks()
+	publishdelivery.Hooks[0] = publishdeliveryMixinHooks0[0]
+	publishdeliveryMixinInters0 := publishdeliveryMixin[0].Interceptors()
+	publishdelivery.Interceptors[0] = publishdeliveryMixinInters0[0]
+	publishdeliveryMixinFields0 := publishdeliveryMixin[0].Fields()
+	_ = publishdeliveryMixinFields0
+	publishdeliveryFields := schema.PublishDelivery{}.Fields()
+	_ = publishdeliveryFields
+	// publishdeliveryDescTenant is the schema descriptor for tenant field.
+	publishdeliveryDescTenant := publishdeliveryMixinFields0[0].Descriptor()
+	// publishdelivery.DefaultTenant holds the default value on creation for the tenant field.
+	publishdelivery.DefaultTenant = publishdeliveryDescTenant.Default.(string)
+	// publishdelivery.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	publishdelivery.TenantValidator = publishdeliveryDescTenant.Validators[0].(func(string) error)
+	// publishdeliveryDescGitoidSha256 is the schema descriptor for gitoid_sha256 field.
+	publishdeliveryDescGitoidSha256 := publishdeliveryFields[1].Descriptor()
+	// publishdelivery.GitoidSha256Validator is a validator for the "gitoid_sha256" field. It is called by the builders before save.
+	publishdelivery.GitoidSha256Validator = publishdeliveryDescGitoidSha256.Validators[0].(func(string) error)
+	// publishdeliveryDescPublisher is the schema descriptor for publisher field.
+	publishdeliveryDescPublisher := publishdeliveryFields[2].Descriptor()
+	// publishdelivery.PublisherValidator is a validator for the "publisher" field. It is called by the builders before save.
+	publishdelivery.PublisherValidator = publishdeliveryDescPublisher.Validators[0].(func(string) error)
+	// publishdeliveryDescAttempts is the schema descriptor for attempts field.
+	publishdeliveryDescAttempts := publishdeliveryFields[4].Descriptor()
+	// publishdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	publishdelivery.DefaultAttempts = publishdeliveryDescAttempts.Default.(int)
+	// publishdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	publishdelivery.AttemptsValidator = publishdeliveryDescAttempts.Validators[0].(func(int) error)
+	// publishdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	publishdeliveryDescNextAttemptAt := publishdeliveryFields[5].Descriptor()
+	// publishdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	publishdelivery.DefaultNextAttemptAt = publishdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// publishdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	publishdeliveryDescCreatedAt := publishdeliveryFields[7].Descriptor()
+	// publishdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	publishdelivery.DefaultCreatedAt = publishdeliveryDescCreatedAt.Default.(func() time.Time)
+	// publishdeliveryDescID is the schema descriptor for id field.
+	publishdeliveryDescID := publishdeliveryFields[0].Descriptor()
+	// publishdelivery.DefaultID holds the default value on creation for the id field.
+	publishdelivery.DefaultID = publishdeliveryDescID.Default.(func() uuid.UUID)
+	sbompackageMixin := schema.SbomPackage{}.Mixin()
+	sbompackageMixinHooks0 := sbompackageMixin[0].Hooks()
+	sbompackage.Hooks[0] = sbompackageMixinHooks0[0]
+	sbompackageMixinInters0 := sbompackageMixin[0].Interceptors()
+	sbompackage.Interceptors[0] = sbompackageMixinInters0[0]
+	sbompackageMixinFields0 := sbompackageMixin[0].Fields()
+	_ = sbompackageMixinFields0
+	sbompackageFields := schema.SbomPackage{}.Fields()
+	_ = sbompackageFields
+	// sbompackageDescTenant is the schema descriptor for tenant field.
+	sbompackageDescTenant := sbompackageMixinFields0[0].Descriptor()
+	// sbompackage.DefaultTenant holds the default value on creation for the tenant field.
+	sbompackage.DefaultTenant = sbompackageDescTenant.Default.(string)
+	// sbompackage.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	sbompackage.TenantValidator = sbompackageDescTenant.Validators[0].(func(string) error)
+	// sbompackageDescName is the schema descriptor for name field.
+	sbompackageDescName := sbompackageFields[1].Descriptor()
+	// sbompackage.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	sbompackage.NameValidator = sbompackageDescName.Validators[0].(func(string) error)
+	// sbompackageDescID is the schema descriptor for id field.
+	sbompackageDescID := sbompackageFields[0].Descriptor()
+	// sbompackage.DefaultID holds the default value on creation for the id field.
+	sbompackage.DefaultID = sbompackageDescID.Default.(func() uuid.UUID)
+	sbompackagedigestMixin := schema.SbomPackageDigest{}.Mixin()
+	sbompackagedigestMixinHooks0 := sbompackagedigestMixin[0].Hooks()
+	sbompackagedigest.Hooks[0] = sbompackagedigestMixinHooks0[0]
+	sbompackagedigestMixinInters0 := sbompackagedigestMixin[0].Interceptors()
+	sbompackagedigest.Interceptors[0] = sbompackagedigestMixinInters0[0]
+	sbompackagedigestMixinFields0 := sbompackagedigestMixin[0].Fields()
+	_ = sbompackagedigestMixinFields0
+	sbompackagedigestFields := schema.SbomPackageDigest{}.Fields()
+	_ = sbompackagedigestFields
+	// sbompackagedigestDescTenant is the schema descriptor for tenant field.
+	sbompackagedigestDescTenant := sbompackagedigestMixinFields0[0].Descriptor()
+	// sbompackagedigest.DefaultTenant holds the default value on creation for the tenant field.
+	sbompackagedigest.DefaultTenant = sbompackagedigestDescTenant.Default.(string)
+	// sbompackagedigest.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	sbompackagedigest.TenantValidator = sbompackagedigestDescTenant.Validators[0].(func(string) error)
+	// sbompackagedigestDescAlgorithm is the schema descriptor for algorithm field.
+	sbompackagedigestDescAlgorithm := sbompackagedigestFields[1].Descriptor()
+	// sbompackagedigest.AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
+	sbompackagedigest.AlgorithmValidator = sbompackagedigestDescAlgorithm.Validators[0].(func(string) error)
+	// sbompackagedigestDescValue is the schema descriptor for value field.
+	sbompackagedigestDescValue := sbompackagedigestFields[2].Descriptor()
+	// sbompackagedigest.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	sbompackagedigest.ValueValidator = sbompackagedigestDescValue.Validators[0].(func(string) error)
+	// sbompackagedigestDescID is the schema descriptor for id field.
+	sbompackagedigestDescID := sbompackagedigestFields[0].Descriptor()
+	// sbompackagedigest.DefaultID holds the default value on creation for the id field.
+	sbompackagedigest.DefaultID = sbompackagedigestDescID.Default.(func() uuid.UUID)
+	signatureMixin := schema.Signature{}.Mixin()
+	signatureMixinHooks0 := signatureMixin[0].Hooks()
+	signature.Hooks[0] = signatureMixinHooks0[0]
+	signatureMixinInters0 := signatureMixin[0].Interceptors()
+	signature.Interceptors[0] = signatureMixinInters0[0]
+	signatureMixinFields0 := signatureMixin[0].Fields()
+	_ = signatureMixinFields0
+	signatureFields := schema.Signature{}.Fields()
+	_ = signatureFields
+	// signatureDescTenant is the schema descriptor for tenant field.
+	signatureDescTenant := signatureMixinFields0[0].Descriptor()
+	// signature.DefaultTenant holds the default value on creation for the tenant field.
+	signature.DefaultTenant = signatureDescTenant.Default.(string)
+	// signature.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	signature.TenantValidator = signatureDescTenant.Validators[0].(func(string) error)
+	// signatureDescSignature is the schema descriptor for signature field.
+	signatureDescSignature := signatureFields[2].Descriptor()
+	// signature.SignatureValidator is a validator for the "signature" field. It is called by the builders before save.
+	signature.SignatureValidator = signatureDescSignature.Validators[0].(func(string) error)
+	// signatureDescVerified is the schema descriptor for verified field.
+	signatureDescVerified := signatureFields[3].Descriptor()
+	// signature.DefaultVerified holds the default value on creation for the verified field.
+	signature.DefaultVerified = signatureDescVerified.Default.(bool)
+	// signatureDescID is the schema descriptor for id field.
+	signatureDescID := signatureFields[0].Descriptor()
+	// signature.DefaultID holds the default value on creation for the id field.
+	signature.DefaultID = signatureDescID.Default.(func() uuid.UUID)
+	slsadependencyMixin := schema.SlsaDependency{}.Mixin()
+	slsadependencyMixinHooks0 := slsadependencyMixin[0].Hooks()
+	slsadependency.Hooks[0] = slsadependencyMixinHooks0[0]
+	slsadependencyMixinInters0 := slsadependencyMixin[0].Interceptors()
+	slsadependency.Interceptors[0] = slsadependencyMixinInters0[0]
+	slsadependencyMixinFields0 := slsadependencyMixin[0].Fields()
+	_ = slsadependencyMixinFields0
+	slsadependencyFields := schema.SlsaDependency{}.Fields()
+	_ = slsadependencyFields
+	// slsadependencyDescTenant is the schema descriptor for tenant field.
+	slsadependencyDescTenant := slsadependencyMixinFields0[0].Descriptor()
+	// slsadependency.DefaultTenant holds the default value on creation for the tenant field.
+	slsadependency.DefaultTenant = slsadependencyDescTenant.Default.(string)
+	// slsadependency.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	slsadependency.TenantValidator = slsadependencyDescTenant.Validators[0].(func(string) error)
+	// slsadependencyDescID is the schema descriptor for id field.
+	slsadependencyDescID := slsadependencyFields[0].Descriptor()
+	// slsadependency.DefaultID holds the default value on creation for the id field.
+	slsadependency.DefaultID = slsadependencyDescID.Default.(func() uuid.UUID)
+	slsaprovenanceMixin := schema.SlsaProvenance{}.Mixin()
+	slsaprovenanceMixinHooks0 := slsaprovenanceMixin[0].Hooks()
+	slsaprovenance.Hooks[0] = slsaprovenanceMixinHooks0[0]
+	slsaprovenanceMixinInters0 := slsaprovenanceMixin[0].Interceptors()
+	slsaprovenance.Interceptors[0] = slsaprovenanceMixinInters0[0]
+	slsaprovenanceMixinFields0 := slsaprovenanceMixin[0].Fields()
+	_ = slsaprovenanceMixinFields0
+	slsaprovenanceFields := schema.SlsaProvenance{}.Fields()
+	_ = slsaprovenanceFields
+	// slsaprovenanceDescTenant is the schema descriptor for tenant field.
+	slsaprovenanceDescTenant := slsaprovenanceMixinFields0[0].Descriptor()
+	// slsaprovenance.DefaultTenant holds the default value on creation for the tenant field.
+	slsaprovenance.DefaultTenant = slsaprovenanceDescTenant.Default.(string)
+	// slsaprovenance.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	slsaprovenance.TenantValidator = slsaprovenanceDescTenant.Validators[0].(func(string) error)
+	// slsaprovenanceDescBuilderID is the schema descriptor for builder_id field.
+	slsaprovenanceDescBuilderID := slsaprovenanceFields[1].Descriptor()
+	// slsaprovenance.BuilderIDValidator is a validator for the "builder_id" field. It is called by the builders before save.
+	slsaprovenance.BuilderIDValidator = slsaprovenanceDescBuilderID.Validators[0].(func(string) error)
+	// slsaprovenanceDescBuildType is the schema descriptor for build_type field.
+	slsaprovenanceDescBuildType := slsaprovenanceFields[2].Descriptor()
+	// slsaprovenance.BuildTypeValidator is a validator for the "build_type" field. It is called by the builders before save.
+	slsaprovenance.BuildTypeValidator = slsaprovenanceDescBuildType.Validators[0].(func(string) error)
+	// slsaprovenanceDescID is the schema descriptor for id field.
+	slsaprovenanceDescID := slsaprovenanceFields[0].Descriptor()
+	// slsaprovenance.DefaultID holds the default value on creation for the id field.
+	slsaprovenance.DefaultID = slsaprovenanceDescID.Default.(func() uuid.UUID)
+	statementMixin := schema.Statement{}.Mixin()
+	statementMixinHooks0 := statementMixin[0].Hooks()
+	statement.Hooks[0] = statementMixinHooks0[0]
+	statementMixinInters0 := statementMixin[0].Interceptors()
+	statement.Interceptors[0] = statementMixinInters0[0]
+	statementMixinFields0 := statementMixin[0].Fields()
+	_ = statementMixinFields0
+	statementFields := schema.Statement{}.Fields()
+	_ = statementFields
+	// statementDescTenant is the schema descriptor for tenant field.
+	statementDescTenant := statementMixinFields0[0].Descriptor()
+	// statement.DefaultTenant holds the default value on creation for the tenant field.
+	statement.DefaultTenant = statementDescTenant.Default.(string)
+	// statement.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	statement.TenantValidator = statementDescTenant.Validators[0].(func(string) error)
+	// statementDescPredicate is the schema descriptor for predicate field.
+	statementDescPredicate := statementFields[1].Descriptor()
+	// statement.PredicateValidator is a validator for the "predicate" field. It is called by the builders before save.
+	statement.PredicateValidator = statementDescPredicate.Validators[0].(func(string) error)
+	// statementDescID is the schema descriptor for id field.
+	statementDescID := statementFields[0].Descriptor()
+	// statement.DefaultID holds the default value on creation for the id field.
+	statement.DefaultID = statementDescID.Default.(func() uuid.UUID)
+	subjectMixin := schema.Subject{}.Mixin()
+	subjectMixinHooks0 := subjectMixin[0].Hooks()
+	subject.Hooks[0] = subjectMixinHooks0[0]
+	subjectMixinInters0 := subjectMixin[0].Interceptors()
+	subject.Interceptors[0] = subjectMixinInters0[0]
+	subjectMixinFields0 := subjectMixin[0].Fields()
+	_ = subjectMixinFields0
+	subjectFields := schema.Subject{}.Fields()
+	_ = subjectFields
+	// subjectDescTenant is the schema descriptor for tenant field.
+	subjectDescTenant := subjectMixinFields0[0].Descriptor()
+	// subject.DefaultTenant holds the default value on creation for the tenant field.
+	subject.DefaultTenant = subjectDescTenant.Default.(string)
+	// subject.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	subject.TenantValidator = subjectDescTenant.Validators[0].(func(string) error)
+	// subjectDescCreatedAt is the schema descriptor for created_at field.
+	subjectDescCreatedAt := subjectFields[1].Descriptor()
+	// subject.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subject.DefaultCreatedAt = subjectDescCreatedAt.Default.(func() time.Time)
+	// subjectDescName is the schema descriptor for name field.
+	subjectDescName := subjectFields[2].Descriptor()
+	// subject.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	subject.NameValidator = subjectDescName.Validators[0].(func(string) error)
+	// subjectDescID is the schema descriptor for id field.
+	subjectDescID := subjectFields[0].Descriptor()
+	// subject.DefaultID holds the default value on creation for the id field.
+	subject.DefaultID = subjectDescID.Default.(func() uuid.UUID)
+	subjectaltnameMixin := schema.SubjectAltName{}.Mixin()
+	subjectaltnameMixinHooks0 := subjectaltnameMixin[0].Hooks()
+	subjectaltname.Hooks[0] = subjectaltnameMixinHooks0[0]
+	subjectaltnameMixinInters0 := subjectaltnameMixin[0].Interceptors()
+	subjectaltname.Interceptors[0] = subjectaltnameMixinInters0[0]
+	subjectaltnameMixinFields0 := subjectaltnameMixin[0].Fields()
+	_ = subjectaltnameMixinFields0
+	subjectaltnameFields := schema.SubjectAltName{}.Fields()
+	_ = subjectaltnameFields
+	// subjectaltnameDescTenant is the schema descriptor for tenant field.
+	subjectaltnameDescTenant := subjectaltnameMixinFields0[0].Descriptor()
+	// subjectaltname.DefaultTenant holds the default value on creation for the tenant field.
+	subjectaltname.DefaultTenant = subjectaltnameDescTenant.Default.(string)
+	// subjectaltname.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	subjectaltname.TenantValidator = subjectaltnameDescTenant.Validators[0].(func(string) error)
+	// subjectaltnameDescValue is the schema descriptor for value field.
+	subjectaltnameDescValue := subjectaltnameFields[2].Descriptor()
+	// subjectaltname.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	subjectaltname.ValueValidator = subjectaltnameDescValue.Validators[0].(func(string) error)
+	// subjectaltnameDescID is the schema descriptor for id field.
+	subjectaltnameDescID := subjectaltnameFields[0].Descriptor()
+	// subjectaltname.DefaultID holds the default value on creation for the id field.
+	subjectaltname.DefaultID = subjectaltnameDescID.Default.(func() uuid.UUID)
+	subjectdigestMixin := schema.SubjectDigest{}.Mixin()
+	subjectdigestMixinHooks0 := subjectdigestMixin[0].Hooks()
+	subjectdigest.Hooks[0] = subjectdigestMixinHooks0[0]
+	subjectdigestMixinInters0 := subjectdigestMixin[0].Interceptors()
+	subjectdigest.Interceptors[0] = subjectdigestMixinInters0[0]
+	subjectdigestMixinFields0 := subjectdigestMixin[0].Fields()
+	_ = subjectdigestMixinFields0
+	subjectdigestFields := schema.SubjectDigest{}.Fields()
+	_ = subjectdigestFields
+	// subjectdigestDescTenant is the schema descriptor for tenant field.
+	subjectdigestDescTenant := subjectdigestMixinFields0[0].Descriptor()
+	// subjectdigest.DefaultTenant holds the default value on creation for the tenant field.
+	subjectdigest.DefaultTenant = subjectdigestDescTenant.Default.(string)
+	// subjectdigest.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	subjectdigest.TenantValidator = subjectdigestDescTenant.Validators[0].(func(string) error)
+	// subjectdigestDescAlgorithm is the schema descriptor for algorithm field.
+	subjectdigestDescAlgorithm := subjectdigestFields[1].Descriptor()
+	// subjectdigest.AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
+	subjectdigest.AlgorithmValidator = subjectdigestDescAlgorithm.Validators[0].(func(string) error)
+	// subjectdigestDescValue is the schema descriptor for value field.
+	subjectdigestDescValue := subjectdigestFields[2].Descriptor()
+	// subjectdigest.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	subjectdigest.ValueValidator = subjectdigestDescValue.Validators[0].(func(string) error)
+	// subjectdigestDescID is the schema descriptor for id field.
+	subjectdigestDescID := subjectdigestFields[0].Descriptor()
+	// subjectdigest.DefaultID holds the default value on creation for the id field.
+	subjectdigest.DefaultID = subjectdigestDescID.Default.(func() uuid.UUID)
+	timestampMixin := schema.Timestamp{}.Mixin()
+	timestampMixinHooks0 := timestampMixin[0].Hooks()
+	timestamp.Hooks[0] = timestampMixinHooks0[0]
+	timestampMixinInters0 := timestampMixin[0].Interceptors()
+	timestamp.Interceptors[0] = timestampMixinInters0[0]
+	timestampMixinFields0 := timestampMixin[0].Fields()
+	_ = timestampMixinFields0
+	timestampFields := schema.Timestamp{}.Fields()
+	_ = timestampFields
+	// timestampDescTenant is the schema descriptor for tenant field.
+	timestampDescTenant := timestampMixinFields0[0].Descriptor()
+	// timestamp.DefaultTenant holds the default value on creation for the tenant field.
+	timestamp.DefaultTenant = timestampDescTenant.Default.(string)
+	// timestamp.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	timestamp.TenantValidator = timestampDescTenant.Validators[0].(func(string) error)
+	// timestampDescID is the schema descriptor for id field.
+	timestampDescID := timestampFields[0].Descriptor()
+	// timestamp.DefaultID holds the default value on creation for the id field.
+	timestamp.DefaultID = timestampDescID.Default.(func() uuid.UUID)
+	transparencylogentryMixin := schema.TransparencyLogEntry{}.Mixin()
+	transparencylogentryMixinHooks0 := transparencylogentryMixin[0].Hooks()
+	transparencylogentry.Hooks[0] = transparencylogentryMixinHooks0[0]
+	transparencylogentryMixinInters0 := transparencylogentryMixin[0].Interceptors()
+	transparencylogentry.Interceptors[0] = transparencylogentryMixinInters0[0]
+	transparencylogentryMixinFields0 := transparencylogentryMixin[0].Fields()
+	_ = transparencylogentryMixinFields0
+	transparencylogentryFields := schema.TransparencyLogEntry{}.Fields()
+	_ = transparencylogentryFields
+	// transparencylogentryDescTenant is the schema descriptor for tenant field.
+	transparencylogentryDescTenant := transparencylogentryMixinFields0[0].Descriptor()
+	// transparencylogentry.DefaultTenant holds the default value on creation for the tenant field.
+	transparencylogentry.DefaultTenant = transparencylogentryDescTenant.Default.(string)
+	// transparencylogentry.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	transparencylogentry.TenantValidator = transparencylogentryDescTenant.Validators[0].(func(string) error)
+	// transparencylogentryDescEntry is the schema descriptor for entry field.
+	transparencylogentryDescEntry := transparencylogentryFields[6].Descriptor()
+	// transparencylogentry.EntryValidator is a validator for the "entry" field. It is called by the builders before save.
+	transparencylogentry.EntryValidator = transparencylogentryDescEntry.Validators[0].(func(string) error)
+	// transparencylogentryDescID is the schema descriptor for id field.
+	transparencylogentryDescID := transparencylogentryFields[0].Descriptor()
+	// transparencylogentry.DefaultID holds the default value on creation for the id field.
+	transparencylogentry.DefaultID = transparencylogentryDescID.Default.(func() uuid.UUID)
+	verificationsummaryMixin := schema.VerificationSummary{}.Mixin()
+	verificationsummaryMixinHooks0 := verificationsummaryMixin[0].Hooks()
+	verificationsummary.Hooks[0] = verificationsummaryMixinHooks0[0]
+	verificationsummaryMixinInters0 := verificationsummaryMixin[0].Interceptors()
+	verificationsummary.Interceptors[0] = verificationsummaryMixinInters0[0]
+	verificationsummaryMixinFields0 := verificationsummaryMixin[0].Fields()
+	_ = verificationsummaryMixinFields0
+	verificationsummaryFields := schema.VerificationSummary{}.Fields()
+	_ = verificationsummaryFields
+	// verificationsummaryDescTenant is the schema descriptor for tenant field.
+	verificationsummaryDescTenant := verificationsummaryMixinFields0[0].Descriptor()
+	// verificationsummary.DefaultTenant holds the default value on creation for the tenant field.
+	verificationsummary.DefaultTenant = verificationsummaryDescTenant.Default.(string)
+	// verificationsummary.TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	verificationsummary.TenantValidator = verificationsummaryDescTenant.Validators[0].(func(string) error)
+	// verificationsummaryDescVerifierID is the schema descriptor for verifier_id field.
+	verificationsummaryDescVerifierID := verificationsummaryFields[1].Descriptor()
+	// verificationsummary.VerifierIDValidator is a validator for the "verifier_id" field. It is called by the builders before save.
+	verificationsummary.VerifierIDValidator = verificationsummaryDescVerifierID.Validators[0].(func(string) error)
+	// verificationsummaryDescResourceURI is the schema descriptor for resource_uri field.
+	verificationsummaryDescResourceURI := verificationsummaryFields[3].Descriptor()
+	// verificationsummary.ResourceURIValidator is a validator for the "resource_uri" field. It is called by the builders before save.
+	verificationsummary.ResourceURIValidator = verificationsummaryDescResourceURI.Validators[0].(func(string) error)
+	// verificationsummaryDescVerificationResult is the schema descriptor for verification_result field.
+	verificationsummaryDescVerificationResult := verificationsummaryFields[5].Descriptor()
+	// verificationsummary.VerificationResultValidator is a validator for the "verification_result" field. It is called by the builders before save.
+	verificationsummary.VerificationResultValidator = verificationsummaryDescVerificationResult.Validators[0].(func(string) error)
+	// verificationsummaryDescID is the schema descriptor for id field.
+	verificationsummaryDescID := verificationsummaryFields[0].Descriptor()
+	// verificationsummary.DefaultID holds the default value on creation for the id field.
+	verificationsummary.DefaultID = verificationsummaryDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.6"                                         // Version of ent codegen.
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/statement"
+)
+
+// SbomPackage is the model entity for the SbomPackage schema.
+type SbomPackage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Version holds the value of the "version" field.
+	Version string `json:"version,omitempty"`
+	// Purl holds the value of the "purl" field.
+	Purl string `json:"purl,omitempty"`
+	// License holds the value of the "license" field.
+	License string `json:"license,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SbomPackageQuery when eager-loading is set.
+	Edges                   SbomPackageEdges `json:"edges"`
+	statement_sbom_packages *uuid.UUID
+	selectValues            sql.SelectValues
+}
+
+// SbomPackageEdges holds the relations/edges for other nodes in the graph.
+type SbomPackageEdges struct {
+	// Digests holds the value of the digests edge.
+	Digests []*SbomPackageDigest `json:"digests,omitempty"`
+	// Statement holds the value of the statement edge.
+	Statement *Statement `json:"statement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedDigests map[string][]*SbomPackageDigest
+}
+
+// DigestsOrErr returns the Digests value or an error if the edge
+// was not loaded in eager-loading.
+func (e SbomPackageEdges) DigestsOrErr() ([]*SbomPackageDigest, error) {
+	if e.loadedTypes[0] {
+		return e.Digests, nil
+	}
+	return nil, &NotLoadedError{edge: "digests"}
+}
+
+// StatementOrErr returns the Statement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SbomPackageEdges) StatementOrErr() (*Statement, error) {
+	if e.Statement != nil {
+		return e.Statement, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: statement.Label}
+	}
+	return nil, &NotLoadedError{edge: "statement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SbomPackage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sbompackage.FieldTenant, sbompackage.FieldName, sbompackage.FieldVersion, sbompackage.FieldPurl, sbompackage.FieldLicense:
+			values[i] = new(sql.NullString)
+		case sbompackage.FieldID:
+			values[i] = new(uuid.UUID)
+		case sbompackage.ForeignKeys[0]: // statement_sbom_packages
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SbomPackage fields.
+func (_m *SbomPackage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sbompackage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case sbompackage.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case sbompackage.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case sbompackage.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.String
+			}
+		case sbompackage.FieldPurl:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purl", values[i])
+			} else if value.Valid {
+				_m.Purl = value.String
+			}
+		case sbompackage.FieldLicense:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field license", values[i])
+			} else if value.Valid {
+				_m.License = value.String
+			}
+		case sbompackage.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_sbom_packages", values[i])
+			} else if value.Valid {
+				_m.statement_sbom_packages = new(uuid.UUID)
+				*_m.statement_sbom_packages = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SbomPackage.
+// This includes values selected through modifiers, order, etc.
+func (_m *SbomPackage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDigests queries the "digests" edge of the SbomPackage entity.
+func (_m *SbomPackage) QueryDigests() *SbomPackageDigestQuery {
+	return NewSbomPackageClient(_m.config).QueryDigests(_m)
+}
+
+// QueryStatement queries the "statement" edge of the SbomPackage entity.
+func (_m *SbomPackage) QueryStatement() *StatementQuery {
+	return NewSbomPackageClient(_m.config).QueryStatement(_m)
+}
+
+// Update returns a builder for updating this SbomPackage.
+// Note that you need to call SbomPackage.Unwrap() before calling this method if this SbomPackage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SbomPackage) Update() *SbomPackageUpdateOne {
+	return NewSbomPackageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SbomPackage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SbomPackage) Unwrap() *SbomPackage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SbomPackage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SbomPackage) String() string {
+	var builder strings.Builder
+	builder.WriteString("SbomPackage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(_m.Version)
+	builder.WriteString(", ")
+	builder.WriteString("purl=")
+	builder.WriteString(_m.Purl)
+	builder.WriteString(", ")
+	builder.WriteString("license=")
+	builder.WriteString(_m.License)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedDigests returns the Digests named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *SbomPackage) NamedDigests(name string) ([]*SbomPackageDigest, error) {
+	if _m.Edges.namedDigests == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedDigests[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *SbomPackage) appendNamedDigests(name string, edges ...*SbomPackageDigest) {
+	if _m.Edges.namedDigests == nil {
+		_m.Edges.namedDigests = make(map[string][]*SbomPackageDigest)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedDigests[name] = []*SbomPackageDigest{}
+	} else {
+		_m.Edges.namedDigests[name] = append(_m.Edges.namedDigests[name], edges...)
+	}
+}
+
+// SbomPackages is a parsable slice of SbomPackage.
+type SbomPackages []*SbomPackage
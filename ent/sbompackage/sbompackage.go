@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sbompackage
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the sbompackage type in the database.
+	Label = "sbom_package"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldPurl holds the string denoting the purl field in the database.
+	FieldPurl = "purl"
+	// FieldLicense holds the string denoting the license field in the database.
+	FieldLicense = "license"
+	// EdgeDigests holds the string denoting the digests edge name in mutations.
+	EdgeDigests = "digests"
+	// EdgeStatement holds the string denoting the statement edge name in mutations.
+	EdgeStatement = "statement"
+	// Table holds the table name of the sbompackage in the database.
+	Table = "sbom_packages"
+	// DigestsTable is the table that holds the digests relation/edge.
+	DigestsTable = "sbom_package_digests"
+	// DigestsInverseTable is the table name for the SbomPackageDigest entity.
+	// It exists in this package in order to avoid circular dependency with the "sbompackagedigest" package.
+	DigestsInverseTable = "sbom_package_digests"
+	// DigestsColumn is the table column denoting the digests relation/edge.
+	DigestsColumn = "sbom_package_digests"
+	// StatementTable is the table that holds the statement relation/edge.
+	StatementTable = "sbom_packages"
+	// StatementInverseTable is the table name for the Statement entity.
+	// It exists in this package in order to avoid circular dependency with the "statement" package.
+	StatementInverseTable = "statements"
+	// StatementColumn is the table column denoting the statement relation/edge.
+	StatementColumn = "statement_sbom_packages"
+)
+
+// Columns holds all SQL columns for sbompackage fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldName,
+	FieldVersion,
+	FieldPurl,
+	FieldLicense,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sbom_packages"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"statement_sbom_packages",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SbomPackage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByPurl orders the results by the purl field.
+func ByPurl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurl, opts...).ToFunc()
+}
+
+// ByLicense orders the results by the license field.
+func ByLicense(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLicense, opts...).ToFunc()
+}
+
+// ByDigestsCount orders the results by digests count.
+func ByDigestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDigestsStep(), opts...)
+	}
+}
+
+// ByDigests orders the results by digests terms.
+func ByDigests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDigestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatementField orders the results by statement field.
+func ByStatementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatementStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDigestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DigestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DigestsTable, DigestsColumn),
+	)
+}
+func newStatementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StatementTable, StatementColumn),
+	)
+}
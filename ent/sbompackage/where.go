@@ -0,0 +1,496 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sbompackage
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldTenant, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldVersion, v))
+}
+
+// Purl applies equality check predicate on the "purl" field. It's identical to PurlEQ.
+func Purl(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldPurl, v))
+}
+
+// License applies equality check predicate on the "license" field. It's identical to LicenseEQ.
+func License(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldLicense, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionIsNil applies the IsNil predicate on the "version" field.
+func VersionIsNil() predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldIsNull(FieldVersion))
+}
+
+// VersionNotNil applies the NotNil predicate on the "version" field.
+func VersionNotNil() predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNotNull(FieldVersion))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// PurlEQ applies the EQ predicate on the "purl" field.
+func PurlEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldPurl, v))
+}
+
+// PurlNEQ applies the NEQ predicate on the "purl" field.
+func PurlNEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNEQ(FieldPurl, v))
+}
+
+// PurlIn applies the In predicate on the "purl" field.
+func PurlIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldIn(FieldPurl, vs...))
+}
+
+// PurlNotIn applies the NotIn predicate on the "purl" field.
+func PurlNotIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNotIn(FieldPurl, vs...))
+}
+
+// PurlGT applies the GT predicate on the "purl" field.
+func PurlGT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGT(FieldPurl, v))
+}
+
+// PurlGTE applies the GTE predicate on the "purl" field.
+func PurlGTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGTE(FieldPurl, v))
+}
+
+// PurlLT applies the LT predicate on the "purl" field.
+func PurlLT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLT(FieldPurl, v))
+}
+
+// PurlLTE applies the LTE predicate on the "purl" field.
+func PurlLTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLTE(FieldPurl, v))
+}
+
+// PurlContains applies the Contains predicate on the "purl" field.
+func PurlContains(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContains(FieldPurl, v))
+}
+
+// PurlHasPrefix applies the HasPrefix predicate on the "purl" field.
+func PurlHasPrefix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasPrefix(FieldPurl, v))
+}
+
+// PurlHasSuffix applies the HasSuffix predicate on the "purl" field.
+func PurlHasSuffix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasSuffix(FieldPurl, v))
+}
+
+// PurlIsNil applies the IsNil predicate on the "purl" field.
+func PurlIsNil() predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldIsNull(FieldPurl))
+}
+
+// PurlNotNil applies the NotNil predicate on the "purl" field.
+func PurlNotNil() predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNotNull(FieldPurl))
+}
+
+// PurlEqualFold applies the EqualFold predicate on the "purl" field.
+func PurlEqualFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEqualFold(FieldPurl, v))
+}
+
+// PurlContainsFold applies the ContainsFold predicate on the "purl" field.
+func PurlContainsFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContainsFold(FieldPurl, v))
+}
+
+// LicenseEQ applies the EQ predicate on the "license" field.
+func LicenseEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEQ(FieldLicense, v))
+}
+
+// LicenseNEQ applies the NEQ predicate on the "license" field.
+func LicenseNEQ(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNEQ(FieldLicense, v))
+}
+
+// LicenseIn applies the In predicate on the "license" field.
+func LicenseIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldIn(FieldLicense, vs...))
+}
+
+// LicenseNotIn applies the NotIn predicate on the "license" field.
+func LicenseNotIn(vs ...string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNotIn(FieldLicense, vs...))
+}
+
+// LicenseGT applies the GT predicate on the "license" field.
+func LicenseGT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGT(FieldLicense, v))
+}
+
+// LicenseGTE applies the GTE predicate on the "license" field.
+func LicenseGTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldGTE(FieldLicense, v))
+}
+
+// LicenseLT applies the LT predicate on the "license" field.
+func LicenseLT(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLT(FieldLicense, v))
+}
+
+// LicenseLTE applies the LTE predicate on the "license" field.
+func LicenseLTE(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldLTE(FieldLicense, v))
+}
+
+// LicenseContains applies the Contains predicate on the "license" field.
+func LicenseContains(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContains(FieldLicense, v))
+}
+
+// LicenseHasPrefix applies the HasPrefix predicate on the "license" field.
+func LicenseHasPrefix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasPrefix(FieldLicense, v))
+}
+
+// LicenseHasSuffix applies the HasSuffix predicate on the "license" field.
+func LicenseHasSuffix(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldHasSuffix(FieldLicense, v))
+}
+
+// LicenseIsNil applies the IsNil predicate on the "license" field.
+func LicenseIsNil() predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldIsNull(FieldLicense))
+}
+
+// LicenseNotNil applies the NotNil predicate on the "license" field.
+func LicenseNotNil() predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldNotNull(FieldLicense))
+}
+
+// LicenseEqualFold applies the EqualFold predicate on the "license" field.
+func LicenseEqualFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldEqualFold(FieldLicense, v))
+}
+
+// LicenseContainsFold applies the ContainsFold predicate on the "license" field.
+func LicenseContainsFold(v string) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.FieldContainsFold(FieldLicense, v))
+}
+
+// HasDigests applies the HasEdge predicate on the "digests" edge.
+func HasDigests() predicate.SbomPackage {
+	return predicate.SbomPackage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DigestsTable, DigestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDigestsWith applies the HasEdge predicate on the "digests" edge with a given conditions (other predicates).
+func HasDigestsWith(preds ...predicate.SbomPackageDigest) predicate.SbomPackage {
+	return predicate.SbomPackage(func(s *sql.Selector) {
+		step := newDigestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatement applies the HasEdge predicate on the "statement" edge.
+func HasStatement() predicate.SbomPackage {
+	return predicate.SbomPackage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StatementTable, StatementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatementWith applies the HasEdge predicate on the "statement" edge with a given conditions (other predicates).
+func HasStatementWith(preds ...predicate.Statement) predicate.SbomPackage {
+	return predicate.SbomPackage(func(s *sql.Selector) {
+		step := newStatementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SbomPackage) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SbomPackage) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SbomPackage) predicate.SbomPackage {
+	return predicate.SbomPackage(sql.NotPredicates(p))
+}
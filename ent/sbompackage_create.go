@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+	"github.com/in-toto/archivista/ent/statement"
+)
+
+// SbomPackageCreate is the builder for creating a SbomPackage entity.
+type SbomPackageCreate struct {
+	config
+	mutation *SbomPackageMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *SbomPackageCreate) SetTenant(v string) *SbomPackageCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *SbomPackageCreate) SetNillableTenant(v *string) *SbomPackageCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SbomPackageCreate) SetName(v string) *SbomPackageCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *SbomPackageCreate) SetVersion(v string) *SbomPackageCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *SbomPackageCreate) SetNillableVersion(v *string) *SbomPackageCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetPurl sets the "purl" field.
+func (_c *SbomPackageCreate) SetPurl(v string) *SbomPackageCreate {
+	_c.mutation.SetPurl(v)
+	return _c
+}
+
+// SetNillablePurl sets the "purl" field if the given value is not nil.
+func (_c *SbomPackageCreate) SetNillablePurl(v *string) *SbomPackageCreate {
+	if v != nil {
+		_c.SetPurl(*v)
+	}
+	return _c
+}
+
+// SetLicense sets the "license" field.
+func (_c *SbomPackageCreate) SetLicense(v string) *SbomPackageCreate {
+	_c.mutation.SetLicense(v)
+	return _c
+}
+
+// SetNillableLicense sets the "license" field if the given value is not nil.
+func (_c *SbomPackageCreate) SetNillableLicense(v *string) *SbomPackageCreate {
+	if v != nil {
+		_c.SetLicense(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SbomPackageCreate) SetID(v uuid.UUID) *SbomPackageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SbomPackageCreate) SetNillableID(v *uuid.UUID) *SbomPackageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddDigestIDs adds the "digests" edge to the SbomPackageDigest entity by IDs.
+func (_c *SbomPackageCreate) AddDigestIDs(ids ...uuid.UUID) *SbomPackageCreate {
+	_c.mutation.AddDigestIDs(ids...)
+	return _c
+}
+
+// AddDigests adds the "digests" edges to the SbomPackageDigest entity.
+func (_c *SbomPackageCreate) AddDigests(v ...*SbomPackageDigest) *SbomPackageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDigestIDs(ids...)
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by ID.
+func (_c *SbomPackageCreate) SetStatementID(id uuid.UUID) *SbomPackageCreate {
+	_c.mutation.SetStatementID(id)
+	return _c
+}
+
+// SetStatement sets the "statement" edge to the Statement entity.
+func (_c *SbomPackageCreate) SetStatement(v *Statement) *SbomPackageCreate {
+	return _c.SetStatementID(v.ID)
+}
+
+// Mutation returns the SbomPackageMutation object of the builder.
+func (_c *SbomPackageCreate) Mutation() *SbomPackageMutation {
+	return _c.mutation
+}
+
+// Save creates the SbomPackage in the database.
+func (_c *SbomPackageCreate) Save(ctx context.Context) (*SbomPackage, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SbomPackageCreate) SaveX(ctx context.Context) *SbomPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SbomPackageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SbomPackageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SbomPackageCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := sbompackage.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if sbompackage.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized sbompackage.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := sbompackage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SbomPackageCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "SbomPackage.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := sbompackage.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "SbomPackage.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SbomPackage.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := sbompackage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SbomPackage.name": %w`, err)}
+		}
+	}
+	if len(_c.mutation.StatementIDs()) == 0 {
+		return &ValidationError{Name: "statement", err: errors.New(`ent: missing required edge "SbomPackage.statement"`)}
+	}
+	return nil
+}
+
+func (_c *SbomPackageCreate) sqlSave(ctx context.Context) (*SbomPackage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SbomPackageCreate) createSpec() (*SbomPackage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SbomPackage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sbompackage.Table, sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(sbompackage.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(sbompackage.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(sbompackage.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Purl(); ok {
+		_spec.SetField(sbompackage.FieldPurl, field.TypeString, value)
+		_node.Purl = value
+	}
+	if value, ok := _c.mutation.License(); ok {
+		_spec.SetField(sbompackage.FieldLicense, field.TypeString, value)
+		_node.License = value
+	}
+	if nodes := _c.mutation.DigestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sbompackage.DigestsTable,
+			Columns: []string{sbompackage.DigestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackage.StatementTable,
+			Columns: []string{sbompackage.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.statement_sbom_packages = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SbomPackageCreateBulk is the builder for creating many SbomPackage entities in bulk.
+type SbomPackageCreateBulk struct {
+	config
+	err      error
+	builders []*SbomPackageCreate
+}
+
+// Save creates the SbomPackage entities in the database.
+func (_c *SbomPackageCreateBulk) Save(ctx context.Context) ([]*SbomPackage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SbomPackage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SbomPackageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SbomPackageCreateBulk) SaveX(ctx context.Context) []*SbomPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SbomPackageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SbomPackageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+	"github.com/in-toto/archivista/ent/statement"
+)
+
+// SbomPackageQuery is the builder for querying SbomPackage entities.
+type SbomPackageQuery struct {
+	config
+	ctx              *QueryContext
+	order            []sbompackage.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.SbomPackage
+	withDigests      *SbomPackageDigestQuery
+	withStatement    *StatementQuery
+	withFKs          bool
+	modifiers        []func(*sql.Selector)
+	loadTotal        []func(context.Context, []*SbomPackage) error
+	withNamedDigests map[string]*SbomPackageDigestQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SbomPackageQuery builder.
+func (_q *SbomPackageQuery) Where(ps ...predicate.SbomPackage) *SbomPackageQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *SbomPackageQuery) Limit(limit int) *SbomPackageQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *SbomPackageQuery) Offset(offset int) *SbomPackageQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *SbomPackageQuery) Unique(unique bool) *SbomPackageQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *SbomPackageQuery) Order(o ...sbompackage.OrderOption) *SbomPackageQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryDigests chains the current query on the "digests" edge.
+func (_q *SbomPackageQuery) QueryDigests() *SbomPackageDigestQuery {
+	query := (&SbomPackageDigestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sbompackage.Table, sbompackage.FieldID, selector),
+			sqlgraph.To(sbompackagedigest.Table, sbompackagedigest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sbompackage.DigestsTable, sbompackage.DigestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStatement chains the current query on the "statement" edge.
+func (_q *SbomPackageQuery) QueryStatement() *StatementQuery {
+	query := (&StatementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sbompackage.Table, sbompackage.FieldID, selector),
+			sqlgraph.To(statement.Table, statement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sbompackage.StatementTable, sbompackage.StatementColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SbomPackage entity from the query.
+// Returns a *NotFoundError when no SbomPackage was found.
+func (_q *SbomPackageQuery) First(ctx context.Context) (*SbomPackage, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sbompackage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *SbomPackageQuery) FirstX(ctx context.Context) *SbomPackage {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SbomPackage ID from the query.
+// Returns a *NotFoundError when no SbomPackage ID was found.
+func (_q *SbomPackageQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sbompackage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *SbomPackageQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SbomPackage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SbomPackage entity is found.
+// Returns a *NotFoundError when no SbomPackage entities are found.
+func (_q *SbomPackageQuery) Only(ctx context.Context) (*SbomPackage, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sbompackage.Label}
+	default:
+		return nil, &NotSingularError{sbompackage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *SbomPackageQuery) OnlyX(ctx context.Context) *SbomPackage {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SbomPackage ID in the query.
+// Returns a *NotSingularError when more than one SbomPackage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *SbomPackageQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sbompackage.Label}
+	default:
+		err = &NotSingularError{sbompackage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *SbomPackageQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SbomPackages.
+func (_q *SbomPackageQuery) All(ctx context.Context) ([]*SbomPackage, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SbomPackage, *SbomPackageQuery]()
+	return withInterceptors[[]*SbomPackage](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *SbomPackageQuery) AllX(ctx context.Context) []*SbomPackage {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SbomPackage IDs.
+func (_q *SbomPackageQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(sbompackage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *SbomPackageQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *SbomPackageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*SbomPackageQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *SbomPackageQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *SbomPackageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *SbomPackageQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SbomPackageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *SbomPackageQuery) Clone() *SbomPackageQuery {
+	if _q == nil {
+		return nil
+	}
+	return &SbomPackageQuery{
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]sbompackage.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.SbomPackage{}, _q.predicates...),
+		withDigests:   _q.withDigests.Clone(),
+		withStatement: _q.withStatement.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithDigests tells the query-builder to eager-load the nodes that are connected to
+// the "digests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SbomPackageQuery) WithDigests(opts ...func(*SbomPackageDigestQuery)) *SbomPackageQuery {
+	query := (&SbomPackageDigestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDigests = query
+	return _q
+}
+
+// WithStatement tells the query-builder to eager-load the nodes that are connected to
+// the "statement" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SbomPackageQuery) WithStatement(opts ...func(*StatementQuery)) *SbomPackageQuery {
+	query := (&StatementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatement = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SbomPackage.Query().
+//		GroupBy(sbompackage.FieldTenant).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *SbomPackageQuery) GroupBy(field string, fields ...string) *SbomPackageGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SbomPackageGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = sbompackage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//	}
+//
+//	client.SbomPackage.Query().
+//		Select(sbompackage.FieldTenant).
+//		Scan(ctx, &v)
+func (_q *SbomPackageQuery) Select(fields ...string) *SbomPackageSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &SbomPackageSelect{SbomPackageQuery: _q}
+	sbuild.label = sbompackage.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SbomPackageSelect configured with the given aggregations.
+func (_q *SbomPackageQuery) Aggregate(fns ...AggregateFunc) *SbomPackageSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *SbomPackageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !sbompackage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *SbomPackageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SbomPackage, error) {
+	var (
+		nodes       = []*SbomPackage{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withDigests != nil,
+			_q.withStatement != nil,
+		}
+	)
+	if _q.withStatement != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, sbompackage.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SbomPackage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SbomPackage{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withDigests; query != nil {
+		if err := _q.loadDigests(ctx, query, nodes,
+			func(n *SbomPackage) { n.Edges.Digests = []*SbomPackageDigest{} },
+			func(n *SbomPackage, e *SbomPackageDigest) { n.Edges.Digests = append(n.Edges.Digests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStatement; query != nil {
+		if err := _q.loadStatement(ctx, query, nodes, nil,
+			func(n *SbomPackage, e *Statement) { n.Edges.Statement = e }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedDigests {
+		if err := _q.loadDigests(ctx, query, nodes,
+			func(n *SbomPackage) { n.appendNamedDigests(name) },
+			func(n *SbomPackage, e *SbomPackageDigest) { n.appendNamedDigests(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range _q.loadTotal {
+		if err := _q.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *SbomPackageQuery) loadDigests(ctx context.Context, query *SbomPackageDigestQuery, nodes []*SbomPackage, init func(*SbomPackage), assign func(*SbomPackage, *SbomPackageDigest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SbomPackage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SbomPackageDigest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(sbompackage.DigestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sbom_package_digests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sbom_package_digests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sbom_package_digests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SbomPackageQuery) loadStatement(ctx context.Context, query *StatementQuery, nodes []*SbomPackage, init func(*SbomPackage), assign func(*SbomPackage, *Statement)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SbomPackage)
+	for i := range nodes {
+		if nodes[i].statement_sbom_packages == nil {
+			continue
+		}
+		fk := *nodes[i].statement_sbom_packages
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(statement.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "statement_sbom_packages" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *SbomPackageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *SbomPackageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sbompackage.Table, sbompackage.Columns, sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sbompackage.FieldID)
+		for i := range fields {
+			if fields[i] != sbompackage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *SbomPackageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(sbompackage.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = sbompackage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedDigests tells the query-builder to eager-load the nodes that are connected to the "digests"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *SbomPackageQuery) WithNamedDigests(name string, opts ...func(*SbomPackageDigestQuery)) *SbomPackageQuery {
+	query := (&SbomPackageDigestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedDigests == nil {
+		_q.withNamedDigests = make(map[string]*SbomPackageDigestQuery)
+	}
+	_q.withNamedDigests[name] = query
+	return _q
+}
+
+// SbomPackageGroupBy is the group-by builder for SbomPackage entities.
+type SbomPackageGroupBy struct {
+	selector
+	build *SbomPackageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *SbomPackageGroupBy) Aggregate(fns ...AggregateFunc) *SbomPackageGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *SbomPackageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SbomPackageQuery, *SbomPackageGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *SbomPackageGroupBy) sqlScan(ctx context.Context, root *SbomPackageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SbomPackageSelect is the builder for selecting fields of SbomPackage entities.
+type SbomPackageSelect struct {
+	*SbomPackageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *SbomPackageSelect) Aggregate(fns ...AggregateFunc) *SbomPackageSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *SbomPackageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SbomPackageQuery, *SbomPackageSelect](ctx, _s.SbomPackageQuery, _s, _s.inters, v)
+}
+
+func (_s *SbomPackageSelect) sqlScan(ctx context.Context, root *SbomPackageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+	"github.com/in-toto/archivista/ent/statement"
+)
+
+// SbomPackageUpdate is the builder for updating SbomPackage entities.
+type SbomPackageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SbomPackageMutation
+}
+
+// Where appends a list predicates to the SbomPackageUpdate builder.
+func (_u *SbomPackageUpdate) Where(ps ...predicate.SbomPackage) *SbomPackageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SbomPackageUpdate) SetName(v string) *SbomPackageUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SbomPackageUpdate) SetNillableName(v *string) *SbomPackageUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *SbomPackageUpdate) SetVersion(v string) *SbomPackageUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *SbomPackageUpdate) SetNillableVersion(v *string) *SbomPackageUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (_u *SbomPackageUpdate) ClearVersion() *SbomPackageUpdate {
+	_u.mutation.ClearVersion()
+	return _u
+}
+
+// SetPurl sets the "purl" field.
+func (_u *SbomPackageUpdate) SetPurl(v string) *SbomPackageUpdate {
+	_u.mutation.SetPurl(v)
+	return _u
+}
+
+// SetNillablePurl sets the "purl" field if the given value is not nil.
+func (_u *SbomPackageUpdate) SetNillablePurl(v *string) *SbomPackageUpdate {
+	if v != nil {
+		_u.SetPurl(*v)
+	}
+	return _u
+}
+
+// ClearPurl clears the value of the "purl" field.
+func (_u *SbomPackageUpdate) ClearPurl() *SbomPackageUpdate {
+	_u.mutation.ClearPurl()
+	return _u
+}
+
+// SetLicense sets the "license" field.
+func (_u *SbomPackageUpdate) SetLicense(v string) *SbomPackageUpdate {
+	_u.mutation.SetLicense(v)
+	return _u
+}
+
+// SetNillableLicense sets the "license" field if the given value is not nil.
+func (_u *SbomPackageUpdate) SetNillableLicense(v *string) *SbomPackageUpdate {
+	if v != nil {
+		_u.SetLicense(*v)
+	}
+	return _u
+}
+
+// ClearLicense clears the value of the "license" field.
+func (_u *SbomPackageUpdate) ClearLicense() *SbomPackageUpdate {
+	_u.mutation.ClearLicense()
+	return _u
+}
+
+// AddDigestIDs adds the "digests" edge to the SbomPackageDigest entity by IDs.
+func (_u *SbomPackageUpdate) AddDigestIDs(ids ...uuid.UUID) *SbomPackageUpdate {
+	_u.mutation.AddDigestIDs(ids...)
+	return _u
+}
+
+// AddDigests adds the "digests" edges to the SbomPackageDigest entity.
+func (_u *SbomPackageUpdate) AddDigests(v ...*SbomPackageDigest) *SbomPackageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDigestIDs(ids...)
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by ID.
+func (_u *SbomPackageUpdate) SetStatementID(id uuid.UUID) *SbomPackageUpdate {
+	_u.mutation.SetStatementID(id)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the Statement entity.
+func (_u *SbomPackageUpdate) SetStatement(v *Statement) *SbomPackageUpdate {
+	return _u.SetStatementID(v.ID)
+}
+
+// Mutation returns the SbomPackageMutation object of the builder.
+func (_u *SbomPackageUpdate) Mutation() *SbomPackageMutation {
+	return _u.mutation
+}
+
+// ClearDigests clears all "digests" edges to the SbomPackageDigest entity.
+func (_u *SbomPackageUpdate) ClearDigests() *SbomPackageUpdate {
+	_u.mutation.ClearDigests()
+	return _u
+}
+
+// RemoveDigestIDs removes the "digests" edge to SbomPackageDigest entities by IDs.
+func (_u *SbomPackageUpdate) RemoveDigestIDs(ids ...uuid.UUID) *SbomPackageUpdate {
+	_u.mutation.RemoveDigestIDs(ids...)
+	return _u
+}
+
+// RemoveDigests removes "digests" edges to SbomPackageDigest entities.
+func (_u *SbomPackageUpdate) RemoveDigests(v ...*SbomPackageDigest) *SbomPackageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDigestIDs(ids...)
+}
+
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (_u *SbomPackageUpdate) ClearStatement() *SbomPackageUpdate {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SbomPackageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SbomPackageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SbomPackageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SbomPackageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SbomPackageUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := sbompackage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SbomPackage.name": %w`, err)}
+		}
+	}
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SbomPackage.statement"`)
+	}
+	return nil
+}
+
+func (_u *SbomPackageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sbompackage.Table, sbompackage.Columns, sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(sbompackage.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(sbompackage.FieldVersion, field.TypeString, value)
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(sbompackage.FieldVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Purl(); ok {
+		_spec.SetField(sbompackage.FieldPurl, field.TypeString, value)
+	}
+	if _u.mutation.PurlCleared() {
+		_spec.ClearField(sbompackage.FieldPurl, field.TypeString)
+	}
+	if value, ok := _u.mutation.License(); ok {
+		_spec.SetField(sbompackage.FieldLicense, field.TypeString, value)
+	}
+	if _u.mutation.LicenseCleared() {
+		_spec.ClearField(sbompackage.FieldLicense, field.TypeString)
+	}
+	if _u.mutation.DigestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sbompackage.DigestsTable,
+			Columns: []string{sbompackage.DigestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDigestsIDs(); len(nodes) > 0 && !_u.mutation.DigestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sbompackage.DigestsTable,
+			Columns: []string{sbompackage.DigestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DigestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sbompackage.DigestsTable,
+			Columns: []string{sbompackage.DigestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackage.StatementTable,
+			Columns: []string{sbompackage.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackage.StatementTable,
+			Columns: []string{sbompackage.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sbompackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SbomPackageUpdateOne is the builder for updating a single SbomPackage entity.
+type SbomPackageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SbomPackageMutation
+}
+
+// SetName sets the "name" field.
+func (_u *SbomPackageUpdateOne) SetName(v string) *SbomPackageUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SbomPackageUpdateOne) SetNillableName(v *string) *SbomPackageUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *SbomPackageUpdateOne) SetVersion(v string) *SbomPackageUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *SbomPackageUpdateOne) SetNillableVersion(v *string) *SbomPackageUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (_u *SbomPackageUpdateOne) ClearVersion() *SbomPackageUpdateOne {
+	_u.mutation.ClearVersion()
+	return _u
+}
+
+// SetPurl sets the "purl" field.
+func (_u *SbomPackageUpdateOne) SetPurl(v string) *SbomPackageUpdateOne {
+	_u.mutation.SetPurl(v)
+	return _u
+}
+
+// SetNillablePurl sets the "purl" field if the given value is not nil.
+func (_u *SbomPackageUpdateOne) SetNillablePurl(v *string) *SbomPackageUpdateOne {
+	if v != nil {
+		_u.SetPurl(*v)
+	}
+	return _u
+}
+
+// ClearPurl clears the value of the "purl" field.
+func (_u *SbomPackageUpdateOne) ClearPurl() *SbomPackageUpdateOne {
+	_u.mutation.ClearPurl()
+	return _u
+}
+
+// SetLicense sets the "license" field.
+func (_u *SbomPackageUpdateOne) SetLicense(v string) *SbomPackageUpdateOne {
+	_u.mutation.SetLicense(v)
+	return _u
+}
+
+// SetNillableLicense sets the "license" field if the given value is not nil.
+func (_u *SbomPackageUpdateOne) SetNillableLicense(v *string) *SbomPackageUpdateOne {
+	if v != nil {
+		_u.SetLicense(*v)
+	}
+	return _u
+}
+
+// ClearLicense clears the value of the "license" field.
+func (_u *SbomPackageUpdateOne) ClearLicense() *SbomPackageUpdateOne {
+	_u.mutation.ClearLicense()
+	return _u
+}
+
+// AddDigestIDs adds the "digests" edge to the SbomPackageDigest entity by IDs.
+func (_u *SbomPackageUpdateOne) AddDigestIDs(ids ...uuid.UUID) *SbomPackageUpdateOne {
+	_u.mutation.AddDigestIDs(ids...)
+	return _u
+}
+
+// AddDigests adds the "digests" edges to the SbomPackageDigest entity.
+func (_u *SbomPackageUpdateOne) AddDigests(v ...*SbomPackageDigest) *SbomPackageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDigestIDs(ids...)
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by ID.
+func (_u *SbomPackageUpdateOne) SetStatementID(id uuid.UUID) *SbomPackageUpdateOne {
+	_u.mutation.SetStatementID(id)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the Statement entity.
+func (_u *SbomPackageUpdateOne) SetStatement(v *Statement) *SbomPackageUpdateOne {
+	return _u.SetStatementID(v.ID)
+}
+
+// Mutation returns the SbomPackageMutation object of the builder.
+func (_u *SbomPackageUpdateOne) Mutation() *SbomPackageMutation {
+	return _u.mutation
+}
+
+// ClearDigests clears all "digests" edges to the SbomPackageDigest entity.
+func (_u *SbomPackageUpdateOne) ClearDigests() *SbomPackageUpdateOne {
+	_u.mutation.ClearDigests()
+	return _u
+}
+
+// RemoveDigestIDs removes the "digests" edge to SbomPackageDigest entities by IDs.
+func (_u *SbomPackageUpdateOne) RemoveDigestIDs(ids ...uuid.UUID) *SbomPackageUpdateOne {
+	_u.mutation.RemoveDigestIDs(ids...)
+	return _u
+}
+
+// RemoveDigests removes "digests" edges to SbomPackageDigest entities.
+func (_u *SbomPackageUpdateOne) RemoveDigests(v ...*SbomPackageDigest) *SbomPackageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDigestIDs(ids...)
+}
+
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (_u *SbomPackageUpdateOne) ClearStatement() *SbomPackageUpdateOne {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// Where appends a list predicates to the SbomPackageUpdate builder.
+func (_u *SbomPackageUpdateOne) Where(ps ...predicate.SbomPackage) *SbomPackageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SbomPackageUpdateOne) Select(field string, fields ...string) *SbomPackageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SbomPackage entity.
+func (_u *SbomPackageUpdateOne) Save(ctx context.Context) (*SbomPackage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SbomPackageUpdateOne) SaveX(ctx context.Context) *SbomPackage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SbomPackageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SbomPackageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SbomPackageUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := sbompackage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SbomPackage.name": %w`, err)}
+		}
+	}
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SbomPackage.statement"`)
+	}
+	return nil
+}
+
+func (_u *SbomPackageUpdateOne) sqlSave(ctx context.Context) (_node *SbomPackage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sbompackage.Table, sbompackage.Columns, sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SbomPackage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sbompackage.FieldID)
+		for _, f := range fields {
+			if !sbompackage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sbompackage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(sbompackage.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(sbompackage.FieldVersion, field.TypeString, value)
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(sbompackage.FieldVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Purl(); ok {
+		_spec.SetField(sbompackage.FieldPurl, field.TypeString, value)
+	}
+	if _u.mutation.PurlCleared() {
+		_spec.ClearField(sbompackage.FieldPurl, field.TypeString)
+	}
+	if value, ok := _u.mutation.License(); ok {
+		_spec.SetField(sbompackage.FieldLicense, field.TypeString, value)
+	}
+	if _u.mutation.LicenseCleared() {
+		_spec.ClearField(sbompackage.FieldLicense, field.TypeString)
+	}
+	if _u.mutation.DigestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sbompackage.DigestsTable,
+			Columns: []string{sbompackage.DigestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDigestsIDs(); len(nodes) > 0 && !_u.mutation.DigestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sbompackage.DigestsTable,
+			Columns: []string{sbompackage.DigestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DigestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sbompackage.DigestsTable,
+			Columns: []string{sbompackage.DigestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackage.StatementTable,
+			Columns: []string{sbompackage.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackage.StatementTable,
+			Columns: []string{sbompackage.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SbomPackage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sbompackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
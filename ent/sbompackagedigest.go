@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+)
+
+// SbomPackageDigest is the model entity for the SbomPackageDigest schema.
+type SbomPackageDigest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// Algorithm holds the value of the "algorithm" field.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SbomPackageDigestQuery when eager-loading is set.
+	Edges                SbomPackageDigestEdges `json:"edges"`
+	sbom_package_digests *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// SbomPackageDigestEdges holds the relations/edges for other nodes in the graph.
+type SbomPackageDigestEdges struct {
+	// SbomPackage holds the value of the sbom_package edge.
+	SbomPackage *SbomPackage `json:"sbom_package,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// SbomPackageOrErr returns the SbomPackage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SbomPackageDigestEdges) SbomPackageOrErr() (*SbomPackage, error) {
+	if e.SbomPackage != nil {
+		return e.SbomPackage, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: sbompackage.Label}
+	}
+	return nil, &NotLoadedError{edge: "sbom_package"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SbomPackageDigest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sbompackagedigest.FieldTenant, sbompackagedigest.FieldAlgorithm, sbompackagedigest.FieldValue:
+			values[i] = new(sql.NullString)
+		case sbompackagedigest.FieldID:
+			values[i] = new(uuid.UUID)
+		case sbompackagedigest.ForeignKeys[0]: // sbom_package_digests
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SbomPackageDigest fields.
+func (_m *SbomPackageDigest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sbompackagedigest.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case sbompackagedigest.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case sbompackagedigest.FieldAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
+			} else if value.Valid {
+				_m.Algorithm = value.String
+			}
+		case sbompackagedigest.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case sbompackagedigest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sbom_package_digests", values[i])
+			} else if value.Valid {
+				_m.sbom_package_digests = new(uuid.UUID)
+				*_m.sbom_package_digests = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the SbomPackageDigest.
+// This includes values selected through modifiers, order, etc.
+func (_m *SbomPackageDigest) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySbomPackage queries the "sbom_package" edge of the SbomPackageDigest entity.
+func (_m *SbomPackageDigest) QuerySbomPackage() *SbomPackageQuery {
+	return NewSbomPackageDigestClient(_m.config).QuerySbomPackage(_m)
+}
+
+// Update returns a builder for updating this SbomPackageDigest.
+// Note that you need to call SbomPackageDigest.Unwrap() before calling this method if this SbomPackageDigest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SbomPackageDigest) Update() *SbomPackageDigestUpdateOne {
+	return NewSbomPackageDigestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SbomPackageDigest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SbomPackageDigest) Unwrap() *SbomPackageDigest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SbomPackageDigest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SbomPackageDigest) String() string {
+	var builder strings.Builder
+	builder.WriteString("SbomPackageDigest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("algorithm=")
+	builder.WriteString(_m.Algorithm)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SbomPackageDigests is a parsable slice of SbomPackageDigest.
+type SbomPackageDigests []*SbomPackageDigest
@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sbompackagedigest
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the sbompackagedigest type in the database.
+	Label = "sbom_package_digest"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldAlgorithm holds the string denoting the algorithm field in the database.
+	FieldAlgorithm = "algorithm"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// EdgeSbomPackage holds the string denoting the sbom_package edge name in mutations.
+	EdgeSbomPackage = "sbom_package"
+	// Table holds the table name of the sbompackagedigest in the database.
+	Table = "sbom_package_digests"
+	// SbomPackageTable is the table that holds the sbom_package relation/edge.
+	SbomPackageTable = "sbom_package_digests"
+	// SbomPackageInverseTable is the table name for the SbomPackage entity.
+	// It exists in this package in order to avoid circular dependency with the "sbompackage" package.
+	SbomPackageInverseTable = "sbom_packages"
+	// SbomPackageColumn is the table column denoting the sbom_package relation/edge.
+	SbomPackageColumn = "sbom_package_digests"
+)
+
+// Columns holds all SQL columns for sbompackagedigest fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldAlgorithm,
+	FieldValue,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sbom_package_digests"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"sbom_package_digests",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
+	AlgorithmValidator func(string) error
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SbomPackageDigest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByAlgorithm orders the results by the algorithm field.
+func ByAlgorithm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlgorithm, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// BySbomPackageField orders the results by sbom_package field.
+func BySbomPackageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSbomPackageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSbomPackageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SbomPackageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SbomPackageTable, SbomPackageColumn),
+	)
+}
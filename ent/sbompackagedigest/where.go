@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sbompackagedigest
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEQ(FieldTenant, v))
+}
+
+// Algorithm applies equality check predicate on the "algorithm" field. It's identical to AlgorithmEQ.
+func Algorithm(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEQ(FieldValue, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// AlgorithmEQ applies the EQ predicate on the "algorithm" field.
+func AlgorithmEQ(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmNEQ applies the NEQ predicate on the "algorithm" field.
+func AlgorithmNEQ(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldNEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmIn applies the In predicate on the "algorithm" field.
+func AlgorithmIn(vs ...string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmNotIn applies the NotIn predicate on the "algorithm" field.
+func AlgorithmNotIn(vs ...string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldNotIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmGT applies the GT predicate on the "algorithm" field.
+func AlgorithmGT(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldGT(FieldAlgorithm, v))
+}
+
+// AlgorithmGTE applies the GTE predicate on the "algorithm" field.
+func AlgorithmGTE(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldGTE(FieldAlgorithm, v))
+}
+
+// AlgorithmLT applies the LT predicate on the "algorithm" field.
+func AlgorithmLT(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldLT(FieldAlgorithm, v))
+}
+
+// AlgorithmLTE applies the LTE predicate on the "algorithm" field.
+func AlgorithmLTE(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldLTE(FieldAlgorithm, v))
+}
+
+// AlgorithmContains applies the Contains predicate on the "algorithm" field.
+func AlgorithmContains(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldContains(FieldAlgorithm, v))
+}
+
+// AlgorithmHasPrefix applies the HasPrefix predicate on the "algorithm" field.
+func AlgorithmHasPrefix(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldHasPrefix(FieldAlgorithm, v))
+}
+
+// AlgorithmHasSuffix applies the HasSuffix predicate on the "algorithm" field.
+func AlgorithmHasSuffix(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldHasSuffix(FieldAlgorithm, v))
+}
+
+// AlgorithmEqualFold applies the EqualFold predicate on the "algorithm" field.
+func AlgorithmEqualFold(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEqualFold(FieldAlgorithm, v))
+}
+
+// AlgorithmContainsFold applies the ContainsFold predicate on the "algorithm" field.
+func AlgorithmContainsFold(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldContainsFold(FieldAlgorithm, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.FieldContainsFold(FieldValue, v))
+}
+
+// HasSbomPackage applies the HasEdge predicate on the "sbom_package" edge.
+func HasSbomPackage() predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SbomPackageTable, SbomPackageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSbomPackageWith applies the HasEdge predicate on the "sbom_package" edge with a given conditions (other predicates).
+func HasSbomPackageWith(preds ...predicate.SbomPackage) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(func(s *sql.Selector) {
+		step := newSbomPackageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SbomPackageDigest) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SbomPackageDigest) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SbomPackageDigest) predicate.SbomPackageDigest {
+	return predicate.SbomPackageDigest(sql.NotPredicates(p))
+}
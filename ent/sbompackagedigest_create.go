@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+)
+
+// SbomPackageDigestCreate is the builder for creating a SbomPackageDigest entity.
+type SbomPackageDigestCreate struct {
+	config
+	mutation *SbomPackageDigestMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *SbomPackageDigestCreate) SetTenant(v string) *SbomPackageDigestCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *SbomPackageDigestCreate) SetNillableTenant(v *string) *SbomPackageDigestCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_c *SbomPackageDigestCreate) SetAlgorithm(v string) *SbomPackageDigestCreate {
+	_c.mutation.SetAlgorithm(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *SbomPackageDigestCreate) SetValue(v string) *SbomPackageDigestCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SbomPackageDigestCreate) SetID(v uuid.UUID) *SbomPackageDigestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SbomPackageDigestCreate) SetNillableID(v *uuid.UUID) *SbomPackageDigestCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSbomPackageID sets the "sbom_package" edge to the SbomPackage entity by ID.
+func (_c *SbomPackageDigestCreate) SetSbomPackageID(id uuid.UUID) *SbomPackageDigestCreate {
+	_c.mutation.SetSbomPackageID(id)
+	return _c
+}
+
+// SetSbomPackage sets the "sbom_package" edge to the SbomPackage entity.
+func (_c *SbomPackageDigestCreate) SetSbomPackage(v *SbomPackage) *SbomPackageDigestCreate {
+	return _c.SetSbomPackageID(v.ID)
+}
+
+// Mutation returns the SbomPackageDigestMutation object of the builder.
+func (_c *SbomPackageDigestCreate) Mutation() *SbomPackageDigestMutation {
+	return _c.mutation
+}
+
+// Save creates the SbomPackageDigest in the database.
+func (_c *SbomPackageDigestCreate) Save(ctx context.Context) (*SbomPackageDigest, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SbomPackageDigestCreate) SaveX(ctx context.Context) *SbomPackageDigest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SbomPackageDigestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SbomPackageDigestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SbomPackageDigestCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := sbompackagedigest.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if sbompackagedigest.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized sbompackagedigest.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := sbompackagedigest.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SbomPackageDigestCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "SbomPackageDigest.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := sbompackagedigest.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "SbomPackageDigest.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Algorithm(); !ok {
+		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "SbomPackageDigest.algorithm"`)}
+	}
+	if v, ok := _c.mutation.Algorithm(); ok {
+		if err := sbompackagedigest.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SbomPackageDigest.algorithm": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "SbomPackageDigest.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := sbompackagedigest.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "SbomPackageDigest.value": %w`, err)}
+		}
+	}
+	if len(_c.mutation.SbomPackageIDs()) == 0 {
+		return &ValidationError{Name: "sbom_package", err: errors.New(`ent: missing required edge "SbomPackageDigest.sbom_package"`)}
+	}
+	return nil
+}
+
+func (_c *SbomPackageDigestCreate) sqlSave(ctx context.Context) (*SbomPackageDigest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SbomPackageDigestCreate) createSpec() (*SbomPackageDigest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SbomPackageDigest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sbompackagedigest.Table, sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(sbompackagedigest.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.Algorithm(); ok {
+		_spec.SetField(sbompackagedigest.FieldAlgorithm, field.TypeString, value)
+		_node.Algorithm = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(sbompackagedigest.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if nodes := _c.mutation.SbomPackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackagedigest.SbomPackageTable,
+			Columns: []string{sbompackagedigest.SbomPackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sbom_package_digests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SbomPackageDigestCreateBulk is the builder for creating many SbomPackageDigest entities in bulk.
+type SbomPackageDigestCreateBulk struct {
+	config
+	err      error
+	builders []*SbomPackageDigestCreate
+}
+
+// Save creates the SbomPackageDigest entities in the database.
+func (_c *SbomPackageDigestCreateBulk) Save(ctx context.Context) ([]*SbomPackageDigest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SbomPackageDigest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SbomPackageDigestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SbomPackageDigestCreateBulk) SaveX(ctx context.Context) []*SbomPackageDigest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SbomPackageDigestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SbomPackageDigestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
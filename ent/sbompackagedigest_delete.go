@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+)
+
+// SbomPackageDigestDelete is the builder for deleting a SbomPackageDigest entity.
+type SbomPackageDigestDelete struct {
+	config
+	hooks    []Hook
+	mutation *SbomPackageDigestMutation
+}
+
+// Where appends a list predicates to the SbomPackageDigestDelete builder.
+func (_d *SbomPackageDigestDelete) Where(ps ...predicate.SbomPackageDigest) *SbomPackageDigestDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SbomPackageDigestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SbomPackageDigestDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SbomPackageDigestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sbompackagedigest.Table, sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SbomPackageDigestDeleteOne is the builder for deleting a single SbomPackageDigest entity.
+type SbomPackageDigestDeleteOne struct {
+	_d *SbomPackageDigestDelete
+}
+
+// Where appends a list predicates to the SbomPackageDigestDelete builder.
+func (_d *SbomPackageDigestDeleteOne) Where(ps ...predicate.SbomPackageDigest) *SbomPackageDigestDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SbomPackageDigestDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sbompackagedigest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SbomPackageDigestDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+)
+
+// SbomPackageDigestUpdate is the builder for updating SbomPackageDigest entities.
+type SbomPackageDigestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SbomPackageDigestMutation
+}
+
+// Where appends a list predicates to the SbomPackageDigestUpdate builder.
+func (_u *SbomPackageDigestUpdate) Where(ps ...predicate.SbomPackageDigest) *SbomPackageDigestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *SbomPackageDigestUpdate) SetAlgorithm(v string) *SbomPackageDigestUpdate {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *SbomPackageDigestUpdate) SetNillableAlgorithm(v *string) *SbomPackageDigestUpdate {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *SbomPackageDigestUpdate) SetValue(v string) *SbomPackageDigestUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *SbomPackageDigestUpdate) SetNillableValue(v *string) *SbomPackageDigestUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetSbomPackageID sets the "sbom_package" edge to the SbomPackage entity by ID.
+func (_u *SbomPackageDigestUpdate) SetSbomPackageID(id uuid.UUID) *SbomPackageDigestUpdate {
+	_u.mutation.SetSbomPackageID(id)
+	return _u
+}
+
+// SetSbomPackage sets the "sbom_package" edge to the SbomPackage entity.
+func (_u *SbomPackageDigestUpdate) SetSbomPackage(v *SbomPackage) *SbomPackageDigestUpdate {
+	return _u.SetSbomPackageID(v.ID)
+}
+
+// Mutation returns the SbomPackageDigestMutation object of the builder.
+func (_u *SbomPackageDigestUpdate) Mutation() *SbomPackageDigestMutation {
+	return _u.mutation
+}
+
+// ClearSbomPackage clears the "sbom_package" edge to the SbomPackage entity.
+func (_u *SbomPackageDigestUpdate) ClearSbomPackage() *SbomPackageDigestUpdate {
+	_u.mutation.ClearSbomPackage()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SbomPackageDigestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SbomPackageDigestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SbomPackageDigestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SbomPackageDigestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SbomPackageDigestUpdate) check() error {
+	if v, ok := _u.mutation.Algorithm(); ok {
+		if err := sbompackagedigest.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SbomPackageDigest.algorithm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := sbompackagedigest.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "SbomPackageDigest.value": %w`, err)}
+		}
+	}
+	if _u.mutation.SbomPackageCleared() && len(_u.mutation.SbomPackageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SbomPackageDigest.sbom_package"`)
+	}
+	return nil
+}
+
+func (_u *SbomPackageDigestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sbompackagedigest.Table, sbompackagedigest.Columns, sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(sbompackagedigest.FieldAlgorithm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(sbompackagedigest.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.SbomPackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackagedigest.SbomPackageTable,
+			Columns: []string{sbompackagedigest.SbomPackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SbomPackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackagedigest.SbomPackageTable,
+			Columns: []string{sbompackagedigest.SbomPackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sbompackagedigest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SbomPackageDigestUpdateOne is the builder for updating a single SbomPackageDigest entity.
+type SbomPackageDigestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SbomPackageDigestMutation
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *SbomPackageDigestUpdateOne) SetAlgorithm(v string) *SbomPackageDigestUpdateOne {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *SbomPackageDigestUpdateOne) SetNillableAlgorithm(v *string) *SbomPackageDigestUpdateOne {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *SbomPackageDigestUpdateOne) SetValue(v string) *SbomPackageDigestUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *SbomPackageDigestUpdateOne) SetNillableValue(v *string) *SbomPackageDigestUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetSbomPackageID sets the "sbom_package" edge to the SbomPackage entity by ID.
+func (_u *SbomPackageDigestUpdateOne) SetSbomPackageID(id uuid.UUID) *SbomPackageDigestUpdateOne {
+	_u.mutation.SetSbomPackageID(id)
+	return _u
+}
+
+// SetSbomPackage sets the "sbom_package" edge to the SbomPackage entity.
+func (_u *SbomPackageDigestUpdateOne) SetSbomPackage(v *SbomPackage) *SbomPackageDigestUpdateOne {
+	return _u.SetSbomPackageID(v.ID)
+}
+
+// Mutation returns the SbomPackageDigestMutation object of the builder.
+func (_u *SbomPackageDigestUpdateOne) Mutation() *SbomPackageDigestMutation {
+	return _u.mutation
+}
+
+// ClearSbomPackage clears the "sbom_package" edge to the SbomPackage entity.
+func (_u *SbomPackageDigestUpdateOne) ClearSbomPackage() *SbomPackageDigestUpdateOne {
+	_u.mutation.ClearSbomPackage()
+	return _u
+}
+
+// Where appends a list predicates to the SbomPackageDigestUpdate builder.
+func (_u *SbomPackageDigestUpdateOne) Where(ps ...predicate.SbomPackageDigest) *SbomPackageDigestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SbomPackageDigestUpdateOne) Select(field string, fields ...string) *SbomPackageDigestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SbomPackageDigest entity.
+func (_u *SbomPackageDigestUpdateOne) Save(ctx context.Context) (*SbomPackageDigest, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SbomPackageDigestUpdateOne) SaveX(ctx context.Context) *SbomPackageDigest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SbomPackageDigestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SbomPackageDigestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SbomPackageDigestUpdateOne) check() error {
+	if v, ok := _u.mutation.Algorithm(); ok {
+		if err := sbompackagedigest.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SbomPackageDigest.algorithm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := sbompackagedigest.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "SbomPackageDigest.value": %w`, err)}
+		}
+	}
+	if _u.mutation.SbomPackageCleared() && len(_u.mutation.SbomPackageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SbomPackageDigest.sbom_package"`)
+	}
+	return nil
+}
+
+func (_u *SbomPackageDigestUpdateOne) sqlSave(ctx context.Context) (_node *SbomPackageDigest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sbompackagedigest.Table, sbompackagedigest.Columns, sqlgraph.NewFieldSpec(sbompackagedigest.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SbomPackageDigest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sbompackagedigest.FieldID)
+		for _, f := range fields {
+			if !sbompackagedigest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sbompackagedigest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(sbompackagedigest.FieldAlgorithm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(sbompackagedigest.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.SbomPackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackagedigest.SbomPackageTable,
+			Columns: []string{sbompackagedigest.SbomPackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SbomPackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sbompackagedigest.SbomPackageTable,
+			Columns: []string{sbompackagedigest.SbomPackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SbomPackageDigest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sbompackagedigest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
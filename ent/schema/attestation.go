@@ -27,6 +27,12 @@ type Attestation struct {
 	ent.Schema
 }
 
+func (Attestation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 func (Attestation) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
@@ -37,6 +43,14 @@ func (Attestation) Fields() []ent.Field {
 func (Attestation) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("attestation_collection", AttestationCollection.Type).Ref("attestations").Unique().Required(),
+		edge.To("git_attestation", GitAttestation.Type).Unique(),
+		edge.To("github_attestation", GithubAttestation.Type).Unique(),
+		edge.To("gitlab_attestation", GitlabAttestation.Type).Unique(),
+		edge.To("environment_attestation", EnvironmentAttestation.Type).Unique(),
+		edge.To("command_run_attestation", CommandRunAttestation.Type).Unique(),
+		edge.To("oci_attestation", OciAttestation.Type).Unique(),
+		edge.To("materials", Material.Type),
+		edge.To("products", Product.Type),
 	}
 }
 
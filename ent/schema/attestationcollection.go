@@ -27,6 +27,12 @@ type AttestationCollection struct {
 	ent.Schema
 }
 
+func (AttestationCollection) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 func (AttestationCollection) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
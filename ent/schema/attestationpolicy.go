@@ -29,6 +29,12 @@ type AttestationPolicy struct {
 	ent.Schema
 }
 
+func (AttestationPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 func (AttestationPolicy) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
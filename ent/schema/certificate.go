@@ -0,0 +1,77 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Certificate represents an X.509 certificate a signature was made with, or one of its intermediates
+type Certificate struct {
+	ent.Schema
+}
+
+func (Certificate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (Certificate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		// the signing certificate is 0 and its intermediates follow in order
+		field.Int("chain_index").NonNegative(),
+		field.String("subject").SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("issuer").SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("serial_number"),
+		field.Time("not_before"),
+		field.Time("not_after"),
+		field.String("spiffe_id").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		// fulcio extensions, see https://github.com/sigstore/fulcio/blob/main/docs/oid-info.md
+		field.String("oidc_issuer").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("build_signer_uri").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("build_signer_digest").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("runner_environment").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("source_repository_uri").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("source_repository_digest").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("source_repository_ref").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("build_config_uri").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("build_trigger").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("run_invocation_uri").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+	}
+}
+
+func (Certificate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("subject_alt_names", SubjectAltName.Type),
+		edge.From("signature", Signature.Type).Ref("certificates").Unique().Required(),
+	}
+}
+
+func (Certificate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("spiffe_id").Annotations(entsql.Prefix(255)),
+		index.Fields("oidc_issuer").Annotations(entsql.Prefix(255)),
+		index.Fields("build_signer_uri").Annotations(entsql.Prefix(255)),
+		index.Fields("source_repository_uri").Annotations(entsql.Prefix(255)),
+	}
+}
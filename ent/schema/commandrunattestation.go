@@ -0,0 +1,48 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CommandRunAttestation holds the command recorded by a witness command-run attestation
+type CommandRunAttestation struct {
+	ent.Schema
+}
+
+func (CommandRunAttestation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (CommandRunAttestation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("cmd").SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.Int("exit_code"),
+	}
+}
+
+func (CommandRunAttestation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attestation", Attestation.Type).Ref("command_run_attestation").Unique().Required(),
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -30,12 +31,18 @@ type Dsse struct {
 	ent.Schema
 }
 
+func (Dsse) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Statement.
 func (Dsse) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
 		field.Time("created_at").Default(time.Now).Immutable().Optional().Nillable().Annotations(entgql.OrderField("CREATED_AT")),
-		field.String("gitoid_sha256").NotEmpty().Unique(),
+		field.String("gitoid_sha256").NotEmpty(),
 		field.String("payload_type").NotEmpty(),
 	}
 }
@@ -46,6 +53,17 @@ func (Dsse) Edges() []ent.Edge {
 		edge.To("statement", Statement.Type).Unique(),
 		edge.To("signatures", Signature.Type),
 		edge.To("payload_digests", PayloadDigest.Type),
+		edge.To("publish_deliveries", PublishDelivery.Type),
+		edge.To("publications", Publication.Type),
+		edge.To("transparency_log_entries", TransparencyLogEntry.Type),
+		edge.From("legal_holds", LegalHold.Type).Ref("dsses"),
+	}
+}
+
+// Indexes of the Dsse. Each tenant may archive the same envelope.
+func (Dsse) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant", "gitoid_sha256").Unique(),
 	}
 }
 
@@ -0,0 +1,57 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EnvironmentAttestation holds the host recorded by a witness environment attestation
+type EnvironmentAttestation struct {
+	ent.Schema
+}
+
+func (EnvironmentAttestation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (EnvironmentAttestation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("os").Optional(),
+		field.String("hostname").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("username").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+	}
+}
+
+func (EnvironmentAttestation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attestation", Attestation.Type).Ref("environment_attestation").Unique().Required(),
+	}
+}
+
+func (EnvironmentAttestation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hostname").Annotations(entsql.Prefix(255)),
+	}
+}
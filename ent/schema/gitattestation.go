@@ -0,0 +1,68 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GitAttestation holds the commit recorded by a witness git attestation
+type GitAttestation struct {
+	ent.Schema
+}
+
+func (GitAttestation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (GitAttestation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("commit_hash"),
+		field.String("branch").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("tree_hash").Optional(),
+		field.String("author").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("author_email").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("committer_name").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("committer_email").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("commit_date").Optional(),
+		field.String("commit_message").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.Strings("parent_hashes").Optional(),
+		field.Strings("refs").Optional(),
+		field.Strings("remotes").Optional(),
+		field.Strings("tags").Optional(),
+	}
+}
+
+func (GitAttestation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attestation", Attestation.Type).Ref("git_attestation").Unique().Required(),
+	}
+}
+
+func (GitAttestation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("commit_hash"),
+		index.Fields("branch").Annotations(entsql.Prefix(255)),
+	}
+}
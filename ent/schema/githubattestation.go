@@ -0,0 +1,65 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GithubAttestation holds the GitHub Actions run recorded by a witness github attestation
+type GithubAttestation struct {
+	ent.Schema
+}
+
+func (GithubAttestation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (GithubAttestation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("ci_config_path").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("pipeline_id").Optional(),
+		field.String("pipeline_name").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("pipeline_url").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("project_url").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("runner_id").Optional(),
+		field.String("ci_host").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("ci_server_url").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("runner_arch").Optional(),
+		field.String("runner_os").Optional(),
+	}
+}
+
+func (GithubAttestation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attestation", Attestation.Type).Ref("github_attestation").Unique().Required(),
+	}
+}
+
+func (GithubAttestation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("pipeline_id"),
+		index.Fields("project_url").Annotations(entsql.Prefix(255)),
+	}
+}
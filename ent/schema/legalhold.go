@@ -0,0 +1,74 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LegalHold freezes archived envelopes so they can't be deleted or overwritten. Holds are
+// released rather than deleted so they remain as a record of who froze what and why.
+type LegalHold struct {
+	ent.Schema
+}
+
+func (LegalHold) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the LegalHold.
+func (LegalHold) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("reason").NotEmpty().Immutable().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		// subject_digest is the digest, as algorithm:value, the held envelopes were selected by
+		field.String("subject_digest").Optional().Immutable(),
+		field.String("created_by").Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable().Annotations(entgql.OrderField("CREATED_AT")),
+		field.String("released_by").Optional(),
+		field.Time("released_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the LegalHold.
+func (LegalHold) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("dsses", Dsse.Type),
+	}
+}
+
+func (LegalHold) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("released_at"),
+	}
+}
+
+func (LegalHold) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField(),
+	}
+}
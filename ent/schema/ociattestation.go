@@ -0,0 +1,57 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OciAttestation holds the image recorded by a witness oci attestation
+type OciAttestation struct {
+	ent.Schema
+}
+
+func (OciAttestation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (OciAttestation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("tar_digest").Optional(),
+		field.String("image_id").Optional(),
+		field.String("manifest_digest").Optional(),
+		field.Strings("image_tags").Optional(),
+	}
+}
+
+func (OciAttestation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attestation", Attestation.Type).Ref("oci_attestation").Unique().Required(),
+	}
+}
+
+func (OciAttestation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("image_id"),
+		index.Fields("manifest_digest"),
+	}
+}
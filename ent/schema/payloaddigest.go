@@ -27,6 +27,12 @@ type PayloadDigest struct {
 	ent.Schema
 }
 
+func (PayloadDigest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Digest.
 func (PayloadDigest) Fields() []ent.Field {
 	return []ent.Field{
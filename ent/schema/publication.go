@@ -0,0 +1,82 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Publication tracks a task a publisher started to make an archived envelope available, such as
+// adding it to TUF metadata with RSTUF, and whether that task completed
+type Publication struct {
+	ent.Schema
+}
+
+func (Publication) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Publication.
+func (Publication) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("gitoid_sha256").NotEmpty().Immutable(),
+		field.String("publisher").NotEmpty().Immutable(),
+		field.String("task_id").NotEmpty().Immutable(),
+		field.Enum("status").
+			NamedValues(
+				"Pending", "PENDING",
+				"Succeeded", "SUCCEEDED",
+				"Failed", "FAILED",
+			).
+			Default("PENDING"),
+		field.String("message").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.Time("created_at").Default(time.Now).Immutable().Annotations(entgql.OrderField("CREATED_AT")),
+		field.Time("completed_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Publication.
+func (Publication) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("dsse", Dsse.Type).Ref("publications").Unique(),
+	}
+}
+
+func (Publication) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("gitoid_sha256"),
+		index.Fields("publisher", "task_id").Unique(),
+		index.Fields("status"),
+	}
+}
+
+func (Publication) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField(),
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PublishDelivery is an outbox entry tracking the delivery of an archived envelope to a single publisher
+type PublishDelivery struct {
+	ent.Schema
+}
+
+func (PublishDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the PublishDelivery.
+func (PublishDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("gitoid_sha256").NotEmpty().Immutable(),
+		field.String("publisher").NotEmpty().Immutable(),
+		field.Enum("status").
+			NamedValues(
+				"Pending", "PENDING",
+				"Delivered", "DELIVERED",
+				"DeadLetter", "DEAD_LETTER",
+			).
+			Default("PENDING"),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.String("last_error").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.Time("created_at").Default(time.Now).Immutable().Annotations(entgql.OrderField("CREATED_AT")),
+		field.Time("delivered_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the PublishDelivery.
+func (PublishDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("dsse", Dsse.Type).Ref("publish_deliveries").Unique(),
+	}
+}
+
+func (PublishDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant", "gitoid_sha256", "publisher").Unique(),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
+
+func (PublishDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField(),
+	}
+}
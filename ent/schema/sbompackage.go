@@ -0,0 +1,69 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SbomPackage represents a package listed in an SPDX or CycloneDX SBOM
+type SbomPackage struct {
+	ent.Schema
+}
+
+func (SbomPackage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (SbomPackage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("name").NotEmpty().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("version").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("purl").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("license").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+	}
+}
+
+func (SbomPackage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("digests", SbomPackageDigest.Type),
+		edge.From("statement", Statement.Type).Ref("sbom_packages").Unique().Required(),
+	}
+}
+
+func (SbomPackage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "version").Annotations(entsql.PrefixColumn("name", 255), entsql.PrefixColumn("version", 255)),
+		index.Fields("purl").Annotations(entsql.Prefix(255)),
+	}
+}
+
+func (SbomPackage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField(),
+	}
+}
@@ -28,12 +28,19 @@ type Signature struct {
 	ent.Schema
 }
 
+func (Signature) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Signature.
 func (Signature) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
 		field.String("key_id"),
 		field.String("signature").NotEmpty().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.Bool("verified").Default(false),
 	}
 }
 
@@ -42,6 +49,7 @@ func (Signature) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("dsse", Dsse.Type).Ref("signatures").Unique(),
 		edge.To("timestamps", Timestamp.Type),
+		edge.To("certificates", Certificate.Type),
 	}
 }
 
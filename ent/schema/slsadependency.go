@@ -0,0 +1,58 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SlsaDependency is one digest of an artifact a SLSA provenance build resolved. Dependencies
+// recorded without a digest have no algorithm or value.
+type SlsaDependency struct {
+	ent.Schema
+}
+
+func (SlsaDependency) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (SlsaDependency) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("uri").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("name").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("algorithm").Optional(),
+		field.String("value").Optional(),
+	}
+}
+
+func (SlsaDependency) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("slsa_provenance", SlsaProvenance.Type).Ref("resolved_dependencies").Unique().Required(),
+	}
+}
+
+func (SlsaDependency) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("value"),
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SlsaProvenance represents the build recorded by a SLSA provenance v1 predicate
+type SlsaProvenance struct {
+	ent.Schema
+}
+
+func (SlsaProvenance) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (SlsaProvenance) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("builder_id").NotEmpty().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("build_type").NotEmpty().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("invocation_id").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.Time("started_on").Optional().Nillable(),
+		field.Time("finished_on").Optional().Nillable(),
+		// the external parameters of the build as JSON, since their shape depends on the build type
+		field.String("external_parameters").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+	}
+}
+
+func (SlsaProvenance) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("resolved_dependencies", SlsaDependency.Type),
+		edge.From("statement", Statement.Type).Ref("slsa_provenance").Unique().Required(),
+	}
+}
+
+func (SlsaProvenance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("builder_id").Annotations(entsql.Prefix(255)),
+		index.Fields("build_type").Annotations(entsql.Prefix(255)),
+	}
+}
@@ -28,6 +28,12 @@ type Statement struct {
 	ent.Schema
 }
 
+func (Statement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Statement.
 func (Statement) Fields() []ent.Field {
 	return []ent.Field{
@@ -42,6 +48,9 @@ func (Statement) Edges() []ent.Edge {
 		edge.To("subjects", Subject.Type).Annotations(entgql.RelayConnection()),
 		edge.To("policy", AttestationPolicy.Type).Unique(),
 		edge.To("attestation_collections", AttestationCollection.Type).Unique(),
+		edge.To("slsa_provenance", SlsaProvenance.Type).Unique(),
+		edge.To("verification_summary", VerificationSummary.Type).Unique(),
+		edge.To("sbom_packages", SbomPackage.Type).Annotations(entgql.RelayConnection()),
 
 		edge.From("dsse", Dsse.Type).Ref("statement"),
 	}
@@ -31,6 +31,12 @@ type Subject struct {
 	ent.Schema
 }
 
+func (Subject) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Subject.
 func (Subject) Fields() []ent.Field {
 	return []ent.Field{
@@ -0,0 +1,63 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SubjectAltName is a subject alternative name of a certificate, such as the email or
+// workflow URI a Fulcio certificate was issued to
+type SubjectAltName struct {
+	ent.Schema
+}
+
+func (SubjectAltName) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (SubjectAltName) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.Enum("type").
+			NamedValues(
+				"Email", "EMAIL",
+				"URI", "URI",
+				"DNS", "DNS",
+				"IP", "IP",
+			),
+		field.String("value").NotEmpty().SchemaType(map[string]string{dialect.MySQL: "text"}),
+	}
+}
+
+func (SubjectAltName) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("certificate", Certificate.Type).Ref("subject_alt_names").Unique().Required(),
+	}
+}
+
+func (SubjectAltName) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("value").Annotations(entsql.Prefix(255)),
+	}
+}
@@ -27,6 +27,12 @@ type SubjectDigest struct {
 	ent.Schema
 }
 
+func (SubjectDigest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Digest.
 func (SubjectDigest) Fields() []ent.Field {
 	return []ent.Field{
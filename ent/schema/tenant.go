@@ -0,0 +1,100 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/in-toto/archivista/ent/intercept"
+	"github.com/in-toto/archivista/pkg/tenant"
+)
+
+const tenantField = "tenant"
+
+// TenantMixin scopes an entity to a tenant. Entities created with a tenant in their context
+// belong to it, and queries, updates and deletes made with a tenant in their context only
+// see that tenant's entities. Without a tenant in the context nothing is scoped.
+type TenantMixin struct {
+	mixin.Schema
+}
+
+// predicater is implemented by every generated mutation
+type predicater interface {
+	WhereP(...func(*sql.Selector))
+}
+
+func (TenantMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String(tenantField).
+			Default(tenant.Default).
+			NotEmpty().
+			Immutable().
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
+	}
+}
+
+func (TenantMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(tenantField),
+	}
+}
+
+func (TenantMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if t, ok := tenant.FromContext(ctx); ok {
+				q.WhereP(sql.FieldEQ(tenantField, t))
+			}
+
+			return nil
+		}),
+	}
+}
+
+func (TenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				t, ok := tenant.FromContext(ctx)
+				if !ok {
+					return next.Mutate(ctx, m)
+				}
+
+				if m.Op().Is(ent.OpCreate) {
+					if err := m.SetField(tenantField, t); err != nil {
+						return nil, err
+					}
+
+					return next.Mutate(ctx, m)
+				}
+
+				scoped, ok := m.(predicater)
+				if !ok {
+					return nil, fmt.Errorf("can not scope mutation %T to a tenant", m)
+				}
+
+				scoped.WhereP(sql.FieldEQ(tenantField, t))
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
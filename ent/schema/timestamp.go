@@ -25,6 +25,12 @@ type Timestamp struct {
 	ent.Schema
 }
 
+func (Timestamp) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 func (Timestamp) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
@@ -0,0 +1,60 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TransparencyLogEntry represents a transparency log entry a Sigstore bundle carried for an envelope
+type TransparencyLogEntry struct {
+	ent.Schema
+}
+
+func (TransparencyLogEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (TransparencyLogEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.Int64("log_index"),
+		field.String("log_id"),
+		field.String("kind").Optional(),
+		field.String("kind_version").Optional(),
+		field.Time("integrated_time").Optional().Nillable(),
+		// the entry as it appeared in the bundle, with its inclusion proof and promise
+		field.String("entry").NotEmpty().SchemaType(map[string]string{dialect.MySQL: "text"}),
+	}
+}
+
+func (TransparencyLogEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("dsse", Dsse.Type).Ref("transparency_log_entries").Unique().Required(),
+	}
+}
+
+func (TransparencyLogEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("log_id", "log_index"),
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// VerificationSummary represents the result recorded by an in-toto Verification Summary Attestation
+type VerificationSummary struct {
+	ent.Schema
+}
+
+func (VerificationSummary) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+func (VerificationSummary) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Unique(),
+		field.String("verifier_id").NotEmpty().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.Time("time_verified").Optional().Nillable(),
+		field.String("resource_uri").NotEmpty().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("policy_uri").Optional().SchemaType(map[string]string{dialect.MySQL: "text"}),
+		field.String("verification_result").NotEmpty(),
+		field.Strings("verified_levels").Optional(),
+		field.String("slsa_version").Optional(),
+	}
+}
+
+func (VerificationSummary) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("statement", Statement.Type).Ref("verification_summary").Unique().Required(),
+	}
+}
+
+func (VerificationSummary) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("verifier_id").Annotations(entsql.Prefix(255)),
+		index.Fields("resource_uri").Annotations(entsql.Prefix(255)),
+		index.Fields("verification_result"),
+	}
+}
@@ -18,10 +18,14 @@ type Signature struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// KeyID holds the value of the "key_id" field.
 	KeyID string `json:"key_id,omitempty"`
 	// Signature holds the value of the "signature" field.
 	Signature string `json:"signature,omitempty"`
+	// Verified holds the value of the "verified" field.
+	Verified bool `json:"verified,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SignatureQuery when eager-loading is set.
 	Edges           SignatureEdges `json:"edges"`
@@ -35,13 +39,16 @@ type SignatureEdges struct {
 	Dsse *Dsse `json:"dsse,omitempty"`
 	// Timestamps holds the value of the timestamps edge.
 	Timestamps []*Timestamp `json:"timestamps,omitempty"`
+	// Certificates holds the value of the certificates edge.
+	Certificates []*Certificate `json:"certificates,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 	// totalCount holds the count of the edges above.
-	totalCount [2]map[string]int
+	totalCount [3]map[string]int
 
-	namedTimestamps map[string][]*Timestamp
+	namedTimestamps   map[string][]*Timestamp
+	namedCertificates map[string][]*Certificate
 }
 
 // DsseOrErr returns the Dsse value or an error if the edge
@@ -64,12 +71,23 @@ func (e SignatureEdges) TimestampsOrErr() ([]*Timestamp, error) {
 	return nil, &NotLoadedError{edge: "timestamps"}
 }
 
+// CertificatesOrErr returns the Certificates value or an error if the edge
+// was not loaded in eager-loading.
+func (e SignatureEdges) CertificatesOrErr() ([]*Certificate, error) {
+	if e.loadedTypes[2] {
+		return e.Certificates, nil
+	}
+	return nil, &NotLoadedError{edge: "certificates"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Signature) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case signature.FieldKeyID, signature.FieldSignature:
+		case signature.FieldVerified:
+			values[i] = new(sql.NullBool)
+		case signature.FieldTenant, signature.FieldKeyID, signature.FieldSignature:
 			values[i] = new(sql.NullString)
 		case signature.FieldID:
 			values[i] = new(uuid.UUID)
@@ -96,6 +114,12 @@ func (_m *Signature) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case signature.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
 		case signature.FieldKeyID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field key_id", values[i])
@@ -108,6 +132,12 @@ func (_m *Signature) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Signature = value.String
 			}
+		case signature.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				_m.Verified = value.Bool
+			}
 		case signature.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field dsse_signatures", values[i])
@@ -138,6 +168,11 @@ func (_m *Signature) QueryTimestamps() *TimestampQuery {
 	return NewSignatureClient(_m.config).QueryTimestamps(_m)
 }
 
+// QueryCertificates queries the "certificates" edge of the Signature entity.
+func (_m *Signature) QueryCertificates() *CertificateQuery {
+	return NewSignatureClient(_m.config).QueryCertificates(_m)
+}
+
 // Update returns a builder for updating this Signature.
 // Note that you need to call Signature.Unwrap() before calling this method if this Signature
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -161,11 +196,17 @@ func (_m *Signature) String() string {
 	var builder strings.Builder
 	builder.WriteString("Signature(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("key_id=")
 	builder.WriteString(_m.KeyID)
 	builder.WriteString(", ")
 	builder.WriteString("signature=")
 	builder.WriteString(_m.Signature)
+	builder.WriteString(", ")
+	builder.WriteString("verified=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Verified))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -194,5 +235,29 @@ func (_m *Signature) appendNamedTimestamps(name string, edges ...*Timestamp) {
 	}
 }
 
+// NamedCertificates returns the Certificates named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Signature) NamedCertificates(name string) ([]*Certificate, error) {
+	if _m.Edges.namedCertificates == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedCertificates[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Signature) appendNamedCertificates(name string, edges ...*Certificate) {
+	if _m.Edges.namedCertificates == nil {
+		_m.Edges.namedCertificates = make(map[string][]*Certificate)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedCertificates[name] = []*Certificate{}
+	} else {
+		_m.Edges.namedCertificates[name] = append(_m.Edges.namedCertificates[name], edges...)
+	}
+}
+
 // Signatures is a parsable slice of Signature.
 type Signatures []*Signature
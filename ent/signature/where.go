@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.Signature {
 	return predicate.Signature(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldEQ(FieldTenant, v))
+}
+
 // KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
 func KeyID(v string) predicate.Signature {
 	return predicate.Signature(sql.FieldEQ(FieldKeyID, v))
@@ -64,6 +69,76 @@ func Signature(v string) predicate.Signature {
 	return predicate.Signature(sql.FieldEQ(FieldSignature, v))
 }
 
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.Signature {
+	return predicate.Signature(sql.FieldEQ(FieldVerified, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Signature {
+	return predicate.Signature(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Signature {
+	return predicate.Signature(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Signature {
+	return predicate.Signature(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // KeyIDEQ applies the EQ predicate on the "key_id" field.
 func KeyIDEQ(v string) predicate.Signature {
 	return predicate.Signature(sql.FieldEQ(FieldKeyID, v))
@@ -194,6 +269,16 @@ func SignatureContainsFold(v string) predicate.Signature {
 	return predicate.Signature(sql.FieldContainsFold(FieldSignature, v))
 }
 
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.Signature {
+	return predicate.Signature(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.Signature {
+	return predicate.Signature(sql.FieldNEQ(FieldVerified, v))
+}
+
 // HasDsse applies the HasEdge predicate on the "dsse" edge.
 func HasDsse() predicate.Signature {
 	return predicate.Signature(func(s *sql.Selector) {
@@ -240,6 +325,29 @@ func HasTimestampsWith(preds ...predicate.Timestamp) predicate.Signature {
 	})
 }
 
+// HasCertificates applies the HasEdge predicate on the "certificates" edge.
+func HasCertificates() predicate.Signature {
+	return predicate.Signature(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CertificatesTable, CertificatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCertificatesWith applies the HasEdge predicate on the "certificates" edge with a given conditions (other predicates).
+func HasCertificatesWith(preds ...predicate.Certificate) predicate.Signature {
+	return predicate.Signature(func(s *sql.Selector) {
+		step := newCertificatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Signature) predicate.Signature {
 	return predicate.Signature(sql.AndPredicates(predicates...))
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/certificate"
 	"github.com/in-toto/archivista/ent/dsse"
 	"github.com/in-toto/archivista/ent/signature"
 	"github.com/in-toto/archivista/ent/timestamp"
@@ -22,6 +23,20 @@ type SignatureCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *SignatureCreate) SetTenant(v string) *SignatureCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *SignatureCreate) SetNillableTenant(v *string) *SignatureCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetKeyID sets the "key_id" field.
 func (_c *SignatureCreate) SetKeyID(v string) *SignatureCreate {
 	_c.mutation.SetKeyID(v)
@@ -34,6 +49,20 @@ func (_c *SignatureCreate) SetSignature(v string) *SignatureCreate {
 	return _c
 }
 
+// SetVerified sets the "verified" field.
+func (_c *SignatureCreate) SetVerified(v bool) *SignatureCreate {
+	_c.mutation.SetVerified(v)
+	return _c
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (_c *SignatureCreate) SetNillableVerified(v *bool) *SignatureCreate {
+	if v != nil {
+		_c.SetVerified(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SignatureCreate) SetID(v uuid.UUID) *SignatureCreate {
 	_c.mutation.SetID(v)
@@ -82,6 +111,21 @@ func (_c *SignatureCreate) AddTimestamps(v ...*Timestamp) *SignatureCreate {
 	return _c.AddTimestampIDs(ids...)
 }
 
+// AddCertificateIDs adds the "certificates" edge to the Certificate entity by IDs.
+func (_c *SignatureCreate) AddCertificateIDs(ids ...uuid.UUID) *SignatureCreate {
+	_c.mutation.AddCertificateIDs(ids...)
+	return _c
+}
+
+// AddCertificates adds the "certificates" edges to the Certificate entity.
+func (_c *SignatureCreate) AddCertificates(v ...*Certificate) *SignatureCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCertificateIDs(ids...)
+}
+
 // Mutation returns the SignatureMutation object of the builder.
 func (_c *SignatureCreate) Mutation() *SignatureMutation {
 	return _c.mutation
@@ -89,7 +133,9 @@ func (_c *SignatureCreate) Mutation() *SignatureMutation {
 
 // Save creates the Signature in the database.
 func (_c *SignatureCreate) Save(ctx context.Context) (*Signature, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -116,15 +162,35 @@ func (_c *SignatureCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *SignatureCreate) defaults() {
+func (_c *SignatureCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := signature.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.Verified(); !ok {
+		v := signature.DefaultVerified
+		_c.mutation.SetVerified(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if signature.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized signature.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := signature.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *SignatureCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Signature.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := signature.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "Signature.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.KeyID(); !ok {
 		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "Signature.key_id"`)}
 	}
@@ -136,6 +202,9 @@ func (_c *SignatureCreate) check() error {
 			return &ValidationError{Name: "signature", err: fmt.Errorf(`ent: validator failed for field "Signature.signature": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Verified(); !ok {
+		return &ValidationError{Name: "verified", err: errors.New(`ent: missing required field "Signature.verified"`)}
+	}
 	return nil
 }
 
@@ -171,6 +240,10 @@ func (_c *SignatureCreate) createSpec() (*Signature, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(signature.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.KeyID(); ok {
 		_spec.SetField(signature.FieldKeyID, field.TypeString, value)
 		_node.KeyID = value
@@ -179,6 +252,10 @@ func (_c *SignatureCreate) createSpec() (*Signature, *sqlgraph.CreateSpec) {
 		_spec.SetField(signature.FieldSignature, field.TypeString, value)
 		_node.Signature = value
 	}
+	if value, ok := _c.mutation.Verified(); ok {
+		_spec.SetField(signature.FieldVerified, field.TypeBool, value)
+		_node.Verified = value
+	}
 	if nodes := _c.mutation.DsseIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -212,6 +289,22 @@ func (_c *SignatureCreate) createSpec() (*Signature, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CertificatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   signature.CertificatesTable,
+			Columns: []string{signature.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/certificate"
 	"github.com/in-toto/archivista/ent/dsse"
 	"github.com/in-toto/archivista/ent/predicate"
 	"github.com/in-toto/archivista/ent/signature"
@@ -22,16 +23,18 @@ import (
 // SignatureQuery is the builder for querying Signature entities.
 type SignatureQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []signature.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.Signature
-	withDsse            *DsseQuery
-	withTimestamps      *TimestampQuery
-	withFKs             bool
-	modifiers           []func(*sql.Selector)
-	loadTotal           []func(context.Context, []*Signature) error
-	withNamedTimestamps map[string]*TimestampQuery
+	ctx                   *QueryContext
+	order                 []signature.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Signature
+	withDsse              *DsseQuery
+	withTimestamps        *TimestampQuery
+	withCertificates      *CertificateQuery
+	withFKs               bool
+	modifiers             []func(*sql.Selector)
+	loadTotal             []func(context.Context, []*Signature) error
+	withNamedTimestamps   map[string]*TimestampQuery
+	withNamedCertificates map[string]*CertificateQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -112,6 +115,28 @@ func (_q *SignatureQuery) QueryTimestamps() *TimestampQuery {
 	return query
 }
 
+// QueryCertificates chains the current query on the "certificates" edge.
+func (_q *SignatureQuery) QueryCertificates() *CertificateQuery {
+	query := (&CertificateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(signature.Table, signature.FieldID, selector),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, signature.CertificatesTable, signature.CertificatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Signature entity from the query.
 // Returns a *NotFoundError when no Signature was found.
 func (_q *SignatureQuery) First(ctx context.Context) (*Signature, error) {
@@ -299,13 +324,14 @@ func (_q *SignatureQuery) Clone() *SignatureQuery {
 		return nil
 	}
 	return &SignatureQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]signature.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Signature{}, _q.predicates...),
-		withDsse:       _q.withDsse.Clone(),
-		withTimestamps: _q.withTimestamps.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]signature.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.Signature{}, _q.predicates...),
+		withDsse:         _q.withDsse.Clone(),
+		withTimestamps:   _q.withTimestamps.Clone(),
+		withCertificates: _q.withCertificates.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -334,18 +360,29 @@ func (_q *SignatureQuery) WithTimestamps(opts ...func(*TimestampQuery)) *Signatu
 	return _q
 }
 
+// WithCertificates tells the query-builder to eager-load the nodes that are connected to
+// the "certificates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SignatureQuery) WithCertificates(opts ...func(*CertificateQuery)) *SignatureQuery {
+	query := (&CertificateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCertificates = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		KeyID string `json:"key_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Signature.Query().
-//		GroupBy(signature.FieldKeyID).
+//		GroupBy(signature.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *SignatureQuery) GroupBy(field string, fields ...string) *SignatureGroupBy {
@@ -363,11 +400,11 @@ func (_q *SignatureQuery) GroupBy(field string, fields ...string) *SignatureGrou
 // Example:
 //
 //	var v []struct {
-//		KeyID string `json:"key_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Signature.Query().
-//		Select(signature.FieldKeyID).
+//		Select(signature.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *SignatureQuery) Select(fields ...string) *SignatureSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -413,9 +450,10 @@ func (_q *SignatureQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Si
 		nodes       = []*Signature{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withDsse != nil,
 			_q.withTimestamps != nil,
+			_q.withCertificates != nil,
 		}
 	)
 	if _q.withDsse != nil {
@@ -458,6 +496,13 @@ func (_q *SignatureQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Si
 			return nil, err
 		}
 	}
+	if query := _q.withCertificates; query != nil {
+		if err := _q.loadCertificates(ctx, query, nodes,
+			func(n *Signature) { n.Edges.Certificates = []*Certificate{} },
+			func(n *Signature, e *Certificate) { n.Edges.Certificates = append(n.Edges.Certificates, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedTimestamps {
 		if err := _q.loadTimestamps(ctx, query, nodes,
 			func(n *Signature) { n.appendNamedTimestamps(name) },
@@ -465,6 +510,13 @@ func (_q *SignatureQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Si
 			return nil, err
 		}
 	}
+	for name, query := range _q.withNamedCertificates {
+		if err := _q.loadCertificates(ctx, query, nodes,
+			func(n *Signature) { n.appendNamedCertificates(name) },
+			func(n *Signature, e *Certificate) { n.appendNamedCertificates(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range _q.loadTotal {
 		if err := _q.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -536,6 +588,37 @@ func (_q *SignatureQuery) loadTimestamps(ctx context.Context, query *TimestampQu
 	}
 	return nil
 }
+func (_q *SignatureQuery) loadCertificates(ctx context.Context, query *CertificateQuery, nodes []*Signature, init func(*Signature), assign func(*Signature, *Certificate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Signature)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Certificate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(signature.CertificatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.signature_certificates
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "signature_certificates" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "signature_certificates" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *SignatureQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -635,6 +718,20 @@ func (_q *SignatureQuery) WithNamedTimestamps(name string, opts ...func(*Timesta
 	return _q
 }
 
+// WithNamedCertificates tells the query-builder to eager-load the nodes that are connected to the "certificates"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *SignatureQuery) WithNamedCertificates(name string, opts ...func(*CertificateQuery)) *SignatureQuery {
+	query := (&CertificateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedCertificates == nil {
+		_q.withNamedCertificates = make(map[string]*CertificateQuery)
+	}
+	_q.withNamedCertificates[name] = query
+	return _q
+}
+
 // SignatureGroupBy is the group-by builder for Signature entities.
 type SignatureGroupBy struct {
 	selector
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/certificate"
 	"github.com/in-toto/archivista/ent/dsse"
 	"github.com/in-toto/archivista/ent/predicate"
 	"github.com/in-toto/archivista/ent/signature"
@@ -58,6 +59,20 @@ func (_u *SignatureUpdate) SetNillableSignature(v *string) *SignatureUpdate {
 	return _u
 }
 
+// SetVerified sets the "verified" field.
+func (_u *SignatureUpdate) SetVerified(v bool) *SignatureUpdate {
+	_u.mutation.SetVerified(v)
+	return _u
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (_u *SignatureUpdate) SetNillableVerified(v *bool) *SignatureUpdate {
+	if v != nil {
+		_u.SetVerified(*v)
+	}
+	return _u
+}
+
 // SetDsseID sets the "dsse" edge to the Dsse entity by ID.
 func (_u *SignatureUpdate) SetDsseID(id uuid.UUID) *SignatureUpdate {
 	_u.mutation.SetDsseID(id)
@@ -92,6 +107,21 @@ func (_u *SignatureUpdate) AddTimestamps(v ...*Timestamp) *SignatureUpdate {
 	return _u.AddTimestampIDs(ids...)
 }
 
+// AddCertificateIDs adds the "certificates" edge to the Certificate entity by IDs.
+func (_u *SignatureUpdate) AddCertificateIDs(ids ...uuid.UUID) *SignatureUpdate {
+	_u.mutation.AddCertificateIDs(ids...)
+	return _u
+}
+
+// AddCertificates adds the "certificates" edges to the Certificate entity.
+func (_u *SignatureUpdate) AddCertificates(v ...*Certificate) *SignatureUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCertificateIDs(ids...)
+}
+
 // Mutation returns the SignatureMutation object of the builder.
 func (_u *SignatureUpdate) Mutation() *SignatureMutation {
 	return _u.mutation
@@ -124,6 +154,27 @@ func (_u *SignatureUpdate) RemoveTimestamps(v ...*Timestamp) *SignatureUpdate {
 	return _u.RemoveTimestampIDs(ids...)
 }
 
+// ClearCertificates clears all "certificates" edges to the Certificate entity.
+func (_u *SignatureUpdate) ClearCertificates() *SignatureUpdate {
+	_u.mutation.ClearCertificates()
+	return _u
+}
+
+// RemoveCertificateIDs removes the "certificates" edge to Certificate entities by IDs.
+func (_u *SignatureUpdate) RemoveCertificateIDs(ids ...uuid.UUID) *SignatureUpdate {
+	_u.mutation.RemoveCertificateIDs(ids...)
+	return _u
+}
+
+// RemoveCertificates removes "certificates" edges to Certificate entities.
+func (_u *SignatureUpdate) RemoveCertificates(v ...*Certificate) *SignatureUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCertificateIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SignatureUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -179,6 +230,9 @@ func (_u *SignatureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Signature(); ok {
 		_spec.SetField(signature.FieldSignature, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Verified(); ok {
+		_spec.SetField(signature.FieldVerified, field.TypeBool, value)
+	}
 	if _u.mutation.DsseCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -253,6 +307,51 @@ func (_u *SignatureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CertificatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   signature.CertificatesTable,
+			Columns: []string{signature.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCertificatesIDs(); len(nodes) > 0 && !_u.mutation.CertificatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   signature.CertificatesTable,
+			Columns: []string{signature.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   signature.CertificatesTable,
+			Columns: []string{signature.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{signature.Label}
@@ -301,6 +400,20 @@ func (_u *SignatureUpdateOne) SetNillableSignature(v *string) *SignatureUpdateOn
 	return _u
 }
 
+// SetVerified sets the "verified" field.
+func (_u *SignatureUpdateOne) SetVerified(v bool) *SignatureUpdateOne {
+	_u.mutation.SetVerified(v)
+	return _u
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (_u *SignatureUpdateOne) SetNillableVerified(v *bool) *SignatureUpdateOne {
+	if v != nil {
+		_u.SetVerified(*v)
+	}
+	return _u
+}
+
 // SetDsseID sets the "dsse" edge to the Dsse entity by ID.
 func (_u *SignatureUpdateOne) SetDsseID(id uuid.UUID) *SignatureUpdateOne {
 	_u.mutation.SetDsseID(id)
@@ -335,6 +448,21 @@ func (_u *SignatureUpdateOne) AddTimestamps(v ...*Timestamp) *SignatureUpdateOne
 	return _u.AddTimestampIDs(ids...)
 }
 
+// AddCertificateIDs adds the "certificates" edge to the Certificate entity by IDs.
+func (_u *SignatureUpdateOne) AddCertificateIDs(ids ...uuid.UUID) *SignatureUpdateOne {
+	_u.mutation.AddCertificateIDs(ids...)
+	return _u
+}
+
+// AddCertificates adds the "certificates" edges to the Certificate entity.
+func (_u *SignatureUpdateOne) AddCertificates(v ...*Certificate) *SignatureUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCertificateIDs(ids...)
+}
+
 // Mutation returns the SignatureMutation object of the builder.
 func (_u *SignatureUpdateOne) Mutation() *SignatureMutation {
 	return _u.mutation
@@ -367,6 +495,27 @@ func (_u *SignatureUpdateOne) RemoveTimestamps(v ...*Timestamp) *SignatureUpdate
 	return _u.RemoveTimestampIDs(ids...)
 }
 
+// ClearCertificates clears all "certificates" edges to the Certificate entity.
+func (_u *SignatureUpdateOne) ClearCertificates() *SignatureUpdateOne {
+	_u.mutation.ClearCertificates()
+	return _u
+}
+
+// RemoveCertificateIDs removes the "certificates" edge to Certificate entities by IDs.
+func (_u *SignatureUpdateOne) RemoveCertificateIDs(ids ...uuid.UUID) *SignatureUpdateOne {
+	_u.mutation.RemoveCertificateIDs(ids...)
+	return _u
+}
+
+// RemoveCertificates removes "certificates" edges to Certificate entities.
+func (_u *SignatureUpdateOne) RemoveCertificates(v ...*Certificate) *SignatureUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCertificateIDs(ids...)
+}
+
 // Where appends a list predicates to the SignatureUpdate builder.
 func (_u *SignatureUpdateOne) Where(ps ...predicate.Signature) *SignatureUpdateOne {
 	_u.mutation.Where(ps...)
@@ -452,6 +601,9 @@ func (_u *SignatureUpdateOne) sqlSave(ctx context.Context) (_node *Signature, er
 	if value, ok := _u.mutation.Signature(); ok {
 		_spec.SetField(signature.FieldSignature, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Verified(); ok {
+		_spec.SetField(signature.FieldVerified, field.TypeBool, value)
+	}
 	if _u.mutation.DsseCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -526,6 +678,51 @@ func (_u *SignatureUpdateOne) sqlSave(ctx context.Context) (_node *Signature, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CertificatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   signature.CertificatesTable,
+			Columns: []string{signature.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCertificatesIDs(); len(nodes) > 0 && !_u.mutation.CertificatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   signature.CertificatesTable,
+			Columns: []string{signature.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   signature.CertificatesTable,
+			Columns: []string{signature.CertificatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Signature{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+)
+
+// SlsaDependency is the model entity for the SlsaDependency schema.
+type SlsaDependency struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Algorithm holds the value of the "algorithm" field.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SlsaDependencyQuery when eager-loading is set.
+	Edges                                 SlsaDependencyEdges `json:"edges"`
+	slsa_provenance_resolved_dependencies *uuid.UUID
+	selectValues                          sql.SelectValues
+}
+
+// SlsaDependencyEdges holds the relations/edges for other nodes in the graph.
+type SlsaDependencyEdges struct {
+	// SlsaProvenance holds the value of the slsa_provenance edge.
+	SlsaProvenance *SlsaProvenance `json:"slsa_provenance,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// SlsaProvenanceOrErr returns the SlsaProvenance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SlsaDependencyEdges) SlsaProvenanceOrErr() (*SlsaProvenance, error) {
+	if e.SlsaProvenance != nil {
+		return e.SlsaProvenance, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: slsaprovenance.Label}
+	}
+	return nil, &NotLoadedError{edge: "slsa_provenance"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SlsaDependency) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case slsadependency.FieldTenant, slsadependency.FieldURI, slsadependency.FieldName, slsadependency.FieldAlgorithm, slsadependency.FieldValue:
+			values[i] = new(sql.NullString)
+		case slsadependency.FieldID:
+			values[i] = new(uuid.UUID)
+		case slsadependency.ForeignKeys[0]: // slsa_provenance_resolved_dependencies
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SlsaDependency fields.
+func (_m *SlsaDependency) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case slsadependency.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case slsadependency.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case slsadependency.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				_m.URI = value.String
+			}
+		case slsadependency.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case slsadependency.FieldAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
+			} else if value.Valid {
+				_m.Algorithm = value.String
+			}
+		case slsadependency.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case slsadependency.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field slsa_provenance_resolved_dependencies", values[i])
+			} else if value.Valid {
+				_m.slsa_provenance_resolved_dependencies = new(uuid.UUID)
+				*_m.slsa_provenance_resolved_dependencies = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the SlsaDependency.
+// This includes values selected through modifiers, order, etc.
+func (_m *SlsaDependency) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySlsaProvenance queries the "slsa_provenance" edge of the SlsaDependency entity.
+func (_m *SlsaDependency) QuerySlsaProvenance() *SlsaProvenanceQuery {
+	return NewSlsaDependencyClient(_m.config).QuerySlsaProvenance(_m)
+}
+
+// Update returns a builder for updating this SlsaDependency.
+// Note that you need to call SlsaDependency.Unwrap() before calling this method if this SlsaDependency
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SlsaDependency) Update() *SlsaDependencyUpdateOne {
+	return NewSlsaDependencyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SlsaDependency entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SlsaDependency) Unwrap() *SlsaDependency {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SlsaDependency is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SlsaDependency) String() string {
+	var builder strings.Builder
+	builder.WriteString("SlsaDependency(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(_m.URI)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("algorithm=")
+	builder.WriteString(_m.Algorithm)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SlsaDependencies is a parsable slice of SlsaDependency.
+type SlsaDependencies []*SlsaDependency
@@ -0,0 +1,132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package slsadependency
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the slsadependency type in the database.
+	Label = "slsa_dependency"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAlgorithm holds the string denoting the algorithm field in the database.
+	FieldAlgorithm = "algorithm"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// EdgeSlsaProvenance holds the string denoting the slsa_provenance edge name in mutations.
+	EdgeSlsaProvenance = "slsa_provenance"
+	// Table holds the table name of the slsadependency in the database.
+	Table = "slsa_dependencies"
+	// SlsaProvenanceTable is the table that holds the slsa_provenance relation/edge.
+	SlsaProvenanceTable = "slsa_dependencies"
+	// SlsaProvenanceInverseTable is the table name for the SlsaProvenance entity.
+	// It exists in this package in order to avoid circular dependency with the "slsaprovenance" package.
+	SlsaProvenanceInverseTable = "slsa_provenances"
+	// SlsaProvenanceColumn is the table column denoting the slsa_provenance relation/edge.
+	SlsaProvenanceColumn = "slsa_provenance_resolved_dependencies"
+)
+
+// Columns holds all SQL columns for slsadependency fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldURI,
+	FieldName,
+	FieldAlgorithm,
+	FieldValue,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "slsa_dependencies"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"slsa_provenance_resolved_dependencies",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SlsaDependency queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByAlgorithm orders the results by the algorithm field.
+func ByAlgorithm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlgorithm, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// BySlsaProvenanceField orders the results by slsa_provenance field.
+func BySlsaProvenanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSlsaProvenanceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSlsaProvenanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SlsaProvenanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SlsaProvenanceTable, SlsaProvenanceColumn),
+	)
+}
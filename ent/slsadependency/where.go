@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package slsadependency
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldTenant, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldURI, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldName, v))
+}
+
+// Algorithm applies equality check predicate on the "algorithm" field. It's identical to AlgorithmEQ.
+func Algorithm(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldValue, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIIsNil applies the IsNil predicate on the "uri" field.
+func URIIsNil() predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIsNull(FieldURI))
+}
+
+// URINotNil applies the NotNil predicate on the "uri" field.
+func URINotNil() predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotNull(FieldURI))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContainsFold(FieldURI, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContainsFold(FieldName, v))
+}
+
+// AlgorithmEQ applies the EQ predicate on the "algorithm" field.
+func AlgorithmEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmNEQ applies the NEQ predicate on the "algorithm" field.
+func AlgorithmNEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmIn applies the In predicate on the "algorithm" field.
+func AlgorithmIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmNotIn applies the NotIn predicate on the "algorithm" field.
+func AlgorithmNotIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmGT applies the GT predicate on the "algorithm" field.
+func AlgorithmGT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGT(FieldAlgorithm, v))
+}
+
+// AlgorithmGTE applies the GTE predicate on the "algorithm" field.
+func AlgorithmGTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGTE(FieldAlgorithm, v))
+}
+
+// AlgorithmLT applies the LT predicate on the "algorithm" field.
+func AlgorithmLT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLT(FieldAlgorithm, v))
+}
+
+// AlgorithmLTE applies the LTE predicate on the "algorithm" field.
+func AlgorithmLTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLTE(FieldAlgorithm, v))
+}
+
+// AlgorithmContains applies the Contains predicate on the "algorithm" field.
+func AlgorithmContains(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContains(FieldAlgorithm, v))
+}
+
+// AlgorithmHasPrefix applies the HasPrefix predicate on the "algorithm" field.
+func AlgorithmHasPrefix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasPrefix(FieldAlgorithm, v))
+}
+
+// AlgorithmHasSuffix applies the HasSuffix predicate on the "algorithm" field.
+func AlgorithmHasSuffix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasSuffix(FieldAlgorithm, v))
+}
+
+// AlgorithmIsNil applies the IsNil predicate on the "algorithm" field.
+func AlgorithmIsNil() predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIsNull(FieldAlgorithm))
+}
+
+// AlgorithmNotNil applies the NotNil predicate on the "algorithm" field.
+func AlgorithmNotNil() predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotNull(FieldAlgorithm))
+}
+
+// AlgorithmEqualFold applies the EqualFold predicate on the "algorithm" field.
+func AlgorithmEqualFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEqualFold(FieldAlgorithm, v))
+}
+
+// AlgorithmContainsFold applies the ContainsFold predicate on the "algorithm" field.
+func AlgorithmContainsFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContainsFold(FieldAlgorithm, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueIsNil applies the IsNil predicate on the "value" field.
+func ValueIsNil() predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldIsNull(FieldValue))
+}
+
+// ValueNotNil applies the NotNil predicate on the "value" field.
+func ValueNotNil() predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldNotNull(FieldValue))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.FieldContainsFold(FieldValue, v))
+}
+
+// HasSlsaProvenance applies the HasEdge predicate on the "slsa_provenance" edge.
+func HasSlsaProvenance() predicate.SlsaDependency {
+	return predicate.SlsaDependency(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SlsaProvenanceTable, SlsaProvenanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSlsaProvenanceWith applies the HasEdge predicate on the "slsa_provenance" edge with a given conditions (other predicates).
+func HasSlsaProvenanceWith(preds ...predicate.SlsaProvenance) predicate.SlsaDependency {
+	return predicate.SlsaDependency(func(s *sql.Selector) {
+		step := newSlsaProvenanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SlsaDependency) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SlsaDependency) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SlsaDependency) predicate.SlsaDependency {
+	return predicate.SlsaDependency(sql.NotPredicates(p))
+}
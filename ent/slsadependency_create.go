@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+)
+
+// SlsaDependencyCreate is the builder for creating a SlsaDependency entity.
+type SlsaDependencyCreate struct {
+	config
+	mutation *SlsaDependencyMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *SlsaDependencyCreate) SetTenant(v string) *SlsaDependencyCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *SlsaDependencyCreate) SetNillableTenant(v *string) *SlsaDependencyCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetURI sets the "uri" field.
+func (_c *SlsaDependencyCreate) SetURI(v string) *SlsaDependencyCreate {
+	_c.mutation.SetURI(v)
+	return _c
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (_c *SlsaDependencyCreate) SetNillableURI(v *string) *SlsaDependencyCreate {
+	if v != nil {
+		_c.SetURI(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SlsaDependencyCreate) SetName(v string) *SlsaDependencyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *SlsaDependencyCreate) SetNillableName(v *string) *SlsaDependencyCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_c *SlsaDependencyCreate) SetAlgorithm(v string) *SlsaDependencyCreate {
+	_c.mutation.SetAlgorithm(v)
+	return _c
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_c *SlsaDependencyCreate) SetNillableAlgorithm(v *string) *SlsaDependencyCreate {
+	if v != nil {
+		_c.SetAlgorithm(*v)
+	}
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *SlsaDependencyCreate) SetValue(v string) *SlsaDependencyCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *SlsaDependencyCreate) SetNillableValue(v *string) *SlsaDependencyCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SlsaDependencyCreate) SetID(v uuid.UUID) *SlsaDependencyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SlsaDependencyCreate) SetNillableID(v *uuid.UUID) *SlsaDependencyCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by ID.
+func (_c *SlsaDependencyCreate) SetSlsaProvenanceID(id uuid.UUID) *SlsaDependencyCreate {
+	_c.mutation.SetSlsaProvenanceID(id)
+	return _c
+}
+
+// SetSlsaProvenance sets the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_c *SlsaDependencyCreate) SetSlsaProvenance(v *SlsaProvenance) *SlsaDependencyCreate {
+	return _c.SetSlsaProvenanceID(v.ID)
+}
+
+// Mutation returns the SlsaDependencyMutation object of the builder.
+func (_c *SlsaDependencyCreate) Mutation() *SlsaDependencyMutation {
+	return _c.mutation
+}
+
+// Save creates the SlsaDependency in the database.
+func (_c *SlsaDependencyCreate) Save(ctx context.Context) (*SlsaDependency, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SlsaDependencyCreate) SaveX(ctx context.Context) *SlsaDependency {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SlsaDependencyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SlsaDependencyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SlsaDependencyCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := slsadependency.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if slsadependency.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized slsadependency.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := slsadependency.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SlsaDependencyCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "SlsaDependency.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := slsadependency.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "SlsaDependency.tenant": %w`, err)}
+		}
+	}
+	if len(_c.mutation.SlsaProvenanceIDs()) == 0 {
+		return &ValidationError{Name: "slsa_provenance", err: errors.New(`ent: missing required edge "SlsaDependency.slsa_provenance"`)}
+	}
+	return nil
+}
+
+func (_c *SlsaDependencyCreate) sqlSave(ctx context.Context) (*SlsaDependency, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SlsaDependencyCreate) createSpec() (*SlsaDependency, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SlsaDependency{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(slsadependency.Table, sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(slsadependency.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.URI(); ok {
+		_spec.SetField(slsadependency.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(slsadependency.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Algorithm(); ok {
+		_spec.SetField(slsadependency.FieldAlgorithm, field.TypeString, value)
+		_node.Algorithm = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(slsadependency.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if nodes := _c.mutation.SlsaProvenanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slsadependency.SlsaProvenanceTable,
+			Columns: []string{slsadependency.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.slsa_provenance_resolved_dependencies = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SlsaDependencyCreateBulk is the builder for creating many SlsaDependency entities in bulk.
+type SlsaDependencyCreateBulk struct {
+	config
+	err      error
+	builders []*SlsaDependencyCreate
+}
+
+// Save creates the SlsaDependency entities in the database.
+func (_c *SlsaDependencyCreateBulk) Save(ctx context.Context) ([]*SlsaDependency, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SlsaDependency, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SlsaDependencyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SlsaDependencyCreateBulk) SaveX(ctx context.Context) []*SlsaDependency {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SlsaDependencyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SlsaDependencyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+)
+
+// SlsaDependencyUpdate is the builder for updating SlsaDependency entities.
+type SlsaDependencyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SlsaDependencyMutation
+}
+
+// Where appends a list predicates to the SlsaDependencyUpdate builder.
+func (_u *SlsaDependencyUpdate) Where(ps ...predicate.SlsaDependency) *SlsaDependencyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetURI sets the "uri" field.
+func (_u *SlsaDependencyUpdate) SetURI(v string) *SlsaDependencyUpdate {
+	_u.mutation.SetURI(v)
+	return _u
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (_u *SlsaDependencyUpdate) SetNillableURI(v *string) *SlsaDependencyUpdate {
+	if v != nil {
+		_u.SetURI(*v)
+	}
+	return _u
+}
+
+// ClearURI clears the value of the "uri" field.
+func (_u *SlsaDependencyUpdate) ClearURI() *SlsaDependencyUpdate {
+	_u.mutation.ClearURI()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SlsaDependencyUpdate) SetName(v string) *SlsaDependencyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SlsaDependencyUpdate) SetNillableName(v *string) *SlsaDependencyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *SlsaDependencyUpdate) ClearName() *SlsaDependencyUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *SlsaDependencyUpdate) SetAlgorithm(v string) *SlsaDependencyUpdate {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *SlsaDependencyUpdate) SetNillableAlgorithm(v *string) *SlsaDependencyUpdate {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// ClearAlgorithm clears the value of the "algorithm" field.
+func (_u *SlsaDependencyUpdate) ClearAlgorithm() *SlsaDependencyUpdate {
+	_u.mutation.ClearAlgorithm()
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *SlsaDependencyUpdate) SetValue(v string) *SlsaDependencyUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *SlsaDependencyUpdate) SetNillableValue(v *string) *SlsaDependencyUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// ClearValue clears the value of the "value" field.
+func (_u *SlsaDependencyUpdate) ClearValue() *SlsaDependencyUpdate {
+	_u.mutation.ClearValue()
+	return _u
+}
+
+// SetSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by ID.
+func (_u *SlsaDependencyUpdate) SetSlsaProvenanceID(id uuid.UUID) *SlsaDependencyUpdate {
+	_u.mutation.SetSlsaProvenanceID(id)
+	return _u
+}
+
+// SetSlsaProvenance sets the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_u *SlsaDependencyUpdate) SetSlsaProvenance(v *SlsaProvenance) *SlsaDependencyUpdate {
+	return _u.SetSlsaProvenanceID(v.ID)
+}
+
+// Mutation returns the SlsaDependencyMutation object of the builder.
+func (_u *SlsaDependencyUpdate) Mutation() *SlsaDependencyMutation {
+	return _u.mutation
+}
+
+// ClearSlsaProvenance clears the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_u *SlsaDependencyUpdate) ClearSlsaProvenance() *SlsaDependencyUpdate {
+	_u.mutation.ClearSlsaProvenance()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SlsaDependencyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SlsaDependencyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SlsaDependencyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SlsaDependencyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SlsaDependencyUpdate) check() error {
+	if _u.mutation.SlsaProvenanceCleared() && len(_u.mutation.SlsaProvenanceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SlsaDependency.slsa_provenance"`)
+	}
+	return nil
+}
+
+func (_u *SlsaDependencyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slsadependency.Table, slsadependency.Columns, sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.URI(); ok {
+		_spec.SetField(slsadependency.FieldURI, field.TypeString, value)
+	}
+	if _u.mutation.URICleared() {
+		_spec.ClearField(slsadependency.FieldURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(slsadependency.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(slsadependency.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(slsadependency.FieldAlgorithm, field.TypeString, value)
+	}
+	if _u.mutation.AlgorithmCleared() {
+		_spec.ClearField(slsadependency.FieldAlgorithm, field.TypeString)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(slsadependency.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(slsadependency.FieldValue, field.TypeString)
+	}
+	if _u.mutation.SlsaProvenanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slsadependency.SlsaProvenanceTable,
+			Columns: []string{slsadependency.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlsaProvenanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slsadependency.SlsaProvenanceTable,
+			Columns: []string{slsadependency.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slsadependency.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SlsaDependencyUpdateOne is the builder for updating a single SlsaDependency entity.
+type SlsaDependencyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SlsaDependencyMutation
+}
+
+// SetURI sets the "uri" field.
+func (_u *SlsaDependencyUpdateOne) SetURI(v string) *SlsaDependencyUpdateOne {
+	_u.mutation.SetURI(v)
+	return _u
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (_u *SlsaDependencyUpdateOne) SetNillableURI(v *string) *SlsaDependencyUpdateOne {
+	if v != nil {
+		_u.SetURI(*v)
+	}
+	return _u
+}
+
+// ClearURI clears the value of the "uri" field.
+func (_u *SlsaDependencyUpdateOne) ClearURI() *SlsaDependencyUpdateOne {
+	_u.mutation.ClearURI()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SlsaDependencyUpdateOne) SetName(v string) *SlsaDependencyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SlsaDependencyUpdateOne) SetNillableName(v *string) *SlsaDependencyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *SlsaDependencyUpdateOne) ClearName() *SlsaDependencyUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *SlsaDependencyUpdateOne) SetAlgorithm(v string) *SlsaDependencyUpdateOne {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *SlsaDependencyUpdateOne) SetNillableAlgorithm(v *string) *SlsaDependencyUpdateOne {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// ClearAlgorithm clears the value of the "algorithm" field.
+func (_u *SlsaDependencyUpdateOne) ClearAlgorithm() *SlsaDependencyUpdateOne {
+	_u.mutation.ClearAlgorithm()
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *SlsaDependencyUpdateOne) SetValue(v string) *SlsaDependencyUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *SlsaDependencyUpdateOne) SetNillableValue(v *string) *SlsaDependencyUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// ClearValue clears the value of the "value" field.
+func (_u *SlsaDependencyUpdateOne) ClearValue() *SlsaDependencyUpdateOne {
+	_u.mutation.ClearValue()
+	return _u
+}
+
+// SetSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by ID.
+func (_u *SlsaDependencyUpdateOne) SetSlsaProvenanceID(id uuid.UUID) *SlsaDependencyUpdateOne {
+	_u.mutation.SetSlsaProvenanceID(id)
+	return _u
+}
+
+// SetSlsaProvenance sets the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_u *SlsaDependencyUpdateOne) SetSlsaProvenance(v *SlsaProvenance) *SlsaDependencyUpdateOne {
+	return _u.SetSlsaProvenanceID(v.ID)
+}
+
+// Mutation returns the SlsaDependencyMutation object of the builder.
+func (_u *SlsaDependencyUpdateOne) Mutation() *SlsaDependencyMutation {
+	return _u.mutation
+}
+
+// ClearSlsaProvenance clears the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_u *SlsaDependencyUpdateOne) ClearSlsaProvenance() *SlsaDependencyUpdateOne {
+	_u.mutation.ClearSlsaProvenance()
+	return _u
+}
+
+// Where appends a list predicates to the SlsaDependencyUpdate builder.
+func (_u *SlsaDependencyUpdateOne) Where(ps ...predicate.SlsaDependency) *SlsaDependencyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SlsaDependencyUpdateOne) Select(field string, fields ...string) *SlsaDependencyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SlsaDependency entity.
+func (_u *SlsaDependencyUpdateOne) Save(ctx context.Context) (*SlsaDependency, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SlsaDependencyUpdateOne) SaveX(ctx context.Context) *SlsaDependency {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SlsaDependencyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SlsaDependencyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SlsaDependencyUpdateOne) check() error {
+	if _u.mutation.SlsaProvenanceCleared() && len(_u.mutation.SlsaProvenanceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SlsaDependency.slsa_provenance"`)
+	}
+	return nil
+}
+
+func (_u *SlsaDependencyUpdateOne) sqlSave(ctx context.Context) (_node *SlsaDependency, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slsadependency.Table, slsadependency.Columns, sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SlsaDependency.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, slsadependency.FieldID)
+		for _, f := range fields {
+			if !slsadependency.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != slsadependency.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.URI(); ok {
+		_spec.SetField(slsadependency.FieldURI, field.TypeString, value)
+	}
+	if _u.mutation.URICleared() {
+		_spec.ClearField(slsadependency.FieldURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(slsadependency.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(slsadependency.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(slsadependency.FieldAlgorithm, field.TypeString, value)
+	}
+	if _u.mutation.AlgorithmCleared() {
+		_spec.ClearField(slsadependency.FieldAlgorithm, field.TypeString)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(slsadependency.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(slsadependency.FieldValue, field.TypeString)
+	}
+	if _u.mutation.SlsaProvenanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slsadependency.SlsaProvenanceTable,
+			Columns: []string{slsadependency.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlsaProvenanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slsadependency.SlsaProvenanceTable,
+			Columns: []string{slsadependency.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SlsaDependency{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slsadependency.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
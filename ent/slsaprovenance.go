@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+	"github.com/in-toto/archivista/ent/statement"
+)
+
+// SlsaProvenance is the model entity for the SlsaProvenance schema.
+type SlsaProvenance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// BuilderID holds the value of the "builder_id" field.
+	BuilderID string `json:"builder_id,omitempty"`
+	// BuildType holds the value of the "build_type" field.
+	BuildType string `json:"build_type,omitempty"`
+	// InvocationID holds the value of the "invocation_id" field.
+	InvocationID string `json:"invocation_id,omitempty"`
+	// StartedOn holds the value of the "started_on" field.
+	StartedOn *time.Time `json:"started_on,omitempty"`
+	// FinishedOn holds the value of the "finished_on" field.
+	FinishedOn *time.Time `json:"finished_on,omitempty"`
+	// ExternalParameters holds the value of the "external_parameters" field.
+	ExternalParameters string `json:"external_parameters,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SlsaProvenanceQuery when eager-loading is set.
+	Edges                     SlsaProvenanceEdges `json:"edges"`
+	statement_slsa_provenance *uuid.UUID
+	selectValues              sql.SelectValues
+}
+
+// SlsaProvenanceEdges holds the relations/edges for other nodes in the graph.
+type SlsaProvenanceEdges struct {
+	// ResolvedDependencies holds the value of the resolved_dependencies edge.
+	ResolvedDependencies []*SlsaDependency `json:"resolved_dependencies,omitempty"`
+	// Statement holds the value of the statement edge.
+	Statement *Statement `json:"statement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedResolvedDependencies map[string][]*SlsaDependency
+}
+
+// ResolvedDependenciesOrErr returns the ResolvedDependencies value or an error if the edge
+// was not loaded in eager-loading.
+func (e SlsaProvenanceEdges) ResolvedDependenciesOrErr() ([]*SlsaDependency, error) {
+	if e.loadedTypes[0] {
+		return e.ResolvedDependencies, nil
+	}
+	return nil, &NotLoadedError{edge: "resolved_dependencies"}
+}
+
+// StatementOrErr returns the Statement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SlsaProvenanceEdges) StatementOrErr() (*Statement, error) {
+	if e.Statement != nil {
+		return e.Statement, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: statement.Label}
+	}
+	return nil, &NotLoadedError{edge: "statement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SlsaProvenance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case slsaprovenance.FieldTenant, slsaprovenance.FieldBuilderID, slsaprovenance.FieldBuildType, slsaprovenance.FieldInvocationID, slsaprovenance.FieldExternalParameters:
+			values[i] = new(sql.NullString)
+		case slsaprovenance.FieldStartedOn, slsaprovenance.FieldFinishedOn:
+			values[i] = new(sql.NullTime)
+		case slsaprovenance.FieldID:
+			values[i] = new(uuid.UUID)
+		case slsaprovenance.ForeignKeys[0]: // statement_slsa_provenance
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SlsaProvenance fields.
+func (_m *SlsaProvenance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case slsaprovenance.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case slsaprovenance.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case slsaprovenance.FieldBuilderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field builder_id", values[i])
+			} else if value.Valid {
+				_m.BuilderID = value.String
+			}
+		case slsaprovenance.FieldBuildType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_type", values[i])
+			} else if value.Valid {
+				_m.BuildType = value.String
+			}
+		case slsaprovenance.FieldInvocationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invocation_id", values[i])
+			} else if value.Valid {
+				_m.InvocationID = value.String
+			}
+		case slsaprovenance.FieldStartedOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_on", values[i])
+			} else if value.Valid {
+				_m.StartedOn = new(time.Time)
+				*_m.StartedOn = value.Time
+			}
+		case slsaprovenance.FieldFinishedOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_on", values[i])
+			} else if value.Valid {
+				_m.FinishedOn = new(time.Time)
+				*_m.FinishedOn = value.Time
+			}
+		case slsaprovenance.FieldExternalParameters:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_parameters", values[i])
+			} else if value.Valid {
+				_m.ExternalParameters = value.String
+			}
+		case slsaprovenance.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_slsa_provenance", values[i])
+			} else if value.Valid {
+				_m.statement_slsa_provenance = new(uuid.UUID)
+				*_m.statement_slsa_provenance = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SlsaProvenance.
+// This includes values selected through modifiers, order, etc.
+func (_m *SlsaProvenance) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryResolvedDependencies queries the "resolved_dependencies" edge of the SlsaProvenance entity.
+func (_m *SlsaProvenance) QueryResolvedDependencies() *SlsaDependencyQuery {
+	return NewSlsaProvenanceClient(_m.config).QueryResolvedDependencies(_m)
+}
+
+// QueryStatement queries the "statement" edge of the SlsaProvenance entity.
+func (_m *SlsaProvenance) QueryStatement() *StatementQuery {
+	return NewSlsaProvenanceClient(_m.config).QueryStatement(_m)
+}
+
+// Update returns a builder for updating this SlsaProvenance.
+// Note that you need to call SlsaProvenance.Unwrap() before calling this method if this SlsaProvenance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SlsaProvenance) Update() *SlsaProvenanceUpdateOne {
+	return NewSlsaProvenanceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SlsaProvenance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SlsaProvenance) Unwrap() *SlsaProvenance {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SlsaProvenance is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SlsaProvenance) String() string {
+	var builder strings.Builder
+	builder.WriteString("SlsaProvenance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("builder_id=")
+	builder.WriteString(_m.BuilderID)
+	builder.WriteString(", ")
+	builder.WriteString("build_type=")
+	builder.WriteString(_m.BuildType)
+	builder.WriteString(", ")
+	builder.WriteString("invocation_id=")
+	builder.WriteString(_m.InvocationID)
+	builder.WriteString(", ")
+	if v := _m.StartedOn; v != nil {
+		builder.WriteString("started_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedOn; v != nil {
+		builder.WriteString("finished_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("external_parameters=")
+	builder.WriteString(_m.ExternalParameters)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedResolvedDependencies returns the ResolvedDependencies named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *SlsaProvenance) NamedResolvedDependencies(name string) ([]*SlsaDependency, error) {
+	if _m.Edges.namedResolvedDependencies == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedResolvedDependencies[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *SlsaProvenance) appendNamedResolvedDependencies(name string, edges ...*SlsaDependency) {
+	if _m.Edges.namedResolvedDependencies == nil {
+		_m.Edges.namedResolvedDependencies = make(map[string][]*SlsaDependency)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedResolvedDependencies[name] = []*SlsaDependency{}
+	} else {
+		_m.Edges.namedResolvedDependencies[name] = append(_m.Edges.namedResolvedDependencies[name], edges...)
+	}
+}
+
+// SlsaProvenances is a parsable slice of SlsaProvenance.
+type SlsaProvenances []*SlsaProvenance
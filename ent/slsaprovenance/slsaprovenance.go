@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package slsaprovenance
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the slsaprovenance type in the database.
+	Label = "slsa_provenance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldBuilderID holds the string denoting the builder_id field in the database.
+	FieldBuilderID = "builder_id"
+	// FieldBuildType holds the string denoting the build_type field in the database.
+	FieldBuildType = "build_type"
+	// FieldInvocationID holds the string denoting the invocation_id field in the database.
+	FieldInvocationID = "invocation_id"
+	// FieldStartedOn holds the string denoting the started_on field in the database.
+	FieldStartedOn = "started_on"
+	// FieldFinishedOn holds the string denoting the finished_on field in the database.
+	FieldFinishedOn = "finished_on"
+	// FieldExternalParameters holds the string denoting the external_parameters field in the database.
+	FieldExternalParameters = "external_parameters"
+	// EdgeResolvedDependencies holds the string denoting the resolved_dependencies edge name in mutations.
+	EdgeResolvedDependencies = "resolved_dependencies"
+	// EdgeStatement holds the string denoting the statement edge name in mutations.
+	EdgeStatement = "statement"
+	// Table holds the table name of the slsaprovenance in the database.
+	Table = "slsa_provenances"
+	// ResolvedDependenciesTable is the table that holds the resolved_dependencies relation/edge.
+	ResolvedDependenciesTable = "slsa_dependencies"
+	// ResolvedDependenciesInverseTable is the table name for the SlsaDependency entity.
+	// It exists in this package in order to avoid circular dependency with the "slsadependency" package.
+	ResolvedDependenciesInverseTable = "slsa_dependencies"
+	// ResolvedDependenciesColumn is the table column denoting the resolved_dependencies relation/edge.
+	ResolvedDependenciesColumn = "slsa_provenance_resolved_dependencies"
+	// StatementTable is the table that holds the statement relation/edge.
+	StatementTable = "slsa_provenances"
+	// StatementInverseTable is the table name for the Statement entity.
+	// It exists in this package in order to avoid circular dependency with the "statement" package.
+	StatementInverseTable = "statements"
+	// StatementColumn is the table column denoting the statement relation/edge.
+	StatementColumn = "statement_slsa_provenance"
+)
+
+// Columns holds all SQL columns for slsaprovenance fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldBuilderID,
+	FieldBuildType,
+	FieldInvocationID,
+	FieldStartedOn,
+	FieldFinishedOn,
+	FieldExternalParameters,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "slsa_provenances"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"statement_slsa_provenance",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// BuilderIDValidator is a validator for the "builder_id" field. It is called by the builders before save.
+	BuilderIDValidator func(string) error
+	// BuildTypeValidator is a validator for the "build_type" field. It is called by the builders before save.
+	BuildTypeValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SlsaProvenance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByBuilderID orders the results by the builder_id field.
+func ByBuilderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuilderID, opts...).ToFunc()
+}
+
+// ByBuildType orders the results by the build_type field.
+func ByBuildType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildType, opts...).ToFunc()
+}
+
+// ByInvocationID orders the results by the invocation_id field.
+func ByInvocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvocationID, opts...).ToFunc()
+}
+
+// ByStartedOn orders the results by the started_on field.
+func ByStartedOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedOn, opts...).ToFunc()
+}
+
+// ByFinishedOn orders the results by the finished_on field.
+func ByFinishedOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedOn, opts...).ToFunc()
+}
+
+// ByExternalParameters orders the results by the external_parameters field.
+func ByExternalParameters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalParameters, opts...).ToFunc()
+}
+
+// ByResolvedDependenciesCount orders the results by resolved_dependencies count.
+func ByResolvedDependenciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newResolvedDependenciesStep(), opts...)
+	}
+}
+
+// ByResolvedDependencies orders the results by resolved_dependencies terms.
+func ByResolvedDependencies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResolvedDependenciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatementField orders the results by statement field.
+func ByStatementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatementStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newResolvedDependenciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResolvedDependenciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ResolvedDependenciesTable, ResolvedDependenciesColumn),
+	)
+}
+func newStatementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, StatementTable, StatementColumn),
+	)
+}
@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package slsaprovenance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldTenant, v))
+}
+
+// BuilderID applies equality check predicate on the "builder_id" field. It's identical to BuilderIDEQ.
+func BuilderID(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldBuilderID, v))
+}
+
+// BuildType applies equality check predicate on the "build_type" field. It's identical to BuildTypeEQ.
+func BuildType(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldBuildType, v))
+}
+
+// InvocationID applies equality check predicate on the "invocation_id" field. It's identical to InvocationIDEQ.
+func InvocationID(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldInvocationID, v))
+}
+
+// StartedOn applies equality check predicate on the "started_on" field. It's identical to StartedOnEQ.
+func StartedOn(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldStartedOn, v))
+}
+
+// FinishedOn applies equality check predicate on the "finished_on" field. It's identical to FinishedOnEQ.
+func FinishedOn(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldFinishedOn, v))
+}
+
+// ExternalParameters applies equality check predicate on the "external_parameters" field. It's identical to ExternalParametersEQ.
+func ExternalParameters(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldExternalParameters, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// BuilderIDEQ applies the EQ predicate on the "builder_id" field.
+func BuilderIDEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldBuilderID, v))
+}
+
+// BuilderIDNEQ applies the NEQ predicate on the "builder_id" field.
+func BuilderIDNEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNEQ(FieldBuilderID, v))
+}
+
+// BuilderIDIn applies the In predicate on the "builder_id" field.
+func BuilderIDIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIn(FieldBuilderID, vs...))
+}
+
+// BuilderIDNotIn applies the NotIn predicate on the "builder_id" field.
+func BuilderIDNotIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotIn(FieldBuilderID, vs...))
+}
+
+// BuilderIDGT applies the GT predicate on the "builder_id" field.
+func BuilderIDGT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGT(FieldBuilderID, v))
+}
+
+// BuilderIDGTE applies the GTE predicate on the "builder_id" field.
+func BuilderIDGTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGTE(FieldBuilderID, v))
+}
+
+// BuilderIDLT applies the LT predicate on the "builder_id" field.
+func BuilderIDLT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLT(FieldBuilderID, v))
+}
+
+// BuilderIDLTE applies the LTE predicate on the "builder_id" field.
+func BuilderIDLTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLTE(FieldBuilderID, v))
+}
+
+// BuilderIDContains applies the Contains predicate on the "builder_id" field.
+func BuilderIDContains(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContains(FieldBuilderID, v))
+}
+
+// BuilderIDHasPrefix applies the HasPrefix predicate on the "builder_id" field.
+func BuilderIDHasPrefix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasPrefix(FieldBuilderID, v))
+}
+
+// BuilderIDHasSuffix applies the HasSuffix predicate on the "builder_id" field.
+func BuilderIDHasSuffix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasSuffix(FieldBuilderID, v))
+}
+
+// BuilderIDEqualFold applies the EqualFold predicate on the "builder_id" field.
+func BuilderIDEqualFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEqualFold(FieldBuilderID, v))
+}
+
+// BuilderIDContainsFold applies the ContainsFold predicate on the "builder_id" field.
+func BuilderIDContainsFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContainsFold(FieldBuilderID, v))
+}
+
+// BuildTypeEQ applies the EQ predicate on the "build_type" field.
+func BuildTypeEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldBuildType, v))
+}
+
+// BuildTypeNEQ applies the NEQ predicate on the "build_type" field.
+func BuildTypeNEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNEQ(FieldBuildType, v))
+}
+
+// BuildTypeIn applies the In predicate on the "build_type" field.
+func BuildTypeIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIn(FieldBuildType, vs...))
+}
+
+// BuildTypeNotIn applies the NotIn predicate on the "build_type" field.
+func BuildTypeNotIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotIn(FieldBuildType, vs...))
+}
+
+// BuildTypeGT applies the GT predicate on the "build_type" field.
+func BuildTypeGT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGT(FieldBuildType, v))
+}
+
+// BuildTypeGTE applies the GTE predicate on the "build_type" field.
+func BuildTypeGTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGTE(FieldBuildType, v))
+}
+
+// BuildTypeLT applies the LT predicate on the "build_type" field.
+func BuildTypeLT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLT(FieldBuildType, v))
+}
+
+// BuildTypeLTE applies the LTE predicate on the "build_type" field.
+func BuildTypeLTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLTE(FieldBuildType, v))
+}
+
+// BuildTypeContains applies the Contains predicate on the "build_type" field.
+func BuildTypeContains(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContains(FieldBuildType, v))
+}
+
+// BuildTypeHasPrefix applies the HasPrefix predicate on the "build_type" field.
+func BuildTypeHasPrefix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasPrefix(FieldBuildType, v))
+}
+
+// BuildTypeHasSuffix applies the HasSuffix predicate on the "build_type" field.
+func BuildTypeHasSuffix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasSuffix(FieldBuildType, v))
+}
+
+// BuildTypeEqualFold applies the EqualFold predicate on the "build_type" field.
+func BuildTypeEqualFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEqualFold(FieldBuildType, v))
+}
+
+// BuildTypeContainsFold applies the ContainsFold predicate on the "build_type" field.
+func BuildTypeContainsFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContainsFold(FieldBuildType, v))
+}
+
+// InvocationIDEQ applies the EQ predicate on the "invocation_id" field.
+func InvocationIDEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldInvocationID, v))
+}
+
+// InvocationIDNEQ applies the NEQ predicate on the "invocation_id" field.
+func InvocationIDNEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNEQ(FieldInvocationID, v))
+}
+
+// InvocationIDIn applies the In predicate on the "invocation_id" field.
+func InvocationIDIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIn(FieldInvocationID, vs...))
+}
+
+// InvocationIDNotIn applies the NotIn predicate on the "invocation_id" field.
+func InvocationIDNotIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotIn(FieldInvocationID, vs...))
+}
+
+// InvocationIDGT applies the GT predicate on the "invocation_id" field.
+func InvocationIDGT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGT(FieldInvocationID, v))
+}
+
+// InvocationIDGTE applies the GTE predicate on the "invocation_id" field.
+func InvocationIDGTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGTE(FieldInvocationID, v))
+}
+
+// InvocationIDLT applies the LT predicate on the "invocation_id" field.
+func InvocationIDLT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLT(FieldInvocationID, v))
+}
+
+// InvocationIDLTE applies the LTE predicate on the "invocation_id" field.
+func InvocationIDLTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLTE(FieldInvocationID, v))
+}
+
+// InvocationIDContains applies the Contains predicate on the "invocation_id" field.
+func InvocationIDContains(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContains(FieldInvocationID, v))
+}
+
+// InvocationIDHasPrefix applies the HasPrefix predicate on the "invocation_id" field.
+func InvocationIDHasPrefix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasPrefix(FieldInvocationID, v))
+}
+
+// InvocationIDHasSuffix applies the HasSuffix predicate on the "invocation_id" field.
+func InvocationIDHasSuffix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasSuffix(FieldInvocationID, v))
+}
+
+// InvocationIDIsNil applies the IsNil predicate on the "invocation_id" field.
+func InvocationIDIsNil() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIsNull(FieldInvocationID))
+}
+
+// InvocationIDNotNil applies the NotNil predicate on the "invocation_id" field.
+func InvocationIDNotNil() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotNull(FieldInvocationID))
+}
+
+// InvocationIDEqualFold applies the EqualFold predicate on the "invocation_id" field.
+func InvocationIDEqualFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEqualFold(FieldInvocationID, v))
+}
+
+// InvocationIDContainsFold applies the ContainsFold predicate on the "invocation_id" field.
+func InvocationIDContainsFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContainsFold(FieldInvocationID, v))
+}
+
+// StartedOnEQ applies the EQ predicate on the "started_on" field.
+func StartedOnEQ(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldStartedOn, v))
+}
+
+// StartedOnNEQ applies the NEQ predicate on the "started_on" field.
+func StartedOnNEQ(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNEQ(FieldStartedOn, v))
+}
+
+// StartedOnIn applies the In predicate on the "started_on" field.
+func StartedOnIn(vs ...time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIn(FieldStartedOn, vs...))
+}
+
+// StartedOnNotIn applies the NotIn predicate on the "started_on" field.
+func StartedOnNotIn(vs ...time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotIn(FieldStartedOn, vs...))
+}
+
+// StartedOnGT applies the GT predicate on the "started_on" field.
+func StartedOnGT(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGT(FieldStartedOn, v))
+}
+
+// StartedOnGTE applies the GTE predicate on the "started_on" field.
+func StartedOnGTE(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGTE(FieldStartedOn, v))
+}
+
+// StartedOnLT applies the LT predicate on the "started_on" field.
+func StartedOnLT(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLT(FieldStartedOn, v))
+}
+
+// StartedOnLTE applies the LTE predicate on the "started_on" field.
+func StartedOnLTE(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLTE(FieldStartedOn, v))
+}
+
+// StartedOnIsNil applies the IsNil predicate on the "started_on" field.
+func StartedOnIsNil() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIsNull(FieldStartedOn))
+}
+
+// StartedOnNotNil applies the NotNil predicate on the "started_on" field.
+func StartedOnNotNil() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotNull(FieldStartedOn))
+}
+
+// FinishedOnEQ applies the EQ predicate on the "finished_on" field.
+func FinishedOnEQ(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldFinishedOn, v))
+}
+
+// FinishedOnNEQ applies the NEQ predicate on the "finished_on" field.
+func FinishedOnNEQ(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNEQ(FieldFinishedOn, v))
+}
+
+// FinishedOnIn applies the In predicate on the "finished_on" field.
+func FinishedOnIn(vs ...time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIn(FieldFinishedOn, vs...))
+}
+
+// FinishedOnNotIn applies the NotIn predicate on the "finished_on" field.
+func FinishedOnNotIn(vs ...time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotIn(FieldFinishedOn, vs...))
+}
+
+// FinishedOnGT applies the GT predicate on the "finished_on" field.
+func FinishedOnGT(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGT(FieldFinishedOn, v))
+}
+
+// FinishedOnGTE applies the GTE predicate on the "finished_on" field.
+func FinishedOnGTE(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGTE(FieldFinishedOn, v))
+}
+
+// FinishedOnLT applies the LT predicate on the "finished_on" field.
+func FinishedOnLT(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLT(FieldFinishedOn, v))
+}
+
+// FinishedOnLTE applies the LTE predicate on the "finished_on" field.
+func FinishedOnLTE(v time.Time) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLTE(FieldFinishedOn, v))
+}
+
+// FinishedOnIsNil applies the IsNil predicate on the "finished_on" field.
+func FinishedOnIsNil() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIsNull(FieldFinishedOn))
+}
+
+// FinishedOnNotNil applies the NotNil predicate on the "finished_on" field.
+func FinishedOnNotNil() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotNull(FieldFinishedOn))
+}
+
+// ExternalParametersEQ applies the EQ predicate on the "external_parameters" field.
+func ExternalParametersEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEQ(FieldExternalParameters, v))
+}
+
+// ExternalParametersNEQ applies the NEQ predicate on the "external_parameters" field.
+func ExternalParametersNEQ(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNEQ(FieldExternalParameters, v))
+}
+
+// ExternalParametersIn applies the In predicate on the "external_parameters" field.
+func ExternalParametersIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIn(FieldExternalParameters, vs...))
+}
+
+// ExternalParametersNotIn applies the NotIn predicate on the "external_parameters" field.
+func ExternalParametersNotIn(vs ...string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotIn(FieldExternalParameters, vs...))
+}
+
+// ExternalParametersGT applies the GT predicate on the "external_parameters" field.
+func ExternalParametersGT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGT(FieldExternalParameters, v))
+}
+
+// ExternalParametersGTE applies the GTE predicate on the "external_parameters" field.
+func ExternalParametersGTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldGTE(FieldExternalParameters, v))
+}
+
+// ExternalParametersLT applies the LT predicate on the "external_parameters" field.
+func ExternalParametersLT(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLT(FieldExternalParameters, v))
+}
+
+// ExternalParametersLTE applies the LTE predicate on the "external_parameters" field.
+func ExternalParametersLTE(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldLTE(FieldExternalParameters, v))
+}
+
+// ExternalParametersContains applies the Contains predicate on the "external_parameters" field.
+func ExternalParametersContains(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContains(FieldExternalParameters, v))
+}
+
+// ExternalParametersHasPrefix applies the HasPrefix predicate on the "external_parameters" field.
+func ExternalParametersHasPrefix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasPrefix(FieldExternalParameters, v))
+}
+
+// ExternalParametersHasSuffix applies the HasSuffix predicate on the "external_parameters" field.
+func ExternalParametersHasSuffix(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldHasSuffix(FieldExternalParameters, v))
+}
+
+// ExternalParametersIsNil applies the IsNil predicate on the "external_parameters" field.
+func ExternalParametersIsNil() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldIsNull(FieldExternalParameters))
+}
+
+// ExternalParametersNotNil applies the NotNil predicate on the "external_parameters" field.
+func ExternalParametersNotNil() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldNotNull(FieldExternalParameters))
+}
+
+// ExternalParametersEqualFold applies the EqualFold predicate on the "external_parameters" field.
+func ExternalParametersEqualFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldEqualFold(FieldExternalParameters, v))
+}
+
+// ExternalParametersContainsFold applies the ContainsFold predicate on the "external_parameters" field.
+func ExternalParametersContainsFold(v string) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.FieldContainsFold(FieldExternalParameters, v))
+}
+
+// HasResolvedDependencies applies the HasEdge predicate on the "resolved_dependencies" edge.
+func HasResolvedDependencies() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ResolvedDependenciesTable, ResolvedDependenciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResolvedDependenciesWith applies the HasEdge predicate on the "resolved_dependencies" edge with a given conditions (other predicates).
+func HasResolvedDependenciesWith(preds ...predicate.SlsaDependency) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(func(s *sql.Selector) {
+		step := newResolvedDependenciesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatement applies the HasEdge predicate on the "statement" edge.
+func HasStatement() predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, StatementTable, StatementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatementWith applies the HasEdge predicate on the "statement" edge with a given conditions (other predicates).
+func HasStatementWith(preds ...predicate.Statement) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(func(s *sql.Selector) {
+		step := newStatementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SlsaProvenance) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SlsaProvenance) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SlsaProvenance) predicate.SlsaProvenance {
+	return predicate.SlsaProvenance(sql.NotPredicates(p))
+}
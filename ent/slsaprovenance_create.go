@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+	"github.com/in-toto/archivista/ent/statement"
+)
+
+// SlsaProvenanceCreate is the builder for creating a SlsaProvenance entity.
+type SlsaProvenanceCreate struct {
+	config
+	mutation *SlsaProvenanceMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *SlsaProvenanceCreate) SetTenant(v string) *SlsaProvenanceCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *SlsaProvenanceCreate) SetNillableTenant(v *string) *SlsaProvenanceCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetBuilderID sets the "builder_id" field.
+func (_c *SlsaProvenanceCreate) SetBuilderID(v string) *SlsaProvenanceCreate {
+	_c.mutation.SetBuilderID(v)
+	return _c
+}
+
+// SetBuildType sets the "build_type" field.
+func (_c *SlsaProvenanceCreate) SetBuildType(v string) *SlsaProvenanceCreate {
+	_c.mutation.SetBuildType(v)
+	return _c
+}
+
+// SetInvocationID sets the "invocation_id" field.
+func (_c *SlsaProvenanceCreate) SetInvocationID(v string) *SlsaProvenanceCreate {
+	_c.mutation.SetInvocationID(v)
+	return _c
+}
+
+// SetNillableInvocationID sets the "invocation_id" field if the given value is not nil.
+func (_c *SlsaProvenanceCreate) SetNillableInvocationID(v *string) *SlsaProvenanceCreate {
+	if v != nil {
+		_c.SetInvocationID(*v)
+	}
+	return _c
+}
+
+// SetStartedOn sets the "started_on" field.
+func (_c *SlsaProvenanceCreate) SetStartedOn(v time.Time) *SlsaProvenanceCreate {
+	_c.mutation.SetStartedOn(v)
+	return _c
+}
+
+// SetNillableStartedOn sets the "started_on" field if the given value is not nil.
+func (_c *SlsaProvenanceCreate) SetNillableStartedOn(v *time.Time) *SlsaProvenanceCreate {
+	if v != nil {
+		_c.SetStartedOn(*v)
+	}
+	return _c
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (_c *SlsaProvenanceCreate) SetFinishedOn(v time.Time) *SlsaProvenanceCreate {
+	_c.mutation.SetFinishedOn(v)
+	return _c
+}
+
+// SetNillableFinishedOn sets the "finished_on" field if the given value is not nil.
+func (_c *SlsaProvenanceCreate) SetNillableFinishedOn(v *time.Time) *SlsaProvenanceCreate {
+	if v != nil {
+		_c.SetFinishedOn(*v)
+	}
+	return _c
+}
+
+// SetExternalParameters sets the "external_parameters" field.
+func (_c *SlsaProvenanceCreate) SetExternalParameters(v string) *SlsaProvenanceCreate {
+	_c.mutation.SetExternalParameters(v)
+	return _c
+}
+
+// SetNillableExternalParameters sets the "external_parameters" field if the given value is not nil.
+func (_c *SlsaProvenanceCreate) SetNillableExternalParameters(v *string) *SlsaProvenanceCreate {
+	if v != nil {
+		_c.SetExternalParameters(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SlsaProvenanceCreate) SetID(v uuid.UUID) *SlsaProvenanceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SlsaProvenanceCreate) SetNillableID(v *uuid.UUID) *SlsaProvenanceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddResolvedDependencyIDs adds the "resolved_dependencies" edge to the SlsaDependency entity by IDs.
+func (_c *SlsaProvenanceCreate) AddResolvedDependencyIDs(ids ...uuid.UUID) *SlsaProvenanceCreate {
+	_c.mutation.AddResolvedDependencyIDs(ids...)
+	return _c
+}
+
+// AddResolvedDependencies adds the "resolved_dependencies" edges to the SlsaDependency entity.
+func (_c *SlsaProvenanceCreate) AddResolvedDependencies(v ...*SlsaDependency) *SlsaProvenanceCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddResolvedDependencyIDs(ids...)
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by ID.
+func (_c *SlsaProvenanceCreate) SetStatementID(id uuid.UUID) *SlsaProvenanceCreate {
+	_c.mutation.SetStatementID(id)
+	return _c
+}
+
+// SetStatement sets the "statement" edge to the Statement entity.
+func (_c *SlsaProvenanceCreate) SetStatement(v *Statement) *SlsaProvenanceCreate {
+	return _c.SetStatementID(v.ID)
+}
+
+// Mutation returns the SlsaProvenanceMutation object of the builder.
+func (_c *SlsaProvenanceCreate) Mutation() *SlsaProvenanceMutation {
+	return _c.mutation
+}
+
+// Save creates the SlsaProvenance in the database.
+func (_c *SlsaProvenanceCreate) Save(ctx context.Context) (*SlsaProvenance, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SlsaProvenanceCreate) SaveX(ctx context.Context) *SlsaProvenance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SlsaProvenanceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SlsaProvenanceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SlsaProvenanceCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := slsaprovenance.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if slsaprovenance.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized slsaprovenance.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := slsaprovenance.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SlsaProvenanceCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "SlsaProvenance.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := slsaprovenance.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "SlsaProvenance.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BuilderID(); !ok {
+		return &ValidationError{Name: "builder_id", err: errors.New(`ent: missing required field "SlsaProvenance.builder_id"`)}
+	}
+	if v, ok := _c.mutation.BuilderID(); ok {
+		if err := slsaprovenance.BuilderIDValidator(v); err != nil {
+			return &ValidationError{Name: "builder_id", err: fmt.Errorf(`ent: validator failed for field "SlsaProvenance.builder_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BuildType(); !ok {
+		return &ValidationError{Name: "build_type", err: errors.New(`ent: missing required field "SlsaProvenance.build_type"`)}
+	}
+	if v, ok := _c.mutation.BuildType(); ok {
+		if err := slsaprovenance.BuildTypeValidator(v); err != nil {
+			return &ValidationError{Name: "build_type", err: fmt.Errorf(`ent: validator failed for field "SlsaProvenance.build_type": %w`, err)}
+		}
+	}
+	if len(_c.mutation.StatementIDs()) == 0 {
+		return &ValidationError{Name: "statement", err: errors.New(`ent: missing required edge "SlsaProvenance.statement"`)}
+	}
+	return nil
+}
+
+func (_c *SlsaProvenanceCreate) sqlSave(ctx context.Context) (*SlsaProvenance, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SlsaProvenanceCreate) createSpec() (*SlsaProvenance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SlsaProvenance{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(slsaprovenance.Table, sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(slsaprovenance.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.BuilderID(); ok {
+		_spec.SetField(slsaprovenance.FieldBuilderID, field.TypeString, value)
+		_node.BuilderID = value
+	}
+	if value, ok := _c.mutation.BuildType(); ok {
+		_spec.SetField(slsaprovenance.FieldBuildType, field.TypeString, value)
+		_node.BuildType = value
+	}
+	if value, ok := _c.mutation.InvocationID(); ok {
+		_spec.SetField(slsaprovenance.FieldInvocationID, field.TypeString, value)
+		_node.InvocationID = value
+	}
+	if value, ok := _c.mutation.StartedOn(); ok {
+		_spec.SetField(slsaprovenance.FieldStartedOn, field.TypeTime, value)
+		_node.StartedOn = &value
+	}
+	if value, ok := _c.mutation.FinishedOn(); ok {
+		_spec.SetField(slsaprovenance.FieldFinishedOn, field.TypeTime, value)
+		_node.FinishedOn = &value
+	}
+	if value, ok := _c.mutation.ExternalParameters(); ok {
+		_spec.SetField(slsaprovenance.FieldExternalParameters, field.TypeString, value)
+		_node.ExternalParameters = value
+	}
+	if nodes := _c.mutation.ResolvedDependenciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   slsaprovenance.ResolvedDependenciesTable,
+			Columns: []string{slsaprovenance.ResolvedDependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   slsaprovenance.StatementTable,
+			Columns: []string{slsaprovenance.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.statement_slsa_provenance = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SlsaProvenanceCreateBulk is the builder for creating many SlsaProvenance entities in bulk.
+type SlsaProvenanceCreateBulk struct {
+	config
+	err      error
+	builders []*SlsaProvenanceCreate
+}
+
+// Save creates the SlsaProvenance entities in the database.
+func (_c *SlsaProvenanceCreateBulk) Save(ctx context.Context) ([]*SlsaProvenance, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SlsaProvenance, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SlsaProvenanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SlsaProvenanceCreateBulk) SaveX(ctx context.Context) []*SlsaProvenance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SlsaProvenanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SlsaProvenanceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
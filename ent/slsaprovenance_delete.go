@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+)
+
+// SlsaProvenanceDelete is the builder for deleting a SlsaProvenance entity.
+type SlsaProvenanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *SlsaProvenanceMutation
+}
+
+// Where appends a list predicates to the SlsaProvenanceDelete builder.
+func (_d *SlsaProvenanceDelete) Where(ps ...predicate.SlsaProvenance) *SlsaProvenanceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SlsaProvenanceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SlsaProvenanceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SlsaProvenanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(slsaprovenance.Table, sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SlsaProvenanceDeleteOne is the builder for deleting a single SlsaProvenance entity.
+type SlsaProvenanceDeleteOne struct {
+	_d *SlsaProvenanceDelete
+}
+
+// Where appends a list predicates to the SlsaProvenanceDelete builder.
+func (_d *SlsaProvenanceDeleteOne) Where(ps ...predicate.SlsaProvenance) *SlsaProvenanceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SlsaProvenanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{slsaprovenance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SlsaProvenanceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
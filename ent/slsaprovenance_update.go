@@ -0,0 +1,751 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+	"github.com/in-toto/archivista/ent/statement"
+)
+
+// SlsaProvenanceUpdate is the builder for updating SlsaProvenance entities.
+type SlsaProvenanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SlsaProvenanceMutation
+}
+
+// Where appends a list predicates to the SlsaProvenanceUpdate builder.
+func (_u *SlsaProvenanceUpdate) Where(ps ...predicate.SlsaProvenance) *SlsaProvenanceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBuilderID sets the "builder_id" field.
+func (_u *SlsaProvenanceUpdate) SetBuilderID(v string) *SlsaProvenanceUpdate {
+	_u.mutation.SetBuilderID(v)
+	return _u
+}
+
+// SetNillableBuilderID sets the "builder_id" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdate) SetNillableBuilderID(v *string) *SlsaProvenanceUpdate {
+	if v != nil {
+		_u.SetBuilderID(*v)
+	}
+	return _u
+}
+
+// SetBuildType sets the "build_type" field.
+func (_u *SlsaProvenanceUpdate) SetBuildType(v string) *SlsaProvenanceUpdate {
+	_u.mutation.SetBuildType(v)
+	return _u
+}
+
+// SetNillableBuildType sets the "build_type" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdate) SetNillableBuildType(v *string) *SlsaProvenanceUpdate {
+	if v != nil {
+		_u.SetBuildType(*v)
+	}
+	return _u
+}
+
+// SetInvocationID sets the "invocation_id" field.
+func (_u *SlsaProvenanceUpdate) SetInvocationID(v string) *SlsaProvenanceUpdate {
+	_u.mutation.SetInvocationID(v)
+	return _u
+}
+
+// SetNillableInvocationID sets the "invocation_id" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdate) SetNillableInvocationID(v *string) *SlsaProvenanceUpdate {
+	if v != nil {
+		_u.SetInvocationID(*v)
+	}
+	return _u
+}
+
+// ClearInvocationID clears the value of the "invocation_id" field.
+func (_u *SlsaProvenanceUpdate) ClearInvocationID() *SlsaProvenanceUpdate {
+	_u.mutation.ClearInvocationID()
+	return _u
+}
+
+// SetStartedOn sets the "started_on" field.
+func (_u *SlsaProvenanceUpdate) SetStartedOn(v time.Time) *SlsaProvenanceUpdate {
+	_u.mutation.SetStartedOn(v)
+	return _u
+}
+
+// SetNillableStartedOn sets the "started_on" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdate) SetNillableStartedOn(v *time.Time) *SlsaProvenanceUpdate {
+	if v != nil {
+		_u.SetStartedOn(*v)
+	}
+	return _u
+}
+
+// ClearStartedOn clears the value of the "started_on" field.
+func (_u *SlsaProvenanceUpdate) ClearStartedOn() *SlsaProvenanceUpdate {
+	_u.mutation.ClearStartedOn()
+	return _u
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (_u *SlsaProvenanceUpdate) SetFinishedOn(v time.Time) *SlsaProvenanceUpdate {
+	_u.mutation.SetFinishedOn(v)
+	return _u
+}
+
+// SetNillableFinishedOn sets the "finished_on" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdate) SetNillableFinishedOn(v *time.Time) *SlsaProvenanceUpdate {
+	if v != nil {
+		_u.SetFinishedOn(*v)
+	}
+	return _u
+}
+
+// ClearFinishedOn clears the value of the "finished_on" field.
+func (_u *SlsaProvenanceUpdate) ClearFinishedOn() *SlsaProvenanceUpdate {
+	_u.mutation.ClearFinishedOn()
+	return _u
+}
+
+// SetExternalParameters sets the "external_parameters" field.
+func (_u *SlsaProvenanceUpdate) SetExternalParameters(v string) *SlsaProvenanceUpdate {
+	_u.mutation.SetExternalParameters(v)
+	return _u
+}
+
+// SetNillableExternalParameters sets the "external_parameters" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdate) SetNillableExternalParameters(v *string) *SlsaProvenanceUpdate {
+	if v != nil {
+		_u.SetExternalParameters(*v)
+	}
+	return _u
+}
+
+// ClearExternalParameters clears the value of the "external_parameters" field.
+func (_u *SlsaProvenanceUpdate) ClearExternalParameters() *SlsaProvenanceUpdate {
+	_u.mutation.ClearExternalParameters()
+	return _u
+}
+
+// AddResolvedDependencyIDs adds the "resolved_dependencies" edge to the SlsaDependency entity by IDs.
+func (_u *SlsaProvenanceUpdate) AddResolvedDependencyIDs(ids ...uuid.UUID) *SlsaProvenanceUpdate {
+	_u.mutation.AddResolvedDependencyIDs(ids...)
+	return _u
+}
+
+// AddResolvedDependencies adds the "resolved_dependencies" edges to the SlsaDependency entity.
+func (_u *SlsaProvenanceUpdate) AddResolvedDependencies(v ...*SlsaDependency) *SlsaProvenanceUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddResolvedDependencyIDs(ids...)
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by ID.
+func (_u *SlsaProvenanceUpdate) SetStatementID(id uuid.UUID) *SlsaProvenanceUpdate {
+	_u.mutation.SetStatementID(id)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the Statement entity.
+func (_u *SlsaProvenanceUpdate) SetStatement(v *Statement) *SlsaProvenanceUpdate {
+	return _u.SetStatementID(v.ID)
+}
+
+// Mutation returns the SlsaProvenanceMutation object of the builder.
+func (_u *SlsaProvenanceUpdate) Mutation() *SlsaProvenanceMutation {
+	return _u.mutation
+}
+
+// ClearResolvedDependencies clears all "resolved_dependencies" edges to the SlsaDependency entity.
+func (_u *SlsaProvenanceUpdate) ClearResolvedDependencies() *SlsaProvenanceUpdate {
+	_u.mutation.ClearResolvedDependencies()
+	return _u
+}
+
+// RemoveResolvedDependencyIDs removes the "resolved_dependencies" edge to SlsaDependency entities by IDs.
+func (_u *SlsaProvenanceUpdate) RemoveResolvedDependencyIDs(ids ...uuid.UUID) *SlsaProvenanceUpdate {
+	_u.mutation.RemoveResolvedDependencyIDs(ids...)
+	return _u
+}
+
+// RemoveResolvedDependencies removes "resolved_dependencies" edges to SlsaDependency entities.
+func (_u *SlsaProvenanceUpdate) RemoveResolvedDependencies(v ...*SlsaDependency) *SlsaProvenanceUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveResolvedDependencyIDs(ids...)
+}
+
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (_u *SlsaProvenanceUpdate) ClearStatement() *SlsaProvenanceUpdate {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SlsaProvenanceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SlsaProvenanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SlsaProvenanceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SlsaProvenanceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SlsaProvenanceUpdate) check() error {
+	if v, ok := _u.mutation.BuilderID(); ok {
+		if err := slsaprovenance.BuilderIDValidator(v); err != nil {
+			return &ValidationError{Name: "builder_id", err: fmt.Errorf(`ent: validator failed for field "SlsaProvenance.builder_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BuildType(); ok {
+		if err := slsaprovenance.BuildTypeValidator(v); err != nil {
+			return &ValidationError{Name: "build_type", err: fmt.Errorf(`ent: validator failed for field "SlsaProvenance.build_type": %w`, err)}
+		}
+	}
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SlsaProvenance.statement"`)
+	}
+	return nil
+}
+
+func (_u *SlsaProvenanceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slsaprovenance.Table, slsaprovenance.Columns, sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BuilderID(); ok {
+		_spec.SetField(slsaprovenance.FieldBuilderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuildType(); ok {
+		_spec.SetField(slsaprovenance.FieldBuildType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvocationID(); ok {
+		_spec.SetField(slsaprovenance.FieldInvocationID, field.TypeString, value)
+	}
+	if _u.mutation.InvocationIDCleared() {
+		_spec.ClearField(slsaprovenance.FieldInvocationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedOn(); ok {
+		_spec.SetField(slsaprovenance.FieldStartedOn, field.TypeTime, value)
+	}
+	if _u.mutation.StartedOnCleared() {
+		_spec.ClearField(slsaprovenance.FieldStartedOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedOn(); ok {
+		_spec.SetField(slsaprovenance.FieldFinishedOn, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedOnCleared() {
+		_spec.ClearField(slsaprovenance.FieldFinishedOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExternalParameters(); ok {
+		_spec.SetField(slsaprovenance.FieldExternalParameters, field.TypeString, value)
+	}
+	if _u.mutation.ExternalParametersCleared() {
+		_spec.ClearField(slsaprovenance.FieldExternalParameters, field.TypeString)
+	}
+	if _u.mutation.ResolvedDependenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   slsaprovenance.ResolvedDependenciesTable,
+			Columns: []string{slsaprovenance.ResolvedDependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedResolvedDependenciesIDs(); len(nodes) > 0 && !_u.mutation.ResolvedDependenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   slsaprovenance.ResolvedDependenciesTable,
+			Columns: []string{slsaprovenance.ResolvedDependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ResolvedDependenciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   slsaprovenance.ResolvedDependenciesTable,
+			Columns: []string{slsaprovenance.ResolvedDependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   slsaprovenance.StatementTable,
+			Columns: []string{slsaprovenance.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   slsaprovenance.StatementTable,
+			Columns: []string{slsaprovenance.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slsaprovenance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SlsaProvenanceUpdateOne is the builder for updating a single SlsaProvenance entity.
+type SlsaProvenanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SlsaProvenanceMutation
+}
+
+// SetBuilderID sets the "builder_id" field.
+func (_u *SlsaProvenanceUpdateOne) SetBuilderID(v string) *SlsaProvenanceUpdateOne {
+	_u.mutation.SetBuilderID(v)
+	return _u
+}
+
+// SetNillableBuilderID sets the "builder_id" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdateOne) SetNillableBuilderID(v *string) *SlsaProvenanceUpdateOne {
+	if v != nil {
+		_u.SetBuilderID(*v)
+	}
+	return _u
+}
+
+// SetBuildType sets the "build_type" field.
+func (_u *SlsaProvenanceUpdateOne) SetBuildType(v string) *SlsaProvenanceUpdateOne {
+	_u.mutation.SetBuildType(v)
+	return _u
+}
+
+// SetNillableBuildType sets the "build_type" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdateOne) SetNillableBuildType(v *string) *SlsaProvenanceUpdateOne {
+	if v != nil {
+		_u.SetBuildType(*v)
+	}
+	return _u
+}
+
+// SetInvocationID sets the "invocation_id" field.
+func (_u *SlsaProvenanceUpdateOne) SetInvocationID(v string) *SlsaProvenanceUpdateOne {
+	_u.mutation.SetInvocationID(v)
+	return _u
+}
+
+// SetNillableInvocationID sets the "invocation_id" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdateOne) SetNillableInvocationID(v *string) *SlsaProvenanceUpdateOne {
+	if v != nil {
+		_u.SetInvocationID(*v)
+	}
+	return _u
+}
+
+// ClearInvocationID clears the value of the "invocation_id" field.
+func (_u *SlsaProvenanceUpdateOne) ClearInvocationID() *SlsaProvenanceUpdateOne {
+	_u.mutation.ClearInvocationID()
+	return _u
+}
+
+// SetStartedOn sets the "started_on" field.
+func (_u *SlsaProvenanceUpdateOne) SetStartedOn(v time.Time) *SlsaProvenanceUpdateOne {
+	_u.mutation.SetStartedOn(v)
+	return _u
+}
+
+// SetNillableStartedOn sets the "started_on" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdateOne) SetNillableStartedOn(v *time.Time) *SlsaProvenanceUpdateOne {
+	if v != nil {
+		_u.SetStartedOn(*v)
+	}
+	return _u
+}
+
+// ClearStartedOn clears the value of the "started_on" field.
+func (_u *SlsaProvenanceUpdateOne) ClearStartedOn() *SlsaProvenanceUpdateOne {
+	_u.mutation.ClearStartedOn()
+	return _u
+}
+
+// SetFinishedOn sets the "finished_on" field.
+func (_u *SlsaProvenanceUpdateOne) SetFinishedOn(v time.Time) *SlsaProvenanceUpdateOne {
+	_u.mutation.SetFinishedOn(v)
+	return _u
+}
+
+// SetNillableFinishedOn sets the "finished_on" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdateOne) SetNillableFinishedOn(v *time.Time) *SlsaProvenanceUpdateOne {
+	if v != nil {
+		_u.SetFinishedOn(*v)
+	}
+	return _u
+}
+
+// ClearFinishedOn clears the value of the "finished_on" field.
+func (_u *SlsaProvenanceUpdateOne) ClearFinishedOn() *SlsaProvenanceUpdateOne {
+	_u.mutation.ClearFinishedOn()
+	return _u
+}
+
+// SetExternalParameters sets the "external_parameters" field.
+func (_u *SlsaProvenanceUpdateOne) SetExternalParameters(v string) *SlsaProvenanceUpdateOne {
+	_u.mutation.SetExternalParameters(v)
+	return _u
+}
+
+// SetNillableExternalParameters sets the "external_parameters" field if the given value is not nil.
+func (_u *SlsaProvenanceUpdateOne) SetNillableExternalParameters(v *string) *SlsaProvenanceUpdateOne {
+	if v != nil {
+		_u.SetExternalParameters(*v)
+	}
+	return _u
+}
+
+// ClearExternalParameters clears the value of the "external_parameters" field.
+func (_u *SlsaProvenanceUpdateOne) ClearExternalParameters() *SlsaProvenanceUpdateOne {
+	_u.mutation.ClearExternalParameters()
+	return _u
+}
+
+// AddResolvedDependencyIDs adds the "resolved_dependencies" edge to the SlsaDependency entity by IDs.
+func (_u *SlsaProvenanceUpdateOne) AddResolvedDependencyIDs(ids ...uuid.UUID) *SlsaProvenanceUpdateOne {
+	_u.mutation.AddResolvedDependencyIDs(ids...)
+	return _u
+}
+
+// AddResolvedDependencies adds the "resolved_dependencies" edges to the SlsaDependency entity.
+func (_u *SlsaProvenanceUpdateOne) AddResolvedDependencies(v ...*SlsaDependency) *SlsaProvenanceUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddResolvedDependencyIDs(ids...)
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by ID.
+func (_u *SlsaProvenanceUpdateOne) SetStatementID(id uuid.UUID) *SlsaProvenanceUpdateOne {
+	_u.mutation.SetStatementID(id)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the Statement entity.
+func (_u *SlsaProvenanceUpdateOne) SetStatement(v *Statement) *SlsaProvenanceUpdateOne {
+	return _u.SetStatementID(v.ID)
+}
+
+// Mutation returns the SlsaProvenanceMutation object of the builder.
+func (_u *SlsaProvenanceUpdateOne) Mutation() *SlsaProvenanceMutation {
+	return _u.mutation
+}
+
+// ClearResolvedDependencies clears all "resolved_dependencies" edges to the SlsaDependency entity.
+func (_u *SlsaProvenanceUpdateOne) ClearResolvedDependencies() *SlsaProvenanceUpdateOne {
+	_u.mutation.ClearResolvedDependencies()
+	return _u
+}
+
+// RemoveResolvedDependencyIDs removes the "resolved_dependencies" edge to SlsaDependency entities by IDs.
+func (_u *SlsaProvenanceUpdateOne) RemoveResolvedDependencyIDs(ids ...uuid.UUID) *SlsaProvenanceUpdateOne {
+	_u.mutation.RemoveResolvedDependencyIDs(ids...)
+	return _u
+}
+
+// RemoveResolvedDependencies removes "resolved_dependencies" edges to SlsaDependency entities.
+func (_u *SlsaProvenanceUpdateOne) RemoveResolvedDependencies(v ...*SlsaDependency) *SlsaProvenanceUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveResolvedDependencyIDs(ids...)
+}
+
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (_u *SlsaProvenanceUpdateOne) ClearStatement() *SlsaProvenanceUpdateOne {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// Where appends a list predicates to the SlsaProvenanceUpdate builder.
+func (_u *SlsaProvenanceUpdateOne) Where(ps ...predicate.SlsaProvenance) *SlsaProvenanceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SlsaProvenanceUpdateOne) Select(field string, fields ...string) *SlsaProvenanceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SlsaProvenance entity.
+func (_u *SlsaProvenanceUpdateOne) Save(ctx context.Context) (*SlsaProvenance, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SlsaProvenanceUpdateOne) SaveX(ctx context.Context) *SlsaProvenance {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SlsaProvenanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SlsaProvenanceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SlsaProvenanceUpdateOne) check() error {
+	if v, ok := _u.mutation.BuilderID(); ok {
+		if err := slsaprovenance.BuilderIDValidator(v); err != nil {
+			return &ValidationError{Name: "builder_id", err: fmt.Errorf(`ent: validator failed for field "SlsaProvenance.builder_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BuildType(); ok {
+		if err := slsaprovenance.BuildTypeValidator(v); err != nil {
+			return &ValidationError{Name: "build_type", err: fmt.Errorf(`ent: validator failed for field "SlsaProvenance.build_type": %w`, err)}
+		}
+	}
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SlsaProvenance.statement"`)
+	}
+	return nil
+}
+
+func (_u *SlsaProvenanceUpdateOne) sqlSave(ctx context.Context) (_node *SlsaProvenance, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slsaprovenance.Table, slsaprovenance.Columns, sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SlsaProvenance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, slsaprovenance.FieldID)
+		for _, f := range fields {
+			if !slsaprovenance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != slsaprovenance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BuilderID(); ok {
+		_spec.SetField(slsaprovenance.FieldBuilderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuildType(); ok {
+		_spec.SetField(slsaprovenance.FieldBuildType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvocationID(); ok {
+		_spec.SetField(slsaprovenance.FieldInvocationID, field.TypeString, value)
+	}
+	if _u.mutation.InvocationIDCleared() {
+		_spec.ClearField(slsaprovenance.FieldInvocationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedOn(); ok {
+		_spec.SetField(slsaprovenance.FieldStartedOn, field.TypeTime, value)
+	}
+	if _u.mutation.StartedOnCleared() {
+		_spec.ClearField(slsaprovenance.FieldStartedOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedOn(); ok {
+		_spec.SetField(slsaprovenance.FieldFinishedOn, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedOnCleared() {
+		_spec.ClearField(slsaprovenance.FieldFinishedOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExternalParameters(); ok {
+		_spec.SetField(slsaprovenance.FieldExternalParameters, field.TypeString, value)
+	}
+	if _u.mutation.ExternalParametersCleared() {
+		_spec.ClearField(slsaprovenance.FieldExternalParameters, field.TypeString)
+	}
+	if _u.mutation.ResolvedDependenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   slsaprovenance.ResolvedDependenciesTable,
+			Columns: []string{slsaprovenance.ResolvedDependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedResolvedDependenciesIDs(); len(nodes) > 0 && !_u.mutation.ResolvedDependenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   slsaprovenance.ResolvedDependenciesTable,
+			Columns: []string{slsaprovenance.ResolvedDependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ResolvedDependenciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   slsaprovenance.ResolvedDependenciesTable,
+			Columns: []string{slsaprovenance.ResolvedDependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsadependency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   slsaprovenance.StatementTable,
+			Columns: []string{slsaprovenance.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   slsaprovenance.StatementTable,
+			Columns: []string{slsaprovenance.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SlsaProvenance{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slsaprovenance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
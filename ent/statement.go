@@ -11,7 +11,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/in-toto/archivista/ent/attestationcollection"
 	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 // Statement is the model entity for the Statement schema.
@@ -19,6 +21,8 @@ type Statement struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// Predicate holds the value of the "predicate" field.
 	Predicate string `json:"predicate,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -35,16 +39,23 @@ type StatementEdges struct {
 	Policy *AttestationPolicy `json:"policy,omitempty"`
 	// AttestationCollections holds the value of the attestation_collections edge.
 	AttestationCollections *AttestationCollection `json:"attestation_collections,omitempty"`
+	// SlsaProvenance holds the value of the slsa_provenance edge.
+	SlsaProvenance *SlsaProvenance `json:"slsa_provenance,omitempty"`
+	// VerificationSummary holds the value of the verification_summary edge.
+	VerificationSummary *VerificationSummary `json:"verification_summary,omitempty"`
+	// SbomPackages holds the value of the sbom_packages edge.
+	SbomPackages []*SbomPackage `json:"sbom_packages,omitempty"`
 	// Dsse holds the value of the dsse edge.
 	Dsse []*Dsse `json:"dsse,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [7]bool
 	// totalCount holds the count of the edges above.
-	totalCount [4]map[string]int
+	totalCount [7]map[string]int
 
-	namedSubjects map[string][]*Subject
-	namedDsse     map[string][]*Dsse
+	namedSubjects     map[string][]*Subject
+	namedSbomPackages map[string][]*SbomPackage
+	namedDsse         map[string][]*Dsse
 }
 
 // SubjectsOrErr returns the Subjects value or an error if the edge
@@ -78,10 +89,41 @@ func (e StatementEdges) AttestationCollectionsOrErr() (*AttestationCollection, e
 	return nil, &NotLoadedError{edge: "attestation_collections"}
 }
 
+// SlsaProvenanceOrErr returns the SlsaProvenance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StatementEdges) SlsaProvenanceOrErr() (*SlsaProvenance, error) {
+	if e.SlsaProvenance != nil {
+		return e.SlsaProvenance, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: slsaprovenance.Label}
+	}
+	return nil, &NotLoadedError{edge: "slsa_provenance"}
+}
+
+// VerificationSummaryOrErr returns the VerificationSummary value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StatementEdges) VerificationSummaryOrErr() (*VerificationSummary, error) {
+	if e.VerificationSummary != nil {
+		return e.VerificationSummary, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: verificationsummary.Label}
+	}
+	return nil, &NotLoadedError{edge: "verification_summary"}
+}
+
+// SbomPackagesOrErr returns the SbomPackages value or an error if the edge
+// was not loaded in eager-loading.
+func (e StatementEdges) SbomPackagesOrErr() ([]*SbomPackage, error) {
+	if e.loadedTypes[5] {
+		return e.SbomPackages, nil
+	}
+	return nil, &NotLoadedError{edge: "sbom_packages"}
+}
+
 // DsseOrErr returns the Dsse value or an error if the edge
 // was not loaded in eager-loading.
 func (e StatementEdges) DsseOrErr() ([]*Dsse, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[6] {
 		return e.Dsse, nil
 	}
 	return nil, &NotLoadedError{edge: "dsse"}
@@ -92,7 +134,7 @@ func (*Statement) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case statement.FieldPredicate:
+		case statement.FieldTenant, statement.FieldPredicate:
 			values[i] = new(sql.NullString)
 		case statement.FieldID:
 			values[i] = new(uuid.UUID)
@@ -117,6 +159,12 @@ func (_m *Statement) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case statement.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
 		case statement.FieldPredicate:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field predicate", values[i])
@@ -151,6 +199,21 @@ func (_m *Statement) QueryAttestationCollections() *AttestationCollectionQuery {
 	return NewStatementClient(_m.config).QueryAttestationCollections(_m)
 }
 
+// QuerySlsaProvenance queries the "slsa_provenance" edge of the Statement entity.
+func (_m *Statement) QuerySlsaProvenance() *SlsaProvenanceQuery {
+	return NewStatementClient(_m.config).QuerySlsaProvenance(_m)
+}
+
+// QueryVerificationSummary queries the "verification_summary" edge of the Statement entity.
+func (_m *Statement) QueryVerificationSummary() *VerificationSummaryQuery {
+	return NewStatementClient(_m.config).QueryVerificationSummary(_m)
+}
+
+// QuerySbomPackages queries the "sbom_packages" edge of the Statement entity.
+func (_m *Statement) QuerySbomPackages() *SbomPackageQuery {
+	return NewStatementClient(_m.config).QuerySbomPackages(_m)
+}
+
 // QueryDsse queries the "dsse" edge of the Statement entity.
 func (_m *Statement) QueryDsse() *DsseQuery {
 	return NewStatementClient(_m.config).QueryDsse(_m)
@@ -179,6 +242,9 @@ func (_m *Statement) String() string {
 	var builder strings.Builder
 	builder.WriteString("Statement(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("predicate=")
 	builder.WriteString(_m.Predicate)
 	builder.WriteByte(')')
@@ -209,6 +275,30 @@ func (_m *Statement) appendNamedSubjects(name string, edges ...*Subject) {
 	}
 }
 
+// NamedSbomPackages returns the SbomPackages named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Statement) NamedSbomPackages(name string) ([]*SbomPackage, error) {
+	if _m.Edges.namedSbomPackages == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedSbomPackages[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Statement) appendNamedSbomPackages(name string, edges ...*SbomPackage) {
+	if _m.Edges.namedSbomPackages == nil {
+		_m.Edges.namedSbomPackages = make(map[string][]*SbomPackage)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedSbomPackages[name] = []*SbomPackage{}
+	} else {
+		_m.Edges.namedSbomPackages[name] = append(_m.Edges.namedSbomPackages[name], edges...)
+	}
+}
+
 // NamedDsse returns the Dsse named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (_m *Statement) NamedDsse(name string) ([]*Dsse, error) {
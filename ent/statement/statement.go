@@ -3,6 +3,7 @@
 package statement
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -13,6 +14,8 @@ const (
 	Label = "statement"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldPredicate holds the string denoting the predicate field in the database.
 	FieldPredicate = "predicate"
 	// EdgeSubjects holds the string denoting the subjects edge name in mutations.
@@ -21,6 +24,12 @@ const (
 	EdgePolicy = "policy"
 	// EdgeAttestationCollections holds the string denoting the attestation_collections edge name in mutations.
 	EdgeAttestationCollections = "attestation_collections"
+	// EdgeSlsaProvenance holds the string denoting the slsa_provenance edge name in mutations.
+	EdgeSlsaProvenance = "slsa_provenance"
+	// EdgeVerificationSummary holds the string denoting the verification_summary edge name in mutations.
+	EdgeVerificationSummary = "verification_summary"
+	// EdgeSbomPackages holds the string denoting the sbom_packages edge name in mutations.
+	EdgeSbomPackages = "sbom_packages"
 	// EdgeDsse holds the string denoting the dsse edge name in mutations.
 	EdgeDsse = "dsse"
 	// Table holds the table name of the statement in the database.
@@ -46,6 +55,27 @@ const (
 	AttestationCollectionsInverseTable = "attestation_collections"
 	// AttestationCollectionsColumn is the table column denoting the attestation_collections relation/edge.
 	AttestationCollectionsColumn = "statement_attestation_collections"
+	// SlsaProvenanceTable is the table that holds the slsa_provenance relation/edge.
+	SlsaProvenanceTable = "slsa_provenances"
+	// SlsaProvenanceInverseTable is the table name for the SlsaProvenance entity.
+	// It exists in this package in order to avoid circular dependency with the "slsaprovenance" package.
+	SlsaProvenanceInverseTable = "slsa_provenances"
+	// SlsaProvenanceColumn is the table column denoting the slsa_provenance relation/edge.
+	SlsaProvenanceColumn = "statement_slsa_provenance"
+	// VerificationSummaryTable is the table that holds the verification_summary relation/edge.
+	VerificationSummaryTable = "verification_summaries"
+	// VerificationSummaryInverseTable is the table name for the VerificationSummary entity.
+	// It exists in this package in order to avoid circular dependency with the "verificationsummary" package.
+	VerificationSummaryInverseTable = "verification_summaries"
+	// VerificationSummaryColumn is the table column denoting the verification_summary relation/edge.
+	VerificationSummaryColumn = "statement_verification_summary"
+	// SbomPackagesTable is the table that holds the sbom_packages relation/edge.
+	SbomPackagesTable = "sbom_packages"
+	// SbomPackagesInverseTable is the table name for the SbomPackage entity.
+	// It exists in this package in order to avoid circular dependency with the "sbompackage" package.
+	SbomPackagesInverseTable = "sbom_packages"
+	// SbomPackagesColumn is the table column denoting the sbom_packages relation/edge.
+	SbomPackagesColumn = "statement_sbom_packages"
 	// DsseTable is the table that holds the dsse relation/edge.
 	DsseTable = "dsses"
 	// DsseInverseTable is the table name for the Dsse entity.
@@ -58,6 +88,7 @@ const (
 // Columns holds all SQL columns for statement fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldPredicate,
 }
 
@@ -71,7 +102,18 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
 	// PredicateValidator is a validator for the "predicate" field. It is called by the builders before save.
 	PredicateValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
@@ -86,6 +128,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByPredicate orders the results by the predicate field.
 func ByPredicate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPredicate, opts...).ToFunc()
@@ -119,6 +166,34 @@ func ByAttestationCollectionsField(field string, opts ...sql.OrderTermOption) Or
 	}
 }
 
+// BySlsaProvenanceField orders the results by slsa_provenance field.
+func BySlsaProvenanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSlsaProvenanceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByVerificationSummaryField orders the results by verification_summary field.
+func ByVerificationSummaryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVerificationSummaryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySbomPackagesCount orders the results by sbom_packages count.
+func BySbomPackagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSbomPackagesStep(), opts...)
+	}
+}
+
+// BySbomPackages orders the results by sbom_packages terms.
+func BySbomPackages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSbomPackagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByDsseCount orders the results by dsse count.
 func ByDsseCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -153,6 +228,27 @@ func newAttestationCollectionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, AttestationCollectionsTable, AttestationCollectionsColumn),
 	)
 }
+func newSlsaProvenanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SlsaProvenanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, SlsaProvenanceTable, SlsaProvenanceColumn),
+	)
+}
+func newVerificationSummaryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VerificationSummaryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, VerificationSummaryTable, VerificationSummaryColumn),
+	)
+}
+func newSbomPackagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SbomPackagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SbomPackagesTable, SbomPackagesColumn),
+	)
+}
 func newDsseStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
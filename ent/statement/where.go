@@ -54,11 +54,81 @@ func IDLTE(id uuid.UUID) predicate.Statement {
 	return predicate.Statement(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldEQ(FieldTenant, v))
+}
+
 // Predicate applies equality check predicate on the "predicate" field. It's identical to PredicateEQ.
 func Predicate(v string) predicate.Statement {
 	return predicate.Statement(sql.FieldEQ(FieldPredicate, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Statement {
+	return predicate.Statement(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Statement {
+	return predicate.Statement(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Statement {
+	return predicate.Statement(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // PredicateEQ applies the EQ predicate on the "predicate" field.
 func PredicateEQ(v string) predicate.Statement {
 	return predicate.Statement(sql.FieldEQ(FieldPredicate, v))
@@ -193,6 +263,75 @@ func HasAttestationCollectionsWith(preds ...predicate.AttestationCollection) pre
 	})
 }
 
+// HasSlsaProvenance applies the HasEdge predicate on the "slsa_provenance" edge.
+func HasSlsaProvenance() predicate.Statement {
+	return predicate.Statement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, SlsaProvenanceTable, SlsaProvenanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSlsaProvenanceWith applies the HasEdge predicate on the "slsa_provenance" edge with a given conditions (other predicates).
+func HasSlsaProvenanceWith(preds ...predicate.SlsaProvenance) predicate.Statement {
+	return predicate.Statement(func(s *sql.Selector) {
+		step := newSlsaProvenanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVerificationSummary applies the HasEdge predicate on the "verification_summary" edge.
+func HasVerificationSummary() predicate.Statement {
+	return predicate.Statement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, VerificationSummaryTable, VerificationSummaryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVerificationSummaryWith applies the HasEdge predicate on the "verification_summary" edge with a given conditions (other predicates).
+func HasVerificationSummaryWith(preds ...predicate.VerificationSummary) predicate.Statement {
+	return predicate.Statement(func(s *sql.Selector) {
+		step := newVerificationSummaryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSbomPackages applies the HasEdge predicate on the "sbom_packages" edge.
+func HasSbomPackages() predicate.Statement {
+	return predicate.Statement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SbomPackagesTable, SbomPackagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSbomPackagesWith applies the HasEdge predicate on the "sbom_packages" edge with a given conditions (other predicates).
+func HasSbomPackagesWith(preds ...predicate.SbomPackage) predicate.Statement {
+	return predicate.Statement(func(s *sql.Selector) {
+		step := newSbomPackagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasDsse applies the HasEdge predicate on the "dsse" edge.
 func HasDsse() predicate.Statement {
 	return predicate.Statement(func(s *sql.Selector) {
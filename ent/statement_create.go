@@ -13,8 +13,11 @@ import (
 	"github.com/in-toto/archivista/ent/attestationcollection"
 	"github.com/in-toto/archivista/ent/attestationpolicy"
 	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 // StatementCreate is the builder for creating a Statement entity.
@@ -24,6 +27,20 @@ type StatementCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *StatementCreate) SetTenant(v string) *StatementCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *StatementCreate) SetNillableTenant(v *string) *StatementCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetPredicate sets the "predicate" field.
 func (_c *StatementCreate) SetPredicate(v string) *StatementCreate {
 	_c.mutation.SetPredicate(v)
@@ -97,6 +114,59 @@ func (_c *StatementCreate) SetAttestationCollections(v *AttestationCollection) *
 	return _c.SetAttestationCollectionsID(v.ID)
 }
 
+// SetSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by ID.
+func (_c *StatementCreate) SetSlsaProvenanceID(id uuid.UUID) *StatementCreate {
+	_c.mutation.SetSlsaProvenanceID(id)
+	return _c
+}
+
+// SetNillableSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by ID if the given value is not nil.
+func (_c *StatementCreate) SetNillableSlsaProvenanceID(id *uuid.UUID) *StatementCreate {
+	if id != nil {
+		_c = _c.SetSlsaProvenanceID(*id)
+	}
+	return _c
+}
+
+// SetSlsaProvenance sets the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_c *StatementCreate) SetSlsaProvenance(v *SlsaProvenance) *StatementCreate {
+	return _c.SetSlsaProvenanceID(v.ID)
+}
+
+// SetVerificationSummaryID sets the "verification_summary" edge to the VerificationSummary entity by ID.
+func (_c *StatementCreate) SetVerificationSummaryID(id uuid.UUID) *StatementCreate {
+	_c.mutation.SetVerificationSummaryID(id)
+	return _c
+}
+
+// SetNillableVerificationSummaryID sets the "verification_summary" edge to the VerificationSummary entity by ID if the given value is not nil.
+func (_c *StatementCreate) SetNillableVerificationSummaryID(id *uuid.UUID) *StatementCreate {
+	if id != nil {
+		_c = _c.SetVerificationSummaryID(*id)
+	}
+	return _c
+}
+
+// SetVerificationSummary sets the "verification_summary" edge to the VerificationSummary entity.
+func (_c *StatementCreate) SetVerificationSummary(v *VerificationSummary) *StatementCreate {
+	return _c.SetVerificationSummaryID(v.ID)
+}
+
+// AddSbomPackageIDs adds the "sbom_packages" edge to the SbomPackage entity by IDs.
+func (_c *StatementCreate) AddSbomPackageIDs(ids ...uuid.UUID) *StatementCreate {
+	_c.mutation.AddSbomPackageIDs(ids...)
+	return _c
+}
+
+// AddSbomPackages adds the "sbom_packages" edges to the SbomPackage entity.
+func (_c *StatementCreate) AddSbomPackages(v ...*SbomPackage) *StatementCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSbomPackageIDs(ids...)
+}
+
 // AddDsseIDs adds the "dsse" edge to the Dsse entity by IDs.
 func (_c *StatementCreate) AddDsseIDs(ids ...uuid.UUID) *StatementCreate {
 	_c.mutation.AddDsseIDs(ids...)
@@ -119,7 +189,9 @@ func (_c *StatementCreate) Mutation() *StatementMutation {
 
 // Save creates the Statement in the database.
 func (_c *StatementCreate) Save(ctx context.Context) (*Statement, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -146,15 +218,31 @@ func (_c *StatementCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *StatementCreate) defaults() {
+func (_c *StatementCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := statement.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if statement.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized statement.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := statement.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *StatementCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Statement.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := statement.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "Statement.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Predicate(); !ok {
 		return &ValidationError{Name: "predicate", err: errors.New(`ent: missing required field "Statement.predicate"`)}
 	}
@@ -198,6 +286,10 @@ func (_c *StatementCreate) createSpec() (*Statement, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(statement.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.Predicate(); ok {
 		_spec.SetField(statement.FieldPredicate, field.TypeString, value)
 		_node.Predicate = value
@@ -250,6 +342,54 @@ func (_c *StatementCreate) createSpec() (*Statement, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SlsaProvenanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.SlsaProvenanceTable,
+			Columns: []string{statement.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VerificationSummaryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.VerificationSummaryTable,
+			Columns: []string{statement.VerificationSummaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationsummary.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SbomPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statement.SbomPackagesTable,
+			Columns: []string{statement.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.DsseIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -17,8 +17,11 @@ import (
 	"github.com/in-toto/archivista/ent/attestationpolicy"
 	"github.com/in-toto/archivista/ent/dsse"
 	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 // StatementQuery is the builder for querying Statement entities.
@@ -31,10 +34,14 @@ type StatementQuery struct {
 	withSubjects               *SubjectQuery
 	withPolicy                 *AttestationPolicyQuery
 	withAttestationCollections *AttestationCollectionQuery
+	withSlsaProvenance         *SlsaProvenanceQuery
+	withVerificationSummary    *VerificationSummaryQuery
+	withSbomPackages           *SbomPackageQuery
 	withDsse                   *DsseQuery
 	modifiers                  []func(*sql.Selector)
 	loadTotal                  []func(context.Context, []*Statement) error
 	withNamedSubjects          map[string]*SubjectQuery
+	withNamedSbomPackages      map[string]*SbomPackageQuery
 	withNamedDsse              map[string]*DsseQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -138,6 +145,72 @@ func (_q *StatementQuery) QueryAttestationCollections() *AttestationCollectionQu
 	return query
 }
 
+// QuerySlsaProvenance chains the current query on the "slsa_provenance" edge.
+func (_q *StatementQuery) QuerySlsaProvenance() *SlsaProvenanceQuery {
+	query := (&SlsaProvenanceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statement.Table, statement.FieldID, selector),
+			sqlgraph.To(slsaprovenance.Table, slsaprovenance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, statement.SlsaProvenanceTable, statement.SlsaProvenanceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVerificationSummary chains the current query on the "verification_summary" edge.
+func (_q *StatementQuery) QueryVerificationSummary() *VerificationSummaryQuery {
+	query := (&VerificationSummaryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statement.Table, statement.FieldID, selector),
+			sqlgraph.To(verificationsummary.Table, verificationsummary.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, statement.VerificationSummaryTable, statement.VerificationSummaryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySbomPackages chains the current query on the "sbom_packages" edge.
+func (_q *StatementQuery) QuerySbomPackages() *SbomPackageQuery {
+	query := (&SbomPackageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statement.Table, statement.FieldID, selector),
+			sqlgraph.To(sbompackage.Table, sbompackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, statement.SbomPackagesTable, statement.SbomPackagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryDsse chains the current query on the "dsse" edge.
 func (_q *StatementQuery) QueryDsse() *DsseQuery {
 	query := (&DsseClient{config: _q.config}).Query()
@@ -355,6 +428,9 @@ func (_q *StatementQuery) Clone() *StatementQuery {
 		withSubjects:               _q.withSubjects.Clone(),
 		withPolicy:                 _q.withPolicy.Clone(),
 		withAttestationCollections: _q.withAttestationCollections.Clone(),
+		withSlsaProvenance:         _q.withSlsaProvenance.Clone(),
+		withVerificationSummary:    _q.withVerificationSummary.Clone(),
+		withSbomPackages:           _q.withSbomPackages.Clone(),
 		withDsse:                   _q.withDsse.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
@@ -395,6 +471,39 @@ func (_q *StatementQuery) WithAttestationCollections(opts ...func(*AttestationCo
 	return _q
 }
 
+// WithSlsaProvenance tells the query-builder to eager-load the nodes that are connected to
+// the "slsa_provenance" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StatementQuery) WithSlsaProvenance(opts ...func(*SlsaProvenanceQuery)) *StatementQuery {
+	query := (&SlsaProvenanceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSlsaProvenance = query
+	return _q
+}
+
+// WithVerificationSummary tells the query-builder to eager-load the nodes that are connected to
+// the "verification_summary" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StatementQuery) WithVerificationSummary(opts ...func(*VerificationSummaryQuery)) *StatementQuery {
+	query := (&VerificationSummaryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVerificationSummary = query
+	return _q
+}
+
+// WithSbomPackages tells the query-builder to eager-load the nodes that are connected to
+// the "sbom_packages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StatementQuery) WithSbomPackages(opts ...func(*SbomPackageQuery)) *StatementQuery {
+	query := (&SbomPackageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSbomPackages = query
+	return _q
+}
+
 // WithDsse tells the query-builder to eager-load the nodes that are connected to
 // the "dsse" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *StatementQuery) WithDsse(opts ...func(*DsseQuery)) *StatementQuery {
@@ -412,12 +521,12 @@ func (_q *StatementQuery) WithDsse(opts ...func(*DsseQuery)) *StatementQuery {
 // Example:
 //
 //	var v []struct {
-//		Predicate string `json:"predicate,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Statement.Query().
-//		GroupBy(statement.FieldPredicate).
+//		GroupBy(statement.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *StatementQuery) GroupBy(field string, fields ...string) *StatementGroupBy {
@@ -435,11 +544,11 @@ func (_q *StatementQuery) GroupBy(field string, fields ...string) *StatementGrou
 // Example:
 //
 //	var v []struct {
-//		Predicate string `json:"predicate,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Statement.Query().
-//		Select(statement.FieldPredicate).
+//		Select(statement.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *StatementQuery) Select(fields ...string) *StatementSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -484,10 +593,13 @@ func (_q *StatementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*St
 	var (
 		nodes       = []*Statement{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [7]bool{
 			_q.withSubjects != nil,
 			_q.withPolicy != nil,
 			_q.withAttestationCollections != nil,
+			_q.withSlsaProvenance != nil,
+			_q.withVerificationSummary != nil,
+			_q.withSbomPackages != nil,
 			_q.withDsse != nil,
 		}
 	)
@@ -531,6 +643,25 @@ func (_q *StatementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*St
 			return nil, err
 		}
 	}
+	if query := _q.withSlsaProvenance; query != nil {
+		if err := _q.loadSlsaProvenance(ctx, query, nodes, nil,
+			func(n *Statement, e *SlsaProvenance) { n.Edges.SlsaProvenance = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVerificationSummary; query != nil {
+		if err := _q.loadVerificationSummary(ctx, query, nodes, nil,
+			func(n *Statement, e *VerificationSummary) { n.Edges.VerificationSummary = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSbomPackages; query != nil {
+		if err := _q.loadSbomPackages(ctx, query, nodes,
+			func(n *Statement) { n.Edges.SbomPackages = []*SbomPackage{} },
+			func(n *Statement, e *SbomPackage) { n.Edges.SbomPackages = append(n.Edges.SbomPackages, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withDsse; query != nil {
 		if err := _q.loadDsse(ctx, query, nodes,
 			func(n *Statement) { n.Edges.Dsse = []*Dsse{} },
@@ -545,6 +676,13 @@ func (_q *StatementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*St
 			return nil, err
 		}
 	}
+	for name, query := range _q.withNamedSbomPackages {
+		if err := _q.loadSbomPackages(ctx, query, nodes,
+			func(n *Statement) { n.appendNamedSbomPackages(name) },
+			func(n *Statement, e *SbomPackage) { n.appendNamedSbomPackages(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedDsse {
 		if err := _q.loadDsse(ctx, query, nodes,
 			func(n *Statement) { n.appendNamedDsse(name) },
@@ -647,6 +785,93 @@ func (_q *StatementQuery) loadAttestationCollections(ctx context.Context, query
 	}
 	return nil
 }
+func (_q *StatementQuery) loadSlsaProvenance(ctx context.Context, query *SlsaProvenanceQuery, nodes []*Statement, init func(*Statement), assign func(*Statement, *SlsaProvenance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Statement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.SlsaProvenance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(statement.SlsaProvenanceColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.statement_slsa_provenance
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "statement_slsa_provenance" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "statement_slsa_provenance" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StatementQuery) loadVerificationSummary(ctx context.Context, query *VerificationSummaryQuery, nodes []*Statement, init func(*Statement), assign func(*Statement, *VerificationSummary)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Statement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.VerificationSummary(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(statement.VerificationSummaryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.statement_verification_summary
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "statement_verification_summary" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "statement_verification_summary" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StatementQuery) loadSbomPackages(ctx context.Context, query *SbomPackageQuery, nodes []*Statement, init func(*Statement), assign func(*Statement, *SbomPackage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Statement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SbomPackage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(statement.SbomPackagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.statement_sbom_packages
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "statement_sbom_packages" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "statement_sbom_packages" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *StatementQuery) loadDsse(ctx context.Context, query *DsseQuery, nodes []*Statement, init func(*Statement), assign func(*Statement, *Dsse)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Statement)
@@ -777,6 +1002,20 @@ func (_q *StatementQuery) WithNamedSubjects(name string, opts ...func(*SubjectQu
 	return _q
 }
 
+// WithNamedSbomPackages tells the query-builder to eager-load the nodes that are connected to the "sbom_packages"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *StatementQuery) WithNamedSbomPackages(name string, opts ...func(*SbomPackageQuery)) *StatementQuery {
+	query := (&SbomPackageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedSbomPackages == nil {
+		_q.withNamedSbomPackages = make(map[string]*SbomPackageQuery)
+	}
+	_q.withNamedSbomPackages[name] = query
+	return _q
+}
+
 // WithNamedDsse tells the query-builder to eager-load the nodes that are connected to the "dsse"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (_q *StatementQuery) WithNamedDsse(name string, opts ...func(*DsseQuery)) *StatementQuery {
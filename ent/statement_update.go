@@ -15,8 +15,11 @@ import (
 	"github.com/in-toto/archivista/ent/attestationpolicy"
 	"github.com/in-toto/archivista/ent/dsse"
 	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
 	"github.com/in-toto/archivista/ent/statement"
 	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/verificationsummary"
 )
 
 // StatementUpdate is the builder for updating Statement entities.
@@ -99,6 +102,59 @@ func (_u *StatementUpdate) SetAttestationCollections(v *AttestationCollection) *
 	return _u.SetAttestationCollectionsID(v.ID)
 }
 
+// SetSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by ID.
+func (_u *StatementUpdate) SetSlsaProvenanceID(id uuid.UUID) *StatementUpdate {
+	_u.mutation.SetSlsaProvenanceID(id)
+	return _u
+}
+
+// SetNillableSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by ID if the given value is not nil.
+func (_u *StatementUpdate) SetNillableSlsaProvenanceID(id *uuid.UUID) *StatementUpdate {
+	if id != nil {
+		_u = _u.SetSlsaProvenanceID(*id)
+	}
+	return _u
+}
+
+// SetSlsaProvenance sets the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_u *StatementUpdate) SetSlsaProvenance(v *SlsaProvenance) *StatementUpdate {
+	return _u.SetSlsaProvenanceID(v.ID)
+}
+
+// SetVerificationSummaryID sets the "verification_summary" edge to the VerificationSummary entity by ID.
+func (_u *StatementUpdate) SetVerificationSummaryID(id uuid.UUID) *StatementUpdate {
+	_u.mutation.SetVerificationSummaryID(id)
+	return _u
+}
+
+// SetNillableVerificationSummaryID sets the "verification_summary" edge to the VerificationSummary entity by ID if the given value is not nil.
+func (_u *StatementUpdate) SetNillableVerificationSummaryID(id *uuid.UUID) *StatementUpdate {
+	if id != nil {
+		_u = _u.SetVerificationSummaryID(*id)
+	}
+	return _u
+}
+
+// SetVerificationSummary sets the "verification_summary" edge to the VerificationSummary entity.
+func (_u *StatementUpdate) SetVerificationSummary(v *VerificationSummary) *StatementUpdate {
+	return _u.SetVerificationSummaryID(v.ID)
+}
+
+// AddSbomPackageIDs adds the "sbom_packages" edge to the SbomPackage entity by IDs.
+func (_u *StatementUpdate) AddSbomPackageIDs(ids ...uuid.UUID) *StatementUpdate {
+	_u.mutation.AddSbomPackageIDs(ids...)
+	return _u
+}
+
+// AddSbomPackages adds the "sbom_packages" edges to the SbomPackage entity.
+func (_u *StatementUpdate) AddSbomPackages(v ...*SbomPackage) *StatementUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSbomPackageIDs(ids...)
+}
+
 // AddDsseIDs adds the "dsse" edge to the Dsse entity by IDs.
 func (_u *StatementUpdate) AddDsseIDs(ids ...uuid.UUID) *StatementUpdate {
 	_u.mutation.AddDsseIDs(ids...)
@@ -152,6 +208,39 @@ func (_u *StatementUpdate) ClearAttestationCollections() *StatementUpdate {
 	return _u
 }
 
+// ClearSlsaProvenance clears the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_u *StatementUpdate) ClearSlsaProvenance() *StatementUpdate {
+	_u.mutation.ClearSlsaProvenance()
+	return _u
+}
+
+// ClearVerificationSummary clears the "verification_summary" edge to the VerificationSummary entity.
+func (_u *StatementUpdate) ClearVerificationSummary() *StatementUpdate {
+	_u.mutation.ClearVerificationSummary()
+	return _u
+}
+
+// ClearSbomPackages clears all "sbom_packages" edges to the SbomPackage entity.
+func (_u *StatementUpdate) ClearSbomPackages() *StatementUpdate {
+	_u.mutation.ClearSbomPackages()
+	return _u
+}
+
+// RemoveSbomPackageIDs removes the "sbom_packages" edge to SbomPackage entities by IDs.
+func (_u *StatementUpdate) RemoveSbomPackageIDs(ids ...uuid.UUID) *StatementUpdate {
+	_u.mutation.RemoveSbomPackageIDs(ids...)
+	return _u
+}
+
+// RemoveSbomPackages removes "sbom_packages" edges to SbomPackage entities.
+func (_u *StatementUpdate) RemoveSbomPackages(v ...*SbomPackage) *StatementUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSbomPackageIDs(ids...)
+}
+
 // ClearDsse clears all "dsse" edges to the Dsse entity.
 func (_u *StatementUpdate) ClearDsse() *StatementUpdate {
 	_u.mutation.ClearDsse()
@@ -328,6 +417,109 @@ func (_u *StatementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SlsaProvenanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.SlsaProvenanceTable,
+			Columns: []string{statement.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlsaProvenanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.SlsaProvenanceTable,
+			Columns: []string{statement.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VerificationSummaryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.VerificationSummaryTable,
+			Columns: []string{statement.VerificationSummaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationsummary.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VerificationSummaryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.VerificationSummaryTable,
+			Columns: []string{statement.VerificationSummaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationsummary.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SbomPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statement.SbomPackagesTable,
+			Columns: []string{statement.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSbomPackagesIDs(); len(nodes) > 0 && !_u.mutation.SbomPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statement.SbomPackagesTable,
+			Columns: []string{statement.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SbomPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statement.SbomPackagesTable,
+			Columns: []string{statement.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.DsseCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -460,6 +652,59 @@ func (_u *StatementUpdateOne) SetAttestationCollections(v *AttestationCollection
 	return _u.SetAttestationCollectionsID(v.ID)
 }
 
+// SetSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by ID.
+func (_u *StatementUpdateOne) SetSlsaProvenanceID(id uuid.UUID) *StatementUpdateOne {
+	_u.mutation.SetSlsaProvenanceID(id)
+	return _u
+}
+
+// SetNillableSlsaProvenanceID sets the "slsa_provenance" edge to the SlsaProvenance entity by ID if the given value is not nil.
+func (_u *StatementUpdateOne) SetNillableSlsaProvenanceID(id *uuid.UUID) *StatementUpdateOne {
+	if id != nil {
+		_u = _u.SetSlsaProvenanceID(*id)
+	}
+	return _u
+}
+
+// SetSlsaProvenance sets the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_u *StatementUpdateOne) SetSlsaProvenance(v *SlsaProvenance) *StatementUpdateOne {
+	return _u.SetSlsaProvenanceID(v.ID)
+}
+
+// SetVerificationSummaryID sets the "verification_summary" edge to the VerificationSummary entity by ID.
+func (_u *StatementUpdateOne) SetVerificationSummaryID(id uuid.UUID) *StatementUpdateOne {
+	_u.mutation.SetVerificationSummaryID(id)
+	return _u
+}
+
+// SetNillableVerificationSummaryID sets the "verification_summary" edge to the VerificationSummary entity by ID if the given value is not nil.
+func (_u *StatementUpdateOne) SetNillableVerificationSummaryID(id *uuid.UUID) *StatementUpdateOne {
+	if id != nil {
+		_u = _u.SetVerificationSummaryID(*id)
+	}
+	return _u
+}
+
+// SetVerificationSummary sets the "verification_summary" edge to the VerificationSummary entity.
+func (_u *StatementUpdateOne) SetVerificationSummary(v *VerificationSummary) *StatementUpdateOne {
+	return _u.SetVerificationSummaryID(v.ID)
+}
+
+// AddSbomPackageIDs adds the "sbom_packages" edge to the SbomPackage entity by IDs.
+func (_u *StatementUpdateOne) AddSbomPackageIDs(ids ...uuid.UUID) *StatementUpdateOne {
+	_u.mutation.AddSbomPackageIDs(ids...)
+	return _u
+}
+
+// AddSbomPackages adds the "sbom_packages" edges to the SbomPackage entity.
+func (_u *StatementUpdateOne) AddSbomPackages(v ...*SbomPackage) *StatementUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSbomPackageIDs(ids...)
+}
+
 // AddDsseIDs adds the "dsse" edge to the Dsse entity by IDs.
 func (_u *StatementUpdateOne) AddDsseIDs(ids ...uuid.UUID) *StatementUpdateOne {
 	_u.mutation.AddDsseIDs(ids...)
@@ -513,6 +758,39 @@ func (_u *StatementUpdateOne) ClearAttestationCollections() *StatementUpdateOne
 	return _u
 }
 
+// ClearSlsaProvenance clears the "slsa_provenance" edge to the SlsaProvenance entity.
+func (_u *StatementUpdateOne) ClearSlsaProvenance() *StatementUpdateOne {
+	_u.mutation.ClearSlsaProvenance()
+	return _u
+}
+
+// ClearVerificationSummary clears the "verification_summary" edge to the VerificationSummary entity.
+func (_u *StatementUpdateOne) ClearVerificationSummary() *StatementUpdateOne {
+	_u.mutation.ClearVerificationSummary()
+	return _u
+}
+
+// ClearSbomPackages clears all "sbom_packages" edges to the SbomPackage entity.
+func (_u *StatementUpdateOne) ClearSbomPackages() *StatementUpdateOne {
+	_u.mutation.ClearSbomPackages()
+	return _u
+}
+
+// RemoveSbomPackageIDs removes the "sbom_packages" edge to SbomPackage entities by IDs.
+func (_u *StatementUpdateOne) RemoveSbomPackageIDs(ids ...uuid.UUID) *StatementUpdateOne {
+	_u.mutation.RemoveSbomPackageIDs(ids...)
+	return _u
+}
+
+// RemoveSbomPackages removes "sbom_packages" edges to SbomPackage entities.
+func (_u *StatementUpdateOne) RemoveSbomPackages(v ...*SbomPackage) *StatementUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSbomPackageIDs(ids...)
+}
+
 // ClearDsse clears all "dsse" edges to the Dsse entity.
 func (_u *StatementUpdateOne) ClearDsse() *StatementUpdateOne {
 	_u.mutation.ClearDsse()
@@ -719,6 +997,109 @@ func (_u *StatementUpdateOne) sqlSave(ctx context.Context) (_node *Statement, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SlsaProvenanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.SlsaProvenanceTable,
+			Columns: []string{statement.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlsaProvenanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.SlsaProvenanceTable,
+			Columns: []string{statement.SlsaProvenanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slsaprovenance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VerificationSummaryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.VerificationSummaryTable,
+			Columns: []string{statement.VerificationSummaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationsummary.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VerificationSummaryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   statement.VerificationSummaryTable,
+			Columns: []string{statement.VerificationSummaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationsummary.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SbomPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statement.SbomPackagesTable,
+			Columns: []string{statement.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSbomPackagesIDs(); len(nodes) > 0 && !_u.mutation.SbomPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statement.SbomPackagesTable,
+			Columns: []string{statement.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SbomPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statement.SbomPackagesTable,
+			Columns: []string{statement.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.DsseCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
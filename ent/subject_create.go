@@ -23,6 +23,20 @@ type SubjectCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *SubjectCreate) SetTenant(v string) *SubjectCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *SubjectCreate) SetNillableTenant(v *string) *SubjectCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *SubjectCreate) SetCreatedAt(v time.Time) *SubjectCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -98,7 +112,9 @@ func (_c *SubjectCreate) Mutation() *SubjectMutation {
 
 // Save creates the Subject in the database.
 func (_c *SubjectCreate) Save(ctx context.Context) (*Subject, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -125,19 +141,38 @@ func (_c *SubjectCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *SubjectCreate) defaults() {
+func (_c *SubjectCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := subject.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if subject.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized subject.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := subject.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if subject.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized subject.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := subject.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *SubjectCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Subject.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := subject.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "Subject.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Subject.name"`)}
 	}
@@ -181,6 +216,10 @@ func (_c *SubjectCreate) createSpec() (*Subject, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(subject.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(subject.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = &value
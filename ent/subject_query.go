@@ -340,12 +340,12 @@ func (_q *SubjectQuery) WithStatement(opts ...func(*StatementQuery)) *SubjectQue
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Subject.Query().
-//		GroupBy(subject.FieldCreatedAt).
+//		GroupBy(subject.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *SubjectQuery) GroupBy(field string, fields ...string) *SubjectGroupBy {
@@ -363,11 +363,11 @@ func (_q *SubjectQuery) GroupBy(field string, fields ...string) *SubjectGroupBy
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Subject.Query().
-//		Select(subject.FieldCreatedAt).
+//		Select(subject.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *SubjectQuery) Select(fields ...string) *SubjectSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
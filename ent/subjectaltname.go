@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+)
+
+// SubjectAltName is the model entity for the SubjectAltName schema.
+type SubjectAltName struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// Type holds the value of the "type" field.
+	Type subjectaltname.Type `json:"type,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubjectAltNameQuery when eager-loading is set.
+	Edges                         SubjectAltNameEdges `json:"edges"`
+	certificate_subject_alt_names *uuid.UUID
+	selectValues                  sql.SelectValues
+}
+
+// SubjectAltNameEdges holds the relations/edges for other nodes in the graph.
+type SubjectAltNameEdges struct {
+	// Certificate holds the value of the certificate edge.
+	Certificate *Certificate `json:"certificate,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// CertificateOrErr returns the Certificate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubjectAltNameEdges) CertificateOrErr() (*Certificate, error) {
+	if e.Certificate != nil {
+		return e.Certificate, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: certificate.Label}
+	}
+	return nil, &NotLoadedError{edge: "certificate"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SubjectAltName) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subjectaltname.FieldTenant, subjectaltname.FieldType, subjectaltname.FieldValue:
+			values[i] = new(sql.NullString)
+		case subjectaltname.FieldID:
+			values[i] = new(uuid.UUID)
+		case subjectaltname.ForeignKeys[0]: // certificate_subject_alt_names
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SubjectAltName fields.
+func (_m *SubjectAltName) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subjectaltname.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case subjectaltname.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case subjectaltname.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = subjectaltname.Type(value.String)
+			}
+		case subjectaltname.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case subjectaltname.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_subject_alt_names", values[i])
+			} else if value.Valid {
+				_m.certificate_subject_alt_names = new(uuid.UUID)
+				*_m.certificate_subject_alt_names = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the SubjectAltName.
+// This includes values selected through modifiers, order, etc.
+func (_m *SubjectAltName) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCertificate queries the "certificate" edge of the SubjectAltName entity.
+func (_m *SubjectAltName) QueryCertificate() *CertificateQuery {
+	return NewSubjectAltNameClient(_m.config).QueryCertificate(_m)
+}
+
+// Update returns a builder for updating this SubjectAltName.
+// Note that you need to call SubjectAltName.Unwrap() before calling this method if this SubjectAltName
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SubjectAltName) Update() *SubjectAltNameUpdateOne {
+	return NewSubjectAltNameClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SubjectAltName entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SubjectAltName) Unwrap() *SubjectAltName {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SubjectAltName is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SubjectAltName) String() string {
+	var builder strings.Builder
+	builder.WriteString("SubjectAltName(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SubjectAltNames is a parsable slice of SubjectAltName.
+type SubjectAltNames []*SubjectAltName
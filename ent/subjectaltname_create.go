@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+)
+
+// SubjectAltNameCreate is the builder for creating a SubjectAltName entity.
+type SubjectAltNameCreate struct {
+	config
+	mutation *SubjectAltNameMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *SubjectAltNameCreate) SetTenant(v string) *SubjectAltNameCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *SubjectAltNameCreate) SetNillableTenant(v *string) *SubjectAltNameCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *SubjectAltNameCreate) SetType(v subjectaltname.Type) *SubjectAltNameCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *SubjectAltNameCreate) SetValue(v string) *SubjectAltNameCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SubjectAltNameCreate) SetID(v uuid.UUID) *SubjectAltNameCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SubjectAltNameCreate) SetNillableID(v *uuid.UUID) *SubjectAltNameCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCertificateID sets the "certificate" edge to the Certificate entity by ID.
+func (_c *SubjectAltNameCreate) SetCertificateID(id uuid.UUID) *SubjectAltNameCreate {
+	_c.mutation.SetCertificateID(id)
+	return _c
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (_c *SubjectAltNameCreate) SetCertificate(v *Certificate) *SubjectAltNameCreate {
+	return _c.SetCertificateID(v.ID)
+}
+
+// Mutation returns the SubjectAltNameMutation object of the builder.
+func (_c *SubjectAltNameCreate) Mutation() *SubjectAltNameMutation {
+	return _c.mutation
+}
+
+// Save creates the SubjectAltName in the database.
+func (_c *SubjectAltNameCreate) Save(ctx context.Context) (*SubjectAltName, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SubjectAltNameCreate) SaveX(ctx context.Context) *SubjectAltName {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubjectAltNameCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubjectAltNameCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SubjectAltNameCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := subjectaltname.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if subjectaltname.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized subjectaltname.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := subjectaltname.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SubjectAltNameCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "SubjectAltName.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := subjectaltname.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "SubjectAltName.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "SubjectAltName.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := subjectaltname.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SubjectAltName.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "SubjectAltName.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := subjectaltname.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "SubjectAltName.value": %w`, err)}
+		}
+	}
+	if len(_c.mutation.CertificateIDs()) == 0 {
+		return &ValidationError{Name: "certificate", err: errors.New(`ent: missing required edge "SubjectAltName.certificate"`)}
+	}
+	return nil
+}
+
+func (_c *SubjectAltNameCreate) sqlSave(ctx context.Context) (*SubjectAltName, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SubjectAltNameCreate) createSpec() (*SubjectAltName, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubjectAltName{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(subjectaltname.Table, sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(subjectaltname.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(subjectaltname.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(subjectaltname.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if nodes := _c.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subjectaltname.CertificateTable,
+			Columns: []string{subjectaltname.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.certificate_subject_alt_names = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SubjectAltNameCreateBulk is the builder for creating many SubjectAltName entities in bulk.
+type SubjectAltNameCreateBulk struct {
+	config
+	err      error
+	builders []*SubjectAltNameCreate
+}
+
+// Save creates the SubjectAltName entities in the database.
+func (_c *SubjectAltNameCreateBulk) Save(ctx context.Context) ([]*SubjectAltName, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SubjectAltName, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubjectAltNameMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SubjectAltNameCreateBulk) SaveX(ctx context.Context) []*SubjectAltName {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubjectAltNameCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubjectAltNameCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
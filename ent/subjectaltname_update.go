@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+)
+
+// SubjectAltNameUpdate is the builder for updating SubjectAltName entities.
+type SubjectAltNameUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubjectAltNameMutation
+}
+
+// Where appends a list predicates to the SubjectAltNameUpdate builder.
+func (_u *SubjectAltNameUpdate) Where(ps ...predicate.SubjectAltName) *SubjectAltNameUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *SubjectAltNameUpdate) SetType(v subjectaltname.Type) *SubjectAltNameUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *SubjectAltNameUpdate) SetNillableType(v *subjectaltname.Type) *SubjectAltNameUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *SubjectAltNameUpdate) SetValue(v string) *SubjectAltNameUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *SubjectAltNameUpdate) SetNillableValue(v *string) *SubjectAltNameUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetCertificateID sets the "certificate" edge to the Certificate entity by ID.
+func (_u *SubjectAltNameUpdate) SetCertificateID(id uuid.UUID) *SubjectAltNameUpdate {
+	_u.mutation.SetCertificateID(id)
+	return _u
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (_u *SubjectAltNameUpdate) SetCertificate(v *Certificate) *SubjectAltNameUpdate {
+	return _u.SetCertificateID(v.ID)
+}
+
+// Mutation returns the SubjectAltNameMutation object of the builder.
+func (_u *SubjectAltNameUpdate) Mutation() *SubjectAltNameMutation {
+	return _u.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (_u *SubjectAltNameUpdate) ClearCertificate() *SubjectAltNameUpdate {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SubjectAltNameUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubjectAltNameUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SubjectAltNameUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubjectAltNameUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubjectAltNameUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := subjectaltname.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SubjectAltName.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := subjectaltname.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "SubjectAltName.value": %w`, err)}
+		}
+	}
+	if _u.mutation.CertificateCleared() && len(_u.mutation.CertificateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubjectAltName.certificate"`)
+	}
+	return nil
+}
+
+func (_u *SubjectAltNameUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subjectaltname.Table, subjectaltname.Columns, sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(subjectaltname.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(subjectaltname.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subjectaltname.CertificateTable,
+			Columns: []string{subjectaltname.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subjectaltname.CertificateTable,
+			Columns: []string{subjectaltname.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subjectaltname.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SubjectAltNameUpdateOne is the builder for updating a single SubjectAltName entity.
+type SubjectAltNameUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubjectAltNameMutation
+}
+
+// SetType sets the "type" field.
+func (_u *SubjectAltNameUpdateOne) SetType(v subjectaltname.Type) *SubjectAltNameUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *SubjectAltNameUpdateOne) SetNillableType(v *subjectaltname.Type) *SubjectAltNameUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *SubjectAltNameUpdateOne) SetValue(v string) *SubjectAltNameUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *SubjectAltNameUpdateOne) SetNillableValue(v *string) *SubjectAltNameUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetCertificateID sets the "certificate" edge to the Certificate entity by ID.
+func (_u *SubjectAltNameUpdateOne) SetCertificateID(id uuid.UUID) *SubjectAltNameUpdateOne {
+	_u.mutation.SetCertificateID(id)
+	return _u
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (_u *SubjectAltNameUpdateOne) SetCertificate(v *Certificate) *SubjectAltNameUpdateOne {
+	return _u.SetCertificateID(v.ID)
+}
+
+// Mutation returns the SubjectAltNameMutation object of the builder.
+func (_u *SubjectAltNameUpdateOne) Mutation() *SubjectAltNameMutation {
+	return _u.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (_u *SubjectAltNameUpdateOne) ClearCertificate() *SubjectAltNameUpdateOne {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// Where appends a list predicates to the SubjectAltNameUpdate builder.
+func (_u *SubjectAltNameUpdateOne) Where(ps ...predicate.SubjectAltName) *SubjectAltNameUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SubjectAltNameUpdateOne) Select(field string, fields ...string) *SubjectAltNameUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SubjectAltName entity.
+func (_u *SubjectAltNameUpdateOne) Save(ctx context.Context) (*SubjectAltName, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubjectAltNameUpdateOne) SaveX(ctx context.Context) *SubjectAltName {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SubjectAltNameUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubjectAltNameUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubjectAltNameUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := subjectaltname.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SubjectAltName.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := subjectaltname.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "SubjectAltName.value": %w`, err)}
+		}
+	}
+	if _u.mutation.CertificateCleared() && len(_u.mutation.CertificateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubjectAltName.certificate"`)
+	}
+	return nil
+}
+
+func (_u *SubjectAltNameUpdateOne) sqlSave(ctx context.Context) (_node *SubjectAltName, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subjectaltname.Table, subjectaltname.Columns, sqlgraph.NewFieldSpec(subjectaltname.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubjectAltName.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subjectaltname.FieldID)
+		for _, f := range fields {
+			if !subjectaltname.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subjectaltname.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(subjectaltname.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(subjectaltname.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subjectaltname.CertificateTable,
+			Columns: []string{subjectaltname.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subjectaltname.CertificateTable,
+			Columns: []string{subjectaltname.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SubjectAltName{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subjectaltname.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
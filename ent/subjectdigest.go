@@ -18,6 +18,8 @@ type SubjectDigest struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// Algorithm holds the value of the "algorithm" field.
 	Algorithm string `json:"algorithm,omitempty"`
 	// Value holds the value of the "value" field.
@@ -56,7 +58,7 @@ func (*SubjectDigest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case subjectdigest.FieldAlgorithm, subjectdigest.FieldValue:
+		case subjectdigest.FieldTenant, subjectdigest.FieldAlgorithm, subjectdigest.FieldValue:
 			values[i] = new(sql.NullString)
 		case subjectdigest.FieldID:
 			values[i] = new(uuid.UUID)
@@ -83,6 +85,12 @@ func (_m *SubjectDigest) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case subjectdigest.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
 		case subjectdigest.FieldAlgorithm:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
@@ -143,6 +151,9 @@ func (_m *SubjectDigest) String() string {
 	var builder strings.Builder
 	builder.WriteString("SubjectDigest(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("algorithm=")
 	builder.WriteString(_m.Algorithm)
 	builder.WriteString(", ")
@@ -3,6 +3,7 @@
 package subjectdigest
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -13,6 +14,8 @@ const (
 	Label = "subject_digest"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldAlgorithm holds the string denoting the algorithm field in the database.
 	FieldAlgorithm = "algorithm"
 	// FieldValue holds the string denoting the value field in the database.
@@ -33,6 +36,7 @@ const (
 // Columns holds all SQL columns for subjectdigest fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldAlgorithm,
 	FieldValue,
 }
@@ -58,7 +62,18 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
 	// AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
 	AlgorithmValidator func(string) error
 	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
@@ -75,6 +90,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByAlgorithm orders the results by the algorithm field.
 func ByAlgorithm(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAlgorithm, opts...).ToFunc()
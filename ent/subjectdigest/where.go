@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.SubjectDigest {
 	return predicate.SubjectDigest(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldEQ(FieldTenant, v))
+}
+
 // Algorithm applies equality check predicate on the "algorithm" field. It's identical to AlgorithmEQ.
 func Algorithm(v string) predicate.SubjectDigest {
 	return predicate.SubjectDigest(sql.FieldEQ(FieldAlgorithm, v))
@@ -64,6 +69,71 @@ func Value(v string) predicate.SubjectDigest {
 	return predicate.SubjectDigest(sql.FieldEQ(FieldValue, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.SubjectDigest {
+	return predicate.SubjectDigest(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // AlgorithmEQ applies the EQ predicate on the "algorithm" field.
 func AlgorithmEQ(v string) predicate.SubjectDigest {
 	return predicate.SubjectDigest(sql.FieldEQ(FieldAlgorithm, v))
@@ -21,6 +21,20 @@ type SubjectDigestCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *SubjectDigestCreate) SetTenant(v string) *SubjectDigestCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *SubjectDigestCreate) SetNillableTenant(v *string) *SubjectDigestCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetAlgorithm sets the "algorithm" field.
 func (_c *SubjectDigestCreate) SetAlgorithm(v string) *SubjectDigestCreate {
 	_c.mutation.SetAlgorithm(v)
@@ -73,7 +87,9 @@ func (_c *SubjectDigestCreate) Mutation() *SubjectDigestMutation {
 
 // Save creates the SubjectDigest in the database.
 func (_c *SubjectDigestCreate) Save(ctx context.Context) (*SubjectDigest, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -100,15 +116,31 @@ func (_c *SubjectDigestCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *SubjectDigestCreate) defaults() {
+func (_c *SubjectDigestCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := subjectdigest.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if subjectdigest.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized subjectdigest.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := subjectdigest.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *SubjectDigestCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "SubjectDigest.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := subjectdigest.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "SubjectDigest.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Algorithm(); !ok {
 		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "SubjectDigest.algorithm"`)}
 	}
@@ -160,6 +192,10 @@ func (_c *SubjectDigestCreate) createSpec() (*SubjectDigest, *sqlgraph.CreateSpe
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(subjectdigest.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.Algorithm(); ok {
 		_spec.SetField(subjectdigest.FieldAlgorithm, field.TypeString, value)
 		_node.Algorithm = value
@@ -302,12 +302,12 @@ func (_q *SubjectDigestQuery) WithSubject(opts ...func(*SubjectQuery)) *SubjectD
 // Example:
 //
 //	var v []struct {
-//		Algorithm string `json:"algorithm,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.SubjectDigest.Query().
-//		GroupBy(subjectdigest.FieldAlgorithm).
+//		GroupBy(subjectdigest.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *SubjectDigestQuery) GroupBy(field string, fields ...string) *SubjectDigestGroupBy {
@@ -325,11 +325,11 @@ func (_q *SubjectDigestQuery) GroupBy(field string, fields ...string) *SubjectDi
 // Example:
 //
 //	var v []struct {
-//		Algorithm string `json:"algorithm,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.SubjectDigest.Query().
-//		Select(subjectdigest.FieldAlgorithm).
+//		Select(subjectdigest.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *SubjectDigestQuery) Select(fields ...string) *SubjectDigestSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
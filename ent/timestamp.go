@@ -19,6 +19,8 @@ type Timestamp struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// Type holds the value of the "type" field.
 	Type string `json:"type,omitempty"`
 	// Timestamp holds the value of the "timestamp" field.
@@ -57,7 +59,7 @@ func (*Timestamp) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case timestamp.FieldType:
+		case timestamp.FieldTenant, timestamp.FieldType:
 			values[i] = new(sql.NullString)
 		case timestamp.FieldTimestamp:
 			values[i] = new(sql.NullTime)
@@ -86,6 +88,12 @@ func (_m *Timestamp) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case timestamp.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
 		case timestamp.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -146,6 +154,9 @@ func (_m *Timestamp) String() string {
 	var builder strings.Builder
 	builder.WriteString("Timestamp(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(_m.Type)
 	builder.WriteString(", ")
@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.Timestamp {
 	return predicate.Timestamp(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldEQ(FieldTenant, v))
+}
+
 // Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
 func Type(v string) predicate.Timestamp {
 	return predicate.Timestamp(sql.FieldEQ(FieldType, v))
@@ -66,6 +71,71 @@ func Timestamp(v time.Time) predicate.Timestamp {
 	return predicate.Timestamp(sql.FieldEQ(FieldTimestamp, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Timestamp {
+	return predicate.Timestamp(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v string) predicate.Timestamp {
 	return predicate.Timestamp(sql.FieldEQ(FieldType, v))
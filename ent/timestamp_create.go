@@ -22,6 +22,20 @@ type TimestampCreate struct {
 	hooks    []Hook
 }
 
+// SetTenant sets the "tenant" field.
+func (_c *TimestampCreate) SetTenant(v string) *TimestampCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *TimestampCreate) SetNillableTenant(v *string) *TimestampCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
 // SetType sets the "type" field.
 func (_c *TimestampCreate) SetType(v string) *TimestampCreate {
 	_c.mutation.SetType(v)
@@ -74,7 +88,9 @@ func (_c *TimestampCreate) Mutation() *TimestampMutation {
 
 // Save creates the Timestamp in the database.
 func (_c *TimestampCreate) Save(ctx context.Context) (*Timestamp, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -101,15 +117,31 @@ func (_c *TimestampCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *TimestampCreate) defaults() {
+func (_c *TimestampCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := timestamp.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if timestamp.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized timestamp.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := timestamp.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *TimestampCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Timestamp.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := timestamp.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "Timestamp.tenant": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Timestamp.type"`)}
 	}
@@ -151,6 +183,10 @@ func (_c *TimestampCreate) createSpec() (*Timestamp, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(timestamp.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := _c.mutation.GetType(); ok {
 		_spec.SetField(timestamp.FieldType, field.TypeString, value)
 		_node.Type = value
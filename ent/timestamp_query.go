@@ -302,12 +302,12 @@ func (_q *TimestampQuery) WithSignature(opts ...func(*SignatureQuery)) *Timestam
 // Example:
 //
 //	var v []struct {
-//		Type string `json:"type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Timestamp.Query().
-//		GroupBy(timestamp.FieldType).
+//		GroupBy(timestamp.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *TimestampQuery) GroupBy(field string, fields ...string) *TimestampGroupBy {
@@ -325,11 +325,11 @@ func (_q *TimestampQuery) GroupBy(field string, fields ...string) *TimestampGrou
 // Example:
 //
 //	var v []struct {
-//		Type string `json:"type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Timestamp.Query().
-//		Select(timestamp.FieldType).
+//		Select(timestamp.FieldTenant).
 //		Scan(ctx, &v)
 func (_q *TimestampQuery) Select(fields ...string) *TimestampSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
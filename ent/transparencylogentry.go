@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+)
+
+// TransparencyLogEntry is the model entity for the TransparencyLogEntry schema.
+type TransparencyLogEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// LogIndex holds the value of the "log_index" field.
+	LogIndex int64 `json:"log_index,omitempty"`
+	// LogID holds the value of the "log_id" field.
+	LogID string `json:"log_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// KindVersion holds the value of the "kind_version" field.
+	KindVersion string `json:"kind_version,omitempty"`
+	// IntegratedTime holds the value of the "integrated_time" field.
+	IntegratedTime *time.Time `json:"integrated_time,omitempty"`
+	// Entry holds the value of the "entry" field.
+	Entry string `json:"entry,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TransparencyLogEntryQuery when eager-loading is set.
+	Edges                         TransparencyLogEntryEdges `json:"edges"`
+	dsse_transparency_log_entries *uuid.UUID
+	selectValues                  sql.SelectValues
+}
+
+// TransparencyLogEntryEdges holds the relations/edges for other nodes in the graph.
+type TransparencyLogEntryEdges struct {
+	// Dsse holds the value of the dsse edge.
+	Dsse *Dsse `json:"dsse,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// DsseOrErr returns the Dsse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransparencyLogEntryEdges) DsseOrErr() (*Dsse, error) {
+	if e.Dsse != nil {
+		return e.Dsse, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: dsse.Label}
+	}
+	return nil, &NotLoadedError{edge: "dsse"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TransparencyLogEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transparencylogentry.FieldLogIndex:
+			values[i] = new(sql.NullInt64)
+		case transparencylogentry.FieldTenant, transparencylogentry.FieldLogID, transparencylogentry.FieldKind, transparencylogentry.FieldKindVersion, transparencylogentry.FieldEntry:
+			values[i] = new(sql.NullString)
+		case transparencylogentry.FieldIntegratedTime:
+			values[i] = new(sql.NullTime)
+		case transparencylogentry.FieldID:
+			values[i] = new(uuid.UUID)
+		case transparencylogentry.ForeignKeys[0]: // dsse_transparency_log_entries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TransparencyLogEntry fields.
+func (_m *TransparencyLogEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transparencylogentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case transparencylogentry.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case transparencylogentry.FieldLogIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field log_index", values[i])
+			} else if value.Valid {
+				_m.LogIndex = value.Int64
+			}
+		case transparencylogentry.FieldLogID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field log_id", values[i])
+			} else if value.Valid {
+				_m.LogID = value.String
+			}
+		case transparencylogentry.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case transparencylogentry.FieldKindVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind_version", values[i])
+			} else if value.Valid {
+				_m.KindVersion = value.String
+			}
+		case transparencylogentry.FieldIntegratedTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field integrated_time", values[i])
+			} else if value.Valid {
+				_m.IntegratedTime = new(time.Time)
+				*_m.IntegratedTime = value.Time
+			}
+		case transparencylogentry.FieldEntry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entry", values[i])
+			} else if value.Valid {
+				_m.Entry = value.String
+			}
+		case transparencylogentry.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field dsse_transparency_log_entries", values[i])
+			} else if value.Valid {
+				_m.dsse_transparency_log_entries = new(uuid.UUID)
+				*_m.dsse_transparency_log_entries = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TransparencyLogEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *TransparencyLogEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDsse queries the "dsse" edge of the TransparencyLogEntry entity.
+func (_m *TransparencyLogEntry) QueryDsse() *DsseQuery {
+	return NewTransparencyLogEntryClient(_m.config).QueryDsse(_m)
+}
+
+// Update returns a builder for updating this TransparencyLogEntry.
+// Note that you need to call TransparencyLogEntry.Unwrap() before calling this method if this TransparencyLogEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TransparencyLogEntry) Update() *TransparencyLogEntryUpdateOne {
+	return NewTransparencyLogEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TransparencyLogEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TransparencyLogEntry) Unwrap() *TransparencyLogEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TransparencyLogEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TransparencyLogEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("TransparencyLogEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("log_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogIndex))
+	builder.WriteString(", ")
+	builder.WriteString("log_id=")
+	builder.WriteString(_m.LogID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("kind_version=")
+	builder.WriteString(_m.KindVersion)
+	builder.WriteString(", ")
+	if v := _m.IntegratedTime; v != nil {
+		builder.WriteString("integrated_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("entry=")
+	builder.WriteString(_m.Entry)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TransparencyLogEntries is a parsable slice of TransparencyLogEntry.
+type TransparencyLogEntries []*TransparencyLogEntry
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transparencylogentry
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the transparencylogentry type in the database.
+	Label = "transparency_log_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldLogIndex holds the string denoting the log_index field in the database.
+	FieldLogIndex = "log_index"
+	// FieldLogID holds the string denoting the log_id field in the database.
+	FieldLogID = "log_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldKindVersion holds the string denoting the kind_version field in the database.
+	FieldKindVersion = "kind_version"
+	// FieldIntegratedTime holds the string denoting the integrated_time field in the database.
+	FieldIntegratedTime = "integrated_time"
+	// FieldEntry holds the string denoting the entry field in the database.
+	FieldEntry = "entry"
+	// EdgeDsse holds the string denoting the dsse edge name in mutations.
+	EdgeDsse = "dsse"
+	// Table holds the table name of the transparencylogentry in the database.
+	Table = "transparency_log_entries"
+	// DsseTable is the table that holds the dsse relation/edge.
+	DsseTable = "transparency_log_entries"
+	// DsseInverseTable is the table name for the Dsse entity.
+	// It exists in this package in order to avoid circular dependency with the "dsse" package.
+	DsseInverseTable = "dsses"
+	// DsseColumn is the table column denoting the dsse relation/edge.
+	DsseColumn = "dsse_transparency_log_entries"
+)
+
+// Columns holds all SQL columns for transparencylogentry fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldLogIndex,
+	FieldLogID,
+	FieldKind,
+	FieldKindVersion,
+	FieldIntegratedTime,
+	FieldEntry,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "transparency_log_entries"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"dsse_transparency_log_entries",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// EntryValidator is a validator for the "entry" field. It is called by the builders before save.
+	EntryValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the TransparencyLogEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByLogIndex orders the results by the log_index field.
+func ByLogIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogIndex, opts...).ToFunc()
+}
+
+// ByLogID orders the results by the log_id field.
+func ByLogID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByKindVersion orders the results by the kind_version field.
+func ByKindVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKindVersion, opts...).ToFunc()
+}
+
+// ByIntegratedTime orders the results by the integrated_time field.
+func ByIntegratedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntegratedTime, opts...).ToFunc()
+}
+
+// ByEntry orders the results by the entry field.
+func ByEntry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntry, opts...).ToFunc()
+}
+
+// ByDsseField orders the results by dsse field.
+func ByDsseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDsseStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDsseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DsseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DsseTable, DsseColumn),
+	)
+}
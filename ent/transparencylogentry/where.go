@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transparencylogentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldTenant, v))
+}
+
+// LogIndex applies equality check predicate on the "log_index" field. It's identical to LogIndexEQ.
+func LogIndex(v int64) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldLogIndex, v))
+}
+
+// LogID applies equality check predicate on the "log_id" field. It's identical to LogIDEQ.
+func LogID(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldLogID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldKind, v))
+}
+
+// KindVersion applies equality check predicate on the "kind_version" field. It's identical to KindVersionEQ.
+func KindVersion(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldKindVersion, v))
+}
+
+// IntegratedTime applies equality check predicate on the "integrated_time" field. It's identical to IntegratedTimeEQ.
+func IntegratedTime(v time.Time) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldIntegratedTime, v))
+}
+
+// Entry applies equality check predicate on the "entry" field. It's identical to EntryEQ.
+func Entry(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldEntry, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// LogIndexEQ applies the EQ predicate on the "log_index" field.
+func LogIndexEQ(v int64) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldLogIndex, v))
+}
+
+// LogIndexNEQ applies the NEQ predicate on the "log_index" field.
+func LogIndexNEQ(v int64) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNEQ(FieldLogIndex, v))
+}
+
+// LogIndexIn applies the In predicate on the "log_index" field.
+func LogIndexIn(vs ...int64) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIn(FieldLogIndex, vs...))
+}
+
+// LogIndexNotIn applies the NotIn predicate on the "log_index" field.
+func LogIndexNotIn(vs ...int64) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotIn(FieldLogIndex, vs...))
+}
+
+// LogIndexGT applies the GT predicate on the "log_index" field.
+func LogIndexGT(v int64) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGT(FieldLogIndex, v))
+}
+
+// LogIndexGTE applies the GTE predicate on the "log_index" field.
+func LogIndexGTE(v int64) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGTE(FieldLogIndex, v))
+}
+
+// LogIndexLT applies the LT predicate on the "log_index" field.
+func LogIndexLT(v int64) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLT(FieldLogIndex, v))
+}
+
+// LogIndexLTE applies the LTE predicate on the "log_index" field.
+func LogIndexLTE(v int64) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLTE(FieldLogIndex, v))
+}
+
+// LogIDEQ applies the EQ predicate on the "log_id" field.
+func LogIDEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldLogID, v))
+}
+
+// LogIDNEQ applies the NEQ predicate on the "log_id" field.
+func LogIDNEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNEQ(FieldLogID, v))
+}
+
+// LogIDIn applies the In predicate on the "log_id" field.
+func LogIDIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIn(FieldLogID, vs...))
+}
+
+// LogIDNotIn applies the NotIn predicate on the "log_id" field.
+func LogIDNotIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotIn(FieldLogID, vs...))
+}
+
+// LogIDGT applies the GT predicate on the "log_id" field.
+func LogIDGT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGT(FieldLogID, v))
+}
+
+// LogIDGTE applies the GTE predicate on the "log_id" field.
+func LogIDGTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGTE(FieldLogID, v))
+}
+
+// LogIDLT applies the LT predicate on the "log_id" field.
+func LogIDLT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLT(FieldLogID, v))
+}
+
+// LogIDLTE applies the LTE predicate on the "log_id" field.
+func LogIDLTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLTE(FieldLogID, v))
+}
+
+// LogIDContains applies the Contains predicate on the "log_id" field.
+func LogIDContains(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContains(FieldLogID, v))
+}
+
+// LogIDHasPrefix applies the HasPrefix predicate on the "log_id" field.
+func LogIDHasPrefix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasPrefix(FieldLogID, v))
+}
+
+// LogIDHasSuffix applies the HasSuffix predicate on the "log_id" field.
+func LogIDHasSuffix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasSuffix(FieldLogID, v))
+}
+
+// LogIDEqualFold applies the EqualFold predicate on the "log_id" field.
+func LogIDEqualFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEqualFold(FieldLogID, v))
+}
+
+// LogIDContainsFold applies the ContainsFold predicate on the "log_id" field.
+func LogIDContainsFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContainsFold(FieldLogID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindIsNil applies the IsNil predicate on the "kind" field.
+func KindIsNil() predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIsNull(FieldKind))
+}
+
+// KindNotNil applies the NotNil predicate on the "kind" field.
+func KindNotNil() predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotNull(FieldKind))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContainsFold(FieldKind, v))
+}
+
+// KindVersionEQ applies the EQ predicate on the "kind_version" field.
+func KindVersionEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldKindVersion, v))
+}
+
+// KindVersionNEQ applies the NEQ predicate on the "kind_version" field.
+func KindVersionNEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNEQ(FieldKindVersion, v))
+}
+
+// KindVersionIn applies the In predicate on the "kind_version" field.
+func KindVersionIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIn(FieldKindVersion, vs...))
+}
+
+// KindVersionNotIn applies the NotIn predicate on the "kind_version" field.
+func KindVersionNotIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotIn(FieldKindVersion, vs...))
+}
+
+// KindVersionGT applies the GT predicate on the "kind_version" field.
+func KindVersionGT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGT(FieldKindVersion, v))
+}
+
+// KindVersionGTE applies the GTE predicate on the "kind_version" field.
+func KindVersionGTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGTE(FieldKindVersion, v))
+}
+
+// KindVersionLT applies the LT predicate on the "kind_version" field.
+func KindVersionLT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLT(FieldKindVersion, v))
+}
+
+// KindVersionLTE applies the LTE predicate on the "kind_version" field.
+func KindVersionLTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLTE(FieldKindVersion, v))
+}
+
+// KindVersionContains applies the Contains predicate on the "kind_version" field.
+func KindVersionContains(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContains(FieldKindVersion, v))
+}
+
+// KindVersionHasPrefix applies the HasPrefix predicate on the "kind_version" field.
+func KindVersionHasPrefix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasPrefix(FieldKindVersion, v))
+}
+
+// KindVersionHasSuffix applies the HasSuffix predicate on the "kind_version" field.
+func KindVersionHasSuffix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasSuffix(FieldKindVersion, v))
+}
+
+// KindVersionIsNil applies the IsNil predicate on the "kind_version" field.
+func KindVersionIsNil() predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIsNull(FieldKindVersion))
+}
+
+// KindVersionNotNil applies the NotNil predicate on the "kind_version" field.
+func KindVersionNotNil() predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotNull(FieldKindVersion))
+}
+
+// KindVersionEqualFold applies the EqualFold predicate on the "kind_version" field.
+func KindVersionEqualFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEqualFold(FieldKindVersion, v))
+}
+
+// KindVersionContainsFold applies the ContainsFold predicate on the "kind_version" field.
+func KindVersionContainsFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContainsFold(FieldKindVersion, v))
+}
+
+// IntegratedTimeEQ applies the EQ predicate on the "integrated_time" field.
+func IntegratedTimeEQ(v time.Time) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldIntegratedTime, v))
+}
+
+// IntegratedTimeNEQ applies the NEQ predicate on the "integrated_time" field.
+func IntegratedTimeNEQ(v time.Time) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNEQ(FieldIntegratedTime, v))
+}
+
+// IntegratedTimeIn applies the In predicate on the "integrated_time" field.
+func IntegratedTimeIn(vs ...time.Time) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIn(FieldIntegratedTime, vs...))
+}
+
+// IntegratedTimeNotIn applies the NotIn predicate on the "integrated_time" field.
+func IntegratedTimeNotIn(vs ...time.Time) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotIn(FieldIntegratedTime, vs...))
+}
+
+// IntegratedTimeGT applies the GT predicate on the "integrated_time" field.
+func IntegratedTimeGT(v time.Time) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGT(FieldIntegratedTime, v))
+}
+
+// IntegratedTimeGTE applies the GTE predicate on the "integrated_time" field.
+func IntegratedTimeGTE(v time.Time) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGTE(FieldIntegratedTime, v))
+}
+
+// IntegratedTimeLT applies the LT predicate on the "integrated_time" field.
+func IntegratedTimeLT(v time.Time) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLT(FieldIntegratedTime, v))
+}
+
+// IntegratedTimeLTE applies the LTE predicate on the "integrated_time" field.
+func IntegratedTimeLTE(v time.Time) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLTE(FieldIntegratedTime, v))
+}
+
+// IntegratedTimeIsNil applies the IsNil predicate on the "integrated_time" field.
+func IntegratedTimeIsNil() predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIsNull(FieldIntegratedTime))
+}
+
+// IntegratedTimeNotNil applies the NotNil predicate on the "integrated_time" field.
+func IntegratedTimeNotNil() predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotNull(FieldIntegratedTime))
+}
+
+// EntryEQ applies the EQ predicate on the "entry" field.
+func EntryEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEQ(FieldEntry, v))
+}
+
+// EntryNEQ applies the NEQ predicate on the "entry" field.
+func EntryNEQ(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNEQ(FieldEntry, v))
+}
+
+// EntryIn applies the In predicate on the "entry" field.
+func EntryIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldIn(FieldEntry, vs...))
+}
+
+// EntryNotIn applies the NotIn predicate on the "entry" field.
+func EntryNotIn(vs ...string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldNotIn(FieldEntry, vs...))
+}
+
+// EntryGT applies the GT predicate on the "entry" field.
+func EntryGT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGT(FieldEntry, v))
+}
+
+// EntryGTE applies the GTE predicate on the "entry" field.
+func EntryGTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldGTE(FieldEntry, v))
+}
+
+// EntryLT applies the LT predicate on the "entry" field.
+func EntryLT(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLT(FieldEntry, v))
+}
+
+// EntryLTE applies the LTE predicate on the "entry" field.
+func EntryLTE(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldLTE(FieldEntry, v))
+}
+
+// EntryContains applies the Contains predicate on the "entry" field.
+func EntryContains(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContains(FieldEntry, v))
+}
+
+// EntryHasPrefix applies the HasPrefix predicate on the "entry" field.
+func EntryHasPrefix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasPrefix(FieldEntry, v))
+}
+
+// EntryHasSuffix applies the HasSuffix predicate on the "entry" field.
+func EntryHasSuffix(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldHasSuffix(FieldEntry, v))
+}
+
+// EntryEqualFold applies the EqualFold predicate on the "entry" field.
+func EntryEqualFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldEqualFold(FieldEntry, v))
+}
+
+// EntryContainsFold applies the ContainsFold predicate on the "entry" field.
+func EntryContainsFold(v string) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.FieldContainsFold(FieldEntry, v))
+}
+
+// HasDsse applies the HasEdge predicate on the "dsse" edge.
+func HasDsse() predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DsseTable, DsseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDsseWith applies the HasEdge predicate on the "dsse" edge with a given conditions (other predicates).
+func HasDsseWith(preds ...predicate.Dsse) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(func(s *sql.Selector) {
+		step := newDsseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TransparencyLogEntry) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TransparencyLogEntry) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TransparencyLogEntry) predicate.TransparencyLogEntry {
+	return predicate.TransparencyLogEntry(sql.NotPredicates(p))
+}
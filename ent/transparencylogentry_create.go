@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+)
+
+// TransparencyLogEntryCreate is the builder for creating a TransparencyLogEntry entity.
+type TransparencyLogEntryCreate struct {
+	config
+	mutation *TransparencyLogEntryMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *TransparencyLogEntryCreate) SetTenant(v string) *TransparencyLogEntryCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *TransparencyLogEntryCreate) SetNillableTenant(v *string) *TransparencyLogEntryCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetLogIndex sets the "log_index" field.
+func (_c *TransparencyLogEntryCreate) SetLogIndex(v int64) *TransparencyLogEntryCreate {
+	_c.mutation.SetLogIndex(v)
+	return _c
+}
+
+// SetLogID sets the "log_id" field.
+func (_c *TransparencyLogEntryCreate) SetLogID(v string) *TransparencyLogEntryCreate {
+	_c.mutation.SetLogID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *TransparencyLogEntryCreate) SetKind(v string) *TransparencyLogEntryCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *TransparencyLogEntryCreate) SetNillableKind(v *string) *TransparencyLogEntryCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetKindVersion sets the "kind_version" field.
+func (_c *TransparencyLogEntryCreate) SetKindVersion(v string) *TransparencyLogEntryCreate {
+	_c.mutation.SetKindVersion(v)
+	return _c
+}
+
+// SetNillableKindVersion sets the "kind_version" field if the given value is not nil.
+func (_c *TransparencyLogEntryCreate) SetNillableKindVersion(v *string) *TransparencyLogEntryCreate {
+	if v != nil {
+		_c.SetKindVersion(*v)
+	}
+	return _c
+}
+
+// SetIntegratedTime sets the "integrated_time" field.
+func (_c *TransparencyLogEntryCreate) SetIntegratedTime(v time.Time) *TransparencyLogEntryCreate {
+	_c.mutation.SetIntegratedTime(v)
+	return _c
+}
+
+// SetNillableIntegratedTime sets the "integrated_time" field if the given value is not nil.
+func (_c *TransparencyLogEntryCreate) SetNillableIntegratedTime(v *time.Time) *TransparencyLogEntryCreate {
+	if v != nil {
+		_c.SetIntegratedTime(*v)
+	}
+	return _c
+}
+
+// SetEntry sets the "entry" field.
+func (_c *TransparencyLogEntryCreate) SetEntry(v string) *TransparencyLogEntryCreate {
+	_c.mutation.SetEntry(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TransparencyLogEntryCreate) SetID(v uuid.UUID) *TransparencyLogEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TransparencyLogEntryCreate) SetNillableID(v *uuid.UUID) *TransparencyLogEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by ID.
+func (_c *TransparencyLogEntryCreate) SetDsseID(id uuid.UUID) *TransparencyLogEntryCreate {
+	_c.mutation.SetDsseID(id)
+	return _c
+}
+
+// SetDsse sets the "dsse" edge to the Dsse entity.
+func (_c *TransparencyLogEntryCreate) SetDsse(v *Dsse) *TransparencyLogEntryCreate {
+	return _c.SetDsseID(v.ID)
+}
+
+// Mutation returns the TransparencyLogEntryMutation object of the builder.
+func (_c *TransparencyLogEntryCreate) Mutation() *TransparencyLogEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the TransparencyLogEntry in the database.
+func (_c *TransparencyLogEntryCreate) Save(ctx context.Context) (*TransparencyLogEntry, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TransparencyLogEntryCreate) SaveX(ctx context.Context) *TransparencyLogEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransparencyLogEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransparencyLogEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TransparencyLogEntryCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := transparencylogentry.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if transparencylogentry.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized transparencylogentry.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := transparencylogentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TransparencyLogEntryCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "TransparencyLogEntry.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := transparencylogentry.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "TransparencyLogEntry.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LogIndex(); !ok {
+		return &ValidationError{Name: "log_index", err: errors.New(`ent: missing required field "TransparencyLogEntry.log_index"`)}
+	}
+	if _, ok := _c.mutation.LogID(); !ok {
+		return &ValidationError{Name: "log_id", err: errors.New(`ent: missing required field "TransparencyLogEntry.log_id"`)}
+	}
+	if _, ok := _c.mutation.Entry(); !ok {
+		return &ValidationError{Name: "entry", err: errors.New(`ent: missing required field "TransparencyLogEntry.entry"`)}
+	}
+	if v, ok := _c.mutation.Entry(); ok {
+		if err := transparencylogentry.EntryValidator(v); err != nil {
+			return &ValidationError{Name: "entry", err: fmt.Errorf(`ent: validator failed for field "TransparencyLogEntry.entry": %w`, err)}
+		}
+	}
+	if len(_c.mutation.DsseIDs()) == 0 {
+		return &ValidationError{Name: "dsse", err: errors.New(`ent: missing required edge "TransparencyLogEntry.dsse"`)}
+	}
+	return nil
+}
+
+func (_c *TransparencyLogEntryCreate) sqlSave(ctx context.Context) (*TransparencyLogEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TransparencyLogEntryCreate) createSpec() (*TransparencyLogEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TransparencyLogEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transparencylogentry.Table, sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(transparencylogentry.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.LogIndex(); ok {
+		_spec.SetField(transparencylogentry.FieldLogIndex, field.TypeInt64, value)
+		_node.LogIndex = value
+	}
+	if value, ok := _c.mutation.LogID(); ok {
+		_spec.SetField(transparencylogentry.FieldLogID, field.TypeString, value)
+		_node.LogID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(transparencylogentry.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.KindVersion(); ok {
+		_spec.SetField(transparencylogentry.FieldKindVersion, field.TypeString, value)
+		_node.KindVersion = value
+	}
+	if value, ok := _c.mutation.IntegratedTime(); ok {
+		_spec.SetField(transparencylogentry.FieldIntegratedTime, field.TypeTime, value)
+		_node.IntegratedTime = &value
+	}
+	if value, ok := _c.mutation.Entry(); ok {
+		_spec.SetField(transparencylogentry.FieldEntry, field.TypeString, value)
+		_node.Entry = value
+	}
+	if nodes := _c.mutation.DsseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transparencylogentry.DsseTable,
+			Columns: []string{transparencylogentry.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.dsse_transparency_log_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TransparencyLogEntryCreateBulk is the builder for creating many TransparencyLogEntry entities in bulk.
+type TransparencyLogEntryCreateBulk struct {
+	config
+	err      error
+	builders []*TransparencyLogEntryCreate
+}
+
+// Save creates the TransparencyLogEntry entities in the database.
+func (_c *TransparencyLogEntryCreateBulk) Save(ctx context.Context) ([]*TransparencyLogEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TransparencyLogEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransparencyLogEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransparencyLogEntryCreateBulk) SaveX(ctx context.Context) []*TransparencyLogEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransparencyLogEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransparencyLogEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+)
+
+// TransparencyLogEntryDelete is the builder for deleting a TransparencyLogEntry entity.
+type TransparencyLogEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *TransparencyLogEntryMutation
+}
+
+// Where appends a list predicates to the TransparencyLogEntryDelete builder.
+func (_d *TransparencyLogEntryDelete) Where(ps ...predicate.TransparencyLogEntry) *TransparencyLogEntryDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TransparencyLogEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TransparencyLogEntryDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TransparencyLogEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(transparencylogentry.Table, sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TransparencyLogEntryDeleteOne is the builder for deleting a single TransparencyLogEntry entity.
+type TransparencyLogEntryDeleteOne struct {
+	_d *TransparencyLogEntryDelete
+}
+
+// Where appends a list predicates to the TransparencyLogEntryDelete builder.
+func (_d *TransparencyLogEntryDeleteOne) Where(ps ...predicate.TransparencyLogEntry) *TransparencyLogEntryDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TransparencyLogEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{transparencylogentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TransparencyLogEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
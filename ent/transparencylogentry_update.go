@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+)
+
+// TransparencyLogEntryUpdate is the builder for updating TransparencyLogEntry entities.
+type TransparencyLogEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TransparencyLogEntryMutation
+}
+
+// Where appends a list predicates to the TransparencyLogEntryUpdate builder.
+func (_u *TransparencyLogEntryUpdate) Where(ps ...predicate.TransparencyLogEntry) *TransparencyLogEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLogIndex sets the "log_index" field.
+func (_u *TransparencyLogEntryUpdate) SetLogIndex(v int64) *TransparencyLogEntryUpdate {
+	_u.mutation.ResetLogIndex()
+	_u.mutation.SetLogIndex(v)
+	return _u
+}
+
+// SetNillableLogIndex sets the "log_index" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdate) SetNillableLogIndex(v *int64) *TransparencyLogEntryUpdate {
+	if v != nil {
+		_u.SetLogIndex(*v)
+	}
+	return _u
+}
+
+// AddLogIndex adds value to the "log_index" field.
+func (_u *TransparencyLogEntryUpdate) AddLogIndex(v int64) *TransparencyLogEntryUpdate {
+	_u.mutation.AddLogIndex(v)
+	return _u
+}
+
+// SetLogID sets the "log_id" field.
+func (_u *TransparencyLogEntryUpdate) SetLogID(v string) *TransparencyLogEntryUpdate {
+	_u.mutation.SetLogID(v)
+	return _u
+}
+
+// SetNillableLogID sets the "log_id" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdate) SetNillableLogID(v *string) *TransparencyLogEntryUpdate {
+	if v != nil {
+		_u.SetLogID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TransparencyLogEntryUpdate) SetKind(v string) *TransparencyLogEntryUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdate) SetNillableKind(v *string) *TransparencyLogEntryUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// ClearKind clears the value of the "kind" field.
+func (_u *TransparencyLogEntryUpdate) ClearKind() *TransparencyLogEntryUpdate {
+	_u.mutation.ClearKind()
+	return _u
+}
+
+// SetKindVersion sets the "kind_version" field.
+func (_u *TransparencyLogEntryUpdate) SetKindVersion(v string) *TransparencyLogEntryUpdate {
+	_u.mutation.SetKindVersion(v)
+	return _u
+}
+
+// SetNillableKindVersion sets the "kind_version" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdate) SetNillableKindVersion(v *string) *TransparencyLogEntryUpdate {
+	if v != nil {
+		_u.SetKindVersion(*v)
+	}
+	return _u
+}
+
+// ClearKindVersion clears the value of the "kind_version" field.
+func (_u *TransparencyLogEntryUpdate) ClearKindVersion() *TransparencyLogEntryUpdate {
+	_u.mutation.ClearKindVersion()
+	return _u
+}
+
+// SetIntegratedTime sets the "integrated_time" field.
+func (_u *TransparencyLogEntryUpdate) SetIntegratedTime(v time.Time) *TransparencyLogEntryUpdate {
+	_u.mutation.SetIntegratedTime(v)
+	return _u
+}
+
+// SetNillableIntegratedTime sets the "integrated_time" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdate) SetNillableIntegratedTime(v *time.Time) *TransparencyLogEntryUpdate {
+	if v != nil {
+		_u.SetIntegratedTime(*v)
+	}
+	return _u
+}
+
+// ClearIntegratedTime clears the value of the "integrated_time" field.
+func (_u *TransparencyLogEntryUpdate) ClearIntegratedTime() *TransparencyLogEntryUpdate {
+	_u.mutation.ClearIntegratedTime()
+	return _u
+}
+
+// SetEntry sets the "entry" field.
+func (_u *TransparencyLogEntryUpdate) SetEntry(v string) *TransparencyLogEntryUpdate {
+	_u.mutation.SetEntry(v)
+	return _u
+}
+
+// SetNillableEntry sets the "entry" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdate) SetNillableEntry(v *string) *TransparencyLogEntryUpdate {
+	if v != nil {
+		_u.SetEntry(*v)
+	}
+	return _u
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by ID.
+func (_u *TransparencyLogEntryUpdate) SetDsseID(id uuid.UUID) *TransparencyLogEntryUpdate {
+	_u.mutation.SetDsseID(id)
+	return _u
+}
+
+// SetDsse sets the "dsse" edge to the Dsse entity.
+func (_u *TransparencyLogEntryUpdate) SetDsse(v *Dsse) *TransparencyLogEntryUpdate {
+	return _u.SetDsseID(v.ID)
+}
+
+// Mutation returns the TransparencyLogEntryMutation object of the builder.
+func (_u *TransparencyLogEntryUpdate) Mutation() *TransparencyLogEntryMutation {
+	return _u.mutation
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (_u *TransparencyLogEntryUpdate) ClearDsse() *TransparencyLogEntryUpdate {
+	_u.mutation.ClearDsse()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransparencyLogEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransparencyLogEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransparencyLogEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransparencyLogEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransparencyLogEntryUpdate) check() error {
+	if v, ok := _u.mutation.Entry(); ok {
+		if err := transparencylogentry.EntryValidator(v); err != nil {
+			return &ValidationError{Name: "entry", err: fmt.Errorf(`ent: validator failed for field "TransparencyLogEntry.entry": %w`, err)}
+		}
+	}
+	if _u.mutation.DsseCleared() && len(_u.mutation.DsseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransparencyLogEntry.dsse"`)
+	}
+	return nil
+}
+
+func (_u *TransparencyLogEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transparencylogentry.Table, transparencylogentry.Columns, sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LogIndex(); ok {
+		_spec.SetField(transparencylogentry.FieldLogIndex, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLogIndex(); ok {
+		_spec.AddField(transparencylogentry.FieldLogIndex, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LogID(); ok {
+		_spec.SetField(transparencylogentry.FieldLogID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(transparencylogentry.FieldKind, field.TypeString, value)
+	}
+	if _u.mutation.KindCleared() {
+		_spec.ClearField(transparencylogentry.FieldKind, field.TypeString)
+	}
+	if value, ok := _u.mutation.KindVersion(); ok {
+		_spec.SetField(transparencylogentry.FieldKindVersion, field.TypeString, value)
+	}
+	if _u.mutation.KindVersionCleared() {
+		_spec.ClearField(transparencylogentry.FieldKindVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.IntegratedTime(); ok {
+		_spec.SetField(transparencylogentry.FieldIntegratedTime, field.TypeTime, value)
+	}
+	if _u.mutation.IntegratedTimeCleared() {
+		_spec.ClearField(transparencylogentry.FieldIntegratedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Entry(); ok {
+		_spec.SetField(transparencylogentry.FieldEntry, field.TypeString, value)
+	}
+	if _u.mutation.DsseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transparencylogentry.DsseTable,
+			Columns: []string{transparencylogentry.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DsseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transparencylogentry.DsseTable,
+			Columns: []string{transparencylogentry.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transparencylogentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransparencyLogEntryUpdateOne is the builder for updating a single TransparencyLogEntry entity.
+type TransparencyLogEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TransparencyLogEntryMutation
+}
+
+// SetLogIndex sets the "log_index" field.
+func (_u *TransparencyLogEntryUpdateOne) SetLogIndex(v int64) *TransparencyLogEntryUpdateOne {
+	_u.mutation.ResetLogIndex()
+	_u.mutation.SetLogIndex(v)
+	return _u
+}
+
+// SetNillableLogIndex sets the "log_index" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdateOne) SetNillableLogIndex(v *int64) *TransparencyLogEntryUpdateOne {
+	if v != nil {
+		_u.SetLogIndex(*v)
+	}
+	return _u
+}
+
+// AddLogIndex adds value to the "log_index" field.
+func (_u *TransparencyLogEntryUpdateOne) AddLogIndex(v int64) *TransparencyLogEntryUpdateOne {
+	_u.mutation.AddLogIndex(v)
+	return _u
+}
+
+// SetLogID sets the "log_id" field.
+func (_u *TransparencyLogEntryUpdateOne) SetLogID(v string) *TransparencyLogEntryUpdateOne {
+	_u.mutation.SetLogID(v)
+	return _u
+}
+
+// SetNillableLogID sets the "log_id" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdateOne) SetNillableLogID(v *string) *TransparencyLogEntryUpdateOne {
+	if v != nil {
+		_u.SetLogID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TransparencyLogEntryUpdateOne) SetKind(v string) *TransparencyLogEntryUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdateOne) SetNillableKind(v *string) *TransparencyLogEntryUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// ClearKind clears the value of the "kind" field.
+func (_u *TransparencyLogEntryUpdateOne) ClearKind() *TransparencyLogEntryUpdateOne {
+	_u.mutation.ClearKind()
+	return _u
+}
+
+// SetKindVersion sets the "kind_version" field.
+func (_u *TransparencyLogEntryUpdateOne) SetKindVersion(v string) *TransparencyLogEntryUpdateOne {
+	_u.mutation.SetKindVersion(v)
+	return _u
+}
+
+// SetNillableKindVersion sets the "kind_version" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdateOne) SetNillableKindVersion(v *string) *TransparencyLogEntryUpdateOne {
+	if v != nil {
+		_u.SetKindVersion(*v)
+	}
+	return _u
+}
+
+// ClearKindVersion clears the value of the "kind_version" field.
+func (_u *TransparencyLogEntryUpdateOne) ClearKindVersion() *TransparencyLogEntryUpdateOne {
+	_u.mutation.ClearKindVersion()
+	return _u
+}
+
+// SetIntegratedTime sets the "integrated_time" field.
+func (_u *TransparencyLogEntryUpdateOne) SetIntegratedTime(v time.Time) *TransparencyLogEntryUpdateOne {
+	_u.mutation.SetIntegratedTime(v)
+	return _u
+}
+
+// SetNillableIntegratedTime sets the "integrated_time" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdateOne) SetNillableIntegratedTime(v *time.Time) *TransparencyLogEntryUpdateOne {
+	if v != nil {
+		_u.SetIntegratedTime(*v)
+	}
+	return _u
+}
+
+// ClearIntegratedTime clears the value of the "integrated_time" field.
+func (_u *TransparencyLogEntryUpdateOne) ClearIntegratedTime() *TransparencyLogEntryUpdateOne {
+	_u.mutation.ClearIntegratedTime()
+	return _u
+}
+
+// SetEntry sets the "entry" field.
+func (_u *TransparencyLogEntryUpdateOne) SetEntry(v string) *TransparencyLogEntryUpdateOne {
+	_u.mutation.SetEntry(v)
+	return _u
+}
+
+// SetNillableEntry sets the "entry" field if the given value is not nil.
+func (_u *TransparencyLogEntryUpdateOne) SetNillableEntry(v *string) *TransparencyLogEntryUpdateOne {
+	if v != nil {
+		_u.SetEntry(*v)
+	}
+	return _u
+}
+
+// SetDsseID sets the "dsse" edge to the Dsse entity by ID.
+func (_u *TransparencyLogEntryUpdateOne) SetDsseID(id uuid.UUID) *TransparencyLogEntryUpdateOne {
+	_u.mutation.SetDsseID(id)
+	return _u
+}
+
+// SetDsse sets the "dsse" edge to the Dsse entity.
+func (_u *TransparencyLogEntryUpdateOne) SetDsse(v *Dsse) *TransparencyLogEntryUpdateOne {
+	return _u.SetDsseID(v.ID)
+}
+
+// Mutation returns the TransparencyLogEntryMutation object of the builder.
+func (_u *TransparencyLogEntryUpdateOne) Mutation() *TransparencyLogEntryMutation {
+	return _u.mutation
+}
+
+// ClearDsse clears the "dsse" edge to the Dsse entity.
+func (_u *TransparencyLogEntryUpdateOne) ClearDsse() *TransparencyLogEntryUpdateOne {
+	_u.mutation.ClearDsse()
+	return _u
+}
+
+// Where appends a list predicates to the TransparencyLogEntryUpdate builder.
+func (_u *TransparencyLogEntryUpdateOne) Where(ps ...predicate.TransparencyLogEntry) *TransparencyLogEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransparencyLogEntryUpdateOne) Select(field string, fields ...string) *TransparencyLogEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TransparencyLogEntry entity.
+func (_u *TransparencyLogEntryUpdateOne) Save(ctx context.Context) (*TransparencyLogEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransparencyLogEntryUpdateOne) SaveX(ctx context.Context) *TransparencyLogEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransparencyLogEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransparencyLogEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransparencyLogEntryUpdateOne) check() error {
+	if v, ok := _u.mutation.Entry(); ok {
+		if err := transparencylogentry.EntryValidator(v); err != nil {
+			return &ValidationError{Name: "entry", err: fmt.Errorf(`ent: validator failed for field "TransparencyLogEntry.entry": %w`, err)}
+		}
+	}
+	if _u.mutation.DsseCleared() && len(_u.mutation.DsseIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransparencyLogEntry.dsse"`)
+	}
+	return nil
+}
+
+func (_u *TransparencyLogEntryUpdateOne) sqlSave(ctx context.Context) (_node *TransparencyLogEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transparencylogentry.Table, transparencylogentry.Columns, sqlgraph.NewFieldSpec(transparencylogentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TransparencyLogEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transparencylogentry.FieldID)
+		for _, f := range fields {
+			if !transparencylogentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transparencylogentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LogIndex(); ok {
+		_spec.SetField(transparencylogentry.FieldLogIndex, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLogIndex(); ok {
+		_spec.AddField(transparencylogentry.FieldLogIndex, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LogID(); ok {
+		_spec.SetField(transparencylogentry.FieldLogID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(transparencylogentry.FieldKind, field.TypeString, value)
+	}
+	if _u.mutation.KindCleared() {
+		_spec.ClearField(transparencylogentry.FieldKind, field.TypeString)
+	}
+	if value, ok := _u.mutation.KindVersion(); ok {
+		_spec.SetField(transparencylogentry.FieldKindVersion, field.TypeString, value)
+	}
+	if _u.mutation.KindVersionCleared() {
+		_spec.ClearField(transparencylogentry.FieldKindVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.IntegratedTime(); ok {
+		_spec.SetField(transparencylogentry.FieldIntegratedTime, field.TypeTime, value)
+	}
+	if _u.mutation.IntegratedTimeCleared() {
+		_spec.ClearField(transparencylogentry.FieldIntegratedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Entry(); ok {
+		_spec.SetField(transparencylogentry.FieldEntry, field.TypeString, value)
+	}
+	if _u.mutation.DsseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transparencylogentry.DsseTable,
+			Columns: []string{transparencylogentry.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DsseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transparencylogentry.DsseTable,
+			Columns: []string{transparencylogentry.DsseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dsse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TransparencyLogEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transparencylogentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -18,20 +18,58 @@ type Tx struct {
 	AttestationCollection *AttestationCollectionClient
 	// AttestationPolicy is the client for interacting with the AttestationPolicy builders.
 	AttestationPolicy *AttestationPolicyClient
+	// Certificate is the client for interacting with the Certificate builders.
+	Certificate *CertificateClient
+	// CommandRunAttestation is the client for interacting with the CommandRunAttestation builders.
+	CommandRunAttestation *CommandRunAttestationClient
 	// Dsse is the client for interacting with the Dsse builders.
 	Dsse *DsseClient
+	// EnvironmentAttestation is the client for interacting with the EnvironmentAttestation builders.
+	EnvironmentAttestation *EnvironmentAttestationClient
+	// GitAttestation is the client for interacting with the GitAttestation builders.
+	GitAttestation *GitAttestationClient
+	// GithubAttestation is the client for interacting with the GithubAttestation builders.
+	GithubAttestation *GithubAttestationClient
+	// GitlabAttestation is the client for interacting with the GitlabAttestation builders.
+	GitlabAttestation *GitlabAttestationClient
+	// LegalHold is the client for interacting with the LegalHold builders.
+	LegalHold *LegalHoldClient
+	// Material is the client for interacting with the Material builders.
+	Material *MaterialClient
+	// OciAttestation is the client for interacting with the OciAttestation builders.
+	OciAttestation *OciAttestationClient
 	// PayloadDigest is the client for interacting with the PayloadDigest builders.
 	PayloadDigest *PayloadDigestClient
+	// Product is the client for interacting with the Product builders.
+	Product *ProductClient
+	// Publication is the client for interacting with the Publication builders.
+	Publication *PublicationClient
+	// PublishDelivery is the client for interacting with the PublishDelivery builders.
+	PublishDelivery *PublishDeliveryClient
+	// SbomPackage is the client for interacting with the SbomPackage builders.
+	SbomPackage *SbomPackageClient
+	// SbomPackageDigest is the client for interacting with the SbomPackageDigest builders.
+	SbomPackageDigest *SbomPackageDigestClient
 	// Signature is the client for interacting with the Signature builders.
 	Signature *SignatureClient
+	// SlsaDependency is the client for interacting with the SlsaDependency builders.
+	SlsaDependency *SlsaDependencyClient
+	// SlsaProvenance is the client for interacting with the SlsaProvenance builders.
+	SlsaProvenance *SlsaProvenanceClient
 	// Statement is the client for interacting with the Statement builders.
 	Statement *StatementClient
 	// Subject is the client for interacting with the Subject builders.
 	Subject *SubjectClient
+	// SubjectAltName is the client for interacting with the SubjectAltName builders.
+	SubjectAltName *SubjectAltNameClient
 	// SubjectDigest is the client for interacting with the SubjectDigest builders.
 	SubjectDigest *SubjectDigestClient
 	// Timestamp is the client for interacting with the Timestamp builders.
 	Timestamp *TimestampClient
+	// TransparencyLogEntry is the client for interacting with the TransparencyLogEntry builders.
+	TransparencyLogEntry *TransparencyLogEntryClient
+	// VerificationSummary is the client for interacting with the VerificationSummary builders.
+	VerificationSummary *VerificationSummaryClient
 
 	// lazily loaded.
 	client     *Client
@@ -166,13 +204,32 @@ func (tx *Tx) init() {
 	tx.Attestation = NewAttestationClient(tx.config)
 	tx.AttestationCollection = NewAttestationCollectionClient(tx.config)
 	tx.AttestationPolicy = NewAttestationPolicyClient(tx.config)
+	tx.Certificate = NewCertificateClient(tx.config)
+	tx.CommandRunAttestation = NewCommandRunAttestationClient(tx.config)
 	tx.Dsse = NewDsseClient(tx.config)
+	tx.EnvironmentAttestation = NewEnvironmentAttestationClient(tx.config)
+	tx.GitAttestation = NewGitAttestationClient(tx.config)
+	tx.GithubAttestation = NewGithubAttestationClient(tx.config)
+	tx.GitlabAttestation = NewGitlabAttestationClient(tx.config)
+	tx.LegalHold = NewLegalHoldClient(tx.config)
+	tx.Material = NewMaterialClient(tx.config)
+	tx.OciAttestation = NewOciAttestationClient(tx.config)
 	tx.PayloadDigest = NewPayloadDigestClient(tx.config)
+	tx.Product = NewProductClient(tx.config)
+	tx.Publication = NewPublicationClient(tx.config)
+	tx.PublishDelivery = NewPublishDeliveryClient(tx.config)
+	tx.SbomPackage = NewSbomPackageClient(tx.config)
+	tx.SbomPackageDigest = NewSbomPackageDigestClient(tx.config)
 	tx.Signature = NewSignatureClient(tx.config)
+	tx.SlsaDependency = NewSlsaDependencyClient(tx.config)
+	tx.SlsaProvenance = NewSlsaProvenanceClient(tx.config)
 	tx.Statement = NewStatementClient(tx.config)
 	tx.Subject = NewSubjectClient(tx.config)
+	tx.SubjectAltName = NewSubjectAltNameClient(tx.config)
 	tx.SubjectDigest = NewSubjectDigestClient(tx.config)
 	tx.Timestamp = NewTimestampClient(tx.config)
+	tx.TransparencyLogEntry = NewTransparencyLogEntryClient(tx.config)
+	tx.VerificationSummary = NewVerificationSummaryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/verificationsummary"
+)
+
+// VerificationSummary is the model entity for the VerificationSummary schema.
+type VerificationSummary struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// VerifierID holds the value of the "verifier_id" field.
+	VerifierID string `json:"verifier_id,omitempty"`
+	// TimeVerified holds the value of the "time_verified" field.
+	TimeVerified *time.Time `json:"time_verified,omitempty"`
+	// ResourceURI holds the value of the "resource_uri" field.
+	ResourceURI string `json:"resource_uri,omitempty"`
+	// PolicyURI holds the value of the "policy_uri" field.
+	PolicyURI string `json:"policy_uri,omitempty"`
+	// VerificationResult holds the value of the "verification_result" field.
+	VerificationResult string `json:"verification_result,omitempty"`
+	// VerifiedLevels holds the value of the "verified_levels" field.
+	VerifiedLevels []string `json:"verified_levels,omitempty"`
+	// SlsaVersion holds the value of the "slsa_version" field.
+	SlsaVersion string `json:"slsa_version,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VerificationSummaryQuery when eager-loading is set.
+	Edges                          VerificationSummaryEdges `json:"edges"`
+	statement_verification_summary *uuid.UUID
+	selectValues                   sql.SelectValues
+}
+
+// VerificationSummaryEdges holds the relations/edges for other nodes in the graph.
+type VerificationSummaryEdges struct {
+	// Statement holds the value of the statement edge.
+	Statement *Statement `json:"statement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// StatementOrErr returns the Statement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VerificationSummaryEdges) StatementOrErr() (*Statement, error) {
+	if e.Statement != nil {
+		return e.Statement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: statement.Label}
+	}
+	return nil, &NotLoadedError{edge: "statement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VerificationSummary) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case verificationsummary.FieldVerifiedLevels:
+			values[i] = new([]byte)
+		case verificationsummary.FieldTenant, verificationsummary.FieldVerifierID, verificationsummary.FieldResourceURI, verificationsummary.FieldPolicyURI, verificationsummary.FieldVerificationResult, verificationsummary.FieldSlsaVersion:
+			values[i] = new(sql.NullString)
+		case verificationsummary.FieldTimeVerified:
+			values[i] = new(sql.NullTime)
+		case verificationsummary.FieldID:
+			values[i] = new(uuid.UUID)
+		case verificationsummary.ForeignKeys[0]: // statement_verification_summary
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VerificationSummary fields.
+func (_m *VerificationSummary) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case verificationsummary.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case verificationsummary.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case verificationsummary.FieldVerifierID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verifier_id", values[i])
+			} else if value.Valid {
+				_m.VerifierID = value.String
+			}
+		case verificationsummary.FieldTimeVerified:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field time_verified", values[i])
+			} else if value.Valid {
+				_m.TimeVerified = new(time.Time)
+				*_m.TimeVerified = value.Time
+			}
+		case verificationsummary.FieldResourceURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_uri", values[i])
+			} else if value.Valid {
+				_m.ResourceURI = value.String
+			}
+		case verificationsummary.FieldPolicyURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_uri", values[i])
+			} else if value.Valid {
+				_m.PolicyURI = value.String
+			}
+		case verificationsummary.FieldVerificationResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_result", values[i])
+			} else if value.Valid {
+				_m.VerificationResult = value.String
+			}
+		case verificationsummary.FieldVerifiedLevels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_levels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VerifiedLevels); err != nil {
+					return fmt.Errorf("unmarshal field verified_levels: %w", err)
+				}
+			}
+		case verificationsummary.FieldSlsaVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slsa_version", values[i])
+			} else if value.Valid {
+				_m.SlsaVersion = value.String
+			}
+		case verificationsummary.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_verification_summary", values[i])
+			} else if value.Valid {
+				_m.statement_verification_summary = new(uuid.UUID)
+				*_m.statement_verification_summary = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VerificationSummary.
+// This includes values selected through modifiers, order, etc.
+func (_m *VerificationSummary) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStatement queries the "statement" edge of the VerificationSummary entity.
+func (_m *VerificationSummary) QueryStatement() *StatementQuery {
+	return NewVerificationSummaryClient(_m.config).QueryStatement(_m)
+}
+
+// Update returns a builder for updating this VerificationSummary.
+// Note that you need to call VerificationSummary.Unwrap() before calling this method if this VerificationSummary
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *VerificationSummary) Update() *VerificationSummaryUpdateOne {
+	return NewVerificationSummaryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the VerificationSummary entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *VerificationSummary) Unwrap() *VerificationSummary {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VerificationSummary is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *VerificationSummary) String() string {
+	var builder strings.Builder
+	builder.WriteString("VerificationSummary(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("verifier_id=")
+	builder.WriteString(_m.VerifierID)
+	builder.WriteString(", ")
+	if v := _m.TimeVerified; v != nil {
+		builder.WriteString("time_verified=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("resource_uri=")
+	builder.WriteString(_m.ResourceURI)
+	builder.WriteString(", ")
+	builder.WriteString("policy_uri=")
+	builder.WriteString(_m.PolicyURI)
+	builder.WriteString(", ")
+	builder.WriteString("verification_result=")
+	builder.WriteString(_m.VerificationResult)
+	builder.WriteString(", ")
+	builder.WriteString("verified_levels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VerifiedLevels))
+	builder.WriteString(", ")
+	builder.WriteString("slsa_version=")
+	builder.WriteString(_m.SlsaVersion)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VerificationSummaries is a parsable slice of VerificationSummary.
+type VerificationSummaries []*VerificationSummary
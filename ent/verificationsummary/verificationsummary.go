@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package verificationsummary
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the verificationsummary type in the database.
+	Label = "verification_summary"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldVerifierID holds the string denoting the verifier_id field in the database.
+	FieldVerifierID = "verifier_id"
+	// FieldTimeVerified holds the string denoting the time_verified field in the database.
+	FieldTimeVerified = "time_verified"
+	// FieldResourceURI holds the string denoting the resource_uri field in the database.
+	FieldResourceURI = "resource_uri"
+	// FieldPolicyURI holds the string denoting the policy_uri field in the database.
+	FieldPolicyURI = "policy_uri"
+	// FieldVerificationResult holds the string denoting the verification_result field in the database.
+	FieldVerificationResult = "verification_result"
+	// FieldVerifiedLevels holds the string denoting the verified_levels field in the database.
+	FieldVerifiedLevels = "verified_levels"
+	// FieldSlsaVersion holds the string denoting the slsa_version field in the database.
+	FieldSlsaVersion = "slsa_version"
+	// EdgeStatement holds the string denoting the statement edge name in mutations.
+	EdgeStatement = "statement"
+	// Table holds the table name of the verificationsummary in the database.
+	Table = "verification_summaries"
+	// StatementTable is the table that holds the statement relation/edge.
+	StatementTable = "verification_summaries"
+	// StatementInverseTable is the table name for the Statement entity.
+	// It exists in this package in order to avoid circular dependency with the "statement" package.
+	StatementInverseTable = "statements"
+	// StatementColumn is the table column denoting the statement relation/edge.
+	StatementColumn = "statement_verification_summary"
+)
+
+// Columns holds all SQL columns for verificationsummary fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldVerifierID,
+	FieldTimeVerified,
+	FieldResourceURI,
+	FieldPolicyURI,
+	FieldVerificationResult,
+	FieldVerifiedLevels,
+	FieldSlsaVersion,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "verification_summaries"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"statement_verification_summary",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/in-toto/archivista/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// TenantValidator is a validator for the "tenant" field. It is called by the builders before save.
+	TenantValidator func(string) error
+	// VerifierIDValidator is a validator for the "verifier_id" field. It is called by the builders before save.
+	VerifierIDValidator func(string) error
+	// ResourceURIValidator is a validator for the "resource_uri" field. It is called by the builders before save.
+	ResourceURIValidator func(string) error
+	// VerificationResultValidator is a validator for the "verification_result" field. It is called by the builders before save.
+	VerificationResultValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the VerificationSummary queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByVerifierID orders the results by the verifier_id field.
+func ByVerifierID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifierID, opts...).ToFunc()
+}
+
+// ByTimeVerified orders the results by the time_verified field.
+func ByTimeVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeVerified, opts...).ToFunc()
+}
+
+// ByResourceURI orders the results by the resource_uri field.
+func ByResourceURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceURI, opts...).ToFunc()
+}
+
+// ByPolicyURI orders the results by the policy_uri field.
+func ByPolicyURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyURI, opts...).ToFunc()
+}
+
+// ByVerificationResult orders the results by the verification_result field.
+func ByVerificationResult(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationResult, opts...).ToFunc()
+}
+
+// BySlsaVersion orders the results by the slsa_version field.
+func BySlsaVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlsaVersion, opts...).ToFunc()
+}
+
+// ByStatementField orders the results by statement field.
+func ByStatementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatementStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStatementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, StatementTable, StatementColumn),
+	)
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package verificationsummary
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldTenant, v))
+}
+
+// VerifierID applies equality check predicate on the "verifier_id" field. It's identical to VerifierIDEQ.
+func VerifierID(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldVerifierID, v))
+}
+
+// TimeVerified applies equality check predicate on the "time_verified" field. It's identical to TimeVerifiedEQ.
+func TimeVerified(v time.Time) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldTimeVerified, v))
+}
+
+// ResourceURI applies equality check predicate on the "resource_uri" field. It's identical to ResourceURIEQ.
+func ResourceURI(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldResourceURI, v))
+}
+
+// PolicyURI applies equality check predicate on the "policy_uri" field. It's identical to PolicyURIEQ.
+func PolicyURI(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldPolicyURI, v))
+}
+
+// VerificationResult applies equality check predicate on the "verification_result" field. It's identical to VerificationResultEQ.
+func VerificationResult(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldVerificationResult, v))
+}
+
+// SlsaVersion applies equality check predicate on the "slsa_version" field. It's identical to SlsaVersionEQ.
+func SlsaVersion(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldSlsaVersion, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// VerifierIDEQ applies the EQ predicate on the "verifier_id" field.
+func VerifierIDEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldVerifierID, v))
+}
+
+// VerifierIDNEQ applies the NEQ predicate on the "verifier_id" field.
+func VerifierIDNEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNEQ(FieldVerifierID, v))
+}
+
+// VerifierIDIn applies the In predicate on the "verifier_id" field.
+func VerifierIDIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIn(FieldVerifierID, vs...))
+}
+
+// VerifierIDNotIn applies the NotIn predicate on the "verifier_id" field.
+func VerifierIDNotIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotIn(FieldVerifierID, vs...))
+}
+
+// VerifierIDGT applies the GT predicate on the "verifier_id" field.
+func VerifierIDGT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGT(FieldVerifierID, v))
+}
+
+// VerifierIDGTE applies the GTE predicate on the "verifier_id" field.
+func VerifierIDGTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGTE(FieldVerifierID, v))
+}
+
+// VerifierIDLT applies the LT predicate on the "verifier_id" field.
+func VerifierIDLT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLT(FieldVerifierID, v))
+}
+
+// VerifierIDLTE applies the LTE predicate on the "verifier_id" field.
+func VerifierIDLTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLTE(FieldVerifierID, v))
+}
+
+// VerifierIDContains applies the Contains predicate on the "verifier_id" field.
+func VerifierIDContains(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContains(FieldVerifierID, v))
+}
+
+// VerifierIDHasPrefix applies the HasPrefix predicate on the "verifier_id" field.
+func VerifierIDHasPrefix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasPrefix(FieldVerifierID, v))
+}
+
+// VerifierIDHasSuffix applies the HasSuffix predicate on the "verifier_id" field.
+func VerifierIDHasSuffix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasSuffix(FieldVerifierID, v))
+}
+
+// VerifierIDEqualFold applies the EqualFold predicate on the "verifier_id" field.
+func VerifierIDEqualFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEqualFold(FieldVerifierID, v))
+}
+
+// VerifierIDContainsFold applies the ContainsFold predicate on the "verifier_id" field.
+func VerifierIDContainsFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContainsFold(FieldVerifierID, v))
+}
+
+// TimeVerifiedEQ applies the EQ predicate on the "time_verified" field.
+func TimeVerifiedEQ(v time.Time) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldTimeVerified, v))
+}
+
+// TimeVerifiedNEQ applies the NEQ predicate on the "time_verified" field.
+func TimeVerifiedNEQ(v time.Time) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNEQ(FieldTimeVerified, v))
+}
+
+// TimeVerifiedIn applies the In predicate on the "time_verified" field.
+func TimeVerifiedIn(vs ...time.Time) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIn(FieldTimeVerified, vs...))
+}
+
+// TimeVerifiedNotIn applies the NotIn predicate on the "time_verified" field.
+func TimeVerifiedNotIn(vs ...time.Time) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotIn(FieldTimeVerified, vs...))
+}
+
+// TimeVerifiedGT applies the GT predicate on the "time_verified" field.
+func TimeVerifiedGT(v time.Time) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGT(FieldTimeVerified, v))
+}
+
+// TimeVerifiedGTE applies the GTE predicate on the "time_verified" field.
+func TimeVerifiedGTE(v time.Time) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGTE(FieldTimeVerified, v))
+}
+
+// TimeVerifiedLT applies the LT predicate on the "time_verified" field.
+func TimeVerifiedLT(v time.Time) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLT(FieldTimeVerified, v))
+}
+
+// TimeVerifiedLTE applies the LTE predicate on the "time_verified" field.
+func TimeVerifiedLTE(v time.Time) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLTE(FieldTimeVerified, v))
+}
+
+// TimeVerifiedIsNil applies the IsNil predicate on the "time_verified" field.
+func TimeVerifiedIsNil() predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIsNull(FieldTimeVerified))
+}
+
+// TimeVerifiedNotNil applies the NotNil predicate on the "time_verified" field.
+func TimeVerifiedNotNil() predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotNull(FieldTimeVerified))
+}
+
+// ResourceURIEQ applies the EQ predicate on the "resource_uri" field.
+func ResourceURIEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldResourceURI, v))
+}
+
+// ResourceURINEQ applies the NEQ predicate on the "resource_uri" field.
+func ResourceURINEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNEQ(FieldResourceURI, v))
+}
+
+// ResourceURIIn applies the In predicate on the "resource_uri" field.
+func ResourceURIIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIn(FieldResourceURI, vs...))
+}
+
+// ResourceURINotIn applies the NotIn predicate on the "resource_uri" field.
+func ResourceURINotIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotIn(FieldResourceURI, vs...))
+}
+
+// ResourceURIGT applies the GT predicate on the "resource_uri" field.
+func ResourceURIGT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGT(FieldResourceURI, v))
+}
+
+// ResourceURIGTE applies the GTE predicate on the "resource_uri" field.
+func ResourceURIGTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGTE(FieldResourceURI, v))
+}
+
+// ResourceURILT applies the LT predicate on the "resource_uri" field.
+func ResourceURILT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLT(FieldResourceURI, v))
+}
+
+// ResourceURILTE applies the LTE predicate on the "resource_uri" field.
+func ResourceURILTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLTE(FieldResourceURI, v))
+}
+
+// ResourceURIContains applies the Contains predicate on the "resource_uri" field.
+func ResourceURIContains(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContains(FieldResourceURI, v))
+}
+
+// ResourceURIHasPrefix applies the HasPrefix predicate on the "resource_uri" field.
+func ResourceURIHasPrefix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasPrefix(FieldResourceURI, v))
+}
+
+// ResourceURIHasSuffix applies the HasSuffix predicate on the "resource_uri" field.
+func ResourceURIHasSuffix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasSuffix(FieldResourceURI, v))
+}
+
+// ResourceURIEqualFold applies the EqualFold predicate on the "resource_uri" field.
+func ResourceURIEqualFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEqualFold(FieldResourceURI, v))
+}
+
+// ResourceURIContainsFold applies the ContainsFold predicate on the "resource_uri" field.
+func ResourceURIContainsFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContainsFold(FieldResourceURI, v))
+}
+
+// PolicyURIEQ applies the EQ predicate on the "policy_uri" field.
+func PolicyURIEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldPolicyURI, v))
+}
+
+// PolicyURINEQ applies the NEQ predicate on the "policy_uri" field.
+func PolicyURINEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNEQ(FieldPolicyURI, v))
+}
+
+// PolicyURIIn applies the In predicate on the "policy_uri" field.
+func PolicyURIIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIn(FieldPolicyURI, vs...))
+}
+
+// PolicyURINotIn applies the NotIn predicate on the "policy_uri" field.
+func PolicyURINotIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotIn(FieldPolicyURI, vs...))
+}
+
+// PolicyURIGT applies the GT predicate on the "policy_uri" field.
+func PolicyURIGT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGT(FieldPolicyURI, v))
+}
+
+// PolicyURIGTE applies the GTE predicate on the "policy_uri" field.
+func PolicyURIGTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGTE(FieldPolicyURI, v))
+}
+
+// PolicyURILT applies the LT predicate on the "policy_uri" field.
+func PolicyURILT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLT(FieldPolicyURI, v))
+}
+
+// PolicyURILTE applies the LTE predicate on the "policy_uri" field.
+func PolicyURILTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLTE(FieldPolicyURI, v))
+}
+
+// PolicyURIContains applies the Contains predicate on the "policy_uri" field.
+func PolicyURIContains(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContains(FieldPolicyURI, v))
+}
+
+// PolicyURIHasPrefix applies the HasPrefix predicate on the "policy_uri" field.
+func PolicyURIHasPrefix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasPrefix(FieldPolicyURI, v))
+}
+
+// PolicyURIHasSuffix applies the HasSuffix predicate on the "policy_uri" field.
+func PolicyURIHasSuffix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasSuffix(FieldPolicyURI, v))
+}
+
+// PolicyURIIsNil applies the IsNil predicate on the "policy_uri" field.
+func PolicyURIIsNil() predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIsNull(FieldPolicyURI))
+}
+
+// PolicyURINotNil applies the NotNil predicate on the "policy_uri" field.
+func PolicyURINotNil() predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotNull(FieldPolicyURI))
+}
+
+// PolicyURIEqualFold applies the EqualFold predicate on the "policy_uri" field.
+func PolicyURIEqualFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEqualFold(FieldPolicyURI, v))
+}
+
+// PolicyURIContainsFold applies the ContainsFold predicate on the "policy_uri" field.
+func PolicyURIContainsFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContainsFold(FieldPolicyURI, v))
+}
+
+// VerificationResultEQ applies the EQ predicate on the "verification_result" field.
+func VerificationResultEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldVerificationResult, v))
+}
+
+// VerificationResultNEQ applies the NEQ predicate on the "verification_result" field.
+func VerificationResultNEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNEQ(FieldVerificationResult, v))
+}
+
+// VerificationResultIn applies the In predicate on the "verification_result" field.
+func VerificationResultIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIn(FieldVerificationResult, vs...))
+}
+
+// VerificationResultNotIn applies the NotIn predicate on the "verification_result" field.
+func VerificationResultNotIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotIn(FieldVerificationResult, vs...))
+}
+
+// VerificationResultGT applies the GT predicate on the "verification_result" field.
+func VerificationResultGT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGT(FieldVerificationResult, v))
+}
+
+// VerificationResultGTE applies the GTE predicate on the "verification_result" field.
+func VerificationResultGTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGTE(FieldVerificationResult, v))
+}
+
+// VerificationResultLT applies the LT predicate on the "verification_result" field.
+func VerificationResultLT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLT(FieldVerificationResult, v))
+}
+
+// VerificationResultLTE applies the LTE predicate on the "verification_result" field.
+func VerificationResultLTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLTE(FieldVerificationResult, v))
+}
+
+// VerificationResultContains applies the Contains predicate on the "verification_result" field.
+func VerificationResultContains(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContains(FieldVerificationResult, v))
+}
+
+// VerificationResultHasPrefix applies the HasPrefix predicate on the "verification_result" field.
+func VerificationResultHasPrefix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasPrefix(FieldVerificationResult, v))
+}
+
+// VerificationResultHasSuffix applies the HasSuffix predicate on the "verification_result" field.
+func VerificationResultHasSuffix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasSuffix(FieldVerificationResult, v))
+}
+
+// VerificationResultEqualFold applies the EqualFold predicate on the "verification_result" field.
+func VerificationResultEqualFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEqualFold(FieldVerificationResult, v))
+}
+
+// VerificationResultContainsFold applies the ContainsFold predicate on the "verification_result" field.
+func VerificationResultContainsFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContainsFold(FieldVerificationResult, v))
+}
+
+// VerifiedLevelsIsNil applies the IsNil predicate on the "verified_levels" field.
+func VerifiedLevelsIsNil() predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIsNull(FieldVerifiedLevels))
+}
+
+// VerifiedLevelsNotNil applies the NotNil predicate on the "verified_levels" field.
+func VerifiedLevelsNotNil() predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotNull(FieldVerifiedLevels))
+}
+
+// SlsaVersionEQ applies the EQ predicate on the "slsa_version" field.
+func SlsaVersionEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEQ(FieldSlsaVersion, v))
+}
+
+// SlsaVersionNEQ applies the NEQ predicate on the "slsa_version" field.
+func SlsaVersionNEQ(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNEQ(FieldSlsaVersion, v))
+}
+
+// SlsaVersionIn applies the In predicate on the "slsa_version" field.
+func SlsaVersionIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIn(FieldSlsaVersion, vs...))
+}
+
+// SlsaVersionNotIn applies the NotIn predicate on the "slsa_version" field.
+func SlsaVersionNotIn(vs ...string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotIn(FieldSlsaVersion, vs...))
+}
+
+// SlsaVersionGT applies the GT predicate on the "slsa_version" field.
+func SlsaVersionGT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGT(FieldSlsaVersion, v))
+}
+
+// SlsaVersionGTE applies the GTE predicate on the "slsa_version" field.
+func SlsaVersionGTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldGTE(FieldSlsaVersion, v))
+}
+
+// SlsaVersionLT applies the LT predicate on the "slsa_version" field.
+func SlsaVersionLT(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLT(FieldSlsaVersion, v))
+}
+
+// SlsaVersionLTE applies the LTE predicate on the "slsa_version" field.
+func SlsaVersionLTE(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldLTE(FieldSlsaVersion, v))
+}
+
+// SlsaVersionContains applies the Contains predicate on the "slsa_version" field.
+func SlsaVersionContains(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContains(FieldSlsaVersion, v))
+}
+
+// SlsaVersionHasPrefix applies the HasPrefix predicate on the "slsa_version" field.
+func SlsaVersionHasPrefix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasPrefix(FieldSlsaVersion, v))
+}
+
+// SlsaVersionHasSuffix applies the HasSuffix predicate on the "slsa_version" field.
+func SlsaVersionHasSuffix(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldHasSuffix(FieldSlsaVersion, v))
+}
+
+// SlsaVersionIsNil applies the IsNil predicate on the "slsa_version" field.
+func SlsaVersionIsNil() predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldIsNull(FieldSlsaVersion))
+}
+
+// SlsaVersionNotNil applies the NotNil predicate on the "slsa_version" field.
+func SlsaVersionNotNil() predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldNotNull(FieldSlsaVersion))
+}
+
+// SlsaVersionEqualFold applies the EqualFold predicate on the "slsa_version" field.
+func SlsaVersionEqualFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldEqualFold(FieldSlsaVersion, v))
+}
+
+// SlsaVersionContainsFold applies the ContainsFold predicate on the "slsa_version" field.
+func SlsaVersionContainsFold(v string) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.FieldContainsFold(FieldSlsaVersion, v))
+}
+
+// HasStatement applies the HasEdge predicate on the "statement" edge.
+func HasStatement() predicate.VerificationSummary {
+	return predicate.VerificationSummary(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, StatementTable, StatementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatementWith applies the HasEdge predicate on the "statement" edge with a given conditions (other predicates).
+func HasStatementWith(preds ...predicate.Statement) predicate.VerificationSummary {
+	return predicate.VerificationSummary(func(s *sql.Selector) {
+		step := newStatementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VerificationSummary) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VerificationSummary) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VerificationSummary) predicate.VerificationSummary {
+	return predicate.VerificationSummary(sql.NotPredicates(p))
+}
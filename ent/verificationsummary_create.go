@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/verificationsummary"
+)
+
+// VerificationSummaryCreate is the builder for creating a VerificationSummary entity.
+type VerificationSummaryCreate struct {
+	config
+	mutation *VerificationSummaryMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *VerificationSummaryCreate) SetTenant(v string) *VerificationSummaryCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (_c *VerificationSummaryCreate) SetNillableTenant(v *string) *VerificationSummaryCreate {
+	if v != nil {
+		_c.SetTenant(*v)
+	}
+	return _c
+}
+
+// SetVerifierID sets the "verifier_id" field.
+func (_c *VerificationSummaryCreate) SetVerifierID(v string) *VerificationSummaryCreate {
+	_c.mutation.SetVerifierID(v)
+	return _c
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (_c *VerificationSummaryCreate) SetTimeVerified(v time.Time) *VerificationSummaryCreate {
+	_c.mutation.SetTimeVerified(v)
+	return _c
+}
+
+// SetNillableTimeVerified sets the "time_verified" field if the given value is not nil.
+func (_c *VerificationSummaryCreate) SetNillableTimeVerified(v *time.Time) *VerificationSummaryCreate {
+	if v != nil {
+		_c.SetTimeVerified(*v)
+	}
+	return _c
+}
+
+// SetResourceURI sets the "resource_uri" field.
+func (_c *VerificationSummaryCreate) SetResourceURI(v string) *VerificationSummaryCreate {
+	_c.mutation.SetResourceURI(v)
+	return _c
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (_c *VerificationSummaryCreate) SetPolicyURI(v string) *VerificationSummaryCreate {
+	_c.mutation.SetPolicyURI(v)
+	return _c
+}
+
+// SetNillablePolicyURI sets the "policy_uri" field if the given value is not nil.
+func (_c *VerificationSummaryCreate) SetNillablePolicyURI(v *string) *VerificationSummaryCreate {
+	if v != nil {
+		_c.SetPolicyURI(*v)
+	}
+	return _c
+}
+
+// SetVerificationResult sets the "verification_result" field.
+func (_c *VerificationSummaryCreate) SetVerificationResult(v string) *VerificationSummaryCreate {
+	_c.mutation.SetVerificationResult(v)
+	return _c
+}
+
+// SetVerifiedLevels sets the "verified_levels" field.
+func (_c *VerificationSummaryCreate) SetVerifiedLevels(v []string) *VerificationSummaryCreate {
+	_c.mutation.SetVerifiedLevels(v)
+	return _c
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (_c *VerificationSummaryCreate) SetSlsaVersion(v string) *VerificationSummaryCreate {
+	_c.mutation.SetSlsaVersion(v)
+	return _c
+}
+
+// SetNillableSlsaVersion sets the "slsa_version" field if the given value is not nil.
+func (_c *VerificationSummaryCreate) SetNillableSlsaVersion(v *string) *VerificationSummaryCreate {
+	if v != nil {
+		_c.SetSlsaVersion(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VerificationSummaryCreate) SetID(v uuid.UUID) *VerificationSummaryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VerificationSummaryCreate) SetNillableID(v *uuid.UUID) *VerificationSummaryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by ID.
+func (_c *VerificationSummaryCreate) SetStatementID(id uuid.UUID) *VerificationSummaryCreate {
+	_c.mutation.SetStatementID(id)
+	return _c
+}
+
+// SetStatement sets the "statement" edge to the Statement entity.
+func (_c *VerificationSummaryCreate) SetStatement(v *Statement) *VerificationSummaryCreate {
+	return _c.SetStatementID(v.ID)
+}
+
+// Mutation returns the VerificationSummaryMutation object of the builder.
+func (_c *VerificationSummaryCreate) Mutation() *VerificationSummaryMutation {
+	return _c.mutation
+}
+
+// Save creates the VerificationSummary in the database.
+func (_c *VerificationSummaryCreate) Save(ctx context.Context) (*VerificationSummary, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VerificationSummaryCreate) SaveX(ctx context.Context) *VerificationSummary {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VerificationSummaryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VerificationSummaryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VerificationSummaryCreate) defaults() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		v := verificationsummary.DefaultTenant
+		_c.mutation.SetTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if verificationsummary.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized verificationsummary.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := verificationsummary.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VerificationSummaryCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "VerificationSummary.tenant"`)}
+	}
+	if v, ok := _c.mutation.Tenant(); ok {
+		if err := verificationsummary.TenantValidator(v); err != nil {
+			return &ValidationError{Name: "tenant", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.tenant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VerifierID(); !ok {
+		return &ValidationError{Name: "verifier_id", err: errors.New(`ent: missing required field "VerificationSummary.verifier_id"`)}
+	}
+	if v, ok := _c.mutation.VerifierID(); ok {
+		if err := verificationsummary.VerifierIDValidator(v); err != nil {
+			return &ValidationError{Name: "verifier_id", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.verifier_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResourceURI(); !ok {
+		return &ValidationError{Name: "resource_uri", err: errors.New(`ent: missing required field "VerificationSummary.resource_uri"`)}
+	}
+	if v, ok := _c.mutation.ResourceURI(); ok {
+		if err := verificationsummary.ResourceURIValidator(v); err != nil {
+			return &ValidationError{Name: "resource_uri", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.resource_uri": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VerificationResult(); !ok {
+		return &ValidationError{Name: "verification_result", err: errors.New(`ent: missing required field "VerificationSummary.verification_result"`)}
+	}
+	if v, ok := _c.mutation.VerificationResult(); ok {
+		if err := verificationsummary.VerificationResultValidator(v); err != nil {
+			return &ValidationError{Name: "verification_result", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.verification_result": %w`, err)}
+		}
+	}
+	if len(_c.mutation.StatementIDs()) == 0 {
+		return &ValidationError{Name: "statement", err: errors.New(`ent: missing required edge "VerificationSummary.statement"`)}
+	}
+	return nil
+}
+
+func (_c *VerificationSummaryCreate) sqlSave(ctx context.Context) (*VerificationSummary, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VerificationSummaryCreate) createSpec() (*VerificationSummary, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VerificationSummary{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(verificationsummary.Table, sqlgraph.NewFieldSpec(verificationsummary.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Tenant(); ok {
+		_spec.SetField(verificationsummary.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := _c.mutation.VerifierID(); ok {
+		_spec.SetField(verificationsummary.FieldVerifierID, field.TypeString, value)
+		_node.VerifierID = value
+	}
+	if value, ok := _c.mutation.TimeVerified(); ok {
+		_spec.SetField(verificationsummary.FieldTimeVerified, field.TypeTime, value)
+		_node.TimeVerified = &value
+	}
+	if value, ok := _c.mutation.ResourceURI(); ok {
+		_spec.SetField(verificationsummary.FieldResourceURI, field.TypeString, value)
+		_node.ResourceURI = value
+	}
+	if value, ok := _c.mutation.PolicyURI(); ok {
+		_spec.SetField(verificationsummary.FieldPolicyURI, field.TypeString, value)
+		_node.PolicyURI = value
+	}
+	if value, ok := _c.mutation.VerificationResult(); ok {
+		_spec.SetField(verificationsummary.FieldVerificationResult, field.TypeString, value)
+		_node.VerificationResult = value
+	}
+	if value, ok := _c.mutation.VerifiedLevels(); ok {
+		_spec.SetField(verificationsummary.FieldVerifiedLevels, field.TypeJSON, value)
+		_node.VerifiedLevels = value
+	}
+	if value, ok := _c.mutation.SlsaVersion(); ok {
+		_spec.SetField(verificationsummary.FieldSlsaVersion, field.TypeString, value)
+		_node.SlsaVersion = value
+	}
+	if nodes := _c.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   verificationsummary.StatementTable,
+			Columns: []string{verificationsummary.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.statement_verification_summary = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// VerificationSummaryCreateBulk is the builder for creating many VerificationSummary entities in bulk.
+type VerificationSummaryCreateBulk struct {
+	config
+	err      error
+	builders []*VerificationSummaryCreate
+}
+
+// Save creates the VerificationSummary entities in the database.
+func (_c *VerificationSummaryCreateBulk) Save(ctx context.Context) ([]*VerificationSummary, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VerificationSummary, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VerificationSummaryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VerificationSummaryCreateBulk) SaveX(ctx context.Context) []*VerificationSummary {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VerificationSummaryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VerificationSummaryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
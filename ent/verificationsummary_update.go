@@ -0,0 +1,639 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/verificationsummary"
+)
+
+// VerificationSummaryUpdate is the builder for updating VerificationSummary entities.
+type VerificationSummaryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VerificationSummaryMutation
+}
+
+// Where appends a list predicates to the VerificationSummaryUpdate builder.
+func (_u *VerificationSummaryUpdate) Where(ps ...predicate.VerificationSummary) *VerificationSummaryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVerifierID sets the "verifier_id" field.
+func (_u *VerificationSummaryUpdate) SetVerifierID(v string) *VerificationSummaryUpdate {
+	_u.mutation.SetVerifierID(v)
+	return _u
+}
+
+// SetNillableVerifierID sets the "verifier_id" field if the given value is not nil.
+func (_u *VerificationSummaryUpdate) SetNillableVerifierID(v *string) *VerificationSummaryUpdate {
+	if v != nil {
+		_u.SetVerifierID(*v)
+	}
+	return _u
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (_u *VerificationSummaryUpdate) SetTimeVerified(v time.Time) *VerificationSummaryUpdate {
+	_u.mutation.SetTimeVerified(v)
+	return _u
+}
+
+// SetNillableTimeVerified sets the "time_verified" field if the given value is not nil.
+func (_u *VerificationSummaryUpdate) SetNillableTimeVerified(v *time.Time) *VerificationSummaryUpdate {
+	if v != nil {
+		_u.SetTimeVerified(*v)
+	}
+	return _u
+}
+
+// ClearTimeVerified clears the value of the "time_verified" field.
+func (_u *VerificationSummaryUpdate) ClearTimeVerified() *VerificationSummaryUpdate {
+	_u.mutation.ClearTimeVerified()
+	return _u
+}
+
+// SetResourceURI sets the "resource_uri" field.
+func (_u *VerificationSummaryUpdate) SetResourceURI(v string) *VerificationSummaryUpdate {
+	_u.mutation.SetResourceURI(v)
+	return _u
+}
+
+// SetNillableResourceURI sets the "resource_uri" field if the given value is not nil.
+func (_u *VerificationSummaryUpdate) SetNillableResourceURI(v *string) *VerificationSummaryUpdate {
+	if v != nil {
+		_u.SetResourceURI(*v)
+	}
+	return _u
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (_u *VerificationSummaryUpdate) SetPolicyURI(v string) *VerificationSummaryUpdate {
+	_u.mutation.SetPolicyURI(v)
+	return _u
+}
+
+// SetNillablePolicyURI sets the "policy_uri" field if the given value is not nil.
+func (_u *VerificationSummaryUpdate) SetNillablePolicyURI(v *string) *VerificationSummaryUpdate {
+	if v != nil {
+		_u.SetPolicyURI(*v)
+	}
+	return _u
+}
+
+// ClearPolicyURI clears the value of the "policy_uri" field.
+func (_u *VerificationSummaryUpdate) ClearPolicyURI() *VerificationSummaryUpdate {
+	_u.mutation.ClearPolicyURI()
+	return _u
+}
+
+// SetVerificationResult sets the "verification_result" field.
+func (_u *VerificationSummaryUpdate) SetVerificationResult(v string) *VerificationSummaryUpdate {
+	_u.mutation.SetVerificationResult(v)
+	return _u
+}
+
+// SetNillableVerificationResult sets the "verification_result" field if the given value is not nil.
+func (_u *VerificationSummaryUpdate) SetNillableVerificationResult(v *string) *VerificationSummaryUpdate {
+	if v != nil {
+		_u.SetVerificationResult(*v)
+	}
+	return _u
+}
+
+// SetVerifiedLevels sets the "verified_levels" field.
+func (_u *VerificationSummaryUpdate) SetVerifiedLevels(v []string) *VerificationSummaryUpdate {
+	_u.mutation.SetVerifiedLevels(v)
+	return _u
+}
+
+// AppendVerifiedLevels appends value to the "verified_levels" field.
+func (_u *VerificationSummaryUpdate) AppendVerifiedLevels(v []string) *VerificationSummaryUpdate {
+	_u.mutation.AppendVerifiedLevels(v)
+	return _u
+}
+
+// ClearVerifiedLevels clears the value of the "verified_levels" field.
+func (_u *VerificationSummaryUpdate) ClearVerifiedLevels() *VerificationSummaryUpdate {
+	_u.mutation.ClearVerifiedLevels()
+	return _u
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (_u *VerificationSummaryUpdate) SetSlsaVersion(v string) *VerificationSummaryUpdate {
+	_u.mutation.SetSlsaVersion(v)
+	return _u
+}
+
+// SetNillableSlsaVersion sets the "slsa_version" field if the given value is not nil.
+func (_u *VerificationSummaryUpdate) SetNillableSlsaVersion(v *string) *VerificationSummaryUpdate {
+	if v != nil {
+		_u.SetSlsaVersion(*v)
+	}
+	return _u
+}
+
+// ClearSlsaVersion clears the value of the "slsa_version" field.
+func (_u *VerificationSummaryUpdate) ClearSlsaVersion() *VerificationSummaryUpdate {
+	_u.mutation.ClearSlsaVersion()
+	return _u
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by ID.
+func (_u *VerificationSummaryUpdate) SetStatementID(id uuid.UUID) *VerificationSummaryUpdate {
+	_u.mutation.SetStatementID(id)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the Statement entity.
+func (_u *VerificationSummaryUpdate) SetStatement(v *Statement) *VerificationSummaryUpdate {
+	return _u.SetStatementID(v.ID)
+}
+
+// Mutation returns the VerificationSummaryMutation object of the builder.
+func (_u *VerificationSummaryUpdate) Mutation() *VerificationSummaryMutation {
+	return _u.mutation
+}
+
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (_u *VerificationSummaryUpdate) ClearStatement() *VerificationSummaryUpdate {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VerificationSummaryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VerificationSummaryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VerificationSummaryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VerificationSummaryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VerificationSummaryUpdate) check() error {
+	if v, ok := _u.mutation.VerifierID(); ok {
+		if err := verificationsummary.VerifierIDValidator(v); err != nil {
+			return &ValidationError{Name: "verifier_id", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.verifier_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceURI(); ok {
+		if err := verificationsummary.ResourceURIValidator(v); err != nil {
+			return &ValidationError{Name: "resource_uri", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.resource_uri": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VerificationResult(); ok {
+		if err := verificationsummary.VerificationResultValidator(v); err != nil {
+			return &ValidationError{Name: "verification_result", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.verification_result": %w`, err)}
+		}
+	}
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VerificationSummary.statement"`)
+	}
+	return nil
+}
+
+func (_u *VerificationSummaryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verificationsummary.Table, verificationsummary.Columns, sqlgraph.NewFieldSpec(verificationsummary.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VerifierID(); ok {
+		_spec.SetField(verificationsummary.FieldVerifierID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeVerified(); ok {
+		_spec.SetField(verificationsummary.FieldTimeVerified, field.TypeTime, value)
+	}
+	if _u.mutation.TimeVerifiedCleared() {
+		_spec.ClearField(verificationsummary.FieldTimeVerified, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResourceURI(); ok {
+		_spec.SetField(verificationsummary.FieldResourceURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PolicyURI(); ok {
+		_spec.SetField(verificationsummary.FieldPolicyURI, field.TypeString, value)
+	}
+	if _u.mutation.PolicyURICleared() {
+		_spec.ClearField(verificationsummary.FieldPolicyURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationResult(); ok {
+		_spec.SetField(verificationsummary.FieldVerificationResult, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VerifiedLevels(); ok {
+		_spec.SetField(verificationsummary.FieldVerifiedLevels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVerifiedLevels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, verificationsummary.FieldVerifiedLevels, value)
+		})
+	}
+	if _u.mutation.VerifiedLevelsCleared() {
+		_spec.ClearField(verificationsummary.FieldVerifiedLevels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SlsaVersion(); ok {
+		_spec.SetField(verificationsummary.FieldSlsaVersion, field.TypeString, value)
+	}
+	if _u.mutation.SlsaVersionCleared() {
+		_spec.ClearField(verificationsummary.FieldSlsaVersion, field.TypeString)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   verificationsummary.StatementTable,
+			Columns: []string{verificationsummary.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   verificationsummary.StatementTable,
+			Columns: []string{verificationsummary.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationsummary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VerificationSummaryUpdateOne is the builder for updating a single VerificationSummary entity.
+type VerificationSummaryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VerificationSummaryMutation
+}
+
+// SetVerifierID sets the "verifier_id" field.
+func (_u *VerificationSummaryUpdateOne) SetVerifierID(v string) *VerificationSummaryUpdateOne {
+	_u.mutation.SetVerifierID(v)
+	return _u
+}
+
+// SetNillableVerifierID sets the "verifier_id" field if the given value is not nil.
+func (_u *VerificationSummaryUpdateOne) SetNillableVerifierID(v *string) *VerificationSummaryUpdateOne {
+	if v != nil {
+		_u.SetVerifierID(*v)
+	}
+	return _u
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (_u *VerificationSummaryUpdateOne) SetTimeVerified(v time.Time) *VerificationSummaryUpdateOne {
+	_u.mutation.SetTimeVerified(v)
+	return _u
+}
+
+// SetNillableTimeVerified sets the "time_verified" field if the given value is not nil.
+func (_u *VerificationSummaryUpdateOne) SetNillableTimeVerified(v *time.Time) *VerificationSummaryUpdateOne {
+	if v != nil {
+		_u.SetTimeVerified(*v)
+	}
+	return _u
+}
+
+// ClearTimeVerified clears the value of the "time_verified" field.
+func (_u *VerificationSummaryUpdateOne) ClearTimeVerified() *VerificationSummaryUpdateOne {
+	_u.mutation.ClearTimeVerified()
+	return _u
+}
+
+// SetResourceURI sets the "resource_uri" field.
+func (_u *VerificationSummaryUpdateOne) SetResourceURI(v string) *VerificationSummaryUpdateOne {
+	_u.mutation.SetResourceURI(v)
+	return _u
+}
+
+// SetNillableResourceURI sets the "resource_uri" field if the given value is not nil.
+func (_u *VerificationSummaryUpdateOne) SetNillableResourceURI(v *string) *VerificationSummaryUpdateOne {
+	if v != nil {
+		_u.SetResourceURI(*v)
+	}
+	return _u
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (_u *VerificationSummaryUpdateOne) SetPolicyURI(v string) *VerificationSummaryUpdateOne {
+	_u.mutation.SetPolicyURI(v)
+	return _u
+}
+
+// SetNillablePolicyURI sets the "policy_uri" field if the given value is not nil.
+func (_u *VerificationSummaryUpdateOne) SetNillablePolicyURI(v *string) *VerificationSummaryUpdateOne {
+	if v != nil {
+		_u.SetPolicyURI(*v)
+	}
+	return _u
+}
+
+// ClearPolicyURI clears the value of the "policy_uri" field.
+func (_u *VerificationSummaryUpdateOne) ClearPolicyURI() *VerificationSummaryUpdateOne {
+	_u.mutation.ClearPolicyURI()
+	return _u
+}
+
+// SetVerificationResult sets the "verification_result" field.
+func (_u *VerificationSummaryUpdateOne) SetVerificationResult(v string) *VerificationSummaryUpdateOne {
+	_u.mutation.SetVerificationResult(v)
+	return _u
+}
+
+// SetNillableVerificationResult sets the "verification_result" field if the given value is not nil.
+func (_u *VerificationSummaryUpdateOne) SetNillableVerificationResult(v *string) *VerificationSummaryUpdateOne {
+	if v != nil {
+		_u.SetVerificationResult(*v)
+	}
+	return _u
+}
+
+// SetVerifiedLevels sets the "verified_levels" field.
+func (_u *VerificationSummaryUpdateOne) SetVerifiedLevels(v []string) *VerificationSummaryUpdateOne {
+	_u.mutation.SetVerifiedLevels(v)
+	return _u
+}
+
+// AppendVerifiedLevels appends value to the "verified_levels" field.
+func (_u *VerificationSummaryUpdateOne) AppendVerifiedLevels(v []string) *VerificationSummaryUpdateOne {
+	_u.mutation.AppendVerifiedLevels(v)
+	return _u
+}
+
+// ClearVerifiedLevels clears the value of the "verified_levels" field.
+func (_u *VerificationSummaryUpdateOne) ClearVerifiedLevels() *VerificationSummaryUpdateOne {
+	_u.mutation.ClearVerifiedLevels()
+	return _u
+}
+
+// SetSlsaVersion sets the "slsa_version" field.
+func (_u *VerificationSummaryUpdateOne) SetSlsaVersion(v string) *VerificationSummaryUpdateOne {
+	_u.mutation.SetSlsaVersion(v)
+	return _u
+}
+
+// SetNillableSlsaVersion sets the "slsa_version" field if the given value is not nil.
+func (_u *VerificationSummaryUpdateOne) SetNillableSlsaVersion(v *string) *VerificationSummaryUpdateOne {
+	if v != nil {
+		_u.SetSlsaVersion(*v)
+	}
+	return _u
+}
+
+// ClearSlsaVersion clears the value of the "slsa_version" field.
+func (_u *VerificationSummaryUpdateOne) ClearSlsaVersion() *VerificationSummaryUpdateOne {
+	_u.mutation.ClearSlsaVersion()
+	return _u
+}
+
+// SetStatementID sets the "statement" edge to the Statement entity by ID.
+func (_u *VerificationSummaryUpdateOne) SetStatementID(id uuid.UUID) *VerificationSummaryUpdateOne {
+	_u.mutation.SetStatementID(id)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the Statement entity.
+func (_u *VerificationSummaryUpdateOne) SetStatement(v *Statement) *VerificationSummaryUpdateOne {
+	return _u.SetStatementID(v.ID)
+}
+
+// Mutation returns the VerificationSummaryMutation object of the builder.
+func (_u *VerificationSummaryUpdateOne) Mutation() *VerificationSummaryMutation {
+	return _u.mutation
+}
+
+// ClearStatement clears the "statement" edge to the Statement entity.
+func (_u *VerificationSummaryUpdateOne) ClearStatement() *VerificationSummaryUpdateOne {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// Where appends a list predicates to the VerificationSummaryUpdate builder.
+func (_u *VerificationSummaryUpdateOne) Where(ps ...predicate.VerificationSummary) *VerificationSummaryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VerificationSummaryUpdateOne) Select(field string, fields ...string) *VerificationSummaryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VerificationSummary entity.
+func (_u *VerificationSummaryUpdateOne) Save(ctx context.Context) (*VerificationSummary, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VerificationSummaryUpdateOne) SaveX(ctx context.Context) *VerificationSummary {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VerificationSummaryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VerificationSummaryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VerificationSummaryUpdateOne) check() error {
+	if v, ok := _u.mutation.VerifierID(); ok {
+		if err := verificationsummary.VerifierIDValidator(v); err != nil {
+			return &ValidationError{Name: "verifier_id", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.verifier_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceURI(); ok {
+		if err := verificationsummary.ResourceURIValidator(v); err != nil {
+			return &ValidationError{Name: "resource_uri", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.resource_uri": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VerificationResult(); ok {
+		if err := verificationsummary.VerificationResultValidator(v); err != nil {
+			return &ValidationError{Name: "verification_result", err: fmt.Errorf(`ent: validator failed for field "VerificationSummary.verification_result": %w`, err)}
+		}
+	}
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VerificationSummary.statement"`)
+	}
+	return nil
+}
+
+func (_u *VerificationSummaryUpdateOne) sqlSave(ctx context.Context) (_node *VerificationSummary, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verificationsummary.Table, verificationsummary.Columns, sqlgraph.NewFieldSpec(verificationsummary.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VerificationSummary.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, verificationsummary.FieldID)
+		for _, f := range fields {
+			if !verificationsummary.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != verificationsummary.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VerifierID(); ok {
+		_spec.SetField(verificationsummary.FieldVerifierID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeVerified(); ok {
+		_spec.SetField(verificationsummary.FieldTimeVerified, field.TypeTime, value)
+	}
+	if _u.mutation.TimeVerifiedCleared() {
+		_spec.ClearField(verificationsummary.FieldTimeVerified, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResourceURI(); ok {
+		_spec.SetField(verificationsummary.FieldResourceURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PolicyURI(); ok {
+		_spec.SetField(verificationsummary.FieldPolicyURI, field.TypeString, value)
+	}
+	if _u.mutation.PolicyURICleared() {
+		_spec.ClearField(verificationsummary.FieldPolicyURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationResult(); ok {
+		_spec.SetField(verificationsummary.FieldVerificationResult, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VerifiedLevels(); ok {
+		_spec.SetField(verificationsummary.FieldVerifiedLevels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVerifiedLevels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, verificationsummary.FieldVerifiedLevels, value)
+		})
+	}
+	if _u.mutation.VerifiedLevelsCleared() {
+		_spec.ClearField(verificationsummary.FieldVerifiedLevels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SlsaVersion(); ok {
+		_spec.SetField(verificationsummary.FieldSlsaVersion, field.TypeString, value)
+	}
+	if _u.mutation.SlsaVersionCleared() {
+		_spec.ClearField(verificationsummary.FieldSlsaVersion, field.TypeString)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   verificationsummary.StatementTable,
+			Columns: []string{verificationsummary.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   verificationsummary.StatementTable,
+			Columns: []string{verificationsummary.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VerificationSummary{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationsummary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,284 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+
+	"github.com/in-toto/go-witness/dsse"
+)
+
+const (
+	// NDJSONMediaType streams a batch download as one BatchDownloadItem per line. It is the default.
+	NDJSONMediaType = "application/x-ndjson"
+	// TarMediaType streams a batch download as a tar archive holding each envelope as <gitoid>.json,
+	// and the ErrorResponse of each envelope that could not be downloaded as <gitoid>.error.json
+	TarMediaType = "application/x-tar"
+)
+
+// MaxBatchDownload is the most envelopes Archivista returns from one batch download request
+const MaxBatchDownload = 1000
+
+// MaxBatchUpload is the most envelopes Archivista accepts in one batch upload request
+const MaxBatchUpload = 1000
+
+// BatchDownloadRequest asks for the envelopes with Gitoids in one response
+type BatchDownloadRequest struct {
+	Gitoids []string `json:"gitoids"`
+}
+
+// BatchDownloadItem is one envelope of a batch download. Envelope holds the envelope exactly as
+// it was stored, so it can be checked against its gitoid. Error is set instead when the
+// envelope could not be downloaded.
+type BatchDownloadItem struct {
+	Gitoid   string         `json:"gitoid"`
+	Envelope []byte         `json:"envelope,omitempty"`
+	Error    *ErrorResponse `json:"error,omitempty"`
+}
+
+// DownloadManyResult is one envelope downloaded by DownloadMany
+type DownloadManyResult struct {
+	Gitoid string
+	// Content is the envelope as it was stored. It has been verified against the gitoid.
+	Content []byte
+	// Err is set when the envelope could not be downloaded. Missing envelopes match ErrNotFound.
+	Err error
+}
+
+// Envelope decodes the downloaded envelope
+func (r DownloadManyResult) Envelope() (dsse.Envelope, error) {
+	if r.Err != nil {
+		return dsse.Envelope{}, r.Err
+	}
+
+	env := dsse.Envelope{}
+	err := json.Unmarshal(r.Content, &env)
+	return env, err
+}
+
+// DownloadMany downloads the envelopes with gitoids, MaxBatchDownload at a time. Results are
+// returned in the order of gitoids. An envelope that can't be downloaded or that does not match
+// its gitoid fails only its own result.
+func DownloadMany(ctx context.Context, baseURL string, gitoids []string, requestOptions ...RequestOption) ([]DownloadManyResult, error) {
+	return DownloadManyWithHTTPClient(ctx, &http.Client{}, baseURL, gitoids, requestOptions...)
+}
+
+func DownloadManyWithHTTPClient(ctx context.Context, client *http.Client, baseURL string, gitoids []string, requestOptions ...RequestOption) ([]DownloadManyResult, error) {
+	results := make([]DownloadManyResult, 0, len(gitoids))
+	for start := 0; start < len(gitoids); start += MaxBatchDownload {
+		end := min(start+MaxBatchDownload, len(gitoids))
+		batch, err := downloadBatch(ctx, client, baseURL, gitoids[start:end], requestOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, batch...)
+	}
+
+	return results, nil
+}
+
+func downloadBatch(ctx context.Context, client *http.Client, baseURL string, gitoids []string, requestOptions ...RequestOption) ([]DownloadManyResult, error) {
+	downloadURL, err := url.JoinPath(baseURL, "v1", "download")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(BatchDownloadRequest{Gitoids: gitoids})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, downloadURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req = applyRequestOptions(req, requestOptions...)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", NDJSONMediaType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		errMsg, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, newHTTPError(resp.StatusCode, errMsg)
+	}
+
+	items := make(map[string]BatchDownloadItem, len(gitoids))
+	dec := json.NewDecoder(bufio.NewReader(resp.Body))
+	for {
+		item := BatchDownloadItem{}
+		if err := dec.Decode(&item); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		items[item.Gitoid] = item
+	}
+
+	results := make([]DownloadManyResult, 0, len(gitoids))
+	for _, gitoid := range gitoids {
+		results = append(results, batchResult(gitoid, items))
+	}
+
+	return results, nil
+}
+
+func batchResult(gitoid string, items map[string]BatchDownloadItem) DownloadManyResult {
+	item, ok := items[gitoid]
+	if !ok {
+		return DownloadManyResult{Gitoid: gitoid, Err: fmt.Errorf("%s is missing from the response", gitoid)}
+	}
+
+	if item.Error != nil {
+		switch item.Error.Error {
+		case "not_found":
+			return DownloadManyResult{Gitoid: gitoid, Err: &HTTPError{StatusCode: http.StatusNotFound, Code: item.Error.Error, Message: item.Error.Message}}
+		case "integrity":
+			// the server found the stored envelope did not match its gitoid
+			return DownloadManyResult{Gitoid: gitoid, Err: fmt.Errorf("%w: %s", ErrGitoidMismatch, gitoid)}
+		default:
+			return DownloadManyResult{Gitoid: gitoid, Err: &HTTPError{StatusCode: http.StatusInternalServerError, Code: item.Error.Error, Message: item.Error.Message}}
+		}
+	}
+
+	verified, err := verifyGitoid(io.NopCloser(bytes.NewReader(item.Envelope)), gitoid, int64(len(item.Envelope)))
+	if err == nil {
+		_, err = io.Copy(io.Discard, verified)
+	}
+
+	if err != nil {
+		return DownloadManyResult{Gitoid: gitoid, Err: err}
+	}
+
+	return DownloadManyResult{Gitoid: gitoid, Content: item.Envelope}
+}
+
+// BatchUploadResult is the outcome of storing one envelope of a batch upload. Index is the
+// position of the envelope in the request. Error is set instead of Gitoid and Status when the
+// envelope could not be stored. Envelopes lists the outcome of each envelope when a bundle was
+// uploaded, as in UploadResponse.
+type BatchUploadResult struct {
+	Index     int              `json:"index"`
+	Gitoid    string           `json:"gitoid,omitempty"`
+	Status    UploadStatus     `json:"status,omitempty"`
+	Envelopes []UploadResponse `json:"envelopes,omitempty"`
+	Error     *ErrorResponse   `json:"error,omitempty"`
+}
+
+// BatchUploadResponse holds the outcome of every envelope of a batch upload, in request order.
+// Error is set when the whole batch was rejected part way, such as for holding more than
+// MaxBatchUpload envelopes, and Results then holds the envelopes handled before that.
+type BatchUploadResponse struct {
+	Results []BatchUploadResult `json:"results"`
+	Error   *ErrorResponse      `json:"error,omitempty"`
+}
+
+// StoreMany uploads envelopes in one request, MaxBatchUpload at a time. Envelopes are read
+// as they are sent, and each is stored or rejected on its own.
+func StoreMany(ctx context.Context, baseURL string, envelopes []io.Reader, requestOptions ...RequestOption) ([]BatchUploadResult, error) {
+	return StoreManyWithHTTPClient(ctx, &http.Client{}, baseURL, envelopes, requestOptions...)
+}
+
+func StoreManyWithHTTPClient(ctx context.Context, client *http.Client, baseURL string, envelopes []io.Reader, requestOptions ...RequestOption) ([]BatchUploadResult, error) {
+	results := make([]BatchUploadResult, 0, len(envelopes))
+	for start := 0; start < len(envelopes); start += MaxBatchUpload {
+		end := min(start+MaxBatchUpload, len(envelopes))
+		batch, err := uploadBatch(ctx, client, baseURL, envelopes[start:end], requestOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range batch {
+			result.Index += start
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+func uploadBatch(ctx context.Context, client *http.Client, baseURL string, envelopes []io.Reader, requestOptions ...RequestOption) ([]BatchUploadResult, error) {
+	uploadURL, err := url.JoinPath(baseURL, "v1", "upload", "batch")
+	if err != nil {
+		return nil, err
+	}
+
+	// envelopes are streamed as the parts of a multipart body so none are held in memory
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		for i, envelope := range envelopes {
+			part, err := mw.CreateFormFile("envelope", fmt.Sprintf("%d.json", i))
+			if err == nil {
+				_, err = io.Copy(part, envelope)
+			}
+
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+
+		pw.CloseWithError(mw.Close())
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, pr)
+	if err != nil {
+		pr.Close()
+		return nil, err
+	}
+
+	req = applyRequestOptions(req, requestOptions...)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newHTTPError(resp.StatusCode, bodyBytes)
+	}
+
+	uploadResp := BatchUploadResponse{}
+	if err := json.Unmarshal(bodyBytes, &uploadResp); err != nil {
+		return nil, err
+	}
+
+	return uploadResp.Results, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT APIBatchDownloadSuite
+type UTAPIBatchSuite struct {
+	suite.Suite
+}
+
+func TestAPIBatchSuite(t *testing.T) {
+	suite.Run(t, new(UTAPIBatchSuite))
+}
+
+func (ut *UTAPIBatchSuite) Test_DownloadMany() {
+	genuine := []byte(`{"payload":"Z2VudWluZQ=="}`)
+	stored, tampered := gitoidOf(genuine), gitoidOf([]byte(`{"payload":"other"}`))
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				ut.Equal(http.MethodPost, r.Method)
+				ut.Equal("/v1/download", r.URL.Path)
+				ut.Equal(api.NDJSONMediaType, r.Header.Get("Accept"))
+				req := api.BatchDownloadRequest{}
+				ut.Require().NoError(json.NewDecoder(r.Body).Decode(&req))
+
+				enc := json.NewEncoder(w)
+				for _, gitoid := range req.Gitoids {
+					switch gitoid {
+					case stored:
+						_ = enc.Encode(api.BatchDownloadItem{Gitoid: gitoid, Envelope: genuine})
+					case tampered:
+						_ = enc.Encode(api.BatchDownloadItem{Gitoid: gitoid, Envelope: genuine})
+					default:
+						_ = enc.Encode(api.BatchDownloadItem{Gitoid: gitoid, Error: &api.ErrorResponse{Error: "not_found", Message: "object not found"}})
+					}
+				}
+			},
+		),
+	)
+	defer testServer.Close()
+
+	results, err := api.DownloadMany(context.TODO(), testServer.URL, []string{stored, "missing", tampered})
+	ut.Require().NoError(err)
+	ut.Require().Len(results, 3)
+
+	ut.Equal(stored, results[0].Gitoid)
+	ut.NoError(results[0].Err)
+	env, err := results[0].Envelope()
+	ut.NoError(err)
+	ut.Equal([]byte("genuine"), env.Payload)
+
+	ut.Equal("missing", results[1].Gitoid)
+	ut.ErrorIs(results[1].Err, api.ErrNotFound)
+
+	ut.Equal(tampered, results[2].Gitoid)
+	ut.ErrorIs(results[2].Err, api.ErrGitoidMismatch)
+	ut.Nil(results[2].Content)
+}
+
+func (ut *UTAPIBatchSuite) Test_DownloadMany_BadRequest() {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "at least one gitoid is required", http.StatusBadRequest)
+			},
+		),
+	)
+	defer testServer.Close()
+
+	_, err := api.DownloadMany(context.TODO(), testServer.URL, []string{"gitoid"})
+	var httpErr *api.HTTPError
+	ut.Require().ErrorAs(err, &httpErr)
+	ut.Equal(http.StatusBadRequest, httpErr.StatusCode)
+}
+
+func (ut *UTAPIBatchSuite) Test_StoreMany() {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				ut.Equal("/v1/upload/batch", r.URL.Path)
+				mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+				ut.Require().NoError(err)
+				ut.Equal("multipart/form-data", mediaType)
+
+				resp := api.BatchUploadResponse{}
+				mr := multipart.NewReader(r.Body, params["boundary"])
+				for index := 0; ; index++ {
+					part, err := mr.NextPart()
+					if err == io.EOF {
+						break
+					}
+
+					ut.Require().NoError(err)
+					envelope, err := io.ReadAll(part)
+					ut.Require().NoError(err)
+					if string(envelope) == "rejected" {
+						resp.Results = append(resp.Results, api.BatchUploadResult{Index: index, Error: &api.ErrorResponse{Error: "too_large", Message: "upload exceeds the maximum allowed size"}})
+					} else {
+						resp.Results = append(resp.Results, api.BatchUploadResult{Index: index, Gitoid: gitoidOf(envelope), Status: api.UploadStatusCreated})
+					}
+				}
+
+				_ = json.NewEncoder(w).Encode(resp)
+			},
+		),
+	)
+	defer testServer.Close()
+
+	results, err := api.StoreMany(context.TODO(), testServer.URL, []io.Reader{
+		strings.NewReader(`{"payload":"first"}`),
+		strings.NewReader("rejected"),
+		strings.NewReader(`{"payload":"second"}`),
+	})
+	ut.Require().NoError(err)
+	ut.Equal([]api.BatchUploadResult{
+		{Index: 0, Gitoid: gitoidOf([]byte(`{"payload":"first"}`)), Status: api.UploadStatusCreated},
+		{Index: 1, Error: &api.ErrorResponse{Error: "too_large", Message: "upload exceeds the maximum allowed size"}},
+		{Index: 2, Gitoid: gitoidOf([]byte(`{"payload":"second"}`)), Status: api.UploadStatusCreated},
+	}, results)
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type DeleteResponse struct {
+	Gitoid string `json:"gitoid"`
+}
+
+// Delete removes the envelope with gitoid and all metadata stored from it
+func Delete(ctx context.Context, baseURL string, gitoid string, requestOptions ...RequestOption) (DeleteResponse, error) {
+	return DeleteWithHTTPClient(ctx, &http.Client{}, baseURL, gitoid, requestOptions...)
+}
+
+func DeleteWithHTTPClient(ctx context.Context, client *http.Client, baseURL string, gitoid string, requestOptions ...RequestOption) (DeleteResponse, error) {
+	deleteURL, err := url.JoinPath(baseURL, "v1", "delete", gitoid)
+	if err != nil {
+		return DeleteResponse{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, deleteURL, nil)
+	if err != nil {
+		return DeleteResponse{}, err
+	}
+
+	req = applyRequestOptions(req, requestOptions...)
+	resp, err := client.Do(req)
+	if err != nil {
+		return DeleteResponse{}, err
+	}
+
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return DeleteResponse{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return DeleteResponse{}, newHTTPError(resp.StatusCode, bodyBytes)
+	}
+
+	deleteResp := DeleteResponse{}
+	if err := json.Unmarshal(bodyBytes, &deleteResp); err != nil {
+		return DeleteResponse{}, err
+	}
+
+	return deleteResp, nil
+}
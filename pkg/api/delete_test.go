@@ -0,0 +1,57 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT APIDelete
+type UTAPIDeleteSuite struct {
+	suite.Suite
+}
+
+func TestAPIDeleteSuite(t *testing.T) {
+	suite.Run(t, new(UTAPIDeleteSuite))
+}
+
+func (ut *UTAPIDeleteSuite) Test_Delete() {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ut.Equal(http.MethodDelete, r.Method)
+		ut.Equal("/v1/delete/gitoid_test", r.URL.Path)
+		_, _ = w.Write([]byte(`{"gitoid":"gitoid_test"}`))
+	}))
+	defer testServer.Close()
+
+	resp, err := api.Delete(context.TODO(), testServer.URL, "gitoid_test")
+	ut.NoError(err)
+	ut.Equal(api.DeleteResponse{Gitoid: "gitoid_test"}, resp)
+}
+
+func (ut *UTAPIDeleteSuite) Test_Delete_NotFound() {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "envelope not found: gitoid_test", http.StatusNotFound)
+	}))
+	defer testServer.Close()
+
+	_, err := api.Delete(context.TODO(), testServer.URL, "gitoid_test")
+	ut.ErrorContains(err, "envelope not found")
+}
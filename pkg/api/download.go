@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -26,6 +27,8 @@ import (
 	"github.com/in-toto/go-witness/dsse"
 )
 
+// DownloadReadCloser streams the envelope with gitoid. Reading the envelope to the end fails
+// with ErrGitoidMismatch if it does not hash to gitoid.
 func DownloadReadCloser(ctx context.Context, baseURL string, gitoid string, requestOptions ...RequestOption) (io.ReadCloser, error) {
 	return DownloadReadCloserWithHTTPClient(ctx, &http.Client{}, baseURL, gitoid, requestOptions...)
 }
@@ -56,9 +59,10 @@ func DownloadReadCloserWithHTTPClient(ctx context.Context, client *http.Client,
 		if err != nil {
 			return nil, err
 		}
-		return nil, errors.New(string(errMsg))
+		return nil, newHTTPError(resp.StatusCode, errMsg)
 	}
-	return resp.Body, nil
+
+	return verifyResponse(resp, gitoid)
 }
 
 func Download(ctx context.Context, baseURL string, gitoid string, requestOptions ...RequestOption) (dsse.Envelope, error) {
@@ -76,6 +80,8 @@ func Download(ctx context.Context, baseURL string, gitoid string, requestOptions
 	return env, nil
 }
 
+// DownloadWithWriter writes the envelope with gitoid to dst. It fails with ErrGitoidMismatch,
+// after the envelope has been written, if the envelope does not hash to gitoid.
 func DownloadWithWriter(ctx context.Context, baseURL string, gitoid string, dst io.Writer, requestOptions ...RequestOption) error {
 	return DownloadWithWriterWithHTTPClient(ctx, &http.Client{}, baseURL, gitoid, dst, requestOptions...)
 }
@@ -93,8 +99,7 @@ func DownloadWithWriterWithHTTPClient(ctx context.Context, client *http.Client,
 
 	req = applyRequestOptions(req, requestOptions...)
 	req.Header.Set("Content-Type", "application/json")
-	hc := &http.Client{}
-	resp, err := hc.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return err
 	}
@@ -106,9 +111,99 @@ func DownloadWithWriterWithHTTPClient(ctx context.Context, client *http.Client,
 			return err
 		}
 
-		return errors.New(string(errMsg))
+		return newHTTPError(resp.StatusCode, errMsg)
+	}
+
+	verified, err := verifyResponse(resp, gitoid)
+	if err != nil {
+		return err
 	}
 
-	_, err = io.Copy(dst, resp.Body)
+	_, err = io.Copy(dst, verified)
 	return err
 }
+
+// Exists reports whether an envelope with gitoid is stored, without downloading it
+func Exists(ctx context.Context, baseURL string, gitoid string, requestOptions ...RequestOption) (bool, error) {
+	return ExistsWithHTTPClient(ctx, &http.Client{}, baseURL, gitoid, requestOptions...)
+}
+
+func ExistsWithHTTPClient(ctx context.Context, client *http.Client, baseURL string, gitoid string, requestOptions ...RequestOption) (bool, error) {
+	downloadURL, err := url.JoinPath(baseURL, "download", gitoid)
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, downloadURL, nil)
+	if err != nil {
+		return false, err
+	}
+
+	req = applyRequestOptions(req, requestOptions...)
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		// responses to HEAD requests have no body to read an error from
+		return false, &HTTPError{StatusCode: resp.StatusCode, Message: resp.Status}
+	}
+}
+
+// DownloadRange streams length bytes of the envelope with gitoid starting at offset. A
+// length of 0 or less reads to the end of the envelope. A range of the envelope can't be
+// checked against its gitoid, so the bytes returned are not verified.
+func DownloadRange(ctx context.Context, baseURL string, gitoid string, offset int64, length int64, requestOptions ...RequestOption) (io.ReadCloser, error) {
+	return DownloadRangeWithHTTPClient(ctx, &http.Client{}, baseURL, gitoid, offset, length, requestOptions...)
+}
+
+func DownloadRangeWithHTTPClient(ctx context.Context, client *http.Client, baseURL string, gitoid string, offset int64, length int64, requestOptions ...RequestOption) (io.ReadCloser, error) {
+	if offset < 0 {
+		return nil, fmt.Errorf("invalid range offset %d", offset)
+	}
+
+	downloadURL, err := url.JoinPath(baseURL, "download", gitoid)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = applyRequestOptions(req, requestOptions...)
+	if length > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	} else {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusPartialContent {
+		defer resp.Body.Close()
+		errMsg, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusOK {
+			return nil, errors.New("server does not support range requests")
+		}
+
+		return nil, newHTTPError(resp.StatusCode, errMsg)
+	}
+
+	return resp.Body, nil
+}
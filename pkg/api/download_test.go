@@ -15,6 +15,7 @@
 package api_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
@@ -23,7 +24,9 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
+	"github.com/edwarnicke/gitoid"
 	"github.com/in-toto/archivista/pkg/api"
 	"github.com/in-toto/go-witness/dsse"
 	"github.com/stretchr/testify/suite"
@@ -65,7 +68,7 @@ func (ut *UTAPIDownloadSuite) Test_Download() {
 	ctx := context.TODO()
 
 	// test api.Download happy flow
-	resp, err := api.Download(ctx, testServer.URL, "gitoid_test")
+	resp, err := api.Download(ctx, testServer.URL, gitoidOf(testEnvelope))
 	if err != nil {
 		ut.FailNow(err.Error())
 	}
@@ -73,6 +76,36 @@ func (ut *UTAPIDownloadSuite) Test_Download() {
 	ut.Equal(expectedEnvelop, resp)
 }
 
+func gitoidOf(content []byte) string {
+	gid, _ := gitoid.New(bytes.NewReader(content), gitoid.WithContentLength(int64(len(content))), gitoid.WithSha256())
+	return gid.String()
+}
+
+func (ut *UTAPIDownloadSuite) Test_Download_GitoidMismatch() {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Has("chunked") {
+					// flushing before writing the body leaves the length unknown
+					w.(http.Flusher).Flush()
+				}
+
+				_, _ = w.Write([]byte(`{"payload":"substituted"}`))
+			},
+		),
+	)
+	defer testServer.Close()
+
+	_, err := api.Download(context.TODO(), testServer.URL, gitoidOf([]byte(`{"payload":"genuine"}`)))
+	ut.ErrorIs(err, api.ErrGitoidMismatch)
+
+	reader, err := api.DownloadReadCloser(context.TODO(), testServer.URL+"?chunked", gitoidOf([]byte(`{"payload":"genuine"}`)))
+	ut.Require().NoError(err)
+	defer reader.Close()
+	_, err = io.ReadAll(reader)
+	ut.ErrorIs(err, api.ErrGitoidMismatch)
+}
+
 func (ut *UTAPIDownloadSuite) Test_Download_DecodeFailure() {
 	testServer := httptest.NewServer(
 		http.HandlerFunc(
@@ -120,7 +153,7 @@ func (ut *UTAPIDownloadSuite) Test_DownloadWithReader() {
 	if err != nil {
 		ut.FailNow(err.Error())
 	}
-	err = api.DownloadWithWriter(ctx, testServer.URL, "gitoid", dst)
+	err = api.DownloadWithWriter(ctx, testServer.URL, gitoidOf([]byte(expected)), dst)
 	if err != nil {
 		ut.FailNow(err.Error())
 	}
@@ -169,4 +202,102 @@ func (ut *UTAPIDownloadSuite) Test_DownloadWithWriter_BadStatusCode() {
 
 	err := api.DownloadWithWriter(ctx, testServer.URL, "gitoid_test", dst)
 	ut.ErrorContains(err, "Internal Server Error")
+	ut.NotErrorIs(err, api.ErrNotFound)
+}
+
+func (ut *UTAPIDownloadSuite) Test_Download_NotFound() {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":"not_found","message":"object not found: gitoid_test"}`))
+			},
+		),
+	)
+	defer testServer.Close()
+
+	_, err := api.Download(context.TODO(), testServer.URL, "gitoid_test")
+	ut.ErrorIs(err, api.ErrNotFound)
+	ut.EqualError(err, "object not found: gitoid_test")
+
+	_, err = api.DownloadReadCloser(context.TODO(), testServer.URL, "gitoid_test")
+	ut.ErrorIs(err, api.ErrNotFound)
+	var httpErr *api.HTTPError
+	ut.Require().ErrorAs(err, &httpErr)
+	ut.Equal(http.StatusNotFound, httpErr.StatusCode)
+	ut.Equal("not_found", httpErr.Code)
+}
+
+func (ut *UTAPIDownloadSuite) Test_Download_ETagMismatch() {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("ETag", `"`+gitoidOf([]byte(`{"payload":"substituted"}`))+`"`)
+				_, _ = w.Write([]byte(`{"payload":"substituted"}`))
+			},
+		),
+	)
+	defer testServer.Close()
+
+	_, err := api.DownloadReadCloser(context.TODO(), testServer.URL, gitoidOf([]byte(`{"payload":"genuine"}`)))
+	ut.ErrorIs(err, api.ErrGitoidMismatch)
+}
+
+func (ut *UTAPIDownloadSuite) Test_Exists() {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				ut.Equal(http.MethodHead, r.Method)
+				switch path.Base(r.URL.Path) {
+				case "stored":
+					w.WriteHeader(http.StatusOK)
+				case "missing":
+					w.WriteHeader(http.StatusNotFound)
+				default:
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			},
+		),
+	)
+	defer testServer.Close()
+
+	exists, err := api.Exists(context.TODO(), testServer.URL, "stored")
+	ut.NoError(err)
+	ut.True(exists)
+
+	exists, err = api.Exists(context.TODO(), testServer.URL, "missing")
+	ut.NoError(err)
+	ut.False(exists)
+
+	_, err = api.Exists(context.TODO(), testServer.URL, "broken")
+	var httpErr *api.HTTPError
+	ut.Require().ErrorAs(err, &httpErr)
+	ut.Equal(http.StatusInternalServerError, httpErr.StatusCode)
+}
+
+func (ut *UTAPIDownloadSuite) Test_DownloadRange() {
+	content := []byte(`{"payload":"genuine"}`)
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+			},
+		),
+	)
+	defer testServer.Close()
+
+	reader, err := api.DownloadRange(context.TODO(), testServer.URL, gitoidOf(content), 2, 7)
+	ut.Require().NoError(err)
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	ut.NoError(err)
+	ut.Equal(`payload`, string(data))
+
+	reader, err = api.DownloadRange(context.TODO(), testServer.URL, gitoidOf(content), 11, 0)
+	ut.Require().NoError(err)
+	defer reader.Close()
+	data, err = io.ReadAll(reader)
+	ut.NoError(err)
+	ut.Equal(`"genuine"}`, string(data))
 }
@@ -0,0 +1,53 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// ErrNotFound matches errors returned when Archivista has nothing stored under the requested
+// gitoid or id. Check for it with errors.Is.
+var ErrNotFound = errors.New("not found")
+
+// HTTPError is returned when Archivista responds with a status other than 200 OK
+type HTTPError struct {
+	StatusCode int
+	// Code is the error code of a JSON error response, such as not_found or forbidden
+	Code    string
+	Message string
+}
+
+func (e *HTTPError) Error() string {
+	return e.Message
+}
+
+// Is lets errors.Is tell a missing envelope apart from a failure of the server
+func (e *HTTPError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// newHTTPError builds the error for a response with statusCode and body. JSON error
+// responses are unwrapped, other bodies are used as the message as is.
+func newHTTPError(statusCode int, body []byte) error {
+	errResp := ErrorResponse{}
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != "" {
+		return &HTTPError{StatusCode: statusCode, Code: errResp.Error, Message: errResp.Message}
+	}
+
+	return &HTTPError{StatusCode: statusCode, Message: string(body)}
+}
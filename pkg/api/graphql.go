@@ -18,7 +18,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -77,6 +76,10 @@ const SearchQuery = `query($algo: String!, $digest: String!) {
 }`
 
 func GraphQlQuery[TRes any, TVars any](ctx context.Context, baseUrl, query string, vars TVars, requestOptions ...RequestOption) (TRes, error) {
+	return GraphQlQueryWithHTTPClient[TRes](ctx, &http.Client{}, baseUrl, query, vars, requestOptions...)
+}
+
+func GraphQlQueryWithHTTPClient[TRes any, TVars any](ctx context.Context, client *http.Client, baseUrl, query string, vars TVars, requestOptions ...RequestOption) (TRes, error) {
 	var response TRes
 	queryUrl, err := url.JoinPath(baseUrl, "query")
 	if err != nil {
@@ -100,8 +103,7 @@ func GraphQlQuery[TRes any, TVars any](ctx context.Context, baseUrl, query strin
 
 	req = applyRequestOptions(req, requestOptions...)
 	req.Header.Set("Content-Type", "application/json")
-	hc := &http.Client{}
-	res, err := hc.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
 		return response, err
 	}
@@ -113,7 +115,7 @@ func GraphQlQuery[TRes any, TVars any](ctx context.Context, baseUrl, query strin
 			return response, err
 		}
 
-		return response, errors.New(string(errMsg))
+		return response, newHTTPError(res.StatusCode, errMsg)
 	}
 
 	dec := json.NewDecoder(res.Body)
@@ -0,0 +1,108 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// HoldRequest places the envelopes with Gitoids, or whose statement has a subject with the
+// digest SubjectDigest, under a legal hold
+type HoldRequest struct {
+	Reason                 string   `json:"reason"`
+	Gitoids                []string `json:"gitoids,omitempty"`
+	SubjectDigestAlgorithm string   `json:"subjectDigestAlgorithm,omitempty"`
+	SubjectDigest          string   `json:"subjectDigest,omitempty"`
+}
+
+type HoldResponse struct {
+	ID         string     `json:"id"`
+	Reason     string     `json:"reason"`
+	Gitoids    []string   `json:"gitoids"`
+	CreatedBy  string     `json:"createdBy,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ReleasedBy string     `json:"releasedBy,omitempty"`
+	ReleasedAt *time.Time `json:"releasedAt,omitempty"`
+}
+
+// Hold places envelopes under a legal hold. Held envelopes can't be deleted until the hold is released.
+func Hold(ctx context.Context, baseURL string, hold HoldRequest, requestOptions ...RequestOption) (HoldResponse, error) {
+	return HoldWithHTTPClient(ctx, &http.Client{}, baseURL, hold, requestOptions...)
+}
+
+func HoldWithHTTPClient(ctx context.Context, client *http.Client, baseURL string, hold HoldRequest, requestOptions ...RequestOption) (HoldResponse, error) {
+	holdURL, err := url.JoinPath(baseURL, "v1", "holds")
+	if err != nil {
+		return HoldResponse{}, err
+	}
+
+	body, err := json.Marshal(hold)
+	if err != nil {
+		return HoldResponse{}, err
+	}
+
+	return doHoldRequest(ctx, client, holdURL, body, requestOptions...)
+}
+
+// ReleaseHold releases the legal hold with id
+func ReleaseHold(ctx context.Context, baseURL string, id string, requestOptions ...RequestOption) (HoldResponse, error) {
+	return ReleaseHoldWithHTTPClient(ctx, &http.Client{}, baseURL, id, requestOptions...)
+}
+
+func ReleaseHoldWithHTTPClient(ctx context.Context, client *http.Client, baseURL string, id string, requestOptions ...RequestOption) (HoldResponse, error) {
+	releaseURL, err := url.JoinPath(baseURL, "v1", "holds", id, "release")
+	if err != nil {
+		return HoldResponse{}, err
+	}
+
+	return doHoldRequest(ctx, client, releaseURL, nil, requestOptions...)
+}
+
+func doHoldRequest(ctx context.Context, client *http.Client, holdURL string, body []byte, requestOptions ...RequestOption) (HoldResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, holdURL, bytes.NewReader(body))
+	if err != nil {
+		return HoldResponse{}, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req = applyRequestOptions(req, requestOptions...)
+	resp, err := client.Do(req)
+	if err != nil {
+		return HoldResponse{}, err
+	}
+
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return HoldResponse{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return HoldResponse{}, newHTTPError(resp.StatusCode, bodyBytes)
+	}
+
+	holdResp := HoldResponse{}
+	if err := json.Unmarshal(bodyBytes, &holdResp); err != nil {
+		return HoldResponse{}, err
+	}
+
+	return holdResp, nil
+}
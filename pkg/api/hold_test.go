@@ -0,0 +1,76 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT APIHold
+type UTAPIHoldSuite struct {
+	suite.Suite
+}
+
+func TestAPIHoldSuite(t *testing.T) {
+	suite.Run(t, new(UTAPIHoldSuite))
+}
+
+func (ut *UTAPIHoldSuite) Test_Hold() {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ut.Equal(http.MethodPost, r.Method)
+		ut.Equal("/v1/holds", r.URL.Path)
+		hold := api.HoldRequest{}
+		ut.NoError(json.NewDecoder(r.Body).Decode(&hold))
+		ut.Equal(api.HoldRequest{Reason: "audit", SubjectDigestAlgorithm: "sha256", SubjectDigest: "abcd"}, hold)
+		_, _ = w.Write([]byte(`{"id":"hold","reason":"audit","gitoids":["a","b"]}`))
+	}))
+	defer testServer.Close()
+
+	resp, err := api.Hold(context.TODO(), testServer.URL, api.HoldRequest{Reason: "audit", SubjectDigestAlgorithm: "sha256", SubjectDigest: "abcd"})
+	ut.NoError(err)
+	ut.Equal("hold", resp.ID)
+	ut.Equal([]string{"a", "b"}, resp.Gitoids)
+}
+
+func (ut *UTAPIHoldSuite) Test_ReleaseHold() {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ut.Equal(http.MethodPost, r.Method)
+		ut.Equal("/v1/holds/hold/release", r.URL.Path)
+		_, _ = w.Write([]byte(`{"id":"hold","reason":"audit","gitoids":["a"],"releasedBy":"auditor","releasedAt":"2026-10-17T00:00:00Z"}`))
+	}))
+	defer testServer.Close()
+
+	resp, err := api.ReleaseHold(context.TODO(), testServer.URL, "hold")
+	ut.NoError(err)
+	ut.Equal("auditor", resp.ReleasedBy)
+	ut.NotNil(resp.ReleasedAt)
+}
+
+func (ut *UTAPIHoldSuite) Test_ReleaseHold_NotFound() {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "legal hold not found: hold", http.StatusNotFound)
+	}))
+	defer testServer.Close()
+
+	_, err := api.ReleaseHold(context.TODO(), testServer.URL, "hold")
+	ut.ErrorContains(err, "legal hold not found")
+}
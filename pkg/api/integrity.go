@@ -0,0 +1,91 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/edwarnicke/gitoid"
+)
+
+// ErrGitoidMismatch is returned when a downloaded envelope does not hash to the gitoid it was requested by
+var ErrGitoidMismatch = errors.New("envelope does not match its gitoid")
+
+// verifyResponse returns a reader over the body of a download of the envelope with gid that
+// fails with ErrGitoidMismatch if the body does not hash to gid. Archivista tags envelopes
+// with their gitoid, so a response tagged with another gitoid is rejected before it is read.
+func verifyResponse(resp *http.Response, gid string) (io.ReadCloser, error) {
+	if etag := resp.Header.Get("ETag"); etag != "" && !strings.EqualFold(strings.Trim(etag, `"`), gid) {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: requested %s but received %s", ErrGitoidMismatch, gid, etag)
+	}
+
+	return verifyGitoid(resp.Body, gid, resp.ContentLength)
+}
+
+// verifyingReader hashes an envelope as it is read and fails the final read if the
+// envelope does not match its gitoid
+type verifyingReader struct {
+	io.ReadCloser
+	gitoid   string
+	hash     hash.Hash
+	expected int64
+	read     int64
+}
+
+// verifyGitoid returns a reader over rc that fails with ErrGitoidMismatch once fully read
+// if the envelope does not hash to gid. The gitoid covers the envelope's length, so when
+// contentLength is unknown the envelope is read into memory and verified up front.
+func verifyGitoid(rc io.ReadCloser, gid string, contentLength int64) (io.ReadCloser, error) {
+	if contentLength < 0 {
+		defer rc.Close()
+		content, err := io.ReadAll(rc)
+		if err != nil {
+			return nil, err
+		}
+
+		rc = io.NopCloser(bytes.NewReader(content))
+		contentLength = int64(len(content))
+	}
+
+	h := sha256.New()
+	h.Write(gitoid.Header(gitoid.BLOB, contentLength))
+	return &verifyingReader{ReadCloser: rc, gitoid: gid, hash: h, expected: contentLength}, nil
+}
+
+func (vr *verifyingReader) Read(p []byte) (int, error) {
+	n, err := vr.ReadCloser.Read(p)
+	vr.hash.Write(p[:n])
+	vr.read += int64(n)
+	if errors.Is(err, io.EOF) {
+		if vr.read != vr.expected {
+			return n, fmt.Errorf("%w: read %d of %d bytes of %s", ErrGitoidMismatch, vr.read, vr.expected, vr.gitoid)
+		}
+
+		if actual := hex.EncodeToString(vr.hash.Sum(nil)); !strings.EqualFold(actual, vr.gitoid) {
+			return n, fmt.Errorf("%w: requested %s but received %s", ErrGitoidMismatch, vr.gitoid, actual)
+		}
+	}
+
+	return n, err
+}
@@ -15,10 +15,14 @@ package api
 
 import "net/http"
 
+// TenantHeader is the header servers with multi-tenancy enabled read the caller's tenant from by default
+const TenantHeader = "X-Archivista-Tenant"
+
 type RequestOption func(*requestOptions)
 
 type requestOptions struct {
 	additionalHeaders http.Header
+	tenant            string
 }
 
 func WithHeaders(h http.Header) RequestOption {
@@ -29,6 +33,13 @@ func WithHeaders(h http.Header) RequestOption {
 	}
 }
 
+// WithTenant scopes the request to tenant on servers with multi-tenancy enabled
+func WithTenant(tenant string) RequestOption {
+	return func(ro *requestOptions) {
+		ro.tenant = tenant
+	}
+}
+
 func applyRequestOptions(req *http.Request, requestOpts ...RequestOption) *http.Request {
 	if req == nil {
 		return nil
@@ -47,5 +58,9 @@ func applyRequestOptions(req *http.Request, requestOpts ...RequestOption) *http.
 		req.Header = opts.additionalHeaders
 	}
 
+	if opts.tenant != "" {
+		req.Header.Set(TenantHeader, opts.tenant)
+	}
+
 	return req
 }
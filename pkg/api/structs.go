@@ -88,3 +88,9 @@ type AttestationCollection struct {
 type Attestation struct {
 	Type string `json:"type"`
 }
+
+// ErrorResponse is the body of a request the server rejected
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
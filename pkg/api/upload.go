@@ -18,7 +18,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"io"
 	"net/http"
 	"net/url"
@@ -26,8 +25,24 @@ import (
 	"github.com/in-toto/go-witness/dsse"
 )
 
+// UploadStatus describes what the server did with an uploaded envelope
+type UploadStatus string
+
+const (
+	// UploadStatusCreated means the envelope was stored for the first time
+	UploadStatusCreated UploadStatus = "created"
+	// UploadStatusAlreadyExists means an envelope with the same gitoid was already stored and nothing was written
+	UploadStatusAlreadyExists UploadStatus = "already_exists"
+)
+
 type UploadResponse struct {
-	Gitoid string `json:"gitoid"`
+	// Gitoid and Status are empty for a bundle of more than one envelope; see Envelopes instead
+	Gitoid string       `json:"gitoid"`
+	Status UploadStatus `json:"status,omitempty"`
+	// Envelopes lists each envelope of an uploaded bundle, in bundle order. It is empty when a single envelope was uploaded.
+	Envelopes []UploadResponse `json:"envelopes,omitempty"`
+	// Error is set on an envelope of a bundle that could not be stored
+	Error *ErrorResponse `json:"error,omitempty"`
 }
 
 // Deprecated: Use UploadResponse instead. It will be removed in version >= v0.6.0
@@ -65,8 +80,7 @@ func StoreWithReaderWithHTTPClient(ctx context.Context, client *http.Client, bas
 
 	req = applyRequestOptions(req, requestOptions...)
 	req.Header.Set("Content-Type", "application/json")
-	hc := &http.Client{}
-	resp, err := hc.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return UploadResponse{}, err
 	}
@@ -78,7 +92,7 @@ func StoreWithReaderWithHTTPClient(ctx context.Context, client *http.Client, bas
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return UploadResponse{}, errors.New(string(bodyBytes))
+		return UploadResponse{}, newHTTPError(resp.StatusCode, bodyBytes)
 	}
 
 	uploadResp := UploadResponse{}
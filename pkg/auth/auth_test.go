@@ -0,0 +1,253 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/in-toto/archivista/pkg/auth"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT Auth
+type UTAuthSuite struct {
+	suite.Suite
+	key      *ecdsa.PrivateKey
+	jwksFile string
+}
+
+func TestUTAuthSuite(t *testing.T) {
+	suite.Run(t, new(UTAuthSuite))
+}
+
+func (ut *UTAuthSuite) SetupTest() {
+	var err error
+	ut.key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ut.Require().NoError(err)
+
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: ut.key.Public(), KeyID: "test", Algorithm: string(jose.ES256), Use: "sig"}}}
+	jwksBytes, err := json.Marshal(jwks)
+	ut.Require().NoError(err)
+
+	ut.jwksFile = filepath.Join(ut.T().TempDir(), "jwks.json")
+	ut.Require().NoError(os.WriteFile(ut.jwksFile, jwksBytes, 0o600))
+}
+
+func (ut *UTAuthSuite) signJWT(key *ecdsa.PrivateKey, claims jwt.Claims, custom map[string]any) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, (&jose.SignerOptions{}).WithHeader("kid", "test"))
+	ut.Require().NoError(err)
+
+	token, err := jwt.Signed(signer).Claims(claims).Claims(custom).Serialize()
+	ut.Require().NoError(err)
+	return token
+}
+
+func (ut *UTAuthSuite) validClaims() jwt.Claims {
+	return jwt.Claims{
+		Issuer:   "https://issuer.example.com",
+		Subject:  "ci-pipeline",
+		Audience: jwt.Audience{"archivista"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func (ut *UTAuthSuite) jwtAuthenticator() *auth.JWTAuthenticator {
+	authenticator, err := auth.NewJWTAuthenticatorFromFile(ut.jwksFile, auth.WithIssuer("https://issuer.example.com"), auth.WithAudience("archivista"))
+	ut.Require().NoError(err)
+	return authenticator
+}
+
+func bearerRequest(token string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/v1/query", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+func (ut *UTAuthSuite) Test_Token() {
+	tokens, err := auth.NewTokenAuthenticator(auth.Token{Token: "secret", Subject: "ci", Roles: []string{"uploader"}})
+	ut.Require().NoError(err)
+
+	identity, err := tokens.Authenticate(bearerRequest("secret"))
+	ut.NoError(err)
+	ut.Equal("ci", identity.Subject)
+	ut.True(identity.HasRole("uploader"))
+
+	_, err = tokens.Authenticate(bearerRequest("wrong"))
+	ut.ErrorIs(err, auth.ErrNoCredentials)
+
+	_, err = tokens.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+	ut.ErrorIs(err, auth.ErrNoCredentials)
+}
+
+func (ut *UTAuthSuite) Test_TokenFile() {
+	path := filepath.Join(ut.T().TempDir(), "tokens.yaml")
+	ut.Require().NoError(os.WriteFile(path, []byte("tokens:\n  - token: secret\n    subject: auditor\n    roles: [auditor]\n    tenant: team-a\n"), 0o600))
+
+	tokens, err := auth.NewTokenAuthenticatorFromFile(path)
+	ut.Require().NoError(err)
+	identity, err := tokens.Authenticate(bearerRequest("secret"))
+	ut.NoError(err)
+	ut.Equal([]string{"auditor"}, identity.Roles)
+	ut.Equal("team-a", identity.Tenant)
+
+	ut.Require().NoError(os.WriteFile(path, []byte("tokens:\n  - token: secret\n"), 0o600))
+	_, err = auth.NewTokenAuthenticatorFromFile(path)
+	ut.ErrorContains(err, "must have a token and a subject")
+}
+
+func (ut *UTAuthSuite) Test_JWT() {
+	token := ut.signJWT(ut.key, ut.validClaims(), map[string]any{"groups": []string{"ci", "uploader"}})
+	identity, err := ut.jwtAuthenticator().Authenticate(bearerRequest(token))
+	ut.Require().NoError(err)
+	ut.Equal("ci-pipeline", identity.Subject)
+	ut.Equal("jwt", identity.Method)
+	ut.Equal([]string{"ci", "uploader"}, identity.Roles)
+}
+
+func (ut *UTAuthSuite) Test_JWT_Tenant() {
+	authenticator, err := auth.NewJWTAuthenticatorFromFile(ut.jwksFile, auth.WithTenantClaim("team"))
+	ut.Require().NoError(err)
+
+	identity, err := authenticator.Authenticate(bearerRequest(ut.signJWT(ut.key, ut.validClaims(), map[string]any{"team": "team-a"})))
+	ut.Require().NoError(err)
+	ut.Equal("team-a", identity.Tenant)
+
+	identity, err = authenticator.Authenticate(bearerRequest(ut.signJWT(ut.key, ut.validClaims(), nil)))
+	ut.Require().NoError(err)
+	ut.Empty(identity.Tenant)
+}
+
+func (ut *UTAuthSuite) Test_JWT_Invalid() {
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ut.Require().NoError(err)
+
+	expired := ut.validClaims()
+	expired.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	wrongAudience := ut.validClaims()
+	wrongAudience.Audience = jwt.Audience{"someone-else"}
+	wrongIssuer := ut.validClaims()
+	wrongIssuer.Issuer = "https://evil.example.com"
+	noExpiry := ut.validClaims()
+	noExpiry.Expiry = nil
+
+	for name, token := range map[string]string{
+		"untrusted key":  ut.signJWT(otherKey, ut.validClaims(), nil),
+		"expired":        ut.signJWT(ut.key, expired, nil),
+		"wrong audience": ut.signJWT(ut.key, wrongAudience, nil),
+		"wrong issuer":   ut.signJWT(ut.key, wrongIssuer, nil),
+		"no expiry":      ut.signJWT(ut.key, noExpiry, nil),
+		"not a jwt":      "opaque",
+	} {
+		_, err := ut.jwtAuthenticator().Authenticate(bearerRequest(token))
+		ut.ErrorContains(err, "invalid bearer token", name)
+	}
+}
+
+func (ut *UTAuthSuite) Test_MTLS() {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "builder", OrganizationalUnit: []string{"ci"}}}
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, VerifiedChains: [][]*x509.Certificate{{cert}}}
+
+	identity, err := auth.NewMTLSAuthenticator().Authenticate(r)
+	ut.Require().NoError(err)
+	ut.Equal("builder", identity.Subject)
+	ut.Equal([]string{"ci"}, identity.Roles)
+
+	r.TLS.VerifiedChains = nil
+	_, err = auth.NewMTLSAuthenticator().Authenticate(r)
+	ut.ErrorContains(err, "not verified")
+
+	_, err = auth.NewMTLSAuthenticator().Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+	ut.ErrorIs(err, auth.ErrNoCredentials)
+}
+
+func (ut *UTAuthSuite) Test_New_NoAuthenticators() {
+	_, err := auth.New()
+	ut.ErrorContains(err, "at least one authenticator is required")
+}
+
+func (ut *UTAuthSuite) Test_Require() {
+	tokens, err := auth.NewTokenAuthenticator(
+		auth.Token{Token: "ci-token", Subject: "ci", Roles: []string{"ci"}},
+		auth.Token{Token: "auditor-token", Subject: "auditor", Roles: []string{"auditor"}},
+	)
+	ut.Require().NoError(err)
+
+	a, err := auth.New(
+		auth.WithAuthenticators(tokens, ut.jwtAuthenticator()),
+		auth.WithPolicy(auth.Policy{auth.PermissionQuery: {"auditor"}}),
+	)
+	ut.Require().NoError(err)
+
+	var seen *auth.Identity
+	handler := a.Require(auth.PermissionQuery, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen, _ = auth.FromContext(r.Context())
+	}))
+
+	tests := []struct {
+		name    string
+		request *http.Request
+		status  int
+		code    string
+	}{
+		{"no credentials", httptest.NewRequest(http.MethodGet, "/v1/query", nil), http.StatusUnauthorized, "unauthenticated"},
+		{"invalid token", bearerRequest("nope"), http.StatusUnauthorized, "unauthenticated"},
+		{"wrong role", bearerRequest("ci-token"), http.StatusForbidden, "forbidden"},
+		{"allowed", bearerRequest("auditor-token"), http.StatusOK, ""},
+	}
+
+	for _, test := range tests {
+		seen = nil
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, test.request)
+		ut.Equal(test.status, w.Code, test.name)
+		if test.code == "" {
+			ut.Require().NotNil(seen, test.name)
+			ut.Equal("auditor", seen.Subject)
+			continue
+		}
+
+		ut.Nil(seen, test.name)
+		ut.Equal("application/json", w.Header().Get("Content-Type"), test.name)
+		resp := api.ErrorResponse{}
+		ut.NoError(json.Unmarshal(w.Body.Bytes(), &resp), test.name)
+		ut.Equal(test.code, resp.Error, test.name)
+	}
+}
+
+func (ut *UTAuthSuite) Test_Policy() {
+	policy := auth.Policy{auth.PermissionUpload: {"ci"}}
+	ci := &auth.Identity{Subject: "ci", Roles: []string{"ci"}}
+	other := &auth.Identity{Subject: "other"}
+
+	ut.True(policy.Allowed(ci, auth.PermissionUpload))
+	ut.False(policy.Allowed(other, auth.PermissionUpload))
+	ut.True(policy.Allowed(other, auth.PermissionDownload))
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+var supportedAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTAuthenticator authenticates requests that present a bearer JWT, such as an OIDC ID
+// token, signed by one of the keys in a JWKS
+type JWTAuthenticator struct {
+	keys        jose.JSONWebKeySet
+	issuer      string
+	audience    string
+	rolesClaim  string
+	tenantClaim string
+	leeway      time.Duration
+	now         func() time.Time
+}
+
+type JWTOption func(*JWTAuthenticator)
+
+// WithIssuer requires the token's iss claim to match issuer.
+func WithIssuer(issuer string) JWTOption {
+	return func(j *JWTAuthenticator) {
+		j.issuer = issuer
+	}
+}
+
+// WithAudience requires the token's aud claim to contain audience.
+func WithAudience(audience string) JWTOption {
+	return func(j *JWTAuthenticator) {
+		j.audience = audience
+	}
+}
+
+// WithTenantClaim binds the identity to the tenant named by claim, if the token has it.
+func WithTenantClaim(claim string) JWTOption {
+	return func(j *JWTAuthenticator) {
+		j.tenantClaim = claim
+	}
+}
+
+// WithRolesClaim sets the claim the identity's roles are read from. The claim may be a
+// string or a list of strings. Defaults to groups.
+func WithRolesClaim(claim string) JWTOption {
+	return func(j *JWTAuthenticator) {
+		j.rolesClaim = claim
+	}
+}
+
+func NewJWTAuthenticator(keys jose.JSONWebKeySet, opts ...JWTOption) (*JWTAuthenticator, error) {
+	if len(keys.Keys) == 0 {
+		return nil, errors.New("jwks contains no keys")
+	}
+
+	j := &JWTAuthenticator{
+		keys:       keys,
+		rolesClaim: "groups",
+		leeway:     jwt.DefaultLeeway,
+		now:        time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(j)
+	}
+
+	return j, nil
+}
+
+// NewJWTAuthenticatorFromFile trusts the keys in the JWKS file at path.
+func NewJWTAuthenticatorFromFile(path string, opts ...JWTOption) (*JWTAuthenticator, error) {
+	jwksBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read jwks file: %w", err)
+	}
+
+	keys := jose.JSONWebKeySet{}
+	if err := json.Unmarshal(jwksBytes, &keys); err != nil {
+		return nil, fmt.Errorf("could not parse jwks file: %w", err)
+	}
+
+	return NewJWTAuthenticator(keys, opts...)
+}
+
+func (j *JWTAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	raw, ok := bearerToken(r)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+
+	token, err := jwt.ParseSigned(raw, supportedAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bearer token: %w", err)
+	}
+
+	claims := jwt.Claims{}
+	custom := make(map[string]any)
+	if err := token.Claims(j.keys, &claims, &custom); err != nil {
+		return nil, fmt.Errorf("invalid bearer token: %w", err)
+	}
+
+	if claims.Expiry == nil {
+		return nil, errors.New("invalid bearer token: token has no expiry")
+	}
+
+	expected := jwt.Expected{Issuer: j.issuer, Time: j.now()}
+	if j.audience != "" {
+		expected.AnyAudience = jwt.Audience{j.audience}
+	}
+
+	if err := claims.ValidateWithLeeway(expected, j.leeway); err != nil {
+		return nil, fmt.Errorf("invalid bearer token: %w", err)
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("invalid bearer token: token has no subject")
+	}
+
+	identity := &Identity{Subject: claims.Subject, Method: "jwt", Roles: stringsClaim(custom[j.rolesClaim])}
+	if j.tenantClaim != "" {
+		identity.Tenant, _ = custom[j.tenantClaim].(string)
+	}
+
+	return identity, nil
+}
+
+func stringsClaim(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// MTLSAuthenticator authenticates requests by the client certificate verified during the
+// TLS handshake. The identity's subject is the certificate's common name, or its first URI
+// SAN if it has no common name, and its roles are the certificate's organizational units.
+type MTLSAuthenticator struct{}
+
+func NewMTLSAuthenticator() *MTLSAuthenticator {
+	return &MTLSAuthenticator{}
+}
+
+func (m *MTLSAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	// the server only asks for certificates when it's configured with client CAs, so a
+	// certificate that wasn't verified against them must not be trusted
+	if len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, errors.New("client certificate was not verified")
+	}
+
+	leaf := r.TLS.VerifiedChains[0][0]
+	subject := leaf.Subject.CommonName
+	if subject == "" && len(leaf.URIs) > 0 {
+		subject = leaf.URIs[0].String()
+	}
+
+	if subject == "" {
+		return nil, errors.New("client certificate has no common name or uri")
+	}
+
+	return &Identity{Subject: subject, Method: "mtls", Roles: leaf.Subject.OrganizationalUnit}, nil
+}
+
+// LoadCertPool returns a pool of the PEM encoded certificates in each of the files.
+func LoadCertPool(paths ...string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, path := range paths {
+		pemBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read certificates %s: %w", path, err)
+		}
+
+		if !pool.AppendCertsFromPEM(pemBytes) {
+			return nil, fmt.Errorf("no certificates found in %s", path)
+		}
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/sirupsen/logrus"
+)
+
+// Permission is what a route allows an identity to do
+type Permission string
+
+const (
+	PermissionUpload    Permission = "upload"
+	PermissionDownload  Permission = "download"
+	PermissionQuery     Permission = "query"
+	PermissionArtifacts Permission = "artifacts"
+	PermissionDelete    Permission = "delete"
+	PermissionHold      Permission = "hold"
+)
+
+// Policy maps each permission to the roles that grant it. A permission with no roles is
+// granted to any authenticated identity.
+type Policy map[Permission][]string
+
+// Allowed reports whether identity holds a role that grants permission.
+func (p Policy) Allowed(identity *Identity, permission Permission) bool {
+	roles := p[permission]
+	if len(roles) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(roles, identity.HasRole)
+}
+
+// Auth authenticates requests with the first authenticator that finds credentials it
+// understands and authorizes them against a Policy
+type Auth struct {
+	authenticators []Authenticator
+	policy         Policy
+}
+
+type Option func(*Auth)
+
+// WithAuthenticators adds authenticators, which are tried in order.
+func WithAuthenticators(authenticators ...Authenticator) Option {
+	return func(a *Auth) {
+		a.authenticators = append(a.authenticators, authenticators...)
+	}
+}
+
+// WithPolicy sets the roles each permission requires.
+func WithPolicy(policy Policy) Option {
+	return func(a *Auth) {
+		a.policy = policy
+	}
+}
+
+func New(opts ...Option) (*Auth, error) {
+	a := &Auth{policy: Policy{}}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if len(a.authenticators) == 0 {
+		return nil, errors.New("at least one authenticator is required")
+	}
+
+	return a, nil
+}
+
+// Authenticate returns the identity proven by the request's credentials.
+func (a *Auth) Authenticate(r *http.Request) (*Identity, error) {
+	for _, authenticator := range a.authenticators {
+		identity, err := authenticator.Authenticate(r)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return identity, nil
+	}
+
+	if r.Header.Get("Authorization") != "" {
+		return nil, errors.New("invalid credentials")
+	}
+
+	return nil, ErrNoCredentials
+}
+
+// Require wraps next so it is only called for requests from an identity granted
+// permission. Unauthenticated requests are rejected with 401 and unauthorized ones with
+// 403. The identity is available to next through FromContext.
+func (a *Auth) Require(permission Permission, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, err := a.Authenticate(r)
+		if errors.Is(err, ErrNoCredentials) {
+			writeError(w, http.StatusUnauthorized, "unauthenticated", "authentication required")
+			return
+		} else if err != nil {
+			logrus.Debugf("rejected request to %s: %+v", r.URL.Path, err)
+			writeError(w, http.StatusUnauthorized, "unauthenticated", err.Error())
+			return
+		}
+
+		if !a.policy.Allowed(identity, permission) {
+			logrus.Debugf("denied %s permission to %s", permission, identity.Subject)
+			writeError(w, http.StatusForbidden, "forbidden", fmt.Sprintf("%s is not allowed to %s", identity.Subject, permission))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), identity)))
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="archivista"`)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(api.ErrorResponse{Error: code, Message: message}); err != nil {
+		logrus.Errorf("failed to write error response: %+v", err)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Token is a static bearer token and the identity it grants
+type Token struct {
+	Token   string   `yaml:"token"`
+	Subject string   `yaml:"subject"`
+	Roles   []string `yaml:"roles"`
+	Tenant  string   `yaml:"tenant"`
+}
+
+type tokensFile struct {
+	Tokens []Token `yaml:"tokens"`
+}
+
+// TokenAuthenticator authenticates requests that present one of a fixed set of bearer tokens
+type TokenAuthenticator struct {
+	tokens []Token
+}
+
+func NewTokenAuthenticator(tokens ...Token) (*TokenAuthenticator, error) {
+	for _, token := range tokens {
+		if token.Token == "" || token.Subject == "" {
+			return nil, errors.New("tokens must have a token and a subject")
+		}
+	}
+
+	return &TokenAuthenticator{tokens: tokens}, nil
+}
+
+// NewTokenAuthenticatorFromFile loads tokens from a yaml file of the form
+//
+//	tokens:
+//	  - token: <secret>
+//	    subject: ci
+//	    roles: [uploader]
+//	    tenant: team-a
+func NewTokenAuthenticatorFromFile(path string) (*TokenAuthenticator, error) {
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read tokens file: %w", err)
+	}
+
+	file := tokensFile{}
+	if err := yaml.Unmarshal(fileBytes, &file); err != nil {
+		return nil, fmt.Errorf("could not parse tokens file: %w", err)
+	}
+
+	return NewTokenAuthenticator(file.Tokens...)
+}
+
+func (t *TokenAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	presented, ok := bearerToken(r)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+
+	// compare digests so the comparison takes the same time regardless of the tokens' lengths
+	presentedDigest := sha256.Sum256([]byte(presented))
+	for _, token := range t.tokens {
+		digest := sha256.Sum256([]byte(token.Token))
+		if subtle.ConstantTimeCompare(presentedDigest[:], digest[:]) == 1 {
+			return &Identity{Subject: token.Subject, Method: "token", Roles: token.Roles, Tenant: token.Tenant}, nil
+		}
+	}
+
+	// the token may be a JWT meant for another authenticator
+	return nil, ErrNoCredentials
+}
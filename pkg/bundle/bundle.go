@@ -0,0 +1,269 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle unpacks uploads that wrap one or more DSSE envelopes, such as
+// in-toto JSON Lines bundles and Sigstore bundles, and keeps the verification
+// material that travels with them.
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/in-toto/go-witness/dsse"
+)
+
+// SigstoreMediaTypePrefix is the prefix of the media type of every Sigstore bundle version
+const SigstoreMediaTypePrefix = "application/vnd.dev.sigstore.bundle"
+
+var (
+	// ErrNotBundle is returned by Unpack when the upload is a single DSSE envelope rather than a bundle
+	ErrNotBundle = errors.New("upload is not a bundle")
+	// ErrInvalidBundle is returned by Unpack when the upload looks like a bundle but can't be unpacked
+	ErrInvalidBundle = errors.New("invalid bundle")
+)
+
+// TlogEntry is a transparency log entry that proves an envelope was logged
+type TlogEntry struct {
+	LogIndex       int64
+	LogID          string
+	Kind           string
+	KindVersion    string
+	IntegratedTime time.Time
+	// Entry is the entry as it appeared in the bundle, including its inclusion proof and promise
+	Entry json.RawMessage
+}
+
+// Material is the verification material a bundle carried for an envelope beyond what fits in the envelope itself
+type Material struct {
+	TlogEntries []TlogEntry
+}
+
+// Envelope is a DSSE envelope unpacked from a bundle along with its material
+type Envelope struct {
+	Envelope []byte
+	Material Material
+}
+
+type materialKey struct{}
+
+// NewContext returns a copy of ctx that carries the material of an unpacked envelope.
+func NewContext(ctx context.Context, material Material) context.Context {
+	return context.WithValue(ctx, materialKey{}, material)
+}
+
+// FromContext returns the material stored in ctx, if any.
+func FromContext(ctx context.Context) (Material, bool) {
+	material, ok := ctx.Value(materialKey{}).(Material)
+	return material, ok
+}
+
+// probe holds just enough of a JSON value to tell what kind of upload it is
+type probe struct {
+	MediaType   string `json:"mediaType"`
+	PayloadType string `json:"payloadType"`
+}
+
+func (p probe) isSigstore() bool {
+	return strings.HasPrefix(p.MediaType, SigstoreMediaTypePrefix)
+}
+
+// Unpack reads a Sigstore bundle or a JSON Lines bundle of DSSE envelopes and Sigstore bundles
+// from r. Sigstore bundles are converted to DSSE envelopes with their certificates and RFC3161
+// timestamps attached to the signatures, and raw DSSE envelopes in a JSON Lines bundle are kept
+// byte for byte. ErrNotBundle is returned if r holds anything else, such as a single DSSE envelope.
+func Unpack(r io.ReadSeeker) ([]Envelope, error) {
+	dec := json.NewDecoder(r)
+	first := probe{}
+	if err := dec.Decode(&first); err != nil {
+		return nil, ErrNotBundle
+	}
+
+	if !first.isSigstore() && !dec.More() {
+		return nil, ErrNotBundle
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	envelopes := make([]Envelope, 0)
+	dec = json.NewDecoder(r)
+	for line := 1; ; line++ {
+		raw := json.RawMessage{}
+		if err := dec.Decode(&raw); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: entry %d: %v", ErrInvalidBundle, line, err)
+		}
+
+		envelope, err := unpackEntry(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: entry %d: %v", ErrInvalidBundle, line, err)
+		}
+
+		envelopes = append(envelopes, envelope)
+	}
+
+	return envelopes, nil
+}
+
+func unpackEntry(raw json.RawMessage) (Envelope, error) {
+	p := probe{}
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return Envelope{}, err
+	}
+
+	switch {
+	case p.isSigstore():
+		return unpackSigstore(raw)
+	case p.PayloadType != "":
+		return Envelope{Envelope: raw}, nil
+	default:
+		return Envelope{}, errors.New("neither a dsse envelope nor a sigstore bundle")
+	}
+}
+
+type sigstoreBundle struct {
+	MediaType            string                       `json:"mediaType"`
+	VerificationMaterial sigstoreVerificationMaterial `json:"verificationMaterial"`
+	DsseEnvelope         *dsse.Envelope               `json:"dsseEnvelope"`
+}
+
+type sigstoreVerificationMaterial struct {
+	Certificate          *sigstoreCertificate `json:"certificate"`
+	X509CertificateChain *struct {
+		Certificates []sigstoreCertificate `json:"certificates"`
+	} `json:"x509CertificateChain"`
+	TlogEntries               []json.RawMessage `json:"tlogEntries"`
+	TimestampVerificationData *struct {
+		Rfc3161Timestamps []struct {
+			SignedTimestamp []byte `json:"signedTimestamp"`
+		} `json:"rfc3161Timestamps"`
+	} `json:"timestampVerificationData"`
+}
+
+type sigstoreCertificate struct {
+	RawBytes []byte `json:"rawBytes"`
+}
+
+type sigstoreTlogEntry struct {
+	LogIndex protoInt64 `json:"logIndex"`
+	LogID    struct {
+		KeyID []byte `json:"keyId"`
+	} `json:"logId"`
+	KindVersion struct {
+		Kind    string `json:"kind"`
+		Version string `json:"version"`
+	} `json:"kindVersion"`
+	IntegratedTime protoInt64 `json:"integratedTime"`
+}
+
+// protoInt64 is an int64 from protobuf's JSON mapping, which writes them as strings
+type protoInt64 int64
+
+func (i *protoInt64) UnmarshalJSON(data []byte) error {
+	n, err := strconv.ParseInt(string(bytes.Trim(data, `"`)), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*i = protoInt64(n)
+	return nil
+}
+
+func unpackSigstore(raw json.RawMessage) (Envelope, error) {
+	b := sigstoreBundle{}
+	if err := json.Unmarshal(raw, &b); err != nil {
+		return Envelope{}, err
+	}
+
+	if b.DsseEnvelope == nil {
+		return Envelope{}, errors.New("sigstore bundle does not contain a dsse envelope")
+	}
+
+	material := b.VerificationMaterial
+	var chain [][]byte
+	if material.Certificate != nil {
+		chain = append(chain, material.Certificate.RawBytes)
+	} else if material.X509CertificateChain != nil {
+		for _, cert := range material.X509CertificateChain.Certificates {
+			chain = append(chain, cert.RawBytes)
+		}
+	}
+
+	var timestamps []dsse.SignatureTimestamp
+	if material.TimestampVerificationData != nil {
+		for _, ts := range material.TimestampVerificationData.Rfc3161Timestamps {
+			timestamps = append(timestamps, dsse.SignatureTimestamp{Type: dsse.TimestampRFC3161, Data: ts.SignedTimestamp})
+		}
+	}
+
+	// a sigstore bundle carries its material once for the whole envelope, and the leaf
+	// certificate comes first in the chain followed by any intermediates
+	envelope := b.DsseEnvelope
+	for i := range envelope.Signatures {
+		if len(chain) > 0 {
+			envelope.Signatures[i].Certificate = pemCertificate(chain[0])
+			envelope.Signatures[i].Intermediates = nil
+			for _, intermediate := range chain[1:] {
+				envelope.Signatures[i].Intermediates = append(envelope.Signatures[i].Intermediates, pemCertificate(intermediate))
+			}
+		}
+
+		envelope.Signatures[i].Timestamps = append(envelope.Signatures[i].Timestamps, timestamps...)
+	}
+
+	tlogEntries := make([]TlogEntry, 0, len(material.TlogEntries))
+	for _, rawEntry := range material.TlogEntries {
+		entry := sigstoreTlogEntry{}
+		if err := json.Unmarshal(rawEntry, &entry); err != nil {
+			return Envelope{}, fmt.Errorf("could not parse tlog entry: %w", err)
+		}
+
+		tlogEntry := TlogEntry{
+			LogIndex:    int64(entry.LogIndex),
+			LogID:       hex.EncodeToString(entry.LogID.KeyID),
+			Kind:        entry.KindVersion.Kind,
+			KindVersion: entry.KindVersion.Version,
+			Entry:       rawEntry,
+		}
+
+		if entry.IntegratedTime > 0 {
+			tlogEntry.IntegratedTime = time.Unix(int64(entry.IntegratedTime), 0).UTC()
+		}
+
+		tlogEntries = append(tlogEntries, tlogEntry)
+	}
+
+	envelopeBytes, err := json.Marshal(envelope)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{Envelope: envelopeBytes, Material: Material{TlogEntries: tlogEntries}}, nil
+}
+
+func pemCertificate(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/in-toto/archivista/pkg/bundle"
+	"github.com/in-toto/go-witness/dsse"
+	"github.com/stretchr/testify/suite"
+)
+
+const rawEnvelope = `{"payloadType":"application/vnd.in-toto+json","payload":"e30=","signatures":[{"keyid":"test","sig":"c2ln"}]}`
+
+// Test Suite: UT Bundle
+type UTBundleSuite struct {
+	suite.Suite
+}
+
+func TestUTBundleSuite(t *testing.T) {
+	suite.Run(t, new(UTBundleSuite))
+}
+
+func b64(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+func (ut *UTBundleSuite) sigstoreBundle(verificationMaterial map[string]any) string {
+	b, err := json.Marshal(map[string]any{
+		"mediaType":            "application/vnd.dev.sigstore.bundle.v0.3+json",
+		"verificationMaterial": verificationMaterial,
+		"dsseEnvelope": map[string]any{
+			"payloadType": "application/vnd.in-toto+json",
+			"payload":     b64("{}"),
+			"signatures":  []map[string]any{{"sig": b64("sig"), "keyid": ""}},
+		},
+	})
+	ut.Require().NoError(err)
+	return string(b)
+}
+
+func (ut *UTBundleSuite) unpackOne(content string) (dsse.Envelope, bundle.Material) {
+	envelopes, err := bundle.Unpack(strings.NewReader(content))
+	ut.Require().NoError(err)
+	ut.Require().Len(envelopes, 1)
+
+	envelope := dsse.Envelope{}
+	ut.Require().NoError(json.Unmarshal(envelopes[0].Envelope, &envelope))
+	return envelope, envelopes[0].Material
+}
+
+func (ut *UTBundleSuite) Test_Unpack_SingleEnvelope() {
+	_, err := bundle.Unpack(strings.NewReader(rawEnvelope + "\n"))
+	ut.ErrorIs(err, bundle.ErrNotBundle)
+}
+
+func (ut *UTBundleSuite) Test_Unpack_NotJSON() {
+	_, err := bundle.Unpack(strings.NewReader("fakeTestData"))
+	ut.ErrorIs(err, bundle.ErrNotBundle)
+}
+
+func (ut *UTBundleSuite) Test_Unpack_Jsonl() {
+	other := `{"payloadType":"application/vnd.in-toto+json","payload":"e30=","signatures":[]}`
+	sigstore := ut.sigstoreBundle(map[string]any{})
+	envelopes, err := bundle.Unpack(strings.NewReader(rawEnvelope + "\n" + other + "\n" + sigstore + "\n"))
+	ut.Require().NoError(err)
+	ut.Require().Len(envelopes, 3)
+	ut.Equal(rawEnvelope, string(envelopes[0].Envelope))
+	ut.Equal(other, string(envelopes[1].Envelope))
+	ut.Contains(string(envelopes[2].Envelope), `"payloadType":"application/vnd.in-toto+json"`)
+}
+
+func (ut *UTBundleSuite) Test_Unpack_JsonlInvalidLine() {
+	_, err := bundle.Unpack(strings.NewReader(rawEnvelope + "\n" + `{"hello":"world"}`))
+	ut.ErrorIs(err, bundle.ErrInvalidBundle)
+	ut.ErrorContains(err, "entry 2")
+}
+
+func (ut *UTBundleSuite) Test_Unpack_JsonlTruncated() {
+	_, err := bundle.Unpack(strings.NewReader(rawEnvelope + "\n" + `{"payloadType":`))
+	ut.ErrorIs(err, bundle.ErrInvalidBundle)
+}
+
+func (ut *UTBundleSuite) Test_Unpack_Sigstore() {
+	content := ut.sigstoreBundle(map[string]any{
+		"certificate": map[string]any{"rawBytes": b64("leaf")},
+		"tlogEntries": []map[string]any{{
+			"logIndex":          "25579",
+			"logId":             map[string]any{"keyId": base64.StdEncoding.EncodeToString([]byte{0xc0, 0xd2})},
+			"kindVersion":       map[string]any{"kind": "dsse", "version": "0.0.1"},
+			"integratedTime":    "1700000000",
+			"canonicalizedBody": b64("body"),
+		}},
+		"timestampVerificationData": map[string]any{
+			"rfc3161Timestamps": []map[string]any{{"signedTimestamp": b64("tsr")}},
+		},
+	})
+
+	envelope, material := ut.unpackOne(content)
+	ut.Equal("application/vnd.in-toto+json", envelope.PayloadType)
+	ut.Equal([]byte("{}"), envelope.Payload)
+	ut.Require().Len(envelope.Signatures, 1)
+
+	sig := envelope.Signatures[0]
+	ut.Equal([]byte("sig"), sig.Signature)
+	block, _ := pem.Decode(sig.Certificate)
+	ut.Require().NotNil(block)
+	ut.Equal("CERTIFICATE", block.Type)
+	ut.Equal([]byte("leaf"), block.Bytes)
+	ut.Empty(sig.Intermediates)
+	ut.Equal([]dsse.SignatureTimestamp{{Type: dsse.TimestampRFC3161, Data: []byte("tsr")}}, sig.Timestamps)
+
+	ut.Require().Len(material.TlogEntries, 1)
+	entry := material.TlogEntries[0]
+	ut.Equal(int64(25579), entry.LogIndex)
+	ut.Equal("c0d2", entry.LogID)
+	ut.Equal("dsse", entry.Kind)
+	ut.Equal("0.0.1", entry.KindVersion)
+	ut.Equal(time.Unix(1700000000, 0).UTC(), entry.IntegratedTime)
+	ut.Contains(string(entry.Entry), `"canonicalizedBody"`)
+}
+
+func (ut *UTBundleSuite) Test_Unpack_SigstoreCertificateChain() {
+	content := ut.sigstoreBundle(map[string]any{
+		"x509CertificateChain": map[string]any{
+			"certificates": []map[string]any{{"rawBytes": b64("leaf")}, {"rawBytes": b64("intermediate")}},
+		},
+		"tlogEntries": []map[string]any{{"logIndex": 7, "logId": map[string]any{"keyId": b64("log")}}},
+	})
+
+	envelope, material := ut.unpackOne(content)
+	sig := envelope.Signatures[0]
+	leaf, _ := pem.Decode(sig.Certificate)
+	ut.Require().NotNil(leaf)
+	ut.Equal([]byte("leaf"), leaf.Bytes)
+	ut.Require().Len(sig.Intermediates, 1)
+	intermediate, _ := pem.Decode(sig.Intermediates[0])
+	ut.Require().NotNil(intermediate)
+	ut.Equal([]byte("intermediate"), intermediate.Bytes)
+
+	ut.Require().Len(material.TlogEntries, 1)
+	ut.Equal(int64(7), material.TlogEntries[0].LogIndex)
+	ut.True(material.TlogEntries[0].IntegratedTime.IsZero())
+}
+
+func (ut *UTBundleSuite) Test_Unpack_SigstoreMessageSignature() {
+	content := `{"mediaType":"application/vnd.dev.sigstore.bundle+json;version=0.2","verificationMaterial":{},"messageSignature":{"signature":"c2ln"}}`
+	_, err := bundle.Unpack(strings.NewReader(content))
+	ut.ErrorIs(err, bundle.ErrInvalidBundle)
+	ut.ErrorContains(err, "does not contain a dsse envelope")
+}
+
+func (ut *UTBundleSuite) Test_Context() {
+	_, ok := bundle.FromContext(context.Background())
+	ut.False(ok)
+
+	material := bundle.Material{TlogEntries: []bundle.TlogEntry{{LogIndex: 1, LogID: "log"}}}
+	fromCtx, ok := bundle.FromContext(bundle.NewContext(context.Background(), material))
+	ut.True(ok)
+	ut.Equal(material, fromCtx)
+}
@@ -30,14 +30,50 @@ type Config struct {
 	WriteTimeout     int      `default:"120" desc:"HTTP write timeout in seconds" split_words:"true"`
 	LogLevel         string   `default:"INFO" desc:"Log level" split_words:"true"`
 	CORSAllowOrigins []string `default:"" desc:"Comma separated list of origins to allow CORS requests from" split_words:"true"`
+	MaxUploadSize    int64    `default:"0" desc:"Maximum size in bytes of an uploaded envelope. 0 disables the limit" split_words:"true"`
+
+	UploadBatchConcurrency int `default:"4" desc:"Number of envelopes of a batch upload stored concurrently" split_words:"true"`
 
 	EnableTLS bool   `default:"FALSE" desc:"Enables TLS on the Archivista server" split_words:"true"`
 	TLSCert   string `default:"" desc:"Path to the file containing the TLS Certificate" split_words:"true"`
 	TLSKey    string `default:"" desc:"Path to the file containing the TLS Key" split_words:"true"`
 
-	EnableSPIFFE          bool   `default:"TRUE" desc:"*** Enable SPIFFE support" split_words:"true"`
-	SPIFFEAddress         string `default:"unix:///tmp/spire-agent/public/api.sock" desc:"SPIFFE server address" split_words:"true"`
-	SPIFFETrustedServerId string `default:"" desc:"Trusted SPIFFE server ID; defaults to any" split_words:"true"`
+	EnableSPIFFE        bool     `default:"FALSE" desc:"*** Serve mTLS with an X.509 SVID from the SPIFFE Workload API" split_words:"true"`
+	SPIFFEAddress       string   `default:"unix:///tmp/spire-agent/public/api.sock" desc:"SPIFFE Workload API address" split_words:"true"`
+	SPIFFEAuthorizedIds []string `default:"" desc:"Comma separated list of SPIFFE IDs allowed to connect; defaults to any ID in the server's trust domain" split_words:"true"`
+
+	EnableSignatureVerification        bool     `default:"FALSE" desc:"*** Reject uploaded envelopes that are not signed by a trusted key or certificate" split_words:"true"`
+	SignatureVerificationKeys          []string `default:"" desc:"Comma separated list of paths to PEM encoded public keys trusted to sign uploads" split_words:"true"`
+	SignatureVerificationRoots         []string `default:"" desc:"Comma separated list of paths to PEM encoded root certificates trusted to sign uploads" split_words:"true"`
+	SignatureVerificationIntermediates []string `default:"" desc:"Comma separated list of paths to PEM encoded intermediate certificates used to build chains" split_words:"true"`
+
+	EnableAuth         bool     `default:"FALSE" desc:"*** Require requests to the API to authenticate" split_words:"true"`
+	AuthTokensFile     string   `default:"" desc:"Path to a yaml file of static bearer tokens and the subject and roles each grants" split_words:"true"`
+	AuthJWKSFile       string   `default:"" desc:"Path to a JWKS file whose keys are trusted to sign bearer JWTs" split_words:"true"`
+	AuthJWTIssuer      string   `default:"" desc:"Issuer bearer JWTs must be issued by. Empty accepts any issuer" split_words:"true"`
+	AuthJWTAudience    string   `default:"" desc:"Audience bearer JWTs must be issued for. Empty accepts any audience" split_words:"true"`
+	AuthJWTRolesClaim  string   `default:"groups" desc:"Claim of bearer JWTs to read roles from" split_words:"true"`
+	AuthJWTTenantClaim string   `default:"tenant" desc:"Claim of bearer JWTs naming the tenant the caller is bound to" split_words:"true"`
+	AuthClientCAFiles  []string `default:"" desc:"Comma separated list of paths to PEM encoded CA certificates trusted to issue client certificates. Requires TLS" split_words:"true"`
+	AuthUploadRoles    []string `default:"" desc:"Comma separated list of roles allowed to upload. Empty allows any authenticated identity" split_words:"true"`
+	AuthDownloadRoles  []string `default:"" desc:"Comma separated list of roles allowed to download. Empty allows any authenticated identity" split_words:"true"`
+	AuthQueryRoles     []string `default:"" desc:"Comma separated list of roles allowed to query GraphQL. Empty allows any authenticated identity" split_words:"true"`
+	AuthArtifactRoles  []string `default:"" desc:"Comma separated list of roles allowed to use the artifact endpoints. Empty allows any authenticated identity" split_words:"true"`
+	AuthDeleteRoles    []string `default:"" desc:"Comma separated list of roles allowed to delete envelopes. Empty allows any authenticated identity" split_words:"true"`
+	AuthHoldRoles      []string `default:"" desc:"Comma separated list of roles allowed to place and release legal holds. Empty allows any authenticated identity" split_words:"true"`
+
+	EnableMultiTenancy bool   `default:"FALSE" desc:"*** Scope stored attestations and queries to the caller's tenant" split_words:"true"`
+	TenantHeader       string `default:"X-Archivista-Tenant" desc:"Header callers that are not bound to a tenant name their tenant with" split_words:"true"`
+
+	EnableDelete           bool          `default:"FALSE" desc:"*** Enable the endpoint to delete envelopes and their metadata" split_words:"true"`
+	EnableLegalHold        bool          `default:"FALSE" desc:"*** Enable the endpoints to place and release legal holds. Requires the SQL store" split_words:"true"`
+	EnableRetention        bool          `default:"FALSE" desc:"*** Periodically delete envelopes that match a retention rule. Requires the SQL store" split_words:"true"`
+	RetentionRulesFile     string        `default:"/tmp/archivista/retention.yaml" desc:"Location of the retention rules" split_words:"true"`
+	RetentionSweepInterval time.Duration `default:"1h" desc:"How often to delete envelopes that match a retention rule" split_words:"true"`
+
+	EnableMetrics bool `default:"FALSE" desc:"*** Serve prometheus metrics on /metrics" split_words:"true"`
+
+	EnableFileMirror bool `default:"FALSE" desc:"*** Serve envelopes read-only on /v1/files/{gitoid}.json, authorized like downloads" split_words:"true"`
 
 	EnableSQLStore                bool          `default:"TRUE" desc:"*** Enable SQL Metadata store. If disabled, GraphQL will also be disabled ***" split_words:"true"`
 	SQLStoreConnectionString      string        `default:"root:example@tcp(db)/testify" desc:"SQL store connection string" split_words:"true"`
@@ -46,9 +82,10 @@ type Config struct {
 	SQLStoreMaxOpenConnections    int           `default:"100" desc:"Maximum number of open connections to the database" split_words:"true"`
 	SQLStoreConnectionMaxLifetime time.Duration `default:"3m" desc:"Maximum amount of time a connection may be reused" split_words:"true"`
 
-	StorageBackend             string `default:"" desc:"Backend to use for attestation storage. Options are FILE, BLOB, or empty string for disabled." split_words:"true"`
-	FileServeOn                string `default:"" desc:"What address to serve files on. Only valid when using FILE storage backend." split_words:"true"`
+	StorageBackend             string `default:"" desc:"Backend to use for attestation storage. Options are FILE, BLOB, GCS, AZURE, any other registered backend, or empty string for disabled." split_words:"true"`
+	FileServeOn                string `default:"" desc:"Deprecated: use ENABLE_FILE_MIRROR. Ignored" split_words:"true"`
 	FileDir                    string `default:"/tmp/archivista/" desc:"Directory to store and serve files. Only valid when using FILE storage backend." split_words:"true"`
+	FileFsync                  bool   `default:"FALSE" desc:"Sync envelopes to disk before uploads complete. Only valid when using FILE storage backend." split_words:"true"`
 	BlobStoreEndpoint          string `default:"127.0.0.1:9000" desc:"URL endpoint for blob storage. Only valid when using BLOB storage backend." split_words:"true"`
 	BlobStoreCredentialType    string `default:"ACCESS_KEY" desc:"Blob store credential type. Options are IAM or ACCESS_KEY" split_words:"true"`
 	BlobStoreAccessKeyId       string `default:"" desc:"Blob store access key id. Only valid when using BLOB storage backend." split_words:"true"`
@@ -56,19 +93,44 @@ type Config struct {
 	BlobStoreUseTLS            bool   `default:"TRUE" desc:"Use TLS for BLOB storage backend. Only valid when using BLOB storage backend." split_words:"true"`
 	BlobStoreBucketName        string `default:"" desc:"Bucket to use for storage.  Only valid when using BLOB storage backend." split_words:"true"`
 
+	BlobStoreLegalHolds      bool          `default:"FALSE" desc:"Mirror legal holds onto S3 object-lock legal holds. The bucket must have object locking enabled" split_words:"true"`
+	BlobStoreRetentionMode   string        `default:"GOVERNANCE" desc:"S3 object-lock retention mode of uploaded envelopes. Options are GOVERNANCE or COMPLIANCE" split_words:"true"`
+	BlobStoreRetentionPeriod time.Duration `default:"0" desc:"How long uploaded envelopes are locked by S3 object-lock retention. 0 disables retention" split_words:"true"`
+
+	GCSBucketName      string `default:"" desc:"Bucket to use for storage. Only valid when using GCS storage backend." split_words:"true"`
+	GCSEndpoint        string `default:"" desc:"Endpoint of the Cloud Storage JSON API. Defaults to Google Cloud Storage. Only valid when using GCS storage backend." split_words:"true"`
+	GCSCredentialsFile string `default:"" desc:"Service account key file. Application default credentials are used when empty. Only valid when using GCS storage backend." split_words:"true"`
+
+	AzureBlobAccountURL       string `default:"" desc:"URL of the Azure storage account, authenticated with the default Azure credential chain. Only valid when using AZURE storage backend." split_words:"true"`
+	AzureBlobConnectionString string `default:"" desc:"Azure storage connection string. Used instead of the account URL when set. Only valid when using AZURE storage backend." split_words:"true"`
+	AzureBlobContainerName    string `default:"" desc:"Container to use for storage. Only valid when using AZURE storage backend." split_words:"true"`
+
+	StorageCompression string `default:"" desc:"Compress envelopes at rest in the object store. Options are ZSTD, GZIP, or empty string for none." split_words:"true"`
+
+	StorageEncryptionKeyProvider string `default:"" desc:"Encrypt envelopes at rest with data keys wrapped by this key provider. Options are KEYFILE, or empty string for no encryption." split_words:"true"`
+	StorageEncryptionKeyFile     string `default:"" desc:"Path to the JSON file holding the keys data keys are wrapped with. Only valid when using KEYFILE key provider." split_words:"true"`
+
 	EnableGraphql          bool `default:"TRUE" desc:"*** Enable GraphQL Endpoint. If GraphQL is disabled, Archivista will be unable to be used by Witness to verify policies" split_words:"true"`
 	GraphqlWebClientEnable bool `default:"TRUE" desc:"Enable GraphiQL, the GraphQL web client" split_words:"true"`
 
 	EnableArtifactStore bool   `default:"FALSE" desc:"*** Enable Artifact Store Endpoints" split_words:"true"`
 	ArtifactStoreConfig string `default:"/tmp/artifacts/config.yaml" desc:"Location of the config describing available artifacts" split_words:"true"`
 
-	Publisher                  []string `default:"" desc:"Publisher to use. Options are DAPR, RSTUF or empty string for disabled." split_words:"true"`
-	PublisherDaprHost          string   `default:"http://127.0.0.1" desc:"Host for Dapr" split_words:"true"`
-	PublisherDaprPort          string   `default:"3500" desc:"Port for Dapr" split_words:"true"`
-	PublisherDaprURL           string   `default:"" desc:"URL for Dapr" split_words:"true"`
-	PublisherDaprComponentName string   `default:"archivista" desc:"Dapr pubsub component name" split_words:"true"`
-	PublisherDaprTopic         string   `default:"attestations" desc:"Dapr pubsub topic" split_words:"true"`
-	PublisherRstufHost         string   `default:"http://127.0.0.1" desc:"Host for RSTUF" split_words:"true"`
+	Publisher                  []string      `default:"" desc:"Publisher to use. Options are DAPR, RSTUF or empty string for disabled." split_words:"true"`
+	PublisherDaprHost          string        `default:"http://127.0.0.1" desc:"Host for Dapr" split_words:"true"`
+	PublisherDaprPort          string        `default:"3500" desc:"Port for Dapr" split_words:"true"`
+	PublisherDaprURL           string        `default:"" desc:"URL for Dapr" split_words:"true"`
+	PublisherDaprComponentName string        `default:"archivista" desc:"Dapr pubsub component name" split_words:"true"`
+	PublisherDaprTopic         string        `default:"attestations" desc:"Dapr pubsub topic" split_words:"true"`
+	PublisherRstufHost         string        `default:"http://127.0.0.1" desc:"Host for RSTUF" split_words:"true"`
+	PublisherRstufPollInterval time.Duration `default:"10s" desc:"How often to check RSTUF for the outcome of publishing tasks. Only used when the SQL store is enabled" split_words:"true"`
+	PublisherRstufTaskTimeout  time.Duration `default:"1h" desc:"How long an RSTUF task may run before its publication is recorded as failed. 0 waits forever" split_words:"true"`
+
+	PublisherWorkers             int           `default:"4" desc:"Number of deliveries to publishers attempted concurrently. Only used when the SQL and object stores are enabled" split_words:"true"`
+	PublisherPollInterval        time.Duration `default:"5s" desc:"How often to check for deliveries to publishers that are due" split_words:"true"`
+	PublisherMaxAttempts         int           `default:"10" desc:"Number of attempts before a delivery to a publisher is dead lettered. 0 retries forever" split_words:"true"`
+	PublisherRetryInitialBackoff time.Duration `default:"1s" desc:"Delay before retrying a failed delivery to a publisher. Doubles on each failure" split_words:"true"`
+	PublisherRetryMaxBackoff     time.Duration `default:"10m" desc:"Maximum delay between retries of a failed delivery to a publisher" split_words:"true"`
 }
 
 // Process reads config from env
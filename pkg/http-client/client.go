@@ -26,12 +26,16 @@ import (
 
 	"github.com/in-toto/archivista/pkg/api"
 	"github.com/in-toto/go-witness/dsse"
+	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
+	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
 )
 
 type ArchivistaClient struct {
 	BaseURL        string
 	GraphQLURL     string
 	requestHeaders http.Header
+	tenant         string
 	*http.Client
 }
 
@@ -45,12 +49,37 @@ func WithHeaders(h http.Header) Option {
 	}
 }
 
+// WithTenant scopes requests to tenant on servers with multi-tenancy enabled
+func WithTenant(tenant string) Option {
+	return func(ac *ArchivistaClient) {
+		ac.tenant = tenant
+	}
+}
+
+// WithSVID presents the X.509 SVID from svids to the server and only trusts servers with an
+// SVID from bundles allowed by authorizer. Sources from the Workload API, such as a
+// workloadapi.X509Source, keep the SVID up to date as it rotates.
+func WithSVID(svids x509svid.Source, bundles x509bundle.Source, authorizer tlsconfig.Authorizer) Option {
+	return func(ac *ArchivistaClient) {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsconfig.MTLSClientConfig(svids, bundles, authorizer)
+
+		client := *ac.Client
+		client.Transport = transport
+		ac.Client = &client
+	}
+}
+
 func (ac *ArchivistaClient) requestOptions() []api.RequestOption {
 	opts := []api.RequestOption{}
 	if ac.requestHeaders != nil {
 		opts = append(opts, api.WithHeaders(ac.requestHeaders))
 	}
 
+	if ac.tenant != "" {
+		opts = append(opts, api.WithTenant(ac.tenant))
+	}
+
 	return opts
 }
 
@@ -67,6 +96,15 @@ type HttpClienter interface {
 	GraphQLQueryReadCloser(ctx context.Context, query string, variables interface{}) (io.ReadCloser, error)
 }
 
+// HttpRetentionClienter deletes envelopes and manages their legal holds. It is kept apart from
+// HttpClienter so that existing implementations of that interface don't break; ArchivistaClient
+// implements both.
+type HttpRetentionClienter interface {
+	Delete(ctx context.Context, gitoid string) (api.DeleteResponse, error)
+	Hold(ctx context.Context, hold api.HoldRequest) (api.HoldResponse, error)
+	ReleaseHold(ctx context.Context, id string) (api.HoldResponse, error)
+}
+
 func CreateArchivistaClient(httpClient *http.Client, baseURL string, opts ...Option) (*ArchivistaClient, error) {
 	client := &ArchivistaClient{
 		BaseURL: baseURL,
@@ -95,8 +133,16 @@ func (ac *ArchivistaClient) DownloadDSSE(ctx context.Context, gitoid string) (ds
 	if err != nil {
 		return dsse.Envelope{}, err
 	}
+
+	defer reader.Close()
+	// read to the end so the envelope is checked against its gitoid before it is decoded
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return dsse.Envelope{}, err
+	}
+
 	env := dsse.Envelope{}
-	if err := json.NewDecoder(reader).Decode(&env); err != nil {
+	if err := json.Unmarshal(content, &env); err != nil {
 		return dsse.Envelope{}, err
 	}
 	return env, nil
@@ -110,12 +156,46 @@ func (ac *ArchivistaClient) DownloadWithWriter(ctx context.Context, gitoid strin
 	return api.DownloadWithWriterWithHTTPClient(ctx, ac.Client, ac.BaseURL, gitoid, dst, ac.requestOptions()...)
 }
 
+// DownloadMany downloads the envelopes with gitoids in one request. Each result holds the
+// envelope or the reason it could not be downloaded.
+func (ac *ArchivistaClient) DownloadMany(ctx context.Context, gitoids []string) ([]api.DownloadManyResult, error) {
+	return api.DownloadManyWithHTTPClient(ctx, ac.Client, ac.BaseURL, gitoids, ac.requestOptions()...)
+}
+
+// Exists reports whether an envelope with gitoid is stored, without downloading it
+func (ac *ArchivistaClient) Exists(ctx context.Context, gitoid string) (bool, error) {
+	return api.ExistsWithHTTPClient(ctx, ac.Client, ac.BaseURL, gitoid, ac.requestOptions()...)
+}
+
+// DownloadRange streams length bytes of the envelope with gitoid starting at offset. The
+// bytes are not verified against the gitoid.
+func (ac *ArchivistaClient) DownloadRange(ctx context.Context, gitoid string, offset int64, length int64) (io.ReadCloser, error) {
+	return api.DownloadRangeWithHTTPClient(ctx, ac.Client, ac.BaseURL, gitoid, offset, length, ac.requestOptions()...)
+}
+
 func (ac *ArchivistaClient) Store(ctx context.Context, envelope dsse.Envelope) (api.UploadResponse, error) {
-	return api.Store(ctx, ac.BaseURL, envelope, ac.requestOptions()...)
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(envelope); err != nil {
+		return api.UploadResponse{}, err
+	}
+
+	return ac.StoreWithReader(ctx, buf)
 }
 
 func (ac *ArchivistaClient) StoreWithReader(ctx context.Context, r io.Reader) (api.UploadResponse, error) {
-	return api.StoreWithReader(ctx, ac.BaseURL, r, ac.requestOptions()...)
+	return api.StoreWithReaderWithHTTPClient(ctx, ac.Client, ac.BaseURL, r, ac.requestOptions()...)
+}
+
+func (ac *ArchivistaClient) Delete(ctx context.Context, gitoid string) (api.DeleteResponse, error) {
+	return api.DeleteWithHTTPClient(ctx, ac.Client, ac.BaseURL, gitoid, ac.requestOptions()...)
+}
+
+func (ac *ArchivistaClient) Hold(ctx context.Context, hold api.HoldRequest) (api.HoldResponse, error) {
+	return api.HoldWithHTTPClient(ctx, ac.Client, ac.BaseURL, hold, ac.requestOptions()...)
+}
+
+func (ac *ArchivistaClient) ReleaseHold(ctx context.Context, id string) (api.HoldResponse, error) {
+	return api.ReleaseHoldWithHTTPClient(ctx, ac.Client, ac.BaseURL, id, ac.requestOptions()...)
 }
 
 type GraphQLRequestBodyInterface struct {
@@ -133,8 +213,9 @@ func (ac *ArchivistaClient) GraphQLRetrieveSubjectResults(
 	ctx context.Context,
 	gitoid string,
 ) (api.RetrieveSubjectResults, error) {
-	return api.GraphQlQuery[api.RetrieveSubjectResults](
+	return api.GraphQlQueryWithHTTPClient[api.RetrieveSubjectResults](
 		ctx,
+		ac.Client,
 		ac.BaseURL,
 		api.RetrieveSubjectsQuery,
 		api.RetrieveSubjectVars{Gitoid: gitoid},
@@ -148,8 +229,9 @@ func (ac *ArchivistaClient) GraphQLRetrieveSearchResults(
 	algo string,
 	digest string,
 ) (api.SearchResults, error) {
-	return api.GraphQlQuery[api.SearchResults](
+	return api.GraphQlQueryWithHTTPClient[api.SearchResults](
 		ctx,
+		ac.Client,
 		ac.BaseURL,
 		api.SearchQuery,
 		api.SearchVars{Algorithm: algo, Digest: digest},
@@ -23,12 +23,19 @@ import (
 	"os"
 	"testing"
 
+	"github.com/edwarnicke/gitoid"
 	"github.com/in-toto/archivista/pkg/api"
 	httpclient "github.com/in-toto/archivista/pkg/http-client"
 	"github.com/in-toto/go-witness/dsse"
 	"github.com/stretchr/testify/suite"
 )
 
+// ArchivistaClient implements both client interfaces
+var (
+	_ httpclient.HttpClienter          = (*httpclient.ArchivistaClient)(nil)
+	_ httpclient.HttpRetentionClienter = (*httpclient.ArchivistaClient)(nil)
+)
+
 // Test Suite: UT HTTPClientDownloadSuite
 type UTHTTPClientDownloadSuite struct {
 	suite.Suite
@@ -65,13 +72,36 @@ func (ut *UTHTTPClientDownloadSuite) Test_DownloadDSSE() {
 	if err != nil {
 		ut.FailNow(err.Error())
 	}
-	resp, err := client.DownloadDSSE(ctx, "gitoid_test")
+	resp, err := client.DownloadDSSE(ctx, gitoidOf(testEnvelope))
 	if err != nil {
 		ut.FailNow(err.Error())
 	}
 	ut.Equal(expectedEnvelop, resp)
 }
 
+func (ut *UTHTTPClientDownloadSuite) Test_DownloadDSSE_GitoidMismatch() {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"payload":"tampered"}`))
+			},
+		),
+	)
+	defer testServer.Close()
+	client, err := httpclient.CreateArchivistaClient(http.DefaultClient, testServer.URL)
+	if err != nil {
+		ut.FailNow(err.Error())
+	}
+	_, err = client.DownloadDSSE(context.TODO(), gitoidOf([]byte(`{"payload":"genuine"}`)))
+	ut.ErrorIs(err, api.ErrGitoidMismatch)
+}
+
+func gitoidOf(content []byte) string {
+	gid, _ := gitoid.New(bytes.NewReader(content), gitoid.WithContentLength(int64(len(content))), gitoid.WithSha256())
+	return gid.String()
+}
+
 func (ut *UTHTTPClientDownloadSuite) Test_DownloadReadCloser() {
 	testEnvelope, err := os.ReadFile("../../test/package.attestation.json")
 	if err != nil {
@@ -99,7 +129,7 @@ func (ut *UTHTTPClientDownloadSuite) Test_DownloadReadCloser() {
 	if err != nil {
 		ut.FailNow(err.Error())
 	}
-	readCloser, err := client.DownloadReadCloser(ctx, "gitoid_test")
+	readCloser, err := client.DownloadReadCloser(ctx, gitoidOf(testEnvelope))
 	if err != nil {
 		ut.FailNow(err.Error())
 	}
@@ -138,7 +168,7 @@ func (ut *UTHTTPClientDownloadSuite) Test_DownloadWithWriter() {
 		ut.FailNow(err.Error())
 	}
 	buf := bytes.NewBuffer(nil)
-	if err := client.DownloadWithWriter(ctx, "gitoid_test", buf); err != nil {
+	if err := client.DownloadWithWriter(ctx, gitoidOf(testEnvelope), buf); err != nil {
 		ut.FailNow(err.Error())
 	}
 	env := dsse.Envelope{}
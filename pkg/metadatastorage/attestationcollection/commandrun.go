@@ -0,0 +1,51 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationcollection
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/in-toto/archivista/ent"
+)
+
+const CommandRunType = "https://witness.dev/attestations/command-run/v0.1"
+
+// commandRunAttestation is the part of a witness command-run attestation that is stored. The
+// output and traced processes are left in the envelope.
+type commandRunAttestation struct {
+	Cmd      []string `json:"cmd"`
+	ExitCode int      `json:"exitcode"`
+}
+
+func init() {
+	Register(CommandRunType, parseCommandRun)
+}
+
+// parseCommandRun stores the command a witness command-run attestation recorded, with its
+// arguments joined by spaces
+func parseCommandRun(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error {
+	run := commandRunAttestation{}
+	if err := json.Unmarshal(message, &run); err != nil {
+		return err
+	}
+
+	return tx.CommandRunAttestation.Create().
+		SetAttestation(attestation).
+		SetCmd(strings.Join(run.Cmd, " ")).
+		SetExitCode(run.ExitCode).
+		Exec(ctx)
+}
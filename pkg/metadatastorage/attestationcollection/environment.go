@@ -0,0 +1,51 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationcollection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/in-toto/archivista/ent"
+)
+
+const EnvironmentType = "https://witness.dev/attestations/environment/v0.1"
+
+// environmentAttestation is the part of a witness environment attestation that is stored. The
+// environment variables are left in the envelope.
+type environmentAttestation struct {
+	OS       string `json:"os"`
+	Hostname string `json:"hostname"`
+	Username string `json:"username"`
+}
+
+func init() {
+	Register(EnvironmentType, parseEnvironment)
+}
+
+// parseEnvironment stores the host a witness environment attestation was recorded on
+func parseEnvironment(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error {
+	env := environmentAttestation{}
+	if err := json.Unmarshal(message, &env); err != nil {
+		return err
+	}
+
+	return tx.EnvironmentAttestation.Create().
+		SetAttestation(attestation).
+		SetOs(env.OS).
+		SetHostname(env.Hostname).
+		SetUsername(env.Username).
+		Exec(ctx)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationcollection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/in-toto/archivista/ent"
+)
+
+const GitType = "https://witness.dev/attestations/git/v0.1"
+
+// gitAttestation is the part of a witness git attestation that is stored
+type gitAttestation struct {
+	CommitHash     string   `json:"commithash"`
+	Author         string   `json:"author"`
+	AuthorEmail    string   `json:"authoremail"`
+	CommitterName  string   `json:"committername"`
+	CommitterEmail string   `json:"committeremail"`
+	CommitDate     string   `json:"commitdate"`
+	CommitMessage  string   `json:"commitmessage"`
+	ParentHashes   []string `json:"parenthashes"`
+	TreeHash       string   `json:"treehash"`
+	Refs           []string `json:"refs"`
+	Remotes        []string `json:"remotes"`
+	Tags           []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+	Branch string `json:"branch"`
+}
+
+func init() {
+	Register(GitType, parseGit)
+}
+
+// parseGit stores the commit a witness git attestation was recorded at
+func parseGit(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error {
+	git := gitAttestation{}
+	if err := json.Unmarshal(message, &git); err != nil {
+		return err
+	}
+
+	tags := make([]string, 0, len(git.Tags))
+	for _, tag := range git.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	return tx.GitAttestation.Create().
+		SetAttestation(attestation).
+		SetCommitHash(git.CommitHash).
+		SetBranch(git.Branch).
+		SetTreeHash(git.TreeHash).
+		SetAuthor(git.Author).
+		SetAuthorEmail(git.AuthorEmail).
+		SetCommitterName(git.CommitterName).
+		SetCommitterEmail(git.CommitterEmail).
+		SetCommitDate(git.CommitDate).
+		SetCommitMessage(git.CommitMessage).
+		SetParentHashes(git.ParentHashes).
+		SetRefs(git.Refs).
+		SetRemotes(git.Remotes).
+		SetTags(tags).
+		Exec(ctx)
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationcollection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/in-toto/archivista/ent"
+)
+
+const GithubType = "https://witness.dev/attestations/github/v0.1"
+
+// githubAttestation is the part of a witness github attestation that is stored
+type githubAttestation struct {
+	CIConfigPath string `json:"ciconfigpath"`
+	PipelineID   string `json:"pipelineid"`
+	PipelineName string `json:"pipelinename"`
+	PipelineUrl  string `json:"pipelineurl"`
+	ProjectUrl   string `json:"projecturl"`
+	RunnerID     string `json:"runnerid"`
+	CIHost       string `json:"cihost"`
+	CIServerUrl  string `json:"ciserverurl"`
+	RunnerArch   string `json:"runnerarch"`
+	RunnerOS     string `json:"runneros"`
+}
+
+func init() {
+	Register(GithubType, parseGithub)
+}
+
+// parseGithub stores the GitHub Actions run a witness github attestation was recorded in
+func parseGithub(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error {
+	github := githubAttestation{}
+	if err := json.Unmarshal(message, &github); err != nil {
+		return err
+	}
+
+	return tx.GithubAttestation.Create().
+		SetAttestation(attestation).
+		SetCiConfigPath(github.CIConfigPath).
+		SetPipelineID(github.PipelineID).
+		SetPipelineName(github.PipelineName).
+		SetPipelineURL(github.PipelineUrl).
+		SetProjectURL(github.ProjectUrl).
+		SetRunnerID(github.RunnerID).
+		SetCiHost(github.CIHost).
+		SetCiServerURL(github.CIServerUrl).
+		SetRunnerArch(github.RunnerArch).
+		SetRunnerOs(github.RunnerOS).
+		Exec(ctx)
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationcollection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/in-toto/archivista/ent"
+)
+
+const GitlabType = "https://witness.dev/attestations/gitlab/v0.1"
+
+// gitlabAttestation is the part of a witness gitlab attestation that is stored
+type gitlabAttestation struct {
+	CIConfigPath string `json:"ciconfigpath"`
+	JobID        string `json:"jobid"`
+	JobImage     string `json:"jobimage"`
+	JobName      string `json:"jobname"`
+	JobStage     string `json:"jobstage"`
+	JobUrl       string `json:"joburl"`
+	PipelineID   string `json:"pipelineid"`
+	PipelineUrl  string `json:"pipelineurl"`
+	ProjectID    string `json:"projectid"`
+	ProjectUrl   string `json:"projecturl"`
+	RunnerID     string `json:"runnerid"`
+	CIHost       string `json:"cihost"`
+	CIServerUrl  string `json:"ciserverurl"`
+}
+
+func init() {
+	Register(GitlabType, parseGitlab)
+}
+
+// parseGitlab stores the GitLab CI job a witness gitlab attestation was recorded in
+func parseGitlab(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error {
+	gitlab := gitlabAttestation{}
+	if err := json.Unmarshal(message, &gitlab); err != nil {
+		return err
+	}
+
+	return tx.GitlabAttestation.Create().
+		SetAttestation(attestation).
+		SetCiConfigPath(gitlab.CIConfigPath).
+		SetPipelineID(gitlab.PipelineID).
+		SetJobID(gitlab.JobID).
+		SetJobImage(gitlab.JobImage).
+		SetJobName(gitlab.JobName).
+		SetJobStage(gitlab.JobStage).
+		SetJobURL(gitlab.JobUrl).
+		SetPipelineURL(gitlab.PipelineUrl).
+		SetProjectID(gitlab.ProjectID).
+		SetProjectURL(gitlab.ProjectUrl).
+		SetRunnerID(gitlab.RunnerID).
+		SetCiHost(gitlab.CIHost).
+		SetCiServerURL(gitlab.CIServerUrl).
+		Exec(ctx)
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationcollection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/in-toto/archivista/ent"
+)
+
+const MaterialType = "https://witness.dev/attestations/material/v0.1"
+
+func init() {
+	Register(MaterialType, parseMaterial)
+}
+
+// parseMaterial stores every digest of every file a witness material attestation recorded
+func parseMaterial(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error {
+	materials := map[string]map[string]string{}
+	if err := json.Unmarshal(message, &materials); err != nil {
+		return err
+	}
+
+	creates := make([]*ent.MaterialCreate, 0, len(materials))
+	for path, digests := range materials {
+		for algorithm, value := range digests {
+			if path == "" || algorithm == "" || value == "" {
+				continue
+			}
+
+			creates = append(creates, tx.Material.Create().
+				SetAttestation(attestation).
+				SetPath(path).
+				SetAlgorithm(algorithm).
+				SetValue(value))
+		}
+	}
+
+	for start := 0; start < len(creates); start += batchSize {
+		if err := tx.Material.CreateBulk(creates[start:min(start+batchSize, len(creates))]...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationcollection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/in-toto/archivista/ent"
+)
+
+const OciType = "https://witness.dev/attestations/oci/v0.1"
+
+// ociAttestation is the part of a witness oci attestation that is stored. Only the sha256
+// digests of the image are kept.
+type ociAttestation struct {
+	TarDigest      map[string]string `json:"tardigest"`
+	ImageTags      []string          `json:"imagetags"`
+	ImageID        map[string]string `json:"imageid"`
+	ManifestDigest map[string]string `json:"manifestdigest"`
+}
+
+func init() {
+	Register(OciType, parseOci)
+}
+
+// parseOci stores the image a witness oci attestation recorded
+func parseOci(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error {
+	oci := ociAttestation{}
+	if err := json.Unmarshal(message, &oci); err != nil {
+		return err
+	}
+
+	return tx.OciAttestation.Create().
+		SetAttestation(attestation).
+		SetTarDigest(oci.TarDigest["sha256"]).
+		SetImageID(oci.ImageID["sha256"]).
+		SetManifestDigest(oci.ManifestDigest["sha256"]).
+		SetImageTags(oci.ImageTags).
+		Exec(ctx)
+}
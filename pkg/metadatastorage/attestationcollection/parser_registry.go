@@ -21,15 +21,16 @@ import (
 	"log"
 )
 
-var registeredParsers map[string]AttestationParser
-
-func init() {
-	registeredParsers = make(map[string]AttestationParser)
-}
+// registeredParsers is created before any init function runs, so the built-in parsers can register
+// themselves from init
+var registeredParsers = make(map[string]AttestationParser)
 
 func Register(attestationType string, parser AttestationParser) {
 	registeredParsers[attestationType] = parser
 	log.Printf("parser registered: %s", attestationType)
 }
 
+// AttestationParser stores the parts of an attestation that can be queried. A parser should decode
+// and validate the attestation before writing to tx: decoding and validation errors only skip the
+// attestation, but any other error rejects the envelope.
 type AttestationParser func(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error
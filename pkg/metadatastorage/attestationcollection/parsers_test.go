@@ -0,0 +1,144 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationcollection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/enttest"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/material"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storeCollection stores a collection with the attestations in predicate, returning the client it
+// was stored with
+func storeCollection(t *testing.T, predicate string) *ent.Client {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	collection, err := Parse([]byte(predicate))
+	require.NoError(t, err)
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	stmt := tx.Statement.Create().SetPredicate(Predicate).SaveX(ctx)
+	require.NoError(t, collection.Store(ctx, tx, stmt.ID))
+	require.NoError(t, tx.Commit())
+	return client
+}
+
+func TestStore_Git(t *testing.T) {
+	client := storeCollection(t, `{"name":"build","attestations":[{"type":"`+GitType+`","attestation":{
+		"commithash":"0123abcd","author":"Jane","authoremail":"jane@example.com","commitmessage":"fix the build",
+		"parenthashes":["4567ef01"],"remotes":["https://example.com/repo.git"],"tags":[{"name":"v1.0.0"}],"branch":"main"}}]}`)
+
+	ctx := context.Background()
+	git := client.GitAttestation.Query().Where(gitattestation.CommitHash("0123abcd"), gitattestation.Branch("main")).OnlyX(ctx)
+	assert.Equal(t, "Jane", git.Author)
+	assert.Equal(t, "fix the build", git.CommitMessage)
+	assert.Equal(t, []string{"4567ef01"}, git.ParentHashes)
+	assert.Equal(t, []string{"https://example.com/repo.git"}, git.Remotes)
+	assert.Equal(t, []string{"v1.0.0"}, git.Tags)
+	assert.Equal(t, GitType, git.QueryAttestation().OnlyX(ctx).Type)
+}
+
+func TestStore_CI(t *testing.T) {
+	client := storeCollection(t, `{"name":"build","attestations":[
+		{"type":"`+GithubType+`","attestation":{"pipelineid":"42","projecturl":"https://github.com/org/repo","runneros":"Linux"}},
+		{"type":"`+GitlabType+`","attestation":{"jobid":"7","jobname":"build","projectid":"9"}},
+		{"type":"`+EnvironmentType+`","attestation":{"os":"linux","hostname":"runner-1","username":"ci","variables":{"HOME":"/root"}}},
+		{"type":"`+CommandRunType+`","attestation":{"cmd":["go","build","./..."],"exitcode":1}}]}`)
+
+	ctx := context.Background()
+	github := client.GithubAttestation.Query().OnlyX(ctx)
+	assert.Equal(t, "42", github.PipelineID)
+	assert.Equal(t, "https://github.com/org/repo", github.ProjectURL)
+	assert.Equal(t, "Linux", github.RunnerOs)
+
+	gitlab := client.GitlabAttestation.Query().OnlyX(ctx)
+	assert.Equal(t, "7", gitlab.JobID)
+	assert.Equal(t, "9", gitlab.ProjectID)
+
+	env := client.EnvironmentAttestation.Query().OnlyX(ctx)
+	assert.Equal(t, "runner-1", env.Hostname)
+
+	run := client.CommandRunAttestation.Query().OnlyX(ctx)
+	assert.Equal(t, "go build ./...", run.Cmd)
+	assert.Equal(t, 1, run.ExitCode)
+}
+
+func TestStore_Artifacts(t *testing.T) {
+	client := storeCollection(t, `{"name":"build","attestations":[
+		{"type":"`+MaterialType+`","attestation":{"go.mod":{"sha256":"aaaa","gitoid:sha1":"bbbb"},"main.go":{"sha256":"cccc"}}},
+		{"type":"`+ProductType+`","attestation":{"bin/app":{"mime_type":"application/x-executable","digest":{"sha256":"dddd"}}}},
+		{"type":"`+OciType+`","attestation":{"tardigest":{"sha256":"eeee"},"imagetags":["app:latest"],"imageid":{"sha256":"ffff"},"manifestdigest":{"sha256":"0000"}}}]}`)
+
+	ctx := context.Background()
+	assert.Equal(t, 3, client.Material.Query().CountX(ctx))
+	goMod := client.Material.Query().Where(material.Value("bbbb")).OnlyX(ctx)
+	assert.Equal(t, "go.mod", goMod.Path)
+	assert.Equal(t, "gitoid:sha1", goMod.Algorithm)
+
+	product := client.Product.Query().OnlyX(ctx)
+	assert.Equal(t, "bin/app", product.Path)
+	assert.Equal(t, "application/x-executable", product.MimeType)
+	assert.Equal(t, "dddd", product.Value)
+
+	oci := client.OciAttestation.Query().OnlyX(ctx)
+	assert.Equal(t, "eeee", oci.TarDigest)
+	assert.Equal(t, "ffff", oci.ImageID)
+	assert.Equal(t, "0000", oci.ManifestDigest)
+	assert.Equal(t, []string{"app:latest"}, oci.ImageTags)
+}
+
+func TestStore_ManyMaterials(t *testing.T) {
+	materials := make([]string, 0, 2*batchSize+1)
+	for i := range cap(materials) {
+		materials = append(materials, fmt.Sprintf(`"file-%d":{"sha256":"%d"}`, i, i))
+	}
+
+	client := storeCollection(t, `{"name":"build","attestations":[
+		{"type":"`+MaterialType+`","attestation":{`+strings.Join(materials, ",")+`}}]}`)
+	assert.Equal(t, 2*batchSize+1, client.Material.Query().CountX(context.Background()))
+}
+
+func TestStore_InvalidAttestation(t *testing.T) {
+	client := storeCollection(t, `{"name":"build","attestations":[
+		{"type":"`+GitType+`","attestation":{"commithash":42}},
+		{"type":"`+MaterialType+`","attestation":{"go.mod":{"sha256":""}}},
+		{"type":"`+EnvironmentType+`","attestation":{"hostname":"runner-1"}}]}`)
+
+	ctx := context.Background()
+	assert.Equal(t, 3, client.Attestation.Query().CountX(ctx))
+	assert.Zero(t, client.GitAttestation.Query().CountX(ctx))
+	assert.Zero(t, client.Material.Query().CountX(ctx))
+	assert.Equal(t, "runner-1", client.EnvironmentAttestation.Query().OnlyX(ctx).Hostname)
+}
+
+func TestStore_GitWithoutCommit(t *testing.T) {
+	client := storeCollection(t, `{"name":"build","attestations":[{"type":"`+GitType+`","attestation":{"branch":"main"}}]}`)
+
+	git := client.GitAttestation.Query().OnlyX(context.Background())
+	assert.Empty(t, git.CommitHash)
+	assert.Equal(t, "main", git.Branch)
+}
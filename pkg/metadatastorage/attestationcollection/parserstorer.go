@@ -17,6 +17,7 @@ package attestationcollection
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/google/uuid"
 	"github.com/in-toto/archivista/ent"
 	"github.com/in-toto/archivista/pkg/metadatastorage"
@@ -28,6 +29,10 @@ const (
 	Predicate = "https://witness.testifysec.com/attestation-collection/v0.1"
 )
 
+// batchSize bounds the rows created by one insert, keeping attestations of large builds under the
+// bind parameter limits of the databases
+const batchSize = 500
+
 // attestation.Collection from go-witness will try to parse each of the attestations by calling their factory functions,
 // which require the attestations to be registered in the go-witness library.  We don't really care about the actual attestation
 // data for the purposes here, so just leave it as a raw message.
@@ -67,13 +72,26 @@ func (parsedCollection ParsedCollection) Store(ctx context.Context, tx *ent.Tx,
 		}
 
 		// we parse if a parser is available. otherwise, we ignore it if no parser is available.
+		// an attestation the parser can't make sense of is skipped, so it doesn't reject the envelope.
 		if parser, exists := registeredParsers[a.Type]; exists {
 			if err := parser(ctx, tx, newAttestation, a.Type, a.Attestation); err != nil {
-				log.Errorf("failed to parse attestation of type %s: %w", a.Type, err)
-				return err
+				if !isUnparsable(err) {
+					return err
+				}
+
+				log.Warnf("skipping attestation of type %s that could not be parsed: %v", a.Type, err)
 			}
 		}
 	}
 
 	return nil
 }
+
+// isUnparsable reports whether err came from decoding or validating an attestation rather than
+// from the database. These errors happen before anything is written, so the transaction can
+// carry on without the attestation.
+func isUnparsable(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &syntaxErr) || errors.As(err, &typeErr) || ent.IsValidationError(err)
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestationcollection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/in-toto/archivista/ent"
+)
+
+const ProductType = "https://witness.dev/attestations/product/v0.1"
+
+// product is a file recorded by a witness product attestation
+type product struct {
+	MimeType string            `json:"mime_type"`
+	Digest   map[string]string `json:"digest"`
+}
+
+func init() {
+	Register(ProductType, parseProduct)
+}
+
+// parseProduct stores every digest of every file a witness product attestation recorded
+func parseProduct(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error {
+	products := map[string]product{}
+	if err := json.Unmarshal(message, &products); err != nil {
+		return err
+	}
+
+	creates := make([]*ent.ProductCreate, 0, len(products))
+	for path, p := range products {
+		for algorithm, value := range p.Digest {
+			if path == "" || algorithm == "" || value == "" {
+				continue
+			}
+
+			creates = append(creates, tx.Product.Create().
+				SetAttestation(attestation).
+				SetPath(path).
+				SetMimeType(p.MimeType).
+				SetAlgorithm(algorithm).
+				SetValue(value))
+		}
+	}
+
+	for start := 0; start < len(creates); start += batchSize {
+		if err := tx.Product.CreateBulk(creates[start:min(start+batchSize, len(creates))]...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
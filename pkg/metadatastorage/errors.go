@@ -0,0 +1,29 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadatastorage
+
+import "errors"
+
+// ErrAlreadyExists is returned when metadata for an envelope with the same gitoid has already been stored
+var ErrAlreadyExists = errors.New("envelope already exists")
+
+// ErrNotFound is returned when no envelope with the requested gitoid is stored
+var ErrNotFound = errors.New("envelope not found")
+
+// ErrHeld is returned when an envelope under an active legal hold would be deleted
+var ErrHeld = errors.New("envelope is under legal hold")
+
+// ErrHoldNotFound is returned when no legal hold with the requested id exists
+var ErrHoldNotFound = errors.New("legal hold not found")
@@ -0,0 +1,25 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadatastorage
+
+// Hold selects envelopes to place under a legal hold, either by their gitoids or by the
+// digest of a subject of their statement.
+type Hold struct {
+	Reason                 string
+	CreatedBy              string
+	Gitoids                []string
+	SubjectDigestAlgorithm string
+	SubjectDigest          string
+}
@@ -17,14 +17,33 @@ package parserregistry
 import (
 	"github.com/in-toto/archivista/pkg/metadatastorage"
 	"github.com/in-toto/archivista/pkg/metadatastorage/attestationcollection"
+	"github.com/in-toto/archivista/pkg/metadatastorage/sbom"
+	"github.com/in-toto/archivista/pkg/metadatastorage/slsaprovenance"
+	"github.com/in-toto/archivista/pkg/metadatastorage/vsa"
 )
 
 var (
 	parsersByPredicate = map[string]metadatastorage.ParserFunc{
 		attestationcollection.Predicate: attestationcollection.Parse,
+		slsaprovenance.Predicate:        slsaprovenance.Parse,
+		vsa.Predicate:                   vsa.Parse,
 	}
 )
 
+func init() {
+	for _, predicate := range sbom.Predicates {
+		parsersByPredicate[predicate] = sbom.Parse
+	}
+}
+
+// Register makes parser the parser of statements with predicate, replacing any parser already
+// registered for it. Statements with a predicate no parser is registered for are stored without
+// their predicate. Parsers should be registered before the server starts storing envelopes,
+// typically from an init function.
+func Register(predicate string, parser metadatastorage.ParserFunc) {
+	parsersByPredicate[predicate] = parser
+}
+
 func ParserForPredicate(predicate string) (metadatastorage.ParserFunc, bool) {
 	pf, ok := parsersByPredicate[predicate]
 	return pf, ok
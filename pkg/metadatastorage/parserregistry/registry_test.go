@@ -0,0 +1,44 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parserregistry
+
+import (
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/in-toto/archivista/pkg/metadatastorage/slsaprovenance"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParserForPredicate_BuiltIn(t *testing.T) {
+	_, ok := ParserForPredicate(slsaprovenance.Predicate)
+	assert.True(t, ok)
+
+	_, ok = ParserForPredicate("https://example.com/unregistered")
+	assert.False(t, ok)
+}
+
+func TestRegister(t *testing.T) {
+	called := false
+	Register("https://example.com/custom", func([]byte) (metadatastorage.Storer, error) {
+		called = true
+		return nil, nil
+	})
+
+	parser, ok := ParserForPredicate("https://example.com/custom")
+	assert.True(t, ok)
+	_, _ = parser(nil)
+	assert.True(t, called)
+}
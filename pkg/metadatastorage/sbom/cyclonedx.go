@@ -0,0 +1,85 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+type cycloneDXComponent struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Purl     string `json:"purl"`
+	Licenses []struct {
+		License struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"license"`
+		Expression string `json:"expression"`
+	} `json:"licenses"`
+	Hashes []struct {
+		Alg     string `json:"alg"`
+		Content string `json:"content"`
+	} `json:"hashes"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+// parseCycloneDX reads the components of a CycloneDX document, including the components nested in
+// other components. Multiple licenses of a component are joined into one AND expression.
+func parseCycloneDX(data []byte) (Packages, error) {
+	bom := struct {
+		Components []cycloneDXComponent `json:"components"`
+	}{}
+	if err := json.Unmarshal(data, &bom); err != nil {
+		return nil, err
+	}
+
+	packages := make(Packages, 0, len(bom.Components))
+	var walk func([]cycloneDXComponent)
+	walk = func(components []cycloneDXComponent) {
+		for _, c := range components {
+			pkg := Package{
+				Name:    c.Name,
+				Version: c.Version,
+				Purl:    c.Purl,
+				Digests: make(map[string]string),
+			}
+
+			licenses := make([]string, 0, len(c.Licenses))
+			for _, l := range c.Licenses {
+				switch {
+				case l.Expression != "":
+					licenses = append(licenses, l.Expression)
+				case l.License.ID != "":
+					licenses = append(licenses, l.License.ID)
+				case l.License.Name != "":
+					licenses = append(licenses, l.License.Name)
+				}
+			}
+
+			pkg.License = strings.Join(licenses, " AND ")
+			for _, hash := range c.Hashes {
+				pkg.Digests[algorithm(hash.Alg)] = hash.Content
+			}
+
+			packages = append(packages, pkg)
+			walk(c.Components)
+		}
+	}
+
+	walk(bom.Components)
+	return packages, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom indexes the packages listed in SPDX 2.x, SPDX 3 and CycloneDX 1.4+ documents, whether
+// they are the predicate of a statement or an attestation in a witness attestation collection
+package sbom
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/in-toto/archivista/pkg/metadatastorage/attestationcollection"
+)
+
+const (
+	SPDXPredicate      = "https://spdx.dev/Document"
+	CycloneDXPredicate = "https://cyclonedx.org/bom"
+	// WitnessType is the attestation type of the witness sbom attestor before it knows the
+	// format of the SBOM it recorded
+	WitnessType = "https://witness.dev/attestations/sbom/v0.1"
+)
+
+// Predicates are the predicate types SBOM statements are parsed for
+var Predicates = []string{
+	SPDXPredicate,
+	SPDXPredicate + "/v2.2",
+	SPDXPredicate + "/v2.3",
+	SPDXPredicate + "/v3.0",
+	CycloneDXPredicate,
+	CycloneDXPredicate + "/v1.4",
+	CycloneDXPredicate + "/v1.5",
+	CycloneDXPredicate + "/v1.6",
+}
+
+func init() {
+	for _, attestationType := range []string{SPDXPredicate, CycloneDXPredicate, WitnessType} {
+		attestationcollection.Register(attestationType, parseAttestation)
+	}
+}
+
+// Package is a package listed in an SBOM
+type Package struct {
+	Name    string
+	Version string
+	Purl    string
+	License string
+	// Digests maps the algorithm of each hash of the package to its value
+	Digests map[string]string
+}
+
+// Packages are the packages listed in an SBOM
+type Packages []Package
+
+// Parse reads the packages from an SPDX or CycloneDX document. Documents of neither format have
+// no packages.
+func Parse(data []byte) (metadatastorage.Storer, error) {
+	doc := struct {
+		SPDXVersion string          `json:"spdxVersion"`
+		Graph       json.RawMessage `json:"@graph"`
+		BomFormat   string          `json:"bomFormat"`
+	}{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return Packages{}, err
+	}
+
+	switch {
+	case strings.HasPrefix(doc.SPDXVersion, "SPDX-2"):
+		return parseSPDX2(data)
+	case len(doc.Graph) > 0:
+		return parseSPDX3(doc.Graph)
+	case doc.BomFormat == "CycloneDX":
+		return parseCycloneDX(data)
+	}
+
+	return Packages{}, nil
+}
+
+// batchSize bounds the rows created by one insert, keeping large SBOMs under the bind parameter
+// limits of the databases
+const batchSize = 500
+
+// Store links every package to the statement the SBOM was found in
+func (packages Packages) Store(ctx context.Context, tx *ent.Tx, stmtID uuid.UUID) error {
+	creates := make([]*ent.SbomPackageCreate, 0, len(packages))
+	digests := make([]*ent.SbomPackageDigestCreate, 0, len(packages))
+	for _, p := range packages {
+		if p.Name == "" {
+			continue
+		}
+
+		// ids are assigned up front so the digests can refer to packages that aren't created yet
+		id := uuid.New()
+		creates = append(creates, tx.SbomPackage.Create().
+			SetID(id).
+			SetStatementID(stmtID).
+			SetName(p.Name).
+			SetVersion(p.Version).
+			SetPurl(p.Purl).
+			SetLicense(p.License))
+
+		for algorithm, value := range p.Digests {
+			digests = append(digests, tx.SbomPackageDigest.Create().
+				SetSbomPackageID(id).
+				SetAlgorithm(algorithm).
+				SetValue(value))
+		}
+	}
+
+	for start := 0; start < len(creates); start += batchSize {
+		if err := tx.SbomPackage.CreateBulk(creates[start:min(start+batchSize, len(creates))]...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for start := 0; start < len(digests); start += batchSize {
+		if err := tx.SbomPackageDigest.CreateBulk(digests[start:min(start+batchSize, len(digests))]...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseAttestation stores the packages of an SBOM recorded by the witness sbom attestor with the
+// statement of the collection it was recorded in
+func parseAttestation(ctx context.Context, tx *ent.Tx, attestation *ent.Attestation, attestationType string, message json.RawMessage) error {
+	packages, err := Parse(message)
+	if err != nil {
+		return err
+	}
+
+	stmtID, err := attestation.QueryAttestationCollection().QueryStatement().OnlyID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return packages.Store(ctx, tx, stmtID)
+}
+
+// algorithm normalizes the name of a hash algorithm, so SHA-256, SHA256 and sha256 are all stored
+// as sha256 like subject digests are
+func algorithm(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "-", ""))
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/enttest"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const spdx2 = `{
+	"spdxVersion": "SPDX-2.3",
+	"packages": [{
+		"name": "log4j-core",
+		"versionInfo": "2.14.1",
+		"licenseConcluded": "NOASSERTION",
+		"licenseDeclared": "Apache-2.0",
+		"externalRefs": [{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"}],
+		"checksums": [{"algorithm": "SHA256", "checksumValue": "abcd"}]
+	}]
+}`
+
+const spdx3 = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+	"@graph": [
+		{"type": "software_Package", "spdxId": "urn:pkg", "name": "log4j-core", "software_packageVersion": "2.14.1",
+			"software_packageUrl": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+			"verifiedUsing": [{"type": "Hash", "algorithm": "sha256", "hashValue": "abcd"}]},
+		{"type": "simplelicensing_LicenseExpression", "spdxId": "urn:license", "simplelicensing_licenseExpression": "Apache-2.0"},
+		{"type": "Relationship", "spdxId": "urn:rel", "relationshipType": "hasConcludedLicense", "from": "urn:pkg", "to": ["urn:license"]}
+	]
+}`
+
+const cycloneDX = `{
+	"bomFormat": "CycloneDX",
+	"specVersion": "1.5",
+	"components": [{
+		"name": "app",
+		"version": "1.0.0",
+		"components": [{
+			"name": "log4j-core",
+			"version": "2.14.1",
+			"purl": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+			"licenses": [{"license": {"id": "Apache-2.0"}}],
+			"hashes": [{"alg": "SHA-256", "content": "abcd"}]
+		}]
+	}]
+}`
+
+// store stores the packages of document with a new statement
+func store(t *testing.T, document string) *ent.Client {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+
+	storer, err := Parse([]byte(document))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	stmt := tx.Statement.Create().SetPredicate(SPDXPredicate).SaveX(ctx)
+	require.NoError(t, storer.Store(ctx, tx, stmt.ID))
+	require.NoError(t, tx.Commit())
+	return client
+}
+
+func TestParse_Formats(t *testing.T) {
+	for name, document := range map[string]string{"spdx2": spdx2, "spdx3": spdx3, "cyclonedx": cycloneDX} {
+		t.Run(name, func(t *testing.T) {
+			client := store(t, document)
+			ctx := context.Background()
+
+			pkg := client.SbomPackage.Query().Where(sbompackage.Purl("pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1")).OnlyX(ctx)
+			assert.Equal(t, "log4j-core", pkg.Name)
+			assert.Equal(t, "2.14.1", pkg.Version)
+			assert.Equal(t, "Apache-2.0", pkg.License)
+
+			digest := pkg.QueryDigests().Where(sbompackagedigest.Value("abcd")).OnlyX(ctx)
+			assert.Equal(t, "sha256", digest.Algorithm)
+			assert.Equal(t, 1, pkg.QueryStatement().CountX(ctx))
+		})
+	}
+}
+
+func TestParse_NestedComponents(t *testing.T) {
+	client := store(t, cycloneDX)
+	assert.Equal(t, 2, client.SbomPackage.Query().CountX(context.Background()))
+}
+
+func TestParse_UnknownFormat(t *testing.T) {
+	storer, err := Parse([]byte(`{"name": "not an sbom"}`))
+	require.NoError(t, err)
+	assert.Empty(t, storer)
+
+	_, err = Parse([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestStore_Batches(t *testing.T) {
+	packages := make(Packages, 0, batchSize+1)
+	for i := 0; i <= batchSize; i++ {
+		packages = append(packages, Package{Name: fmt.Sprintf("pkg-%d", i), Digests: map[string]string{"sha256": fmt.Sprint(i)}})
+	}
+
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+
+	ctx := context.Background()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	stmt := tx.Statement.Create().SetPredicate(CycloneDXPredicate).SaveX(ctx)
+	require.NoError(t, packages.Store(ctx, tx, stmt.ID))
+	require.NoError(t, tx.Commit())
+
+	assert.Equal(t, batchSize+1, client.SbomPackage.Query().CountX(ctx))
+	assert.Equal(t, batchSize+1, client.SbomPackageDigest.Query().CountX(ctx))
+}
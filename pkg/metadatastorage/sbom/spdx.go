@@ -0,0 +1,157 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+type spdx2Document struct {
+	Packages []struct {
+		Name             string `json:"name"`
+		VersionInfo      string `json:"versionInfo"`
+		LicenseConcluded string `json:"licenseConcluded"`
+		LicenseDeclared  string `json:"licenseDeclared"`
+		ExternalRefs     []struct {
+			ReferenceType    string `json:"referenceType"`
+			ReferenceLocator string `json:"referenceLocator"`
+		} `json:"externalRefs"`
+		Checksums []struct {
+			Algorithm     string `json:"algorithm"`
+			ChecksumValue string `json:"checksumValue"`
+		} `json:"checksums"`
+	} `json:"packages"`
+}
+
+func parseSPDX2(data []byte) (Packages, error) {
+	doc := spdx2Document{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	packages := make(Packages, 0, len(doc.Packages))
+	for _, p := range doc.Packages {
+		pkg := Package{
+			Name:    p.Name,
+			Version: p.VersionInfo,
+			License: spdxLicense(p.LicenseConcluded),
+			Digests: make(map[string]string),
+		}
+
+		if pkg.License == "" {
+			pkg.License = spdxLicense(p.LicenseDeclared)
+		}
+
+		for _, ref := range p.ExternalRefs {
+			if ref.ReferenceType == "purl" {
+				pkg.Purl = ref.ReferenceLocator
+				break
+			}
+		}
+
+		for _, checksum := range p.Checksums {
+			pkg.Digests[algorithm(checksum.Algorithm)] = checksum.ChecksumValue
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
+
+// spdx3Element holds the properties of the SPDX 3 elements a package is read from
+type spdx3Element struct {
+	Type              string   `json:"type"`
+	SpdxID            string   `json:"spdxId"`
+	Name              string   `json:"name"`
+	PackageVersion    string   `json:"software_packageVersion"`
+	PackageURL        string   `json:"software_packageUrl"`
+	LicenseExpression string   `json:"simplelicensing_licenseExpression"`
+	RelationshipType  string   `json:"relationshipType"`
+	From              string   `json:"from"`
+	To                []string `json:"to"`
+	VerifiedUsing     []struct {
+		Algorithm string `json:"algorithm"`
+		HashValue string `json:"hashValue"`
+	} `json:"verifiedUsing"`
+}
+
+// parseSPDX3 reads the software packages from the graph of an SPDX 3 JSON-LD document. The license
+// of a package is the expression it has a concluded license relationship with, or its declared
+// license if none was concluded.
+func parseSPDX3(graph json.RawMessage) (Packages, error) {
+	elements := []spdx3Element{}
+	if err := json.Unmarshal(graph, &elements); err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]spdx3Element, len(elements))
+	concluded := make(map[string]string)
+	declared := make(map[string]string)
+	for _, e := range elements {
+		byID[e.SpdxID] = e
+	}
+
+	for _, e := range elements {
+		if e.Type != "Relationship" || len(e.To) == 0 {
+			continue
+		}
+
+		switch e.RelationshipType {
+		case "hasConcludedLicense":
+			concluded[e.From] = spdxLicense(byID[e.To[0]].LicenseExpression)
+		case "hasDeclaredLicense":
+			declared[e.From] = spdxLicense(byID[e.To[0]].LicenseExpression)
+		}
+	}
+
+	packages := make(Packages, 0)
+	for _, e := range elements {
+		if e.Type != "software_Package" {
+			continue
+		}
+
+		pkg := Package{
+			Name:    e.Name,
+			Version: e.PackageVersion,
+			Purl:    e.PackageURL,
+			License: concluded[e.SpdxID],
+			Digests: make(map[string]string),
+		}
+
+		if pkg.License == "" {
+			pkg.License = declared[e.SpdxID]
+		}
+
+		for _, hash := range e.VerifiedUsing {
+			pkg.Digests[algorithm(hash.Algorithm)] = hash.HashValue
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
+
+// spdxLicense returns license unless it is one of the SPDX values that mean no license is known
+func spdxLicense(license string) string {
+	switch strings.ToUpper(license) {
+	case "NOASSERTION", "NONE":
+		return ""
+	}
+
+	return license
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slsaprovenance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+)
+
+const (
+	Predicate = "https://slsa.dev/provenance/v1"
+)
+
+// batchSize bounds the rows created by one insert, keeping provenance with many resolved
+// dependencies under the bind parameter limits of the databases
+const batchSize = 500
+
+type ResourceDescriptor struct {
+	URI    string            `json:"uri"`
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Provenance is the part of a SLSA provenance v1 predicate that is stored
+type Provenance struct {
+	BuildDefinition struct {
+		BuildType            string               `json:"buildType"`
+		ExternalParameters   json.RawMessage      `json:"externalParameters"`
+		ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies"`
+	} `json:"buildDefinition"`
+	RunDetails struct {
+		Builder struct {
+			ID string `json:"id"`
+		} `json:"builder"`
+		Metadata struct {
+			InvocationID string     `json:"invocationId"`
+			StartedOn    *time.Time `json:"startedOn"`
+			FinishedOn   *time.Time `json:"finishedOn"`
+		} `json:"metadata"`
+	} `json:"runDetails"`
+}
+
+func Parse(data []byte) (metadatastorage.Storer, error) {
+	provenance := Provenance{}
+	if err := json.Unmarshal(data, &provenance); err != nil {
+		return provenance, err
+	}
+
+	if provenance.RunDetails.Builder.ID == "" {
+		return provenance, errors.New("provenance has no builder id")
+	} else if provenance.BuildDefinition.BuildType == "" {
+		return provenance, errors.New("provenance has no build type")
+	}
+
+	return provenance, nil
+}
+
+// Store stores the build recorded by the provenance, with a resolved dependency for every digest
+// of every dependency the build resolved
+func (provenance Provenance) Store(ctx context.Context, tx *ent.Tx, stmtID uuid.UUID) error {
+	create := tx.SlsaProvenance.Create().
+		SetStatementID(stmtID).
+		SetBuilderID(provenance.RunDetails.Builder.ID).
+		SetBuildType(provenance.BuildDefinition.BuildType).
+		SetInvocationID(provenance.RunDetails.Metadata.InvocationID).
+		SetNillableStartedOn(provenance.RunDetails.Metadata.StartedOn).
+		SetNillableFinishedOn(provenance.RunDetails.Metadata.FinishedOn)
+	if len(provenance.BuildDefinition.ExternalParameters) > 0 {
+		create.SetExternalParameters(string(provenance.BuildDefinition.ExternalParameters))
+	}
+
+	stored, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	dependencies := make([]*ent.SlsaDependencyCreate, 0, len(provenance.BuildDefinition.ResolvedDependencies))
+	for _, dep := range provenance.BuildDefinition.ResolvedDependencies {
+		if len(dep.Digest) == 0 {
+			dependencies = append(dependencies, tx.SlsaDependency.Create().
+				SetSlsaProvenance(stored).
+				SetURI(dep.URI).
+				SetName(dep.Name))
+			continue
+		}
+
+		for algorithm, value := range dep.Digest {
+			dependencies = append(dependencies, tx.SlsaDependency.Create().
+				SetSlsaProvenance(stored).
+				SetURI(dep.URI).
+				SetName(dep.Name).
+				SetAlgorithm(algorithm).
+				SetValue(value))
+		}
+	}
+
+	for start := 0; start < len(dependencies); start += batchSize {
+		if err := tx.SlsaDependency.CreateBulk(dependencies[start:min(start+batchSize, len(dependencies))]...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
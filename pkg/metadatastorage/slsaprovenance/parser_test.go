@@ -0,0 +1,98 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slsaprovenance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/in-toto/archivista/ent/enttest"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const provenance = `{
+	"buildDefinition": {
+		"buildType": "https://slsa-framework.github.io/github-actions-buildtypes/workflow/v1",
+		"externalParameters": {"workflow": {"ref": "refs/heads/main", "path": ".github/workflows/build.yml"}},
+		"resolvedDependencies": [
+			{"uri": "git+https://github.com/org/repo@refs/heads/main", "digest": {"gitCommit": "0123abcd"}},
+			{"uri": "https://example.com/toolchain.tar.gz", "name": "toolchain"}
+		]
+	},
+	"runDetails": {
+		"builder": {"id": "https://github.com/actions/runner"},
+		"metadata": {"invocationId": "https://github.com/org/repo/actions/runs/1", "startedOn": "2026-10-17T12:00:00Z"}
+	}
+}`
+
+func TestParse_MissingBuilder(t *testing.T) {
+	_, err := Parse([]byte(`{"buildDefinition":{"buildType":"https://example.com/build"}}`))
+	assert.ErrorContains(t, err, "no builder id")
+}
+
+func TestStore(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+
+	storer, err := Parse([]byte(provenance))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	stmt := tx.Statement.Create().SetPredicate(Predicate).SaveX(ctx)
+	require.NoError(t, storer.Store(ctx, tx, stmt.ID))
+	require.NoError(t, tx.Commit())
+
+	stored := client.Statement.Query().QuerySlsaProvenance().OnlyX(ctx)
+	assert.Equal(t, "https://github.com/actions/runner", stored.BuilderID)
+	assert.Equal(t, "https://slsa-framework.github.io/github-actions-buildtypes/workflow/v1", stored.BuildType)
+	assert.Equal(t, "https://github.com/org/repo/actions/runs/1", stored.InvocationID)
+	assert.Equal(t, "2026-10-17T12:00:00Z", stored.StartedOn.UTC().Format("2006-01-02T15:04:05Z"))
+	assert.Nil(t, stored.FinishedOn)
+	assert.JSONEq(t, `{"workflow": {"ref": "refs/heads/main", "path": ".github/workflows/build.yml"}}`, stored.ExternalParameters)
+
+	commit := stored.QueryResolvedDependencies().Where(slsadependency.Value("0123abcd")).OnlyX(ctx)
+	assert.Equal(t, "git+https://github.com/org/repo@refs/heads/main", commit.URI)
+	assert.Equal(t, "gitCommit", commit.Algorithm)
+	toolchain := stored.QueryResolvedDependencies().Where(slsadependency.Name("toolchain")).OnlyX(ctx)
+	assert.Empty(t, toolchain.Value)
+}
+
+func TestStore_ManyDependencies(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+
+	storer := Provenance{}
+	storer.BuildDefinition.BuildType = "https://example.com/build"
+	storer.RunDetails.Builder.ID = "https://github.com/actions/runner"
+	for i := range 2*batchSize + 1 {
+		storer.BuildDefinition.ResolvedDependencies = append(storer.BuildDefinition.ResolvedDependencies,
+			ResourceDescriptor{URI: fmt.Sprintf("pkg:golang/example.com/dep%d", i), Digest: map[string]string{"sha256": fmt.Sprint(i)}})
+	}
+
+	ctx := context.Background()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	stmt := tx.Statement.Create().SetPredicate(Predicate).SaveX(ctx)
+	require.NoError(t, storer.Store(ctx, tx, stmt.ID))
+	require.NoError(t, tx.Commit())
+
+	assert.Equal(t, 2*batchSize+1, client.SlsaDependency.Query().CountX(ctx))
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlstore
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+	"github.com/in-toto/go-witness/cryptoutil"
+	"github.com/in-toto/go-witness/dsse"
+	"github.com/sirupsen/logrus"
+)
+
+// fulcio's certificate extensions, see https://github.com/sigstore/fulcio/blob/main/docs/oid-info.md
+var (
+	oidIssuerV1               = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuer                 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+	oidBuildSignerURI         = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 9}
+	oidBuildSignerDigest      = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 10}
+	oidRunnerEnvironment      = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 11}
+	oidSourceRepositoryURI    = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 12}
+	oidSourceRepositoryDigest = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 13}
+	oidSourceRepositoryRef    = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 14}
+	oidBuildConfigURI         = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 18}
+	oidBuildTrigger           = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 20}
+	oidRunInvocationURI       = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 21}
+)
+
+// fulcioExtensions holds the values of fulcio's extensions found on a certificate
+type fulcioExtensions struct {
+	issuer                 string
+	buildSignerURI         string
+	buildSignerDigest      string
+	runnerEnvironment      string
+	sourceRepositoryURI    string
+	sourceRepositoryDigest string
+	sourceRepositoryRef    string
+	buildConfigURI         string
+	buildTrigger           string
+	runInvocationURI       string
+}
+
+func parseFulcioExtensions(cert *x509.Certificate) fulcioExtensions {
+	ext := fulcioExtensions{}
+	fields := map[string]*string{
+		oidIssuer.String():                 &ext.issuer,
+		oidBuildSignerURI.String():         &ext.buildSignerURI,
+		oidBuildSignerDigest.String():      &ext.buildSignerDigest,
+		oidRunnerEnvironment.String():      &ext.runnerEnvironment,
+		oidSourceRepositoryURI.String():    &ext.sourceRepositoryURI,
+		oidSourceRepositoryDigest.String(): &ext.sourceRepositoryDigest,
+		oidSourceRepositoryRef.String():    &ext.sourceRepositoryRef,
+		oidBuildConfigURI.String():         &ext.buildConfigURI,
+		oidBuildTrigger.String():           &ext.buildTrigger,
+		oidRunInvocationURI.String():       &ext.runInvocationURI,
+	}
+
+	var issuerV1 string
+	for _, e := range cert.Extensions {
+		if e.Id.Equal(oidIssuerV1) {
+			// the deprecated issuer extension holds the raw string rather than a DER encoded one
+			issuerV1 = string(e.Value)
+			continue
+		}
+
+		field, ok := fields[e.Id.String()]
+		if !ok {
+			continue
+		}
+
+		var value string
+		if _, err := asn1.Unmarshal(e.Value, &value); err != nil {
+			logrus.Warnf("could not parse certificate extension %s: %v", e.Id, err)
+			continue
+		}
+
+		*field = value
+	}
+
+	if ext.issuer == "" {
+		ext.issuer = issuerV1
+	}
+
+	return ext
+}
+
+// parseCertificateChain parses the PEM encoded signing certificate and intermediates of a signature.
+// the signing certificate comes first, and an empty chain is returned if the signature has none.
+func parseCertificateChain(sig dsse.Signature) ([]*x509.Certificate, error) {
+	if len(sig.Certificate) == 0 {
+		return nil, nil
+	}
+
+	chain := make([]*x509.Certificate, 0, len(sig.Intermediates)+1)
+	for _, pemBytes := range append([][]byte{sig.Certificate}, sig.Intermediates...) {
+		block, _ := pem.Decode(pemBytes)
+		if block == nil {
+			return nil, errors.New("certificate is not pem encoded")
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		chain = append(chain, cert)
+	}
+
+	return chain, nil
+}
+
+// verifyWithCertificate checks that sig was made over the envelope by the key of the signing
+// certificate. It says nothing of whether the certificate itself is trusted.
+func verifyWithCertificate(envelope *dsse.Envelope, sig dsse.Signature, cert *x509.Certificate) error {
+	verifier, err := cryptoutil.NewVerifier(cert.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	// verify an envelope holding only this signature, without its certificates, so dsse checks it
+	// against the certificate's key alone rather than trying to build a chain we have no roots for
+	_, err = dsse.Envelope{
+		PayloadType: envelope.PayloadType,
+		Payload:     envelope.Payload,
+		Signatures:  []dsse.Signature{{KeyID: sig.KeyID, Signature: sig.Signature}},
+	}.Verify(dsse.VerifyWithVerifiers(verifier))
+	return err
+}
+
+// createCertificates stores the certificate chain of a signature along with each certificate's
+// subject alternative names. Certificates that can't be parsed, or whose signing certificate's key
+// didn't make the signature, are skipped so the envelope is still stored, the same as a signature
+// with no certificate.
+func createCertificates(ctx context.Context, tx *ent.Tx, envelope *dsse.Envelope, sig dsse.Signature, storedSig *ent.Signature) error {
+	chain, err := parseCertificateChain(sig)
+	if err != nil {
+		logrus.Warnf("could not parse certificates of signature with key id %q: %v", sig.KeyID, err)
+		return nil
+	}
+
+	if len(chain) > 0 {
+		if err := verifyWithCertificate(envelope, sig, chain[0]); err != nil {
+			logrus.Warnf("signature with key id %q was not made by its certificate, skipping the certificates: %v", sig.KeyID, err)
+			return nil
+		}
+	}
+
+	for i, cert := range chain {
+		ext := parseFulcioExtensions(cert)
+		create := tx.Certificate.Create().
+			SetChainIndex(i).
+			SetSubject(cert.Subject.String()).
+			SetIssuer(cert.Issuer.String()).
+			SetSerialNumber(cert.SerialNumber.Text(16)).
+			SetNotBefore(cert.NotBefore).
+			SetNotAfter(cert.NotAfter).
+			SetOidcIssuer(ext.issuer).
+			SetBuildSignerURI(ext.buildSignerURI).
+			SetBuildSignerDigest(ext.buildSignerDigest).
+			SetRunnerEnvironment(ext.runnerEnvironment).
+			SetSourceRepositoryURI(ext.sourceRepositoryURI).
+			SetSourceRepositoryDigest(ext.sourceRepositoryDigest).
+			SetSourceRepositoryRef(ext.sourceRepositoryRef).
+			SetBuildConfigURI(ext.buildConfigURI).
+			SetBuildTrigger(ext.buildTrigger).
+			SetRunInvocationURI(ext.runInvocationURI).
+			SetSignature(storedSig)
+
+		bulkSans := make([]*ent.SubjectAltNameCreate, 0)
+		addSan := func(sanType subjectaltname.Type, value string) {
+			bulkSans = append(bulkSans, tx.SubjectAltName.Create().SetType(sanType).SetValue(value))
+		}
+
+		for _, email := range cert.EmailAddresses {
+			addSan(subjectaltname.TypeEmail, email)
+		}
+
+		for _, uri := range cert.URIs {
+			addSan(subjectaltname.TypeURI, uri.String())
+			if uri.Scheme == "spiffe" {
+				create.SetSpiffeID(uri.String())
+			}
+		}
+
+		for _, name := range cert.DNSNames {
+			addSan(subjectaltname.TypeDNS, name)
+		}
+
+		for _, ip := range cert.IPAddresses {
+			addSan(subjectaltname.TypeIP, ip.String())
+		}
+
+		storedCert, err := create.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, san := range bulkSans {
+			san.SetCertificate(storedCert)
+		}
+
+		if err := tx.SubjectAltName.CreateBulk(bulkSans...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
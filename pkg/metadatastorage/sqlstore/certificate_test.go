@@ -0,0 +1,191 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlstore
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+	"github.com/in-toto/go-witness/cryptoutil"
+	witnessdsse "github.com/in-toto/go-witness/dsse"
+)
+
+func (ut *UTStoreSuite) newCertificate(template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) ([]byte, *x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ut.Require().NoError(err)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	ut.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	ut.Require().NoError(err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), cert, key
+}
+
+func fulcioExtension(oid asn1.ObjectIdentifier, value string) pkix.Extension {
+	der, _ := asn1.Marshal(value)
+	return pkix.Extension{Id: oid, Value: der}
+}
+
+// certificateEnvelope returns an envelope with sig, signed by key if it isn't nil
+func (ut *UTStoreSuite) certificateEnvelope(key *ecdsa.PrivateKey, sig witnessdsse.Signature) []byte {
+	payloadType := "application/vnd.in-toto+json"
+	payload := `{"_type":"https://in-toto.io/Statement/v0.1","subject":[],"predicateType":"https://example.com/unparsed","predicate":{}}`
+	envelope := witnessdsse.Envelope{PayloadType: payloadType, Payload: []byte(payload)}
+	if key != nil {
+		signed, err := witnessdsse.Sign(payloadType, strings.NewReader(payload), witnessdsse.SignWithSigners(cryptoutil.NewECDSASigner(key, crypto.SHA256)))
+		ut.Require().NoError(err)
+		sig.Signature = signed.Signatures[0].Signature
+	}
+
+	envelope.Signatures = []witnessdsse.Signature{sig}
+	env, err := json.Marshal(envelope)
+	ut.Require().NoError(err)
+	return env
+}
+
+func (ut *UTStoreSuite) Test_Store_Certificates() {
+	ctx := context.Background()
+	notBefore := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+	notAfter := notBefore.Add(10 * time.Minute)
+	_, root, rootKey := ut.newCertificate(&x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "sigstore"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	intermediatePEM, intermediate, intermediateKey := ut.newCertificate(&x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "sigstore-intermediate"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, root, rootKey)
+
+	workflow, _ := url.Parse("https://github.com/in-toto/archivista/.github/workflows/release.yml@refs/tags/v1.0.0")
+	spiffeID, _ := url.Parse("spiffe://example.com/ci/runner")
+	leafPEM, _, leafKey := ut.newCertificate(&x509.Certificate{
+		SerialNumber:   big.NewInt(0xbeef),
+		NotBefore:      notBefore,
+		NotAfter:       notAfter,
+		URIs:           []*url.URL{workflow, spiffeID},
+		EmailAddresses: []string{"ci@example.com"},
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtraExtensions: []pkix.Extension{
+			{Id: oidIssuerV1, Value: []byte("https://token.actions.githubusercontent.com")},
+			fulcioExtension(oidSourceRepositoryURI, "https://github.com/in-toto/archivista"),
+			fulcioExtension(oidSourceRepositoryRef, "refs/tags/v1.0.0"),
+			fulcioExtension(oidBuildTrigger, "push"),
+		},
+	}, intermediate, intermediateKey)
+
+	ut.Require().NoError(ut.store.Store(ctx, "signed", ut.certificateEnvelope(leafKey, witnessdsse.Signature{
+		KeyID:         "leaf",
+		Certificate:   leafPEM,
+		Intermediates: [][]byte{intermediatePEM},
+	})))
+	ut.Require().NoError(ut.store.Store(ctx, "unsigned", envelope("artifact")))
+
+	gitoids := ut.client.Dsse.Query().
+		Where(dsse.HasSignaturesWith(signature.HasCertificatesWith(certificate.SourceRepositoryURI("https://github.com/in-toto/archivista")))).
+		Select(dsse.FieldGitoidSha256).StringsX(ctx)
+	ut.Equal([]string{"signed"}, gitoids)
+
+	certs := ut.client.Certificate.Query().WithSubjectAltNames().Order(certificate.ByChainIndex()).AllX(ctx)
+	ut.Require().Len(certs, 2)
+
+	leaf := certs[0]
+	ut.Equal(0, leaf.ChainIndex)
+	ut.Equal("CN=sigstore-intermediate", leaf.Issuer)
+	ut.Equal("beef", leaf.SerialNumber)
+	ut.True(notBefore.Equal(leaf.NotBefore))
+	ut.True(notAfter.Equal(leaf.NotAfter))
+	ut.Equal("spiffe://example.com/ci/runner", leaf.SpiffeID)
+	ut.Equal("https://token.actions.githubusercontent.com", leaf.OidcIssuer)
+	ut.Equal("refs/tags/v1.0.0", leaf.SourceRepositoryRef)
+	ut.Equal("push", leaf.BuildTrigger)
+	ut.Empty(leaf.BuildSignerURI)
+
+	sans := map[subjectaltname.Type][]string{}
+	for _, san := range leaf.Edges.SubjectAltNames {
+		sans[san.Type] = append(sans[san.Type], san.Value)
+	}
+	ut.ElementsMatch([]string{workflow.String(), spiffeID.String()}, sans[subjectaltname.TypeURI])
+	ut.Equal([]string{"ci@example.com"}, sans[subjectaltname.TypeEmail])
+
+	ut.Equal(1, certs[1].ChainIndex)
+	ut.Equal("CN=sigstore-intermediate", certs[1].Subject)
+	ut.Equal("CN=sigstore", certs[1].Issuer)
+	ut.Empty(certs[1].Edges.SubjectAltNames)
+
+	ut.Require().NoError(ut.store.Delete(ctx, "signed"))
+	ut.Equal(0, ut.client.Certificate.Query().CountX(ctx))
+	ut.Equal(0, ut.client.SubjectAltName.Query().CountX(ctx))
+}
+
+func (ut *UTStoreSuite) Test_Store_InvalidCertificate() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", ut.certificateEnvelope(nil, witnessdsse.Signature{
+		KeyID:       "leaf",
+		Signature:   []byte("sig"),
+		Certificate: []byte("not a certificate"),
+	})))
+
+	ut.Equal(1, ut.client.Signature.Query().CountX(ctx))
+	ut.Equal(0, ut.client.Certificate.Query().CountX(ctx))
+}
+
+func (ut *UTStoreSuite) Test_Store_MismatchedCertificate() {
+	ctx := context.Background()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "someone else"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Minute),
+	}
+	certPEM, _, _ := ut.newCertificate(template, nil, nil)
+	_, _, signerKey := ut.newCertificate(template, nil, nil)
+
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", ut.certificateEnvelope(signerKey, witnessdsse.Signature{
+		KeyID:       "leaf",
+		Certificate: certPEM,
+	})))
+
+	ut.Equal(1, ut.client.Signature.Query().CountX(ctx))
+	ut.Equal(0, ut.client.Certificate.Query().CountX(ctx))
+}
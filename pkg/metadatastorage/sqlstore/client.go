@@ -24,6 +24,8 @@ import (
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/in-toto/archivista/ent"
+	// registers the schema's defaults, hooks and interceptors with the generated client
+	_ "github.com/in-toto/archivista/ent/runtime"
 	"github.com/in-toto/archivista/internal/credentialrefresh/driver"
 	"github.com/in-toto/archivista/internal/credentialrefresh/store/static"
 )
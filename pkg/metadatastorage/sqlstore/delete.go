@@ -0,0 +1,148 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/attestation"
+	"github.com/in-toto/archivista/ent/attestationcollection"
+	"github.com/in-toto/archivista/ent/attestationpolicy"
+	"github.com/in-toto/archivista/ent/certificate"
+	"github.com/in-toto/archivista/ent/commandrunattestation"
+	entdsse "github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/environmentattestation"
+	"github.com/in-toto/archivista/ent/gitattestation"
+	"github.com/in-toto/archivista/ent/githubattestation"
+	"github.com/in-toto/archivista/ent/gitlabattestation"
+	"github.com/in-toto/archivista/ent/material"
+	"github.com/in-toto/archivista/ent/ociattestation"
+	"github.com/in-toto/archivista/ent/payloaddigest"
+	"github.com/in-toto/archivista/ent/product"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/sbompackagedigest"
+	"github.com/in-toto/archivista/ent/signature"
+	"github.com/in-toto/archivista/ent/slsadependency"
+	"github.com/in-toto/archivista/ent/slsaprovenance"
+	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectaltname"
+	"github.com/in-toto/archivista/ent/subjectdigest"
+	"github.com/in-toto/archivista/ent/timestamp"
+	"github.com/in-toto/archivista/ent/transparencylogentry"
+	"github.com/in-toto/archivista/ent/verificationsummary"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+)
+
+type deleter interface {
+	Exec(context.Context) (int, error)
+}
+
+// Delete removes the metadata of the envelope with gitoid and everything that was stored
+// from it. Publications and legal holds are kept as a record of where the envelope was
+// published and who froze it. Envelopes under a legal hold can't be deleted.
+func (s *Store) Delete(ctx context.Context, gitoid string) error {
+	return s.withTx(ctx, func(tx *ent.Tx) error {
+		stored, err := tx.Dsse.Query().
+			Where(entdsse.GitoidSha256(gitoid)).
+			WithStatement().
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", metadatastorage.ErrNotFound, gitoid)
+		} else if err != nil {
+			return err
+		}
+
+		held, err := tx.Dsse.Query().Where(entdsse.ID(stored.ID), IsHeld()).Exist(ctx)
+		if err != nil {
+			return err
+		} else if held {
+			return fmt.Errorf("%w: %s", metadatastorage.ErrHeld, gitoid)
+		}
+
+		return deleteDsse(ctx, tx, stored)
+	})
+}
+
+func deleteDsse(ctx context.Context, tx *ent.Tx, stored *ent.Dsse) error {
+	ofDsse := entdsse.ID(stored.ID)
+	ofSignature := signature.HasDsseWith(ofDsse)
+	if err := execDeletes(ctx,
+		tx.Timestamp.Delete().Where(timestamp.HasSignatureWith(ofSignature)),
+		tx.SubjectAltName.Delete().Where(subjectaltname.HasCertificateWith(certificate.HasSignatureWith(ofSignature))),
+		tx.Certificate.Delete().Where(certificate.HasSignatureWith(ofSignature)),
+		tx.Signature.Delete().Where(ofSignature),
+		tx.PayloadDigest.Delete().Where(payloaddigest.HasDsseWith(ofDsse)),
+		tx.TransparencyLogEntry.Delete().Where(transparencylogentry.HasDsseWith(ofDsse)),
+		tx.PublishDelivery.Delete().Where(publishdelivery.HasDsseWith(ofDsse)),
+		tx.Dsse.Delete().Where(ofDsse),
+	); err != nil {
+		return err
+	}
+
+	if stored.Edges.Statement == nil {
+		return nil
+	}
+
+	// each envelope gets its own statement, but leave any statement another envelope refers to
+	stmtID := stored.Edges.Statement.ID
+	shared, err := tx.Dsse.Query().Where(entdsse.HasStatementWith(statement.ID(stmtID))).Exist(ctx)
+	if err != nil || shared {
+		return err
+	}
+
+	return deleteStatement(ctx, tx, stmtID)
+}
+
+func deleteStatement(ctx context.Context, tx *ent.Tx, stmtID uuid.UUID) error {
+	ofStatement := statement.ID(stmtID)
+	ofAttestation := attestation.HasAttestationCollectionWith(attestationcollection.HasStatementWith(ofStatement))
+	return execDeletes(ctx,
+		tx.SubjectDigest.Delete().Where(subjectdigest.HasSubjectWith(subject.HasStatementWith(ofStatement))),
+		tx.Subject.Delete().Where(subject.HasStatementWith(ofStatement)),
+		tx.GitAttestation.Delete().Where(gitattestation.HasAttestationWith(ofAttestation)),
+		tx.GithubAttestation.Delete().Where(githubattestation.HasAttestationWith(ofAttestation)),
+		tx.GitlabAttestation.Delete().Where(gitlabattestation.HasAttestationWith(ofAttestation)),
+		tx.EnvironmentAttestation.Delete().Where(environmentattestation.HasAttestationWith(ofAttestation)),
+		tx.CommandRunAttestation.Delete().Where(commandrunattestation.HasAttestationWith(ofAttestation)),
+		tx.OciAttestation.Delete().Where(ociattestation.HasAttestationWith(ofAttestation)),
+		tx.Material.Delete().Where(material.HasAttestationWith(ofAttestation)),
+		tx.Product.Delete().Where(product.HasAttestationWith(ofAttestation)),
+		tx.Attestation.Delete().Where(ofAttestation),
+		tx.AttestationCollection.Delete().Where(attestationcollection.HasStatementWith(ofStatement)),
+		tx.AttestationPolicy.Delete().Where(attestationpolicy.HasStatementWith(ofStatement)),
+		tx.SlsaDependency.Delete().Where(slsadependency.HasSlsaProvenanceWith(slsaprovenance.HasStatementWith(ofStatement))),
+		tx.SlsaProvenance.Delete().Where(slsaprovenance.HasStatementWith(ofStatement)),
+		tx.VerificationSummary.Delete().Where(verificationsummary.HasStatementWith(ofStatement)),
+		tx.SbomPackageDigest.Delete().Where(sbompackagedigest.HasSbomPackageWith(sbompackage.HasStatementWith(ofStatement))),
+		tx.SbomPackage.Delete().Where(sbompackage.HasStatementWith(ofStatement)),
+		tx.Statement.Delete().Where(ofStatement),
+	)
+}
+
+// execDeletes runs each delete in order, so rows are removed before the rows they refer to
+func execDeletes(ctx context.Context, deletes ...deleter) error {
+	for _, d := range deletes {
+		if _, err := d.Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
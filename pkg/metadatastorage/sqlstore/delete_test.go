@@ -0,0 +1,110 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/in-toto/archivista/pkg/metadatastorage/attestationcollection"
+	"github.com/in-toto/archivista/pkg/metadatastorage/slsaprovenance"
+	"github.com/in-toto/archivista/pkg/metadatastorage/vsa"
+	"github.com/in-toto/archivista/pkg/tenant"
+	witnessdsse "github.com/in-toto/go-witness/dsse"
+)
+
+func collectionEnvelope(collectionName, subjectName string) []byte {
+	statement := fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v0.1","subject":[{"name":%q,"digest":{"sha256":"abcd","sha1":"ef01"}}],"predicateType":%q,"predicate":{"name":%q,"attestations":[{"type":"https://witness.dev/attestations/material/v0.1","attestation":{"go.mod":{"sha256":"abcd"}}},{"type":"https://witness.dev/attestations/git/v0.1","attestation":{"commithash":"0123","branch":"main"}}]}}`,
+		subjectName, attestationcollection.Predicate, collectionName)
+	env, _ := json.Marshal(witnessdsse.Envelope{
+		PayloadType: "application/vnd.in-toto+json",
+		Payload:     []byte(statement),
+		Signatures:  []witnessdsse.Signature{{KeyID: "key", Signature: []byte("sig")}},
+	})
+	return env
+}
+
+func predicateEnvelope(predicateType, predicate string) []byte {
+	statement := fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v1","subject":[{"name":"artifact","digest":{"sha256":"abcd"}}],"predicateType":%q,"predicate":%s}`, predicateType, predicate)
+	env, _ := json.Marshal(witnessdsse.Envelope{PayloadType: "application/vnd.in-toto+json", Payload: []byte(statement)})
+	return env
+}
+
+func (ut *UTStoreSuite) Test_Delete_Cascades() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", collectionEnvelope("build", "artifact")))
+	ut.Require().NoError(ut.store.Store(ctx, "kept", collectionEnvelope("test", "other-artifact")))
+	ut.client.Timestamp.Create().
+		SetSignature(ut.client.Signature.Query().FirstX(ctx)).
+		SetType("tsp").
+		SetTimestamp(time.Now()).
+		SaveX(ctx)
+
+	ut.Require().NoError(ut.store.Delete(ctx, "gitoid"))
+
+	exists, err := ut.store.Exists(ctx, "gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+	ut.Equal(1, ut.client.Dsse.Query().CountX(ctx))
+	ut.Equal(1, ut.client.Signature.Query().CountX(ctx))
+	ut.Equal(0, ut.client.Timestamp.Query().CountX(ctx))
+	ut.Equal(1, ut.client.PayloadDigest.Query().CountX(ctx))
+	ut.Equal(1, ut.client.Statement.Query().CountX(ctx))
+	ut.Equal(1, ut.client.Subject.Query().CountX(ctx))
+	ut.Equal(2, ut.client.SubjectDigest.Query().CountX(ctx))
+	ut.Equal(1, ut.client.AttestationCollection.Query().CountX(ctx))
+	ut.Equal(2, ut.client.Attestation.Query().CountX(ctx))
+	ut.Equal(1, ut.client.Material.Query().CountX(ctx))
+	ut.Equal(1, ut.client.GitAttestation.Query().CountX(ctx))
+	ut.Equal("test", ut.client.AttestationCollection.Query().OnlyX(ctx).Name)
+}
+
+func (ut *UTStoreSuite) Test_Delete_CascadesPredicates() {
+	ctx := context.Background()
+	provenance := `{"buildDefinition":{"buildType":"https://example.com/build","resolvedDependencies":[{"uri":"git+https://example.com/repo","digest":{"gitCommit":"0123"}}]},"runDetails":{"builder":{"id":"https://example.com/builder"}}}`
+	summary := `{"verifier":{"id":"https://example.com/verifier"},"resourceUri":"pkg:oci/app","verificationResult":"PASSED"}`
+	ut.Require().NoError(ut.store.Store(ctx, "provenance", predicateEnvelope(slsaprovenance.Predicate, provenance)))
+	ut.Require().NoError(ut.store.Store(ctx, "summary", predicateEnvelope(vsa.Predicate, summary)))
+	ut.Equal(1, ut.client.SlsaDependency.Query().CountX(ctx))
+
+	ut.Require().NoError(ut.store.Delete(ctx, "provenance"))
+	ut.Require().NoError(ut.store.Delete(ctx, "summary"))
+	ut.Equal(0, ut.client.SlsaDependency.Query().CountX(ctx))
+	ut.Equal(0, ut.client.SlsaProvenance.Query().CountX(ctx))
+	ut.Equal(0, ut.client.VerificationSummary.Query().CountX(ctx))
+	ut.Equal(0, ut.client.Statement.Query().CountX(ctx))
+}
+
+func (ut *UTStoreSuite) Test_Delete_NotFound() {
+	ut.ErrorIs(ut.store.Delete(context.Background(), "missing"), metadatastorage.ErrNotFound)
+}
+
+func (ut *UTStoreSuite) Test_Delete_IsScopedToTenant() {
+	teamA := tenant.NewContext(context.Background(), "team-a")
+	teamB := tenant.NewContext(context.Background(), "team-b")
+	ut.Require().NoError(ut.store.Store(teamA, "gitoid", envelope("artifact")))
+	ut.Require().NoError(ut.store.Store(teamB, "gitoid", envelope("artifact")))
+
+	ut.Require().NoError(ut.store.Delete(teamA, "gitoid"))
+	ut.ErrorIs(ut.store.Delete(teamA, "gitoid"), metadatastorage.ErrNotFound)
+
+	exists, err := ut.store.Exists(teamB, "gitoid")
+	ut.NoError(err)
+	ut.True(exists)
+	ut.Equal(1, ut.client.Statement.Query().CountX(context.Background()))
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent"
+	entdsse "github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/ent/subjectdigest"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+)
+
+// IsHeld matches envelopes under at least one legal hold that hasn't been released
+func IsHeld() predicate.Dsse {
+	return entdsse.HasLegalHoldsWith(legalhold.ReleasedAtIsNil())
+}
+
+// Hold places the envelopes selected by hold under a new legal hold and returns it with the
+// held envelopes loaded. Envelopes archived after the hold is placed are not held by it.
+func (s *Store) Hold(ctx context.Context, hold metadatastorage.Hold) (*ent.LegalHold, error) {
+	if hold.Reason == "" {
+		return nil, errors.New("a reason is required to place a legal hold")
+	}
+
+	selectors := make([]predicate.Dsse, 0, 2)
+	if len(hold.Gitoids) > 0 {
+		selectors = append(selectors, entdsse.GitoidSha256In(hold.Gitoids...))
+	}
+
+	subjectDigest := ""
+	if hold.SubjectDigest != "" {
+		subjectDigest = fmt.Sprintf("%s:%s", hold.SubjectDigestAlgorithm, hold.SubjectDigest)
+		selectors = append(selectors, entdsse.HasStatementWith(statement.HasSubjectsWith(subject.HasSubjectDigestsWith(
+			subjectdigest.Algorithm(hold.SubjectDigestAlgorithm),
+			subjectdigest.Value(hold.SubjectDigest),
+		))))
+	}
+
+	if len(selectors) == 0 {
+		return nil, errors.New("gitoids or a subject digest are required to place a legal hold")
+	}
+
+	var created *ent.LegalHold
+	err := s.withTx(ctx, func(tx *ent.Tx) error {
+		ids, err := tx.Dsse.Query().Where(entdsse.Or(selectors...)).IDs(ctx)
+		if err != nil {
+			return err
+		} else if len(ids) == 0 {
+			return fmt.Errorf("%w: no envelopes match the legal hold", metadatastorage.ErrNotFound)
+		}
+
+		created, err = tx.LegalHold.Create().
+			SetReason(hold.Reason).
+			SetSubjectDigest(subjectDigest).
+			SetCreatedBy(hold.CreatedBy).
+			AddDssIDs(ids...).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		created.Edges.Dsses, err = created.QueryDsses().All(ctx)
+		return err
+	})
+
+	return created, err
+}
+
+// ReleaseHold releases the legal hold with id and returns it with the envelopes it held
+// loaded. Releasing a hold that is already released leaves it unchanged.
+func (s *Store) ReleaseHold(ctx context.Context, id uuid.UUID, releasedBy string) (*ent.LegalHold, error) {
+	var released *ent.LegalHold
+	err := s.withTx(ctx, func(tx *ent.Tx) error {
+		hold, err := tx.LegalHold.Query().Where(legalhold.ID(id)).WithDsses().Only(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", metadatastorage.ErrHoldNotFound, id)
+		} else if err != nil {
+			return err
+		}
+
+		if hold.ReleasedAt != nil {
+			released = hold
+			return nil
+		}
+
+		released, err = hold.Update().
+			SetReleasedAt(time.Now()).
+			SetReleasedBy(releasedBy).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		released.Edges.Dsses = hold.Edges.Dsses
+		return nil
+	})
+
+	return released, err
+}
+
+// Held reports whether the envelope with gitoid is under a legal hold
+func (s *Store) Held(ctx context.Context, gitoid string) (bool, error) {
+	return s.client.Dsse.Query().Where(entdsse.GitoidSha256(gitoid), IsHeld()).Exist(ctx)
+}
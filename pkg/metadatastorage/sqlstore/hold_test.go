@@ -0,0 +1,97 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlstore
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/in-toto/archivista/pkg/tenant"
+)
+
+func (ut *UTStoreSuite) Test_Hold_BlocksDelete() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", envelope("artifact")))
+
+	hold, err := ut.store.Hold(ctx, metadatastorage.Hold{Reason: "release 1.0 audit", CreatedBy: "auditor", Gitoids: []string{"gitoid", "missing"}})
+	ut.Require().NoError(err)
+	ut.Require().Len(hold.Edges.Dsses, 1)
+	ut.Equal("gitoid", hold.Edges.Dsses[0].GitoidSha256)
+	ut.Equal("auditor", hold.CreatedBy)
+
+	held, err := ut.store.Held(ctx, "gitoid")
+	ut.NoError(err)
+	ut.True(held)
+	ut.ErrorIs(ut.store.Delete(ctx, "gitoid"), metadatastorage.ErrHeld)
+
+	released, err := ut.store.ReleaseHold(ctx, hold.ID, "auditor")
+	ut.Require().NoError(err)
+	ut.Require().NotNil(released.ReleasedAt)
+	ut.Len(released.Edges.Dsses, 1)
+
+	// releasing again leaves the original release in place
+	again, err := ut.store.ReleaseHold(ctx, hold.ID, "someone-else")
+	ut.NoError(err)
+	ut.Equal("auditor", again.ReleasedBy)
+
+	ut.NoError(ut.store.Delete(ctx, "gitoid"))
+	// the released hold is kept as a record
+	ut.Equal(1, ut.client.LegalHold.Query().Where(legalhold.ReleasedBy("auditor")).CountX(ctx))
+}
+
+func (ut *UTStoreSuite) Test_Hold_BySubjectDigest() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "first", collectionEnvelope("build", "artifact")))
+	ut.Require().NoError(ut.store.Store(ctx, "second", collectionEnvelope("test", "artifact")))
+	ut.Require().NoError(ut.store.Store(ctx, "other", envelope("other-artifact")))
+
+	// envelope() subjects have a sha256 of abcd too, but only collectionEnvelope() has the sha1
+	hold, err := ut.store.Hold(ctx, metadatastorage.Hold{Reason: "shipped", SubjectDigestAlgorithm: "sha1", SubjectDigest: "ef01"})
+	ut.Require().NoError(err)
+	ut.Len(hold.Edges.Dsses, 2)
+	ut.Equal("sha1:ef01", hold.SubjectDigest)
+
+	held, err := ut.store.Held(ctx, "other")
+	ut.NoError(err)
+	ut.False(held)
+}
+
+func (ut *UTStoreSuite) Test_Hold_NothingMatches() {
+	_, err := ut.store.Hold(context.Background(), metadatastorage.Hold{Reason: "audit", Gitoids: []string{"missing"}})
+	ut.ErrorIs(err, metadatastorage.ErrNotFound)
+
+	_, err = ut.store.Hold(context.Background(), metadatastorage.Hold{Reason: "audit"})
+	ut.Error(err)
+
+	_, err = ut.store.ReleaseHold(context.Background(), uuid.New(), "auditor")
+	ut.ErrorIs(err, metadatastorage.ErrHoldNotFound)
+}
+
+func (ut *UTStoreSuite) Test_Hold_IsScopedToTenant() {
+	teamA := tenant.NewContext(context.Background(), "team-a")
+	teamB := tenant.NewContext(context.Background(), "team-b")
+	ut.Require().NoError(ut.store.Store(teamA, "gitoid", envelope("artifact")))
+	ut.Require().NoError(ut.store.Store(teamB, "gitoid", envelope("artifact")))
+
+	hold, err := ut.store.Hold(teamA, metadatastorage.Hold{Reason: "audit", Gitoids: []string{"gitoid"}})
+	ut.Require().NoError(err)
+	ut.Len(hold.Edges.Dsses, 1)
+	ut.NoError(ut.store.Delete(teamB, "gitoid"))
+
+	_, err = ut.store.ReleaseHold(teamB, hold.ID, "intruder")
+	ut.ErrorIs(err, metadatastorage.ErrHoldNotFound)
+}
@@ -19,14 +19,21 @@ import (
 	"crypto"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
 	"github.com/digitorus/timestamp"
 	"github.com/in-toto/archivista/ent"
+	entdsse "github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/migrate"
+	"github.com/in-toto/archivista/pkg/bundle"
 	"github.com/in-toto/archivista/pkg/metadatastorage"
 	"github.com/in-toto/archivista/pkg/metadatastorage/parserregistry"
+	"github.com/in-toto/archivista/pkg/verifier"
 	"github.com/in-toto/go-witness/cryptoutil"
 	"github.com/in-toto/go-witness/dsse"
 	"github.com/in-toto/go-witness/intoto"
@@ -45,11 +52,36 @@ const subjectDigestBatchSize = 20000
 // constant for Policy PayloadType
 const policyPayloadType = "https://witness.testifysec.com/policy/"
 
+// ErrLegacyGitoidIndex is returned by New when multi-tenancy is enabled on a database that still
+// has a unique index on gitoids from before envelopes were scoped to a tenant. Another tenant
+// uploading an envelope that is already stored would be told it already exists.
+var ErrLegacyGitoidIndex = errors.New("database has a unique index on gitoids that isn't scoped to a tenant")
+
 type Store struct {
-	client *ent.Client
+	client     *ent.Client
+	publishers []string
+	tenancy    bool
+}
+
+type Option func(*Store)
+
+// WithTenancy tells the store that envelopes are scoped to tenants, so New refuses a database
+// that can only hold an envelope for one tenant
+func WithTenancy() Option {
+	return func(s *Store) {
+		s.tenancy = true
+	}
+}
+
+// WithPublishers records a pending delivery for each named publisher in the same transaction
+// that stores an envelope's metadata, so publishing survives a crash after the upload succeeds.
+func WithPublishers(names ...string) Option {
+	return func(s *Store) {
+		s.publishers = append(s.publishers, names...)
+	}
 }
 
-func New(ctx context.Context, client *ent.Client) (*Store, <-chan error, error) {
+func New(ctx context.Context, client *ent.Client, opts ...Option) (*Store, <-chan error, error) {
 	errCh := make(chan error)
 
 	go func() {
@@ -61,13 +93,63 @@ func New(ctx context.Context, client *ent.Client) (*Store, <-chan error, error)
 		close(errCh)
 	}()
 
-	if err := client.Schema.Create(ctx); err != nil {
+	store := &Store{
+		client: client,
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	legacy := make([]string, 0)
+	if err := client.Schema.Create(ctx, schema.WithDiffHook(findLegacyGitoidIndexes(&legacy))); err != nil {
 		logrus.Fatalf("failed creating schema resources: %v", err)
 	}
 
-	return &Store{
-		client: client,
-	}, errCh, nil
+	if len(legacy) > 0 && store.tenancy {
+		return nil, errCh, fmt.Errorf("%w: %s; run archivista migrate-schema to drop it", ErrLegacyGitoidIndex, strings.Join(legacy, ", "))
+	} else if len(legacy) > 0 {
+		logrus.Warnf("database has unique indexes on gitoids that aren't scoped to a tenant (%s); run archivista migrate-schema before enabling multi-tenancy", strings.Join(legacy, ", "))
+	}
+
+	return store, errCh, nil
+}
+
+// MigrateSchema brings a database that was only ever auto-migrated up to the current schema,
+// dropping indexes the schema no longer declares. This removes the global uniqueness of
+// gitoids, which is scoped to a tenant now, along with any index that was added by hand, so it
+// only runs when an operator asks for it.
+func MigrateSchema(ctx context.Context, client *ent.Client) error {
+	return client.Schema.Create(ctx, migrate.WithDropIndex(true))
+}
+
+// findLegacyGitoidIndexes records the unique indexes on gitoids that don't include the tenant.
+// Auto-migration never drops indexes, so databases created before multi-tenancy keep them until
+// MigrateSchema runs.
+func findLegacyGitoidIndexes(found *[]string) schema.DiffHook {
+	return func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			for _, table := range current.Tables {
+				for _, idx := range table.Indexes {
+					if idx.Unique && indexHasColumn(idx, "gitoid_sha256") && !indexHasColumn(idx, "tenant") {
+						*found = append(*found, table.Name+"."+idx.Name)
+					}
+				}
+			}
+
+			return next.Diff(current, desired)
+		})
+	}
+}
+
+func indexHasColumn(idx *atlas.Index, name string) bool {
+	for _, part := range idx.Parts {
+		if part.C != nil && part.C.Name == name {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (s *Store) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
@@ -112,39 +194,13 @@ func (s *Store) storeAttestation(ctx context.Context, envelope *dsse.Envelope, g
 	}
 
 	err = s.withTx(ctx, func(tx *ent.Tx) error {
-		dsse, err := tx.Dsse.Create().
-			SetPayloadType(envelope.PayloadType).
-			SetGitoidSha256(gitoid).
-			Save(ctx)
+		dsse, err := s.createDsse(ctx, tx, envelope, gitoid)
 		if err != nil {
 			return err
 		}
 
-		for _, sig := range envelope.Signatures {
-			storedSig, err := tx.Signature.Create().
-				SetKeyID(sig.KeyID).
-				SetSignature(base64.StdEncoding.EncodeToString(sig.Signature)).
-				SetDsse(dsse).
-				Save(ctx)
-			if err != nil {
-				return err
-			}
-
-			for _, timestamp := range sig.Timestamps {
-				timestampedTime, err := timeFromTimestamp(timestamp)
-				if err != nil {
-					return err
-				}
-
-				_, err = tx.Timestamp.Create().
-					SetSignature(storedSig).
-					SetTimestamp(timestampedTime).
-					SetType(string(timestamp.Type)).
-					Save(ctx)
-				if err != nil {
-					return err
-				}
-			}
+		if err := createSignatures(ctx, tx, envelope, dsse); err != nil {
+			return err
 		}
 
 		for hashFn, digest := range payloadDigestSet {
@@ -221,6 +277,91 @@ func (s *Store) storeAttestation(ctx context.Context, envelope *dsse.Envelope, g
 	return nil
 }
 
+// createDsse stores the row for the envelope itself along with its pending publish deliveries
+// and any transparency log entries the envelope was unpacked from a bundle with.
+// the gitoid column is unique, so a constraint violation here means a concurrent upload of the
+// same envelope won the race.
+func (s *Store) createDsse(ctx context.Context, tx *ent.Tx, envelope *dsse.Envelope, gitoid string) (*ent.Dsse, error) {
+	stored, err := tx.Dsse.Create().
+		SetPayloadType(envelope.PayloadType).
+		SetGitoidSha256(gitoid).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("%w: %s", metadatastorage.ErrAlreadyExists, gitoid)
+	} else if err != nil {
+		return nil, err
+	}
+
+	for _, publisher := range s.publishers {
+		if err := tx.PublishDelivery.Create().
+			SetGitoidSha256(gitoid).
+			SetPublisher(publisher).
+			SetDsse(stored).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("could not enqueue delivery to %s: %w", publisher, err)
+		}
+	}
+
+	material, _ := bundle.FromContext(ctx)
+	for _, entry := range material.TlogEntries {
+		create := tx.TransparencyLogEntry.Create().
+			SetLogIndex(entry.LogIndex).
+			SetLogID(entry.LogID).
+			SetKind(entry.Kind).
+			SetKindVersion(entry.KindVersion).
+			SetEntry(string(entry.Entry)).
+			SetDsse(stored)
+		if !entry.IntegratedTime.IsZero() {
+			create.SetIntegratedTime(entry.IntegratedTime)
+		}
+
+		if err := create.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("could not store transparency log entry %s/%d: %w", entry.LogID, entry.LogIndex, err)
+		}
+	}
+
+	return stored, nil
+}
+
+// createSignatures stores the envelope's signatures along with their timestamps and certificates
+func createSignatures(ctx context.Context, tx *ent.Tx, envelope *dsse.Envelope, stored *ent.Dsse) error {
+	// the server records which signatures passed verification before handing the envelope to us
+	verification, _ := verifier.FromContext(ctx)
+	for i, sig := range envelope.Signatures {
+		storedSig, err := tx.Signature.Create().
+			SetKeyID(sig.KeyID).
+			SetSignature(base64.StdEncoding.EncodeToString(sig.Signature)).
+			SetVerified(verification.SignatureVerified(i)).
+			SetDsse(stored).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, timestamp := range sig.Timestamps {
+			timestampedTime, err := timeFromTimestamp(timestamp)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Timestamp.Create().
+				SetSignature(storedSig).
+				SetTimestamp(timestampedTime).
+				SetType(string(timestamp.Type)).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := createCertificates(ctx, tx, envelope, sig, storedSig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Store) storePolicy(ctx context.Context, envelope *dsse.Envelope, gitoid string) error {
 	payloadDigestSet, err := cryptoutil.CalculateDigestSetFromBytes(envelope.Payload, []cryptoutil.DigestValue{{Hash: crypto.SHA256}})
 	if err != nil {
@@ -236,40 +377,14 @@ func (s *Store) storePolicy(ctx context.Context, envelope *dsse.Envelope, gitoid
 	custom["gitoid"] = gitoid
 
 	err = s.withTx(ctx, func(tx *ent.Tx) error {
-		dsse, err := tx.Dsse.Create().
-			SetPayloadType(envelope.PayloadType).
-			SetGitoidSha256(gitoid).
-			Save(ctx)
+		dsse, err := s.createDsse(ctx, tx, envelope, gitoid)
 		if err != nil {
 			return err
 		}
 
 		// stores the envelope signatures
-		for _, sig := range envelope.Signatures {
-			storedSig, err := tx.Signature.Create().
-				SetKeyID(sig.KeyID).
-				SetSignature(base64.StdEncoding.EncodeToString(sig.Signature)).
-				SetDsse(dsse).
-				Save(ctx)
-			if err != nil {
-				return err
-			}
-
-			for _, timestamp := range sig.Timestamps {
-				timestampedTime, err := timeFromTimestamp(timestamp)
-				if err != nil {
-					return err
-				}
-
-				_, err = tx.Timestamp.Create().
-					SetSignature(storedSig).
-					SetTimestamp(timestampedTime).
-					SetType(string(timestamp.Type)).
-					Save(ctx)
-				if err != nil {
-					return err
-				}
-			}
+		if err := createSignatures(ctx, tx, envelope, dsse); err != nil {
+			return err
 		}
 
 		// stores the payload digests
@@ -342,6 +457,11 @@ func (s *Store) Store(ctx context.Context, gitoid string, obj []byte) error {
 	return nil
 }
 
+// Exists reports whether metadata for an envelope with the given gitoid has already been stored
+func (s *Store) Exists(ctx context.Context, gitoid string) (bool, error) {
+	return s.client.Dsse.Query().Where(entdsse.GitoidSha256(gitoid)).Exist(ctx)
+}
+
 func (s *Store) GetClient() *ent.Client {
 	return s.client
 }
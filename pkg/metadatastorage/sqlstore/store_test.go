@@ -0,0 +1,188 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/enttest"
+	"github.com/in-toto/archivista/ent/sbompackage"
+	"github.com/in-toto/archivista/ent/subject"
+	"github.com/in-toto/archivista/pkg/bundle"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/in-toto/archivista/pkg/metadatastorage/attestationcollection"
+	"github.com/in-toto/archivista/pkg/tenant"
+	witnessdsse "github.com/in-toto/go-witness/dsse"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT Store
+type UTStoreSuite struct {
+	suite.Suite
+	client *ent.Client
+	store  *Store
+	cancel context.CancelFunc
+}
+
+func TestUTStoreSuite(t *testing.T) {
+	suite.Run(t, new(UTStoreSuite))
+}
+
+func (ut *UTStoreSuite) SetupTest() {
+	ut.client = enttest.Open(ut.T(), "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", ut.T().Name()))
+	var ctx context.Context
+	ctx, ut.cancel = context.WithCancel(context.Background())
+
+	var err error
+	ut.store, _, err = New(ctx, ut.client)
+	ut.Require().NoError(err)
+}
+
+func (ut *UTStoreSuite) TearDownTest() {
+	ut.cancel()
+}
+
+func envelope(subjectName string) []byte {
+	statement := fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v0.1","subject":[{"name":%q,"digest":{"sha256":"abcd"}}],"predicateType":"https://example.com/unparsed","predicate":{}}`, subjectName)
+	env, _ := json.Marshal(witnessdsse.Envelope{PayloadType: "application/vnd.in-toto+json", Payload: []byte(statement)})
+	return env
+}
+
+func (ut *UTStoreSuite) Test_Store_IsolatesTenants() {
+	teamA := tenant.NewContext(context.Background(), "team-a")
+	teamB := tenant.NewContext(context.Background(), "team-b")
+
+	ut.Require().NoError(ut.store.Store(teamA, "gitoid", envelope("a-artifact")))
+	// each tenant may archive the same envelope
+	ut.Require().NoError(ut.store.Store(teamB, "gitoid", envelope("a-artifact")))
+	ut.ErrorIs(ut.store.Store(teamA, "gitoid", envelope("a-artifact")), metadatastorage.ErrAlreadyExists)
+	ut.Require().NoError(ut.store.Store(teamA, "other", envelope("another-artifact")))
+
+	exists, err := ut.store.Exists(tenant.NewContext(context.Background(), "team-c"), "gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+
+	ut.Equal(2, ut.client.Dsse.Query().CountX(teamA))
+	ut.Equal(1, ut.client.Dsse.Query().CountX(teamB))
+	ut.Equal(3, ut.client.Dsse.Query().CountX(context.Background()))
+
+	// traversals are scoped as well as the queries they start from
+	subjects := ut.client.Dsse.Query().Where(dsse.GitoidSha256("gitoid")).QueryStatement().QuerySubjects().AllX(teamB)
+	ut.Require().Len(subjects, 1)
+	ut.Equal("team-b", subjects[0].Tenant)
+	ut.Equal(0, ut.client.Subject.Query().Where(subject.Name("another-artifact")).CountX(teamB))
+
+	for _, sig := range ut.client.Subject.Query().WithSubjectDigests().AllX(teamA) {
+		for _, digest := range sig.Edges.SubjectDigests {
+			ut.Equal("team-a", digest.Tenant)
+		}
+	}
+}
+
+func (ut *UTStoreSuite) Test_Mutations_AreScoped() {
+	teamA := tenant.NewContext(context.Background(), "team-a")
+	teamB := tenant.NewContext(context.Background(), "team-b")
+	ut.Require().NoError(ut.store.Store(teamA, "gitoid", envelope("artifact")))
+
+	stored := ut.client.Dsse.Query().OnlyX(teamA)
+	_, err := ut.client.Dsse.UpdateOneID(stored.ID).SetPayloadType("changed").Save(teamB)
+	ut.True(ent.IsNotFound(err))
+
+	deleted, err := ut.client.PayloadDigest.Delete().Exec(teamB)
+	ut.NoError(err)
+	ut.Equal(0, deleted)
+	ut.Equal(1, ut.client.PayloadDigest.Query().CountX(teamA))
+}
+
+func (ut *UTStoreSuite) Test_Store_WithoutTenant() {
+	ut.Require().NoError(ut.store.Store(context.Background(), "gitoid", envelope("artifact")))
+
+	stored := ut.client.Dsse.Query().OnlyX(tenant.NewContext(context.Background(), tenant.Default))
+	ut.Equal(tenant.Default, stored.Tenant)
+}
+
+func (ut *UTStoreSuite) Test_Store_IndexesSbomAttestations() {
+	ctx := context.Background()
+	bom := `{"bomFormat":"CycloneDX","specVersion":"1.5","components":[{"name":"log4j-core","version":"2.14.1","purl":"pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"}]}`
+	collection := `{"name":"build","attestations":[{"type":"https://cyclonedx.org/bom","attestation":` + bom + `}]}`
+	ut.Require().NoError(ut.store.Store(ctx, "collection", predicateEnvelope(attestationcollection.Predicate, collection)))
+	ut.Require().NoError(ut.store.Store(ctx, "sbom", predicateEnvelope("https://cyclonedx.org/bom/v1.5", bom)))
+
+	gitoids := ut.client.SbomPackage.Query().
+		Where(sbompackage.Purl("pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1")).
+		QueryStatement().QueryDsse().Order(dsse.ByGitoidSha256()).
+		Select(dsse.FieldGitoidSha256).StringsX(ctx)
+	ut.Equal([]string{"collection", "sbom"}, gitoids)
+
+	ut.Require().NoError(ut.store.Delete(ctx, "collection"))
+	ut.Equal(1, ut.client.SbomPackage.Query().CountX(ctx))
+}
+
+func (ut *UTStoreSuite) Test_Store_KeepsTransparencyLogEntries() {
+	ctx := context.Background()
+	integrated := time.Unix(1700000000, 0).UTC()
+	material := bundle.Material{TlogEntries: []bundle.TlogEntry{{
+		LogIndex:       25579,
+		LogID:          "c0d2",
+		Kind:           "dsse",
+		KindVersion:    "0.0.1",
+		IntegratedTime: integrated,
+		Entry:          json.RawMessage(`{"logIndex":"25579"}`),
+	}}}
+	ut.Require().NoError(ut.store.Store(bundle.NewContext(ctx, material), "bundled", envelope("artifact")))
+	ut.Require().NoError(ut.store.Store(ctx, "plain", envelope("artifact")))
+
+	entry := ut.client.TransparencyLogEntry.Query().WithDsse().OnlyX(ctx)
+	ut.Equal(int64(25579), entry.LogIndex)
+	ut.Equal("c0d2", entry.LogID)
+	ut.Equal("dsse", entry.Kind)
+	ut.Equal("0.0.1", entry.KindVersion)
+	ut.Require().NotNil(entry.IntegratedTime)
+	ut.True(integrated.Equal(*entry.IntegratedTime))
+	ut.Equal(`{"logIndex":"25579"}`, entry.Entry)
+	ut.Equal("bundled", entry.Edges.Dsse.GitoidSha256)
+
+	ut.Require().NoError(ut.store.Delete(ctx, "bundled"))
+	ut.Equal(0, ut.client.TransparencyLogEntry.Query().CountX(ctx))
+}
+
+func (ut *UTStoreSuite) Test_New_LegacyGitoidIndex() {
+	ctx := context.Background()
+	// databases created before multi-tenancy made gitoids unique across every tenant
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", ut.T().Name()))
+	ut.Require().NoError(err)
+	defer db.Close()
+	_, err = db.ExecContext(ctx, "CREATE UNIQUE INDEX gitoid_sha256 ON dsses (gitoid_sha256)")
+	ut.Require().NoError(err)
+
+	_, _, err = New(ctx, ut.client, WithTenancy())
+	ut.ErrorIs(err, ErrLegacyGitoidIndex)
+	ut.ErrorContains(err, "dsses.gitoid_sha256")
+
+	_, _, err = New(ctx, ut.client)
+	ut.NoError(err)
+
+	ut.Require().NoError(MigrateSchema(ctx, ut.client))
+	_, _, err = New(ctx, ut.client, WithTenancy())
+	ut.NoError(err)
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+)
+
+const (
+	Predicate = "https://slsa.dev/verification_summary/v1"
+)
+
+// VerificationSummary is the part of an in-toto Verification Summary Attestation that is stored
+type VerificationSummary struct {
+	Verifier struct {
+		ID string `json:"id"`
+	} `json:"verifier"`
+	TimeVerified *time.Time `json:"timeVerified"`
+	ResourceURI  string     `json:"resourceUri"`
+	Policy       struct {
+		URI string `json:"uri"`
+	} `json:"policy"`
+	VerificationResult string   `json:"verificationResult"`
+	VerifiedLevels     []string `json:"verifiedLevels"`
+	SlsaVersion        string   `json:"slsaVersion"`
+}
+
+func Parse(data []byte) (metadatastorage.Storer, error) {
+	summary := VerificationSummary{}
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return summary, err
+	}
+
+	if summary.Verifier.ID == "" {
+		return summary, errors.New("verification summary has no verifier id")
+	} else if summary.ResourceURI == "" {
+		return summary, errors.New("verification summary has no resource uri")
+	} else if summary.VerificationResult == "" {
+		return summary, errors.New("verification summary has no verification result")
+	}
+
+	return summary, nil
+}
+
+func (summary VerificationSummary) Store(ctx context.Context, tx *ent.Tx, stmtID uuid.UUID) error {
+	return tx.VerificationSummary.Create().
+		SetStatementID(stmtID).
+		SetVerifierID(summary.Verifier.ID).
+		SetNillableTimeVerified(summary.TimeVerified).
+		SetResourceURI(summary.ResourceURI).
+		SetPolicyURI(summary.Policy.URI).
+		SetVerificationResult(summary.VerificationResult).
+		SetVerifiedLevels(summary.VerifiedLevels).
+		SetSlsaVersion(summary.SlsaVersion).
+		Exec(ctx)
+}
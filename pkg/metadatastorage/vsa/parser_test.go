@@ -0,0 +1,63 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsa
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/in-toto/archivista/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_MissingResult(t *testing.T) {
+	_, err := Parse([]byte(`{"verifier":{"id":"https://example.com/verifier"},"resourceUri":"pkg:oci/app"}`))
+	assert.ErrorContains(t, err, "no verification result")
+}
+
+func TestStore(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+
+	storer, err := Parse([]byte(`{
+		"verifier": {"id": "https://example.com/verifier"},
+		"timeVerified": "2026-10-17T12:00:00Z",
+		"resourceUri": "pkg:oci/app@sha256:abcd",
+		"policy": {"uri": "https://example.com/policy", "digest": {"sha256": "ef01"}},
+		"verificationResult": "PASSED",
+		"verifiedLevels": ["SLSA_BUILD_LEVEL_3"],
+		"slsaVersion": "1.0"
+	}`))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	stmt := tx.Statement.Create().SetPredicate(Predicate).SaveX(ctx)
+	require.NoError(t, storer.Store(ctx, tx, stmt.ID))
+	require.NoError(t, tx.Commit())
+
+	stored := client.Statement.Query().QueryVerificationSummary().OnlyX(ctx)
+	assert.Equal(t, "https://example.com/verifier", stored.VerifierID)
+	assert.Equal(t, "pkg:oci/app@sha256:abcd", stored.ResourceURI)
+	assert.Equal(t, "https://example.com/policy", stored.PolicyURI)
+	assert.Equal(t, "PASSED", stored.VerificationResult)
+	assert.Equal(t, []string{"SLSA_BUILD_LEVEL_3"}, stored.VerifiedLevels)
+	assert.Equal(t, "1.0", stored.SlsaVersion)
+	require.NotNil(t, stored.TimeVerified)
+}
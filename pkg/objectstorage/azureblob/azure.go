@@ -0,0 +1,263 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package azureblob keeps envelopes in an Azure Blob Storage container
+package azureblob
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/in-toto/archivista/pkg/tenant"
+)
+
+type Store struct {
+	container   *container.Client
+	compression objectstorage.Compression
+}
+
+type Option func(*Store)
+
+// WithCompression compresses envelopes uploaded to the container, recording the compression in
+// the blob's metadata. Envelopes already stored keep the compression they were uploaded with
+// until they are recompressed.
+func WithCompression(compression objectstorage.Compression) Option {
+	return func(s *Store) {
+		s.compression = compression
+	}
+}
+
+func init() {
+	objectstorage.Register("AZURE", newFromConfig)
+}
+
+// newFromConfig creates the AZURE backend from the ARCHIVISTA_AZURE_BLOB_* configuration. A
+// connection string, such as the one of an Azurite emulator, is used when configured. Otherwise
+// the account URL is authenticated with the default Azure credential chain.
+func newFromConfig(ctx context.Context, cfg *config.Config) (objectstorage.Store, error) {
+	compression, err := objectstorage.ParseCompression(cfg.StorageCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	var client *container.Client
+	if cfg.AzureBlobConnectionString != "" {
+		client, err = container.NewClientFromConnectionString(cfg.AzureBlobConnectionString, cfg.AzureBlobContainerName, nil)
+	} else {
+		client, err = newClientWithDefaultCredential(cfg.AzureBlobAccountURL, cfg.AzureBlobContainerName)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create azure blob client: %w", err)
+	}
+
+	return New(client, WithCompression(compression)), nil
+}
+
+func newClientWithDefaultCredential(accountURL, containerName string) (*container.Client, error) {
+	if accountURL == "" {
+		return nil, fmt.Errorf("an account url or connection string is required")
+	}
+
+	containerURL, err := url.JoinPath(accountURL, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return container.NewClient(containerURL, cred, nil)
+}
+
+// New returns a reader/writer for storing/retrieving attestations in the container of client.
+// The container is checked when the store is started.
+func New(client *container.Client, opts ...Option) *Store {
+	store := &Store{container: client}
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	return store
+}
+
+// Start checks the container exists
+func (s *Store) Start(ctx context.Context) error {
+	if _, err := s.container.GetProperties(ctx, nil); err != nil {
+		return fmt.Errorf("failed to find container: %w", err)
+	}
+
+	return nil
+}
+
+// Close does nothing, since the container client holds no resources that need releasing
+func (s *Store) Close() error {
+	return nil
+}
+
+func (s *Store) Get(ctx context.Context, gitoid string) (io.ReadCloser, error) {
+	resp, err := s.container.NewBlobClient(tenant.ObjectKey(ctx, gitoid)).DownloadStream(ctx, nil)
+	if bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return nil, fmt.Errorf("%w: %s", objectstorage.ErrNotFound, gitoid)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return encoding(resp.Metadata).Decompress(resp.Body)
+}
+
+// encoding returns the compression recorded in the metadata of a blob. Metadata names are case
+// insensitive, and are not returned with the case they were set with.
+func encoding(metadata map[string]*string) objectstorage.Compression {
+	for key, value := range metadata {
+		if strings.EqualFold(key, objectstorage.EncodingMetadataKey) && value != nil {
+			return objectstorage.Compression(*value)
+		}
+	}
+
+	return objectstorage.CompressionNone
+}
+
+// Exists reports whether an envelope with the given gitoid has been uploaded to the container
+func (s *Store) Exists(ctx context.Context, gitoid string) (bool, error) {
+	if _, err := s.container.NewBlobClient(tenant.ObjectKey(ctx, gitoid)).GetProperties(ctx, nil); err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *Store) Store(ctx context.Context, gitoid string, payload []byte) error {
+	return s.StoreStream(ctx, gitoid, bytes.NewReader(payload), int64(len(payload)))
+}
+
+func (s *Store) StoreStream(ctx context.Context, gitoid string, r io.Reader, size int64) error {
+	return s.put(ctx, tenant.ObjectKey(ctx, gitoid), r, size)
+}
+
+// put uploads the envelope read from r under key with the store's compression. The length of
+// the envelope is only checked against size if size isn't negative.
+func (s *Store) put(ctx context.Context, key string, r io.Reader, size int64) error {
+	counter := &objectstorage.CountingReader{R: r}
+	compressed := s.compression.Compress(counter)
+	defer compressed.Close()
+
+	opts := &blockblob.UploadStreamOptions{
+		HTTPHeaders: &blob.HTTPHeaders{BlobContentType: to.Ptr("application/json")},
+	}
+	if s.compression != objectstorage.CompressionNone {
+		opts.Metadata = map[string]*string{objectstorage.EncodingMetadataKey: to.Ptr(string(s.compression))}
+	}
+
+	if _, err := s.container.NewBlockBlobClient(key).UploadStream(ctx, compressed, opts); err != nil {
+		return fmt.Errorf("failed to put blob: %w", err)
+	} else if size >= 0 && counter.N != size {
+		return fmt.Errorf("failed to upload full blob: size %d != uploaded size %d", size, counter.N)
+	}
+
+	return nil
+}
+
+// Delete removes the envelope with gitoid from the container. Deleting an envelope that is not stored is not an error
+func (s *Store) Delete(ctx context.Context, gitoid string) error {
+	_, err := s.container.NewBlobClient(tenant.ObjectKey(ctx, gitoid)).Delete(ctx, nil)
+	if bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+// List calls fn with the key of every envelope in the container
+func (s *Store) List(ctx context.Context, fn func(key string) error) error {
+	pager := s.container.NewListBlobsFlatPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			if err := fn(*item.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Recompress uploads the envelope stored under key again with the store's compression
+func (s *Store) Recompress(ctx context.Context, key string) (bool, error) {
+	resp, err := s.container.NewBlobClient(key).DownloadStream(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	compression := encoding(resp.Metadata)
+	if compression == s.compression {
+		resp.Body.Close()
+		return false, nil
+	}
+
+	r, err := compression.Decompress(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	return true, s.put(ctx, key, r, -1)
+}
+
+// Rewrite replaces the envelope stored under key with the one returned by fn
+func (s *Store) Rewrite(ctx context.Context, key string, fn func(io.Reader) (io.ReadCloser, error)) (bool, error) {
+	resp, err := s.container.NewBlobClient(key).DownloadStream(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	compression := encoding(resp.Metadata)
+
+	r, err := compression.Decompress(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	rewritten, err := fn(r)
+	if err != nil || rewritten == nil {
+		return false, err
+	}
+	defer rewritten.Close()
+
+	return true, s.put(ctx, key, rewritten, -1)
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureblob_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/in-toto/archivista/pkg/objectstorage/azureblob"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	account       = "devstoreaccount1"
+	containerName = "envelopes"
+	// the well known key of the Azurite emulator
+	accountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+// fakeAzure serves the parts of the Blob service REST API the store uses
+type fakeAzure struct {
+	mu       sync.Mutex
+	blobs    map[string][]byte
+	metadata map[string]http.Header
+	blocks   map[string][]byte
+}
+
+func (f *fakeAzure) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	prefix := "/" + account + "/" + containerName
+	if r.URL.Path == prefix && r.URL.Query().Get("comp") == "list" {
+		list := &bytes.Buffer{}
+		list.WriteString(`<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+		for name := range f.blobs {
+			fmt.Fprintf(list, "<Blob><Name>%s</Name><Properties></Properties></Blob>", name)
+		}
+		list.WriteString("</Blobs><NextMarker /></EnumerationResults>")
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write(list.Bytes())
+		return
+	} else if r.URL.Path == prefix && r.URL.Query().Get("restype") == "container" {
+		w.WriteHeader(http.StatusOK)
+		return
+	} else if !strings.HasPrefix(r.URL.Path, prefix+"/") {
+		writeError(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, prefix+"/")
+	content, ok := f.blobs[name]
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		switch r.URL.Query().Get("comp") {
+		case "block":
+			f.blocks[r.URL.Query().Get("blockid")] = body
+		case "blocklist":
+			blockList := struct {
+				Latest []string `xml:"Latest"`
+			}{}
+			if err := xml.Unmarshal(body, &blockList); err != nil {
+				writeError(w, http.StatusBadRequest, "InvalidXmlDocument")
+				return
+			}
+
+			content := &bytes.Buffer{}
+			for _, id := range blockList.Latest {
+				content.Write(f.blocks[id])
+			}
+
+			f.blobs[name] = content.Bytes()
+			f.metadata[name] = userMetadata(r.Header)
+		default:
+			f.blobs[name] = body
+			f.metadata[name] = userMetadata(r.Header)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+
+	case http.MethodGet, http.MethodHead:
+		if !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+
+		for key, values := range f.metadata[name] {
+			w.Header()[key] = values
+		}
+
+		w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+		w.Header().Set("x-ms-blob-type", "BlockBlob")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(content)
+		}
+
+	case http.MethodDelete:
+		if !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+
+		delete(f.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func userMetadata(header http.Header) http.Header {
+	metadata := http.Header{}
+	for key, values := range header {
+		if strings.HasPrefix(strings.ToLower(key), "x-ms-meta-") {
+			metadata[key] = values
+		}
+	}
+
+	return metadata
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(status)
+}
+
+// Test Suite: UT AzureBlobStore
+type UTAzureBlobStoreSuite struct {
+	suite.Suite
+	fake   *fakeAzure
+	server *httptest.Server
+	store  *azureblob.Store
+}
+
+func TestUTAzureBlobStoreSuite(t *testing.T) {
+	suite.Run(t, new(UTAzureBlobStoreSuite))
+}
+
+func (ut *UTAzureBlobStoreSuite) client(name string) *container.Client {
+	connectionString := fmt.Sprintf("DefaultEndpointsProtocol=http;AccountName=%s;AccountKey=%s;BlobEndpoint=%s/%s;", account, accountKey, ut.server.URL, account)
+	client, err := container.NewClientFromConnectionString(connectionString, name, nil)
+	ut.Require().NoError(err)
+	return client
+}
+
+func (ut *UTAzureBlobStoreSuite) SetupTest() {
+	ut.fake = &fakeAzure{blobs: map[string][]byte{}, metadata: map[string]http.Header{}, blocks: map[string][]byte{}}
+	ut.server = httptest.NewServer(ut.fake)
+	ut.store = ut.newStore(objectstorage.CompressionNone)
+}
+
+func (ut *UTAzureBlobStoreSuite) newStore(compression objectstorage.Compression) *azureblob.Store {
+	store := azureblob.New(ut.client(containerName), azureblob.WithCompression(compression))
+	ut.Require().NoError(store.Start(context.Background()))
+	return store
+}
+
+func (ut *UTAzureBlobStoreSuite) TearDownTest() {
+	ut.server.Close()
+}
+
+func (ut *UTAzureBlobStoreSuite) Test_StoreGet() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", []byte("envelope")))
+	ut.Equal([]byte("envelope"), ut.fake.blobs["gitoid"])
+
+	r, err := ut.store.Get(ctx, "gitoid")
+	ut.Require().NoError(err)
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	ut.NoError(err)
+	ut.Equal("envelope", string(content))
+
+	exists, err := ut.store.Exists(ctx, "gitoid")
+	ut.NoError(err)
+	ut.True(exists)
+}
+
+func (ut *UTAzureBlobStoreSuite) Test_StoreStream_Tenant() {
+	ctx := tenant.NewContext(context.Background(), "acme")
+	ut.Require().NoError(ut.store.StoreStream(ctx, "gitoid", strings.NewReader("envelope"), 8))
+	ut.Equal([]byte("envelope"), ut.fake.blobs["acme/gitoid"])
+
+	ut.ErrorContains(ut.store.StoreStream(ctx, "short", strings.NewReader("envelope"), 9), "size 9 != uploaded size 8")
+}
+
+func (ut *UTAzureBlobStoreSuite) Test_NotFound() {
+	ctx := context.Background()
+	_, err := ut.store.Get(ctx, "missing")
+	ut.True(errors.Is(err, objectstorage.ErrNotFound))
+
+	exists, err := ut.store.Exists(ctx, "missing")
+	ut.NoError(err)
+	ut.False(exists)
+}
+
+func (ut *UTAzureBlobStoreSuite) Test_Delete() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", []byte("envelope")))
+	ut.NoError(ut.store.Delete(ctx, "gitoid"))
+	ut.NotContains(ut.fake.blobs, "gitoid")
+	ut.NoError(ut.store.Delete(ctx, "gitoid"))
+}
+
+func (ut *UTAzureBlobStoreSuite) Test_Start_MissingContainer() {
+	store := azureblob.New(ut.client("missing"))
+	ut.ErrorContains(store.Start(context.Background()), "failed to find container")
+}
+
+func (ut *UTAzureBlobStoreSuite) Test_Compression() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "plain", []byte("plain envelope")))
+
+	store := ut.newStore(objectstorage.CompressionGzip)
+	ut.Require().NoError(store.Store(ctx, "gitoid", []byte("envelope")))
+	ut.Equal("gzip", ut.fake.metadata["gitoid"].Get("x-ms-meta-"+objectstorage.EncodingMetadataKey))
+	ut.NotEqual([]byte("envelope"), ut.fake.blobs["gitoid"])
+	ut.Equal("envelope", ut.read(store, "gitoid"))
+	ut.Equal("envelope", ut.read(ut.store, "gitoid"))
+	ut.Equal("plain envelope", ut.read(store, "plain"))
+
+	result, err := objectstorage.RecompressAll(ctx, store, func(key string, err error) { ut.Fail(key, err) })
+	ut.NoError(err)
+	ut.Equal(objectstorage.RecompressResult{Checked: 2, Recompressed: 1}, result)
+	ut.Equal("gzip", ut.fake.metadata["plain"].Get("x-ms-meta-"+objectstorage.EncodingMetadataKey))
+	ut.Equal("plain envelope", ut.read(ut.store, "plain"))
+}
+
+func (ut *UTAzureBlobStoreSuite) Test_Rewrite() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", []byte("envelope")))
+
+	rewritten, err := ut.store.Rewrite(ctx, "gitoid", func(r io.Reader) (io.ReadCloser, error) {
+		content, err := io.ReadAll(r)
+		return io.NopCloser(strings.NewReader(strings.ToUpper(string(content)))), err
+	})
+	ut.NoError(err)
+	ut.True(rewritten)
+	ut.Equal([]byte("ENVELOPE"), ut.fake.blobs["gitoid"])
+
+	rewritten, err = ut.store.Rewrite(ctx, "gitoid", func(io.Reader) (io.ReadCloser, error) { return nil, nil })
+	ut.NoError(err)
+	ut.False(rewritten)
+	ut.Equal([]byte("ENVELOPE"), ut.fake.blobs["gitoid"])
+}
+
+func (ut *UTAzureBlobStoreSuite) read(store *azureblob.Store, gitoid string) string {
+	r, err := store.Get(context.Background(), gitoid)
+	ut.Require().NoError(err)
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	ut.Require().NoError(err)
+	return string(content)
+}
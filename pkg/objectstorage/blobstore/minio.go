@@ -18,68 +18,349 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"github.com/minio/minio-go/v7"
 	"io"
+	"strings"
+	"time"
 
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
 type Store struct {
-	client   *minio.Client
-	bucket   string
-	location string
+	client          *minio.Client
+	bucket          string
+	location        string
+	legalHolds      bool
+	retentionMode   minio.RetentionMode
+	retentionPeriod time.Duration
+	compression     objectstorage.Compression
+}
+
+type Option func(*Store)
+
+// WithCompression compresses envelopes uploaded to the bucket, recording the compression in the
+// object's metadata. Envelopes already stored keep the compression they were uploaded with
+// until they are recompressed.
+func WithCompression(compression objectstorage.Compression) Option {
+	return func(s *Store) {
+		s.compression = compression
+	}
+}
+
+func init() {
+	objectstorage.Register("BLOB", newFromConfig)
+}
+
+// newFromConfig creates the BLOB backend from the ARCHIVISTA_BLOB_STORE_* configuration
+func newFromConfig(ctx context.Context, cfg *config.Config) (objectstorage.Store, error) {
+	var creds *credentials.Credentials
+	switch cfg.BlobStoreCredentialType {
+	case "IAM":
+		creds = credentials.NewIAM("")
+	case "ACCESS_KEY":
+		creds = credentials.NewStaticV4(cfg.BlobStoreAccessKeyId, cfg.BlobStoreSecretAccessKeyId, "")
+	default:
+		return nil, fmt.Errorf("invalid blob store credential type: %s", cfg.BlobStoreCredentialType)
+	}
+
+	compression, err := objectstorage.ParseCompression(cfg.StorageCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []Option{WithCompression(compression)}
+	if cfg.BlobStoreLegalHolds {
+		opts = append(opts, WithLegalHolds())
+	}
+
+	if cfg.BlobStoreRetentionPeriod > 0 {
+		opts = append(opts, WithRetention(minio.RetentionMode(strings.ToUpper(cfg.BlobStoreRetentionMode)), cfg.BlobStoreRetentionPeriod))
+	}
+
+	store, err := New(cfg.BlobStoreEndpoint, creds, cfg.BlobStoreBucketName, cfg.BlobStoreUseTLS, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// WithLegalHolds mirrors legal holds placed on envelopes onto S3 object-lock legal holds, so
+// the bucket refuses to delete them as well. The bucket must have object locking enabled.
+func WithLegalHolds() Option {
+	return func(s *Store) {
+		s.legalHolds = true
+	}
+}
+
+// WithRetention locks every uploaded envelope for period with the S3 object-lock retention
+// mode. The bucket must have object locking enabled.
+func WithRetention(mode minio.RetentionMode, period time.Duration) Option {
+	return func(s *Store) {
+		s.retentionMode = mode
+		s.retentionPeriod = period
+	}
 }
 
 // PutBlob stores the attestation blob into the backend store
 func (store *Store) PutBlob(ctx context.Context, idx string, obj []byte) error {
+	return store.PutBlobStream(ctx, idx, bytes.NewReader(obj), int64(len(obj)))
+}
+
+// PutBlobStream streams size bytes from r into the backend store
+func (store *Store) PutBlobStream(ctx context.Context, idx string, r io.Reader, size int64) error {
+	return store.put(ctx, idx, r, size)
+}
+
+// put uploads the envelope read from r under idx with the store's compression. The length of
+// the envelope is only checked against size if size isn't negative.
+func (store *Store) put(ctx context.Context, idx string, r io.Reader, size int64) error {
 	opt := minio.PutObjectOptions{}
-	size := int64(len(obj))
-	n, err := store.client.PutObject(ctx, store.bucket, idx, bytes.NewReader(obj), size, opt)
-	if err != nil {
+	if store.retentionPeriod > 0 {
+		opt.Mode = store.retentionMode
+		opt.RetainUntilDate = time.Now().Add(store.retentionPeriod)
+	}
+
+	if store.compression == objectstorage.CompressionNone {
+		n, err := store.client.PutObject(ctx, store.bucket, idx, r, size, opt)
+		if err != nil {
+			return fmt.Errorf("failed to put blob: %v", err)
+		} else if size >= 0 && n.Size != size {
+			return fmt.Errorf("failed to upload full blob: size %d != uploaded size %d", size, n.Size)
+		}
+
+		return nil
+	}
+
+	// the compressed size isn't known until the envelope has been uploaded
+	counter := &objectstorage.CountingReader{R: r}
+	compressed := store.compression.Compress(counter)
+	defer compressed.Close()
+	opt.UserMetadata = map[string]string{objectstorage.EncodingMetadataKey: string(store.compression)}
+	if _, err := store.client.PutObject(ctx, store.bucket, idx, compressed, -1, opt); err != nil {
 		return fmt.Errorf("failed to put blob: %v", err)
-	} else if n.Size != size {
-		return fmt.Errorf("failed to upload full blob: size %d != uploaded size %d", size, n.Size)
+	} else if size >= 0 && counter.N != size {
+		return fmt.Errorf("failed to upload full blob: size %d != uploaded size %d", size, counter.N)
 	}
+
 	return nil
 }
 
-// New returns a reader/writer for storing/retrieving attestations
-func New(ctx context.Context, endpoint string, creds *credentials.Credentials, bucketName string, useTLS bool) (*Store, <-chan error, error) {
-	errCh := make(chan error)
-	go func() {
-		<-ctx.Done()
-		close(errCh)
-	}()
+// encoding returns the compression recorded in the metadata of an object
+func encoding(info minio.ObjectInfo) objectstorage.Compression {
+	for key, value := range info.UserMetadata {
+		if strings.EqualFold(key, objectstorage.EncodingMetadataKey) {
+			return objectstorage.Compression(value)
+		}
+	}
+
+	return objectstorage.CompressionNone
+}
 
+// New returns a reader/writer for storing/retrieving attestations. The bucket is checked when the
+// store is started.
+func New(endpoint string, creds *credentials.Credentials, bucketName string, useTLS bool, opts ...Option) (*Store, error) {
 	c, err := minio.New(endpoint, &minio.Options{
 		Creds:  creds,
 		Secure: useTLS,
 	})
 	if err != nil {
-		return nil, errCh, err
+		return nil, err
 	}
 
-	exists, err := c.BucketExists(ctx, bucketName)
+	store := &Store{
+		client: c,
+		bucket: bucketName,
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	if store.retentionPeriod > 0 && !store.retentionMode.IsValid() {
+		return nil, fmt.Errorf("invalid object lock retention mode: %s", store.retentionMode)
+	}
+
+	return store, nil
+}
+
+// Start checks the bucket exists, and has object locking enabled if legal holds or retention are
+// mirrored onto it
+func (s *Store) Start(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.bucket)
 	if !exists || err != nil {
-		return nil, errCh, fmt.Errorf("failed to find bucket exists: %v", err)
+		return fmt.Errorf("failed to find bucket exists: %v", err)
 	}
 
-	loc, err := c.GetBucketLocation(ctx, bucketName)
+	loc, err := s.client.GetBucketLocation(ctx, s.bucket)
 	if err != nil {
-		return nil, errCh, err
+		return err
+	}
+
+	s.location = loc
+	if s.legalHolds || s.retentionPeriod > 0 {
+		objectLock, _, _, _, err := s.client.GetObjectLockConfig(ctx, s.bucket)
+		if err != nil {
+			return fmt.Errorf("failed to get object lock configuration of bucket: %w", err)
+		} else if objectLock != "Enabled" {
+			return fmt.Errorf("bucket %s does not have object locking enabled", s.bucket)
+		}
 	}
 
-	return &Store{
-		client:   c,
-		location: loc,
-		bucket:   bucketName,
-	}, errCh, nil
+	return nil
+}
+
+// Close does nothing, since the minio client holds no resources that need releasing
+func (s *Store) Close() error {
+	return nil
 }
 
 func (s *Store) Get(ctx context.Context, gitoid string) (io.ReadCloser, error) {
-	return s.client.GetObject(ctx, s.bucket, gitoid, minio.GetObjectOptions{})
+	obj, compression, err := s.open(ctx, tenant.ObjectKey(ctx, gitoid))
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return nil, fmt.Errorf("%w: %s", objectstorage.ErrNotFound, gitoid)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return compression.Decompress(obj)
+}
+
+// open opens the object stored under key along with the compression it was uploaded with
+func (s *Store) open(ctx context.Context, key string) (*minio.Object, objectstorage.Compression, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, objectstorage.CompressionNone, err
+	}
+
+	// GetObject doesn't make a request until the object is first read, so stat it to find out
+	// whether it exists before anything is served
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		return nil, objectstorage.CompressionNone, err
+	}
+
+	return obj, encoding(info), nil
+}
+
+// Exists reports whether an envelope with the given gitoid has been uploaded to the bucket
+func (s *Store) Exists(ctx context.Context, gitoid string) (bool, error) {
+	if _, err := s.client.StatObject(ctx, s.bucket, tenant.ObjectKey(ctx, gitoid), minio.StatObjectOptions{}); err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
 }
 
 func (s *Store) Store(ctx context.Context, gitoid string, payload []byte) error {
-	return s.PutBlob(ctx, gitoid, payload)
+	return s.PutBlob(ctx, tenant.ObjectKey(ctx, gitoid), payload)
+}
+
+func (s *Store) StoreStream(ctx context.Context, gitoid string, r io.Reader, size int64) error {
+	return s.PutBlobStream(ctx, tenant.ObjectKey(ctx, gitoid), r, size)
+}
+
+// Delete removes every version of the envelope with gitoid from the bucket, along with any delete
+// markers. Removing the object without a version would only add a delete marker on versioned and
+// object-lock buckets and leave the envelope in place. Versions that are still under retention or
+// a legal hold can't be removed, and fail the delete. Deleting an envelope that is not stored is
+// not an error.
+func (s *Store) Delete(ctx context.Context, gitoid string) error {
+	key := tenant.ObjectKey(ctx, gitoid)
+	for version := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: key, WithVersions: true}) {
+		if version.Err != nil {
+			return version.Err
+		}
+
+		// the prefix also matches other keys that start with this one
+		if version.Key != key {
+			continue
+		}
+
+		if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{VersionID: version.VersionID}); err != nil {
+			return fmt.Errorf("could not remove version %s of %s: %w", version.VersionID, key, err)
+		}
+	}
+
+	return nil
+}
+
+// SetLegalHold places or lifts the S3 object-lock legal hold on the envelope with gitoid. It
+// does nothing unless the store was created WithLegalHolds.
+func (s *Store) SetLegalHold(ctx context.Context, gitoid string, held bool) error {
+	if !s.legalHolds {
+		return nil
+	}
+
+	status := minio.LegalHoldDisabled
+	if held {
+		status = minio.LegalHoldEnabled
+	}
+
+	return s.client.PutObjectLegalHold(ctx, s.bucket, tenant.ObjectKey(ctx, gitoid), minio.PutObjectLegalHoldOptions{Status: &status})
+}
+
+// List calls fn with the key of every envelope in the bucket
+func (s *Store) List(ctx context.Context, fn func(key string) error) error {
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+
+		if err := fn(obj.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Recompress uploads the envelope stored under key again with the store's compression. With
+// object locking the envelope as it was stored is kept as an earlier version of the object.
+func (s *Store) Recompress(ctx context.Context, key string) (bool, error) {
+	obj, compression, err := s.open(ctx, key)
+	if err != nil {
+		return false, err
+	} else if compression == s.compression {
+		obj.Close()
+		return false, nil
+	}
+
+	r, err := compression.Decompress(obj)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	return true, s.put(ctx, key, r, -1)
+}
+
+// Rewrite replaces the envelope stored under key with the one returned by fn
+func (s *Store) Rewrite(ctx context.Context, key string, fn func(io.Reader) (io.ReadCloser, error)) (bool, error) {
+	obj, compression, err := s.open(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	r, err := compression.Decompress(obj)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	rewritten, err := fn(r)
+	if err != nil || rewritten == nil {
+		return false, err
+	}
+	defer rewritten.Close()
+
+	return true, s.put(ctx, key, rewritten, -1)
 }
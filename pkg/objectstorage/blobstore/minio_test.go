@@ -0,0 +1,127 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore_test
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/objectstorage/blobstore"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/suite"
+)
+
+const bucket = "envelopes"
+
+type fakeVersion struct {
+	key          string
+	id           string
+	deleteMarker bool
+}
+
+// fakeS3 serves the parts of the S3 API that deleting an envelope uses
+type fakeS3 struct {
+	mu       sync.Mutex
+	versions []fakeVersion
+	deletes  []string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodGet && query.Has("location"):
+		fmt.Fprint(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">us-east-1</LocationConstraint>`)
+
+	case r.Method == http.MethodGet && query.Has("versions"):
+		fmt.Fprintf(w, `<ListVersionsResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>%s</Name><IsTruncated>false</IsTruncated>`, bucket)
+		for _, v := range f.versions {
+			if !strings.HasPrefix(v.key, query.Get("prefix")) {
+				continue
+			}
+
+			element := "Version"
+			if v.deleteMarker {
+				element = "DeleteMarker"
+			}
+
+			fmt.Fprintf(w, `<%s><Key>%s</Key><VersionId>%s</VersionId><LastModified>2026-10-17T00:00:00.000Z</LastModified></%s>`, element, xmlEscape(v.key), v.id, element)
+		}
+
+		fmt.Fprint(w, `</ListVersionsResult>`)
+
+	case r.Method == http.MethodDelete:
+		key := strings.TrimPrefix(r.URL.Path, "/"+bucket+"/")
+		f.deletes = append(f.deletes, key+"@"+query.Get("versionId"))
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "unexpected request "+r.Method+" "+r.URL.String(), http.StatusNotImplemented)
+	}
+}
+
+func xmlEscape(s string) string {
+	b := &strings.Builder{}
+	_ = xml.EscapeText(b, []byte(s))
+	return b.String()
+}
+
+// Test Suite: UT Blobstore
+type UTBlobstoreSuite struct {
+	suite.Suite
+	fake  *fakeS3
+	store *blobstore.Store
+}
+
+func TestUTBlobstoreSuite(t *testing.T) {
+	suite.Run(t, new(UTBlobstoreSuite))
+}
+
+func (ut *UTBlobstoreSuite) SetupTest() {
+	ut.fake = &fakeS3{}
+	server := httptest.NewServer(ut.fake)
+	ut.T().Cleanup(server.Close)
+
+	endpoint, err := url.Parse(server.URL)
+	ut.Require().NoError(err)
+	ut.store, err = blobstore.New(endpoint.Host, credentials.NewStaticV4("access", "secret", ""), bucket, false)
+	ut.Require().NoError(err)
+}
+
+func (ut *UTBlobstoreSuite) Test_Delete_RemovesEveryVersion() {
+	ut.fake.versions = []fakeVersion{
+		{key: "gitoid", id: "v3", deleteMarker: true},
+		{key: "gitoid", id: "v2"},
+		{key: "gitoid", id: "v1"},
+		{key: "gitoid2", id: "v1"},
+	}
+
+	ut.Require().NoError(ut.store.Delete(context.Background(), "gitoid"))
+	ut.Equal([]string{"gitoid@v3", "gitoid@v2", "gitoid@v1"}, ut.fake.deletes)
+}
+
+func (ut *UTBlobstoreSuite) Test_Delete_NotStored() {
+	ut.Require().NoError(ut.store.Delete(context.Background(), "gitoid"))
+	ut.Empty(ut.fake.deletes)
+}
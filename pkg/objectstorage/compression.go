@@ -0,0 +1,137 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstorage
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression is how envelopes are compressed at rest. Envelopes are always addressed by the
+// gitoid of their uncompressed bytes, and are decompressed again when they are read.
+type Compression string
+
+const (
+	CompressionNone Compression = ""
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+)
+
+// EncodingMetadataKey is the object metadata that records the Compression of a stored envelope.
+// Objects without it are not compressed.
+const EncodingMetadataKey = "ArchivistaEncoding"
+
+// ParseCompression returns the Compression named by name. An empty name or NONE is no compression.
+func ParseCompression(name string) (Compression, error) {
+	switch c := Compression(strings.ToLower(name)); c {
+	case CompressionNone, "none":
+		return CompressionNone, nil
+	case CompressionGzip, CompressionZstd:
+		return c, nil
+	default:
+		return CompressionNone, fmt.Errorf("unknown compression: %s", name)
+	}
+}
+
+// Compress returns a reader of r compressed with c. It must be closed if it isn't read to the end.
+func (c Compression) Compress(r io.Reader) io.ReadCloser {
+	if c == CompressionNone {
+		return io.NopCloser(r)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		var (
+			w   io.WriteCloser
+			err error
+		)
+
+		switch c {
+		case CompressionGzip:
+			w = gzip.NewWriter(pw)
+		case CompressionZstd:
+			w, err = zstd.NewWriter(pw)
+		default:
+			err = fmt.Errorf("unknown compression: %s", c)
+		}
+
+		if err == nil {
+			if _, err = io.Copy(w, r); err == nil {
+				err = w.Close()
+			} else {
+				w.Close()
+			}
+		}
+
+		pw.CloseWithError(err)
+	}()
+
+	return pr
+}
+
+// Decompress returns a reader of r decompressed with c. Closing it closes r.
+func (c Compression) Decompress(r io.ReadCloser) (io.ReadCloser, error) {
+	switch c {
+	case CompressionNone:
+		return r, nil
+	case CompressionGzip:
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+
+		return &decompressReader{Reader: zr, close: func() { zr.Close() }, r: r}, nil
+	case CompressionZstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+
+		return &decompressReader{Reader: zr, close: zr.Close, r: r}, nil
+	default:
+		r.Close()
+		return nil, fmt.Errorf("unknown compression: %s", c)
+	}
+}
+
+type decompressReader struct {
+	io.Reader
+	close func()
+	r     io.ReadCloser
+}
+
+func (d *decompressReader) Close() error {
+	d.close()
+	return d.r.Close()
+}
+
+// CountingReader counts the bytes read through it, so the size of an envelope can be checked
+// after it was compressed on the way to the store.
+type CountingReader struct {
+	R io.Reader
+	N int64
+}
+
+func (c *CountingReader) Read(p []byte) (int, error) {
+	n, err := c.R.Read(p)
+	c.N += int64(n)
+	return n, err
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstorage_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT Compression
+type UTCompressionSuite struct {
+	suite.Suite
+}
+
+func TestUTCompressionSuite(t *testing.T) {
+	suite.Run(t, new(UTCompressionSuite))
+}
+
+func (ut *UTCompressionSuite) Test_ParseCompression() {
+	for name, expected := range map[string]objectstorage.Compression{
+		"":     objectstorage.CompressionNone,
+		"NONE": objectstorage.CompressionNone,
+		"GZIP": objectstorage.CompressionGzip,
+		"zstd": objectstorage.CompressionZstd,
+	} {
+		compression, err := objectstorage.ParseCompression(name)
+		ut.NoError(err, name)
+		ut.Equal(expected, compression, name)
+	}
+
+	_, err := objectstorage.ParseCompression("brotli")
+	ut.EqualError(err, "unknown compression: brotli")
+}
+
+func (ut *UTCompressionSuite) Test_RoundTrip() {
+	envelope := strings.Repeat(`{"payloadType":"application/vnd.in-toto+json"}`, 100)
+	for _, compression := range []objectstorage.Compression{objectstorage.CompressionNone, objectstorage.CompressionGzip, objectstorage.CompressionZstd} {
+		compressed, err := io.ReadAll(compression.Compress(strings.NewReader(envelope)))
+		ut.Require().NoError(err, compression)
+		if compression != objectstorage.CompressionNone {
+			ut.Less(len(compressed), len(envelope), compression)
+		}
+
+		r, err := compression.Decompress(io.NopCloser(bytes.NewReader(compressed)))
+		ut.Require().NoError(err, compression)
+		decompressed, err := io.ReadAll(r)
+		ut.NoError(err, compression)
+		ut.NoError(r.Close())
+		ut.Equal(envelope, string(decompressed), compression)
+	}
+}
+
+func (ut *UTCompressionSuite) Test_Decompress_Corrupt() {
+	_, err := objectstorage.CompressionGzip.Decompress(io.NopCloser(strings.NewReader("{}")))
+	ut.Error(err)
+}
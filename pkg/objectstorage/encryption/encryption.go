@@ -0,0 +1,412 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package encryption encrypts envelopes before they are put in an object store. Every envelope
+// is encrypted with its own data key, which is stored alongside the envelope wrapped by a
+// KeyProvider. Only envelopes are encrypted; the metadata Archivista indexes stays searchable.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+)
+
+// magic starts every encrypted envelope. Envelopes are JSON, so an envelope stored before
+// encryption was enabled never starts with it and is read as it is.
+const magic = "ARCVENC1"
+
+// maxHeaderSize bounds the header read from an object before it is known to be an envelope
+const maxHeaderSize = 64 * 1024
+
+// header is stored in front of every encrypted envelope
+type header struct {
+	KeyID       string                    `json:"keyId"`
+	WrappedKey  []byte                    `json:"wrappedKey"`
+	NoncePrefix []byte                    `json:"noncePrefix"`
+	Compression objectstorage.Compression `json:"compression,omitempty"`
+}
+
+func (h header) marshal() ([]byte, error) {
+	content, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBufferString(magic)
+	_ = binary.Write(buf, binary.BigEndian, uint32(len(content)))
+	buf.Write(content)
+	return buf.Bytes(), nil
+}
+
+// readHeader reads the header of the envelope in r. It reports false, leaving r as it was, if
+// the envelope isn't encrypted.
+func readHeader(r *bufio.Reader) (header, bool, error) {
+	h := header{}
+	if prefix, err := r.Peek(len(magic)); err != nil && !errors.Is(err, io.EOF) {
+		return h, false, err
+	} else if string(prefix) != magic {
+		return h, false, nil
+	}
+
+	var size uint32
+	if _, err := r.Discard(len(magic)); err != nil {
+		return h, false, err
+	} else if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return h, false, fmt.Errorf("failed to read encryption header: %w", err)
+	} else if size > maxHeaderSize {
+		return h, false, fmt.Errorf("encryption header of %d bytes is too large", size)
+	}
+
+	content := make([]byte, size)
+	if _, err := io.ReadFull(r, content); err != nil {
+		return h, false, fmt.Errorf("failed to read encryption header: %w", err)
+	} else if err := json.Unmarshal(content, &h); err != nil {
+		return h, false, fmt.Errorf("failed to parse encryption header: %w", err)
+	}
+
+	return h, true, nil
+}
+
+// the optional capabilities of the wrapped store, which Store passes on
+type streamStorer interface {
+	StoreStream(ctx context.Context, gitoid string, r io.Reader, size int64) error
+}
+
+type exister interface {
+	Exists(ctx context.Context, gitoid string) (bool, error)
+}
+
+type deleter interface {
+	Delete(ctx context.Context, gitoid string) error
+}
+
+type legalHolder interface {
+	SetLegalHold(ctx context.Context, gitoid string, held bool) error
+}
+
+// Store encrypts the envelopes put in the object store it wraps
+type Store struct {
+	store       objectstorage.Store
+	provider    KeyProvider
+	compression objectstorage.Compression
+}
+
+type Option func(*Store)
+
+// WithCompression compresses envelopes before they are encrypted, since encrypted envelopes
+// don't compress. The compression is recorded in the header of each envelope.
+func WithCompression(compression objectstorage.Compression) Option {
+	return func(s *Store) {
+		s.compression = compression
+	}
+}
+
+// New returns a Store that encrypts envelopes with data keys wrapped by provider before putting
+// them in store. Envelopes in store that were put there before they were encrypted stay
+// readable until they are rewrapped.
+func New(store objectstorage.Store, provider KeyProvider, opts ...Option) *Store {
+	s := &Store{store: store, provider: provider}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// NewFromConfig creates the object store of the configured backend. Its envelopes are
+// encrypted if ARCHIVISTA_STORAGE_ENCRYPTION_KEY_PROVIDER is set, in which case envelopes are
+// compressed before they are encrypted rather than by the backend.
+func NewFromConfig(ctx context.Context, cfg *config.Config) (objectstorage.Store, error) {
+	if cfg.StorageEncryptionKeyProvider == "" {
+		return objectstorage.New(ctx, cfg.StorageBackend, cfg)
+	}
+
+	compression, err := objectstorage.ParseCompression(cfg.StorageCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := NewKeyProvider(ctx, cfg.StorageEncryptionKeyProvider, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("could not create key provider: %w", err)
+	}
+
+	backendCfg := *cfg
+	backendCfg.StorageCompression = ""
+	store, err := objectstorage.New(ctx, cfg.StorageBackend, &backendCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(store, provider, WithCompression(compression)), nil
+}
+
+// Start starts the store envelopes are put in
+func (s *Store) Start(ctx context.Context) error {
+	return s.store.Start(ctx)
+}
+
+// Close closes the store envelopes are put in
+func (s *Store) Close() error {
+	return s.store.Close()
+}
+
+func (s *Store) Get(ctx context.Context, gitoid string) (io.ReadCloser, error) {
+	rc, err := s.store.Get(ctx, gitoid)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.decrypt(ctx, rc)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+
+	return readCloser{r, closers{r, rc}}, nil
+}
+
+// decrypt returns the envelope read from r, decrypting and decompressing it if it is encrypted
+func (s *Store) decrypt(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	h, encrypted, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	} else if !encrypted {
+		return io.NopCloser(br), nil
+	}
+
+	dataKey, err := s.provider.UnwrapKey(ctx, h.KeyID, h.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Compression.Decompress(io.NopCloser(open(br, aead, h.NoncePrefix)))
+}
+
+func (s *Store) Store(ctx context.Context, gitoid string, payload []byte) error {
+	return s.StoreStream(ctx, gitoid, bytes.NewReader(payload), int64(len(payload)))
+}
+
+// StoreStream encrypts the envelope read from r as it is stored. The stored envelope fails to
+// upload if fewer or more than size bytes are read from r, unless size is negative.
+func (s *Store) StoreStream(ctx context.Context, gitoid string, r io.Reader, size int64) error {
+	encrypted, err := s.encrypt(ctx, &sizeReader{r: r, size: size})
+	if err != nil {
+		return err
+	}
+	defer encrypted.Close()
+
+	if streamStorer, ok := s.store.(streamStorer); ok {
+		return streamStorer.StoreStream(ctx, gitoid, encrypted, -1)
+	}
+
+	payload, err := io.ReadAll(encrypted)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Store(ctx, gitoid, payload)
+}
+
+// encrypt returns a reader of r compressed and encrypted with a new data key, behind its
+// header. It must be closed if it isn't read to the end.
+func (s *Store) encrypt(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	dataKey := make([]byte, 32)
+	noncePrefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	} else if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, err
+	}
+
+	keyID, wrapped, err := s.provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	h, err := header{KeyID: keyID, WrappedKey: wrapped, NoncePrefix: noncePrefix, Compression: s.compression}.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	compressed := s.compression.Compress(r)
+	sealed := seal(compressed, aead, noncePrefix)
+	return readCloser{io.MultiReader(bytes.NewReader(h), sealed), closers{sealed, compressed}}, nil
+}
+
+// Exists reports whether an envelope with the given gitoid is in the wrapped store
+func (s *Store) Exists(ctx context.Context, gitoid string) (bool, error) {
+	if exister, ok := s.store.(exister); ok {
+		return exister.Exists(ctx, gitoid)
+	}
+
+	r, err := s.store.Get(ctx, gitoid)
+	if errors.Is(err, objectstorage.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, r.Close()
+}
+
+// Delete removes the envelope with gitoid from the wrapped store
+func (s *Store) Delete(ctx context.Context, gitoid string) error {
+	if deleter, ok := s.store.(deleter); ok {
+		return deleter.Delete(ctx, gitoid)
+	}
+
+	return errors.New("the object store does not support deleting envelopes")
+}
+
+// SetLegalHold places or lifts the legal hold on the envelope with gitoid, if the wrapped store
+// enforces legal holds itself
+func (s *Store) SetLegalHold(ctx context.Context, gitoid string, held bool) error {
+	if legalHolder, ok := s.store.(legalHolder); ok {
+		return legalHolder.SetLegalHold(ctx, gitoid, held)
+	}
+
+	return nil
+}
+
+// RewrapResult counts the envelopes visited by RewrapAll
+type RewrapResult struct {
+	Checked   int
+	Rewrapped int
+	Failed    int
+}
+
+// RewrapAll wraps the data key of every envelope that isn't wrapped with the current key of the
+// key provider again with the current key, and encrypts the envelopes that were stored before
+// encryption was enabled. Only the header of an encrypted envelope changes. An envelope that
+// can't be rewrapped is reported to onError and skipped.
+func (s *Store) RewrapAll(ctx context.Context, onError func(key string, err error)) (RewrapResult, error) {
+	result := RewrapResult{}
+	rewriter, ok := s.store.(objectstorage.Rewriter)
+	if !ok {
+		return result, errors.New("the object store does not support rewriting envelopes")
+	}
+
+	err := rewriter.List(ctx, func(key string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		result.Checked++
+		rewrapped, err := rewriter.Rewrite(ctx, key, func(r io.Reader) (io.ReadCloser, error) {
+			return s.rewrap(ctx, r)
+		})
+		if err != nil {
+			result.Failed++
+			onError(key, err)
+			return nil
+		}
+
+		if rewrapped {
+			result.Rewrapped++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to list stored envelopes: %w", err)
+	}
+
+	return result, nil
+}
+
+// rewrap returns the envelope read from r with its data key wrapped with the current key, or
+// nil if it already is
+func (s *Store) rewrap(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	h, encrypted, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	} else if !encrypted {
+		return s.encrypt(ctx, br)
+	} else if h.KeyID == s.provider.CurrentKeyID() {
+		return nil, nil
+	}
+
+	dataKey, err := s.provider.UnwrapKey(ctx, h.KeyID, h.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	h.KeyID, h.WrappedKey, err = s.provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	content, err := h.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(io.MultiReader(bytes.NewReader(content), br)), nil
+}
+
+// sizeReader fails at the end of r if its length isn't size, so a short envelope isn't stored
+type sizeReader struct {
+	r    io.Reader
+	size int64
+	n    int64
+}
+
+func (s *sizeReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	s.n += int64(n)
+	if errors.Is(err, io.EOF) && s.size >= 0 && s.n != s.size {
+		return n, fmt.Errorf("failed to store full envelope: size %d != uploaded size %d", s.size, s.n)
+	}
+
+	return n, err
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+type closers []io.Closer
+
+func (c closers) Close() error {
+	var errs []error
+	for _, closer := range c {
+		errs = append(errs, closer.Close())
+	}
+
+	return errors.Join(errs...)
+}
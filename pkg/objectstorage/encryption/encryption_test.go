@@ -0,0 +1,175 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/in-toto/archivista/pkg/objectstorage/encryption"
+	"github.com/in-toto/archivista/pkg/objectstorage/filestore"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT Encryption
+type UTEncryptionSuite struct {
+	suite.Suite
+	dir     string
+	keyFile string
+	files   *filestore.Store
+}
+
+func TestUTEncryptionSuite(t *testing.T) {
+	suite.Run(t, new(UTEncryptionSuite))
+}
+
+func (ut *UTEncryptionSuite) SetupTest() {
+	ut.dir = ut.T().TempDir()
+	ut.keyFile = filepath.Join(ut.T().TempDir(), "keys.json")
+	ut.files = filestore.New(ut.dir)
+}
+
+// newStore returns a Store over the file store with keys, the first of which is current
+func (ut *UTEncryptionSuite) newStore(opts []encryption.Option, keys ...string) *encryption.Store {
+	ut.Require().NoError(writeKeyFile(ut.keyFile, keys...))
+	provider, err := encryption.LoadKeyFile(ut.keyFile)
+	ut.Require().NoError(err)
+	return encryption.New(ut.files, provider, opts...)
+}
+
+func (ut *UTEncryptionSuite) read(store *encryption.Store, ctx context.Context, gitoid string) string {
+	r, err := store.Get(ctx, gitoid)
+	ut.Require().NoError(err)
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	ut.Require().NoError(err)
+	return string(content)
+}
+
+func (ut *UTEncryptionSuite) Test_StoreGet() {
+	store := ut.newStore(nil, "a")
+	ctx := tenant.NewContext(context.Background(), "acme")
+	// envelopes of a few chunks, ending in the middle of a chunk and right at the end of one
+	for _, size := range []int{0, 10, 200 * 1024, 128 * 1024} {
+		envelope := make([]byte, size)
+		_, _ = rand.Read(envelope)
+		ut.Require().NoError(store.Store(ctx, "gitoid", envelope))
+
+		stored, err := os.ReadFile(filepath.Join(ut.dir, "acme", "gi", "to", "gitoid.json"))
+		ut.Require().NoError(err)
+		ut.True(bytes.HasPrefix(stored, []byte("ARCVENC1")))
+		ut.Equal(string(envelope), ut.read(store, ctx, "gitoid"))
+		ut.Require().NoError(store.Delete(ctx, "gitoid"))
+	}
+
+	exists, err := store.Exists(ctx, "gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+}
+
+func (ut *UTEncryptionSuite) Test_Compression() {
+	store := ut.newStore([]encryption.Option{encryption.WithCompression(objectstorage.CompressionZstd)}, "a")
+	ctx := context.Background()
+	envelope := strings.Repeat(`{"payload": "secret"}`, 10000)
+	ut.Require().NoError(store.Store(ctx, "gitoid", []byte(envelope)))
+
+	stored, err := os.ReadFile(filepath.Join(ut.dir, "gi", "to", "gitoid.json"))
+	ut.Require().NoError(err)
+	ut.NotContains(string(stored), "secret")
+	ut.Less(len(stored), len(envelope)/10)
+	ut.Equal(envelope, ut.read(store, ctx, "gitoid"))
+}
+
+func (ut *UTEncryptionSuite) Test_Tampered() {
+	store := ut.newStore(nil, "a")
+	ctx := context.Background()
+	ut.Require().NoError(store.Store(ctx, "gitoid", []byte(strings.Repeat("envelope", 20000))))
+
+	path := filepath.Join(ut.dir, "gi", "to", "gitoid.json")
+	stored, err := os.ReadFile(path)
+	ut.Require().NoError(err)
+
+	// the sealed chunks follow the magic, the length of the header and the header
+	firstChunkEnd := 12 + int(binary.BigEndian.Uint32(stored[8:12])) + 64*1024 + 16
+	for _, tampered := range [][]byte{
+		append(append([]byte{}, stored[:len(stored)-1]...), stored[len(stored)-1]^1),
+		stored[:len(stored)-100],
+		stored[:firstChunkEnd],
+	} {
+		ut.Require().NoError(os.WriteFile(path, tampered, 0o600))
+		r, err := store.Get(ctx, "gitoid")
+		ut.Require().NoError(err)
+		_, err = io.ReadAll(r)
+		ut.Error(err)
+		r.Close()
+	}
+}
+
+func (ut *UTEncryptionSuite) Test_StoreStream_Size() {
+	store := ut.newStore(nil, "a")
+	ctx := context.Background()
+	ut.ErrorContains(store.StoreStream(ctx, "gitoid", strings.NewReader("envelope"), 9), "size 9 != uploaded size 8")
+
+	exists, err := store.Exists(ctx, "gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+}
+
+func (ut *UTEncryptionSuite) Test_RewrapAll() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.files.Store(ctx, "plain", []byte("plain envelope")))
+	ut.Require().NoError(ut.newStore(nil, "old").Store(ctx, "gitoid", []byte("envelope")))
+
+	store := ut.newStore(nil, "new", "old")
+	ut.Equal("plain envelope", ut.read(store, ctx, "plain"))
+	result, err := store.RewrapAll(ctx, func(key string, err error) { ut.Fail(key, err) })
+	ut.NoError(err)
+	ut.Equal(encryption.RewrapResult{Checked: 2, Rewrapped: 2}, result)
+
+	result, err = store.RewrapAll(ctx, func(key string, err error) { ut.Fail(key, err) })
+	ut.NoError(err)
+	ut.Equal(encryption.RewrapResult{Checked: 2}, result)
+
+	// the old key can be retired once everything is rewrapped
+	store = ut.newStore(nil, "new")
+	ut.Equal("envelope", ut.read(store, ctx, "gitoid"))
+	ut.Equal("plain envelope", ut.read(store, ctx, "plain"))
+	stored, err := os.ReadFile(filepath.Join(ut.dir, "pl", "ai", "plain.json"))
+	ut.Require().NoError(err)
+	ut.NotContains(string(stored), "plain envelope")
+}
+
+func (ut *UTEncryptionSuite) Test_RewrapAll_UnknownKey() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.newStore(nil, "retired").Store(ctx, "gitoid", []byte("envelope")))
+
+	failed := []string{}
+	result, err := ut.newStore(nil, "new").RewrapAll(ctx, func(key string, err error) {
+		failed = append(failed, key)
+		ut.EqualError(err, "unknown key: retired")
+	})
+	ut.NoError(err)
+	ut.Equal(encryption.RewrapResult{Checked: 1, Failed: 1}, result)
+	ut.Equal([]string{"gitoid"}, failed)
+}
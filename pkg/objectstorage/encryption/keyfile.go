@@ -0,0 +1,133 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/in-toto/archivista/pkg/config"
+)
+
+func init() {
+	RegisterKeyProvider("KEYFILE", func(ctx context.Context, cfg *config.Config) (KeyProvider, error) {
+		if cfg.StorageEncryptionKeyFile == "" {
+			return nil, errors.New("a key file is required")
+		}
+
+		return LoadKeyFile(cfg.StorageEncryptionKeyFile)
+	})
+}
+
+// KeyFile wraps data keys with AES-256 keys read from a local JSON file of the form
+//
+//	{"current": "2026-10", "keys": {"2026-10": "<base64 key>", "2026-01": "<base64 key>"}}
+//
+// Keys are rotated by adding a new key, making it the current one and rewrapping the stored
+// envelopes. Older keys can be removed from the file once nothing is wrapped with them.
+type KeyFile struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+type keyFile struct {
+	Current string            `json:"current"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// LoadKeyFile reads the keys of a KeyFile from path
+func LoadKeyFile(path string) (*KeyFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	parsed := keyFile{}
+	if err := json.Unmarshal(content, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse key file: %w", err)
+	}
+
+	kf := &KeyFile{current: parsed.Current, keys: make(map[string]cipher.AEAD, len(parsed.Keys))}
+	for id, encoded := range parsed.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key %s: %w", id, err)
+		} else if len(key) != 32 {
+			return nil, fmt.Errorf("key %s is %d bytes long, expected 32", id, len(key))
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+
+		kf.keys[id] = aead
+	}
+
+	if _, ok := kf.keys[kf.current]; !ok {
+		return nil, fmt.Errorf("current key %q is not in the key file", kf.current)
+	}
+
+	return kf, nil
+}
+
+func (kf *KeyFile) CurrentKeyID() string {
+	return kf.current
+}
+
+// WrapKey seals dataKey with the current key. The id of the key is authenticated along with
+// the data key, so a wrapped key can't be passed off as wrapped by another key.
+func (kf *KeyFile) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	aead := kf.keys[kf.current]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+
+	return kf.current, aead.Seal(nonce, nonce, dataKey, []byte(kf.current)), nil
+}
+
+func (kf *KeyFile) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := kf.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key: %s", keyID)
+	} else if len(wrapped) < aead.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealed, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with key %s: %w", keyID, err)
+	}
+
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage/encryption"
+	"github.com/stretchr/testify/suite"
+)
+
+// writeKeyFile writes a key file with the given keys, the first of which is current
+func writeKeyFile(path string, ids ...string) error {
+	keys := map[string]string{}
+	for _, id := range ids {
+		keys[id] = base64.StdEncoding.EncodeToString([]byte(strings.Repeat(id[:1], 32)))
+	}
+
+	content, err := json.Marshal(map[string]any{"current": ids[0], "keys": keys})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o600)
+}
+
+// Test Suite: UT KeyFile
+type UTKeyFileSuite struct {
+	suite.Suite
+	path string
+}
+
+func TestUTKeyFileSuite(t *testing.T) {
+	suite.Run(t, new(UTKeyFileSuite))
+}
+
+func (ut *UTKeyFileSuite) SetupTest() {
+	ut.path = filepath.Join(ut.T().TempDir(), "keys.json")
+}
+
+func (ut *UTKeyFileSuite) Test_WrapUnwrap() {
+	ctx := context.Background()
+	ut.Require().NoError(writeKeyFile(ut.path, "old"))
+	old, err := encryption.LoadKeyFile(ut.path)
+	ut.Require().NoError(err)
+	keyID, wrapped, err := old.WrapKey(ctx, []byte("data key"))
+	ut.Require().NoError(err)
+	ut.Equal("old", keyID)
+
+	ut.Require().NoError(writeKeyFile(ut.path, "new", "old"))
+	rotated, err := encryption.LoadKeyFile(ut.path)
+	ut.Require().NoError(err)
+	ut.Equal("new", rotated.CurrentKeyID())
+	dataKey, err := rotated.UnwrapKey(ctx, "old", wrapped)
+	ut.NoError(err)
+	ut.Equal([]byte("data key"), dataKey)
+
+	_, err = rotated.UnwrapKey(ctx, "new", wrapped)
+	ut.ErrorContains(err, "failed to unwrap data key with key new")
+
+	_, err = rotated.UnwrapKey(ctx, "retired", wrapped)
+	ut.EqualError(err, "unknown key: retired")
+}
+
+func (ut *UTKeyFileSuite) Test_LoadKeyFile_Invalid() {
+	ut.Require().NoError(os.WriteFile(ut.path, []byte(`{"current": "a", "keys": {"a": "c2hvcnQ="}}`), 0o600))
+	_, err := encryption.LoadKeyFile(ut.path)
+	ut.EqualError(err, "key a is 5 bytes long, expected 32")
+
+	ut.Require().NoError(os.WriteFile(ut.path, []byte(`{"current": "b", "keys": {}}`), 0o600))
+	_, err = encryption.LoadKeyFile(ut.path)
+	ut.EqualError(err, `current key "b" is not in the key file`)
+}
+
+func (ut *UTKeyFileSuite) Test_NewKeyProvider() {
+	ut.Require().NoError(writeKeyFile(ut.path, "a"))
+	provider, err := encryption.NewKeyProvider(context.Background(), "keyfile", &config.Config{StorageEncryptionKeyFile: ut.path})
+	ut.Require().NoError(err)
+	ut.Equal("a", provider.CurrentKeyID())
+	ut.Contains(encryption.KeyProviders(), "KEYFILE")
+
+	_, err = encryption.NewKeyProvider(context.Background(), "keyfile", &config.Config{})
+	ut.EqualError(err, "a key file is required")
+
+	_, err = encryption.NewKeyProvider(context.Background(), "vault", &config.Config{})
+	ut.EqualError(err, "unknown key provider: vault")
+}
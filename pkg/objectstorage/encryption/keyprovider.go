@@ -0,0 +1,90 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/in-toto/archivista/pkg/config"
+)
+
+// KeyProvider wraps the data keys envelopes are encrypted with, such as with a key held in a
+// KMS. Every wrapped data key is stored with the id of the key that wrapped it, so keys can be
+// rotated while envelopes wrapped with older keys stay readable.
+type KeyProvider interface {
+	// CurrentKeyID returns the id of the key new data keys are wrapped with
+	CurrentKeyID() string
+	// WrapKey encrypts dataKey with the current key, returning the id of that key
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+	// UnwrapKey decrypts a data key wrapped with the key keyID
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// KeyProviderFactory creates a KeyProvider from the configuration
+type KeyProviderFactory func(ctx context.Context, cfg *config.Config) (KeyProvider, error)
+
+var (
+	providersMu sync.RWMutex
+	providers   = make(map[string]KeyProviderFactory)
+)
+
+// RegisterKeyProvider makes a key provider available under name, which is matched against
+// ARCHIVISTA_STORAGE_ENCRYPTION_KEY_PROVIDER case insensitively. RegisterKeyProvider panics if
+// a key provider is registered twice under the same name.
+func RegisterKeyProvider(name string, factory KeyProviderFactory) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+
+	name = strings.ToUpper(name)
+	if factory == nil {
+		panic("encryption: RegisterKeyProvider factory is nil for " + name)
+	}
+
+	if _, ok := providers[name]; ok {
+		panic("encryption: RegisterKeyProvider called twice for " + name)
+	}
+
+	providers[name] = factory
+}
+
+// KeyProviders returns the sorted names of the registered key providers
+func KeyProviders() []string {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// NewKeyProvider creates the key provider registered under name
+func NewKeyProvider(ctx context.Context, name string, cfg *config.Config) (KeyProvider, error) {
+	providersMu.RLock()
+	factory, ok := providers[strings.ToUpper(name)]
+	providersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown key provider: %s", name)
+	}
+
+	return factory(ctx, cfg)
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// envelopes are sealed in chunks so they can be encrypted and decrypted without holding them
+// in memory. The nonce of each chunk is the envelope's random nonce prefix, the chunk's index
+// and whether it is the last chunk, so chunks can't be reordered, dropped or truncated without
+// failing to open.
+const (
+	chunkSize       = 64 * 1024
+	noncePrefixSize = 7
+)
+
+var errTruncated = errors.New("encrypted envelope is truncated")
+
+func chunkNonce(prefix []byte, index uint32, last bool) []byte {
+	nonce := make([]byte, noncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], index)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+
+	return nonce
+}
+
+// seal returns a reader of r sealed chunk by chunk with aead. It must be closed if it isn't
+// read to the end.
+func seal(r io.Reader, aead cipher.AEAD, prefix []byte) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		br := bufio.NewReaderSize(r, chunkSize)
+		buf := make([]byte, chunkSize)
+		sealed := make([]byte, 0, chunkSize+aead.Overhead())
+		for index := uint32(0); ; index++ {
+			n, err := io.ReadFull(br, buf)
+			last := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+			if err != nil && !last {
+				pw.CloseWithError(err)
+				return
+			} else if !last {
+				// a chunk that ends the envelope exactly is still the last one
+				if _, err := br.Peek(1); errors.Is(err, io.EOF) {
+					last = true
+				} else if err != nil {
+					pw.CloseWithError(err)
+					return
+				}
+			}
+
+			sealed = aead.Seal(sealed[:0], chunkNonce(prefix, index, last), buf[:n], nil)
+			if _, err := pw.Write(sealed); err != nil {
+				return
+			}
+
+			if last {
+				pw.Close()
+				return
+			}
+		}
+	}()
+
+	return pr
+}
+
+// opener reads the envelope sealed by seal from r
+type opener struct {
+	r      *bufio.Reader
+	aead   cipher.AEAD
+	prefix []byte
+	index  uint32
+	buf    []byte
+	chunk  []byte
+	done   bool
+}
+
+func open(r io.Reader, aead cipher.AEAD, prefix []byte) io.Reader {
+	return &opener{
+		r:      bufio.NewReaderSize(r, chunkSize+aead.Overhead()),
+		aead:   aead,
+		prefix: prefix,
+		buf:    make([]byte, chunkSize+aead.Overhead()),
+	}
+}
+
+func (o *opener) Read(p []byte) (int, error) {
+	for len(o.chunk) == 0 {
+		if o.done {
+			return 0, io.EOF
+		}
+
+		if err := o.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, o.chunk)
+	o.chunk = o.chunk[n:]
+	return n, nil
+}
+
+// next opens the next chunk of the envelope
+func (o *opener) next() error {
+	n, err := io.ReadFull(o.r, o.buf)
+	last := errors.Is(err, io.ErrUnexpectedEOF)
+	if errors.Is(err, io.EOF) {
+		return errTruncated
+	} else if err != nil && !last {
+		return err
+	} else if !last {
+		if _, err := o.r.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	chunk, err := o.aead.Open(o.buf[:0], chunkNonce(o.prefix, o.index, last), o.buf[:n], nil)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt envelope: %w", err)
+	}
+
+	o.index++
+	o.chunk = chunk
+	o.done = last
+	return nil
+}
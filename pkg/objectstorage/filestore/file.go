@@ -15,61 +15,503 @@
 package filestore
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
-	"log"
-	"net/http"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
-	"time"
+	"strings"
 
-	"github.com/gorilla/handlers"
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/in-toto/archivista/pkg/tenant"
 )
 
+// Store keeps envelopes in files under a directory. Envelopes are sharded into two levels of
+// directories named after the first bytes of their gitoid, so no one directory holds every
+// envelope. Envelopes written before sharding are still read from the top of the directory
+// until they are moved by MigrateLayout.
 type Store struct {
-	prefix string
+	prefix      string
+	compression objectstorage.Compression
+	fsync       bool
 }
 
-func New(ctx context.Context, directory string, address string) (*Store, <-chan error, error) {
-	errCh := make(chan error)
-	go func() {
-		server := &http.Server{
-			Addr:         address,
-			Handler:      handlers.CompressHandler(http.FileServer(http.Dir(directory))),
-			ReadTimeout:  5 * time.Second,
-			WriteTimeout: 10 * time.Second,
+type Option func(*Store)
+
+// WithCompression compresses envelopes written to the store. Envelopes already stored keep
+// the compression they were written with until they are recompressed.
+func WithCompression(compression objectstorage.Compression) Option {
+	return func(s *Store) {
+		s.compression = compression
+	}
+}
+
+// extensions are the suffixes of the files envelopes are stored in, which record how the
+// envelope in each file is compressed
+var extensions = []struct {
+	compression objectstorage.Compression
+	extension   string
+}{
+	{objectstorage.CompressionNone, ".json"},
+	{objectstorage.CompressionGzip, ".json.gz"},
+	{objectstorage.CompressionZstd, ".json.zst"},
+}
+
+// WithFsync syncs envelopes and the directories they are written to before a write returns, so
+// a stored envelope survives a crash of the host
+func WithFsync(fsync bool) Option {
+	return func(s *Store) {
+		s.fsync = fsync
+	}
+}
+
+func extension(compression objectstorage.Compression) string {
+	for _, ext := range extensions {
+		if ext.compression == compression {
+			return ext.extension
+		}
+	}
+
+	return ".json"
+}
+
+func init() {
+	objectstorage.Register("FILE", newFromConfig)
+}
+
+// newFromConfig creates the FILE backend from ARCHIVISTA_FILE_DIR
+func newFromConfig(ctx context.Context, cfg *config.Config) (objectstorage.Store, error) {
+	compression, err := objectstorage.ParseCompression(cfg.StorageCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(cfg.FileDir, WithCompression(compression), WithFsync(cfg.FileFsync)), nil
+}
+
+// New returns a reader/writer for storing/retrieving attestations in files under directory
+func New(directory string, opts ...Option) *Store {
+	store := &Store{
+		prefix: directory,
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	return store
+}
+
+// Start creates the directory of the store if it does not exist yet
+func (s *Store) Start(ctx context.Context) error {
+	return os.MkdirAll(s.prefix, 0o755)
+}
+
+// Close does nothing, since files are closed as soon as envelopes are read or written
+func (s *Store) Close() error {
+	return nil
+}
+
+// key returns the key the envelope with gitoid is stored under for the context's tenant
+func (s *Store) key(ctx context.Context, gitoid string) (string, error) {
+	if !filepath.IsLocal(gitoid) {
+		return "", filepath.ErrBadPattern
+	}
+
+	return tenant.ObjectKey(ctx, gitoid), nil
+}
+
+// shardable reports whether the file of the envelope named name is sharded. Gitoids always
+// are, but names too short to shard, or that would make a shard directory of dots, are kept
+// at the top of their tenant's directory.
+func shardable(name string) bool {
+	if len(name) < 4 {
+		return false
+	}
+
+	for _, c := range name[:4] {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// shard returns the path relative to the store of the file the envelope with key is stored in,
+// without its extension. A key of tenant/abcdef... is stored in tenant/ab/cd/abcdef...
+func shard(key string) string {
+	dir, name := path.Split(key)
+	if !shardable(name) {
+		return key
+	}
+
+	return path.Join(dir, name[:2], name[2:4], name)
+}
+
+// unshard returns the key of the envelope stored in rel, a path relative to the store without
+// its extension, whether it is sharded or in the flat layout from before sharding
+func unshard(rel string) string {
+	dir, name := path.Split(rel)
+	if !shardable(name) {
+		return rel
+	}
+
+	shardDir := name[:2] + "/" + name[2:4] + "/"
+	if !strings.HasSuffix(dir, shardDir) {
+		return rel
+	}
+
+	return strings.TrimSuffix(dir, shardDir) + name
+}
+
+// paths returns the files, without their extension, the envelope with key may be stored in.
+// The sharded file is looked at before the flat one.
+func (s *Store) paths(key string) []string {
+	sharded := shard(key)
+	if sharded == key {
+		return []string{filepath.Join(s.prefix, key)}
+	}
+
+	return []string{filepath.Join(s.prefix, sharded), filepath.Join(s.prefix, key)}
+}
+
+// find returns the file the envelope with key is stored in, and how it is compressed
+func (s *Store) find(key string) (string, objectstorage.Compression, error) {
+	for _, base := range s.paths(key) {
+		path, compression, err := stat(base)
+		if !errors.Is(err, fs.ErrNotExist) {
+			return path, compression, err
 		}
+	}
+
+	return "", objectstorage.CompressionNone, fs.ErrNotExist
+}
 
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Could not listen on %s: %v\n", address, err)
+// stat returns the file with any of the extensions envelopes are stored with that exists at
+// base, and how it is compressed
+func stat(base string) (string, objectstorage.Compression, error) {
+	for _, ext := range extensions {
+		path := base + ext.extension
+		if _, err := os.Stat(path); err == nil {
+			return path, ext.compression, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", objectstorage.CompressionNone, err
 		}
+	}
 
-		<-ctx.Done()
+	return "", objectstorage.CompressionNone, fs.ErrNotExist
+}
 
-		if err := server.Shutdown(ctx); err != nil {
-			log.Fatalf("Server Shutdown Failed:%+v", err)
+// open opens the envelope with key for reading, decompressing it if needed
+func (s *Store) open(key string) (io.ReadCloser, error) {
+	// the file may be replaced by one with another compression, or moved into its shard,
+	// between finding and opening it while the store is being migrated, so look once more if
+	// it has gone
+	for attempt := 0; ; attempt++ {
+		path, compression, err := s.find(key)
+		if err != nil {
+			return nil, err
 		}
 
-		close(errCh)
-	}()
+		file, err := os.Open(path)
+		if errors.Is(err, fs.ErrNotExist) && attempt == 0 {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
 
-	return &Store{
-		prefix: directory,
-	}, errCh, nil
+		return compression.Decompress(file)
+	}
 }
 
 func (s *Store) Get(ctx context.Context, gitoid string) (io.ReadCloser, error) {
-	if filepath.IsLocal(gitoid) {
-		return os.Open(filepath.Join(s.prefix, gitoid+".json"))
-	} else {
-		return nil, filepath.ErrBadPattern
+	key, err := s.key(ctx, gitoid)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.open(key)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", objectstorage.ErrNotFound, gitoid)
+	}
+
+	return r, err
+}
+
+// Exists reports whether an envelope with the given gitoid has been written to the store
+func (s *Store) Exists(ctx context.Context, gitoid string) (bool, error) {
+	key, err := s.key(ctx, gitoid)
+	if err != nil {
+		return false, err
+	}
+
+	_, _, err = s.find(key)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// StoreStream writes the envelope read from r without buffering it in memory. The envelope is
+// written to a temporary file that is renamed into place once it is complete, so a crash never
+// leaves a partial envelope under its gitoid.
+func (s *Store) StoreStream(ctx context.Context, gitoid string, r io.Reader, size int64) error {
+	key, err := s.key(ctx, gitoid)
+	if err != nil {
+		return err
+	}
+
+	// envelopes are addressed by their content, so one that is already stored is never
+	// overwritten, whatever it was compressed with. this keeps envelopes under a legal hold
+	// immutable.
+	if _, _, err := s.find(key); err == nil {
+		return nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
 	}
+
+	return s.write(filepath.Join(s.prefix, shard(key)+extension(s.compression)), r)
 }
 
 func (s *Store) Store(ctx context.Context, gitoid string, payload []byte) error {
-	if filepath.IsLocal(gitoid) {
-		return os.WriteFile(filepath.Join(s.prefix, gitoid+".json"), payload, 0o600)
-	} else {
-		return filepath.ErrBadPattern
+	return s.StoreStream(ctx, gitoid, bytes.NewReader(payload), int64(len(payload)))
+}
+
+// write compresses the envelope read from r into a temporary file next to path, then renames it
+// to path
+func (s *Store) write(path string, r io.Reader) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	compressed := s.compression.Compress(r)
+	defer compressed.Close()
+	if _, err := io.Copy(tmp, compressed); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if s.fsync {
+		if err := tmp.Sync(); err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return err
+		}
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return s.syncDir(dir)
+}
+
+// syncDir syncs dir, so the files renamed into it survive a crash, if the store syncs writes
+func (s *Store) syncDir(dir string) error {
+	if !s.fsync {
+		return nil
+	}
+
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
+
+// Delete removes the envelope with gitoid. Deleting an envelope that is not stored is not an error
+func (s *Store) Delete(ctx context.Context, gitoid string) error {
+	key, err := s.key(ctx, gitoid)
+	if err != nil {
+		return err
+	}
+
+	for _, base := range s.paths(key) {
+		for _, ext := range extensions {
+			if err := os.Remove(base + ext.extension); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// List calls fn with the key of every envelope in the store
+func (s *Store) List(ctx context.Context, fn func(key string) error) error {
+	return filepath.WalkDir(s.prefix, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(s.prefix, path)
+		if err != nil {
+			return err
+		}
+
+		// longer extensions are checked first so .json.gz isn't taken for .json
+		for i := len(extensions) - 1; i >= 0; i-- {
+			if rel, ok := strings.CutSuffix(filepath.ToSlash(rel), extensions[i].extension); ok {
+				return fn(unshard(rel))
+			}
+		}
+
+		return nil
+	})
+}
+
+// Recompress rewrites the envelope stored under key with the store's compression
+func (s *Store) Recompress(ctx context.Context, key string) (bool, error) {
+	path, compression, err := s.find(key)
+	if err != nil {
+		return false, err
+	} else if compression == s.compression {
+		return false, nil
 	}
+
+	r, err := s.open(key)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	return true, s.replace(path, key, r)
+}
+
+// Rewrite replaces the envelope stored under key with the one returned by fn
+func (s *Store) Rewrite(ctx context.Context, key string, fn func(io.Reader) (io.ReadCloser, error)) (bool, error) {
+	path, _, err := s.find(key)
+	if err != nil {
+		return false, err
+	}
+
+	r, err := s.open(key)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	rewritten, err := fn(r)
+	if err != nil || rewritten == nil {
+		return false, err
+	}
+	defer rewritten.Close()
+
+	return true, s.replace(path, key, rewritten)
+}
+
+// replace writes the envelope read from r over the one stored under key in path, with the
+// store's compression and in its shard. The new file is moved into place before the old one is
+// removed, so the envelope can be read throughout.
+func (s *Store) replace(path, key string, r io.Reader) error {
+	newPath := filepath.Join(s.prefix, shard(key)+extension(s.compression))
+	if err := s.write(newPath, r); err != nil {
+		return err
+	} else if newPath == path {
+		return nil
+	}
+
+	return os.Remove(path)
+}
+
+// MigrateResult counts the envelopes visited by MigrateLayout
+type MigrateResult struct {
+	Checked  int
+	Migrated int
+	Failed   int
+}
+
+// MigrateLayout moves every envelope stored in the flat layout from before sharding into its
+// shard. Envelopes stay readable while they are moved, so it can run while the store is in use.
+// An envelope that can't be moved is reported to onError and skipped.
+func (s *Store) MigrateLayout(ctx context.Context, onError func(key string, err error)) (MigrateResult, error) {
+	result := MigrateResult{}
+	err := s.List(ctx, func(key string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		result.Checked++
+		migrated, err := s.migrate(key)
+		if err != nil {
+			result.Failed++
+			onError(key, err)
+			return nil
+		}
+
+		if migrated {
+			result.Migrated++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to list stored envelopes: %w", err)
+	}
+
+	return result, nil
+}
+
+// migrate moves the envelope stored under key from the flat layout into its shard. A flat copy
+// of an envelope that is already in its shard is removed.
+func (s *Store) migrate(key string) (bool, error) {
+	sharded := shard(key)
+	if sharded == key {
+		return false, nil
+	}
+
+	migrated := false
+	for _, ext := range extensions {
+		flat := filepath.Join(s.prefix, key+ext.extension)
+		if _, err := os.Stat(flat); errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return migrated, err
+		}
+
+		if _, _, err := stat(filepath.Join(s.prefix, sharded)); errors.Is(err, fs.ErrNotExist) {
+			path := filepath.Join(s.prefix, sharded+ext.extension)
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return migrated, err
+			}
+
+			if err := os.Rename(flat, path); err != nil {
+				return migrated, err
+			}
+
+			if err := s.syncDir(filepath.Dir(path)); err != nil {
+				return migrated, err
+			}
+		} else if err != nil {
+			return migrated, err
+		} else if err := os.Remove(flat); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return migrated, err
+		}
+
+		migrated = true
+	}
+
+	return migrated, nil
 }
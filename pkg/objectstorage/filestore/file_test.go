@@ -14,13 +14,17 @@
 package filestore_test
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"os"
 	"path/filepath"
 	"testing"
+	"testing/iotest"
 
+	"github.com/in-toto/archivista/pkg/objectstorage"
 	"github.com/in-toto/archivista/pkg/objectstorage/filestore"
+	"github.com/in-toto/archivista/pkg/tenant"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -50,16 +54,13 @@ func (ut *UTFileStoreSuite) TearDownTest() {
 }
 func (ut *UTFileStoreSuite) Test_Get() {
 
-	store, _, err := filestore.New(context.Background(), ut.tempDir, ":50025")
-	if err != nil {
-		ut.FailNow(err.Error())
-	}
+	store := filestore.New(ut.tempDir)
 
 	// Define a test payload
 	payload := []byte("test payload")
 
 	// Store the payload
-	err = store.Store(context.Background(), "test_gitoid", payload)
+	err := store.Store(context.Background(), "test_gitoid", payload)
 	if err != nil {
 		ut.FailNow(err.Error())
 	}
@@ -93,3 +94,209 @@ func (ut *UTFileStoreSuite) Test_Get() {
 	}
 
 }
+
+func (ut *UTFileStoreSuite) Test_Start() {
+	store := filestore.New(filepath.Join(ut.tempDir, "envelopes"))
+	ut.NoError(store.Start(context.Background()))
+	ut.DirExists(filepath.Join(ut.tempDir, "envelopes"))
+	ut.NoError(store.Close())
+}
+
+func (ut *UTFileStoreSuite) Test_Get_NotFound() {
+	store := filestore.New(ut.tempDir)
+
+	_, err := store.Get(context.Background(), "missing_gitoid")
+	ut.ErrorIs(err, objectstorage.ErrNotFound)
+}
+
+func (ut *UTFileStoreSuite) Test_Exists() {
+	store := filestore.New(ut.tempDir)
+
+	exists, err := store.Exists(context.Background(), "test_gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+
+	err = store.Store(context.Background(), "test_gitoid", ut.payload)
+	if err != nil {
+		ut.FailNow(err.Error())
+	}
+
+	exists, err = store.Exists(context.Background(), "test_gitoid")
+	ut.NoError(err)
+	ut.True(exists)
+
+	_, err = store.Exists(context.Background(), "../../test_gitoid")
+	ut.ErrorIs(err, filepath.ErrBadPattern)
+}
+
+func (ut *UTFileStoreSuite) Test_Delete() {
+	store := filestore.New(ut.tempDir)
+
+	ut.NoError(store.Store(context.Background(), "test_gitoid", ut.payload))
+	ut.NoError(store.Delete(context.Background(), "test_gitoid"))
+
+	exists, err := store.Exists(context.Background(), "test_gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+
+	// deleting an object that is already gone is not an error so deletes can be retried
+	ut.NoError(store.Delete(context.Background(), "test_gitoid"))
+	ut.ErrorIs(store.Delete(context.Background(), "../../test_gitoid"), filepath.ErrBadPattern)
+}
+
+func (ut *UTFileStoreSuite) Test_Store_NeverOverwrites() {
+	store := filestore.New(ut.tempDir)
+
+	ut.NoError(store.Store(context.Background(), "test_gitoid", ut.payload))
+	ut.NoError(store.Store(context.Background(), "test_gitoid", []byte("tampered")))
+	ut.NoError(store.StoreStream(context.Background(), "test_gitoid", bytes.NewReader([]byte("tampered")), 8))
+
+	retrievedPayload, err := os.ReadFile(filepath.Join(ut.tempDir, "te", "st", "test_gitoid.json"))
+	ut.NoError(err)
+	ut.Equal(ut.payload, retrievedPayload)
+}
+
+func (ut *UTFileStoreSuite) Test_StoreStream() {
+	store := filestore.New(ut.tempDir)
+
+	err := store.StoreStream(context.Background(), "test_gitoid", bytes.NewReader(ut.payload), int64(len(ut.payload)))
+	ut.NoError(err)
+
+	retrievedPayload, err := os.ReadFile(filepath.Join(ut.tempDir, "te", "st", "test_gitoid.json"))
+	ut.NoError(err)
+	ut.Equal(ut.payload, retrievedPayload)
+
+	err = store.StoreStream(context.Background(), "../../test_gitoid", bytes.NewReader(ut.payload), int64(len(ut.payload)))
+	ut.ErrorIs(err, filepath.ErrBadPattern)
+}
+
+func (ut *UTFileStoreSuite) Test_Tenants() {
+	store := filestore.New(ut.tempDir)
+
+	teamA := tenant.NewContext(context.Background(), "team-a")
+	ut.NoError(store.Store(teamA, "test_gitoid", ut.payload))
+
+	retrievedPayload, err := os.ReadFile(filepath.Join(ut.tempDir, "team-a", "te", "st", "test_gitoid.json"))
+	ut.NoError(err)
+	ut.Equal(ut.payload, retrievedPayload)
+
+	exists, err := store.Exists(teamA, "test_gitoid")
+	ut.NoError(err)
+	ut.True(exists)
+
+	exists, err = store.Exists(tenant.NewContext(context.Background(), "team-b"), "test_gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+
+	// the default tenant keeps the layout from before multi-tenancy
+	exists, err = store.Exists(tenant.NewContext(context.Background(), tenant.Default), "test_gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+}
+
+func (ut *UTFileStoreSuite) Test_Compression() {
+	plain := filestore.New(ut.tempDir)
+	store := filestore.New(ut.tempDir, filestore.WithCompression(objectstorage.CompressionZstd))
+
+	teamA := tenant.NewContext(context.Background(), "team-a")
+	ut.NoError(plain.Store(teamA, "plain_gitoid", ut.payload))
+	ut.NoError(store.Store(context.Background(), "test_gitoid", ut.payload))
+	ut.FileExists(filepath.Join(ut.tempDir, "te", "st", "test_gitoid.json.zst"))
+	ut.NoFileExists(filepath.Join(ut.tempDir, "te", "st", "test_gitoid.json"))
+
+	// envelopes are read back uncompressed whatever the store reading them compresses with
+	for _, s := range []*filestore.Store{plain, store} {
+		reader, err := s.Get(context.Background(), "test_gitoid")
+		ut.Require().NoError(err)
+		retrievedPayload, err := io.ReadAll(reader)
+		reader.Close()
+		ut.NoError(err)
+		ut.Equal(ut.payload, retrievedPayload)
+	}
+
+	// an envelope is not stored again just because the store's compression changed
+	ut.NoError(store.Store(teamA, "plain_gitoid", ut.payload))
+	ut.NoFileExists(filepath.Join(ut.tempDir, "team-a", "pl", "ai", "plain_gitoid.json.zst"))
+
+	result, err := objectstorage.RecompressAll(context.Background(), store, func(key string, err error) { ut.Fail(key, err) })
+	ut.NoError(err)
+	ut.Equal(objectstorage.RecompressResult{Checked: 2, Recompressed: 1}, result)
+	ut.NoFileExists(filepath.Join(ut.tempDir, "team-a", "pl", "ai", "plain_gitoid.json"))
+
+	reader, err := plain.Get(teamA, "plain_gitoid")
+	ut.Require().NoError(err)
+	defer reader.Close()
+	retrievedPayload, err := io.ReadAll(reader)
+	ut.NoError(err)
+	ut.Equal(ut.payload, retrievedPayload)
+
+	ut.NoError(store.Delete(teamA, "plain_gitoid"))
+	exists, err := plain.Exists(teamA, "plain_gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+}
+
+func (ut *UTFileStoreSuite) Test_Store_Atomic() {
+	store := filestore.New(ut.tempDir, filestore.WithFsync(true))
+
+	// an envelope that fails to be read is never left under its gitoid
+	err := store.StoreStream(context.Background(), "test_gitoid", io.MultiReader(bytes.NewReader(ut.payload), iotest.ErrReader(io.ErrUnexpectedEOF)), 0)
+	ut.ErrorIs(err, io.ErrUnexpectedEOF)
+
+	exists, err := store.Exists(context.Background(), "test_gitoid")
+	ut.NoError(err)
+	ut.False(exists)
+
+	entries, err := os.ReadDir(filepath.Join(ut.tempDir, "te", "st"))
+	ut.NoError(err)
+	ut.Empty(entries)
+
+	ut.NoError(store.Store(context.Background(), "test_gitoid", ut.payload))
+	retrievedPayload, err := os.ReadFile(filepath.Join(ut.tempDir, "te", "st", "test_gitoid.json"))
+	ut.NoError(err)
+	ut.Equal(ut.payload, retrievedPayload)
+}
+
+func (ut *UTFileStoreSuite) Test_MigrateLayout() {
+	store := filestore.New(ut.tempDir)
+
+	// envelopes written before sharding are in the flat layout
+	ut.Require().NoError(os.WriteFile(filepath.Join(ut.tempDir, "flat_gitoid.json"), ut.payload, 0o600))
+	ut.Require().NoError(os.MkdirAll(filepath.Join(ut.tempDir, "team-a"), 0o755))
+	ut.Require().NoError(os.WriteFile(filepath.Join(ut.tempDir, "team-a", "flat_gitoid.json"), ut.payload, 0o600))
+	ut.Require().NoError(os.WriteFile(filepath.Join(ut.tempDir, "abc.json"), ut.payload, 0o600))
+	ut.NoError(store.Store(context.Background(), "test_gitoid", ut.payload))
+
+	teamA := tenant.NewContext(context.Background(), "team-a")
+	for _, ctx := range []context.Context{context.Background(), teamA} {
+		reader, err := store.Get(ctx, "flat_gitoid")
+		ut.Require().NoError(err)
+		retrievedPayload, err := io.ReadAll(reader)
+		reader.Close()
+		ut.NoError(err)
+		ut.Equal(ut.payload, retrievedPayload)
+	}
+
+	keys := []string{}
+	ut.NoError(store.List(context.Background(), func(key string) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	ut.ElementsMatch([]string{"abc", "flat_gitoid", "team-a/flat_gitoid", "test_gitoid"}, keys)
+
+	result, err := store.MigrateLayout(context.Background(), func(key string, err error) { ut.Fail(key, err) })
+	ut.NoError(err)
+	ut.Equal(filestore.MigrateResult{Checked: 4, Migrated: 2}, result)
+	ut.NoFileExists(filepath.Join(ut.tempDir, "flat_gitoid.json"))
+	ut.FileExists(filepath.Join(ut.tempDir, "fl", "at", "flat_gitoid.json"))
+	ut.FileExists(filepath.Join(ut.tempDir, "team-a", "fl", "at", "flat_gitoid.json"))
+	// names too short to shard stay where they are
+	ut.FileExists(filepath.Join(ut.tempDir, "abc.json"))
+
+	exists, err := store.Exists(teamA, "flat_gitoid")
+	ut.NoError(err)
+	ut.True(exists)
+
+	ut.NoError(store.Delete(teamA, "flat_gitoid"))
+	ut.NoFileExists(filepath.Join(ut.tempDir, "team-a", "fl", "at", "flat_gitoid.json"))
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gcsstore keeps envelopes in a Google Cloud Storage bucket
+package gcsstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/storage"
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+type Store struct {
+	client        *storage.Client
+	bucket        *storage.BucketHandle
+	clientOptions []option.ClientOption
+	compression   objectstorage.Compression
+}
+
+type Option func(*Store)
+
+// WithClientOptions configures the Cloud Storage client, such as its endpoint and credentials
+func WithClientOptions(opts ...option.ClientOption) Option {
+	return func(s *Store) {
+		s.clientOptions = append(s.clientOptions, opts...)
+	}
+}
+
+// WithCompression compresses envelopes uploaded to the bucket, recording the compression in the
+// object's metadata. Envelopes already stored keep the compression they were uploaded with
+// until they are recompressed.
+func WithCompression(compression objectstorage.Compression) Option {
+	return func(s *Store) {
+		s.compression = compression
+	}
+}
+
+func init() {
+	objectstorage.Register("GCS", newFromConfig)
+}
+
+// newFromConfig creates the GCS backend from the ARCHIVISTA_GCS_* configuration. Application
+// default credentials are used unless a credentials file is configured, and STORAGE_EMULATOR_HOST
+// points the client at an emulator.
+func newFromConfig(ctx context.Context, cfg *config.Config) (objectstorage.Store, error) {
+	compression, err := objectstorage.ParseCompression(cfg.StorageCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []Option{WithCompression(compression)}
+	if cfg.GCSEndpoint != "" {
+		opts = append(opts, WithClientOptions(option.WithEndpoint(cfg.GCSEndpoint)))
+	}
+
+	if cfg.GCSCredentialsFile != "" {
+		opts = append(opts, WithClientOptions(option.WithAuthCredentialsFile(option.ServiceAccount, cfg.GCSCredentialsFile)))
+	}
+
+	store, err := New(ctx, cfg.GCSBucketName, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// New returns a reader/writer for storing/retrieving attestations in bucketName. The bucket is
+// checked when the store is started.
+func New(ctx context.Context, bucketName string, opts ...Option) (*Store, error) {
+	store := &Store{}
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	client, err := storage.NewClient(ctx, store.clientOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcs client: %w", err)
+	}
+
+	store.client = client
+	store.bucket = client.Bucket(bucketName)
+	return store, nil
+}
+
+// Start checks the bucket exists
+func (s *Store) Start(ctx context.Context) error {
+	if _, err := s.bucket.Attrs(ctx); err != nil {
+		return fmt.Errorf("failed to find bucket %s: %w", s.bucket.BucketName(), err)
+	}
+
+	return nil
+}
+
+// Close closes the client of the store
+func (s *Store) Close() error {
+	return s.client.Close()
+}
+
+func (s *Store) Get(ctx context.Context, gitoid string) (io.ReadCloser, error) {
+	r, compression, err := s.open(ctx, tenant.ObjectKey(ctx, gitoid))
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, fmt.Errorf("%w: %s", objectstorage.ErrNotFound, gitoid)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return compression.Decompress(r)
+}
+
+// open opens the object stored under key along with the compression it was uploaded with
+func (s *Store) open(ctx context.Context, key string) (io.ReadCloser, objectstorage.Compression, error) {
+	obj := s.bucket.Object(key)
+	attrs, err := obj.Attrs(ctx)
+	if err != nil {
+		return nil, objectstorage.CompressionNone, err
+	}
+
+	// read the generation the metadata came from, in case the object is being recompressed
+	r, err := obj.Generation(attrs.Generation).NewReader(ctx)
+	if err != nil {
+		return nil, objectstorage.CompressionNone, err
+	}
+
+	return r, objectstorage.Compression(attrs.Metadata[objectstorage.EncodingMetadataKey]), nil
+}
+
+// Exists reports whether an envelope with the given gitoid has been uploaded to the bucket
+func (s *Store) Exists(ctx context.Context, gitoid string) (bool, error) {
+	if _, err := s.bucket.Object(tenant.ObjectKey(ctx, gitoid)).Attrs(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *Store) Store(ctx context.Context, gitoid string, payload []byte) error {
+	return s.StoreStream(ctx, gitoid, bytes.NewReader(payload), int64(len(payload)))
+}
+
+func (s *Store) StoreStream(ctx context.Context, gitoid string, r io.Reader, size int64) error {
+	return s.put(ctx, tenant.ObjectKey(ctx, gitoid), r, size)
+}
+
+// put uploads the envelope read from r under key with the store's compression. The length of
+// the envelope is only checked against size if size isn't negative.
+func (s *Store) put(ctx context.Context, key string, r io.Reader, size int64) error {
+	// the upload is only abandoned, rather than committed, if its context is cancelled before Close
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	counter := &objectstorage.CountingReader{R: r}
+	compressed := s.compression.Compress(counter)
+	defer compressed.Close()
+
+	w := s.bucket.Object(key).NewWriter(ctx)
+	w.ContentType = "application/json"
+	if s.compression != objectstorage.CompressionNone {
+		w.Metadata = map[string]string{objectstorage.EncodingMetadataKey: string(s.compression)}
+	}
+
+	if _, err := io.Copy(w, compressed); err != nil {
+		cancel()
+		_ = w.Close()
+		return fmt.Errorf("failed to put object: %w", err)
+	} else if size >= 0 && counter.N != size {
+		cancel()
+		_ = w.Close()
+		return fmt.Errorf("failed to upload full object: size %d != uploaded size %d", size, counter.N)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the envelope with gitoid from the bucket. Deleting an envelope that is not stored is not an error
+func (s *Store) Delete(ctx context.Context, gitoid string) error {
+	err := s.bucket.Object(tenant.ObjectKey(ctx, gitoid)).Delete(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// List calls fn with the key of every envelope in the bucket
+func (s *Store) List(ctx context.Context, fn func(key string) error) error {
+	it := s.bucket.Objects(ctx, nil)
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := fn(attrs.Name); err != nil {
+			return err
+		}
+	}
+}
+
+// Recompress uploads the envelope stored under key again with the store's compression
+func (s *Store) Recompress(ctx context.Context, key string) (bool, error) {
+	obj, compression, err := s.open(ctx, key)
+	if err != nil {
+		return false, err
+	} else if compression == s.compression {
+		obj.Close()
+		return false, nil
+	}
+
+	r, err := compression.Decompress(obj)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	return true, s.put(ctx, key, r, -1)
+}
+
+// Rewrite replaces the envelope stored under key with the one returned by fn
+func (s *Store) Rewrite(ctx context.Context, key string, fn func(io.Reader) (io.ReadCloser, error)) (bool, error) {
+	obj, compression, err := s.open(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	r, err := compression.Decompress(obj)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	rewritten, err := fn(r)
+	if err != nil || rewritten == nil {
+		return false, err
+	}
+	defer rewritten.Close()
+
+	return true, s.put(ctx, key, rewritten, -1)
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcsstore_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/in-toto/archivista/pkg/objectstorage/gcsstore"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/api/option"
+)
+
+const bucket = "envelopes"
+
+// fakeGCS serves the parts of the Cloud Storage JSON and XML APIs the store uses
+type fakeGCS struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	metadata map[string]map[string]string
+}
+
+func (f *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch path := r.URL.Path; {
+	case path == "/storage/v1/b/"+bucket:
+		writeJSON(w, map[string]string{"name": bucket})
+
+	case path == "/upload/storage/v1/b/"+bucket+"/o":
+		name, metadata, content, err := readMultipartUpload(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		f.objects[name] = content
+		f.metadata[name] = metadata
+		writeJSON(w, f.objectAttrs(name))
+
+	case path == "/storage/v1/b/"+bucket+"/o":
+		items := make([]map[string]any, 0)
+		for name := range f.objects {
+			items = append(items, f.objectAttrs(name))
+		}
+
+		writeJSON(w, map[string]any{"items": items})
+
+	case strings.HasPrefix(path, "/storage/v1/b/"+bucket+"/o/"):
+		name := strings.TrimPrefix(path, "/storage/v1/b/"+bucket+"/o/")
+		if _, ok := f.objects[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]any{"error": map[string]any{"code": http.StatusNotFound, "message": "No such object"}})
+			return
+		}
+
+		if r.Method == http.MethodDelete {
+			delete(f.objects, name)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		writeJSON(w, f.objectAttrs(name))
+
+	case strings.HasPrefix(path, "/"+bucket+"/"):
+		content, ok := f.objects[strings.TrimPrefix(path, "/"+bucket+"/")]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+		_, _ = w.Write(content)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func readMultipartUpload(r *http.Request) (string, map[string]string, []byte, error) {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	mr := multipart.NewReader(r.Body, params["boundary"])
+	attrs := struct {
+		Name     string            `json:"name"`
+		Metadata map[string]string `json:"metadata"`
+	}{}
+
+	part, err := mr.NextPart()
+	if err != nil {
+		return "", nil, nil, err
+	} else if err := json.NewDecoder(part).Decode(&attrs); err != nil {
+		return "", nil, nil, err
+	}
+
+	part, err = mr.NextPart()
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	content, err := io.ReadAll(part)
+	return attrs.Name, attrs.Metadata, content, err
+}
+
+func (f *fakeGCS) objectAttrs(name string) map[string]any {
+	return map[string]any{"bucket": bucket, "name": name, "size": fmt.Sprint(len(f.objects[name])), "generation": "1", "metadata": f.metadata[name]}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// Test Suite: UT GCSStore
+type UTGCSStoreSuite struct {
+	suite.Suite
+	fake   *fakeGCS
+	server *httptest.Server
+	store  *gcsstore.Store
+}
+
+func TestUTGCSStoreSuite(t *testing.T) {
+	suite.Run(t, new(UTGCSStoreSuite))
+}
+
+func (ut *UTGCSStoreSuite) SetupTest() {
+	ut.fake = &fakeGCS{objects: map[string][]byte{}, metadata: map[string]map[string]string{}}
+	ut.server = httptest.NewServer(ut.fake)
+	ut.store = ut.newStore(objectstorage.CompressionNone)
+}
+
+func (ut *UTGCSStoreSuite) newStore(compression objectstorage.Compression) *gcsstore.Store {
+	store, err := gcsstore.New(context.Background(), bucket, ut.clientOptions(), gcsstore.WithCompression(compression))
+	ut.Require().NoError(err)
+	ut.Require().NoError(store.Start(context.Background()))
+	ut.T().Cleanup(func() { store.Close() })
+	return store
+}
+
+func (ut *UTGCSStoreSuite) clientOptions() gcsstore.Option {
+	return gcsstore.WithClientOptions(option.WithEndpoint(ut.server.URL+"/storage/v1/"), option.WithoutAuthentication())
+}
+
+func (ut *UTGCSStoreSuite) TearDownTest() {
+	ut.server.Close()
+}
+
+func (ut *UTGCSStoreSuite) Test_StoreGet() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", []byte("envelope")))
+	ut.Equal([]byte("envelope"), ut.fake.objects["gitoid"])
+
+	r, err := ut.store.Get(ctx, "gitoid")
+	ut.Require().NoError(err)
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	ut.NoError(err)
+	ut.Equal("envelope", string(content))
+
+	exists, err := ut.store.Exists(ctx, "gitoid")
+	ut.NoError(err)
+	ut.True(exists)
+}
+
+func (ut *UTGCSStoreSuite) Test_StoreStream_Tenant() {
+	ctx := tenant.NewContext(context.Background(), "acme")
+	ut.Require().NoError(ut.store.StoreStream(ctx, "gitoid", strings.NewReader("envelope"), 8))
+	ut.Equal([]byte("envelope"), ut.fake.objects["acme/gitoid"])
+
+	ut.ErrorContains(ut.store.StoreStream(ctx, "short", strings.NewReader("envelope"), 9), "size 9 != uploaded size 8")
+}
+
+func (ut *UTGCSStoreSuite) Test_NotFound() {
+	ctx := context.Background()
+	_, err := ut.store.Get(ctx, "missing")
+	ut.True(errors.Is(err, objectstorage.ErrNotFound))
+
+	exists, err := ut.store.Exists(ctx, "missing")
+	ut.NoError(err)
+	ut.False(exists)
+}
+
+func (ut *UTGCSStoreSuite) Test_Delete() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", []byte("envelope")))
+	ut.NoError(ut.store.Delete(ctx, "gitoid"))
+	ut.NotContains(ut.fake.objects, "gitoid")
+	ut.NoError(ut.store.Delete(ctx, "gitoid"))
+}
+
+func (ut *UTGCSStoreSuite) Test_Start_MissingBucket() {
+	store, err := gcsstore.New(context.Background(), "missing", ut.clientOptions())
+	ut.Require().NoError(err)
+	defer store.Close()
+	ut.ErrorContains(store.Start(context.Background()), "failed to find bucket missing")
+}
+
+func (ut *UTGCSStoreSuite) Test_Compression() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "plain", []byte("plain envelope")))
+
+	store := ut.newStore(objectstorage.CompressionZstd)
+	ut.Require().NoError(store.Store(ctx, "gitoid", []byte("envelope")))
+	ut.Equal(map[string]string{objectstorage.EncodingMetadataKey: "zstd"}, ut.fake.metadata["gitoid"])
+	ut.NotEqual([]byte("envelope"), ut.fake.objects["gitoid"])
+	ut.Equal("envelope", ut.read(store, "gitoid"))
+	ut.Equal("envelope", ut.read(ut.store, "gitoid"))
+	ut.Equal("plain envelope", ut.read(store, "plain"))
+
+	result, err := objectstorage.RecompressAll(ctx, store, func(key string, err error) { ut.Fail(key, err) })
+	ut.NoError(err)
+	ut.Equal(objectstorage.RecompressResult{Checked: 2, Recompressed: 1}, result)
+	ut.Equal("zstd", ut.fake.metadata["plain"][objectstorage.EncodingMetadataKey])
+	ut.Equal("plain envelope", ut.read(ut.store, "plain"))
+}
+
+func (ut *UTGCSStoreSuite) Test_Rewrite() {
+	ctx := context.Background()
+	ut.Require().NoError(ut.store.Store(ctx, "gitoid", []byte("envelope")))
+
+	rewritten, err := ut.store.Rewrite(ctx, "gitoid", func(r io.Reader) (io.ReadCloser, error) {
+		content, err := io.ReadAll(r)
+		return io.NopCloser(strings.NewReader(strings.ToUpper(string(content)))), err
+	})
+	ut.NoError(err)
+	ut.True(rewritten)
+	ut.Equal([]byte("ENVELOPE"), ut.fake.objects["gitoid"])
+
+	rewritten, err = ut.store.Rewrite(ctx, "gitoid", func(io.Reader) (io.ReadCloser, error) { return nil, nil })
+	ut.NoError(err)
+	ut.False(rewritten)
+	ut.Equal([]byte("ENVELOPE"), ut.fake.objects["gitoid"])
+}
+
+func (ut *UTGCSStoreSuite) read(store *gcsstore.Store, gitoid string) string {
+	r, err := store.Get(context.Background(), gitoid)
+	ut.Require().NoError(err)
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	ut.Require().NoError(err)
+	return string(content)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+)
+
+// Recompressor is implemented by object stores that can rewrite the envelopes they already hold
+// with the Compression they are configured with
+type Recompressor interface {
+	// List calls fn with the key of every stored envelope, as built by tenant.ObjectKey
+	List(ctx context.Context, fn func(key string) error) error
+	// Recompress rewrites the envelope stored under key with the store's Compression. It
+	// reports false if the envelope already was compressed that way.
+	Recompress(ctx context.Context, key string) (bool, error)
+}
+
+// RecompressResult counts the envelopes visited by RecompressAll
+type RecompressResult struct {
+	Checked      int
+	Recompressed int
+	Failed       int
+}
+
+// RecompressAll rewrites every envelope in store that is not compressed with the store's
+// Compression. An envelope that can't be rewritten is reported to onError and skipped, so one
+// bad object does not stop the migration.
+func RecompressAll(ctx context.Context, store Recompressor, onError func(key string, err error)) (RecompressResult, error) {
+	result := RecompressResult{}
+	err := store.List(ctx, func(key string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		result.Checked++
+		rewritten, err := store.Recompress(ctx, key)
+		if err != nil {
+			result.Failed++
+			onError(key, err)
+			return nil
+		}
+
+		if rewritten {
+			result.Recompressed++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to list stored envelopes: %w", err)
+	}
+
+	return result, nil
+}
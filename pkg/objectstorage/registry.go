@@ -0,0 +1,93 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/in-toto/archivista/pkg/config"
+)
+
+// Store is an object store envelopes are kept in, keyed by their gitoid. Start is called once
+// before the store is used, and Close once it no longer is.
+type Store interface {
+	Store(ctx context.Context, gitoid string, payload []byte) error
+	Get(ctx context.Context, gitoid string) (io.ReadCloser, error)
+	// Start checks the store can be used, such as that its bucket exists
+	Start(ctx context.Context) error
+	// Close releases the resources held by the store
+	Close() error
+}
+
+// Factory creates the object store of a backend from the configuration. The store is not
+// started.
+type Factory func(ctx context.Context, cfg *config.Config) (Store, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = make(map[string]Factory)
+)
+
+// Register makes an object store backend available under name, which is matched against
+// ARCHIVISTA_STORAGE_BACKEND case insensitively. Backends register themselves from init, so
+// importing the package of a backend is enough to use it. Register panics if a backend is
+// registered twice under the same name.
+func Register(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	name = strings.ToUpper(name)
+	if factory == nil {
+		panic("objectstorage: Register factory is nil for " + name)
+	}
+
+	if _, ok := factories[name]; ok {
+		panic("objectstorage: Register called twice for " + name)
+	}
+
+	factories[name] = factory
+}
+
+// Backends returns the sorted names of the registered backends
+func Backends() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// New creates the object store of the backend registered under name. The caller starts the
+// store and closes it once it is done with it.
+func New(ctx context.Context, name string, cfg *config.Config) (Store, error) {
+	factoriesMu.RLock()
+	factory, ok := factories[strings.ToUpper(name)]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown storage backend: %s", name)
+	}
+
+	return factory(ctx, cfg)
+}
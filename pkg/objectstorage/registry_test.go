@@ -0,0 +1,77 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstorage_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/stretchr/testify/suite"
+)
+
+type memoryStore struct {
+	bucket string
+}
+
+func (m *memoryStore) Store(context.Context, string, []byte) error {
+	return nil
+}
+
+func (m *memoryStore) Get(context.Context, string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(m.bucket)), nil
+}
+
+func (m *memoryStore) Start(context.Context) error {
+	return nil
+}
+
+func (m *memoryStore) Close() error {
+	return nil
+}
+
+// Test Suite: UT Registry
+type UTRegistrySuite struct {
+	suite.Suite
+}
+
+func TestUTRegistrySuite(t *testing.T) {
+	suite.Run(t, new(UTRegistrySuite))
+}
+
+func (ut *UTRegistrySuite) Test_Register() {
+	objectstorage.Register("memory-test", func(ctx context.Context, cfg *config.Config) (objectstorage.Store, error) {
+		return &memoryStore{bucket: cfg.BlobStoreBucketName}, nil
+	})
+
+	ut.Contains(objectstorage.Backends(), "MEMORY-TEST")
+	store, err := objectstorage.New(context.Background(), "Memory-Test", &config.Config{BlobStoreBucketName: "envelopes"})
+	ut.Require().NoError(err)
+	ut.Equal(&memoryStore{bucket: "envelopes"}, store)
+
+	ut.Panics(func() {
+		objectstorage.Register("MEMORY-TEST", func(context.Context, *config.Config) (objectstorage.Store, error) {
+			return nil, nil
+		})
+	})
+}
+
+func (ut *UTRegistrySuite) Test_New_Unknown() {
+	_, err := objectstorage.New(context.Background(), "tape", &config.Config{})
+	ut.EqualError(err, "unknown storage backend: tape")
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstorage
+
+import (
+	"context"
+	"io"
+)
+
+// Rewriter is implemented by object stores that can replace the envelopes they already hold,
+// such as to wrap their encryption keys again
+type Rewriter interface {
+	// List calls fn with the key of every stored envelope, as built by tenant.ObjectKey
+	List(ctx context.Context, fn func(key string) error) error
+	// Rewrite calls fn with the envelope stored under key and replaces the envelope with what
+	// fn returns. The envelope is left as it is, and false reported, if fn returns nil.
+	Rewrite(ctx context.Context, key string, fn func(io.Reader) (io.ReadCloser, error)) (bool, error)
+}
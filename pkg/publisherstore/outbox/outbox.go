@@ -0,0 +1,287 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package outbox delivers stored envelopes to publishers in the background. Pending
+// deliveries are written by the SQL store in the same transaction as the envelope's
+// metadata, and the worker retries failed deliveries with exponential backoff until
+// they succeed or are moved to the dead letter state.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/pkg/publisherstore"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/sirupsen/logrus"
+)
+
+// Getter loads the envelope that a delivery refers to.
+type Getter interface {
+	Get(context.Context, string) (io.ReadCloser, error)
+}
+
+type Worker struct {
+	client         *ent.Client
+	objectStore    Getter
+	publishers     map[string]publisherstore.Publisher
+	workers        int
+	pollInterval   time.Duration
+	lease          time.Duration
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	now            func() time.Time
+}
+
+type Option func(*Worker)
+
+// WithWorkers sets how many deliveries are attempted concurrently.
+func WithWorkers(workers int) Option {
+	return func(w *Worker) {
+		w.workers = workers
+	}
+}
+
+// WithPollInterval sets how often the outbox is checked for due deliveries.
+func WithPollInterval(interval time.Duration) Option {
+	return func(w *Worker) {
+		w.pollInterval = interval
+	}
+}
+
+// WithLease sets how long a claimed delivery is hidden from other workers. A
+// delivery that is still in flight when its lease expires may be attempted again.
+func WithLease(lease time.Duration) Option {
+	return func(w *Worker) {
+		w.lease = lease
+	}
+}
+
+// WithMaxAttempts sets how many times a delivery is attempted before it is dead lettered.
+func WithMaxAttempts(attempts int) Option {
+	return func(w *Worker) {
+		w.maxAttempts = attempts
+	}
+}
+
+// WithBackoff sets the delay after the first failed attempt and the upper bound the
+// delay doubles up to on each subsequent failure.
+func WithBackoff(initial, maxDelay time.Duration) Option {
+	return func(w *Worker) {
+		w.initialBackoff = initial
+		w.maxBackoff = maxDelay
+	}
+}
+
+func New(client *ent.Client, objectStore Getter, publishers map[string]publisherstore.Publisher, opts ...Option) *Worker {
+	w := &Worker{
+		client:         client,
+		objectStore:    objectStore,
+		publishers:     publishers,
+		workers:        4,
+		pollInterval:   5 * time.Second,
+		lease:          time.Minute,
+		maxAttempts:    10,
+		initialBackoff: time.Second,
+		maxBackoff:     10 * time.Minute,
+		now:            time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	if w.workers < 1 {
+		w.workers = 1
+	}
+
+	return w
+}
+
+// Start polls for due deliveries until ctx is done. The returned channel is closed
+// once every in flight delivery has finished.
+func (w *Worker) Start(ctx context.Context) <-chan error {
+	errCh := make(chan error)
+	jobs := make(chan *ent.PublishDelivery)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < w.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range jobs {
+				w.attempt(ctx, delivery)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(errCh)
+		defer wg.Wait()
+		defer close(jobs)
+
+		ticker := time.NewTicker(w.pollInterval)
+		defer ticker.Stop()
+		for {
+			due, err := w.due(ctx)
+			if err != nil && ctx.Err() == nil {
+				logrus.Errorf("could not query publish outbox: %+v", err)
+			}
+
+			for _, delivery := range due {
+				select {
+				case jobs <- delivery:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return errCh
+}
+
+// due returns pending deliveries whose next attempt is scheduled for now or earlier.
+// deliveries for publishers that are no longer configured are left alone.
+func (w *Worker) due(ctx context.Context) ([]*ent.PublishDelivery, error) {
+	names := make([]string, 0, len(w.publishers))
+	for name := range w.publishers {
+		names = append(names, name)
+	}
+
+	return w.client.PublishDelivery.Query().
+		Where(
+			publishdelivery.StatusEQ(publishdelivery.StatusPending),
+			publishdelivery.NextAttemptAtLTE(w.now()),
+			publishdelivery.PublisherIn(names...),
+		).
+		Order(ent.Asc(publishdelivery.FieldNextAttemptAt)).
+		Limit(w.workers * 10).
+		All(ctx)
+}
+
+// attempt claims the delivery and publishes it. the claim pushes the next attempt out
+// by the lease, conditioned on the row being unchanged since it was read, so only one
+// worker across all replicas delivers it at a time.
+func (w *Worker) attempt(ctx context.Context, delivery *ent.PublishDelivery) {
+	claimed, err := w.client.PublishDelivery.Update().
+		Where(
+			publishdelivery.ID(delivery.ID),
+			publishdelivery.StatusEQ(publishdelivery.StatusPending),
+			publishdelivery.NextAttemptAt(delivery.NextAttemptAt),
+		).
+		SetNextAttemptAt(w.now().Add(w.lease)).
+		Save(ctx)
+	if err != nil {
+		logrus.Errorf("could not claim delivery of %s to %s: %+v", delivery.GitoidSha256, delivery.Publisher, err)
+		return
+	} else if claimed == 0 {
+		return
+	}
+
+	// the envelope is read and published as the tenant that uploaded it
+	publishErr := w.publish(tenant.NewContext(ctx, delivery.Tenant), delivery)
+	if publishErr != nil && ctx.Err() != nil {
+		// shutting down. the lease will expire and the delivery will be picked up again.
+		return
+	}
+
+	update := w.client.PublishDelivery.UpdateOneID(delivery.ID)
+	o := w.outcome(delivery.Attempts, publishErr)
+	update.SetStatus(o.status).SetAttempts(o.attempts)
+	switch o.status {
+	case publishdelivery.StatusDelivered:
+		update.SetDeliveredAt(w.now()).ClearLastError()
+	case publishdelivery.StatusPending:
+		update.SetNextAttemptAt(w.now().Add(o.retryIn)).SetLastError(publishErr.Error())
+		logrus.Warnf("delivery of %s to %s failed, retrying in %s: %+v", delivery.GitoidSha256, delivery.Publisher, o.retryIn, publishErr)
+	case publishdelivery.StatusDeadLetter:
+		update.SetLastError(publishErr.Error())
+		logrus.Errorf("delivery of %s to %s failed after %d attempts: %+v", delivery.GitoidSha256, delivery.Publisher, o.attempts, publishErr)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		logrus.Errorf("could not record delivery of %s to %s: %+v", delivery.GitoidSha256, delivery.Publisher, err)
+	}
+}
+
+func (w *Worker) publish(ctx context.Context, delivery *ent.PublishDelivery) error {
+	publisher, ok := w.publishers[delivery.Publisher]
+	if !ok {
+		return fmt.Errorf("publisher %s is not configured", delivery.Publisher)
+	}
+
+	reader, err := w.objectStore.Get(ctx, delivery.GitoidSha256)
+	if err != nil {
+		return fmt.Errorf("could not load envelope: %w", err)
+	}
+	defer reader.Close()
+
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("could not load envelope: %w", err)
+	}
+
+	return publisher.Publish(ctx, delivery.GitoidSha256, payload)
+}
+
+type outcome struct {
+	status   publishdelivery.Status
+	attempts int
+	retryIn  time.Duration
+}
+
+// outcome decides what happens to a delivery after an attempt. attempts is the number
+// of attempts made before this one.
+func (w *Worker) outcome(attempts int, err error) outcome {
+	attempts++
+	if err == nil {
+		return outcome{status: publishdelivery.StatusDelivered, attempts: attempts}
+	}
+
+	if w.maxAttempts > 0 && attempts >= w.maxAttempts {
+		return outcome{status: publishdelivery.StatusDeadLetter, attempts: attempts}
+	}
+
+	return outcome{status: publishdelivery.StatusPending, attempts: attempts, retryIn: w.backoff(attempts)}
+}
+
+// backoff returns the delay before the next attempt after the given number of failed attempts.
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.initialBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= w.maxBackoff || delay <= 0 {
+			return w.maxBackoff
+		}
+	}
+
+	if delay > w.maxBackoff {
+		return w.maxBackoff
+	}
+
+	return delay
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/publishdelivery"
+	"github.com/in-toto/archivista/pkg/publisherstore"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetterMock struct {
+	mock.Mock
+}
+
+func (m *GetterMock) Get(context.Context, string) (io.ReadCloser, error) {
+	args := m.Called()
+	return io.NopCloser(bytes.NewReader(args.Get(0).([]byte))), args.Error(1)
+}
+
+type PublisherMock struct {
+	mock.Mock
+}
+
+func (m *PublisherMock) Publish(ctx context.Context, gitoid string, payload []byte) error {
+	args := m.Called(gitoid, payload)
+	return args.Error(0)
+}
+
+// Test Suite: UT Outbox
+type UTOutboxSuite struct {
+	suite.Suite
+}
+
+func TestUTOutboxSuite(t *testing.T) {
+	suite.Run(t, new(UTOutboxSuite))
+}
+
+func (ut *UTOutboxSuite) Test_Backoff() {
+	w := New(nil, nil, nil, WithBackoff(time.Second, 10*time.Second))
+	ut.Equal(time.Second, w.backoff(1))
+	ut.Equal(2*time.Second, w.backoff(2))
+	ut.Equal(8*time.Second, w.backoff(4))
+	ut.Equal(10*time.Second, w.backoff(5))
+	ut.Equal(10*time.Second, w.backoff(1000))
+}
+
+func (ut *UTOutboxSuite) Test_Outcome_Delivered() {
+	w := New(nil, nil, nil)
+	o := w.outcome(2, nil)
+	ut.Equal(publishdelivery.StatusDelivered, o.status)
+	ut.Equal(3, o.attempts)
+}
+
+func (ut *UTOutboxSuite) Test_Outcome_Retry() {
+	w := New(nil, nil, nil, WithMaxAttempts(3), WithBackoff(time.Second, time.Minute))
+	o := w.outcome(1, errors.New("unavailable"))
+	ut.Equal(publishdelivery.StatusPending, o.status)
+	ut.Equal(2, o.attempts)
+	ut.Equal(2*time.Second, o.retryIn)
+}
+
+func (ut *UTOutboxSuite) Test_Outcome_DeadLetter() {
+	w := New(nil, nil, nil, WithMaxAttempts(3))
+	o := w.outcome(2, errors.New("unavailable"))
+	ut.Equal(publishdelivery.StatusDeadLetter, o.status)
+	ut.Equal(3, o.attempts)
+}
+
+func (ut *UTOutboxSuite) Test_Publish() {
+	getter := &GetterMock{}
+	getter.On("Get").Return([]byte("envelope"), nil)
+	publisher := &PublisherMock{}
+	publisher.On("Publish", "gitoid", []byte("envelope")).Return(nil)
+
+	w := New(nil, getter, map[string]publisherstore.Publisher{"RSTUF": publisher})
+	err := w.publish(context.TODO(), &ent.PublishDelivery{GitoidSha256: "gitoid", Publisher: "RSTUF"})
+	ut.NoError(err)
+	publisher.AssertExpectations(ut.T())
+}
+
+func (ut *UTOutboxSuite) Test_Publish_UnknownPublisher() {
+	w := New(nil, &GetterMock{}, map[string]publisherstore.Publisher{})
+	err := w.publish(context.TODO(), &ent.PublishDelivery{GitoidSha256: "gitoid", Publisher: "DAPR"})
+	ut.ErrorContains(err, "publisher DAPR is not configured")
+}
+
+func (ut *UTOutboxSuite) Test_Publish_ObjectStoreError() {
+	getter := &GetterMock{}
+	getter.On("Get").Return([]byte{}, errors.New("not found"))
+	publisher := &PublisherMock{}
+
+	w := New(nil, getter, map[string]publisherstore.Publisher{"RSTUF": publisher})
+	err := w.publish(context.TODO(), &ent.PublishDelivery{GitoidSha256: "gitoid", Publisher: "RSTUF"})
+	ut.ErrorContains(err, "could not load envelope")
+	publisher.AssertNotCalled(ut.T(), "Publish", mock.Anything, mock.Anything)
+}
@@ -27,21 +27,69 @@ type Publisher interface {
 	Publish(ctx context.Context, gitoid string, payload []byte) error
 }
 
-func New(config *config.Config) []Publisher {
+type options struct {
+	rstufTasks rstuf.TaskStore
+}
+
+type Option func(*options)
+
+// WithRSTUFTaskStore records the task RSTUF starts for each published envelope so its
+// completion can be tracked.
+func WithRSTUFTaskStore(tasks rstuf.TaskStore) Option {
+	return func(o *options) {
+		o.rstufTasks = tasks
+	}
+}
+
+func New(config *config.Config, opts ...Option) []Publisher {
 	var publisherStore []Publisher
 	for _, pubType := range config.Publisher {
-		pubType = strings.ToUpper(pubType) // Normalize the input
-		switch pubType {
-		case "DAPR":
-			publisherStore = append(publisherStore, dapr.NewPublisher(config))
-			logrus.Info("Using publisher: DAPR")
-
-		case "RSTUF":
-			publisherStore = append(publisherStore, rstuf.NewPublisher(config))
-			logrus.Info("Using publisher: RSTUF")
-		default:
-			logrus.Errorf("unsupported publisher type: %s", pubType)
+		if publisher, ok := newPublisher(pubType, config, opts...); ok {
+			publisherStore = append(publisherStore, publisher)
 		}
 	}
 	return publisherStore
 }
+
+// NewNamed returns the configured publishers keyed by their normalized type so
+// deliveries recorded for a publisher can be routed back to it.
+func NewNamed(config *config.Config, opts ...Option) map[string]Publisher {
+	publishers := make(map[string]Publisher)
+	for _, pubType := range config.Publisher {
+		pubType = strings.ToUpper(pubType)
+		if _, ok := publishers[pubType]; ok {
+			continue
+		}
+
+		if publisher, ok := newPublisher(pubType, config, opts...); ok {
+			publishers[pubType] = publisher
+		}
+	}
+	return publishers
+}
+
+func newPublisher(pubType string, config *config.Config, opts ...Option) (Publisher, bool) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	pubType = strings.ToUpper(pubType) // Normalize the input
+	switch pubType {
+	case "DAPR":
+		logrus.Info("Using publisher: DAPR")
+		return dapr.NewPublisher(config), true
+
+	case "RSTUF":
+		logrus.Info("Using publisher: RSTUF")
+		rstufOpts := make([]rstuf.Option, 0)
+		if o.rstufTasks != nil {
+			rstufOpts = append(rstufOpts, rstuf.WithTaskStore(o.rstufTasks))
+		}
+
+		return rstuf.NewPublisher(config, rstufOpts...), true
+	default:
+		logrus.Errorf("unsupported publisher type: %s", pubType)
+		return nil, false
+	}
+}
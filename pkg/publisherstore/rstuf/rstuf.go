@@ -30,7 +30,18 @@ import (
 )
 
 type RSTUF struct {
-	Host string
+	Host  string
+	tasks TaskStore
+}
+
+type Option func(*RSTUF)
+
+// WithTaskStore records the RSTUF task started for each published envelope so a Monitor
+// can follow it to completion.
+func WithTaskStore(tasks TaskStore) Option {
+	return func(r *RSTUF) {
+		r.tasks = tasks
+	}
 }
 
 type Publisher interface {
@@ -89,16 +100,16 @@ func (r *RSTUF) Publish(ctx context.Context, gitoid string, payload []byte) erro
 	// Add any additional headers or authentication if needed
 
 	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := client.Do(req.WithContext(ctx))
 	if err != nil {
-		logrus.Errorf("error making request: %v", err)
+		return fmt.Errorf("error making request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusAccepted {
 		logb, _ := httputil.DumpResponse(resp, true)
 		logrus.Errorf("error body from RSTUF: %v", string(logb))
-		return fmt.Errorf("error response from RSTUF: %v", err)
+		return fmt.Errorf("error response from RSTUF: %v", resp.Status)
 	}
 
 	// Handle the response as needed
@@ -113,12 +124,33 @@ func (r *RSTUF) Publish(ctx context.Context, gitoid string, payload []byte) erro
 		logrus.Errorf("error unmarshaling response: %v", err)
 	}
 	logrus.Debugf("RSTUF task id: %v", response.Data.TaskId)
-	// TODO: monitor RSTUF task id for completion
+
+	if r.tasks == nil {
+		return nil
+	}
+
+	if response.Data.TaskId == "" {
+		logrus.Errorf("RSTUF did not return a task id for %s, its publication can not be tracked", gitoid)
+		return nil
+	}
+
+	// RSTUF has accepted the artifact at this point, so failing here would only cause it to be
+	// published again. log instead and let the publication go untracked.
+	if err := r.tasks.RecordTask(ctx, gitoid, response.Data.TaskId); err != nil {
+		logrus.Errorf("could not record RSTUF task %s for %s: %+v", response.Data.TaskId, gitoid, err)
+	}
+
 	return nil
 }
 
-func NewPublisher(config *config.Config) Publisher {
-	return &RSTUF{
+func NewPublisher(config *config.Config, opts ...Option) Publisher {
+	r := &RSTUF{
 		Host: config.PublisherRstufHost,
 	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
 }
@@ -0,0 +1,159 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rstuf
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/in-toto/archivista/pkg/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type finishedTask struct {
+	succeeded bool
+	message   string
+}
+
+// TaskStoreMock keeps tasks in memory
+type TaskStoreMock struct {
+	mu       sync.Mutex
+	pending  []Task
+	finished map[string]finishedTask
+}
+
+func (m *TaskStoreMock) RecordTask(ctx context.Context, gitoid, taskID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pending = append(m.pending, Task{Gitoid: gitoid, TaskID: taskID, CreatedAt: time.Now()})
+	return nil
+}
+
+func (m *TaskStoreMock) PendingTasks(ctx context.Context) ([]Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Task{}, m.pending...), nil
+}
+
+func (m *TaskStoreMock) FinishTask(ctx context.Context, taskID string, succeeded bool, message string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.finished == nil {
+		m.finished = make(map[string]finishedTask)
+	}
+
+	m.finished[taskID] = finishedTask{succeeded: succeeded, message: message}
+	for i, task := range m.pending {
+		if task.TaskID == taskID {
+			m.pending = append(m.pending[:i], m.pending[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+// Test Suite: UT RSTUF
+type UTRSTUFSuite struct {
+	suite.Suite
+	states map[string]string
+	server *httptest.Server
+}
+
+func TestUTRSTUFSuite(t *testing.T) {
+	suite.Run(t, new(UTRSTUFSuite))
+}
+
+func (ut *UTRSTUFSuite) SetupTest() {
+	ut.states = map[string]string{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(Response{Data: ArtifactsResponse{TaskId: "task-1"}})
+	})
+	mux.HandleFunc("GET /api/v1/task", func(w http.ResponseWriter, r *http.Request) {
+		state, ok := ut.states[r.URL.Query().Get("task_id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(state))
+	})
+	ut.server = httptest.NewServer(mux)
+}
+
+func (ut *UTRSTUFSuite) TearDownTest() {
+	ut.server.Close()
+}
+
+func (ut *UTRSTUFSuite) Test_Publish_RecordsTask() {
+	tasks := &TaskStoreMock{}
+	publisher := NewPublisher(&config.Config{PublisherRstufHost: ut.server.URL}, WithTaskStore(tasks))
+
+	err := publisher.Publish(context.TODO(), "gitoid", []byte("envelope"))
+	ut.NoError(err)
+
+	pending, _ := tasks.PendingTasks(context.TODO())
+	ut.Require().Len(pending, 1)
+	ut.Equal("gitoid", pending[0].Gitoid)
+	ut.Equal("task-1", pending[0].TaskID)
+}
+
+func (ut *UTRSTUFSuite) Test_Publish_Unreachable() {
+	publisher := NewPublisher(&config.Config{PublisherRstufHost: "http://127.0.0.1:0"})
+	err := publisher.Publish(context.TODO(), "gitoid", []byte("envelope"))
+	ut.ErrorContains(err, "error making request")
+}
+
+func (ut *UTRSTUFSuite) Test_Monitor() {
+	tasks := &TaskStoreMock{}
+	for _, id := range []string{"running", "succeeded", "failed", "rejected", "missing"} {
+		ut.NoError(tasks.RecordTask(context.TODO(), "gitoid-"+id, id))
+	}
+
+	ut.states["running"] = `{"data":{"task_id":"running","state":"RUNNING"}}`
+	ut.states["succeeded"] = `{"data":{"task_id":"succeeded","state":"SUCCESS","result":{"status":true}}}`
+	ut.states["failed"] = `{"data":{"task_id":"failed","state":"SUCCESS","result":{"status":false,"error":"artifact not added"}}}`
+	ut.states["rejected"] = `{"data":{"task_id":"rejected","state":"REJECTED"},"message":"bootstrap not finished"}`
+
+	monitor := NewMonitor(ut.server.URL, tasks)
+	monitor.Poll(context.TODO())
+
+	ut.Equal(finishedTask{succeeded: true, message: "SUCCESS"}, tasks.finished["succeeded"])
+	ut.Equal(finishedTask{succeeded: false, message: "SUCCESS: artifact not added"}, tasks.finished["failed"])
+	ut.Equal(finishedTask{succeeded: false, message: "REJECTED: bootstrap not finished"}, tasks.finished["rejected"])
+
+	pending, _ := tasks.PendingTasks(context.TODO())
+	ut.Len(pending, 2)
+}
+
+func (ut *UTRSTUFSuite) Test_Monitor_Timeout() {
+	tasks := &TaskStoreMock{}
+	ut.NoError(tasks.RecordTask(context.TODO(), "gitoid", "running"))
+	ut.states["running"] = `{"data":{"task_id":"running","state":"STARTED"}}`
+
+	monitor := NewMonitor(ut.server.URL, tasks, WithTaskTimeout(time.Minute))
+	monitor.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	monitor.Poll(context.TODO())
+
+	ut.False(tasks.finished["running"].succeeded)
+	ut.Contains(tasks.finished["running"].message, "did not finish within 1m0s")
+}
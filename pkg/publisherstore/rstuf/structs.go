@@ -14,6 +14,8 @@
 
 package rstuf
 
+import "encoding/json"
+
 // Hashes represents the Hashes structure
 type Hashes struct {
 	Sha256 string `json:"sha256"`
@@ -49,3 +51,22 @@ type ArtifactsResponse struct {
 type Response struct {
 	Data ArtifactsResponse `json:"data"`
 }
+
+// TaskResult represents the result RSTUF reports for a finished task
+type TaskResult struct {
+	// Status is a boolean on current RSTUF releases and a message on older ones
+	Status  json.RawMessage `json:"status,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Details map[string]any  `json:"details,omitempty"`
+}
+
+type TaskData struct {
+	TaskId string     `json:"task_id"`
+	State  string     `json:"state"`
+	Result TaskResult `json:"result"`
+}
+
+type TaskResponse struct {
+	Data    TaskData `json:"data"`
+	Message string   `json:"message"`
+}
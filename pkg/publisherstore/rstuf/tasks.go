@@ -0,0 +1,285 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rstuf
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/publication"
+	"github.com/sirupsen/logrus"
+)
+
+// PublisherName is the name RSTUF publications are recorded under
+const PublisherName = "RSTUF"
+
+// Task is an RSTUF task that has not finished yet
+type Task struct {
+	Gitoid    string
+	TaskID    string
+	CreatedAt time.Time
+}
+
+// TaskStore persists the RSTUF tasks started for published envelopes and their outcome
+type TaskStore interface {
+	RecordTask(ctx context.Context, gitoid, taskID string) error
+	PendingTasks(ctx context.Context) ([]Task, error)
+	FinishTask(ctx context.Context, taskID string, succeeded bool, message string) error
+}
+
+// EntTaskStore stores RSTUF tasks as publications of the envelope in the metadata store
+type EntTaskStore struct {
+	client *ent.Client
+}
+
+func NewEntTaskStore(client *ent.Client) *EntTaskStore {
+	return &EntTaskStore{client: client}
+}
+
+func (s *EntTaskStore) RecordTask(ctx context.Context, gitoid, taskID string) error {
+	create := s.client.Publication.Create().
+		SetGitoidSha256(gitoid).
+		SetPublisher(PublisherName).
+		SetTaskID(taskID)
+
+	// the envelope's metadata is stored before it is published, but the metadata store may have
+	// been disabled when it was uploaded
+	dsseID, err := s.client.Dsse.Query().Where(dsse.GitoidSha256(gitoid)).OnlyID(ctx)
+	if err == nil {
+		create.SetDsseID(dsseID)
+	} else if !ent.IsNotFound(err) {
+		return err
+	}
+
+	return create.Exec(ctx)
+}
+
+func (s *EntTaskStore) PendingTasks(ctx context.Context) ([]Task, error) {
+	pending, err := s.client.Publication.Query().
+		Where(
+			publication.Publisher(PublisherName),
+			publication.StatusEQ(publication.StatusPending),
+		).
+		Order(ent.Asc(publication.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]Task, 0, len(pending))
+	for _, p := range pending {
+		tasks = append(tasks, Task{Gitoid: p.GitoidSha256, TaskID: p.TaskID, CreatedAt: p.CreatedAt})
+	}
+
+	return tasks, nil
+}
+
+func (s *EntTaskStore) FinishTask(ctx context.Context, taskID string, succeeded bool, message string) error {
+	status := publication.StatusFailed
+	if succeeded {
+		status = publication.StatusSucceeded
+	}
+
+	return s.client.Publication.Update().
+		Where(
+			publication.Publisher(PublisherName),
+			publication.TaskID(taskID),
+			publication.StatusEQ(publication.StatusPending),
+		).
+		SetStatus(status).
+		SetMessage(message).
+		SetCompletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// Monitor polls RSTUF for the state of pending tasks and records their outcome
+type Monitor struct {
+	host         string
+	tasks        TaskStore
+	client       *http.Client
+	pollInterval time.Duration
+	timeout      time.Duration
+	now          func() time.Time
+}
+
+type MonitorOption func(*Monitor)
+
+// WithPollInterval sets how often pending tasks are checked.
+func WithPollInterval(interval time.Duration) MonitorOption {
+	return func(m *Monitor) {
+		m.pollInterval = interval
+	}
+}
+
+// WithTaskTimeout sets how long a task may stay unfinished before its publication is
+// recorded as failed. A timeout of 0 waits forever.
+func WithTaskTimeout(timeout time.Duration) MonitorOption {
+	return func(m *Monitor) {
+		m.timeout = timeout
+	}
+}
+
+// WithHTTPClient sets the client used to query RSTUF.
+func WithHTTPClient(client *http.Client) MonitorOption {
+	return func(m *Monitor) {
+		m.client = client
+	}
+}
+
+func NewMonitor(host string, tasks TaskStore, opts ...MonitorOption) *Monitor {
+	m := &Monitor{
+		host:         host,
+		tasks:        tasks,
+		client:       &http.Client{Timeout: 30 * time.Second},
+		pollInterval: 10 * time.Second,
+		timeout:      time.Hour,
+		now:          time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Start checks pending tasks until ctx is done. The returned channel is closed once
+// the monitor has stopped.
+func (m *Monitor) Start(ctx context.Context) <-chan error {
+	errCh := make(chan error)
+	go func() {
+		defer close(errCh)
+
+		ticker := time.NewTicker(m.pollInterval)
+		defer ticker.Stop()
+		for {
+			m.Poll(ctx)
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return errCh
+}
+
+// Poll checks each pending task once and records the ones that have finished.
+func (m *Monitor) Poll(ctx context.Context) {
+	pending, err := m.tasks.PendingTasks(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			logrus.Errorf("could not load pending RSTUF tasks: %+v", err)
+		}
+		return
+	}
+
+	for _, task := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err := m.check(ctx, task); err != nil {
+			logrus.Warnf("could not check RSTUF task %s for %s: %+v", task.TaskID, task.Gitoid, err)
+		}
+	}
+}
+
+func (m *Monitor) check(ctx context.Context, task Task) error {
+	state, err := m.taskState(ctx, task.TaskID)
+	if err != nil {
+		return err
+	}
+
+	succeeded, message, finished := taskOutcome(state)
+	if !finished {
+		if m.timeout > 0 && m.now().Sub(task.CreatedAt) > m.timeout {
+			succeeded, message, finished = false, fmt.Sprintf("task did not finish within %s, last state %s", m.timeout, state.Data.State), true
+		} else {
+			return nil
+		}
+	}
+
+	if succeeded {
+		logrus.Infof("RSTUF task %s published %s", task.TaskID, task.Gitoid)
+	} else {
+		logrus.Errorf("RSTUF task %s failed to publish %s: %s", task.TaskID, task.Gitoid, message)
+	}
+
+	return m.tasks.FinishTask(ctx, task.TaskID, succeeded, message)
+}
+
+func (m *Monitor) taskState(ctx context.Context, taskID string) (TaskResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.host+"/api/v1/task?task_id="+url.QueryEscape(taskID), nil)
+	if err != nil {
+		return TaskResponse{}, err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return TaskResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return TaskResponse{}, fmt.Errorf("unexpected response from RSTUF: %v", resp.Status)
+	}
+
+	response := TaskResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return TaskResponse{}, fmt.Errorf("could not decode RSTUF task: %w", err)
+	}
+
+	return response, nil
+}
+
+// taskOutcome interprets the state RSTUF reports for a task. RSTUF reports SUCCESS once the
+// task has run, so the result's status must also be checked to know if the artifact was added.
+func taskOutcome(response TaskResponse) (succeeded bool, message string, finished bool) {
+	state := strings.ToUpper(response.Data.State)
+	switch state {
+	case "SUCCESS":
+		if bytes.Equal(bytes.TrimSpace(response.Data.Result.Status), []byte("false")) {
+			return false, taskMessage(response, state), true
+		}
+
+		return true, taskMessage(response, state), true
+	case "FAILURE", "ERRORED", "REVOKED", "REJECTED":
+		return false, taskMessage(response, state), true
+	default:
+		return false, "", false
+	}
+}
+
+func taskMessage(response TaskResponse, state string) string {
+	if response.Data.Result.Error != "" {
+		return fmt.Sprintf("%s: %s", state, response.Data.Result.Error)
+	}
+
+	if response.Message != "" {
+		return fmt.Sprintf("%s: %s", state, response.Message)
+	}
+
+	return state
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retention periodically deletes envelopes that have outlived the retention rule
+// they match. Rules select envelopes by the predicate type of their statement, the name
+// of their attestation collection and their tenant. Envelopes under a legal hold are kept.
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/attestationcollection"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/ent/legalhold"
+	"github.com/in-toto/archivista/ent/predicate"
+	"github.com/in-toto/archivista/ent/statement"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/sirupsen/logrus"
+	"go.yaml.in/yaml/v3"
+)
+
+// Rule deletes envelopes older than MaxAge. Empty selectors match every envelope.
+type Rule struct {
+	Name string `yaml:"name"`
+	// Predicate is the exact predicate type of the envelope's statement
+	Predicate string `yaml:"predicate"`
+	// Collection is a glob matched against the name of the attestation collection, where
+	// * matches any run of characters and ? matches a single character
+	Collection string        `yaml:"collection"`
+	Tenant     string        `yaml:"tenant"`
+	MaxAge     time.Duration `yaml:"maxAge"`
+}
+
+// Config is the format of the retention rules file
+type Config struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Deleter removes an envelope from the object store and its metadata from the metadata store
+type Deleter interface {
+	Delete(context.Context, string) error
+}
+
+type Sweeper struct {
+	client    *ent.Client
+	deleter   Deleter
+	rules     []Rule
+	interval  time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+type Option func(*Sweeper)
+
+// WithInterval sets how often envelopes matching the rules are deleted
+func WithInterval(interval time.Duration) Option {
+	return func(s *Sweeper) {
+		s.interval = interval
+	}
+}
+
+// WithBatchSize sets how many envelopes are looked up at a time while sweeping
+func WithBatchSize(size int) Option {
+	return func(s *Sweeper) {
+		s.batchSize = size
+	}
+}
+
+// LoadRules reads retention rules from a yaml file
+func LoadRules(path string) ([]Rule, error) {
+	configBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := Config{}
+	if err := yaml.Unmarshal(configBytes, &config); err != nil {
+		return nil, err
+	}
+
+	return config.Rules, nil
+}
+
+func New(client *ent.Client, deleter Deleter, rules []Rule, opts ...Option) (*Sweeper, error) {
+	errs := make([]error, 0)
+	for i, rule := range rules {
+		if rule.MaxAge <= 0 {
+			errs = append(errs, fmt.Errorf("retention rule %d (%s) must have a positive maxAge", i, rule.Name))
+		}
+
+		if rule.Tenant != "" {
+			if err := tenant.Validate(rule.Tenant); err != nil {
+				errs = append(errs, fmt.Errorf("retention rule %d (%s): %w", i, rule.Name, err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	s := &Sweeper{
+		client:    client,
+		deleter:   deleter,
+		rules:     rules,
+		interval:  time.Hour,
+		batchSize: 100,
+		now:       time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.batchSize < 1 {
+		s.batchSize = 1
+	}
+
+	return s, nil
+}
+
+// Start sweeps once per interval until ctx is done. The returned channel is closed once
+// the sweep in progress has finished.
+func (s *Sweeper) Start(ctx context.Context) <-chan error {
+	errCh := make(chan error)
+	go func() {
+		defer close(errCh)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			deleted := s.Sweep(ctx)
+			if deleted > 0 {
+				logrus.Infof("retention sweep deleted %d envelopes", deleted)
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return errCh
+}
+
+// Sweep deletes every envelope that matches a rule and returns how many were deleted. A
+// rule that fails is logged and retried on the next sweep.
+func (s *Sweeper) Sweep(ctx context.Context) int {
+	deleted := 0
+	for _, rule := range s.rules {
+		n, err := s.sweepRule(ctx, rule)
+		deleted += n
+		if err != nil && ctx.Err() == nil {
+			logrus.Errorf("retention rule %s failed: %+v", rule.Name, err)
+		}
+	}
+
+	return deleted
+}
+
+// sweepRule deletes the envelopes matching rule, oldest first. An envelope that can't be deleted
+// is logged and skipped so it doesn't hold back the envelopes after it, and the rule fails once
+// the rest have been deleted.
+func (s *Sweeper) sweepRule(ctx context.Context, rule Rule) (int, error) {
+	deleted, failed := 0, 0
+	cutoff := s.now().Add(-rule.MaxAge)
+	var last *ent.Dsse
+	for {
+		query := s.client.Dsse.Query().Where(s.matches(rule, cutoff)...)
+		if last != nil {
+			// envelopes that were deleted are gone, so this only skips past the ones that failed
+			query = query.Where(dsse.Or(
+				dsse.CreatedAtGT(*last.CreatedAt),
+				dsse.And(dsse.CreatedAtEQ(*last.CreatedAt), dsse.IDGT(last.ID)),
+			))
+		}
+
+		expired, err := query.
+			Order(ent.Asc(dsse.FieldCreatedAt), ent.Asc(dsse.FieldID)).
+			Limit(s.batchSize).
+			All(ctx)
+		if err != nil {
+			return deleted, err
+		}
+
+		for _, d := range expired {
+			if err := s.deleter.Delete(tenant.NewContext(ctx, d.Tenant), d.GitoidSha256); err != nil {
+				if ctx.Err() != nil {
+					return deleted, ctx.Err()
+				}
+
+				logrus.Errorf("retention rule %s could not delete %s: %+v", rule.Name, d.GitoidSha256, err)
+				failed++
+				continue
+			}
+
+			deleted++
+		}
+
+		if len(expired) < s.batchSize {
+			break
+		}
+
+		last = expired[len(expired)-1]
+	}
+
+	if failed > 0 {
+		return deleted, fmt.Errorf("could not delete %d expired envelopes", failed)
+	}
+
+	return deleted, nil
+}
+
+func (s *Sweeper) matches(rule Rule, cutoff time.Time) []predicate.Dsse {
+	preds := []predicate.Dsse{
+		dsse.CreatedAtLT(cutoff),
+		// envelopes under a legal hold are kept however old they are
+		dsse.Not(dsse.HasLegalHoldsWith(legalhold.ReleasedAtIsNil())),
+	}
+	if rule.Tenant != "" {
+		preds = append(preds, dsse.TenantEQ(rule.Tenant))
+	}
+
+	statementPreds := make([]predicate.Statement, 0)
+	if rule.Predicate != "" {
+		statementPreds = append(statementPreds, statement.PredicateEQ(rule.Predicate))
+	}
+
+	if rule.Collection != "" {
+		statementPreds = append(statementPreds, statement.HasAttestationCollectionsWith(collectionLike(rule.Collection)))
+	}
+
+	if len(statementPreds) > 0 {
+		preds = append(preds, dsse.HasStatementWith(statementPreds...))
+	}
+
+	return preds
+}
+
+var globToLike = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_", "*", "%", "?", "_")
+
+// collectionLike matches attestation collection names against a glob
+func collectionLike(glob string) predicate.AttestationCollection {
+	pattern := globToLike.Replace(glob)
+	return predicate.AttestationCollection(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(attestationcollection.FieldName)).WriteString(" LIKE ").Arg(pattern).WriteString(" ESCAPE '!'")
+		}))
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/ent/dsse"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/in-toto/archivista/pkg/metadatastorage/attestationcollection"
+	"github.com/in-toto/archivista/pkg/metadatastorage/sqlstore"
+	"github.com/in-toto/archivista/pkg/tenant"
+	witnessdsse "github.com/in-toto/go-witness/dsse"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT Retention
+type UTRetentionSuite struct {
+	suite.Suite
+	db     *sql.DB
+	client *ent.Client
+	store  *sqlstore.Store
+	cancel context.CancelFunc
+	now    time.Time
+}
+
+func TestUTRetentionSuite(t *testing.T) {
+	suite.Run(t, new(UTRetentionSuite))
+}
+
+func (ut *UTRetentionSuite) SetupTest() {
+	var err error
+	ut.db, err = sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", ut.T().Name()))
+	ut.Require().NoError(err)
+	ut.client = ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, ut.db)))
+	var ctx context.Context
+	ctx, ut.cancel = context.WithCancel(context.Background())
+
+	ut.store, _, err = sqlstore.New(ctx, ut.client)
+	ut.Require().NoError(err)
+	ut.now = time.Now()
+}
+
+func (ut *UTRetentionSuite) TearDownTest() {
+	ut.cancel()
+	ut.client.Close()
+}
+
+// archive stores an attestation collection that was uploaded age ago
+func (ut *UTRetentionSuite) archive(ctx context.Context, gitoid, collection string, age time.Duration) {
+	statement := fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v0.1","subject":[{"name":"artifact","digest":{"sha256":"abcd"}}],"predicateType":%q,"predicate":{"name":%q,"attestations":[]}}`,
+		attestationcollection.Predicate, collection)
+	env, err := json.Marshal(witnessdsse.Envelope{PayloadType: "application/vnd.in-toto+json", Payload: []byte(statement)})
+	ut.Require().NoError(err)
+	ut.Require().NoError(ut.store.Store(ctx, gitoid, env))
+	// created_at is immutable, so backdate the row directly
+	t, _ := tenant.FromContext(ctx)
+	if t == "" {
+		t = tenant.Default
+	}
+
+	_, err = ut.db.ExecContext(ctx, "UPDATE dsses SET created_at = ? WHERE gitoid_sha256 = ? AND tenant = ?", ut.now.Add(-age), gitoid, t)
+	ut.Require().NoError(err)
+}
+
+func (ut *UTRetentionSuite) sweeper(rules ...Rule) *Sweeper {
+	s, err := New(ut.client, ut.store, rules, WithBatchSize(1))
+	ut.Require().NoError(err)
+	s.now = func() time.Time { return ut.now }
+	return s
+}
+
+func (ut *UTRetentionSuite) remaining(ctx context.Context) []string {
+	return ut.client.Dsse.Query().Order(ent.Asc(dsse.FieldGitoidSha256)).Select(dsse.FieldGitoidSha256).StringsX(ctx)
+}
+
+func (ut *UTRetentionSuite) Test_Sweep_Collection() {
+	ctx := context.Background()
+	ut.archive(ctx, "old-build", "build-linux", 48*time.Hour)
+	ut.archive(ctx, "new-build", "build_linux", time.Hour)
+	ut.archive(ctx, "old-test", "test", 48*time.Hour)
+	// _ is not a wildcard in globs
+	ut.archive(ctx, "old-underscore", "build_linux", 48*time.Hour)
+
+	s := ut.sweeper(Rule{Name: "builds", Predicate: attestationcollection.Predicate, Collection: "build-*", MaxAge: 24 * time.Hour})
+	ut.Equal(1, s.Sweep(ctx))
+	ut.Equal([]string{"new-build", "old-test", "old-underscore"}, ut.remaining(ctx))
+	ut.Equal(0, s.Sweep(ctx))
+}
+
+func (ut *UTRetentionSuite) Test_Sweep_Batches() {
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		ut.archive(ctx, fmt.Sprintf("old-%d", i), "build", 48*time.Hour)
+	}
+
+	s := ut.sweeper(Rule{Name: "everything", MaxAge: 24 * time.Hour})
+	ut.Equal(3, s.Sweep(ctx))
+	ut.Empty(ut.remaining(ctx))
+	ut.Equal(0, ut.client.Statement.Query().CountX(ctx))
+}
+
+// failingDeleter can't delete the envelope with gitoid stuck
+type failingDeleter struct {
+	Deleter
+	stuck string
+}
+
+func (d failingDeleter) Delete(ctx context.Context, gitoid string) error {
+	if gitoid == d.stuck {
+		return errors.New("permission denied")
+	}
+
+	return d.Deleter.Delete(ctx, gitoid)
+}
+
+func (ut *UTRetentionSuite) Test_Sweep_SkipsFailedDeletes() {
+	ctx := context.Background()
+	ut.archive(ctx, "stuck", "build", 72*time.Hour)
+	ut.archive(ctx, "old-1", "build", 48*time.Hour)
+	ut.archive(ctx, "old-2", "build", 36*time.Hour)
+
+	s := ut.sweeper(Rule{Name: "everything", MaxAge: 24 * time.Hour})
+	s.deleter = failingDeleter{Deleter: ut.store, stuck: "stuck"}
+	deleted, err := s.sweepRule(ctx, s.rules[0])
+	ut.Equal(2, deleted)
+	ut.ErrorContains(err, "could not delete 1 expired envelopes")
+	ut.Equal([]string{"stuck"}, ut.remaining(ctx))
+}
+
+func (ut *UTRetentionSuite) Test_Sweep_Tenant() {
+	teamA := tenant.NewContext(context.Background(), "team-a")
+	teamB := tenant.NewContext(context.Background(), "team-b")
+	ut.archive(teamA, "gitoid", "build", 48*time.Hour)
+	ut.archive(teamB, "gitoid", "build", 48*time.Hour)
+
+	s := ut.sweeper(Rule{Name: "team-a", Tenant: "team-a", MaxAge: 24 * time.Hour})
+	ut.Equal(1, s.Sweep(context.Background()))
+	ut.Equal(0, ut.client.Dsse.Query().CountX(teamA))
+	ut.Equal(1, ut.client.Dsse.Query().CountX(teamB))
+}
+
+func (ut *UTRetentionSuite) Test_Sweep_SkipsHeld() {
+	ctx := context.Background()
+	ut.archive(ctx, "held", "build", 48*time.Hour)
+	ut.archive(ctx, "released", "build", 48*time.Hour)
+
+	_, err := ut.store.Hold(ctx, metadatastorage.Hold{Reason: "audit", Gitoids: []string{"held"}})
+	ut.Require().NoError(err)
+	released, err := ut.store.Hold(ctx, metadatastorage.Hold{Reason: "audit", Gitoids: []string{"released"}})
+	ut.Require().NoError(err)
+	_, err = ut.store.ReleaseHold(ctx, released.ID, "auditor")
+	ut.Require().NoError(err)
+
+	s := ut.sweeper(Rule{Name: "everything", MaxAge: 24 * time.Hour})
+	ut.Equal(1, s.Sweep(ctx))
+	ut.Equal([]string{"held"}, ut.remaining(ctx))
+}
+
+func (ut *UTRetentionSuite) Test_New_InvalidRules() {
+	_, err := New(ut.client, ut.store, []Rule{{Name: "forever"}, {Name: "bad", Tenant: "Not A Tenant", MaxAge: time.Hour}})
+	ut.ErrorContains(err, "positive maxAge")
+	ut.ErrorIs(err, tenant.ErrInvalid)
+}
+
+func (ut *UTRetentionSuite) Test_LoadRules() {
+	path := filepath.Join(ut.T().TempDir(), "retention.yaml")
+	ut.Require().NoError(os.WriteFile(path, []byte(`rules:
+  - name: builds
+    predicate: https://witness.testifysec.com/attestation-collection/v0.1
+    collection: build-*
+    tenant: team-a
+    maxAge: 2160h
+`), 0o600))
+
+	rules, err := LoadRules(path)
+	ut.Require().NoError(err)
+	ut.Equal([]Rule{{
+		Name:       "builds",
+		Predicate:  attestationcollection.Predicate,
+		Collection: "build-*",
+		Tenant:     "team-a",
+		MaxAge:     2160 * time.Hour,
+	}}, rules)
+}
@@ -0,0 +1,377 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/sirupsen/logrus"
+)
+
+// batchWriter writes the envelopes of a batch download in one of the supported formats
+type batchWriter interface {
+	WriteEnvelope(gitoid string, content []byte) error
+	WriteError(gitoid string, errResp api.ErrorResponse) error
+	Close() error
+}
+
+// @Summary Download many
+// @Description download many attestations in one response, as NDJSON or a tar archive
+// @Accept  json
+// @Produce  application/x-ndjson
+// @Produce  application/x-tar
+// @Param request body api.BatchDownloadRequest true "gitoids"
+// @Success 200 {object} api.BatchDownloadItem
+// @Failure 400 {object} string
+// @Tags attestation
+// @Router /v1/download [post]
+func (s *Server) BatchDownloadHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("%s is an unsupported method", r.Method), http.StatusBadRequest)
+		return
+	}
+
+	req := api.BatchDownloadRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid batch download request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if len(req.Gitoids) == 0 {
+		http.Error(w, "at least one gitoid is required", http.StatusBadRequest)
+		return
+	} else if len(req.Gitoids) > api.MaxBatchDownload {
+		http.Error(w, fmt.Sprintf("at most %d gitoids may be downloaded at once", api.MaxBatchDownload), http.StatusBadRequest)
+		return
+	}
+
+	for _, gitoid := range req.Gitoids {
+		// gitoids name the files of tar archives, so they must not be paths
+		if strings.TrimSpace(gitoid) == "" || strings.ContainsAny(gitoid, `/\`) || gitoid == ".." {
+			http.Error(w, fmt.Sprintf("invalid gitoid %q", gitoid), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var bw batchWriter
+	switch batchMediaType(r.Header.Get("Accept")) {
+	case api.TarMediaType:
+		w.Header().Set("Content-Type", api.TarMediaType)
+		bw = &tarBatchWriter{tw: tar.NewWriter(w)}
+	default:
+		w.Header().Set("Content-Type", api.NDJSONMediaType)
+		bw = &ndjsonBatchWriter{enc: json.NewEncoder(w)}
+	}
+
+	// the status is sent with the first envelope, so envelopes that can't be downloaded are
+	// reported in the response instead of failing it
+	for _, gitoid := range req.Gitoids {
+		if err := s.writeBatchEnvelope(r, bw, gitoid); err != nil {
+			logrus.Errorf("failed to write batch download response: %+v", err)
+			return
+		}
+	}
+
+	if err := bw.Close(); err != nil {
+		logrus.Errorf("failed to write batch download response: %+v", err)
+	}
+}
+
+func (s *Server) writeBatchEnvelope(r *http.Request, bw batchWriter, gitoid string) error {
+	reader, err := s.Download(r.Context(), gitoid)
+	if err != nil {
+		return bw.WriteError(gitoid, batchError(err))
+	}
+
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return bw.WriteError(gitoid, batchError(err))
+	}
+
+	return bw.WriteEnvelope(gitoid, content)
+}
+
+func batchError(err error) api.ErrorResponse {
+	switch {
+	case errors.Is(err, objectstorage.ErrNotFound):
+		return api.ErrorResponse{Error: "not_found", Message: err.Error()}
+	case errors.Is(err, api.ErrGitoidMismatch):
+		return api.ErrorResponse{Error: "integrity", Message: err.Error()}
+	default:
+		return api.ErrorResponse{Error: "internal", Message: err.Error()}
+	}
+}
+
+// batchMediaType picks the format of a batch download from an Accept header, preferring NDJSON
+func batchMediaType(accept string) string {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case api.NDJSONMediaType:
+			return api.NDJSONMediaType
+		case api.TarMediaType:
+			return api.TarMediaType
+		}
+	}
+
+	return api.NDJSONMediaType
+}
+
+type ndjsonBatchWriter struct {
+	enc *json.Encoder
+}
+
+func (bw *ndjsonBatchWriter) WriteEnvelope(gitoid string, content []byte) error {
+	return bw.enc.Encode(api.BatchDownloadItem{Gitoid: gitoid, Envelope: content})
+}
+
+func (bw *ndjsonBatchWriter) WriteError(gitoid string, errResp api.ErrorResponse) error {
+	return bw.enc.Encode(api.BatchDownloadItem{Gitoid: gitoid, Error: &errResp})
+}
+
+func (bw *ndjsonBatchWriter) Close() error {
+	return nil
+}
+
+type tarBatchWriter struct {
+	tw *tar.Writer
+}
+
+func (bw *tarBatchWriter) WriteEnvelope(gitoid string, content []byte) error {
+	return bw.writeFile(gitoid+".json", content)
+}
+
+func (bw *tarBatchWriter) WriteError(gitoid string, errResp api.ErrorResponse) error {
+	content, err := json.Marshal(errResp)
+	if err != nil {
+		return err
+	}
+
+	return bw.writeFile(gitoid+".error.json", content)
+}
+
+func (bw *tarBatchWriter) writeFile(name string, content []byte) error {
+	if err := bw.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(content)),
+		ModTime: time.Unix(0, 0),
+	}); err != nil {
+		return err
+	}
+
+	_, err := bw.tw.Write(content)
+	return err
+}
+
+func (bw *tarBatchWriter) Close() error {
+	return bw.tw.Close()
+}
+
+// @Summary Upload many
+// @Description stores many attestations, sent as NDJSON or as the parts of a multipart body
+// @Accept  application/x-ndjson
+// @Accept  multipart/form-data
+// @Produce  json
+// @Success 200 {object} api.BatchUploadResponse
+// @Failure 400 {object} string
+// @Failure 413 {object} api.BatchUploadResponse
+// @Failure 415 {object} string
+// @Tags attestation
+// @Router /v1/upload/batch [post]
+func (s *Server) BatchUploadHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("%s is an unsupported method", r.Method), http.StatusBadRequest)
+		return
+	}
+
+	defer r.Body.Close()
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	var next func() ([]byte, error)
+	switch {
+	case err != nil:
+		http.Error(w, fmt.Sprintf("invalid content type: %v", err), http.StatusUnsupportedMediaType)
+		return
+	case mediaType == api.NDJSONMediaType:
+		next = ndjsonEnvelopes(bufio.NewReader(r.Body), s.maxUploadSize)
+	case strings.HasPrefix(mediaType, "multipart/"):
+		next = multipartEnvelopes(multipart.NewReader(r.Body, params["boundary"]), s.maxUploadSize)
+	default:
+		http.Error(w, fmt.Sprintf("%s is an unsupported content type, use %s or multipart/form-data", mediaType, api.NDJSONMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	workers := max(s.uploadWorkers, 1)
+	sem := make(chan struct{}, workers)
+	wg := sync.WaitGroup{}
+	mu := sync.Mutex{}
+	results := make([]api.BatchUploadResult, 0)
+	record := func(result api.BatchUploadResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		results = append(results, result)
+	}
+
+	var tooMany *api.ErrorResponse
+	// envelopes are read one at a time, and at most workers of them are being stored while the
+	// next is read, so a batch never needs more than a few envelopes in memory
+	for index := 0; ; index++ {
+		envelope, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if index >= api.MaxBatchUpload {
+			// the envelopes before this one may already be stored, so they're reported along with the error
+			tooMany = &api.ErrorResponse{Error: "too_many", Message: fmt.Sprintf("at most %d envelopes may be uploaded at once", api.MaxBatchUpload)}
+			break
+		} else if errors.Is(err, ErrUploadTooLarge) {
+			record(api.BatchUploadResult{Index: index, Error: batchUploadError(err)})
+			continue
+		} else if err != nil {
+			// the rest of the body can't be read, so report what was stored so far
+			record(api.BatchUploadResult{Index: index, Error: &api.ErrorResponse{Error: "invalid_request", Message: err.Error()}})
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(index int, envelope []byte) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			resp, err := s.upload(r.Context(), bytes.NewReader(envelope), int64(len(envelope)))
+			if err != nil {
+				record(api.BatchUploadResult{Index: index, Error: batchUploadError(err)})
+				return
+			}
+
+			record(api.BatchUploadResult{Index: index, Gitoid: resp.Gitoid, Status: resp.Status, Envelopes: resp.Envelopes})
+		}(index, envelope)
+	}
+
+	wg.Wait()
+	slices.SortFunc(results, func(a, b api.BatchUploadResult) int {
+		return a.Index - b.Index
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	if tooMany != nil {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+	}
+
+	if err := json.NewEncoder(w).Encode(api.BatchUploadResponse{Results: results, Error: tooMany}); err != nil {
+		logrus.Errorf("failed to write batch upload response: %+v", err)
+	}
+}
+
+func batchUploadError(err error) *api.ErrorResponse {
+	code := "internal"
+	switch uploadErrorStatus(err) {
+	case http.StatusBadRequest:
+		code = "invalid_envelope"
+	case http.StatusUnprocessableEntity:
+		code = "untrusted_envelope"
+	case http.StatusRequestEntityTooLarge:
+		code = "too_large"
+	}
+
+	return &api.ErrorResponse{Error: code, Message: err.Error()}
+}
+
+// ndjsonEnvelopes reads one envelope per line, skipping blank lines. Lines longer than
+// maxSize are skipped and reported as ErrUploadTooLarge. A maxSize of 0 or less disables
+// the limit.
+func ndjsonEnvelopes(br *bufio.Reader, maxSize int64) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		for {
+			line := make([]byte, 0)
+			tooLarge := false
+			for {
+				chunk, err := br.ReadSlice('\n')
+				if !tooLarge {
+					line = append(line, chunk...)
+					if maxSize > 0 && int64(len(bytes.TrimSpace(line))) > maxSize {
+						tooLarge, line = true, nil
+					}
+				}
+
+				if errors.Is(err, bufio.ErrBufferFull) {
+					continue
+				} else if errors.Is(err, io.EOF) && len(line) == 0 && !tooLarge {
+					return nil, io.EOF
+				} else if err != nil && !errors.Is(err, io.EOF) {
+					return nil, err
+				}
+
+				break
+			}
+
+			if tooLarge {
+				return nil, ErrUploadTooLarge
+			}
+
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				return line, nil
+			}
+		}
+	}
+}
+
+// multipartEnvelopes reads one envelope per part. Parts larger than maxSize are reported as
+// ErrUploadTooLarge. A maxSize of 0 or less disables the limit.
+func multipartEnvelopes(mr *multipart.Reader, maxSize int64) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		part, err := mr.NextPart()
+		if err != nil {
+			return nil, err
+		}
+
+		defer part.Close()
+		var r io.Reader = part
+		if maxSize > 0 {
+			r = io.LimitReader(part, maxSize+1)
+		}
+
+		envelope, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		} else if maxSize > 0 && int64(len(envelope)) > maxSize {
+			return nil, ErrUploadTooLarge
+		}
+
+		return envelope, nil
+	}
+}
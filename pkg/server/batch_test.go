@@ -0,0 +1,277 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+)
+
+// MapObjectStore serves envelopes from memory, keyed by the gitoid they are stored under
+type MapObjectStore struct {
+	mu      sync.Mutex
+	objects map[string]string
+}
+
+func NewMapObjectStore(objects map[string]string) *MapObjectStore {
+	return &MapObjectStore{objects: objects}
+}
+
+func (m *MapObjectStore) Store(_ context.Context, gitoid string, payload []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[gitoid] = string(payload)
+	return nil
+}
+
+func (m *MapObjectStore) Get(_ context.Context, gitoid string) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	content, ok := m.objects[gitoid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", objectstorage.ErrNotFound, gitoid)
+	}
+
+	return io.NopCloser(strings.NewReader(content)), nil
+}
+
+func (ut *UTServerSuite) batchServer() (stored string, tampered string) {
+	stored, tampered = gitoidOf("stored"), gitoidOf("genuine")
+	ut.testServer.objectStore = NewMapObjectStore(map[string]string{stored: "stored", tampered: "substituted"})
+	return stored, tampered
+}
+
+func (ut *UTServerSuite) Test_BatchDownloadHandler_NDJSON() {
+	stored, tampered := ut.batchServer()
+	w := httptest.NewRecorder()
+	body := fmt.Sprintf(`{"gitoids":[%q,"missing",%q]}`, stored, tampered)
+	request := httptest.NewRequest(http.MethodPost, "/v1/download", strings.NewReader(body))
+
+	ut.testServer.BatchDownloadHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	ut.Equal(api.NDJSONMediaType, w.Header().Get("Content-Type"))
+
+	items := make([]api.BatchDownloadItem, 0)
+	dec := json.NewDecoder(w.Body)
+	for dec.More() {
+		item := api.BatchDownloadItem{}
+		ut.Require().NoError(dec.Decode(&item))
+		items = append(items, item)
+	}
+
+	ut.Require().Len(items, 3)
+	ut.Equal(api.BatchDownloadItem{Gitoid: stored, Envelope: []byte("stored")}, items[0])
+	ut.Equal("missing", items[1].Gitoid)
+	ut.Equal("not_found", items[1].Error.Error)
+	ut.Equal(tampered, items[2].Gitoid)
+	ut.Equal("integrity", items[2].Error.Error)
+	ut.Nil(items[2].Envelope)
+}
+
+func (ut *UTServerSuite) Test_BatchDownloadHandler_Tar() {
+	stored, _ := ut.batchServer()
+	w := httptest.NewRecorder()
+	body := fmt.Sprintf(`{"gitoids":[%q,"missing"]}`, stored)
+	request := httptest.NewRequest(http.MethodPost, "/v1/download", strings.NewReader(body))
+	request.Header.Set("Accept", "application/x-tar, application/x-ndjson;q=0.5")
+
+	ut.testServer.BatchDownloadHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	ut.Equal(api.TarMediaType, w.Header().Get("Content-Type"))
+
+	files := map[string]string{}
+	tr := tar.NewReader(w.Body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+
+		ut.Require().NoError(err)
+		content, err := io.ReadAll(tr)
+		ut.Require().NoError(err)
+		files[hdr.Name] = string(content)
+	}
+
+	ut.Equal("stored", files[stored+".json"])
+	ut.JSONEq(`{"error":"not_found","message":"object not found: missing"}`, files["missing.error.json"])
+}
+
+func (ut *UTServerSuite) Test_BatchDownloadHandler_BadRequest() {
+	ut.batchServer()
+	tests := []struct {
+		name    string
+		method  string
+		body    string
+		message string
+	}{
+		{"bad method", http.MethodGet, "", "GET is an unsupported method"},
+		{"invalid json", http.MethodPost, "{", "invalid batch download request"},
+		{"no gitoids", http.MethodPost, `{"gitoids":[]}`, "at least one gitoid is required"},
+		{"path", http.MethodPost, `{"gitoids":["../etc/passwd"]}`, "invalid gitoid"},
+		{"too many", http.MethodPost, `{"gitoids":[` + strings.Repeat(`"a",`, api.MaxBatchDownload) + `"a"]}`, "at most 1000 gitoids"},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		request := httptest.NewRequest(test.method, "/v1/download", strings.NewReader(test.body))
+		ut.testServer.BatchDownloadHandler(w, request)
+		ut.Equal(http.StatusBadRequest, w.Code, test.name)
+		ut.Contains(w.Body.String(), test.message, test.name)
+	}
+}
+
+func (ut *UTServerSuite) Test_BatchUploadHandler_NDJSON() {
+	objectStore := NewMapObjectStore(map[string]string{})
+	ut.testServer = Server{objectStore: objectStore, maxUploadSize: 16, uploadWorkers: 2}
+
+	w := httptest.NewRecorder()
+	body := "{\"n\":1}\n\n{\"n\":2}\r\n{\"payload\":\"much too large\"}\n{\"n\":3}"
+	request := httptest.NewRequest(http.MethodPost, "/v1/upload/batch", strings.NewReader(body))
+	request.Header.Set("Content-Type", api.NDJSONMediaType)
+
+	ut.testServer.BatchUploadHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	resp := api.BatchUploadResponse{}
+	ut.Require().NoError(json.NewDecoder(w.Body).Decode(&resp))
+	ut.Equal([]api.BatchUploadResult{
+		{Index: 0, Gitoid: gitoidOf(`{"n":1}`), Status: api.UploadStatusCreated},
+		{Index: 1, Gitoid: gitoidOf(`{"n":2}`), Status: api.UploadStatusCreated},
+		{Index: 2, Error: &api.ErrorResponse{Error: "too_large", Message: ErrUploadTooLarge.Error()}},
+		{Index: 3, Gitoid: gitoidOf(`{"n":3}`), Status: api.UploadStatusCreated},
+	}, resp.Results)
+	ut.Equal(`{"n":3}`, objectStore.objects[gitoidOf(`{"n":3}`)])
+}
+
+func (ut *UTServerSuite) Test_BatchUploadHandler_Multipart() {
+	ut.testServer = Server{objectStore: NewMapObjectStore(map[string]string{})}
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	for _, envelope := range []string{"{\n  \"n\": 1\n}\n", `{"n":2}`} {
+		part, err := mw.CreateFormFile("envelope", "envelope.json")
+		ut.Require().NoError(err)
+		_, err = part.Write([]byte(envelope))
+		ut.Require().NoError(err)
+	}
+	ut.Require().NoError(mw.Close())
+
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/upload/batch", body)
+	request.Header.Set("Content-Type", mw.FormDataContentType())
+
+	ut.testServer.BatchUploadHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	resp := api.BatchUploadResponse{}
+	ut.Require().NoError(json.NewDecoder(w.Body).Decode(&resp))
+	ut.Equal([]api.BatchUploadResult{
+		{Index: 0, Gitoid: gitoidOf("{\n  \"n\": 1\n}\n"), Status: api.UploadStatusCreated},
+		{Index: 1, Gitoid: gitoidOf(`{"n":2}`), Status: api.UploadStatusCreated},
+	}, resp.Results)
+}
+
+func (ut *UTServerSuite) Test_BatchUploadHandler_Bundle() {
+	first := `{"payloadType":"test","payload":"Zmlyc3Q=","signatures":[]}`
+	second := `{"payloadType":"test","payload":"c2Vjb25k","signatures":[]}`
+	ut.mockedStorerGetter.On("Store").Return(nil)
+	ut.mockedStorer.On("Store").Return(nil).Once()
+	ut.mockedStorer.On("Store").Return(errors.New("database is down")).Once()
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	part, err := mw.CreateFormFile("envelope", "bundle.jsonl")
+	ut.Require().NoError(err)
+	_, err = part.Write([]byte(first + "\n" + second + "\n"))
+	ut.Require().NoError(err)
+	ut.Require().NoError(mw.Close())
+
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/upload/batch", body)
+	request.Header.Set("Content-Type", mw.FormDataContentType())
+
+	ut.testServer.BatchUploadHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	resp := api.BatchUploadResponse{}
+	ut.Require().NoError(json.NewDecoder(w.Body).Decode(&resp))
+	ut.Equal([]api.BatchUploadResult{{Index: 0, Envelopes: []api.UploadResponse{
+		{Gitoid: gitoidOf(first), Status: api.UploadStatusCreated},
+		{Error: &api.ErrorResponse{Error: "internal", Message: "database is down"}},
+	}}}, resp.Results)
+}
+
+func (ut *UTServerSuite) Test_BatchUploadHandler_BadRequest() {
+	ut.testServer = Server{objectStore: NewMapObjectStore(map[string]string{})}
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		status      int
+	}{
+		{"bad method", http.MethodGet, api.NDJSONMediaType, "", http.StatusBadRequest},
+		{"no content type", http.MethodPost, "", "{}", http.StatusUnsupportedMediaType},
+		{"unsupported content type", http.MethodPost, "application/json", "{}", http.StatusUnsupportedMediaType},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		request := httptest.NewRequest(test.method, "/v1/upload/batch", strings.NewReader(test.body))
+		request.Header.Set("Content-Type", test.contentType)
+		ut.testServer.BatchUploadHandler(w, request)
+		ut.Equal(test.status, w.Code, test.name)
+	}
+}
+
+func (ut *UTServerSuite) Test_BatchUploadHandler_TooMany() {
+	objectStore := NewMapObjectStore(map[string]string{})
+	ut.testServer = Server{objectStore: objectStore, uploadWorkers: 4}
+
+	lines := make([]string, 0, api.MaxBatchUpload+2)
+	for i := 0; i < api.MaxBatchUpload+2; i++ {
+		lines = append(lines, fmt.Sprintf(`{"n":%d}`, i))
+	}
+
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/upload/batch", strings.NewReader(strings.Join(lines, "\n")))
+	request.Header.Set("Content-Type", api.NDJSONMediaType)
+
+	ut.testServer.BatchUploadHandler(w, request)
+	ut.Equal(http.StatusRequestEntityTooLarge, w.Code)
+	resp := api.BatchUploadResponse{}
+	ut.Require().NoError(json.NewDecoder(w.Body).Decode(&resp))
+	ut.Require().NotNil(resp.Error)
+	ut.Equal("too_many", resp.Error.Error)
+
+	// the envelopes that were stored before the limit was reached are reported
+	ut.Require().Len(resp.Results, api.MaxBatchUpload)
+	ut.Len(objectStore.objects, api.MaxBatchUpload)
+	for i, result := range resp.Results {
+		ut.Equal(i, result.Index)
+		ut.Equal(gitoidOf(lines[i]), result.Gitoid)
+	}
+}
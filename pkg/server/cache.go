@@ -0,0 +1,67 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"strings"
+)
+
+// envelopes are addressed by their content so they never change, and may be cached for as
+// long as caches allow. Shared caches may only keep them when anyone may download them.
+const (
+	envelopeCacheControl        = "public, max-age=31536000, immutable"
+	privateEnvelopeCacheControl = "private, max-age=31536000, immutable"
+)
+
+// setEnvelopeHeaders sets the headers of a successful download of the envelope with gitoid.
+// The gitoid is the envelope's strong ETag. When downloads depend on who is asking or for
+// which tenant, the envelope is kept out of shared caches.
+func (s *Server) setEnvelopeHeaders(w http.ResponseWriter, gitoid string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etag(gitoid))
+	w.Header().Set("Accept-Ranges", "bytes")
+	if s.auth == nil && s.tenantHeader == "" {
+		w.Header().Set("Cache-Control", envelopeCacheControl)
+		return
+	}
+
+	w.Header().Set("Cache-Control", privateEnvelopeCacheControl)
+	if s.auth != nil {
+		w.Header().Add("Vary", "Authorization")
+	}
+
+	if s.tenantHeader != "" {
+		w.Header().Add("Vary", s.tenantHeader)
+	}
+}
+
+func etag(gitoid string) string {
+	return `"` + gitoid + `"`
+}
+
+// etagMatches reports whether an If-None-Match header names the envelope with gitoid
+func etagMatches(ifNoneMatch string, gitoid string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		// If-None-Match uses the weak comparison, so weak validators match as well
+		candidate = strings.TrimPrefix(candidate, "W/")
+		if candidate == "*" || candidate == etag(gitoid) {
+			return true
+		}
+	}
+
+	return false
+}
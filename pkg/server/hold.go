@@ -0,0 +1,227 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/in-toto/archivista/pkg/auth"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrHoldsUnsupported is returned when the metadata store can't record legal holds
+var ErrHoldsUnsupported = errors.New("legal holds require the SQL metadata store")
+
+// Holder is implemented by metadata stores that record legal holds
+type Holder interface {
+	Hold(context.Context, metadatastorage.Hold) (*ent.LegalHold, error)
+	ReleaseHold(context.Context, uuid.UUID, string) (*ent.LegalHold, error)
+	Held(context.Context, string) (bool, error)
+}
+
+// LegalHolder is implemented by object stores that can enforce legal holds themselves, such
+// as S3 buckets with object locking enabled
+type LegalHolder interface {
+	SetLegalHold(context.Context, string, bool) error
+}
+
+// Hold places the envelopes selected by hold under a legal hold, on behalf of the caller
+func (s *Server) Hold(ctx context.Context, hold api.HoldRequest) (api.HoldResponse, error) {
+	holder, ok := s.metadataStore.(Holder)
+	if !ok {
+		return api.HoldResponse{}, ErrHoldsUnsupported
+	}
+
+	created, err := holder.Hold(ctx, metadatastorage.Hold{
+		Reason:                 hold.Reason,
+		CreatedBy:              caller(ctx),
+		Gitoids:                hold.Gitoids,
+		SubjectDigestAlgorithm: hold.SubjectDigestAlgorithm,
+		SubjectDigest:          hold.SubjectDigest,
+	})
+	if err != nil {
+		return api.HoldResponse{}, err
+	}
+
+	logrus.Infof("%s placed legal hold %s on %d envelopes", created.CreatedBy, created.ID, len(created.Edges.Dsses))
+	for _, d := range created.Edges.Dsses {
+		if err := s.setObjectLegalHold(ctx, d, true); err != nil {
+			return api.HoldResponse{}, fmt.Errorf("legal hold %s was placed but could not be applied to the object store: %w", created.ID, err)
+		}
+	}
+
+	return holdResponse(created), nil
+}
+
+// ReleaseHold releases the legal hold with id on behalf of the caller. Envelopes that are
+// still under another hold stay held.
+func (s *Server) ReleaseHold(ctx context.Context, id uuid.UUID) (api.HoldResponse, error) {
+	holder, ok := s.metadataStore.(Holder)
+	if !ok {
+		return api.HoldResponse{}, ErrHoldsUnsupported
+	}
+
+	released, err := holder.ReleaseHold(ctx, id, caller(ctx))
+	if err != nil {
+		return api.HoldResponse{}, err
+	}
+
+	logrus.Infof("%s released legal hold %s", released.ReleasedBy, released.ID)
+	for _, d := range released.Edges.Dsses {
+		held, err := holder.Held(tenant.NewContext(ctx, d.Tenant), d.GitoidSha256)
+		if err != nil {
+			return api.HoldResponse{}, err
+		} else if held {
+			continue
+		}
+
+		if err := s.setObjectLegalHold(ctx, d, false); err != nil {
+			return api.HoldResponse{}, fmt.Errorf("legal hold %s was released but could not be lifted in the object store: %w", released.ID, err)
+		}
+	}
+
+	return holdResponse(released), nil
+}
+
+// held reports whether the envelope with gitoid is under a legal hold
+func (s *Server) held(ctx context.Context, gitoid string) (bool, error) {
+	if holder, ok := s.metadataStore.(Holder); ok {
+		return holder.Held(ctx, gitoid)
+	}
+
+	return false, nil
+}
+
+func (s *Server) setObjectLegalHold(ctx context.Context, d *ent.Dsse, held bool) error {
+	if legalHolder, ok := s.objectStore.(LegalHolder); ok {
+		return legalHolder.SetLegalHold(tenant.NewContext(ctx, d.Tenant), d.GitoidSha256, held)
+	}
+
+	return nil
+}
+
+// caller returns the subject of the authenticated caller, if any
+func caller(ctx context.Context) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.Subject
+	}
+
+	return ""
+}
+
+func holdResponse(hold *ent.LegalHold) api.HoldResponse {
+	gitoids := make([]string, 0, len(hold.Edges.Dsses))
+	for _, d := range hold.Edges.Dsses {
+		gitoids = append(gitoids, d.GitoidSha256)
+	}
+
+	return api.HoldResponse{
+		ID:         hold.ID.String(),
+		Reason:     hold.Reason,
+		Gitoids:    gitoids,
+		CreatedBy:  hold.CreatedBy,
+		CreatedAt:  hold.CreatedAt,
+		ReleasedBy: hold.ReleasedBy,
+		ReleasedAt: hold.ReleasedAt,
+	}
+}
+
+// @Summary Place a legal hold
+// @Description places envelopes, selected by gitoid or subject digest, under a legal hold that blocks their deletion
+// @Accept  json
+// @Produce  json
+// @Param hold body api.HoldRequest true "hold"
+// @Success 200 {object} api.HoldResponse
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Tags holds
+// @Router /v1/holds [post]
+func (s *Server) HoldHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("%s is an unsupported method", r.Method), http.StatusBadRequest)
+		return
+	}
+
+	hold := api.HoldRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&hold); err != nil {
+		http.Error(w, fmt.Sprintf("invalid legal hold: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if strings.TrimSpace(hold.Reason) == "" {
+		http.Error(w, "a reason is required to place a legal hold", http.StatusBadRequest)
+		return
+	} else if len(hold.Gitoids) == 0 && (hold.SubjectDigest == "" || hold.SubjectDigestAlgorithm == "") {
+		http.Error(w, "gitoids or a subject digest and its algorithm are required to place a legal hold", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := s.Hold(r.Context(), hold)
+	s.writeHoldResponse(w, resp, err)
+}
+
+// @Summary Release a legal hold
+// @Description releases a legal hold. the hold is kept as a record of who placed and released it
+// @Produce  json
+// @Param id path string true "id"
+// @Success 200 {object} api.HoldResponse
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Tags holds
+// @Router /v1/holds/{id}/release [post]
+func (s *Server) ReleaseHoldHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("%s is an unsupported method", r.Method), http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid legal hold id: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := s.ReleaseHold(r.Context(), id)
+	s.writeHoldResponse(w, resp, err)
+}
+
+func (s *Server) writeHoldResponse(w http.ResponseWriter, resp api.HoldResponse, err error) {
+	if errors.Is(err, metadatastorage.ErrNotFound) || errors.Is(err, metadatastorage.ErrHoldNotFound) {
+		writeNotFound(w, err)
+		return
+	} else if err != nil {
+		logrus.Errorf("legal hold failed: %+v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logrus.Errorf("failed to write legal hold response: %+v", err)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/in-toto/archivista/ent"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock HoldingStorerMock
+type HoldingStorerMock struct {
+	DeletingStorerMock
+}
+
+func (m *HoldingStorerMock) Hold(_ context.Context, hold metadatastorage.Hold) (*ent.LegalHold, error) {
+	args := m.Called(hold)
+	held, _ := args.Get(0).(*ent.LegalHold)
+	return held, args.Error(1)
+}
+
+func (m *HoldingStorerMock) ReleaseHold(_ context.Context, id uuid.UUID, releasedBy string) (*ent.LegalHold, error) {
+	args := m.Called(id, releasedBy)
+	released, _ := args.Get(0).(*ent.LegalHold)
+	return released, args.Error(1)
+}
+
+func (m *HoldingStorerMock) Held(_ context.Context, gitoid string) (bool, error) {
+	args := m.Called(gitoid)
+	return args.Bool(0), args.Error(1)
+}
+
+// Mock LegalHoldingStorerGetterMock
+type LegalHoldingStorerGetterMock struct {
+	DeletingStorerGetterMock
+}
+
+func (m *LegalHoldingStorerGetterMock) SetLegalHold(_ context.Context, gitoid string, held bool) error {
+	args := m.Called(gitoid, held)
+	return args.Error(0)
+}
+
+func legalHold(gitoids ...string) *ent.LegalHold {
+	hold := &ent.LegalHold{ID: uuid.New(), Reason: "audit", CreatedAt: time.Now()}
+	for _, gitoid := range gitoids {
+		hold.Edges.Dsses = append(hold.Edges.Dsses, &ent.Dsse{GitoidSha256: gitoid, Tenant: "default"})
+	}
+
+	return hold
+}
+
+func (ut *UTServerSuite) Test_Delete_Held() {
+	metadataStore := new(HoldingStorerMock)
+	metadataStore.On("Exists").Return(true, nil)
+	metadataStore.On("Held", "fakeGitoid").Return(true, nil)
+	objectStore := new(DeletingStorerGetterMock)
+	ut.testServer.metadataStore = metadataStore
+	ut.testServer.objectStore = objectStore
+
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodDelete, "/v1/delete/fakeGitoid", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": "fakeGitoid"})
+
+	ut.testServer.DeleteHandler(w, request)
+	ut.Equal(http.StatusConflict, w.Code)
+	objectStore.AssertNotCalled(ut.T(), "Delete")
+	metadataStore.AssertNotCalled(ut.T(), "Delete")
+}
+
+func (ut *UTServerSuite) Test_HoldHandler() {
+	metadataStore := new(HoldingStorerMock)
+	metadataStore.On("Hold", metadatastorage.Hold{Reason: "audit", Gitoids: []string{"a", "b"}}).Return(legalHold("a", "b"), nil)
+	objectStore := new(LegalHoldingStorerGetterMock)
+	objectStore.On("SetLegalHold", "a", true).Return(nil)
+	objectStore.On("SetLegalHold", "b", true).Return(nil)
+	ut.testServer.metadataStore = metadataStore
+	ut.testServer.objectStore = objectStore
+
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/holds", strings.NewReader(`{"reason":"audit","gitoids":["a","b"]}`))
+
+	ut.testServer.HoldHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	ut.Contains(w.Body.String(), `"gitoids":["a","b"]`)
+	objectStore.AssertExpectations(ut.T())
+}
+
+func (ut *UTServerSuite) Test_HoldHandler_Invalid() {
+	ut.testServer.metadataStore = new(HoldingStorerMock)
+	for _, body := range []string{`{"gitoids":["a"]}`, `{"reason":"audit"}`, `{"reason":"audit","subjectDigest":"abcd"}`, `not json`} {
+		w := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodPost, "/v1/holds", strings.NewReader(body))
+
+		ut.testServer.HoldHandler(w, request)
+		ut.Equal(http.StatusBadRequest, w.Code, body)
+	}
+}
+
+func (ut *UTServerSuite) Test_HoldHandler_Unsupported() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/holds", strings.NewReader(`{"reason":"audit","gitoids":["a"]}`))
+
+	ut.testServer.HoldHandler(w, request)
+	ut.Equal(http.StatusInternalServerError, w.Code)
+	ut.Contains(w.Body.String(), ErrHoldsUnsupported.Error())
+}
+
+func (ut *UTServerSuite) Test_ReleaseHoldHandler() {
+	released := legalHold("a", "b")
+	releasedAt := time.Now()
+	released.ReleasedAt = &releasedAt
+	metadataStore := new(HoldingStorerMock)
+	metadataStore.On("ReleaseHold", released.ID, "").Return(released, nil)
+	// b is still held by another hold, so its object stays locked
+	metadataStore.On("Held", "a").Return(false, nil)
+	metadataStore.On("Held", "b").Return(true, nil)
+	objectStore := new(LegalHoldingStorerGetterMock)
+	objectStore.On("SetLegalHold", "a", false).Return(nil)
+	ut.testServer.metadataStore = metadataStore
+	ut.testServer.objectStore = objectStore
+
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/v1/holds/%s/release", released.ID), nil)
+	request = mux.SetURLVars(request, map[string]string{"id": released.ID.String()})
+
+	ut.testServer.ReleaseHoldHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	objectStore.AssertExpectations(ut.T())
+	objectStore.AssertNotCalled(ut.T(), "SetLegalHold", "b", mock.Anything)
+}
+
+func (ut *UTServerSuite) Test_ReleaseHoldHandler_NotFound() {
+	id := uuid.New()
+	metadataStore := new(HoldingStorerMock)
+	metadataStore.On("ReleaseHold", id, "").Return(nil, fmt.Errorf("%w: %s", metadatastorage.ErrHoldNotFound, id))
+	ut.testServer.metadataStore = metadataStore
+
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/holds/x/release", nil)
+	request = mux.SetURLVars(request, map[string]string{"id": id.String()})
+
+	ut.testServer.ReleaseHoldHandler(w, request)
+	ut.Equal(http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	request = mux.SetURLVars(request, map[string]string{"id": "not-a-uuid"})
+	ut.testServer.ReleaseHoldHandler(w, request)
+	ut.Equal(http.StatusBadRequest, w.Code)
+}
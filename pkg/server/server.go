@@ -26,20 +26,27 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
-	"github.com/edwarnicke/gitoid"
 	"github.com/gorilla/mux"
 	"github.com/in-toto/archivista"
 	_ "github.com/in-toto/archivista/docs"
 	"github.com/in-toto/archivista/ent"
 	"github.com/in-toto/archivista/pkg/api"
 	"github.com/in-toto/archivista/pkg/artifactstore"
+	"github.com/in-toto/archivista/pkg/auth"
+	"github.com/in-toto/archivista/pkg/bundle"
 	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/in-toto/archivista/pkg/metrics"
+	"github.com/in-toto/archivista/pkg/objectstorage"
 	"github.com/in-toto/archivista/pkg/publisherstore"
+	"github.com/in-toto/archivista/pkg/verifier"
+	"github.com/in-toto/go-witness/dsse"
 	"github.com/sirupsen/logrus"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 )
@@ -51,8 +58,16 @@ type Server struct {
 	router         *mux.Router
 	sqlClient      *ent.Client
 	publisherStore []publisherstore.Publisher
+	verifier       EnvelopeVerifier
+	maxUploadSize  int64
+	uploadWorkers  int
+	auth           *auth.Auth
+	tenantHeader   string
 }
 
+// ErrInvalidEnvelope is returned when an upload can not be parsed as a DSSE envelope
+var ErrInvalidEnvelope = errors.New("invalid dsse envelope")
+
 type Storer interface {
 	Store(context.Context, string, []byte) error
 }
@@ -66,6 +81,26 @@ type StorerGetter interface {
 	Getter
 }
 
+// StreamStorer is implemented by object stores that can store an envelope without
+// needing the whole envelope in memory. size is the length of the envelope in bytes.
+type StreamStorer interface {
+	StoreStream(ctx context.Context, gitoid string, r io.Reader, size int64) error
+}
+
+// Deleter is implemented by stores that can remove an envelope
+type Deleter interface {
+	Delete(context.Context, string) error
+}
+
+// Exister is implemented by stores that can report whether an envelope has already been stored
+type Exister interface {
+	Exists(context.Context, string) (bool, error)
+}
+
+type EnvelopeVerifier interface {
+	Verify(context.Context, dsse.Envelope) (verifier.Result, error)
+}
+
 type Option func(*Server)
 
 func WithMetadataStore(metadataStore Storer) Option {
@@ -98,6 +133,34 @@ func WithPublishers(pub []publisherstore.Publisher) Option {
 	}
 }
 
+// WithVerifier requires uploaded envelopes to pass verification before they are stored
+func WithVerifier(v EnvelopeVerifier) Option {
+	return func(s *Server) {
+		s.verifier = v
+	}
+}
+
+// WithAuth requires requests to each route to be authenticated and authorized
+func WithAuth(a *auth.Auth) Option {
+	return func(s *Server) {
+		s.auth = a
+	}
+}
+
+// WithMaxUploadSize rejects uploads larger than maxUploadSize bytes. 0 disables the limit
+func WithMaxUploadSize(maxUploadSize int64) Option {
+	return func(s *Server) {
+		s.maxUploadSize = maxUploadSize
+	}
+}
+
+// WithUploadBatchConcurrency sets how many envelopes of a batch upload are stored at once
+func WithUploadBatchConcurrency(workers int) Option {
+	return func(s *Server) {
+		s.uploadWorkers = workers
+	}
+}
+
 func New(cfg *config.Config, opts ...Option) (Server, error) {
 	r := mux.NewRouter()
 	s := Server{
@@ -109,15 +172,23 @@ func New(cfg *config.Config, opts ...Option) (Server, error) {
 	}
 
 	// TODO: remove from future version (v0.6.0) endpoint with version
-	r.HandleFunc("/download/{gitoid}", s.DownloadHandler)
-	r.HandleFunc("/upload", s.UploadHandler)
+	r.Handle("/download/{gitoid}", s.protect(auth.PermissionDownload, s.DownloadHandler))
+	r.Handle("/upload", s.protect(auth.PermissionUpload, s.UploadHandler))
 	if cfg.EnableSQLStore && cfg.EnableGraphql {
-		r.Handle("/query", s.Query(s.sqlClient))
-		r.Handle("/v1/query", s.Query(s.sqlClient))
+		r.Handle("/query", s.protect(auth.PermissionQuery, s.Query(s.sqlClient).ServeHTTP))
+		r.Handle("/v1/query", s.protect(auth.PermissionQuery, s.Query(s.sqlClient).ServeHTTP))
+	}
+
+	r.Handle("/v1/download/{gitoid}", s.protect(auth.PermissionDownload, s.DownloadHandler))
+	r.Handle("/v1/download", s.protect(auth.PermissionDownload, s.BatchDownloadHandler))
+	r.Handle("/v1/upload", s.protect(auth.PermissionUpload, s.UploadHandler))
+	r.Handle("/v1/upload/batch", s.protect(auth.PermissionUpload, s.BatchUploadHandler))
+	if cfg.EnableFileMirror {
+		// a read-only mirror of envelopes at the paths files used to be served on by the FILE
+		// backend, authorized and cached like downloads
+		r.Handle("/v1/files/{gitoid}.json", s.protect(auth.PermissionDownload, s.DownloadHandler))
 	}
 
-	r.HandleFunc("/v1/download/{gitoid}", s.DownloadHandler)
-	r.HandleFunc("/v1/upload", s.UploadHandler)
 	if cfg.EnableSQLStore && cfg.EnableGraphql && cfg.GraphqlWebClientEnable {
 		r.Handle("/",
 			playground.Handler("Archivista", "/v1/query"),
@@ -125,16 +196,44 @@ func New(cfg *config.Config, opts ...Option) (Server, error) {
 	}
 
 	if cfg.EnableArtifactStore {
-		r.HandleFunc("/v1/artifacts", s.AllArtifactsHandler)
-		r.HandleFunc("/v1/artifacts/{name}", s.ArtifactAllVersionsHandler)
-		r.HandleFunc("/v1/artifacts/{name}/{version}", s.ArtifactVersionHandler)
-		r.HandleFunc("/v1/download/artifact/{name}/{version}/{distribution}", s.DownloadArtifactHandler)
+		r.Handle("/v1/artifacts", s.protect(auth.PermissionArtifacts, s.AllArtifactsHandler))
+		r.Handle("/v1/artifacts/{name}", s.protect(auth.PermissionArtifacts, s.ArtifactAllVersionsHandler))
+		r.Handle("/v1/artifacts/{name}/{version}", s.protect(auth.PermissionArtifacts, s.ArtifactVersionHandler))
+		r.Handle("/v1/download/artifact/{name}/{version}/{distribution}", s.protect(auth.PermissionArtifacts, s.DownloadArtifactHandler))
+	}
+
+	if cfg.EnableDelete {
+		r.Handle("/v1/delete/{gitoid}", s.protect(auth.PermissionDelete, s.DeleteHandler))
+	}
+
+	if cfg.EnableLegalHold {
+		r.Handle("/v1/holds", s.protect(auth.PermissionHold, s.HoldHandler))
+		r.Handle("/v1/holds/{id}/release", s.protect(auth.PermissionHold, s.ReleaseHoldHandler))
+	}
+
+	if cfg.EnableMetrics {
+		r.Handle("/metrics", metrics.Handler())
 	}
 
 	r.PathPrefix("/swagger/").Handler(httpSwagger.WrapHandler)
 	return s, nil
 }
 
+// protect requires requests to the handler to be granted permission when auth is configured,
+// and scopes them to the caller's tenant when tenancy is configured
+func (s *Server) protect(permission auth.Permission, handler http.HandlerFunc) http.Handler {
+	var h http.Handler = handler
+	if s.tenantHeader != "" {
+		h = s.scopeToTenant(h)
+	}
+
+	if s.auth == nil {
+		return h
+	}
+
+	return s.auth.Require(permission, h)
+}
+
 // @title Archivista API
 // @description Archivista API
 // @version v1
@@ -151,29 +250,72 @@ func (s *Server) Router() *mux.Router {
 // @Description stores an attestation
 // @Produce  json
 // @Success 200 {object} api.StoreResponse
+// @Failure 400 {object} string
+// @Failure 413 {object} string
+// @Failure 422 {object} string
+// @Failure 500 {object} string
 // @Tags attestation
 // @Router /v1/upload [post]
 func (s *Server) Upload(ctx context.Context, r io.Reader) (api.UploadResponse, error) {
-	payload, err := io.ReadAll(r)
+	return s.upload(ctx, r, -1)
+}
+
+// upload stores the envelope read from r. contentLength is the size of the upload if
+// it is known ahead of time, or -1 otherwise.
+func (s *Server) upload(ctx context.Context, r io.Reader, contentLength int64) (api.UploadResponse, error) {
+	upload, err := newSpool(r, contentLength, s.maxUploadSize)
 	if err != nil {
+		logrus.Errorf("failed to spool upload: %v", err)
 		return api.UploadResponse{}, err
 	}
 
-	gid, err := gitoid.New(bytes.NewReader(payload), gitoid.WithContentLength(int64(len(payload))), gitoid.WithSha256())
+	defer upload.Close()
+	if envelopes, err := bundle.Unpack(upload.Reader()); err == nil {
+		return s.uploadBundle(ctx, envelopes)
+	} else if !errors.Is(err, bundle.ErrNotBundle) {
+		return api.UploadResponse{}, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+	}
+
+	gid := upload.gitoid
+	if s.verifier != nil {
+		ctx, err = s.verify(ctx, upload.Reader())
+		if err != nil {
+			logrus.Errorf("failed to verify envelope %s: %v", gid, err)
+			return api.UploadResponse{}, err
+		}
+	}
+
+	exists, err := s.exists(ctx, gid)
 	if err != nil {
-		logrus.Errorf("failed to generate gitoid: %v", err)
+		logrus.Errorf("failed to check if %s already exists: %+v", gid, err)
 		return api.UploadResponse{}, err
 	}
 
+	if exists {
+		logrus.Debugf("envelope %s already exists, skipping storage", gid)
+		return api.UploadResponse{Gitoid: gid, Status: api.UploadStatusAlreadyExists}, nil
+	}
+
 	if s.objectStore != nil {
-		if err := s.objectStore.Store(ctx, gid.String(), payload); err != nil {
+		if err := s.storeObject(ctx, gid, upload); err != nil {
 			logrus.Errorf("received error from object store: %+v", err)
 			return api.UploadResponse{}, err
 		}
 	}
 
+	// the metadata store and publishers need the whole envelope, so only read it into memory if one of them is configured
+	var payload []byte
+	if s.metadataStore != nil || len(s.publisherStore) > 0 {
+		if payload, err = upload.Bytes(); err != nil {
+			return api.UploadResponse{}, err
+		}
+	}
+
 	if s.metadataStore != nil {
-		if err := s.metadataStore.Store(ctx, gid.String(), payload); err != nil {
+		if err := s.metadataStore.Store(ctx, gid, payload); errors.Is(err, metadatastorage.ErrAlreadyExists) {
+			// a concurrent upload of the same envelope stored it first
+			return api.UploadResponse{Gitoid: gid, Status: api.UploadStatusAlreadyExists}, nil
+		} else if err != nil {
 			logrus.Errorf("received error from metadata store: %+v", err)
 			return api.UploadResponse{}, err
 		}
@@ -181,14 +323,99 @@ func (s *Server) Upload(ctx context.Context, r io.Reader) (api.UploadResponse, e
 
 	if s.publisherStore != nil {
 		for _, publisher := range s.publisherStore {
-			// TODO: Make publish asynchrouns and use goroutine
-			if err := publisher.Publish(ctx, gid.String(), payload); err != nil {
+			if err := publisher.Publish(ctx, gid, payload); err != nil {
 				logrus.Errorf("received error from publisher: %+v", err)
 			}
 		}
 	}
 
-	return api.UploadResponse{Gitoid: gid.String()}, nil
+	return api.UploadResponse{Gitoid: gid, Status: api.UploadStatusCreated}, nil
+}
+
+// uploadBundle stores each envelope unpacked from a bundle as if it had been uploaded on its
+// own, passing along the bundle's material so the metadata store can keep it with the envelope.
+// A bundle of one envelope is answered like that envelope's upload. Otherwise every envelope is
+// stored or rejected on its own, like a batch upload: the response lists the outcome of each in
+// bundle order and has no gitoid or status of its own.
+func (s *Server) uploadBundle(ctx context.Context, envelopes []bundle.Envelope) (api.UploadResponse, error) {
+	responses := make([]api.UploadResponse, 0, len(envelopes))
+	for i, envelope := range envelopes {
+		resp, err := s.upload(bundle.NewContext(ctx, envelope.Material), bytes.NewReader(envelope.Envelope), int64(len(envelope.Envelope)))
+		if err != nil && len(envelopes) == 1 {
+			return api.UploadResponse{}, err
+		} else if err != nil {
+			logrus.Errorf("failed to store envelope %d of bundle: %v", i+1, err)
+			resp.Error = batchUploadError(err)
+		}
+
+		responses = append(responses, resp)
+	}
+
+	if len(responses) == 1 {
+		return api.UploadResponse{Gitoid: responses[0].Gitoid, Status: responses[0].Status, Envelopes: responses}, nil
+	}
+
+	return api.UploadResponse{Envelopes: responses}, nil
+}
+
+// storeObject streams the upload to the object store if it supports it, and falls back to
+// handing it the whole envelope otherwise.
+func (s *Server) storeObject(ctx context.Context, gitoid string, upload *spool) error {
+	if streamStorer, ok := s.objectStore.(StreamStorer); ok {
+		return streamStorer.StoreStream(ctx, gitoid, upload.Reader(), upload.size)
+	}
+
+	payload, err := upload.Bytes()
+	if err != nil {
+		return err
+	}
+
+	return s.objectStore.Store(ctx, gitoid, payload)
+}
+
+// exists checks whether the envelope has already been stored. The metadata store is authoritative
+// when it is enabled since it enforces gitoid uniqueness, otherwise the object store is asked.
+func (s *Server) exists(ctx context.Context, gitoid string) (bool, error) {
+	if exister, ok := s.metadataStore.(Exister); ok {
+		return exister.Exists(ctx, gitoid)
+	}
+
+	if exister, ok := s.objectStore.(Exister); ok {
+		return exister.Exists(ctx, gitoid)
+	}
+
+	return false, nil
+}
+
+// verify checks the envelope's signatures and returns a context carrying the result
+// so the metadata store can record which signatures were trusted.
+func (s *Server) verify(ctx context.Context, r io.Reader) (context.Context, error) {
+	envelope := dsse.Envelope{}
+	if err := json.NewDecoder(r).Decode(&envelope); err != nil {
+		return ctx, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+	}
+
+	result, err := s.verifier.Verify(ctx, envelope)
+	if err != nil {
+		return ctx, err
+	}
+
+	return verifier.NewContext(ctx, result), nil
+}
+
+// uploadErrorStatus maps errors returned by Upload to an HTTP status code
+func uploadErrorStatus(err error) int {
+	var untrusted verifier.ErrUntrustedEnvelope
+	switch {
+	case errors.Is(err, ErrInvalidEnvelope):
+		return http.StatusBadRequest
+	case errors.As(err, &untrusted):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, ErrUploadTooLarge):
+		return http.StatusRequestEntityTooLarge
+	default:
+		return http.StatusInternalServerError
+	}
 }
 
 // @Summary Upload
@@ -204,9 +431,9 @@ func (s *Server) UploadHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	defer r.Body.Close()
-	resp, err := s.Upload(r.Context(), r.Body)
+	resp, err := s.upload(r.Context(), r.Body, r.ContentLength)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), uploadErrorStatus(err))
 		return
 	}
 
@@ -226,7 +453,7 @@ func (s *Server) UploadHandler(w http.ResponseWriter, r *http.Request) {
 // @Param gitoid path string true "gitoid"
 // @Success 200 {object} dsse.Envelope
 // @Failure 500 {object} string
-// @Failure 404 {object} nil
+// @Failure 404 {object} api.ErrorResponse
 // @Failure 400 {object} string
 // @Tags attestation
 // @Router /v1/download/{gitoid} [get]
@@ -238,9 +465,26 @@ func (s *Server) Download(ctx context.Context, gitoid string) (io.ReadCloser, er
 	objReader, err := s.objectStore.Get(ctx, gitoid)
 	if err != nil {
 		logrus.Errorf("failed to get object: %+v", err)
+		return nil, err
 	}
 
-	return objReader, err
+	defer objReader.Close()
+	// the object is hashed before any of it is served so a corrupted or tampered object is
+	// never passed off as the envelope with gitoid
+	sp, err := newSpool(objReader, -1, 0)
+	if err != nil {
+		logrus.Errorf("failed to read object: %+v", err)
+		return nil, err
+	}
+
+	if !strings.EqualFold(sp.gitoid, gitoid) {
+		sp.Close()
+		metrics.DownloadIntegrityFailures.Inc()
+		logrus.Errorf("object stored as %s hashes to %s", gitoid, sp.gitoid)
+		return nil, fmt.Errorf("%w: %s", api.ErrGitoidMismatch, gitoid)
+	}
+
+	return &spoolReadCloser{SectionReader: sp.Reader(), spool: sp}, nil
 }
 
 // @Summary Download
@@ -249,12 +493,12 @@ func (s *Server) Download(ctx context.Context, gitoid string) (io.ReadCloser, er
 // @Param gitoid path string true "gitoid"
 // @Success 200 {object} dsse.Envelope
 // @Failure 500 {object} string
-// @Failure 404 {object} nil
+// @Failure 404 {object} api.ErrorResponse
 // @Failure 400 {object} string
 // @Deprecated
 // @Router /download/{gitoid} [get]
 func (s *Server) DownloadHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
 		http.Error(w, fmt.Sprintf("%s is an unsupported method", r.Method), http.StatusBadRequest)
 		return
 	}
@@ -269,20 +513,171 @@ func (s *Server) DownloadHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	attestationReader, err := s.Download(r.Context(), vars["gitoid"])
-	if err != nil {
+	gitoid := vars["gitoid"]
+	notModified := etagMatches(r.Header.Get("If-None-Match"), gitoid)
+	// a HEAD or a revalidation of a cached envelope only needs to know the envelope exists,
+	// so skip reading it when the object store can tell us that cheaply
+	if exister, ok := s.objectStore.(Exister); ok && (r.Method == http.MethodHead || notModified) {
+		exists, err := exister.Exists(r.Context(), gitoid)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		} else if !exists {
+			writeNotFound(w, fmt.Errorf("%w: %s", objectstorage.ErrNotFound, gitoid))
+			return
+		}
+
+		s.setEnvelopeHeaders(w, gitoid)
+		if notModified {
+			w.WriteHeader(http.StatusNotModified)
+		}
+
+		return
+	}
+
+	attestationReader, err := s.Download(r.Context(), gitoid)
+	if errors.Is(err, objectstorage.ErrNotFound) {
+		writeNotFound(w, err)
+		return
+	} else if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
 	defer attestationReader.Close()
+	s.setEnvelopeHeaders(w, gitoid)
+	if notModified {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	if seeker, ok := attestationReader.(io.ReadSeeker); ok && r.Header.Get("Range") != "" {
+		http.ServeContent(w, r, "", time.Time{}, seeker)
+		return
+	}
+
+	if sized, ok := attestationReader.(interface{ Size() int64 }); ok {
+		w.Header().Set("Content-Length", strconv.FormatInt(sized.Size(), 10))
+	}
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
 	if _, err := io.Copy(w, attestationReader); err != nil {
 		logrus.Errorf("failed to copy attestation to response: %+v", err)
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
+}
+
+// writeNotFound responds with a JSON error body so clients can tell a missing envelope from
+// a failure of the server
+func writeNotFound(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	if err := json.NewEncoder(w).Encode(api.ErrorResponse{Error: "not_found", Message: err.Error()}); err != nil {
+		logrus.Errorf("failed to write error response: %+v", err)
+	}
+}
+
+// Delete removes the envelope with gitoid from the object store and its metadata from the
+// metadata store. Envelopes under a legal hold can't be deleted.
+func (s *Server) Delete(ctx context.Context, gitoid string) error {
+	exists, err := s.exists(ctx, gitoid)
+	if err != nil {
+		return err
+	}
+
+	orphaned := false
+	if !exists {
+		if orphaned, err = s.orphaned(ctx, gitoid); err != nil {
+			return err
+		} else if !orphaned {
+			return fmt.Errorf("%w: %s", metadatastorage.ErrNotFound, gitoid)
+		}
+	}
+
+	held, err := s.held(ctx, gitoid)
+	if err != nil {
+		return err
+	} else if held {
+		return fmt.Errorf("%w: %s", metadatastorage.ErrHeld, gitoid)
+	}
+
+	// the metadata goes first, in a transaction that checks for holds again, so a hold placed
+	// since the check above keeps the whole envelope. Only once that has committed is the object
+	// removed, and if that fails deleting again removes the orphaned object.
+	if deleter, ok := s.metadataStore.(Deleter); ok && !orphaned {
+		if err := deleter.Delete(ctx, gitoid); err != nil {
+			return fmt.Errorf("could not delete envelope metadata: %w", err)
+		}
+	}
+
+	if deleter, ok := s.objectStore.(Deleter); ok {
+		if err := deleter.Delete(ctx, gitoid); err != nil {
+			return fmt.Errorf("envelope metadata was deleted but the object store could not remove the envelope, delete it again to retry: %w", err)
+		}
+	}
+
+	logrus.Infof("deleted envelope %s", gitoid)
+	return nil
+}
+
+// orphaned reports whether the object store still holds an envelope whose metadata is gone,
+// which is left behind when removing the object fails after the metadata was deleted
+func (s *Server) orphaned(ctx context.Context, gitoid string) (bool, error) {
+	if _, ok := s.metadataStore.(Exister); !ok {
+		// the object store was already asked whether the envelope exists
+		return false, nil
+	}
+
+	if exister, ok := s.objectStore.(Exister); ok {
+		return exister.Exists(ctx, gitoid)
+	}
+
+	return false, nil
+}
+
+// @Summary Delete
+// @Description deletes an attestation and all metadata stored from it
+// @Produce  json
+// @Param gitoid path string true "gitoid"
+// @Success 200 {object} api.DeleteResponse
+// @Failure 400 {object} string
+// @Failure 404 {object} api.ErrorResponse
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Tags attestation
+// @Router /v1/delete/{gitoid} [delete]
+func (s *Server) DeleteHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, fmt.Sprintf("%s is an unsupported method", r.Method), http.StatusBadRequest)
+		return
+	}
+
+	gitoid := strings.TrimSpace(mux.Vars(r)["gitoid"])
+	if len(gitoid) == 0 {
+		http.Error(w, "gitoid parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.Delete(r.Context(), gitoid); errors.Is(err, metadatastorage.ErrNotFound) {
+		writeNotFound(w, err)
+		return
+	} else if errors.Is(err, metadatastorage.ErrHeld) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	} else if err != nil {
+		logrus.Errorf("failed to delete %s: %+v", gitoid, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(api.DeleteResponse{Gitoid: gitoid}); err != nil {
+		logrus.Errorf("failed to write delete response: %+v", err)
+	}
 }
 
 // @Summary Query GraphQL
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -26,10 +27,20 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/edwarnicke/gitoid"
 	"github.com/gorilla/mux"
 	"github.com/in-toto/archivista/pkg/api"
 	"github.com/in-toto/archivista/pkg/artifactstore"
+	"github.com/in-toto/archivista/pkg/auth"
 	"github.com/in-toto/archivista/pkg/config"
+	"github.com/in-toto/archivista/pkg/metadatastorage"
+	"github.com/in-toto/archivista/pkg/metrics"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/in-toto/archivista/pkg/publisherstore"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/in-toto/archivista/pkg/verifier"
+	"github.com/in-toto/go-witness/dsse"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -63,6 +74,92 @@ func (m *StorerGetterMock) Get(context.Context, string) (io.ReadCloser, error) {
 	return stringReadCloser, args.Error(0)
 }
 
+// Mock StreamStorerGetterMock
+type StreamStorerGetterMock struct {
+	StorerGetterMock
+	streamed []byte
+}
+
+func (m *StreamStorerGetterMock) StoreStream(_ context.Context, _ string, r io.Reader, size int64) error {
+	args := m.Called()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	m.streamed = data
+	return args.Error(0)
+}
+
+// Mock ExistingStorerMock
+type ExistingStorerMock struct {
+	StorerMock
+}
+
+func (m *ExistingStorerMock) Exists(context.Context, string) (bool, error) {
+	args := m.Called()
+	return args.Bool(0), args.Error(1)
+}
+
+// Mock DeletingStorerMock
+type DeletingStorerMock struct {
+	ExistingStorerMock
+}
+
+func (m *DeletingStorerMock) Delete(context.Context, string) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// Mock DeletingStorerGetterMock
+type DeletingStorerGetterMock struct {
+	StorerGetterMock
+}
+
+func (m *DeletingStorerGetterMock) Delete(context.Context, string) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// Mock DeletingExistingStorerGetterMock
+type DeletingExistingStorerGetterMock struct {
+	DeletingStorerGetterMock
+}
+
+func (m *DeletingExistingStorerGetterMock) Exists(context.Context, string) (bool, error) {
+	args := m.Called()
+	return args.Bool(0), args.Error(1)
+}
+
+type ExistingStorerGetterMock struct {
+	StorerGetterMock
+}
+
+func (m *ExistingStorerGetterMock) Exists(context.Context, string) (bool, error) {
+	args := m.Called()
+	return args.Bool(0), args.Error(1)
+}
+
+// Mock PublisherMock
+type PublisherMock struct {
+	mock.Mock
+}
+
+func (m *PublisherMock) Publish(context.Context, string, []byte) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// Mock EnvelopeVerifier
+type VerifierMock struct {
+	mock.Mock
+}
+
+func (m *VerifierMock) Verify(context.Context, dsse.Envelope) (verifier.Result, error) {
+	args := m.Called()
+	return args.Get(0).(verifier.Result), args.Error(1)
+}
+
 // Mock ResponseRecorderMock
 type ResponseRecorderMock struct {
 	mock.Mock
@@ -141,12 +238,137 @@ func (ut *UTServerSuite) Test_New() {
 	ut.Contains(allPaths, "/upload")
 	ut.Contains(allPaths, "/query")
 	ut.Contains(allPaths, "/v1/download/{gitoid}")
+	ut.Contains(allPaths, "/v1/download")
 	ut.Contains(allPaths, "/v1/upload")
 	ut.Contains(allPaths, "/v1/query")
 	ut.Contains(allPaths, "/")
 	ut.Contains(allPaths, "/swagger/")
 }
 
+func (ut *UTServerSuite) Test_New_EnableMetrics() {
+	cfg := new(config.Config)
+	cfg.EnableMetrics = true
+	var err error
+	ut.testServer, err = New(cfg, WithObjectStore(ut.mockedStorerGetter))
+	ut.Require().NoError(err)
+
+	w := httptest.NewRecorder()
+	ut.testServer.Router().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	ut.Equal(http.StatusOK, w.Code)
+	ut.Contains(w.Body.String(), "archivista_download_integrity_failures_total")
+}
+
+func (ut *UTServerSuite) Test_New_WithAuth() {
+	tokens, err := auth.NewTokenAuthenticator(
+		auth.Token{Token: "ci-token", Subject: "ci", Roles: []string{"ci"}},
+		auth.Token{Token: "auditor-token", Subject: "auditor", Roles: []string{"auditor"}},
+	)
+	ut.Require().NoError(err)
+	a, err := auth.New(auth.WithAuthenticators(tokens), auth.WithPolicy(auth.Policy{auth.PermissionUpload: {"ci"}}))
+	ut.Require().NoError(err)
+
+	ut.testServer, err = New(new(config.Config), WithObjectStore(ut.mockedStorerGetter), WithAuth(a))
+	ut.Require().NoError(err)
+
+	ut.mockedStorerGetter.On("Store").Return(nil)
+	for token, status := range map[string]int{"": http.StatusUnauthorized, "auditor-token": http.StatusForbidden, "ci-token": http.StatusOK} {
+		req := httptest.NewRequest(http.MethodPost, "/v1/upload", strings.NewReader(`{"payloadType":"test","payload":"","signatures":[]}`))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		w := httptest.NewRecorder()
+		ut.testServer.Router().ServeHTTP(w, req)
+		ut.Equal(status, w.Code, token)
+	}
+
+	// swagger docs stay public
+	w := httptest.NewRecorder()
+	ut.testServer.Router().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil))
+	ut.NotEqual(http.StatusUnauthorized, w.Code)
+}
+
+func (ut *UTServerSuite) Test_New_EnableFileMirror() {
+	var err error
+	ut.testServer, err = New(new(config.Config), WithObjectStore(ut.mockedStorerGetter))
+	ut.Require().NoError(err)
+
+	w := httptest.NewRecorder()
+	ut.testServer.Router().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/files/"+gitoidOf("testData")+".json", nil))
+	ut.Equal(http.StatusNotFound, w.Code)
+
+	cfg := new(config.Config)
+	cfg.EnableFileMirror = true
+	ut.testServer, err = New(cfg, WithObjectStore(ut.mockedStorerGetter))
+	ut.Require().NoError(err)
+
+	ut.mockedStorerGetter.On("Get").Return(nil)
+	w = httptest.NewRecorder()
+	ut.testServer.Router().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/files/"+gitoidOf("testData")+".json", nil))
+	ut.Equal(http.StatusOK, w.Code)
+	ut.Equal("testData", w.Body.String())
+	ut.Equal(`"`+gitoidOf("testData")+`"`, w.Header().Get("ETag"))
+}
+
+// TenantRecorder records the tenant each download was scoped to
+type TenantRecorder struct {
+	StorerGetter
+	tenants []string
+}
+
+func (r *TenantRecorder) Get(ctx context.Context, gitoid string) (io.ReadCloser, error) {
+	t, _ := tenant.FromContext(ctx)
+	r.tenants = append(r.tenants, t)
+	return io.NopCloser(strings.NewReader("{}")), nil
+}
+
+func (ut *UTServerSuite) Test_New_WithTenancy() {
+	recorder := &TenantRecorder{}
+	tokens, err := auth.NewTokenAuthenticator(
+		auth.Token{Token: "team-a-token", Subject: "ci", Tenant: "team-a"},
+		auth.Token{Token: "admin-token", Subject: "admin"},
+	)
+	ut.Require().NoError(err)
+	a, err := auth.New(auth.WithAuthenticators(tokens))
+	ut.Require().NoError(err)
+
+	ut.testServer, err = New(new(config.Config), WithObjectStore(recorder), WithAuth(a), WithTenancy(""))
+	ut.Require().NoError(err)
+
+	tests := []struct {
+		name   string
+		token  string
+		header string
+		status int
+		tenant string
+	}{
+		{"bound identity", "team-a-token", "", http.StatusOK, "team-a"},
+		{"bound identity naming its tenant", "team-a-token", "team-a", http.StatusOK, "team-a"},
+		{"bound identity naming another tenant", "team-a-token", "team-b", http.StatusForbidden, ""},
+		{"unbound identity", "admin-token", "", http.StatusOK, tenant.Default},
+		{"unbound identity naming a tenant", "admin-token", "team-b", http.StatusOK, "team-b"},
+		{"invalid tenant", "admin-token", "../team-b", http.StatusBadRequest, ""},
+	}
+
+	for _, test := range tests {
+		recorder.tenants = nil
+		req := httptest.NewRequest(http.MethodGet, "/v1/download/"+gitoidOf("{}"), nil)
+		req.Header.Set("Authorization", "Bearer "+test.token)
+		if test.header != "" {
+			req.Header.Set(api.TenantHeader, test.header)
+		}
+
+		w := httptest.NewRecorder()
+		ut.testServer.Router().ServeHTTP(w, req)
+		ut.Equal(test.status, w.Code, test.name)
+		if test.tenant != "" {
+			ut.Equal([]string{test.tenant}, recorder.tenants, test.name)
+		} else {
+			ut.Empty(recorder.tenants, test.name)
+		}
+	}
+}
+
 func (ut *UTServerSuite) Test_New_EnableGraphQL_False() {
 	cfg := new(config.Config)
 	cfg.EnableGraphql = false
@@ -175,6 +397,7 @@ func (ut *UTServerSuite) Test_New_EnableGraphQL_False() {
 	ut.Contains(allPaths, "/upload")
 	ut.NotContains(allPaths, "/query")
 	ut.Contains(allPaths, "/v1/download/{gitoid}")
+	ut.Contains(allPaths, "/v1/download")
 	ut.Contains(allPaths, "/v1/upload")
 	ut.NotContains(allPaths, "/v1/query")
 	ut.Contains(allPaths, "/swagger/")
@@ -208,6 +431,7 @@ func (ut *UTServerSuite) Test_New_GraphqlWebClientEnable_False() {
 	ut.Contains(allPaths, "/upload")
 	ut.Contains(allPaths, "/query")
 	ut.Contains(allPaths, "/v1/download/{gitoid}")
+	ut.Contains(allPaths, "/v1/download")
 	ut.Contains(allPaths, "/v1/upload")
 	ut.Contains(allPaths, "/v1/query")
 	ut.NotContains(allPaths, "/")
@@ -262,6 +486,141 @@ func (ut *UTServerSuite) Test_Upload_FailedMetadatStprage() {
 	ut.Equal(api.UploadResponse{}, resp)
 }
 
+func (ut *UTServerSuite) Test_Upload_AlreadyExists() {
+	ctx := context.TODO()
+	r := strings.NewReader("fakeTestData")
+
+	existingStorer := new(ExistingStorerMock)
+	existingStorer.On("Exists").Return(true, nil)
+	publisher := new(PublisherMock)
+	ut.testServer.metadataStore = existingStorer
+	ut.testServer.publisherStore = []publisherstore.Publisher{publisher}
+
+	resp, err := ut.testServer.Upload(ctx, r)
+	ut.NoError(err)
+	ut.NotEqual("", resp.Gitoid)
+	ut.Equal(api.UploadStatusAlreadyExists, resp.Status)
+	ut.mockedStorerGetter.AssertNotCalled(ut.T(), "Store")
+	existingStorer.AssertNotCalled(ut.T(), "Store")
+	publisher.AssertNotCalled(ut.T(), "Publish")
+}
+
+func (ut *UTServerSuite) Test_Upload_NotExists() {
+	ctx := context.TODO()
+	r := strings.NewReader("fakeTestData")
+
+	existingStorer := new(ExistingStorerMock)
+	existingStorer.On("Exists").Return(false, nil)
+	existingStorer.On("Store").Return(nil)
+	publisher := new(PublisherMock)
+	publisher.On("Publish").Return(nil)
+	ut.testServer.metadataStore = existingStorer
+	ut.testServer.publisherStore = []publisherstore.Publisher{publisher}
+	ut.mockedStorerGetter.On("Store").Return(nil)
+
+	resp, err := ut.testServer.Upload(ctx, r)
+	ut.NoError(err)
+	ut.Equal(api.UploadStatusCreated, resp.Status)
+	publisher.AssertExpectations(ut.T())
+}
+
+func (ut *UTServerSuite) Test_Upload_ExistsFailed() {
+	ctx := context.TODO()
+	r := strings.NewReader("fakeTestData")
+
+	existingStorer := new(ExistingStorerMock)
+	existingStorer.On("Exists").Return(false, errors.New("Bad SQL"))
+	ut.testServer.metadataStore = existingStorer
+
+	_, err := ut.testServer.Upload(ctx, r)
+	ut.ErrorContains(err, "Bad SQL")
+	ut.mockedStorerGetter.AssertNotCalled(ut.T(), "Store")
+}
+
+func (ut *UTServerSuite) Test_Upload_ConcurrentDuplicate() {
+	ctx := context.TODO()
+	r := strings.NewReader("fakeTestData")
+
+	publisher := new(PublisherMock)
+	ut.testServer.publisherStore = []publisherstore.Publisher{publisher}
+	ut.mockedStorerGetter.On("Store").Return(nil)
+	ut.mockedStorer.On("Store").Return(fmt.Errorf("%w: gitoid", metadatastorage.ErrAlreadyExists))
+
+	resp, err := ut.testServer.Upload(ctx, r)
+	ut.NoError(err)
+	ut.Equal(api.UploadStatusAlreadyExists, resp.Status)
+	publisher.AssertNotCalled(ut.T(), "Publish")
+}
+
+func (ut *UTServerSuite) Test_Upload_StreamStorer() {
+	ctx := context.TODO()
+	r := strings.NewReader("fakeTestData")
+
+	streamStorer := new(StreamStorerGetterMock)
+	streamStorer.On("StoreStream").Return(nil)
+	ut.testServer.objectStore = streamStorer
+	ut.mockedStorer.On("Store").Return(nil)
+
+	resp, err := ut.testServer.Upload(ctx, r)
+	ut.NoError(err)
+	ut.NotEqual("", resp.Gitoid)
+	ut.Equal("fakeTestData", string(streamStorer.streamed))
+	streamStorer.AssertNotCalled(ut.T(), "Store")
+}
+
+func (ut *UTServerSuite) Test_Upload_JsonlBundle() {
+	ctx := context.TODO()
+	first := `{"payloadType":"test","payload":"Zmlyc3Q=","signatures":[]}`
+	second := `{"payloadType":"test","payload":"c2Vjb25k","signatures":[]}`
+	r := strings.NewReader(first + "\n" + second + "\n")
+
+	ut.mockedStorerGetter.On("Store").Return(nil)
+	ut.mockedStorer.On("Store").Return(nil)
+
+	resp, err := ut.testServer.Upload(ctx, r)
+	ut.NoError(err)
+	ut.Empty(resp.Gitoid)
+	ut.Empty(resp.Status)
+	ut.Equal([]api.UploadResponse{
+		{Gitoid: gitoidOf(first), Status: api.UploadStatusCreated},
+		{Gitoid: gitoidOf(second), Status: api.UploadStatusCreated},
+	}, resp.Envelopes)
+	ut.mockedStorer.AssertNumberOfCalls(ut.T(), "Store", 2)
+}
+
+func (ut *UTServerSuite) Test_Upload_JsonlBundlePartiallyStored() {
+	ctx := context.TODO()
+	first := `{"payloadType":"test","payload":"Zmlyc3Q=","signatures":[]}`
+	second := `{"payloadType":"test","payload":"c2Vjb25k","signatures":[]}`
+	third := `{"payloadType":"test","payload":"dGhpcmQ=","signatures":[]}`
+	r := strings.NewReader(first + "\n" + second + "\n" + third + "\n")
+
+	ut.mockedStorerGetter.On("Store").Return(nil)
+	ut.mockedStorer.On("Store").Return(nil).Once()
+	ut.mockedStorer.On("Store").Return(errors.New("database is down")).Once()
+	ut.mockedStorer.On("Store").Return(nil).Once()
+
+	resp, err := ut.testServer.Upload(ctx, r)
+	ut.NoError(err)
+	ut.Empty(resp.Gitoid)
+	ut.Equal([]api.UploadResponse{
+		{Gitoid: gitoidOf(first), Status: api.UploadStatusCreated},
+		{Error: &api.ErrorResponse{Error: "internal", Message: "database is down"}},
+		{Gitoid: gitoidOf(third), Status: api.UploadStatusCreated},
+	}, resp.Envelopes)
+}
+
+func (ut *UTServerSuite) Test_UploadHandler_TooLarge() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/upload", strings.NewReader("fakePayload"))
+	ut.testServer.maxUploadSize = 4
+
+	ut.testServer.UploadHandler(w, request)
+	ut.Equal(http.StatusRequestEntityTooLarge, w.Code)
+	ut.mockedStorerGetter.AssertNotCalled(ut.T(), "Store")
+	ut.mockedStorer.AssertNotCalled(ut.T(), "Store")
+}
+
 func (ut *UTServerSuite) Test_UploadHandler() {
 	w := httptest.NewRecorder()
 	requestBody := []byte("fakePayload")
@@ -300,21 +659,86 @@ func (ut *UTServerSuite) Test_UploadHandler_FailureUpload() {
 	ut.Contains(w.Body.String(), "BAD S3")
 }
 
+func (ut *UTServerSuite) Test_UploadHandler_Verified() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/upload", strings.NewReader(`{"payloadType":"test","payload":"","signatures":[{"keyid":"test","sig":""}]}`))
+
+	mockedVerifier := new(VerifierMock)
+	mockedVerifier.On("Verify").Return(verifier.Result{Signatures: []bool{true}}, nil)
+	ut.testServer.verifier = mockedVerifier
+	ut.mockedStorerGetter.On("Store").Return(nil) // mock Store() to return nil
+	ut.mockedStorer.On("Store").Return(nil)       // mock Store() to return nil
+
+	ut.testServer.UploadHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	mockedVerifier.AssertExpectations(ut.T())
+}
+
+func (ut *UTServerSuite) Test_UploadHandler_InvalidEnvelope() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/upload", strings.NewReader("fakePayload"))
+
+	ut.testServer.verifier = new(VerifierMock)
+
+	ut.testServer.UploadHandler(w, request)
+	ut.Equal(http.StatusBadRequest, w.Code)
+	ut.Contains(w.Body.String(), "invalid dsse envelope")
+	ut.mockedStorerGetter.AssertNotCalled(ut.T(), "Store")
+	ut.mockedStorer.AssertNotCalled(ut.T(), "Store")
+}
+
+func (ut *UTServerSuite) Test_UploadHandler_InvalidBundle() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/upload", strings.NewReader(`{"payloadType":"test","payload":"","signatures":[]}`+"\n"+`{"not":"an envelope"}`))
+
+	ut.testServer.UploadHandler(w, request)
+	ut.Equal(http.StatusBadRequest, w.Code)
+	ut.Contains(w.Body.String(), "invalid bundle")
+	ut.mockedStorerGetter.AssertNotCalled(ut.T(), "Store")
+	ut.mockedStorer.AssertNotCalled(ut.T(), "Store")
+}
+
+func (ut *UTServerSuite) Test_UploadHandler_UntrustedEnvelope() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/upload", strings.NewReader(`{"payloadType":"test","payload":"","signatures":[{"keyid":"test","sig":""}]}`))
+
+	mockedVerifier := new(VerifierMock)
+	mockedVerifier.On("Verify").Return(verifier.Result{}, verifier.ErrUntrustedEnvelope{Reason: errors.New("bad signature")})
+	ut.testServer.verifier = mockedVerifier
+
+	ut.testServer.UploadHandler(w, request)
+	ut.Equal(http.StatusUnprocessableEntity, w.Code)
+	ut.Contains(w.Body.String(), "envelope is not signed by a trusted key")
+	ut.mockedStorerGetter.AssertNotCalled(ut.T(), "Store")
+	ut.mockedStorer.AssertNotCalled(ut.T(), "Store")
+}
+
 func (ut *UTServerSuite) Test_Download() {
 	ctx := context.TODO()
 	ut.mockedStorerGetter.On("Get").Return(nil) // mock Get() to return nil
 
-	resp, err := ut.testServer.Download(ctx, "fakeGitoid")
+	resp, err := ut.testServer.Download(ctx, gitoidOf("testData"))
 	ut.NoError(err)
 	data, _ := io.ReadAll(resp)
 	ut.Equal("testData", string(data))
+	ut.NoError(resp.Close())
+}
+
+func (ut *UTServerSuite) Test_Download_GitoidMismatch() {
+	ctx := context.TODO()
+	ut.mockedStorerGetter.On("Get").Return(nil)
+	failures := testutil.ToFloat64(metrics.DownloadIntegrityFailures)
+
+	_, err := ut.testServer.Download(ctx, gitoidOf("tampered"))
+	ut.ErrorIs(err, api.ErrGitoidMismatch)
+	ut.Equal(failures+1, testutil.ToFloat64(metrics.DownloadIntegrityFailures))
 }
 
 func (ut *UTServerSuite) Test_Download_NoObjectStorage() {
 	ctx := context.TODO()
 	ut.testServer.objectStore = nil
 
-	_, err := ut.testServer.Download(ctx, "fakeGitoid")
+	_, err := ut.testServer.Download(ctx, gitoidOf("testData"))
 	ut.ErrorContains(err, "object store unavailable")
 }
 
@@ -322,26 +746,146 @@ func (ut *UTServerSuite) Test_Download_ObjectStorageError() {
 	ctx := context.TODO()
 	ut.mockedStorerGetter.On("Get").Return(errors.New("BAD S3")) // mock Get() to return nil
 
-	_, err := ut.testServer.Download(ctx, "fakeGitoid")
+	_, err := ut.testServer.Download(ctx, gitoidOf("testData"))
 	ut.ErrorContains(err, "BAD S3")
 }
 
 func (ut *UTServerSuite) Test_DownloadHandler() {
 	w := httptest.NewRecorder()
 	request := httptest.NewRequest(http.MethodGet, "/v1/download", nil)
-	request = mux.SetURLVars(request, map[string]string{"gitoid": "fakeGitoid"})
+	request = mux.SetURLVars(request, map[string]string{"gitoid": gitoidOf("testData")})
 
 	ut.mockedStorerGetter.On("Get").Return(nil) // mock Get() to return nil
 
 	ut.testServer.DownloadHandler(w, request)
 	ut.Equal(http.StatusOK, w.Code)
 	ut.Equal("testData", w.Body.String())
+	ut.Equal("8", w.Header().Get("Content-Length"))
+}
+
+func (ut *UTServerSuite) Test_DownloadHandler_GitoidMismatch() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/v1/download", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": gitoidOf("tampered")})
+
+	ut.mockedStorerGetter.On("Get").Return(nil)
+
+	ut.testServer.DownloadHandler(w, request)
+	ut.Equal(http.StatusInternalServerError, w.Code)
+	ut.Contains(w.Body.String(), api.ErrGitoidMismatch.Error())
+	ut.NotContains(w.Body.String(), "testData")
+}
+
+func (ut *UTServerSuite) Test_DownloadHandler_CacheHeaders() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/v1/download", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": gitoidOf("testData")})
+
+	ut.mockedStorerGetter.On("Get").Return(nil)
+
+	ut.testServer.DownloadHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	ut.Equal(`"`+gitoidOf("testData")+`"`, w.Header().Get("ETag"))
+	ut.Equal("public, max-age=31536000, immutable", w.Header().Get("Cache-Control"))
+	ut.Empty(w.Header().Values("Vary"))
+	ut.Equal("application/json", w.Header().Get("Content-Type"))
+}
+
+func (ut *UTServerSuite) Test_DownloadHandler_CacheHeadersAuthenticated() {
+	tokens, err := auth.NewTokenAuthenticator(auth.Token{Token: "ci-token", Subject: "ci"})
+	ut.Require().NoError(err)
+	a, err := auth.New(auth.WithAuthenticators(tokens))
+	ut.Require().NoError(err)
+
+	ut.testServer, err = New(new(config.Config), WithObjectStore(&TenantRecorder{}), WithAuth(a), WithTenancy(""))
+	ut.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/download/"+gitoidOf("{}"), nil)
+	req.Header.Set("Authorization", "Bearer ci-token")
+	w := httptest.NewRecorder()
+	ut.testServer.Router().ServeHTTP(w, req)
+	ut.Equal(http.StatusOK, w.Code)
+	ut.Equal("private, max-age=31536000, immutable", w.Header().Get("Cache-Control"))
+	ut.Equal([]string{"Authorization", api.TenantHeader}, w.Header().Values("Vary"))
+}
+
+func (ut *UTServerSuite) Test_DownloadHandler_IfNoneMatch() {
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		status      int
+		body        string
+	}{
+		{"matching etag", `"` + gitoidOf("testData") + `"`, http.StatusNotModified, ""},
+		{"one of many", `"other", W/"` + gitoidOf("testData") + `"`, http.StatusNotModified, ""},
+		{"any", "*", http.StatusNotModified, ""},
+		{"other etag", `"other"`, http.StatusOK, "testData"},
+	}
+
+	ut.mockedStorerGetter.On("Get").Return(nil)
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/v1/download", nil)
+		request = mux.SetURLVars(request, map[string]string{"gitoid": gitoidOf("testData")})
+		request.Header.Set("If-None-Match", test.ifNoneMatch)
+
+		ut.testServer.DownloadHandler(w, request)
+		ut.Equal(test.status, w.Code, test.name)
+		ut.Equal(test.body, w.Body.String(), test.name)
+	}
+}
+
+func (ut *UTServerSuite) Test_DownloadHandler_Head() {
+	objectStore := new(ExistingStorerGetterMock)
+	ut.testServer.objectStore = objectStore
+
+	objectStore.On("Exists").Return(true, nil).Once()
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodHead, "/v1/download", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": "fakeGitoid"})
+	ut.testServer.DownloadHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	ut.Equal(`"fakeGitoid"`, w.Header().Get("ETag"))
+	ut.Empty(w.Body.String())
+
+	objectStore.On("Exists").Return(false, nil).Once()
+	w = httptest.NewRecorder()
+	ut.testServer.DownloadHandler(w, request)
+	ut.Equal(http.StatusNotFound, w.Code)
+	objectStore.AssertNotCalled(ut.T(), "Get")
+}
+
+func (ut *UTServerSuite) Test_DownloadHandler_Head_NoExister() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodHead, "/v1/download", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": gitoidOf("testData")})
+
+	ut.mockedStorerGetter.On("Get").Return(nil)
+
+	ut.testServer.DownloadHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	ut.Equal("8", w.Header().Get("Content-Length"))
+	ut.Empty(w.Body.String())
+}
+
+func (ut *UTServerSuite) Test_DownloadHandler_Range() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/v1/download", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": gitoidOf("testData")})
+	request.Header.Set("Range", "bytes=4-")
+
+	ut.mockedStorerGetter.On("Get").Return(nil)
+
+	ut.testServer.DownloadHandler(w, request)
+	ut.Equal(http.StatusPartialContent, w.Code)
+	ut.Equal("bytes 4-7/8", w.Header().Get("Content-Range"))
+	ut.Equal("Data", w.Body.String())
 }
 
 func (ut *UTServerSuite) Test_DownloadHandler_BadMethod() {
 	w := httptest.NewRecorder()
 	request := httptest.NewRequest(http.MethodPost, "/v1/download", nil)
-	request = mux.SetURLVars(request, map[string]string{"gitoid": "fakeGitoid"})
+	request = mux.SetURLVars(request, map[string]string{"gitoid": gitoidOf("testData")})
 
 	ut.mockedStorerGetter.On("Get").Return(nil) // mock Get() to return nil
 
@@ -376,7 +920,7 @@ func (ut *UTServerSuite) Test_DownloadHandler_GitOIDEmpty() {
 func (ut *UTServerSuite) Test_DownloadHandler_ObjectStorageFailed() {
 	w := httptest.NewRecorder()
 	request := httptest.NewRequest(http.MethodGet, "/v1/download", nil)
-	request = mux.SetURLVars(request, map[string]string{"gitoid": "fakeGitoid"})
+	request = mux.SetURLVars(request, map[string]string{"gitoid": gitoidOf("testData")})
 
 	ut.mockedStorerGetter.On("Get").Return(errors.New("BAD S3")) // mock Get() to return nil
 
@@ -385,10 +929,23 @@ func (ut *UTServerSuite) Test_DownloadHandler_ObjectStorageFailed() {
 	ut.Contains(w.Body.String(), "BAD S3")
 }
 
-func (ut *UTServerSuite) Test_DownloadHandler_NotFound() {
+func (ut *UTServerSuite) Test_DownloadHandler_ObjectNotFound() {
+	w := httptest.NewRecorder()
 	request := httptest.NewRequest(http.MethodGet, "/v1/download", nil)
 	request = mux.SetURLVars(request, map[string]string{"gitoid": "fakeGitoid"})
 
+	ut.mockedStorerGetter.On("Get").Return(fmt.Errorf("%w: fakeGitoid", objectstorage.ErrNotFound))
+
+	ut.testServer.DownloadHandler(w, request)
+	ut.Equal(http.StatusNotFound, w.Code)
+	ut.Equal("application/json", w.Header().Get("Content-Type"))
+	ut.JSONEq(`{"error":"not_found","message":"object not found: fakeGitoid"}`, w.Body.String())
+}
+
+func (ut *UTServerSuite) Test_DownloadHandler_NotFound() {
+	request := httptest.NewRequest(http.MethodGet, "/v1/download", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": gitoidOf("testData")})
+
 	ut.mockedStorerGetter.On("Get").Return(nil) // mock Get() to return nil
 	ut.mockedResposeRecorder.On("Write").Return(404, errors.New("Not Found"))
 
@@ -397,6 +954,106 @@ func (ut *UTServerSuite) Test_DownloadHandler_NotFound() {
 	ut.Nil(ut.mockedResposeRecorder.Body)
 }
 
+func gitoidOf(content string) string {
+	gid, _ := gitoid.New(strings.NewReader(content), gitoid.WithContentLength(int64(len(content))), gitoid.WithSha256())
+	return gid.String()
+}
+
+func (ut *UTServerSuite) Test_Delete() {
+	metadataStore := new(DeletingStorerMock)
+	metadataStore.On("Exists").Return(true, nil)
+	metadataStore.On("Delete").Return(nil)
+	objectStore := new(DeletingStorerGetterMock)
+	objectStore.On("Delete").Return(nil)
+	ut.testServer.metadataStore = metadataStore
+	ut.testServer.objectStore = objectStore
+
+	ut.NoError(ut.testServer.Delete(context.TODO(), "fakeGitoid"))
+	metadataStore.AssertExpectations(ut.T())
+	objectStore.AssertExpectations(ut.T())
+}
+
+func (ut *UTServerSuite) Test_Delete_ObjectStorageFailed() {
+	metadataStore := new(DeletingStorerMock)
+	metadataStore.On("Exists").Return(true, nil)
+	metadataStore.On("Delete").Return(nil)
+	objectStore := new(DeletingStorerGetterMock)
+	objectStore.On("Delete").Return(errors.New("BAD S3"))
+	ut.testServer.metadataStore = metadataStore
+	ut.testServer.objectStore = objectStore
+
+	err := ut.testServer.Delete(context.TODO(), "fakeGitoid")
+	ut.ErrorContains(err, "BAD S3")
+	ut.ErrorContains(err, "delete it again to retry")
+	metadataStore.AssertExpectations(ut.T())
+}
+
+func (ut *UTServerSuite) Test_Delete_Orphaned() {
+	metadataStore := new(DeletingStorerMock)
+	metadataStore.On("Exists").Return(false, nil)
+	objectStore := new(DeletingExistingStorerGetterMock)
+	objectStore.On("Exists").Return(true, nil)
+	objectStore.On("Delete").Return(nil)
+	ut.testServer.metadataStore = metadataStore
+	ut.testServer.objectStore = objectStore
+
+	ut.NoError(ut.testServer.Delete(context.TODO(), "fakeGitoid"))
+	objectStore.AssertExpectations(ut.T())
+	metadataStore.AssertNotCalled(ut.T(), "Delete")
+}
+
+func (ut *UTServerSuite) Test_Delete_HeldDuringDelete() {
+	// a hold placed after the first check is caught by the metadata store's transaction
+	metadataStore := new(DeletingStorerMock)
+	metadataStore.On("Exists").Return(true, nil)
+	metadataStore.On("Delete").Return(fmt.Errorf("%w: fakeGitoid", metadatastorage.ErrHeld))
+	objectStore := new(DeletingStorerGetterMock)
+	ut.testServer.metadataStore = metadataStore
+	ut.testServer.objectStore = objectStore
+
+	ut.ErrorIs(ut.testServer.Delete(context.TODO(), "fakeGitoid"), metadatastorage.ErrHeld)
+	objectStore.AssertNotCalled(ut.T(), "Delete")
+}
+
+func (ut *UTServerSuite) Test_DeleteHandler() {
+	metadataStore := new(DeletingStorerMock)
+	metadataStore.On("Exists").Return(true, nil)
+	metadataStore.On("Delete").Return(nil)
+	ut.testServer.metadataStore = metadataStore
+
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodDelete, "/v1/delete/fakeGitoid", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": "fakeGitoid"})
+
+	ut.testServer.DeleteHandler(w, request)
+	ut.Equal(http.StatusOK, w.Code)
+	ut.JSONEq(`{"gitoid":"fakeGitoid"}`, w.Body.String())
+}
+
+func (ut *UTServerSuite) Test_DeleteHandler_NotFound() {
+	metadataStore := new(DeletingStorerMock)
+	metadataStore.On("Exists").Return(false, nil)
+	ut.testServer.metadataStore = metadataStore
+
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodDelete, "/v1/delete/fakeGitoid", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": "fakeGitoid"})
+
+	ut.testServer.DeleteHandler(w, request)
+	ut.Equal(http.StatusNotFound, w.Code)
+	metadataStore.AssertNotCalled(ut.T(), "Delete")
+}
+
+func (ut *UTServerSuite) Test_DeleteHandler_BadMethod() {
+	w := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/v1/delete/fakeGitoid", nil)
+	request = mux.SetURLVars(request, map[string]string{"gitoid": "fakeGitoid"})
+
+	ut.testServer.DeleteHandler(w, request)
+	ut.Equal(http.StatusBadRequest, w.Code)
+	ut.Contains(w.Body.String(), "GET is an unsupported method")
+}
+
 func (ut *UTServerSuite) Test_AllArtifactsHandler() {
 	w := httptest.NewRecorder()
 	request := httptest.NewRequest(http.MethodGet, "/v1/artifacts", nil)
@@ -21,17 +21,28 @@ package server
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/in-toto/archivista/pkg/artifactstore"
+	"github.com/in-toto/archivista/pkg/auth"
 	"github.com/in-toto/archivista/pkg/config"
 	"github.com/in-toto/archivista/pkg/metadatastorage/sqlstore"
-	"github.com/in-toto/archivista/pkg/objectstorage/blobstore"
-	"github.com/in-toto/archivista/pkg/objectstorage/filestore"
+	"github.com/in-toto/archivista/pkg/objectstorage"
+	"github.com/in-toto/archivista/pkg/objectstorage/encryption"
+	// the object store backends register themselves with objectstorage when imported
+	_ "github.com/in-toto/archivista/pkg/objectstorage/azureblob"
+	_ "github.com/in-toto/archivista/pkg/objectstorage/blobstore"
+	_ "github.com/in-toto/archivista/pkg/objectstorage/filestore"
+	_ "github.com/in-toto/archivista/pkg/objectstorage/gcsstore"
 	"github.com/in-toto/archivista/pkg/publisherstore"
-	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/in-toto/archivista/pkg/publisherstore/outbox"
+	"github.com/in-toto/archivista/pkg/publisherstore/rstuf"
+	"github.com/in-toto/archivista/pkg/retention"
+	"github.com/in-toto/archivista/pkg/spiffe"
+	"github.com/in-toto/archivista/pkg/verifier"
 	"github.com/sirupsen/logrus"
 )
 
@@ -39,16 +50,20 @@ import (
 type Service interface {
 	Setup() (Server, error)
 	GetConfig() *config.Config
-	GetFileStoreCh() chan error
+	Close() error
 	GetSQLStoreCh() chan error
+	GetPublisherCh() chan error
+	GetRetentionCh() chan error
 }
 
 // ArchivistaService is the implementation of the Archivista service
 type ArchivistaService struct {
 	Ctx         context.Context // context for the service
 	Cfg         *config.Config  // configuration for the service (if none it uses environment variables)
-	fileStoreCh <-chan error
+	objectStore objectstorage.Store
 	sqlStoreCh  <-chan error
+	publisherCh <-chan error
+	retentionCh <-chan error
 }
 
 // Setup Archivista Service
@@ -59,6 +74,9 @@ func (a *ArchivistaService) Setup() (*Server, error) {
 		sqlStore       *sqlstore.Store
 		fileStore      StorerGetter
 		publisherStore []publisherstore.Publisher
+		publishers     map[string]publisherstore.Publisher
+		publisherOpts  []publisherstore.Option
+		rstufTasks     *rstuf.EntTaskStore
 	)
 	serverOpts := make([]Option, 0)
 
@@ -91,10 +109,17 @@ func (a *ArchivistaService) Setup() (*Server, error) {
 	logrus.Infof("executing phase: initializing storage clients (time since start: %s)", time.Since(startTime))
 	// ********************************************************************************
 	now = time.Now()
-	fileStore, a.fileStoreCh, err = a.initObjectStore()
+	if a.Cfg.FileServeOn != "" {
+		logrus.Warn("ARCHIVISTA_FILE_SERVE_ON is no longer supported; set ARCHIVISTA_ENABLE_FILE_MIRROR to serve envelopes on /v1/files instead")
+	}
+
+	a.objectStore, err = a.initObjectStore()
 	if err != nil {
 		logrus.Fatalf("could not create object store: %+v", err)
 	}
+	if a.objectStore != nil {
+		fileStore = a.objectStore
+	}
 	serverOpts = append(serverOpts, WithObjectStore(fileStore))
 
 	if a.Cfg.EnableSQLStore {
@@ -109,8 +134,31 @@ func (a *ArchivistaService) Setup() (*Server, error) {
 			logrus.Fatalf("could not create ent client: %+v", err)
 		}
 
+		// RSTUF publishes asynchronously, so record its tasks and follow them to completion
+		for _, pubType := range a.Cfg.Publisher {
+			if strings.EqualFold(pubType, rstuf.PublisherName) {
+				rstufTasks = rstuf.NewEntTaskStore(entClient)
+				publisherOpts = append(publisherOpts, publisherstore.WithRSTUFTaskStore(rstufTasks))
+				break
+			}
+		}
+
+		// deliveries to publishers are recorded alongside the metadata and sent in the background,
+		// which needs the object store to load the envelope from when it's time to publish
+		sqlStoreOpts := make([]sqlstore.Option, 0)
+		if len(a.Cfg.Publisher) > 0 && fileStore != nil {
+			publishers = publisherstore.NewNamed(a.Cfg, publisherOpts...)
+			for name := range publishers {
+				sqlStoreOpts = append(sqlStoreOpts, sqlstore.WithPublishers(name))
+			}
+		}
+
+		if a.Cfg.EnableMultiTenancy {
+			sqlStoreOpts = append(sqlStoreOpts, sqlstore.WithTenancy())
+		}
+
 		// Continue with the existing setup code for the SQLStore
-		sqlStore, a.sqlStoreCh, err = sqlstore.New(context.Background(), entClient)
+		sqlStore, a.sqlStoreCh, err = sqlstore.New(context.Background(), entClient, sqlStoreOpts...)
 		if err != nil {
 			logrus.Fatalf("error initializing new SQLStore: %+v", err)
 		}
@@ -138,23 +186,111 @@ func (a *ArchivistaService) Setup() (*Server, error) {
 		serverOpts = append(serverOpts, WithArtifactStore(wds))
 	}
 
-	if a.Cfg.Publisher != nil {
-		publisherStore = publisherstore.New(a.Cfg)
-		serverOpts = append(serverOpts, WithPublishers(publisherStore))
+	serverOpts = append(serverOpts, WithMaxUploadSize(a.Cfg.MaxUploadSize))
+	serverOpts = append(serverOpts, WithUploadBatchConcurrency(a.Cfg.UploadBatchConcurrency))
+
+	if a.Cfg.EnableSignatureVerification {
+		v, err := verifier.New(
+			verifier.WithKeyFiles(a.Cfg.SignatureVerificationKeys...),
+			verifier.WithRootFiles(a.Cfg.SignatureVerificationRoots...),
+			verifier.WithIntermediateFiles(a.Cfg.SignatureVerificationIntermediates...),
+		)
+		if err != nil {
+			logrus.Fatalf("could not create the signature verifier: %+v", err)
+		}
+
+		serverOpts = append(serverOpts, WithVerifier(v))
+	}
+
+	if a.Cfg.EnableAuth {
+		authenticator, err := a.initAuth()
+		if err != nil {
+			logrus.Fatalf("could not create the authenticator: %+v", err)
+		}
+
+		serverOpts = append(serverOpts, WithAuth(authenticator))
 	}
+
+	if a.Cfg.EnableMultiTenancy {
+		serverOpts = append(serverOpts, WithTenancy(a.Cfg.TenantHeader))
+	}
+
+	publisherChs := make([]<-chan error, 0)
+	if len(publishers) > 0 {
+		worker := outbox.New(sqlStore.GetClient(), fileStore, publishers,
+			outbox.WithWorkers(a.Cfg.PublisherWorkers),
+			outbox.WithPollInterval(a.Cfg.PublisherPollInterval),
+			outbox.WithMaxAttempts(a.Cfg.PublisherMaxAttempts),
+			outbox.WithBackoff(a.Cfg.PublisherRetryInitialBackoff, a.Cfg.PublisherRetryMaxBackoff),
+		)
+		publisherChs = append(publisherChs, worker.Start(a.Ctx))
+	} else {
+		if a.Cfg.Publisher != nil {
+			logrus.Warn("publishing synchronously without retries; enable both the SQL store and a storage backend to publish in the background")
+			publisherStore = publisherstore.New(a.Cfg, publisherOpts...)
+			serverOpts = append(serverOpts, WithPublishers(publisherStore))
+		}
+
+		publisherChan := make(chan error)
+		publisherChs = append(publisherChs, publisherChan)
+		go func() {
+			<-a.Ctx.Done()
+			close(publisherChan)
+		}()
+	}
+
+	if rstufTasks != nil {
+		monitor := rstuf.NewMonitor(a.Cfg.PublisherRstufHost, rstufTasks,
+			rstuf.WithPollInterval(a.Cfg.PublisherRstufPollInterval),
+			rstuf.WithTaskTimeout(a.Cfg.PublisherRstufTaskTimeout),
+		)
+		publisherChs = append(publisherChs, monitor.Start(a.Ctx))
+	}
+	a.publisherCh = mergeErrChs(publisherChs...)
+
 	// Create the Archivista server with all options
 	server, err := New(a.Cfg, serverOpts...)
 	if err != nil {
 		logrus.Fatalf("could not create archivista server: %+v", err)
 	}
 
+	if a.Cfg.EnableRetention {
+		if sqlStore == nil {
+			logrus.Fatal("retention requires the SQL store to be enabled")
+		}
+
+		rules, err := retention.LoadRules(a.Cfg.RetentionRulesFile)
+		if err != nil {
+			logrus.Fatalf("could not load retention rules: %+v", err)
+		}
+
+		sweeper, err := retention.New(sqlStore.GetClient(), &server, rules, retention.WithInterval(a.Cfg.RetentionSweepInterval))
+		if err != nil {
+			logrus.Fatalf("could not create the retention sweeper: %+v", err)
+		}
+
+		a.retentionCh = sweeper.Start(a.Ctx)
+	} else {
+		retentionChan := make(chan error)
+		a.retentionCh = retentionChan
+		go func() {
+			<-a.Ctx.Done()
+			close(retentionChan)
+		}()
+	}
+
 	logrus.WithField("duration", time.Since(now)).Infof("completed phase: initializing storage clients")
 	return &server, nil
 }
 
-// GetFileStoreCh returns the file store channel
-func (a *ArchivistaService) GetFileStoreCh() <-chan error {
-	return a.fileStoreCh
+// Close closes the object store. It is called once the server, publishers and retention
+// sweeper have stopped using it.
+func (a *ArchivistaService) Close() error {
+	if a.objectStore == nil {
+		return nil
+	}
+
+	return a.objectStore.Close()
 }
 
 // GetSQLStoreCh returns the SQL store channel
@@ -162,39 +298,102 @@ func (a *ArchivistaService) GetSQLStoreCh() <-chan error {
 	return a.sqlStoreCh
 }
 
-func (a *ArchivistaService) initObjectStore() (StorerGetter, <-chan error, error) {
-	switch strings.ToUpper(a.Cfg.StorageBackend) {
-	case "FILE":
-		return filestore.New(a.Ctx, a.Cfg.FileDir, a.Cfg.FileServeOn)
-
-	case "BLOB":
-		var creds *credentials.Credentials
-
-		switch a.Cfg.BlobStoreCredentialType {
-		case "IAM":
-			creds = credentials.NewIAM("")
-		case "ACCESS_KEY":
-			creds = credentials.NewStaticV4(a.Cfg.BlobStoreAccessKeyId, a.Cfg.BlobStoreSecretAccessKeyId, "")
-		default:
-			logrus.Fatalf("invalid blob store credential type: %s", a.Cfg.BlobStoreCredentialType)
-		}
-		return blobstore.New(
-			a.Ctx,
-			a.Cfg.BlobStoreEndpoint,
-			creds,
-			a.Cfg.BlobStoreBucketName,
-			a.Cfg.BlobStoreUseTLS,
+// GetPublisherCh returns the publisher channel, which is closed once in flight deliveries finish
+func (a *ArchivistaService) GetPublisherCh() <-chan error {
+	return a.publisherCh
+}
+
+// GetRetentionCh returns the retention channel, which is closed once the sweep in progress finishes
+func (a *ArchivistaService) GetRetentionCh() <-chan error {
+	return a.retentionCh
+}
+
+func (a *ArchivistaService) initAuth() (*auth.Auth, error) {
+	authenticators := make([]auth.Authenticator, 0)
+	if a.Cfg.EnableSPIFFE {
+		authenticators = append(authenticators, spiffe.NewAuthenticator())
+	}
+
+	if len(a.Cfg.AuthClientCAFiles) > 0 {
+		if !a.Cfg.EnableTLS {
+			return nil, errors.New("client certificate authentication requires TLS to be enabled")
+		}
+
+		authenticators = append(authenticators, auth.NewMTLSAuthenticator())
+	}
+
+	if a.Cfg.AuthTokensFile != "" {
+		tokens, err := auth.NewTokenAuthenticatorFromFile(a.Cfg.AuthTokensFile)
+		if err != nil {
+			return nil, err
+		}
+
+		authenticators = append(authenticators, tokens)
+	}
+
+	if a.Cfg.AuthJWKSFile != "" {
+		jwts, err := auth.NewJWTAuthenticatorFromFile(
+			a.Cfg.AuthJWKSFile,
+			auth.WithIssuer(a.Cfg.AuthJWTIssuer),
+			auth.WithAudience(a.Cfg.AuthJWTAudience),
+			auth.WithRolesClaim(a.Cfg.AuthJWTRolesClaim),
+			auth.WithTenantClaim(a.Cfg.AuthJWTTenantClaim),
 		)
+		if err != nil {
+			return nil, err
+		}
 
-	case "":
-		errCh := make(chan error)
-		go func() {
-			<-a.Ctx.Done()
-			close(errCh)
-		}()
-		return nil, errCh, nil
+		authenticators = append(authenticators, jwts)
+	}
 
-	default:
-		return nil, nil, fmt.Errorf("unknown storage backend: %s", a.Cfg.StorageBackend)
+	return auth.New(
+		auth.WithAuthenticators(authenticators...),
+		auth.WithPolicy(auth.Policy{
+			auth.PermissionUpload:    a.Cfg.AuthUploadRoles,
+			auth.PermissionDownload:  a.Cfg.AuthDownloadRoles,
+			auth.PermissionQuery:     a.Cfg.AuthQueryRoles,
+			auth.PermissionArtifacts: a.Cfg.AuthArtifactRoles,
+			auth.PermissionDelete:    a.Cfg.AuthDeleteRoles,
+			auth.PermissionHold:      a.Cfg.AuthHoldRoles,
+		}),
+	)
+}
+
+// mergeErrChs forwards errors from each channel and closes the returned channel once all of them are closed
+func mergeErrChs(chs ...<-chan error) <-chan error {
+	merged := make(chan error)
+	wg := &sync.WaitGroup{}
+	for _, ch := range chs {
+		wg.Add(1)
+		go func(ch <-chan error) {
+			defer wg.Done()
+			for err := range ch {
+				merged <- err
+			}
+		}(ch)
 	}
+
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+
+	return merged
+}
+
+func (a *ArchivistaService) initObjectStore() (objectstorage.Store, error) {
+	if a.Cfg.StorageBackend == "" {
+		return nil, nil
+	}
+
+	store, err := encryption.NewFromConfig(a.Ctx, a.Cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.Start(a.Ctx); err != nil {
+		return nil, err
+	}
+
+	return store, nil
 }
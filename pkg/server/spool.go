@@ -0,0 +1,132 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/edwarnicke/gitoid"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrUploadTooLarge is returned when an upload is larger than the configured maximum upload size
+var ErrUploadTooLarge = errors.New("upload exceeds the maximum allowed size")
+
+// spool buffers an upload on disk so it can be hashed and handed to each store
+// without holding the whole envelope in memory.
+type spool struct {
+	file   *os.File
+	size   int64
+	gitoid string
+}
+
+// newSpool copies r to a temporary file and calculates its gitoid. contentLength
+// may be -1 if the size of the upload is not known ahead of time. A maxSize of 0
+// or less disables the size limit.
+func newSpool(r io.Reader, contentLength int64, maxSize int64) (*spool, error) {
+	if maxSize > 0 {
+		if contentLength > maxSize {
+			return nil, ErrUploadTooLarge
+		}
+
+		// read one byte past the limit so we can tell an upload of exactly maxSize from a larger one
+		r = io.LimitReader(r, maxSize+1)
+	}
+
+	file, err := os.CreateTemp("", "archivista-upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("could not create upload spool: %w", err)
+	}
+
+	sp := &spool{file: file}
+	if err := sp.fill(r, contentLength, maxSize); err != nil {
+		sp.Close()
+		return nil, err
+	}
+
+	return sp, nil
+}
+
+func (sp *spool) fill(r io.Reader, contentLength int64, maxSize int64) error {
+	if contentLength > 0 {
+		// the gitoid header includes the length, so when it's known up front the
+		// gitoid can be calculated while the upload is written to disk.
+		gid, err := gitoid.New(io.TeeReader(r, sp.file), gitoid.WithContentLength(contentLength), gitoid.WithSha256())
+		if err != nil {
+			return fmt.Errorf("failed to generate gitoid: %w", err)
+		}
+
+		sp.size = contentLength
+		sp.gitoid = gid.String()
+		return nil
+	}
+
+	size, err := io.Copy(sp.file, r)
+	if err != nil {
+		return err
+	}
+
+	if maxSize > 0 && size > maxSize {
+		return ErrUploadTooLarge
+	}
+
+	sp.size = size
+	gid, err := gitoid.New(sp.Reader(), gitoid.WithContentLength(size), gitoid.WithSha256())
+	if err != nil {
+		return fmt.Errorf("failed to generate gitoid: %w", err)
+	}
+
+	sp.gitoid = gid.String()
+	return nil
+}
+
+// Reader returns a new reader over the full upload. Readers are independent of each other.
+func (sp *spool) Reader() *io.SectionReader {
+	return io.NewSectionReader(sp.file, 0, sp.size)
+}
+
+// Bytes reads the full upload into memory for consumers that can not stream.
+func (sp *spool) Bytes() ([]byte, error) {
+	return io.ReadAll(sp.Reader())
+}
+
+func (sp *spool) Close() {
+	if err := sp.file.Close(); err != nil {
+		logrus.Errorf("failed to close upload spool %s: %+v", sp.file.Name(), err)
+	}
+
+	if err := os.Remove(sp.file.Name()); err != nil {
+		logrus.Errorf("failed to remove upload spool %s: %+v", sp.file.Name(), err)
+	}
+}
+
+// spoolReadCloser reads a spool and removes it once closed. It can seek so ranges of the
+// envelope can be served.
+type spoolReadCloser struct {
+	*io.SectionReader
+	spool *spool
+}
+
+func (rc *spoolReadCloser) Size() int64 {
+	return rc.spool.size
+}
+
+func (rc *spoolReadCloser) Close() error {
+	rc.spool.Close()
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/edwarnicke/gitoid"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT Spool
+type UTSpoolSuite struct {
+	suite.Suite
+	payload []byte
+	gitoid  string
+}
+
+func TestUTSpoolSuite(t *testing.T) {
+	suite.Run(t, new(UTSpoolSuite))
+}
+
+func (ut *UTSpoolSuite) SetupTest() {
+	ut.payload = []byte(`{"payloadType":"test","payload":"","signatures":[]}`)
+	gid, err := gitoid.New(bytes.NewReader(ut.payload), gitoid.WithContentLength(int64(len(ut.payload))), gitoid.WithSha256())
+	ut.Require().NoError(err)
+	ut.gitoid = gid.String()
+}
+
+func (ut *UTSpoolSuite) Test_KnownLength() {
+	sp, err := newSpool(bytes.NewReader(ut.payload), int64(len(ut.payload)), 0)
+	ut.Require().NoError(err)
+	defer sp.Close()
+
+	ut.Equal(ut.gitoid, sp.gitoid)
+	ut.Equal(int64(len(ut.payload)), sp.size)
+	data, err := sp.Bytes()
+	ut.NoError(err)
+	ut.Equal(ut.payload, data)
+}
+
+func (ut *UTSpoolSuite) Test_UnknownLength() {
+	sp, err := newSpool(bytes.NewReader(ut.payload), -1, 0)
+	ut.Require().NoError(err)
+	defer sp.Close()
+
+	ut.Equal(ut.gitoid, sp.gitoid)
+
+	// readers are independent of each other
+	first, err := io.ReadAll(sp.Reader())
+	ut.NoError(err)
+	second, err := io.ReadAll(sp.Reader())
+	ut.NoError(err)
+	ut.Equal(ut.payload, first)
+	ut.Equal(ut.payload, second)
+}
+
+func (ut *UTSpoolSuite) Test_ShortBody() {
+	_, err := newSpool(bytes.NewReader(ut.payload), int64(len(ut.payload)+10), 0)
+	ut.ErrorIs(err, io.ErrUnexpectedEOF)
+}
+
+func (ut *UTSpoolSuite) Test_TooLarge() {
+	_, err := newSpool(bytes.NewReader(ut.payload), int64(len(ut.payload)), 10)
+	ut.ErrorIs(err, ErrUploadTooLarge)
+
+	_, err = newSpool(bytes.NewReader(ut.payload), -1, 10)
+	ut.ErrorIs(err, ErrUploadTooLarge)
+
+	sp, err := newSpool(bytes.NewReader(ut.payload), -1, int64(len(ut.payload)))
+	ut.Require().NoError(err)
+	sp.Close()
+}
+
+func (ut *UTSpoolSuite) Test_CloseRemovesFile() {
+	sp, err := newSpool(strings.NewReader("test"), -1, 0)
+	ut.Require().NoError(err)
+
+	name := sp.file.Name()
+	sp.Close()
+	_, err = os.Stat(name)
+	ut.True(os.IsNotExist(err))
+}
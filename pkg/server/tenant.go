@@ -0,0 +1,64 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/in-toto/archivista/pkg/api"
+	"github.com/in-toto/archivista/pkg/auth"
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/sirupsen/logrus"
+)
+
+// WithTenancy scopes every request to a tenant. Callers whose identity is bound to a tenant
+// are scoped to it, and other callers choose their tenant with header, which defaults to
+// api.TenantHeader. Requests that name no tenant are scoped to tenant.Default.
+func WithTenancy(header string) Option {
+	return func(s *Server) {
+		if header == "" {
+			header = api.TenantHeader
+		}
+
+		s.tenantHeader = header
+	}
+}
+
+// scopeToTenant calls next with the request's context scoped to the caller's tenant
+func (s *Server) scopeToTenant(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested := r.Header.Get(s.tenantHeader)
+		name := requested
+		if identity, ok := auth.FromContext(r.Context()); ok && identity.Tenant != "" {
+			if requested != "" && requested != identity.Tenant {
+				logrus.Debugf("denied %s access to tenant %s", identity.Subject, requested)
+				http.Error(w, fmt.Sprintf("%s may not access tenant %s", identity.Subject, requested), http.StatusForbidden)
+				return
+			}
+
+			name = identity.Tenant
+		} else if name == "" {
+			name = tenant.Default
+		}
+
+		if err := tenant.Validate(name); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(tenant.NewContext(r.Context(), name)))
+	})
+}
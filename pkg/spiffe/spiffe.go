@@ -0,0 +1,101 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spiffe serves and calls the Archivista API over mTLS using X.509 SVIDs
+// fetched from the SPIFFE Workload API.
+package spiffe
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/in-toto/archivista/pkg/auth"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+	"github.com/spiffe/go-spiffe/v2/workloadapi"
+)
+
+// NewX509Source connects to the Workload API at address and blocks until the first SVID is
+// received. The source keeps the SVID and trust bundles up to date as they rotate, so TLS
+// configs built from it pick up new certificates without a restart.
+func NewX509Source(ctx context.Context, address string) (*workloadapi.X509Source, error) {
+	source, err := workloadapi.NewX509Source(ctx, workloadapi.WithClientOptions(workloadapi.WithAddr(address)))
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch x509 svid from workload api at %s: %w", address, err)
+	}
+
+	return source, nil
+}
+
+// Authorizer allows peers with any of the given SPIFFE IDs. If no IDs are given, any peer
+// in trustDomain is allowed.
+func Authorizer(ids []string, trustDomain spiffeid.TrustDomain) (tlsconfig.Authorizer, error) {
+	allowed := make([]spiffeid.ID, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+
+		parsed, err := spiffeid.FromString(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spiffe id %q: %w", id, err)
+		}
+
+		allowed = append(allowed, parsed)
+	}
+
+	if len(allowed) == 0 {
+		return tlsconfig.AuthorizeMemberOf(trustDomain), nil
+	}
+
+	return tlsconfig.AuthorizeOneOf(allowed...), nil
+}
+
+// ServerTLSConfig returns a TLS config that presents the source's current SVID and only
+// accepts clients that present an SVID from the source's trust bundles allowed by authorizer.
+func ServerTLSConfig(source *workloadapi.X509Source, authorizer tlsconfig.Authorizer) *tls.Config {
+	return tlsconfig.MTLSServerConfig(source, source, authorizer)
+}
+
+// ClientTLSConfig returns a TLS config that presents the source's current SVID and only
+// trusts servers allowed by authorizer.
+func ClientTLSConfig(source *workloadapi.X509Source, authorizer tlsconfig.Authorizer) *tls.Config {
+	return tlsconfig.MTLSClientConfig(source, source, authorizer)
+}
+
+// Authenticator identifies callers by the SPIFFE ID of the SVID they presented. It relies on
+// the server's TLS config to have verified the SVID, so it must only be used with a server
+// configured by ServerTLSConfig. The identity's subject and only role is its SPIFFE ID.
+type Authenticator struct{}
+
+func NewAuthenticator() *Authenticator {
+	return &Authenticator{}
+}
+
+func (a *Authenticator) Authenticate(r *http.Request) (*auth.Identity, error) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, auth.ErrNoCredentials
+	}
+
+	id, err := x509svid.IDFromCert(r.TLS.PeerCertificates[0])
+	if err != nil {
+		return nil, errors.New("client certificate is not an svid")
+	}
+
+	return &auth.Identity{Subject: id.String(), Method: "spiffe", Roles: []string{id.String()}}, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spiffe_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edwarnicke/gitoid"
+	"github.com/in-toto/archivista/pkg/auth"
+	httpclient "github.com/in-toto/archivista/pkg/http-client"
+	"github.com/in-toto/archivista/pkg/spiffe"
+	"github.com/spiffe/go-spiffe/v2/proto/spiffe/workload"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"github.com/spiffe/go-spiffe/v2/workloadapi"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+)
+
+// fakeWorkloadAPI streams X.509 SVIDs to workloads the same way a SPIRE agent does
+type fakeWorkloadAPI struct {
+	workload.UnimplementedSpiffeWorkloadAPIServer
+	updates chan *workload.X509SVIDResponse
+}
+
+func (f *fakeWorkloadAPI) FetchX509SVID(_ *workload.X509SVIDRequest, stream grpc.ServerStreamingServer[workload.X509SVIDResponse]) error {
+	for {
+		select {
+		case resp := <-f.updates:
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// Test Suite: UT SPIFFE
+type UTSPIFFESuite struct {
+	suite.Suite
+	ca          testCA
+	trustDomain spiffeid.TrustDomain
+}
+
+func TestUTSPIFFESuite(t *testing.T) {
+	suite.Run(t, new(UTSPIFFESuite))
+}
+
+func (ut *UTSPIFFESuite) SetupTest() {
+	ut.trustDomain = spiffeid.RequireTrustDomainFromString("example.org")
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ut.Require().NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "example.org"},
+		URIs:                  []*url.URL{ut.trustDomain.ID().URL()},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	ut.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	ut.Require().NoError(err)
+	ut.ca = testCA{cert: cert, key: key}
+}
+
+func (ut *UTSPIFFESuite) svid(id string, serial int64) *workload.X509SVIDResponse {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ut.Require().NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		URIs:         []*url.URL{spiffeid.RequireFromString(id).URL()},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ut.ca.cert, key.Public(), ut.ca.key)
+	ut.Require().NoError(err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	ut.Require().NoError(err)
+
+	return &workload.X509SVIDResponse{
+		Svids: []*workload.X509SVID{{
+			SpiffeId:    id,
+			X509Svid:    der,
+			X509SvidKey: keyDER,
+			Bundle:      ut.ca.cert.Raw,
+		}},
+	}
+}
+
+// startWorkloadAPI serves a fake Workload API on a unix socket and returns its address and
+// a channel that pushes SVID updates to connected workloads.
+func (ut *UTSPIFFESuite) startWorkloadAPI(initial *workload.X509SVIDResponse) (string, chan<- *workload.X509SVIDResponse) {
+	socket := filepath.Join(ut.T().TempDir(), "agent.sock")
+	listener, err := net.Listen("unix", socket)
+	ut.Require().NoError(err)
+
+	updates := make(chan *workload.X509SVIDResponse, 1)
+	updates <- initial
+	server := grpc.NewServer()
+	workload.RegisterSpiffeWorkloadAPIServer(server, &fakeWorkloadAPI{updates: updates})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	ut.T().Cleanup(server.Stop)
+
+	return "unix://" + socket, updates
+}
+
+func (ut *UTSPIFFESuite) source(id string) (*workloadapi.X509Source, chan<- *workload.X509SVIDResponse) {
+	address, updates := ut.startWorkloadAPI(ut.svid(id, 2))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	source, err := spiffe.NewX509Source(ctx, address)
+	ut.Require().NoError(err)
+	ut.T().Cleanup(func() { source.Close() })
+	return source, updates
+}
+
+// startServer serves a handler that echoes the caller's authenticated SPIFFE ID and returns
+// its URL. It is served the same way as cmd/archivista so the SVID is the only certificate.
+func (ut *UTSPIFFESuite) startServer(source *workloadapi.X509Source, authorizedIDs ...string) string {
+	authorizer, err := spiffe.Authorizer(authorizedIDs, ut.trustDomain)
+	ut.Require().NoError(err)
+
+	a, err := auth.New(auth.WithAuthenticators(spiffe.NewAuthenticator()))
+	ut.Require().NoError(err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	ut.Require().NoError(err)
+
+	server := &http.Server{
+		Handler: a.Require(auth.PermissionDownload, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			identity, _ := auth.FromContext(r.Context())
+			_, _ = w.Write([]byte(identity.Subject))
+		})),
+		TLSConfig: spiffe.ServerTLSConfig(source, authorizer),
+		ErrorLog:  log.New(io.Discard, "", 0),
+	}
+	go func() {
+		_ = server.ServeTLS(listener, "", "")
+	}()
+	ut.T().Cleanup(func() { _ = server.Close() })
+	return "https://" + listener.Addr().String()
+}
+
+func (ut *UTSPIFFESuite) Test_AuthorizedClient() {
+	serverSource, _ := ut.source("spiffe://example.org/archivista")
+	clientSource, _ := ut.source("spiffe://example.org/ci")
+	server := ut.startServer(serverSource, "spiffe://example.org/ci")
+
+	client, err := httpclient.CreateArchivistaClient(nil, server,
+		httpclient.WithSVID(clientSource, clientSource, tlsconfig.AuthorizeID(spiffeid.RequireFromString("spiffe://example.org/archivista"))),
+	)
+	ut.Require().NoError(err)
+
+	// the handler echoes the caller's SPIFFE ID, so that is the envelope the client verifies
+	want := "spiffe://example.org/ci"
+	gid, err := gitoid.New(strings.NewReader(want), gitoid.WithContentLength(int64(len(want))), gitoid.WithSha256())
+	ut.Require().NoError(err)
+	reader, err := client.DownloadReadCloser(context.TODO(), gid.String())
+	ut.Require().NoError(err)
+	defer reader.Close()
+	body, err := io.ReadAll(reader)
+	ut.NoError(err)
+	ut.Equal(want, string(body))
+}
+
+func (ut *UTSPIFFESuite) Test_UnauthorizedClient() {
+	serverSource, _ := ut.source("spiffe://example.org/archivista")
+	clientSource, _ := ut.source("spiffe://example.org/intruder")
+	server := ut.startServer(serverSource, "spiffe://example.org/ci")
+
+	client, err := httpclient.CreateArchivistaClient(nil, server,
+		httpclient.WithSVID(clientSource, clientSource, tlsconfig.AuthorizeMemberOf(ut.trustDomain)),
+	)
+	ut.Require().NoError(err)
+
+	_, err = client.DownloadReadCloser(context.TODO(), "gitoid")
+	ut.Error(err)
+}
+
+func (ut *UTSPIFFESuite) Test_ClientRejectsUnexpectedServer() {
+	serverSource, _ := ut.source("spiffe://example.org/impostor")
+	clientSource, _ := ut.source("spiffe://example.org/ci")
+	server := ut.startServer(serverSource)
+
+	client, err := httpclient.CreateArchivistaClient(nil, server,
+		httpclient.WithSVID(clientSource, clientSource, tlsconfig.AuthorizeID(spiffeid.RequireFromString("spiffe://example.org/archivista"))),
+	)
+	ut.Require().NoError(err)
+
+	_, err = client.DownloadReadCloser(context.TODO(), "gitoid")
+	ut.Error(err)
+}
+
+func (ut *UTSPIFFESuite) Test_Rotation() {
+	source, updates := ut.source("spiffe://example.org/archivista")
+	svid, err := source.GetX509SVID()
+	ut.Require().NoError(err)
+	ut.Equal(int64(2), svid.Certificates[0].SerialNumber.Int64())
+
+	updates <- ut.svid("spiffe://example.org/archivista", 3)
+	ut.Eventually(func() bool {
+		svid, err := source.GetX509SVID()
+		return err == nil && svid.Certificates[0].SerialNumber.Int64() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (ut *UTSPIFFESuite) Test_Authorizer_InvalidID() {
+	_, err := spiffe.Authorizer([]string{"not-a-spiffe-id"}, ut.trustDomain)
+	ut.ErrorContains(err, "invalid spiffe id")
+}
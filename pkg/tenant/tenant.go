@@ -0,0 +1,71 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenant carries the tenant a request is scoped to. Metadata and objects stored
+// while a tenant is in the context belong to that tenant, and only that tenant's data is
+// visible to queries made with it.
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Default is the tenant of data stored without a tenant, including everything stored
+// before multi-tenancy was enabled.
+const Default = "default"
+
+// ErrInvalid is returned for tenant names that can not be used
+var ErrInvalid = errors.New("invalid tenant")
+
+// names are used as object key prefixes, so they are restricted to characters that are
+// safe in paths and bucket keys.
+var nameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9._-]{0,61}[a-z0-9])?$`)
+
+// Validate returns ErrInvalid if name can not be used as a tenant.
+func Validate(name string) error {
+	if !nameRegexp.MatchString(name) {
+		return fmt.Errorf("%w %q: must be 1-63 lowercase letters, digits, '.', '_' or '-' and start and end with a letter or digit", ErrInvalid, name)
+	}
+
+	return nil
+}
+
+type tenantKey struct{}
+
+// NewContext returns a copy of ctx scoped to tenant.
+func NewContext(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// FromContext returns the tenant ctx is scoped to. Contexts without a tenant are not
+// scoped, which lets background workers operate on every tenant's data.
+func FromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok && tenant != ""
+}
+
+// ObjectKey returns the key an envelope is stored under in an object store. Envelopes of
+// the default tenant keep the key they had before multi-tenancy, and other tenants' are
+// prefixed by the tenant.
+func ObjectKey(ctx context.Context, gitoid string) string {
+	tenant, ok := FromContext(ctx)
+	if !ok || tenant == Default {
+		return gitoid
+	}
+
+	return tenant + "/" + gitoid
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/tenant"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT Tenant
+type UTTenantSuite struct {
+	suite.Suite
+}
+
+func TestUTTenantSuite(t *testing.T) {
+	suite.Run(t, new(UTTenantSuite))
+}
+
+func (ut *UTTenantSuite) Test_Validate() {
+	for _, name := range []string{"default", "team-a", "team_b.prod", "a", "0"} {
+		ut.NoError(tenant.Validate(name), name)
+	}
+
+	for _, name := range []string{"", "Team", "team/a", "..", "-team", "team-", "team a"} {
+		ut.ErrorIs(tenant.Validate(name), tenant.ErrInvalid, name)
+	}
+}
+
+func (ut *UTTenantSuite) Test_Context() {
+	_, ok := tenant.FromContext(context.Background())
+	ut.False(ok)
+
+	_, ok = tenant.FromContext(tenant.NewContext(context.Background(), ""))
+	ut.False(ok)
+
+	name, ok := tenant.FromContext(tenant.NewContext(context.Background(), "team-a"))
+	ut.True(ok)
+	ut.Equal("team-a", name)
+}
+
+func (ut *UTTenantSuite) Test_ObjectKey() {
+	ut.Equal("gitoid", tenant.ObjectKey(context.Background(), "gitoid"))
+	ut.Equal("gitoid", tenant.ObjectKey(tenant.NewContext(context.Background(), tenant.Default), "gitoid"))
+	ut.Equal("team-a/gitoid", tenant.ObjectKey(tenant.NewContext(context.Background(), "team-a"), "gitoid"))
+}
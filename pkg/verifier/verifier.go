@@ -0,0 +1,227 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package verifier checks the signatures on uploaded DSSE envelopes against a
+// configured set of trusted public keys and X.509 roots.
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/in-toto/go-witness/cryptoutil"
+	"github.com/in-toto/go-witness/dsse"
+)
+
+// ErrUntrustedEnvelope is returned when none of an envelope's signatures can be
+// verified against the trust root.
+type ErrUntrustedEnvelope struct {
+	Reason error
+}
+
+func (e ErrUntrustedEnvelope) Error() string {
+	return fmt.Sprintf("envelope is not signed by a trusted key: %v", e.Reason)
+}
+
+func (e ErrUntrustedEnvelope) Unwrap() error {
+	return e.Reason
+}
+
+// Result records which of an envelope's signatures were verified. Signatures is
+// indexed the same as the envelope's signatures.
+type Result struct {
+	Signatures []bool
+}
+
+// SignatureVerified reports whether the signature at index i was verified.
+func (r Result) SignatureVerified(i int) bool {
+	return i >= 0 && i < len(r.Signatures) && r.Signatures[i]
+}
+
+type resultKey struct{}
+
+// NewContext returns a copy of ctx that carries the verification result.
+func NewContext(ctx context.Context, result Result) context.Context {
+	return context.WithValue(ctx, resultKey{}, result)
+}
+
+// FromContext returns the verification result stored in ctx, if any.
+func FromContext(ctx context.Context) (Result, bool) {
+	result, ok := ctx.Value(resultKey{}).(Result)
+	return result, ok
+}
+
+type Verifier struct {
+	verifiers     []cryptoutil.Verifier
+	roots         []*x509.Certificate
+	intermediates []*x509.Certificate
+}
+
+type Option func(*Verifier) error
+
+// WithKeyFiles trusts the PEM encoded public keys found in each of the files.
+func WithKeyFiles(paths ...string) Option {
+	return func(v *Verifier) error {
+		for _, path := range paths {
+			keyBytes, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("could not read trusted key %s: %w", path, err)
+			}
+
+			verifier, err := cryptoutil.NewVerifierFromReader(bytes.NewReader(keyBytes))
+			if err != nil {
+				return fmt.Errorf("could not load trusted key %s: %w", path, err)
+			}
+
+			v.verifiers = append(v.verifiers, verifier)
+		}
+
+		return nil
+	}
+}
+
+// WithRootFiles trusts certificates that chain to any of the PEM encoded root
+// certificates found in each of the files.
+func WithRootFiles(paths ...string) Option {
+	return func(v *Verifier) error {
+		for _, path := range paths {
+			certs, err := loadCertificates(path)
+			if err != nil {
+				return fmt.Errorf("could not load trusted root %s: %w", path, err)
+			}
+
+			v.roots = append(v.roots, certs...)
+		}
+
+		return nil
+	}
+}
+
+// WithIntermediateFiles adds the PEM encoded certificates found in each of the
+// files to the pool of intermediates used when building certificate chains.
+func WithIntermediateFiles(paths ...string) Option {
+	return func(v *Verifier) error {
+		for _, path := range paths {
+			certs, err := loadCertificates(path)
+			if err != nil {
+				return fmt.Errorf("could not load intermediate %s: %w", path, err)
+			}
+
+			v.intermediates = append(v.intermediates, certs...)
+		}
+
+		return nil
+	}
+}
+
+// WithVerifiers trusts the provided verifiers directly.
+func WithVerifiers(verifiers ...cryptoutil.Verifier) Option {
+	return func(v *Verifier) error {
+		v.verifiers = append(v.verifiers, verifiers...)
+		return nil
+	}
+}
+
+// WithRoots trusts certificates that chain to any of the provided roots.
+func WithRoots(roots ...*x509.Certificate) Option {
+	return func(v *Verifier) error {
+		v.roots = append(v.roots, roots...)
+		return nil
+	}
+}
+
+func New(opts ...Option) (*Verifier, error) {
+	v := &Verifier{}
+	for _, opt := range opts {
+		if err := opt(v); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(v.verifiers) == 0 && len(v.roots) == 0 {
+		return nil, errors.New("at least one trusted key or root certificate is required")
+	}
+
+	return v, nil
+}
+
+// Verify checks each signature on the envelope independently so the caller can
+// tell which signatures are trusted. An ErrUntrustedEnvelope is returned if no
+// signature verifies.
+func (v *Verifier) Verify(ctx context.Context, envelope dsse.Envelope) (Result, error) {
+	if len(envelope.Signatures) == 0 {
+		return Result{}, ErrUntrustedEnvelope{Reason: dsse.ErrNoSignatures{}}
+	}
+
+	result := Result{Signatures: make([]bool, len(envelope.Signatures))}
+	verified := 0
+	var errs []error
+	for i, sig := range envelope.Signatures {
+		single := dsse.Envelope{
+			Payload:     envelope.Payload,
+			PayloadType: envelope.PayloadType,
+			Signatures:  []dsse.Signature{sig},
+		}
+
+		if _, err := single.Verify(
+			dsse.VerifyWithVerifiers(v.verifiers...),
+			dsse.VerifyWithRoots(v.roots...),
+			dsse.VerifyWithIntermediates(v.intermediates...),
+		); err != nil {
+			errs = append(errs, fmt.Errorf("signature %d (keyid %q): %w", i, sig.KeyID, err))
+			continue
+		}
+
+		result.Signatures[i] = true
+		verified++
+	}
+
+	if verified == 0 {
+		return result, ErrUntrustedEnvelope{Reason: errors.Join(errs...)}
+	}
+
+	return result, nil
+}
+
+func loadCertificates(path string) ([]*x509.Certificate, error) {
+	pemBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := make([]*x509.Certificate, 0)
+	for block, rest := pem.Decode(pemBytes); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != dsse.PemTypeCertificate {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates found")
+	}
+
+	return certs, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The Archivista Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/in-toto/archivista/pkg/verifier"
+	"github.com/in-toto/go-witness/cryptoutil"
+	"github.com/in-toto/go-witness/dsse"
+	"github.com/stretchr/testify/suite"
+)
+
+// Test Suite: UT Verifier
+type UTVerifierSuite struct {
+	suite.Suite
+	trusted   cryptoutil.Signer
+	untrusted cryptoutil.Signer
+	keyFile   string
+}
+
+func TestUTVerifierSuite(t *testing.T) {
+	suite.Run(t, new(UTVerifierSuite))
+}
+
+func (ut *UTVerifierSuite) SetupTest() {
+	ut.trusted = ut.newSigner()
+	ut.untrusted = ut.newSigner()
+
+	trustedVerifier, err := ut.trusted.Verifier()
+	ut.Require().NoError(err)
+	pemBytes, err := trustedVerifier.Bytes()
+	ut.Require().NoError(err)
+
+	ut.keyFile = filepath.Join(ut.T().TempDir(), "trusted.pem")
+	ut.Require().NoError(os.WriteFile(ut.keyFile, pemBytes, 0o600))
+}
+
+func (ut *UTVerifierSuite) newSigner() cryptoutil.Signer {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	ut.Require().NoError(err)
+	return cryptoutil.NewED25519Signer(priv)
+}
+
+func (ut *UTVerifierSuite) sign(signers ...cryptoutil.Signer) dsse.Envelope {
+	env, err := dsse.Sign("application/vnd.in-toto+json", strings.NewReader(`{"hello":"world"}`), dsse.SignWithSigners(signers...))
+	ut.Require().NoError(err)
+	return env
+}
+
+func (ut *UTVerifierSuite) Test_New_NoTrustRoot() {
+	_, err := verifier.New()
+	ut.ErrorContains(err, "at least one trusted key or root certificate is required")
+}
+
+func (ut *UTVerifierSuite) Test_New_MissingKeyFile() {
+	_, err := verifier.New(verifier.WithKeyFiles(filepath.Join(ut.T().TempDir(), "missing.pem")))
+	ut.ErrorContains(err, "could not read trusted key")
+}
+
+func (ut *UTVerifierSuite) Test_New_RootFileWithoutCertificates() {
+	_, err := verifier.New(verifier.WithRootFiles(ut.keyFile))
+	ut.ErrorContains(err, "no certificates found")
+}
+
+func (ut *UTVerifierSuite) Test_Verify_Trusted() {
+	v, err := verifier.New(verifier.WithKeyFiles(ut.keyFile))
+	ut.Require().NoError(err)
+
+	result, err := v.Verify(context.TODO(), ut.sign(ut.trusted))
+	ut.NoError(err)
+	ut.True(result.SignatureVerified(0))
+}
+
+func (ut *UTVerifierSuite) Test_Verify_Untrusted() {
+	v, err := verifier.New(verifier.WithKeyFiles(ut.keyFile))
+	ut.Require().NoError(err)
+
+	_, err = v.Verify(context.TODO(), ut.sign(ut.untrusted))
+	var untrusted verifier.ErrUntrustedEnvelope
+	ut.True(errors.As(err, &untrusted))
+}
+
+func (ut *UTVerifierSuite) Test_Verify_NoSignatures() {
+	v, err := verifier.New(verifier.WithKeyFiles(ut.keyFile))
+	ut.Require().NoError(err)
+
+	env := ut.sign(ut.trusted)
+	env.Signatures = nil
+	_, err = v.Verify(context.TODO(), env)
+	ut.ErrorContains(err, "no signatures in dsse envelope")
+}
+
+func (ut *UTVerifierSuite) Test_Verify_PartiallyTrusted() {
+	v, err := verifier.New(verifier.WithKeyFiles(ut.keyFile))
+	ut.Require().NoError(err)
+
+	result, err := v.Verify(context.TODO(), ut.sign(ut.untrusted, ut.trusted))
+	ut.NoError(err)
+	ut.False(result.SignatureVerified(0))
+	ut.True(result.SignatureVerified(1))
+	ut.False(result.SignatureVerified(2))
+}
+
+func (ut *UTVerifierSuite) Test_Context() {
+	_, ok := verifier.FromContext(context.TODO())
+	ut.False(ok)
+
+	ctx := verifier.NewContext(context.TODO(), verifier.Result{Signatures: []bool{true}})
+	result, ok := verifier.FromContext(ctx)
+	ut.True(ok)
+	ut.True(result.SignatureVerified(0))
+}